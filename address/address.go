@@ -56,6 +56,10 @@ var (
 	// ErrNoAddr is returned if no address is found in the address store.
 	ErrNoAddr = errors.New("address: no address found")
 
+	// ErrAddrAlreadyPaid is returned when attempting to revoke an address
+	// that already received a payment.
+	ErrAddrAlreadyPaid = errors.New("address: address already paid")
+
 	// ErrScriptKeyNotFound is returned when a script key is not found in
 	// the local database.
 	ErrScriptKeyNotFound = errors.New("script key not found")
@@ -456,7 +460,7 @@ func DecodeAddress(addr string, net *ChainParams) (*Tap, error) {
 	// so we can decode the TLV blob into an actual address struct.
 	_, data, err := bech32.DecodeNoLimit(addr)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBech32m, err)
 	}
 
 	// The remaining characters of the address returned are grouped into
@@ -464,13 +468,14 @@ func DecodeAddress(addr string, net *ChainParams) (*Tap, error) {
 	// we'll need to regroup into 8 bit words.
 	converted, err := bech32.ConvertBits(data, 5, 8, false)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBech32m, err)
 	}
 
 	var a Tap
 	buf := bytes.NewBuffer(converted)
 	if err := a.Decode(buf); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("address: unable to decode TLV "+
+			"payload: %w", err)
 	}
 
 	a.ChainParams = net
@@ -7,6 +7,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/bech32"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
@@ -524,9 +525,139 @@ func runBIPTestVector(t *testing.T, testVectors *TestVectors) {
 	}
 }
 
+// TestDecodeAddressMalformed tests that malformed addresses are rejected with a
+// descriptive error.
+func TestDecodeAddressMalformed(t *testing.T) {
+	t.Parallel()
+
+	_, encodedAddr, err := randEncodedAddress(
+		t, &TestNet3Tap, false, false, asset.Normal,
+	)
+	require.NoError(t, err)
+
+	// Flipping the last character invalidates the bech32m checksum.
+	lastChar := encodedAddr[len(encodedAddr)-1]
+	flipped := byte('q')
+	if lastChar == flipped {
+		flipped = 'p'
+	}
+	badChecksum := encodedAddr[:len(encodedAddr)-1] + string(flipped)
+
+	_, err = DecodeAddress(badChecksum, &TestNet3Tap)
+	require.ErrorIs(t, err, ErrInvalidBech32m)
+
+	// A correctly encoded bech32m string that doesn't contain a valid
+	// address TLV stream should fail while decoding the payload.
+	data, err := bech32.ConvertBits([]byte{0xff, 0x01}, 8, 5, true)
+	require.NoError(t, err)
+	badPayload, err := bech32.EncodeM(TestNet3Tap.TapHRP, data)
+	require.NoError(t, err)
+
+	_, err = DecodeAddress(badPayload, &TestNet3Tap)
+	require.ErrorContains(t, err, "unable to decode TLV payload")
+	require.NotErrorIs(t, err, ErrInvalidBech32m)
+}
+
 func FuzzAddressDecode(f *testing.F) {
 	f.Fuzz(func(t *testing.T, data []byte) {
 		a := &Tap{}
 		_ = a.Decode(bytes.NewReader(data))
 	})
 }
+
+// TestSummarizeEvents tests that address events are correctly grouped by their
+// address and that an address is only reported as paid once at least one of
+// its incoming transfers completed.
+func TestSummarizeEvents(t *testing.T) {
+	t.Parallel()
+
+	newAddr := func() *AddrWithKeyInfo {
+		return &AddrWithKeyInfo{
+			TaprootOutputKey: *test.RandPubKey(t),
+		}
+	}
+	paidAddr, pendingAddr, unusedAddr := newAddr(), newAddr(), newAddr()
+
+	events := []*Event{
+		{Addr: paidAddr, Status: StatusTransactionConfirmed},
+		{Addr: paidAddr, Status: StatusCompleted},
+		{Addr: pendingAddr, Status: StatusTransactionDetected},
+		{Addr: pendingAddr, Status: StatusProofReceived},
+		{Addr: nil, Status: StatusCompleted},
+	}
+
+	summaries := SummarizeEvents(events)
+	require.Len(t, summaries, 2)
+
+	paid := summaries[asset.ToSerialized(&paidAddr.TaprootOutputKey)]
+	require.NotNil(t, paid)
+	require.True(t, paid.Paid)
+	require.Equal(t, 2, paid.NumReceives)
+
+	pending := summaries[asset.ToSerialized(&pendingAddr.TaprootOutputKey)]
+	require.NotNil(t, pending)
+	require.False(t, pending.Paid)
+	require.Equal(t, 2, pending.NumReceives)
+
+	_, ok := summaries[asset.ToSerialized(&unusedAddr.TaprootOutputKey)]
+	require.False(t, ok)
+}
+
+// TestEventPaymentStatus tests that the payment status of an address event
+// reflects whether the received amount matches the amount of the address.
+func TestEventPaymentStatus(t *testing.T) {
+	t.Parallel()
+
+	addr := &AddrWithKeyInfo{
+		Tap: &Tap{
+			Amount: 100,
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		addr        *AddrWithKeyInfo
+		receivedAmt uint64
+		status      PaymentStatus
+	}{{
+		name:        "proof not received",
+		addr:        addr,
+		receivedAmt: 0,
+		status:      PaymentStatusUnknown,
+	}, {
+		name:        "no address",
+		addr:        nil,
+		receivedAmt: 100,
+		status:      PaymentStatusUnknown,
+	}, {
+		name:        "exact",
+		addr:        addr,
+		receivedAmt: 100,
+		status:      PaymentStatusExact,
+	}, {
+		name:        "overpaid",
+		addr:        addr,
+		receivedAmt: 101,
+		status:      PaymentStatusOverpaid,
+	}, {
+		name:        "underpaid",
+		addr:        addr,
+		receivedAmt: 99,
+		status:      PaymentStatusUnderpaid,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			event := &Event{
+				Addr:           tc.addr,
+				Status:         StatusCompleted,
+				ReceivedAmount: tc.receivedAmt,
+			}
+			require.Equal(t, tc.status, event.PaymentStatus())
+		})
+	}
+}
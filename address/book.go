@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -46,6 +47,16 @@ type AddrWithKeyInfo struct {
 	// ManagedAfter is the time at which the address was imported into the
 	// wallet.
 	ManagedAfter time.Time
+
+	// RevokedAt is the time at which the address was revoked. Payments to a
+	// revoked address are no longer claimed by the wallet. If the address
+	// isn't revoked, this is the zero time.
+	RevokedAt time.Time
+}
+
+// IsRevoked returns true if the address was revoked.
+func (a *AddrWithKeyInfo) IsRevoked() bool {
+	return !a.RevokedAt.IsZero()
 }
 
 // QueryParams holds the set of query params for the address book.
@@ -95,6 +106,16 @@ type Storage interface {
 	SetAddrManaged(ctx context.Context, addr *AddrWithKeyInfo,
 		managedFrom time.Time) error
 
+	// RevokeAddr sets an address as being revoked, so payments to it are
+	// no longer claimed.
+	RevokeAddr(ctx context.Context, addr *AddrWithKeyInfo,
+		revokedAt time.Time) error
+
+	// HasRevokedAddr returns true if any revoked address uses the given
+	// script key.
+	HasRevokedAddr(ctx context.Context,
+		scriptKey *btcec.PublicKey) (bool, error)
+
 	// InsertInternalKey inserts an internal key into the database to make
 	// sure it is identified as a local key later on when importing proofs.
 	// The key can be an internal key for an asset script key or the
@@ -102,6 +123,12 @@ type Storage interface {
 	InsertInternalKey(ctx context.Context,
 		keyDesc keychain.KeyDescriptor) error
 
+	// QueryInternalKeys returns the internal keys stored in the database,
+	// sorted by their key family and index. If the key family is set, only
+	// the keys of that family are returned.
+	QueryInternalKeys(ctx context.Context,
+		keyFamily *keychain.KeyFamily) ([]keychain.KeyDescriptor, error)
+
 	// InsertScriptKey inserts an address related script key into the
 	// database, so it can be recognized as belonging to the wallet when a
 	// transfer comes in later on.
@@ -308,6 +335,24 @@ func (b *Book) NextInternalKey(ctx context.Context,
 	return internalKey, nil
 }
 
+// ListInternalKeys returns the internal keys that were derived by the wallet,
+// sorted by their key family and index. If the key family is set, only the
+// keys of that family are returned. Keys of other parties, such as the internal
+// keys of anchor outputs we sent assets to, are stored with an empty key
+// locator and are skipped.
+func (b *Book) ListInternalKeys(ctx context.Context,
+	keyFamily *keychain.KeyFamily) ([]keychain.KeyDescriptor, error) {
+
+	keys, err := b.cfg.Store.QueryInternalKeys(ctx, keyFamily)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query internal keys: %w", err)
+	}
+
+	return fn.Filter(keys, func(key keychain.KeyDescriptor) bool {
+		return key.Family != 0
+	}), nil
+}
+
 // NextScriptKey derives then inserts a script key into the database to make
 // sure it is identified as a local key later on when importing proofs.
 func (b *Book) NextScriptKey(ctx context.Context,
@@ -350,6 +395,54 @@ func (b *Book) SetAddrManaged(ctx context.Context, addr *AddrWithKeyInfo,
 	return b.cfg.Store.SetAddrManaged(ctx, addr, managedFrom)
 }
 
+// RevokeAddr revokes the address with the given Taproot output key. Payments to
+// a revoked address are no longer claimed by the wallet, and proofs for its
+// script key are rejected. An address that already received a payment can't be
+// revoked.
+func (b *Book) RevokeAddr(ctx context.Context,
+	taprootOutputKey *btcec.PublicKey) (*AddrWithKeyInfo, error) {
+
+	addr, err := b.cfg.Store.AddrByTaprootOutput(ctx, taprootOutputKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Revoking an address twice is a no-op, we keep the time of the first
+	// revocation.
+	if addr.IsRevoked() {
+		return addr, nil
+	}
+
+	completed := StatusCompleted
+	events, err := b.cfg.Store.QueryAddrEvents(ctx, EventQueryParams{
+		AddrTaprootOutputKey: schnorr.SerializePubKey(taprootOutputKey),
+		StatusFrom:           &completed,
+		StatusTo:             &completed,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to query addr events: %w", err)
+	}
+	if len(events) > 0 {
+		return nil, ErrAddrAlreadyPaid
+	}
+
+	revokedAt := time.Now()
+	if err := b.cfg.Store.RevokeAddr(ctx, addr, revokedAt); err != nil {
+		return nil, fmt.Errorf("unable to revoke addr: %w", err)
+	}
+	addr.RevokedAt = revokedAt.UTC()
+
+	return addr, nil
+}
+
+// IsScriptKeyRevoked returns true if the given script key belongs to a revoked
+// address.
+func (b *Book) IsScriptKeyRevoked(ctx context.Context,
+	scriptKey *btcec.PublicKey) (bool, error) {
+
+	return b.cfg.Store.HasRevokedAddr(ctx, scriptKey)
+}
+
 // GetOrCreateEvent creates a new address event for the given status, address
 // and transaction. If an event for that address and transaction already exists,
 // then the status and transaction information is updated instead.
@@ -382,11 +475,14 @@ func (b *Book) QueryEvents(ctx context.Context,
 }
 
 // CompleteEvent updates an address event as being complete and links it with
-// the proof and asset that was imported/created for it.
+// the proof and asset that was imported/created for it. The asset amount that
+// was actually received is stored with the event.
 func (b *Book) CompleteEvent(ctx context.Context, event *Event,
-	status Status, anchorPoint wire.OutPoint) error {
+	status Status, anchorPoint wire.OutPoint, receivedAmt uint64) error {
 
-	return b.cfg.Store.CompleteEvent(ctx, event, status, anchorPoint)
+	return b.cfg.Store.CompleteEvent(
+		ctx, event, status, anchorPoint, receivedAmt,
+	)
 }
 
 // RegisterSubscriber adds a new subscriber for receiving events. The
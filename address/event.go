@@ -8,6 +8,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/asset"
 )
 
 // Status denotes an address event's current status.
@@ -34,6 +35,46 @@ const (
 	StatusCompleted Status = 3
 )
 
+// PaymentStatus denotes whether the asset amount received by an incoming asset
+// transfer matches the amount of the address it was sent to.
+type PaymentStatus uint8
+
+const (
+	// PaymentStatusUnknown denotes that the received amount isn't known
+	// yet, as the proof of the transfer wasn't received yet.
+	PaymentStatusUnknown PaymentStatus = 0
+
+	// PaymentStatusExact denotes that the received amount matches the
+	// amount of the address.
+	PaymentStatusExact PaymentStatus = 1
+
+	// PaymentStatusOverpaid denotes that the received amount is larger
+	// than the amount of the address.
+	PaymentStatusOverpaid PaymentStatus = 2
+
+	// PaymentStatusUnderpaid denotes that the received amount is smaller
+	// than the amount of the address. The received assets are still taken
+	// custody of, so the payment can be reconciled.
+	PaymentStatusUnderpaid PaymentStatus = 3
+)
+
+// String returns a human-readable string for the payment status.
+func (s PaymentStatus) String() string {
+	switch s {
+	case PaymentStatusExact:
+		return "exact"
+
+	case PaymentStatusOverpaid:
+		return "overpaid"
+
+	case PaymentStatusUnderpaid:
+		return "underpaid"
+
+	default:
+		return "unknown"
+	}
+}
+
 // EventQueryParams holds the set of query params for address events.
 type EventQueryParams struct {
 	// AddrTaprootOutputKey is the optional 32-byte x-only serialized
@@ -93,6 +134,74 @@ type Event struct {
 	// don't keep a reference to it in memory as the proof itself can be
 	// large. The proof can be fetched by the script key of the address.
 	HasProof bool
+
+	// ReceivedAmount is the asset amount that was actually received by the
+	// transfer, which might differ from the amount of the address. This is
+	// only known once the proof of the transfer was received, and is zero
+	// before that.
+	ReceivedAmount uint64
+}
+
+// PaymentStatus returns whether the asset amount received by the transfer
+// matches the amount of the address it was sent to.
+func (e *Event) PaymentStatus() PaymentStatus {
+	if e.Addr == nil || e.ReceivedAmount == 0 {
+		return PaymentStatusUnknown
+	}
+
+	return NewPaymentStatus(e.Addr.Amount, e.ReceivedAmount)
+}
+
+// NewPaymentStatus returns the payment status of a transfer that received the
+// given amount of assets for an address of the given amount.
+func NewPaymentStatus(addrAmt, receivedAmt uint64) PaymentStatus {
+	switch {
+	case receivedAmt > addrAmt:
+		return PaymentStatusOverpaid
+
+	case receivedAmt < addrAmt:
+		return PaymentStatusUnderpaid
+
+	default:
+		return PaymentStatusExact
+	}
+}
+
+// ReceiveSummary summarizes all incoming transfers that were detected for a
+// single Taproot Asset address.
+type ReceiveSummary struct {
+	// NumReceives is the number of on-chain transfers that were detected
+	// for the address, regardless of their status.
+	NumReceives int
+
+	// Paid indicates that at least one incoming transfer to the address
+	// was completed and the local node took custody of the assets.
+	Paid bool
+}
+
+// SummarizeEvents groups the given address events by the Taproot output key of
+// their address and returns a receive summary for each address.
+func SummarizeEvents(events []*Event) map[asset.SerializedKey]*ReceiveSummary {
+	summaries := make(map[asset.SerializedKey]*ReceiveSummary)
+	for _, event := range events {
+		if event.Addr == nil {
+			continue
+		}
+
+		key := asset.ToSerialized(&event.Addr.TaprootOutputKey)
+		summary, ok := summaries[key]
+		if !ok {
+			summary = &ReceiveSummary{}
+			summaries[key] = summary
+		}
+
+		summary.NumReceives++
+		if event.Status == StatusCompleted {
+			summary.Paid = true
+		}
+	}
+
+	return summaries
 }
 
 // EventStorage is the interface that a component storing address events should
@@ -112,7 +221,8 @@ type EventStorage interface {
 		error)
 
 	// CompleteEvent updates an address event as being complete and links it
-	// with the proof and asset that was imported/created for it.
+	// with the proof and asset that was imported/created for it. The asset
+	// amount that was actually received is stored with the event.
 	CompleteEvent(ctx context.Context, event *Event, status Status,
-		anchorPoint wire.OutPoint) error
+		anchorPoint wire.OutPoint, receivedAmt uint64) error
 }
@@ -20,8 +20,10 @@ var addrCommands = []cli.Command{
 		Subcommands: []cli.Command{
 			newAddrCommand,
 			queryAddrsCommand,
+			listAddrsCommand,
 			decodeAddrCommand,
 			receivesAddrCommand,
+			revokeAddrCommand,
 		},
 	},
 }
@@ -168,6 +170,49 @@ func queryAddr(ctx *cli.Context) error {
 	return nil
 }
 
+const (
+	unpaidOnlyName = "unpaid_only"
+
+	includeRevokedName = "include_revoked"
+)
+
+var listAddrsCommand = cli.Command{
+	Name:      "list",
+	ShortName: "l",
+	Usage:     "List created addresses with their paid and revoked status",
+	Description: "List the created addresses together with whether they " +
+		"were paid or revoked. Revoked addresses are only listed if " +
+		"--include_revoked is set.",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  unpaidOnlyName,
+			Usage: "only list addresses that haven't been paid yet",
+		},
+		cli.BoolFlag{
+			Name:  includeRevokedName,
+			Usage: "also list revoked addresses",
+		},
+	},
+	Action: listAddrs,
+}
+
+func listAddrs(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListAddrs(ctxc, &taprpc.ListAddrsRequest{
+		UnpaidOnly:     ctx.Bool(unpaidOnlyName),
+		IncludeRevoked: ctx.Bool(includeRevokedName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list addrs: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 const addrName = "addr"
 
 var decodeAddrCommand = cli.Command{
@@ -250,3 +295,50 @@ func addrReceives(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var revokeAddrCommand = cli.Command{
+	Name:      "revoke",
+	ShortName: "rv",
+	ArgsUsage: "[--addr | addr]",
+	Usage:     "Revoke an address that hasn't been paid yet",
+	Description: `
+	Revoke a taproot asset address that hasn't been paid yet. Payments to a
+	revoked address are no longer claimed by the wallet, and proofs for its
+	script key are rejected. An already paid address can't be revoked.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  addrName,
+			Usage: "the address to revoke",
+		},
+	},
+	Action: revokeAddr,
+}
+
+func revokeAddr(ctx *cli.Context) error {
+	var addr string
+	switch {
+	case ctx.String(addrName) != "":
+		addr = ctx.String(addrName)
+
+	case len(ctx.Args()) > 0:
+		addr = ctx.Args().First()
+
+	default:
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.RevokeAddr(ctxc, &taprpc.RevokeAddrRequest{
+		Addr: addr,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to revoke addr: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
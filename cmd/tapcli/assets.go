@@ -1,14 +1,21 @@
 package main
 
 import (
+	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
 	"math"
 	"os"
+	"strconv"
+	"strings"
 
 	taprootassets "github.com/lightninglabs/taproot-assets"
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/tapcfg"
 	"github.com/lightninglabs/taproot-assets/taprpc"
+	wrpc "github.com/lightninglabs/taproot-assets/taprpc/assetwalletrpc"
 	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
 	"github.com/urfave/cli"
 )
@@ -23,12 +30,16 @@ var assetsCommands = []cli.Command{
 			mintAssetCommand,
 			listAssetsCommand,
 			listUtxosCommand,
+			setAssetLabelCommand,
 			listGroupsCommand,
 			listAssetBalancesCommand,
 			sendAssetsCommand,
+			estimateFeeCommand,
 			burnAssetsCommand,
 			listTransfersCommand,
 			fetchMetaCommand,
+			signMessageCommand,
+			verifyMessageCommand,
 		},
 	},
 }
@@ -45,13 +56,30 @@ var (
 	assetShowSpentName           = "show_spent"
 	assetGroupKeyName            = "group_key"
 	assetGroupAnchorName         = "group_anchor"
+	assetEmissionEventName       = "emission_event"
 	batchKeyName                 = "batch_key"
 	groupByGroupName             = "by_group"
 	assetIDName                  = "asset_id"
 	shortResponseName            = "short"
 	feeRateName                  = "fee_rate"
+	satPerVByteName              = "sat_per_vbyte"
 	assetAmountName              = "amount"
 	burnOverrideConfirmationName = "override_confirmation_destroy_assets"
+	assetLabelName               = "label"
+	labelFilterName              = "label_filter"
+	nonZeroOnlyName              = "non_zero"
+	proofCourierAddrName         = "proof_courier_addr"
+	batchNameName                = "batch_name"
+	openOnlyName                 = "open_only"
+	groupInternalKeyName         = "group_internal_key"
+	genesisPointName             = "genesis_point"
+	anchorOutputIndexName        = "anchor_output_index"
+	fundedPsbtName               = "funded_psbt"
+	signedPsbtName               = "signed_psbt"
+	genesisUtxoName              = "genesis_utxo"
+	genesisMinValueName          = "genesis_min_value"
+	batchStateName               = "state"
+	targetConfsName              = "target_confs"
 )
 
 var mintAssetCommand = cli.Command{
@@ -87,8 +115,9 @@ var mintAssetCommand = cli.Command{
 				"and used as the asset meta",
 		},
 		cli.IntFlag{
-			Name:  assetMetaTypeName,
-			Usage: "the type of the meta data for the asset",
+			Name: assetMetaTypeName,
+			Usage: "the type of the meta data for the asset, " +
+				"0 for opaque and 1 for JSON",
 		},
 		cli.BoolFlag{
 			Name: assetEmissionName,
@@ -105,6 +134,30 @@ var mintAssetCommand = cli.Command{
 			Usage: "the other asset in this batch that the new " +
 				"asset be grouped with",
 		},
+		cli.StringSliceFlag{
+			Name: assetEmissionEventName,
+			Usage: "a future issuance of the asset in the form " +
+				"block_height:amount; once the block height " +
+				"is reached, the amount is issued into the " +
+				"asset group; can be specified multiple times",
+		},
+		cli.StringFlag{
+			Name: assetLabelName,
+			Usage: "an optional human-readable label of the " +
+				"asset that is only stored locally",
+		},
+		cli.StringFlag{
+			Name: proofCourierAddrName,
+			Usage: "an optional proof courier address to " +
+				"embed into addresses created for the asset " +
+				"instead of the default one of the node",
+		},
+		cli.StringFlag{
+			Name: batchNameName,
+			Usage: "if set, the seedling is added to the named " +
+				"batch which is only finalized on explicit " +
+				"request",
+		},
 		cli.BoolFlag{
 			Name: shortResponseName,
 			Usage: "if true, then the current assets within the " +
@@ -116,8 +169,15 @@ var mintAssetCommand = cli.Command{
 	Action: mintAsset,
 	Subcommands: []cli.Command{
 		listBatchesCommand,
+		listMintsCommand,
 		finalizeBatchCommand,
 		cancelBatchCommand,
+		bumpBatchFeeCommand,
+		fetchEmissionScheduleCommand,
+		fetchBatchAnchorCommand,
+		batchConfirmationsCommand,
+		previewAssetIDCommand,
+		mintPsbtCommand,
 	},
 }
 
@@ -135,6 +195,40 @@ func parseAssetType(ctx *cli.Context) (taprpc.AssetType, error) {
 	}
 }
 
+// parseAssetMeta parses the asset meta from either the meta bytes or the meta
+// file path flag. If neither is set, then nil is returned.
+func parseAssetMeta(ctx *cli.Context) (*taprpc.AssetMeta, error) {
+	switch {
+	case ctx.String(assetMetaBytesName) != "" &&
+		ctx.String(assetMetaFilePathName) != "":
+		return nil, fmt.Errorf("meta bytes or meta file path cannot " +
+			"be both set")
+
+	case ctx.String(assetMetaBytesName) != "":
+		return &taprpc.AssetMeta{
+			Data: []byte(ctx.String(assetMetaBytesName)),
+			Type: taprpc.AssetMetaType(ctx.Int(assetMetaTypeName)),
+		}, nil
+
+	case ctx.String(assetMetaFilePathName) != "":
+		metaPath := tapcfg.CleanAndExpandPath(
+			ctx.String(assetMetaFilePathName),
+		)
+		metaFileBytes, err := os.ReadFile(metaPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read meta file: %w",
+				err)
+		}
+
+		return &taprpc.AssetMeta{
+			Data: metaFileBytes,
+			Type: taprpc.AssetMetaType(ctx.Int(assetMetaTypeName)),
+		}, nil
+	}
+
+	return nil, nil
+}
+
 func mintAsset(ctx *cli.Context) error {
 	switch {
 	case ctx.String(assetTagName) == "":
@@ -156,33 +250,9 @@ func mintAsset(ctx *cli.Context) error {
 		}
 	}
 
-	// Both the meta bytes and the meta path can be set.
-	var assetMeta *taprpc.AssetMeta
-	switch {
-	case ctx.String(assetMetaBytesName) != "" &&
-		ctx.String(assetMetaFilePathName) != "":
-		return fmt.Errorf("meta bytes or meta file path cannot " +
-			"be both set")
-
-	case ctx.String(assetMetaBytesName) != "":
-		assetMeta = &taprpc.AssetMeta{
-			Data: []byte(ctx.String(assetMetaBytesName)),
-			Type: taprpc.AssetMetaType(ctx.Int(assetMetaTypeName)),
-		}
-
-	case ctx.String(assetMetaFilePathName) != "":
-		metaPath := tapcfg.CleanAndExpandPath(
-			ctx.String(assetMetaFilePathName),
-		)
-		metaFileBytes, err := os.ReadFile(metaPath)
-		if err != nil {
-			return fmt.Errorf("unable to read meta file: %w", err)
-		}
-
-		assetMeta = &taprpc.AssetMeta{
-			Data: metaFileBytes,
-			Type: taprpc.AssetMetaType(ctx.Int(assetMetaTypeName)),
-		}
+	assetMeta, err := parseAssetMeta(ctx)
+	if err != nil {
+		return err
 	}
 
 	assetType, err := parseAssetType(ctx)
@@ -212,6 +282,13 @@ func mintAsset(ctx *cli.Context) error {
 		return fmt.Errorf("supply must be set for normal assets")
 	}
 
+	emissionSchedule, err := parseEmissionSchedule(
+		ctx.StringSlice(assetEmissionEventName),
+	)
+	if err != nil {
+		return err
+	}
+
 	ctxc := getContext()
 	client, cleanUp := getMintClient(ctx)
 	defer cleanUp()
@@ -227,9 +304,13 @@ func mintAsset(ctx *cli.Context) error {
 			AssetVersion: taprpc.AssetVersion(
 				ctx.Uint64(assetVersionName),
 			),
+			EmissionSchedule: emissionSchedule,
+			Label:            ctx.String(assetLabelName),
+			ProofCourierAddr: ctx.String(proofCourierAddrName),
 		},
 		EnableEmission: ctx.Bool(assetEmissionName),
 		ShortResponse:  ctx.Bool(shortResponseName),
+		BatchName:      ctx.String(batchNameName),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to mint asset: %w", err)
@@ -239,6 +320,40 @@ func mintAsset(ctx *cli.Context) error {
 	return nil
 }
 
+// parseEmissionSchedule parses a set of emission events, each in the form of
+// block_height:amount.
+func parseEmissionSchedule(events []string) ([]*mintrpc.EmissionEvent,
+	error) {
+
+	schedule := make([]*mintrpc.EmissionEvent, 0, len(events))
+	for _, event := range events {
+		heightStr, amountStr, ok := strings.Cut(event, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid emission event '%v', "+
+				"expected block_height:amount", event)
+		}
+
+		height, err := strconv.ParseUint(heightStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid emission block "+
+				"height '%v': %w", heightStr, err)
+		}
+
+		amount, err := strconv.ParseUint(amountStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid emission amount "+
+				"'%v': %w", amountStr, err)
+		}
+
+		schedule = append(schedule, &mintrpc.EmissionEvent{
+			BlockHeight: uint32(height),
+			Amount:      amount,
+		})
+	}
+
+	return schedule, nil
+}
+
 var finalizeBatchCommand = cli.Command{
 	Name:        "finalize",
 	ShortName:   "f",
@@ -257,10 +372,46 @@ var finalizeBatchCommand = cli.Command{
 			Usage: "if set, the fee rate in sat/kw to use for the" +
 				"minting transaction",
 		},
+		cli.Uint64Flag{
+			Name: satPerVByteName,
+			Usage: "if set, the fee rate in sat/vByte to use for " +
+				"the minting transaction",
+		},
+		cli.StringFlag{
+			Name: batchNameName,
+			Usage: "if set, the named batch to finalize instead " +
+				"of the default pending batch",
+		},
+		cli.StringFlag{
+			Name: genesisUtxoName,
+			Usage: "if set, the wallet UTXO in the form " +
+				"txid:vout to spend as the genesis point of " +
+				"the minting transaction, which the asset " +
+				"IDs are derived from",
+		},
+		cli.Uint64Flag{
+			Name: genesisMinValueName,
+			Usage: "if set, the minimum value in satoshis of " +
+				"the wallet UTXO to spend as the genesis " +
+				"point of the minting transaction",
+		},
 	},
 	Action: finalizeBatch,
 }
 
+// parseGenesisPointPolicy parses the optional genesis point policy flags. Nil
+// is returned if none of the flags are set.
+func parseGenesisPointPolicy(ctx *cli.Context) *mintrpc.GenesisPointPolicy {
+	if !ctx.IsSet(genesisUtxoName) && !ctx.IsSet(genesisMinValueName) {
+		return nil
+	}
+
+	return &mintrpc.GenesisPointPolicy{
+		Outpoint:    ctx.String(genesisUtxoName),
+		MinValueSat: ctx.Uint64(genesisMinValueName),
+	}
+}
+
 func parseFeeRate(ctx *cli.Context) (uint32, error) {
 	if ctx.IsSet(feeRateName) {
 		feeRate := ctx.Uint64(feeRateName)
@@ -287,6 +438,9 @@ func finalizeBatch(ctx *cli.Context) error {
 	resp, err := client.FinalizeBatch(ctxc, &mintrpc.FinalizeBatchRequest{
 		ShortResponse: ctx.Bool(shortResponseName),
 		FeeRate:       feeRate,
+		SatPerVbyte:   ctx.Uint64(satPerVByteName),
+		BatchName:     ctx.String(batchNameName),
+		GenesisPolicy: parseGenesisPointPolicy(ctx),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to finalize batch: %w", err)
@@ -318,6 +472,482 @@ func cancelBatch(ctx *cli.Context) error {
 	return nil
 }
 
+var bumpBatchFeeCommand = cli.Command{
+	Name:  "bump",
+	Usage: "bump the fee of a broadcast batch",
+	Description: "Attempt to replace the broadcast minting transaction " +
+		"of a batch with one that pays a higher fee. The fee " +
+		"increase is paid for by the change output of the minting " +
+		"transaction.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  batchKeyName,
+			Usage: "the batch key of the batch to bump the fee of",
+		},
+		cli.Uint64Flag{
+			Name: satPerVByteName,
+			Usage: "the fee rate in sat/vByte to use for the " +
+				"replacement minting transaction",
+		},
+	},
+	Action: bumpBatchFee,
+}
+
+func bumpBatchFee(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	if !ctx.IsSet(batchKeyName) || !ctx.IsSet(satPerVByteName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	batchKey, err := hex.DecodeString(ctx.String(batchKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid batch key")
+	}
+
+	resp, err := client.BumpBatchFee(ctxc, &mintrpc.BumpBatchFeeRequest{
+		BatchKey:    batchKey,
+		SatPerVbyte: ctx.Uint64(satPerVByteName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to bump batch fee: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var fetchEmissionScheduleCommand = cli.Command{
+	Name:  "emission",
+	Usage: "show the remaining emission schedule of an asset group",
+	Description: "Show the scheduled future issuance events of an asset " +
+		"group that weren't issued yet.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: assetGroupKeyName,
+			Usage: "the group key of the asset group to show the " +
+				"emission schedule of",
+		},
+	},
+	Action: fetchEmissionSchedule,
+}
+
+func fetchEmissionSchedule(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	if !ctx.IsSet(assetGroupKeyName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	groupKey, err := hex.DecodeString(ctx.String(assetGroupKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid group key")
+	}
+
+	resp, err := client.FetchEmissionSchedule(
+		ctxc, &mintrpc.FetchEmissionScheduleRequest{
+			Group: &mintrpc.FetchEmissionScheduleRequest_GroupKey{
+				GroupKey: groupKey,
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to fetch emission schedule: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var fetchBatchAnchorCommand = cli.Command{
+	Name:  "anchor",
+	Usage: "show the on-chain anchor of a broadcast batch",
+	Description: "Show the minting transaction of a batch and the index " +
+		"of the output that commits to its assets. Once the minting " +
+		"transaction is confirmed, the block it was confirmed in and " +
+		"a merkle proof of its inclusion in that block are shown as " +
+		"well.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: batchKeyName,
+			Usage: "the batch key of the batch to show the " +
+				"anchor of",
+		},
+	},
+	Action: fetchBatchAnchor,
+}
+
+func fetchBatchAnchor(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	if !ctx.IsSet(batchKeyName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	batchKey, err := hex.DecodeString(ctx.String(batchKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid batch key")
+	}
+
+	resp, err := client.FetchBatchAnchor(
+		ctxc, &mintrpc.FetchBatchAnchorRequest{
+			Batch: &mintrpc.FetchBatchAnchorRequest_BatchKey{
+				BatchKey: batchKey,
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to fetch batch anchor: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var batchConfirmationsCommand = cli.Command{
+	Name:  "confirmations",
+	Usage: "follow the confirmations of the minting transaction of a batch",
+	Description: "Follow the confirmations of the minting transaction " +
+		"of a batch and print an event for each new confirmation until " +
+		"the target number of confirmations is reached. If a re-org " +
+		"rolls the minting transaction back, a rollback event is " +
+		"printed and the confirmations are followed again.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: batchKeyName,
+			Usage: "the batch key of the batch to follow the " +
+				"confirmations of",
+		},
+		cli.Uint64Flag{
+			Name: targetConfsName,
+			Usage: "the number of confirmations of the minting " +
+				"transaction to wait for",
+			Value: 6,
+		},
+	},
+	Action: batchConfirmations,
+}
+
+func batchConfirmations(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	if !ctx.IsSet(batchKeyName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	batchKey, err := hex.DecodeString(ctx.String(batchKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid batch key")
+	}
+
+	stream, err := client.SubscribeBatchConfirmation(
+		ctxc, &mintrpc.SubscribeBatchConfirmationRequest{
+			BatchKey:    batchKey,
+			TargetConfs: uint32(ctx.Uint64(targetConfsName)),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to subscribe to batch "+
+			"confirmations: %w", err)
+	}
+
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("unable to receive batch "+
+				"confirmation event: %w", err)
+		}
+
+		printRespJSON(event)
+	}
+}
+
+var previewAssetIDCommand = cli.Command{
+	Name:  "preview",
+	Usage: "preview the asset ID of an asset before minting it",
+	Description: "Compute the asset ID an asset would be minted with, " +
+		"given the genesis point of the minting transaction, which " +
+		"is the outpoint spent by its first input. The asset ID " +
+		"commits to the genesis point, so minting the asset with a " +
+		"different genesis point results in a different asset ID. " +
+		"If the asset anchors a new asset group with a specific " +
+		"internal key, then the group key is shown as well.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: assetTypeName,
+			Usage: "the type of asset, must either be: normal, " +
+				"or collectible",
+			Value: "normal",
+		},
+		cli.StringFlag{
+			Name:  assetTagName,
+			Usage: "the name/tag of the asset",
+		},
+		cli.StringFlag{
+			Name:  assetMetaBytesName,
+			Usage: "the raw metadata associated with the asset",
+		},
+		cli.StringFlag{
+			Name: assetMetaFilePathName,
+			Usage: "a path to a file on disk that should be read " +
+				"and used as the asset meta",
+		},
+		cli.IntFlag{
+			Name: assetMetaTypeName,
+			Usage: "the type of the meta data for the asset, " +
+				"0 for opaque and 1 for JSON",
+		},
+		cli.BoolFlag{
+			Name: assetEmissionName,
+			Usage: "if true, then the asset anchors a new asset " +
+				"group",
+		},
+		cli.StringFlag{
+			Name: assetGroupKeyName,
+			Usage: "the key of the existing asset group the " +
+				"asset is minted into",
+		},
+		cli.StringFlag{
+			Name: groupInternalKeyName,
+			Usage: "the internal key of the new asset group, " +
+				"only valid if emission is enabled",
+		},
+		cli.StringFlag{
+			Name: genesisPointName,
+			Usage: "the genesis point of the minting transaction " +
+				"in the form txid:vout",
+		},
+		cli.Uint64Flag{
+			Name: anchorOutputIndexName,
+			Usage: "the index of the output of the minting " +
+				"transaction that commits to the assets",
+		},
+	},
+	Action: previewAssetID,
+}
+
+func previewAssetID(ctx *cli.Context) error {
+	if ctx.String(assetTagName) == "" ||
+		ctx.String(genesisPointName) == "" {
+
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	assetType, err := parseAssetType(ctx)
+	if err != nil {
+		return err
+	}
+
+	assetMeta, err := parseAssetMeta(ctx)
+	if err != nil {
+		return err
+	}
+
+	var groupKey []byte
+	if ctx.IsSet(assetGroupKeyName) {
+		groupKey, err = hex.DecodeString(ctx.String(assetGroupKeyName))
+		if err != nil {
+			return fmt.Errorf("invalid group key")
+		}
+	}
+
+	var groupInternalKey *taprpc.KeyDescriptor
+	if ctx.IsSet(groupInternalKeyName) {
+		rawKey, err := hex.DecodeString(
+			ctx.String(groupInternalKeyName),
+		)
+		if err != nil {
+			return fmt.Errorf("invalid group internal key")
+		}
+
+		groupInternalKey = &taprpc.KeyDescriptor{
+			RawKeyBytes: rawKey,
+		}
+	}
+
+	anchorOutputIndex := ctx.Uint64(anchorOutputIndexName)
+	if anchorOutputIndex > math.MaxUint32 {
+		return fmt.Errorf("anchor output index exceeds 2^32")
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.PreviewAssetID(ctxc, &mintrpc.PreviewAssetIDRequest{
+		Asset: &mintrpc.MintAsset{
+			AssetType:        assetType,
+			Name:             ctx.String(assetTagName),
+			AssetMeta:        assetMeta,
+			GroupKey:         groupKey,
+			GroupInternalKey: groupInternalKey,
+		},
+		EnableEmission:    ctx.Bool(assetEmissionName),
+		GenesisPoint:      ctx.String(genesisPointName),
+		AnchorOutputIndex: uint32(anchorOutputIndex),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to preview asset ID: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var mintPsbtCommand = cli.Command{
+	Name:  "psbt",
+	Usage: "mint a batch with an externally funded minting transaction",
+	Description: "Commit a batch to a minting transaction that is funded " +
+		"and signed by an external wallet, instead of the internal " +
+		"wallet.",
+	Subcommands: []cli.Command{
+		commitBatchPsbtCommand,
+		publishBatchPsbtCommand,
+	},
+}
+
+var commitBatchPsbtCommand = cli.Command{
+	Name:  "commit",
+	Usage: "commit a batch to an externally funded minting transaction",
+	Description: "Commit the assets of a batch to an output of a minting " +
+		"transaction that was funded by an external wallet. The " +
+		"returned unsigned PSBT must be signed by the external " +
+		"wallet and then published with the publish command. " +
+		"Besides the anchor output, the minting transaction can " +
+		"only have a single change output.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: fundedPsbtName,
+			Usage: "the hex encoded funded minting transaction " +
+				"PSBT",
+		},
+		cli.Uint64Flag{
+			Name: anchorOutputIndexName,
+			Usage: "the index of the output of the minting " +
+				"transaction that commits to the assets",
+		},
+		cli.StringFlag{
+			Name: batchNameName,
+			Usage: "if set, the named batch to commit instead of " +
+				"the default pending batch",
+		},
+		cli.BoolFlag{
+			Name: shortResponseName,
+			Usage: "if true, then the current assets within the " +
+				"batch will not be returned in the response " +
+				"in order to avoid printing a large amount " +
+				"of data in case of large batches",
+		},
+	},
+	Action: commitBatchPsbt,
+}
+
+func commitBatchPsbt(ctx *cli.Context) error {
+	if !ctx.IsSet(fundedPsbtName) || !ctx.IsSet(anchorOutputIndexName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	fundedPsbt, err := hex.DecodeString(ctx.String(fundedPsbtName))
+	if err != nil {
+		return fmt.Errorf("invalid funded psbt")
+	}
+
+	anchorOutputIndex := ctx.Uint64(anchorOutputIndexName)
+	if anchorOutputIndex > math.MaxUint32 {
+		return fmt.Errorf("anchor output index exceeds 2^32")
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.CommitBatchPsbt(
+		ctxc, &mintrpc.CommitBatchPsbtRequest{
+			FundedPsbt:        fundedPsbt,
+			AnchorOutputIndex: uint32(anchorOutputIndex),
+			BatchName:         ctx.String(batchNameName),
+			ShortResponse:     ctx.Bool(shortResponseName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to commit batch: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var publishBatchPsbtCommand = cli.Command{
+	Name:  "publish",
+	Usage: "publish the externally signed minting transaction of a batch",
+	Description: "Finalize and broadcast the signed minting transaction " +
+		"of a batch that was committed with the commit command. The " +
+		"signed transaction must be the exact transaction that was " +
+		"returned by the commit command.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  batchKeyName,
+			Usage: "the batch key of the batch to publish",
+		},
+		cli.StringFlag{
+			Name: signedPsbtName,
+			Usage: "the hex encoded signed minting transaction " +
+				"PSBT",
+		},
+		cli.BoolFlag{
+			Name: shortResponseName,
+			Usage: "if true, then the assets of the batch will " +
+				"not be returned in the response",
+		},
+	},
+	Action: publishBatchPsbt,
+}
+
+func publishBatchPsbt(ctx *cli.Context) error {
+	if !ctx.IsSet(batchKeyName) || !ctx.IsSet(signedPsbtName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	batchKey, err := hex.DecodeString(ctx.String(batchKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid batch key")
+	}
+
+	signedPsbt, err := hex.DecodeString(ctx.String(signedPsbtName))
+	if err != nil {
+		return fmt.Errorf("invalid signed psbt")
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.PublishBatchPsbt(
+		ctxc, &mintrpc.PublishBatchPsbtRequest{
+			BatchKey:      batchKey,
+			SignedPsbt:    signedPsbt,
+			ShortResponse: ctx.Bool(shortResponseName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to publish batch: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var listBatchesCommand = cli.Command{
 	Name:        "batches",
 	ShortName:   "b",
@@ -328,34 +958,138 @@ var listBatchesCommand = cli.Command{
 			Name:  batchKeyName,
 			Usage: "if set, the batch key for a specific batch",
 		},
+		cli.StringFlag{
+			Name: labelFilterName,
+			Usage: "if set, only list assets with a label that " +
+				"contains this string",
+		},
+		cli.BoolFlag{
+			Name: openOnlyName,
+			Usage: "if true, only list batches that are still " +
+				"open for new seedlings",
+		},
+	},
+	Action: listBatches,
+}
+
+func listBatches(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	var (
+		batchKeyStr = ctx.String(batchKeyName)
+		batchKey    []byte
+		err         error
+	)
+	if len(batchKeyStr) != 0 {
+		batchKey, err = hex.DecodeString(batchKeyStr)
+		if err != nil {
+			return fmt.Errorf("invalid batch key")
+		}
+	}
+
+	resp, err := client.ListBatches(ctxc, &mintrpc.ListBatchRequest{
+		Filter: &mintrpc.ListBatchRequest_BatchKey{
+			BatchKey: batchKey,
+		},
+		LabelFilter: ctx.String(labelFilterName),
+		OpenOnly:    ctx.Bool(openOnlyName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list batches: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listMintsCommand = cli.Command{
+	Name:      "history",
+	ShortName: "hi",
+	Usage:     "list the issuance history",
+	Description: `
+	List every batch that was ever submitted for minting, including
+	cancelled and failed batches, along with the minted assets and the
+	on-chain status of the minting transaction.
+	`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: startTime,
+			Usage: "(optional) only list batches created at or " +
+				"after this unix timestamp",
+		},
+		cli.Int64Flag{
+			Name: endTime,
+			Usage: "(optional) only list batches created at or " +
+				"before this unix timestamp",
+		},
+		cli.StringSliceFlag{
+			Name: batchStateName,
+			Usage: "(optional) only list batches in this state; " +
+				"one of 'pending', 'frozen', 'committed', " +
+				"'broadcast', 'confirmed', 'finalized', " +
+				"'seedling-cancelled', 'sprout-cancelled' or " +
+				"'awaiting-signature'; can be specified " +
+				"multiple times",
+		},
 	},
-	Action: listBatches,
+	Action: listMints,
 }
 
-func listBatches(ctx *cli.Context) error {
-	ctxc := getContext()
-	client, cleanUp := getMintClient(ctx)
-	defer cleanUp()
+func parseBatchState(state string) (mintrpc.BatchState, error) {
+	switch state {
+	case "pending":
+		return mintrpc.BatchState_BATCH_STATE_PEDNING, nil
 
-	var (
-		batchKeyStr = ctx.String(batchKeyName)
-		batchKey    []byte
-		err         error
+	case "frozen":
+		return mintrpc.BatchState_BATCH_STATE_FROZEN, nil
+
+	case "committed":
+		return mintrpc.BatchState_BATCH_STATE_COMMITTED, nil
+
+	case "broadcast":
+		return mintrpc.BatchState_BATCH_STATE_BROADCAST, nil
+
+	case "confirmed":
+		return mintrpc.BatchState_BATCH_STATE_CONFIRMED, nil
+
+	case "finalized":
+		return mintrpc.BatchState_BATCH_STATE_FINALIZED, nil
+
+	case "seedling-cancelled":
+		return mintrpc.BatchState_BATCH_STATE_SEEDLING_CANCELLED, nil
+
+	case "sprout-cancelled":
+		return mintrpc.BatchState_BATCH_STATE_SPROUT_CANCELLED, nil
+
+	case "awaiting-signature":
+		return mintrpc.BatchState_BATCH_STATE_AWAITING_SIGNATURE, nil
+
+	default:
+		return 0, fmt.Errorf("unknown batch state: %v", state)
+	}
+}
+
+func listMints(ctx *cli.Context) error {
+	states, err := fn.MapErr(
+		ctx.StringSlice(batchStateName), parseBatchState,
 	)
-	if len(batchKeyStr) != 0 {
-		batchKey, err = hex.DecodeString(batchKeyStr)
-		if err != nil {
-			return fmt.Errorf("invalid batch key")
-		}
+	if err != nil {
+		return err
 	}
 
-	resp, err := client.ListBatches(ctxc, &mintrpc.ListBatchRequest{
-		Filter: &mintrpc.ListBatchRequest_BatchKey{
-			BatchKey: batchKey,
-		},
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListMints(ctxc, &mintrpc.ListMintsRequest{
+		StartTimestamp: ctx.Int64(startTime),
+		EndTimestamp:   ctx.Int64(endTime),
+		States:         states,
 	})
 	if err != nil {
-		return fmt.Errorf("unable to list batches: %w", err)
+		return fmt.Errorf("unable to list mints: %w", err)
 	}
 
 	printRespJSON(resp)
@@ -376,6 +1110,11 @@ var listAssetsCommand = cli.Command{
 			Name:  assetShowSpentName,
 			Usage: "include fully spent assets in the list",
 		},
+		cli.StringFlag{
+			Name: labelFilterName,
+			Usage: "if set, only list assets with a label that " +
+				"contains this string",
+		},
 	},
 	Action: listAssets,
 }
@@ -390,6 +1129,7 @@ func listAssets(ctx *cli.Context) error {
 	resp, err := client.ListAssets(ctxc, &taprpc.ListAssetRequest{
 		WithWitness:  ctx.Bool(assetShowWitnessName),
 		IncludeSpent: ctx.Bool(assetShowSpentName),
+		LabelFilter:  ctx.String(labelFilterName),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to list assets: %w", err)
@@ -419,6 +1159,52 @@ func listUtxos(ctx *cli.Context) error {
 	return nil
 }
 
+var setAssetLabelCommand = cli.Command{
+	Name:  "setlabel",
+	Usage: "set the label of an asset",
+	Description: `
+	Set the human-readable label of all assets with the given asset ID. The
+	label is only stored locally and is not committed to on-chain. An empty
+	label removes the current label.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the asset to label",
+		},
+		cli.StringFlag{
+			Name:  assetLabelName,
+			Usage: "the new label of the asset",
+		},
+	},
+	Action: setAssetLabel,
+}
+
+func setAssetLabel(ctx *cli.Context) error {
+	if ctx.String(assetIDName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	assetID, err := hex.DecodeString(ctx.String(assetIDName))
+	if err != nil {
+		return fmt.Errorf("unable to decode asset ID: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SetAssetLabel(ctxc, &taprpc.SetAssetLabelRequest{
+		AssetId: assetID,
+		Label:   ctx.String(assetLabelName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set asset label: %w", err)
+	}
+	printRespJSON(resp)
+	return nil
+}
+
 var listGroupsCommand = cli.Command{
 	Name:        "groups",
 	ShortName:   "g",
@@ -462,6 +1248,11 @@ var listAssetBalancesCommand = cli.Command{
 				"balance query against. Must be used " +
 				"together with --by_group",
 		},
+		cli.BoolFlag{
+			Name: nonZeroOnlyName,
+			Usage: "Only show assets or groups with a non-zero " +
+				"balance",
+		},
 	},
 }
 
@@ -472,7 +1263,9 @@ func listAssetBalances(ctx *cli.Context) error {
 
 	var err error
 
-	req := &taprpc.ListBalancesRequest{}
+	req := &taprpc.ListBalancesRequest{
+		NonZeroOnly: ctx.Bool(nonZeroOnlyName),
+	}
 
 	if !ctx.Bool(groupByGroupName) {
 		req.GroupBy = &taprpc.ListBalancesRequest_AssetId{
@@ -511,6 +1304,12 @@ func listAssetBalances(ctx *cli.Context) error {
 	return nil
 }
 
+const (
+	coinSelectStrategyName = "coin_select_strategy"
+	changeScriptKeyName    = "change_script_key"
+	consolidateInputsName  = "consolidate_inputs"
+)
+
 var sendAssetsCommand = cli.Command{
 	Name:        "send",
 	ShortName:   "s",
@@ -520,13 +1319,34 @@ var sendAssetsCommand = cli.Command{
 		cli.StringSliceFlag{
 			Name: addrName,
 			Usage: "addr to send to; can be specified multiple " +
-				"times to send to multiple addresses at once",
+				"times to send to multiple addresses at " +
+				"once, which can also be of different assets",
 		},
 		cli.Uint64Flag{
 			Name: feeRateName,
 			Usage: "if set, the fee rate in sat/kw to use for the" +
 				"anchor transaction",
 		},
+		cli.StringFlag{
+			Name: coinSelectStrategyName,
+			Usage: "the strategy used to select the asset UTXOs " +
+				"that fund the send, either 'largest-first', " +
+				"'smallest-first', 'minimize-inputs' or " +
+				"'minimize-change'",
+			Value: "largest-first",
+		},
+		cli.StringFlag{
+			Name: changeScriptKeyName,
+			Usage: "if set, the hex encoded script key of this " +
+				"node the change of the send goes to; a new " +
+				"script key is derived otherwise",
+		},
+		cli.BoolFlag{
+			Name: consolidateInputsName,
+			Usage: "if set, all spendable UTXOs of the sent " +
+				"assets are consolidated into the change " +
+				"output",
+		},
 		// TODO(roasbeef): add arg for file name to write sender proof
 		// blob
 	},
@@ -548,9 +1368,29 @@ func sendAssets(ctx *cli.Context) error {
 		return err
 	}
 
+	strategy, err := parseCoinSelectStrategy(
+		ctx.String(coinSelectStrategyName),
+	)
+	if err != nil {
+		return err
+	}
+
+	var changeScriptKey []byte
+	if ctx.IsSet(changeScriptKeyName) {
+		changeScriptKey, err = hex.DecodeString(
+			ctx.String(changeScriptKeyName),
+		)
+		if err != nil {
+			return fmt.Errorf("invalid change script key: %w", err)
+		}
+	}
+
 	resp, err := client.SendAsset(ctxc, &taprpc.SendAssetRequest{
-		TapAddrs: addrs,
-		FeeRate:  feeRate,
+		TapAddrs:           addrs,
+		FeeRate:            feeRate,
+		CoinSelectStrategy: strategy,
+		ChangeScriptKey:    changeScriptKey,
+		ConsolidateInputs:  ctx.Bool(consolidateInputsName),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to send assets: %w", err)
@@ -560,6 +1400,101 @@ func sendAssets(ctx *cli.Context) error {
 	return nil
 }
 
+var estimateFeeCommand = cli.Command{
+	Name:  "estimatefee",
+	Usage: "estimate the on-chain fee of sending an asset",
+	Description: `
+	Estimate the size and on-chain fee of the anchor transaction that
+	would be created when sending to the given taproot asset addrs. Coin
+	selection and transaction construction are performed as for a real
+	send, but nothing is signed or broadcast.
+	`,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: addrName,
+			Usage: "addr to send to; can be specified multiple " +
+				"times to estimate a send to multiple " +
+				"addresses at once",
+		},
+		cli.Uint64Flag{
+			Name: feeRateName,
+			Usage: "if set, the fee rate in sat/kw to estimate the " +
+				"anchor transaction fee with",
+		},
+		cli.StringFlag{
+			Name: coinSelectStrategyName,
+			Usage: "the strategy used to select the asset UTXOs " +
+				"that fund the send, either 'largest-first', " +
+				"'smallest-first', 'minimize-inputs' or " +
+				"'minimize-change'",
+			Value: "largest-first",
+		},
+	},
+	Action: estimateFee,
+}
+
+func estimateFee(ctx *cli.Context) error {
+	addrs := ctx.StringSlice(addrName)
+	if ctx.NArg() != 0 || ctx.NumFlags() == 0 || len(addrs) == 0 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	feeRate, err := parseFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	strategy, err := parseCoinSelectStrategy(
+		ctx.String(coinSelectStrategyName),
+	)
+	if err != nil {
+		return err
+	}
+
+	req := &taprpc.EstimateTransferFeeRequest{
+		TapAddrs:           addrs,
+		FeeRate:            feeRate,
+		CoinSelectStrategy: strategy,
+	}
+	resp, err := client.EstimateTransferFee(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to estimate fee: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+// parseCoinSelectStrategy parses the coin selection strategy given on the
+// command line.
+func parseCoinSelectStrategy(strategy string) (taprpc.CoinSelectStrategy,
+	error) {
+
+	switch strategy {
+	case "largest-first":
+		return taprpc.CoinSelectStrategy_COIN_SELECT_LARGEST_FIRST, nil
+
+	case "smallest-first":
+		return taprpc.CoinSelectStrategy_COIN_SELECT_SMALLEST_FIRST, nil
+
+	case "minimize-inputs":
+		return taprpc.CoinSelectStrategy_COIN_SELECT_MINIMIZE_INPUTS,
+			nil
+
+	case "minimize-change":
+		return taprpc.CoinSelectStrategy_COIN_SELECT_MINIMIZE_CHANGE,
+			nil
+
+	default:
+		return 0, fmt.Errorf("unknown coin select strategy: %v",
+			strategy)
+	}
+}
+
 var burnAssetsCommand = cli.Command{
 	Name:  "burn",
 	Usage: "burn a number of asset units",
@@ -660,6 +1595,14 @@ func burnAssets(ctx *cli.Context) error {
 	return nil
 }
 
+const (
+	transferStateName = "state"
+
+	followName = "follow"
+
+	includeReceivesName = "include_receives"
+)
+
 var listTransfersCommand = cli.Command{
 	Name:      "transfers",
 	ShortName: "t",
@@ -673,6 +1616,24 @@ var listTransfersCommand = cli.Command{
 			Usage: "A specific asset ID to list outgoing " +
 				"transfers for",
 		},
+		cli.StringFlag{
+			Name: transferStateName,
+			Usage: "only list transfers in the given state, one " +
+				"of: unconfirmed, awaiting_proof_delivery, " +
+				"completed",
+		},
+		cli.BoolFlag{
+			Name: followName,
+			Usage: "instead of listing the existing transfers, " +
+				"print state updates of transfers as they " +
+				"happen",
+		},
+		cli.BoolFlag{
+			Name: includeReceivesName,
+			Usage: "also list the incoming transfers to our " +
+				"addresses, including whether the received " +
+				"amount matches the address amount",
+		},
 	},
 }
 
@@ -681,7 +1642,29 @@ func listTransfers(ctx *cli.Context) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	req := &taprpc.ListTransfersRequest{}
+	var assetID []byte
+	if ctx.IsSet(assetIDName) {
+		var err error
+		assetID, err = hex.DecodeString(ctx.String(assetIDName))
+		if err != nil {
+			return fmt.Errorf("invalid asset ID")
+		}
+	}
+
+	if ctx.Bool(followName) {
+		return followTransfers(ctxc, client, assetID)
+	}
+
+	state, err := parseTransferState(ctx.String(transferStateName))
+	if err != nil {
+		return err
+	}
+
+	req := &taprpc.ListTransfersRequest{
+		FilterAssetId:   assetID,
+		FilterState:     state,
+		IncludeReceives: ctx.Bool(includeReceivesName),
+	}
 	resp, err := client.ListTransfers(ctxc, req)
 	if err != nil {
 		return fmt.Errorf("unable to list asset transfers: %w", err)
@@ -691,6 +1674,56 @@ func listTransfers(ctx *cli.Context) error {
 	return nil
 }
 
+// parseTransferState parses the transfer state filter given on the command
+// line. An empty string means no filter.
+func parseTransferState(state string) (taprpc.TransferState, error) {
+	switch state {
+	case "":
+		return taprpc.TransferState_TRANSFER_STATE_UNKNOWN, nil
+
+	case "unconfirmed":
+		return taprpc.TransferState_TRANSFER_STATE_UNCONFIRMED, nil
+
+	case "awaiting_proof_delivery":
+		return taprpc.TransferState_TRANSFER_STATE_AWAITING_PROOF_DELIVERY,
+			nil
+
+	case "completed":
+		return taprpc.TransferState_TRANSFER_STATE_COMPLETED, nil
+
+	default:
+		return 0, fmt.Errorf("unknown transfer state: %v", state)
+	}
+}
+
+// followTransfers subscribes to transfer state updates and prints each update
+// as it arrives.
+func followTransfers(ctxc context.Context, client taprpc.TaprootAssetsClient,
+	assetID []byte) error {
+
+	stream, err := client.SubscribeTransfers(
+		ctxc, &taprpc.SubscribeTransfersRequest{
+			FilterAssetId: assetID,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to subscribe to transfers: %w", err)
+	}
+
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("unable to receive transfer "+
+				"event: %w", err)
+		}
+
+		printRespJSON(event)
+	}
+}
+
 const (
 	metaName = "asset_meta"
 )
@@ -755,3 +1788,129 @@ func fetchMeta(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+const (
+	messageName = "msg"
+
+	signatureName = "sig"
+
+	checkBalanceName = "check_balance"
+)
+
+var signMessageCommand = cli.Command{
+	Name:  "signmessage",
+	Usage: "sign a message with the script key of an owned asset",
+	Description: `
+	Sign an arbitrary message with the script key of an owned asset, to
+	prove control of the asset off-chain. The returned signature is a
+	hex encoded 64-byte schnorr signature over the BIP-0322 virtual
+	transaction that commits to the message.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  scriptKeyName,
+			Usage: "the script key of the asset to sign with",
+		},
+		cli.StringFlag{
+			Name:  messageName,
+			Usage: "the message to sign",
+		},
+	},
+	Action: signMessage,
+}
+
+func signMessage(ctx *cli.Context) error {
+	if !ctx.IsSet(scriptKeyName) || !ctx.IsSet(messageName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	scriptKey, err := hex.DecodeString(ctx.String(scriptKeyName))
+	if err != nil {
+		return fmt.Errorf("unable to decode script key: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SignAssetMessage(
+		ctxc, &wrpc.SignAssetMessageRequest{
+			ScriptKey: scriptKey,
+			Message:   []byte(ctx.String(messageName)),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to sign message: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var verifyMessageCommand = cli.Command{
+	Name:  "verifymessage",
+	Usage: "verify a message signed with the script key of an asset",
+	Description: `
+	Verify that a message was signed with the given script key. If
+	--check_balance is set, then the script key must also control a
+	non-zero balance of unspent assets known to this node.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  scriptKeyName,
+			Usage: "the script key the message was signed with",
+		},
+		cli.StringFlag{
+			Name:  messageName,
+			Usage: "the message that was signed",
+		},
+		cli.StringFlag{
+			Name:  signatureName,
+			Usage: "the hex encoded signature of the message",
+		},
+		cli.BoolFlag{
+			Name: checkBalanceName,
+			Usage: "also require the script key to control a " +
+				"non-zero balance",
+		},
+	},
+	Action: verifyMessage,
+}
+
+func verifyMessage(ctx *cli.Context) error {
+	switch {
+	case !ctx.IsSet(scriptKeyName), !ctx.IsSet(messageName),
+		!ctx.IsSet(signatureName):
+
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	scriptKey, err := hex.DecodeString(ctx.String(scriptKeyName))
+	if err != nil {
+		return fmt.Errorf("unable to decode script key: %w", err)
+	}
+
+	sig, err := hex.DecodeString(ctx.String(signatureName))
+	if err != nil {
+		return fmt.Errorf("unable to decode signature: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.VerifyAssetMessage(
+		ctxc, &wrpc.VerifyAssetMessageRequest{
+			ScriptKey:    scriptKey,
+			Message:      []byte(ctx.String(messageName)),
+			Signature:    sig,
+			CheckBalance: ctx.Bool(checkBalanceName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to verify message: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
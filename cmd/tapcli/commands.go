@@ -3,11 +3,14 @@ package main
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/signal"
 	"github.com/urfave/cli"
 	"google.golang.org/protobuf/proto"
@@ -131,3 +134,110 @@ func getInfo(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var bakeMacaroonCommand = cli.Command{
+	Name:      "bakemacaroon",
+	Category:  "Macaroons",
+	Usage:     "Bake a new macaroon with a restricted set of permissions.",
+	ArgsUsage: "[--save_to=] permission [permission...]",
+	Description: `
+	Bake a new macaroon that only grants the given permissions. Each
+	permission is an entity/action pair in the form entity:action, for
+	example universe:read. The set of entity/action pairs required by each
+	RPC method can be listed with the listpermissions command.
+
+	For example, to bake a macaroon that only grants read access to the
+	public data of the local universe, which can be handed out to sync
+	clients, run:
+
+	tapcli bakemacaroon --save_to=universe_read.macaroon universe:read
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "save_to",
+			Usage: "if set, the macaroon is written to this file " +
+				"in binary format instead of being printed " +
+				"as hex",
+		},
+	},
+	Action: bakeMacaroon,
+}
+
+func bakeMacaroon(ctx *cli.Context) error {
+	if ctx.NArg() == 0 {
+		return cli.ShowCommandHelp(ctx, "bakemacaroon")
+	}
+
+	permissions := make([]*taprpc.MacaroonPermission, 0, ctx.NArg())
+	for _, arg := range ctx.Args() {
+		entity, action, ok := strings.Cut(arg, ":")
+		if !ok || entity == "" || action == "" {
+			return fmt.Errorf("invalid permission %q, must be in "+
+				"the form entity:action", arg)
+		}
+
+		permissions = append(permissions, &taprpc.MacaroonPermission{
+			Entity: entity,
+			Action: action,
+		})
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.BakeMacaroon(ctxc, &taprpc.BakeMacaroonRequest{
+		Permissions: permissions,
+	})
+	if err != nil {
+		return err
+	}
+
+	savePath := lncfg.CleanAndExpandPath(ctx.String("save_to"))
+	if savePath == "" {
+		printRespJSON(resp)
+		return nil
+	}
+
+	macBytes, err := hex.DecodeString(resp.Macaroon)
+	if err != nil {
+		return fmt.Errorf("unable to decode macaroon: %w", err)
+	}
+
+	err = os.WriteFile(savePath, macBytes, 0600)
+	if err != nil {
+		return fmt.Errorf("unable to save macaroon: %w", err)
+	}
+
+	fmt.Printf("Macaroon saved to %s\n", savePath)
+	return nil
+}
+
+var listPermissionsCommand = cli.Command{
+	Name:     "listpermissions",
+	Category: "Macaroons",
+	Usage: "List the permissions required to call each RPC " +
+		"method.",
+	Description: `
+	List the entity/action pairs that are required to call each of the RPC
+	methods of the daemon. These can be used to bake scoped macaroons with
+	the bakemacaroon command.
+	`,
+	Action: listPermissions,
+}
+
+func listPermissions(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListPermissions(
+		ctxc, &taprpc.ListPermissionsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
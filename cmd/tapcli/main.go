@@ -334,6 +334,8 @@ func main() {
 		debugLevelCommand,
 		profileSubCommand,
 		getInfoCommand,
+		bakeMacaroonCommand,
+		listPermissionsCommand,
 	}
 	app.Commands = append(app.Commands, assetsCommands...)
 	app.Commands = append(app.Commands, addrCommands...)
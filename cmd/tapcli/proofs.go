@@ -6,11 +6,16 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
+	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/taprpc"
 	wrpc "github.com/lightninglabs/taproot-assets/taprpc/assetwalletrpc"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/urfave/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var proofCommands = []cli.Command{
@@ -22,7 +27,12 @@ var proofCommands = []cli.Command{
 		Subcommands: []cli.Command{
 			verifyProofCommand,
 			decodeProofCommand,
+			decodeCommitmentCommand,
 			exportProofCommand,
+			exportAllProofsCommand,
+			importProofCommand,
+			claimProofCommand,
+			verifyGroupCommand,
 			proveOwnershipCommand,
 			verifyOwnershipCommand,
 		},
@@ -54,6 +64,11 @@ var verifyProofCommand = cli.Command{
 			Usage: "the path to the proof file on disk; use the " +
 				"dash character (-) to read from stdin instead",
 		},
+		cli.StringFlag{
+			Name: universeHostName,
+			Usage: "an optional universe server to verify the " +
+				"universe inclusion of each proof against",
+		},
 	},
 	Action: verifyProof,
 }
@@ -76,6 +91,7 @@ func verifyProof(ctx *cli.Context) error {
 
 	resp, err := client.VerifyProof(ctxc, &taprpc.ProofFile{
 		RawProofFile: rawFile,
+		UniverseHost: ctx.String(universeHostName),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to verify proof file: %w", err)
@@ -154,6 +170,84 @@ func decodeProof(ctx *cli.Context) error {
 	return nil
 }
 
+const (
+	anchorTxName = "anchor_tx"
+
+	outputIndexName = "output_index"
+)
+
+var decodeCommitmentCommand = cli.Command{
+	Name:      "decodecommitment",
+	ShortName: "dc",
+	Usage:     "verify the Taproot Asset commitment of an anchor output",
+	Description: `
+	Reconstruct the Taproot Asset commitment of an anchor transaction output
+	from the proofs of all assets committed to it and check whether the
+	resulting taproot output key matches the one found on-chain. The
+	--proof_file flag can be specified multiple times, once for each asset
+	anchored in the output.
+`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  anchorTxName,
+			Usage: "the raw anchor transaction, encoded as hex",
+		},
+		cli.UintFlag{
+			Name:  outputIndexName,
+			Usage: "the index of the anchor output to verify",
+		},
+		cli.StringSliceFlag{
+			Name: proofPathName,
+			Usage: "the path to a proof file on disk of an asset " +
+				"committed to the anchor output",
+		},
+	},
+	Action: decodeCommitment,
+}
+
+func decodeCommitment(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	switch {
+	case ctx.String(anchorTxName) == "",
+		len(ctx.StringSlice(proofPathName)) == 0:
+
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	anchorTx, err := hex.DecodeString(ctx.String(anchorTxName))
+	if err != nil {
+		return fmt.Errorf("unable to decode anchor TX: %w", err)
+	}
+
+	var rawProofs [][]byte
+	for _, proofPath := range ctx.StringSlice(proofPathName) {
+		filePath := lncfg.CleanAndExpandPath(proofPath)
+		rawFile, err := readFile(filePath)
+		if err != nil {
+			return fmt.Errorf("unable to read proof file: %w", err)
+		}
+
+		rawProofs = append(rawProofs, rawFile)
+	}
+
+	resp, err := client.DecodeCommitment(
+		ctxc, &taprpc.DecodeCommitmentRequest{
+			AnchorTx:    anchorTx,
+			OutputIndex: uint32(ctx.Uint(outputIndexName)),
+			RawProofs:   rawProofs,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to decode commitment: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var verifyOwnershipCommand = cli.Command{
 	Name:      "verifyownership",
 	ShortName: "vo",
@@ -207,6 +301,8 @@ func verifyOwnershipProof(ctx *cli.Context) error {
 
 const (
 	scriptKeyName = "script_key"
+
+	ownedOnlyName = "owned_only"
 )
 
 var exportProofCommand = cli.Command{
@@ -236,6 +332,11 @@ var exportProofCommand = cli.Command{
 				"the raw binary proof to stdout instead of " +
 				"the default JSON format",
 		},
+		cli.BoolFlag{
+			Name: ownedOnlyName,
+			Usage: "only export the proof if the asset is " +
+				"currently held by this node, fail otherwise",
+		},
 	},
 	Action: exportProof,
 }
@@ -264,6 +365,7 @@ func exportProof(ctx *cli.Context) error {
 	resp, err := client.ExportProof(ctxc, &taprpc.ExportProofRequest{
 		AssetId:   assetID,
 		ScriptKey: scriptKeyBytes,
+		OwnedOnly: ctx.Bool(ownedOnlyName),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to export proof file: %w", err)
@@ -280,6 +382,434 @@ func exportProof(ctx *cli.Context) error {
 	return nil
 }
 
+const (
+	outputDirName = "output_dir"
+)
+
+var exportAllProofsCommand = cli.Command{
+	Name:      "exportall",
+	ShortName: "ea",
+	Usage:     "export the proofs of all universe leaves of an asset",
+	Description: `
+	Export a full proof file for each leaf of the local issuance and
+	transfer universes of an asset or asset group into the given directory.
+	Each file is named by the outpoint and script key of its leaf and can be
+	verified with the "verify" command and imported with the "import"
+	command.
+
+	Leaves whose proof file can't be built are skipped with a warning.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the asset to export",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the asset group to export",
+		},
+		cli.StringFlag{
+			Name:  outputDirName,
+			Usage: "the directory to write the proof files to",
+		},
+	},
+	Action: exportAllProofs,
+}
+
+func exportAllProofs(ctx *cli.Context) error {
+	switch {
+	case ctx.String(outputDirName) == "",
+		ctx.String(assetIDName) == "" && ctx.String(groupKeyName) == "":
+
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	assetID, err := hex.DecodeString(ctx.String(assetIDName))
+	if err != nil {
+		return fmt.Errorf("invalid asset ID: %w", err)
+	}
+	groupKey, err := hex.DecodeString(ctx.String(groupKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid group key: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	stream, err := client.ExportProofs(ctxc, &taprpc.ExportProofsRequest{
+		AssetId:  assetID,
+		GroupKey: groupKey,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to export proofs: %w", err)
+	}
+
+	outputDir := lncfg.CleanAndExpandPath(ctx.String(outputDirName))
+
+	var numWritten, numSkipped int
+	for {
+		exported, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("unable to export proofs: %w", err)
+		}
+
+		if exported.SkipReason != "" {
+			fmt.Fprintf(os.Stderr, "Warning: skipping proof of "+
+				"leaf %v: %v\n", exported.Outpoint,
+				exported.SkipReason)
+
+			numSkipped++
+			continue
+		}
+
+		// The colon of the outpoint isn't allowed in file names on
+		// all platforms.
+		fileName := fmt.Sprintf("%s_%x%s",
+			strings.ReplaceAll(exported.Outpoint, ":", "_"),
+			exported.ScriptKey, proof.TaprootAssetsFileSuffix)
+
+		err = writeToFile(
+			filepath.Join(outputDir, fileName),
+			exported.RawProofFile,
+		)
+		if err != nil {
+			return err
+		}
+
+		numWritten++
+	}
+
+	printJSON(struct {
+		NumWritten int `json:"num_written"`
+		NumSkipped int `json:"num_skipped"`
+	}{
+		NumWritten: numWritten,
+		NumSkipped: numSkipped,
+	})
+
+	return nil
+}
+
+const (
+	trackOnlyName = "track_only"
+
+	minConfsName = "min_confs"
+)
+
+var importProofCommand = cli.Command{
+	Name:      "import",
+	ShortName: "i",
+	Usage:     "import a taproot asset proof received out-of-band",
+	Description: `
+	Import a taproot asset proof file that was received out-of-band, for
+	example from a sender that didn't use a proof courier. The full
+	provenance of the asset is verified and the asset is recorded as owned
+	by this node. The proofs are also inserted into the local universe.
+
+	By default, the import fails if the script key of the asset doesn't
+	belong to this node. Use --track_only to import the asset anyway.
+
+	The import also fails with a retryable error if the anchor transaction
+	of the asset doesn't have the minimum number of confirmations configured
+	for the node yet. Use --min_confs to override that minimum.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: proofPathName,
+			Usage: "the path to the proof file on disk; use the " +
+				"dash character (-) to read from stdin instead",
+		},
+		cli.BoolFlag{
+			Name: trackOnlyName,
+			Usage: "import the asset even if its script key " +
+				"doesn't belong to this node",
+		},
+		cli.Uint64Flag{
+			Name: minConfsName,
+			Usage: "if set, overrides the minimum number of " +
+				"confirmations the anchor transaction of " +
+				"the asset must have",
+		},
+	},
+	Action: importProof,
+}
+
+func importProof(ctx *cli.Context) error {
+	switch {
+	case ctx.String(proofPathName) == "":
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(proofPathName))
+	rawFile, err := readFile(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to read proof file: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ImportProof(ctxc, &taprpc.ImportProofRequest{
+		RawProofFile: rawFile,
+		TrackOnly:    ctx.Bool(trackOnlyName),
+		MinConfs:     uint32(ctx.Uint64(minConfsName)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to import proof file: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const (
+	waitName = "wait"
+
+	// claimPollInterval is the interval at which the claim command polls
+	// the universe while waiting for the proof to show up.
+	claimPollInterval = 5 * time.Second
+)
+
+var claimProofCommand = cli.Command{
+	Name:      "claim",
+	ShortName: "c",
+	Usage:     "claim an asset by fetching its proof from a universe",
+	Description: `
+	Fetch the proof of an asset sent to one of this node's script keys from
+	a universe server and import it. This can be used to receive an asset if
+	the sender pushed the proof to a universe but the proof courier didn't
+	deliver it to this node.
+
+	If no universe host is given, the universe used as the default proof
+	courier of the node is queried.
+
+	If the universe doesn't know about the transfer yet, the command fails
+	with a retryable error. Use --wait to keep polling the universe until
+	the proof shows up or the given duration has passed.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the asset to claim",
+		},
+		cli.StringFlag{
+			Name: groupKeyName,
+			Usage: "the group key of the asset to claim, if it " +
+				"belongs to a group",
+		},
+		cli.StringFlag{
+			Name:  scriptKeyName,
+			Usage: "the script key the asset was sent to",
+		},
+		cli.StringFlag{
+			Name: universeHostName,
+			Usage: "the host of the universe to query, " +
+				"defaults to the proof courier of the node",
+		},
+		cli.Uint64Flag{
+			Name: minConfsName,
+			Usage: "if set, overrides the minimum number of " +
+				"confirmations the anchor transaction of " +
+				"the asset must have",
+		},
+		cli.DurationFlag{
+			Name: waitName,
+			Usage: "if set, keep polling the universe for up to " +
+				"this duration until the proof is found",
+		},
+	},
+	Action: claimProof,
+}
+
+func claimProof(ctx *cli.Context) error {
+	switch {
+	case ctx.String(assetIDName) == "",
+		ctx.String(scriptKeyName) == "":
+
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	assetID, err := hex.DecodeString(ctx.String(assetIDName))
+	if err != nil {
+		return fmt.Errorf("invalid asset ID: %w", err)
+	}
+	scriptKey, err := hex.DecodeString(ctx.String(scriptKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid script key: %w", err)
+	}
+
+	var groupKey []byte
+	if ctx.String(groupKeyName) != "" {
+		groupKey, err = hex.DecodeString(ctx.String(groupKeyName))
+		if err != nil {
+			return fmt.Errorf("invalid group key: %w", err)
+		}
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &taprpc.ClaimFromUniverseRequest{
+		AssetId:      assetID,
+		GroupKey:     groupKey,
+		ScriptKey:    scriptKey,
+		UniverseHost: ctx.String(universeHostName),
+		MinConfs:     uint32(ctx.Uint64(minConfsName)),
+	}
+
+	deadline := time.Now().Add(ctx.Duration(waitName))
+	for {
+		resp, err := client.ClaimFromUniverse(ctxc, req)
+		switch {
+		case err == nil:
+			printRespJSON(resp)
+			return nil
+
+		// The universe doesn't know about the transfer yet, so we'll
+		// try again if we're still allowed to wait.
+		case status.Code(err) == codes.NotFound &&
+			time.Now().Add(claimPollInterval).Before(deadline):
+
+		default:
+			return fmt.Errorf("unable to claim asset: %w", err)
+		}
+
+		select {
+		case <-time.After(claimPollInterval):
+		case <-ctxc.Done():
+			return ctxc.Err()
+		}
+	}
+}
+
+const (
+	assetIDAName = "asset_id_a"
+
+	proofPathAName = "proof_file_a"
+
+	assetIDBName = "asset_id_b"
+
+	proofPathBName = "proof_file_b"
+)
+
+var verifyGroupCommand = cli.Command{
+	Name:      "verifygroup",
+	ShortName: "vg",
+	Usage:     "verify that two assets are part of the same asset group",
+	Description: `
+	Verify that two assets were issued into the same asset group. Each asset
+	is given either by its asset ID, in which case its genesis proof is
+	looked up in the local universe, or by the path to its genesis proof or
+	proof file.
+
+	The genesis proof of each asset is verified, including the group
+	witness that proves the asset was issued by the holder of the group key.
+	The shared group key is returned if both assets are part of the same
+	group, otherwise the reason for the mismatch is returned.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDAName,
+			Usage: "the asset ID of the first asset",
+		},
+		cli.StringFlag{
+			Name: proofPathAName,
+			Usage: "the path to the genesis proof or proof file " +
+				"of the first asset",
+		},
+		cli.StringFlag{
+			Name:  assetIDBName,
+			Usage: "the asset ID of the second asset",
+		},
+		cli.StringFlag{
+			Name: proofPathBName,
+			Usage: "the path to the genesis proof or proof file " +
+				"of the second asset",
+		},
+	},
+	Action: verifyGroup,
+}
+
+// parseGroupMembershipAsset parses the asset given either by the asset ID or
+// the proof path flag of the given names.
+func parseGroupMembershipAsset(ctx *cli.Context, assetIDFlag,
+	proofPathFlag string) (*taprpc.GroupMembershipAsset, error) {
+
+	switch {
+	case ctx.String(assetIDFlag) != "" && ctx.String(proofPathFlag) != "":
+		return nil, fmt.Errorf("only one of --%s and --%s can be set",
+			assetIDFlag, proofPathFlag)
+
+	case ctx.String(assetIDFlag) != "":
+		assetID, err := hex.DecodeString(ctx.String(assetIDFlag))
+		if err != nil {
+			return nil, fmt.Errorf("invalid asset ID: %w", err)
+		}
+
+		return &taprpc.GroupMembershipAsset{
+			Asset: &taprpc.GroupMembershipAsset_AssetId{
+				AssetId: assetID,
+			},
+		}, nil
+
+	case ctx.String(proofPathFlag) != "":
+		filePath := lncfg.CleanAndExpandPath(ctx.String(proofPathFlag))
+		rawProof, err := readFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read proof file: %w",
+				err)
+		}
+
+		return &taprpc.GroupMembershipAsset{
+			Asset: &taprpc.GroupMembershipAsset_RawProof{
+				RawProof: rawProof,
+			},
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("either --%s or --%s must be set",
+			assetIDFlag, proofPathFlag)
+	}
+}
+
+func verifyGroup(ctx *cli.Context) error {
+	assetA, err := parseGroupMembershipAsset(
+		ctx, assetIDAName, proofPathAName,
+	)
+	if err != nil {
+		return err
+	}
+	assetB, err := parseGroupMembershipAsset(
+		ctx, assetIDBName, proofPathBName,
+	)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.VerifyGroupMembership(
+		ctxc, &taprpc.VerifyGroupMembershipRequest{
+			AssetA: assetA,
+			AssetB: assetB,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to verify group membership: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var proveOwnershipCommand = cli.Command{
 	Name:      "proveownership",
 	ShortName: "po",
@@ -16,7 +16,7 @@ var devCommands = []cli.Command{
 		Usage:    "Developer and debug subcommands.",
 		Category: "Dev",
 		Subcommands: []cli.Command{
-			importProofCommand,
+			devImportProofCommand,
 		},
 	},
 }
@@ -31,7 +31,7 @@ func getDevClient(ctx *cli.Context) (tapdevrpc.TapDevClient, func()) {
 	return tapdevrpc.NewTapDevClient(conn), cleanUp
 }
 
-var importProofCommand = cli.Command{
+var devImportProofCommand = cli.Command{
 	Name:      "importproof",
 	ShortName: "i",
 	Usage:     "import a taproot asset proof",
@@ -48,10 +48,10 @@ var importProofCommand = cli.Command{
 				"dash character (-) to read from stdin instead",
 		},
 	},
-	Action: importProof,
+	Action: devImportProof,
 }
 
-func importProof(ctx *cli.Context) error {
+func devImportProof(ctx *cli.Context) error {
 	ctxc := getContext()
 	client, cleanUp := getDevClient(ctx)
 	defer cleanUp()
@@ -2,21 +2,39 @@ package main
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	tap "github.com/lightninglabs/taproot-assets"
 	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/taprpc"
 	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/urfave/cli"
+	"google.golang.org/grpc/metadata"
 )
 
 const (
 	proofTypeName = "proof_type"
+
+	atHeightName = "at_height"
+
+	includeAssetsName = "include_assets"
+
+	verifyKeyName = "verify_key"
+
+	universeIDName = "id"
 )
 
 func getUniverseClient(ctx *cli.Context) (unirpc.UniverseClient, func()) {
@@ -38,14 +56,29 @@ var universeCommands = []cli.Command{
 		Category:  "Universe",
 		Subcommands: []cli.Command{
 			universeRootsCommand,
+			universeRootsBatchCommand,
+			universeMultiverseRootCommand,
+			universeSnapshotCommand,
 			universeDeleteRootCommand,
 			universeLeavesCommand,
 			universeKeysCommand,
 			universeProofCommand,
 			universeSyncCommand,
+			universeDiffCommand,
+			universeVerifyCommand,
+			universeComputeRootCommand,
+			universePruneCommand,
+			universeCompactCommand,
 			universeFederationCommand,
 			universeInfoCommand,
 			universeStatsCommand,
+			universeSupplyCommand,
+			universeUtxosCommand,
+			universeSearchCommand,
+			universeGenesisCommand,
+			universeArchiveCommand,
+			universePolicyCommand,
+			universeMailboxCommand,
 		},
 	},
 }
@@ -70,6 +103,42 @@ var universeRootsCommand = cli.Command{
 				"either 'issuance' or 'transfer'",
 			Value: universe.ProofTypeIssuance.String(),
 		},
+		cli.Int64Flag{
+			Name: limitName,
+			Usage: "the maximum number of roots to return when " +
+				"querying for all universe roots",
+		},
+		cli.Int64Flag{
+			Name: offsetName,
+			Usage: "the offset to start returning roots from when " +
+				"querying for all universe roots",
+		},
+		cli.Uint64Flag{
+			Name: atHeightName,
+			Usage: "if set, the roots of the given universe are " +
+				"returned as they were at this block height",
+		},
+		cli.StringFlag{
+			Name: assetType,
+			Usage: "if set, only the roots of universes with the " +
+				"given asset type are returned when querying " +
+				"for all universe roots, either 'normal' or " +
+				"'collectible'",
+		},
+		cli.StringFlag{
+			Name: universeIDPrefixName,
+			Usage: "if set, only the roots of universes whose " +
+				"group key (or asset ID if not grouped) " +
+				"starts with the given hex prefix are " +
+				"returned when querying for all universe roots",
+		},
+		cli.StringFlag{
+			Name: verifyKeyName,
+			Usage: "if set, the signature of each root returned " +
+				"when querying for all universe roots is " +
+				"verified against the given hex encoded " +
+				"identity key of the universe server",
+		},
 	},
 	Action: universeRoots,
 }
@@ -91,6 +160,24 @@ func parseProofType(ctx *cli.Context) (*unirpc.ProofType, error) {
 	return &rpcProofType, nil
 }
 
+// parseAssetTypeFilter parses the optional asset type filter flag.
+func parseAssetTypeFilter(ctx *cli.Context) (unirpc.AssetTypeFilter, error) {
+	switch ctx.String(assetType) {
+	case "":
+		return unirpc.AssetTypeFilter_FILTER_ASSET_NONE, nil
+
+	case "normal":
+		return unirpc.AssetTypeFilter_FILTER_ASSET_NORMAL, nil
+
+	case "collectible":
+		return unirpc.AssetTypeFilter_FILTER_ASSET_COLLECTIBLE, nil
+
+	default:
+		return 0, fmt.Errorf("invalid asset type: %v",
+			ctx.String(assetType))
+	}
+}
+
 func parseUniverseID(ctx *cli.Context, mustParse bool) (*unirpc.ID, error) {
 	rpcProofType, err := parseProofType(ctx)
 	if err != nil {
@@ -150,22 +237,53 @@ func universeRoots(ctx *cli.Context) error {
 		return err
 	}
 
+	verifyKey, err := parseVerifyKey(ctx)
+	if err != nil {
+		return err
+	}
+
 	// If neither an asset ID or group key is specified, then we'll query
 	// for all the known universe roots.
 	if universeID == nil {
+		typeFilter, err := parseAssetTypeFilter(ctx)
+		if err != nil {
+			return err
+		}
+
+		idPrefix := ctx.String(universeIDPrefixName)
 		universeRoots, err := client.AssetRoots(
-			ctxc, &unirpc.AssetRootRequest{},
+			ctxc, &unirpc.AssetRootRequest{
+				Offset:          int32(ctx.Int64(offsetName)),
+				Limit:           int32(ctx.Int64(limitName)),
+				AssetTypeFilter: typeFilter,
+				IdPrefix:        idPrefix,
+			},
 		)
 		if err != nil {
 			return err
 		}
 
+		if verifyKey != nil {
+			err := verifyUniverseRootSigs(universeRoots, verifyKey)
+			if err != nil {
+				return err
+			}
+		}
+
 		printRespJSON(universeRoots)
 		return nil
 	}
 
+	// Only the roots returned when querying for all universe roots are
+	// signed by the server.
+	if verifyKey != nil {
+		return fmt.Errorf("--%s can only be used when querying for "+
+			"all universe roots", verifyKeyName)
+	}
+
 	rootReq := &unirpc.AssetRootQuery{
-		Id: universeID,
+		Id:       universeID,
+		AtHeight: uint32(ctx.Uint64(atHeightName)),
 	}
 
 	universeRoot, err := client.QueryAssetRoots(ctxc, rootReq)
@@ -177,6 +295,155 @@ func universeRoots(ctx *cli.Context) error {
 	return nil
 }
 
+var universeRootsBatchCommand = cli.Command{
+	Name:  "roots-batch",
+	Usage: "query the universe roots of multiple assets at once",
+	Description: `
+	Query the issuance and transfer universe roots of multiple assets with
+	a single call. The results are returned in the order the assets are
+	given in, and each result indicates whether any root was found for the
+	asset.
+	`,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: universeIDName,
+			Usage: "the hex encoded asset ID or group key of an " +
+				"asset to query the roots for; can be " +
+				"specified multiple times",
+		},
+		cli.Uint64Flag{
+			Name: atHeightName,
+			Usage: "if set, the roots are returned as they were " +
+				"at this block height",
+		},
+	},
+	Action: universeRootsBatch,
+}
+
+// parseUniverseIDStr parses a hex encoded asset ID or group key into a
+// universe ID.
+func parseUniverseIDStr(idStr string) (*unirpc.ID, error) {
+	idBytes, err := hex.DecodeString(idStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid universe ID %v: %w", idStr, err)
+	}
+
+	switch len(idBytes) {
+	case sha256.Size:
+		return &unirpc.ID{
+			Id: &unirpc.ID_AssetId{
+				AssetId: idBytes,
+			},
+		}, nil
+
+	case btcec.PubKeyBytesLenCompressed:
+		return &unirpc.ID{
+			Id: &unirpc.ID_GroupKey{
+				GroupKey: idBytes,
+			},
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("invalid universe ID %v: expected "+
+			"32-byte asset ID or 33-byte group key", idStr)
+	}
+}
+
+func universeRootsBatch(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	idStrs := ctx.StringSlice(universeIDName)
+	if len(idStrs) == 0 {
+		return fmt.Errorf("at least one --%s must be set",
+			universeIDName)
+	}
+
+	ids := make([]*unirpc.ID, 0, len(idStrs))
+	for _, idStr := range idStrs {
+		id, err := parseUniverseIDStr(idStr)
+		if err != nil {
+			return err
+		}
+
+		ids = append(ids, id)
+	}
+
+	resp, err := client.QueryAssetRootsBatch(
+		ctxc, &unirpc.QueryAssetRootsBatchRequest{
+			Ids:      ids,
+			AtHeight: uint32(ctx.Uint64(atHeightName)),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+// parseVerifyKey parses the optional identity key that signed universe roots
+// are verified against. Nil is returned if no key is set.
+func parseVerifyKey(ctx *cli.Context) (*btcec.PublicKey, error) {
+	if !ctx.IsSet(verifyKeyName) {
+		return nil, nil
+	}
+
+	keyBytes, err := hex.DecodeString(ctx.String(verifyKeyName))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode verify key: %w", err)
+	}
+
+	return btcec.ParsePubKey(keyBytes)
+}
+
+// unmarshalRootNode parses the RPC form of a merkle sum root node.
+func unmarshalRootNode(rpcNode *unirpc.MerkleSumNode) (mssmt.Node, error) {
+	if rpcNode == nil {
+		return nil, fmt.Errorf("root node missing")
+	}
+
+	var nodeHash mssmt.NodeHash
+	if len(rpcNode.RootHash) != len(nodeHash) {
+		return nil, fmt.Errorf("invalid root hash length: %d",
+			len(rpcNode.RootHash))
+	}
+	copy(nodeHash[:], rpcNode.RootHash)
+
+	return mssmt.NewComputedNode(nodeHash, uint64(rpcNode.RootSum)), nil
+}
+
+// verifyUniverseRootSigs verifies that all the given universe roots are signed
+// by the given identity key.
+func verifyUniverseRootSigs(resp *unirpc.AssetRootResponse,
+	verifyKey *btcec.PublicKey) error {
+
+	for namespace, root := range resp.UniverseRoots {
+		uniID, err := tap.UnmarshalUniID(root.Id)
+		if err != nil {
+			return err
+		}
+
+		rootNode, err := unmarshalRootNode(root.MssmtRoot)
+		if err != nil {
+			return err
+		}
+
+		err = universe.VerifyRootSig(
+			universe.UniverseRootMsg(uniID, rootNode),
+			root.Signature, verifyKey,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to verify universe root %v: "+
+				"%w", namespace, err)
+		}
+	}
+
+	return nil
+}
+
 var universeDeleteRootCommand = cli.Command{
 	Name:        "delete",
 	ShortName:   "d",
@@ -278,8 +545,12 @@ var universeLeavesCommand = cli.Command{
 	Description: `
 	Query for the set of known leaves for a given asset universe. A leaf in a
 	universe is an entry that denotes either a new issuance event (asset
-	minting) or an asset transfer. A leaf includes the asset under action, a
-	state transition proof for that asset.`,
+	minting) or an asset transfer. A leaf includes its leaf key and amount,
+	and optionally the asset under action and a state transition proof for
+	that asset.
+
+	The leaves are sorted by their leaf key, so the offset and limit can be
+	used to page through them.`,
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name:  assetIDName,
@@ -295,6 +566,19 @@ var universeLeavesCommand = cli.Command{
 				"either 'issuance' or 'transfer'",
 			Value: universe.ProofTypeIssuance.String(),
 		},
+		cli.Int64Flag{
+			Name:  limitName,
+			Usage: "the maximum number of leaves to return",
+		},
+		cli.Int64Flag{
+			Name:  offsetName,
+			Usage: "the offset to start returning leaves from",
+		},
+		cli.BoolFlag{
+			Name: includeAssetsName,
+			Usage: "if set, the full asset and proof of each " +
+				"leaf are included",
+		},
 	},
 	Action: universeLeaves,
 }
@@ -309,7 +593,12 @@ func universeLeaves(ctx *cli.Context) error {
 		return err
 	}
 
-	assetLeaves, err := client.AssetLeaves(ctxc, universeID)
+	assetLeaves, err := client.AssetLeaves(ctxc, &unirpc.AssetLeavesRequest{
+		Id:            universeID,
+		Offset:        int32(ctx.Int64(offsetName)),
+		Limit:         int32(ctx.Int64(limitName)),
+		IncludeAssets: ctx.Bool(includeAssetsName),
+	})
 	if err != nil {
 		return err
 	}
@@ -359,12 +648,16 @@ var universeProofCommand = cli.Command{
 	on chain. Proofs are namespaced based on a top level assetID/groupKey,
 	so that must be specified for each command.
 
-	Two sub-commands are available: proof querying (query) and proof
-	insertion (insert).
+	Four sub-commands are available: proof querying (query), inclusion
+	proof querying (inclusion), proof insertion (insert) and proof
+	deletion (delete).
 	`,
 	Subcommands: []cli.Command{
 		universeProofQueryCommand,
+		universeProofInclusionCommand,
 		universeProofInsertInsert,
+		universeProofInsertBatchCommand,
+		universeProofDeleteCommand,
 	},
 }
 
@@ -428,6 +721,49 @@ func universeProofQuery(ctx *cli.Context) error {
 	return nil
 }
 
+var universeProofInclusionCommand = cli.Command{
+	Name:  "inclusion",
+	Usage: "query for and verify a universe inclusion proof",
+	Description: `
+	Attempt to query the target universe for the inclusion proof of a given
+	asset leaf based on a top level asset id or group key, and the leaf key
+	of: outpoint || script key. The returned proof is verified locally
+	against the returned universe root before being printed.
+	`,
+	Flags:  universeProofArgs,
+	Action: universeProofInclusion,
+}
+
+func universeProofInclusion(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	assetKey, err := parseAssetKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	universeID, err := parseUniverseID(ctx, true)
+	if err != nil {
+		return err
+	}
+	uniProof, err := client.QueryUniverseProof(ctxc, &unirpc.UniverseKey{
+		Id:      universeID,
+		LeafKey: assetKey,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tap.VerifyUniverseInclusionProof(uniProof); err != nil {
+		return fmt.Errorf("unable to verify inclusion proof: %w", err)
+	}
+
+	printRespJSON(uniProof)
+	return nil
+}
+
 var universeProofInsertInsert = cli.Command{
 	Name:  "insert",
 	Usage: "insert a new universe proof",
@@ -510,8 +846,177 @@ func universeProofInsert(ctx *cli.Context) error {
 	return nil
 }
 
+var universeProofInsertBatchCommand = cli.Command{
+	Name:  "insert-batch",
+	Usage: "insert a batch of new universe proofs",
+	Description: `
+	Attempt to insert the last proof of each of the given proof files into
+	the universe. The universe and leaf key of each proof are derived from
+	the proof itself. All valid proofs are inserted, even if other proofs
+	of the batch are rejected, and the result of each proof is returned.
+	`,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: proofPathName,
+			Usage: "the path to a proof file to insert; can be " +
+				"specified multiple times",
+		},
+	},
+	Action: universeProofInsertBatch,
+}
+
+func universeProofInsertBatch(ctx *cli.Context) error {
+	filePaths := ctx.StringSlice(proofPathName)
+	if len(filePaths) == 0 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	req := &unirpc.InsertProofsRequest{
+		Proofs: make([]*unirpc.AssetProof, 0, len(filePaths)),
+	}
+	for _, filePath := range filePaths {
+		filePath = lncfg.CleanAndExpandPath(filePath)
+		rawFile, err := readFile(filePath)
+		if err != nil {
+			return fmt.Errorf("unable to read proof file %v: %w",
+				filePath, err)
+		}
+
+		var proofFile proof.File
+		err = proofFile.Decode(bytes.NewReader(rawFile))
+		if err != nil {
+			return fmt.Errorf("unable to decode proof file %v: %w",
+				filePath, err)
+		}
+
+		assetProof, err := proofFile.LastProof()
+		if err != nil {
+			return err
+		}
+		rawProof, err := proofFile.RawLastProof()
+		if err != nil {
+			return err
+		}
+
+		rpcAsset, err := taprpc.MarshalAsset(
+			ctxc, &assetProof.Asset, false, true, nil,
+		)
+		if err != nil {
+			return err
+		}
+
+		// The proof type is left unspecified, so the server derives it
+		// from the proof.
+		uniID := universe.Identifier{
+			AssetID: assetProof.Asset.ID(),
+		}
+		if assetProof.Asset.GroupKey != nil {
+			uniID.GroupKey = &assetProof.Asset.GroupKey.GroupPubKey
+		}
+		rpcUniID, err := tap.MarshalUniID(uniID)
+		if err != nil {
+			return err
+		}
+
+		outpoint := assetProof.OutPoint()
+		scriptKey := assetProof.Asset.ScriptKey.PubKey
+		leafKey := &unirpc.AssetKey{
+			Outpoint: &unirpc.AssetKey_OpStr{
+				OpStr: outpoint.String(),
+			},
+			ScriptKey: &unirpc.AssetKey_ScriptKeyBytes{
+				ScriptKeyBytes: schnorr.SerializePubKey(
+					scriptKey,
+				),
+			},
+		}
+
+		req.Proofs = append(req.Proofs, &unirpc.AssetProof{
+			Key: &unirpc.UniverseKey{
+				Id:      rpcUniID,
+				LeafKey: leafKey,
+			},
+			AssetLeaf: &unirpc.AssetLeaf{
+				Asset:         rpcAsset,
+				IssuanceProof: rawProof,
+			},
+		})
+	}
+
+	resp, err := client.InsertProofs(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeProofDeleteCommand = cli.Command{
+	Name:  "delete",
+	Usage: "delete a proof from the local universe",
+	Description: `
+	Delete a single proof leaf from the local universe based on a top
+	level asset id or group key, and the leaf key of: outpoint || script
+	key. The proof type must be specified. The new universe root is
+	returned. Deleting a proof that doesn't exist is a no-op.
+	`,
+	Flags:  universeProofArgs,
+	Action: universeProofDelete,
+}
+
+func universeProofDelete(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	assetKey, err := parseAssetKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	universeID, err := parseUniverseID(ctx, true)
+	if err != nil {
+		return err
+	}
+	resp, err := client.DeleteUniverseLeaf(
+		ctxc, &unirpc.DeleteUniverseLeafRequest{
+			Key: &unirpc.UniverseKey{
+				Id:      universeID,
+				LeafKey: assetKey,
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var (
 	universeHostName = "universe_host"
+
+	universeSyncDeltaName = "delta"
+
+	universeSyncRootsOnlyName = "roots_only"
+
+	universeSyncDryRunName = "dry_run"
+
+	universeSyncProgressName = "progress"
+
+	universeSyncAllName = "all"
+
+	universeSyncResumeName = "resume"
+
+	universeIDPrefixName = "id_prefix"
+
+	universeKnownMultiverseRootName = "known_multiverse_root"
 )
 
 var universeSyncCommand = cli.Command{
@@ -539,14 +1044,70 @@ var universeSyncCommand = cli.Command{
 				"or 'transfer'",
 			Value: universe.ProofTypeIssuance.String(),
 		},
-	},
-	Action: universeSync,
-}
-
-func universeSync(ctx *cli.Context) error {
-	switch {
-	case ctx.String(universeHostName) == "":
-		return cli.ShowSubcommandHelp(ctx)
+		cli.BoolFlag{
+			Name: universeSyncDeltaName,
+			Usage: "only fetch the leaves inserted since the " +
+				"current local root, falling back to a full " +
+				"diff if the remote universe doesn't know it",
+		},
+		cli.BoolFlag{
+			Name: universeSyncRootsOnlyName,
+			Usage: "only import the roots of the remote " +
+				"universe, the leaves are fetched from the " +
+				"remote universe once they're queried",
+		},
+		cli.BoolFlag{
+			Name: universeSyncDryRunName,
+			Usage: "only show the diff against the remote " +
+				"universe, without inserting any new leaves " +
+				"into the local universe",
+		},
+		cli.BoolFlag{
+			Name: universeSyncProgressName,
+			Usage: "print progress events while the sync is " +
+				"running, followed by the final summary",
+		},
+		cli.StringFlag{
+			Name: universeIDPrefixName,
+			Usage: "if set, only the universes whose group key " +
+				"(or asset ID if not grouped) starts with " +
+				"the given hex prefix are synced",
+		},
+		cli.Uint64Flag{
+			Name: minConfsName,
+			Usage: "if set, overrides the minimum number of " +
+				"confirmations the anchor transaction of a " +
+				"new leaf must have to be synced",
+		},
+		cli.StringFlag{
+			Name: universeKnownMultiverseRootName,
+			Usage: "the multiverse_root returned by a previous " +
+				"sync; if the remote multiverse root still " +
+				"matches, the sync is skipped",
+		},
+		cli.BoolFlag{
+			Name: universeSyncResumeName,
+			Usage: "skip the universes that were already synced " +
+				"by a previous sync with the same remote " +
+				"universe that was interrupted",
+		},
+		cli.BoolFlag{
+			Name: universeSyncAllName,
+			Usage: "sync with all federation members " +
+				"concurrently instead of a single remote " +
+				"universe, merging the leaves of all members",
+		},
+	},
+	Action: universeSync,
+}
+
+func universeSync(ctx *cli.Context) error {
+	switch {
+	case ctx.Bool(universeSyncAllName):
+		return universeSyncAll(ctx)
+
+	case ctx.String(universeHostName) == "":
+		return cli.ShowSubcommandHelp(ctx)
 	}
 
 	universeID, err := parseUniverseID(ctx, false)
@@ -565,20 +1126,34 @@ func universeSync(ctx *cli.Context) error {
 	client, cleanUp := getUniverseClient(ctx)
 	defer cleanUp()
 
-	rpcProofType, err := parseProofType(ctx)
+	syncMode, err := parseUniverseSyncMode(ctx)
 	if err != nil {
 		return err
 	}
-	syncMode := unirpc.UniverseSyncMode_SYNC_ISSUANCE_ONLY
-	if *rpcProofType == unirpc.ProofType_PROOF_TYPE_TRANSFER {
-		syncMode = unirpc.UniverseSyncMode_SYNC_FULL
+
+	knownMultiverseRoot, err := hex.DecodeString(
+		ctx.String(universeKnownMultiverseRootName),
+	)
+	if err != nil {
+		return fmt.Errorf("invalid known multiverse root: %w", err)
 	}
 
-	syncResp, err := client.SyncUniverse(ctxc, &unirpc.SyncRequest{
-		UniverseHost: ctx.String(universeHostName),
-		SyncTargets:  targets,
-		SyncMode:     syncMode,
-	})
+	syncReq := &unirpc.SyncRequest{
+		UniverseHost:        ctx.String(universeHostName),
+		SyncTargets:         targets,
+		SyncMode:            syncMode,
+		DryRun:              ctx.Bool(universeSyncDryRunName),
+		IdPrefix:            ctx.String(universeIDPrefixName),
+		MinConfs:            uint32(ctx.Uint64(minConfsName)),
+		KnownMultiverseRoot: knownMultiverseRoot,
+		ResumeSync:          ctx.Bool(universeSyncResumeName),
+	}
+
+	if ctx.Bool(universeSyncProgressName) {
+		return universeSyncWithProgress(ctxc, client, syncReq)
+	}
+
+	syncResp, err := client.SyncUniverse(ctxc, syncReq)
 	if err != nil {
 		return err
 	}
@@ -587,67 +1162,122 @@ func universeSync(ctx *cli.Context) error {
 	return nil
 }
 
-var universeFederationCommand = cli.Command{
-	Name:      "federation",
-	ShortName: "f",
-	Usage:     "manage the set of active servers in the Universe Federation",
-	Description: `
-	Manage the set of active Universe Federation servers. These servers
-	will be used to push out any new proof updates generated within the RPC
-	interface. These servers will also be used to periodically reconcile
-	Universe state roots for any active/known assets.
-	`,
-	Subcommands: []cli.Command{
-		universeFederationListCommand,
-		universeFederationAddCommand,
-		universeFederationDelCommand,
-		universeFederationConfigCommand,
-	},
-}
+// parseUniverseSyncMode returns the sync mode selected by the proof type, delta
+// and roots only flags.
+func parseUniverseSyncMode(ctx *cli.Context) (unirpc.UniverseSyncMode, error) {
+	rpcProofType, err := parseProofType(ctx)
+	if err != nil {
+		return 0, err
+	}
 
-var universeFederationListCommand = cli.Command{
-	Name:        "list",
-	ShortName:   "l",
-	Description: "List the set of active servers in the Federation",
-	Flags:       []cli.Flag{},
-	Action:      universeFederationList,
+	syncMode := unirpc.UniverseSyncMode_SYNC_ISSUANCE_ONLY
+	if *rpcProofType == unirpc.ProofType_PROOF_TYPE_TRANSFER {
+		syncMode = unirpc.UniverseSyncMode_SYNC_FULL
+	}
+	switch {
+	case ctx.Bool(universeSyncDeltaName) &&
+		ctx.Bool(universeSyncRootsOnlyName):
+
+		return 0, fmt.Errorf("only one of --%s and --%s can be set",
+			universeSyncDeltaName, universeSyncRootsOnlyName)
+
+	case ctx.Bool(universeSyncDeltaName):
+		syncMode = unirpc.UniverseSyncMode_SYNC_DELTA
+
+	case ctx.Bool(universeSyncRootsOnlyName):
+		syncMode = unirpc.UniverseSyncMode_SYNC_ROOTS_ONLY
+	}
+
+	return syncMode, nil
 }
 
-func universeFederationList(ctx *cli.Context) error {
+// universeSyncAll syncs the local universe with all federation members.
+func universeSyncAll(ctx *cli.Context) error {
+	switch {
+	case ctx.IsSet(universeHostName), ctx.IsSet(assetIDName),
+		ctx.IsSet(groupKeyName), ctx.IsSet(universeIDPrefixName):
+
+		return fmt.Errorf("--%s syncs all universes of all federation "+
+			"members, no host or universe can be specified",
+			universeSyncAllName)
+
+	case ctx.Bool(universeSyncDryRunName),
+		ctx.Bool(universeSyncProgressName):
+
+		return fmt.Errorf("--%s can't be combined with --%s or --%s",
+			universeSyncAllName, universeSyncDryRunName,
+			universeSyncProgressName)
+	}
+
+	syncMode, err := parseUniverseSyncMode(ctx)
+	if err != nil {
+		return err
+	}
+
 	ctxc := getContext()
 	client, cleanUp := getUniverseClient(ctx)
 	defer cleanUp()
 
-	servers, err := client.ListFederationServers(
-		ctxc, &unirpc.ListFederationServersRequest{},
-	)
+	resp, err := client.SyncAll(ctxc, &unirpc.SyncAllRequest{
+		SyncMode: syncMode,
+	})
 	if err != nil {
 		return err
 	}
 
-	printRespJSON(servers)
+	printRespJSON(resp)
 	return nil
 }
 
-var universeFederationAddCommand = cli.Command{
-	Name:      "add",
-	ShortName: "a",
+// universeSyncWithProgress runs the given sync request as a streaming sync and
+// prints each progress event as it arrives, followed by the final summary.
+func universeSyncWithProgress(ctxc context.Context,
+	client unirpc.UniverseClient, syncReq *unirpc.SyncRequest) error {
+
+	stream, err := client.SyncUniverseStream(ctxc, syncReq)
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("unable to receive sync event: %w",
+				err)
+		}
+
+		switch e := event.Event.(type) {
+		case *unirpc.SyncProgressEvent_Progress:
+			printRespJSON(e.Progress)
+
+		case *unirpc.SyncProgressEvent_Summary:
+			printRespJSON(e.Summary)
+		}
+	}
+}
+
+var universeDiffCommand = cli.Command{
+	Name:  "diff",
+	Usage: "compare the universe roots with a remote universe",
 	Description: `
-	Add a new server to the Federation. Newly added servers will be synced
-	automatically, will also be used to push out newly validated proofs.
+	Compare the local universe roots with the roots of a remote universe.
+	For each asset whose roots differ, the side that is ahead is shown.
+	No leaves are inserted into the local universe.
 	`,
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name: universeHostName,
-			Usage: "the address for the universe server, eg: " +
-				"testnet.mydomain.com:10029. The default port " +
-				"(10029) will be used if none is provided",
+			Usage: "the host:port or just host of the remote " +
+				"universe",
 		},
 	},
-	Action: universeFederationAdd,
+	Action: universeDiff,
 }
 
-func universeFederationAdd(ctx *cli.Context) error {
+func universeDiff(ctx *cli.Context) error {
 	switch {
 	case ctx.String(universeHostName) == "":
 		return cli.ShowSubcommandHelp(ctx)
@@ -657,67 +1287,69 @@ func universeFederationAdd(ctx *cli.Context) error {
 	client, cleanUp := getUniverseClient(ctx)
 	defer cleanUp()
 
-	resp, err := client.AddFederationServer(
-		ctxc, &unirpc.AddFederationServerRequest{
-			Servers: []*unirpc.UniverseFederationServer{
-				{
-					Host: ctx.String(universeHostName),
-				},
-			},
-		},
-	)
+	diffResp, err := client.DiffUniverse(ctxc, &unirpc.DiffUniverseRequest{
+		UniverseHost: ctx.String(universeHostName),
+	})
 	if err != nil {
 		return err
 	}
 
-	printRespJSON(resp)
+	printRespJSON(diffResp)
 	return nil
 }
 
-const universeServerID = "server_id"
+const (
+	universeVerifyRepairName = "repair"
+)
 
-var universeFederationDelCommand = cli.Command{
-	Name:      "del",
-	ShortName: "d",
+var universeVerifyCommand = cli.Command{
+	Name:  "verify",
+	Usage: "verify the persisted universe roots against their leaves",
 	Description: `
-	Remove a server from the Federation. Servers can be identified either
-	via their ID, or the server host.
+	Recompute the roots of the local universe trees from their stored
+	leaves, and compare them to the persisted roots. If neither an asset ID
+	nor a group key is set, then all known universes are verified. With
+	--repair, the persisted roots of mismatching universes are rewritten to
+	match the recomputed roots.
 	`,
 	Flags: []cli.Flag{
 		cli.StringFlag{
-			Name: universeHostName,
-			Usage: "the host:port or just host of the remote " +
-				"universe",
+			Name:  assetIDName,
+			Usage: "the asset ID of the universe to verify",
 		},
-		cli.IntFlag{
-			Name:  universeServerID,
-			Usage: "the ID of the universe server to delete",
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the universe to verify",
+		},
+		cli.StringFlag{
+			Name: proofTypeName,
+			Usage: "the type of proof to verify the root for, " +
+				"either 'issuance' or 'transfer'",
+			Value: universe.ProofTypeIssuance.String(),
+		},
+		cli.BoolFlag{
+			Name: universeVerifyRepairName,
+			Usage: "rewrite the persisted roots that don't match " +
+				"the recomputed roots",
 		},
 	},
-	Action: universeFederationDel,
+	Action: universeVerify,
 }
 
-func universeFederationDel(ctx *cli.Context) error {
-	switch {
-	case ctx.String(universeHostName) == "" &&
-		ctx.Int(universeServerID) == 0:
-		return cli.ShowSubcommandHelp(ctx)
-	}
-
+func universeVerify(ctx *cli.Context) error {
 	ctxc := getContext()
 	client, cleanUp := getUniverseClient(ctx)
 	defer cleanUp()
 
-	resp, err := client.DeleteFederationServer(
-		ctxc, &unirpc.DeleteFederationServerRequest{
-			Servers: []*unirpc.UniverseFederationServer{
-				{
-					Id:   int32(ctx.Int(universeServerID)),
-					Host: ctx.String(universeHostName),
-				},
-			},
-		},
-	)
+	universeID, err := parseUniverseID(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.VerifyUniverse(ctxc, &unirpc.VerifyUniverseRequest{
+		Id:     universeID,
+		Repair: ctx.Bool(universeVerifyRepairName),
+	})
 	if err != nil {
 		return err
 	}
@@ -726,174 +1358,199 @@ func universeFederationDel(ctx *cli.Context) error {
 	return nil
 }
 
-var universeFederationConfigCommand = cli.Command{
-	Name:      "config",
-	ShortName: "c",
-	Usage:     "Change the sync behavior of the local Universe",
-	Description: `
-	Manage the sync behavior of the local Universe. These settings are
-	defined by the proof type (issuance or transfer), the sync behavior
-	(insert from remote Universe or export to remote Universe), and the
-	scope (all assets or specific assets).
-        `,
-	Subcommands: []cli.Command{
-		universeFederationGlobalConfig,
-		universeFederationLocalConfig,
-		universeFederationConfigInfo,
-	},
-}
+const (
+	universeLeavesFileName = "leaves_file"
 
-var (
-	universeConfigScope    = "config_scope"
-	proofInsertName        = "allow_insert"
-	proofExportName        = "allow_export"
-	universeSyncConfigArgs = []cli.Flag{
+	universeExpectedRootHashName = "expected_root_hash"
+
+	universeExpectedRootSumName = "expected_root_sum"
+)
+
+var universeComputeRootCommand = cli.Command{
+	Name:  "compute-root",
+	Usage: "compute the universe root of a set of leaves",
+	Description: `
+	Compute the MS-SMT root of a universe tree that holds exactly the
+	leaves of the given file, without accessing any local universe state.
+	The file must contain the JSON output of the 'universe leaves' command,
+	for example as returned by a remote universe server. If an expected
+	root is given, then the computed root is compared to it, which can be
+	used to cross-check the root claimed by a universe server against the
+	leaves it serves.
+	`,
+	Flags: []cli.Flag{
 		cli.StringFlag{
-			Name:  proofTypeName,
-			Usage: "the type of proof",
+			Name: universeLeavesFileName,
+			Usage: "the path to the JSON file with the leaves; " +
+				"use the dash character (-) to read from " +
+				"stdin",
 		},
 		cli.StringFlag{
-			Name: proofInsertName,
-			Usage: "if true, remote Universes can push proofs to" +
-				"the local Universe",
+			Name: universeExpectedRootHashName,
+			Usage: "the hex encoded root hash the computed " +
+				"root is compared to",
 		},
-		cli.StringFlag{
-			Name: proofExportName,
-			Usage: "if true, remote Universes can pull proofs from" +
-				"the local Universe",
+		cli.Int64Flag{
+			Name:  universeExpectedRootSumName,
+			Usage: "the root sum the computed root is compared to",
 		},
-	}
-)
+	},
+	Action: universeComputeRoot,
+}
 
-func isValidBool(arg string) (bool, error) {
-	// The cli.BoolFlag works for variables that are false by default, but
-	// here we don't want a default for this argument.
-	switch arg {
-	case "true":
-		return true, nil
-	case "false":
-		return false, nil
-	default:
-		return false, fmt.Errorf("invalid bool flag")
+func universeComputeRoot(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	if !ctx.IsSet(universeLeavesFileName) {
+		return fmt.Errorf("leaves file must be set")
 	}
-}
 
-func parseConfigArgs(ctx *cli.Context) (*bool, *bool, error) {
-	var (
-		insertOpt, exportOpt bool
-		err                  error
-	)
+	filePath := lncfg.CleanAndExpandPath(ctx.String(universeLeavesFileName))
+	leavesJSON, err := readFile(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to read leaves file: %w", err)
+	}
 
-	if !ctx.IsSet(proofInsertName) && !ctx.IsSet(proofExportName) {
-		return nil, nil, fmt.Errorf("either insert or export must be " +
-			"set")
+	var leaves unirpc.AssetLeafResponse
+	err = taprpc.ProtoJSONUnmarshalOpts.Unmarshal(leavesJSON, &leaves)
+	if err != nil {
+		return fmt.Errorf("unable to decode leaves file: %w", err)
 	}
 
-	// Parse the insert and export flags, which are not mutually exclusive.
-	if ctx.IsSet(proofInsertName) {
-		insertOpt, err = isValidBool(ctx.String(proofInsertName))
+	req := &unirpc.ComputeUniverseRootRequest{
+		Leaves: leaves.Leaves,
+	}
+	if ctx.IsSet(universeExpectedRootHashName) {
+		rootHash, err := hex.DecodeString(
+			ctx.String(universeExpectedRootHashName),
+		)
 		if err != nil {
-			return nil, nil, fmt.Errorf("invalid proof insert flag")
+			return fmt.Errorf("invalid expected root hash: %w",
+				err)
 		}
-	}
 
-	if ctx.IsSet(proofExportName) {
-		exportOpt, err = isValidBool(ctx.String(proofExportName))
-		if err != nil {
-			return nil, nil, fmt.Errorf("invalid proof export flag")
+		req.ExpectedRoot = &unirpc.MerkleSumNode{
+			RootHash: rootHash,
+			RootSum:  ctx.Int64(universeExpectedRootSumName),
 		}
 	}
 
-	switch {
-	case ctx.IsSet(proofExportName) && ctx.IsSet(proofInsertName):
-		return &insertOpt, &exportOpt, nil
-
-	case ctx.IsSet(proofInsertName):
-		return &insertOpt, nil, nil
-
-	case ctx.IsSet(proofExportName):
-		return nil, &exportOpt, nil
-
-	default:
-		return nil, nil, nil
+	resp, err := client.ComputeUniverseRoot(ctxc, req)
+	if err != nil {
+		return err
 	}
+
+	printRespJSON(resp)
+	return nil
 }
 
-var universeFederationGlobalConfig = cli.Command{
-	Name:      "global",
-	ShortName: "g",
-	Usage:     "Change the global sync behavior of the local Universe",
+const (
+	universePruneZeroSupplyName = "zero_supply"
+
+	universePruneOlderThanName = "older_than_blocks"
+
+	universePruneDryRunName = "dry_run"
+
+	universePruneMinConfsName = "min_confs"
+)
+
+var universePruneCommand = cli.Command{
+	Name:  "prune",
+	Usage: "remove the universe trees of assets matching the criteria",
 	Description: `
-	Manage the sync behavior of the local Universe that will apply to all
-	assets by default. Per-asset sync behavior will override global
-	settings. These settings are defined by the proof type (issuance or
-	transfer) and the sync behavior (insert from remote Universe or export
-	to remote Universe).
-        `,
-	Flags:  universeSyncConfigArgs,
-	Action: universeFederationUpdateGlobalConfig,
+	Remove the issuance and transfer universe trees of all assets that
+	match the given criteria. With --zero_supply, only assets that had
+	their full issued supply burned are pruned. With --older_than_blocks,
+	only assets that didn't have any new leaf inserted within the given
+	number of blocks are pruned. If both are set, then an asset must match
+	both criteria. With --dry_run, the assets that would be pruned are
+	shown without removing them.
+	`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: universePruneZeroSupplyName,
+			Usage: "only prune assets that had their full supply " +
+				"burned",
+		},
+		cli.Uint64Flag{
+			Name: universePruneOlderThanName,
+			Usage: "only prune assets that weren't updated " +
+				"within this many blocks",
+		},
+		cli.BoolFlag{
+			Name: universePruneDryRunName,
+			Usage: "only show the assets that would be pruned " +
+				"without removing them",
+		},
+	},
+	Action: universePrune,
+	Subcommands: []cli.Command{
+		universePruneSpentCommand,
+		universePruneListCommand,
+	},
 }
 
-func universeFederationUpdateGlobalConfig(ctx *cli.Context) error {
+func universePrune(ctx *cli.Context) error {
 	ctxc := getContext()
 	client, cleanUp := getUniverseClient(ctx)
 	defer cleanUp()
 
-	// There is no default proof type, so we need to make sure this
-	// flag is set.
-	if !ctx.IsSet(proofTypeName) {
-		return fmt.Errorf("must specify proof type")
-	}
-
-	rpcProofType, err := parseProofType(ctx)
-	if err != nil {
-		return err
-	}
-
-	insertOpt, exportOpt, err := parseConfigArgs(ctx)
-	if err != nil {
-		return err
-	}
-
-	// Read the current global config for the matching proof type.
-	syncConfigs, err := client.QueryFederationSyncConfig(
-		ctxc, &unirpc.QueryFederationSyncConfigRequest{},
-	)
+	resp, err := client.PruneUniverse(ctxc, &unirpc.PruneUniverseRequest{
+		ZeroSupply:      ctx.Bool(universePruneZeroSupplyName),
+		OlderThanBlocks: uint32(ctx.Uint64(universePruneOlderThanName)),
+		DryRun:          ctx.Bool(universePruneDryRunName),
+	})
 	if err != nil {
 		return err
 	}
 
-	// This should never occur, as we always have the default configs.
-	if syncConfigs.GlobalSyncConfigs == nil {
-		return fmt.Errorf("no global sync configs found")
-	}
+	printRespJSON(resp)
+	return nil
+}
 
-	currentConfig, err := fn.First(
-		syncConfigs.GlobalSyncConfigs,
-		func(cfg *unirpc.GlobalFederationSyncConfig) bool {
-			return cfg.ProofType == *rpcProofType
+var universePruneSpentCommand = cli.Command{
+	Name:  "spent",
+	Usage: "prune the proofs of deeply confirmed spent transfer leaves",
+	Description: `
+	Prune the proofs of all transfer leaves that are spent by a transfer
+	with at least --min_confs confirmations. If not set, the retention
+	policy configured for the daemon is used. Pruned leaves keep their
+	hash and sum, so the universe roots don't change and can still be
+	verified. The proofs of pruned leaves can no longer be served to
+	syncers or clients that request the full transfer history of an
+	asset. With --dry_run, the leaves that would be pruned are shown
+	without pruning them.
+	`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: universePruneMinConfsName,
+			Usage: "the minimum number of confirmations of the " +
+				"transfer that spends a leaf",
 		},
-	)
-
-	if err != nil {
-		return fmt.Errorf("no existing config found")
-	}
+		cli.BoolFlag{
+			Name: universePruneDryRunName,
+			Usage: "only show the leaves that would be pruned " +
+				"without pruning them",
+		},
+	},
+	Action: universePruneSpent,
+}
 
-	// Modify the existing config and update it on disk.
-	if insertOpt != nil {
-		currentConfig.AllowSyncInsert = *insertOpt
-	}
-	if exportOpt != nil {
-		currentConfig.AllowSyncExport = *exportOpt
-	}
+func universePruneSpent(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
 
-	configReq := &unirpc.SetFederationSyncConfigRequest{
-		GlobalSyncConfigs: []*unirpc.GlobalFederationSyncConfig{
-			currentConfig,
+	resp, err := client.PruneSpentLeaves(
+		ctxc, &unirpc.PruneSpentLeavesRequest{
+			MinConfirmations: uint32(
+				ctx.Uint64(universePruneMinConfsName),
+			),
+			DryRun: ctx.Bool(universePruneDryRunName),
 		},
-	}
-
-	resp, err := client.SetFederationSyncConfig(ctxc, configReq)
+	)
 	if err != nil {
 		return err
 	}
@@ -902,30 +1559,33 @@ func universeFederationUpdateGlobalConfig(ctx *cli.Context) error {
 	return nil
 }
 
-var universeFederationLocalConfig = cli.Command{
-	Name:      "local",
-	ShortName: "l",
-	Usage: "Change the sync behavior of the local Universe for a " +
-		"specific asset",
+var universePruneListCommand = cli.Command{
+	Name:  "list",
+	Usage: "list the pruned leaves of a universe",
 	Description: `
-	Manage the sync behavior of the local Universe for a specific asset.
-	Local settings will override global settings. These settings are
-	defined by the proof type (issuance or transfer) and the sync behavior
-	(insert from remote Universe or export to remote Universe).
-        `,
-	Flags: append(universeSyncConfigArgs,
+	List the keys of all leaves of the given universe that were pruned.
+	The proofs of these leaves can no longer be fetched.
+	`,
+	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name:  assetIDName,
-			Usage: "the asset ID of the universe to configure",
+			Usage: "the asset ID of the universe to query for",
 		},
 		cli.StringFlag{
 			Name:  groupKeyName,
-			Usage: "the group key of the universe to configure",
-		}),
-	Action: universeFederationUpdateLocalConfig,
+			Usage: "the group key of the universe to query for",
+		},
+		cli.StringFlag{
+			Name: proofTypeName,
+			Usage: "the type of proof to show the pruned leaves " +
+				"for, either 'issuance' or 'transfer'",
+			Value: universe.ProofTypeTransfer.String(),
+		},
+	},
+	Action: universePruneList,
 }
 
-func universeFederationUpdateLocalConfig(ctx *cli.Context) error {
+func universePruneList(ctx *cli.Context) error {
 	ctxc := getContext()
 	client, cleanUp := getUniverseClient(ctx)
 	defer cleanUp()
@@ -935,231 +1595,1781 @@ func universeFederationUpdateLocalConfig(ctx *cli.Context) error {
 		return err
 	}
 
-	if universeID == nil {
-		return fmt.Errorf("invalid universe ID")
-	}
-
-	insertOpt, exportOpt, err := parseConfigArgs(ctx)
+	resp, err := client.ListPrunedLeaves(
+		ctxc, &unirpc.ListPrunedLeavesRequest{
+			Id: universeID,
+		},
+	)
 	if err != nil {
 		return err
 	}
 
-	// Read the current local config for the matching Universe if it exists.
-	syncConfigs, err := client.QueryFederationSyncConfig(
-		ctxc, &unirpc.QueryFederationSyncConfigRequest{},
+	printRespJSON(resp)
+	return nil
+}
+
+var universeCompactCommand = cli.Command{
+	Name:  "compact",
+	Usage: "compact the universe store of the database",
+	Description: `
+	Compact the universe store of the database, reclaiming the space of
+	dead rows and rebuilding its indexes. The compaction runs while the
+	daemon is online, though on SQLite mutating operations are blocked
+	while the database file is rebuilt. The size of the database before
+	and after the compaction is shown.
+	`,
+	Action: universeCompact,
+}
+
+func universeCompact(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.CompactUniverseDB(
+		ctxc, &unirpc.CompactUniverseDBRequest{},
 	)
 	if err != nil {
 		return err
 	}
 
-	var localConfig *unirpc.AssetFederationSyncConfig
-	switch {
-	case syncConfigs.AssetSyncConfigs == nil:
-		// Create a new config for this asset.
-		localConfig = &unirpc.AssetFederationSyncConfig{
-			Id: universeID,
-		}
-
-	default:
-		// We have some asset-specific configs, so search for one that
-		// matches the specified asset.
-		localConfig, err = fn.First(
-			syncConfigs.AssetSyncConfigs,
-			func(cfg *unirpc.AssetFederationSyncConfig) bool {
-				return cfg.Id == universeID
-			},
-		)
+	printRespJSON(resp)
+	return nil
+}
 
-		if err != nil {
-			// Create a new config for this asset.
-			localConfig = &unirpc.AssetFederationSyncConfig{
-				Id: universeID,
-			}
-		}
-	}
+var universeFederationCommand = cli.Command{
+	Name:      "federation",
+	ShortName: "f",
+	Usage:     "manage the set of active servers in the Universe Federation",
+	Description: `
+	Manage the set of active Universe Federation servers. These servers
+	will be used to push out any new proof updates generated within the RPC
+	interface. These servers will also be used to periodically reconcile
+	Universe state roots for any active/known assets.
+	`,
+	Subcommands: []cli.Command{
+		universeFederationListCommand,
+		universeFederationAddCommand,
+		universeFederationDelCommand,
+		universeFederationSetPriorityCommand,
+		universeFederationCheckCommand,
+		universeFederationRootsCommand,
+		universeFederationConfigCommand,
+	},
+}
 
-	if insertOpt != nil {
-		localConfig.AllowSyncInsert = *insertOpt
-	}
-	if exportOpt != nil {
-		localConfig.AllowSyncExport = *exportOpt
-	}
+var universeFederationListCommand = cli.Command{
+	Name:        "list",
+	ShortName:   "l",
+	Description: "List the set of active servers in the Federation",
+	Flags:       []cli.Flag{},
+	Action:      universeFederationList,
+}
 
-	configReq := &unirpc.SetFederationSyncConfigRequest{
-		AssetSyncConfigs: []*unirpc.AssetFederationSyncConfig{
-			localConfig,
-		},
-	}
+func universeFederationList(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
 
-	resp, err := client.SetFederationSyncConfig(ctxc, configReq)
+	servers, err := client.ListFederationServers(
+		ctxc, &unirpc.ListFederationServersRequest{},
+	)
 	if err != nil {
 		return err
 	}
 
-	printRespJSON(resp)
+	printRespJSON(servers)
 	return nil
 }
 
-var universeFederationConfigInfo = cli.Command{
-	Name:      "info",
-	ShortName: "i",
-	Usage:     "Get the sync behavior of the local Universe",
+var universeFederationAddCommand = cli.Command{
+	Name:      "add",
+	ShortName: "a",
 	Description: `
-        Get the sync behavior of the local Universe. These settings are
-        defined by the proof type (issuance or transfer), the sync behavior
-        (insert from remote Universe or export to remote Universe), and the
-        scope (all assets or specific assets).
-        `,
+	Add a new server to the Federation. Newly added servers will be synced
+	automatically, will also be used to push out newly validated proofs.
+	`,
 	Flags: []cli.Flag{
 		cli.StringFlag{
-			Name:  universeConfigScope,
-			Usage: "the scope (global or local) of the config",
+			Name: universeHostName,
+			Usage: "the address for the universe server, eg: " +
+				"testnet.mydomain.com:10029. The default port " +
+				"(10029) will be used if none is provided",
 		},
 		cli.StringFlag{
-			Name:  assetIDName,
-			Usage: "the asset ID of the universe",
+			Name: universeTLSCertPath,
+			Usage: "an optional path to the TLS certificate of the " +
+				"universe server; if set, the certificate is " +
+				"pinned and used as the only trusted CA for " +
+				"the server",
+		},
+		cli.DurationFlag{
+			Name: universeSyncInterval,
+			Usage: "an optional interval at which the server " +
+				"should be synced; if not set, the global " +
+				"sync interval of the node is used",
+		},
+		cli.BoolFlag{
+			Name: universePushOnRegister,
+			Usage: "if set, the full local universe state is " +
+				"pushed to the server once it was added",
 		},
 		cli.StringFlag{
-			Name:  groupKeyName,
-			Usage: "the group key of the universe",
+			Name: universeProxyAddr,
+			Usage: "the host:port of an optional SOCKS5 proxy, " +
+				"such as a Tor daemon, that is used to " +
+				"connect to the server; if not set, the " +
+				"global proxy of the node is used, if any",
+		},
+		cli.StringFlag{
+			Name: universeProxyUser,
+			Usage: "the optional user name used to authenticate " +
+				"with the proxy",
+		},
+		cli.StringFlag{
+			Name: universeProxyPassword,
+			Usage: "the optional password used to authenticate " +
+				"with the proxy",
+		},
+		cli.IntFlag{
+			Name: universePriority,
+			Usage: "the priority of the server; servers with a " +
+				"higher priority are synced first and their " +
+				"leaves are preferred over diverging leaves " +
+				"of servers with a lower priority",
 		},
 	},
-	Action: universeFederationGetConfigInfo,
+	Action: universeFederationAdd,
 }
 
-func universeFederationGetConfigInfo(ctx *cli.Context) error {
-	ctxc := getContext()
+const (
+	universeTLSCertPath = "tls_cert_path"
+
+	universeSyncInterval = "sync_interval"
+
+	universePushOnRegister = "push_on_register"
+
+	universeProxyAddr = "proxy_addr"
+
+	universeProxyUser = "proxy_user"
+
+	universeProxyPassword = "proxy_password"
+
+	universePriority = "priority"
+)
+
+func universeFederationAdd(ctx *cli.Context) error {
+	switch {
+	case ctx.String(universeHostName) == "":
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	var tlsCert []byte
+	if certPath := ctx.String(universeTLSCertPath); certPath != "" {
+		var err error
+		tlsCert, err = os.ReadFile(lncfg.CleanAndExpandPath(certPath))
+		if err != nil {
+			return fmt.Errorf("unable to read TLS cert: %w", err)
+		}
+	}
+
+	syncInterval := ctx.Duration(universeSyncInterval)
+	if syncInterval < 0 {
+		return fmt.Errorf("sync interval must be positive")
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.AddFederationServer(
+		ctxc, &unirpc.AddFederationServerRequest{
+			Servers: []*unirpc.UniverseFederationServer{
+				{
+					Host:    ctx.String(universeHostName),
+					TlsCert: tlsCert,
+					SyncIntervalSeconds: uint64(
+						syncInterval.Seconds(),
+					),
+					ProxyAddr: ctx.String(
+						universeProxyAddr,
+					),
+					ProxyUser: ctx.String(
+						universeProxyUser,
+					),
+					ProxyPassword: ctx.String(
+						universeProxyPassword,
+					),
+					Priority: int32(
+						ctx.Int(universePriority),
+					),
+				},
+			},
+			PushOnRegister: ctx.Bool(universePushOnRegister),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const universeServerID = "server_id"
+
+var universeFederationDelCommand = cli.Command{
+	Name:      "del",
+	ShortName: "d",
+	Description: `
+	Remove a server from the Federation. Servers can be identified either
+	via their ID, or the server host.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: universeHostName,
+			Usage: "the host:port or just host of the remote " +
+				"universe",
+		},
+		cli.IntFlag{
+			Name:  universeServerID,
+			Usage: "the ID of the universe server to delete",
+		},
+	},
+	Action: universeFederationDel,
+}
+
+func universeFederationDel(ctx *cli.Context) error {
+	switch {
+	case ctx.String(universeHostName) == "" &&
+		ctx.Int(universeServerID) == 0:
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.DeleteFederationServer(
+		ctxc, &unirpc.DeleteFederationServerRequest{
+			Servers: []*unirpc.UniverseFederationServer{
+				{
+					Id:   int32(ctx.Int(universeServerID)),
+					Host: ctx.String(universeHostName),
+				},
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeFederationSetPriorityCommand = cli.Command{
+	Name:  "set-priority",
+	Usage: "update the priority of a server",
+	Description: `
+	Update the priority of a server of the Federation, without removing
+	and re-adding it. Servers can be identified either via their ID, or
+	the server host. Servers with a higher priority are synced first, and
+	leaves synced from them are never replaced by diverging leaves synced
+	from servers with a lower or equal priority.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: universeHostName,
+			Usage: "the host:port or just host of the remote " +
+				"universe",
+		},
+		cli.IntFlag{
+			Name:  universeServerID,
+			Usage: "the ID of the universe server to update",
+		},
+		cli.IntFlag{
+			Name:  universePriority,
+			Usage: "the new priority of the server",
+		},
+	},
+	Action: universeFederationSetPriority,
+}
+
+func universeFederationSetPriority(ctx *cli.Context) error {
+	switch {
+	case ctx.String(universeHostName) == "" &&
+		ctx.Int(universeServerID) == 0:
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SetFederationServerPriority(
+		ctxc, &unirpc.SetFederationServerPriorityRequest{
+			Host:     ctx.String(universeHostName),
+			Id:       int32(ctx.Int(universeServerID)),
+			Priority: int32(ctx.Int(universePriority)),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeFederationCheckCommand = cli.Command{
+	Name:  "check",
+	Usage: "check the connectivity and sync status of a server",
+	Description: `
+	Probe a server of the Federation on demand. The server is dialed and
+	its universe roots are compared to the local roots. The resulting
+	connectivity and sync status is returned.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: universeHostName,
+			Usage: "the host:port or just host of the remote " +
+				"universe",
+		},
+	},
+	Action: universeFederationCheck,
+}
+
+func universeFederationCheck(ctx *cli.Context) error {
+	if ctx.String(universeHostName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.CheckFederationServer(
+		ctxc, &unirpc.CheckFederationServerRequest{
+			Host: ctx.String(universeHostName),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const (
+	maxConcurrencyName = "max_concurrency"
+
+	serverTimeoutName = "server_timeout"
+)
+
+var universeFederationRootsCommand = cli.Command{
+	Name:  "roots",
+	Usage: "list the universe roots of all servers of the Federation",
+	Description: `
+	Fetch the universe roots of all servers of the Federation in a single
+	call. The servers are queried concurrently, a server that can't be
+	reached or doesn't respond in time is reported with the error that
+	prevented its roots from being fetched.
+	`,
+	Flags: []cli.Flag{
+		cli.UintFlag{
+			Name: maxConcurrencyName,
+			Usage: "the maximum number of servers that are " +
+				"queried at the same time",
+		},
+		cli.UintFlag{
+			Name: serverTimeoutName,
+			Usage: "the time in seconds a single server has to " +
+				"return its roots",
+		},
+	},
+	Action: universeFederationRoots,
+}
+
+func universeFederationRoots(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListFederationRoots(
+		ctxc, &unirpc.ListFederationRootsRequest{
+			MaxConcurrency: uint32(ctx.Uint(maxConcurrencyName)),
+			ServerTimeoutSeconds: uint32(
+				ctx.Uint(serverTimeoutName),
+			),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeFederationConfigCommand = cli.Command{
+	Name:      "config",
+	ShortName: "c",
+	Usage:     "Change the sync behavior of the local Universe",
+	Description: `
+	Manage the sync behavior of the local Universe. These settings are
+	defined by the proof type (issuance or transfer), the sync behavior
+	(insert from remote Universe or export to remote Universe), and the
+	scope (all assets or specific assets).
+        `,
+	Subcommands: []cli.Command{
+		universeFederationGlobalConfig,
+		universeFederationLocalConfig,
+		universeFederationConfigInfo,
+	},
+}
+
+var (
+	universeConfigScope    = "config_scope"
+	proofInsertName        = "allow_insert"
+	proofExportName        = "allow_export"
+	universeSyncConfigArgs = []cli.Flag{
+		cli.StringFlag{
+			Name:  proofTypeName,
+			Usage: "the type of proof",
+		},
+		cli.StringFlag{
+			Name: proofInsertName,
+			Usage: "if true, remote Universes can push proofs to" +
+				"the local Universe",
+		},
+		cli.StringFlag{
+			Name: proofExportName,
+			Usage: "if true, remote Universes can pull proofs from" +
+				"the local Universe",
+		},
+	}
+)
+
+func isValidBool(arg string) (bool, error) {
+	// The cli.BoolFlag works for variables that are false by default, but
+	// here we don't want a default for this argument.
+	switch arg {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	default:
+		return false, fmt.Errorf("invalid bool flag")
+	}
+}
+
+func parseConfigArgs(ctx *cli.Context) (*bool, *bool, error) {
+	var (
+		insertOpt, exportOpt bool
+		err                  error
+	)
+
+	if !ctx.IsSet(proofInsertName) && !ctx.IsSet(proofExportName) {
+		return nil, nil, fmt.Errorf("either insert or export must be " +
+			"set")
+	}
+
+	// Parse the insert and export flags, which are not mutually exclusive.
+	if ctx.IsSet(proofInsertName) {
+		insertOpt, err = isValidBool(ctx.String(proofInsertName))
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid proof insert flag")
+		}
+	}
+
+	if ctx.IsSet(proofExportName) {
+		exportOpt, err = isValidBool(ctx.String(proofExportName))
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid proof export flag")
+		}
+	}
+
+	switch {
+	case ctx.IsSet(proofExportName) && ctx.IsSet(proofInsertName):
+		return &insertOpt, &exportOpt, nil
+
+	case ctx.IsSet(proofInsertName):
+		return &insertOpt, nil, nil
+
+	case ctx.IsSet(proofExportName):
+		return nil, &exportOpt, nil
+
+	default:
+		return nil, nil, nil
+	}
+}
+
+var universeFederationGlobalConfig = cli.Command{
+	Name:      "global",
+	ShortName: "g",
+	Usage:     "Change the global sync behavior of the local Universe",
+	Description: `
+	Manage the sync behavior of the local Universe that will apply to all
+	assets by default. Per-asset sync behavior will override global
+	settings. These settings are defined by the proof type (issuance or
+	transfer) and the sync behavior (insert from remote Universe or export
+	to remote Universe).
+        `,
+	Flags:  universeSyncConfigArgs,
+	Action: universeFederationUpdateGlobalConfig,
+}
+
+func universeFederationUpdateGlobalConfig(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	// There is no default proof type, so we need to make sure this
+	// flag is set.
+	if !ctx.IsSet(proofTypeName) {
+		return fmt.Errorf("must specify proof type")
+	}
+
+	rpcProofType, err := parseProofType(ctx)
+	if err != nil {
+		return err
+	}
+
+	insertOpt, exportOpt, err := parseConfigArgs(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Read the current global config for the matching proof type.
+	syncConfigs, err := client.QueryFederationSyncConfig(
+		ctxc, &unirpc.QueryFederationSyncConfigRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	// This should never occur, as we always have the default configs.
+	if syncConfigs.GlobalSyncConfigs == nil {
+		return fmt.Errorf("no global sync configs found")
+	}
+
+	currentConfig, err := fn.First(
+		syncConfigs.GlobalSyncConfigs,
+		func(cfg *unirpc.GlobalFederationSyncConfig) bool {
+			return cfg.ProofType == *rpcProofType
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("no existing config found")
+	}
+
+	// Modify the existing config and update it on disk.
+	if insertOpt != nil {
+		currentConfig.AllowSyncInsert = *insertOpt
+	}
+	if exportOpt != nil {
+		currentConfig.AllowSyncExport = *exportOpt
+	}
+
+	configReq := &unirpc.SetFederationSyncConfigRequest{
+		GlobalSyncConfigs: []*unirpc.GlobalFederationSyncConfig{
+			currentConfig,
+		},
+	}
+
+	resp, err := client.SetFederationSyncConfig(ctxc, configReq)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeFederationLocalConfig = cli.Command{
+	Name:      "local",
+	ShortName: "l",
+	Usage: "Change the sync behavior of the local Universe for a " +
+		"specific asset",
+	Description: `
+	Manage the sync behavior of the local Universe for a specific asset.
+	Local settings will override global settings. These settings are
+	defined by the proof type (issuance or transfer) and the sync behavior
+	(insert from remote Universe or export to remote Universe).
+        `,
+	Flags: append(universeSyncConfigArgs,
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the universe to configure",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the universe to configure",
+		}),
+	Action: universeFederationUpdateLocalConfig,
+}
+
+func universeFederationUpdateLocalConfig(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	universeID, err := parseUniverseID(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	if universeID == nil {
+		return fmt.Errorf("invalid universe ID")
+	}
+
+	insertOpt, exportOpt, err := parseConfigArgs(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Read the current local config for the matching Universe if it exists.
+	syncConfigs, err := client.QueryFederationSyncConfig(
+		ctxc, &unirpc.QueryFederationSyncConfigRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	var localConfig *unirpc.AssetFederationSyncConfig
+	switch {
+	case syncConfigs.AssetSyncConfigs == nil:
+		// Create a new config for this asset.
+		localConfig = &unirpc.AssetFederationSyncConfig{
+			Id: universeID,
+		}
+
+	default:
+		// We have some asset-specific configs, so search for one that
+		// matches the specified asset.
+		localConfig, err = fn.First(
+			syncConfigs.AssetSyncConfigs,
+			func(cfg *unirpc.AssetFederationSyncConfig) bool {
+				return cfg.Id == universeID
+			},
+		)
+
+		if err != nil {
+			// Create a new config for this asset.
+			localConfig = &unirpc.AssetFederationSyncConfig{
+				Id: universeID,
+			}
+		}
+	}
+
+	if insertOpt != nil {
+		localConfig.AllowSyncInsert = *insertOpt
+	}
+	if exportOpt != nil {
+		localConfig.AllowSyncExport = *exportOpt
+	}
+
+	configReq := &unirpc.SetFederationSyncConfigRequest{
+		AssetSyncConfigs: []*unirpc.AssetFederationSyncConfig{
+			localConfig,
+		},
+	}
+
+	resp, err := client.SetFederationSyncConfig(ctxc, configReq)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeFederationConfigInfo = cli.Command{
+	Name:      "info",
+	ShortName: "i",
+	Usage:     "Get the sync behavior of the local Universe",
+	Description: `
+        Get the sync behavior of the local Universe. These settings are
+        defined by the proof type (issuance or transfer), the sync behavior
+        (insert from remote Universe or export to remote Universe), and the
+        scope (all assets or specific assets).
+        `,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  universeConfigScope,
+			Usage: "the scope (global or local) of the config",
+		},
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the universe",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the universe",
+		},
+	},
+	Action: universeFederationGetConfigInfo,
+}
+
+func universeFederationGetConfigInfo(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	validScopes := []string{"global", "local"}
+	parseConfigScope := func(ctx *cli.Context) (string, error) {
+		if !ctx.IsSet(universeConfigScope) {
+			return "", fmt.Errorf("config scope not specified")
+		}
+
+		scope := ctx.String(universeConfigScope)
+		isValid := fn.Any(validScopes, func(s string) bool {
+			return s == scope
+		})
+
+		if !isValid {
+			return "", fmt.Errorf("invalid config scope")
+		}
+
+		return scope, nil
+	}
+
+	// Fetch all configs, and then filter passed on the flags set.
+	syncConfigs, err := client.QueryFederationSyncConfig(
+		ctxc, &unirpc.QueryFederationSyncConfigRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !ctx.IsSet(assetIDName) && !ctx.IsSet(groupKeyName) {
+		// The default scope is both global and local.
+		if !ctx.IsSet(universeConfigScope) {
+			printRespJSON(syncConfigs)
+			return nil
+		}
+
+		scope, err := parseConfigScope(ctx)
+		if err != nil {
+			return err
+		}
+
+		switch scope {
+		case "global":
+			for _, config := range syncConfigs.GlobalSyncConfigs {
+				config := config
+				printRespJSON(config)
+			}
+
+			return nil
+
+		case "local":
+			return fmt.Errorf("local scope requires " +
+				"universe ID fields")
+		}
+	}
+
+	// Match configs for both proof types for the specified Universe.
+	err = ctx.Set(proofTypeName, "issuance")
+	if err != nil {
+		return err
+	}
+
+	uniIdIssuance, err := parseUniverseID(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	err = ctx.Set(proofTypeName, "transfer")
+	if err != nil {
+		return err
+	}
+
+	uniIdTransfer, err := parseUniverseID(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	matchingLocalConfig := func(c *unirpc.AssetFederationSyncConfig) bool {
+		return c.Id == uniIdIssuance || c.Id == uniIdTransfer
+	}
+
+	printLocalConfig := func() error {
+		localConfig := fn.Filter(
+			syncConfigs.AssetSyncConfigs, matchingLocalConfig,
+		)
+		if len(localConfig) == 0 {
+			return fmt.Errorf("no matching universe configs found")
+		}
+
+		for _, config := range localConfig {
+			config := config
+			printRespJSON(config)
+		}
+
+		return nil
+	}
+
+	// If an asset was specified, the scope can be missing or local.
+	if !ctx.IsSet(universeConfigScope) {
+		return printLocalConfig()
+	}
+
+	scope, err := parseConfigScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	if scope == "global" {
+		return fmt.Errorf("cannot specify global scope and a specific" +
+			"asset")
+	}
+
+	return printLocalConfig()
+}
+
+var universeMultiverseRootCommand = cli.Command{
+	Name:  "multiverse",
+	Usage: "query for the multiverse root of the local Universe server",
+	Description: `
+	Query for the root of the multiverse tree, which commits to all the
+	universe roots of the given proof type known to the Universe server.
+	Two servers with the same universe state will return the same
+	multiverse root.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: proofTypeName,
+			Usage: "the type of proof to show the multiverse " +
+				"root for, either 'issuance' or 'transfer'",
+			Value: universe.ProofTypeIssuance.String(),
+		},
+		cli.StringFlag{
+			Name: verifyKeyName,
+			Usage: "if set, the signature of the multiverse root " +
+				"is verified against the given hex encoded " +
+				"identity key of the universe server",
+		},
+	},
+	Action: universeMultiverseRoot,
+}
+
+func universeMultiverseRoot(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	rpcProofType, err := parseProofType(ctx)
+	if err != nil {
+		return err
+	}
+
+	verifyKey, err := parseVerifyKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.MultiverseRoot(ctxc, &unirpc.MultiverseRootRequest{
+		ProofType: *rpcProofType,
+	})
+	if err != nil {
+		return err
+	}
+
+	if verifyKey != nil {
+		proofType, err := tap.UnmarshalUniProofType(*rpcProofType)
+		if err != nil {
+			return err
+		}
+
+		rootNode, err := unmarshalRootNode(resp.MultiverseRoot)
+		if err != nil {
+			return err
+		}
+
+		err = universe.VerifyRootSig(
+			universe.MultiverseRootMsg(proofType, rootNode),
+			resp.Signature, verifyKey,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to verify multiverse "+
+				"root: %w", err)
+		}
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeSnapshotCommand = cli.Command{
+	Name:  "snapshot",
+	Usage: "export a signed snapshot of an asset universe",
+	Description: `
+	Export a snapshot of the universe of an asset as it was at the given
+	block height. The snapshot commits to the root, sum and number of the
+	leaves confirmed at or before that height, as well as to the hash of
+	the block at that height, and is signed with the identity key of the
+	Universe server. This requires the server to sign roots.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the universe to export",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the universe to export",
+		},
+		cli.StringFlag{
+			Name: proofTypeName,
+			Usage: "the type of proof to export the universe " +
+				"for, either 'issuance' or 'transfer'",
+			Value: universe.ProofTypeIssuance.String(),
+		},
+		cli.Uint64Flag{
+			Name: atHeightName,
+			Usage: "the block height to create the snapshot at, " +
+				"defaults to the current best height",
+		},
+		cli.StringFlag{
+			Name: verifyKeyName,
+			Usage: "if set, the signature of the snapshot is " +
+				"verified against the given hex encoded " +
+				"identity key of the universe server",
+		},
+	},
+	Action: universeSnapshot,
+}
+
+func universeSnapshot(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	universeID, err := parseUniverseID(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	verifyKey, err := parseVerifyKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ExportUniverseSnapshot(
+		ctxc, &unirpc.UniverseSnapshotRequest{
+			Id:          universeID,
+			BlockHeight: uint32(ctx.Uint64(atHeightName)),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if verifyKey != nil {
+		err := verifyUniverseSnapshotSig(resp, verifyKey)
+		if err != nil {
+			return fmt.Errorf("unable to verify universe "+
+				"snapshot: %w", err)
+		}
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+// verifyUniverseSnapshotSig verifies that the given universe snapshot is signed
+// by the given identity key.
+func verifyUniverseSnapshotSig(resp *unirpc.UniverseSnapshotResponse,
+	verifyKey *btcec.PublicKey) error {
+
+	rpcSnapshot := resp.Snapshot
+	if rpcSnapshot == nil {
+		return fmt.Errorf("snapshot missing")
+	}
+
+	uniID, err := tap.UnmarshalUniID(rpcSnapshot.Id)
+	if err != nil {
+		return err
+	}
+
+	rootNode, err := unmarshalRootNode(rpcSnapshot.Root)
+	if err != nil {
+		return err
+	}
+
+	blockHash, err := chainhash.NewHashFromStr(rpcSnapshot.BlockHash)
+	if err != nil {
+		return fmt.Errorf("invalid block hash: %w", err)
+	}
+
+	return universe.VerifySnapshotSig(&universe.Snapshot{
+		ID:          uniID,
+		Root:        rootNode,
+		NumLeaves:   rpcSnapshot.NumLeaves,
+		BlockHeight: rpcSnapshot.BlockHeight,
+		BlockHash:   *blockHash,
+	}, resp.Signature, verifyKey)
+}
+
+var universeInfoCommand = cli.Command{
+	Name:      "info",
+	ShortName: "i",
+	Usage:     "query for info related to the active local Universe server",
+	Description: `
+	Query for information related to the local Universe server.
+	`,
+	Action: universeInfo,
+}
+
+func universeInfo(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.Info(ctxc, &unirpc.InfoRequest{})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeSupplyCommand = cli.Command{
+	Name:  "supply",
+	Usage: "query the total issued supply of an asset group",
+	Description: `
+	Query the total supply committed across all issuances of an asset
+	group, along with the number of issuance events. A zero supply is
+	returned for an unknown group.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the asset group to query for",
+		},
+	},
+	Action: universeSupply,
+}
+
+func universeSupply(ctx *cli.Context) error {
+	if ctx.String(groupKeyName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.QueryAssetSupply(
+		ctxc, &unirpc.QueryAssetSupplyRequest{
+			Group: &unirpc.QueryAssetSupplyRequest_GroupKeyStr{
+				GroupKeyStr: ctx.String(groupKeyName),
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeUtxosCommand = cli.Command{
+	Name:  "utxos",
+	Usage: "query the unspent outputs of an asset",
+	Description: `
+	Query the owner script keys and amounts of the current set of unspent
+	outputs of an asset or asset group. The set is derived from the
+	issuance and transfer leaves of the local universe, so it is only
+	complete if the universe knows about all transfers of the asset.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the asset to query for",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the asset group to query for",
+		},
+	},
+	Action: universeUtxos,
+}
+
+func universeUtxos(ctx *cli.Context) error {
+	var uniID *unirpc.ID
+	switch {
+	case ctx.IsSet(assetIDName) && ctx.IsSet(groupKeyName):
+		return fmt.Errorf("only asset_id or group_key can be set, " +
+			"not both")
+
+	case ctx.IsSet(assetIDName):
+		uniID = &unirpc.ID{
+			Id: &unirpc.ID_AssetIdStr{
+				AssetIdStr: ctx.String(assetIDName),
+			},
+		}
+
+	case ctx.IsSet(groupKeyName):
+		uniID = &unirpc.ID{
+			Id: &unirpc.ID_GroupKeyStr{
+				GroupKeyStr: ctx.String(groupKeyName),
+			},
+		}
+
+	default:
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.QueryAssetUtxos(
+		ctxc, &unirpc.QueryAssetUtxosRequest{
+			Id: uniID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeSearchCommand = cli.Command{
+	Name:  "search",
+	Usage: "search for the universe roots of assets by name",
+	Description: `
+	Search for the known universe roots of all assets with a name that
+	contains the given string. The name is matched case-insensitively and
+	the results are sorted by asset name. If the response contains a
+	non-zero next_offset, then more results can be fetched by passing it
+	as the --offset of the next call.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetTagName,
+			Usage: "the string the asset name must contain",
+		},
+		cli.Int64Flag{
+			Name: limitName,
+			Usage: "the maximum number of roots to return, " +
+				"defaults to 100",
+		},
+		cli.Int64Flag{
+			Name:  offsetName,
+			Usage: "the number of matching roots to skip",
+		},
+	},
+	Action: universeSearch,
+}
+
+func universeSearch(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SearchAssets(ctxc, &unirpc.SearchAssetsRequest{
+		Name:   ctx.String(assetTagName),
+		Offset: int32(ctx.Int64(offsetName)),
+		Limit:  int32(ctx.Int64(limitName)),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeGenesisCommand = cli.Command{
+	Name:  "genesis",
+	Usage: "query the genesis information of an asset",
+	Description: `
+	Query the genesis information (name, meta hash, type and genesis
+	outpoint) of an asset from the issuance leaves of its universe. If a
+	group key is given, then the genesis information of each asset within
+	the group is returned.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the asset to query for",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the asset group to query for",
+		},
+	},
+	Action: universeGenesis,
+}
+
+func universeGenesis(ctx *cli.Context) error {
+	var uniID *unirpc.ID
+	switch {
+	case ctx.IsSet(assetIDName) && ctx.IsSet(groupKeyName):
+		return fmt.Errorf("only asset_id or group_key can be set, " +
+			"not both")
+
+	case ctx.IsSet(assetIDName):
+		uniID = &unirpc.ID{
+			Id: &unirpc.ID_AssetIdStr{
+				AssetIdStr: ctx.String(assetIDName),
+			},
+		}
+
+	case ctx.IsSet(groupKeyName):
+		uniID = &unirpc.ID{
+			Id: &unirpc.ID_GroupKeyStr{
+				GroupKeyStr: ctx.String(groupKeyName),
+			},
+		}
+
+	default:
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.QueryAssetGenesis(
+		ctxc, &unirpc.QueryAssetGenesisRequest{
+			Id: uniID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const (
+	assetStatsName = "asset_stats"
+)
+
+var universeStatsCommand = cli.Command{
+	Name:      "stats",
+	ShortName: "s",
+	Usage:     "query for stats related to the active local Universe server",
+	Description: `
+	Query for a set of aggregate statistics related to the local Universe
+	server.  The 'universe stats asset' sub-command can be used to query
+	for stats for a given asset, asset name, or type.
+	`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  assetStatsName,
+			Usage: "include the stats of each known asset",
+		},
+	},
+	Action: universeStatsSummaryCommand,
+	Subcommands: []cli.Command{
+		universeAssetStatsCommand,
+		universeEventStatsCommand,
+	},
+}
+
+func universeStatsSummaryCommand(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.UniverseStats(ctxc, &unirpc.StatsRequest{
+		IncludeAssetStats: ctx.Bool(assetStatsName),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const (
+	assetName = "asset_name"
+
+	assetType = "asset_type"
+
+	sortByName = "sort_by"
+
+	startTime = "start_time"
+
+	endTime = "end_time"
+)
+
+var universeAssetStatsCommand = cli.Command{
+	Name:      "assets",
+	ShortName: "a",
+	Usage:     "query Universe stats for a single asset",
+	Description: `
+	Query for stats related to a given asset or series of assets identified
+	by the set of available filters. This command support pagination (via
+	the offset+limit) commands, and also allows for sorting by a given
+	filter value.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetName,
+			Usage: "the name of the asset to query for",
+		},
+		cli.StringFlag{
+			Name:  assetType,
+			Usage: "the type of the asset to query for",
+		},
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the asset to query for",
+		},
+		cli.StringFlag{
+			Name: sortByName,
+			Usage: "the name of the field to sort by, " +
+				"[--sort_by=asset_name|asset_type|asset_id]",
+		},
+		cli.Int64Flag{
+			Name:  limitName,
+			Usage: "the maximum number of results to return",
+		},
+		cli.Int64Flag{
+			Name:  offsetName,
+			Usage: "the offset to start returning results from",
+		},
+	},
+	Action: universeStatsQueryCommand,
+}
+
+func universeStatsQueryCommand(ctx *cli.Context) error {
+	ctxc := getContext()
 	client, cleanUp := getUniverseClient(ctx)
 	defer cleanUp()
 
-	validScopes := []string{"global", "local"}
-	parseConfigScope := func(ctx *cli.Context) (string, error) {
-		if !ctx.IsSet(universeConfigScope) {
-			return "", fmt.Errorf("config scope not specified")
+	// Validate the user is attempting to sort by the correct value
+	switch ctx.String(sortByName) {
+	case "asset_name":
+	case "asset_type":
+	case "asset_id":
+	default:
+		return fmt.Errorf("invalid sort_by value: %v",
+			ctx.String(sortByName))
+	}
+
+	var (
+		assetID []byte
+		err     error
+	)
+	if ctx.String(assetIDName) != "" {
+		assetID, err = hex.DecodeString(ctx.String(assetIDName))
+		if err != nil {
+			return fmt.Errorf("unable to decode asset id: %w", err)
 		}
+	}
 
-		scope := ctx.String(universeConfigScope)
-		isValid := fn.Any(validScopes, func(s string) bool {
-			return s == scope
-		})
+	resp, err := client.QueryAssetStats(ctxc, &unirpc.AssetStatsQuery{
+		AssetNameFilter: ctx.String(assetName),
+		AssetIdFilter:   assetID,
+		AssetTypeFilter: func() unirpc.AssetTypeFilter {
+			switch {
+			case ctx.String(assetTypeName) == "normal":
+				return unirpc.AssetTypeFilter_FILTER_ASSET_NORMAL
 
-		if !isValid {
-			return "", fmt.Errorf("invalid config scope")
-		}
+			case ctx.String(assetTypeName) == "collectible":
+				return unirpc.AssetTypeFilter_FILTER_ASSET_COLLECTIBLE
 
-		return scope, nil
+			default:
+				return unirpc.AssetTypeFilter_FILTER_ASSET_NONE
+			}
+		}(),
+		SortBy: func() unirpc.AssetQuerySort {
+			switch {
+			case ctx.String(sortByName) == "asset_name":
+				return unirpc.AssetQuerySort_SORT_BY_ASSET_NAME
+
+			case ctx.String(sortByName) == "asset_id":
+				return unirpc.AssetQuerySort_SORT_BY_ASSET_ID
+
+			case ctx.String(sortByName) == "asset_type":
+				return unirpc.AssetQuerySort_SORT_BY_ASSET_TYPE
+
+			default:
+				return unirpc.AssetQuerySort_SORT_BY_NONE
+			}
+		}(),
+		Limit:  int32(ctx.Int64(limitName)),
+		Offset: int32(ctx.Int64(offsetName)),
+	})
+	if err != nil {
+		return err
 	}
 
-	// Fetch all configs, and then filter passed on the flags set.
-	syncConfigs, err := client.QueryFederationSyncConfig(
-		ctxc, &unirpc.QueryFederationSyncConfigRequest{},
-	)
+	printRespJSON(resp)
+	return nil
+}
+
+var universeEventStatsCommand = cli.Command{
+	Name:      "events",
+	ShortName: "e",
+	Usage:     "query Universe event stats",
+	Description: `
+	Query for the number of sync and proof events for a given time
+    	period, grouped by day.
+	`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: startTime,
+			Usage: "(optional) the unix timestamp to start " +
+				"querying from; if not specified, will query " +
+				"from last 30 days by default",
+		},
+		cli.Int64Flag{
+			Name: offsetName,
+			Usage: "(optional) the unix timestamp to end " +
+				"querying from; if not specified, will query " +
+				"until now by default",
+		},
+	},
+	Action: universeEventStatsQueryCommand,
+}
+
+func universeEventStatsQueryCommand(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.QueryEvents(ctxc, &unirpc.QueryEventsRequest{
+		StartTimestamp: ctx.Int64(startTime),
+		EndTimestamp:   ctx.Int64(endTime),
+	})
 	if err != nil {
 		return err
 	}
 
-	if !ctx.IsSet(assetIDName) && !ctx.IsSet(groupKeyName) {
-		// The default scope is both global and local.
-		if !ctx.IsSet(universeConfigScope) {
-			printRespJSON(syncConfigs)
-			return nil
-		}
+	printRespJSON(resp)
+	return nil
+}
 
-		scope, err := parseConfigScope(ctx)
+const (
+	archiveFileName = "archive_file"
+
+	// archiveChunkSize is the maximum size of a single archive chunk that
+	// is sent to the Universe server.
+	archiveChunkSize = 1024 * 1024
+)
+
+var universeArchiveCommand = cli.Command{
+	Name:      "archive",
+	ShortName: "a",
+	Usage:     "export or import a portable Universe archive",
+	Description: `
+	Export the local Universe trees into a portable archive file, or import
+	such an archive into the local Universe server. This can be used to
+	back up a Universe server or to bootstrap a new one.
+	`,
+	Subcommands: []cli.Command{
+		universeArchiveExportCommand,
+		universeArchiveImportCommand,
+	},
+}
+
+var universeArchiveExportCommand = cli.Command{
+	Name:  "export",
+	Usage: "export the local Universe trees into an archive file",
+	Description: `
+	Export the roots, leaves and proofs of the local Universe trees into an
+	archive file. If an asset ID or group key is specified, then only the
+	universe of that asset is exported.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: archiveFileName,
+			Usage: "the file to write the archive to; use the " +
+				"dash character (-) to write to stdout",
+		},
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the universe to export",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the universe to export",
+		},
+		cli.StringFlag{
+			Name: proofTypeName,
+			Usage: "the type of proof of the universe to export, " +
+				"either 'issuance' or 'transfer'",
+			Value: universe.ProofTypeIssuance.String(),
+		},
+	},
+	Action: universeArchiveExport,
+}
+
+func universeArchiveExport(ctx *cli.Context) error {
+	if ctx.String(archiveFileName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	universeID, err := parseUniverseID(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	req := &unirpc.ExportUniverseRequest{}
+	if universeID != nil {
+		req.Ids = []*unirpc.ID{universeID}
+	}
+
+	stream, err := client.ExportUniverse(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	var archiveBytes []byte
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
 		if err != nil {
-			return err
+			return fmt.Errorf("unable to receive archive chunk: %w",
+				err)
 		}
 
-		switch scope {
-		case "global":
-			for _, config := range syncConfigs.GlobalSyncConfigs {
-				config := config
-				printRespJSON(config)
-			}
+		archiveBytes = append(archiveBytes, chunk.ChunkData...)
+	}
 
-			return nil
+	filePath := lncfg.CleanAndExpandPath(ctx.String(archiveFileName))
+	return writeToFile(filePath, archiveBytes)
+}
 
-		case "local":
-			return fmt.Errorf("local scope requires " +
-				"universe ID fields")
-		}
+var universeArchiveImportCommand = cli.Command{
+	Name:  "import",
+	Usage: "import an archive file into the local Universe server",
+	Description: `
+	Import an archive file that was created with 'universe archive export'.
+	The proof of each leaf is verified before it is inserted. Leaves that
+	are already known are skipped, so an archive can be imported into a
+	Universe server that already has some of its leaves.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: archiveFileName,
+			Usage: "the archive file to import; use the dash " +
+				"character (-) to read from stdin",
+		},
+	},
+	Action: universeArchiveImport,
+}
+
+func universeArchiveImport(ctx *cli.Context) error {
+	if ctx.String(archiveFileName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
 	}
 
-	// Match configs for both proof types for the specified Universe.
-	err = ctx.Set(proofTypeName, "issuance")
+	filePath := lncfg.CleanAndExpandPath(ctx.String(archiveFileName))
+	archiveBytes, err := readFile(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to read archive file: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	stream, err := client.ImportUniverse(ctxc)
 	if err != nil {
 		return err
 	}
 
-	uniIdIssuance, err := parseUniverseID(ctx, true)
-	if err != nil {
-		return err
+	for len(archiveBytes) > 0 {
+		chunkSize := archiveChunkSize
+		if len(archiveBytes) < chunkSize {
+			chunkSize = len(archiveBytes)
+		}
+
+		err := stream.Send(&unirpc.UniverseArchiveChunk{
+			ChunkData: archiveBytes[:chunkSize],
+		})
+		if err != nil {
+			return fmt.Errorf("unable to send archive chunk: %w",
+				err)
+		}
+
+		archiveBytes = archiveBytes[chunkSize:]
 	}
 
-	err = ctx.Set(proofTypeName, "transfer")
+	resp, err := stream.CloseAndRecv()
 	if err != nil {
 		return err
 	}
 
-	uniIdTransfer, err := parseUniverseID(ctx, true)
+	printRespJSON(resp)
+	return nil
+}
+
+const (
+	policyAllowName = "allow"
+
+	policyDenyName = "deny"
+
+	policyPushHostName = "host"
+)
+
+var universePolicyCommand = cli.Command{
+	Name:  "policy",
+	Usage: "manage the asset policy of the local Universe",
+	Description: `
+	Manage the policy that determines which assets the local Universe
+	accepts proofs for from remote parties, either through a federation
+	push or a proof insert, and which remote hosts it accepts pushed
+	proofs from. Proofs created locally are always accepted.
+	`,
+	Subcommands: []cli.Command{
+		universePolicyGetCommand,
+		universePolicySetCommand,
+		universePolicyGetPushCommand,
+		universePolicySetPushCommand,
+	},
+}
+
+var universePolicyGetCommand = cli.Command{
+	Name:        "get",
+	Usage:       "show the current asset policy",
+	Description: "Show the current asset policy of the local Universe",
+	Action:      universePolicyGet,
+}
+
+func universePolicyGet(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.QueryAssetPolicy(
+		ctxc, &unirpc.QueryAssetPolicyRequest{},
+	)
 	if err != nil {
 		return err
 	}
 
-	matchingLocalConfig := func(c *unirpc.AssetFederationSyncConfig) bool {
-		return c.Id == uniIdIssuance || c.Id == uniIdTransfer
-	}
+	printRespJSON(resp)
+	return nil
+}
 
-	printLocalConfig := func() error {
-		localConfig := fn.Filter(
-			syncConfigs.AssetSyncConfigs, matchingLocalConfig,
-		)
-		if len(localConfig) == 0 {
-			return fmt.Errorf("no matching universe configs found")
-		}
+var universePolicySetCommand = cli.Command{
+	Name:  "set",
+	Usage: "replace the current asset policy",
+	Description: `
+	Replace the current asset policy of the local Universe. If any assets
+	are allowed, proofs of all other assets are rejected. Denied assets
+	are always rejected. Calling the command without any flags accepts
+	proofs of all assets. The policy is reset to the configured policy
+	when the daemon restarts.
+	`,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: policyAllowName,
+			Usage: "the hex encoded asset ID or group key of an " +
+				"asset to allow; can be specified multiple " +
+				"times",
+		},
+		cli.StringSliceFlag{
+			Name: policyDenyName,
+			Usage: "the hex encoded asset ID or group key of an " +
+				"asset to deny; can be specified multiple " +
+				"times",
+		},
+	},
+	Action: universePolicySet,
+}
 
-		for _, config := range localConfig {
-			config := config
-			printRespJSON(config)
+// parsePolicyRules parses the given hex encoded asset IDs or group keys into
+// asset policy rules.
+func parsePolicyRules(ruleStrs []string) ([]*unirpc.AssetPolicyRule, error) {
+	rules := make([]*unirpc.AssetPolicyRule, 0, len(ruleStrs))
+	for _, ruleStr := range ruleStrs {
+		rule, err := universe.NewAssetPolicyRule(ruleStr)
+		if err != nil {
+			return nil, err
 		}
 
-		return nil
+		switch {
+		case rule.GroupKey != nil:
+			groupKey := rule.GroupKey.SerializeCompressed()
+			rules = append(rules, &unirpc.AssetPolicyRule{
+				Rule: &unirpc.AssetPolicyRule_GroupKey{
+					GroupKey: groupKey,
+				},
+			})
+
+		default:
+			rules = append(rules, &unirpc.AssetPolicyRule{
+				Rule: &unirpc.AssetPolicyRule_AssetId{
+					AssetId: rule.AssetID[:],
+				},
+			})
+		}
 	}
 
-	// If an asset was specified, the scope can be missing or local.
-	if !ctx.IsSet(universeConfigScope) {
-		return printLocalConfig()
+	return rules, nil
+}
+
+func universePolicySet(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	allowList, err := parsePolicyRules(ctx.StringSlice(policyAllowName))
+	if err != nil {
+		return err
 	}
 
-	scope, err := parseConfigScope(ctx)
+	denyList, err := parsePolicyRules(ctx.StringSlice(policyDenyName))
 	if err != nil {
 		return err
 	}
 
-	if scope == "global" {
-		return fmt.Errorf("cannot specify global scope and a specific" +
-			"asset")
+	resp, err := client.SetAssetPolicy(ctxc, &unirpc.SetAssetPolicyRequest{
+		Policy: &unirpc.AssetPolicy{
+			AllowList: allowList,
+			DenyList:  denyList,
+		},
+	})
+	if err != nil {
+		return err
 	}
 
-	return printLocalConfig()
+	printRespJSON(resp)
+	return nil
 }
 
-var universeInfoCommand = cli.Command{
-	Name:      "info",
-	ShortName: "i",
-	Usage:     "query for info related to the active local Universe server",
-	Description: `
-	Query for information related to the local Universe server.
-	`,
-	Action: universeInfo,
+var universePolicyGetPushCommand = cli.Command{
+	Name:  "getpush",
+	Usage: "show the hosts pushed proofs are accepted from",
+	Description: "Show the remote hosts the local Universe accepts pushed " +
+		"proofs from",
+	Action: universePolicyGetPush,
 }
 
-func universeInfo(ctx *cli.Context) error {
+func universePolicyGetPush(ctx *cli.Context) error {
 	ctxc := getContext()
 	client, cleanUp := getUniverseClient(ctx)
 	defer cleanUp()
 
-	resp, err := client.Info(ctxc, &unirpc.InfoRequest{})
+	resp, err := client.QueryAcceptPushFrom(
+		ctxc, &unirpc.QueryAcceptPushFromRequest{},
+	)
 	if err != nil {
 		return err
 	}
@@ -1168,28 +3378,37 @@ func universeInfo(ctx *cli.Context) error {
 	return nil
 }
 
-var universeStatsCommand = cli.Command{
-	Name:      "stats",
-	ShortName: "s",
-	Usage:     "query for stats related to the active local Universe server",
+var universePolicySetPushCommand = cli.Command{
+	Name:  "setpush",
+	Usage: "replace the hosts pushed proofs are accepted from",
 	Description: `
-	Query for a set of aggregate statistics related to the local Universe
-	server.  The 'universe stats asset' sub-command can be used to query
-	for stats for a given asset, asset name, or type.
+	Replace the list of remote hosts the local Universe accepts pushed
+	proofs from. Pushes from all other hosts are rejected, even if they're
+	federation servers the local Universe syncs from. Calling the command
+	without any hosts accepts pushes from all hosts. The list is reset to
+	the configured list when the daemon restarts.
 	`,
-	Action: universeStatsSummaryCommand,
-	Subcommands: []cli.Command{
-		universeAssetStatsCommand,
-		universeEventStatsCommand,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: policyPushHostName,
+			Usage: "the IP address or host name of a host to " +
+				"accept pushes from; can be specified multiple " +
+				"times",
+		},
 	},
+	Action: universePolicySetPush,
 }
 
-func universeStatsSummaryCommand(ctx *cli.Context) error {
+func universePolicySetPush(ctx *cli.Context) error {
 	ctxc := getContext()
 	client, cleanUp := getUniverseClient(ctx)
 	defer cleanUp()
 
-	resp, err := client.UniverseStats(ctxc, &unirpc.StatsRequest{})
+	resp, err := client.SetAcceptPushFrom(
+		ctxc, &unirpc.SetAcceptPushFromRequest{
+			Hosts: ctx.StringSlice(policyPushHostName),
+		},
+	)
 	if err != nil {
 		return err
 	}
@@ -1199,116 +3418,84 @@ func universeStatsSummaryCommand(ctx *cli.Context) error {
 }
 
 const (
-	assetName = "asset_name"
+	mailboxAuthTokenName = "auth_token"
+)
 
-	assetType = "asset_type"
+// mailboxAuthTokenFlag is the flag of the bearer token that authorizes
+// requests to a proof courier mailbox that requires one.
+var mailboxAuthTokenFlag = cli.StringFlag{
+	Name: mailboxAuthTokenName,
+	Usage: "the bearer token to authorize the request with, if the " +
+		"mailbox requires one",
+}
 
-	sortByName = "sort_by"
+// withMailboxAuthToken adds the bearer token given on the command line, if
+// any, to the outgoing metadata of the given context.
+func withMailboxAuthToken(ctx *cli.Context,
+	ctxc context.Context) context.Context {
 
-	startTime = "start_time"
+	if ctx.String(mailboxAuthTokenName) == "" {
+		return ctxc
+	}
 
-	endTime = "end_time"
-)
+	return metadata.AppendToOutgoingContext(
+		ctxc, "authorization",
+		"Bearer "+ctx.String(mailboxAuthTokenName),
+	)
+}
 
-var universeAssetStatsCommand = cli.Command{
-	Name:      "assets",
-	ShortName: "a",
-	Usage:     "query Universe stats for a single asset",
+var universeMailboxCommand = cli.Command{
+	Name:  "mailbox",
+	Usage: "upload or fetch proofs of a proof courier mailbox",
 	Description: `
-	Query for stats related to a given asset or series of assets identified
-	by the set of available filters. This command support pagination (via
-	the offset+limit) commands, and also allows for sorting by a given
-	filter value.
+	Interact with the proof courier mailbox of a tapd node. The sender of
+	an asset can upload the proof file to the mailbox, where the receiver
+	can fetch it later using the script key of the asset. This allows a
+	transfer to complete without both nodes being online at the same time.
+	`,
+	Subcommands: []cli.Command{
+		universeMailboxUploadCommand,
+		universeMailboxFetchCommand,
+	},
+}
+
+var universeMailboxUploadCommand = cli.Command{
+	Name:  "upload",
+	Usage: "upload a proof file to the mailbox",
+	Description: `
+	Upload a proof file to the mailbox. The proof file is stored under the
+	script key of the asset of its last proof.
 	`,
 	Flags: []cli.Flag{
 		cli.StringFlag{
-			Name:  assetName,
-			Usage: "the name of the asset to query for",
-		},
-		cli.StringFlag{
-			Name:  assetType,
-			Usage: "the type of the asset to query for",
-		},
-		cli.StringFlag{
-			Name:  assetIDName,
-			Usage: "the asset ID of the asset to query for",
-		},
-		cli.StringFlag{
-			Name: sortByName,
-			Usage: "the name of the field to sort by, " +
-				"[--sort_by=asset_name|asset_type|asset_id]",
-		},
-		cli.Int64Flag{
-			Name:  limitName,
-			Usage: "the maximum number of results to return",
-		},
-		cli.Int64Flag{
-			Name:  offsetName,
-			Usage: "the offset to start returning results from",
+			Name:  proofPathName,
+			Usage: "the path to the proof file to upload",
 		},
+		mailboxAuthTokenFlag,
 	},
-	Action: universeStatsQueryCommand,
+	Action: universeMailboxUpload,
 }
 
-func universeStatsQueryCommand(ctx *cli.Context) error {
-	ctxc := getContext()
-	client, cleanUp := getUniverseClient(ctx)
-	defer cleanUp()
-
-	// Validate the user is attempting to sort by the correct value
-	switch ctx.String(sortByName) {
-	case "asset_name":
-	case "asset_type":
-	case "asset_id":
-	default:
-		return fmt.Errorf("invalid sort_by value: %v",
-			ctx.String(sortByName))
+func universeMailboxUpload(ctx *cli.Context) error {
+	if ctx.String(proofPathName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
 	}
 
-	var (
-		assetID []byte
-		err     error
-	)
-	if ctx.String(assetIDName) != "" {
-		assetID, err = hex.DecodeString(ctx.String(assetIDName))
-		if err != nil {
-			return fmt.Errorf("unable to decode asset id: %w", err)
-		}
+	filePath := lncfg.CleanAndExpandPath(ctx.String(proofPathName))
+	rawFile, err := readFile(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to read proof file: %w", err)
 	}
 
-	resp, err := client.QueryAssetStats(ctxc, &unirpc.AssetStatsQuery{
-		AssetNameFilter: ctx.String(assetName),
-		AssetIdFilter:   assetID,
-		AssetTypeFilter: func() unirpc.AssetTypeFilter {
-			switch {
-			case ctx.String(assetTypeName) == "normal":
-				return unirpc.AssetTypeFilter_FILTER_ASSET_NORMAL
-
-			case ctx.String(assetTypeName) == "collectible":
-				return unirpc.AssetTypeFilter_FILTER_ASSET_COLLECTIBLE
-
-			default:
-				return unirpc.AssetTypeFilter_FILTER_ASSET_NONE
-			}
-		}(),
-		SortBy: func() unirpc.AssetQuerySort {
-			switch {
-			case ctx.String(sortByName) == "asset_name":
-				return unirpc.AssetQuerySort_SORT_BY_ASSET_NAME
-
-			case ctx.String(sortByName) == "asset_id":
-				return unirpc.AssetQuerySort_SORT_BY_ASSET_ID
-
-			case ctx.String(sortByName) == "asset_type":
-				return unirpc.AssetQuerySort_SORT_BY_ASSET_TYPE
+	ctxc := withMailboxAuthToken(ctx, getContext())
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
 
-			default:
-				return unirpc.AssetQuerySort_SORT_BY_NONE
-			}
-		}(),
-		Limit:  int32(ctx.Int64(limitName)),
-		Offset: int32(ctx.Int64(offsetName)),
-	})
+	resp, err := client.UploadMailboxProof(
+		ctxc, &unirpc.UploadMailboxProofRequest{
+			RawProofFile: rawFile,
+		},
+	)
 	if err != nil {
 		return err
 	}
@@ -1317,40 +3504,40 @@ func universeStatsQueryCommand(ctx *cli.Context) error {
 	return nil
 }
 
-var universeEventStatsCommand = cli.Command{
-	Name:      "events",
-	ShortName: "e",
-	Usage:     "query Universe event stats",
+var universeMailboxFetchCommand = cli.Command{
+	Name:  "fetch",
+	Usage: "fetch the proof files uploaded for a script key",
 	Description: `
-	Query for the number of sync and proof events for a given time
-    	period, grouped by day.
+	Fetch all proof files that were uploaded to the mailbox for the given
+	script key and didn't expire yet.
 	`,
 	Flags: []cli.Flag{
-		cli.Int64Flag{
-			Name: startTime,
-			Usage: "(optional) the unix timestamp to start " +
-				"querying from; if not specified, will query " +
-				"from last 30 days by default",
-		},
-		cli.Int64Flag{
-			Name: offsetName,
-			Usage: "(optional) the unix timestamp to end " +
-				"querying from; if not specified, will query " +
-				"until now by default",
+		cli.StringFlag{
+			Name: scriptKeyName,
+			Usage: "the script key to fetch the proof files for, " +
+				"hex encoded",
 		},
+		mailboxAuthTokenFlag,
 	},
-	Action: universeEventStatsQueryCommand,
+	Action: universeMailboxFetch,
 }
 
-func universeEventStatsQueryCommand(ctx *cli.Context) error {
-	ctxc := getContext()
+func universeMailboxFetch(ctx *cli.Context) error {
+	if ctx.String(scriptKeyName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := withMailboxAuthToken(ctx, getContext())
 	client, cleanUp := getUniverseClient(ctx)
 	defer cleanUp()
 
-	resp, err := client.QueryEvents(ctxc, &unirpc.QueryEventsRequest{
-		StartTimestamp: ctx.Int64(startTime),
-		EndTimestamp:   ctx.Int64(endTime),
-	})
+	resp, err := client.FetchMailboxProofs(
+		ctxc, &unirpc.FetchMailboxProofsRequest{
+			Key: &unirpc.FetchMailboxProofsRequest_ScriptKeyStr{
+				ScriptKeyStr: ctx.String(scriptKeyName),
+			},
+		},
+	)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/monitoring"
 	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/rpcperms"
 	"github.com/lightninglabs/taproot-assets/tapdb"
 	"github.com/lightninglabs/taproot-assets/tapfreighter"
 	"github.com/lightninglabs/taproot-assets/tapgarden"
@@ -35,6 +36,11 @@ type RPCConfig struct {
 
 	RestListenFunc func(net.Addr) (net.Listener, error)
 
+	// ReloadTLS is an optional function that reloads the TLS certificate
+	// and key from disk, for all new gRPC and REST connections. If set,
+	// it is called whenever the daemon receives a SIGHUP.
+	ReloadTLS func() error
+
 	WSPingInterval time.Duration
 
 	WSPongWait time.Duration
@@ -56,6 +62,10 @@ type RPCConfig struct {
 	LetsEncryptDomain string
 
 	LetsEncryptEmail string
+
+	// UniverseRateLimits is the optional config of the per peer rate
+	// limits that are enforced on the Universe RPCs.
+	UniverseRateLimits *rpcperms.RateLimiterConfig
 }
 
 // DatabaseConfig is the config that holds all the persistence related structs
@@ -72,6 +82,9 @@ type DatabaseConfig struct {
 	Multiverse *tapdb.MultiverseStore
 
 	FederationDB *tapdb.UniverseFederationDB
+
+	// DBCompactor is used to compact the universe store of the database.
+	DBCompactor tapdb.DBCompactor
 }
 
 // Config is the main config of the Taproot Assets server.
@@ -94,6 +107,10 @@ type Config struct {
 
 	AssetMinter tapgarden.Planter
 
+	// EmissionScheduler issues the additional units of the emission
+	// schedules of minted assets once their block height is reached.
+	EmissionScheduler *tapgarden.EmissionScheduler
+
 	AssetCustodian *tapgarden.Custodian
 
 	ChainBridge tapgarden.ChainBridge
@@ -104,6 +121,16 @@ type Config struct {
 
 	ProofArchive proof.Archiver
 
+	// ProofMailbox is the proof courier mailbox that other nodes can
+	// upload proofs to for their receivers to download later. This is nil
+	// if the mailbox is disabled.
+	ProofMailbox *proof.Mailbox
+
+	// LightningProofReceiver reassembles the proofs that are delivered to
+	// the local Lightning node inside the custom records of keysend
+	// payments. This is nil if no proof courier is configured.
+	LightningProofReceiver *proof.LightningProofReceiver
+
 	AssetWallet tapfreighter.Wallet
 
 	CoinSelect *tapfreighter.CoinSelect
@@ -116,14 +143,53 @@ type Config struct {
 
 	UniverseFederation *universe.FederationEnvoy
 
+	// UniverseReOrgWatcher removes leaves synced from or pushed by remote
+	// universe servers from the local universe trees if their anchor
+	// transaction is re-organized out of the chain.
+	UniverseReOrgWatcher *universe.LeafReOrgWatcher
+
+	// SpentLeafPruner prunes the proofs of deeply confirmed spent transfer
+	// leaves from the local universe trees. This is nil if no retention
+	// policy is set.
+	SpentLeafPruner *universe.SpentLeafPruner
+
+	// SpentLeafRetention is the number of confirmations after which spent
+	// transfer leaves are pruned. Zero means the full transfer history is
+	// kept.
+	SpentLeafRetention uint32
+
 	UniverseStats universe.Telemetry
 
+	// UniverseProxy is the optional SOCKS5 proxy that's used to connect to
+	// remote universe servers that don't have a server specific proxy set.
+	UniverseProxy *universe.ProxyConfig
+
 	// UniversePublicAccess is flag which, If true, and the Universe server
 	// is on a public interface, valid proof from remote parties will be
 	// accepted, and proofs will be queryable by remote parties.
 	// This applies to federation syncing as well as RPC insert and query.
 	UniversePublicAccess bool
 
+	// UniverseReadOnly is a flag which, if true, disables all RPCs that
+	// mutate the local universe or minting state, such as minting, proof
+	// insertion and federation changes. Queries and outbound syncs are
+	// still served.
+	UniverseReadOnly bool
+
+	// ProofMinConfs is the minimum number of confirmations the anchor
+	// transaction of a proof must have before it's accepted from a remote
+	// party or imported.
+	ProofMinConfs uint32
+
+	// UniverseRootSigner is the optional signer that's used to sign the
+	// multiverse roots served by the universe server. If nil, roots are
+	// served without a signature.
+	UniverseRootSigner universe.RootSigner
+
+	// UniverseSignAssetRoots is a flag which, if true, also signs the
+	// per-asset universe roots with the UniverseRootSigner.
+	UniverseSignAssetRoots bool
+
 	Prometheus monitoring.PrometheusConfig
 
 	// LogWriter is the root logger that all of the daemon's subloggers are
@@ -32,3 +32,24 @@ func ParSlice[V any](ctx context.Context, s []V, f ErrFunc[V]) error {
 
 	return errGroup.Wait()
 }
+
+// ParSliceLimit is like ParSlice, but limits the number of active goroutines
+// to the given limit instead of the number of CPUs. A limit of zero or less
+// means that the number of active goroutines isn't limited.
+func ParSliceLimit[V any](ctx context.Context, limit int, s []V,
+	f ErrFunc[V]) error {
+
+	errGroup, ctx := errgroup.WithContext(ctx)
+	if limit > 0 {
+		errGroup.SetLimit(limit)
+	}
+
+	for _, v := range s {
+		v := v
+		errGroup.Go(func() error {
+			return f(ctx, v)
+		})
+	}
+
+	return errGroup.Wait()
+}
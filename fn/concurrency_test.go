@@ -3,7 +3,9 @@ package fn
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -57,3 +59,33 @@ func TestParSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestParSliceLimit(t *testing.T) {
+	t.Parallel()
+
+	const limit = 3
+
+	var active, maxActive atomic.Int32
+	trackActive := func(ctx context.Context, _ int) error {
+		numActive := active.Add(1)
+		defer active.Add(-1)
+
+		for {
+			current := maxActive.Load()
+			if numActive <= current ||
+				maxActive.CompareAndSwap(current, numActive) {
+
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	}
+
+	values := make([]int, 20)
+	err := ParSliceLimit(context.TODO(), limit, values, trackActive)
+	require.NoError(t, err)
+	require.LessOrEqual(t, maxActive.Load(), int32(limit))
+	require.Positive(t, maxActive.Load())
+}
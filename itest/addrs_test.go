@@ -11,6 +11,8 @@ import (
 	"github.com/lightninglabs/taproot-assets/taprpc/tapdevrpc"
 	"github.com/lightningnetwork/lnd/lntest/wait"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // testAddresses tests the various RPC calls related to addresses.
@@ -142,6 +144,34 @@ func testAddresses(t *harnessTest) {
 		)
 		require.NoError(t.t, err)
 		require.True(t.t, verifyResp.ValidProof)
+
+		// Only the receiver holds the asset, so exporting the proof
+		// with the owned only flag should only work on its node.
+		exportReq := &taprpc.ExportProofRequest{
+			AssetId:   receiverAddr.AssetId,
+			ScriptKey: receiverAddr.ScriptKey,
+			OwnedOnly: true,
+		}
+		exportResp, err := secondTapd.ExportProof(ctxt, exportReq)
+		require.NoError(t.t, err)
+
+		verifyProofResp, err := t.tapd.VerifyProof(
+			ctxt, &taprpc.ProofFile{
+				RawProofFile: exportResp.RawProofFile,
+			},
+		)
+		require.NoError(t.t, err)
+		require.True(t.t, verifyProofResp.Valid)
+
+		_, err = t.tapd.ExportProof(ctxt, exportReq)
+		require.Equal(t.t, codes.FailedPrecondition, status.Code(err))
+
+		// The sender doesn't own the script key of the receiver, so
+		// it can't claim the asset by importing its proof.
+		_, err = t.tapd.ImportProof(ctxt, &taprpc.ImportProofRequest{
+			RawProofFile: exportResp.RawProofFile,
+		})
+		require.Equal(t.t, codes.FailedPrecondition, status.Code(err))
 	}
 }
 
@@ -309,10 +339,12 @@ func sendProof(t *harnessTest, src, dst *tapdHarness, scriptKey []byte,
 
 	t.Logf("Importing proof %x", proofResp.RawProofFile)
 
-	importResp, err := dst.ImportProof(ctxb, &tapdevrpc.ImportProofRequest{
-		ProofFile:    proofResp.RawProofFile,
-		GenesisPoint: genInfo.GenesisPoint,
-	})
+	importResp, err := dst.TapDevClient.ImportProof(
+		ctxb, &tapdevrpc.ImportProofRequest{
+			ProofFile:    proofResp.RawProofFile,
+			GenesisPoint: genInfo.GenesisPoint,
+		},
+	)
 	require.NoError(t.t, err)
 
 	return importResp
@@ -56,6 +56,12 @@ func AssetTypeCheck(assetType taprpc.AssetType) AssetCheck {
 				"wanted %v", a.AssetType, assetType)
 		}
 
+		if a.AssetGenesis.AssetType != assetType {
+			return fmt.Errorf("unexpected asset genesis type, "+
+				"got %v wanted %v", a.AssetGenesis.AssetType,
+				assetType)
+		}
+
 		return nil
 	}
 }
@@ -431,6 +437,12 @@ func VerifyProofBlob(t *testing.T, tapClient taprpc.TaprootAssetsClient,
 	require.NoError(t, err)
 	require.True(t, verifyResp.Valid)
 
+	// Each proof in the file should have been verified individually.
+	require.Len(t, verifyResp.StepResults, f.NumProofs())
+	for _, stepResult := range verifyResp.StepResults {
+		require.True(t, stepResult.Valid)
+	}
+
 	// Also make sure that the RPC can decode the proof as well.
 	decodeResp, err := tapClient.DecodeProof(
 		ctxt, &taprpc.DecodeProofRequest{
@@ -832,6 +844,7 @@ func AssertAssetGenesis(t *testing.T, expected, actual *taprpc.GenesisInfo) {
 	require.Equal(t, expected.AssetId, actual.AssetId)
 	require.Equal(t, expected.OutputIndex, actual.OutputIndex)
 	require.Equal(t, expected.Version, actual.Version)
+	require.Equal(t, expected.AssetType, actual.AssetType)
 }
 
 // AssertBalanceByID asserts that the balance of a single asset,
@@ -1166,19 +1179,52 @@ func AssertUniverseStateEqual(t *testing.T, a, b unirpc.UniverseClient) bool {
 	return AssertUniverseRootsEqual(rootsA, rootsB)
 }
 
+// AssertMultiverseRootEqual asserts that the multiverse roots of the given
+// proof type are equal for both Universe servers.
+func AssertMultiverseRootEqual(t *testing.T, a, b unirpc.UniverseClient,
+	proofType unirpc.ProofType) {
+
+	ctxb := context.Background()
+	req := &unirpc.MultiverseRootRequest{
+		ProofType: proofType,
+	}
+
+	rootA, err := a.MultiverseRoot(ctxb, req)
+	require.NoError(t, err)
+
+	rootB, err := b.MultiverseRoot(ctxb, req)
+	require.NoError(t, err)
+
+	require.Equal(
+		t, rootA.MultiverseRoot.RootHash, rootB.MultiverseRoot.RootHash,
+	)
+	require.Equal(
+		t, rootA.MultiverseRoot.RootSum, rootB.MultiverseRoot.RootSum,
+	)
+}
+
 func AssertUniverseLeavesEqual(t *testing.T, uniIDs []*unirpc.ID,
 	a, b unirpc.UniverseClient) {
 
 	for _, uniID := range uniIDs {
-		aLeaves, err := a.AssetLeaves(context.Background(), uniID)
+		req := &unirpc.AssetLeavesRequest{
+			Id:            uniID,
+			IncludeAssets: true,
+		}
+		aLeaves, err := a.AssetLeaves(context.Background(), req)
 		require.NoError(t, err)
 
-		bLeaves, err := b.AssetLeaves(context.Background(), uniID)
+		bLeaves, err := b.AssetLeaves(context.Background(), req)
 		require.NoError(t, err)
 
 		require.Equal(t, len(aLeaves.Leaves), len(bLeaves.Leaves))
 
 		for i := 0; i < len(aLeaves.Leaves); i++ {
+			require.Equal(
+				t, aLeaves.Leaves[i].LeafKey,
+				bLeaves.Leaves[i].LeafKey,
+			)
+
 			require.Equal(
 				t, aLeaves.Leaves[i].Asset,
 				bLeaves.Leaves[i].Asset,
@@ -1307,7 +1353,6 @@ func VerifyGroupAnchor(t *testing.T, assets []*taprpc.Asset,
 	require.NoError(t, err)
 
 	anchorGen := ParseGenInfo(t, anchor.AssetGenesis)
-	anchorGen.Type = asset.Type(anchor.AssetType)
 	AssertGroupAnchor(t, anchorGen, anchor.AssetGroup)
 
 	return anchor
@@ -1351,6 +1396,7 @@ func AssertAssetsMinted(t *testing.T,
 			AssetAnchorCheck(mintTXID, blockHash),
 			AssetScriptKeyIsLocalCheck(true),
 			AssetVersionCheck(assetRequest.Asset.AssetVersion),
+			AssetTypeCheck(assetRequest.Asset.AssetType),
 			func(a *taprpc.Asset) error {
 				anchor := a.ChainAnchor
 
@@ -158,10 +158,12 @@ func transferAssetProofs(t *harnessTest, src, dst *tapdHarness,
 		proofFile := AssertAssetProofs(
 			t.t, src, chainClient, existingAsset,
 		)
-		_, err := dst.ImportProof(ctxt, &tapdevrpc.ImportProofRequest{
-			ProofFile:    proofFile,
-			GenesisPoint: gen.GenesisPoint,
-		})
+		_, err := dst.TapDevClient.ImportProof(
+			ctxt, &tapdevrpc.ImportProofRequest{
+				ProofFile:    proofFile,
+				GenesisPoint: gen.GenesisPoint,
+			},
+		)
 		require.NoError(t.t, err)
 	}
 
@@ -11,6 +11,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/taprpc"
 	wrpc "github.com/lightninglabs/taproot-assets/taprpc/assetwalletrpc"
 	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
+	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	"github.com/stretchr/testify/require"
 )
 
@@ -300,4 +301,67 @@ func testBurnAssets(t *harnessTest) {
 		simpleGroupCollectGen.AssetId, []uint64{1}, 6, 7, 1, true,
 	)
 	AssertBalanceByID(t.t, t.tapd, simpleGroupCollectGen.AssetId, 0)
+
+	// The burns of the grouped assets were registered with the universe,
+	// which reduces the supply of both groups. A second node that syncs
+	// the full universe from us should see the reduced supply as well.
+	secondTapd := setupTapdHarness(
+		t.t, t, t.lndHarness.Bob, t.universeServer,
+	)
+	defer func() {
+		require.NoError(t.t, secondTapd.stop(!*noDelete))
+	}()
+
+	_, err = secondTapd.SyncUniverse(ctxt, &unirpc.SyncRequest{
+		UniverseHost: t.tapd.rpcHost(),
+		SyncMode:     unirpc.UniverseSyncMode_SYNC_FULL,
+	})
+	require.NoError(t.t, err)
+
+	for _, node := range []*tapdHarness{t.tapd, secondTapd} {
+		assertBurnedGroupSupply(
+			t, node, simpleGroup, simpleGroup.Amount-burnAmt,
+			burnAmt,
+		)
+		assertBurnedGroupSupply(
+			t, node, simpleGroupCollect, 0,
+			simpleGroupCollect.Amount,
+		)
+	}
+}
+
+// assertBurnedGroupSupply asserts that the given node reports the expected
+// remaining and burned supply for the group of the given asset, and that the
+// burn root of the group's transfer universe commits to the burned supply.
+func assertBurnedGroupSupply(t *harnessTest, node *tapdHarness,
+	groupAsset *taprpc.Asset, totalSupply, burnedSupply uint64) {
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	groupKey := groupAsset.AssetGroup.TweakedGroupKey
+	supply, err := node.QueryAssetSupply(
+		ctxt, &unirpc.QueryAssetSupplyRequest{
+			Group: &unirpc.QueryAssetSupplyRequest_GroupKey{
+				GroupKey: groupKey,
+			},
+		},
+	)
+	require.NoError(t.t, err)
+	require.Equal(t.t, totalSupply, supply.TotalSupply)
+	require.Equal(t.t, burnedSupply, supply.BurnedSupply)
+
+	roots, err := node.QueryAssetRoots(ctxt, &unirpc.AssetRootQuery{
+		Id: &unirpc.ID{
+			Id: &unirpc.ID_GroupKey{
+				GroupKey: groupKey,
+			},
+		},
+	})
+	require.NoError(t.t, err)
+	require.NotNil(t.t, roots.TransferRoot.BurnRoot)
+	require.EqualValues(
+		t.t, burnedSupply, roots.TransferRoot.BurnRoot.RootSum,
+	)
 }
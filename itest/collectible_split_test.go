@@ -309,7 +309,10 @@ func testCollectibleGroupSend(t *harnessTest) {
 		},
 		ProofType: unirpc.ProofType_PROOF_TYPE_ISSUANCE,
 	}
-	uniLeaves, err := t.tapd.AssetLeaves(ctxb, &collectUniID)
+	uniLeaves, err := t.tapd.AssetLeaves(ctxb, &unirpc.AssetLeavesRequest{
+		Id:            &collectUniID,
+		IncludeAssets: true,
+	})
 	require.NoError(t.t, err)
 	require.Len(t.t, uniLeaves.Leaves, batchSize)
 
@@ -325,6 +328,37 @@ func testCollectibleGroupSend(t *harnessTest) {
 	})
 	require.True(t.t, correctOp)
 
+	// Paging through the leaves without their assets should return the
+	// same leaf keys in the same order.
+	const pageSize = 2
+	var pagedLeaves []*unirpc.AssetLeaf
+	for offset := int32(0); ; {
+		req := &unirpc.AssetLeavesRequest{
+			Id:     &collectUniID,
+			Offset: offset,
+			Limit:  pageSize,
+		}
+		page, err := t.tapd.AssetLeaves(ctxb, req)
+		require.NoError(t.t, err)
+		require.EqualValues(t.t, batchSize, page.TotalCount)
+
+		for _, leaf := range page.Leaves {
+			require.Nil(t.t, leaf.Asset)
+			require.Empty(t.t, leaf.IssuanceProof)
+		}
+		pagedLeaves = append(pagedLeaves, page.Leaves...)
+
+		if page.NextOffset == 0 {
+			break
+		}
+		offset = page.NextOffset
+	}
+	require.Len(t.t, pagedLeaves, batchSize)
+	for i, leaf := range pagedLeaves {
+		require.Equal(t.t, uniLeaves.Leaves[i].LeafKey, leaf.LeafKey)
+		require.Equal(t.t, uniLeaves.Leaves[i].Amount, leaf.Amount)
+	}
+
 	secondTapd := setupTapdHarness(
 		t.t, t, t.lndHarness.Bob, t.universeServer,
 	)
@@ -139,7 +139,10 @@ func mintTest(t *testing.T, ctx context.Context, cfg *Config) {
 		},
 		ProofType: unirpc.ProofType_PROOF_TYPE_ISSUANCE,
 	}
-	uniLeaves, err := alice.AssetLeaves(ctx, &collectUniID)
+	uniLeaves, err := alice.AssetLeaves(ctx, &unirpc.AssetLeavesRequest{
+		Id:            &collectUniID,
+		IncludeAssets: true,
+	})
 	require.NoError(t, err)
 	require.Len(t, uniLeaves.Leaves, batchSize)
 
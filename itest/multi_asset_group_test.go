@@ -58,9 +58,12 @@ func testMintMultiAssetGroups(t *harnessTest) {
 	require.NoError(t.t, err)
 
 	// For each group minted, we check that the total balance for each
-	// group matches our minting requests.
+	// group matches our minting requests. All assets were minted in the
+	// same batch, so each group is anchored in a single UTXO.
 	var singleAssetGroupKey, normalGroupKey, collectGroupKey string
 	for groupKey, groupBalance := range balancesResp.AssetGroupBalances {
+		require.EqualValues(t.t, 1, groupBalance.NumUtxos)
+
 		switch groupBalance.Balance {
 		case issuableAsset.Asset.Amount:
 			singleAssetGroupKey = groupKey
@@ -371,6 +371,118 @@ func testReOrgMintAndSend(t *harnessTest) {
 	t.lndHarness.MineBlocks(8)
 }
 
+// testReOrgUniverseLeaf tests that when a re-org occurs, universe leaves that
+// were synced from another universe server are removed from the local universe
+// and that the removal is reported to subscribers.
+func testReOrgUniverseLeaf(t *harnessTest) {
+	// First, we'll mint a few assets but don't confirm the batch TX.
+	mintRequests := []*mintrpc.MintAssetRequest{
+		issuableAssets[0], issuableAssets[1],
+	}
+	mintTXID, batchKey := MintAssetUnconfirmed(
+		t.t, t.lndHarness.Miner.Client, t.tapd, mintRequests,
+	)
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	// Before we mine a block to confirm the mint TX, we create a temporary
+	// miner that'll mine the competing chain.
+	tempMiner := spawnTempMiner(t.t, t, ctxt)
+
+	initialBlock := MineBlocks(t.t, t.lndHarness.Miner.Client, 1, 1)[0]
+	initialBlockHash := initialBlock.BlockHash()
+	WaitForBatchState(
+		t.t, ctxt, t.tapd, defaultWaitTimeout, batchKey,
+		mintrpc.BatchState_BATCH_STATE_FINALIZED,
+	)
+	assetList := AssertAssetsMinted(
+		t.t, t.tapd, mintRequests, mintTXID, initialBlockHash,
+	)
+
+	// The second node syncs the issuance leaves from the first node on
+	// startup. As the leaves are synced from a remote universe, the second
+	// node watches their anchor transaction for re-orgs.
+	secondTapd := setupTapdHarness(
+		t.t, t, t.lndHarness.Bob, t.universeServer,
+		func(params *tapdHarnessParams) {
+			params.startupSyncNode = t.tapd
+			params.startupSyncNumAssets = len(assetList)
+		},
+	)
+	defer func() {
+		require.NoError(t.t, secondTapd.stop(!*noDelete))
+	}()
+	AssertUniverseRootEquality(t.t, t.tapd, secondTapd, true)
+
+	// We subscribe to the universe updates of the second node, so we can
+	// observe the removal of the leaves.
+	streamCtx, streamCancel := context.WithCancel(ctxb)
+	defer streamCancel()
+	updates, err := secondTapd.SubscribeUniverseUpdates(
+		streamCtx, &unirpc.SubscribeUniverseUpdatesRequest{},
+	)
+	require.NoError(t.t, err)
+
+	// We now mine a competing chain that doesn't contain the mint TX.
+	generateReOrg(t.t, t.lndHarness, tempMiner, 3, 2)
+
+	_, tempMinerHeight, err := tempMiner.Client.GetBestBlock()
+	require.NoError(t.t, err, "unable to get current block height")
+	t.lndHarness.WaitForNodeBlockHeight(t.lndHarness.Bob, tempMinerHeight)
+
+	// The second node should now remove the leaves of both assets, as
+	// their anchor transaction was re-organized out of the chain.
+	removedEvents := make([]*unirpc.UniverseUpdateEvent, 0, len(assetList))
+	for len(removedEvents) < len(assetList) {
+		event, err := updates.Recv()
+		require.NoError(t.t, err)
+
+		if !event.Removed {
+			continue
+		}
+
+		require.Equal(
+			t.t, unirpc.UniverseLeafSource_LEAF_SOURCE_FEDERATION,
+			event.Source,
+		)
+		removedEvents = append(removedEvents, event)
+	}
+
+	// The removed leaves are no longer served by the second node.
+	for _, event := range removedEvents {
+		_, err := secondTapd.QueryProof(ctxt, &unirpc.UniverseKey{
+			Id:      event.UniverseRoot.Id,
+			LeafKey: event.LeafKey,
+		})
+		require.Error(t.t, err)
+	}
+	AssertUniverseRootEquality(t.t, t.tapd, secondTapd, false)
+
+	// Once the mint TX is confirmed again, the first node updates its
+	// issuance proofs.
+	newBlock := t.lndHarness.MineBlocksAndAssertNumTxes(1, 1)[0]
+	_, newBlockHeight := t.lndHarness.Miner.GetBestBlock()
+	t.lndHarness.Miner.AssertTxInBlock(newBlock, &mintTXID)
+	WaitForProofUpdate(t.t, t.tapd, assetList[0], newBlockHeight)
+
+	// Let's now bury the proofs under sufficient blocks to allow the re-org
+	// watchers to stop watching the TX.
+	t.lndHarness.MineBlocks(8)
+
+	// A universe sync should now bring the updated leaves to the second
+	// node.
+	syncDiff, err := secondTapd.SyncUniverse(ctxt, &unirpc.SyncRequest{
+		UniverseHost: t.tapd.rpcHost(),
+		SyncMode:     unirpc.UniverseSyncMode_SYNC_ISSUANCE_ONLY,
+	})
+	require.NoError(t.t, err)
+	require.Len(t.t, syncDiff.SyncedUniverses, len(assetList))
+
+	AssertUniverseRootEquality(t.t, t.tapd, secondTapd, true)
+}
+
 // spawnTempMiner creates a temporary miner that uses the same chain backend
 // and client as the main miner.
 func spawnTempMiner(t *testing.T, ht *harnessTest,
@@ -830,6 +830,26 @@ func testSendMultipleCoins(t *harnessTest) {
 		)
 	}
 
+	// Sending more units than we own in total is rejected right away,
+	// even if the amount of each single address is within our balance.
+	overBalanceAddrs := make([]string, 2)
+	for i := range overBalanceAddrs {
+		overBalanceAddr, err := secondTapd.NewAddr(
+			ctxb, &taprpc.NewAddrRequest{
+				AssetId: genInfo.AssetId,
+				Amt:     rpcAssets[0].Amount,
+			},
+		)
+		require.NoError(t.t, err)
+
+		overBalanceAddrs[i] = overBalanceAddr.Encoded
+	}
+
+	_, err := t.tapd.SendAsset(ctxb, &taprpc.SendAssetRequest{
+		TapAddrs: overBalanceAddrs,
+	})
+	require.ErrorContains(t.t, err, "units available")
+
 	// Before we mine the next block, we'll make sure that we get a proper
 	// error message when trying to send more assets (there are currently no
 	// asset UTXOs available).
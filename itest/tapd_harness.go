@@ -69,7 +69,10 @@ type tapdHarness struct {
 	assetwalletrpc.AssetWalletClient
 	mintrpc.MintClient
 	universerpc.UniverseClient
-	tapdevrpc.TapDevClient
+
+	// TapDevClient is not embedded, as its ImportProof RPC would clash
+	// with the one of the main RPC service.
+	TapDevClient tapdevrpc.TapDevClient
 }
 
 // tapdConfig holds all configuration items that are required to start a tapd
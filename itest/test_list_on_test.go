@@ -38,6 +38,10 @@ var testCases = []*testCase{
 		name: "re-org mint and send",
 		test: testReOrgMintAndSend,
 	},
+	{
+		name: "re-org universe leaf",
+		test: testReOrgUniverseLeaf,
+	},
 	{
 		name:             "basic send unidirectional",
 		test:             testBasicSendUnidirectional,
@@ -168,6 +172,10 @@ var testCases = []*testCase{
 		name: "universe federation",
 		test: testUniverseFederation,
 	},
+	{
+		name: "universe archive",
+		test: testUniverseArchive,
+	},
 	{
 		name: "get info",
 		test: testGetInfo,
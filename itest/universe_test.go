@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"io"
 	prand "math/rand"
+	"net/http"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/internal/test"
 
 	tap "github.com/lightninglabs/taproot-assets"
@@ -216,6 +218,23 @@ func testUniverseSync(t *harnessTest) {
 	firstAssetFromUni.PrevWitnesses = nil
 	AssertAsset(t.t, rpcSimpleAssets[0], firstAssetFromUni)
 
+	// We should also be able to fetch a self-contained inclusion proof for
+	// the first asset, which we can verify without trusting the root
+	// reported by Bob.
+	firstAssetInclusionProof, err := bob.QueryUniverseProof(
+		ctxt, &firstAssetProofQuery,
+	)
+	require.NoError(t.t, err)
+	require.NoError(
+		t.t, tap.VerifyUniverseInclusionProof(firstAssetInclusionProof),
+	)
+	require.Equal(
+		t.t, firstAssetUniMssmtRoot.NodeHash(),
+		unmarshalMerkleSumNode(
+			firstAssetInclusionProof.UniverseRoot,
+		).NodeHash(),
+	)
+
 	// Now we'll delete a universe root on Bob's node, and then re-sync it.
 	_, err = bob.DeleteAssetRoot(ctxt, &unirpc.DeleteRootQuery{
 		Id: &unirpc.ID{
@@ -270,6 +289,85 @@ func unmarshalMerkleSumNode(root *unirpc.MerkleSumNode) mssmt.Node {
 	return mssmt.NewComputedBranch(nodeHash, uint64(root.RootSum))
 }
 
+// testUniverseArchive tests that the universe state of one node can be
+// exported into an archive and imported into another node, and that importing
+// the same archive again merges it with the existing state.
+func testUniverseArchive(t *harnessTest) {
+	miner := t.lndHarness.Miner.Client
+	MintAssetsConfirmBatch(t.t, miner, t.tapd, simpleAssets)
+	MintAssetsConfirmBatch(t.t, miner, t.tapd, issuableAssets)
+
+	bob := setupTapdHarness(
+		t.t, t, t.lndHarness.Bob, nil,
+	)
+	defer func() {
+		require.NoError(t.t, bob.stop(!*noDelete))
+	}()
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	// We'll export the full universe state of the primary node first.
+	exportStream, err := t.tapd.ExportUniverse(
+		ctxt, &unirpc.ExportUniverseRequest{},
+	)
+	require.NoError(t.t, err)
+
+	var chunks []*unirpc.UniverseArchiveChunk
+	for {
+		chunk, err := exportStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t.t, err)
+
+		chunks = append(chunks, chunk)
+	}
+	require.NotEmpty(t.t, chunks)
+
+	importArchive := func() *unirpc.ImportUniverseResponse {
+		importStream, err := bob.ImportUniverse(ctxt)
+		require.NoError(t.t, err)
+
+		for _, chunk := range chunks {
+			require.NoError(t.t, importStream.Send(chunk))
+		}
+
+		resp, err := importStream.CloseAndRecv()
+		require.NoError(t.t, err)
+
+		return resp
+	}
+
+	universeRoots, err := t.tapd.AssetRoots(
+		ctxt, &unirpc.AssetRootRequest{},
+	)
+	require.NoError(t.t, err)
+
+	// Importing the archive into Bob's node should insert all leaves, after
+	// which his universe roots should match the ones of the primary node.
+	resp := importArchive()
+	numUniverses := len(universeRoots.UniverseRoots)
+	require.EqualValues(t.t, numUniverses, resp.NumUniverses)
+	require.NotZero(t.t, resp.NumImportedLeaves)
+	require.Zero(t.t, resp.NumExistingLeaves)
+
+	universeRootsBob, err := bob.AssetRoots(
+		ctxt, &unirpc.AssetRootRequest{},
+	)
+	require.NoError(t.t, err)
+	require.True(
+		t.t, AssertUniverseRootsEqual(universeRoots, universeRootsBob),
+	)
+
+	// Importing the same archive again should skip all the leaves that are
+	// already known instead of failing.
+	resp2 := importArchive()
+	require.Zero(t.t, resp2.NumImportedLeaves)
+	require.Equal(t.t, resp.NumImportedLeaves, resp2.NumExistingLeaves)
+}
+
 // testUniverseREST tests that we're able to properly query the universe state
 // via the REST interface.
 func testUniverseREST(t *harnessTest) {
@@ -318,8 +416,32 @@ func testUniverseREST(t *harnessTest) {
 		require.True(t.t, AssertUniverseRootEqual(
 			roots.UniverseRoots[uniIDStr], assetRoots.IssuanceRoot,
 		))
+
+		// We should also be able to query the asset's leaf by its
+		// anchor outpoint only.
+		leafURI := fmt.Sprintf(
+			"%s/leaves/asset-id/%s/%s", urlPrefix, assetID,
+			simpleAsset.ChainAnchor.AnchorOutpoint,
+		)
+		leaf, err := getJSON[*unirpc.AssetProofResponse](leafURI)
+		require.NoError(t.t, err)
+		require.Equal(
+			t.t, simpleAsset.AssetGenesis.AssetId,
+			leaf.AssetLeaf.Asset.AssetGenesis.AssetId,
+		)
 	}
 
+	// Querying the leaf of an unknown outpoint should result in a not
+	// found error.
+	unknownLeafURI := fmt.Sprintf(
+		"%s/leaves/asset-id/%x/%v", urlPrefix,
+		rpcSimpleAssets[0].AssetGenesis.AssetId, wire.OutPoint{},
+	)
+	resp, err := client.Get(unknownLeafURI)
+	require.NoError(t.t, err)
+	require.NoError(t.t, resp.Body.Close())
+	require.Equal(t.t, http.StatusNotFound, resp.StatusCode)
+
 	// Re-issuable assets are keyed by their group keys.
 	for _, issuableAsset := range rpcIssuableAssets {
 		// The group key is the full 33-byte public key, but the
@@ -451,6 +573,33 @@ func testUniverseFederation(t *harnessTest) {
 		return AssertUniverseStateEqual(t.t, bob, t.tapd)
 	}, defaultWaitTimeout, wait.PollInterval)
 
+	// As both nodes have the same Universe state, their multiverse roots
+	// should also match.
+	AssertMultiverseRootEqual(
+		t.t, bob, t.tapd, unirpc.ProofType_PROOF_TYPE_ISSUANCE,
+	)
+
+	// Checking the main node on demand should show that it's reachable
+	// and in sync with Bob's Universe.
+	checkResp, err := bob.CheckFederationServer(
+		ctxt, &unirpc.CheckFederationServerRequest{
+			Host: t.tapd.rpcHost(),
+		},
+	)
+	require.NoError(t.t, err)
+	require.True(t.t, checkResp.Server.Health.Reachable)
+	require.True(t.t, checkResp.Server.Health.InSync)
+	require.False(t.t, checkResp.Server.Health.Stale)
+
+	// The result of the check should also be reported when listing the
+	// federation servers.
+	fedNodes, err = bob.ListFederationServers(
+		ctxt, &unirpc.ListFederationServersRequest{},
+	)
+	require.NoError(t.t, err)
+	require.Len(t.t, fedNodes.Servers, 1)
+	require.True(t.t, fedNodes.Servers[0].Health.Reachable)
+
 	// Bob's Universe stats should show that he now has a single asset. We
 	// should also be able to query for stats specifically for the asset.
 	AssertUniverseStats(t.t, bob, 1, 0, 1, 0)
@@ -78,6 +78,7 @@ func ParseGenInfo(t *testing.T, genInfo *taprpc.GenesisInfo) *asset.Genesis {
 		FirstPrevOut: *genPoint,
 		Tag:          genInfo.Name,
 		OutputIndex:  genInfo.OutputIndex,
+		Type:         asset.Type(genInfo.AssetType),
 	}
 	copy(parsedGenesis.MetaHash[:], genInfo.MetaHash)
 
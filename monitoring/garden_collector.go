@@ -0,0 +1,100 @@
+package monitoring
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// gardenGroupName is the name of the minting metric group.
+	gardenGroupName = "mint"
+)
+
+var (
+	// batchDurationMetric is the time it took for a minting batch to be
+	// finalized, starting from its creation.
+	batchDurationMetric = prometheus.BuildFQName(
+		namespace, gardenGroupName, "batch_duration_seconds",
+	)
+)
+
+// gardenCollector is a MetricGroup that exports metrics about the minting
+// process.
+type gardenCollector struct {
+	registry *prometheus.Registry
+
+	// batchDuration tracks the time it took for minting batches to be
+	// finalized.
+	batchDuration prometheus.Histogram
+}
+
+// A compile-time check to ensure that gardenCollector implements the
+// MetricGroup interface.
+var _ MetricGroup = (*gardenCollector)(nil)
+
+// newGardenCollector creates a new minting metric group.
+func newGardenCollector(_ *PrometheusConfig,
+	registry *prometheus.Registry) (MetricGroup, error) {
+
+	return &gardenCollector{
+		registry: registry,
+		batchDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name: batchDurationMetric,
+			Help: "Time it took for a minting batch to be " +
+				"finalized, starting from its creation",
+
+			// A batch is finalized after at least a single block
+			// confirmation, so we start at one minute and go up
+			// to a bit more than a day.
+			Buckets: prometheus.ExponentialBuckets(60, 2, 12),
+		}),
+	}, nil
+}
+
+// Name is the name of the metric group.
+//
+// NOTE: This is part of the MetricGroup interface.
+func (g *gardenCollector) Name() string {
+	return gardenGroupName
+}
+
+// RegisterMetricFuncs registers the garden collector with the registry of the
+// exporter.
+//
+// NOTE: This is part of the MetricGroup interface.
+func (g *gardenCollector) RegisterMetricFuncs() error {
+	return g.registry.Register(g)
+}
+
+// Describe sends the super-set of all possible descriptors of metrics
+// collected by this Collector to the provided channel.
+//
+// NOTE: This is part of the prometheus.Collector interface.
+func (g *gardenCollector) Describe(ch chan<- *prometheus.Desc) {
+	g.batchDuration.Describe(ch)
+}
+
+// Collect is called by the Prometheus registry when collecting metrics.
+//
+// NOTE: This is part of the prometheus.Collector interface.
+func (g *gardenCollector) Collect(ch chan<- prometheus.Metric) {
+	g.batchDuration.Collect(ch)
+}
+
+// ObserveBatchDuration records the time it took for a minting batch to be
+// finalized. This is a no-op if the Prometheus exporter isn't active.
+func ObserveBatchDuration(duration time.Duration) {
+	group, ok := activeGroup(gardenGroupName).(*gardenCollector)
+	if !ok {
+		return
+	}
+
+	group.batchDuration.Observe(duration.Seconds())
+}
+
+func init() {
+	metricsMtx.Lock()
+	metricGroups[gardenGroupName] = newGardenCollector
+	metricsMtx.Unlock()
+}
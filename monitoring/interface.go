@@ -3,9 +3,10 @@ package monitoring
 import "github.com/prometheus/client_golang/prometheus"
 
 // metricGroupFactory is a factory method that given the primary prometheus
-// config, will create a new MetricGroup that will be managed by the main
-// PrometheusExporter.
-type metricGroupFactory func(*PrometheusConfig) (MetricGroup, error)
+// config and the registry of the exporter, will create a new MetricGroup that
+// will be managed by the main PrometheusExporter.
+type metricGroupFactory func(*PrometheusConfig,
+	*prometheus.Registry) (MetricGroup, error)
 
 // MetricGroup is the primary interface of this package. The main exporter (in
 // this case the PrometheusExporter), will manage these directly, ensuring that
@@ -22,7 +23,7 @@ type MetricGroup interface {
 
 	// RegisterMetricFuncs signals to the underlying hybrid collector that
 	// it should register all metrics that it aims to export with the
-	// global Prometheus registry. Rather than using the series of
+	// Prometheus registry of the exporter. Rather than using the series of
 	// "MustRegister" directives, implementers of this interface should
 	// instead propagate back any errors related to metric registration.
 	RegisterMetricFuncs() error
@@ -34,6 +34,20 @@ var (
 	serverMetrics *grpc_prometheus.ServerMetrics
 )
 
+// namespace is the namespace all metrics exported by the metric groups are
+// prefixed with.
+const namespace = "tapd"
+
+// activeGroup returns the active metric group with the given name, or nil if
+// the group isn't active. This is used by the package level methods that
+// export observations, which are no-ops if the exporter isn't running.
+func activeGroup(name string) MetricGroup {
+	metricsMtx.Lock()
+	defer metricsMtx.Unlock()
+
+	return activeGroups[name]
+}
+
 // PrometheusExporter is a metric exporter that uses Prometheus directly. The
 // internal server will interact with this struct in order to export relevant
 // metrics.
@@ -66,7 +80,7 @@ func (p *PrometheusExporter) Start() error {
 
 	// Next, we'll attempt to register all our metrics. If we fail to
 	// register ANY metric, then we'll fail all together.
-	if err := p.registerMetrics(); err != nil {
+	if err := p.registerMetrics(reg); err != nil {
 		return err
 	}
 
@@ -100,14 +114,14 @@ func (p *PrometheusExporter) Start() error {
 }
 
 // registerMetrics iterates through all the registered metric groups and
-// attempts to register each one. If any of the MetricGroups fail to register,
-// then an error will be returned.
-func (p *PrometheusExporter) registerMetrics() error {
+// attempts to register each one with the given registry. If any of the
+// MetricGroups fail to register, then an error will be returned.
+func (p *PrometheusExporter) registerMetrics(reg *prometheus.Registry) error {
 	metricsMtx.Lock()
 	defer metricsMtx.Unlock()
 
 	for _, metricGroupFunc := range metricGroups {
-		metricGroup, err := metricGroupFunc(p.config)
+		metricGroup, err := metricGroupFunc(p.config, reg)
 		if err != nil {
 			return err
 		}
@@ -123,10 +137,10 @@ func (p *PrometheusExporter) registerMetrics() error {
 }
 
 // gauges is a map type that maps a gauge to its unique name.
-type gauges map[string]*prometheus.GaugeVec // nolint:unused
+type gauges map[string]*prometheus.GaugeVec
 
 // addGauge adds a new gauge vector to the map.
-func (g gauges) addGauge(name, help string, labels []string) { // nolint:unused
+func (g gauges) addGauge(name, help string, labels []string) {
 	g[name] = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: name,
@@ -137,21 +151,21 @@ func (g gauges) addGauge(name, help string, labels []string) { // nolint:unused
 }
 
 // describe describes all gauges contained in the map to the given channel.
-func (g gauges) describe(ch chan<- *prometheus.Desc) { // nolint:unused
+func (g gauges) describe(ch chan<- *prometheus.Desc) {
 	for _, gauge := range g {
 		gauge.Describe(ch)
 	}
 }
 
 // collect collects all metrics of the map's gauges to the given channel.
-func (g gauges) collect(ch chan<- prometheus.Metric) { // nolint:unused
+func (g gauges) collect(ch chan<- prometheus.Metric) {
 	for _, gauge := range g {
 		gauge.Collect(ch)
 	}
 }
 
 // reset resets all gauges in the map.
-func (g gauges) reset() { // nolint:unused
+func (g gauges) reset() {
 	for _, gauge := range g {
 		gauge.Reset()
 	}
@@ -0,0 +1,272 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// universeGroupName is the name of the universe metric group.
+	universeGroupName = "universe"
+
+	// maxAssetLabels is the maximum number of assets we export per-asset
+	// metrics for. This bounds the cardinality of the asset ID label, as
+	// a universe can contain an arbitrary number of assets. Only the
+	// assets with the most proofs are exported.
+	maxAssetLabels = 50
+
+	// collectTimeout is the maximum amount of time we'll wait for the
+	// stats to be fetched from the database when collecting metrics.
+	collectTimeout = 10 * time.Second
+
+	// pushResultSuccess is the result label of a successful federation
+	// push.
+	pushResultSuccess = "success"
+
+	// pushResultFailure is the result label of a failed federation push.
+	pushResultFailure = "failure"
+)
+
+var (
+	// universeAssetsMetric is the total number of assets in the universe.
+	universeAssetsMetric = prometheus.BuildFQName(
+		namespace, universeGroupName, "assets_total",
+	)
+
+	// universeGroupsMetric is the total number of asset groups in the
+	// universe.
+	universeGroupsMetric = prometheus.BuildFQName(
+		namespace, universeGroupName, "groups_total",
+	)
+
+	// universeLeavesMetric is the total number of leaves across all
+	// universe trees.
+	universeLeavesMetric = prometheus.BuildFQName(
+		namespace, universeGroupName, "leaves_total",
+	)
+
+	// universeSyncsMetric is the total number of syncs served by the
+	// universe.
+	universeSyncsMetric = prometheus.BuildFQName(
+		namespace, universeGroupName, "syncs_total",
+	)
+
+	// universeProofsMetric is the total number of proofs inserted into the
+	// universe.
+	universeProofsMetric = prometheus.BuildFQName(
+		namespace, universeGroupName, "proofs_total",
+	)
+
+	// assetSyncsMetric is the number of syncs served for a single asset.
+	assetSyncsMetric = prometheus.BuildFQName(
+		namespace, universeGroupName, "asset_syncs_total",
+	)
+
+	// assetProofsMetric is the number of proofs inserted for a single
+	// asset.
+	assetProofsMetric = prometheus.BuildFQName(
+		namespace, universeGroupName, "asset_proofs_total",
+	)
+
+	// federationPushesMetric is the number of attempts to push a leaf to
+	// a federation server.
+	federationPushesMetric = prometheus.BuildFQName(
+		namespace, universeGroupName, "federation_pushes_total",
+	)
+)
+
+// universeCollector is a MetricGroup that exports the stats of the local
+// universe, and the results of the pushes to the universe federation.
+type universeCollector struct {
+	cfg      *PrometheusConfig
+	registry *prometheus.Registry
+
+	// collectMtx ensures that only a single collection runs at a time.
+	collectMtx sync.Mutex
+
+	// gauges are the gauges that are populated from the universe stats
+	// every time the metrics are collected.
+	gauges gauges
+
+	// federationPushes counts the push attempts to each federation
+	// server by result.
+	federationPushes *prometheus.CounterVec
+}
+
+// A compile-time check to ensure that universeCollector implements the
+// MetricGroup interface.
+var _ MetricGroup = (*universeCollector)(nil)
+
+// newUniverseCollector creates a new universe metric group.
+func newUniverseCollector(cfg *PrometheusConfig,
+	registry *prometheus.Registry) (MetricGroup, error) {
+
+	if cfg.UniverseStats == nil {
+		return nil, fmt.Errorf("universe stats must be set")
+	}
+
+	g := make(gauges)
+	g.addGauge(
+		universeAssetsMetric, "Total number of assets in the universe",
+		nil,
+	)
+	g.addGauge(
+		universeGroupsMetric, "Total number of asset groups in the "+
+			"universe", nil,
+	)
+	g.addGauge(
+		universeLeavesMetric, "Total number of leaves across all "+
+			"universe trees", nil,
+	)
+	g.addGauge(
+		universeSyncsMetric, "Total number of syncs served by the "+
+			"universe", nil,
+	)
+	g.addGauge(
+		universeProofsMetric, "Total number of proofs inserted into "+
+			"the universe", nil,
+	)
+	g.addGauge(
+		assetSyncsMetric, "Number of syncs served for an asset",
+		[]string{"asset_id"},
+	)
+	g.addGauge(
+		assetProofsMetric, "Number of proofs inserted for an asset",
+		[]string{"asset_id"},
+	)
+
+	return &universeCollector{
+		cfg:      cfg,
+		registry: registry,
+		gauges:   g,
+		federationPushes: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: federationPushesMetric,
+				Help: "Number of attempts to push a leaf " +
+					"to a federation server",
+			},
+			[]string{"server", "result"},
+		),
+	}, nil
+}
+
+// Name is the name of the metric group.
+//
+// NOTE: This is part of the MetricGroup interface.
+func (u *universeCollector) Name() string {
+	return universeGroupName
+}
+
+// RegisterMetricFuncs registers the universe collector with the registry of
+// the exporter.
+//
+// NOTE: This is part of the MetricGroup interface.
+func (u *universeCollector) RegisterMetricFuncs() error {
+	return u.registry.Register(u)
+}
+
+// Describe sends the super-set of all possible descriptors of metrics
+// collected by this Collector to the provided channel.
+//
+// NOTE: This is part of the prometheus.Collector interface.
+func (u *universeCollector) Describe(ch chan<- *prometheus.Desc) {
+	u.gauges.describe(ch)
+	u.federationPushes.Describe(ch)
+}
+
+// Collect is called by the Prometheus registry when collecting metrics. The
+// universe stats are fetched from the database on every collection.
+//
+// NOTE: This is part of the prometheus.Collector interface.
+func (u *universeCollector) Collect(ch chan<- prometheus.Metric) {
+	u.collectMtx.Lock()
+	defer u.collectMtx.Unlock()
+
+	u.federationPushes.Collect(ch)
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), collectTimeout,
+	)
+	defer cancel()
+
+	// We reset the gauges first, so assets that are no longer among the
+	// assets with the most proofs aren't exported anymore.
+	u.gauges.reset()
+
+	stats, err := u.cfg.UniverseStats.AggregateSyncStats(ctx)
+	if err != nil {
+		log.Errorf("Unable to fetch universe stats: %v", err)
+		return
+	}
+
+	u.gauges[universeAssetsMetric].WithLabelValues().Set(
+		float64(stats.NumTotalAssets),
+	)
+	u.gauges[universeGroupsMetric].WithLabelValues().Set(
+		float64(stats.NumTotalGroups),
+	)
+	u.gauges[universeLeavesMetric].WithLabelValues().Set(
+		float64(stats.NumTotalLeaves),
+	)
+	u.gauges[universeSyncsMetric].WithLabelValues().Set(
+		float64(stats.NumTotalSyncs),
+	)
+	u.gauges[universeProofsMetric].WithLabelValues().Set(
+		float64(stats.NumTotalProofs),
+	)
+
+	assetStats, err := u.cfg.UniverseStats.QuerySyncStats(
+		ctx, universe.SyncStatsQuery{
+			SortBy:        universe.SortByTotalProofs,
+			SortDirection: universe.SortDescending,
+			Limit:         maxAssetLabels,
+		},
+	)
+	if err != nil {
+		log.Errorf("Unable to fetch asset stats: %v", err)
+		u.gauges.collect(ch)
+
+		return
+	}
+
+	for _, assetStat := range assetStats.SyncStats {
+		assetID := assetStat.AssetID.String()
+
+		u.gauges[assetSyncsMetric].WithLabelValues(assetID).Set(
+			float64(assetStat.TotalSyncs),
+		)
+		u.gauges[assetProofsMetric].WithLabelValues(assetID).Set(
+			float64(assetStat.TotalProofs),
+		)
+	}
+
+	u.gauges.collect(ch)
+}
+
+// ObservePush records the result of an attempt to push a leaf to the universe
+// federation server with the given host. This is a no-op if the Prometheus
+// exporter isn't active.
+func ObservePush(serverHost string, err error) {
+	group, ok := activeGroup(universeGroupName).(*universeCollector)
+	if !ok {
+		return
+	}
+
+	result := pushResultSuccess
+	if err != nil {
+		result = pushResultFailure
+	}
+
+	group.federationPushes.WithLabelValues(serverHost, result).Inc()
+}
+
+func init() {
+	metricsMtx.Lock()
+	metricGroups[universeGroupName] = newUniverseCollector
+	metricsMtx.Unlock()
+}
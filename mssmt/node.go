@@ -58,6 +58,10 @@ type LeafNode struct {
 
 	Value []byte
 	sum   uint64
+
+	// pruned is true if the value of the leaf was pruned, in which case
+	// only the leaf's hash and sum are known.
+	pruned bool
 }
 
 // NewLeafNode constructs a new leaf node.
@@ -68,6 +72,18 @@ func NewLeafNode(value []byte, sum uint64) *LeafNode {
 	}
 }
 
+// NewPrunedLeafNode constructs a leaf node of which the value was pruned. The
+// leaf commits to the given hash and sum, so it can take the place of the
+// original leaf in the tree without changing any of the tree's roots, but its
+// value can no longer be retrieved.
+func NewPrunedLeafNode(leafHash NodeHash, sum uint64) *LeafNode {
+	return &LeafNode{
+		nodeHash: &leafHash,
+		sum:      sum,
+		pruned:   true,
+	}
+}
+
 // NodeHash returns the unique identifier for a MS-SMT node. It represents the
 // hash of the leaf committing to its internal data.
 func (n *LeafNode) NodeHash() NodeHash {
@@ -89,7 +105,12 @@ func (n *LeafNode) NodeSum() uint64 {
 
 // IsEmpty returns whether this is an empty leaf.
 func (n *LeafNode) IsEmpty() bool {
-	return len(n.Value) == 0 && n.sum == 0
+	return !n.pruned && len(n.Value) == 0 && n.sum == 0
+}
+
+// IsPruned returns whether the value of this leaf was pruned.
+func (n *LeafNode) IsPruned() bool {
+	return n.pruned
 }
 
 // Copy returns a deep copy of the leaf node.
@@ -107,6 +128,7 @@ func (n *LeafNode) Copy() Node {
 		nodeHash: nodeHashCopy,
 		Value:    valueCopy,
 		sum:      n.sum,
+		pruned:   n.pruned,
 	}
 }
 
@@ -804,6 +804,98 @@ func TestMerkleProof(t *testing.T) {
 	}
 }
 
+// TestPrunedLeaves tests that a pruned leaf can take the place of the original
+// leaf without changing the root of the tree, and that it's still pruned after
+// more leaves are inserted around it.
+func TestPrunedLeaves(t *testing.T) {
+	t.Parallel()
+
+	leaves := randTree(100)
+	moreLeaves := randTree(100)
+
+	runTest := func(t *testing.T, name string,
+		makeTree func(mssmt.TreeStore) mssmt.Tree,
+		makeStore makeTestTreeStoreFunc) {
+
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			store, err := makeStore()
+			require.NoError(t, err)
+			tree := makeTree(store)
+
+			prunedStore, err := makeStore()
+			require.NoError(t, err)
+			prunedTree := makeTree(prunedStore)
+
+			// We prune every other leaf in the second tree.
+			for i, item := range leaves {
+				_, err := tree.Insert(ctx, item.key, item.leaf)
+				require.NoError(t, err)
+
+				leaf := item.leaf
+				if i%2 == 0 {
+					leaf = mssmt.NewPrunedLeafNode(
+						leaf.NodeHash(),
+						leaf.NodeSum(),
+					)
+				}
+				_, err = prunedTree.Insert(ctx, item.key, leaf)
+				require.NoError(t, err)
+			}
+
+			// Inserting more leaves moves the pruned leaves
+			// further down the tree.
+			for _, item := range moreLeaves {
+				_, err := tree.Insert(ctx, item.key, item.leaf)
+				require.NoError(t, err)
+
+				_, err = prunedTree.Insert(
+					ctx, item.key, item.leaf,
+				)
+				require.NoError(t, err)
+			}
+
+			root, err := tree.Root(ctx)
+			require.NoError(t, err)
+			prunedRoot, err := prunedTree.Root(ctx)
+			require.NoError(t, err)
+			require.True(t, mssmt.IsEqualNode(root, prunedRoot))
+
+			for i, item := range leaves {
+				leaf, err := prunedTree.Get(ctx, item.key)
+				require.NoError(t, err)
+				require.Equal(t, i%2 == 0, leaf.IsPruned())
+				require.False(t, leaf.IsEmpty())
+				require.True(t, mssmt.IsEqualNode(
+					item.leaf, leaf,
+				))
+
+				if leaf.IsPruned() {
+					require.Empty(t, leaf.Value)
+				}
+
+				// The inclusion proof of a pruned leaf must
+				// still prove the original leaf.
+				proof, err := prunedTree.MerkleProof(
+					ctx, item.key,
+				)
+				require.NoError(t, err)
+				require.True(t, mssmt.VerifyMerkleProof(
+					item.key, item.leaf, proof, root,
+				))
+			}
+		})
+	}
+
+	for storeName, makeStore := range genTestStores(t) {
+		t.Run(storeName, func(t *testing.T) {
+			runTest(t, "full SMT", makeFullTree, makeStore)
+			runTest(t, "smol SMT", makeSmolTree, makeStore)
+		})
+	}
+}
+
 // TestBIPTestVectors tests that the BIP test vectors are passing.
 func TestBIPTestVectors(t *testing.T) {
 	t.Parallel()
@@ -4,7 +4,14 @@ import "gopkg.in/macaroon-bakery.v2/bakery"
 
 var (
 	// RequiredPermissions is a map of all tapd RPC methods and their
-	// required macaroon permissions to access tapd.
+	// required macaroon permissions to access tapd. Each permission is an
+	// entity/action pair, where the action is either "read" or "write",
+	// except for baking new macaroons, which requires the "generate"
+	// action of the "macaroon" entity. The public data of the local
+	// universe is guarded by the "universe" entity, while the management
+	// of the federation is guarded by the separate "federation" entity.
+	// That way, a macaroon that is handed out to public sync clients
+	// doesn't grant access to the federation configuration.
 	//
 	// TODO(roasbeef): re think these and go instead w/ the * approach?
 	RequiredPermissions = map[string][]bakery.Op{
@@ -20,6 +27,14 @@ var (
 			Entity: "daemon",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/BakeMacaroon": {{
+			Entity: "macaroon",
+			Action: "generate",
+		}},
+		"/taprpc.TaprootAssets/ListPermissions": {{
+			Entity: "macaroon",
+			Action: "read",
+		}},
 		"/taprpc.TaprootAssets/ListAssets": {{
 			Entity: "assets",
 			Action: "read",
@@ -28,6 +43,10 @@ var (
 			Entity: "assets",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/SetAssetLabel": {{
+			Entity: "assets",
+			Action: "write",
+		}},
 		"/taprpc.TaprootAssets/ListGroups": {{
 			Entity: "assets",
 			Action: "read",
@@ -40,14 +59,26 @@ var (
 			Entity: "assets",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/SubscribeTransfers": {{
+			Entity: "assets",
+			Action: "read",
+		}},
 		"/taprpc.TaprootAssets/QueryAddrs": {{
 			Entity: "addresses",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/ListAddrs": {{
+			Entity: "addresses",
+			Action: "read",
+		}},
 		"/taprpc.TaprootAssets/NewAddr": {{
 			Entity: "addresses",
 			Action: "write",
 		}},
+		"/taprpc.TaprootAssets/RevokeAddr": {{
+			Entity: "addresses",
+			Action: "write",
+		}},
 		"/taprpc.TaprootAssets/DecodeAddr": {{
 			Entity: "addresses",
 			Action: "read",
@@ -64,14 +95,44 @@ var (
 			Entity: "proofs",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/DecodeCommitment": {{
+			Entity: "proofs",
+			Action: "read",
+		}},
 		"/taprpc.TaprootAssets/ExportProof": {{
 			Entity: "proofs",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/ExportProofs": {{
+			Entity: "proofs",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/ImportProof": {{
+			Entity: "proofs",
+			Action: "write",
+		}, {
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ClaimFromUniverse": {{
+			Entity: "proofs",
+			Action: "write",
+		}, {
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/VerifyGroupMembership": {{
+			Entity: "proofs",
+			Action: "read",
+		}},
 		"/taprpc.TaprootAssets/SendAsset": {{
 			Entity: "assets",
 			Action: "write",
 		}},
+		"/taprpc.TaprootAssets/EstimateTransferFee": {{
+			Entity: "assets",
+			Action: "write",
+		}},
 		"/taprpc.TaprootAssets/BurnAsset": {{
 			Entity: "assets",
 			Action: "write",
@@ -100,6 +161,10 @@ var (
 			Entity: "assets",
 			Action: "write",
 		}},
+		"/assetwalletrpc.AssetWallet/ListInternalKeys": {{
+			Entity: "assets",
+			Action: "read",
+		}},
 		"/assetwalletrpc.AssetWallet/NextScriptKey": {{
 			Entity: "assets",
 			Action: "write",
@@ -112,6 +177,14 @@ var (
 			Entity: "assets",
 			Action: "read",
 		}},
+		"/assetwalletrpc.AssetWallet/SignAssetMessage": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/VerifyAssetMessage": {{
+			Entity: "assets",
+			Action: "read",
+		}},
 		"/assetwalletrpc.AssetWallet/RemoveUTXOLease": {{
 			Entity: "assets",
 			Action: "write",
@@ -132,6 +205,38 @@ var (
 			Entity: "mint",
 			Action: "read",
 		}},
+		"/mintrpc.Mint/ListMints": {{
+			Entity: "mint",
+			Action: "read",
+		}},
+		"/mintrpc.Mint/BumpBatchFee": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/FetchEmissionSchedule": {{
+			Entity: "mint",
+			Action: "read",
+		}},
+		"/mintrpc.Mint/FetchBatchAnchor": {{
+			Entity: "mint",
+			Action: "read",
+		}},
+		"/mintrpc.Mint/SubscribeBatchConfirmation": {{
+			Entity: "mint",
+			Action: "read",
+		}},
+		"/mintrpc.Mint/PreviewAssetID": {{
+			Entity: "mint",
+			Action: "read",
+		}},
+		"/mintrpc.Mint/CommitBatchPsbt": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/PublishBatchPsbt": {{
+			Entity: "mint",
+			Action: "write",
+		}},
 		"/universerpc.Universe/AssetRoots": {{
 			Entity: "universe",
 			Action: "read",
@@ -140,14 +245,38 @@ var (
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/QueryAssetRootsBatch": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/SearchAssets": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/QueryAssetGenesis": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/MultiverseRoot": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/DeleteAssetRoot": {{
 			Entity: "universe",
 			Action: "write",
 		}},
+		"/universerpc.Universe/DeleteUniverseLeaf": {{
+			Entity: "universe",
+			Action: "write",
+		}},
 		"/universerpc.Universe/AssetLeafKeys": {{
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/AssetLeafKeysSince": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/AssetLeaves": {{
 			Entity: "universe",
 			Action: "read",
@@ -156,26 +285,98 @@ var (
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/QueryUniverseProof": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/InsertProof": {{
 			Entity: "universe",
 			Action: "write",
 		}},
+		"/universerpc.Universe/InsertProofs": {{
+			Entity: "universe",
+			Action: "write",
+		}},
+		"/universerpc.Universe/UploadMailboxProof": {{
+			Entity: "universe",
+			Action: "write",
+		}},
+		"/universerpc.Universe/FetchMailboxProofs": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/SyncUniverse": {{
 			Entity: "universe",
 			Action: "write",
 		}},
-		"/universerpc.Universe/ListFederationServers": {{
+		"/universerpc.Universe/SyncUniverseStream": {{
+			Entity: "universe",
+			Action: "write",
+		}},
+		"/universerpc.Universe/SyncAll": {{
+			Entity: "universe",
+			Action: "write",
+		}},
+		"/universerpc.Universe/DiffUniverse": {{
 			Entity: "universe",
 			Action: "read",
 		}},
-		"/universerpc.Universe/AddFederationServer": {{
+		"/universerpc.Universe/VerifyUniverse": {{
 			Entity: "universe",
 			Action: "write",
 		}},
-		"/universerpc.Universe/DeleteFederationServer": {{
+		"/universerpc.Universe/ComputeUniverseRoot": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/ExportUniverseSnapshot": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/PruneUniverse": {{
 			Entity: "universe",
 			Action: "write",
 		}},
+		"/universerpc.Universe/PruneSpentLeaves": {{
+			Entity: "universe",
+			Action: "write",
+		}},
+		"/universerpc.Universe/ListPrunedLeaves": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/CompactUniverseDB": {{
+			Entity: "universe",
+			Action: "write",
+		}},
+		"/universerpc.Universe/ListFederationServers": {{
+			Entity: "federation",
+			Action: "read",
+		}},
+		"/universerpc.Universe/AddFederationServer": {{
+			Entity: "federation",
+			Action: "write",
+		}},
+		"/universerpc.Universe/DeleteFederationServer": {{
+			Entity: "federation",
+			Action: "write",
+		}},
+		"/universerpc.Universe/SetFederationServerPriority": {{
+			Entity: "federation",
+			Action: "write",
+		}},
+		"/universerpc.Universe/CheckFederationServer": {{
+			Entity: "federation",
+			Action: "read",
+		}},
+		"/universerpc.Universe/ListFederationRoots": {{
+			Entity: "federation",
+			Action: "read",
+		}},
+		"/universerpc.Universe/Info": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/UniverseStats": {{
 			Entity: "universe",
 			Action: "read",
@@ -184,18 +385,58 @@ var (
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/QueryAssetSupply": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/QueryAssetUtxos": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/QueryEvents": {{
 			Entity: "universe",
 			Action: "read",
 		}},
 		"/universerpc.Universe/SetFederationSyncConfig": {{
-			Entity: "universe",
+			Entity: "federation",
 			Action: "write",
 		}},
 		"/universerpc.Universe/QueryFederationSyncConfig": {{
+			Entity: "federation",
+			Action: "read",
+		}},
+		"/universerpc.Universe/SetAssetPolicy": {{
+			Entity: "universe",
+			Action: "write",
+		}},
+		"/universerpc.Universe/QueryAssetPolicy": {{
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/SetAcceptPushFrom": {{
+			Entity: "universe",
+			Action: "write",
+		}},
+		"/universerpc.Universe/QueryAcceptPushFrom": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/SubscribeUniverseUpdates": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/SubscribeFederationEvents": {{
+			Entity: "federation",
+			Action: "read",
+		}},
+		"/universerpc.Universe/ExportUniverse": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/ImportUniverse": {{
+			Entity: "universe",
+			Action: "write",
+		}},
 		"/tapdevrpc.TapDev/ImportProof": {{
 			Entity: "proofs",
 			Action: "write",
@@ -209,11 +450,15 @@ var (
 	// InsertProof as a valid proof requires an on-chain transaction, so we
 	// gain a layer of DoS defense.
 	defaultMacaroonWhitelist = map[string]struct{}{
-		"/universerpc.Universe/AssetRoots":      {},
-		"/universerpc.Universe/QueryAssetRoots": {},
-		"/universerpc.Universe/AssetLeafKeys":   {},
-		"/universerpc.Universe/AssetLeaves":     {},
-		"/universerpc.Universe/Info":            {},
+		"/universerpc.Universe/AssetRoots":           {},
+		"/universerpc.Universe/QueryAssetRoots":      {},
+		"/universerpc.Universe/QueryAssetRootsBatch": {},
+		"/universerpc.Universe/SearchAssets":         {},
+		"/universerpc.Universe/QueryAssetGenesis":    {},
+		"/universerpc.Universe/AssetLeafKeys":        {},
+		"/universerpc.Universe/AssetLeafKeysSince":   {},
+		"/universerpc.Universe/AssetLeaves":          {},
+		"/universerpc.Universe/Info":                 {},
 	}
 )
 
@@ -233,11 +478,20 @@ func MacaroonWhitelist(allowPublicUniProofCourier bool,
 	if allowPublicUniProofCourier {
 		whitelist["/universerpc.Universe/QueryProof"] = struct{}{}
 		whitelist["/universerpc.Universe/InsertProof"] = struct{}{}
+		whitelist["/universerpc.Universe/InsertProofs"] = struct{}{}
+		whitelist["/universerpc.Universe/QueryUniverseProof"] =
+			struct{}{}
+		whitelist["/universerpc.Universe/UploadMailboxProof"] =
+			struct{}{}
+		whitelist["/universerpc.Universe/FetchMailboxProofs"] =
+			struct{}{}
 	}
 
 	// Conditionally add public stats RPC endpoints to the whitelist.
 	if allowPublicStats {
 		whitelist["/universerpc.Universe/QueryAssetStats"] = struct{}{}
+		whitelist["/universerpc.Universe/QueryAssetSupply"] = struct{}{}
+		whitelist["/universerpc.Universe/QueryAssetUtxos"] = struct{}{}
 		whitelist["/universerpc.Universe/UniverseStats"] = struct{}{}
 		whitelist["/universerpc.Universe/QueryEvents"] = struct{}{}
 	}
@@ -101,6 +101,8 @@ func ParseCourierAddrUrl(addr url.URL) (CourierAddr, error) {
 		return NewHashMailCourierAddr(addr)
 	case UniverseRpcCourierType:
 		return NewUniverseRpcCourierAddr(addr)
+	case LightningCourierType:
+		return NewLightningCourierAddr(addr)
 	}
 
 	return nil, fmt.Errorf("unknown courier address protocol "+
@@ -256,6 +258,19 @@ type CourierCfg struct {
 	// DeliveryLog is the log that the courier will use to record the
 	// attempted delivery of proofs to the receiver.
 	DeliveryLog DeliveryLog
+
+	// LightningCourier configures the delivery of proofs over Lightning.
+	// If nil, the default config is used.
+	LightningCourier *LightningCourierCfg
+
+	// KeysendSender is used by the Lightning courier to send the keysend
+	// payments that carry the proofs.
+	KeysendSender KeysendSender
+
+	// LightningReceiver reassembles the proofs received over Lightning.
+	// If nil, proofs can only be received through the fallback courier
+	// of a Lightning courier address.
+	LightningReceiver *LightningProofReceiver
 }
 
 // ProofMailbox represents an abstract store-and-forward mailbox that can be
@@ -1037,29 +1052,24 @@ func (c *UniverseRpcCourier) DeliverProof(ctx context.Context,
 func (c *UniverseRpcCourier) ReceiveProof(ctx context.Context,
 	originLocator Locator) (*AnnotatedProof, error) {
 
-	// In order to reconstruct the proof file we must collect all the
-	// transition proofs that make up the main chain of proofs. That is
-	// accomplished by iterating backwards through the main chain of proofs
-	// until we reach the genesis point (minting proof).
-
-	// We will update the locator at each iteration.
-	loc := originLocator
-
-	// revProofs is a slice of transition proofs ordered from latest to
-	// earliest (the issuance proof comes last in the slice). This ordering
-	// is a reversal of that found in the proof file.
-	var revProofs []Proof
+	return FetchUniverseProofFile(ctx, c.client, originLocator)
+}
 
-	for {
-		assetID := *loc.AssetID
+// FetchUniverseProofFile reconstructs the full proof file of the asset
+// identified by the given locator from the transfer and issuance proofs
+// served by the given universe. The locator must have the asset ID and the
+// outpoint set.
+func FetchUniverseProofFile(ctx context.Context, client unirpc.UniverseClient,
+	originLocator Locator) (*AnnotatedProof, error) {
 
+	fetchProof := func(ctx context.Context, loc Locator) (*Proof, error) {
 		var groupKeyBytes []byte
 		if loc.GroupKey != nil {
 			groupKeyBytes = loc.GroupKey.SerializeCompressed()
 		}
 
 		universeID := unirpc.MarshalUniverseID(
-			assetID[:], groupKeyBytes,
+			loc.AssetID[:], groupKeyBytes,
 		)
 		assetKey := unirpc.MarshalAssetKey(
 			*loc.OutPoint, &loc.ScriptKey,
@@ -1069,7 +1079,7 @@ func (c *UniverseRpcCourier) ReceiveProof(ctx context.Context,
 			LeafKey: assetKey,
 		}
 
-		resp, err := c.client.QueryProof(ctx, &universeKey)
+		resp, err := client.QueryProof(ctx, &universeKey)
 		if err != nil {
 			return nil, err
 		}
@@ -1083,7 +1093,61 @@ func (c *UniverseRpcCourier) ReceiveProof(ctx context.Context,
 			return nil, err
 		}
 
-		revProofs = append(revProofs, transitionProof)
+		return &transitionProof, nil
+	}
+
+	proofFile, err := FetchProofProvenance(ctx, originLocator, fetchProof)
+	if err != nil {
+		return nil, err
+	}
+
+	// Encode the full proof file.
+	var buf bytes.Buffer
+	if err := proofFile.Encode(&buf); err != nil {
+		return nil, fmt.Errorf("error encoding proof file: %w", err)
+	}
+	proofFileBlob := buf.Bytes()
+
+	return &AnnotatedProof{
+		Locator: originLocator,
+		Blob:    proofFileBlob,
+	}, nil
+}
+
+// FetchProofProvenance reconstructs the full proof file of the asset
+// identified by the given locator, by following the chain of transition
+// proofs back to the genesis proof. The single transition proof identified by
+// a locator is fetched with the given function. The locator must have the
+// asset ID and the outpoint set.
+func FetchProofProvenance(ctx context.Context, originLocator Locator,
+	fetchProof func(context.Context, Locator) (*Proof, error)) (*File,
+	error) {
+
+	if originLocator.AssetID == nil || originLocator.OutPoint == nil {
+		return nil, fmt.Errorf("locator must have asset ID and " +
+			"outpoint set")
+	}
+
+	// In order to reconstruct the proof file we must collect all the
+	// transition proofs that make up the main chain of proofs. That is
+	// accomplished by iterating backwards through the main chain of proofs
+	// until we reach the genesis point (minting proof).
+
+	// We will update the locator at each iteration.
+	loc := originLocator
+
+	// revProofs is a slice of transition proofs ordered from latest to
+	// earliest (the issuance proof comes last in the slice). This ordering
+	// is a reversal of that found in the proof file.
+	var revProofs []Proof
+
+	for {
+		transitionProof, err := fetchProof(ctx, loc)
+		if err != nil {
+			return nil, err
+		}
+
+		revProofs = append(revProofs, *transitionProof)
 
 		// Break if we've reached the genesis point (the asset is the
 		// genesis asset).
@@ -1121,17 +1185,7 @@ func (c *UniverseRpcCourier) ReceiveProof(ctx context.Context,
 		}
 	}
 
-	// Encode the full proof file.
-	var buf bytes.Buffer
-	if err := proofFile.Encode(&buf); err != nil {
-		return nil, fmt.Errorf("error encoding proof file: %w", err)
-	}
-	proofFileBlob := buf.Bytes()
-
-	return &AnnotatedProof{
-		Locator: originLocator,
-		Blob:    proofFileBlob,
-	}, nil
+	return proofFile, nil
 }
 
 // SetSubscribers sets the subscribers for the courier. This method is
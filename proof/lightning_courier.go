@@ -0,0 +1,852 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// LightningCourierType is a courier that delivers proofs inside the
+	// custom records of keysend payments to the Lightning node of the
+	// receiver.
+	LightningCourierType = "lightning"
+
+	// LightningFallbackParam is the name of the query parameter of a
+	// Lightning courier address that holds the address of the courier
+	// that is used for proofs that are too large to be sent over
+	// Lightning.
+	LightningFallbackParam = "fallback"
+)
+
+const (
+	// ProofTransferIDRecordType is the custom record type that holds the
+	// ID of a proof transfer, which is the SHA256 hash of the proof file.
+	ProofTransferIDRecordType uint64 = 0x74617001
+
+	// ProofChunkIndexRecordType is the custom record type that holds the
+	// index of the proof file chunk carried by a payment.
+	ProofChunkIndexRecordType uint64 = 0x74617003
+
+	// ProofChunkCountRecordType is the custom record type that holds the
+	// total number of chunks of a proof file.
+	ProofChunkCountRecordType uint64 = 0x74617005
+
+	// ProofChunkDataRecordType is the custom record type that holds the
+	// proof file chunk carried by a payment.
+	ProofChunkDataRecordType uint64 = 0x74617007
+)
+
+const (
+	// MaxLightningChunkSize is the maximum size of a proof file chunk
+	// carried by a single payment. The onion payload of a payment is
+	// limited to 1300 bytes, which needs to fit the per-hop payloads of
+	// the route as well.
+	MaxLightningChunkSize = 1000
+
+	// DefaultLightningChunkSize is the default size of the proof file
+	// chunks carried by a single payment.
+	DefaultLightningChunkSize = 800
+
+	// DefaultLightningMaxProofSize is the default size of the largest
+	// proof file that is delivered over Lightning. Larger proof files are
+	// delivered through the fallback courier.
+	DefaultLightningMaxProofSize = 16 * 1024
+
+	// DefaultLightningChunkAmt is the default amount that is paid to the
+	// receiver with each payment.
+	DefaultLightningChunkAmt = btcutil.Amount(1)
+
+	// DefaultLightningMaxFee is the default fee limit of each payment.
+	DefaultLightningMaxFee = btcutil.Amount(10)
+
+	// DefaultLightningPaymentTimeout is the default amount of time we try
+	// to route a single payment.
+	DefaultLightningPaymentTimeout = time.Minute
+
+	// lightningTransferExpiry is the amount of time the receiver keeps the
+	// chunks of an incomplete proof transfer.
+	lightningTransferExpiry = time.Hour
+
+	// lightningFallbackPollInterval is the interval at which the receiver
+	// polls the fallback courier while waiting for a proof.
+	lightningFallbackPollInterval = 30 * time.Second
+)
+
+var (
+	// ErrProofTooLarge is returned if a proof file is too large to be
+	// delivered over Lightning and no fallback courier is configured.
+	ErrProofTooLarge = errors.New("proof file too large for Lightning " +
+		"courier")
+)
+
+// KeysendSender sends keysend payments to other Lightning nodes.
+type KeysendSender interface {
+	// SendPayment attempts to route a payment to the final destination.
+	// The call returns a payment update stream and an error stream.
+	SendPayment(ctx context.Context,
+		request lndclient.SendPaymentRequest) (
+		chan lndclient.PaymentStatus, chan error, error)
+}
+
+// InvoiceSubscriber is used to receive updates of the invoices of the local
+// Lightning node.
+type InvoiceSubscriber interface {
+	// SubscribeInvoices subscribes to updates of newly added and settled
+	// invoices.
+	SubscribeInvoices(ctx context.Context,
+		req lndclient.InvoiceSubscriptionRequest) (
+		<-chan *lndclient.Invoice, <-chan error, error)
+}
+
+// LightningCourierCfg is the config for the Lightning proof courier.
+type LightningCourierCfg struct {
+	// MaxProofSize is the size of the largest proof file that is
+	// delivered over Lightning.
+	MaxProofSize int `long:"maxproofsize" description:"The size in bytes of the largest proof file that is delivered over Lightning. Larger proof files are delivered through the fallback courier of the address."`
+
+	// ChunkSize is the size of the proof file chunk carried by a single
+	// payment.
+	ChunkSize int `long:"chunksize" description:"The size in bytes of the proof file chunk carried by a single keysend payment."`
+
+	// ChunkAmt is the amount paid to the receiver with each payment.
+	ChunkAmt btcutil.Amount `long:"chunkamt" description:"The amount in satoshis paid to the receiver with each keysend payment."`
+
+	// MaxFee is the fee limit of each payment.
+	MaxFee btcutil.Amount `long:"maxfee" description:"The fee limit in satoshis of each keysend payment."`
+
+	// PaymentTimeout is the amount of time we try to route a single
+	// payment.
+	PaymentTimeout time.Duration `long:"paymenttimeout" description:"The amount of time to try to route a single keysend payment."`
+}
+
+// DefaultLightningCourierCfg returns the default config of the Lightning proof
+// courier.
+func DefaultLightningCourierCfg() *LightningCourierCfg {
+	return &LightningCourierCfg{
+		MaxProofSize:   DefaultLightningMaxProofSize,
+		ChunkSize:      DefaultLightningChunkSize,
+		ChunkAmt:       DefaultLightningChunkAmt,
+		MaxFee:         DefaultLightningMaxFee,
+		PaymentTimeout: DefaultLightningPaymentTimeout,
+	}
+}
+
+// LightningCourierAddr is a Lightning specific implementation of the
+// CourierAddr interface. The host of the address is the public key of the
+// Lightning node of the receiver.
+type LightningCourierAddr struct {
+	addr url.URL
+
+	// node is the Lightning node of the receiver.
+	node route.Vertex
+
+	// fallback is the courier address that is used for proofs that are
+	// too large to be sent over Lightning. This is nil if no fallback
+	// courier was specified.
+	fallback CourierAddr
+}
+
+// Url returns the url.URL representation of the Lightning courier address.
+func (l *LightningCourierAddr) Url() *url.URL {
+	return &l.addr
+}
+
+// Node returns the Lightning node of the receiver.
+func (l *LightningCourierAddr) Node() route.Vertex {
+	return l.node
+}
+
+// NewCourier generates a new courier service handle.
+func (l *LightningCourierAddr) NewCourier(ctx context.Context,
+	cfg *CourierCfg, recipient Recipient) (Courier, error) {
+
+	lnCfg := cfg.LightningCourier
+	if lnCfg == nil {
+		lnCfg = DefaultLightningCourierCfg()
+	}
+
+	var fallback Courier
+	if l.fallback != nil {
+		var err error
+		fallback, err = l.fallback.NewCourier(ctx, cfg, recipient)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create fallback "+
+				"courier: %w", err)
+		}
+	}
+
+	subscribers := make(
+		map[uint64]*fn.EventReceiver[fn.Event],
+	)
+
+	return &LightningCourier{
+		cfg:         lnCfg,
+		recipient:   recipient,
+		node:        l.node,
+		sender:      cfg.KeysendSender,
+		receiver:    cfg.LightningReceiver,
+		fallback:    fallback,
+		deliveryLog: cfg.DeliveryLog,
+		subscribers: subscribers,
+	}, nil
+}
+
+// NewLightningCourierAddr generates a new Lightning courier address from a
+// given URL. This function also performs Lightning specific address
+// validation.
+func NewLightningCourierAddr(addr url.URL) (*LightningCourierAddr, error) {
+	if addr.Scheme != LightningCourierType {
+		return nil, fmt.Errorf("expected lightning courier protocol: "+
+			"%v", addr.Scheme)
+	}
+
+	node, err := route.NewVertexFromStr(addr.Hostname())
+	if err != nil {
+		return nil, fmt.Errorf("invalid lightning node public key: %w",
+			err)
+	}
+
+	courierAddr := &LightningCourierAddr{
+		addr: addr,
+		node: node,
+	}
+
+	fallbackAddr := addr.Query().Get(LightningFallbackParam)
+	if fallbackAddr == "" {
+		return courierAddr, nil
+	}
+
+	fallback, err := ParseCourierAddrString(fallbackAddr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fallback courier address: %w",
+			err)
+	}
+	if fallback.Url().Scheme == LightningCourierType {
+		return nil, fmt.Errorf("fallback courier must not be a " +
+			"lightning courier")
+	}
+	courierAddr.fallback = fallback
+
+	return courierAddr, nil
+}
+
+// proofChunk is a single chunk of a proof file, as carried by the custom
+// records of a keysend payment.
+type proofChunk struct {
+	// transferID identifies the proof transfer the chunk belongs to.
+	transferID [sha256.Size]byte
+
+	// index is the index of the chunk within the proof file.
+	index uint16
+
+	// count is the total number of chunks of the proof file.
+	count uint16
+
+	// data is the chunk of the proof file.
+	data []byte
+}
+
+// records returns the custom records that carry the chunk.
+func (c *proofChunk) records() map[uint64][]byte {
+	var index, count [2]byte
+	binary.BigEndian.PutUint16(index[:], c.index)
+	binary.BigEndian.PutUint16(count[:], c.count)
+
+	return map[uint64][]byte{
+		ProofTransferIDRecordType: c.transferID[:],
+		ProofChunkIndexRecordType: index[:],
+		ProofChunkCountRecordType: count[:],
+		ProofChunkDataRecordType:  c.data,
+	}
+}
+
+// chunkProof splits the given proof file into chunks of at most the given
+// size.
+func chunkProof(blob Blob, chunkSize int) ([]*proofChunk, error) {
+	if len(blob) == 0 {
+		return nil, fmt.Errorf("empty proof file")
+	}
+	if chunkSize <= 0 || chunkSize > MaxLightningChunkSize {
+		return nil, fmt.Errorf("invalid chunk size %d", chunkSize)
+	}
+
+	numChunks := (len(blob) + chunkSize - 1) / chunkSize
+	if numChunks > int(^uint16(0)) {
+		return nil, fmt.Errorf("proof file of %d bytes has too many "+
+			"chunks", len(blob))
+	}
+
+	transferID := sha256.Sum256(blob)
+	chunks := make([]*proofChunk, 0, numChunks)
+	for i := 0; i < numChunks; i++ {
+		end := (i + 1) * chunkSize
+		if end > len(blob) {
+			end = len(blob)
+		}
+
+		chunks = append(chunks, &proofChunk{
+			transferID: transferID,
+			index:      uint16(i),
+			count:      uint16(numChunks),
+			data:       blob[i*chunkSize : end],
+		})
+	}
+
+	return chunks, nil
+}
+
+// decodeProofChunk decodes a proof file chunk from the given custom records.
+// If the records don't carry a proof file chunk, then nil is returned.
+func decodeProofChunk(records map[uint64][]byte) (*proofChunk, error) {
+	transferID, ok := records[ProofTransferIDRecordType]
+	if !ok {
+		return nil, nil
+	}
+
+	index := records[ProofChunkIndexRecordType]
+	count := records[ProofChunkCountRecordType]
+	data := records[ProofChunkDataRecordType]
+	switch {
+	case len(transferID) != sha256.Size:
+		return nil, fmt.Errorf("invalid transfer ID length %d",
+			len(transferID))
+
+	case len(index) != 2 || len(count) != 2:
+		return nil, fmt.Errorf("invalid chunk index or count")
+
+	case len(data) == 0:
+		return nil, fmt.Errorf("empty chunk")
+	}
+
+	chunk := &proofChunk{
+		index: binary.BigEndian.Uint16(index),
+		count: binary.BigEndian.Uint16(count),
+		data:  data,
+	}
+	copy(chunk.transferID[:], transferID)
+
+	if chunk.index >= chunk.count {
+		return nil, fmt.Errorf("chunk index %d out of range, count=%d",
+			chunk.index, chunk.count)
+	}
+
+	return chunk, nil
+}
+
+// pendingTransfer is a proof transfer of which not all chunks were received
+// yet.
+type pendingTransfer struct {
+	// count is the total number of chunks of the proof file.
+	count uint16
+
+	// chunks holds the received chunks, keyed by their index.
+	chunks map[uint16][]byte
+
+	// size is the total size of the received chunks.
+	size int
+
+	// firstSeen is the time the first chunk of the transfer was received.
+	firstSeen time.Time
+}
+
+// proofAssembler reassembles proof files from their chunks.
+type proofAssembler struct {
+	// maxProofSize is the size of the largest proof file that is
+	// reassembled.
+	maxProofSize int
+
+	// pending holds the incomplete transfers, keyed by their transfer ID.
+	pending map[[sha256.Size]byte]*pendingTransfer
+}
+
+// newProofAssembler creates a new proof file assembler.
+func newProofAssembler(maxProofSize int) *proofAssembler {
+	return &proofAssembler{
+		maxProofSize: maxProofSize,
+		pending:      make(map[[sha256.Size]byte]*pendingTransfer),
+	}
+}
+
+// addChunk adds the given chunk to its transfer. If this completes the
+// transfer, then the reassembled proof file is returned.
+func (p *proofAssembler) addChunk(chunk *proofChunk, now time.Time) (Blob,
+	error) {
+
+	transfer, ok := p.pending[chunk.transferID]
+	if !ok {
+		transfer = &pendingTransfer{
+			count:     chunk.count,
+			chunks:    make(map[uint16][]byte, chunk.count),
+			firstSeen: now,
+		}
+		p.pending[chunk.transferID] = transfer
+	}
+
+	if chunk.count != transfer.count {
+		return nil, fmt.Errorf("chunk count mismatch for transfer "+
+			"%x: %d vs %d", chunk.transferID[:], chunk.count,
+			transfer.count)
+	}
+
+	// A chunk might be delivered twice if the sender retried a payment.
+	if _, ok := transfer.chunks[chunk.index]; ok {
+		return nil, nil
+	}
+
+	transfer.chunks[chunk.index] = chunk.data
+	transfer.size += len(chunk.data)
+	if transfer.size > p.maxProofSize {
+		delete(p.pending, chunk.transferID)
+
+		return nil, fmt.Errorf("transfer %x exceeds max proof size "+
+			"of %d bytes", chunk.transferID[:], p.maxProofSize)
+	}
+
+	if len(transfer.chunks) < int(transfer.count) {
+		return nil, nil
+	}
+
+	delete(p.pending, chunk.transferID)
+
+	var buf bytes.Buffer
+	for i := uint16(0); i < transfer.count; i++ {
+		buf.Write(transfer.chunks[i])
+	}
+	blob := Blob(buf.Bytes())
+
+	if sha256.Sum256(blob) != chunk.transferID {
+		return nil, fmt.Errorf("reassembled proof file doesn't match "+
+			"transfer ID %x", chunk.transferID[:])
+	}
+
+	return blob, nil
+}
+
+// prune removes all incomplete transfers of which the first chunk was
+// received before the given time.
+func (p *proofAssembler) prune(before time.Time) {
+	for id, transfer := range p.pending {
+		if transfer.firstSeen.Before(before) {
+			log.Debugf("Removing expired Lightning proof transfer "+
+				"%x with %d of %d chunks", id[:],
+				len(transfer.chunks), transfer.count)
+
+			delete(p.pending, id)
+		}
+	}
+}
+
+// LightningReceiverConfig is the config for the Lightning proof receiver.
+type LightningReceiverConfig struct {
+	// Invoices is used to receive the keysend payments that carry the
+	// proof file chunks.
+	Invoices InvoiceSubscriber
+
+	// MaxProofSize is the size of the largest proof file that is accepted.
+	// If zero, DefaultLightningMaxProofSize is used.
+	MaxProofSize int
+}
+
+// LightningProofReceiver reassembles the proof files that are delivered in
+// chunks inside the custom records of keysend payments to the local Lightning
+// node. The reassembled proof files are kept in memory until they're picked up
+// by the Lightning courier of the receiving address.
+//
+// NOTE: Only payments that are settled while the receiver is running are
+// seen. Proofs sent while tapd is offline need to be received through the
+// fallback courier.
+type LightningProofReceiver struct {
+	cfg LightningReceiverConfig
+
+	assembler *proofAssembler
+
+	// proofs holds the reassembled proof files, keyed by the script key
+	// of the asset of their last proof.
+	proofs map[asset.SerializedKey]Blob
+
+	// newProof is closed and replaced whenever a proof file was
+	// reassembled, to wake up the couriers waiting for a proof.
+	newProof chan struct{}
+
+	mtx sync.Mutex
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*fn.ContextGuard
+}
+
+// NewLightningProofReceiver creates a new Lightning proof receiver from the
+// given config.
+func NewLightningProofReceiver(
+	cfg LightningReceiverConfig) *LightningProofReceiver {
+
+	if cfg.MaxProofSize == 0 {
+		cfg.MaxProofSize = DefaultLightningMaxProofSize
+	}
+
+	return &LightningProofReceiver{
+		cfg:       cfg,
+		assembler: newProofAssembler(cfg.MaxProofSize),
+		proofs:    make(map[asset.SerializedKey]Blob),
+		newProof:  make(chan struct{}),
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: defaultMailboxTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start subscribes to the invoices of the local Lightning node.
+func (r *LightningProofReceiver) Start() error {
+	log.Infof("Starting Lightning proof receiver, max_proof_size=%d",
+		r.cfg.MaxProofSize)
+
+	ctx, cancel := r.WithCtxQuitNoTimeout()
+
+	invoices, errChan, err := r.cfg.Invoices.SubscribeInvoices(
+		ctx, lndclient.InvoiceSubscriptionRequest{},
+	)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("unable to subscribe to invoices: %w", err)
+	}
+
+	r.Wg.Add(1)
+	go func() {
+		defer cancel()
+		r.receiveChunks(invoices, errChan)
+	}()
+
+	return nil
+}
+
+// Stop stops the receiver.
+func (r *LightningProofReceiver) Stop() error {
+	log.Info("Stopping Lightning proof receiver")
+
+	close(r.Quit)
+	r.Wg.Wait()
+
+	return nil
+}
+
+// receiveChunks reassembles the proof files from the chunks carried by the
+// settled keysend payments.
+//
+// NOTE: This function MUST be run as a goroutine.
+func (r *LightningProofReceiver) receiveChunks(
+	invoices <-chan *lndclient.Invoice, errChan <-chan error) {
+
+	defer r.Wg.Done()
+
+	for {
+		select {
+		case invoice, ok := <-invoices:
+			if !ok {
+				return
+			}
+
+			if !invoice.IsKeysend || invoice.SettleIndex == 0 {
+				continue
+			}
+
+			for _, htlc := range invoice.Htlcs {
+				r.handleRecords(htlc.CustomRecords)
+			}
+
+		case err, ok := <-errChan:
+			if ok && err != nil {
+				log.Errorf("Invoice subscription failed, no "+
+					"longer receiving Lightning proofs: %v",
+					err)
+			}
+
+			return
+
+		case <-r.Quit:
+			return
+		}
+	}
+}
+
+// handleRecords handles the custom records of a single HTLC, which might carry
+// a proof file chunk.
+func (r *LightningProofReceiver) handleRecords(records map[uint64][]byte) {
+	chunk, err := decodeProofChunk(records)
+	if err != nil {
+		log.Warnf("Unable to decode Lightning proof chunk: %v", err)
+		return
+	}
+	if chunk == nil {
+		return
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	now := time.Now()
+	r.assembler.prune(now.Add(-lightningTransferExpiry))
+
+	blob, err := r.assembler.addChunk(chunk, now)
+	if err != nil {
+		log.Warnf("Unable to add Lightning proof chunk: %v", err)
+		return
+	}
+	if blob == nil {
+		return
+	}
+
+	var file File
+	if err := file.Decode(bytes.NewReader(blob)); err != nil {
+		log.Warnf("Unable to decode Lightning proof file: %v", err)
+		return
+	}
+	lastProof, err := file.LastProof()
+	if err != nil {
+		log.Warnf("Invalid Lightning proof file: %v", err)
+		return
+	}
+	scriptKey := lastProof.Asset.ScriptKey.PubKey
+	if scriptKey == nil {
+		log.Warnf("Lightning proof file is missing script key")
+		return
+	}
+
+	log.Debugf("Received proof file with %d proofs over Lightning for "+
+		"script_key=%x", file.NumProofs(),
+		scriptKey.SerializeCompressed())
+
+	r.proofs[asset.ToSerialized(scriptKey)] = blob
+
+	close(r.newProof)
+	r.newProof = make(chan struct{})
+}
+
+// takeProof returns and removes the reassembled proof file of the given script
+// key. If no proof file was received for the script key yet, then nil is
+// returned, together with a channel that is closed once the next proof file
+// was reassembled.
+func (r *LightningProofReceiver) takeProof(
+	scriptKey *btcec.PublicKey) (Blob, <-chan struct{}) {
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	key := asset.ToSerialized(scriptKey)
+	if blob, ok := r.proofs[key]; ok {
+		delete(r.proofs, key)
+		return blob, nil
+	}
+
+	return nil, r.newProof
+}
+
+// LightningCourier is a proof courier service handle that delivers proofs in
+// chunks inside the custom records of keysend payments to the Lightning node
+// of the receiver. It implements the Courier interface.
+type LightningCourier struct {
+	cfg *LightningCourierCfg
+
+	// recipient describes the recipient of the proof.
+	recipient Recipient
+
+	// node is the Lightning node of the receiver.
+	node route.Vertex
+
+	// sender is used to send the keysend payments.
+	sender KeysendSender
+
+	// receiver reassembles the proofs received by the local Lightning
+	// node.
+	receiver *LightningProofReceiver
+
+	// fallback is the courier that is used for proofs that are too large
+	// to be sent over Lightning. This is nil if the address doesn't
+	// specify a fallback courier.
+	fallback Courier
+
+	// deliveryLog is the log that the courier will use to record the
+	// attempted delivery of proofs to the receiver.
+	deliveryLog DeliveryLog
+
+	// subscribers is a map of components that want to be notified on new
+	// events, keyed by their subscription ID.
+	subscribers map[uint64]*fn.EventReceiver[fn.Event]
+
+	// subscriberMtx guards the subscribers map and access to the
+	// subscriptionID.
+	subscriberMtx sync.Mutex
+}
+
+// DeliverProof attempts to deliver a proof file to the receiver. The proof
+// file is sent in chunks, one keysend payment per chunk. Proof files larger
+// than the configured maximum size are delivered through the fallback
+// courier.
+func (c *LightningCourier) DeliverProof(ctx context.Context,
+	proof *AnnotatedProof) error {
+
+	if len(proof.Blob) > c.cfg.MaxProofSize {
+		if c.fallback == nil {
+			return fmt.Errorf("%w: %d bytes exceed limit of %d "+
+				"bytes", ErrProofTooLarge, len(proof.Blob),
+				c.cfg.MaxProofSize)
+		}
+
+		log.Infof("Proof file of %d bytes exceeds Lightning limit, "+
+			"delivering through fallback courier", len(proof.Blob))
+
+		return c.fallback.DeliverProof(ctx, proof)
+	}
+
+	if c.sender == nil {
+		return fmt.Errorf("lightning courier has no keysend sender")
+	}
+
+	chunks, err := chunkProof(proof.Blob, c.cfg.ChunkSize)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Attempting to deliver receiver proof over Lightning for "+
+		"send of asset_id=%v, amt=%v, node=%v, num_chunks=%d",
+		c.recipient.AssetID, c.recipient.Amount, c.node, len(chunks))
+
+	// Before attempting to deliver the proof, log that an attempted
+	// delivery is about to occur.
+	err = c.deliveryLog.StoreProofDeliveryAttempt(ctx, proof.Locator)
+	if err != nil {
+		return fmt.Errorf("unable to log proof delivery attempt: %w",
+			err)
+	}
+
+	for _, chunk := range chunks {
+		if err := c.sendChunk(ctx, chunk); err != nil {
+			return fmt.Errorf("unable to send proof chunk %d of "+
+				"%d: %w", chunk.index+1, chunk.count, err)
+		}
+	}
+
+	return nil
+}
+
+// sendChunk sends a single proof file chunk with a keysend payment and waits
+// for the payment to complete.
+func (c *LightningCourier) sendChunk(ctx context.Context,
+	chunk *proofChunk) error {
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	statusChan, errChan, err := c.sender.SendPayment(
+		ctx, lndclient.SendPaymentRequest{
+			Target:        c.node,
+			Amount:        c.cfg.ChunkAmt,
+			MaxFee:        c.cfg.MaxFee,
+			Timeout:       c.cfg.PaymentTimeout,
+			KeySend:       true,
+			CustomRecords: chunk.records(),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case status := <-statusChan:
+			switch status.State {
+			case lnrpc.Payment_SUCCEEDED:
+				return nil
+
+			case lnrpc.Payment_FAILED:
+				return fmt.Errorf("payment failed: %v",
+					status.FailureReason)
+			}
+
+		case err := <-errChan:
+			return err
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// ReceiveProof waits for the proof file identified by the given locator to be
+// received over Lightning. If the address specifies a fallback courier, then
+// the fallback courier is polled as well.
+func (c *LightningCourier) ReceiveProof(ctx context.Context,
+	loc Locator) (*AnnotatedProof, error) {
+
+	if c.receiver == nil && c.fallback == nil {
+		return nil, fmt.Errorf("lightning proof receiver not active")
+	}
+
+	pollTicker := time.NewTicker(lightningFallbackPollInterval)
+	defer pollTicker.Stop()
+
+	for {
+		var newProof <-chan struct{}
+		if c.receiver != nil {
+			var blob Blob
+			blob, newProof = c.receiver.takeProof(&loc.ScriptKey)
+			if blob != nil {
+				return &AnnotatedProof{
+					Locator: loc,
+					Blob:    blob,
+				}, nil
+			}
+		}
+
+		if c.fallback != nil {
+			proof, err := c.fallback.ReceiveProof(ctx, loc)
+			if err == nil {
+				return proof, nil
+			}
+
+			log.Debugf("Proof not yet available from fallback "+
+				"courier: %v", err)
+		}
+
+		select {
+		case <-newProof:
+		case <-pollTicker.C:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// SetSubscribers sets the subscribers for the courier. This method is
+// thread-safe.
+func (c *LightningCourier) SetSubscribers(
+	subscribers map[uint64]*fn.EventReceiver[fn.Event]) {
+
+	c.subscriberMtx.Lock()
+	defer c.subscriberMtx.Unlock()
+
+	c.subscribers = subscribers
+
+	if c.fallback != nil {
+		c.fallback.SetSubscribers(subscribers)
+	}
+}
+
+// A compile-time assertion to ensure the LightningCourier meets the
+// proof.Courier interface.
+var _ Courier = (*LightningCourier)(nil)
@@ -0,0 +1,243 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// mockDeliveryLog is a delivery log that only counts the delivery attempts.
+type mockDeliveryLog struct {
+	numAttempts int
+}
+
+func (m *mockDeliveryLog) StoreProofDeliveryAttempt(context.Context,
+	Locator) error {
+
+	m.numAttempts++
+	return nil
+}
+
+func (m *mockDeliveryLog) QueryProofDeliveryLog(context.Context,
+	Locator) ([]time.Time, error) {
+
+	return nil, nil
+}
+
+// mockKeysendSender hands the custom records of all keysend payments directly
+// to the given Lightning proof receiver.
+type mockKeysendSender struct {
+	receiver *LightningProofReceiver
+
+	numPayments int
+}
+
+func (m *mockKeysendSender) SendPayment(_ context.Context,
+	req lndclient.SendPaymentRequest) (chan lndclient.PaymentStatus,
+	chan error, error) {
+
+	m.numPayments++
+	m.receiver.handleRecords(req.CustomRecords)
+
+	statusChan := make(chan lndclient.PaymentStatus, 1)
+	statusChan <- lndclient.PaymentStatus{
+		State: lnrpc.Payment_SUCCEEDED,
+	}
+
+	return statusChan, make(chan error), nil
+}
+
+// TestProofChunkReassembly tests that a proof file split into chunks is
+// reassembled in any order, and that invalid chunks are rejected.
+func TestProofChunkReassembly(t *testing.T) {
+	t.Parallel()
+
+	blob := Blob(test.RandBytes(2500))
+	chunks, err := chunkProof(blob, 800)
+	require.NoError(t, err)
+	require.Len(t, chunks, 4)
+
+	// Records that don't carry a chunk are ignored.
+	chunk, err := decodeProofChunk(map[uint64][]byte{65537: {1}})
+	require.NoError(t, err)
+	require.Nil(t, chunk)
+
+	// The chunks are delivered in reverse order, with one of them being
+	// delivered twice.
+	now := time.Now()
+	assembler := newProofAssembler(DefaultLightningMaxProofSize)
+	for i := len(chunks) - 1; i >= 0; i-- {
+		chunk, err := decodeProofChunk(chunks[i].records())
+		require.NoError(t, err)
+
+		result, err := assembler.addChunk(chunk, now)
+		require.NoError(t, err)
+
+		if i == 2 {
+			result, err = assembler.addChunk(chunk, now)
+			require.NoError(t, err)
+		}
+
+		if i > 0 {
+			require.Nil(t, result)
+			continue
+		}
+
+		require.Equal(t, blob, result)
+	}
+	require.Empty(t, assembler.pending)
+
+	// A chunk with an out of range index is rejected.
+	records := chunks[0].records()
+	records[ProofChunkIndexRecordType] = []byte{0, 4}
+	_, err = decodeProofChunk(records)
+	require.ErrorContains(t, err, "out of range")
+
+	// A transfer with a tampered chunk doesn't match its transfer ID.
+	tampered := *chunks[1]
+	tampered.data = test.RandBytes(800)
+	for _, chunk := range []*proofChunk{chunks[0], &tampered, chunks[2]} {
+		_, err := assembler.addChunk(chunk, now)
+		require.NoError(t, err)
+	}
+	_, err = assembler.addChunk(chunks[3], now)
+	require.ErrorContains(t, err, "doesn't match transfer ID")
+
+	// A transfer that exceeds the max proof size is dropped.
+	assembler = newProofAssembler(1000)
+	_, err = assembler.addChunk(chunks[0], now)
+	require.NoError(t, err)
+	_, err = assembler.addChunk(chunks[1], now)
+	require.ErrorContains(t, err, "exceeds max proof size")
+	require.Empty(t, assembler.pending)
+
+	// Incomplete transfers are removed once they expire.
+	_, err = assembler.addChunk(chunks[0], now)
+	require.NoError(t, err)
+	assembler.prune(now)
+	require.Len(t, assembler.pending, 1)
+	assembler.prune(now.Add(time.Second))
+	require.Empty(t, assembler.pending)
+}
+
+// TestLightningCourier tests that a proof file delivered by the Lightning
+// courier is reassembled by the receiver and handed to the courier waiting for
+// the proof of the same script key.
+func TestLightningCourier(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	amount := uint64(1000)
+	genesisProof, _ := genRandomGenesisWithProof(
+		t, asset.Normal, &amount, nil, true, nil, nil, asset.V0,
+	)
+	file, err := NewFile(V0, genesisProof)
+	require.NoError(t, err)
+
+	var fileBuf bytes.Buffer
+	require.NoError(t, file.Encode(&fileBuf))
+	blob := Blob(fileBuf.Bytes())
+
+	nodeKey := "02" + hex.EncodeToString(test.RandBytes(32))
+	addr, err := ParseCourierAddrString(
+		LightningCourierType + "://" + nodeKey,
+	)
+	require.NoError(t, err)
+
+	receiver := NewLightningProofReceiver(LightningReceiverConfig{})
+	sender := &mockKeysendSender{
+		receiver: receiver,
+	}
+	deliveryLog := &mockDeliveryLog{}
+	lnCfg := DefaultLightningCourierCfg()
+	lnCfg.ChunkSize = 100
+	cfg := &CourierCfg{
+		DeliveryLog:       deliveryLog,
+		LightningCourier:  lnCfg,
+		KeysendSender:     sender,
+		LightningReceiver: receiver,
+	}
+
+	scriptKey := genesisProof.Asset.ScriptKey.PubKey
+	courier, err := addr.NewCourier(ctx, cfg, Recipient{
+		ScriptKey: scriptKey,
+	})
+	require.NoError(t, err)
+
+	loc := Locator{
+		ScriptKey: *scriptKey,
+	}
+	err = courier.DeliverProof(ctx, &AnnotatedProof{
+		Locator: loc,
+		Blob:    blob,
+	})
+	require.NoError(t, err)
+	require.Equal(t, (len(blob)+99)/100, sender.numPayments)
+	require.Equal(t, 1, deliveryLog.numAttempts)
+
+	ctxt, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	received, err := courier.ReceiveProof(ctxt, loc)
+	require.NoError(t, err)
+	require.Equal(t, blob, received.Blob)
+
+	// A proof file that exceeds the limit can't be delivered without a
+	// fallback courier.
+	lnCfg.MaxProofSize = len(blob) - 1
+	err = courier.DeliverProof(ctx, &AnnotatedProof{
+		Locator: loc,
+		Blob:    blob,
+	})
+	require.ErrorIs(t, err, ErrProofTooLarge)
+}
+
+// TestLightningCourierAddr tests the parsing of Lightning courier addresses.
+func TestLightningCourierAddr(t *testing.T) {
+	t.Parallel()
+
+	nodeKey := "02" + hex.EncodeToString(test.RandBytes(32))
+
+	addr, err := NewLightningCourierAddr(url.URL{
+		Scheme: LightningCourierType,
+		Host:   nodeKey,
+	})
+	require.NoError(t, err)
+	require.Nil(t, addr.fallback)
+
+	fallback := url.Values{}
+	fallback.Set(LightningFallbackParam, "universerpc://localhost:10029")
+	addr, err = NewLightningCourierAddr(url.URL{
+		Scheme:   LightningCourierType,
+		Host:     nodeKey,
+		RawQuery: fallback.Encode(),
+	})
+	require.NoError(t, err)
+	require.Equal(
+		t, UniverseRpcCourierType, addr.fallback.Url().Scheme,
+	)
+
+	// The fallback courier can't be a Lightning courier itself.
+	fallback.Set(LightningFallbackParam, "lightning://"+nodeKey)
+	_, err = NewLightningCourierAddr(url.URL{
+		Scheme:   LightningCourierType,
+		Host:     nodeKey,
+		RawQuery: fallback.Encode(),
+	})
+	require.ErrorContains(t, err, "must not be a lightning courier")
+
+	_, err = NewLightningCourierAddr(url.URL{
+		Scheme: LightningCourierType,
+		Host:   "not-a-node",
+	})
+	require.ErrorContains(t, err, "invalid lightning node public key")
+}
@@ -0,0 +1,268 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+const (
+	// DefaultMailboxProofTTL is the default amount of time an uploaded
+	// proof file is kept in the mailbox before it expires.
+	DefaultMailboxProofTTL = 7 * 24 * time.Hour
+
+	// defaultMailboxPruneInterval is the interval at which expired proof
+	// files are removed from the mailbox.
+	defaultMailboxPruneInterval = time.Hour
+
+	// defaultMailboxTimeout is the default timeout we use for database
+	// operations of the mailbox.
+	defaultMailboxTimeout = 30 * time.Second
+)
+
+// MailboxProof is a proof file that was uploaded to the mailbox by the sender
+// of an asset, so the receiver can download it later.
+type MailboxProof struct {
+	// ScriptKey is the script key of the asset the proof file proves.
+	ScriptKey *btcec.PublicKey
+
+	// Blob is the raw proof file.
+	Blob Blob
+
+	// UploadTime is the time the proof file was (last) uploaded.
+	UploadTime time.Time
+
+	// ExpiryTime is the time after which the proof file is no longer
+	// served and will be removed from the mailbox.
+	ExpiryTime time.Time
+}
+
+// MailboxStore is used to persist the proof files that were uploaded to the
+// mailbox.
+type MailboxStore interface {
+	// StoreMailboxProof stores the given proof file. If the same proof
+	// file was already uploaded for the script key, then only its upload
+	// and expiry time are updated.
+	StoreMailboxProof(ctx context.Context, p MailboxProof) error
+
+	// FetchMailboxProofs returns all proof files for the given script key
+	// that didn't expire before the given time.
+	FetchMailboxProofs(ctx context.Context, scriptKey *btcec.PublicKey,
+		now time.Time) ([]MailboxProof, error)
+
+	// PruneMailboxProofs removes all proof files that expired before the
+	// given time and returns the number of removed proof files.
+	PruneMailboxProofs(ctx context.Context, now time.Time) (int64, error)
+}
+
+// MailboxConfig is the config for the proof courier mailbox.
+type MailboxConfig struct {
+	// Store is used to persist the uploaded proof files.
+	Store MailboxStore
+
+	// ProofTTL is the amount of time an uploaded proof file is kept before
+	// it expires. If zero, DefaultMailboxProofTTL is used.
+	ProofTTL time.Duration
+
+	// Clock is used to determine the upload and expiry time of proof
+	// files.
+	Clock clock.Clock
+
+	// UploadTokens is the set of auth tokens of which one is required to
+	// upload a proof file. If empty, then anyone can upload proof files.
+	UploadTokens []MailboxAuthToken
+
+	// FetchTokens is the set of auth tokens of which one is required to
+	// fetch the proof files of a script key. If empty, then anyone can
+	// fetch proof files.
+	FetchTokens []MailboxAuthToken
+}
+
+// Mailbox is a proof courier mailbox. The sender of an asset uploads the proof
+// file of the asset to the mailbox, keyed by the script key of the receiver,
+// and the receiver polls the mailbox to download it later. This allows two
+// nodes to complete a transfer without being online at the same time.
+type Mailbox struct {
+	cfg MailboxConfig
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*fn.ContextGuard
+}
+
+// NewMailbox creates a new proof courier mailbox from the given config.
+func NewMailbox(cfg MailboxConfig) *Mailbox {
+	if cfg.ProofTTL == 0 {
+		cfg.ProofTTL = DefaultMailboxProofTTL
+	}
+	if cfg.Clock == nil {
+		cfg.Clock = clock.NewDefaultClock()
+	}
+
+	return &Mailbox{
+		cfg: cfg,
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: defaultMailboxTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start starts the goroutine that periodically removes expired proof files.
+func (m *Mailbox) Start() error {
+	log.Infof("Starting proof courier mailbox, proof_ttl=%v, "+
+		"upload_auth=%v, fetch_auth=%v", m.cfg.ProofTTL,
+		len(m.cfg.UploadTokens) != 0, len(m.cfg.FetchTokens) != 0)
+
+	m.Wg.Add(1)
+	go m.pruner()
+
+	return nil
+}
+
+// Stop stops the mailbox.
+func (m *Mailbox) Stop() error {
+	log.Info("Stopping proof courier mailbox")
+
+	close(m.Quit)
+	m.Wg.Wait()
+
+	return nil
+}
+
+// UploadProof validates the structure of the given proof file and stores it
+// in the mailbox, keyed by the script key of the asset of its last proof.
+// If upload tokens are configured, then the given auth token must be valid
+// for the script key or the asset of the proof file. The stored proof file is
+// returned.
+func (m *Mailbox) UploadProof(ctx context.Context, blob Blob,
+	authToken string) (*MailboxProof, error) {
+
+	// We check the token before decoding the proof file, so we don't waste
+	// any resources on unauthorized uploads.
+	var uploadToken *MailboxAuthToken
+	if len(m.cfg.UploadTokens) != 0 {
+		uploadToken = findAuthToken(m.cfg.UploadTokens, authToken)
+		if uploadToken == nil {
+			return nil, fmt.Errorf("%w: missing or unknown upload "+
+				"token", ErrMailboxUnauthorized)
+		}
+	}
+
+	if err := CheckMaxFileSize(blob); err != nil {
+		return nil, err
+	}
+
+	if !IsProofFile(blob) {
+		return nil, fmt.Errorf("blob is not a proof file")
+	}
+
+	var file File
+	if err := file.Decode(bytes.NewReader(blob)); err != nil {
+		return nil, fmt.Errorf("unable to decode proof file: %w", err)
+	}
+
+	lastProof, err := file.LastProof()
+	if err != nil {
+		return nil, fmt.Errorf("invalid proof file: %w", err)
+	}
+
+	scriptKey := lastProof.Asset.ScriptKey.PubKey
+	if scriptKey == nil {
+		return nil, fmt.Errorf("last proof is missing script key")
+	}
+
+	assetID := lastProof.Asset.ID()
+	if uploadToken != nil && !uploadToken.allowsScriptKey(scriptKey) &&
+		!uploadToken.allowsAsset(assetID) {
+
+		return nil, fmt.Errorf("%w: upload token not valid for "+
+			"script_key=%x, asset_id=%v", ErrMailboxUnauthorized,
+			scriptKey.SerializeCompressed(), assetID)
+	}
+
+	now := m.cfg.Clock.Now().UTC()
+	mailboxProof := &MailboxProof{
+		ScriptKey:  scriptKey,
+		Blob:       blob,
+		UploadTime: now,
+		ExpiryTime: now.Add(m.cfg.ProofTTL),
+	}
+	err = m.cfg.Store.StoreMailboxProof(ctx, *mailboxProof)
+	if err != nil {
+		return nil, fmt.Errorf("unable to store proof: %w", err)
+	}
+
+	log.Debugf("Stored proof file with %d proofs in mailbox for "+
+		"script_key=%x", file.NumProofs(),
+		scriptKey.SerializeCompressed())
+
+	return mailboxProof, nil
+}
+
+// FetchProofs returns all proof files in the mailbox for the given script
+// key that didn't expire yet. If fetch tokens are configured, then the given
+// auth token must be valid for the script key.
+func (m *Mailbox) FetchProofs(ctx context.Context, scriptKey *btcec.PublicKey,
+	authToken string) ([]MailboxProof, error) {
+
+	if len(m.cfg.FetchTokens) != 0 {
+		fetchToken := findAuthToken(m.cfg.FetchTokens, authToken)
+		if fetchToken == nil || !fetchToken.allowsScriptKey(scriptKey) {
+			return nil, fmt.Errorf("%w: fetch token not valid for "+
+				"script_key=%x", ErrMailboxUnauthorized,
+				scriptKey.SerializeCompressed())
+		}
+	}
+
+	return m.cfg.Store.FetchMailboxProofs(
+		ctx, scriptKey, m.cfg.Clock.Now().UTC(),
+	)
+}
+
+// pruneProofs removes all expired proof files from the mailbox.
+func (m *Mailbox) pruneProofs() {
+	ctx, cancel := m.WithCtxQuit()
+	defer cancel()
+
+	numPruned, err := m.cfg.Store.PruneMailboxProofs(
+		ctx, m.cfg.Clock.Now().UTC(),
+	)
+	if err != nil {
+		log.Warnf("Unable to prune expired mailbox proofs: %v", err)
+		return
+	}
+
+	if numPruned > 0 {
+		log.Infof("Pruned %d expired proofs from mailbox", numPruned)
+	}
+}
+
+// pruner is the goroutine that periodically removes expired proof files from
+// the mailbox.
+//
+// NOTE: This function MUST be run as a goroutine.
+func (m *Mailbox) pruner() {
+	defer m.Wg.Done()
+
+	pruneTicker := time.NewTicker(defaultMailboxPruneInterval)
+	defer pruneTicker.Stop()
+
+	// Remove any proofs that expired while we were offline.
+	m.pruneProofs()
+
+	for {
+		select {
+		case <-pruneTicker.C:
+			m.pruneProofs()
+
+		case <-m.Quit:
+			return
+		}
+	}
+}
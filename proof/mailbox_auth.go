@@ -0,0 +1,170 @@
+package proof
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/asset"
+)
+
+const (
+	// mailboxScopeScriptKey is the prefix of a token scope entry that
+	// restricts the token to a script key.
+	mailboxScopeScriptKey = "scriptkey:"
+
+	// mailboxScopeAsset is the prefix of a token scope entry that restricts
+	// the token to an asset ID.
+	mailboxScopeAsset = "asset:"
+)
+
+var (
+	// ErrMailboxUnauthorized is returned when a request to the mailbox
+	// doesn't carry a valid auth token, or if the token isn't valid for
+	// the script key or asset of the request.
+	ErrMailboxUnauthorized = errors.New("mailbox request not authorized")
+)
+
+// MailboxAuthToken is a bearer token that authorizes requests to the proof
+// courier mailbox. A token without any script keys or asset IDs is valid for
+// all requests, otherwise only for those that match one of them.
+type MailboxAuthToken struct {
+	// Token is the secret bearer token.
+	Token string
+
+	// ScriptKeys is the set of script keys the token is valid for.
+	ScriptKeys []*btcec.PublicKey
+
+	// AssetIDs is the set of asset IDs the token is valid for. This only
+	// applies to uploads, as the asset of a proof file isn't known before
+	// it is fetched.
+	AssetIDs []asset.ID
+}
+
+// ParseMailboxAuthToken parses a mailbox auth token of the form token or
+// token=scope,scope,... where each scope is either scriptkey:<hex> with the
+// x-only or compressed script key, or asset:<hex> with the asset ID.
+func ParseMailboxAuthToken(tokenStr string) (MailboxAuthToken, error) {
+	var authToken MailboxAuthToken
+
+	token, scopes, hasScopes := strings.Cut(tokenStr, "=")
+	if token == "" {
+		return authToken, fmt.Errorf("token must not be empty")
+	}
+	authToken.Token = token
+
+	if !hasScopes {
+		return authToken, nil
+	}
+
+	for _, scope := range strings.Split(scopes, ",") {
+		switch {
+		case strings.HasPrefix(scope, mailboxScopeScriptKey):
+			keyHex := strings.TrimPrefix(
+				scope, mailboxScopeScriptKey,
+			)
+			keyBytes, err := hex.DecodeString(keyHex)
+			if err != nil {
+				return authToken, fmt.Errorf("invalid script "+
+					"key %v: %w", keyHex, err)
+			}
+
+			var scriptKey *btcec.PublicKey
+			if len(keyBytes) == schnorr.PubKeyBytesLen {
+				scriptKey, err = schnorr.ParsePubKey(keyBytes)
+			} else {
+				scriptKey, err = btcec.ParsePubKey(keyBytes)
+			}
+			if err != nil {
+				return authToken, fmt.Errorf("invalid script "+
+					"key %v: %w", keyHex, err)
+			}
+
+			authToken.ScriptKeys = append(
+				authToken.ScriptKeys, scriptKey,
+			)
+
+		case strings.HasPrefix(scope, mailboxScopeAsset):
+			idHex := strings.TrimPrefix(scope, mailboxScopeAsset)
+			idBytes, err := hex.DecodeString(idHex)
+			if err != nil || len(idBytes) != len(asset.ID{}) {
+				return authToken, fmt.Errorf("invalid "+
+					"asset ID %v", idHex)
+			}
+
+			var assetID asset.ID
+			copy(assetID[:], idBytes)
+			authToken.AssetIDs = append(authToken.AssetIDs, assetID)
+
+		default:
+			return authToken, fmt.Errorf("invalid token scope %v, "+
+				"expected %v<hex> or %v<hex>", scope,
+				mailboxScopeScriptKey, mailboxScopeAsset)
+		}
+	}
+
+	return authToken, nil
+}
+
+// unscoped returns true if the token is valid for all requests.
+func (t *MailboxAuthToken) unscoped() bool {
+	return len(t.ScriptKeys) == 0 && len(t.AssetIDs) == 0
+}
+
+// allowsScriptKey returns true if the token is valid for the given script
+// key.
+func (t *MailboxAuthToken) allowsScriptKey(scriptKey *btcec.PublicKey) bool {
+	if t.unscoped() {
+		return true
+	}
+
+	// The mailbox only tracks the x-only script key, so we ignore the
+	// parity of the keys.
+	xOnlyKey := schnorr.SerializePubKey(scriptKey)
+	for _, key := range t.ScriptKeys {
+		if bytes.Equal(schnorr.SerializePubKey(key), xOnlyKey) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// allowsAsset returns true if the token is valid for the given asset ID.
+func (t *MailboxAuthToken) allowsAsset(assetID asset.ID) bool {
+	if t.unscoped() {
+		return true
+	}
+
+	for _, id := range t.AssetIDs {
+		if id == assetID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findAuthToken returns the token of the given set that matches the given
+// bearer token, or nil if there is none. The tokens are compared in constant
+// time, to not leak any information about the configured tokens.
+func findAuthToken(tokens []MailboxAuthToken,
+	token string) *MailboxAuthToken {
+
+	var match *MailboxAuthToken
+	for idx := range tokens {
+		if subtle.ConstantTimeCompare(
+			[]byte(tokens[idx].Token), []byte(token),
+		) == 1 {
+
+			match = &tokens[idx]
+		}
+	}
+
+	return match
+}
@@ -0,0 +1,235 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockMailboxStore is an in-memory implementation of the MailboxStore.
+type mockMailboxStore struct {
+	proofs []MailboxProof
+}
+
+func (m *mockMailboxStore) StoreMailboxProof(_ context.Context,
+	p MailboxProof) error {
+
+	for i := range m.proofs {
+		if m.proofs[i].ScriptKey.IsEqual(p.ScriptKey) &&
+			bytes.Equal(m.proofs[i].Blob, p.Blob) {
+
+			m.proofs[i] = p
+			return nil
+		}
+	}
+
+	m.proofs = append(m.proofs, p)
+
+	return nil
+}
+
+func (m *mockMailboxStore) FetchMailboxProofs(_ context.Context,
+	scriptKey *btcec.PublicKey, now time.Time) ([]MailboxProof, error) {
+
+	var proofs []MailboxProof
+	for _, p := range m.proofs {
+		if bytes.Equal(
+			schnorr.SerializePubKey(p.ScriptKey),
+			schnorr.SerializePubKey(scriptKey),
+		) && p.ExpiryTime.After(now) {
+
+			proofs = append(proofs, p)
+		}
+	}
+
+	return proofs, nil
+}
+
+func (m *mockMailboxStore) PruneMailboxProofs(_ context.Context,
+	now time.Time) (int64, error) {
+
+	var (
+		remaining []MailboxProof
+		numPruned int64
+	)
+	for _, p := range m.proofs {
+		if p.ExpiryTime.After(now) {
+			remaining = append(remaining, p)
+			continue
+		}
+
+		numPruned++
+	}
+	m.proofs = remaining
+
+	return numPruned, nil
+}
+
+// TestMailboxUploadProof tests that proof files uploaded to the mailbox are
+// validated, stored under the script key of their last proof and expire
+// after the proof TTL.
+func TestMailboxUploadProof(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	const proofTTL = time.Hour
+	testClock := clock.NewTestClock(time.Now())
+	store := &mockMailboxStore{}
+	mailbox := NewMailbox(MailboxConfig{
+		Store:    store,
+		ProofTTL: proofTTL,
+		Clock:    testClock,
+	})
+
+	amount := uint64(1000)
+	genesisProof, _ := genRandomGenesisWithProof(
+		t, asset.Normal, &amount, nil, true, nil, nil, asset.V0,
+	)
+	file, err := NewFile(V0, genesisProof)
+	require.NoError(t, err)
+
+	var fileBuf bytes.Buffer
+	require.NoError(t, file.Encode(&fileBuf))
+	blob := Blob(fileBuf.Bytes())
+
+	// A single proof or a blob that isn't a proof at all is rejected.
+	var proofBuf bytes.Buffer
+	require.NoError(t, genesisProof.Encode(&proofBuf))
+	_, err = mailbox.UploadProof(ctx, proofBuf.Bytes(), "")
+	require.ErrorContains(t, err, "not a proof file")
+
+	_, err = mailbox.UploadProof(ctx, blob[:len(blob)/2], "")
+	require.ErrorContains(t, err, "unable to decode proof file")
+
+	// An empty proof file is rejected as well.
+	var emptyBuf bytes.Buffer
+	require.NoError(t, NewEmptyFile(V0).Encode(&emptyBuf))
+	_, err = mailbox.UploadProof(ctx, emptyBuf.Bytes(), "")
+	require.ErrorIs(t, err, ErrNoProofAvailable)
+
+	// A valid proof file is stored under the script key of the asset.
+	scriptKey := genesisProof.Asset.ScriptKey.PubKey
+	mailboxProof, err := mailbox.UploadProof(ctx, blob, "")
+	require.NoError(t, err)
+	require.True(t, scriptKey.IsEqual(mailboxProof.ScriptKey))
+	require.Equal(t, testClock.Now().Add(proofTTL).UTC(),
+		mailboxProof.ExpiryTime)
+
+	proofs, err := mailbox.FetchProofs(ctx, scriptKey, "")
+	require.NoError(t, err)
+	require.Len(t, proofs, 1)
+	require.Equal(t, blob, proofs[0].Blob)
+
+	// Uploading the same proof file again only extends its lifetime.
+	testClock.SetTime(testClock.Now().Add(proofTTL / 2))
+	_, err = mailbox.UploadProof(ctx, blob, "")
+	require.NoError(t, err)
+	require.Len(t, store.proofs, 1)
+
+	// After the original expiry time passed, the proof is still served,
+	// but not once the extended lifetime expired.
+	testClock.SetTime(testClock.Now().Add(proofTTL / 2))
+	proofs, err = mailbox.FetchProofs(ctx, scriptKey, "")
+	require.NoError(t, err)
+	require.Len(t, proofs, 1)
+
+	testClock.SetTime(testClock.Now().Add(proofTTL / 2))
+	proofs, err = mailbox.FetchProofs(ctx, scriptKey, "")
+	require.NoError(t, err)
+	require.Empty(t, proofs)
+
+	// Expired proofs are removed when pruning.
+	mailbox.pruneProofs()
+	require.Empty(t, store.proofs)
+}
+
+// TestMailboxAuthTokens tests that uploads and fetches are only accepted with
+// a token that is valid for the script key or asset of the request, if tokens
+// are configured.
+func TestMailboxAuthTokens(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	amount := uint64(1000)
+	genesisProof, _ := genRandomGenesisWithProof(
+		t, asset.Normal, &amount, nil, true, nil, nil, asset.V0,
+	)
+	file, err := NewFile(V0, genesisProof)
+	require.NoError(t, err)
+
+	var fileBuf bytes.Buffer
+	require.NoError(t, file.Encode(&fileBuf))
+	blob := Blob(fileBuf.Bytes())
+
+	scriptKey := genesisProof.Asset.ScriptKey.PubKey
+	scriptKeyHex := hex.EncodeToString(schnorr.SerializePubKey(scriptKey))
+	assetID := genesisProof.Asset.ID()
+	otherKey := test.RandPubKey(t)
+	otherKeyHex := hex.EncodeToString(otherKey.SerializeCompressed())
+
+	parseToken := func(tokenStr string) MailboxAuthToken {
+		token, err := ParseMailboxAuthToken(tokenStr)
+		require.NoError(t, err)
+
+		return token
+	}
+
+	mailbox := NewMailbox(MailboxConfig{
+		Store: &mockMailboxStore{},
+		UploadTokens: []MailboxAuthToken{
+			parseToken("any"),
+			parseToken("key=scriptkey:" + scriptKeyHex),
+			parseToken("asset=asset:" + assetID.String()),
+			parseToken("other=scriptkey:" + otherKeyHex),
+		},
+		FetchTokens: []MailboxAuthToken{
+			parseToken("recipient=scriptkey:" + scriptKeyHex),
+			parseToken("other=scriptkey:" + otherKeyHex),
+		},
+	})
+
+	// Uploads without a token, with an unknown token or with a token that
+	// is scoped to another script key are rejected.
+	for _, token := range []string{"", "unknown", "other"} {
+		_, err = mailbox.UploadProof(ctx, blob, token)
+		require.ErrorIs(t, err, ErrMailboxUnauthorized)
+	}
+
+	// An unscoped token and the tokens scoped to the script key or asset
+	// of the proof file are accepted.
+	for _, token := range []string{"any", "key", "asset"} {
+		_, err = mailbox.UploadProof(ctx, blob, token)
+		require.NoError(t, err)
+	}
+
+	// Only the token of the recipient can fetch the proof files.
+	for _, token := range []string{"", "any", "other"} {
+		_, err = mailbox.FetchProofs(ctx, scriptKey, token)
+		require.ErrorIs(t, err, ErrMailboxUnauthorized)
+	}
+
+	proofs, err := mailbox.FetchProofs(ctx, scriptKey, "recipient")
+	require.NoError(t, err)
+	require.Len(t, proofs, 1)
+
+	// Tokens with an invalid scope are rejected.
+	invalidTokens := []string{
+		"", "=scriptkey:" + scriptKeyHex, "token=", "token=foo",
+		"token=scriptkey:00", "token=asset:" + scriptKeyHex[:10],
+	}
+	for _, tokenStr := range invalidTokens {
+		_, err := ParseMailboxAuthToken(tokenStr)
+		require.Error(t, err, tokenStr)
+	}
+}
@@ -3,6 +3,7 @@ package proof
 import (
 	"bytes"
 	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"io"
 
@@ -18,6 +19,10 @@ const (
 	// bytes without any specific interpretation.
 	MetaOpaque MetaType = 0
 
+	// MetaJson signals that the meta data is a JSON object or value. The
+	// data must be valid JSON.
+	MetaJson MetaType = 1
+
 	// MetaDataMaxSizeBytes is the maximum length of the meta data. We limit
 	// this to 1MiB for now. This should be of sufficient size to commit to
 	// any JSON data or even medium resolution images. If there is need to
@@ -35,6 +40,10 @@ var (
 
 	// ErrMetaDataTooLarge signals that the meta data is too large.
 	ErrMetaDataTooLarge = errors.New("meta data too large")
+
+	// ErrInvalidJSON signals that the meta data is declared as JSON but
+	// doesn't parse as such.
+	ErrInvalidJSON = errors.New("meta data is not valid JSON")
 )
 
 // MetaReveal is an optional TLV type that can be added to the proof of a
@@ -66,6 +75,10 @@ func (m *MetaReveal) Validate() error {
 		return ErrMetaDataTooLarge
 	}
 
+	if m.Type == MetaJson && !json.Valid(m.Data) {
+		return ErrInvalidJSON
+	}
+
 	return nil
 }
 
@@ -38,6 +38,20 @@ func TestValidateMetaReveal(t *testing.T) {
 			Data: make([]byte, MetaDataMaxSizeBytes+1),
 		},
 		expectedErr: ErrMetaDataTooLarge,
+	}, {
+		name: "valid JSON reveal",
+		reveal: &MetaReveal{
+			Type: MetaJson,
+			Data: []byte(`{"name": "foo", "decimals": 2}`),
+		},
+		expectedErr: nil,
+	}, {
+		name: "invalid JSON reveal",
+		reveal: &MetaReveal{
+			Type: MetaJson,
+			Data: []byte("{not json"),
+		},
+		expectedErr: ErrInvalidJSON,
 	}}
 
 	for _, tc := range testCases {
@@ -105,6 +105,21 @@ var (
 	// invalid.
 	ErrProofInvalid = errors.New("proof is invalid")
 
+	// ErrNotGenesisProof is an error returned if a proof that is expected
+	// to be the genesis proof of an asset is for a later transfer.
+	ErrNotGenesisProof = errors.New("proof is not a genesis proof")
+
+	// ErrAssetNotGrouped is an error returned if an asset that is expected
+	// to be part of an asset group doesn't have a group key.
+	ErrAssetNotGrouped = errors.New("asset is not part of an asset " +
+		"group")
+
+	// ErrGroupMismatch is an error returned if two assets that are
+	// expected to be part of the same asset group have different group
+	// keys.
+	ErrGroupMismatch = errors.New("assets are not part of the same " +
+		"asset group")
+
 	// RegtestTestVectorName is the name of the test vector file that is
 	// generated/updated by an actual integration test run on regtest. It is
 	// exported here, so we can use it in the integration tests.
@@ -629,6 +629,91 @@ func TestGenesisProofVerification(t *testing.T) {
 	test.WriteTestVectors(t, generatedTestVectorName, testVectors)
 }
 
+// TestVerifySharedGroup tests that two assets are only considered part of the
+// same asset group if both have a valid genesis proof with the same group key.
+func TestVerifySharedGroup(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	amount := uint64(5000)
+
+	genesisA, _ := genRandomGenesisWithProof(
+		t, asset.Normal, &amount, nil, true, nil, nil, asset.V0,
+	)
+	genesisB, _ := genRandomGenesisWithProof(
+		t, asset.Normal, &amount, nil, true, nil, nil, asset.V0,
+	)
+
+	// copyProof returns a copy of the given proof with a deep copy of the
+	// asset, so we can modify the asset.
+	copyProof := func(p Proof) *Proof {
+		p.Asset = *p.Asset.Copy()
+		return &p
+	}
+
+	ungrouped := copyProof(genesisB)
+	ungrouped.Asset.GroupKey = nil
+
+	transfer := copyProof(genesisB)
+	transfer.Asset.PrevWitnesses[0].PrevID = &asset.PrevID{
+		ID: genesisA.Asset.ID(),
+	}
+
+	invalidWitness := copyProof(genesisB)
+	invalidWitness.Asset.PrevWitnesses[0].TxWitness[0][0] ^= 1
+
+	testCases := []struct {
+		name        string
+		other       *Proof
+		expectedErr error
+	}{{
+		name:  "same group",
+		other: copyProof(genesisA),
+	}, {
+		name:        "different group",
+		other:       &genesisB,
+		expectedErr: ErrGroupMismatch,
+	}, {
+		name:        "ungrouped asset",
+		other:       ungrouped,
+		expectedErr: ErrAssetNotGrouped,
+	}, {
+		name:        "transfer proof",
+		other:       transfer,
+		expectedErr: ErrNotGenesisProof,
+	}, {
+		name:  "invalid group witness",
+		other: invalidWitness,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			groupKey, err := VerifySharedGroup(
+				ctx, &genesisA, tc.other, MockHeaderVerifier,
+				MockGroupVerifier,
+			)
+
+			switch {
+			case tc.expectedErr != nil:
+				require.ErrorIs(t, err, tc.expectedErr)
+
+			case tc.other == invalidWitness:
+				require.ErrorContains(
+					t, err, "invalid genesis proof",
+				)
+
+			default:
+				require.NoError(t, err)
+				require.True(t, groupKey.IsEqual(
+					&genesisA.Asset.GroupKey.GroupPubKey,
+				))
+			}
+		})
+	}
+}
+
 // TestProofBlockHeaderVerification ensures that an error returned by the
 // HeaderVerifier callback is correctly propagated by the Verify proof method.
 func TestProofBlockHeaderVerification(t *testing.T) {
@@ -714,6 +799,78 @@ func TestProofFileVerification(t *testing.T) {
 	require.ErrorIs(t, err, ErrUnknownVersion)
 }
 
+// TestFetchProofProvenance tests that the full proof file of an asset can be
+// reconstructed from its single transition proofs.
+func TestFetchProofProvenance(t *testing.T) {
+	proofHex, err := os.ReadFile(proofFileHexFileName)
+	require.NoError(t, err)
+
+	proofBytes, err := hex.DecodeString(
+		strings.Trim(string(proofHex), "\n"),
+	)
+	require.NoError(t, err)
+
+	f := &File{}
+	err = f.Decode(bytes.NewReader(proofBytes))
+	require.NoError(t, err)
+
+	// We'll serve each transition proof of the file by its locator, the
+	// same way a universe serves its leaves.
+	type proofKey struct {
+		assetID   asset.ID
+		outPoint  wire.OutPoint
+		scriptKey asset.SerializedKey
+	}
+	keyOf := func(loc Locator) proofKey {
+		return proofKey{
+			assetID:   *loc.AssetID,
+			outPoint:  *loc.OutPoint,
+			scriptKey: asset.ToSerialized(&loc.ScriptKey),
+		}
+	}
+
+	proofs := make(map[proofKey]*Proof)
+	var lastLoc Locator
+	for idx := 0; idx < f.NumProofs(); idx++ {
+		p, err := f.ProofAt(uint32(idx))
+		require.NoError(t, err)
+
+		assetID := p.Asset.ID()
+		outPoint := p.OutPoint()
+		lastLoc = Locator{
+			AssetID:   &assetID,
+			OutPoint:  &outPoint,
+			ScriptKey: *p.Asset.ScriptKey.PubKey,
+		}
+		proofs[keyOf(lastLoc)] = p
+	}
+
+	fetchProof := func(_ context.Context, loc Locator) (*Proof, error) {
+		p, ok := proofs[keyOf(loc)]
+		if !ok {
+			return nil, ErrProofNotFound
+		}
+
+		return p, nil
+	}
+
+	provenance, err := FetchProofProvenance(
+		context.Background(), lastLoc, fetchProof,
+	)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, provenance.Encode(&buf))
+	require.Equal(t, proofBytes, buf.Bytes())
+
+	// A locator without an outpoint can't be resolved.
+	_, err = FetchProofProvenance(
+		context.Background(), Locator{AssetID: lastLoc.AssetID},
+		fetchProof,
+	)
+	require.ErrorContains(t, err, "outpoint")
+}
+
 // TestProofVerification ensures that the proof encoding and decoding works as
 // expected.
 func TestProofVerification(t *testing.T) {
@@ -761,6 +918,67 @@ func TestProofVerification(t *testing.T) {
 	require.ErrorIs(t, err, ErrUnknownVersion)
 }
 
+// TestReconstructAnchorCommitment tests that the Taproot Asset commitment of an
+// anchor output can be reconstructed from the proofs of its assets, and that it
+// only matches the output if the claimed set of assets is correct.
+func TestReconstructAnchorCommitment(t *testing.T) {
+	t.Parallel()
+
+	amt := uint64(5000)
+	sibling := commitment.NewPreimageFromLeaf(
+		txscript.NewBaseTapLeaf([]byte{txscript.OP_TRUE}),
+	)
+	genesisProof, _ := genRandomGenesisWithProof(
+		t, asset.Normal, &amt, sibling, true, nil, nil, asset.V0,
+	)
+	anchorTx := genesisProof.AnchorTx
+
+	anchorCommitment, err := ReconstructAnchorCommitment(
+		&anchorTx, 0, []*Proof{&genesisProof},
+	)
+	require.NoError(t, err)
+	require.True(t, anchorCommitment.Matches())
+	require.Len(t, anchorCommitment.Commitment.CommittedAssets(), 1)
+	require.Equal(t, sibling, anchorCommitment.TapSiblingPreimage)
+
+	// A claimed set of assets that isn't committed to by the output is
+	// reconstructed, but doesn't match the output key.
+	otherProof, _ := genRandomGenesisWithProof(
+		t, asset.Normal, &amt, sibling, true, nil, nil, asset.V0,
+	)
+	otherProof.AnchorTx = anchorTx
+	otherProof.InclusionProof.InternalKey =
+		genesisProof.InclusionProof.InternalKey
+
+	anchorCommitment, err = ReconstructAnchorCommitment(
+		&anchorTx, 0, []*Proof{&genesisProof, &otherProof},
+	)
+	require.NoError(t, err)
+	require.False(t, anchorCommitment.Matches())
+	require.Len(t, anchorCommitment.Commitment.CommittedAssets(), 2)
+
+	// Proofs that aren't anchored in the given output are rejected.
+	_, err = ReconstructAnchorCommitment(
+		&anchorTx, 1, []*Proof{&genesisProof},
+	)
+	require.ErrorContains(t, err, "out of range")
+
+	unrelatedProof, _ := genRandomGenesisWithProof(
+		t, asset.Normal, &amt, nil, true, nil, nil, asset.V0,
+	)
+	_, err = ReconstructAnchorCommitment(
+		&anchorTx, 0, []*Proof{&genesisProof, &unrelatedProof},
+	)
+	require.ErrorContains(t, err, "is anchored in TX")
+
+	// All proofs must agree on the tapscript sibling.
+	otherProof.InclusionProof.CommitmentProof.TapSiblingPreimage = nil
+	_, err = ReconstructAnchorCommitment(
+		&anchorTx, 0, []*Proof{&genesisProof, &otherProof},
+	)
+	require.ErrorContains(t, err, "different tapscript sibling")
+}
+
 // TestOwnershipProofVerification ensures that the ownership proof encoding and
 // decoding as well as the verification works as expected.
 func TestOwnershipProofVerification(t *testing.T) {
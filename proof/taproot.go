@@ -10,6 +10,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
@@ -551,3 +552,149 @@ func AddExclusionProofs(baseProof *BaseProofParams, packet *psbt.Packet,
 
 	return nil
 }
+
+// AnchorCommitment is a Taproot Asset commitment that was reconstructed from
+// the proofs of all assets that are claimed to be committed to by a single
+// anchor output.
+type AnchorCommitment struct {
+	// Commitment is the reconstructed Taproot Asset commitment.
+	Commitment *commitment.TapCommitment
+
+	// InternalKey is the internal key of the anchor output.
+	InternalKey *btcec.PublicKey
+
+	// TapSiblingPreimage is the optional preimage of the tapscript sibling
+	// of the Taproot Asset commitment leaf.
+	TapSiblingPreimage *commitment.TapscriptPreimage
+
+	// OutputKey is the taproot output key of the anchor output, as found
+	// on-chain.
+	OutputKey *btcec.PublicKey
+
+	// DerivedKey is the taproot output key derived from the reconstructed
+	// commitment.
+	DerivedKey *btcec.PublicKey
+}
+
+// Matches returns true if the anchor output commits to exactly the assets of
+// the reconstructed commitment.
+func (c *AnchorCommitment) Matches() bool {
+	return c.OutputKey.IsEqual(c.DerivedKey)
+}
+
+// ReconstructAnchorCommitment reconstructs the Taproot Asset commitment of the
+// given anchor output from the proofs of the assets it's claimed to commit to,
+// and derives the taproot output key backing it. All proofs must be anchored
+// in the given output and agree on its internal key and tapscript sibling.
+// The output only matches the commitment if the proofs cover all of its
+// assets.
+func ReconstructAnchorCommitment(anchorTx *wire.MsgTx, outputIndex uint32,
+	proofs []*Proof) (*AnchorCommitment, error) {
+
+	if len(proofs) == 0 {
+		return nil, fmt.Errorf("at least one proof is required")
+	}
+
+	if int(outputIndex) >= len(anchorTx.TxOut) {
+		return nil, fmt.Errorf("output index %d out of range, anchor "+
+			"TX only has %d outputs", outputIndex,
+			len(anchorTx.TxOut))
+	}
+
+	pkScript := anchorTx.TxOut[outputIndex].PkScript
+	if !txscript.IsPayToTaproot(pkScript) {
+		return nil, fmt.Errorf("anchor output %d is not a taproot "+
+			"output", outputIndex)
+	}
+	outputKey, err := schnorr.ParsePubKey(pkScript[2:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid taproot output key: %w", err)
+	}
+
+	var (
+		anchorTxHash = anchorTx.TxHash()
+		internalKey  *btcec.PublicKey
+		sibling      *commitment.TapscriptPreimage
+		siblingHash  *chainhash.Hash
+		assets       = make([]*asset.Asset, 0, len(proofs))
+	)
+	for idx, p := range proofs {
+		if p.AnchorTx.TxHash() != anchorTxHash {
+			return nil, fmt.Errorf("proof %d is anchored in TX "+
+				"%v, expected %v", idx, p.AnchorTx.TxHash(),
+				anchorTxHash)
+		}
+
+		inclusion := p.InclusionProof
+		if inclusion.OutputIndex != outputIndex {
+			return nil, fmt.Errorf("proof %d is anchored in "+
+				"output %d, expected %d", idx,
+				inclusion.OutputIndex, outputIndex)
+		}
+		if inclusion.CommitmentProof == nil ||
+			inclusion.InternalKey == nil {
+
+			return nil, fmt.Errorf("proof %d: %w", idx,
+				ErrInvalidCommitmentProof)
+		}
+
+		// All assets of an output share its internal key and
+		// tapscript sibling.
+		proofSibling := inclusion.CommitmentProof.TapSiblingPreimage
+		var proofSiblingHash *chainhash.Hash
+		if proofSibling != nil {
+			proofSiblingHash, err = proofSibling.TapHash()
+			if err != nil {
+				return nil, fmt.Errorf("proof %d: invalid "+
+					"tapscript sibling: %w", idx, err)
+			}
+		}
+
+		if idx == 0 {
+			internalKey = inclusion.InternalKey
+			sibling = proofSibling
+			siblingHash = proofSiblingHash
+		}
+
+		if !inclusion.InternalKey.IsEqual(internalKey) {
+			return nil, fmt.Errorf("proof %d has a different "+
+				"internal key", idx)
+		}
+		if (proofSiblingHash == nil) != (siblingHash == nil) ||
+			(siblingHash != nil &&
+				*proofSiblingHash != *siblingHash) {
+
+			return nil, fmt.Errorf("proof %d has a different "+
+				"tapscript sibling", idx)
+		}
+
+		// The output of a split was committed to without the split
+		// commitment, just like when verifying an inclusion proof.
+		committedAsset := p.Asset.Copy()
+		if committedAsset.HasSplitCommitmentWitness() {
+			committedAsset.PrevWitnesses[0].SplitCommitment = nil
+		}
+		assets = append(assets, committedAsset)
+	}
+
+	tapCommitment, err := commitment.FromAssets(assets...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to reconstruct commitment: %w",
+			err)
+	}
+
+	derivedKey, err := deriveTaprootKeyFromTapCommitment(
+		tapCommitment, siblingHash, internalKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AnchorCommitment{
+		Commitment:         tapCommitment,
+		InternalKey:        internalKey,
+		TapSiblingPreimage: sibling,
+		OutputKey:          outputKey,
+		DerivedKey:         derivedKey,
+	}, nil
+}
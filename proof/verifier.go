@@ -547,6 +547,47 @@ func (p *Proof) Verify(ctx context.Context, prev *AssetSnapshot,
 	}, nil
 }
 
+// VerifySharedGroup verifies the genesis proofs of two assets and checks that
+// both assets were issued into the same asset group. As verifying a genesis
+// proof validates the group witness of the asset, an asset can't claim to be
+// part of a group without the approval of the group key holder. The shared
+// group key is returned on success.
+func VerifySharedGroup(ctx context.Context, a, b *Proof,
+	headerVerifier HeaderVerifier,
+	groupVerifier GroupVerifier) (*btcec.PublicKey, error) {
+
+	for _, p := range []*Proof{a, b} {
+		assetID := p.Asset.ID()
+
+		if p.Asset.GroupKey == nil {
+			return nil, fmt.Errorf("%w: asset %v",
+				ErrAssetNotGrouped, assetID)
+		}
+
+		if !p.Asset.IsGenesisAsset() {
+			return nil, fmt.Errorf("%w: asset %v",
+				ErrNotGenesisProof, assetID)
+		}
+
+		_, err := p.Verify(ctx, nil, headerVerifier, groupVerifier)
+		if err != nil {
+			return nil, fmt.Errorf("invalid genesis proof of "+
+				"asset %v: %w", assetID, err)
+		}
+	}
+
+	groupKeyA := &a.Asset.GroupKey.GroupPubKey
+	groupKeyB := &b.Asset.GroupKey.GroupPubKey
+	if !groupKeyA.IsEqual(groupKeyB) {
+		return nil, fmt.Errorf("%w: asset %v has group key %x, asset "+
+			"%v has group key %x", ErrGroupMismatch, a.Asset.ID(),
+			groupKeyA.SerializeCompressed(), b.Asset.ID(),
+			groupKeyB.SerializeCompressed())
+	}
+
+	return groupKeyA, nil
+}
+
 // Verify attempts to verify a full proof file starting from the asset's
 // genesis.
 //
@@ -11,6 +11,8 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/macaroons"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gopkg.in/macaroon-bakery.v2/bakery"
 )
 
@@ -64,6 +66,8 @@ var (
 //	  +----------------------------------+
 //	  | RPC State Interceptor            |
 //	  +----------------------------------+
+//	  | Rate Limit Interceptor           |
+//	  +----------------------------------+
 //	  | Macaroon Interceptor             |
 //	  +----------------------------------+
 //	  | Prometheus Interceptor           |
@@ -207,6 +211,14 @@ func (r *InterceptorChain) Permissions() map[string][]bakery.Op {
 // interceptors.
 type InterceptorsOpts struct {
 	Prometheus *monitoring.PrometheusConfig
+
+	// RateLimiter is an optional rate limiter that enforces per peer
+	// limits on the universe RPCs.
+	RateLimiter *RateLimiter
+
+	// ReadOnly indicates that all RPCs that mutate the local universe or
+	// minting state should be rejected.
+	ReadOnly bool
 }
 
 // CreateServerOpts creates the GRPC server options that can be added to a GRPC
@@ -236,6 +248,29 @@ func (r *InterceptorChain) CreateServerOpts(
 		strmInterceptors, r.rpcStateStreamServerInterceptor(),
 	)
 
+	// If a rate limiter is set, we'll reject any universe calls of peers
+	// that exceeded their limits before doing any further work.
+	if opts.RateLimiter != nil {
+		unaryInterceptors = append(
+			unaryInterceptors,
+			opts.RateLimiter.UnaryServerInterceptor(),
+		)
+		strmInterceptors = append(
+			strmInterceptors,
+			opts.RateLimiter.StreamServerInterceptor(),
+		)
+	}
+
+	// In read-only mode, we'll reject any mutating calls right away.
+	if opts.ReadOnly {
+		unaryInterceptors = append(
+			unaryInterceptors, ReadOnlyUnaryServerInterceptor(),
+		)
+		strmInterceptors = append(
+			strmInterceptors, ReadOnlyStreamServerInterceptor(),
+		)
+	}
+
 	// We'll add the macaroon interceptors. If macaroons aren't disabled,
 	// then these interceptors will enforce macaroon authentication.
 	unaryInterceptors = append(
@@ -330,8 +365,8 @@ func (r *InterceptorChain) checkMacaroon(ctx context.Context,
 	uriPermissions, ok := r.permissionMap[fullMethod]
 	r.RUnlock()
 	if !ok {
-		return fmt.Errorf("%s: unknown permissions required for method",
-			fullMethod)
+		return status.Errorf(codes.PermissionDenied, "%s: unknown "+
+			"permissions required for method", fullMethod)
 	}
 
 	// Find out if there is an external validator registered for
@@ -341,8 +376,15 @@ func (r *InterceptorChain) checkMacaroon(ctx context.Context,
 		validator = svc
 	}
 
-	// Now that we know what validator to use, let it do its work.
-	return validator.ValidateMacaroon(ctx, uriPermissions, fullMethod)
+	// Now that we know what validator to use, let it do its work. Any
+	// failure means the macaroon doesn't grant access to the method, so
+	// we'll report it as such to the caller.
+	err := validator.ValidateMacaroon(ctx, uriPermissions, fullMethod)
+	if err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	return nil
 }
 
 // MacaroonUnaryServerInterceptor is a GRPC interceptor that checks whether the
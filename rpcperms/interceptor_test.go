@@ -0,0 +1,88 @@
+package rpcperms
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btclog"
+	"github.com/lightninglabs/taproot-assets/perms"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+// TestMacaroonPermissions tests that a macaroon that only grants read access
+// to the universe can be used to call the public universe RPCs, while calls
+// that require any other permission fail with a PermissionDenied error.
+func TestMacaroonPermissions(t *testing.T) {
+	t.Parallel()
+
+	svc, err := macaroons.NewService(
+		bakery.NewMemRootKeyStore(), "tapd", false,
+	)
+	require.NoError(t, err)
+
+	chain := NewInterceptorChain(btclog.Disabled, false, nil, nil)
+	chain.AddMacaroonService(svc)
+	for method, ops := range perms.RequiredPermissions {
+		require.NoError(t, chain.AddPermission(method, ops))
+	}
+
+	mac, err := svc.NewMacaroon(
+		context.Background(), macaroons.DefaultRootKeyID, bakery.Op{
+			Entity: "universe",
+			Action: "read",
+		},
+	)
+	require.NoError(t, err)
+
+	macBytes, err := mac.M().MarshalBinary()
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(
+		context.Background(), metadata.Pairs(
+			"macaroon", hex.EncodeToString(macBytes),
+		),
+	)
+
+	requireDenied := func(err error) {
+		t.Helper()
+
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, codes.PermissionDenied, st.Code())
+	}
+
+	// Reading the public universe data is allowed.
+	require.NoError(t, chain.checkMacaroon(
+		ctx, universeRPCPrefix+"AssetRoots",
+	))
+	require.NoError(t, chain.checkMacaroon(
+		ctx, universeRPCPrefix+"QueryProof",
+	))
+
+	// Writing to the universe, managing the federation and minting
+	// require other permissions.
+	requireDenied(chain.checkMacaroon(ctx, universeRPCPrefix+"InsertProof"))
+	requireDenied(chain.checkMacaroon(
+		ctx, universeRPCPrefix+"ListFederationServers",
+	))
+	requireDenied(chain.checkMacaroon(
+		ctx, universeRPCPrefix+"AddFederationServer",
+	))
+	requireDenied(chain.checkMacaroon(ctx, mintRPCPrefix+"MintAsset"))
+	requireDenied(chain.checkMacaroon(
+		ctx, "/taprpc.TaprootAssets/BakeMacaroon",
+	))
+
+	// A call without any macaroon is denied as well, as is a call to an
+	// unknown method.
+	requireDenied(chain.checkMacaroon(
+		context.Background(), universeRPCPrefix+"AssetRoots",
+	))
+	requireDenied(chain.checkMacaroon(ctx, universeRPCPrefix+"Unknown"))
+}
@@ -0,0 +1,356 @@
+package rpcperms
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// universeRPCPrefix is the prefix of the full method name of all RPCs
+	// of the universe service.
+	universeRPCPrefix = "/universerpc.Universe/"
+
+	// forwardedForHeader is the metadata key the REST proxy uses to pass
+	// on the address of the original caller.
+	forwardedForHeader = "x-forwarded-for"
+
+	// syncRetryDelay is the retry hint we return to a peer that exceeded
+	// its number of concurrent syncs. As we can't know when one of the
+	// active syncs will complete, we'll just use a static delay.
+	syncRetryDelay = time.Second
+
+	// DefaultPeerIdleTimeout is the default amount of time after which
+	// the rate limiting state of an inactive peer is discarded.
+	DefaultPeerIdleTimeout = time.Minute * 10
+)
+
+// universeSyncMethods is the set of universe RPCs that return a full set of
+// leaves or trigger a sync, and are therefore counted as a sync.
+var universeSyncMethods = map[string]struct{}{
+	universeRPCPrefix + "AssetLeafKeys":      {},
+	universeRPCPrefix + "AssetLeafKeysSince": {},
+	universeRPCPrefix + "AssetLeaves":        {},
+	universeRPCPrefix + "SyncUniverse":       {},
+	universeRPCPrefix + "ExportUniverse":     {},
+}
+
+// RateLimit is the set of limits that are enforced for a single peer.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained number of universe RPC calls per
+	// second a peer is allowed to make. If zero, then the number of calls
+	// isn't limited.
+	RequestsPerSecond float64
+
+	// Burst is the number of calls a peer is allowed to make at once on
+	// top of the sustained rate. If zero, a burst of a single call is
+	// used.
+	Burst int
+
+	// MaxConcurrentSyncs is the maximum number of sync calls a peer is
+	// allowed to have in flight at the same time. If zero, then the number
+	// of concurrent syncs isn't limited.
+	MaxConcurrentSyncs int
+}
+
+// RateLimiterConfig is the config of the universe RPC rate limiter.
+type RateLimiterConfig struct {
+	// DefaultLimit is the limit that is enforced for all peers that don't
+	// have a specific limit configured.
+	DefaultLimit RateLimit
+
+	// PeerLimits is a map from peer host to the limit that should be
+	// enforced for that peer instead of the default one. This can be used
+	// to give federation members a higher (or lower) limit.
+	PeerLimits map[string]RateLimit
+
+	// PeerIdleTimeout is the amount of time after which the rate limiting
+	// state of an inactive peer is discarded. If zero, then
+	// DefaultPeerIdleTimeout is used.
+	PeerIdleTimeout time.Duration
+}
+
+// peerState is the rate limiting state of a single peer.
+type peerState struct {
+	// limiter is the token bucket used to limit the rate of calls. This is
+	// nil if the peer's call rate isn't limited.
+	limiter *rate.Limiter
+
+	// activeSyncs is the number of sync calls currently in flight.
+	activeSyncs int
+
+	// lastSeen is the time of the last call of the peer.
+	lastSeen time.Time
+}
+
+// RateLimiter enforces per peer rate limits on the universe RPC surface. Peers
+// are identified by their source address.
+type RateLimiter struct {
+	cfg RateLimiterConfig
+
+	peers map[string]*peerState
+
+	lastPrune time.Time
+
+	sync.Mutex
+}
+
+// NewRateLimiter creates a new universe RPC rate limiter from the given
+// config.
+func NewRateLimiter(cfg RateLimiterConfig) *RateLimiter {
+	if cfg.PeerIdleTimeout == 0 {
+		cfg.PeerIdleTimeout = DefaultPeerIdleTimeout
+	}
+
+	return &RateLimiter{
+		cfg:       cfg,
+		peers:     make(map[string]*peerState),
+		lastPrune: time.Now(),
+	}
+}
+
+// limitFor returns the limit that should be enforced for the given peer.
+func (l *RateLimiter) limitFor(peerKey string) RateLimit {
+	if limit, ok := l.cfg.PeerLimits[peerKey]; ok {
+		return limit
+	}
+
+	return l.cfg.DefaultLimit
+}
+
+// acquire checks whether the peer that issued the call is allowed to invoke
+// the given method. If the call is allowed, then a closure is returned that
+// must be called once the call completes. Otherwise, a ResourceExhausted
+// status error is returned that contains a retry hint.
+func (l *RateLimiter) acquire(ctx context.Context,
+	fullMethod string) (func(), error) {
+
+	noop := func() {}
+
+	// We only limit calls to the universe service.
+	if !strings.HasPrefix(fullMethod, universeRPCPrefix) {
+		return noop, nil
+	}
+
+	peerKey := PeerHostFromContext(ctx)
+	limit := l.limitFor(peerKey)
+
+	l.Lock()
+	defer l.Unlock()
+
+	now := time.Now()
+	l.pruneIdlePeers(now)
+
+	state, ok := l.peers[peerKey]
+	if !ok {
+		state = &peerState{}
+		if limit.RequestsPerSecond > 0 {
+			burst := limit.Burst
+			if burst == 0 {
+				burst = 1
+			}
+
+			state.limiter = rate.NewLimiter(
+				rate.Limit(limit.RequestsPerSecond), burst,
+			)
+		}
+
+		l.peers[peerKey] = state
+	}
+	state.lastSeen = now
+
+	// We check the number of concurrent syncs first, so a rejected sync
+	// doesn't count towards the request rate of the peer.
+	_, isSync := universeSyncMethods[fullMethod]
+	limitSyncs := isSync && limit.MaxConcurrentSyncs > 0
+	if limitSyncs && state.activeSyncs >= limit.MaxConcurrentSyncs {
+		return nil, resourceExhaustedErr(fmt.Sprintf("limit of %d "+
+			"concurrent syncs exceeded", limit.MaxConcurrentSyncs),
+			syncRetryDelay)
+	}
+
+	if state.limiter != nil {
+		reservation := state.limiter.ReserveN(now, 1)
+		delay := reservation.DelayFrom(now)
+		if delay > 0 {
+			reservation.CancelAt(now)
+
+			return nil, resourceExhaustedErr(fmt.Sprintf("rate "+
+				"limit of %v requests per second exceeded",
+				limit.RequestsPerSecond), delay)
+		}
+	}
+
+	if !limitSyncs {
+		return noop, nil
+	}
+
+	state.activeSyncs++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.Lock()
+			defer l.Unlock()
+
+			state.activeSyncs--
+			state.lastSeen = time.Now()
+		})
+	}, nil
+}
+
+// pruneIdlePeers removes the state of all peers that haven't made a call
+// within the idle timeout. This must be called with the lock held.
+func (l *RateLimiter) pruneIdlePeers(now time.Time) {
+	if now.Sub(l.lastPrune) < l.cfg.PeerIdleTimeout {
+		return
+	}
+
+	for peerKey, state := range l.peers {
+		idle := now.Sub(state.lastSeen) >= l.cfg.PeerIdleTimeout
+		if idle && state.activeSyncs == 0 {
+			delete(l.peers, peerKey)
+		}
+	}
+
+	l.lastPrune = now
+}
+
+// UnaryServerInterceptor is a GRPC interceptor that enforces the per peer
+// rate limits on unary universe RPC calls.
+func (l *RateLimiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		release, err := l.acquire(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is a GRPC interceptor that enforces the per peer
+// rate limits on streaming universe RPC calls.
+func (l *RateLimiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		release, err := l.acquire(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		return handler(srv, ss)
+	}
+}
+
+// PeerHostFromContext returns the host that identifies the peer that issued
+// the call, which is the host of its source address. Calls made through the
+// REST proxy originate from the loopback interface, so for those we'll use the
+// address the proxy forwarded instead.
+func PeerHostFromContext(ctx context.Context) string {
+	host, loopback := transportHostFromContext(ctx)
+	if !loopback {
+		return host
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return host
+	}
+
+	forwardedFor := md.Get(forwardedForHeader)
+	if len(forwardedFor) == 0 {
+		return host
+	}
+
+	// The REST proxy appends the remote address of the HTTP request to any
+	// X-Forwarded-For header sent by the client. Everything before that
+	// is under the control of the client, so the last hop is the only one
+	// we can trust.
+	hops := strings.Split(forwardedFor[len(forwardedFor)-1], ",")
+	remoteHost := strings.TrimSpace(hops[len(hops)-1])
+	if remoteHost == "" {
+		return host
+	}
+
+	return remoteHost
+}
+
+// IsDirectLocalCall returns true if the call was issued over a direct
+// connection from the loopback interface. Calls made through the REST proxy
+// originate from the loopback interface as well, but are issued on behalf of
+// an HTTP client that can be anywhere, so they're never considered local.
+func IsDirectLocalCall(ctx context.Context) bool {
+	_, loopback := transportHostFromContext(ctx)
+	if !loopback {
+		return false
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return true
+	}
+
+	return len(md.Get(forwardedForHeader)) == 0
+}
+
+// transportHostFromContext returns the host of the source address of the
+// connection the call was issued on, and whether that host is a loopback
+// address.
+func transportHostFromContext(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "", false
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	ip := net.ParseIP(host)
+
+	return host, ip != nil && ip.IsLoopback()
+}
+
+// resourceExhaustedErr returns a ResourceExhausted status error with the given
+// message that tells the caller when to retry the call.
+func resourceExhaustedErr(msg string, retryAfter time.Duration) error {
+	// We round up to full milliseconds, so we never tell the caller to
+	// retry before the limit allows it.
+	if rem := retryAfter % time.Millisecond; rem != 0 {
+		retryAfter += time.Millisecond - rem
+	}
+
+	st := status.New(
+		codes.ResourceExhausted, fmt.Sprintf("%s, retry after %v", msg,
+			retryAfter),
+	)
+
+	stWithDetails, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryAfter),
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return stWithDetails.Err()
+}
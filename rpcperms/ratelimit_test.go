@@ -0,0 +1,205 @@
+package rpcperms
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	queryMethod = universeRPCPrefix + "QueryProof"
+	syncMethod  = universeRPCPrefix + "AssetLeaves"
+)
+
+// peerContext returns a context for a call that originates from the given
+// address.
+func peerContext(addr string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{
+			IP:   net.ParseIP(addr),
+			Port: 10029,
+		},
+	})
+}
+
+// requireResourceExhausted asserts that the given error is a ResourceExhausted
+// status error that contains a retry hint.
+func requireResourceExhausted(t *testing.T, err error) {
+	t.Helper()
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.ResourceExhausted, st.Code())
+
+	require.Len(t, st.Details(), 1)
+	retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	require.Positive(t, retryInfo.RetryDelay.AsDuration())
+}
+
+// TestRateLimiter tests that the rate limiter enforces the request rate and
+// the number of concurrent syncs per peer, and that peer specific limits take
+// precedence over the default one.
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewRateLimiter(RateLimiterConfig{
+		DefaultLimit: RateLimit{
+			RequestsPerSecond:  0.001,
+			Burst:              2,
+			MaxConcurrentSyncs: 1,
+		},
+		PeerLimits: map[string]RateLimit{
+			"10.0.0.2": {},
+		},
+	})
+
+	ctx := peerContext("10.0.0.1")
+
+	// The first sync is allowed, but a second concurrent one isn't.
+	releaseSync, err := limiter.acquire(ctx, syncMethod)
+	require.NoError(t, err)
+
+	_, err = limiter.acquire(ctx, syncMethod)
+	requireResourceExhausted(t, err)
+
+	// Once the first sync is done, the burst allows another call, after
+	// which the request rate is exceeded.
+	releaseSync()
+
+	release, err := limiter.acquire(ctx, queryMethod)
+	require.NoError(t, err)
+	release()
+
+	_, err = limiter.acquire(ctx, queryMethod)
+	requireResourceExhausted(t, err)
+
+	// Calls to other services aren't limited.
+	_, err = limiter.acquire(ctx, "/taprpc.TaprootAssets/ListAssets")
+	require.NoError(t, err)
+
+	// Other peers have their own limits, and the peer with an override
+	// isn't limited at all.
+	_, err = limiter.acquire(peerContext("10.0.0.3"), queryMethod)
+	require.NoError(t, err)
+
+	unlimitedCtx := peerContext("10.0.0.2")
+	for i := 0; i < 10; i++ {
+		_, err := limiter.acquire(unlimitedCtx, syncMethod)
+		require.NoError(t, err)
+	}
+}
+
+// proxyContext returns a context for a call that was forwarded by the REST
+// proxy with the given X-Forwarded-For values.
+func proxyContext(forwardedFor ...string) context.Context {
+	md := metadata.MD{}
+	for _, value := range forwardedFor {
+		md.Append(forwardedForHeader, value)
+	}
+
+	return metadata.NewIncomingContext(peerContext("127.0.0.1"), md)
+}
+
+// TestPeerKeyFromContext tests that calls forwarded by the REST proxy are
+// keyed by the remote address the proxy appended, while the forwarding header
+// is ignored for any other peer.
+func TestPeerKeyFromContext(t *testing.T) {
+	t.Parallel()
+
+	forwardedMD := metadata.Pairs(forwardedForHeader, "10.0.0.1, 10.0.0.2")
+
+	directCtx := peerContext("10.0.0.5")
+	require.Equal(t, "10.0.0.5", PeerHostFromContext(directCtx))
+	require.Empty(t, PeerHostFromContext(context.Background()))
+
+	// The client controls all but the last hop of the header, which is the
+	// remote address the REST proxy appended.
+	proxyCtx := proxyContext("10.0.0.1, 10.0.0.2")
+	require.Equal(t, "10.0.0.2", PeerHostFromContext(proxyCtx))
+
+	// The same goes for values injected as separate metadata entries.
+	injectedCtx := proxyContext("10.0.0.1", "10.0.0.3, 10.0.0.2")
+	require.Equal(t, "10.0.0.2", PeerHostFromContext(injectedCtx))
+
+	proxyCtx = proxyContext("10.0.0.2")
+	require.Equal(t, "10.0.0.2", PeerHostFromContext(proxyCtx))
+
+	spoofedCtx := metadata.NewIncomingContext(
+		peerContext("10.0.0.5"), forwardedMD,
+	)
+	require.Equal(t, "10.0.0.5", PeerHostFromContext(spoofedCtx))
+}
+
+// TestRateLimiterSpoofedForwardedFor tests that a REST client can't escape its
+// rate limit or claim the limit of another peer by sending its own
+// X-Forwarded-For header.
+func TestRateLimiterSpoofedForwardedFor(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewRateLimiter(RateLimiterConfig{
+		DefaultLimit: RateLimit{
+			RequestsPerSecond: 0.001,
+			Burst:             1,
+		},
+		PeerLimits: map[string]RateLimit{
+			"10.0.0.2": {},
+		},
+	})
+
+	// The first call of the client uses up its burst.
+	_, err := limiter.acquire(proxyContext("10.0.0.1"), queryMethod)
+	require.NoError(t, err)
+
+	// Rotating the spoofed first hop doesn't give the client a new bucket,
+	// and neither does pretending to be the peer with the unlimited
+	// override.
+	for _, spoofed := range []string{"10.0.0.3", "10.0.0.4", "10.0.0.2"} {
+		ctx := proxyContext(spoofed + ", 10.0.0.1")
+		_, err := limiter.acquire(ctx, queryMethod)
+		requireResourceExhausted(t, err)
+	}
+}
+
+// TestIsDirectLocalCall tests that only direct calls from the loopback
+// interface are considered local, and that a spoofed X-Forwarded-For header
+// can't make a call through the REST proxy look like a local one.
+func TestIsDirectLocalCall(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, IsDirectLocalCall(peerContext("127.0.0.1")))
+	require.True(t, IsDirectLocalCall(peerContext("::1")))
+	require.False(t, IsDirectLocalCall(peerContext("10.0.0.5")))
+	require.False(t, IsDirectLocalCall(context.Background()))
+
+	// A call through the REST proxy is issued on behalf of the remote
+	// host the proxy appended, even if the client claims to be local.
+	spoofedCtx := proxyContext("127.0.0.1, 10.0.0.2")
+	require.False(t, IsDirectLocalCall(spoofedCtx))
+	require.Equal(t, "10.0.0.2", PeerHostFromContext(spoofedCtx))
+
+	injectedCtx := proxyContext("127.0.0.1", "127.0.0.1, 10.0.0.2")
+	require.False(t, IsDirectLocalCall(injectedCtx))
+	require.Equal(t, "10.0.0.2", PeerHostFromContext(injectedCtx))
+
+	// Even a call the REST proxy forwarded for the local machine isn't a
+	// direct one, as the proxy might sit behind another local proxy that
+	// forwards calls from anywhere.
+	require.False(t, IsDirectLocalCall(proxyContext("127.0.0.1")))
+
+	// The forwarding header is ignored for a remote peer, so it can't
+	// claim to be local either.
+	remoteCtx := metadata.NewIncomingContext(
+		peerContext("10.0.0.5"),
+		metadata.Pairs(forwardedForHeader, "127.0.0.1"),
+	)
+	require.False(t, IsDirectLocalCall(remoteCtx))
+	require.Equal(t, "10.0.0.5", PeerHostFromContext(remoteCtx))
+}
@@ -0,0 +1,116 @@
+package rpcperms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/perms"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+const (
+	// mintRPCPrefix is the prefix of the full method name of all RPCs of
+	// the minting service.
+	mintRPCPrefix = "/mintrpc.Mint/"
+)
+
+// readOnlyAllowedMethods is the set of RPCs that require write permissions,
+// but are still allowed if the universe server runs in read-only mode.
+// Syncing with remote universes is how a read-only mirror learns about new
+// leaves, and verifying the universe roots only mutates them if a repair is
+// requested, which is checked separately.
+var readOnlyAllowedMethods = map[string]struct{}{
+	universeRPCPrefix + "SyncUniverse":       {},
+	universeRPCPrefix + "SyncUniverseStream": {},
+	universeRPCPrefix + "SyncAll":            {},
+	universeRPCPrefix + "VerifyUniverse":     {},
+}
+
+// readOnlyDeniedMethods is the set of RPCs that are rejected if the universe
+// server runs in read-only mode. Instead of keeping a separate list that can
+// get out of sync, all RPCs that require a write permission are rejected,
+// except for the ones that are explicitly allowed.
+var readOnlyDeniedMethods = deniedMethods(perms.RequiredPermissions)
+
+// deniedMethods returns the set of RPCs within the given permission map that
+// are rejected in read-only mode.
+func deniedMethods(
+	requiredPermissions map[string][]bakery.Op) map[string]struct{} {
+
+	denied := make(map[string]struct{})
+	for method, ops := range requiredPermissions {
+		if _, ok := readOnlyAllowedMethods[method]; ok {
+			continue
+		}
+
+		if fn.Any(ops, isMutatingOp) {
+			denied[method] = struct{}{}
+		}
+	}
+
+	return denied
+}
+
+// isMutatingOp returns true if the given permission allows the caller to
+// mutate the local state of the node. Stopping the daemon or changing its log
+// level doesn't touch any asset or universe data, so the write permission of
+// the daemon entity doesn't count.
+func isMutatingOp(op bakery.Op) bool {
+	return op.Action == "write" && op.Entity != "daemon"
+}
+
+// repairRequest is a request that can ask the server to repair the data it
+// operates on.
+type repairRequest interface {
+	GetRepair() bool
+}
+
+// checkReadOnly returns a PermissionDenied error if the given call isn't
+// allowed in read-only mode. The request is nil for streaming calls.
+func checkReadOnly(fullMethod string, req interface{}) error {
+	_, denied := readOnlyDeniedMethods[fullMethod]
+	if r, ok := req.(repairRequest); ok && r.GetRepair() {
+		denied = true
+	}
+
+	if !denied {
+		return nil
+	}
+
+	return status.Error(codes.PermissionDenied, fmt.Sprintf("%s is "+
+		"disabled, the universe server is running in read-only mode",
+		fullMethod))
+}
+
+// ReadOnlyUnaryServerInterceptor is a GRPC interceptor that rejects all unary
+// calls that would mutate the local universe or minting state.
+func ReadOnlyUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		if err := checkReadOnly(info.FullMethod, req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// ReadOnlyStreamServerInterceptor is a GRPC interceptor that rejects all
+// streaming calls that would mutate the local universe or minting state.
+func ReadOnlyStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		if err := checkReadOnly(info.FullMethod, nil); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,111 @@
+package rpcperms
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/perms"
+	"github.com/lightninglabs/taproot-assets/taprpc/universerpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestReadOnlyInterceptor tests that the read-only interceptors reject
+// mutating calls with a PermissionDenied error, while letting queries and
+// syncs through.
+func TestReadOnlyInterceptor(t *testing.T) {
+	t.Parallel()
+
+	unary := ReadOnlyUnaryServerInterceptor()
+	stream := ReadOnlyStreamServerInterceptor()
+
+	unaryHandler := func(context.Context, interface{}) (interface{},
+		error) {
+
+		return nil, nil
+	}
+	callUnaryReq := func(method string, req interface{}) error {
+		_, err := unary(
+			context.Background(), req,
+			&grpc.UnaryServerInfo{FullMethod: method}, unaryHandler,
+		)
+		return err
+	}
+	callUnary := func(method string) error {
+		return callUnaryReq(method, nil)
+	}
+	callStream := func(method string) error {
+		return stream(
+			nil, nil, &grpc.StreamServerInfo{FullMethod: method},
+			func(interface{}, grpc.ServerStream) error {
+				return nil
+			},
+		)
+	}
+
+	requireDenied := func(err error) {
+		t.Helper()
+
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, codes.PermissionDenied, st.Code())
+	}
+
+	// Minting, proof insertion and federation changes are rejected.
+	requireDenied(callUnary(mintRPCPrefix + "MintAsset"))
+	requireDenied(callUnary(mintRPCPrefix + "PublishBatchPsbt"))
+	requireDenied(callUnary(universeRPCPrefix + "InsertProof"))
+	requireDenied(callUnary(universeRPCPrefix + "AddFederationServer"))
+	requireDenied(callStream(universeRPCPrefix + "ImportUniverse"))
+
+	// Queries and outbound syncs are still allowed.
+	require.NoError(t, callUnary(universeRPCPrefix+"AssetRoots"))
+	require.NoError(t, callUnary(universeRPCPrefix+"QueryAssetRoots"))
+	require.NoError(t, callUnary(universeRPCPrefix+"SyncUniverse"))
+	require.NoError(t, callStream(universeRPCPrefix+"SyncUniverseStream"))
+	require.NoError(t, callUnary(mintRPCPrefix+"ListBatches"))
+
+	// Verifying the universe is only rejected if a repair is requested.
+	verifyMethod := universeRPCPrefix + "VerifyUniverse"
+	require.NoError(t, callUnaryReq(
+		verifyMethod, &universerpc.VerifyUniverseRequest{},
+	))
+	requireDenied(callUnaryReq(
+		verifyMethod, &universerpc.VerifyUniverseRequest{Repair: true},
+	))
+
+	// Every other RPC that requires a write permission is rejected, no
+	// matter if it's called as a unary or streaming RPC.
+	for method, ops := range perms.RequiredPermissions {
+		_, allowed := readOnlyAllowedMethods[method]
+
+		// Stopping the daemon or changing its log level doesn't mutate
+		// any asset or universe state.
+		mutating := false
+		for _, op := range ops {
+			if op.Action == "write" && op.Entity != "daemon" {
+				mutating = true
+			}
+		}
+
+		if allowed || !mutating {
+			require.NoError(t, callUnary(method), method)
+			require.NoError(t, callStream(method), method)
+
+			continue
+		}
+
+		requireDenied(callUnary(method))
+		requireDenied(callStream(method))
+	}
+
+	// The RPCs that were previously missing from the hand-kept deny list
+	// are rejected as well.
+	requireDenied(callUnary(mintRPCPrefix + "CommitBatchPsbt"))
+	requireDenied(callUnary(universeRPCPrefix + "CompactUniverseDB"))
+	requireDenied(callUnary(universeRPCPrefix + "PruneSpentLeaves"))
+	requireDenied(callUnary("/taprpc.TaprootAssets/ClaimFromUniverse"))
+	requireDenied(callUnary("/taprpc.TaprootAssets/ImportProof"))
+}
@@ -7,8 +7,10 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -17,6 +19,8 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
@@ -27,6 +31,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/perms"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/rpcperms"
 	"github.com/lightninglabs/taproot-assets/tapdb"
@@ -43,8 +48,13 @@ import (
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/lightningnetwork/lnd/signal"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaroon-bakery.v2/bakery"
 )
 
 const (
@@ -58,6 +68,26 @@ const (
 	// timestamp, not including any map/cache overhead).
 	maxNumBlocksInCache = 100_000
 
+	// universeArchiveChunkSize is the maximum size of a single chunk of a
+	// universe archive that is sent over an RPC stream.
+	universeArchiveChunkSize = 1024 * 1024
+
+	// maxInsertProofsBatchSize is the maximum number of proofs that can be
+	// inserted with a single InsertProofs call.
+	maxInsertProofsBatchSize = 1000
+
+	// maxQueryAssetRootsBatchSize is the maximum number of assets the
+	// roots can be queried for with a single QueryAssetRootsBatch call.
+	maxQueryAssetRootsBatchSize = 1000
+
+	// authorizationHeader is the metadata key of the bearer token that
+	// authorizes requests to the proof courier mailbox. The REST proxy
+	// passes on the HTTP Authorization header under this key.
+	authorizationHeader = "authorization"
+
+	// bearerAuthScheme is the auth scheme of the authorization header.
+	bearerAuthScheme = "Bearer"
+
 	// AssetBurnConfirmationText is the text that needs to be set on the
 	// RPC to confirm an asset burn.
 	AssetBurnConfirmationText = "assets will be destroyed"
@@ -84,7 +114,6 @@ type rpcServer struct {
 	wrpc.UnimplementedAssetWalletServer
 	mintrpc.UnimplementedMintServer
 	unirpc.UnimplementedUniverseServer
-	tapdevrpc.UnimplementedTapDevServer
 
 	interceptor signal.Interceptor
 
@@ -151,7 +180,7 @@ func (r *rpcServer) RegisterWithGrpcServer(grpcServer *grpc.Server) error {
 	wrpc.RegisterAssetWalletServer(grpcServer, r)
 	mintrpc.RegisterMintServer(grpcServer, r)
 	unirpc.RegisterUniverseServer(grpcServer, r)
-	tapdevrpc.RegisterGrpcServer(grpcServer, r)
+	tapdevrpc.RegisterGrpcServer(grpcServer, &devRPCServer{rpc: r})
 	return nil
 }
 
@@ -190,6 +219,16 @@ func (r *rpcServer) RegisterWithRestProxy(restCtx context.Context,
 		return err
 	}
 
+	// The universe roots export streams raw CSV or JSON, so it can't be
+	// expressed as a regular gRPC gateway mapping and is registered as a
+	// custom handler instead.
+	err = registerRootsExportHandler(
+		restCtx, restMux, restProxyDest, restDialOpts,
+	)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -215,10 +254,11 @@ func allowCORS(handler http.Handler, origins []string) http.Handler {
 			return
 		}
 
-		// Set the static header fields first.
+		// Set the static header fields first. The authorization header
+		// carries the bearer token of proof courier mailbox requests.
 		w.Header().Set(
-			allowHeaders,
-			"Content-Type, Accept, Grpc-Metadata-Macaroon",
+			allowHeaders, "Content-Type, Accept, Authorization, "+
+				"Grpc-Metadata-Macaroon",
 		)
 		w.Header().Set(allowMethods, "GET, POST, DELETE")
 
@@ -312,6 +352,98 @@ func (r *rpcServer) GetInfo(ctx context.Context,
 	}, nil
 }
 
+// validMacaroonPermissions returns the set of all entity/action pairs that are
+// required by at least one RPC method.
+func validMacaroonPermissions() map[bakery.Op]struct{} {
+	validPerms := make(map[bakery.Op]struct{})
+	for _, ops := range perms.RequiredPermissions {
+		for _, op := range ops {
+			validPerms[op] = struct{}{}
+		}
+	}
+
+	return validPerms
+}
+
+// BakeMacaroon bakes a new macaroon that only grants the given set of
+// permissions.
+func (r *rpcServer) BakeMacaroon(ctx context.Context,
+	req *taprpc.BakeMacaroonRequest) (*taprpc.BakeMacaroonResponse, error) {
+
+	if len(req.Permissions) == 0 {
+		return nil, fmt.Errorf("at least one permission must be set")
+	}
+
+	// We only accept permissions that are actually required by an RPC
+	// method, so a typo doesn't result in a macaroon that silently
+	// grants nothing.
+	validPerms := validMacaroonPermissions()
+	ops := make([]bakery.Op, 0, len(req.Permissions))
+	for _, perm := range req.Permissions {
+		op := bakery.Op{
+			Entity: perm.Entity,
+			Action: perm.Action,
+		}
+		if _, ok := validPerms[op]; !ok {
+			return nil, fmt.Errorf("invalid permission %v:%v",
+				perm.Entity, perm.Action)
+		}
+
+		ops = append(ops, op)
+	}
+
+	if r.interceptorChain == nil {
+		return nil, fmt.Errorf("macaroon service not available")
+	}
+	svc := r.interceptorChain.MacaroonService()
+	if svc == nil {
+		return nil, fmt.Errorf("macaroon service not available")
+	}
+
+	mac, err := svc.NewMacaroon(ctx, macaroons.DefaultRootKeyID, ops...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to bake macaroon: %w", err)
+	}
+
+	macBytes, err := mac.M().MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize macaroon: %w", err)
+	}
+
+	return &taprpc.BakeMacaroonResponse{
+		Macaroon: hex.EncodeToString(macBytes),
+	}, nil
+}
+
+// ListPermissions lists the entity/action pairs that are required to call each
+// of the RPC methods of tapd.
+func (r *rpcServer) ListPermissions(_ context.Context,
+	_ *taprpc.ListPermissionsRequest) (*taprpc.ListPermissionsResponse,
+	error) {
+
+	methodPerms := make(
+		map[string]*taprpc.MacaroonPermissionList,
+		len(perms.RequiredPermissions),
+	)
+	for method, ops := range perms.RequiredPermissions {
+		rpcPerms := make([]*taprpc.MacaroonPermission, 0, len(ops))
+		for _, op := range ops {
+			rpcPerms = append(rpcPerms, &taprpc.MacaroonPermission{
+				Entity: op.Entity,
+				Action: op.Action,
+			})
+		}
+
+		methodPerms[method] = &taprpc.MacaroonPermissionList{
+			Permissions: rpcPerms,
+		}
+	}
+
+	return &taprpc.ListPermissionsResponse{
+		MethodPermissions: methodPerms,
+	}, nil
+}
+
 // MintAsset attempts to mint the set of assets (async by default to ensure
 // proper batching) specified in the request.
 func (r *rpcServer) MintAsset(ctx context.Context,
@@ -326,6 +458,13 @@ func (r *rpcServer) MintAsset(ctx context.Context,
 		return nil, fmt.Errorf("invalid asset name: %w", err)
 	}
 
+	// The emission scheduler issues due emission events in its own named
+	// batch, which must not be mixed up with a batch of the user.
+	if req.BatchName == tapgarden.EmissionBatchName {
+		return nil, fmt.Errorf("batch name %v is reserved",
+			req.BatchName)
+	}
+
 	specificGroupKey := len(req.Asset.GroupKey) != 0
 	specificGroupAnchor := len(req.Asset.GroupAnchor) != 0
 
@@ -344,17 +483,26 @@ func (r *rpcServer) MintAsset(ctx context.Context,
 		return nil, err
 	}
 
+	emissionSchedule := fn.Map(
+		req.Asset.EmissionSchedule, unmarshalEmissionEvent,
+	)
+
 	seedling := &tapgarden.Seedling{
-		AssetVersion:   assetVersion,
-		AssetType:      asset.Type(req.Asset.AssetType),
-		AssetName:      req.Asset.Name,
-		Amount:         req.Asset.Amount,
-		EnableEmission: req.EnableEmission,
+		AssetVersion:     assetVersion,
+		AssetType:        asset.Type(req.Asset.AssetType),
+		AssetName:        req.Asset.Name,
+		Amount:           req.Asset.Amount,
+		EnableEmission:   req.EnableEmission,
+		EmissionSchedule: emissionSchedule,
+		Label:            req.Asset.Label,
+		ProofCourierAddr: req.Asset.ProofCourierAddr,
+		BatchName:        req.BatchName,
 	}
 
 	rpcsLog.Infof("[MintAsset]: version=%v, type=%v, name=%v, amt=%v, "+
-		"issuance=%v", seedling.AssetVersion, seedling.AssetType,
-		seedling.AssetName, seedling.Amount, seedling.EnableEmission)
+		"issuance=%v, batch=%q", seedling.AssetVersion,
+		seedling.AssetType, seedling.AssetName, seedling.Amount,
+		seedling.EnableEmission, seedling.BatchName)
 
 	// If a group key is provided, parse the provided group public key
 	// before creating the asset seedling.
@@ -369,9 +517,23 @@ func (r *rpcServer) MintAsset(ctx context.Context,
 			return nil, fmt.Errorf("invalid group key: %w", err)
 		}
 
+		// The scheduled emissions will be issued into the same group,
+		// so they need to be accounted for as well.
+		totalAmount := req.Asset.Amount
+		for _, event := range emissionSchedule {
+			err := mssmt.CheckSumOverflowUint64(
+				totalAmount, event.Amount,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("emission schedule "+
+					"would overflow asset amount: %w", err)
+			}
+
+			totalAmount += event.Amount
+		}
+
 		err = r.checkBalanceOverflow(
-			ctx, nil, groupTweakedKey,
-			req.Asset.Amount,
+			ctx, nil, groupTweakedKey, totalAmount,
 		)
 		if err != nil {
 			return nil, err
@@ -390,26 +552,31 @@ func (r *rpcServer) MintAsset(ctx context.Context,
 		seedling.GroupAnchor = &req.Asset.GroupAnchor
 	}
 
-	if req.Asset.AssetMeta != nil {
-		// Ensure that the meta field is within bounds.
-		switch {
-		case req.Asset.AssetMeta.Type < 0:
-			return nil, fmt.Errorf("meta type cannot be negative")
-
-		case req.Asset.AssetMeta.Type > math.MaxUint8:
-			return nil, fmt.Errorf("meta type is too large: %v, "+
-				"max is: %v", req.Asset.AssetMeta.Type,
-				math.MaxUint8)
+	// Custom keys are only parsed here, the minter makes sure that they
+	// are well-formed and can be used.
+	if req.Asset.ScriptKey != nil {
+		seedling.ScriptKey, err = UnmarshalScriptKey(
+			req.Asset.ScriptKey,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid script key: %w", err)
 		}
+	}
 
-		seedling.Meta = &proof.MetaReveal{
-			Type: proof.MetaType(req.Asset.AssetMeta.Type),
-			Data: req.Asset.AssetMeta.Data,
+	if req.Asset.GroupInternalKey != nil {
+		groupInternalKey, err := UnmarshalKeyDescriptor(
+			req.Asset.GroupInternalKey,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group internal key: %w",
+				err)
 		}
+		seedling.GroupInternalKey = &groupInternalKey
+	}
 
-		// If the asset meta field was specified, then the data inside
-		// must be valid. Let's check that now.
-		if err := seedling.Meta.Validate(); err != nil {
+	if req.Asset.AssetMeta != nil {
+		seedling.Meta, err = unmarshalAssetMeta(req.Asset.AssetMeta)
+		if err != nil {
 			return nil, err
 		}
 	}
@@ -466,17 +633,52 @@ func checkFeeRateSanity(rpcFeeRate uint32) (*chainfee.SatPerKWeight, error) {
 	return feeRate, nil
 }
 
-// FinalizeBatch attempts to finalize the current pending batch.
+// satPerVByteToFeeRate converts the given fee rate in sat/vByte to a fee rate
+// in sat/kw.
+func satPerVByteToFeeRate(satPerVByte uint64) (chainfee.SatPerKWeight, error) {
+	// A sat/vByte is worth 250 sat/kw, so we make sure the converted fee
+	// rate fits into the sat/kw fee rate fields.
+	if satPerVByte > math.MaxUint32/250 {
+		return 0, fmt.Errorf("fee rate of %d sat/vByte too high",
+			satPerVByte)
+	}
+
+	return chainfee.SatPerKVByte(satPerVByte * 1000).FeePerKWeight(), nil
+}
+
+// FinalizeBatch attempts to finalize the current pending batch, or the named
+// batch with the given name.
 func (r *rpcServer) FinalizeBatch(_ context.Context,
 	req *mintrpc.FinalizeBatchRequest) (*mintrpc.FinalizeBatchResponse,
 	error) {
 
-	feeRate, err := checkFeeRateSanity(req.FeeRate)
+	rpcFeeRate := req.FeeRate
+	if req.SatPerVbyte != 0 {
+		if req.FeeRate != 0 {
+			return nil, fmt.Errorf("cannot specify both fee_rate " +
+				"and sat_per_vbyte")
+		}
+
+		feeRate, err := satPerVByteToFeeRate(req.SatPerVbyte)
+		if err != nil {
+			return nil, err
+		}
+		rpcFeeRate = uint32(feeRate)
+	}
+
+	feeRate, err := checkFeeRateSanity(rpcFeeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	genesisPolicy, err := unmarshalGenesisPointPolicy(req.GenesisPolicy)
 	if err != nil {
 		return nil, err
 	}
 
-	batch, err := r.cfg.AssetMinter.FinalizeBatch(feeRate)
+	batch, err := r.cfg.AssetMinter.FinalizeBatch(
+		req.BatchName, feeRate, genesisPolicy,
+	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to finalize batch: %w", err)
 	}
@@ -496,7 +698,38 @@ func (r *rpcServer) FinalizeBatch(_ context.Context,
 	}, nil
 }
 
-// CancelBatch attempts to cancel the current pending batch.
+// unmarshalGenesisPointPolicy parses the optional RPC genesis point policy. Nil
+// is returned if no policy is set.
+func unmarshalGenesisPointPolicy(
+	rpcPolicy *mintrpc.GenesisPointPolicy) (*tapgarden.GenesisPointPolicy,
+	error) {
+
+	if rpcPolicy == nil {
+		return nil, nil
+	}
+
+	policy := &tapgarden.GenesisPointPolicy{
+		MinValue: btcutil.Amount(rpcPolicy.MinValueSat),
+	}
+	if rpcPolicy.Outpoint != "" {
+		outpoint, err := wire.NewOutPointFromString(rpcPolicy.Outpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid genesis outpoint: %w",
+				err)
+		}
+		policy.Outpoint = outpoint
+	}
+
+	if policy.Outpoint == nil && policy.MinValue == 0 {
+		return nil, fmt.Errorf("genesis point policy must specify an " +
+			"outpoint or a minimum value")
+	}
+
+	return policy, nil
+}
+
+// CancelBatch attempts to cancel the current pending batch. If there is no
+// pending batch, an empty response is returned.
 func (r *rpcServer) CancelBatch(_ context.Context,
 	_ *mintrpc.CancelBatchRequest) (*mintrpc.CancelBatchResponse,
 	error) {
@@ -516,3285 +749,8290 @@ func (r *rpcServer) CancelBatch(_ context.Context,
 	}, nil
 }
 
-// ListBatches lists the set of batches submitted for minting, including pending
-// and cancelled batches.
-func (r *rpcServer) ListBatches(_ context.Context,
-	req *mintrpc.ListBatchRequest) (*mintrpc.ListBatchResponse, error) {
-
-	var (
-		batchKey *btcec.PublicKey
-		err      error
-	)
-
-	switch {
-	case len(req.GetBatchKey()) > 0 && len(req.GetBatchKeyStr()) > 0:
-		return nil, fmt.Errorf("cannot specify both batch_key and " +
-			"batch_key_string")
-
-	case len(req.GetBatchKey()) > 0:
-		batchKey, err = btcec.ParsePubKey(req.GetBatchKey())
-		if err != nil {
-			return nil, fmt.Errorf("invalid batch key: %w", err)
-		}
+// BumpBatchFee attempts to replace the broadcast minting transaction of a batch
+// with one that pays a higher fee.
+func (r *rpcServer) BumpBatchFee(_ context.Context,
+	req *mintrpc.BumpBatchFeeRequest) (*mintrpc.BumpBatchFeeResponse,
+	error) {
 
-	case len(req.GetBatchKeyStr()) > 0:
-		batchKeyBytes, err := hex.DecodeString(req.GetBatchKeyStr())
-		if err != nil {
-			return nil, fmt.Errorf("invalid batch key string: %w",
-				err)
-		}
+	batchKey, err := btcec.ParsePubKey(req.BatchKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid batch key: %w", err)
+	}
 
-		batchKey, err = btcec.ParsePubKey(batchKeyBytes)
-		if err != nil {
-			return nil, fmt.Errorf("invalid batch key: %w", err)
-		}
+	if req.SatPerVbyte == 0 {
+		return nil, fmt.Errorf("fee rate must be set")
 	}
 
-	batches, err := r.cfg.AssetMinter.ListBatches(batchKey)
+	feeRate, err := satPerVByteToFeeRate(req.SatPerVbyte)
 	if err != nil {
-		return nil, fmt.Errorf("unable to list batches: %w", err)
+		return nil, err
 	}
 
-	rpcBatches, err := fn.MapErr(
-		batches,
-		func(b *tapgarden.MintingBatch) (*mintrpc.MintingBatch, error) {
-			return marshalMintingBatch(b, false)
-		},
-	)
+	txid, err := r.cfg.AssetMinter.BumpBatchFee(batchKey, feeRate)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to bump batch fee: %w", err)
 	}
 
-	return &mintrpc.ListBatchResponse{
-		Batches: rpcBatches,
+	return &mintrpc.BumpBatchFeeResponse{
+		Txid: txid.String(),
 	}, nil
 }
 
-// checkBalanceOverflow ensures that the new asset amount will not overflow
-// the max allowed asset (or asset group) balance.
-func (r *rpcServer) checkBalanceOverflow(ctx context.Context,
-	assetID *asset.ID, groupPubKey *btcec.PublicKey,
-	newAmount uint64) error {
-
-	if assetID != nil && groupPubKey != nil {
-		return fmt.Errorf("asset ID and group public key cannot both " +
-			"be set")
-	}
-
-	if assetID == nil && groupPubKey == nil {
-		return fmt.Errorf("asset ID and group public key cannot both " +
-			"be nil")
-	}
-
-	var balance uint64
+// FetchEmissionSchedule returns the remaining emission schedule of the asset
+// group with the given key.
+func (r *rpcServer) FetchEmissionSchedule(ctx context.Context,
+	req *mintrpc.FetchEmissionScheduleRequest) (
+	*mintrpc.FetchEmissionScheduleResponse, error) {
 
+	var groupKeyBytes []byte
 	switch {
-	case assetID != nil:
-		// Retrieve the current asset balance.
-		balances, err := r.cfg.AssetStore.QueryBalancesByAsset(
-			ctx, assetID,
-		)
-		if err != nil {
-			return fmt.Errorf("unable to query asset balance: %w",
-				err)
-		}
+	case len(req.GetGroupKey()) > 0 && len(req.GetGroupKeyStr()) > 0:
+		return nil, fmt.Errorf("cannot specify both group_key and " +
+			"group_key_str")
 
-		// There should only be one balance entry per asset.
-		for _, balanceEntry := range balances {
-			balance = balanceEntry.Balance
-			break
-		}
+	case len(req.GetGroupKey()) > 0:
+		groupKeyBytes = req.GetGroupKey()
 
-	case groupPubKey != nil:
-		// Retrieve the current balance of the group.
-		balances, err := r.cfg.AssetStore.QueryAssetBalancesByGroup(
-			ctx, groupPubKey,
-		)
+	case len(req.GetGroupKeyStr()) > 0:
+		var err error
+		groupKeyBytes, err = hex.DecodeString(req.GetGroupKeyStr())
 		if err != nil {
-			return fmt.Errorf("unable to query group balance: %w",
+			return nil, fmt.Errorf("invalid group key string: %w",
 				err)
 		}
 
-		// There should only be one balance entry per group.
-		for _, balanceEntry := range balances {
-			balance = balanceEntry.Balance
-			break
-		}
+	default:
+		return nil, fmt.Errorf("group key must be set")
 	}
 
-	// Check for overflow.
-	err := mssmt.CheckSumOverflowUint64(balance, newAmount)
+	groupKey, err := btcec.ParsePubKey(groupKeyBytes)
 	if err != nil {
-		return fmt.Errorf("new asset amount would overflow "+
-			"asset balance: %w", err)
+		return nil, fmt.Errorf("invalid group key: %w", err)
 	}
 
-	return nil
+	emissions, err := r.cfg.EmissionScheduler.FetchSchedule(ctx, groupKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch emission schedule: "+
+			"%w", err)
+	}
+
+	return &mintrpc.FetchEmissionScheduleResponse{
+		Emissions: fn.Map(emissions, marshalScheduledEmission),
+	}, nil
 }
 
-// ListAssets lists the set of assets owned by the target daemon.
-func (r *rpcServer) ListAssets(ctx context.Context,
-	req *taprpc.ListAssetRequest) (*taprpc.ListAssetResponse, error) {
+// FetchBatchAnchor returns the on-chain anchor of the batch with the given key.
+// Once the minting transaction is confirmed, a merkle proof of its inclusion in
+// the block it was confirmed in is returned as well.
+func (r *rpcServer) FetchBatchAnchor(ctx context.Context,
+	req *mintrpc.FetchBatchAnchorRequest) (
+	*mintrpc.FetchBatchAnchorResponse, error) {
 
-	switch {
-	case req.IncludeSpent && req.IncludeLeased:
-		return nil, fmt.Errorf("cannot specify both include_spent " +
-			"and include_leased")
+	batchKey, err := parseBatchKey(req.GetBatchKey(), req.GetBatchKeyStr())
+	if err != nil {
+		return nil, err
 	}
 
-	rpcAssets, err := r.fetchRpcAssets(
-		ctx, req.WithWitness, req.IncludeSpent, req.IncludeLeased,
-	)
+	batchAnchor, err := r.cfg.AssetMinter.FetchBatchAnchor(ctx, batchKey)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to fetch batch anchor: %w", err)
 	}
 
-	return &taprpc.ListAssetResponse{
-		Assets: rpcAssets,
-	}, nil
-}
+	var txBuf bytes.Buffer
+	if err := batchAnchor.AnchorTx.Serialize(&txBuf); err != nil {
+		return nil, err
+	}
 
-func (r *rpcServer) fetchRpcAssets(ctx context.Context, withWitness,
-	includeSpent, includeLeased bool) ([]*taprpc.Asset, error) {
+	resp := &mintrpc.FetchBatchAnchorResponse{
+		AnchorTx:    txBuf.Bytes(),
+		AnchorTxid:  batchAnchor.AnchorTx.TxHash().String(),
+		OutputIndex: batchAnchor.OutputIndex,
+		Confirmed:   batchAnchor.Confirmed(),
+	}
+	if !batchAnchor.Confirmed() {
+		return resp, nil
+	}
 
-	assets, err := r.cfg.AssetStore.FetchAllAssets(
-		ctx, includeSpent, includeLeased, nil,
-	)
+	var proofBuf bytes.Buffer
+	err = batchAnchor.TxMerkleProof.Encode(&proofBuf)
 	if err != nil {
-		return nil, fmt.Errorf("unable to read chain assets: %w", err)
+		return nil, fmt.Errorf("unable to encode merkle proof: %w", err)
 	}
 
-	rpcAssets := make([]*taprpc.Asset, len(assets))
-	for i, a := range assets {
-		rpcAssets[i], err = r.marshalChainAsset(ctx, a, withWitness)
+	resp.BlockHash = batchAnchor.BlockHash.String()
+	resp.BlockHeight = batchAnchor.BlockHeight
+	resp.TxIndex = batchAnchor.TxIndex
+	resp.TxMerkleProof = proofBuf.Bytes()
+
+	return resp, nil
+}
+
+// parseBatchKey parses a batch key that is either given as raw bytes or as a
+// hex encoded string.
+func parseBatchKey(batchKey []byte, batchKeyStr string) (*btcec.PublicKey,
+	error) {
+
+	var batchKeyBytes []byte
+	switch {
+	case len(batchKey) > 0 && len(batchKeyStr) > 0:
+		return nil, fmt.Errorf("cannot specify both batch_key and " +
+			"batch_key_str")
+
+	case len(batchKey) > 0:
+		batchKeyBytes = batchKey
+
+	case len(batchKeyStr) > 0:
+		var err error
+		batchKeyBytes, err = hex.DecodeString(batchKeyStr)
 		if err != nil {
-			return nil, fmt.Errorf("unable to marshal asset: %w",
+			return nil, fmt.Errorf("invalid batch key string: %w",
 				err)
 		}
+
+	default:
+		return nil, fmt.Errorf("batch key must be set")
 	}
 
-	return rpcAssets, nil
+	parsedKey, err := btcec.ParsePubKey(batchKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid batch key: %w", err)
+	}
+
+	return parsedKey, nil
 }
 
-func (r *rpcServer) marshalChainAsset(ctx context.Context, a *tapdb.ChainAsset,
-	withWitness bool) (*taprpc.Asset, error) {
+// SubscribeBatchConfirmation tracks the confirmations of the minting
+// transaction of a batch until the stream is closed, sending an event for each
+// change until the target number of confirmations is reached, and a rollback
+// event if a re-org drops the minting transaction below the target again.
+func (r *rpcServer) SubscribeBatchConfirmation(
+	req *mintrpc.SubscribeBatchConfirmationRequest,
+	ntfnStream mintrpc.Mint_SubscribeBatchConfirmationServer) error {
 
-	rpcAsset, err := taprpc.MarshalAsset(
-		ctx, a.Asset, a.IsSpent, withWitness, r.cfg.AddrBook,
-	)
+	batchKey, err := parseBatchKey(req.GetBatchKey(), req.GetBatchKeyStr())
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	var anchorTxBytes []byte
-	if a.AnchorTx != nil {
-		var anchorTxBuf bytes.Buffer
-		err := a.AnchorTx.Serialize(&anchorTxBuf)
-		if err != nil {
-			return nil, fmt.Errorf("unable to serialize anchor "+
-				"tx: %w", err)
-		}
-		anchorTxBytes = anchorTxBuf.Bytes()
+	if req.TargetConfs == 0 {
+		return fmt.Errorf("target_confs must be at least 1")
 	}
 
-	rpcAsset.ChainAnchor = &taprpc.AnchorInfo{
-		AnchorTx:         anchorTxBytes,
-		AnchorTxid:       a.AnchorTxid.String(),
-		AnchorBlockHash:  a.AnchorBlockHash.String(),
-		AnchorOutpoint:   a.AnchorOutpoint.String(),
-		InternalKey:      a.AnchorInternalKey.SerializeCompressed(),
-		MerkleRoot:       a.AnchorMerkleRoot,
-		TapscriptSibling: a.AnchorTapscriptSibling,
-		BlockHeight:      a.AnchorBlockHeight,
-	}
+	ctx, cancel := context.WithCancel(ntfnStream.Context())
+	defer cancel()
 
-	if a.AnchorLeaseOwner != [32]byte{} {
-		rpcAsset.LeaseOwner = a.AnchorLeaseOwner[:]
-		rpcAsset.LeaseExpiry = a.AnchorLeaseExpiry.UTC().Unix()
-	}
+	// Stop tracking the confirmations if the RPC server is shutting down.
+	go func() {
+		select {
+		case <-r.quit:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
 
-	return rpcAsset, nil
-}
+	batchKeyBytes := batchKey.SerializeCompressed()
+	err = r.cfg.AssetMinter.WatchBatchConfirmation(
+		ctx, batchKey, req.TargetConfs,
+		func(event *tapgarden.BatchConfEvent) error {
+			rpcEvent, err := marshalBatchConfEvent(
+				batchKeyBytes, event,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to marshal batch "+
+					"confirmation event: %w", err)
+			}
 
-func (r *rpcServer) listBalancesByAsset(ctx context.Context,
-	assetID *asset.ID) (*taprpc.ListBalancesResponse, error) {
+			err = ntfnStream.Send(rpcEvent)
+			if err != nil {
+				return fmt.Errorf("failed to RPC stream send "+
+					"event: %w", err)
+			}
 
-	balances, err := r.cfg.AssetStore.QueryBalancesByAsset(ctx, assetID)
-	if err != nil {
-		return nil, fmt.Errorf("unable to list balances: %w", err)
-	}
+			return nil
+		},
+	)
 
-	resp := &taprpc.ListBalancesResponse{
-		AssetBalances: make(map[string]*taprpc.AssetBalance, len(balances)),
+	// Don't return an error if a normal context cancellation has
+	// occurred, either by the client or by the RPC server shutting down.
+	if errors.Is(err, context.Canceled) {
+		return nil
 	}
 
-	for _, balance := range balances {
-		balance := balance
+	return err
+}
 
-		assetIDStr := hex.EncodeToString(balance.ID[:])
+// marshalBatchConfEventType turns the batch confirmation event type into the
+// RPC counterpart.
+func marshalBatchConfEventType(
+	eventType tapgarden.BatchConfEventType) (mintrpc.BatchConfEventType,
+	error) {
 
-		resp.AssetBalances[assetIDStr] = &taprpc.AssetBalance{
-			AssetGenesis: &taprpc.GenesisInfo{
-				Version:      int32(balance.Version),
-				GenesisPoint: balance.GenesisPoint.String(),
-				Name:         balance.Tag,
-				MetaHash:     balance.MetaHash[:],
-				AssetId:      balance.ID[:],
-			},
-			AssetType: taprpc.AssetType(balance.Type),
-			Balance:   balance.Balance,
-		}
-	}
+	switch eventType {
+	case tapgarden.BatchConfEventUpdate:
+		return mintrpc.BatchConfEventType_BATCH_CONF_EVENT_UPDATE, nil
 
-	return resp, nil
+	case tapgarden.BatchConfEventTargetReached:
+		return mintrpc.BatchConfEventType_BATCH_CONF_EVENT_TARGET_REACHED,
+			nil
+
+	case tapgarden.BatchConfEventRollback:
+		return mintrpc.BatchConfEventType_BATCH_CONF_EVENT_ROLLBACK, nil
+
+	default:
+		return 0, fmt.Errorf("unknown batch confirmation event "+
+			"type: %v", eventType)
+	}
 }
 
-func (r *rpcServer) listBalancesByGroupKey(ctx context.Context,
-	groupKey *btcec.PublicKey) (*taprpc.ListBalancesResponse, error) {
+// marshalBatchConfEvent turns a batch confirmation event into the RPC
+// counterpart.
+func marshalBatchConfEvent(batchKey []byte,
+	event *tapgarden.BatchConfEvent) (*mintrpc.BatchConfirmationEvent,
+	error) {
 
-	balances, err := r.cfg.AssetStore.QueryAssetBalancesByGroup(
-		ctx, groupKey,
-	)
+	eventType, err := marshalBatchConfEventType(event.Type)
 	if err != nil {
-		return nil, fmt.Errorf("unable to list balances: %w", err)
+		return nil, err
 	}
 
-	resp := &taprpc.ListBalancesResponse{
-		AssetGroupBalances: make(
-			map[string]*taprpc.AssetGroupBalance, len(balances),
-		),
+	rpcEvent := &mintrpc.BatchConfirmationEvent{
+		Type:        eventType,
+		BatchKey:    batchKey,
+		AnchorTxid:  event.AnchorTxid.String(),
+		NumConfs:    event.NumConfs,
+		TargetConfs: event.TargetConfs,
+		BlockHeight: event.BlockHeight,
+		Timestamp:   event.Timestamp.UnixMicro(),
 	}
-
-	for _, balance := range balances {
-		balance := balance
-
-		var groupKey []byte
-		if balance.GroupKey != nil {
-			groupKey = balance.GroupKey.SerializeCompressed()
-		}
-
-		groupKeyString := hex.EncodeToString(groupKey)
-		resp.AssetGroupBalances[groupKeyString] = &taprpc.AssetGroupBalance{
-			GroupKey: groupKey,
-			Balance:  balance.Balance,
-		}
+	if event.BlockHash != nil {
+		rpcEvent.BlockHash = event.BlockHash.String()
 	}
 
-	return resp, nil
+	return rpcEvent, nil
 }
 
-// ListUtxos lists the UTXOs managed by the target daemon, and the assets they
-// hold.
-func (r *rpcServer) ListUtxos(ctx context.Context,
-	req *taprpc.ListUtxosRequest) (*taprpc.ListUtxosResponse, error) {
+// PreviewAssetID computes the asset ID an asset would be minted with, given its
+// genesis parameters and the genesis point of the minting transaction, without
+// minting the asset.
+func (r *rpcServer) PreviewAssetID(_ context.Context,
+	req *mintrpc.PreviewAssetIDRequest) (*mintrpc.PreviewAssetIDResponse,
+	error) {
 
-	rpcAssets, err := r.fetchRpcAssets(ctx, false, false, req.IncludeLeased)
-	if err != nil {
-		return nil, err
+	if req.Asset == nil {
+		return nil, fmt.Errorf("asset cannot be nil")
 	}
 
-	managedUtxos, err := r.cfg.AssetStore.FetchManagedUTXOs(ctx)
+	err := asset.ValidateAssetName(req.Asset.Name)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid asset name: %w", err)
 	}
 
-	utxos := make(map[string]*taprpc.ManagedUtxo)
-	for _, u := range managedUtxos {
-		utxos[u.OutPoint.String()] = &taprpc.ManagedUtxo{
-			OutPoint:         u.OutPoint.String(),
-			AmtSat:           int64(u.OutputValue),
-			InternalKey:      u.InternalKey.PubKey.SerializeCompressed(),
-			TaprootAssetRoot: u.TaprootAssetRoot,
-			MerkleRoot:       u.MerkleRoot,
-		}
+	assetType := asset.Type(req.Asset.AssetType)
+	if assetType != asset.Normal && assetType != asset.Collectible {
+		return nil, fmt.Errorf("unsupported asset type: %v",
+			req.Asset.AssetType)
 	}
 
-	// Populate the assets managed by each UTXO.
-	for _, a := range rpcAssets {
-		op := a.ChainAnchor.AnchorOutpoint
-		utxo, ok := utxos[op]
-		if !ok {
-			return nil, fmt.Errorf("unable to find utxo %s for "+
-				"asset_id=%x", op, a.AssetGenesis.AssetId)
-		}
+	specificGroupKey := len(req.Asset.GroupKey) != 0
+	switch {
+	case len(req.Asset.GroupAnchor) != 0:
+		return nil, fmt.Errorf("cannot preview asset with group " +
+			"anchor, preview the group anchor asset instead")
 
-		utxo.Assets = append(utxo.Assets, a)
-		utxos[op] = utxo
-	}
+	case req.EnableEmission && specificGroupKey:
+		return nil, fmt.Errorf("must disable emission to specify a " +
+			"group")
 
-	// As a final pass, we'll prune out any UTXOs that don't have any
-	// assets, as these may be in the DB just for record keeping.
-	for _, utxo := range utxos {
-		if len(utxo.Assets) == 0 {
-			delete(utxos, utxo.OutPoint)
-		}
+	case !req.EnableEmission && req.Asset.GroupInternalKey != nil:
+		return nil, fmt.Errorf("group internal key can only be " +
+			"specified if emission is enabled")
 	}
 
-	return &taprpc.ListUtxosResponse{
-		ManagedUtxos: utxos,
-	}, nil
-}
-
-// ListGroups lists known groups and the assets held in each group.
-func (r *rpcServer) ListGroups(ctx context.Context,
-	_ *taprpc.ListGroupsRequest) (*taprpc.ListGroupsResponse, error) {
-
-	readableAssets, err := r.cfg.AssetStore.FetchGroupedAssets(ctx)
+	genesisPoint, err := wire.NewOutPointFromString(req.GenesisPoint)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid genesis point: %w", err)
 	}
 
-	groupsWithAssets := make(map[string]*taprpc.GroupedAssets)
-
-	// Populate the map of group keys to assets in that group.
-	for _, a := range readableAssets {
-		groupKey := hex.EncodeToString(a.GroupKey.SerializeCompressed())
-
-		assetVersion, err := taprpc.MarshalAssetVersion(
-			a.Version,
-		)
+	seedling := &tapgarden.Seedling{
+		AssetType: assetType,
+		AssetName: req.Asset.Name,
+	}
+	if req.Asset.AssetMeta != nil {
+		seedling.Meta, err = unmarshalAssetMeta(req.Asset.AssetMeta)
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		asset := &taprpc.AssetHumanReadable{
-			Id:               a.ID[:],
-			Version:          assetVersion,
-			Amount:           a.Amount,
-			LockTime:         int32(a.LockTime),
-			RelativeLockTime: int32(a.RelativeLockTime),
-			Tag:              a.Tag,
-			MetaHash:         a.MetaHash[:],
-			Type:             taprpc.AssetType(a.Type),
+	assetGen := seedling.Genesis(*genesisPoint, req.AnchorOutputIndex)
+	assetID := assetGen.ID()
+	resp := &mintrpc.PreviewAssetIDResponse{
+		AssetId: assetID[:],
+	}
+
+	switch {
+	// An asset minted into an existing group keeps the key of the group.
+	case specificGroupKey:
+		groupKey, err := btcec.ParsePubKey(req.Asset.GroupKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group key: %w", err)
 		}
+		resp.GroupKey = groupKey.SerializeCompressed()
 
-		_, ok := groupsWithAssets[groupKey]
-		if !ok {
-			groupsWithAssets[groupKey] = &taprpc.GroupedAssets{
-				Assets: []*taprpc.AssetHumanReadable{},
-			}
+	// The key of a new group is only known in advance if the internal key
+	// of the group is specified, otherwise it's derived at mint time.
+	case req.Asset.GroupInternalKey != nil:
+		internalKey, err := UnmarshalKeyDescriptor(
+			req.Asset.GroupInternalKey,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group internal key: %w",
+				err)
 		}
 
-		groupsWithAssets[groupKey].Assets = append(
-			groupsWithAssets[groupKey].Assets, asset,
+		groupKey, err := tapgarden.NewGroupPubKey(
+			internalKey.PubKey, assetGen,
 		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to derive group key: %w",
+				err)
+		}
+		resp.GroupKey = groupKey.SerializeCompressed()
 	}
 
-	return &taprpc.ListGroupsResponse{Groups: groupsWithAssets}, nil
+	return resp, nil
 }
 
-// ListBalances lists the asset balances owned by the daemon.
-func (r *rpcServer) ListBalances(ctx context.Context,
-	req *taprpc.ListBalancesRequest) (*taprpc.ListBalancesResponse, error) {
-
-	switch groupBy := req.GroupBy.(type) {
-	case *taprpc.ListBalancesRequest_AssetId:
-		if !groupBy.AssetId {
-			return nil, fmt.Errorf("invalid group_by")
-		}
+// CommitBatchPsbt commits the assets of the current pending batch, or the named
+// batch with the given name, to an output of a minting transaction that was
+// funded by an external wallet.
+func (r *rpcServer) CommitBatchPsbt(_ context.Context,
+	req *mintrpc.CommitBatchPsbtRequest) (*mintrpc.CommitBatchPsbtResponse,
+	error) {
 
-		var assetID *asset.ID
-		if len(req.AssetFilter) != 0 {
-			assetID = &asset.ID{}
-			if len(req.AssetFilter) != len(assetID) {
-				return nil, fmt.Errorf("invalid asset filter")
-			}
+	fundedPkt, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.FundedPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode funded psbt: %w", err)
+	}
 
-			copy(assetID[:], req.AssetFilter)
-		}
+	batch, err := r.cfg.AssetMinter.CommitBatchPsbt(
+		req.BatchName, fundedPkt, req.AnchorOutputIndex,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to commit batch: %w", err)
+	}
 
-		return r.listBalancesByAsset(ctx, assetID)
+	var buf bytes.Buffer
+	if err := batch.GenesisPacket.Pkt.Serialize(&buf); err != nil {
+		return nil, fmt.Errorf("unable to serialize psbt: %w", err)
+	}
 
-	case *taprpc.ListBalancesRequest_GroupKey:
-		if !groupBy.GroupKey {
-			return nil, fmt.Errorf("invalid group_by")
-		}
+	rpcBatch, err := marshalMintingBatch(batch, req.ShortResponse)
+	if err != nil {
+		return nil, err
+	}
 
-		var groupKey *btcec.PublicKey
-		if len(req.GroupKeyFilter) != 0 {
-			var err error
-			groupKey, err = btcec.ParsePubKey(req.GroupKeyFilter)
-			if err != nil {
-				return nil, fmt.Errorf("invalid group key "+
-					"filter: %v", err)
-			}
-		}
+	return &mintrpc.CommitBatchPsbtResponse{
+		Batch:        rpcBatch,
+		UnsignedPsbt: buf.Bytes(),
+	}, nil
+}
 
-		return r.listBalancesByGroupKey(ctx, groupKey)
+// PublishBatchPsbt finalizes and broadcasts the externally signed minting
+// transaction of a batch that was committed with CommitBatchPsbt.
+func (r *rpcServer) PublishBatchPsbt(_ context.Context,
+	req *mintrpc.PublishBatchPsbtRequest) (
+	*mintrpc.PublishBatchPsbtResponse, error) {
 
-	default:
-		return nil, fmt.Errorf("invalid group_by")
+	batchKey, err := btcec.ParsePubKey(req.BatchKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid batch key: %w", err)
 	}
-}
-
-// ListTransfers lists all asset transfers managed by this deamon.
-func (r *rpcServer) ListTransfers(ctx context.Context,
-	_ *taprpc.ListTransfersRequest) (*taprpc.ListTransfersResponse,
-	error) {
 
-	parcels, err := r.cfg.AssetStore.QueryParcels(ctx, false)
+	signedPkt, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.SignedPsbt), false,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query parcels: %w", err)
+		return nil, fmt.Errorf("unable to decode signed psbt: %w", err)
 	}
 
-	resp := &taprpc.ListTransfersResponse{
-		Transfers: make([]*taprpc.AssetTransfer, len(parcels)),
+	batch, err := r.cfg.AssetMinter.PublishBatchPsbt(batchKey, signedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to publish batch: %w", err)
 	}
 
-	for idx := range parcels {
-		resp.Transfers[idx], err = marshalOutboundParcel(parcels[idx])
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal parcel: %w",
-				err)
-		}
+	rpcBatch, err := marshalMintingBatch(batch, req.ShortResponse)
+	if err != nil {
+		return nil, err
 	}
 
-	return resp, nil
-}
+	return &mintrpc.PublishBatchPsbtResponse{
+		Batch: rpcBatch,
+	}, nil
+}
 
-// QueryAddrs queries the set of Taproot Asset addresses stored in the database.
-func (r *rpcServer) QueryAddrs(ctx context.Context,
-	req *taprpc.QueryAddrRequest) (*taprpc.QueryAddrResponse, error) {
+// unmarshalAssetMeta parses and validates an asset meta reveal from its RPC
+// counterpart.
+func unmarshalAssetMeta(meta *taprpc.AssetMeta) (*proof.MetaReveal, error) {
+	// Ensure that the meta field is within bounds.
+	switch {
+	case meta.Type < 0:
+		return nil, fmt.Errorf("meta type cannot be negative")
 
-	query := address.QueryParams{
-		Limit:  req.Limit,
-		Offset: req.Offset,
+	case meta.Type > math.MaxUint8:
+		return nil, fmt.Errorf("meta type is too large: %v, max is: %v",
+			meta.Type, math.MaxUint8)
 	}
 
-	// The unix time of 0 (1970-01-01) is not the same as an empty Time
-	// struct (0000-00-00). For our query to succeed, we need to set the
-	// time values the way the address book expects them.
-	if req.CreatedBefore > 0 {
-		query.CreatedBefore = time.Unix(req.CreatedBefore, 0)
+	metaReveal := &proof.MetaReveal{
+		Type: proof.MetaType(meta.Type),
+		Data: meta.Data,
 	}
-	if req.CreatedAfter > 0 {
-		query.CreatedAfter = time.Unix(req.CreatedAfter, 0)
-	}
-
-	rpcsLog.Debugf("[QueryAddrs]: addr query params: %v",
-		spew.Sdump(query))
 
-	dbAddrs, err := r.cfg.AddrBook.ListAddrs(ctx, query)
-	if err != nil {
-		return nil, fmt.Errorf("unable to query addrs: %w", err)
+	// If the asset meta field was specified, then the data inside must be
+	// valid. Let's check that now.
+	if err := metaReveal.Validate(); err != nil {
+		return nil, err
 	}
 
-	// TODO(roasbeef): just stop storing the hrp in the addr?
-	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+	return metaReveal, nil
+}
 
-	addrs := make([]*taprpc.Addr, len(dbAddrs))
-	for i, dbAddr := range dbAddrs {
-		dbAddr.ChainParams = &tapParams
+// unmarshalEmissionEvent parses an emission event from its RPC counterpart.
+func unmarshalEmissionEvent(
+	event *mintrpc.EmissionEvent) tapgarden.EmissionEvent {
 
-		addrs[i], err = marshalAddr(dbAddr.Tap, r.cfg.TapAddrBook)
-		if err != nil {
-			return nil, fmt.Errorf("unable to marshal addr: %w",
-				err)
-		}
+	return tapgarden.EmissionEvent{
+		BlockHeight: event.BlockHeight,
+		Amount:      event.Amount,
 	}
-
-	rpcsLog.Debugf("[QueryAddrs]: returning %v addrs", len(addrs))
-
-	return &taprpc.QueryAddrResponse{
-		Addrs: addrs,
-	}, nil
 }
 
-// NewAddr makes a new address from the set of request params.
-func (r *rpcServer) NewAddr(ctx context.Context,
-	req *taprpc.NewAddrRequest) (*taprpc.Addr, error) {
-
-	var err error
-
-	// Parse the proof courier address if one was provided, otherwise use
-	// the default specified in the config.
-	courierAddr := r.cfg.DefaultProofCourierAddr
-	if req.ProofCourierAddr != "" {
-		addr, err := proof.ParseCourierAddrString(
-			req.ProofCourierAddr,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("invalid proof courier "+
-				"address: %w", err)
-		}
+// marshalEmissionEvent marshals an emission event into its RPC counterpart.
+func marshalEmissionEvent(
+	event tapgarden.EmissionEvent) *mintrpc.EmissionEvent {
 
-		// At this point, we do not intend on creating a proof courier
-		// service instance. We are only interested in parsing and
-		// validating the address. We therefore convert the address into
-		// an url.URL type for storage in the address book.
-		courierAddr = addr.Url()
+	return &mintrpc.EmissionEvent{
+		BlockHeight: event.BlockHeight,
+		Amount:      event.Amount,
 	}
+}
 
-	// Check that the proof courier address is set. This should never
-	// happen, but we check anyway to avoid panics (possibly caused by
-	// future erroneous config changes).
-	if courierAddr == nil {
-		return nil, fmt.Errorf("no proof courier address provided")
-	}
-	proofCourierAddr := *courierAddr
+// marshalScheduledEmission marshals a pending emission event into its RPC
+// counterpart.
+func marshalScheduledEmission(
+	emission tapgarden.PendingEmission) *mintrpc.ScheduledEmission {
 
-	if len(req.AssetId) != 32 {
-		return nil, fmt.Errorf("invalid asset id length")
+	return &mintrpc.ScheduledEmission{
+		AssetName:   emission.AssetName,
+		BlockHeight: emission.BlockHeight,
+		Amount:      emission.Amount,
 	}
+}
 
-	var assetID asset.ID
-	copy(assetID[:], req.AssetId)
-
-	rpcsLog.Infof("[NewAddr]: making new addr: asset_id=%x, amt=%v",
-		assetID[:], req.Amt)
-
-	err = r.checkBalanceOverflow(ctx, &assetID, nil, req.Amt)
-	if err != nil {
-		return nil, err
-	}
+// ListBatches lists the set of batches submitted for minting, including pending
+// and cancelled batches.
+func (r *rpcServer) ListBatches(_ context.Context,
+	req *mintrpc.ListBatchRequest) (*mintrpc.ListBatchResponse, error) {
 
-	// Was there a tapscript sibling preimage specified?
-	tapscriptSibling, _, err := commitment.MaybeDecodeTapscriptPreimage(
-		req.TapscriptSibling,
+	var (
+		batchKey *btcec.PublicKey
+		err      error
 	)
-	if err != nil {
-		return nil, fmt.Errorf("invalid tapscript sibling: %w", err)
-	}
-
-	assetVersion, err := taprpc.UnmarshalAssetVersion(req.AssetVersion)
-	if err != nil {
-		return nil, err
-	}
 
-	var addr *address.AddrWithKeyInfo
 	switch {
-	// No key was specified, we'll let the address book derive them.
-	case req.ScriptKey == nil && req.InternalKey == nil:
-		// Now that we have all the params, we'll try to add a new
-		// address to the addr book.
-		addr, err = r.cfg.AddrBook.NewAddress(
-			ctx, assetID, req.Amt, tapscriptSibling,
-			proofCourierAddr,
-			address.WithAssetVersion(assetVersion),
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to make new addr: %w",
-				err)
-		}
-
-	// Only the script key was specified.
-	case req.ScriptKey != nil && req.InternalKey == nil:
-		return nil, fmt.Errorf("internal key must also be specified " +
-			"if script key is specified")
-
-	// Only the internal key was specified.
-	case req.ScriptKey == nil && req.InternalKey != nil:
-		return nil, fmt.Errorf("script key must also be specified " +
-			"if internal key is specified")
+	case len(req.GetBatchKey()) > 0 && len(req.GetBatchKeyStr()) > 0:
+		return nil, fmt.Errorf("cannot specify both batch_key and " +
+			"batch_key_string")
 
-	// Both the script and internal keys were specified.
-	default:
-		scriptKey, err := UnmarshalScriptKey(req.ScriptKey)
+	case len(req.GetBatchKey()) > 0:
+		batchKey, err = btcec.ParsePubKey(req.GetBatchKey())
 		if err != nil {
-			return nil, fmt.Errorf("unable to decode script key: "+
-				"%w", err)
+			return nil, fmt.Errorf("invalid batch key: %w", err)
 		}
 
-		rpcsLog.Debugf("Decoded script key %x (internal %x, tweak %x)",
-			scriptKey.PubKey.SerializeCompressed(),
-			scriptKey.RawKey.PubKey.SerializeCompressed(),
-			scriptKey.Tweak[:])
-
-		internalKey, err := UnmarshalKeyDescriptor(req.InternalKey)
+	case len(req.GetBatchKeyStr()) > 0:
+		batchKeyBytes, err := hex.DecodeString(req.GetBatchKeyStr())
 		if err != nil {
-			return nil, fmt.Errorf("unable to decode internal "+
-				"key: %w", err)
+			return nil, fmt.Errorf("invalid batch key string: %w",
+				err)
 		}
 
-		// Now that we have all the params, we'll try to add a new
-		// address to the addr book.
-		addr, err = r.cfg.AddrBook.NewAddressWithKeys(
-			ctx, assetID, req.Amt, *scriptKey, internalKey,
-			tapscriptSibling, proofCourierAddr,
-			address.WithAssetVersion(assetVersion),
-		)
+		batchKey, err = btcec.ParsePubKey(batchKeyBytes)
 		if err != nil {
-			return nil, fmt.Errorf("unable to make new addr: %w",
-				err)
+			return nil, fmt.Errorf("invalid batch key: %w", err)
 		}
 	}
 
-	// With our addr obtained, we'll marshal it as an RPC message then send
-	// off the response.
-	rpcAddr, err := marshalAddr(addr.Tap, r.cfg.TapAddrBook)
+	batches, err := r.cfg.AssetMinter.ListBatches(batchKey)
 	if err != nil {
-		return nil, fmt.Errorf("unable to marshal addr: %w", err)
+		return nil, fmt.Errorf("unable to list batches: %w", err)
 	}
 
-	return rpcAddr, nil
-}
+	// Only pending batches are still open for new assets.
+	if req.OpenOnly {
+		isOpen := func(b *tapgarden.MintingBatch) bool {
+			return b.State() == tapgarden.BatchStatePending
+		}
+		batches = fn.Filter(batches, isOpen)
+	}
 
-// DecodeAddr decode a Taproot Asset address into a partial asset message that
-// represents the asset it wants to receive.
-func (r *rpcServer) DecodeAddr(_ context.Context,
-	req *taprpc.DecodeAddrRequest) (*taprpc.Addr, error) {
+	rpcBatches, err := fn.MapErr(
+		batches,
+		func(b *tapgarden.MintingBatch) (*mintrpc.MintingBatch, error) {
+			return marshalMintingBatch(b, false)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
 
-	if len(req.Addr) == 0 {
-		return nil, fmt.Errorf("must specify an addr")
+	if req.LabelFilter != "" {
+		rpcBatches = filterBatchesByLabel(rpcBatches, req.LabelFilter)
 	}
 
-	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+	return &mintrpc.ListBatchResponse{
+		Batches: rpcBatches,
+	}, nil
+}
 
-	addr, err := address.DecodeAddress(req.Addr, &tapParams)
-	if err != nil {
-		return nil, fmt.Errorf("unable to decode addr: %w", err)
+// filterBatchesByLabel only keeps the assets of the given batches that have a
+// label containing the given string, matched case-insensitively. Batches
+// without any such asset are removed.
+func filterBatchesByLabel(batches []*mintrpc.MintingBatch,
+	labelFilter string) []*mintrpc.MintingBatch {
+
+	labelFilter = strings.ToLower(labelFilter)
+	matchesLabel := func(a *mintrpc.MintAsset) bool {
+		return strings.Contains(strings.ToLower(a.Label), labelFilter)
 	}
 
-	rpcAddr, err := marshalAddr(addr, r.cfg.TapAddrBook)
-	if err != nil {
-		return nil, fmt.Errorf("unable to marshal addr: %w", err)
+	filtered := make([]*mintrpc.MintingBatch, 0, len(batches))
+	for _, batch := range batches {
+		batch.Assets = fn.Filter(batch.Assets, matchesLabel)
+		if len(batch.Assets) == 0 {
+			continue
+		}
+
+		filtered = append(filtered, batch)
 	}
 
-	return rpcAddr, nil
+	return filtered
 }
 
-// VerifyProof attempts to verify a given proof file that claims to be anchored
-// at the specified genesis point.
-func (r *rpcServer) VerifyProof(ctx context.Context,
-	req *taprpc.ProofFile) (*taprpc.VerifyProofResponse, error) {
+// ListMints lists the issuance history of the node, which is every batch that
+// was ever submitted for minting, including cancelled and failed batches.
+func (r *rpcServer) ListMints(ctx context.Context,
+	req *mintrpc.ListMintsRequest) (*mintrpc.ListMintsResponse, error) {
 
-	if !proof.IsProofFile(req.RawProofFile) {
-		return nil, fmt.Errorf("invalid raw proof, expect file, not " +
-			"single encoded mint or transition proof")
+	if req.StartTimestamp < 0 || req.EndTimestamp < 0 {
+		return nil, fmt.Errorf("timestamps must not be negative")
+	}
+	if req.EndTimestamp != 0 && req.EndTimestamp < req.StartTimestamp {
+		return nil, fmt.Errorf("end timestamp %d is before start "+
+			"timestamp %d", req.EndTimestamp, req.StartTimestamp)
 	}
 
-	if err := proof.CheckMaxFileSize(req.RawProofFile); err != nil {
-		return nil, fmt.Errorf("invalid proof file: %w", err)
+	var query tapgarden.MintHistoryQuery
+	if req.StartTimestamp != 0 {
+		query.StartTime = time.Unix(req.StartTimestamp, 0)
+	}
+	if req.EndTimestamp != 0 {
+		query.EndTime = time.Unix(req.EndTimestamp, 0)
 	}
 
-	var proofFile proof.File
-	err := proofFile.Decode(bytes.NewReader(req.RawProofFile))
+	states, err := fn.MapErr(req.States, unmarshalBatchState)
 	if err != nil {
-		return nil, fmt.Errorf("unable to decode proof file: %w", err)
+		return nil, err
 	}
+	query.States = states
 
-	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
-	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
-	_, err = proofFile.Verify(ctx, headerVerifier, groupVerifier)
+	records, err := r.cfg.AssetMinter.ListMints(ctx, query)
 	if err != nil {
-		// We don't want to fail the RPC request because of a proof
-		// verification error, but we do want to log it for easier
-		// debugging.
-		rpcsLog.Errorf("Proof verification failed with err: %v", err)
+		return nil, fmt.Errorf("unable to list mints: %w", err)
 	}
-	valid := err == nil
 
-	p, err := proofFile.LastProof()
+	rpcRecords, err := fn.MapErr(records, marshalMintRecord)
 	if err != nil {
 		return nil, err
 	}
-	decodedProof, err := r.marshalProof(ctx, p, false, false)
-	if err != nil {
-		return nil, fmt.Errorf("unable to marshal proof: %w", err)
-	}
-
-	decodedProof.ProofAtDepth = 0
-	decodedProof.NumberOfProofs = uint32(proofFile.NumProofs())
 
-	return &taprpc.VerifyProofResponse{
-		Valid:        valid,
-		DecodedProof: decodedProof,
+	return &mintrpc.ListMintsResponse{
+		Mints: rpcRecords,
 	}, nil
 }
 
-// DecodeProof attempts to decode a given proof file that claims to be anchored
-// at the specified genesis point.
-func (r *rpcServer) DecodeProof(ctx context.Context,
-	req *taprpc.DecodeProofRequest) (*taprpc.DecodeProofResponse, error) {
+// marshalMintRecord converts a record of the issuance history into its RPC
+// counterpart.
+func marshalMintRecord(
+	record *tapgarden.MintRecord) (*mintrpc.MintRecord, error) {
 
-	var (
-		proofReader = bytes.NewReader(req.RawProof)
-		rpcProof    *taprpc.DecodedProof
-	)
+	batch := record.Batch
+	rpcBatchState, err := marshalBatchState(batch)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcRecord := &mintrpc.MintRecord{
+		BatchKey:          batch.BatchKey.PubKey.SerializeCompressed(),
+		BatchName:         batch.Name,
+		State:             rpcBatchState,
+		CreationTimestamp: batch.CreationTime.Unix(),
+	}
+
+	// Once the batch is committed, the sprouts carry the final assets
+	// including their IDs. Before that, we only know the seedlings.
 	switch {
-	case proof.IsSingleProof(req.RawProof):
-		var p proof.Proof
-		err := p.Decode(proofReader)
-		if err != nil {
-			return nil, fmt.Errorf("unable to decode proof: %w",
-				err)
-		}
+	case batch.RootAssetCommitment != nil &&
+		len(batch.RootAssetCommitment.CommittedAssets()) > 0:
 
-		rpcProof, err = r.marshalProof(
-			ctx, &p, req.WithPrevWitnesses, req.WithMetaReveal,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to marshal proof: %w",
-				err)
-		}
+		sprouts := batch.RootAssetCommitment.CommittedAssets()
+		for _, sprout := range sprouts {
+			assetID := sprout.ID()
 
-		rpcProof.NumberOfProofs = 1
+			var groupKeyBytes []byte
+			if sprout.GroupKey != nil {
+				gpk := sprout.GroupKey.GroupPubKey
+				groupKeyBytes = gpk.SerializeCompressed()
+			}
 
-	case proof.IsProofFile(req.RawProof):
+			rpcRecord.Assets = append(rpcRecord.Assets,
+				&mintrpc.MintedAsset{
+					AssetId: assetID[:],
+					Name:    sprout.Tag,
+					AssetType: taprpc.AssetType(
+						sprout.Type,
+					),
+					Amount:   sprout.Amount,
+					GroupKey: groupKeyBytes,
+					Label:    batch.AssetLabels[assetID],
+				},
+			)
+		}
+
+	default:
+		for _, seedling := range batch.Seedlings {
+			var groupKeyBytes []byte
+			if seedling.HasGroupKey() {
+				gpk := seedling.GroupInfo.GroupKey.GroupPubKey
+				groupKeyBytes = gpk.SerializeCompressed()
+			}
+
+			rpcRecord.Assets = append(rpcRecord.Assets,
+				&mintrpc.MintedAsset{
+					Name: seedling.AssetName,
+					AssetType: taprpc.AssetType(
+						seedling.AssetType,
+					),
+					Amount:   seedling.Amount,
+					GroupKey: groupKeyBytes,
+					Label:    seedling.Label,
+				},
+			)
+		}
+
+		// The seedlings are kept in a map, so we sort them by name
+		// to return them in a stable order.
+		sort.Slice(rpcRecord.Assets, func(i, j int) bool {
+			return rpcRecord.Assets[i].Name <
+				rpcRecord.Assets[j].Name
+		})
+	}
+
+	anchor := record.Anchor
+	if anchor == nil {
+		return rpcRecord, nil
+	}
+
+	rpcRecord.AnchorTxid = anchor.AnchorTx.TxHash().String()
+	if anchor.Confirmed() {
+		rpcRecord.BlockHeight = anchor.BlockHeight
+		rpcRecord.BlockHash = anchor.BlockHash.String()
+	}
+
+	return rpcRecord, nil
+}
+
+// checkBalanceOverflow ensures that the new asset amount will not overflow
+// the max allowed asset (or asset group) balance.
+func (r *rpcServer) checkBalanceOverflow(ctx context.Context,
+	assetID *asset.ID, groupPubKey *btcec.PublicKey,
+	newAmount uint64) error {
+
+	if assetID != nil && groupPubKey != nil {
+		return fmt.Errorf("asset ID and group public key cannot both " +
+			"be set")
+	}
+
+	if assetID == nil && groupPubKey == nil {
+		return fmt.Errorf("asset ID and group public key cannot both " +
+			"be nil")
+	}
+
+	var balance uint64
+
+	switch {
+	case assetID != nil:
+		// Retrieve the current asset balance.
+		balances, err := r.cfg.AssetStore.QueryBalancesByAsset(
+			ctx, assetID,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to query asset balance: %w",
+				err)
+		}
+
+		// There should only be one balance entry per asset.
+		for _, balanceEntry := range balances {
+			balance = balanceEntry.Balance
+			break
+		}
+
+	case groupPubKey != nil:
+		// Retrieve the current balance of the group.
+		balances, err := r.cfg.AssetStore.QueryAssetBalancesByGroup(
+			ctx, groupPubKey,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to query group balance: %w",
+				err)
+		}
+
+		// There should only be one balance entry per group.
+		for _, balanceEntry := range balances {
+			balance = balanceEntry.Balance
+			break
+		}
+	}
+
+	// Check for overflow.
+	err := mssmt.CheckSumOverflowUint64(balance, newAmount)
+	if err != nil {
+		return fmt.Errorf("new asset amount would overflow "+
+			"asset balance: %w", err)
+	}
+
+	return nil
+}
+
+// ListAssets lists the set of assets owned by the target daemon.
+func (r *rpcServer) ListAssets(ctx context.Context,
+	req *taprpc.ListAssetRequest) (*taprpc.ListAssetResponse, error) {
+
+	switch {
+	case req.IncludeSpent && req.IncludeLeased:
+		return nil, fmt.Errorf("cannot specify both include_spent " +
+			"and include_leased")
+	}
+
+	rpcAssets, err := r.fetchRpcAssets(
+		ctx, req.WithWitness, req.IncludeSpent, req.IncludeLeased,
+		req.LabelFilter,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taprpc.ListAssetResponse{
+		Assets: rpcAssets,
+	}, nil
+}
+
+func (r *rpcServer) fetchRpcAssets(ctx context.Context, withWitness,
+	includeSpent, includeLeased bool,
+	labelFilter string) ([]*taprpc.Asset, error) {
+
+	var filters *tapdb.AssetQueryFilters
+	if labelFilter != "" {
+		filters = &tapdb.AssetQueryFilters{
+			Label: labelFilter,
+		}
+	}
+
+	assets, err := r.cfg.AssetStore.FetchAllAssets(
+		ctx, includeSpent, includeLeased, filters,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read chain assets: %w", err)
+	}
+
+	rpcAssets := make([]*taprpc.Asset, len(assets))
+	for i, a := range assets {
+		rpcAssets[i], err = r.marshalChainAsset(ctx, a, withWitness)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal asset: %w",
+				err)
+		}
+	}
+
+	return rpcAssets, nil
+}
+
+func (r *rpcServer) marshalChainAsset(ctx context.Context, a *tapdb.ChainAsset,
+	withWitness bool) (*taprpc.Asset, error) {
+
+	rpcAsset, err := taprpc.MarshalAsset(
+		ctx, a.Asset, a.IsSpent, withWitness, r.cfg.AddrBook,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var anchorTxBytes []byte
+	if a.AnchorTx != nil {
+		var anchorTxBuf bytes.Buffer
+		err := a.AnchorTx.Serialize(&anchorTxBuf)
+		if err != nil {
+			return nil, fmt.Errorf("unable to serialize anchor "+
+				"tx: %w", err)
+		}
+		anchorTxBytes = anchorTxBuf.Bytes()
+	}
+
+	rpcAsset.ChainAnchor = &taprpc.AnchorInfo{
+		AnchorTx:         anchorTxBytes,
+		AnchorTxid:       a.AnchorTxid.String(),
+		AnchorBlockHash:  a.AnchorBlockHash.String(),
+		AnchorOutpoint:   a.AnchorOutpoint.String(),
+		InternalKey:      a.AnchorInternalKey.SerializeCompressed(),
+		MerkleRoot:       a.AnchorMerkleRoot,
+		TapscriptSibling: a.AnchorTapscriptSibling,
+		BlockHeight:      a.AnchorBlockHeight,
+	}
+	rpcAsset.Label = a.Label
+
+	if a.AnchorLeaseOwner != [32]byte{} {
+		rpcAsset.LeaseOwner = a.AnchorLeaseOwner[:]
+		rpcAsset.LeaseExpiry = a.AnchorLeaseExpiry.UTC().Unix()
+	}
+
+	return rpcAsset, nil
+}
+
+func (r *rpcServer) listBalancesByAsset(ctx context.Context,
+	assetID *asset.ID,
+	nonZeroOnly bool) (*taprpc.ListBalancesResponse, error) {
+
+	balances, err := r.cfg.AssetStore.QueryBalancesByAsset(ctx, assetID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list balances: %w", err)
+	}
+
+	resp := &taprpc.ListBalancesResponse{
+		AssetBalances: make(map[string]*taprpc.AssetBalance, len(balances)),
+	}
+
+	for _, balance := range balances {
+		balance := balance
+
+		if nonZeroOnly && balance.Balance == 0 {
+			continue
+		}
+
+		assetIDStr := hex.EncodeToString(balance.ID[:])
+
+		resp.AssetBalances[assetIDStr] = &taprpc.AssetBalance{
+			AssetGenesis: &taprpc.GenesisInfo{
+				Version:      int32(balance.Version),
+				GenesisPoint: balance.GenesisPoint.String(),
+				Name:         balance.Tag,
+				MetaHash:     balance.MetaHash[:],
+				AssetId:      balance.ID[:],
+				AssetType:    taprpc.AssetType(balance.Type),
+			},
+			AssetType: taprpc.AssetType(balance.Type),
+			Balance:   balance.Balance,
+			NumUtxos:  balance.NumUtxos,
+		}
+	}
+
+	return resp, nil
+}
+
+func (r *rpcServer) listBalancesByGroupKey(ctx context.Context,
+	groupKey *btcec.PublicKey,
+	nonZeroOnly bool) (*taprpc.ListBalancesResponse, error) {
+
+	balances, err := r.cfg.AssetStore.QueryAssetBalancesByGroup(
+		ctx, groupKey,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list balances: %w", err)
+	}
+
+	resp := &taprpc.ListBalancesResponse{
+		AssetGroupBalances: make(
+			map[string]*taprpc.AssetGroupBalance, len(balances),
+		),
+	}
+
+	for _, balance := range balances {
+		balance := balance
+
+		if nonZeroOnly && balance.Balance == 0 {
+			continue
+		}
+
+		var groupKey []byte
+		if balance.GroupKey != nil {
+			groupKey = balance.GroupKey.SerializeCompressed()
+		}
+
+		groupKeyString := hex.EncodeToString(groupKey)
+		resp.AssetGroupBalances[groupKeyString] = &taprpc.AssetGroupBalance{
+			GroupKey: groupKey,
+			Balance:  balance.Balance,
+			NumUtxos: balance.NumUtxos,
+		}
+	}
+
+	return resp, nil
+}
+
+// ListUtxos lists the UTXOs managed by the target daemon, and the assets they
+// hold.
+func (r *rpcServer) ListUtxos(ctx context.Context,
+	req *taprpc.ListUtxosRequest) (*taprpc.ListUtxosResponse, error) {
+
+	rpcAssets, err := r.fetchRpcAssets(
+		ctx, false, false, req.IncludeLeased, "",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	managedUtxos, err := r.cfg.AssetStore.FetchManagedUTXOs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	utxos := make(map[string]*taprpc.ManagedUtxo)
+	for _, u := range managedUtxos {
+		utxos[u.OutPoint.String()] = &taprpc.ManagedUtxo{
+			OutPoint:         u.OutPoint.String(),
+			AmtSat:           int64(u.OutputValue),
+			InternalKey:      u.InternalKey.PubKey.SerializeCompressed(),
+			TaprootAssetRoot: u.TaprootAssetRoot,
+			MerkleRoot:       u.MerkleRoot,
+		}
+	}
+
+	// Populate the assets managed by each UTXO.
+	for _, a := range rpcAssets {
+		op := a.ChainAnchor.AnchorOutpoint
+		utxo, ok := utxos[op]
+		if !ok {
+			return nil, fmt.Errorf("unable to find utxo %s for "+
+				"asset_id=%x", op, a.AssetGenesis.AssetId)
+		}
+
+		utxo.Assets = append(utxo.Assets, a)
+		utxos[op] = utxo
+	}
+
+	// As a final pass, we'll prune out any UTXOs that don't have any
+	// assets, as these may be in the DB just for record keeping.
+	for _, utxo := range utxos {
+		if len(utxo.Assets) == 0 {
+			delete(utxos, utxo.OutPoint)
+		}
+	}
+
+	return &taprpc.ListUtxosResponse{
+		ManagedUtxos: utxos,
+	}, nil
+}
+
+// SetAssetLabel sets the human-readable label of all assets with the given
+// asset ID. An empty label removes the current label.
+func (r *rpcServer) SetAssetLabel(ctx context.Context,
+	req *taprpc.SetAssetLabelRequest) (*taprpc.SetAssetLabelResponse,
+	error) {
+
+	if len(req.AssetId) != sha256.Size {
+		return nil, status.Errorf(codes.InvalidArgument, "asset ID "+
+			"must be %d bytes", sha256.Size)
+	}
+
+	if err := tapgarden.ValidateAssetLabel(req.Label); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var assetID asset.ID
+	copy(assetID[:], req.AssetId)
+
+	err := r.cfg.AssetStore.SetAssetLabel(ctx, assetID, req.Label)
+	switch {
+	case errors.Is(err, tapdb.ErrAssetNotFound):
+		return nil, status.Errorf(codes.NotFound, "asset %v not found",
+			assetID)
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to set asset label: %w", err)
+	}
+
+	return &taprpc.SetAssetLabelResponse{}, nil
+}
+
+// ListGroups lists known groups and the assets held in each group.
+func (r *rpcServer) ListGroups(ctx context.Context,
+	_ *taprpc.ListGroupsRequest) (*taprpc.ListGroupsResponse, error) {
+
+	readableAssets, err := r.cfg.AssetStore.FetchGroupedAssets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	groupsWithAssets := make(map[string]*taprpc.GroupedAssets)
+
+	// Populate the map of group keys to assets in that group.
+	for _, a := range readableAssets {
+		groupKey := hex.EncodeToString(a.GroupKey.SerializeCompressed())
+
+		assetVersion, err := taprpc.MarshalAssetVersion(
+			a.Version,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		asset := &taprpc.AssetHumanReadable{
+			Id:               a.ID[:],
+			Version:          assetVersion,
+			Amount:           a.Amount,
+			LockTime:         int32(a.LockTime),
+			RelativeLockTime: int32(a.RelativeLockTime),
+			Tag:              a.Tag,
+			MetaHash:         a.MetaHash[:],
+			Type:             taprpc.AssetType(a.Type),
+		}
+
+		_, ok := groupsWithAssets[groupKey]
+		if !ok {
+			groupsWithAssets[groupKey] = &taprpc.GroupedAssets{
+				Assets: []*taprpc.AssetHumanReadable{},
+			}
+		}
+
+		groupsWithAssets[groupKey].Assets = append(
+			groupsWithAssets[groupKey].Assets, asset,
+		)
+	}
+
+	return &taprpc.ListGroupsResponse{Groups: groupsWithAssets}, nil
+}
+
+// ListBalances lists the asset balances owned by the daemon.
+func (r *rpcServer) ListBalances(ctx context.Context,
+	req *taprpc.ListBalancesRequest) (*taprpc.ListBalancesResponse, error) {
+
+	switch groupBy := req.GroupBy.(type) {
+	case *taprpc.ListBalancesRequest_AssetId:
+		if !groupBy.AssetId {
+			return nil, fmt.Errorf("invalid group_by")
+		}
+
+		var assetID *asset.ID
+		if len(req.AssetFilter) != 0 {
+			assetID = &asset.ID{}
+			if len(req.AssetFilter) != len(assetID) {
+				return nil, fmt.Errorf("invalid asset filter")
+			}
+
+			copy(assetID[:], req.AssetFilter)
+		}
+
+		return r.listBalancesByAsset(ctx, assetID, req.NonZeroOnly)
+
+	case *taprpc.ListBalancesRequest_GroupKey:
+		if !groupBy.GroupKey {
+			return nil, fmt.Errorf("invalid group_by")
+		}
+
+		var groupKey *btcec.PublicKey
+		if len(req.GroupKeyFilter) != 0 {
+			var err error
+			groupKey, err = btcec.ParsePubKey(req.GroupKeyFilter)
+			if err != nil {
+				return nil, fmt.Errorf("invalid group key "+
+					"filter: %v", err)
+			}
+		}
+
+		return r.listBalancesByGroupKey(
+			ctx, groupKey, req.NonZeroOnly,
+		)
+
+	default:
+		return nil, fmt.Errorf("invalid group_by")
+	}
+}
+
+// ListTransfers lists all asset transfers managed by this deamon.
+func (r *rpcServer) ListTransfers(ctx context.Context,
+	req *taprpc.ListTransfersRequest) (*taprpc.ListTransfersResponse,
+	error) {
+
+	filterAssetID, err := unmarshalTransferAssetFilter(req.FilterAssetId)
+	if err != nil {
+		return nil, err
+	}
+
+	filterState, err := unmarshalTransferState(req.FilterState)
+	if err != nil {
+		return nil, err
+	}
+
+	parcels, err := r.cfg.AssetStore.QueryParcels(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query parcels: %w", err)
+	}
+
+	// Parcels are only marked as confirmed on disk once all proofs were
+	// delivered, so we need the set of pending parcels to tell completed
+	// transfers apart from the ones still in-flight.
+	pendingParcels, err := r.cfg.AssetStore.PendingParcels(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pending parcels: %w",
+			err)
+	}
+	pending := make(map[chainhash.Hash]struct{}, len(pendingParcels))
+	for _, parcel := range pendingParcels {
+		pending[parcel.AnchorTx.TxHash()] = struct{}{}
+	}
+
+	resp := &taprpc.ListTransfersResponse{
+		Transfers: make([]*taprpc.AssetTransfer, 0, len(parcels)),
+	}
+	for _, parcel := range parcels {
+		if filterAssetID != nil &&
+			!parcelSpendsAsset(parcel, *filterAssetID) {
+
+			continue
+		}
+
+		anchorTxHash := parcel.AnchorTx.TxHash()
+		state := tapfreighter.TransferStateCompleted
+		if _, ok := pending[anchorTxHash]; ok {
+			// The porter resumes all pending parcels on startup, so
+			// it should know about their current state. If it
+			// doesn't (yet), the transfer is still unconfirmed.
+			state = tapfreighter.TransferStateUnconfirmed
+			porterState, ok := r.cfg.ChainPorter.TransferState(
+				anchorTxHash,
+			)
+			if ok {
+				state = porterState
+			}
+		}
+
+		if filterState != nil && state != *filterState {
+			continue
+		}
+
+		rpcParcel, err := marshalOutboundParcel(parcel, state)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal parcel: %w",
+				err)
+		}
+
+		resp.Transfers = append(resp.Transfers, rpcParcel)
+	}
+
+	if !req.IncludeReceives {
+		return resp, nil
+	}
+
+	events, err := r.cfg.AddrBook.QueryEvents(
+		ctx, address.EventQueryParams{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query address events: %w",
+			err)
+	}
+
+	resp.Receives = make([]*taprpc.AddrEvent, 0, len(events))
+	for _, event := range events {
+		if filterAssetID != nil &&
+			event.Addr.AssetID != *filterAssetID {
+
+			continue
+		}
+
+		rpcEvent, err := marshalAddrEvent(event, r.cfg.TapAddrBook)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal address "+
+				"event: %w", err)
+		}
+
+		resp.Receives = append(resp.Receives, rpcEvent)
+	}
+
+	return resp, nil
+}
+
+// unmarshalTransferAssetFilter parses the optional asset ID filter of a
+// transfer query.
+func unmarshalTransferAssetFilter(filter []byte) (*asset.ID, error) {
+	if len(filter) == 0 {
+		return nil, nil
+	}
+
+	var assetID asset.ID
+	if len(filter) != len(assetID) {
+		return nil, fmt.Errorf("invalid asset ID filter length")
+	}
+	copy(assetID[:], filter)
+
+	return &assetID, nil
+}
+
+// parcelSpendsAsset returns true if any of the inputs of the given parcel
+// spends the asset with the given ID.
+func parcelSpendsAsset(parcel *tapfreighter.OutboundParcel,
+	assetID asset.ID) bool {
+
+	for _, in := range parcel.Inputs {
+		if in.ID == assetID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// QueryAddrs queries the set of Taproot Asset addresses stored in the database.
+func (r *rpcServer) QueryAddrs(ctx context.Context,
+	req *taprpc.QueryAddrRequest) (*taprpc.QueryAddrResponse, error) {
+
+	query := address.QueryParams{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	}
+
+	// The unix time of 0 (1970-01-01) is not the same as an empty Time
+	// struct (0000-00-00). For our query to succeed, we need to set the
+	// time values the way the address book expects them.
+	if req.CreatedBefore > 0 {
+		query.CreatedBefore = time.Unix(req.CreatedBefore, 0)
+	}
+	if req.CreatedAfter > 0 {
+		query.CreatedAfter = time.Unix(req.CreatedAfter, 0)
+	}
+
+	rpcsLog.Debugf("[QueryAddrs]: addr query params: %v",
+		spew.Sdump(query))
+
+	dbAddrs, err := r.cfg.AddrBook.ListAddrs(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query addrs: %w", err)
+	}
+
+	// TODO(roasbeef): just stop storing the hrp in the addr?
+	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+
+	addrs := make([]*taprpc.Addr, len(dbAddrs))
+	receiveStatus := make([]*taprpc.AddrReceiveStatus, len(dbAddrs))
+	for i, dbAddr := range dbAddrs {
+		dbAddr.ChainParams = &tapParams
+
+		addrs[i], err = marshalAddr(dbAddr.Tap, r.cfg.TapAddrBook)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal addr: %w",
+				err)
+		}
+
+		// To report whether the address was paid, we'll fetch the
+		// events of the address. We only query the events of the
+		// addresses we return, so the cost of the query doesn't grow
+		// with the total number of events.
+		events, err := r.cfg.AddrBook.QueryEvents(
+			ctx, address.EventQueryParams{
+				AddrTaprootOutputKey: schnorr.SerializePubKey(
+					&dbAddr.TaprootOutputKey,
+				),
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to query addr events: "+
+				"%w", err)
+		}
+		summaries := address.SummarizeEvents(events)
+
+		receiveStatus[i] = &taprpc.AddrReceiveStatus{
+			Encoded: addrs[i].Encoded,
+		}
+		outputKey := asset.ToSerialized(&dbAddr.TaprootOutputKey)
+		if summary, ok := summaries[outputKey]; ok {
+			receiveStatus[i].Paid = summary.Paid
+			receiveStatus[i].NumReceives = uint32(
+				summary.NumReceives,
+			)
+		}
+	}
+
+	rpcsLog.Debugf("[QueryAddrs]: returning %v addrs", len(addrs))
+
+	return &taprpc.QueryAddrResponse{
+		Addrs:         addrs,
+		ReceiveStatus: receiveStatus,
+	}, nil
+}
+
+// ListAddrs lists the Taproot Asset addresses stored in the database together
+// with their paid and revocation status.
+func (r *rpcServer) ListAddrs(ctx context.Context,
+	req *taprpc.ListAddrsRequest) (*taprpc.ListAddrsResponse, error) {
+
+	dbAddrs, err := r.cfg.AddrBook.ListAddrs(ctx, address.QueryParams{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to query addrs: %w", err)
+	}
+
+	events, err := r.cfg.AddrBook.QueryEvents(
+		ctx, address.EventQueryParams{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query addr events: %w", err)
+	}
+	summaries := address.SummarizeEvents(events)
+
+	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+
+	addrs := make([]*taprpc.AddrWithStatus, 0, len(dbAddrs))
+	for _, dbAddr := range dbAddrs {
+		if dbAddr.IsRevoked() && !req.IncludeRevoked {
+			continue
+		}
+
+		var summary address.ReceiveSummary
+		outputKey := asset.ToSerialized(&dbAddr.TaprootOutputKey)
+		if addrSummary, ok := summaries[outputKey]; ok {
+			summary = *addrSummary
+		}
+		if summary.Paid && req.UnpaidOnly {
+			continue
+		}
+
+		dbAddr.ChainParams = &tapParams
+		rpcAddr, err := marshalAddr(dbAddr.Tap, r.cfg.TapAddrBook)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal addr: %w",
+				err)
+		}
+
+		addrWithStatus := &taprpc.AddrWithStatus{
+			Addr:        rpcAddr,
+			Paid:        summary.Paid,
+			NumReceives: uint32(summary.NumReceives),
+			Revoked:     dbAddr.IsRevoked(),
+		}
+		if dbAddr.IsRevoked() {
+			addrWithStatus.RevokedAt = dbAddr.RevokedAt.Unix()
+		}
+
+		addrs = append(addrs, addrWithStatus)
+	}
+
+	rpcsLog.Debugf("[ListAddrs]: returning %v addrs", len(addrs))
+
+	return &taprpc.ListAddrsResponse{
+		Addrs: addrs,
+	}, nil
+}
+
+// NewAddr makes a new address from the set of request params.
+func (r *rpcServer) NewAddr(ctx context.Context,
+	req *taprpc.NewAddrRequest) (*taprpc.Addr, error) {
+
+	var err error
+
+	if len(req.AssetId) != 32 {
+		return nil, fmt.Errorf("invalid asset id length")
+	}
+
+	var assetID asset.ID
+	copy(assetID[:], req.AssetId)
+
+	// Parse the proof courier address if one was provided. Otherwise, use
+	// the one that was set when the asset was minted, falling back to the
+	// default specified in the config.
+	courierAddrStr := req.ProofCourierAddr
+	if courierAddrStr == "" {
+		assetStore := r.cfg.AssetStore
+		courierAddrStr, err = assetStore.FetchAssetProofCourierAddr(
+			ctx, assetID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch proof courier "+
+				"address of asset: %w", err)
+		}
+	}
+
+	courierAddr := r.cfg.DefaultProofCourierAddr
+	if courierAddrStr != "" {
+		addr, err := proof.ParseCourierAddrString(courierAddrStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof courier "+
+				"address: %w", err)
+		}
+
+		// At this point, we do not intend on creating a proof courier
+		// service instance. We are only interested in parsing and
+		// validating the address. We therefore convert the address into
+		// an url.URL type for storage in the address book.
+		courierAddr = addr.Url()
+	}
+
+	// Check that the proof courier address is set. This should never
+	// happen, but we check anyway to avoid panics (possibly caused by
+	// future erroneous config changes).
+	if courierAddr == nil {
+		return nil, fmt.Errorf("no proof courier address provided")
+	}
+	proofCourierAddr := *courierAddr
+
+	rpcsLog.Infof("[NewAddr]: making new addr: asset_id=%x, amt=%v",
+		assetID[:], req.Amt)
+
+	err = r.checkBalanceOverflow(ctx, &assetID, nil, req.Amt)
+	if err != nil {
+		return nil, err
+	}
+
+	// Was there a tapscript sibling preimage specified?
+	tapscriptSibling, _, err := commitment.MaybeDecodeTapscriptPreimage(
+		req.TapscriptSibling,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tapscript sibling: %w", err)
+	}
+
+	assetVersion, err := taprpc.UnmarshalAssetVersion(req.AssetVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	var addr *address.AddrWithKeyInfo
+	switch {
+	// No key was specified, we'll let the address book derive them.
+	case req.ScriptKey == nil && req.InternalKey == nil:
+		// Now that we have all the params, we'll try to add a new
+		// address to the addr book.
+		addr, err = r.cfg.AddrBook.NewAddress(
+			ctx, assetID, req.Amt, tapscriptSibling,
+			proofCourierAddr,
+			address.WithAssetVersion(assetVersion),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to make new addr: %w",
+				err)
+		}
+
+	// Only the script key was specified.
+	case req.ScriptKey != nil && req.InternalKey == nil:
+		return nil, fmt.Errorf("internal key must also be specified " +
+			"if script key is specified")
+
+	// Only the internal key was specified.
+	case req.ScriptKey == nil && req.InternalKey != nil:
+		return nil, fmt.Errorf("script key must also be specified " +
+			"if internal key is specified")
+
+	// Both the script and internal keys were specified.
+	default:
+		scriptKey, err := UnmarshalScriptKey(req.ScriptKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode script key: "+
+				"%w", err)
+		}
+
+		rpcsLog.Debugf("Decoded script key %x (internal %x, tweak %x)",
+			scriptKey.PubKey.SerializeCompressed(),
+			scriptKey.RawKey.PubKey.SerializeCompressed(),
+			scriptKey.Tweak[:])
+
+		internalKey, err := UnmarshalKeyDescriptor(req.InternalKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode internal "+
+				"key: %w", err)
+		}
+
+		// Now that we have all the params, we'll try to add a new
+		// address to the addr book.
+		addr, err = r.cfg.AddrBook.NewAddressWithKeys(
+			ctx, assetID, req.Amt, *scriptKey, internalKey,
+			tapscriptSibling, proofCourierAddr,
+			address.WithAssetVersion(assetVersion),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to make new addr: %w",
+				err)
+		}
+	}
+
+	// With our addr obtained, we'll marshal it as an RPC message then send
+	// off the response.
+	rpcAddr, err := marshalAddr(addr.Tap, r.cfg.TapAddrBook)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal addr: %w", err)
+	}
+
+	return rpcAddr, nil
+}
+
+// RevokeAddr revokes a Taproot Asset address that hasn't been paid yet, so
+// payments to it are no longer claimed and proofs for its script key are
+// rejected.
+func (r *rpcServer) RevokeAddr(ctx context.Context,
+	req *taprpc.RevokeAddrRequest) (*taprpc.RevokeAddrResponse, error) {
+
+	if len(req.Addr) == 0 {
+		return nil, status.Error(
+			codes.InvalidArgument, "must specify an addr",
+		)
+	}
+
+	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+	addr, err := address.DecodeAddress(req.Addr, &tapParams)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to "+
+			"decode addr: %v", err)
+	}
+
+	// We need the genesis of the asset to derive the taproot output key
+	// the address is identified by.
+	assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(
+		ctx, addr.AssetID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unknown asset=%x: %w", addr.AssetID[:],
+			err)
+	}
+	addr.AttachGenesis(*assetGroup.Genesis)
+
+	taprootOutputKey, err := addr.TaprootOutputKey()
+	if err != nil {
+		return nil, fmt.Errorf("error deriving Taproot key: %w", err)
+	}
+
+	revokedAddr, err := r.cfg.AddrBook.RevokeAddr(ctx, taprootOutputKey)
+	switch {
+	case errors.Is(err, address.ErrNoAddr):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, address.ErrAddrAlreadyPaid):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to revoke addr: %w", err)
+	}
+
+	rpcsLog.Infof("[RevokeAddr]: revoked addr %v", req.Addr)
+
+	return &taprpc.RevokeAddrResponse{
+		RevokedAt: revokedAddr.RevokedAt.Unix(),
+	}, nil
+}
+
+// DecodeAddr decode a Taproot Asset address into a partial asset message that
+// represents the asset it wants to receive.
+func (r *rpcServer) DecodeAddr(_ context.Context,
+	req *taprpc.DecodeAddrRequest) (*taprpc.Addr, error) {
+
+	if len(req.Addr) == 0 {
+		return nil, status.Error(
+			codes.InvalidArgument, "must specify an addr",
+		)
+	}
+
+	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+
+	addr, err := address.DecodeAddress(req.Addr, &tapParams)
+	switch {
+	// A valid address for another network is a common mistake, so we'll
+	// tell the caller which network we expected.
+	case errors.Is(err, address.ErrMismatchedHRP):
+		return nil, status.Errorf(codes.InvalidArgument, "unable to "+
+			"decode addr: %v, expected address with prefix %v "+
+			"for network %v", err, tapParams.TapHRP,
+			r.cfg.ChainParams.Name)
+
+	case err != nil:
+		return nil, status.Errorf(codes.InvalidArgument, "unable to "+
+			"decode addr: %v", err)
+	}
+
+	rpcAddr, err := marshalAddr(addr, r.cfg.TapAddrBook)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal addr: %w", err)
+	}
+
+	return rpcAddr, nil
+}
+
+// VerifyProof attempts to verify a given proof file that claims to be anchored
+// at the specified genesis point.
+func (r *rpcServer) VerifyProof(ctx context.Context,
+	req *taprpc.ProofFile) (*taprpc.VerifyProofResponse, error) {
+
+	if err := proof.CheckMaxFileSize(req.RawProofFile); err != nil {
+		return nil, fmt.Errorf("invalid proof file: %w", err)
+	}
+
+	// A single mint or transition proof is treated as a proof file that
+	// only contains that proof.
+	var proofFile *proof.File
+	switch {
+	case proof.IsProofFile(req.RawProofFile):
+		proofFile = &proof.File{}
+		err := proofFile.Decode(bytes.NewReader(req.RawProofFile))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode proof file: "+
+				"%w", err)
+		}
+
+	case proof.IsSingleProof(req.RawProofFile):
+		var p proof.Proof
+		err := p.Decode(bytes.NewReader(req.RawProofFile))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode proof: %w",
+				err)
+		}
+
+		proofFile, err = proof.NewFile(proof.V0, p)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("invalid raw proof, expect file or " +
+			"single encoded mint or transition proof")
+	}
+
+	stepResults, err := r.verifyProofSteps(
+		ctx, proofFile, req.UniverseHost,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The proof file is only valid if every single proof is valid and, if
+	// a universe host was specified, included in the universe.
+	valid := fn.All(stepResults, func(s *taprpc.ProofStepResult) bool {
+		return s.Valid &&
+			(req.UniverseHost == "" || s.UniverseInclusionValid)
+	})
+
+	p, err := proofFile.LastProof()
+	if err != nil {
+		return nil, err
+	}
+	decodedProof, err := r.marshalProof(ctx, p, false, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal proof: %w", err)
+	}
+
+	decodedProof.ProofAtDepth = 0
+	decodedProof.NumberOfProofs = uint32(proofFile.NumProofs())
+
+	return &taprpc.VerifyProofResponse{
+		Valid:        valid,
+		DecodedProof: decodedProof,
+		StepResults:  stepResults,
+	}, nil
+}
+
+// verifyProofSteps verifies each proof of the given proof file in order,
+// starting with the genesis proof. Once a proof is found to be invalid, none of
+// the following proofs can be verified anymore. If a universe host is given,
+// then each proof is also checked to be included in the corresponding universe
+// of that server.
+func (r *rpcServer) verifyProofSteps(ctx context.Context, proofFile *proof.File,
+	universeHost string) ([]*taprpc.ProofStepResult, error) {
+
+	var remoteUniverse universe.DiffEngine
+	if universeHost != "" {
+		var err error
+		uniAddr, err := r.remoteUniverseAddr(ctx, universeHost)
+		if err != nil {
+			return nil, err
+		}
+
+		remoteUniverse, err = NewRpcUniverseDiff(uniAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
+
+	var (
+		prev        *proof.AssetSnapshot
+		prevInvalid bool
+		results     = make(
+			[]*taprpc.ProofStepResult, proofFile.NumProofs(),
+		)
+	)
+	for idx := range results {
+		p, err := proofFile.ProofAt(uint32(idx))
+		if err != nil {
+			return nil, err
+		}
+
+		result := &taprpc.ProofStepResult{
+			ProofIndex:     uint32(idx),
+			AnchorOutpoint: p.OutPoint().String(),
+		}
+		results[idx] = result
+
+		// If any of the previous proofs is invalid, then we can't
+		// verify this proof either.
+		if prevInvalid {
+			result.Error = "previous proof invalid"
+		} else {
+			prev, err = p.Verify(
+				ctx, prev, headerVerifier, groupVerifier,
+			)
+			if err != nil {
+				// We don't want to fail the RPC request because
+				// of a proof verification error, but we do want
+				// to log it for easier debugging.
+				rpcsLog.Errorf("Proof verification failed at "+
+					"index %d with err: %v", idx, err)
+
+				prevInvalid = true
+				result.Error = err.Error()
+			} else {
+				result.Valid = true
+			}
+		}
+
+		if remoteUniverse == nil {
+			continue
+		}
+
+		err = verifyUniverseInclusion(ctx, remoteUniverse, p)
+		if err != nil {
+			rpcsLog.Debugf("Universe inclusion check failed at "+
+				"index %d with err: %v", idx, err)
+
+			if result.Error == "" {
+				result.Error = err.Error()
+			}
+			continue
+		}
+
+		result.UniverseInclusionValid = true
+	}
+
+	return results, nil
+}
+
+// verifyUniverseInclusion checks that the given proof is included in the
+// corresponding universe of the given remote universe server.
+func verifyUniverseInclusion(ctx context.Context, remote universe.DiffEngine,
+	p *proof.Proof) error {
+
+	uniID, leafKey, leaf := universeLeafFromProof(p)
+
+	uniProofs, err := remote.FetchIssuanceProof(ctx, uniID, leafKey)
+	if err != nil {
+		return fmt.Errorf("unable to fetch universe proof: %w", err)
+	}
+
+	// We'll reconstruct the universe root from our own version of the
+	// leaf, to make sure it's exactly this proof that is included in the
+	// universe.
+	leafNode, err := leaf.SmtLeafNode()
+	if err != nil {
+		return err
+	}
+
+	uniProof := uniProofs[0]
+	root := uniProof.UniverseInclusionProof.Root(
+		leafKey.UniverseKey(), leafNode,
+	)
+	if !mssmt.IsEqualNode(root, uniProof.UniverseRoot) {
+		return fmt.Errorf("proof not included in universe")
+	}
+
+	return nil
+}
+
+// universeLeafFromProof returns the universe ID, leaf key and leaf under which
+// the given proof is stored in a universe.
+func universeLeafFromProof(p *proof.Proof) (universe.Identifier,
+	universe.LeafKey, *universe.Leaf) {
+
+	uniID := universe.Identifier{
+		AssetID:   p.Asset.ID(),
+		ProofType: universe.ProofTypeTransfer,
+	}
+	if p.Asset.IsGenesisAsset() {
+		uniID.ProofType = universe.ProofTypeIssuance
+	}
+	if p.Asset.GroupKey != nil {
+		uniID.GroupKey = &p.Asset.GroupKey.GroupPubKey
+	}
+
+	leafKey := universe.LeafKey{
+		OutPoint:  p.OutPoint(),
+		ScriptKey: &p.Asset.ScriptKey,
+	}
+
+	leaf := &universe.Leaf{
+		GenesisWithGroup: universe.GenesisWithGroup{
+			Genesis:  p.Asset.Genesis,
+			GroupKey: p.Asset.GroupKey,
+		},
+		Proof: p,
+		Amt:   p.Asset.Amount,
+	}
+
+	return uniID, leafKey, leaf
+}
+
+// DecodeProof attempts to decode a given proof file that claims to be anchored
+// at the specified genesis point.
+func (r *rpcServer) DecodeProof(ctx context.Context,
+	req *taprpc.DecodeProofRequest) (*taprpc.DecodeProofResponse, error) {
+
+	var (
+		proofReader = bytes.NewReader(req.RawProof)
+		rpcProof    *taprpc.DecodedProof
+	)
+	switch {
+	case proof.IsSingleProof(req.RawProof):
+		var p proof.Proof
+		err := p.Decode(proofReader)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode proof: %w",
+				err)
+		}
+
+		rpcProof, err = r.marshalProof(
+			ctx, &p, req.WithPrevWitnesses, req.WithMetaReveal,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal proof: %w",
+				err)
+		}
+
+		rpcProof.NumberOfProofs = 1
+
+	case proof.IsProofFile(req.RawProof):
 		if err := proof.CheckMaxFileSize(req.RawProof); err != nil {
 			return nil, fmt.Errorf("invalid proof file: %w", err)
 		}
 
-		var proofFile proof.File
-		if err := proofFile.Decode(proofReader); err != nil {
-			return nil, fmt.Errorf("unable to decode proof file: "+
-				"%w", err)
+		var proofFile proof.File
+		if err := proofFile.Decode(proofReader); err != nil {
+			return nil, fmt.Errorf("unable to decode proof file: "+
+				"%w", err)
+		}
+
+		latestProofIndex := uint32(proofFile.NumProofs() - 1)
+		if req.ProofAtDepth > latestProofIndex {
+			return nil, fmt.Errorf("invalid depth %d is greater "+
+				"than latest proof index of %d",
+				req.ProofAtDepth, latestProofIndex)
+		}
+
+		// Default to latest proof.
+		index := latestProofIndex - req.ProofAtDepth
+		p, err := proofFile.ProofAt(index)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcProof, err = r.marshalProof(
+			ctx, p, req.WithPrevWitnesses,
+			req.WithMetaReveal,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal proof: %w",
+				err)
+		}
+
+		rpcProof.ProofAtDepth = req.ProofAtDepth
+		rpcProof.NumberOfProofs = uint32(proofFile.NumProofs())
+
+	default:
+		return nil, fmt.Errorf("invalid raw proof, could not " +
+			"identify decoding format")
+	}
+
+	return &taprpc.DecodeProofResponse{
+		DecodedProof: rpcProof,
+	}, nil
+}
+
+// DecodeCommitment reconstructs the Taproot Asset commitment of an anchor
+// output from the given asset proofs and checks whether it matches the taproot
+// output key found on-chain.
+func (r *rpcServer) DecodeCommitment(ctx context.Context,
+	req *taprpc.DecodeCommitmentRequest) (*taprpc.DecodeCommitmentResponse,
+	error) {
+
+	var anchorTx wire.MsgTx
+	err := anchorTx.Deserialize(bytes.NewReader(req.AnchorTx))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode anchor TX: %w", err)
+	}
+
+	proofs := make([]*proof.Proof, 0, len(req.RawProofs))
+	for idx, rawProof := range req.RawProofs {
+		p, err := decodeLatestProof(rawProof)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode proof %d: %w",
+				idx, err)
+		}
+
+		proofs = append(proofs, p)
+	}
+
+	anchorCommitment, err := proof.ReconstructAnchorCommitment(
+		&anchorTx, req.OutputIndex, proofs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to reconstruct commitment: %w",
+			err)
+	}
+
+	committedAssets := anchorCommitment.Commitment.CommittedAssets()
+	rpcAssets := make([]*taprpc.Asset, 0, len(committedAssets))
+	for _, a := range committedAssets {
+		rpcAsset, err := taprpc.MarshalAsset(ctx, a, false, true, nil)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal asset: %w",
+				err)
+		}
+
+		rpcAssets = append(rpcAssets, rpcAsset)
+	}
+
+	return &taprpc.DecodeCommitmentResponse{
+		Assets:  rpcAssets,
+		Matches: anchorCommitment.Matches(),
+		InternalKey: anchorCommitment.InternalKey.
+			SerializeCompressed(),
+		TaprootOutputKey: schnorr.SerializePubKey(
+			anchorCommitment.OutputKey,
+		),
+		DerivedOutputKey: schnorr.SerializePubKey(
+			anchorCommitment.DerivedKey,
+		),
+	}, nil
+}
+
+// decodeLatestProof decodes either a single proof or a proof file and returns
+// the latest proof contained in it.
+func decodeLatestProof(rawProof []byte) (*proof.Proof, error) {
+	switch {
+	case proof.IsSingleProof(rawProof):
+		var p proof.Proof
+		if err := p.Decode(bytes.NewReader(rawProof)); err != nil {
+			return nil, err
+		}
+
+		return &p, nil
+
+	case proof.IsProofFile(rawProof):
+		if err := proof.CheckMaxFileSize(rawProof); err != nil {
+			return nil, fmt.Errorf("invalid proof file: %w", err)
+		}
+
+		var proofFile proof.File
+		err := proofFile.Decode(bytes.NewReader(rawProof))
+		if err != nil {
+			return nil, err
+		}
+
+		return proofFile.LastProof()
+
+	default:
+		return nil, fmt.Errorf("invalid raw proof, could not " +
+			"identify decoding format")
+	}
+}
+
+// marshalProof turns a transition proof into an RPC DecodedProof.
+func (r *rpcServer) marshalProof(ctx context.Context, p *proof.Proof,
+	withPrevWitnesses, withMetaReveal bool) (*taprpc.DecodedProof, error) {
+
+	var (
+		rpcMeta        *taprpc.AssetMeta
+		rpcGenesis     = p.GenesisReveal
+		rpcGroupKey    = p.GroupKeyReveal
+		anchorOutpoint = wire.OutPoint{
+			Hash:  p.AnchorTx.TxHash(),
+			Index: p.InclusionProof.OutputIndex,
+		}
+		txMerkleProof  = p.TxMerkleProof
+		inclusionProof = p.InclusionProof
+		splitRootProof = p.SplitRootProof
+	)
+
+	var txMerkleProofBuf bytes.Buffer
+	if err := txMerkleProof.Encode(&txMerkleProofBuf); err != nil {
+		return nil, fmt.Errorf("unable to encode serialized Bitcoin "+
+			"merkle proof: %w", err)
+	}
+
+	var inclusionProofBuf bytes.Buffer
+	if err := inclusionProof.Encode(&inclusionProofBuf); err != nil {
+		return nil, fmt.Errorf("unable to encode inclusion proof: %w",
+			err)
+	}
+
+	if inclusionProof.CommitmentProof == nil {
+		return nil, fmt.Errorf("inclusion proof is missing " +
+			"commitment proof")
+	}
+	tsSibling, tsHash, err := commitment.MaybeEncodeTapscriptPreimage(
+		inclusionProof.CommitmentProof.TapSiblingPreimage,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding tapscript sibling: %w",
+			err)
+	}
+
+	tapProof, err := inclusionProof.CommitmentProof.DeriveByAssetInclusion(
+		&p.Asset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving inclusion proof: %w",
+			err)
+	}
+	merkleRoot := tapProof.TapscriptRoot(tsHash)
+
+	var exclusionProofs [][]byte
+	for _, exclusionProof := range p.ExclusionProofs {
+		var exclusionProofBuf bytes.Buffer
+		err := exclusionProof.Encode(&exclusionProofBuf)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode exclusion "+
+				"proofs: %w", err)
+		}
+		exclusionProofs = append(
+			exclusionProofs, exclusionProofBuf.Bytes(),
+		)
+	}
+
+	var splitRootProofBuf bytes.Buffer
+	if splitRootProof != nil {
+		err := splitRootProof.Encode(&splitRootProofBuf)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode split root "+
+				"proof: %w", err)
+		}
+	}
+
+	rpcAsset, err := r.marshalChainAsset(ctx, &tapdb.ChainAsset{
+		Asset:                  &p.Asset,
+		AnchorTx:               &p.AnchorTx,
+		AnchorTxid:             p.AnchorTx.TxHash(),
+		AnchorBlockHash:        p.BlockHeader.BlockHash(),
+		AnchorBlockHeight:      p.BlockHeight,
+		AnchorOutpoint:         anchorOutpoint,
+		AnchorInternalKey:      p.InclusionProof.InternalKey,
+		AnchorMerkleRoot:       merkleRoot[:],
+		AnchorTapscriptSibling: tsSibling,
+	}, withPrevWitnesses)
+	if err != nil {
+		return nil, err
+	}
+
+	if withMetaReveal {
+		metaHash := rpcAsset.AssetGenesis.MetaHash
+		if len(metaHash) == 0 {
+			return nil, fmt.Errorf("asset does not contain meta " +
+				"data")
+		}
+
+		rpcMeta, err = r.FetchAssetMeta(
+			ctx, &taprpc.FetchAssetMetaRequest{
+				Asset: &taprpc.FetchAssetMetaRequest_MetaHash{
+					MetaHash: metaHash,
+				},
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	decodedAssetID := p.Asset.ID()
+	var genesisReveal *taprpc.GenesisReveal
+	if rpcGenesis != nil {
+		genesisReveal = &taprpc.GenesisReveal{
+			GenesisBaseReveal: &taprpc.GenesisInfo{
+				GenesisPoint: rpcGenesis.FirstPrevOut.String(),
+				Name:         rpcGenesis.Tag,
+				MetaHash:     rpcGenesis.MetaHash[:],
+				AssetId:      decodedAssetID[:],
+				OutputIndex:  rpcGenesis.OutputIndex,
+				AssetType: taprpc.AssetType(
+					rpcGenesis.Type,
+				),
+			},
+			AssetType: taprpc.AssetType(p.Asset.Type),
+		}
+	}
+
+	var GroupKeyReveal taprpc.GroupKeyReveal
+	if rpcGroupKey != nil {
+		GroupKeyReveal = taprpc.GroupKeyReveal{
+			RawGroupKey:   rpcGroupKey.RawKey[:],
+			TapscriptRoot: rpcGroupKey.TapscriptRoot,
+		}
+	}
+
+	return &taprpc.DecodedProof{
+		Asset:               rpcAsset,
+		MetaReveal:          rpcMeta,
+		TxMerkleProof:       txMerkleProofBuf.Bytes(),
+		InclusionProof:      inclusionProofBuf.Bytes(),
+		ExclusionProofs:     exclusionProofs,
+		SplitRootProof:      splitRootProofBuf.Bytes(),
+		NumAdditionalInputs: uint32(len(p.AdditionalInputs)),
+		ChallengeWitness:    p.ChallengeWitness,
+		IsBurn:              p.Asset.IsBurn(),
+		GenesisReveal:       genesisReveal,
+		GroupKeyReveal:      &GroupKeyReveal,
+	}, nil
+}
+
+// ExportProof exports the latest raw proof file anchored at the specified
+// script_key.
+func (r *rpcServer) ExportProof(ctx context.Context,
+	req *taprpc.ExportProofRequest) (*taprpc.ProofFile, error) {
+
+	if len(req.ScriptKey) == 0 {
+		return nil, fmt.Errorf("a valid script key must be specified")
+	}
+
+	scriptKey, err := parseUserKey(req.ScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid script key: %w", err)
+	}
+
+	if len(req.AssetId) != 32 {
+		return nil, fmt.Errorf("asset ID must be 32 bytes")
+	}
+
+	var assetID asset.ID
+	copy(assetID[:], req.AssetId)
+
+	// If the caller only wants the proof of an asset we currently hold,
+	// we make sure it's among our unspent assets before exporting it.
+	if req.OwnedOnly {
+		ownedAsset, err := r.fetchOwnedAsset(ctx, assetID, scriptKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if ownedAsset == nil {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"asset %v with script key %x is not owned by "+
+					"this node", assetID,
+				schnorr.SerializePubKey(scriptKey))
+		}
+	}
+
+	proofBlob, err := r.cfg.ProofArchive.FetchProof(ctx, proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *scriptKey,
+	})
+	switch {
+	case errors.Is(err, proof.ErrProofNotFound):
+		return nil, status.Errorf(codes.NotFound, "no proof found for "+
+			"asset %v with script key %x", assetID,
+			schnorr.SerializePubKey(scriptKey))
+
+	case err != nil:
+		return nil, err
+	}
+
+	return &taprpc.ProofFile{
+		RawProofFile: proofBlob,
+	}, nil
+}
+
+// ExportProofs streams a full proof file for each leaf of the local issuance
+// and transfer universes of the given asset or asset group. Leaves whose proof
+// file can't be built are reported with the reason instead of a proof file.
+func (r *rpcServer) ExportProofs(req *taprpc.ExportProofsRequest,
+	stream taprpc.TaprootAssets_ExportProofsServer) error {
+
+	ctx := stream.Context()
+
+	var uniID universe.Identifier
+	switch {
+	case len(req.AssetId) != 0 && len(req.GroupKey) != 0:
+		return status.Error(codes.InvalidArgument, "only one of asset "+
+			"ID and group key can be set")
+
+	case len(req.AssetId) != 0:
+		if len(req.AssetId) != sha256.Size {
+			return status.Errorf(codes.InvalidArgument, "asset ID "+
+				"must be %d bytes", sha256.Size)
+		}
+		copy(uniID.AssetID[:], req.AssetId)
+
+	case len(req.GroupKey) != 0:
+		groupKey, err := parseUserKey(req.GroupKey)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid "+
+				"group key: %v", err)
+		}
+		uniID.GroupKey = groupKey
+
+	default:
+		return status.Error(codes.InvalidArgument, "either asset ID "+
+			"or group key must be set")
+	}
+
+	proofTypes := []universe.ProofType{
+		universe.ProofTypeIssuance, universe.ProofTypeTransfer,
+	}
+	for _, proofType := range proofTypes {
+		uniID.ProofType = proofType
+
+		leafKeys, err := r.cfg.BaseUniverse.UniverseLeafKeys(ctx, uniID)
+		if err != nil {
+			return fmt.Errorf("unable to fetch leaf keys of "+
+				"universe %v: %w", uniID.String(), err)
+		}
+
+		for _, leafKey := range leafKeys {
+			exported := &taprpc.ExportedProof{
+				Outpoint: leafKey.OutPoint.String(),
+				ScriptKey: leafKey.ScriptKey.PubKey.
+					SerializeCompressed(),
+			}
+
+			rawFile, err := r.exportLeafProofFile(
+				ctx, uniID, leafKey,
+			)
+			if err != nil {
+				rpcsLog.Warnf("Skipping export of proof of "+
+					"leaf %v in universe %v: %v",
+					leafKey.OutPoint, uniID.String(), err)
+
+				exported.SkipReason = err.Error()
+			} else {
+				exported.RawProofFile = rawFile
+			}
+
+			if err := stream.Send(exported); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// exportLeafProofFile builds the full proof file of the given leaf of the
+// local universe, by following its chain of transition proofs back through
+// the local issuance and transfer universes.
+func (r *rpcServer) exportLeafProofFile(ctx context.Context,
+	uniID universe.Identifier, leafKey universe.LeafKey) ([]byte, error) {
+
+	leafProofs, err := r.cfg.BaseUniverse.FetchIssuanceProof(
+		ctx, uniID, leafKey,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch leaf proof: %w", err)
+	}
+
+	// The asset ID is needed to locate the previous transition proofs of
+	// a grouped asset.
+	assetID := leafProofs[0].Leaf.Proof.Asset.ID()
+	outPoint := leafKey.OutPoint
+	loc := proof.Locator{
+		AssetID:   &assetID,
+		GroupKey:  uniID.GroupKey,
+		ScriptKey: *leafKey.ScriptKey.PubKey,
+		OutPoint:  &outPoint,
+	}
+
+	// A previous transition proof is either a transfer leaf or, for the
+	// genesis proof, an issuance leaf.
+	fetchProof := func(ctx context.Context,
+		loc proof.Locator) (*proof.Proof, error) {
+
+		key := universe.LeafKey{
+			OutPoint: *loc.OutPoint,
+			ScriptKey: &asset.ScriptKey{
+				PubKey: &loc.ScriptKey,
+			},
+		}
+		proofTypes := []universe.ProofType{
+			universe.ProofTypeTransfer, universe.ProofTypeIssuance,
+		}
+		for _, proofType := range proofTypes {
+			id := universe.Identifier{
+				AssetID:   *loc.AssetID,
+				GroupKey:  loc.GroupKey,
+				ProofType: proofType,
+			}
+
+			proofs, err := r.cfg.BaseUniverse.FetchIssuanceProof(
+				ctx, id, key,
+			)
+			switch {
+			case errors.Is(err, universe.ErrNoUniverseProofFound):
+				continue
+
+			case err != nil:
+				return nil, err
+			}
+
+			return proofs[0].Leaf.Proof, nil
+		}
+
+		return nil, fmt.Errorf("no proof found for asset %v at %v",
+			loc.AssetID, loc.OutPoint)
+	}
+
+	proofFile, err := proof.FetchProofProvenance(ctx, loc, fetchProof)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := proofFile.Encode(&buf); err != nil {
+		return nil, fmt.Errorf("unable to encode proof file: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// fetchOwnedAsset returns the unspent asset with the given asset ID and script
+// key held by this node, or nil if there is no such asset.
+func (r *rpcServer) fetchOwnedAsset(ctx context.Context, assetID asset.ID,
+	scriptKey *btcec.PublicKey) (*tapdb.ChainAsset, error) {
+
+	constraints := tapfreighter.CommitmentConstraints{
+		AssetID: &assetID,
+	}
+	assets, err := r.cfg.AssetStore.FetchAllAssets(
+		ctx, false, true, &tapdb.AssetQueryFilters{
+			CommitmentConstraints: constraints,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read chain assets: %w", err)
+	}
+
+	// The script key might have been given as an x-only key, so we only
+	// compare the x coordinate.
+	targetKey := schnorr.SerializePubKey(scriptKey)
+	for _, a := range assets {
+		if a.ScriptKey.PubKey == nil {
+			continue
+		}
+
+		ownedKey := schnorr.SerializePubKey(a.ScriptKey.PubKey)
+		if bytes.Equal(ownedKey, targetKey) {
+			return a, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ImportProof imports a proof file of an asset that was received out-of-band
+// and claims the asset. The full provenance of the asset is verified and,
+// unless the asset is only tracked, the final script key must belong to this
+// node. Each proof of the file is also inserted into the local universe.
+func (r *rpcServer) ImportProof(ctx context.Context,
+	req *taprpc.ImportProofRequest) (*taprpc.ImportProofResponse, error) {
+
+	if len(req.RawProofFile) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proof file "+
+			"must be specified")
+	}
+
+	if err := proof.CheckMaxFileSize(req.RawProofFile); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid "+
+			"proof file: %v", err)
+	}
+
+	proofFile := &proof.File{}
+	err := proofFile.Decode(bytes.NewReader(req.RawProofFile))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to "+
+			"decode proof file: %v", err)
+	}
+
+	lastProof, err := proofFile.LastProof()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to "+
+			"fetch last proof: %v", err)
+	}
+	lastAsset := &lastProof.Asset
+	scriptKey := lastAsset.ScriptKey.PubKey
+
+	// Unless the caller only wants to track the asset, we'll make sure
+	// that the final script key is one of ours, otherwise we'd import an
+	// asset we can't spend.
+	if !req.TrackOnly {
+		isLocal, err := r.isLocalScriptKey(ctx, scriptKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isLocal {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"script key %x of asset %v is not owned by "+
+					"this node",
+				scriptKey.SerializeCompressed(), lastAsset.ID())
+		}
+	}
+
+	// The anchor of the final proof must be buried deep enough. As the
+	// anchors of all previous proofs are at or below it, this covers the
+	// full proof chain.
+	minConfs := r.cfg.ProofMinConfs
+	if req.MinConfs != 0 {
+		minConfs = req.MinConfs
+	}
+	if minConfs > 1 {
+		currentHeight, err := r.cfg.ChainBridge.CurrentHeight(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch current "+
+				"height: %w", err)
+		}
+
+		err = universe.CheckProofConfs(
+			lastProof, minConfs, currentHeight,
+		)
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+	}
+
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
+
+	// Importing the proof verifies the full provenance of the asset and
+	// records it in our asset store.
+	err = r.cfg.ProofArchive.ImportProofs(
+		ctx, headerVerifier, groupVerifier, false,
+		&proof.AnnotatedProof{Blob: req.RawProofFile},
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to "+
+			"import proof file: %v", err)
+	}
+
+	// With the asset recorded, we'll also add the proofs to our local
+	// universe. This isn't critical for owning the asset, so we only log
+	// any failure.
+	leavesInserted := true
+	if err := r.registerProofFileLeaves(ctx, proofFile); err != nil {
+		rpcsLog.Warnf("Unable to insert proofs of asset %v into local "+
+			"universe: %v", lastAsset.ID(), err)
+
+		leavesInserted = false
+	}
+
+	var rpcAsset *taprpc.Asset
+	chainAsset, err := r.fetchOwnedAsset(ctx, lastAsset.ID(), scriptKey)
+	switch {
+	case err != nil:
+		return nil, err
+
+	case chainAsset != nil:
+		rpcAsset, err = r.marshalChainAsset(ctx, chainAsset, false)
+
+	default:
+		rpcAsset, err = taprpc.MarshalAsset(
+			ctx, lastAsset, false, false, r.cfg.AddrBook,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &taprpc.ImportProofResponse{
+		Asset:                  rpcAsset,
+		UniverseLeavesInserted: leavesInserted,
+	}, nil
+}
+
+// ClaimFromUniverse fetches the proof of an asset sent to one of our script
+// keys from a universe server and imports it. If the universe doesn't know
+// about a transfer to the script key yet, a NotFound error is returned so the
+// caller can retry later.
+func (r *rpcServer) ClaimFromUniverse(ctx context.Context,
+	req *taprpc.ClaimFromUniverseRequest) (*taprpc.ImportProofResponse,
+	error) {
+
+	if len(req.AssetId) != sha256.Size {
+		return nil, status.Errorf(codes.InvalidArgument, "asset ID "+
+			"must be %d bytes", sha256.Size)
+	}
+	var assetID asset.ID
+	copy(assetID[:], req.AssetId)
+
+	scriptKey, err := parseUserKey(req.ScriptKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid "+
+			"script key: %v", err)
+	}
+
+	var groupKey *btcec.PublicKey
+	if len(req.GroupKey) != 0 {
+		groupKey, err = parseUserKey(req.GroupKey)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid group key: %v", err)
+		}
+	}
+
+	// There's no point in contacting the universe if we can't spend the
+	// asset once it's imported.
+	isLocal, err := r.isLocalScriptKey(ctx, scriptKey)
+	if err != nil {
+		return nil, err
+	}
+	if !isLocal {
+		return nil, status.Errorf(codes.FailedPrecondition, "script "+
+			"key %x is not owned by this node",
+			scriptKey.SerializeCompressed())
+	}
+
+	// Without an explicit host, we'll fall back to the universe that
+	// serves as our default proof courier.
+	host := req.UniverseHost
+	courierAddr := r.cfg.DefaultProofCourierAddr
+	if host == "" && courierAddr != nil &&
+		courierAddr.Scheme == proof.UniverseRpcCourierType {
+
+		host = courierAddr.Host
+	}
+	if host == "" {
+		return nil, status.Error(codes.InvalidArgument, "universe "+
+			"host must be specified")
+	}
+
+	uniAddr, err := r.remoteUniverseAddr(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	client, err := ConnectUniverse(uniAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "unable to "+
+			"connect to universe %v: %v", host, err)
+	}
+
+	uniID := universe.Identifier{
+		AssetID:   assetID,
+		ProofType: universe.ProofTypeTransfer,
+	}
+	if groupKey != nil {
+		uniID = universe.Identifier{
+			GroupKey:  groupKey,
+			ProofType: universe.ProofTypeTransfer,
+		}
+	}
+	rpcUniID, err := MarshalUniID(uniID)
+	if err != nil {
+		return nil, err
+	}
+
+	leafKeys, err := client.AssetLeafKeys(ctx, rpcUniID)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "unable to "+
+			"fetch leaf keys from universe %v: %v", host, err)
+	}
+
+	// Universe leaf keys may only commit to the x coordinate of the script
+	// key, so we compare the keys in their BIP-340 form.
+	targetKey := schnorr.SerializePubKey(scriptKey)
+
+	var leafKey *universe.LeafKey
+	for _, rpcKey := range leafKeys.AssetKeys {
+		key, err := unmarshalLeafKey(rpcKey)
+		if err != nil {
+			return nil, err
+		}
+
+		keyBytes := schnorr.SerializePubKey(key.ScriptKey.PubKey)
+		if bytes.Equal(keyBytes, targetKey) {
+			leafKey = &key
+			break
+		}
+	}
+	if leafKey == nil {
+		return nil, status.Errorf(codes.NotFound, "no transfer of "+
+			"asset %v to script key %x found in universe %v yet, "+
+			"try again later", assetID, targetKey, host)
+	}
+
+	annotatedProof, err := proof.FetchUniverseProofFile(
+		ctx, client, proof.Locator{
+			AssetID:   &assetID,
+			GroupKey:  groupKey,
+			ScriptKey: *leafKey.ScriptKey.PubKey,
+			OutPoint:  &leafKey.OutPoint,
+		},
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "unable to "+
+			"fetch proof from universe %v: %v", host, err)
+	}
+
+	// Importing the proof file verifies the full provenance of the asset,
+	// so we don't trust the universe with anything beyond availability.
+	return r.ImportProof(ctx, &taprpc.ImportProofRequest{
+		RawProofFile: annotatedProof.Blob,
+		MinConfs:     req.MinConfs,
+	})
+}
+
+// VerifyGroupMembership verifies that two assets were issued into the same
+// asset group, by verifying the genesis proof of each asset, including its
+// group witness, and comparing their group keys.
+func (r *rpcServer) VerifyGroupMembership(ctx context.Context,
+	req *taprpc.VerifyGroupMembershipRequest) (
+	*taprpc.VerifyGroupMembershipResponse, error) {
+
+	proofA, err := r.groupMembershipProof(ctx, req.AssetA)
+	if err != nil {
+		return nil, err
+	}
+	proofB, err := r.groupMembershipProof(ctx, req.AssetB)
+	if err != nil {
+		return nil, err
+	}
+
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
+
+	// Any reason the assets can't be confirmed to be part of the same
+	// group is reported as a mismatch rather than an RPC error.
+	groupKey, err := proof.VerifySharedGroup(
+		ctx, proofA, proofB, headerVerifier, groupVerifier,
+	)
+	if err != nil {
+		return &taprpc.VerifyGroupMembershipResponse{
+			MismatchReason: err.Error(),
+		}, nil
+	}
+
+	return &taprpc.VerifyGroupMembershipResponse{
+		SameGroup: true,
+		GroupKey:  groupKey.SerializeCompressed(),
+	}, nil
+}
+
+// groupMembershipProof returns the genesis proof of the given asset, either
+// decoded from the raw proof or looked up in the local issuance universe.
+func (r *rpcServer) groupMembershipProof(ctx context.Context,
+	target *taprpc.GroupMembershipAsset) (*proof.Proof, error) {
+
+	switch {
+	case len(target.GetRawProof()) != 0:
+		rawProof := target.GetRawProof()
+
+		// A proof file starts with the genesis proof of the asset.
+		if proof.IsProofFile(rawProof) {
+			var proofFile proof.File
+			err := proofFile.Decode(bytes.NewReader(rawProof))
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument,
+					"unable to decode proof file: %v", err)
+			}
+
+			genesisProof, err := proofFile.ProofAt(0)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument,
+					"unable to fetch genesis proof: %v",
+					err)
+			}
+
+			return genesisProof, nil
+		}
+
+		var genesisProof proof.Proof
+		err := genesisProof.Decode(bytes.NewReader(rawProof))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"unable to decode proof: %v", err)
+		}
+
+		return &genesisProof, nil
+
+	case len(target.GetAssetId()) != 0:
+		if len(target.GetAssetId()) != sha256.Size {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"asset ID must be %d bytes", sha256.Size)
+		}
+
+		var assetID asset.ID
+		copy(assetID[:], target.GetAssetId())
+
+		// The issuance universe of a grouped asset is identified by
+		// its group key, so we need to know the group first.
+		assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(
+			ctx, assetID,
+		)
+		switch {
+		case errors.Is(err, address.ErrAssetGroupUnknown):
+			return nil, status.Errorf(codes.NotFound, "asset %v "+
+				"is unknown", assetID)
+
+		case err != nil:
+			return nil, fmt.Errorf("unable to query asset "+
+				"group: %w", err)
+		}
+
+		uniID := universe.Identifier{
+			AssetID:   assetID,
+			ProofType: universe.ProofTypeIssuance,
+		}
+		if assetGroup.GroupKey != nil {
+			uniID = universe.Identifier{
+				GroupKey:  &assetGroup.GroupKey.GroupPubKey,
+				ProofType: universe.ProofTypeIssuance,
+			}
+		}
+
+		leaves, err := r.cfg.BaseUniverse.MintingLeaves(ctx, uniID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch issuance "+
+				"leaves: %w", err)
+		}
+		for _, leaf := range leaves {
+			if leaf.Genesis.ID() == assetID {
+				return leaf.Proof, nil
+			}
+		}
+
+		return nil, status.Errorf(codes.NotFound, "no genesis proof "+
+			"of asset %v found in local universe", assetID)
+
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either "+
+			"asset ID or raw proof must be set")
+	}
+}
+
+// isLocalScriptKey returns true if the given script key was derived by this
+// node's wallet.
+func (r *rpcServer) isLocalScriptKey(ctx context.Context,
+	scriptKey *btcec.PublicKey) (bool, error) {
+
+	tweakedKey, err := r.cfg.TapAddrBook.FetchScriptKey(ctx, scriptKey)
+	switch {
+	case errors.Is(err, address.ErrScriptKeyNotFound):
+		return false, nil
+
+	case err != nil:
+		return false, fmt.Errorf("unable to fetch script key: %w", err)
+	}
+
+	return r.cfg.AddrBook.IsLocalKey(ctx, tweakedKey.RawKey), nil
+}
+
+// registerProofFileLeaves inserts each proof of the given file into the local
+// universe, starting with the genesis proof. The proofs are inserted in order,
+// as each transfer proof is verified against the previous one.
+func (r *rpcServer) registerProofFileLeaves(ctx context.Context,
+	proofFile *proof.File) error {
+
+	registrar := r.cfg.BaseUniverse.RegistrarWithSource(
+		universe.LeafSourceLocal,
+	)
+	for idx := uint32(0); idx < uint32(proofFile.NumProofs()); idx++ {
+		p, err := proofFile.ProofAt(idx)
+		if err != nil {
+			return err
+		}
+
+		uniID, leafKey, leaf := universeLeafFromProof(p)
+		_, err = registrar.RegisterIssuance(ctx, uniID, leafKey, leaf)
+		if err != nil {
+			return fmt.Errorf("unable to register proof at index "+
+				"%d: %w", idx, err)
+		}
+	}
+
+	return nil
+}
+
+// devRPCServer implements the developer RPC service. It's kept separate from
+// the main rpcServer, as some of its RPCs share their name with RPCs of the
+// main service.
+type devRPCServer struct {
+	tapdevrpc.UnimplementedTapDevServer
+
+	rpc *rpcServer
+}
+
+// ImportProof attempts to import a proof file into the daemon. If successful, a
+// new asset will be inserted on disk, spendable using the specified target
+// script key, and internal key.
+func (d *devRPCServer) ImportProof(ctx context.Context,
+	req *tapdevrpc.ImportProofRequest) (*tapdevrpc.ImportProofResponse,
+	error) {
+
+	r := d.rpc
+
+	// We'll perform some basic input validation before we move forward.
+	if len(req.ProofFile) == 0 {
+		return nil, fmt.Errorf("proof file must be specified")
+	}
+
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
+
+	// Now that we know the proof file is at least present, we'll attempt
+	// to import it into the main archive.
+	err := r.cfg.ProofArchive.ImportProofs(
+		ctx, headerVerifier, groupVerifier, false,
+		&proof.AnnotatedProof{Blob: req.ProofFile},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tapdevrpc.ImportProofResponse{}, nil
+}
+
+// AddrReceives lists all receives for incoming asset transfers for addresses
+// that were created previously.
+func (r *rpcServer) AddrReceives(ctx context.Context,
+	req *taprpc.AddrReceivesRequest) (*taprpc.AddrReceivesResponse,
+	error) {
+
+	var sqlQuery address.EventQueryParams
+
+	if len(req.FilterAddr) > 0 {
+		tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+
+		addr, err := address.DecodeAddress(req.FilterAddr, &tapParams)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode addr: %w", err)
+		}
+
+		// Now that we've decoded the address, we'll check to make sure
+		// that we can fetch the genesis for this address. Otherwise,
+		// that means we don't know anything about what it should look
+		// like on chain (the genesis is required to derive the taproot
+		// output key).
+		assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(
+			ctx, addr.AssetID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unknown asset=%x: %w",
+				addr.AssetID[:], err)
+		}
+
+		rpcsLog.Tracef("Listing receives for group: %v",
+			spew.Sdump(assetGroup))
+
+		addr.AttachGenesis(*assetGroup.Genesis)
+
+		taprootOutputKey, err := addr.TaprootOutputKey()
+		if err != nil {
+			return nil, fmt.Errorf("error deriving Taproot key: %w",
+				err)
+		}
+
+		sqlQuery.AddrTaprootOutputKey = schnorr.SerializePubKey(
+			taprootOutputKey,
+		)
+	}
+
+	if req.FilterStatus != taprpc.AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN {
+		status, err := unmarshalAddrEventStatus(req.FilterStatus)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing status: %w", err)
+		}
+
+		sqlQuery.StatusFrom = &status
+		sqlQuery.StatusTo = &status
+	}
+
+	events, err := r.cfg.AddrBook.QueryEvents(ctx, sqlQuery)
+	if err != nil {
+		return nil, fmt.Errorf("error querying events: %w", err)
+	}
+
+	resp := &taprpc.AddrReceivesResponse{
+		Events: make([]*taprpc.AddrEvent, len(events)),
+	}
+
+	for idx, event := range events {
+		resp.Events[idx], err = marshalAddrEvent(
+			event, r.cfg.TapAddrBook,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling event: %w",
+				err)
+		}
+	}
+
+	return resp, nil
+}
+
+// FundVirtualPsbt selects inputs from the available asset commitments to fund
+// a virtual transaction matching the template.
+func (r *rpcServer) FundVirtualPsbt(ctx context.Context,
+	req *wrpc.FundVirtualPsbtRequest) (*wrpc.FundVirtualPsbtResponse,
+	error) {
+
+	var fundedVPkt *tapfreighter.FundedVPacket
+	switch {
+	case req.GetPsbt() != nil:
+		vPkt, err := tappsbt.NewFromRawBytes(
+			bytes.NewReader(req.GetPsbt()), false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode psbt: %w", err)
+		}
+
+		// Extract the recipient information from the packet. This
+		// basically assembles the asset ID we want to send to and the
+		// sum of all output amounts.
+		desc, err := tapscript.DescribeRecipients(
+			ctx, vPkt, r.cfg.TapAddrBook,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to describe packet "+
+				"recipients: %w", err)
+		}
+
+		fundedVPkt, err = r.cfg.AssetWallet.FundPacket(
+			ctx, desc, tapfreighter.PreferMaxAmount, vPkt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error funding packet: %w", err)
+		}
+
+	case req.GetRaw() != nil:
+		raw := req.GetRaw()
+		if len(raw.Inputs) > 0 {
+			return nil, fmt.Errorf("template inputs not yet " +
+				"supported")
+		}
+		if len(raw.Recipients) > 1 {
+			return nil, fmt.Errorf("only one recipient supported")
+		}
+
+		var (
+			tapParams = address.ParamsForChain(
+				r.cfg.ChainParams.Name,
+			)
+			addr *address.Tap
+			err  error
+		)
+		for a := range raw.Recipients {
+			addr, err = address.DecodeAddress(a, &tapParams)
+			if err != nil {
+				return nil, fmt.Errorf("unable to decode "+
+					"addr: %w", err)
+			}
+		}
+
+		if addr == nil {
+			return nil, fmt.Errorf("no recipients specified")
+		}
+
+		fundedVPkts, err := r.cfg.AssetWallet.FundAddressSend(
+			ctx, tapfreighter.PreferMaxAmount,
+			tapfreighter.ChangeOptions{}, addr,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error funding address send: "+
+				"%w", err)
+		}
+
+		// A single address always results in a single packet.
+		fundedVPkt = fundedVPkts[0]
+
+	default:
+		return nil, fmt.Errorf("either PSBT or raw template must be " +
+			"specified")
+	}
+
+	var b bytes.Buffer
+	if err := fundedVPkt.VPacket.Serialize(&b); err != nil {
+		return nil, fmt.Errorf("error serializing packet: %w", err)
+	}
+
+	return &wrpc.FundVirtualPsbtResponse{
+		FundedPsbt:        b.Bytes(),
+		ChangeOutputIndex: 0,
+	}, nil
+}
+
+// SignVirtualPsbt signs the inputs of a virtual transaction and prepares the
+// commitments of the inputs and outputs.
+func (r *rpcServer) SignVirtualPsbt(_ context.Context,
+	req *wrpc.SignVirtualPsbtRequest) (*wrpc.SignVirtualPsbtResponse,
+	error) {
+
+	if req.FundedPsbt == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	vPkt, err := tappsbt.NewFromRawBytes(
+		bytes.NewReader(req.FundedPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding packet: %w", err)
+	}
+
+	signedInputs, err := r.cfg.AssetWallet.SignVirtualPacket(vPkt)
+	if err != nil {
+		return nil, fmt.Errorf("error signing packet: %w", err)
+	}
+
+	var b bytes.Buffer
+	if err := vPkt.Serialize(&b); err != nil {
+		return nil, fmt.Errorf("error serializing packet: %w", err)
+	}
+
+	return &wrpc.SignVirtualPsbtResponse{
+		SignedPsbt:   b.Bytes(),
+		SignedInputs: signedInputs,
+	}, nil
+}
+
+// AnchorVirtualPsbts merges and then commits multiple virtual transactions in
+// a single BTC level anchor transaction.
+//
+// TODO(guggero): Actually implement accepting and merging multiple
+// transactions.
+func (r *rpcServer) AnchorVirtualPsbts(ctx context.Context,
+	req *wrpc.AnchorVirtualPsbtsRequest) (*taprpc.SendAssetResponse,
+	error) {
+
+	if len(req.VirtualPsbts) == 0 {
+		return nil, fmt.Errorf("no virtual PSBTs specified")
+	}
+
+	if len(req.VirtualPsbts) > 1 {
+		return nil, fmt.Errorf("only one virtual PSBT supported")
+	}
+
+	vPacket, err := tappsbt.NewFromRawBytes(
+		bytes.NewReader(req.VirtualPsbts[0]), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding packet: %w", err)
+	}
+
+	if len(vPacket.Inputs) != 1 {
+		return nil, fmt.Errorf("only one input is currently supported")
+	}
+
+	inputAsset := vPacket.Inputs[0].Asset()
+	prevID := vPacket.Inputs[0].PrevID
+	inputCommitment, err := r.cfg.AssetStore.FetchCommitment(
+		ctx, inputAsset.ID(), prevID.OutPoint, inputAsset.GroupKey,
+		&inputAsset.ScriptKey, true,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching input commitment: %w",
+			err)
+	}
+
+	rpcsLog.Debugf("Selected commitment for anchor point %v, requesting "+
+		"delivery", inputCommitment.AnchorPoint)
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(
+		tapfreighter.NewPreSignedParcel(
+			vPacket, tappsbt.InputCommitments{
+				0: inputCommitment.Commitment,
+			},
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting delivery: %w", err)
+	}
+
+	parcel, err := marshalOutboundParcel(
+		resp, tapfreighter.TransferStateUnconfirmed,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	return &taprpc.SendAssetResponse{
+		Transfer: parcel,
+	}, nil
+}
+
+// NextInternalKey derives the next internal key for the given key family and
+// stores it as an internal key in the database to make sure it is identified
+// as a local key later on when importing proofs. While an internal key can
+// also be used as the internal key of a script key, it is recommended to use
+// the NextScriptKey RPC instead, to make sure the tweaked Taproot output key
+// is also recognized as a local key.
+func (r *rpcServer) NextInternalKey(ctx context.Context,
+	req *wrpc.NextInternalKeyRequest) (*wrpc.NextInternalKeyResponse,
+	error) {
+
+	// Due to how we detect local keys, we need to make sure that the key
+	// family is not zero.
+	if req.KeyFamily == 0 {
+		return nil, fmt.Errorf("key family must be set to a non-zero " +
+			"value")
+	}
+
+	keyDesc, err := r.cfg.AddrBook.NextInternalKey(ctx, keychain.KeyFamily(
+		req.KeyFamily,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("error inserting internal key: %w", err)
+	}
+
+	return &wrpc.NextInternalKeyResponse{
+		InternalKey: marshalKeyDescriptor(keyDesc),
+	}, nil
+}
+
+// ListInternalKeys lists the internal keys that were derived by the asset
+// wallet, along with their key family and index, sorted by both.
+func (r *rpcServer) ListInternalKeys(ctx context.Context,
+	req *wrpc.ListInternalKeysRequest) (*wrpc.ListInternalKeysResponse,
+	error) {
+
+	// Keys of the zero key family aren't derived by the wallet, so a
+	// filter for it would always return an empty list.
+	var keyFamily *keychain.KeyFamily
+	if req.KeyFamily != 0 {
+		family := keychain.KeyFamily(req.KeyFamily)
+		keyFamily = &family
+	}
+
+	keys, err := r.cfg.AddrBook.ListInternalKeys(ctx, keyFamily)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list internal keys: %w", err)
+	}
+
+	return &wrpc.ListInternalKeysResponse{
+		InternalKeys: fn.Map(keys, marshalKeyDescriptor),
+	}, nil
+}
+
+// NextScriptKey derives the next script key (and its corresponding internal
+// key) and stores them both in the database to make sure they are identified
+// as local keys later on when importing proofs.
+func (r *rpcServer) NextScriptKey(ctx context.Context,
+	req *wrpc.NextScriptKeyRequest) (*wrpc.NextScriptKeyResponse,
+	error) {
+
+	// Due to how we detect local keys, we need to make sure that the key
+	// family is not zero.
+	if req.KeyFamily == 0 {
+		return nil, fmt.Errorf("key family must be set to a non-zero " +
+			"value")
+	}
+
+	scriptKey, err := r.cfg.AddrBook.NextScriptKey(ctx, keychain.KeyFamily(
+		req.KeyFamily,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("error inserting internal key: %w", err)
+	}
+
+	return &wrpc.NextScriptKeyResponse{
+		ScriptKey: marshalScriptKey(scriptKey),
+	}, nil
+}
+
+// marshalAddr turns an address into its RPC counterpart.
+func marshalAddr(addr *address.Tap,
+	db address.Storage) (*taprpc.Addr, error) {
+
+	addrStr, err := addr.EncodeAddress()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode addr: %w", err)
+	}
+
+	// We can only derive the taproot output if we already know the genesis
+	// for this asset, as that's required to make the template asset that
+	// will be committed to in the tapscript tree.
+	var taprootOutputKey []byte
+	assetGroup, err := db.QueryAssetGroup(
+		context.Background(), addr.AssetID,
+	)
+	if err == nil {
+		addr.AttachGenesis(*assetGroup.Genesis)
+
+		outputKey, err := addr.TaprootOutputKey()
+		if err != nil {
+			return nil, fmt.Errorf("error deriving Taproot "+
+				"output key: %w", err)
+		}
+
+		taprootOutputKey = schnorr.SerializePubKey(outputKey)
+	}
+
+	siblingBytes, _, err := commitment.MaybeEncodeTapscriptPreimage(
+		addr.TapscriptSibling,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding tapscript sibling: %w",
+			err)
+	}
+
+	assetVersion, err := taprpc.MarshalAssetVersion(addr.AssetVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	id := addr.AssetID
+	rpcAddr := &taprpc.Addr{
+		AssetVersion:     assetVersion,
+		Encoded:          addrStr,
+		AssetId:          id[:],
+		Amount:           addr.Amount,
+		ScriptKey:        addr.ScriptKey.SerializeCompressed(),
+		InternalKey:      addr.InternalKey.SerializeCompressed(),
+		TapscriptSibling: siblingBytes,
+		TaprootOutputKey: taprootOutputKey,
+		AssetType:        taprpc.AssetType(addr.AssetType()),
+		ProofCourierAddr: addr.ProofCourierAddr.String(),
+	}
+
+	if addr.GroupKey != nil {
+		rpcAddr.GroupKey = addr.GroupKey.SerializeCompressed()
+	}
+
+	return rpcAddr, nil
+}
+
+// marshalAddrEvent turns an address event into its RPC counterpart.
+func marshalAddrEvent(event *address.Event,
+	db address.Storage) (*taprpc.AddrEvent, error) {
+
+	rpcAddr, err := marshalAddr(event.Addr.Tap, db)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling addr: %w", err)
+	}
+
+	rpcStatus, err := marshalAddrEventStatus(event.Status)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling status: %w", err)
+	}
+
+	paymentStatus := marshalPaymentStatus(event.PaymentStatus())
+
+	return &taprpc.AddrEvent{
+		CreationTimeUnixSeconds: uint64(event.CreationTime.Unix()),
+		Addr:                    rpcAddr,
+		Status:                  rpcStatus,
+		Outpoint:                event.Outpoint.String(),
+		UtxoAmtSat:              uint64(event.Amt),
+		ConfirmationHeight:      event.ConfirmationHeight,
+		HasProof:                event.HasProof,
+		ReceivedAmount:          event.ReceivedAmount,
+		PaymentStatus:           paymentStatus,
+	}, nil
+}
+
+// marshalPaymentStatus turns the payment status of an address event into the
+// RPC counterpart.
+func marshalPaymentStatus(
+	status address.PaymentStatus) taprpc.AddrPaymentStatus {
+
+	switch status {
+	case address.PaymentStatusExact:
+		return taprpc.AddrPaymentStatus_ADDR_PAYMENT_STATUS_EXACT
+
+	case address.PaymentStatusOverpaid:
+		return taprpc.AddrPaymentStatus_ADDR_PAYMENT_STATUS_OVERPAID
+
+	case address.PaymentStatusUnderpaid:
+		return taprpc.AddrPaymentStatus_ADDR_PAYMENT_STATUS_UNDERPAID
+
+	default:
+		return taprpc.AddrPaymentStatus_ADDR_PAYMENT_STATUS_UNKNOWN
+	}
+}
+
+// unmarshalAddrEventStatus parses the RPC address event status into the native
+// counterpart.
+func unmarshalAddrEventStatus(
+	rpcStatus taprpc.AddrEventStatus) (address.Status, error) {
+
+	switch rpcStatus {
+	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_DETECTED:
+		return address.StatusTransactionDetected, nil
+
+	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED:
+		return address.StatusTransactionConfirmed, nil
+
+	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_PROOF_RECEIVED:
+		return address.StatusProofReceived, nil
+
+	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED:
+		return address.StatusCompleted, nil
+
+	default:
+		return 0, fmt.Errorf("unknown address event status <%d>",
+			rpcStatus)
+	}
+}
+
+// marshalAddrEventStatus turns the address event status into the RPC
+// counterpart.
+func marshalAddrEventStatus(status address.Status) (taprpc.AddrEventStatus,
+	error) {
+
+	switch status {
+	case address.StatusTransactionDetected:
+		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_DETECTED,
+			nil
+
+	case address.StatusTransactionConfirmed:
+		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED,
+			nil
+
+	case address.StatusProofReceived:
+		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_PROOF_RECEIVED,
+			nil
+
+	case address.StatusCompleted:
+		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED, nil
+
+	default:
+		return 0, fmt.Errorf("unknown address event status <%d>",
+			status)
+	}
+}
+
+// SendAsset uses one or multiple passed Taproot Asset address(es) to attempt to
+// complete an asset send. The method returns information w.r.t the on chain
+// send, as well as the proof file information the receiver needs to fully
+// receive the asset.
+func (r *rpcServer) SendAsset(ctx context.Context,
+	req *taprpc.SendAssetRequest) (*taprpc.SendAssetResponse, error) {
+
+	tapAddrs, err := r.decodeSendAddrs(ctx, req.TapAddrs)
+	if err != nil {
+		return nil, err
+	}
+
+	feeRate, err := checkFeeRateSanity(req.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	strategy, err := unmarshalCoinSelectStrategy(req.CoinSelectStrategy)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	changeOpts := tapfreighter.ChangeOptions{
+		ConsolidateInputs: req.ConsolidateInputs,
+	}
+	if len(req.ChangeScriptKey) > 0 {
+		changeScriptKey, err := parseUserKey(req.ChangeScriptKey)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid change script key: %v", err)
+		}
+
+		// The change must go to a key we can spend from, otherwise it
+		// would be lost.
+		isLocal, err := r.isLocalScriptKey(ctx, changeScriptKey)
+		if err != nil {
+			return nil, err
+		}
+		if !isLocal {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"change script key %x is not a script key of "+
+					"this node",
+				schnorr.SerializePubKey(changeScriptKey))
+		}
+
+		changeOpts.ScriptKey = changeScriptKey
+	}
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(
+		tapfreighter.NewAddressParcel(
+			feeRate, strategy, changeOpts, tapAddrs...,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parcel, err := marshalOutboundParcel(
+		resp, tapfreighter.TransferStateUnconfirmed,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	addrResults, err := marshalAddrSendResults(req.TapAddrs, tapAddrs, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	changeOutputs, err := marshalSendChangeOutputs(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	// The inputs of the transfer are exactly the asset UTXOs that were
+	// picked by coin selection.
+	return &taprpc.SendAssetResponse{
+		Transfer:       parcel,
+		SelectedInputs: parcel.Inputs,
+		AddrResults:    addrResults,
+		AnchorTxid:     resp.AnchorTx.TxHash().String(),
+		ChangeOutputs:  changeOutputs,
+	}, nil
+}
+
+// marshalSendChangeOutputs returns the outputs of the given transfer that hold
+// the change of a send. The change of each sent asset goes to the split root
+// output of its virtual packet, unless there was no change left over, in which
+// case that output is a zero amount tombstone.
+func marshalSendChangeOutputs(
+	transfer *tapfreighter.OutboundParcel) ([]*taprpc.SendChangeOutput,
+	error) {
+
+	var changeOutputs []*taprpc.SendChangeOutput
+	for idx := range transfer.Outputs {
+		out := &transfer.Outputs[idx]
+		if !out.Type.IsSplitRoot() || out.Amount == 0 {
+			continue
+		}
+
+		// The transfer output doesn't carry the asset ID, so we look
+		// it up in the proof of the output.
+		var p proof.Proof
+		err := p.Decode(bytes.NewReader(out.ProofSuffix))
+		if err != nil {
+			return nil, fmt.Errorf("error decoding change proof: "+
+				"%w", err)
+		}
+		assetID := p.Asset.ID()
+		scriptKey := out.ScriptKey.PubKey
+
+		changeOutputs = append(changeOutputs, &taprpc.SendChangeOutput{
+			OutputIndex:    uint32(idx),
+			AssetId:        assetID[:],
+			Amount:         out.Amount,
+			ScriptKey:      scriptKey.SerializeCompressed(),
+			AnchorOutpoint: out.Anchor.OutPoint.String(),
+		})
+	}
+
+	return changeOutputs, nil
+}
+
+// decodeSendAddrs decodes the given Taproot Asset addresses of a send request
+// and makes sure the wallet owns enough units of each asset to pay them.
+func (r *rpcServer) decodeSendAddrs(ctx context.Context,
+	encodedAddrs []string) ([]*address.Tap, error) {
+
+	if len(encodedAddrs) == 0 {
+		return nil, fmt.Errorf("at least one addr is required")
+	}
+
+	var (
+		tapParams = address.ParamsForChain(r.cfg.ChainParams.Name)
+		tapAddrs  = make([]*address.Tap, len(encodedAddrs))
+		assetIDs  []asset.ID
+		totalAmts = make(map[asset.ID]uint64)
+	)
+	for idx := range encodedAddrs {
+		if encodedAddrs[idx] == "" {
+			return nil, fmt.Errorf("addr %d must be specified", idx)
+		}
+
+		var err error
+		tapAddrs[idx], err = address.DecodeAddress(
+			encodedAddrs[idx], &tapParams,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// The addrs can be of different asset IDs. Within a single
+		// transfer (=a single virtual packet), we expect only to have
+		// inputs and outputs of the same asset ID, so the wallet
+		// creates a separate virtual packet for each asset ID. They are
+		// then merged into the same anchor transaction in the wallet's
+		// AnchorVirtualTransactions call.
+		//
+		// TODO(guggero): Revisit after we have a way to send fungible
+		// assets with different IDs to an address (non-interactive).
+		assetID := tapAddrs[idx].AssetID
+		totalAmt, ok := totalAmts[assetID]
+		if !ok {
+			assetIDs = append(assetIDs, assetID)
+		}
+
+		if totalAmt+tapAddrs[idx].Amount < totalAmt {
+			return nil, fmt.Errorf("total amount of addrs " +
+				"overflows")
+		}
+		totalAmts[assetID] = totalAmt + tapAddrs[idx].Amount
+	}
+
+	// We can't send more than we own, so we reject the send early with a
+	// clear error instead of failing coin selection.
+	for _, assetID := range assetIDs {
+		balances, err := r.cfg.AssetStore.QueryBalancesByAsset(
+			ctx, &assetID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to query asset "+
+				"balance: %w", err)
+		}
+
+		totalAmt := totalAmts[assetID]
+		balance := balances[assetID].Balance
+		if totalAmt > balance {
+			return nil, fmt.Errorf("cannot send %d units of asset "+
+				"%v, only %d units available", totalAmt,
+				assetID, balance)
+		}
+	}
+
+	return tapAddrs, nil
+}
+
+// EstimateTransferFee estimates the on-chain fee and size of the anchor
+// transaction of a transfer to the given addresses, without signing or
+// broadcasting anything.
+func (r *rpcServer) EstimateTransferFee(ctx context.Context,
+	req *taprpc.EstimateTransferFeeRequest) (
+	*taprpc.EstimateTransferFeeResponse, error) {
+
+	tapAddrs, err := r.decodeSendAddrs(ctx, req.TapAddrs)
+	if err != nil {
+		return nil, err
+	}
+
+	manualFeeRate, err := checkFeeRateSanity(req.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	feeRate := manualFeeRate
+	if feeRate == nil {
+		estimatedFeeRate, err := r.cfg.ChainBridge.EstimateFee(
+			ctx, tapscript.SendConfTarget,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to estimate fee: %w",
+				err)
+		}
+		feeRate = &estimatedFeeRate
+	}
+
+	strategy, err := unmarshalCoinSelectStrategy(req.CoinSelectStrategy)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Coin selection fails if the amounts of the addresses can't be
+	// satisfied by the asset UTXOs available.
+	fundedPkts, err := r.cfg.AssetWallet.FundAddressSend(
+		ctx, strategy, tapfreighter.ChangeOptions{}, tapAddrs...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fund send: %w", err)
+	}
+
+	// Coin selection leased the selected asset UTXOs, which we need to
+	// release again since we won't actually send.
+	var (
+		vPkts            = make([]*tappsbt.VPacket, len(fundedPkts))
+		inputCommitments = make(
+			[]tappsbt.InputCommitments, len(fundedPkts),
+		)
+		leasedOutPoints []wire.OutPoint
+	)
+	for idx, fundedPkt := range fundedPkts {
+		vPkts[idx] = fundedPkt.VPacket
+		inputCommitments[idx] = fundedPkt.InputCommitments
+
+		for _, vIn := range fundedPkt.VPacket.Inputs {
+			leasedOutPoints = append(
+				leasedOutPoints, vIn.PrevID.OutPoint,
+			)
+		}
+	}
+	defer func() {
+		err := r.cfg.CoinSelect.ReleaseCoins(ctx, leasedOutPoints...)
+		if err != nil {
+			rpcsLog.Errorf("Unable to release asset coins of fee "+
+				"estimation: %v", err)
+		}
+	}()
+
+	estimate, err := r.cfg.AssetWallet.EstimateAnchorTxFee(
+		ctx, &tapfreighter.AnchorVTxnsParams{
+			FeeRate:          *feeRate,
+			VPkts:            vPkts,
+			InputCommitments: inputCommitments,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to estimate anchor TX fee: %w",
+			err)
+	}
+
+	return &taprpc.EstimateTransferFeeResponse{
+		Vsize:        uint64(estimate.VSize()),
+		SatPerKw:     uint32(estimate.FeeRate),
+		TotalFeeSats: int64(estimate.TotalFee),
+	}, nil
+}
+
+// marshalAddrSendResults creates the per address results of a send, locating
+// the anchor output that pays each of the given addresses in the transfer.
+func marshalAddrSendResults(encodedAddrs []string, tapAddrs []*address.Tap,
+	transfer *tapfreighter.OutboundParcel) ([]*taprpc.AddrSendResult,
+	error) {
+
+	results := make([]*taprpc.AddrSendResult, len(tapAddrs))
+	for idx, addr := range tapAddrs {
+		// Each address has its own script and internal key, which
+		// identifies the output paying it.
+		var out *tapfreighter.TransferOutput
+		for outIdx := range transfer.Outputs {
+			candidate := &transfer.Outputs[outIdx]
+			scriptKey := candidate.ScriptKey.PubKey
+			internalKey := candidate.Anchor.InternalKey.PubKey
+			if scriptKey.IsEqual(&addr.ScriptKey) &&
+				internalKey.IsEqual(&addr.InternalKey) {
+
+				out = candidate
+				break
+			}
+		}
+		if out == nil {
+			return nil, fmt.Errorf("no transfer output found for "+
+				"addr %d", idx)
+		}
+
+		results[idx] = &taprpc.AddrSendResult{
+			TapAddr:        encodedAddrs[idx],
+			AssetId:        fn.ByteSlice(addr.AssetID),
+			Amount:         addr.Amount,
+			AnchorOutpoint: out.Anchor.OutPoint.String(),
+		}
+	}
+
+	return results, nil
+}
+
+// BurnAsset burns the given number of units of a given asset by sending them
+// to a provably un-spendable script key. Burning means irrevocably destroying
+// a certain number of assets, reducing the total supply of the asset. Because
+// burning is such a destructive and non-reversible operation, some specific
+// values need to be set in the request to avoid accidental burns.
+func (r *rpcServer) BurnAsset(ctx context.Context,
+	in *taprpc.BurnAssetRequest) (*taprpc.BurnAssetResponse, error) {
+
+	var assetID asset.ID
+	switch {
+	case len(in.GetAssetId()) > 0:
+		copy(assetID[:], in.GetAssetId())
+
+	case len(in.GetAssetIdStr()) > 0:
+		assetIDBytes, err := hex.DecodeString(in.GetAssetIdStr())
+		if err != nil {
+			return nil, fmt.Errorf("error decoding asset ID: %w",
+				err)
+		}
+
+		copy(assetID[:], assetIDBytes)
+
+	default:
+		return nil, fmt.Errorf("asset ID must be specified")
+	}
+
+	if in.AmountToBurn == 0 {
+		return nil, fmt.Errorf("amount to burn must be specified")
+	}
+	if in.ConfirmationText != AssetBurnConfirmationText {
+		return nil, fmt.Errorf("invalid confirmation text, please " +
+			"read API doc and confirm safety measure to avoid " +
+			"accidental asset burns")
+	}
+
+	// We can't burn more than we own, so we reject the burn early with a
+	// clear error instead of failing coin selection.
+	balances, err := r.cfg.AssetStore.QueryBalancesByAsset(ctx, &assetID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query asset balance: %w", err)
+	}
+	balance := balances[assetID].Balance
+	if in.AmountToBurn > balance {
+		return nil, fmt.Errorf("cannot burn %d units of asset %v, "+
+			"only %d units available", in.AmountToBurn, assetID,
+			balance)
+	}
+
+	var groupKey *btcec.PublicKey
+	assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(ctx, assetID)
+	if err == nil && assetGroup.GroupKey != nil {
+		groupKey = &assetGroup.GroupPubKey
+	}
+
+	fundResp, err := r.cfg.AssetWallet.FundBurn(
+		ctx, &tapscript.FundingDescriptor{
+			ID:       assetID,
+			GroupKey: groupKey,
+			Amount:   in.AmountToBurn,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error funding burn: %w", err)
+	}
+
+	// Now we can sign the packet and send it to the chain.
+	_, err = r.cfg.AssetWallet.SignVirtualPacket(fundResp.VPacket)
+	if err != nil {
+		return nil, fmt.Errorf("error signing packet: %w", err)
+	}
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(
+		tapfreighter.NewPreSignedParcel(
+			fundResp.VPacket, fundResp.InputCommitments,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parcel, err := marshalOutboundParcel(
+		resp, tapfreighter.TransferStateUnconfirmed,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	var burnProof *taprpc.DecodedProof
+	for idx := range resp.Outputs {
+		vOut := fundResp.VPacket.Outputs[idx]
+		tOut := resp.Outputs[idx]
+		if vOut.Asset.IsBurn() {
+			var p proof.Proof
+			err = p.Decode(bytes.NewReader(tOut.ProofSuffix))
+			if err != nil {
+				return nil, fmt.Errorf("error decoding "+
+					"burn proof: %w", err)
+			}
+
+			burnProof, err = r.marshalProof(ctx, &p, true, false)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding "+
+					"burn proof: %w", err)
+			}
+		}
+	}
+
+	return &taprpc.BurnAssetResponse{
+		BurnTransfer: parcel,
+		BurnProof:    burnProof,
+	}, nil
+}
+
+// marshalOutboundParcel turns a pending parcel into its RPC counterpart.
+func marshalOutboundParcel(parcel *tapfreighter.OutboundParcel,
+	state tapfreighter.TransferState) (*taprpc.AssetTransfer, error) {
+
+	rpcState, err := marshalTransferState(state)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcInputs := make([]*taprpc.TransferInput, len(parcel.Inputs))
+	for idx := range parcel.Inputs {
+		in := parcel.Inputs[idx]
+		rpcInputs[idx] = &taprpc.TransferInput{
+			AnchorPoint: in.OutPoint.String(),
+			AssetId:     in.ID[:],
+			ScriptKey:   in.ScriptKey[:],
+			Amount:      in.Amount,
+		}
+	}
+
+	rpcOutputs := make(
+		[]*taprpc.TransferOutput, len(parcel.Outputs),
+	)
+	for idx := range parcel.Outputs {
+		out := parcel.Outputs[idx]
+
+		internalPubKey := out.Anchor.InternalKey.PubKey
+		internalKeyBytes := internalPubKey.SerializeCompressed()
+		rpcAnchor := &taprpc.TransferOutputAnchor{
+			Outpoint:         out.Anchor.OutPoint.String(),
+			Value:            int64(out.Anchor.Value),
+			InternalKey:      internalKeyBytes,
+			TaprootAssetRoot: out.Anchor.TaprootAssetRoot[:],
+			MerkleRoot:       out.Anchor.MerkleRoot[:],
+			TapscriptSibling: out.Anchor.TapscriptSibling,
+			NumPassiveAssets: out.Anchor.NumPassiveAssets,
+		}
+		scriptPubKey := out.ScriptKey.PubKey
+
+		var splitCommitRoot []byte
+		if out.SplitCommitmentRoot != nil {
+			hash := out.SplitCommitmentRoot.NodeHash()
+			if hash != mssmt.ZeroNodeHash {
+				splitCommitRoot = hash[:]
+			}
+		}
+
+		rpcOutType, err := marshalOutputType(out.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		assetVersion, err := taprpc.MarshalAssetVersion(
+			out.AssetVersion,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		deliveryStatus, err := marshalProofDeliveryStatus(&out, state)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcOutputs[idx] = &taprpc.TransferOutput{
+			Anchor:              rpcAnchor,
+			ScriptKey:           scriptPubKey.SerializeCompressed(),
+			ScriptKeyIsLocal:    out.ScriptKeyLocal,
+			Amount:              out.Amount,
+			NewProofBlob:        out.ProofSuffix,
+			SplitCommitRootHash: splitCommitRoot,
+			OutputType:          rpcOutType,
+			AssetVersion:        assetVersion,
+			ProofDeliveryStatus: deliveryStatus,
+		}
+	}
+
+	anchorTxHash := parcel.AnchorTx.TxHash()
+	return &taprpc.AssetTransfer{
+		TransferTimestamp:  parcel.TransferTime.Unix(),
+		AnchorTxHash:       anchorTxHash[:],
+		AnchorTxHeightHint: parcel.AnchorTxHeightHint,
+		AnchorTxChainFees:  parcel.ChainFees,
+		Inputs:             rpcInputs,
+		Outputs:            rpcOutputs,
+		State:              rpcState,
+	}, nil
+}
+
+// marshalProofDeliveryStatus returns the RPC proof delivery status of a
+// transfer output. Parcels are only marked as completed once all proofs were
+// delivered, so the delivery status follows from the transfer state.
+func marshalProofDeliveryStatus(out *tapfreighter.TransferOutput,
+	state tapfreighter.TransferState) (taprpc.ProofDeliveryStatus, error) {
+
+	deliveryRequired, err := out.ProofDeliveryRequired()
+	switch {
+	case err != nil:
+		return 0, err
+
+	case !deliveryRequired:
+		return taprpc.ProofDeliveryStatus_PROOF_DELIVERY_STATUS_NOT_APPLICABLE,
+			nil
+
+	case state == tapfreighter.TransferStateCompleted:
+		return taprpc.ProofDeliveryStatus_PROOF_DELIVERY_STATUS_COMPLETE,
+			nil
+
+	default:
+		return taprpc.ProofDeliveryStatus_PROOF_DELIVERY_STATUS_PENDING,
+			nil
+	}
+}
+
+// marshalTransferState turns the transfer state into the RPC counterpart.
+func marshalTransferState(
+	state tapfreighter.TransferState) (taprpc.TransferState, error) {
+
+	switch state {
+	case tapfreighter.TransferStateUnconfirmed:
+		return taprpc.TransferState_TRANSFER_STATE_UNCONFIRMED, nil
+
+	case tapfreighter.TransferStateAwaitingProofDelivery:
+		return taprpc.TransferState_TRANSFER_STATE_AWAITING_PROOF_DELIVERY,
+			nil
+
+	case tapfreighter.TransferStateCompleted:
+		return taprpc.TransferState_TRANSFER_STATE_COMPLETED, nil
+
+	default:
+		return 0, fmt.Errorf("unknown transfer state: %v", state)
+	}
+}
+
+// unmarshalTransferState parses the optional RPC transfer state filter. Nil is
+// returned if no state is set.
+func unmarshalTransferState(
+	state taprpc.TransferState) (*tapfreighter.TransferState, error) {
+
+	var result tapfreighter.TransferState
+	switch state {
+	case taprpc.TransferState_TRANSFER_STATE_UNKNOWN:
+		return nil, nil
+
+	case taprpc.TransferState_TRANSFER_STATE_UNCONFIRMED:
+		result = tapfreighter.TransferStateUnconfirmed
+
+	case taprpc.TransferState_TRANSFER_STATE_AWAITING_PROOF_DELIVERY:
+		result = tapfreighter.TransferStateAwaitingProofDelivery
+
+	case taprpc.TransferState_TRANSFER_STATE_COMPLETED:
+		result = tapfreighter.TransferStateCompleted
+
+	default:
+		return nil, fmt.Errorf("unknown transfer state: %v", state)
+	}
+
+	return &result, nil
+}
+
+// unmarshalCoinSelectStrategy parses the RPC coin selection strategy into its
+// native counterpart.
+func unmarshalCoinSelectStrategy(
+	strategy taprpc.CoinSelectStrategy) (
+	tapfreighter.MultiCommitmentSelectStrategy, error) {
+
+	switch strategy {
+	case taprpc.CoinSelectStrategy_COIN_SELECT_LARGEST_FIRST:
+		return tapfreighter.PreferMaxAmount, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_SMALLEST_FIRST:
+		return tapfreighter.PreferMinAmount, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_MINIMIZE_INPUTS:
+		return tapfreighter.MinimizeInputs, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_MINIMIZE_CHANGE:
+		return tapfreighter.MinimizeChange, nil
+
+	default:
+		return 0, fmt.Errorf("unknown coin select strategy: %v",
+			strategy)
+	}
+}
+
+// marshalOutputType turns the transfer output type into the RPC counterpart.
+func marshalOutputType(outputType tappsbt.VOutputType) (taprpc.OutputType,
+	error) {
+
+	switch outputType {
+	case tappsbt.TypeSimple:
+		return taprpc.OutputType_OUTPUT_TYPE_SIMPLE, nil
+
+	case tappsbt.TypeSplitRoot:
+		return taprpc.OutputType_OUTPUT_TYPE_SPLIT_ROOT, nil
+
+	case tappsbt.TypePassiveAssetsOnly:
+		return taprpc.OutputType_OUTPUT_TYPE_PASSIVE_ASSETS_ONLY, nil
+
+	case tappsbt.TypePassiveSplitRoot:
+		return taprpc.OutputType_OUTPUT_TYPE_PASSIVE_SPLIT_ROOT, nil
+
+	case tappsbt.TypeSimplePassiveAssets:
+		return taprpc.OutputType_OUTPUT_TYPE_SIMPLE_PASSIVE_ASSETS, nil
+
+	default:
+		return 0, fmt.Errorf("unknown output type: %d", outputType)
+	}
+}
+
+// SubscribeSendAssetEventNtfns registers a subscription to the event
+// notification stream which relates to the asset sending process.
+func (r *rpcServer) SubscribeSendAssetEventNtfns(
+	_ *taprpc.SubscribeSendAssetEventNtfnsRequest,
+	ntfnStream taprpc.TaprootAssets_SubscribeSendAssetEventNtfnsServer) error {
+
+	// Create a new event subscriber and pass a copy to the chain porter.
+	// We will then read events from the subscriber.
+	eventSubscriber := fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize)
+	defer eventSubscriber.Stop()
+
+	err := r.cfg.ChainPorter.RegisterSubscriber(eventSubscriber, false, false)
+	if err != nil {
+		return fmt.Errorf("failed to register event notifications "+
+			"subscription: %w", err)
+	}
+
+	// Loop and read from the ChainPorter event subscription and forward to
+	// the RPC stream.
+	for {
+		select {
+		// Handle receiving a new event from the ChainPorter.
+		// The event will be mapped to the RPC event type and
+		// sent over the stream.
+		case event := <-eventSubscriber.NewItemCreated.ChanOut():
+			// Transfer state updates are delivered through the
+			// SubscribeTransfers stream instead.
+			_, ok := event.(*tapfreighter.TransferStateEvent)
+			if ok {
+				continue
+			}
+
+			rpcEvent, err := marshallSendAssetEvent(event)
+			if err != nil {
+				return fmt.Errorf("failed to marshall "+
+					"ChainPorter event into RPC event: "+
+					"%w", err)
+			}
+
+			err = ntfnStream.Send(rpcEvent)
+			if err != nil {
+				return fmt.Errorf("failed to RPC stream send "+
+					"event: %w", err)
+			}
+
+		// Handle the case where the RPC stream is closed by the
+		// client.
+		case <-ntfnStream.Context().Done():
+			// Don't return an error if a normal context
+			// cancellation has occurred.
+			isCanceledContext := errors.Is(
+				ntfnStream.Context().Err(), context.Canceled,
+			)
+			if isCanceledContext {
+				return nil
+			}
+
+			return ntfnStream.Context().Err()
+
+		// Handle the case where the RPC server is shutting down.
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// SubscribeTransfers registers a subscription to state updates of outbound
+// asset transfers.
+func (r *rpcServer) SubscribeTransfers(req *taprpc.SubscribeTransfersRequest,
+	ntfnStream taprpc.TaprootAssets_SubscribeTransfersServer) error {
+
+	filterAssetID, err := unmarshalTransferAssetFilter(req.FilterAssetId)
+	if err != nil {
+		return err
+	}
+
+	// Create a new event subscriber and pass a copy to the chain porter.
+	// We will then read events from the subscriber.
+	eventSubscriber := fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize)
+	defer eventSubscriber.Stop()
+
+	err = r.cfg.ChainPorter.RegisterSubscriber(
+		eventSubscriber, false, false,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to register event notifications "+
+			"subscription: %w", err)
+	}
+
+	for {
+		select {
+		// We only forward transfer state updates, all other porter
+		// events are delivered through SubscribeSendAssetEventNtfns.
+		case event := <-eventSubscriber.NewItemCreated.ChanOut():
+			update, ok := event.(*tapfreighter.TransferStateEvent)
+			if !ok {
+				continue
+			}
+
+			if filterAssetID != nil && !parcelSpendsAsset(
+				update.Parcel, *filterAssetID,
+			) {
+
+				continue
+			}
+
+			rpcTransfer, err := marshalOutboundParcel(
+				update.Parcel, update.State,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to marshal "+
+					"parcel: %w", err)
+			}
+
+			err = ntfnStream.Send(&taprpc.TransferEvent{
+				Timestamp: update.Timestamp().UnixMicro(),
+				Transfer:  rpcTransfer,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to RPC stream send "+
+					"event: %w", err)
+			}
+
+		// Handle the case where the RPC stream is closed by the
+		// client.
+		case <-ntfnStream.Context().Done():
+			// Don't return an error if a normal context
+			// cancellation has occurred.
+			isCanceledContext := errors.Is(
+				ntfnStream.Context().Err(), context.Canceled,
+			)
+			if isCanceledContext {
+				return nil
+			}
+
+			return ntfnStream.Context().Err()
+
+		// Handle the case where the RPC server is shutting down.
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// marshallSendAssetEvent maps a ChainPorter event to its RPC counterpart.
+func marshallSendAssetEvent(
+	eventInterface fn.Event) (*taprpc.SendAssetEvent, error) {
+
+	switch event := eventInterface.(type) {
+	case *tapfreighter.ExecuteSendStateEvent:
+		eventRpc := &taprpc.SendAssetEvent_ExecuteSendStateEvent{
+			ExecuteSendStateEvent: &taprpc.ExecuteSendStateEvent{
+				Timestamp: event.Timestamp().UnixMicro(),
+				SendState: event.SendState.String(),
+			},
+		}
+		return &taprpc.SendAssetEvent{
+			Event: eventRpc,
+		}, nil
+
+	case *proof.ReceiverProofBackoffWaitEvent:
+		eventRpc := taprpc.SendAssetEvent_ReceiverProofBackoffWaitEvent{
+			ReceiverProofBackoffWaitEvent: &taprpc.ReceiverProofBackoffWaitEvent{
+				Timestamp:    event.Timestamp().UnixMicro(),
+				Backoff:      event.Backoff.Microseconds(),
+				TriesCounter: event.TriesCounter,
+			},
+		}
+		return &taprpc.SendAssetEvent{
+			Event: &eventRpc,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown event type: %T", eventInterface)
+	}
+}
+
+// marshalMintingBatch marshals a minting batch into the RPC counterpart.
+func marshalMintingBatch(batch *tapgarden.MintingBatch,
+	skipSeedlings bool) (*mintrpc.MintingBatch, error) {
+
+	rpcBatchState, err := marshalBatchState(batch)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcBatch := &mintrpc.MintingBatch{
+		BatchKey:  batch.BatchKey.PubKey.SerializeCompressed(),
+		State:     rpcBatchState,
+		BatchName: batch.Name,
+	}
+
+	// The genesis point is only known once the batch is committed to a
+	// minting transaction.
+	if batch.GenesisPacket != nil {
+		genesisTx := batch.GenesisPacket.Pkt.UnsignedTx
+		if len(genesisTx.TxIn) > 0 {
+			rpcBatch.GenesisPoint =
+				genesisTx.TxIn[0].PreviousOutPoint.String()
+		}
+	}
+
+	// If we don't need to include the seedlings, we can return here.
+	if skipSeedlings {
+		return rpcBatch, nil
+	}
+
+	// When we have sprouts, then they represent the same assets as the
+	// seedlings but in a more "grown up" state. So in that case we only
+	// marshal the sprouts.
+	switch {
+	// We have sprouts, ignore seedlings.
+	case batch.RootAssetCommitment != nil &&
+		len(batch.RootAssetCommitment.CommittedAssets()) > 0:
+
+		rpcBatch.Assets = marshalSprouts(
+			batch.RootAssetCommitment.CommittedAssets(),
+			batch.AssetMetas, batch.AssetLabels,
+		)
+
+	// No sprouts, so we marshal the seedlings.
+	case len(batch.Seedlings) > 0:
+		rpcBatch.Assets, err = marshalSeedlings(batch.Seedlings)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rpcBatch, nil
+}
+
+// marshalSeedlings marshals the seedlings into the RPC counterpart.
+func marshalSeedlings(
+	seedlings map[string]*tapgarden.Seedling) ([]*mintrpc.MintAsset, error) {
+
+	rpcAssets := make([]*mintrpc.MintAsset, 0, len(seedlings))
+	for _, seedling := range seedlings {
+		var groupKeyBytes []byte
+		if seedling.HasGroupKey() {
+			groupKey := seedling.GroupInfo.GroupKey
+			groupPubKey := groupKey.GroupPubKey
+			groupKeyBytes = groupPubKey.SerializeCompressed()
+		}
+
+		var groupAnchor string
+		if seedling.GroupAnchor != nil {
+			groupAnchor = *seedling.GroupAnchor
+		}
+
+		var seedlingMeta *taprpc.AssetMeta
+		if seedling.Meta != nil {
+			seedlingMeta = &taprpc.AssetMeta{
+				MetaHash: fn.ByteSlice(
+					seedling.Meta.MetaHash(),
+				),
+				Data: seedling.Meta.Data,
+				Type: taprpc.AssetMetaType(seedling.Meta.Type),
+			}
+		}
+
+		assetVersion, err := taprpc.MarshalAssetVersion(
+			seedling.AssetVersion,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		var scriptKey *taprpc.ScriptKey
+		if seedling.ScriptKey != nil {
+			scriptKey = marshalScriptKey(*seedling.ScriptKey)
+		}
+
+		var groupInternalKey *taprpc.KeyDescriptor
+		if seedling.GroupInternalKey != nil {
+			groupInternalKey = marshalKeyDescriptor(
+				*seedling.GroupInternalKey,
+			)
+		}
+
+		rpcAssets = append(rpcAssets, &mintrpc.MintAsset{
+			AssetType:    taprpc.AssetType(seedling.AssetType),
+			AssetVersion: assetVersion,
+			Name:         seedling.AssetName,
+			AssetMeta:    seedlingMeta,
+			Amount:       seedling.Amount,
+			GroupKey:     groupKeyBytes,
+			GroupAnchor:  groupAnchor,
+			EmissionSchedule: fn.Map(
+				seedling.EmissionSchedule, marshalEmissionEvent,
+			),
+			ScriptKey:        scriptKey,
+			GroupInternalKey: groupInternalKey,
+			Label:            seedling.Label,
+		})
+	}
+
+	return rpcAssets, nil
+}
+
+// marshalSprouts marshals the sprouts into the RPC counterpart.
+func marshalSprouts(sprouts []*asset.Asset, metas tapgarden.AssetMetas,
+	labels tapgarden.AssetLabels) []*mintrpc.MintAsset {
+
+	rpcAssets := make([]*mintrpc.MintAsset, 0, len(sprouts))
+	for _, sprout := range sprouts {
+		scriptKey := asset.ToSerialized(sprout.ScriptKey.PubKey)
+
+		var assetMeta *taprpc.AssetMeta
+		if metas != nil {
+			if m, ok := metas[scriptKey]; ok && m != nil {
+				assetMeta = &taprpc.AssetMeta{
+					MetaHash: fn.ByteSlice(m.MetaHash()),
+					Data:     m.Data,
+					Type:     taprpc.AssetMetaType(m.Type),
+				}
+			}
+		}
+
+		var groupKeyBytes []byte
+		if sprout.GroupKey != nil {
+			gpk := sprout.GroupKey.GroupPubKey
+			groupKeyBytes = gpk.SerializeCompressed()
+		}
+
+		rpcAssets = append(rpcAssets, &mintrpc.MintAsset{
+			AssetType: taprpc.AssetType(sprout.Type),
+			Name:      sprout.Tag,
+			AssetMeta: assetMeta,
+			Amount:    sprout.Amount,
+			GroupKey:  groupKeyBytes,
+			Label:     labels[sprout.ID()],
+		})
+	}
+
+	return rpcAssets
+}
+
+// marshalBatchState converts the batch state field into its RPC counterpart.
+func marshalBatchState(batch *tapgarden.MintingBatch) (mintrpc.BatchState,
+	error) {
+
+	currentBatchState := batch.State()
+
+	switch currentBatchState {
+	case tapgarden.BatchStatePending:
+		return mintrpc.BatchState_BATCH_STATE_PEDNING, nil
+
+	case tapgarden.BatchStateFrozen:
+		return mintrpc.BatchState_BATCH_STATE_FROZEN, nil
+
+	case tapgarden.BatchStateCommitted:
+		return mintrpc.BatchState_BATCH_STATE_COMMITTED, nil
+
+	case tapgarden.BatchStateBroadcast:
+		return mintrpc.BatchState_BATCH_STATE_BROADCAST, nil
+
+	case tapgarden.BatchStateConfirmed:
+		return mintrpc.BatchState_BATCH_STATE_CONFIRMED, nil
+
+	case tapgarden.BatchStateFinalized:
+		return mintrpc.BatchState_BATCH_STATE_FINALIZED, nil
+
+	case tapgarden.BatchStateSeedlingCancelled:
+		return mintrpc.BatchState_BATCH_STATE_SEEDLING_CANCELLED, nil
+
+	case tapgarden.BatchStateSproutCancelled:
+		return mintrpc.BatchState_BATCH_STATE_SPROUT_CANCELLED, nil
+
+	case tapgarden.BatchStateAwaitingSignature:
+		return mintrpc.BatchState_BATCH_STATE_AWAITING_SIGNATURE, nil
+
+	default:
+		return 0, fmt.Errorf("unknown batch state: %v",
+			currentBatchState.String())
+	}
+}
+
+// unmarshalBatchState converts the RPC batch state into its native
+// counterpart.
+func unmarshalBatchState(
+	state mintrpc.BatchState) (tapgarden.BatchState, error) {
+
+	switch state {
+	case mintrpc.BatchState_BATCH_STATE_PEDNING:
+		return tapgarden.BatchStatePending, nil
+
+	case mintrpc.BatchState_BATCH_STATE_FROZEN:
+		return tapgarden.BatchStateFrozen, nil
+
+	case mintrpc.BatchState_BATCH_STATE_COMMITTED:
+		return tapgarden.BatchStateCommitted, nil
+
+	case mintrpc.BatchState_BATCH_STATE_BROADCAST:
+		return tapgarden.BatchStateBroadcast, nil
+
+	case mintrpc.BatchState_BATCH_STATE_CONFIRMED:
+		return tapgarden.BatchStateConfirmed, nil
+
+	case mintrpc.BatchState_BATCH_STATE_FINALIZED:
+		return tapgarden.BatchStateFinalized, nil
+
+	case mintrpc.BatchState_BATCH_STATE_SEEDLING_CANCELLED:
+		return tapgarden.BatchStateSeedlingCancelled, nil
+
+	case mintrpc.BatchState_BATCH_STATE_SPROUT_CANCELLED:
+		return tapgarden.BatchStateSproutCancelled, nil
+
+	case mintrpc.BatchState_BATCH_STATE_AWAITING_SIGNATURE:
+		return tapgarden.BatchStateAwaitingSignature, nil
+
+	default:
+		return 0, fmt.Errorf("unknown batch state: %v", state)
+	}
+}
+
+// UnmarshalScriptKey parses the RPC script key into the native counterpart.
+func UnmarshalScriptKey(rpcKey *taprpc.ScriptKey) (*asset.ScriptKey, error) {
+	var (
+		scriptKey asset.ScriptKey
+		err       error
+	)
+
+	// The script public key is a Taproot key, so 32-byte x-only.
+	scriptKey.PubKey, err = schnorr.ParsePubKey(rpcKey.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// The key descriptor is optional for script keys that are completely
+	// independent of the backing wallet.
+	if rpcKey.KeyDesc != nil {
+		keyDesc, err := UnmarshalKeyDescriptor(rpcKey.KeyDesc)
+		if err != nil {
+			return nil, err
+		}
+		scriptKey.TweakedScriptKey = &asset.TweakedScriptKey{
+			RawKey: keyDesc,
+
+			// The tweak is optional, if it's empty it means the key
+			// is derived using BIP-0086.
+			Tweak: rpcKey.TapTweak,
+		}
+	}
+
+	return &scriptKey, nil
+}
+
+// marshalScriptKey marshals the native script key into the RPC counterpart.
+func marshalScriptKey(scriptKey asset.ScriptKey) *taprpc.ScriptKey {
+	rpcScriptKey := &taprpc.ScriptKey{
+		PubKey: schnorr.SerializePubKey(scriptKey.PubKey),
+	}
+
+	if scriptKey.TweakedScriptKey != nil {
+		rpcScriptKey.KeyDesc = marshalKeyDescriptor(
+			scriptKey.TweakedScriptKey.RawKey,
+		)
+		rpcScriptKey.TapTweak = scriptKey.TweakedScriptKey.Tweak
+	}
+
+	return rpcScriptKey
+}
+
+// parseUserKey parses a user-provided script or group key, which can be in
+// either the Schnorr or Compressed format.
+func parseUserKey(scriptKey []byte) (*btcec.PublicKey, error) {
+	switch len(scriptKey) {
+	case schnorr.PubKeyBytesLen:
+		return schnorr.ParsePubKey(scriptKey)
+
+	// Truncate the key and then parse as a Schnorr key.
+	case btcec.PubKeyBytesLenCompressed:
+		return schnorr.ParsePubKey(scriptKey[1:])
+
+	default:
+		return nil, fmt.Errorf("unknown script key length: %v",
+			len(scriptKey))
+	}
+}
+
+// marshalKeyDescriptor marshals the native key descriptor into the RPC
+// counterpart.
+func marshalKeyDescriptor(desc keychain.KeyDescriptor) *taprpc.KeyDescriptor {
+	return &taprpc.KeyDescriptor{
+		RawKeyBytes: desc.PubKey.SerializeCompressed(),
+		KeyLoc: &taprpc.KeyLocator{
+			KeyFamily: int32(desc.KeyLocator.Family),
+			KeyIndex:  int32(desc.KeyLocator.Index),
+		},
+	}
+}
+
+// UnmarshalKeyDescriptor parses the RPC key descriptor into the native
+// counterpart.
+func UnmarshalKeyDescriptor(
+	rpcDesc *taprpc.KeyDescriptor) (keychain.KeyDescriptor, error) {
+
+	var (
+		desc keychain.KeyDescriptor
+		err  error
+	)
+
+	// The public key of a key descriptor is mandatory. It is enough to
+	// locate the corresponding private key in the backing wallet. But to
+	// speed things up (and for additional context), the locator should
+	// still be provided if available.
+	desc.PubKey, err = btcec.ParsePubKey(rpcDesc.RawKeyBytes)
+	if err != nil {
+		return desc, err
+	}
+
+	if rpcDesc.KeyLoc != nil {
+		desc.KeyLocator = keychain.KeyLocator{
+			Family: keychain.KeyFamily(rpcDesc.KeyLoc.KeyFamily),
+			Index:  uint32(rpcDesc.KeyLoc.KeyIndex),
+		}
+	}
+
+	return desc, nil
+}
+
+// FetchAssetMeta allows a caller to fetch the reveal meta data for an asset
+// either by the asset ID for that asset, or a meta hash.
+func (r *rpcServer) FetchAssetMeta(ctx context.Context,
+	req *taprpc.FetchAssetMetaRequest) (*taprpc.AssetMeta, error) {
+
+	var (
+		assetMeta *proof.MetaReveal
+		err       error
+	)
+
+	switch {
+	case req.GetAssetId() != nil:
+		if len(req.GetAssetId()) != sha256.Size {
+			return nil, fmt.Errorf("asset ID must be 32 bytes")
+		}
+
+		var assetID asset.ID
+		copy(assetID[:], req.GetAssetId())
+
+		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaForAsset(
+			ctx, assetID,
+		)
+
+	case req.GetAssetIdStr() != "":
+		if len(req.GetAssetIdStr()) != hex.EncodedLen(sha256.Size) {
+			return nil, fmt.Errorf("asset ID must be 32 bytes")
+		}
+
+		var assetIDBytes []byte
+		assetIDBytes, err = hex.DecodeString(req.GetAssetIdStr())
+		if err != nil {
+			return nil, fmt.Errorf("error hex decoding asset ID: "+
+				"%w", err)
+		}
+
+		var assetID asset.ID
+		copy(assetID[:], assetIDBytes)
+
+		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaForAsset(
+			ctx, assetID,
+		)
+
+	case req.GetMetaHash() != nil:
+		if len(req.GetMetaHash()) != sha256.Size {
+			return nil, fmt.Errorf("meta hash must be 32 bytes")
+		}
+
+		var metaHash [asset.MetaHashLen]byte
+		copy(metaHash[:], req.GetMetaHash())
+
+		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaByHash(
+			ctx, metaHash,
+		)
+
+	case req.GetMetaHashStr() != "":
+		if len(req.GetMetaHashStr()) != hex.EncodedLen(sha256.Size) {
+			return nil, fmt.Errorf("meta hash must be 32 bytes")
+		}
+
+		var metaHashBytes []byte
+		metaHashBytes, err = hex.DecodeString(req.GetMetaHashStr())
+		if err != nil {
+			return nil, fmt.Errorf("error hex decoding meta hash: "+
+				"%w", err)
+		}
+
+		var metaHash [asset.MetaHashLen]byte
+		copy(metaHash[:], metaHashBytes)
+
+		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaByHash(
+			ctx, metaHash,
+		)
+
+	default:
+		return nil, fmt.Errorf("either asset ID or meta hash must " +
+			"be set")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch asset "+
+			"meta: %w", err)
+	}
+
+	metaHash := assetMeta.MetaHash()
+	return &taprpc.AssetMeta{
+		Data:     assetMeta.Data,
+		Type:     taprpc.AssetMetaType(assetMeta.Type),
+		MetaHash: metaHash[:],
+	}, nil
+}
+
+// MarshalUniProofType marshals the universe proof type into the RPC
+// counterpart.
+func MarshalUniProofType(
+	proofType universe.ProofType) (unirpc.ProofType, error) {
+
+	switch proofType {
+	case universe.ProofTypeUnspecified:
+		return unirpc.ProofType_PROOF_TYPE_UNSPECIFIED, nil
+	case universe.ProofTypeIssuance:
+		return unirpc.ProofType_PROOF_TYPE_ISSUANCE, nil
+	case universe.ProofTypeTransfer:
+		return unirpc.ProofType_PROOF_TYPE_TRANSFER, nil
+
+	default:
+		return 0, fmt.Errorf("unknown universe proof type: %v",
+			proofType)
+	}
+}
+
+// MarshalUniID marshals the universe ID into the RPC counterpart.
+func MarshalUniID(id universe.Identifier) (*unirpc.ID, error) {
+	var uniID unirpc.ID
+
+	if id.GroupKey != nil {
+		uniID.Id = &unirpc.ID_GroupKey{
+			GroupKey: schnorr.SerializePubKey(id.GroupKey),
+		}
+	} else {
+		uniID.Id = &unirpc.ID_AssetId{
+			AssetId: id.AssetID[:],
+		}
+	}
+
+	proofTypeRpc, err := MarshalUniProofType(id.ProofType)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal proof type: %w", err)
+	}
+	uniID.ProofType = proofTypeRpc
+
+	return &uniID, nil
+}
+
+// marshalMssmtNode marshals a MS-SMT node into the RPC counterpart.
+func marshalMssmtNode(node mssmt.Node) *unirpc.MerkleSumNode {
+	nodeHash := node.NodeHash()
+
+	return &unirpc.MerkleSumNode{
+		RootHash: nodeHash[:],
+		RootSum:  int64(node.NodeSum()),
+	}
+}
+
+// unmarshalMssmtNode parses an MS-SMT node from its RPC counterpart.
+func unmarshalMssmtNode(node *unirpc.MerkleSumNode) (mssmt.Node, error) {
+	if len(node.RootHash) != sha256.Size {
+		return nil, fmt.Errorf("root hash must be %d bytes, got %d",
+			sha256.Size, len(node.RootHash))
+	}
+	if node.RootSum < 0 {
+		return nil, fmt.Errorf("root sum must not be negative")
+	}
+
+	var nodeHash mssmt.NodeHash
+	copy(nodeHash[:], node.RootHash)
+
+	return mssmt.NewComputedNode(nodeHash, uint64(node.RootSum)), nil
+}
+
+// marshallUniverseRoot marshals the universe root into the RPC counterpart.
+func marshalUniverseRoot(node universe.BaseRoot) (*unirpc.UniverseRoot, error) {
+	// There was no old base root, so we'll just return a blank root.
+	if node.Node == nil {
+		return &unirpc.UniverseRoot{}, nil
+	}
+	mssmtRoot := marshalMssmtNode(node.Node)
+
+	rpcGroupedAssets := make(map[string]uint64, len(node.GroupedAssets))
+	for assetID, amount := range node.GroupedAssets {
+		rpcGroupedAssets[assetID.String()] = amount
+	}
+
+	uniID, err := MarshalUniID(node.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastUpdated int64
+	if !node.LastUpdated.IsZero() {
+		lastUpdated = node.LastUpdated.Unix()
+	}
+
+	rpcRoot := &unirpc.UniverseRoot{
+		Id:               uniID,
+		MssmtRoot:        mssmtRoot,
+		AssetName:        node.AssetName,
+		AmountsByAssetId: rpcGroupedAssets,
+		NumLeaves:        node.NumLeaves,
+		LastUpdated:      lastUpdated,
+		Lazy:             node.Lazy,
+	}
+	if node.BurnRoot != nil {
+		rpcRoot.BurnRoot = marshalMssmtNode(node.BurnRoot)
+	}
+
+	return rpcRoot, nil
+}
+
+// unmarshalAssetTypeFilter maps the RPC asset type filter to the asset type it
+// selects. Nil is returned if no filter is set.
+func unmarshalAssetTypeFilter(filter unirpc.AssetTypeFilter) *asset.Type {
+	switch filter {
+	case unirpc.AssetTypeFilter_FILTER_ASSET_NORMAL:
+		return fn.Ptr(asset.Normal)
+
+	case unirpc.AssetTypeFilter_FILTER_ASSET_COLLECTIBLE:
+		return fn.Ptr(asset.Collectible)
+
+	default:
+		return nil
+	}
+}
+
+// AssetRoots queries for the known Universe roots associated with each known
+// asset. These roots represent the supply/audit state for each known asset.
+func (r *rpcServer) AssetRoots(ctx context.Context,
+	req *unirpc.AssetRootRequest) (*unirpc.AssetRootResponse, error) {
+
+	if req.Offset < 0 || req.Limit < 0 {
+		return nil, fmt.Errorf("offset and limit must be non-negative")
+	}
+
+	rootsQuery := universe.RootNodesQuery{
+		IDPrefix: strings.ToLower(req.IdPrefix),
+	}
+	if err := rootsQuery.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// First, we'll retrieve the full set of known asset Universe roots
+	// that match the optional ID prefix.
+	assetRoots, err := r.cfg.BaseUniverse.RootNodes(ctx, rootsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve config for use in filtering asset roots based on sync export
+	// settings.
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Skip any assets that aren't configured for sync export or that don't
+	// match the optional asset type filter.
+	typeFilter := unmarshalAssetTypeFilter(req.AssetTypeFilter)
+	assetRoots = fn.Filter(assetRoots, func(root universe.BaseRoot) bool {
+		if typeFilter != nil && root.AssetType != *typeFilter {
+			return false
+		}
+
+		return syncConfigs.IsSyncExportEnabled(root.ID)
+	})
+
+	// We sort the roots by their namespace, so the order is stable across
+	// calls and the offset and limit can be used to page through them.
+	sort.Slice(assetRoots, func(i, j int) bool {
+		return assetRoots[i].ID.String() < assetRoots[j].ID.String()
+	})
+
+	resp := &unirpc.AssetRootResponse{
+		UniverseRoots: make(map[string]*unirpc.UniverseRoot),
+		TotalCount:    int64(len(assetRoots)),
+	}
+
+	// Now we'll select the page of roots requested.
+	start := int(req.Offset)
+	if start > len(assetRoots) {
+		start = len(assetRoots)
+	}
+	end := len(assetRoots)
+	if req.Limit > 0 && start+int(req.Limit) < end {
+		end = start + int(req.Limit)
+		resp.NextOffset = int32(end)
+	}
+
+	// For each universe root, marshal it into the RPC form, taking care to
+	// specify the proper universe ID.
+	signRoots := r.cfg.UniverseRootSigner != nil &&
+		r.cfg.UniverseSignAssetRoots
+	for _, assetRoot := range assetRoots[start:end] {
+		idStr := assetRoot.ID.String()
+
+		rpcRoot, err := marshalUniverseRoot(assetRoot)
+		if err != nil {
+			return nil, err
+		}
+
+		if signRoots && assetRoot.Node != nil {
+			rpcRoot.Signature, err = r.signRootMsg(
+				ctx, universe.UniverseRootMsg(
+					assetRoot.ID, assetRoot.Node,
+				),
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resp.UniverseRoots[idStr] = rpcRoot
+	}
+
+	if signRoots {
+		resp.SignerPubkey = r.cfg.UniverseRootSigner.PubKey().
+			SerializeCompressed()
+	}
+
+	return resp, nil
+}
+
+// defaultSearchAssetsLimit is the maximum number of universe roots returned
+// by SearchAssets if the request doesn't specify a limit.
+const defaultSearchAssetsLimit = 100
+
+// SearchAssets returns the known Universe roots of all assets with a name that
+// contains the given string, matched case-insensitively.
+func (r *rpcServer) SearchAssets(ctx context.Context,
+	req *unirpc.SearchAssetsRequest) (*unirpc.SearchAssetsResponse, error) {
+
+	if req.Offset < 0 || req.Limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset and "+
+			"limit must be non-negative")
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultSearchAssetsLimit
+	}
+
+	// We fetch one more root than requested, so we know whether there is
+	// another page of results.
+	searchQuery := universe.AssetSearchQuery{
+		Name:   req.Name,
+		Offset: int(req.Offset),
+		Limit:  limit + 1,
+	}
+	assetRoots, err := r.cfg.BaseUniverse.SearchRoots(ctx, searchQuery)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to search "+
+			"universe roots: %v", err)
+	}
+
+	resp := &unirpc.SearchAssetsResponse{}
+	if len(assetRoots) > limit {
+		assetRoots = assetRoots[:limit]
+		resp.NextOffset = req.Offset + int32(limit)
+	}
+
+	// Retrieve config for use in filtering asset roots based on sync export
+	// settings. Filtered roots still count towards the page, so the next
+	// offset stays consistent with the database ordering.
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, assetRoot := range assetRoots {
+		if !syncConfigs.IsSyncExportEnabled(assetRoot.ID) {
+			continue
+		}
+
+		rpcRoot, err := marshalUniverseRoot(assetRoot)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Roots = append(resp.Roots, rpcRoot)
+	}
+
+	return resp, nil
+}
+
+// signRootMsg signs the given universe root message with the configured root
+// signer.
+func (r *rpcServer) signRootMsg(ctx context.Context,
+	msg []byte) ([]byte, error) {
+
+	sig, err := r.cfg.UniverseRootSigner.SignRootMsg(ctx, msg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to sign "+
+			"universe root: %v", err)
+	}
+
+	return sig, nil
+}
+
+// UnmarshalUniProofType parses the RPC universe proof type into the native
+// counterpart.
+func UnmarshalUniProofType(rpcType unirpc.ProofType) (universe.ProofType,
+	error) {
+
+	switch rpcType {
+	case unirpc.ProofType_PROOF_TYPE_UNSPECIFIED:
+		return universe.ProofTypeUnspecified, nil
+
+	case unirpc.ProofType_PROOF_TYPE_ISSUANCE:
+		return universe.ProofTypeIssuance, nil
+
+	case unirpc.ProofType_PROOF_TYPE_TRANSFER:
+		return universe.ProofTypeTransfer, nil
+
+	default:
+		return 0, fmt.Errorf("unknown universe proof type: %v", rpcType)
+	}
+}
+
+// unmarshalAssetSyncConfig parses the RPC asset sync config into the native
+// counterpart.
+func unmarshalAssetSyncConfig(
+	config *unirpc.AssetFederationSyncConfig) (*universe.FedUniSyncConfig,
+	error) {
+
+	if config == nil {
+		return nil, fmt.Errorf("empty universe sync config")
+	}
+
+	// Parse the universe ID from the RPC form.
+	uniID, err := UnmarshalUniID(config.Id)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse universe id: %w",
+			err)
+	}
+
+	return &universe.FedUniSyncConfig{
+		UniverseID:      uniID,
+		AllowSyncInsert: config.AllowSyncInsert,
+		AllowSyncExport: config.AllowSyncExport,
+	}, nil
+}
+
+// UnmarshalUniID parses the RPC universe ID into the native counterpart.
+func UnmarshalUniID(rpcID *unirpc.ID) (universe.Identifier, error) {
+	if rpcID == nil {
+		return universe.Identifier{}, fmt.Errorf("missing universe id")
+	}
+
+	// Unmarshal the proof type.
+	proofType, err := UnmarshalUniProofType(rpcID.ProofType)
+	if err != nil {
+		return universe.Identifier{}, fmt.Errorf("unable to unmarshal "+
+			"proof type: %w", err)
+	}
+	switch {
+	case rpcID.GetAssetId() != nil:
+		var assetID asset.ID
+		copy(assetID[:], rpcID.GetAssetId())
+
+		return universe.Identifier{
+			AssetID:   assetID,
+			ProofType: proofType,
+		}, nil
+
+	case rpcID.GetAssetIdStr() != "":
+		assetIDBytes, err := hex.DecodeString(rpcID.GetAssetIdStr())
+		if err != nil {
+			return universe.Identifier{}, err
+		}
+
+		// TODO(roasbeef): reuse with above
+
+		var assetID asset.ID
+		copy(assetID[:], assetIDBytes)
+
+		return universe.Identifier{
+			AssetID:   assetID,
+			ProofType: proofType,
+		}, nil
+
+	case rpcID.GetGroupKey() != nil:
+		groupKey, err := parseUserKey(rpcID.GetGroupKey())
+		if err != nil {
+			return universe.Identifier{}, err
+		}
+
+		return universe.Identifier{
+			GroupKey:  groupKey,
+			ProofType: proofType,
+		}, nil
+
+	case rpcID.GetGroupKeyStr() != "":
+		groupKeyBytes, err := hex.DecodeString(rpcID.GetGroupKeyStr())
+		if err != nil {
+			return universe.Identifier{}, err
+		}
+
+		// TODO(roasbeef): reuse with above
+
+		groupKey, err := parseUserKey(groupKeyBytes)
+		if err != nil {
+			return universe.Identifier{}, err
+		}
+
+		return universe.Identifier{
+			GroupKey:  groupKey,
+			ProofType: proofType,
+		}, nil
+
+	default:
+		return universe.Identifier{}, fmt.Errorf("no id set")
+	}
+}
+
+// QueryAssetRoots attempts to locate the current Universe root for a specific
+// asset. This asset can be identified by its asset ID or group key.
+func (r *rpcServer) QueryAssetRoots(ctx context.Context,
+	req *unirpc.AssetRootQuery) (*unirpc.QueryRootResponse, error) {
+
+	universeID, err := UnmarshalUniID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	universeID.ProofType = universe.ProofTypeIssuance
+
+	// Ensure proof export is enabled for the given universe.
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !syncConfigs.IsSyncExportEnabled(universeID) {
+		return nil, fmt.Errorf("proof export is disabled for the " +
+			"given universe")
+	}
+
+	roots, _, err := r.fetchAssetRoots(ctx, universeID, req.AtHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return roots, nil
+}
+
+// fetchAssetRoots fetches the issuance and the transfer root of the asset
+// identified by the given universe ID. If a block height is given, then the
+// roots as they were at that height are returned instead. A root that isn't
+// known is returned as a blank root, and the returned boolean indicates
+// whether any of the two roots is known.
+func (r *rpcServer) fetchAssetRoots(ctx context.Context,
+	universeID universe.Identifier,
+	atHeight uint32) (*unirpc.QueryRootResponse, bool, error) {
+
+	// If a block height was specified, then we'll look up the roots as
+	// they were at that height instead of the current ones.
+	fetchRoot := func(id universe.Identifier) (universe.BaseRoot, error) {
+		if atHeight != 0 {
+			return r.cfg.BaseUniverse.RootNodeAtHeight(
+				ctx, id, atHeight,
+			)
+		}
+
+		return r.cfg.BaseUniverse.RootNode(ctx, id)
+	}
+
+	// Attempt to retrieve the issuance universe root.
+	rpcsLog.Debugf("Querying for asset (group) issuance universe root "+
+		"for %v", spew.Sdump(universeID))
+
+	universeID.ProofType = universe.ProofTypeIssuance
+
+	issuanceRoot, err := fetchRoot(universeID)
+	if err != nil {
+		// Do not return at this point if the error only indicates that
+		// the root wasn't found. We'll try to find the transfer root
+		// below.
+		if !errors.Is(err, universe.ErrNoUniverseRoot) {
+			return nil, false, err
 		}
+	}
 
-		latestProofIndex := uint32(proofFile.NumProofs() - 1)
-		if req.ProofAtDepth > latestProofIndex {
-			return nil, fmt.Errorf("invalid depth %d is greater "+
-				"than latest proof index of %d",
-				req.ProofAtDepth, latestProofIndex)
+	issuanceRootRPC, err := marshalUniverseRoot(issuanceRoot)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// Attempt to retrieve the transfer universe root.
+	rpcsLog.Debugf("Querying for asset (group) transfer universe root "+
+		"for %v", spew.Sdump(universeID))
+
+	universeID.ProofType = universe.ProofTypeTransfer
+
+	transferRoot, err := fetchRoot(universeID)
+	if err != nil {
+		// Do not return at this point if the error only indicates that
+		// the root wasn't found. We may have found the issuance root
+		// above.
+		if !errors.Is(err, universe.ErrNoUniverseRoot) {
+			return nil, false, err
 		}
+	}
 
-		// Default to latest proof.
-		index := latestProofIndex - req.ProofAtDepth
-		p, err := proofFile.ProofAt(index)
+	transferRootRPC, err := marshalUniverseRoot(transferRoot)
+	if err != nil {
+		return nil, false, err
+	}
+
+	found := issuanceRoot.Node != nil || transferRoot.Node != nil
+
+	return &unirpc.QueryRootResponse{
+		IssuanceRoot: issuanceRootRPC,
+		TransferRoot: transferRootRPC,
+	}, found, nil
+}
+
+// QueryAssetRootsBatch attempts to locate the current Universe roots for a
+// list of assets in a single call. The results are returned in the same order
+// as the given IDs.
+func (r *rpcServer) QueryAssetRootsBatch(ctx context.Context,
+	req *unirpc.QueryAssetRootsBatchRequest) (
+	*unirpc.QueryAssetRootsBatchResponse, error) {
+
+	if len(req.Ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one "+
+			"ID must be provided")
+	}
+	if len(req.Ids) > maxQueryAssetRootsBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "too many "+
+			"IDs, max is %d", maxQueryAssetRootsBatchSize)
+	}
+
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &unirpc.QueryAssetRootsBatchResponse{
+		Results: make([]*unirpc.AssetRootsResult, 0, len(req.Ids)),
+	}
+	for idx, rpcID := range req.Ids {
+		universeID, err := UnmarshalUniID(rpcID)
 		if err != nil {
-			return nil, err
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid ID at index %d: %v", idx, err)
 		}
+		universeID.ProofType = universe.ProofTypeIssuance
 
-		rpcProof, err = r.marshalProof(
-			ctx, p, req.WithPrevWitnesses,
-			req.WithMetaReveal,
+		// A universe we don't export proofs for is reported as not
+		// found, just like one we don't know about.
+		result := &unirpc.AssetRootsResult{
+			Id: rpcID,
+		}
+		if !syncConfigs.IsSyncExportEnabled(universeID) {
+			resp.Results = append(resp.Results, result)
+			continue
+		}
+
+		roots, found, err := r.fetchAssetRoots(
+			ctx, universeID, req.AtHeight,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("unable to marshal proof: %w",
-				err)
+			return nil, err
 		}
 
-		rpcProof.ProofAtDepth = req.ProofAtDepth
-		rpcProof.NumberOfProofs = uint32(proofFile.NumProofs())
+		if found {
+			result.Found = true
+			result.IssuanceRoot = roots.IssuanceRoot
+			result.TransferRoot = roots.TransferRoot
+		}
 
-	default:
-		return nil, fmt.Errorf("invalid raw proof, could not " +
-			"identify decoding format")
+		resp.Results = append(resp.Results, result)
 	}
 
-	return &taprpc.DecodeProofResponse{
-		DecodedProof: rpcProof,
-	}, nil
+	return resp, nil
 }
 
-// marshalProof turns a transition proof into an RPC DecodedProof.
-func (r *rpcServer) marshalProof(ctx context.Context, p *proof.Proof,
-	withPrevWitnesses, withMetaReveal bool) (*taprpc.DecodedProof, error) {
+// QueryAssetGenesis returns the genesis information of an asset, or of each
+// asset within a group, as recorded in the issuance leaves of its Universe.
+func (r *rpcServer) QueryAssetGenesis(ctx context.Context,
+	req *unirpc.QueryAssetGenesisRequest) (
+	*unirpc.QueryAssetGenesisResponse, error) {
 
-	var (
-		rpcMeta        *taprpc.AssetMeta
-		rpcGenesis     = p.GenesisReveal
-		rpcGroupKey    = p.GroupKeyReveal
-		anchorOutpoint = wire.OutPoint{
-			Hash:  p.AnchorTx.TxHash(),
-			Index: p.InclusionProof.OutputIndex,
-		}
-		txMerkleProof  = p.TxMerkleProof
-		inclusionProof = p.InclusionProof
-		splitRootProof = p.SplitRootProof
-	)
+	universeID, err := UnmarshalUniID(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	universeID.ProofType = universe.ProofTypeIssuance
 
-	var txMerkleProofBuf bytes.Buffer
-	if err := txMerkleProof.Encode(&txMerkleProofBuf); err != nil {
-		return nil, fmt.Errorf("unable to encode serialized Bitcoin "+
-			"merkle proof: %w", err)
+	// Ensure proof export is enabled for the given universe.
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	var inclusionProofBuf bytes.Buffer
-	if err := inclusionProof.Encode(&inclusionProofBuf); err != nil {
-		return nil, fmt.Errorf("unable to encode inclusion proof: %w",
-			err)
+	if !syncConfigs.IsSyncExportEnabled(universeID) {
+		return nil, fmt.Errorf("proof export is disabled for the " +
+			"given universe")
 	}
 
-	if inclusionProof.CommitmentProof == nil {
-		return nil, fmt.Errorf("inclusion proof is missing " +
-			"commitment proof")
+	leaves, err := r.cfg.BaseUniverse.MintingLeaves(ctx, universeID)
+	switch {
+	case errors.Is(err, universe.ErrNoUniverseRoot):
+		leaves = nil
+
+	case err != nil:
+		return nil, err
 	}
-	tsSibling, tsHash, err := commitment.MaybeEncodeTapscriptPreimage(
-		inclusionProof.CommitmentProof.TapSiblingPreimage,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("error encoding tapscript sibling: %w",
-			err)
+
+	if len(leaves) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no issuance leaf "+
+			"found for universe %v", universeID.StringForLog())
 	}
 
-	tapProof, err := inclusionProof.CommitmentProof.DeriveByAssetInclusion(
-		&p.Asset,
+	// Multiple issuance leaves can share the same genesis, so we only
+	// return each asset once.
+	var (
+		assetIDs    []asset.ID
+		genesisByID = make(map[asset.ID]asset.Genesis)
 	)
-	if err != nil {
-		return nil, fmt.Errorf("error deriving inclusion proof: %w",
-			err)
+	for _, leaf := range leaves {
+		assetID := leaf.Genesis.ID()
+		if _, ok := genesisByID[assetID]; ok {
+			continue
+		}
+
+		genesisByID[assetID] = leaf.Genesis
+		assetIDs = append(assetIDs, assetID)
 	}
-	merkleRoot := tapProof.TapscriptRoot(tsHash)
 
-	var exclusionProofs [][]byte
-	for _, exclusionProof := range p.ExclusionProofs {
-		var exclusionProofBuf bytes.Buffer
-		err := exclusionProof.Encode(&exclusionProofBuf)
-		if err != nil {
-			return nil, fmt.Errorf("unable to encode exclusion "+
-				"proofs: %w", err)
-		}
-		exclusionProofs = append(
-			exclusionProofs, exclusionProofBuf.Bytes(),
+	sort.Slice(assetIDs, func(i, j int) bool {
+		return bytes.Compare(assetIDs[i][:], assetIDs[j][:]) < 0
+	})
+
+	resp := &unirpc.QueryAssetGenesisResponse{}
+	for _, assetID := range assetIDs {
+		gen := genesisByID[assetID]
+		resp.GenesisInfos = append(
+			resp.GenesisInfos, &taprpc.GenesisInfo{
+				GenesisPoint: gen.FirstPrevOut.String(),
+				Name:         gen.Tag,
+				MetaHash:     gen.MetaHash[:],
+				AssetId:      fn.ByteSlice(assetID),
+				OutputIndex:  gen.OutputIndex,
+				AssetType:    taprpc.AssetType(gen.Type),
+			},
 		)
 	}
 
-	var splitRootProofBuf bytes.Buffer
-	if splitRootProof != nil {
-		err := splitRootProof.Encode(&splitRootProofBuf)
-		if err != nil {
-			return nil, fmt.Errorf("unable to encode split root "+
-				"proof: %w", err)
-		}
+	return resp, nil
+}
+
+// MultiverseRoot returns the root of the multiverse tree for the given proof
+// type. The multiverse tree commits to all the Universe roots known to the
+// server.
+func (r *rpcServer) MultiverseRoot(ctx context.Context,
+	req *unirpc.MultiverseRootRequest) (*unirpc.MultiverseRootResponse,
+	error) {
+
+	proofType, err := UnmarshalUniProofType(req.ProofType)
+	if err != nil {
+		return nil, err
+	}
+	if proofType == universe.ProofTypeUnspecified {
+		return nil, fmt.Errorf("proof type must be specified")
 	}
 
-	rpcAsset, err := r.marshalChainAsset(ctx, &tapdb.ChainAsset{
-		Asset:                  &p.Asset,
-		AnchorTx:               &p.AnchorTx,
-		AnchorTxid:             p.AnchorTx.TxHash(),
-		AnchorBlockHash:        p.BlockHeader.BlockHash(),
-		AnchorBlockHeight:      p.BlockHeight,
-		AnchorOutpoint:         anchorOutpoint,
-		AnchorInternalKey:      p.InclusionProof.InternalKey,
-		AnchorMerkleRoot:       merkleRoot[:],
-		AnchorTapscriptSibling: tsSibling,
-	}, withPrevWitnesses)
+	multiverseRoot, err := r.cfg.BaseUniverse.MultiverseRoot(
+		ctx, proofType,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	if withMetaReveal {
-		metaHash := rpcAsset.AssetGenesis.MetaHash
-		if len(metaHash) == 0 {
-			return nil, fmt.Errorf("asset does not contain meta " +
-				"data")
-		}
+	resp := &unirpc.MultiverseRootResponse{
+		MultiverseRoot: marshalMssmtNode(multiverseRoot.Node),
+	}
 
-		rpcMeta, err = r.FetchAssetMeta(
-			ctx, &taprpc.FetchAssetMetaRequest{
-				Asset: &taprpc.FetchAssetMetaRequest_MetaHash{
-					MetaHash: metaHash,
-				},
-			},
+	// If enabled, we'll sign the root so the caller can verify it was
+	// served by us.
+	if r.cfg.UniverseRootSigner != nil {
+		resp.Signature, err = r.signRootMsg(
+			ctx, universe.MultiverseRootMsg(
+				proofType, multiverseRoot.Node,
+			),
 		)
 		if err != nil {
 			return nil, err
 		}
+
+		resp.SignerPubkey = r.cfg.UniverseRootSigner.PubKey().
+			SerializeCompressed()
 	}
 
-	decodedAssetID := p.Asset.ID()
-	var genesisReveal *taprpc.GenesisReveal
-	if rpcGenesis != nil {
-		genesisReveal = &taprpc.GenesisReveal{
-			GenesisBaseReveal: &taprpc.GenesisInfo{
-				GenesisPoint: rpcGenesis.FirstPrevOut.String(),
-				Name:         rpcGenesis.Tag,
-				MetaHash:     rpcGenesis.MetaHash[:],
-				AssetId:      decodedAssetID[:],
-				OutputIndex:  rpcGenesis.OutputIndex,
-			},
-			AssetType: taprpc.AssetType(p.Asset.Type),
-		}
+	return resp, nil
+}
+
+// DeleteAssetRoot attempts to locate the current Universe root for a specific
+// asset, and deletes the associated Universe tree if found.
+func (r *rpcServer) DeleteAssetRoot(ctx context.Context,
+	req *unirpc.DeleteRootQuery) (*unirpc.DeleteRootResponse, error) {
+
+	universeID, err := UnmarshalUniID(req.Id)
+	if err != nil {
+		return nil, err
 	}
 
-	var GroupKeyReveal taprpc.GroupKeyReveal
-	if rpcGroupKey != nil {
-		GroupKeyReveal = taprpc.GroupKeyReveal{
-			RawGroupKey:   rpcGroupKey.RawKey[:],
-			TapscriptRoot: rpcGroupKey.TapscriptRoot,
+	rpcsLog.Debugf("Deleting asset root for %v", spew.Sdump(universeID))
+
+	// If the universe proof type is unspecified, we'll delete both the
+	// issuance and transfer roots.
+	if universeID.ProofType == universe.ProofTypeUnspecified {
+		universeID.ProofType = universe.ProofTypeIssuance
+		_, err := r.cfg.BaseUniverse.DeleteRoot(ctx, universeID)
+		if err != nil {
+			return nil, err
 		}
-	}
 
-	return &taprpc.DecodedProof{
-		Asset:               rpcAsset,
-		MetaReveal:          rpcMeta,
-		TxMerkleProof:       txMerkleProofBuf.Bytes(),
-		InclusionProof:      inclusionProofBuf.Bytes(),
-		ExclusionProofs:     exclusionProofs,
-		SplitRootProof:      splitRootProofBuf.Bytes(),
-		NumAdditionalInputs: uint32(len(p.AdditionalInputs)),
-		ChallengeWitness:    p.ChallengeWitness,
-		IsBurn:              p.Asset.IsBurn(),
-		GenesisReveal:       genesisReveal,
-		GroupKeyReveal:      &GroupKeyReveal,
-	}, nil
+		universeID.ProofType = universe.ProofTypeTransfer
+		_, err = r.cfg.BaseUniverse.DeleteRoot(ctx, universeID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &unirpc.DeleteRootResponse{}, nil
+	}
+
+	// At this point the universe proof type was specified, so we'll only
+	// delete the root for that proof type.
+	_, err = r.cfg.BaseUniverse.DeleteRoot(ctx, universeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.DeleteRootResponse{}, nil
 }
 
-// ExportProof exports the latest raw proof file anchored at the specified
-// script_key.
-func (r *rpcServer) ExportProof(ctx context.Context,
-	req *taprpc.ExportProofRequest) (*taprpc.ProofFile, error) {
+// DeleteUniverseLeaf deletes a single leaf from a local Universe tree, and
+// returns the new Universe root. Deleting a leaf that doesn't exist is a
+// no-op.
+func (r *rpcServer) DeleteUniverseLeaf(ctx context.Context,
+	req *unirpc.DeleteUniverseLeafRequest) (
+	*unirpc.DeleteUniverseLeafResponse, error) {
 
-	if len(req.ScriptKey) == 0 {
-		return nil, fmt.Errorf("a valid script key must be specified")
+	if req.Key == nil {
+		return nil, fmt.Errorf("key cannot be nil")
 	}
 
-	scriptKey, err := parseUserKey(req.ScriptKey)
+	universeID, err := UnmarshalUniID(req.Key.Id)
 	if err != nil {
-		return nil, fmt.Errorf("invalid script key: %w", err)
+		return nil, err
+	}
+	if universeID.ProofType == universe.ProofTypeUnspecified {
+		return nil, fmt.Errorf("proof type must be specified")
 	}
 
-	if len(req.AssetId) != 32 {
-		return nil, fmt.Errorf("asset ID must be 32 bytes")
+	leafKey, err := unmarshalLeafKey(req.Key.LeafKey)
+	if err != nil {
+		return nil, err
 	}
 
-	var assetID asset.ID
-	copy(assetID[:], req.AssetId)
+	rpcsLog.Debugf("Deleting universe leaf (universeID=%v, leafKey=%x)",
+		universeID, leafKey.UniverseKey())
 
-	proofBlob, err := r.cfg.ProofArchive.FetchProof(ctx, proof.Locator{
-		AssetID:   &assetID,
-		ScriptKey: *scriptKey,
-	})
+	newRoot, err := r.cfg.BaseUniverse.DeleteLeaf(ctx, universeID, leafKey)
 	if err != nil {
 		return nil, err
 	}
 
-	return &taprpc.ProofFile{
-		RawProofFile: proofBlob,
+	uniRoot, err := marshalUniverseRoot(newRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.DeleteUniverseLeafResponse{
+		UniverseRoot: uniRoot,
 	}, nil
 }
 
-// ImportProof attempts to import a proof file into the daemon. If successful, a
-// new asset will be inserted on disk, spendable using the specified target
-// script key, and internal key.
-func (r *rpcServer) ImportProof(ctx context.Context,
-	req *tapdevrpc.ImportProofRequest) (*tapdevrpc.ImportProofResponse,
-	error) {
-
-	// We'll perform some basic input validation before we move forward.
-	if len(req.ProofFile) == 0 {
-		return nil, fmt.Errorf("proof file must be specified")
+func marshalLeafKey(leafKey universe.LeafKey) *unirpc.AssetKey {
+	return &unirpc.AssetKey{
+		Outpoint: &unirpc.AssetKey_OpStr{
+			OpStr: leafKey.OutPoint.String(),
+		},
+		ScriptKey: &unirpc.AssetKey_ScriptKeyBytes{
+			ScriptKeyBytes: schnorr.SerializePubKey(
+				leafKey.ScriptKey.PubKey,
+			),
+		},
 	}
+}
 
-	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
-	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
+// AssetLeafKeys queries for the set of Universe keys associated with a given
+// asset_id or group_key. Each key takes the form: (outpoint, script_key),
+// where outpoint is an outpoint in the Bitcoin blockchain that anchors a valid
+// Taproot Asset commitment, and script_key is the script_key of the asset
+// within the Taproot Asset commitment for the given asset_id or group_key.
+func (r *rpcServer) AssetLeafKeys(ctx context.Context,
+	req *unirpc.ID) (*unirpc.AssetLeafKeyResponse, error) {
 
-	// Now that we know the proof file is at least present, we'll attempt
-	// to import it into the main archive.
-	err := r.cfg.ProofArchive.ImportProofs(
-		ctx, headerVerifier, groupVerifier, false,
-		&proof.AnnotatedProof{Blob: req.ProofFile},
-	)
+	universeID, err := UnmarshalUniID(req)
 	if err != nil {
 		return nil, err
 	}
 
-	return &tapdevrpc.ImportProofResponse{}, nil
-}
-
-// AddrReceives lists all receives for incoming asset transfers for addresses
-// that were created previously.
-func (r *rpcServer) AddrReceives(ctx context.Context,
-	req *taprpc.AddrReceivesRequest) (*taprpc.AddrReceivesResponse,
-	error) {
-
-	var sqlQuery address.EventQueryParams
+	// TODO(roasbeef): tell above if was tring or not, then would set
+	// below diff
 
-	if len(req.FilterAddr) > 0 {
-		tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+	leafKeys, err := r.cfg.BaseUniverse.UniverseLeafKeys(ctx, universeID)
+	if err != nil {
+		return nil, err
+	}
 
-		addr, err := address.DecodeAddress(req.FilterAddr, &tapParams)
-		if err != nil {
-			return nil, fmt.Errorf("unable to decode addr: %w", err)
-		}
+	resp := &unirpc.AssetLeafKeyResponse{
+		AssetKeys: make([]*unirpc.AssetKey, len(leafKeys)),
+	}
 
-		// Now that we've decoded the address, we'll check to make sure
-		// that we can fetch the genesis for this address. Otherwise,
-		// that means we don't know anything about what it should look
-		// like on chain (the genesis is required to derive the taproot
-		// output key).
-		assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(
-			ctx, addr.AssetID,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unknown asset=%x: %w",
-				addr.AssetID[:], err)
-		}
+	for i, leafKey := range leafKeys {
+		resp.AssetKeys[i] = marshalLeafKey(leafKey)
+	}
 
-		rpcsLog.Tracef("Listing receives for group: %v",
-			spew.Sdump(assetGroup))
+	return resp, nil
+}
 
-		addr.AttachGenesis(*assetGroup.Genesis)
+// AssetLeafKeysSince queries for the set of Universe keys that were inserted
+// into the Universe of a given asset_id or group_key after the Universe had the
+// given root hash.
+func (r *rpcServer) AssetLeafKeysSince(ctx context.Context,
+	req *unirpc.AssetLeafKeysSinceRequest) (
+	*unirpc.AssetLeafKeysSinceResponse, error) {
 
-		taprootOutputKey, err := addr.TaprootOutputKey()
-		if err != nil {
-			return nil, fmt.Errorf("error deriving Taproot key: %w",
-				err)
-		}
+	universeID, err := UnmarshalUniID(req.Id)
+	if err != nil {
+		return nil, err
+	}
 
-		sqlQuery.AddrTaprootOutputKey = schnorr.SerializePubKey(
-			taprootOutputKey,
-		)
+	if len(req.RootHash) != sha256.Size {
+		return nil, fmt.Errorf("root hash must be %d bytes",
+			sha256.Size)
 	}
 
-	if req.FilterStatus != taprpc.AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN {
-		status, err := unmarshalAddrEventStatus(req.FilterStatus)
-		if err != nil {
-			return nil, fmt.Errorf("error parsing status: %w", err)
-		}
+	var rootHash mssmt.NodeHash
+	copy(rootHash[:], req.RootHash)
 
-		sqlQuery.StatusFrom = &status
-		sqlQuery.StatusTo = &status
-	}
+	leafKeys, err := r.cfg.BaseUniverse.UniverseLeafKeysSince(
+		ctx, universeID, rootHash,
+	)
+	switch {
+	// An unknown root isn't an error, the caller is expected to fall back
+	// to a full sync instead.
+	case errors.Is(err, universe.ErrUnknownUniverseRoot):
+		return &unirpc.AssetLeafKeysSinceResponse{
+			UnknownRoot: true,
+		}, nil
 
-	events, err := r.cfg.AddrBook.QueryEvents(ctx, sqlQuery)
-	if err != nil {
-		return nil, fmt.Errorf("error querying events: %w", err)
+	case err != nil:
+		return nil, err
 	}
 
-	resp := &taprpc.AddrReceivesResponse{
-		Events: make([]*taprpc.AddrEvent, len(events)),
+	resp := &unirpc.AssetLeafKeysSinceResponse{
+		AssetKeys: make([]*unirpc.AssetKey, len(leafKeys)),
 	}
-
-	for idx, event := range events {
-		resp.Events[idx], err = marshalAddrEvent(
-			event, r.cfg.TapAddrBook,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling event: %w",
-				err)
-		}
+	for i, leafKey := range leafKeys {
+		resp.AssetKeys[i] = marshalLeafKey(leafKey)
 	}
 
 	return resp, nil
 }
 
-// FundVirtualPsbt selects inputs from the available asset commitments to fund
-// a virtual transaction matching the template.
-func (r *rpcServer) FundVirtualPsbt(ctx context.Context,
-	req *wrpc.FundVirtualPsbtRequest) (*wrpc.FundVirtualPsbtResponse,
-	error) {
-
-	var fundedVPkt *tapfreighter.FundedVPacket
-	switch {
-	case req.GetPsbt() != nil:
-		vPkt, err := tappsbt.NewFromRawBytes(
-			bytes.NewReader(req.GetPsbt()), false,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to decode psbt: %w", err)
-		}
-
-		// Extract the recipient information from the packet. This
-		// basically assembles the asset ID we want to send to and the
-		// sum of all output amounts.
-		desc, err := tapscript.DescribeRecipients(
-			ctx, vPkt, r.cfg.TapAddrBook,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to describe packet "+
-				"recipients: %w", err)
-		}
+func marshalAssetLeaf(ctx context.Context, keys taprpc.KeyLookup,
+	assetLeaf *universe.Leaf) (*unirpc.AssetLeaf, error) {
 
-		fundedVPkt, err = r.cfg.AssetWallet.FundPacket(
-			ctx, desc, vPkt,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("error funding packet: %w", err)
-		}
+	rpcLeaf := marshalAssetLeafKey(assetLeaf)
 
-	case req.GetRaw() != nil:
-		raw := req.GetRaw()
-		if len(raw.Inputs) > 0 {
-			return nil, fmt.Errorf("template inputs not yet " +
-				"supported")
-		}
-		if len(raw.Recipients) > 1 {
-			return nil, fmt.Errorf("only one recipient supported")
-		}
+	// In order to display the full asset, we'll also encode the genesis
+	// proof.
+	var buf bytes.Buffer
+	if err := assetLeaf.Proof.Encode(&buf); err != nil {
+		return nil, err
+	}
 
-		var (
-			tapParams = address.ParamsForChain(
-				r.cfg.ChainParams.Name,
-			)
-			addr *address.Tap
-			err  error
-		)
-		for a := range raw.Recipients {
-			addr, err = address.DecodeAddress(a, &tapParams)
-			if err != nil {
-				return nil, fmt.Errorf("unable to decode "+
-					"addr: %w", err)
-			}
-		}
+	rpcAsset, err := taprpc.MarshalAsset(
+		ctx, &assetLeaf.Proof.Asset, false, true, keys,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-		if addr == nil {
-			return nil, fmt.Errorf("no recipients specified")
-		}
+	rpcLeaf.Asset = rpcAsset
+	rpcLeaf.IssuanceProof = buf.Bytes()
 
-		fundedVPkt, _, err = r.cfg.AssetWallet.FundAddressSend(
-			ctx, addr,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("error funding address send: "+
-				"%w", err)
-		}
+	return rpcLeaf, nil
+}
 
-	default:
-		return nil, fmt.Errorf("either PSBT or raw template must be " +
-			"specified")
+// marshalAssetLeafKey marshals the key and amount of an asset leaf into the
+// RPC form, without the asset and proof.
+func marshalAssetLeafKey(assetLeaf *universe.Leaf) *unirpc.AssetLeaf {
+	return &unirpc.AssetLeaf{
+		LeafKey: marshalLeafKey(assetLeafKey(assetLeaf)),
+		Amount:  assetLeaf.Amt,
 	}
+}
 
-	var b bytes.Buffer
-	if err := fundedVPkt.VPacket.Serialize(&b); err != nil {
-		return nil, fmt.Errorf("error serializing packet: %w", err)
+// assetLeafKey returns the key of the given leaf within its universe tree,
+// which is made up of the outpoint the asset is anchored at and its script
+// key.
+func assetLeafKey(assetLeaf *universe.Leaf) universe.LeafKey {
+	return universe.LeafKey{
+		OutPoint:  assetLeaf.Proof.OutPoint(),
+		ScriptKey: &assetLeaf.Proof.Asset.ScriptKey,
 	}
+}
+
+// marshalAssetLeaf marshals an asset leaf into the RPC form.
+func (r *rpcServer) marshalAssetLeaf(ctx context.Context,
+	assetLeaf *universe.Leaf) (*unirpc.AssetLeaf, error) {
 
-	return &wrpc.FundVirtualPsbtResponse{
-		FundedPsbt:        b.Bytes(),
-		ChangeOutputIndex: 0,
-	}, nil
+	return marshalAssetLeaf(ctx, r.cfg.AddrBook, assetLeaf)
 }
 
-// SignVirtualPsbt signs the inputs of a virtual transaction and prepares the
-// commitments of the inputs and outputs.
-func (r *rpcServer) SignVirtualPsbt(_ context.Context,
-	req *wrpc.SignVirtualPsbtRequest) (*wrpc.SignVirtualPsbtResponse,
-	error) {
+// AssetLeaves queries for the set of asset leaves (the values in the Universe
+// MS-SMT tree) for a given asset_id or group_key. These represents either
+// asset issuance events (they have a genesis witness) or asset transfers that
+// took place on chain. Each leaf contains its leaf key and amount, and
+// optionally a normal Taproot asset proof, as well as details for the asset.
+func (r *rpcServer) AssetLeaves(ctx context.Context,
+	req *unirpc.AssetLeavesRequest) (*unirpc.AssetLeafResponse, error) {
 
-	if req.FundedPsbt == nil {
-		return nil, fmt.Errorf("request cannot be nil")
+	if req.Offset < 0 || req.Limit < 0 {
+		return nil, fmt.Errorf("offset and limit must be non-negative")
 	}
 
-	vPkt, err := tappsbt.NewFromRawBytes(
-		bytes.NewReader(req.FundedPsbt), false,
-	)
+	universeID, err := UnmarshalUniID(req.Id)
 	if err != nil {
-		return nil, fmt.Errorf("error decoding packet: %w", err)
+		return nil, err
 	}
 
-	signedInputs, err := r.cfg.AssetWallet.SignVirtualPacket(vPkt)
+	assetLeaves, err := r.cfg.BaseUniverse.MintingLeaves(ctx, universeID)
 	if err != nil {
-		return nil, fmt.Errorf("error signing packet: %w", err)
+		return nil, err
 	}
 
-	var b bytes.Buffer
-	if err := vPkt.Serialize(&b); err != nil {
-		return nil, fmt.Errorf("error serializing packet: %w", err)
+	// We sort the leaves by their universe key, which is the order they
+	// have within the universe tree. This keeps the order stable across
+	// calls, so the offset and limit can be used to page through them.
+	sort.Slice(assetLeaves, func(i, j int) bool {
+		keyI := assetLeafKey(&assetLeaves[i]).UniverseKey()
+		keyJ := assetLeafKey(&assetLeaves[j]).UniverseKey()
+		return bytes.Compare(keyI[:], keyJ[:]) < 0
+	})
+
+	resp := &unirpc.AssetLeafResponse{
+		TotalCount: int64(len(assetLeaves)),
 	}
 
-	return &wrpc.SignVirtualPsbtResponse{
-		SignedPsbt:   b.Bytes(),
-		SignedInputs: signedInputs,
-	}, nil
-}
+	// Now we'll select the page of leaves requested.
+	start := int(req.Offset)
+	if start > len(assetLeaves) {
+		start = len(assetLeaves)
+	}
+	end := len(assetLeaves)
+	if req.Limit > 0 && start+int(req.Limit) < end {
+		end = start + int(req.Limit)
+		resp.NextOffset = int32(end)
+	}
 
-// AnchorVirtualPsbts merges and then commits multiple virtual transactions in
-// a single BTC level anchor transaction.
-//
-// TODO(guggero): Actually implement accepting and merging multiple
-// transactions.
-func (r *rpcServer) AnchorVirtualPsbts(ctx context.Context,
-	req *wrpc.AnchorVirtualPsbtsRequest) (*taprpc.SendAssetResponse,
-	error) {
+	resp.Leaves = make([]*unirpc.AssetLeaf, 0, end-start)
+	for i := range assetLeaves[start:end] {
+		assetLeaf := &assetLeaves[start+i]
 
-	if len(req.VirtualPsbts) == 0 {
-		return nil, fmt.Errorf("no virtual PSBTs specified")
+		if !req.IncludeAssets {
+			resp.Leaves = append(
+				resp.Leaves, marshalAssetLeafKey(assetLeaf),
+			)
+			continue
+		}
+
+		rpcLeaf, err := r.marshalAssetLeaf(ctx, assetLeaf)
+		if err != nil {
+			return nil, err
+		}
+		resp.Leaves = append(resp.Leaves, rpcLeaf)
 	}
 
-	if len(req.VirtualPsbts) > 1 {
-		return nil, fmt.Errorf("only one virtual PSBT supported")
+	return resp, nil
+}
+
+// UnmarshalOutpoint un-marshals an outpoint from a string received via RPC.
+func UnmarshalOutpoint(outpoint string) (*wire.OutPoint, error) {
+	parts := strings.Split(outpoint, ":")
+	if len(parts) != 2 {
+		return nil, errors.New("outpoint should be of form txid:index")
 	}
 
-	vPacket, err := tappsbt.NewFromRawBytes(
-		bytes.NewReader(req.VirtualPsbts[0]), false,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("error decoding packet: %w", err)
+	txidStr := parts[0]
+	if hex.DecodedLen(len(txidStr)) != chainhash.HashSize {
+		return nil, fmt.Errorf("invalid hex-encoded txid %v", txidStr)
 	}
 
-	if len(vPacket.Inputs) != 1 {
-		return nil, fmt.Errorf("only one input is currently supported")
+	txid, err := chainhash.NewHashFromStr(txidStr)
+	if err != nil {
+		return nil, err
 	}
 
-	inputAsset := vPacket.Inputs[0].Asset()
-	prevID := vPacket.Inputs[0].PrevID
-	inputCommitment, err := r.cfg.AssetStore.FetchCommitment(
-		ctx, inputAsset.ID(), prevID.OutPoint, inputAsset.GroupKey,
-		&inputAsset.ScriptKey, true,
-	)
+	outputIndex, err := strconv.Atoi(parts[1])
 	if err != nil {
-		return nil, fmt.Errorf("error fetching input commitment: %w",
-			err)
+		return nil, fmt.Errorf("invalid output index: %v", err)
 	}
 
-	rpcsLog.Debugf("Selected commitment for anchor point %v, requesting "+
-		"delivery", inputCommitment.AnchorPoint)
+	return &wire.OutPoint{
+		Hash:  *txid,
+		Index: uint32(outputIndex),
+	}, nil
+}
 
-	resp, err := r.cfg.ChainPorter.RequestShipment(
-		tapfreighter.NewPreSignedParcel(
-			vPacket, tappsbt.InputCommitments{
-				0: inputCommitment.Commitment,
-			},
-		),
+// unmarshalLeafKey un-marshals a leaf key from the RPC form.
+func unmarshalLeafKey(key *unirpc.AssetKey) (universe.LeafKey, error) {
+	var (
+		leafKey universe.LeafKey
+		err     error
 	)
-	if err != nil {
-		return nil, fmt.Errorf("error requesting delivery: %w", err)
+
+	switch {
+	case key.GetScriptKeyBytes() != nil:
+		pubKey, err := parseUserKey(key.GetScriptKeyBytes())
+		if err != nil {
+			return leafKey, err
+		}
+
+		leafKey.ScriptKey = &asset.ScriptKey{
+			PubKey: pubKey,
+		}
+
+	case key.GetScriptKeyStr() != "":
+		scriptKeyBytes, sErr := hex.DecodeString(key.GetScriptKeyStr())
+		if sErr != nil {
+			return leafKey, err
+		}
+
+		pubKey, err := parseUserKey(scriptKeyBytes)
+		if err != nil {
+			return leafKey, err
+		}
+
+		leafKey.ScriptKey = &asset.ScriptKey{
+			PubKey: pubKey,
+		}
+	default:
+		return leafKey, fmt.Errorf("script key must be set")
 	}
 
-	parcel, err := marshalOutboundParcel(resp)
+	leafKey.OutPoint, err = unmarshalLeafKeyOutPoint(key)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
-			err)
+		return leafKey, err
 	}
 
-	return &taprpc.SendAssetResponse{
-		Transfer: parcel,
-	}, nil
+	return leafKey, nil
 }
 
-// NextInternalKey derives the next internal key for the given key family and
-// stores it as an internal key in the database to make sure it is identified
-// as a local key later on when importing proofs. While an internal key can
-// also be used as the internal key of a script key, it is recommended to use
-// the NextScriptKey RPC instead, to make sure the tweaked Taproot output key
-// is also recognized as a local key.
-func (r *rpcServer) NextInternalKey(ctx context.Context,
-	req *wrpc.NextInternalKeyRequest) (*wrpc.NextInternalKeyResponse,
-	error) {
+// unmarshalLeafKeyOutPoint un-marshals the outpoint of a leaf key from the RPC
+// form.
+func unmarshalLeafKeyOutPoint(key *unirpc.AssetKey) (wire.OutPoint, error) {
+	switch {
+	case key.GetOpStr() != "":
+		// Parse a bitcoin outpoint in the form txid:index into a
+		// wire.OutPoint struct.
+		outpoint, err := UnmarshalOutpoint(key.GetOpStr())
+		if err != nil {
+			return wire.OutPoint{}, err
+		}
 
-	// Due to how we detect local keys, we need to make sure that the key
-	// family is not zero.
-	if req.KeyFamily == 0 {
-		return nil, fmt.Errorf("key family must be set to a non-zero " +
-			"value")
+		return *outpoint, nil
+
+	case key.GetOutpoint() != nil:
+		op := key.GetOp()
+
+		hash, err := chainhash.NewHashFromStr(op.HashStr)
+		if err != nil {
+			return wire.OutPoint{}, err
+		}
+
+		return wire.OutPoint{
+			Hash:  *hash,
+			Index: uint32(op.Index),
+		}, nil
+
+	default:
+		return wire.OutPoint{}, fmt.Errorf("outpoint not set")
 	}
+}
 
-	keyDesc, err := r.cfg.AddrBook.NextInternalKey(ctx, keychain.KeyFamily(
-		req.KeyFamily,
-	))
-	if err != nil {
-		return nil, fmt.Errorf("error inserting internal key: %w", err)
+// hasLeafKeyScriptKey returns true if the script key of the given RPC leaf key
+// is set.
+func hasLeafKeyScriptKey(key *unirpc.AssetKey) bool {
+	return key.GetScriptKeyBytes() != nil || key.GetScriptKeyStr() != ""
+}
+
+// marshalMssmtProof marshals a MS-SMT proof into the RPC form.
+func marshalMssmtProof(proof *mssmt.Proof) ([]byte, error) {
+	compressedProof := proof.Compress()
+
+	var b bytes.Buffer
+	if err := compressedProof.Encode(&b); err != nil {
+		return nil, err
 	}
 
-	return &wrpc.NextInternalKeyResponse{
-		InternalKey: marshalKeyDescriptor(keyDesc),
-	}, nil
+	return b.Bytes(), nil
 }
 
-// NextScriptKey derives the next script key (and its corresponding internal
-// key) and stores them both in the database to make sure they are identified
-// as local keys later on when importing proofs.
-func (r *rpcServer) NextScriptKey(ctx context.Context,
-	req *wrpc.NextScriptKeyRequest) (*wrpc.NextScriptKeyResponse,
-	error) {
+// marshalIssuanceProof marshals an issuance proof into the RPC form.
+func (r *rpcServer) marshalIssuanceProof(ctx context.Context,
+	req *unirpc.UniverseKey,
+	proof *universe.Proof) (*unirpc.AssetProofResponse, error) {
 
-	// Due to how we detect local keys, we need to make sure that the key
-	// family is not zero.
-	if req.KeyFamily == 0 {
-		return nil, fmt.Errorf("key family must be set to a non-zero " +
-			"value")
+	uniProof, err := marshalMssmtProof(proof.UniverseInclusionProof)
+	if err != nil {
+		return nil, err
 	}
 
-	scriptKey, err := r.cfg.AddrBook.NextScriptKey(ctx, keychain.KeyFamily(
-		req.KeyFamily,
-	))
+	assetLeaf, err := r.marshalAssetLeaf(ctx, proof.Leaf)
 	if err != nil {
-		return nil, fmt.Errorf("error inserting internal key: %w", err)
+		return nil, err
 	}
 
-	return &wrpc.NextScriptKeyResponse{
-		ScriptKey: marshalScriptKey(scriptKey),
+	uniRoot, err := marshalUniverseRoot(universe.BaseRoot{
+		Node: proof.UniverseRoot,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uniRoot.AssetName = assetLeaf.Asset.AssetGenesis.Name
+	uniRoot.Id = req.Id
+
+	// Marshal multiverse specific fields.
+	multiverseRoot := marshalMssmtNode(proof.MultiverseRoot)
+
+	multiverseProof, err := marshalMssmtProof(
+		proof.MultiverseInclusionProof,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.AssetProofResponse{
+		Req:                      req,
+		UniverseRoot:             uniRoot,
+		UniverseInclusionProof:   uniProof,
+		AssetLeaf:                assetLeaf,
+		MultiverseRoot:           multiverseRoot,
+		MultiverseInclusionProof: multiverseProof,
 	}, nil
 }
 
-// marshalAddr turns an address into its RPC counterpart.
-func marshalAddr(addr *address.Tap,
-	db address.Storage) (*taprpc.Addr, error) {
+// QueryProof attempts to query for an issuance proof for a given asset based
+// on its UniverseKey. A UniverseKey is composed of the Universe ID
+// (asset_id/group_key) and also a leaf key (outpoint || script_key). If found,
+// then the issuance proof is returned that includes an inclusion proof to the
+// known Universe root, as well as a Taproot Asset state transition or issuance
+// proof for the said asset.
+func (r *rpcServer) QueryProof(ctx context.Context,
+	req *unirpc.UniverseKey) (*unirpc.AssetProofResponse, error) {
 
-	addrStr, err := addr.EncodeAddress()
+	proof, err := r.fetchUniverseProof(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("unable to encode addr: %w", err)
+		return nil, err
 	}
 
-	// We can only derive the taproot output if we already know the genesis
-	// for this asset, as that's required to make the template asset that
-	// will be committed to in the tapscript tree.
-	var taprootOutputKey []byte
-	assetGroup, err := db.QueryAssetGroup(
-		context.Background(), addr.AssetID,
-	)
-	if err == nil {
-		addr.AttachGenesis(*assetGroup.Genesis)
+	return r.marshalIssuanceProof(ctx, req, proof)
+}
 
-		outputKey, err := addr.TaprootOutputKey()
-		if err != nil {
-			return nil, fmt.Errorf("error deriving Taproot "+
-				"output key: %w", err)
-		}
+// fetchUniverseProof attempts to fetch the universe proof for the given
+// UniverseKey from the local base universe. If the proof type of the key is
+// unspecified, then both the issuance and transfer universes are queried,
+// taking the proof export config into account.
+func (r *rpcServer) fetchUniverseProof(ctx context.Context,
+	req *unirpc.UniverseKey) (*universe.Proof, error) {
 
-		taprootOutputKey = schnorr.SerializePubKey(outputKey)
+	universeID, err := UnmarshalUniID(req.Id)
+	if err != nil {
+		return nil, err
 	}
 
-	siblingBytes, _, err := commitment.MaybeEncodeTapscriptPreimage(
-		addr.TapscriptSibling,
-	)
+	// The script key of the leaf key is optional. If it isn't set, then
+	// we'll look up the leaf by its outpoint only.
+	var leafKey universe.LeafKey
+	if hasLeafKeyScriptKey(req.LeafKey) {
+		leafKey, err = unmarshalLeafKey(req.LeafKey)
+	} else {
+		leafKey.OutPoint, err = unmarshalLeafKeyOutPoint(req.LeafKey)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("error encoding tapscript sibling: %w",
-			err)
+		return nil, err
 	}
 
-	assetVersion, err := taprpc.MarshalAssetVersion(addr.AssetVersion)
+	rpcsLog.Debugf("[QueryProof]: fetching proof at (universeID=%v, "+
+		"outpoint=%v)", universeID, leafKey.OutPoint)
+
+	// Retrieve proof export config for the given universe.
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	id := addr.AssetID
-	rpcAddr := &taprpc.Addr{
-		AssetVersion:     assetVersion,
-		Encoded:          addrStr,
-		AssetId:          id[:],
-		Amount:           addr.Amount,
-		ScriptKey:        addr.ScriptKey.SerializeCompressed(),
-		InternalKey:      addr.InternalKey.SerializeCompressed(),
-		TapscriptSibling: siblingBytes,
-		TaprootOutputKey: taprootOutputKey,
-		AssetType:        taprpc.AssetType(addr.AssetType()),
-		ProofCourierAddr: addr.ProofCourierAddr.String(),
+	var candidateIDs []universe.Identifier
+
+	if universeID.ProofType == universe.ProofTypeUnspecified {
+		// If the proof type is unspecified, then we'll attempt to
+		// retrieve both the issuance and transfer proofs. We gather the
+		// corresponding universe IDs into a candidate set.
+		universeID.ProofType = universe.ProofTypeIssuance
+		if syncConfigs.IsSyncExportEnabled(universeID) {
+			candidateIDs = append(candidateIDs, universeID)
+		}
+
+		universeID.ProofType = universe.ProofTypeTransfer
+		if syncConfigs.IsSyncExportEnabled(universeID) {
+			candidateIDs = append(candidateIDs, universeID)
+		}
+	} else {
+		// Otherwise, we'll only attempt to retrieve the proof for the
+		// specified proof type. But first we'll check that proof export
+		// is enabled for the given universe.
+		if !syncConfigs.IsSyncExportEnabled(universeID) {
+			return nil, fmt.Errorf("proof export is disabled for " +
+				"the given universe")
+		}
+
+		candidateIDs = append(candidateIDs, universeID)
 	}
 
-	if addr.GroupKey != nil {
-		rpcAddr.GroupKey = addr.GroupKey.SerializeCompressed()
+	// If no candidate IDs were applicable then our config must have
+	// disabled proof export for the given universe.
+	if len(candidateIDs) == 0 {
+		return nil, fmt.Errorf("proof export is disabled for the " +
+			"given universe")
 	}
 
-	return rpcAddr, nil
-}
+	// Attempt to retrieve the proof given the candidate set of universe
+	// IDs.
+	var proofs []*universe.Proof
+	for i := range candidateIDs {
+		candidateID := candidateIDs[i]
 
-// marshalAddrEvent turns an address event into its RPC counterpart.
-func marshalAddrEvent(event *address.Event,
-	db address.Storage) (*taprpc.AddrEvent, error) {
+		candidateKey := leafKey
+		if candidateKey.ScriptKey == nil {
+			candidateKey, err = r.leafKeyByOutPoint(
+				ctx, candidateID, leafKey.OutPoint,
+			)
+			if errors.Is(err, universe.ErrNoUniverseProofFound) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
 
-	rpcAddr, err := marshalAddr(event.Addr.Tap, db)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling addr: %w", err)
+		proofs, err = r.cfg.BaseUniverse.FetchIssuanceProof(
+			ctx, candidateID, candidateKey,
+		)
+		if err != nil {
+			if errors.Is(err, universe.ErrNoUniverseProofFound) {
+				continue
+			}
+
+			rpcsLog.Debugf("[QueryProof]: error querying for "+
+				"proof at (universeID=%v, leafKey=%x)",
+				universeID, candidateKey.UniverseKey())
+			return nil, err
+		}
+
+		// At this point we've found a proof, so we'll break out of the
+		// loop. We don't need to attempt to retrieve a proof for any
+		// other candidate IDs.
+		break
 	}
 
-	rpcStatus, err := marshalAddrEventStatus(event.Status)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling status: %w", err)
+	// We use the NotFound status code here, so REST clients receive a
+	// proper 404 response.
+	if len(proofs) == 0 {
+		errNotFound := universe.ErrNoUniverseProofFound
+		return nil, status.Error(codes.NotFound, errNotFound.Error())
 	}
 
-	return &taprpc.AddrEvent{
-		CreationTimeUnixSeconds: uint64(event.CreationTime.Unix()),
-		Addr:                    rpcAddr,
-		Status:                  rpcStatus,
-		Outpoint:                event.Outpoint.String(),
-		UtxoAmtSat:              uint64(event.Amt),
-		ConfirmationHeight:      event.ConfirmationHeight,
-		HasProof:                event.HasProof,
-	}, nil
-}
+	proof := proofs[0]
 
-// unmarshalAddrEventStatus parses the RPC address event status into the native
-// counterpart.
-func unmarshalAddrEventStatus(
-	rpcStatus taprpc.AddrEventStatus) (address.Status, error) {
+	rpcsLog.Debugf("[QueryProof]: found proof at (universeID=%v, "+
+		"leafKey=%x)", universeID, proof.LeafKey.UniverseKey())
 
-	switch rpcStatus {
-	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_DETECTED:
-		return address.StatusTransactionDetected, nil
+	return proof, nil
+}
 
-	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED:
-		return address.StatusTransactionConfirmed, nil
+// leafKeyByOutPoint attempts to find the full leaf key of the leaf with the
+// given outpoint within the target universe. If the universe contains multiple
+// leaves with the same outpoint, an error is returned, as the script key is
+// needed to identify the leaf.
+func (r *rpcServer) leafKeyByOutPoint(ctx context.Context,
+	id universe.Identifier, op wire.OutPoint) (universe.LeafKey, error) {
 
-	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_PROOF_RECEIVED:
-		return address.StatusProofReceived, nil
+	leafKeys, err := r.cfg.BaseUniverse.UniverseLeafKeys(ctx, id)
+	if err != nil {
+		return universe.LeafKey{}, err
+	}
 
-	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED:
-		return address.StatusCompleted, nil
+	matches := fn.Filter(leafKeys, func(key universe.LeafKey) bool {
+		return key.OutPoint == op
+	})
+	switch len(matches) {
+	case 0:
+		return universe.LeafKey{}, universe.ErrNoUniverseProofFound
+
+	case 1:
+		return matches[0], nil
 
 	default:
-		return 0, fmt.Errorf("unknown address event status <%d>",
-			rpcStatus)
+		return universe.LeafKey{}, status.Errorf(codes.InvalidArgument,
+			"found %d leaves with outpoint %v, script key must be "+
+				"set", len(matches), op)
 	}
 }
 
-// marshalAddrEventStatus turns the address event status into the RPC
-// counterpart.
-func marshalAddrEventStatus(status address.Status) (taprpc.AddrEventStatus,
-	error) {
+// QueryUniverseProof attempts to query for the MS-SMT inclusion proof of a
+// given asset leaf based on its UniverseKey. The response contains all the data
+// required to independently verify that the leaf is committed to within the
+// returned Universe root.
+func (r *rpcServer) QueryUniverseProof(ctx context.Context,
+	req *unirpc.UniverseKey) (*unirpc.UniverseInclusionProof, error) {
 
-	switch status {
-	case address.StatusTransactionDetected:
-		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_DETECTED,
-			nil
+	proof, err := r.fetchUniverseProof(ctx, req)
+	if err != nil {
+		return nil, err
+	}
 
-	case address.StatusTransactionConfirmed:
-		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED,
-			nil
+	leafNode, err := proof.Leaf.SmtLeafNode()
+	if err != nil {
+		return nil, err
+	}
 
-	case address.StatusProofReceived:
-		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_PROOF_RECEIVED,
-			nil
+	uniProof, err := marshalMssmtProof(proof.UniverseInclusionProof)
+	if err != nil {
+		return nil, err
+	}
 
-	case address.StatusCompleted:
-		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED, nil
+	leafKey := proof.LeafKey.UniverseKey()
 
-	default:
-		return 0, fmt.Errorf("unknown address event status <%d>",
-			status)
+	return &unirpc.UniverseInclusionProof{
+		Req:                    req,
+		UniverseRoot:           marshalMssmtNode(proof.UniverseRoot),
+		LeafKey:                leafKey[:],
+		LeafValue:              leafNode.Value,
+		LeafSum:                leafNode.NodeSum(),
+		UniverseInclusionProof: uniProof,
+	}, nil
+}
+
+// unmarshalAssetLeaf unmarshals an asset leaf from the RPC form.
+func unmarshalAssetLeaf(leaf *unirpc.AssetLeaf) (*universe.Leaf, error) {
+	// We'll just pull the asset details from the serialized issuance proof
+	// itself.
+	var assetProof proof.Proof
+	if err := assetProof.Decode(
+		bytes.NewReader(leaf.IssuanceProof),
+	); err != nil {
+		return nil, err
 	}
+
+	// TODO(roasbeef): double check posted file format everywhere
+	//  * raw proof, or within file?
+
+	return &universe.Leaf{
+		GenesisWithGroup: universe.GenesisWithGroup{
+			Genesis:  assetProof.Asset.Genesis,
+			GroupKey: assetProof.Asset.GroupKey,
+		},
+		Proof: &assetProof,
+		Amt:   assetProof.Asset.Amount,
+	}, nil
 }
 
-// SendAsset uses one or multiple passed Taproot Asset address(es) to attempt to
-// complete an asset send. The method returns information w.r.t the on chain
-// send, as well as the proof file information the receiver needs to fully
-// receive the asset.
-func (r *rpcServer) SendAsset(_ context.Context,
-	req *taprpc.SendAssetRequest) (*taprpc.SendAssetResponse, error) {
+// InsertProof attempts to insert a new issuance proof into the Universe tree
+// specified by the UniverseKey. If valid, then the proof is inserted into the
+// database, with a new Universe root returned for the updated
+// asset_id/group_key.
+func (r *rpcServer) InsertProof(ctx context.Context,
+	req *unirpc.AssetProof) (*unirpc.AssetProofResponse, error) {
 
-	if len(req.TapAddrs) == 0 {
-		return nil, fmt.Errorf("at least one addr is required")
+	if req.Key == nil {
+		return nil, fmt.Errorf("key cannot be nil")
 	}
 
-	var (
-		tapParams = address.ParamsForChain(r.cfg.ChainParams.Name)
-		tapAddrs  = make([]*address.Tap, len(req.TapAddrs))
-		err       error
-	)
-	for idx := range req.TapAddrs {
-		if req.TapAddrs[idx] == "" {
-			return nil, fmt.Errorf("addr %d must be specified", idx)
-		}
+	universeID, err := UnmarshalUniID(req.Key.Id)
+	if err != nil {
+		return nil, err
+	}
+	leafKey, err := unmarshalLeafKey(req.Key.LeafKey)
+	if err != nil {
+		return nil, err
+	}
 
-		tapAddrs[idx], err = address.DecodeAddress(
-			req.TapAddrs[idx], &tapParams,
-		)
-		if err != nil {
-			return nil, err
-		}
+	assetLeaf, err := unmarshalAssetLeaf(req.AssetLeaf)
+	if err != nil {
+		return nil, err
+	}
 
-		// Ensure all addrs are of the same asset ID. Within a single
-		// transfer (=a single virtual packet), we expect only to have
-		// inputs and outputs of the same asset ID. Multiple assets can
-		// be moved in a single BTC level anchor output, but the
-		// expectation is that they would be in separate virtual
-		// packets, one for each asset ID. They would then be merged
-		// into the same anchor output in the wallet's
-		// AnchorVirtualTransactions call.
-		//
-		// TODO(guggero): Support creating multiple virtual packets, one
-		// for each asset ID when the user wants to send multiple asset
-		// IDs at the same time without going through the PSBT flow.
-		//
-		// TODO(guggero): Revisit after we have a way to send fungible
-		// assets with different IDs to an address (non-interactive).
-		if idx > 0 {
-			if tapAddrs[idx].AssetID != tapAddrs[0].AssetID {
-				return nil, fmt.Errorf("all addrs must be of "+
-					"the same asset ID %v",
-					tapAddrs[0].AssetID)
-			}
+	// If universe proof type unspecified, set based on the provided asset
+	// proof.
+	if universeID.ProofType == universe.ProofTypeUnspecified {
+		universeID.ProofType, err = universe.NewProofTypeFromAssetProof(
+			assetLeaf.Proof,
+		)
+		if err != nil {
+			return nil, err
 		}
 	}
 
-	feeRate, err := checkFeeRateSanity(req.FeeRate)
+	// Ensure that the new proof is of the correct type for the target
+	// universe.
+	err = universe.ValidateProofUniverseType(assetLeaf.Proof, universeID)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := r.cfg.ChainPorter.RequestShipment(
-		tapfreighter.NewAddressParcel(feeRate, tapAddrs...),
-	)
-	if err != nil {
+	// Ensure proofs pushed by the caller are accepted.
+	if err := r.checkPushAllowed(ctx); err != nil {
 		return nil, err
 	}
 
-	parcel, err := marshalOutboundParcel(resp)
+	// Ensure the proof isn't for the script key of a revoked address.
+	if err := r.checkScriptKeyNotRevoked(ctx, leafKey); err != nil {
+		return nil, err
+	}
+
+	// Ensure proof insert is enabled for the given universe.
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
-			err)
+		return nil, err
 	}
 
-	return &taprpc.SendAssetResponse{
-		Transfer: parcel,
-	}, nil
-}
+	if !syncConfigs.IsSyncInsertEnabled(universeID) {
+		return nil, fmt.Errorf("proof insert is disabled for the " +
+			"given universe")
+	}
 
-// BurnAsset burns the given number of units of a given asset by sending them
-// to a provably un-spendable script key. Burning means irrevocably destroying
-// a certain number of assets, reducing the total supply of the asset. Because
-// burning is such a destructive and non-reversible operation, some specific
-// values need to be set in the request to avoid accidental burns.
-func (r *rpcServer) BurnAsset(ctx context.Context,
-	in *taprpc.BurnAssetRequest) (*taprpc.BurnAssetResponse, error) {
+	rpcsLog.Debugf("[InsertProof]: inserting proof at "+
+		"(universeID=%v, leafKey=%x)", universeID,
+		leafKey.UniverseKey())
 
-	var assetID asset.ID
+	newUniverseState, err := r.cfg.BaseUniverse.RegisterIssuance(
+		ctx, universeID, leafKey, assetLeaf,
+	)
 	switch {
-	case len(in.GetAssetId()) > 0:
-		copy(assetID[:], in.GetAssetId())
-
-	case len(in.GetAssetIdStr()) > 0:
-		assetIDBytes, err := hex.DecodeString(in.GetAssetIdStr())
-		if err != nil {
-			return nil, fmt.Errorf("error decoding asset ID: %w",
-				err)
-		}
+	case errors.Is(err, universe.ErrAssetNotAllowed):
+		return nil, status.Error(codes.PermissionDenied, err.Error())
 
-		copy(assetID[:], assetIDBytes)
+	// A proof that isn't buried deep enough yet can be inserted later, so
+	// we'll signal the caller to retry.
+	case errors.Is(err, universe.ErrInsufficientConfs):
+		return nil, status.Error(codes.Unavailable, err.Error())
 
-	default:
-		return nil, fmt.Errorf("asset ID must be specified")
+	case err != nil:
+		return nil, err
 	}
 
-	if in.AmountToBurn == 0 {
-		return nil, fmt.Errorf("amount to burn must be specified")
-	}
-	if in.ConfirmationText != AssetBurnConfirmationText {
-		return nil, fmt.Errorf("invalid confirmation text, please " +
-			"read API doc and confirm safety measure to avoid " +
-			"accidental asset burns")
-	}
+	universeRootHash := newUniverseState.UniverseRoot.NodeHash()
+	rpcsLog.Debugf("[InsertProof]: proof inserted, new universe root: %x",
+		universeRootHash[:])
 
-	var groupKey *btcec.PublicKey
-	assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(ctx, assetID)
-	if err == nil && assetGroup.GroupKey != nil {
-		groupKey = &assetGroup.GroupPubKey
-	}
+	return r.marshalIssuanceProof(ctx, req.Key, newUniverseState)
+}
 
-	fundResp, err := r.cfg.AssetWallet.FundBurn(
-		ctx, &tapscript.FundingDescriptor{
-			ID:       assetID,
-			GroupKey: groupKey,
-			Amount:   in.AmountToBurn,
-		},
-	)
-	if err != nil {
-		return nil, fmt.Errorf("error funding burn: %w", err)
+// checkPushAllowed returns a PermissionDenied error if the caller is a remote
+// host that pushed proofs aren't accepted from. Direct gRPC calls from the
+// local machine are issued by the operator and are always accepted. Calls
+// through the REST proxy are checked against the host the proxy forwarded
+// them for, even if that's the local machine.
+func (r *rpcServer) checkPushAllowed(ctx context.Context) error {
+	if rpcperms.IsDirectLocalCall(ctx) {
+		return nil
 	}
 
-	// Now we can sign the packet and send it to the chain.
-	_, err = r.cfg.AssetWallet.SignVirtualPacket(fundResp.VPacket)
-	if err != nil {
-		return nil, fmt.Errorf("error signing packet: %w", err)
+	host := rpcperms.PeerHostFromContext(ctx)
+	if host == "" {
+		return nil
 	}
 
-	resp, err := r.cfg.ChainPorter.RequestShipment(
-		tapfreighter.NewPreSignedParcel(
-			fundResp.VPacket, fundResp.InputCommitments,
-		),
-	)
-	if err != nil {
-		return nil, err
+	err := r.cfg.BaseUniverse.CheckPushHost(ctx, host)
+	if errors.Is(err, universe.ErrPushNotAllowed) {
+		return status.Error(codes.PermissionDenied, err.Error())
 	}
 
-	parcel, err := marshalOutboundParcel(resp)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
-			err)
+	return err
+}
+
+// checkScriptKeyNotRevoked returns a PermissionDenied error if the script key
+// of the given leaf key belongs to a revoked address. This makes sure proofs
+// for a revoked address aren't accepted when this node acts as the proof
+// courier of its own addresses.
+func (r *rpcServer) checkScriptKeyNotRevoked(ctx context.Context,
+	leafKey universe.LeafKey) error {
+
+	if leafKey.ScriptKey == nil || leafKey.ScriptKey.PubKey == nil {
+		return nil
 	}
 
-	var burnProof *taprpc.DecodedProof
-	for idx := range resp.Outputs {
-		vOut := fundResp.VPacket.Outputs[idx]
-		tOut := resp.Outputs[idx]
-		if vOut.Asset.IsBurn() {
-			var p proof.Proof
-			err = p.Decode(bytes.NewReader(tOut.ProofSuffix))
-			if err != nil {
-				return nil, fmt.Errorf("error decoding "+
-					"burn proof: %w", err)
-			}
+	revoked, err := r.cfg.AddrBook.IsScriptKeyRevoked(
+		ctx, leafKey.ScriptKey.PubKey,
+	)
+	switch {
+	case err != nil:
+		return fmt.Errorf("unable to check script key: %w", err)
 
-			burnProof, err = r.marshalProof(ctx, &p, true, false)
-			if err != nil {
-				return nil, fmt.Errorf("error decoding "+
-					"burn proof: %w", err)
-			}
-		}
+	case revoked:
+		return status.Errorf(codes.PermissionDenied, "script key %x "+
+			"belongs to a revoked address",
+			leafKey.ScriptKey.PubKey.SerializeCompressed())
 	}
 
-	return &taprpc.BurnAssetResponse{
-		BurnTransfer: parcel,
-		BurnProof:    burnProof,
-	}, nil
+	return nil
 }
 
-// marshalOutboundParcel turns a pending parcel into its RPC counterpart.
-func marshalOutboundParcel(
-	parcel *tapfreighter.OutboundParcel) (*taprpc.AssetTransfer,
+// InsertProofs attempts to insert a batch of new issuance or transfer proofs
+// into the Universe trees specified by their UniverseKeys. All valid proofs
+// are inserted, even if other proofs of the batch are invalid, and the result
+// of each proof is returned.
+func (r *rpcServer) InsertProofs(ctx context.Context,
+	req *unirpc.InsertProofsRequest) (*unirpc.InsertProofsResponse,
 	error) {
 
-	rpcInputs := make([]*taprpc.TransferInput, len(parcel.Inputs))
-	for idx := range parcel.Inputs {
-		in := parcel.Inputs[idx]
-		rpcInputs[idx] = &taprpc.TransferInput{
-			AnchorPoint: in.OutPoint.String(),
-			AssetId:     in.ID[:],
-			ScriptKey:   in.ScriptKey[:],
-			Amount:      in.Amount,
-		}
+	if len(req.Proofs) == 0 {
+		return nil, fmt.Errorf("at least one proof must be provided")
+	}
+	if len(req.Proofs) > maxInsertProofsBatchSize {
+		return nil, fmt.Errorf("too many proofs, max is %d",
+			maxInsertProofsBatchSize)
 	}
 
-	rpcOutputs := make(
-		[]*taprpc.TransferOutput, len(parcel.Outputs),
-	)
-	for idx := range parcel.Outputs {
-		out := parcel.Outputs[idx]
+	if err := r.checkPushAllowed(ctx); err != nil {
+		return nil, err
+	}
 
-		internalPubKey := out.Anchor.InternalKey.PubKey
-		internalKeyBytes := internalPubKey.SerializeCompressed()
-		rpcAnchor := &taprpc.TransferOutputAnchor{
-			Outpoint:         out.Anchor.OutPoint.String(),
-			Value:            int64(out.Anchor.Value),
-			InternalKey:      internalKeyBytes,
-			TaprootAssetRoot: out.Anchor.TaprootAssetRoot[:],
-			MerkleRoot:       out.Anchor.MerkleRoot[:],
-			TapscriptSibling: out.Anchor.TapscriptSibling,
-			NumPassiveAssets: out.Anchor.NumPassiveAssets,
-		}
-		scriptPubKey := out.ScriptKey.PubKey
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-		var splitCommitRoot []byte
-		if out.SplitCommitmentRoot != nil {
-			hash := out.SplitCommitmentRoot.NodeHash()
-			if hash != mssmt.ZeroNodeHash {
-				splitCommitRoot = hash[:]
-			}
+	// parseProof unmarshals a single proof of the request into an issuance
+	// item, making sure proof insert is enabled for its universe.
+	parseProof := func(
+		rpcProof *unirpc.AssetProof) (*universe.IssuanceItem, error) {
+
+		if rpcProof.Key == nil {
+			return nil, fmt.Errorf("key cannot be nil")
 		}
 
-		rpcOutType, err := marshalOutputType(out.Type)
+		universeID, err := UnmarshalUniID(rpcProof.Key.Id)
 		if err != nil {
 			return nil, err
 		}
-
-		assetVersion, err := taprpc.MarshalAssetVersion(
-			out.AssetVersion,
-		)
+		leafKey, err := unmarshalLeafKey(rpcProof.Key.LeafKey)
 		if err != nil {
 			return nil, err
 		}
 
-		rpcOutputs[idx] = &taprpc.TransferOutput{
-			Anchor:              rpcAnchor,
-			ScriptKey:           scriptPubKey.SerializeCompressed(),
-			ScriptKeyIsLocal:    out.ScriptKeyLocal,
-			Amount:              out.Amount,
-			NewProofBlob:        out.ProofSuffix,
-			SplitCommitRootHash: splitCommitRoot,
-			OutputType:          rpcOutType,
-			AssetVersion:        assetVersion,
+		assetLeaf, err := unmarshalAssetLeaf(rpcProof.AssetLeaf)
+		if err != nil {
+			return nil, err
 		}
-	}
-
-	anchorTxHash := parcel.AnchorTx.TxHash()
-	return &taprpc.AssetTransfer{
-		TransferTimestamp:  parcel.TransferTime.Unix(),
-		AnchorTxHash:       anchorTxHash[:],
-		AnchorTxHeightHint: parcel.AnchorTxHeightHint,
-		AnchorTxChainFees:  parcel.ChainFees,
-		Inputs:             rpcInputs,
-		Outputs:            rpcOutputs,
-	}, nil
-}
-
-// marshalOutputType turns the transfer output type into the RPC counterpart.
-func marshalOutputType(outputType tappsbt.VOutputType) (taprpc.OutputType,
-	error) {
-
-	switch outputType {
-	case tappsbt.TypeSimple:
-		return taprpc.OutputType_OUTPUT_TYPE_SIMPLE, nil
-
-	case tappsbt.TypeSplitRoot:
-		return taprpc.OutputType_OUTPUT_TYPE_SPLIT_ROOT, nil
-
-	case tappsbt.TypePassiveAssetsOnly:
-		return taprpc.OutputType_OUTPUT_TYPE_PASSIVE_ASSETS_ONLY, nil
-
-	case tappsbt.TypePassiveSplitRoot:
-		return taprpc.OutputType_OUTPUT_TYPE_PASSIVE_SPLIT_ROOT, nil
-
-	case tappsbt.TypeSimplePassiveAssets:
-		return taprpc.OutputType_OUTPUT_TYPE_SIMPLE_PASSIVE_ASSETS, nil
-
-	default:
-		return 0, fmt.Errorf("unknown output type: %d", outputType)
-	}
-}
-
-// SubscribeSendAssetEventNtfns registers a subscription to the event
-// notification stream which relates to the asset sending process.
-func (r *rpcServer) SubscribeSendAssetEventNtfns(
-	_ *taprpc.SubscribeSendAssetEventNtfnsRequest,
-	ntfnStream taprpc.TaprootAssets_SubscribeSendAssetEventNtfnsServer) error {
-
-	// Create a new event subscriber and pass a copy to the chain porter.
-	// We will then read events from the subscriber.
-	eventSubscriber := fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize)
-	defer eventSubscriber.Stop()
-
-	err := r.cfg.ChainPorter.RegisterSubscriber(eventSubscriber, false, false)
-	if err != nil {
-		return fmt.Errorf("failed to register event notifications "+
-			"subscription: %w", err)
-	}
-
-	// Loop and read from the ChainPorter event subscription and forward to
-	// the RPC stream.
-	for {
-		select {
-		// Handle receiving a new event from the ChainPorter.
-		// The event will be mapped to the RPC event type and
-		// sent over the stream.
-		case event := <-eventSubscriber.NewItemCreated.ChanOut():
 
-			rpcEvent, err := marshallSendAssetEvent(event)
+		// If universe proof type unspecified, set based on the
+		// provided asset proof.
+		if universeID.ProofType == universe.ProofTypeUnspecified {
+			universeID.ProofType, err =
+				universe.NewProofTypeFromAssetProof(
+					assetLeaf.Proof,
+				)
 			if err != nil {
-				return fmt.Errorf("failed to marshall "+
-					"ChainPorter event into RPC event: "+
-					"%w", err)
+				return nil, err
 			}
+		}
+
+		// Ensure that the new proof is of the correct type for the
+		// target universe.
+		err = universe.ValidateProofUniverseType(
+			assetLeaf.Proof, universeID,
+		)
+		if err != nil {
+			return nil, err
+		}
 
-			err = ntfnStream.Send(rpcEvent)
-			if err != nil {
-				return fmt.Errorf("failed to RPC stream send "+
-					"event: %w", err)
-			}
+		if !syncConfigs.IsSyncInsertEnabled(universeID) {
+			return nil, fmt.Errorf("proof insert is disabled for " +
+				"the given universe")
+		}
 
-		// Handle the case where the RPC stream is closed by the
-		// client.
-		case <-ntfnStream.Context().Done():
-			// Don't return an error if a normal context
-			// cancellation has occurred.
-			isCanceledContext := errors.Is(
-				ntfnStream.Context().Err(), context.Canceled,
-			)
-			if isCanceledContext {
-				return nil
-			}
+		err = r.checkScriptKeyNotRevoked(ctx, leafKey)
+		if err != nil {
+			return nil, err
+		}
 
-			return ntfnStream.Context().Err()
+		return &universe.IssuanceItem{
+			ID:   universeID,
+			Key:  leafKey,
+			Leaf: assetLeaf,
+		}, nil
+	}
 
-		// Handle the case where the RPC server is shutting down.
-		case <-r.quit:
-			return nil
+	// Proofs that can't be parsed are rejected right away, all others are
+	// passed on to the universe archive in a single batch.
+	var (
+		results  = make([]*unirpc.InsertProofResult, len(req.Proofs))
+		items    []*universe.IssuanceItem
+		itemIdxs []int
+	)
+	for idx, rpcProof := range req.Proofs {
+		results[idx] = &unirpc.InsertProofResult{
+			Key: rpcProof.Key,
+		}
+
+		item, err := parseProof(rpcProof)
+		if err != nil {
+			results[idx].Error = err.Error()
+			continue
 		}
+
+		items = append(items, item)
+		itemIdxs = append(itemIdxs, idx)
 	}
-}
 
-// marshallSendAssetEvent maps a ChainPorter event to its RPC counterpart.
-func marshallSendAssetEvent(
-	eventInterface fn.Event) (*taprpc.SendAssetEvent, error) {
+	rpcsLog.Debugf("[InsertProofs]: inserting batch of %d proofs (%d "+
+		"rejected before verification)", len(items),
+		len(req.Proofs)-len(items))
 
-	switch event := eventInterface.(type) {
-	case *tapfreighter.ExecuteSendStateEvent:
-		eventRpc := &taprpc.SendAssetEvent_ExecuteSendStateEvent{
-			ExecuteSendStateEvent: &taprpc.ExecuteSendStateEvent{
-				Timestamp: event.Timestamp().UnixMicro(),
-				SendState: event.SendState.String(),
-			},
+	if len(items) > 0 {
+		itemErrs, err := r.cfg.BaseUniverse.RegisterProofBatch(
+			ctx, items,
+		)
+		if err != nil {
+			return nil, err
 		}
-		return &taprpc.SendAssetEvent{
-			Event: eventRpc,
-		}, nil
 
-	case *proof.ReceiverProofBackoffWaitEvent:
-		eventRpc := taprpc.SendAssetEvent_ReceiverProofBackoffWaitEvent{
-			ReceiverProofBackoffWaitEvent: &taprpc.ReceiverProofBackoffWaitEvent{
-				Timestamp:    event.Timestamp().UnixMicro(),
-				Backoff:      event.Backoff.Microseconds(),
-				TriesCounter: event.TriesCounter,
-			},
+		for i, itemErr := range itemErrs {
+			if itemErr != nil {
+				results[itemIdxs[i]].Error = itemErr.Error()
+				continue
+			}
+
+			results[itemIdxs[i]].Success = true
 		}
-		return &taprpc.SendAssetEvent{
-			Event: &eventRpc,
-		}, nil
+	}
 
-	default:
-		return nil, fmt.Errorf("unknown event type: %T", eventInterface)
+	resp := &unirpc.InsertProofsResponse{
+		Results: results,
+	}
+	for _, result := range results {
+		if result.Success {
+			resp.NumInserted++
+		} else {
+			resp.NumFailed++
+		}
 	}
+
+	rpcsLog.Debugf("[InsertProofs]: inserted %d proofs, %d failed",
+		resp.NumInserted, resp.NumFailed)
+
+	return resp, nil
 }
 
-// marshalMintingBatch marshals a minting batch into the RPC counterpart.
-func marshalMintingBatch(batch *tapgarden.MintingBatch,
-	skipSeedlings bool) (*mintrpc.MintingBatch, error) {
+// UploadMailboxProof uploads a proof file to the proof courier mailbox, so the
+// receiver of the asset can download it later.
+func (r *rpcServer) UploadMailboxProof(ctx context.Context,
+	req *unirpc.UploadMailboxProofRequest) (
+	*unirpc.UploadMailboxProofResponse, error) {
 
-	rpcBatchState, err := marshalBatchState(batch)
-	if err != nil {
-		return nil, err
+	if r.cfg.ProofMailbox == nil {
+		return nil, status.Error(
+			codes.Unimplemented, "proof mailbox not enabled",
+		)
 	}
 
-	rpcBatch := &mintrpc.MintingBatch{
-		BatchKey: batch.BatchKey.PubKey.SerializeCompressed(),
-		State:    rpcBatchState,
-	}
+	mailboxProof, err := r.cfg.ProofMailbox.UploadProof(
+		ctx, req.RawProofFile, mailboxAuthToken(ctx),
+	)
+	switch {
+	case errors.Is(err, proof.ErrMailboxUnauthorized):
+		return nil, status.Error(codes.Unauthenticated, err.Error())
 
-	// If we don't need to include the seedlings, we can return here.
-	if skipSeedlings {
-		return rpcBatch, nil
+	case err != nil:
+		return nil, status.Errorf(codes.InvalidArgument, "unable to "+
+			"upload proof: %v", err)
 	}
 
-	// When we have sprouts, then they represent the same assets as the
-	// seedlings but in a more "grown up" state. So in that case we only
-	// marshal the sprouts.
-	switch {
-	// We have sprouts, ignore seedlings.
-	case batch.RootAssetCommitment != nil &&
-		len(batch.RootAssetCommitment.CommittedAssets()) > 0:
+	return &unirpc.UploadMailboxProofResponse{
+		Proof: marshalMailboxProof(*mailboxProof),
+	}, nil
+}
 
-		rpcBatch.Assets = marshalSprouts(
-			batch.RootAssetCommitment.CommittedAssets(),
-			batch.AssetMetas,
+// FetchMailboxProofs returns all proof files in the proof courier mailbox that
+// were uploaded for the given script key and didn't expire yet.
+func (r *rpcServer) FetchMailboxProofs(ctx context.Context,
+	req *unirpc.FetchMailboxProofsRequest) (
+	*unirpc.FetchMailboxProofsResponse, error) {
+
+	if r.cfg.ProofMailbox == nil {
+		return nil, status.Error(
+			codes.Unimplemented, "proof mailbox not enabled",
 		)
+	}
 
-	// No sprouts, so we marshal the seedlings.
-	case len(batch.Seedlings) > 0:
-		rpcBatch.Assets, err = marshalSeedlings(batch.Seedlings)
+	scriptKeyBytes := req.GetScriptKey()
+	if req.GetScriptKeyStr() != "" {
+		var err error
+		scriptKeyBytes, err = hex.DecodeString(req.GetScriptKeyStr())
 		if err != nil {
-			return nil, err
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid script key: %v", err)
 		}
 	}
 
-	return rpcBatch, nil
+	scriptKey, err := parseUserKey(scriptKeyBytes)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid "+
+			"script key: %v", err)
+	}
+
+	mailboxProofs, err := r.cfg.ProofMailbox.FetchProofs(
+		ctx, scriptKey, mailboxAuthToken(ctx),
+	)
+	switch {
+	case errors.Is(err, proof.ErrMailboxUnauthorized):
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch mailbox proofs: %w",
+			err)
+	}
+
+	return &unirpc.FetchMailboxProofsResponse{
+		Proofs: fn.Map(mailboxProofs, marshalMailboxProof),
+	}, nil
 }
 
-// marshalSeedlings marshals the seedlings into the RPC counterpart.
-func marshalSeedlings(
-	seedlings map[string]*tapgarden.Seedling) ([]*mintrpc.MintAsset, error) {
+// mailboxAuthToken returns the bearer token of the authorization header of
+// the given request, or an empty string if there is none.
+func mailboxAuthToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
 
-	rpcAssets := make([]*mintrpc.MintAsset, 0, len(seedlings))
-	for _, seedling := range seedlings {
-		var groupKeyBytes []byte
-		if seedling.HasGroupKey() {
-			groupKey := seedling.GroupInfo.GroupKey
-			groupPubKey := groupKey.GroupPubKey
-			groupKeyBytes = groupPubKey.SerializeCompressed()
-		}
+	authHeader := md.Get(authorizationHeader)
+	if len(authHeader) == 0 {
+		return ""
+	}
 
-		var groupAnchor string
-		if seedling.GroupAnchor != nil {
-			groupAnchor = *seedling.GroupAnchor
-		}
+	// The auth scheme is case-insensitive.
+	scheme, token, ok := strings.Cut(authHeader[0], " ")
+	if !ok || !strings.EqualFold(scheme, bearerAuthScheme) {
+		return ""
+	}
 
-		var seedlingMeta *taprpc.AssetMeta
-		if seedling.Meta != nil {
-			seedlingMeta = &taprpc.AssetMeta{
-				MetaHash: fn.ByteSlice(
-					seedling.Meta.MetaHash(),
-				),
-				Data: seedling.Meta.Data,
-				Type: taprpc.AssetMetaType(seedling.Meta.Type),
-			}
-		}
+	return strings.TrimSpace(token)
+}
 
-		assetVersion, err := taprpc.MarshalAssetVersion(
-			seedling.AssetVersion,
-		)
-		if err != nil {
-			return nil, err
-		}
+// marshalMailboxProof converts a proof file of the proof courier mailbox into
+// its RPC form.
+func marshalMailboxProof(p proof.MailboxProof) *unirpc.MailboxProof {
+	return &unirpc.MailboxProof{
+		ScriptKey:       schnorr.SerializePubKey(p.ScriptKey),
+		RawProofFile:    p.Blob,
+		UploadTimestamp: p.UploadTime.Unix(),
+		ExpiryTimestamp: p.ExpiryTime.Unix(),
+	}
+}
 
-		rpcAssets = append(rpcAssets, &mintrpc.MintAsset{
-			AssetType:    taprpc.AssetType(seedling.AssetType),
-			AssetVersion: assetVersion,
-			Name:         seedling.AssetName,
-			AssetMeta:    seedlingMeta,
-			Amount:       seedling.Amount,
-			GroupKey:     groupKeyBytes,
-			GroupAnchor:  groupAnchor,
-		})
+// Info returns a set of information about the current state of the Universe.
+func (r *rpcServer) Info(ctx context.Context,
+	_ *unirpc.InfoRequest) (*unirpc.InfoResponse, error) {
+
+	universeStats, err := r.cfg.UniverseStats.AggregateSyncStats(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return rpcAssets, nil
+	return &unirpc.InfoResponse{
+		RuntimeId: r.cfg.RuntimeID,
+		NumAssets: universeStats.NumTotalAssets,
+	}, nil
 }
 
-// marshalSprouts marshals the sprouts into the RPC counterpart.
-func marshalSprouts(sprouts []*asset.Asset,
-	metas tapgarden.AssetMetas) []*mintrpc.MintAsset {
+// unmarshalUniverseSyncType maps an RPC universe sync type into a concrete
+// type.
+func unmarshalUniverseSyncType(req unirpc.UniverseSyncMode) (
+	universe.SyncType, error) {
 
-	rpcAssets := make([]*mintrpc.MintAsset, 0, len(sprouts))
-	for _, sprout := range sprouts {
-		scriptKey := asset.ToSerialized(sprout.ScriptKey.PubKey)
+	switch req {
+	case unirpc.UniverseSyncMode_SYNC_FULL:
+		return universe.SyncFull, nil
 
-		var assetMeta *taprpc.AssetMeta
-		if metas != nil {
-			if m, ok := metas[scriptKey]; ok && m != nil {
-				assetMeta = &taprpc.AssetMeta{
-					MetaHash: fn.ByteSlice(m.MetaHash()),
-					Data:     m.Data,
-					Type:     taprpc.AssetMetaType(m.Type),
-				}
-			}
-		}
+	case unirpc.UniverseSyncMode_SYNC_DELTA:
+		return universe.SyncDelta, nil
 
-		var groupKeyBytes []byte
-		if sprout.GroupKey != nil {
-			gpk := sprout.GroupKey.GroupPubKey
-			groupKeyBytes = gpk.SerializeCompressed()
-		}
+	case unirpc.UniverseSyncMode_SYNC_ROOTS_ONLY:
+		return universe.SyncRootsOnly, nil
 
-		rpcAssets = append(rpcAssets, &mintrpc.MintAsset{
-			AssetType: taprpc.AssetType(sprout.Type),
-			Name:      sprout.Tag,
-			AssetMeta: assetMeta,
-			Amount:    sprout.Amount,
-			GroupKey:  groupKeyBytes,
-		})
+	case unirpc.UniverseSyncMode_SYNC_ISSUANCE_ONLY:
+		return universe.SyncIssuance, nil
+
+	default:
+		return 0, fmt.Errorf("unknown sync type: %v", req)
 	}
+}
 
-	return rpcAssets
+// unmarshalSyncTargets maps an RPC sync target into a concrete type.
+func unmarshalSyncTargets(targets []*unirpc.SyncTarget) ([]universe.Identifier, error) {
+	uniIDs := make([]universe.Identifier, 0, len(targets))
+	for _, target := range targets {
+		uniID, err := UnmarshalUniID(target.Id)
+		if err != nil {
+			return nil, err
+		}
+		uniIDs = append(uniIDs, uniID)
+	}
+
+	return uniIDs, nil
 }
 
-// marshalBatchState converts the batch state field into its RPC counterpart.
-func marshalBatchState(batch *tapgarden.MintingBatch) (mintrpc.BatchState,
-	error) {
+// marshalUniverseDiff marshals a universe diff into the RPC form.
+func (r *rpcServer) marshalUniverseDiff(ctx context.Context,
+	uniDiff []universe.AssetSyncDiff) (*unirpc.SyncResponse, error) {
 
-	currentBatchState := batch.State()
+	resp := &unirpc.SyncResponse{
+		SyncedUniverses: make([]*unirpc.SyncedUniverse, 0, len(uniDiff)),
+	}
 
-	switch currentBatchState {
-	case tapgarden.BatchStatePending:
-		return mintrpc.BatchState_BATCH_STATE_PEDNING, nil
+	err := fn.ForEachErr(uniDiff, func(diff universe.AssetSyncDiff) error {
+		oldUniRoot, err := marshalUniverseRoot(diff.OldUniverseRoot)
+		if err != nil {
+			return fmt.Errorf("unable to marshal old uni "+
+				"root: %w", err)
+		}
+		newUniRoot, err := marshalUniverseRoot(diff.NewUniverseRoot)
+		if err != nil {
+			return fmt.Errorf("unable to marshal new unit "+
+				"root: %w", err)
+		}
 
-	case tapgarden.BatchStateFrozen:
-		return mintrpc.BatchState_BATCH_STATE_FROZEN, nil
+		leaves := make([]*unirpc.AssetLeaf, len(diff.NewLeafProofs))
+		for i, leaf := range diff.NewLeafProofs {
+			leaves[i], err = r.marshalAssetLeaf(ctx, leaf)
+			if err != nil {
+				return err
+			}
+		}
 
-	case tapgarden.BatchStateCommitted:
-		return mintrpc.BatchState_BATCH_STATE_COMMITTED, nil
+		// A failed diff may not carry a root node, so we'll make sure
+		// the ID of the target universe is always included.
+		var syncErr string
+		if diff.Failed() {
+			syncErr = diff.SyncErr.Error()
 
-	case tapgarden.BatchStateBroadcast:
-		return mintrpc.BatchState_BATCH_STATE_BROADCAST, nil
+			if newUniRoot.Id == nil {
+				newUniRoot.Id, err = MarshalUniID(
+					diff.NewUniverseRoot.ID,
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
 
-	case tapgarden.BatchStateConfirmed:
-		return mintrpc.BatchState_BATCH_STATE_CONFIRMED, nil
+		resp.SyncedUniverses = append(
+			resp.SyncedUniverses, &unirpc.SyncedUniverse{
+				OldAssetRoot:      oldUniRoot,
+				NewAssetRoot:      newUniRoot,
+				NewAssetLeaves:    leaves,
+				NumIssuanceLeaves: diff.NumIssuanceLeaves,
+				NumTransferLeaves: diff.NumTransferLeaves,
+				SyncError:         syncErr,
+				DeltaFallback:     diff.DeltaFallback,
+				SkippedTooDeep:    diff.SkippedTooDeep,
+				NumShallowLeaves:  diff.NumShallowLeaves,
+			},
+		)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	case tapgarden.BatchStateFinalized:
-		return mintrpc.BatchState_BATCH_STATE_FINALIZED, nil
+	return resp, nil
+}
 
-	case tapgarden.BatchStateSeedlingCancelled:
-		return mintrpc.BatchState_BATCH_STATE_SEEDLING_CANCELLED, nil
+// SyncUniverse takes host information for a remote Universe server, then
+// attempts to synchronize either only the set of specified asset_ids, or all
+// assets if none are specified. The sync process will attempt to query for the
+// latest known root for each asset, performing tree based reconciliation to
+// arrive at a new shared root.
+func (r *rpcServer) SyncUniverse(ctx context.Context,
+	req *unirpc.SyncRequest) (*unirpc.SyncResponse, error) {
 
-	case tapgarden.BatchStateSproutCancelled:
-		return mintrpc.BatchState_BATCH_STATE_SPROUT_CANCELLED, nil
+	// TODO(roasbeef): have another layer, only allow single outstanding
+	// sync request per host?
 
-	default:
-		return 0, fmt.Errorf("unknown batch state: %v",
-			currentBatchState.String())
+	syncReq, err := r.unmarshalSyncRequest(ctx, req)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// UnmarshalScriptKey parses the RPC script key into the native counterpart.
-func UnmarshalScriptKey(rpcKey *taprpc.ScriptKey) (*asset.ScriptKey, error) {
-	var (
-		scriptKey asset.ScriptKey
-		err       error
+	// If nothing changed since the caller's last sync, we can return
+	// right away without comparing any of the universes.
+	multiverseRoot, unchanged, err := r.checkSyncMultiverseRoot(
+		ctx, syncReq,
 	)
-
-	// The script public key is a Taproot key, so 32-byte x-only.
-	scriptKey.PubKey, err = schnorr.ParsePubKey(rpcKey.PubKey)
 	if err != nil {
 		return nil, err
 	}
+	if unchanged {
+		return &unirpc.SyncResponse{
+			MultiverseUnchanged: true,
+			MultiverseRoot:      multiverseRoot[:],
+		}, nil
+	}
 
-	// The key descriptor is optional for script keys that are completely
-	// independent of the backing wallet.
-	if rpcKey.KeyDesc != nil {
-		keyDesc, err := UnmarshalKeyDescriptor(rpcKey.KeyDesc)
+	// For a dry run, we'll only compute the diff without applying it.
+	if req.DryRun {
+		universeDiff, err := r.cfg.UniverseSyncer.FetchSyncDiff(
+			ctx, syncReq.addr, syncReq.syncType,
+			syncReq.syncConfigs, syncReq.targets...,
+		)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("unable to fetch universe sync "+
+				"diff: %w", err)
 		}
-		scriptKey.TweakedScriptKey = &asset.TweakedScriptKey{
-			RawKey: keyDesc,
 
-			// The tweak is optional, if it's empty it means the key
-			// is derived using BIP-0086.
-			Tweak: rpcKey.TapTweak,
+		resp, err := r.marshalUniverseDiff(ctx, universeDiff)
+		if err != nil {
+			return nil, err
 		}
-	}
+		resp.MultiverseRoot = multiverseRoot[:]
 
-	return &scriptKey, nil
-}
+		return resp, nil
+	}
 
-// marshalScriptKey marshals the native script key into the RPC counterpart.
-func marshalScriptKey(scriptKey asset.ScriptKey) *taprpc.ScriptKey {
-	rpcScriptKey := &taprpc.ScriptKey{
-		PubKey: schnorr.SerializePubKey(scriptKey.PubKey),
+	// TODO(roasbeef): add layer of indirection in front of?
+	//  * just interface interaction
+	// TODO(ffranr): Sync via the FederationEnvoy rather than syncer.
+	universeDiff, err := r.cfg.UniverseSyncer.SyncUniverse(
+		ctx, syncReq.addr, syncReq.syncType, syncReq.syncConfigs,
+		syncReq.targets...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sync universe: %w", err)
 	}
 
-	if scriptKey.TweakedScriptKey != nil {
-		rpcScriptKey.KeyDesc = marshalKeyDescriptor(
-			scriptKey.TweakedScriptKey.RawKey,
-		)
-		rpcScriptKey.TapTweak = scriptKey.TweakedScriptKey.Tweak
+	resp, err := r.marshalUniverseDiff(ctx, universeDiff)
+	if err != nil {
+		return nil, err
 	}
+	resp.MultiverseRoot = multiverseRoot[:]
 
-	return rpcScriptKey
+	return resp, nil
 }
 
-// parseUserKey parses a user-provided script or group key, which can be in
-// either the Schnorr or Compressed format.
-func parseUserKey(scriptKey []byte) (*btcec.PublicKey, error) {
-	switch len(scriptKey) {
-	case schnorr.PubKeyBytesLen:
-		return schnorr.ParsePubKey(scriptKey)
-
-	// Truncate the key and then parse as a Schnorr key.
-	case btcec.PubKeyBytesLenCompressed:
-		return schnorr.ParsePubKey(scriptKey[1:])
+// SyncUniverseStream is a server-streaming variant of SyncUniverse that sends
+// progress events as the sync proceeds, followed by a final summary event.
+func (r *rpcServer) SyncUniverseStream(req *unirpc.SyncRequest,
+	stream unirpc.Universe_SyncUniverseStreamServer) error {
 
-	default:
-		return nil, fmt.Errorf("unknown script key length: %v",
-			len(scriptKey))
+	if req.DryRun {
+		return fmt.Errorf("dry runs aren't supported for streaming " +
+			"syncs, use SyncUniverse instead")
 	}
-}
 
-// marshalKeyDescriptor marshals the native key descriptor into the RPC
-// counterpart.
-func marshalKeyDescriptor(desc keychain.KeyDescriptor) *taprpc.KeyDescriptor {
-	return &taprpc.KeyDescriptor{
-		RawKeyBytes: desc.PubKey.SerializeCompressed(),
-		KeyLoc: &taprpc.KeyLocator{
-			KeyFamily: int32(desc.KeyLocator.Family),
-			KeyIndex:  int32(desc.KeyLocator.Index),
-		},
+	ctx := stream.Context()
+	syncReq, err := r.unmarshalSyncRequest(ctx, req)
+	if err != nil {
+		return err
 	}
-}
-
-// UnmarshalKeyDescriptor parses the RPC key descriptor into the native
-// counterpart.
-func UnmarshalKeyDescriptor(
-	rpcDesc *taprpc.KeyDescriptor) (keychain.KeyDescriptor, error) {
 
-	var (
-		desc keychain.KeyDescriptor
-		err  error
+	// If nothing changed since the caller's last sync, the summary is the
+	// only event we send.
+	multiverseRoot, unchanged, err := r.checkSyncMultiverseRoot(
+		ctx, syncReq,
 	)
-
-	// The public key of a key descriptor is mandatory. It is enough to
-	// locate the corresponding private key in the backing wallet. But to
-	// speed things up (and for additional context), the locator should
-	// still be provided if available.
-	desc.PubKey, err = btcec.ParsePubKey(rpcDesc.RawKeyBytes)
 	if err != nil {
-		return desc, err
+		return err
+	}
+	if unchanged {
+		return stream.Send(&unirpc.SyncProgressEvent{
+			Event: &unirpc.SyncProgressEvent_Summary{
+				Summary: &unirpc.SyncResponse{
+					MultiverseUnchanged: true,
+					MultiverseRoot:      multiverseRoot[:],
+				},
+			},
+		})
 	}
 
-	if rpcDesc.KeyLoc != nil {
-		desc.KeyLocator = keychain.KeyLocator{
-			Family: keychain.KeyFamily(rpcDesc.KeyLoc.KeyFamily),
-			Index:  uint32(rpcDesc.KeyLoc.KeyIndex),
+	// The syncer never calls the progress function concurrently, so we
+	// can send the events directly. If a send fails, we stop sending
+	// further events and report the failure once the sync returns.
+	var sendErr error
+	sendProgress := func(progress universe.SyncProgress) {
+		if sendErr != nil {
+			return
 		}
-	}
 
-	return desc, nil
-}
+		rpcProgress, err := marshalSyncProgress(progress)
+		if err != nil {
+			sendErr = err
+			return
+		}
 
-// FetchAssetMeta allows a caller to fetch the reveal meta data for an asset
-// either by the asset ID for that asset, or a meta hash.
-func (r *rpcServer) FetchAssetMeta(ctx context.Context,
-	req *taprpc.FetchAssetMetaRequest) (*taprpc.AssetMeta, error) {
+		sendErr = stream.Send(&unirpc.SyncProgressEvent{
+			Event: &unirpc.SyncProgressEvent_Progress{
+				Progress: rpcProgress,
+			},
+		})
+	}
 
-	var (
-		assetMeta *proof.MetaReveal
-		err       error
+	universeDiff, err := r.cfg.UniverseSyncer.SyncUniverseWithProgress(
+		ctx, syncReq.addr, syncReq.syncType, syncReq.syncConfigs,
+		sendProgress, syncReq.targets...,
 	)
+	if err != nil {
+		return fmt.Errorf("unable to sync universe: %w", err)
+	}
+	if sendErr != nil {
+		return fmt.Errorf("unable to send sync progress: %w", sendErr)
+	}
 
-	switch {
-	case req.GetAssetId() != nil:
-		if len(req.GetAssetId()) != sha256.Size {
-			return nil, fmt.Errorf("asset ID must be 32 bytes")
-		}
+	summary, err := r.marshalUniverseDiff(ctx, universeDiff)
+	if err != nil {
+		return err
+	}
+	summary.MultiverseRoot = multiverseRoot[:]
 
-		var assetID asset.ID
-		copy(assetID[:], req.GetAssetId())
+	return stream.Send(&unirpc.SyncProgressEvent{
+		Event: &unirpc.SyncProgressEvent_Summary{
+			Summary: summary,
+		},
+	})
+}
 
-		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaForAsset(
-			ctx, assetID,
-		)
+// SyncAll syncs the local Universe with all configured federation members
+// concurrently, merging the leaves of all members into the local Universe.
+func (r *rpcServer) SyncAll(ctx context.Context,
+	req *unirpc.SyncAllRequest) (*unirpc.SyncAllResponse, error) {
 
-	case req.GetAssetIdStr() != "":
-		if len(req.GetAssetIdStr()) != hex.EncodedLen(sha256.Size) {
-			return nil, fmt.Errorf("asset ID must be 32 bytes")
+	syncType, err := unmarshalUniverseSyncType(req.SyncMode)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to "+
+			"parse sync type: %v", err)
+	}
+
+	result, err := r.cfg.UniverseFederation.SyncAll(ctx, syncType)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sync with federation: %w",
+			err)
+	}
+
+	resp := &unirpc.SyncAllResponse{
+		Members: make(
+			[]*unirpc.MemberSyncResult, 0, len(result.Members),
+		),
+		TotalLeavesMerged: result.NumLeavesMerged,
+	}
+	for _, member := range result.Members {
+		rpcMember := &unirpc.MemberSyncResult{
+			UniverseHost: member.Server.HostStr(),
+			Success:      member.Err == nil,
+			NumLeaves:    member.NumLeaves,
+		}
+		if member.Err != nil {
+			rpcMember.Error = member.Err.Error()
 		}
 
-		var assetIDBytes []byte
-		assetIDBytes, err = hex.DecodeString(req.GetAssetIdStr())
+		diffResp, err := r.marshalUniverseDiff(ctx, member.Diffs)
 		if err != nil {
-			return nil, fmt.Errorf("error hex decoding asset ID: "+
-				"%w", err)
+			return nil, err
 		}
+		rpcMember.SyncedUniverses = diffResp.SyncedUniverses
 
-		var assetID asset.ID
-		copy(assetID[:], assetIDBytes)
-
-		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaForAsset(
-			ctx, assetID,
-		)
+		resp.Members = append(resp.Members, rpcMember)
+	}
 
-	case req.GetMetaHash() != nil:
-		if len(req.GetMetaHash()) != sha256.Size {
-			return nil, fmt.Errorf("meta hash must be 32 bytes")
-		}
+	return resp, nil
+}
 
-		var metaHash [asset.MetaHashLen]byte
-		copy(metaHash[:], req.GetMetaHash())
+// syncRequest is the parsed form of an RPC universe sync request.
+type syncRequest struct {
+	addr        universe.ServerAddr
+	syncType    universe.SyncType
+	targets     []universe.Identifier
+	syncConfigs universe.SyncConfigs
+
+	// knownMultiverseRoot is the multiverse root of the remote universe
+	// the caller saw during its last sync. This is nil if the caller
+	// didn't present one.
+	knownMultiverseRoot *mssmt.NodeHash
+}
 
-		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaByHash(
-			ctx, metaHash,
-		)
+// unmarshalSyncRequest parses the given RPC sync request, and also fetches the
+// federation sync configs that govern the sync.
+func (r *rpcServer) unmarshalSyncRequest(ctx context.Context,
+	req *unirpc.SyncRequest) (*syncRequest, error) {
 
-	case req.GetMetaHashStr() != "":
-		if len(req.GetMetaHashStr()) != hex.EncodedLen(sha256.Size) {
-			return nil, fmt.Errorf("meta hash must be 32 bytes")
-		}
+	syncMode, err := unmarshalUniverseSyncType(req.SyncMode)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sync type: %w", err)
+	}
+	syncTargets, err := unmarshalSyncTargets(req.SyncTargets)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sync targets: %w", err)
+	}
 
-		var metaHashBytes []byte
-		metaHashBytes, err = hex.DecodeString(req.GetMetaHashStr())
-		if err != nil {
-			return nil, fmt.Errorf("error hex decoding meta hash: "+
-				"%w", err)
+	var knownMultiverseRoot *mssmt.NodeHash
+	if len(req.KnownMultiverseRoot) != 0 {
+		if len(req.KnownMultiverseRoot) != sha256.Size {
+			return nil, fmt.Errorf("known multiverse root must be "+
+				"%d bytes", sha256.Size)
 		}
 
-		var metaHash [asset.MetaHashLen]byte
-		copy(metaHash[:], metaHashBytes)
+		var rootHash mssmt.NodeHash
+		copy(rootHash[:], req.KnownMultiverseRoot)
+		knownMultiverseRoot = &rootHash
+	}
 
-		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaByHash(
-			ctx, metaHash,
-		)
+	idPrefix := strings.ToLower(req.IdPrefix)
+	rootsQuery := universe.RootNodesQuery{
+		IDPrefix: idPrefix,
+	}
+	if err := rootsQuery.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid ID prefix: %w", err)
+	}
 
-	default:
-		return nil, fmt.Errorf("either asset ID or meta hash must " +
-			"be set")
+	uniAddr, err := r.remoteUniverseAddr(ctx, req.UniverseHost)
+	if err != nil {
+		return nil, err
 	}
+
+	// Obtain the general and universe specific federation sync configs.
+	queryFedSyncConfigs := r.cfg.FederationDB.QueryFederationSyncConfigs
+	globalConfigs, uniSyncConfigs, err := queryFedSyncConfigs(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to fetch asset "+
-			"meta: %w", err)
+		return nil, fmt.Errorf("unable to query federation sync "+
+			"config(s): %w", err)
 	}
 
-	metaHash := assetMeta.MetaHash()
-	return &taprpc.AssetMeta{
-		Data:     assetMeta.Data,
-		Type:     taprpc.AssetMetaType(assetMeta.Type),
-		MetaHash: metaHash[:],
+	return &syncRequest{
+		addr:     uniAddr,
+		syncType: syncMode,
+		targets:  syncTargets,
+		syncConfigs: universe.SyncConfigs{
+			GlobalSyncConfigs: globalConfigs,
+			UniSyncConfigs:    uniSyncConfigs,
+			IDPrefix:          idPrefix,
+			MinConfs:          req.MinConfs,
+			ResumeSync:        req.ResumeSync,
+		},
+		knownMultiverseRoot: knownMultiverseRoot,
 	}, nil
 }
 
-// MarshalUniProofType marshals the universe proof type into the RPC
-// counterpart.
-func MarshalUniProofType(
-	proofType universe.ProofType) (unirpc.ProofType, error) {
-
-	switch proofType {
-	case universe.ProofTypeUnspecified:
-		return unirpc.ProofType_PROOF_TYPE_UNSPECIFIED, nil
-	case universe.ProofTypeIssuance:
-		return unirpc.ProofType_PROOF_TYPE_ISSUANCE, nil
-	case universe.ProofTypeTransfer:
-		return unirpc.ProofType_PROOF_TYPE_TRANSFER, nil
+// checkSyncMultiverseRoot fetches the multiverse root of the remote universe
+// that summarizes the universes covered by the given sync request. The
+// returned bool is true if the root matches the known multiverse root of the
+// request, meaning nothing changed since the caller's last sync.
+func (r *rpcServer) checkSyncMultiverseRoot(ctx context.Context,
+	syncReq *syncRequest) (mssmt.NodeHash, bool, error) {
 
-	default:
-		return 0, fmt.Errorf("unknown universe proof type: %v",
-			proofType)
+	multiverseRoot, err := r.cfg.UniverseSyncer.MultiverseRoot(
+		ctx, syncReq.addr, syncReq.syncType,
+	)
+	if err != nil {
+		return mssmt.NodeHash{}, false, fmt.Errorf("unable to fetch "+
+			"remote multiverse root: %w", err)
 	}
+
+	unchanged := syncReq.knownMultiverseRoot != nil &&
+		*syncReq.knownMultiverseRoot == multiverseRoot
+
+	return multiverseRoot, unchanged, nil
 }
 
-// MarshalUniID marshals the universe ID into the RPC counterpart.
-func MarshalUniID(id universe.Identifier) (*unirpc.ID, error) {
-	var uniID unirpc.ID
+// marshalSyncProgress marshals the progress of a universe sync into its RPC
+// counterpart.
+func marshalSyncProgress(
+	progress universe.SyncProgress) (*unirpc.SyncProgress, error) {
 
-	if id.GroupKey != nil {
-		uniID.Id = &unirpc.ID_GroupKey{
-			GroupKey: schnorr.SerializePubKey(id.GroupKey),
-		}
-	} else {
-		uniID.Id = &unirpc.ID_AssetId{
-			AssetId: id.AssetID[:],
-		}
+	rpcProgress := &unirpc.SyncProgress{
+		NumUniverses:      uint32(progress.NumUniverses),
+		NumUniversesDone:  uint32(progress.NumUniversesDone),
+		NumLeavesInserted: uint64(progress.NumLeavesInserted),
 	}
+	if progress.CurrentID != nil {
+		uniID, err := MarshalUniID(*progress.CurrentID)
+		if err != nil {
+			return nil, err
+		}
 
-	proofTypeRpc, err := MarshalUniProofType(id.ProofType)
-	if err != nil {
-		return nil, fmt.Errorf("unable to marshal proof type: %w", err)
+		rpcProgress.CurrentId = uniID
 	}
-	uniID.ProofType = proofTypeRpc
 
-	return &uniID, nil
+	return rpcProgress, nil
 }
 
-// marshalMssmtNode marshals a MS-SMT node into the RPC counterpart.
-func marshalMssmtNode(node mssmt.Node) *unirpc.MerkleSumNode {
-	nodeHash := node.NodeHash()
+// DiffUniverse takes host information for a remote Universe server and
+// compares its roots with the local ones. For every asset whose roots differ,
+// the side that is ahead is returned.
+func (r *rpcServer) DiffUniverse(ctx context.Context,
+	req *unirpc.DiffUniverseRequest) (*unirpc.DiffUniverseResponse, error) {
 
-	return &unirpc.MerkleSumNode{
-		RootHash: nodeHash[:],
-		RootSum:  int64(node.NodeSum()),
+	if req.UniverseHost == "" {
+		return nil, fmt.Errorf("universe host must be set")
 	}
-}
 
-// marshallUniverseRoot marshals the universe root into the RPC counterpart.
-func marshalUniverseRoot(node universe.BaseRoot) (*unirpc.UniverseRoot, error) {
-	// There was no old base root, so we'll just return a blank root.
-	if node.Node == nil {
-		return &unirpc.UniverseRoot{}, nil
+	uniAddr, err := r.remoteUniverseAddr(ctx, req.UniverseHost)
+	if err != nil {
+		return nil, err
 	}
-	mssmtRoot := marshalMssmtNode(node.Node)
 
-	rpcGroupedAssets := make(map[string]uint64, len(node.GroupedAssets))
-	for assetID, amount := range node.GroupedAssets {
-		rpcGroupedAssets[assetID.String()] = amount
+	rootDiffs, err := r.cfg.UniverseSyncer.DiffUniverse(ctx, uniAddr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to diff universe: %w", err)
 	}
 
-	uniID, err := MarshalUniID(node.ID)
+	rpcDiffs, err := fn.MapErr(rootDiffs, marshalUniverseRootDiff)
 	if err != nil {
 		return nil, err
 	}
 
-	return &unirpc.UniverseRoot{
-		Id:               uniID,
-		MssmtRoot:        mssmtRoot,
-		AssetName:        node.AssetName,
-		AmountsByAssetId: rpcGroupedAssets,
+	return &unirpc.DiffUniverseResponse{
+		Diffs: rpcDiffs,
 	}, nil
 }
 
-// AssetRoots queries for the known Universe roots associated with each known
-// asset. These roots represent the supply/audit state for each known asset.
-func (r *rpcServer) AssetRoots(ctx context.Context,
-	_ *unirpc.AssetRootRequest) (*unirpc.AssetRootResponse, error) {
+// marshalUniverseRootDiff marshals a universe root diff into its RPC
+// counterpart.
+func marshalUniverseRootDiff(
+	rootDiff universe.UniverseRootDiff) (*unirpc.UniverseRootDiff, error) {
 
-	// First, we'll retrieve the full set of known asset Universe roots.
-	assetRoots, err := r.cfg.BaseUniverse.RootNodes(ctx)
+	uniID, err := MarshalUniID(rootDiff.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := &unirpc.AssetRootResponse{
-		UniverseRoots: make(map[string]*unirpc.UniverseRoot),
-	}
+	var status unirpc.RootDiffStatus
+	switch rootDiff.Status {
+	case universe.RootDiffLocalAhead:
+		status = unirpc.RootDiffStatus_ROOT_DIFF_LOCAL_AHEAD
 
-	// Retrieve config for use in filtering asset roots based on sync export
-	// settings.
-	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
-	if err != nil {
-		return nil, err
-	}
+	case universe.RootDiffRemoteAhead:
+		status = unirpc.RootDiffStatus_ROOT_DIFF_REMOTE_AHEAD
 
-	// For each universe root, marshal it into the RPC form, taking care to
-	// specify the proper universe ID.
-	for _, assetRoot := range assetRoots {
-		idStr := assetRoot.ID.String()
+	case universe.RootDiffDiverged:
+		status = unirpc.RootDiffStatus_ROOT_DIFF_DIVERGED
 
-		// Skip this asset if it's not configured for sync export.
-		if !syncConfigs.IsSyncExportEnabled(assetRoot.ID) {
-			continue
-		}
+	default:
+		return nil, fmt.Errorf("unknown root diff status: %v",
+			rootDiff.Status)
+	}
 
-		resp.UniverseRoots[idStr], err = marshalUniverseRoot(assetRoot)
-		if err != nil {
-			return nil, err
-		}
+	rpcDiff := &unirpc.UniverseRootDiff{
+		Id:           uniID,
+		Status:       status,
+		NumNewLeaves: rootDiff.NumNewLeaves,
+	}
+	if rootDiff.LocalRoot != nil {
+		rpcDiff.LocalRoot = marshalMssmtNode(rootDiff.LocalRoot)
+	}
+	if rootDiff.RemoteRoot != nil {
+		rpcDiff.RemoteRoot = marshalMssmtNode(rootDiff.RemoteRoot)
 	}
 
-	return resp, nil
+	return rpcDiff, nil
 }
 
-// UnmarshalUniProofType parses the RPC universe proof type into the native
-// counterpart.
-func UnmarshalUniProofType(rpcType unirpc.ProofType) (universe.ProofType,
+// VerifyUniverse recomputes the MS-SMT roots of local universe trees from their
+// stored leaves and compares them to the persisted roots. If requested,
+// mismatching roots are repaired.
+func (r *rpcServer) VerifyUniverse(ctx context.Context,
+	req *unirpc.VerifyUniverseRequest) (*unirpc.VerifyUniverseResponse,
 	error) {
 
-	switch rpcType {
-	case unirpc.ProofType_PROOF_TYPE_UNSPECIFIED:
-		return universe.ProofTypeUnspecified, nil
+	// If no ID is specified, then all known universes are verified.
+	var universeIDs []universe.Identifier
+	if req.Id != nil {
+		universeID, err := UnmarshalUniID(req.Id)
+		if err != nil {
+			return nil, err
+		}
 
-	case unirpc.ProofType_PROOF_TYPE_ISSUANCE:
-		return universe.ProofTypeIssuance, nil
+		// If the proof type is unspecified, we'll verify both the
+		// issuance and the transfer universe.
+		switch universeID.ProofType {
+		case universe.ProofTypeUnspecified:
+			issuanceID, transferID := universeID, universeID
+			issuanceID.ProofType = universe.ProofTypeIssuance
+			transferID.ProofType = universe.ProofTypeTransfer
 
-	case unirpc.ProofType_PROOF_TYPE_TRANSFER:
-		return universe.ProofTypeTransfer, nil
+			universeIDs = append(
+				universeIDs, issuanceID, transferID,
+			)
 
-	default:
-		return 0, fmt.Errorf("unknown universe proof type: %v", rpcType)
+		default:
+			universeIDs = append(universeIDs, universeID)
+		}
 	}
-}
 
-// unmarshalAssetSyncConfig parses the RPC asset sync config into the native
-// counterpart.
-func unmarshalAssetSyncConfig(
-	config *unirpc.AssetFederationSyncConfig) (*universe.FedUniSyncConfig,
-	error) {
+	verifications, err := r.cfg.BaseUniverse.VerifyUniverse(
+		ctx, universeIDs, req.Repair,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to verify universe: %w", err)
+	}
 
-	if config == nil {
-		return nil, fmt.Errorf("empty universe sync config")
+	resp := &unirpc.VerifyUniverseResponse{
+		NumChecked: uint64(len(verifications)),
 	}
+	for _, verification := range verifications {
+		if !verification.Mismatch {
+			continue
+		}
 
-	// Parse the universe ID from the RPC form.
-	uniID, err := UnmarshalUniID(config.Id)
-	if err != nil {
-		return nil, fmt.Errorf("unable to parse universe id: %w",
-			err)
+		resp.NumMismatched++
+		if verification.Repaired {
+			resp.NumRepaired++
+		}
+
+		mismatch, err := marshalUniverseRootMismatch(verification)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Mismatches = append(resp.Mismatches, mismatch)
 	}
 
-	return &universe.FedUniSyncConfig{
-		UniverseID:      uniID,
-		AllowSyncInsert: config.AllowSyncInsert,
-		AllowSyncExport: config.AllowSyncExport,
-	}, nil
+	return resp, nil
 }
 
-// UnmarshalUniID parses the RPC universe ID into the native counterpart.
-func UnmarshalUniID(rpcID *unirpc.ID) (universe.Identifier, error) {
-	if rpcID == nil {
-		return universe.Identifier{}, fmt.Errorf("missing universe id")
-	}
+// marshalUniverseRootMismatch marshals the verification of a universe whose
+// roots don't match into its RPC counterpart.
+func marshalUniverseRootMismatch(
+	verification universe.RootVerification) (*unirpc.UniverseRootMismatch,
+	error) {
 
-	// Unmarshal the proof type.
-	proofType, err := UnmarshalUniProofType(rpcID.ProofType)
+	uniID, err := MarshalUniID(verification.ID)
 	if err != nil {
-		return universe.Identifier{}, fmt.Errorf("unable to unmarshal "+
-			"proof type: %w", err)
+		return nil, err
 	}
-	switch {
-	case rpcID.GetAssetId() != nil:
-		var assetID asset.ID
-		copy(assetID[:], rpcID.GetAssetId())
-
-		return universe.Identifier{
-			AssetID:   assetID,
-			ProofType: proofType,
-		}, nil
 
-	case rpcID.GetAssetIdStr() != "":
-		assetIDBytes, err := hex.DecodeString(rpcID.GetAssetIdStr())
-		if err != nil {
-			return universe.Identifier{}, err
-		}
+	mismatch := &unirpc.UniverseRootMismatch{
+		Id:        uniID,
+		NumLeaves: verification.NumLeaves,
+		Repaired:  verification.Repaired,
+	}
+	if verification.PersistedRoot != nil {
+		mismatch.PersistedRoot = marshalMssmtNode(
+			verification.PersistedRoot,
+		)
+	}
+	if verification.CommittedRoot != nil {
+		mismatch.CommittedRoot = marshalMssmtNode(
+			verification.CommittedRoot,
+		)
+	}
+	if verification.ComputedRoot != nil {
+		mismatch.ComputedRoot = marshalMssmtNode(
+			verification.ComputedRoot,
+		)
+	}
 
-		// TODO(roasbeef): reuse with above
+	return mismatch, nil
+}
 
-		var assetID asset.ID
-		copy(assetID[:], assetIDBytes)
+// ComputeUniverseRoot computes the root of a universe tree that holds exactly
+// the given leaves, without accessing any local universe state.
+func (r *rpcServer) ComputeUniverseRoot(ctx context.Context,
+	req *unirpc.ComputeUniverseRootRequest) (
+	*unirpc.ComputeUniverseRootResponse, error) {
 
-		return universe.Identifier{
-			AssetID:   assetID,
-			ProofType: proofType,
-		}, nil
+	leaves := make([]universe.ArchiveLeaf, 0, len(req.Leaves))
+	for idx, rpcLeaf := range req.Leaves {
+		if rpcLeaf.LeafKey == nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"leaf key of leaf at index %d must be set", idx)
+		}
+		if len(rpcLeaf.IssuanceProof) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"proof of leaf at index %d must be set", idx)
+		}
 
-	case rpcID.GetGroupKey() != nil:
-		groupKey, err := parseUserKey(rpcID.GetGroupKey())
+		leafKey, err := unmarshalLeafKey(rpcLeaf.LeafKey)
 		if err != nil {
-			return universe.Identifier{}, err
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid leaf key at index %d: %v", idx, err)
 		}
 
-		return universe.Identifier{
-			GroupKey:  groupKey,
-			ProofType: proofType,
-		}, nil
-
-	case rpcID.GetGroupKeyStr() != "":
-		groupKeyBytes, err := hex.DecodeString(rpcID.GetGroupKeyStr())
+		leaf, err := unmarshalAssetLeaf(rpcLeaf)
 		if err != nil {
-			return universe.Identifier{}, err
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid proof at index %d: %v", idx, err)
 		}
 
-		// TODO(roasbeef): reuse with above
+		leaves = append(leaves, universe.ArchiveLeaf{
+			Key:   leafKey,
+			Proof: leaf.Proof,
+		})
+	}
 
-		groupKey, err := parseUserKey(groupKeyBytes)
+	var expectedRoot mssmt.Node
+	if req.ExpectedRoot != nil {
+		var err error
+		expectedRoot, err = unmarshalMssmtNode(req.ExpectedRoot)
 		if err != nil {
-			return universe.Identifier{}, err
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid expected root: %v", err)
 		}
+	}
 
-		return universe.Identifier{
-			GroupKey:  groupKey,
-			ProofType: proofType,
-		}, nil
+	root, err := universe.ComputeRoot(ctx, leaves)
+	switch {
+	case errors.Is(err, universe.ErrDuplicateLeafKey):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 
-	default:
-		return universe.Identifier{}, fmt.Errorf("no id set")
+	case err != nil:
+		return nil, fmt.Errorf("unable to compute universe root: %w",
+			err)
 	}
+
+	return &unirpc.ComputeUniverseRootResponse{
+		Root: marshalMssmtNode(root),
+		MatchesExpected: expectedRoot != nil &&
+			mssmt.IsEqualNode(root, expectedRoot),
+	}, nil
 }
 
-// QueryAssetRoots attempts to locate the current Universe root for a specific
-// asset. This asset can be identified by its asset ID or group key.
-func (r *rpcServer) QueryAssetRoots(ctx context.Context,
-	req *unirpc.AssetRootQuery) (*unirpc.QueryRootResponse, error) {
+// ExportUniverseSnapshot creates a snapshot of the universe of an asset as it
+// was at the given block height, signed with the node identity key.
+func (r *rpcServer) ExportUniverseSnapshot(ctx context.Context,
+	req *unirpc.UniverseSnapshotRequest) (*unirpc.UniverseSnapshotResponse,
+	error) {
+
+	if r.cfg.UniverseRootSigner == nil {
+		return nil, status.Error(codes.FailedPrecondition, "universe "+
+			"root signing is disabled, enable it with "+
+			"universe.signroots")
+	}
 
 	universeID, err := UnmarshalUniID(req.Id)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "invalid "+
+			"universe ID: %v", err)
+	}
+	if universeID.ProofType == universe.ProofTypeUnspecified {
+		return nil, status.Error(codes.InvalidArgument, "proof type "+
+			"must be specified")
 	}
 
-	// Attempt to retrieve the issuance universe root.
-	rpcsLog.Debugf("Querying for asset (group) issuance universe root "+
-		"for %v", spew.Sdump(universeID))
-
-	universeID.ProofType = universe.ProofTypeIssuance
-
-	// Ensure proof export is enabled for the given universe.
-	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	bestHeight, err := r.cfg.ChainBridge.CurrentHeight(ctx)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to fetch current height: %w",
+			err)
 	}
 
-	if !syncConfigs.IsSyncExportEnabled(universeID) {
-		return nil, fmt.Errorf("proof export is disabled for the " +
-			"given universe")
-	}
+	// The snapshot can't be created for a block we don't know yet, as it
+	// wouldn't commit to any leaves confirmed until then.
+	blockHeight := req.BlockHeight
+	switch {
+	case blockHeight == 0:
+		blockHeight = bestHeight
 
-	issuanceRoot, err := r.cfg.BaseUniverse.RootNode(ctx, universeID)
-	if err != nil {
-		// Do not return at this point if the error only indicates that
-		// the root wasn't found. We'll try to find the transfer root
-		// below.
-		if !errors.Is(err, universe.ErrNoUniverseRoot) {
-			return nil, err
-		}
+	case blockHeight > bestHeight:
+		return nil, status.Errorf(codes.InvalidArgument, "block "+
+			"height %d is above the current best height %d",
+			blockHeight, bestHeight)
 	}
 
-	issuanceRootRPC, err := marshalUniverseRoot(issuanceRoot)
+	blockHash, err := r.cfg.ChainBridge.GetBlockHash(
+		ctx, int64(blockHeight),
+	)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to fetch block hash: %w", err)
 	}
 
-	// Attempt to retrieve the transfer universe root.
-	rpcsLog.Debugf("Querying for asset (group) transfer universe root "+
-		"for %v", spew.Sdump(universeID))
-
-	universeID.ProofType = universe.ProofTypeTransfer
+	snapshot, err := r.cfg.BaseUniverse.UniverseSnapshot(
+		ctx, universeID, blockHeight, blockHash,
+	)
+	switch {
+	case errors.Is(err, universe.ErrNoUniverseRoot):
+		return nil, status.Error(codes.NotFound, err.Error())
 
-	transferRoot, err := r.cfg.BaseUniverse.RootNode(ctx, universeID)
-	if err != nil {
-		// Do not return at this point if the error only indicates that
-		// the root wasn't found. We may have found the issuance root
-		// above.
-		if !errors.Is(err, universe.ErrNoUniverseRoot) {
-			return nil, err
-		}
+	case err != nil:
+		return nil, fmt.Errorf("unable to create universe snapshot: "+
+			"%w", err)
 	}
 
-	transferRootRPC, err := marshalUniverseRoot(transferRoot)
+	sig, err := r.signRootMsg(ctx, universe.UniverseSnapshotMsg(snapshot))
 	if err != nil {
 		return nil, err
 	}
 
-	return &unirpc.QueryRootResponse{
-		IssuanceRoot: issuanceRootRPC,
-		TransferRoot: transferRootRPC,
+	rpcID, err := MarshalUniID(snapshot.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.UniverseSnapshotResponse{
+		Snapshot: &unirpc.UniverseSnapshot{
+			Id:          rpcID,
+			Root:        marshalMssmtNode(snapshot.Root),
+			NumLeaves:   snapshot.NumLeaves,
+			BlockHeight: snapshot.BlockHeight,
+			BlockHash:   snapshot.BlockHash.String(),
+		},
+		Signature: sig,
+		SignerPubkey: r.cfg.UniverseRootSigner.PubKey().
+			SerializeCompressed(),
 	}, nil
 }
 
-// DeleteAssetRoot attempts to locate the current Universe root for a specific
-// asset, and deletes the associated Universe tree if found.
-func (r *rpcServer) DeleteAssetRoot(ctx context.Context,
-	req *unirpc.DeleteRootQuery) (*unirpc.DeleteRootResponse, error) {
+// PruneUniverse removes the issuance and transfer universe trees of all assets
+// that match the given criteria, returning a summary of the pruned assets.
+func (r *rpcServer) PruneUniverse(ctx context.Context,
+	req *unirpc.PruneUniverseRequest) (*unirpc.PruneUniverseResponse,
+	error) {
 
-	universeID, err := UnmarshalUniID(req.Id)
-	if err != nil {
-		return nil, err
+	if !req.ZeroSupply && req.OlderThanBlocks == 0 {
+		return nil, fmt.Errorf("at least one of zero_supply or " +
+			"older_than_blocks must be set")
 	}
 
-	rpcsLog.Debugf("Deleting asset root for %v", spew.Sdump(universeID))
+	criteria := universe.PruneCriteria{
+		ZeroSupply: req.ZeroSupply,
+		DryRun:     req.DryRun,
+	}
 
-	// If the universe proof type is unspecified, we'll delete both the
-	// issuance and transfer roots.
-	if universeID.ProofType == universe.ProofTypeUnspecified {
-		universeID.ProofType = universe.ProofTypeIssuance
-		_, err := r.cfg.BaseUniverse.DeleteRoot(ctx, universeID)
+	resp := &unirpc.PruneUniverseResponse{
+		DryRun: req.DryRun,
+	}
+
+	if req.OlderThanBlocks != 0 {
+		currentHeight, err := r.cfg.ChainBridge.CurrentHeight(ctx)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("unable to fetch current "+
+				"height: %w", err)
 		}
 
-		universeID.ProofType = universe.ProofTypeTransfer
-		_, err = r.cfg.BaseUniverse.DeleteRoot(ctx, universeID)
-		if err != nil {
-			return nil, err
+		// If the chain isn't long enough yet, then no asset can be
+		// old enough to be pruned.
+		if currentHeight <= req.OlderThanBlocks {
+			return resp, nil
 		}
 
-		return &unirpc.DeleteRootResponse{}, nil
+		criteria.MaxUpdateHeight = currentHeight - req.OlderThanBlocks
 	}
 
-	// At this point the universe proof type was specified, so we'll only
-	// delete the root for that proof type.
-	_, err = r.cfg.BaseUniverse.DeleteRoot(ctx, universeID)
+	prunedAssets, err := r.cfg.BaseUniverse.PruneUniverses(ctx, criteria)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to prune universe: %w", err)
 	}
 
-	return &unirpc.DeleteRootResponse{}, nil
-}
+	for _, prunedAsset := range prunedAssets {
+		uniID, err := MarshalUniID(prunedAsset.ID)
+		if err != nil {
+			return nil, err
+		}
 
-func marshalLeafKey(leafKey universe.LeafKey) *unirpc.AssetKey {
-	return &unirpc.AssetKey{
-		Outpoint: &unirpc.AssetKey_OpStr{
-			OpStr: leafKey.OutPoint.String(),
-		},
-		ScriptKey: &unirpc.AssetKey_ScriptKeyBytes{
-			ScriptKeyBytes: schnorr.SerializePubKey(
-				leafKey.ScriptKey.PubKey,
-			),
-		},
+		rpcAsset := &unirpc.PrunedAsset{
+			Id:               uniID,
+			AssetName:        prunedAsset.AssetName,
+			IssuedSupply:     prunedAsset.IssuedSupply,
+			BurnedSupply:     prunedAsset.BurnedSupply,
+			LastUpdateHeight: prunedAsset.LastUpdateHeight,
+		}
+		resp.PrunedAssets = append(resp.PrunedAssets, rpcAsset)
 	}
+
+	return resp, nil
 }
 
-// AssetLeafKeys queries for the set of Universe keys associated with a given
-// asset_id or group_key. Each key takes the form: (outpoint, script_key),
-// where outpoint is an outpoint in the Bitcoin blockchain that anchors a valid
-// Taproot Asset commitment, and script_key is the script_key of the asset
-// within the Taproot Asset commitment for the given asset_id or group_key.
-func (r *rpcServer) AssetLeafKeys(ctx context.Context,
-	req *unirpc.ID) (*unirpc.AssetLeafKeyResponse, error) {
+// PruneSpentLeaves prunes the proofs of all transfer leaves that are spent by a
+// transfer with at least the given number of confirmations, returning the
+// pruned leaves.
+func (r *rpcServer) PruneSpentLeaves(ctx context.Context,
+	req *unirpc.PruneSpentLeavesRequest) (*unirpc.PruneSpentLeavesResponse,
+	error) {
 
-	universeID, err := UnmarshalUniID(req)
+	minConfs := req.MinConfirmations
+	if minConfs == 0 {
+		minConfs = r.cfg.SpentLeafRetention
+	}
+	if minConfs == 0 {
+		return nil, fmt.Errorf("min_confirmations must be set if no " +
+			"spent leaf retention policy is configured")
+	}
+
+	currentHeight, err := r.cfg.ChainBridge.CurrentHeight(ctx)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to fetch current height: %w",
+			err)
 	}
 
-	// TODO(roasbeef): tell above if was tring or not, then would set
-	// below diff
+	prunedLeaves, err := r.cfg.BaseUniverse.PruneSpentLeaves(
+		ctx, universe.SpentLeafPruneCriteria{
+			MinConfs:      minConfs,
+			CurrentHeight: currentHeight,
+			DryRun:        req.DryRun,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to prune spent leaves: %w", err)
+	}
 
-	leafKeys, err := r.cfg.BaseUniverse.UniverseLeafKeys(ctx, universeID)
+	resp := &unirpc.PruneSpentLeavesResponse{
+		DryRun: req.DryRun,
+	}
+	for _, prunedLeaf := range prunedLeaves {
+		uniID, err := MarshalUniID(prunedLeaf.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcLeaf := &unirpc.PrunedLeaf{
+			Id:          uniID,
+			LeafKey:     marshalLeafKey(prunedLeaf.Key),
+			Amount:      prunedLeaf.Amount,
+			SpentHeight: prunedLeaf.SpentHeight,
+		}
+		resp.PrunedLeaves = append(resp.PrunedLeaves, rpcLeaf)
+	}
+
+	return resp, nil
+}
+
+// ListPrunedLeaves returns the keys of all leaves of the given universe that
+// were pruned.
+func (r *rpcServer) ListPrunedLeaves(ctx context.Context,
+	req *unirpc.ListPrunedLeavesRequest) (*unirpc.ListPrunedLeavesResponse,
+	error) {
+
+	universeID, err := UnmarshalUniID(req.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := &unirpc.AssetLeafKeyResponse{
-		AssetKeys: make([]*unirpc.AssetKey, len(leafKeys)),
+	leafKeys, err := r.cfg.BaseUniverse.PrunedLeafKeys(ctx, universeID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch pruned leaves: %w", err)
 	}
 
-	for i, leafKey := range leafKeys {
-		resp.AssetKeys[i] = marshalLeafKey(leafKey)
+	resp := &unirpc.ListPrunedLeavesResponse{
+		LeafKeys: make([]*unirpc.AssetKey, 0, len(leafKeys)),
+	}
+	for _, leafKey := range leafKeys {
+		resp.LeafKeys = append(resp.LeafKeys, marshalLeafKey(leafKey))
 	}
 
 	return resp, nil
 }
 
-func marshalAssetLeaf(ctx context.Context, keys taprpc.KeyLookup,
-	assetLeaf *universe.Leaf) (*unirpc.AssetLeaf, error) {
+// CompactUniverseDB compacts the universe store of the database, returning the
+// size of the database before and after the compaction.
+func (r *rpcServer) CompactUniverseDB(ctx context.Context,
+	_ *unirpc.CompactUniverseDBRequest) (*unirpc.CompactUniverseDBResponse,
+	error) {
 
-	// In order to display the full asset, we'll also encode the genesis
-	// proof.
-	var buf bytes.Buffer
-	if err := assetLeaf.Proof.Encode(&buf); err != nil {
-		return nil, err
+	if r.cfg.DBCompactor == nil {
+		return nil, status.Error(codes.Unimplemented, "database "+
+			"compaction isn't supported by the database backend")
 	}
 
-	rpcAsset, err := taprpc.MarshalAsset(
-		ctx, &assetLeaf.Proof.Asset, false, true, keys,
-	)
+	rpcsLog.Infof("[CompactUniverseDB]: compacting universe store")
+
+	stats, err := r.cfg.DBCompactor.CompactUniverse(ctx)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to compact universe store: %w",
+			err)
 	}
 
-	return &unirpc.AssetLeaf{
-		Asset:         rpcAsset,
-		IssuanceProof: buf.Bytes(),
+	return &unirpc.CompactUniverseDBResponse{
+		SizeBeforeBytes: stats.SizeBefore,
+		SizeAfterBytes:  stats.SizeAfter,
+		DurationMs:      stats.Duration.Milliseconds(),
 	}, nil
 }
 
-// marshalAssetLeaf marshals an asset leaf into the RPC form.
-func (r *rpcServer) marshalAssetLeaf(ctx context.Context,
-	assetLeaf *universe.Leaf) (*unirpc.AssetLeaf, error) {
+// remoteUniverseAddr returns the server address of the remote universe with
+// the given host. If the host is a federation server, then its server specific
+// proxy is used to connect to it, otherwise the global universe proxy, if any.
+func (r *rpcServer) remoteUniverseAddr(ctx context.Context,
+	host string) (universe.ServerAddr, error) {
 
-	return marshalAssetLeaf(ctx, r.cfg.AddrBook, assetLeaf)
+	uniAddr := universe.NewServerAddrFromStr(host)
+
+	servers, err := r.cfg.FederationDB.UniverseServers(ctx)
+	if err != nil {
+		return uniAddr, fmt.Errorf("unable to fetch federation "+
+			"servers: %w", err)
+	}
+	for _, server := range servers {
+		if server.HostStr() == host {
+			uniAddr.Proxy = server.Proxy
+			break
+		}
+	}
+
+	return universe.WithDefaultProxy(uniAddr, r.cfg.UniverseProxy), nil
 }
 
-// AssetLeaves queries for the set of asset leaves (the values in the Universe
-// MS-SMT tree) for a given asset_id or group_key. These represents either
-// asset issuance events (they have a genesis witness) or asset transfers that
-// took place on chain. The leaves contain a normal Taproot asset proof, as well
-// as details for the asset.
-func (r *rpcServer) AssetLeaves(ctx context.Context,
-	req *unirpc.ID) (*unirpc.AssetLeafResponse, error) {
+// marshalUniverseServer marshals a federation server into its RPC counterpart.
+// The proxy password is never returned.
+func marshalUniverseServer(server universe.ServerAddr,
+) *unirpc.UniverseFederationServer {
 
-	universeID, err := UnmarshalUniID(req)
-	if err != nil {
-		return nil, err
+	rpcServer := &unirpc.UniverseFederationServer{
+		Host:    server.HostStr(),
+		Id:      int32(server.ID),
+		TlsCert: server.TLSCert,
+		SyncIntervalSeconds: uint64(
+			server.SyncInterval / time.Second,
+		),
+		LastSyncTimestamp: server.LastSyncTime.Unix(),
+		Priority:          int32(server.Priority),
+	}
+	if server.Proxy != nil {
+		rpcServer.ProxyAddr = server.Proxy.Addr
+		rpcServer.ProxyUser = server.Proxy.User
 	}
 
-	assetLeaves, err := r.cfg.BaseUniverse.MintingLeaves(ctx, universeID)
+	return rpcServer
+}
+
+// ListFederationServers lists the set of servers that make up the federation
+// of the local Universe server. This servers are used to push out new proofs,
+// and also periodically call sync new proofs from the remote server.
+func (r *rpcServer) ListFederationServers(ctx context.Context,
+	_ *unirpc.ListFederationServersRequest,
+) (*unirpc.ListFederationServersResponse, error) {
+
+	uniServers, err := r.cfg.FederationDB.UniverseServers(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := &unirpc.AssetLeafResponse{
-		Leaves: make([]*unirpc.AssetLeaf, len(assetLeaves)),
+	numPendingPushes, err := r.cfg.UniverseFederation.NumPendingPushes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch pending pushes: %w",
+			err)
 	}
-	for i, assetLeaf := range assetLeaves {
-		assetLeaf := assetLeaf
 
-		resp.Leaves[i], err = r.marshalAssetLeaf(ctx, &assetLeaf)
-		if err != nil {
-			return nil, err
+	rpcServers := fn.Map(uniServers, marshalUniverseServer)
+	for idx, server := range uniServers {
+		health, ok := r.cfg.UniverseFederation.ServerHealth(server)
+		if ok {
+			rpcServers[idx].Health = marshalServerHealth(health)
 		}
+
+		host := server.HostStr()
+		rpcServers[idx].NumPendingPushes = numPendingPushes[host]
 	}
 
-	return resp, nil
+	return &unirpc.ListFederationServersResponse{
+		Servers: rpcServers,
+	}, nil
 }
 
-// UnmarshalOutpoint un-marshals an outpoint from a string received via RPC.
-func UnmarshalOutpoint(outpoint string) (*wire.OutPoint, error) {
-	parts := strings.Split(outpoint, ":")
-	if len(parts) != 2 {
-		return nil, errors.New("outpoint should be of form txid:index")
+// marshalServerHealth marshals the health of a federation server into the RPC
+// form.
+func marshalServerHealth(
+	health universe.ServerHealth) *unirpc.FederationServerHealth {
+
+	rpcHealth := &unirpc.FederationServerHealth{
+		LastCheckTimestamp: health.LastCheck.Unix(),
+		Reachable:          health.Reachable,
+		InSync:             health.InSync,
+		Stale:              health.Stale,
+	}
+	if !health.LastContact.IsZero() {
+		rpcHealth.LastContactTimestamp = health.LastContact.Unix()
+	}
+	if health.Err != nil {
+		rpcHealth.Error = health.Err.Error()
 	}
 
-	txidStr := parts[0]
-	if hex.DecodedLen(len(txidStr)) != chainhash.HashSize {
-		return nil, fmt.Errorf("invalid hex-encoded txid %v", txidStr)
+	return rpcHealth
+}
+
+// CheckFederationServer probes a server of the federation of the local
+// Universe server on demand, and returns its connectivity and sync status.
+func (r *rpcServer) CheckFederationServer(ctx context.Context,
+	req *unirpc.CheckFederationServerRequest,
+) (*unirpc.CheckFederationServerResponse, error) {
+
+	if req.Host == "" {
+		return nil, fmt.Errorf("server host must be set")
 	}
 
-	txid, err := chainhash.NewHashFromStr(txidStr)
+	// We only check servers that are part of the federation, so we can
+	// use their pinned TLS certificate, if any.
+	uniServers, err := r.cfg.FederationDB.UniverseServers(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	outputIndex, err := strconv.Atoi(parts[1])
+	var server *universe.ServerAddr
+	for idx := range uniServers {
+		if uniServers[idx].HostStr() == req.Host {
+			server = &uniServers[idx]
+			break
+		}
+	}
+	if server == nil {
+		return nil, fmt.Errorf("server %v is not a federation member",
+			req.Host)
+	}
+
+	health, err := r.cfg.UniverseFederation.CheckServerHealth(
+		ctx, *server,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("invalid output index: %v", err)
+		return nil, fmt.Errorf("unable to check server %v: %w",
+			req.Host, err)
 	}
 
-	return &wire.OutPoint{
-		Hash:  *txid,
-		Index: uint32(outputIndex),
+	rpcServer := marshalUniverseServer(*server)
+	rpcServer.Health = marshalServerHealth(health)
+
+	return &unirpc.CheckFederationServerResponse{
+		Server: rpcServer,
 	}, nil
 }
 
-// unmarshalLeafKey un-marshals a leaf key from the RPC form.
-func unmarshalLeafKey(key *unirpc.AssetKey) (universe.LeafKey, error) {
-	var (
-		leafKey universe.LeafKey
-		err     error
+// ListFederationRoots fetches the universe roots of all servers of the
+// federation of the local Universe server in a single call.
+func (r *rpcServer) ListFederationRoots(ctx context.Context,
+	req *unirpc.ListFederationRootsRequest,
+) (*unirpc.ListFederationRootsResponse, error) {
+
+	timeout := time.Duration(req.ServerTimeoutSeconds) * time.Second
+	fedRoots, err := r.cfg.UniverseFederation.FetchFederationRoots(
+		ctx, int(req.MaxConcurrency), timeout,
 	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch federation roots: %w",
+			err)
+	}
 
-	switch {
-	case key.GetScriptKeyBytes() != nil:
-		pubKey, err := parseUserKey(key.GetScriptKeyBytes())
-		if err != nil {
-			return leafKey, err
+	serverRoots := make(
+		map[string]*unirpc.FederationServerRoots, len(fedRoots),
+	)
+	for _, fedRoot := range fedRoots {
+		rpcRoots := &unirpc.FederationServerRoots{}
+		if fedRoot.Err != nil {
+			rpcRoots.Error = fedRoot.Err.Error()
 		}
 
-		leafKey.ScriptKey = &asset.ScriptKey{
-			PubKey: pubKey,
-		}
+		for _, root := range fedRoot.Roots {
+			rpcRoot, err := marshalUniverseRoot(root)
+			if err != nil {
+				return nil, err
+			}
 
-	case key.GetScriptKeyStr() != "":
-		scriptKeyBytes, sErr := hex.DecodeString(key.GetScriptKeyStr())
-		if sErr != nil {
-			return leafKey, err
+			rpcRoots.Roots = append(rpcRoots.Roots, rpcRoot)
 		}
 
-		pubKey, err := parseUserKey(scriptKeyBytes)
-		if err != nil {
-			return leafKey, err
-		}
+		serverRoots[fedRoot.Server.HostStr()] = rpcRoots
+	}
 
-		leafKey.ScriptKey = &asset.ScriptKey{
-			PubKey: pubKey,
+	return &unirpc.ListFederationRootsResponse{
+		ServerRoots: serverRoots,
+	}, nil
+}
+
+func unmarshalUniverseServer(
+	server *unirpc.UniverseFederationServer) (universe.ServerAddr, error) {
+
+	serverAddr := universe.NewServerAddr(int64(server.Id), server.Host)
+	serverAddr.TLSCert = server.TlsCert
+	serverAddr.SyncInterval = time.Duration(
+		server.SyncIntervalSeconds,
+	) * time.Second
+	serverAddr.Priority = int(server.Priority)
+
+	switch {
+	case server.ProxyAddr != "":
+		serverAddr.Proxy = &universe.ProxyConfig{
+			Addr:     server.ProxyAddr,
+			User:     server.ProxyUser,
+			Password: server.ProxyPassword,
 		}
-	default:
-		// TODO(roasbeef): can actually allow not to be, then would
-		// fetch all for the given outpoint
-		return leafKey, fmt.Errorf("script key must be set")
+		if err := serverAddr.Proxy.Validate(); err != nil {
+			return serverAddr, err
+		}
+
+	case server.ProxyUser != "" || server.ProxyPassword != "":
+		return serverAddr, fmt.Errorf("proxy credentials set without " +
+			"proxy address")
 	}
 
-	switch {
-	case key.GetOpStr() != "":
-		// Parse a bitcoin outpoint in the form txid:index into a
-		// wire.OutPoint struct.
-		outpointStr := key.GetOpStr()
-		outpoint, err := UnmarshalOutpoint(outpointStr)
+	return serverAddr, nil
+}
+
+// AddFederationServer adds a new server to the federation of the local
+// Universe server. Once a server is added, this call can also optionally be
+// used to trigger a sync of the remote server.
+func (r *rpcServer) AddFederationServer(ctx context.Context,
+	req *unirpc.AddFederationServerRequest,
+) (*unirpc.AddFederationServerResponse, error) {
+
+	serversToAdd, err := fn.MapErr(req.Servers, unmarshalUniverseServer)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx := range serversToAdd {
+		server := universe.WithDefaultProxy(
+			serversToAdd[idx], r.cfg.UniverseProxy,
+		)
+
+		// Before we add the server as a federation member, we check
+		// that we can actually connect to it and that it isn't
+		// ourselves.
+		err := CheckFederationServer(
+			r.cfg.RuntimeID, universe.DefaultTimeout, server,
+		)
 		if err != nil {
-			return leafKey, err
+			return nil, err
 		}
+	}
+
+	err = r.cfg.UniverseFederation.AddServer(serversToAdd...)
+	if err != nil {
+		return nil, err
+	}
 
-		leafKey.OutPoint = *outpoint
+	resp := &unirpc.AddFederationServerResponse{}
+	if !req.PushOnRegister {
+		return resp, nil
+	}
 
-	case key.GetOutpoint() != nil:
-		op := key.GetOp()
+	// Now that we've synced with the new servers, we'll also push out our
+	// local state, so the servers learn about all the leaves they didn't
+	// know about yet.
+	for idx := range serversToAdd {
+		server := serversToAdd[idx]
 
-		hash, err := chainhash.NewHashFromStr(op.HashStr)
+		failedPushes, err := r.cfg.UniverseFederation.PushLocalState(
+			ctx, server,
+		)
 		if err != nil {
-			return leafKey, err
+			return nil, fmt.Errorf("unable to push local state to "+
+				"server %v: %w", server.HostStr(), err)
 		}
 
-		leafKey.OutPoint = wire.OutPoint{
-			Hash:  *hash,
-			Index: uint32(op.Index),
-		}
+		for _, failedPush := range failedPushes {
+			rpcFailedPush, err := marshalFailedLeafPush(failedPush)
+			if err != nil {
+				return nil, err
+			}
 
-	default:
-		return leafKey, fmt.Errorf("outpoint not set: %v", err)
+			resp.FailedPushes = append(
+				resp.FailedPushes, rpcFailedPush,
+			)
+		}
 	}
 
-	return leafKey, nil
+	return resp, nil
 }
 
-// marshalMssmtProof marshals a MS-SMT proof into the RPC form.
-func marshalMssmtProof(proof *mssmt.Proof) ([]byte, error) {
-	compressedProof := proof.Compress()
+// marshalFailedLeafPush marshals a failed leaf push into the RPC form.
+func marshalFailedLeafPush(
+	failedPush universe.FailedLeafPush) (*unirpc.FailedLeafPush, error) {
 
-	var b bytes.Buffer
-	if err := compressedProof.Encode(&b); err != nil {
+	uniID, err := MarshalUniID(failedPush.ID)
+	if err != nil {
 		return nil, err
 	}
 
-	return b.Bytes(), nil
+	return &unirpc.FailedLeafPush{
+		ServerHost: failedPush.ServerAddr.HostStr(),
+		Key: &unirpc.UniverseKey{
+			Id:      uniID,
+			LeafKey: marshalLeafKey(failedPush.Key),
+		},
+		Error: failedPush.Err.Error(),
+	}, nil
 }
 
-// marshalIssuanceProof marshals an issuance proof into the RPC form.
-func (r *rpcServer) marshalIssuanceProof(ctx context.Context,
-	req *unirpc.UniverseKey,
-	proof *universe.Proof) (*unirpc.AssetProofResponse, error) {
+// DeleteFederationServer removes a server from the federation of the local
+// Universe server.
+func (r *rpcServer) DeleteFederationServer(ctx context.Context,
+	req *unirpc.DeleteFederationServerRequest,
+) (*unirpc.DeleteFederationServerResponse, error) {
 
-	uniProof, err := marshalMssmtProof(proof.UniverseInclusionProof)
+	serversToDel, err := fn.MapErr(req.Servers, unmarshalUniverseServer)
 	if err != nil {
 		return nil, err
 	}
 
-	assetLeaf, err := r.marshalAssetLeaf(ctx, proof.Leaf)
+	err = r.cfg.UniverseFederation.RemoveServer(serversToDel...)
 	if err != nil {
 		return nil, err
 	}
 
-	uniRoot, err := marshalUniverseRoot(universe.BaseRoot{
-		Node: proof.UniverseRoot,
-	})
-	if err != nil {
-		return nil, err
-	}
+	return &unirpc.DeleteFederationServerResponse{}, nil
+}
 
-	uniRoot.AssetName = assetLeaf.Asset.AssetGenesis.Name
-	uniRoot.Id = req.Id
+// SetFederationServerPriority updates the priority of a server of the
+// federation of the local Universe server.
+func (r *rpcServer) SetFederationServerPriority(ctx context.Context,
+	req *unirpc.SetFederationServerPriorityRequest,
+) (*unirpc.SetFederationServerPriorityResponse, error) {
 
-	// Marshal multiverse specific fields.
-	multiverseRoot := marshalMssmtNode(proof.MultiverseRoot)
+	if req.Host == "" && req.Id == 0 {
+		return nil, fmt.Errorf("server host or ID must be set")
+	}
 
-	multiverseProof, err := marshalMssmtProof(
-		proof.MultiverseInclusionProof,
+	serverAddr := universe.NewServerAddr(int64(req.Id), req.Host)
+	err := r.cfg.UniverseFederation.UpdateServerPriority(
+		serverAddr, int(req.Priority),
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &unirpc.AssetProofResponse{
-		Req:                      req,
-		UniverseRoot:             uniRoot,
-		UniverseInclusionProof:   uniProof,
-		AssetLeaf:                assetLeaf,
-		MultiverseRoot:           multiverseRoot,
-		MultiverseInclusionProof: multiverseProof,
-	}, nil
-}
-
-// QueryProof attempts to query for an issuance proof for a given asset based
-// on its UniverseKey. A UniverseKey is composed of the Universe ID
-// (asset_id/group_key) and also a leaf key (outpoint || script_key). If found,
-// then the issuance proof is returned that includes an inclusion proof to the
-// known Universe root, as well as a Taproot Asset state transition or issuance
-// proof for the said asset.
-func (r *rpcServer) QueryProof(ctx context.Context,
-	req *unirpc.UniverseKey) (*unirpc.AssetProofResponse, error) {
-
-	universeID, err := UnmarshalUniID(req.Id)
-	if err != nil {
-		return nil, err
-	}
-	leafKey, err := unmarshalLeafKey(req.LeafKey)
+	// We'll return the updated server as it's now stored, so the caller
+	// can verify the new priority.
+	uniServers, err := r.cfg.FederationDB.UniverseServers(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	rpcsLog.Debugf("[QueryProof]: fetching proof at (universeID=%v, "+
-		"leafKey=%x)", universeID, leafKey.UniverseKey())
+	for _, server := range uniServers {
+		switch {
+		case req.Host != "" && server.HostStr() == req.Host:
+		case req.Host == "" && server.ID == int64(req.Id):
+		default:
+			continue
+		}
 
-	// Retrieve proof export config for the given universe.
-	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
-	if err != nil {
-		return nil, err
+		return &unirpc.SetFederationServerPriorityResponse{
+			Server: marshalUniverseServer(server),
+		}, nil
 	}
 
-	var candidateIDs []universe.Identifier
+	return nil, universe.ErrUnknownUniverseServer
+}
 
-	if universeID.ProofType == universe.ProofTypeUnspecified {
-		// If the proof type is unspecified, then we'll attempt to
-		// retrieve both the issuance and transfer proofs. We gather the
-		// corresponding universe IDs into a candidate set.
-		universeID.ProofType = universe.ProofTypeIssuance
-		if syncConfigs.IsSyncExportEnabled(universeID) {
-			candidateIDs = append(candidateIDs, universeID)
-		}
+// SetFederationSyncConfig sets the configuration of the universe federation
+// sync.
+func (r *rpcServer) SetFederationSyncConfig(ctx context.Context,
+	req *unirpc.SetFederationSyncConfigRequest) (
+	*unirpc.SetFederationSyncConfigResponse, error) {
 
-		universeID.ProofType = universe.ProofTypeTransfer
-		if syncConfigs.IsSyncExportEnabled(universeID) {
-			candidateIDs = append(candidateIDs, universeID)
-		}
-	} else {
-		// Otherwise, we'll only attempt to retrieve the proof for the
-		// specified proof type. But first we'll check that proof export
-		// is enabled for the given universe.
-		if !syncConfigs.IsSyncExportEnabled(universeID) {
-			return nil, fmt.Errorf("proof export is disabled for " +
-				"the given universe")
-		}
+	// Unmarshal global sync configs.
+	globalSyncConfig := make(
+		[]*universe.FedGlobalSyncConfig, len(req.GlobalSyncConfigs),
+	)
+	for i := range req.GlobalSyncConfigs {
+		config := req.GlobalSyncConfigs[i]
 
-		candidateIDs = append(candidateIDs, universeID)
-	}
+		proofType, err := UnmarshalUniProofType(config.ProofType)
+		if err != nil {
+			return nil, fmt.Errorf("unable to unmarshal "+
+				"proof type: %w", err)
+		}
 
-	// If no candidate IDs were applicable then our config must have
-	// disabled proof export for the given universe.
-	if len(candidateIDs) == 0 {
-		return nil, fmt.Errorf("proof export is disabled for the " +
-			"given universe")
+		globalSyncConfig[i] = &universe.FedGlobalSyncConfig{
+			ProofType:       proofType,
+			AllowSyncInsert: config.AllowSyncInsert,
+			AllowSyncExport: config.AllowSyncExport,
+		}
 	}
 
-	// Attempt to retrieve the proof given the candidate set of universe
-	// IDs.
-	var proofs []*universe.Proof
-	for i := range candidateIDs {
-		candidateID := candidateIDs[i]
-
-		proofs, err = r.cfg.BaseUniverse.FetchIssuanceProof(
-			ctx, candidateID, leafKey,
-		)
+	// Unmarshal asset (asset/asset group) specific sync configs.
+	assetSyncConfigs := make(
+		[]*universe.FedUniSyncConfig, len(req.AssetSyncConfigs),
+	)
+	for i := range req.AssetSyncConfigs {
+		assetSyncConfig := req.AssetSyncConfigs[i]
+		config, err := unmarshalAssetSyncConfig(assetSyncConfig)
 		if err != nil {
-			if errors.Is(err, universe.ErrNoUniverseProofFound) {
-				continue
-			}
-
-			rpcsLog.Debugf("[QueryProof]: error querying for "+
-				"proof at (universeID=%v, leafKey=%x)",
-				universeID, leafKey.UniverseKey())
-			return nil, err
+			return nil, fmt.Errorf("unable to parse asset sync "+
+				"config: %w", err)
 		}
 
-		// At this point we've found a proof, so we'll break out of the
-		// loop. We don't need to attempt to retrieve a proof for any
-		// other candidate IDs.
-		break
-	}
-
-	if len(proofs) == 0 {
-		return nil, universe.ErrNoUniverseProofFound
+		assetSyncConfigs[i] = config
 	}
 
-	// TODO(roasbeef): query may return multiple proofs, if allow key to
-	// not be fully specified
-	proof := proofs[0]
-
-	rpcsLog.Debugf("[QueryProof]: found proof at (universeID=%v, "+
-		"leafKey=%x)", universeID, leafKey.UniverseKey())
-
-	return r.marshalIssuanceProof(ctx, req, proof)
-}
-
-// unmarshalAssetLeaf unmarshals an asset leaf from the RPC form.
-func unmarshalAssetLeaf(leaf *unirpc.AssetLeaf) (*universe.Leaf, error) {
-	// We'll just pull the asset details from the serialized issuance proof
-	// itself.
-	var assetProof proof.Proof
-	if err := assetProof.Decode(
-		bytes.NewReader(leaf.IssuanceProof),
-	); err != nil {
-		return nil, err
+	// Update asset (asset/asset group) specific sync configs.
+	err := r.cfg.FederationDB.UpsertFederationSyncConfig(
+		ctx, globalSyncConfig, assetSyncConfigs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to set federation sync "+
+			"config: %w", err)
 	}
 
-	// TODO(roasbeef): double check posted file format everywhere
-	//  * raw proof, or within file?
-
-	return &universe.Leaf{
-		GenesisWithGroup: universe.GenesisWithGroup{
-			Genesis:  assetProof.Asset.Genesis,
-			GroupKey: assetProof.Asset.GroupKey,
-		},
-		Proof: &assetProof,
-		Amt:   assetProof.Asset.Amount,
-	}, nil
+	return &unirpc.SetFederationSyncConfigResponse{}, nil
 }
 
-// InsertProof attempts to insert a new issuance proof into the Universe tree
-// specified by the UniverseKey. If valid, then the proof is inserted into the
-// database, with a new Universe root returned for the updated
-// asset_id/group_key.
-func (r *rpcServer) InsertProof(ctx context.Context,
-	req *unirpc.AssetProof) (*unirpc.AssetProofResponse, error) {
-
-	if req.Key == nil {
-		return nil, fmt.Errorf("key cannot be nil")
-	}
+// QueryFederationSyncConfig queries the universe federation sync configuration
+// settings.
+func (r *rpcServer) QueryFederationSyncConfig(ctx context.Context,
+	_ *unirpc.QueryFederationSyncConfigRequest,
+) (*unirpc.QueryFederationSyncConfigResponse, error) {
 
-	universeID, err := UnmarshalUniID(req.Key.Id)
-	if err != nil {
-		return nil, err
-	}
-	leafKey, err := unmarshalLeafKey(req.Key.LeafKey)
+	// Obtain the general and universe specific federation sync configs.
+	queryFedSyncConfigs := r.cfg.FederationDB.QueryFederationSyncConfigs
+	globalConfigs, uniSyncConfigs, err := queryFedSyncConfigs(ctx)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to query federation sync "+
+			"config(s): %w", err)
 	}
 
-	assetLeaf, err := unmarshalAssetLeaf(req.AssetLeaf)
-	if err != nil {
-		return nil, err
+	// Marshal the general sync config into the RPC form.
+	globalConfigRPC := make(
+		[]*unirpc.GlobalFederationSyncConfig, len(globalConfigs),
+	)
+	for i := range globalConfigs {
+		globalConfig := globalConfigs[i]
+
+		proofTypeRpc, err := MarshalUniProofType(globalConfig.ProofType)
+		if err != nil {
+			return nil, fmt.Errorf("unable to unmarshal "+
+				"proof type: %w", err)
+		}
+
+		globalConfigRPC[i] = &unirpc.GlobalFederationSyncConfig{
+			ProofType:       proofTypeRpc,
+			AllowSyncInsert: globalConfig.AllowSyncInsert,
+			AllowSyncExport: globalConfig.AllowSyncExport,
+		}
 	}
 
-	// If universe proof type unspecified, set based on the provided asset
-	// proof.
-	if universeID.ProofType == universe.ProofTypeUnspecified {
-		universeID.ProofType, err = universe.NewProofTypeFromAssetProof(
-			assetLeaf.Proof,
-		)
+	// Marshal universe specific sync configs into the RPC form.
+	uniConfigRPCs := make(
+		[]*unirpc.AssetFederationSyncConfig, len(uniSyncConfigs),
+	)
+	for i := range uniSyncConfigs {
+		uniSyncConfig := uniSyncConfigs[i]
+		uniConfigRPC, err := MarshalAssetFedSyncCfg(*uniSyncConfig)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("unable to marshal universe "+
+				"specific federation sync config: %w", err)
 		}
+		uniConfigRPCs[i] = uniConfigRPC
 	}
 
-	// Ensure that the new proof is of the correct type for the target
-	// universe.
-	err = universe.ValidateProofUniverseType(assetLeaf.Proof, universeID)
-	if err != nil {
-		return nil, err
-	}
+	return &unirpc.QueryFederationSyncConfigResponse{
+		GlobalSyncConfigs: globalConfigRPC,
+		AssetSyncConfigs:  uniConfigRPCs,
+	}, nil
+}
 
-	// Ensure proof insert is enabled for the given universe.
-	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+// SetAssetPolicy replaces the policy that determines which assets the Universe
+// server accepts proofs for from remote parties.
+func (r *rpcServer) SetAssetPolicy(_ context.Context,
+	req *unirpc.SetAssetPolicyRequest) (*unirpc.SetAssetPolicyResponse,
+	error) {
+
+	policy, err := UnmarshalAssetPolicy(req.Policy)
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if !syncConfigs.IsSyncInsertEnabled(universeID) {
-		return nil, fmt.Errorf("proof insert is disabled for the " +
-			"given universe")
+	if err := r.cfg.BaseUniverse.SetAssetPolicy(policy); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	rpcsLog.Debugf("[InsertProof]: inserting proof at "+
-		"(universeID=%v, leafKey=%x)", universeID,
-		leafKey.UniverseKey())
+	return &unirpc.SetAssetPolicyResponse{}, nil
+}
 
-	newUniverseState, err := r.cfg.BaseUniverse.RegisterIssuance(
-		ctx, universeID, leafKey, assetLeaf,
-	)
+// QueryAssetPolicy queries the current policy that determines which assets the
+// Universe server accepts proofs for from remote parties.
+func (r *rpcServer) QueryAssetPolicy(_ context.Context,
+	_ *unirpc.QueryAssetPolicyRequest) (*unirpc.QueryAssetPolicyResponse,
+	error) {
+
+	return &unirpc.QueryAssetPolicyResponse{
+		Policy: MarshalAssetPolicy(r.cfg.BaseUniverse.AssetPolicy()),
+	}, nil
+}
+
+// SetAcceptPushFrom replaces the list of remote hosts the Universe server
+// accepts pushed proofs from.
+func (r *rpcServer) SetAcceptPushFrom(_ context.Context,
+	req *unirpc.SetAcceptPushFromRequest) (*unirpc.SetAcceptPushFromResponse,
+	error) {
+
+	err := r.cfg.BaseUniverse.SetAcceptPushFrom(req.Hosts)
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	universeRootHash := newUniverseState.UniverseRoot.NodeHash()
-	rpcsLog.Debugf("[InsertProof]: proof inserted, new universe root: %x",
-		universeRootHash[:])
+	return &unirpc.SetAcceptPushFromResponse{}, nil
+}
 
-	return r.marshalIssuanceProof(ctx, req.Key, newUniverseState)
+// QueryAcceptPushFrom queries the current list of remote hosts the Universe
+// server accepts pushed proofs from.
+func (r *rpcServer) QueryAcceptPushFrom(_ context.Context,
+	_ *unirpc.QueryAcceptPushFromRequest) (
+	*unirpc.QueryAcceptPushFromResponse, error) {
+
+	return &unirpc.QueryAcceptPushFromResponse{
+		Hosts: r.cfg.BaseUniverse.AcceptPushFrom(),
+	}, nil
 }
 
-// Info returns a set of information about the current state of the Universe.
-func (r *rpcServer) Info(ctx context.Context,
-	_ *unirpc.InfoRequest) (*unirpc.InfoResponse, error) {
+// UnmarshalAssetPolicy parses an asset policy from its RPC form.
+func UnmarshalAssetPolicy(
+	policy *unirpc.AssetPolicy) (universe.AssetPolicy, error) {
 
-	universeStats, err := r.cfg.UniverseStats.AggregateSyncStats(ctx)
+	// A missing policy means that all assets are accepted.
+	if policy == nil {
+		return universe.AssetPolicy{}, nil
+	}
+
+	allowList, err := fn.MapErr(
+		policy.AllowList, unmarshalAssetPolicyRule,
+	)
 	if err != nil {
-		return nil, err
+		return universe.AssetPolicy{}, fmt.Errorf("invalid allow "+
+			"list: %w", err)
 	}
 
-	return &unirpc.InfoResponse{
-		RuntimeId: r.cfg.RuntimeID,
-		NumAssets: universeStats.NumTotalAssets,
+	denyList, err := fn.MapErr(policy.DenyList, unmarshalAssetPolicyRule)
+	if err != nil {
+		return universe.AssetPolicy{}, fmt.Errorf("invalid deny "+
+			"list: %w", err)
+	}
+
+	return universe.AssetPolicy{
+		AllowList: allowList,
+		DenyList:  denyList,
 	}, nil
 }
 
-// unmarshalUniverseSyncType maps an RPC universe sync type into a concrete
-// type.
-func unmarshalUniverseSyncType(req unirpc.UniverseSyncMode) (
-	universe.SyncType, error) {
+// unmarshalAssetPolicyRule parses an asset policy rule from its RPC form.
+func unmarshalAssetPolicyRule(
+	rule *unirpc.AssetPolicyRule) (universe.AssetPolicyRule, error) {
 
-	switch req {
-	case unirpc.UniverseSyncMode_SYNC_FULL:
-		return universe.SyncFull, nil
+	switch {
+	case len(rule.GetAssetId()) > 0:
+		if len(rule.GetAssetId()) != sha256.Size {
+			return universe.AssetPolicyRule{}, fmt.Errorf("asset "+
+				"ID must be %d bytes", sha256.Size)
+		}
 
-	case unirpc.UniverseSyncMode_SYNC_ISSUANCE_ONLY:
-		return universe.SyncIssuance, nil
+		var assetID asset.ID
+		copy(assetID[:], rule.GetAssetId())
 
-	default:
-		return 0, fmt.Errorf("unknown sync type: %v", req)
-	}
-}
+		return universe.AssetPolicyRule{
+			AssetID: &assetID,
+		}, nil
 
-// unmarshalSyncTargets maps an RPC sync target into a concrete type.
-func unmarshalSyncTargets(targets []*unirpc.SyncTarget) ([]universe.Identifier, error) {
-	uniIDs := make([]universe.Identifier, 0, len(targets))
-	for _, target := range targets {
-		uniID, err := UnmarshalUniID(target.Id)
+	case len(rule.GetGroupKey()) > 0:
+		groupKey, err := btcec.ParsePubKey(rule.GetGroupKey())
 		if err != nil {
-			return nil, err
+			return universe.AssetPolicyRule{}, fmt.Errorf(
+				"invalid group key: %w", err,
+			)
 		}
-		uniIDs = append(uniIDs, uniID)
-	}
-
-	return uniIDs, nil
-}
 
-// marshalUniverseDiff marshals a universe diff into the RPC form.
-func (r *rpcServer) marshalUniverseDiff(ctx context.Context,
-	uniDiff []universe.AssetSyncDiff) (*unirpc.SyncResponse, error) {
+		return universe.AssetPolicyRule{
+			GroupKey: groupKey,
+		}, nil
 
-	resp := &unirpc.SyncResponse{
-		SyncedUniverses: make([]*unirpc.SyncedUniverse, 0, len(uniDiff)),
+	default:
+		return universe.AssetPolicyRule{}, fmt.Errorf("asset policy " +
+			"rule must specify either an asset ID or a group key")
 	}
+}
 
-	err := fn.ForEachErr(uniDiff, func(diff universe.AssetSyncDiff) error {
-		oldUniRoot, err := marshalUniverseRoot(diff.OldUniverseRoot)
-		if err != nil {
-			return fmt.Errorf("unable to marshal old uni "+
-				"root: %w", err)
-		}
-		newUniRoot, err := marshalUniverseRoot(diff.NewUniverseRoot)
-		if err != nil {
-			return fmt.Errorf("unable to marshal new unit "+
-				"root: %w", err)
-		}
+// MarshalAssetPolicy converts an asset policy into its RPC form.
+func MarshalAssetPolicy(policy universe.AssetPolicy) *unirpc.AssetPolicy {
+	marshalRule := func(
+		rule universe.AssetPolicyRule) *unirpc.AssetPolicyRule {
 
-		leaves := make([]*unirpc.AssetLeaf, len(diff.NewLeafProofs))
-		for i, leaf := range diff.NewLeafProofs {
-			leaves[i], err = r.marshalAssetLeaf(ctx, leaf)
-			if err != nil {
-				return err
+		if rule.GroupKey != nil {
+			groupKey := rule.GroupKey.SerializeCompressed()
+			return &unirpc.AssetPolicyRule{
+				Rule: &unirpc.AssetPolicyRule_GroupKey{
+					GroupKey: groupKey,
+				},
 			}
 		}
 
-		resp.SyncedUniverses = append(
-			resp.SyncedUniverses, &unirpc.SyncedUniverse{
-				OldAssetRoot:   oldUniRoot,
-				NewAssetRoot:   newUniRoot,
-				NewAssetLeaves: leaves,
+		return &unirpc.AssetPolicyRule{
+			Rule: &unirpc.AssetPolicyRule_AssetId{
+				AssetId: fn.ByteSlice(*rule.AssetID),
 			},
-		)
-		return nil
-	})
-	if err != nil {
-		return nil, err
+		}
 	}
 
-	return resp, nil
+	return &unirpc.AssetPolicy{
+		AllowList: fn.Map(policy.AllowList, marshalRule),
+		DenyList:  fn.Map(policy.DenyList, marshalRule),
+	}
 }
 
-// SyncUniverse takes host information for a remote Universe server, then
-// attempts to synchronize either only the set of specified asset_ids, or all
-// assets if none are specified. The sync process will attempt to query for the
-// latest known root for each asset, performing tree based reconciliation to
-// arrive at a new shared root.
-func (r *rpcServer) SyncUniverse(ctx context.Context,
-	req *unirpc.SyncRequest) (*unirpc.SyncResponse, error) {
-
-	// TODO(roasbeef): have another layer, only allow single outstanding
-	// sync request per host?
+// SubscribeUniverseUpdates subscribes to new leaves being inserted into any of
+// the local Universe trees, either as a result of local minting or of a
+// federation push or sync, and to leaves being removed after a re-org.
+func (r *rpcServer) SubscribeUniverseUpdates(
+	req *unirpc.SubscribeUniverseUpdatesRequest,
+	ntfnStream unirpc.Universe_SubscribeUniverseUpdatesServer) error {
 
-	syncMode, err := unmarshalUniverseSyncType(req.SyncMode)
-	if err != nil {
-		return nil, fmt.Errorf("unable to parse sync type: %w", err)
-	}
-	syncTargets, err := unmarshalSyncTargets(req.SyncTargets)
+	filterIDs, err := fn.MapErr(req.Ids, UnmarshalUniID)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse sync targets: %w", err)
+		return fmt.Errorf("unable to parse universe IDs: %w", err)
 	}
 
-	uniAddr := universe.NewServerAddrFromStr(req.UniverseHost)
-
-	// Obtain the general and universe specific federation sync configs.
-	queryFedSyncConfigs := r.cfg.FederationDB.QueryFederationSyncConfigs
-	globalConfigs, uniSyncConfigs, err := queryFedSyncConfigs(ctx)
+	// Create a new event subscriber and register it with the base
+	// universe. If a start index was specified, then we'll also have any
+	// recent events after that index delivered first.
+	eventSubscriber := fn.NewEventReceiver[*universe.LeafEvent](
+		fn.DefaultQueueSize,
+	)
+	err = r.cfg.BaseUniverse.RegisterSubscriber(
+		eventSubscriber, req.StartIndex != 0, req.StartIndex,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to query federation sync "+
-			"config(s): %w", err)
+		return fmt.Errorf("failed to register universe update "+
+			"subscription: %w", err)
 	}
+	defer func() {
+		err := r.cfg.BaseUniverse.RemoveSubscriber(eventSubscriber)
+		if err != nil {
+			rpcsLog.Warnf("Unable to remove universe update "+
+				"subscriber: %v", err)
+		}
+	}()
 
-	syncConfigs := universe.SyncConfigs{
-		GlobalSyncConfigs: globalConfigs,
-		UniSyncConfigs:    uniSyncConfigs,
-	}
+	for {
+		select {
+		// Handle receiving a new leaf event. If it matches the filter
+		// of the subscriber, it's mapped to the RPC event type and sent
+		// over the stream.
+		case event := <-eventSubscriber.NewItemCreated.ChanOut():
+			uniID := event.UniverseRoot.ID
+			if !matchesUniverseFilter(filterIDs, uniID) {
+				continue
+			}
 
-	// TODO(roasbeef): add layer of indirection in front of?
-	//  * just interface interaction
-	// TODO(ffranr): Sync via the FederationEnvoy rather than syncer.
-	universeDiff, err := r.cfg.UniverseSyncer.SyncUniverse(
-		ctx, uniAddr, syncMode, syncConfigs, syncTargets...,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("unable to sync universe: %w", err)
-	}
+			rpcEvent, err := r.marshalUniverseUpdateEvent(
+				ntfnStream.Context(), event,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to marshal universe "+
+					"update event: %w", err)
+			}
 
-	return r.marshalUniverseDiff(ctx, universeDiff)
-}
+			err = ntfnStream.Send(rpcEvent)
+			if err != nil {
+				return fmt.Errorf("failed to RPC stream send "+
+					"event: %w", err)
+			}
 
-func marshalUniverseServer(server universe.ServerAddr,
-) *unirpc.UniverseFederationServer {
+		// Handle the case where the RPC stream is closed by the
+		// client.
+		case <-ntfnStream.Context().Done():
+			// Don't return an error if a normal context
+			// cancellation has occurred.
+			isCanceledContext := errors.Is(
+				ntfnStream.Context().Err(), context.Canceled,
+			)
+			if isCanceledContext {
+				return nil
+			}
+
+			return ntfnStream.Context().Err()
 
-	return &unirpc.UniverseFederationServer{
-		Host: server.HostStr(),
-		Id:   int32(server.ID),
+		// Handle the case where the RPC server is shutting down.
+		case <-r.quit:
+			return nil
+		}
 	}
 }
 
-// ListFederationServers lists the set of servers that make up the federation
-// of the local Universe server. This servers are used to push out new proofs,
-// and also periodically call sync new proofs from the remote server.
-func (r *rpcServer) ListFederationServers(ctx context.Context,
-	_ *unirpc.ListFederationServersRequest,
-) (*unirpc.ListFederationServersResponse, error) {
+// SubscribeFederationEvents subscribes to changes of the federation of the
+// local Universe server. The current membership of the federation is sent as
+// the first event.
+func (r *rpcServer) SubscribeFederationEvents(
+	_ *unirpc.SubscribeFederationEventsRequest,
+	ntfnStream unirpc.Universe_SubscribeFederationEventsServer) error {
 
-	uniServers, err := r.cfg.FederationDB.UniverseServers(ctx)
+	eventSubscriber := fn.NewEventReceiver[*universe.FederationEvent](
+		fn.DefaultQueueSize,
+	)
+	err := r.cfg.UniverseFederation.RegisterSubscriber(
+		eventSubscriber, true, 0,
+	)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("failed to register federation event "+
+			"subscription: %w", err)
 	}
+	defer func() {
+		err := r.cfg.UniverseFederation.RemoveSubscriber(
+			eventSubscriber,
+		)
+		if err != nil {
+			rpcsLog.Warnf("Unable to remove federation event "+
+				"subscriber: %v", err)
+		}
+	}()
 
-	return &unirpc.ListFederationServersResponse{
-		Servers: fn.Map(uniServers, marshalUniverseServer),
-	}, nil
-}
+	for {
+		select {
+		// Handle receiving a new federation event, which is mapped to
+		// the RPC event type and sent over the stream.
+		case event := <-eventSubscriber.NewItemCreated.ChanOut():
+			rpcEvent, err := r.marshalFederationEvent(event)
+			if err != nil {
+				return fmt.Errorf("failed to marshal "+
+					"federation event: %w", err)
+			}
 
-func unmarshalUniverseServer(
-	server *unirpc.UniverseFederationServer) universe.ServerAddr {
+			err = ntfnStream.Send(rpcEvent)
+			if err != nil {
+				return fmt.Errorf("failed to RPC stream send "+
+					"event: %w", err)
+			}
+
+		// Handle the case where the RPC stream is closed by the
+		// client.
+		case <-ntfnStream.Context().Done():
+			// Don't return an error if a normal context
+			// cancellation has occurred.
+			isCanceledContext := errors.Is(
+				ntfnStream.Context().Err(), context.Canceled,
+			)
+			if isCanceledContext {
+				return nil
+			}
+
+			return ntfnStream.Context().Err()
 
-	return universe.NewServerAddr(int64(server.Id), server.Host)
+		// Handle the case where the RPC server is shutting down.
+		case <-r.quit:
+			return nil
+		}
+	}
 }
 
-// AddFederationServer adds a new server to the federation of the local
-// Universe server. Once a server is added, this call can also optionally be
-// used to trigger a sync of the remote server.
-func (r *rpcServer) AddFederationServer(ctx context.Context,
-	req *unirpc.AddFederationServerRequest,
-) (*unirpc.AddFederationServerResponse, error) {
+// marshalFederationEventType turns the federation event type into the RPC
+// counterpart.
+func marshalFederationEventType(
+	eventType universe.FederationEventType) (unirpc.FederationEventType,
+	error) {
+
+	switch eventType {
+	case universe.FederationEventMembership:
+		return unirpc.FederationEventType_FEDERATION_EVENT_MEMBERSHIP,
+			nil
 
-	serversToAdd := fn.Map(req.Servers, unmarshalUniverseServer)
+	case universe.FederationEventServerAdded:
+		return unirpc.FederationEventType_FEDERATION_EVENT_SERVER_ADDED,
+			nil
 
-	for idx := range serversToAdd {
-		server := serversToAdd[idx]
+	case universe.FederationEventServerRemoved:
+		return unirpc.FederationEventType_FEDERATION_EVENT_SERVER_REMOVED,
+			nil
 
-		// Before we add the server as a federation member, we check
-		// that we can actually connect to it and that it isn't
-		// ourselves.
-		err := CheckFederationServer(
-			r.cfg.RuntimeID, universe.DefaultTimeout, server,
-		)
-		if err != nil {
-			return nil, err
-		}
+	case universe.FederationEventHealthChanged:
+		return unirpc.FederationEventType_FEDERATION_EVENT_HEALTH_CHANGED,
+			nil
+
+	default:
+		return 0, fmt.Errorf("unknown federation event type <%d>",
+			eventType)
 	}
+}
+
+// marshalFederationEvent maps a federation event into the RPC form.
+func (r *rpcServer) marshalFederationEvent(
+	event *universe.FederationEvent) (*unirpc.FederationEvent, error) {
 
-	err := r.cfg.UniverseFederation.AddServer(serversToAdd...)
+	eventType, err := marshalFederationEventType(event.Type)
 	if err != nil {
 		return nil, err
 	}
 
-	return &unirpc.AddFederationServerResponse{}, nil
-}
+	rpcEvent := &unirpc.FederationEvent{
+		Type:      eventType,
+		Timestamp: event.Timestamp.UnixMicro(),
+	}
 
-// DeleteFederationServer removes a server from the federation of the local
-// Universe server.
-func (r *rpcServer) DeleteFederationServer(ctx context.Context,
-	req *unirpc.DeleteFederationServerRequest,
-) (*unirpc.DeleteFederationServerResponse, error) {
+	switch event.Type {
+	// Membership events carry the full set of servers, along with their
+	// health if they were already checked.
+	case universe.FederationEventMembership:
+		fed := r.cfg.UniverseFederation
+		for _, server := range event.Members {
+			rpcServer := marshalUniverseServer(server)
+			health, ok := fed.ServerHealth(server)
+			if ok {
+				rpcServer.Health = marshalServerHealth(health)
+			}
 
-	serversToDel := fn.Map(req.Servers, unmarshalUniverseServer)
+			rpcEvent.Members = append(rpcEvent.Members, rpcServer)
+		}
 
-	err := r.cfg.FederationDB.RemoveServers(ctx, serversToDel...)
-	if err != nil {
-		return nil, err
+	case universe.FederationEventHealthChanged:
+		rpcEvent.Server = marshalUniverseServer(event.Server)
+		rpcEvent.Server.Health = marshalServerHealth(event.Health)
+
+	default:
+		rpcEvent.Server = marshalUniverseServer(event.Server)
 	}
 
-	return &unirpc.DeleteFederationServerResponse{}, nil
+	return rpcEvent, nil
 }
 
-// SetFederationSyncConfig sets the configuration of the universe federation
-// sync.
-func (r *rpcServer) SetFederationSyncConfig(ctx context.Context,
-	req *unirpc.SetFederationSyncConfigRequest) (
-	*unirpc.SetFederationSyncConfigResponse, error) {
+// ExportUniverse exports the local Universe trees into a portable archive,
+// which is streamed back to the caller in chunks.
+func (r *rpcServer) ExportUniverse(req *unirpc.ExportUniverseRequest,
+	stream unirpc.Universe_ExportUniverseServer) error {
 
-	// Unmarshal global sync configs.
-	globalSyncConfig := make(
-		[]*universe.FedGlobalSyncConfig, len(req.GlobalSyncConfigs),
+	filterIDs, err := fn.MapErr(req.Ids, UnmarshalUniID)
+	if err != nil {
+		return fmt.Errorf("unable to parse universe IDs: %w", err)
+	}
+
+	archive, err := r.cfg.BaseUniverse.ExportArchive(
+		stream.Context(), func(id universe.Identifier) bool {
+			return matchesUniverseFilter(filterIDs, id)
+		},
 	)
-	for i := range req.GlobalSyncConfigs {
-		config := req.GlobalSyncConfigs[i]
+	if err != nil {
+		return fmt.Errorf("unable to export universe archive: %w", err)
+	}
 
-		proofType, err := UnmarshalUniProofType(config.ProofType)
-		if err != nil {
-			return nil, fmt.Errorf("unable to unmarshal "+
-				"proof type: %w", err)
+	var buf bytes.Buffer
+	if err := archive.Encode(&buf); err != nil {
+		return fmt.Errorf("unable to encode universe archive: %w", err)
+	}
+
+	archiveBytes := buf.Bytes()
+	for len(archiveBytes) > 0 {
+		chunkSize := universeArchiveChunkSize
+		if len(archiveBytes) < chunkSize {
+			chunkSize = len(archiveBytes)
 		}
 
-		globalSyncConfig[i] = &universe.FedGlobalSyncConfig{
-			ProofType:       proofType,
-			AllowSyncInsert: config.AllowSyncInsert,
-			AllowSyncExport: config.AllowSyncExport,
+		err := stream.Send(&unirpc.UniverseArchiveChunk{
+			ChunkData: archiveBytes[:chunkSize],
+		})
+		if err != nil {
+			return fmt.Errorf("failed to RPC stream send archive "+
+				"chunk: %w", err)
 		}
+
+		archiveBytes = archiveBytes[chunkSize:]
 	}
 
-	// Unmarshal asset (asset/asset group) specific sync configs.
-	assetSyncConfigs := make(
-		[]*universe.FedUniSyncConfig, len(req.AssetSyncConfigs),
-	)
-	for i := range req.AssetSyncConfigs {
-		assetSyncConfig := req.AssetSyncConfigs[i]
-		config, err := unmarshalAssetSyncConfig(assetSyncConfig)
+	return nil
+}
+
+// ImportUniverse imports a Universe archive that is streamed in chunks by the
+// caller. Leaves that are already known locally are skipped.
+func (r *rpcServer) ImportUniverse(
+	stream unirpc.Universe_ImportUniverseServer) error {
+
+	var buf bytes.Buffer
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
 		if err != nil {
-			return nil, fmt.Errorf("unable to parse asset sync "+
-				"config: %w", err)
+			return fmt.Errorf("failed to receive archive chunk: %w",
+				err)
 		}
 
-		assetSyncConfigs[i] = config
+		buf.Write(chunk.ChunkData)
 	}
 
-	// Update asset (asset/asset group) specific sync configs.
-	err := r.cfg.FederationDB.UpsertFederationSyncConfig(
-		ctx, globalSyncConfig, assetSyncConfigs,
+	var archive universe.ArchiveFile
+	if err := archive.Decode(&buf); err != nil {
+		return fmt.Errorf("unable to decode universe archive: %w", err)
+	}
+
+	result, err := r.cfg.BaseUniverse.ImportArchive(
+		stream.Context(), &archive,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to set federation sync "+
-			"config: %w", err)
+		return fmt.Errorf("unable to import universe archive: %w", err)
 	}
 
-	return &unirpc.SetFederationSyncConfigResponse{}, nil
+	return stream.SendAndClose(&unirpc.ImportUniverseResponse{
+		NumUniverses:      uint32(result.NumUniverses),
+		NumImportedLeaves: uint64(result.NumImported),
+		NumExistingLeaves: uint64(result.NumExisting),
+	})
 }
 
-// QueryFederationSyncConfig queries the universe federation sync configuration
-// settings.
-func (r *rpcServer) QueryFederationSyncConfig(ctx context.Context,
-	_ *unirpc.QueryFederationSyncConfigRequest,
-) (*unirpc.QueryFederationSyncConfigResponse, error) {
+// matchesUniverseFilter returns true if the given universe ID matches any of
+// the IDs in the filter, or if the filter is empty. An unspecified proof type
+// in the filter matches any proof type.
+func matchesUniverseFilter(filter []universe.Identifier,
+	id universe.Identifier) bool {
 
-	// Obtain the general and universe specific federation sync configs.
-	queryFedSyncConfigs := r.cfg.FederationDB.QueryFederationSyncConfigs
-	globalConfigs, uniSyncConfigs, err := queryFedSyncConfigs(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("unable to query federation sync "+
-			"config(s): %w", err)
+	if len(filter) == 0 {
+		return true
 	}
 
-	// Marshal the general sync config into the RPC form.
-	globalConfigRPC := make(
-		[]*unirpc.GlobalFederationSyncConfig, len(globalConfigs),
-	)
-	for i := range globalConfigs {
-		globalConfig := globalConfigs[i]
-
-		proofTypeRpc, err := MarshalUniProofType(globalConfig.ProofType)
-		if err != nil {
-			return nil, fmt.Errorf("unable to unmarshal "+
-				"proof type: %w", err)
+	for _, filterID := range filter {
+		if filterID.Bytes() != id.Bytes() {
+			continue
 		}
 
-		globalConfigRPC[i] = &unirpc.GlobalFederationSyncConfig{
-			ProofType:       proofTypeRpc,
-			AllowSyncInsert: globalConfig.AllowSyncInsert,
-			AllowSyncExport: globalConfig.AllowSyncExport,
+		if filterID.ProofType == universe.ProofTypeUnspecified ||
+			filterID.ProofType == id.ProofType {
+
+			return true
 		}
 	}
 
-	// Marshal universe specific sync configs into the RPC form.
-	uniConfigRPCs := make(
-		[]*unirpc.AssetFederationSyncConfig, len(uniSyncConfigs),
-	)
-	for i := range uniSyncConfigs {
-		uniSyncConfig := uniSyncConfigs[i]
-		uniConfigRPC, err := MarshalAssetFedSyncCfg(*uniSyncConfig)
-		if err != nil {
-			return nil, fmt.Errorf("unable to marshal universe "+
-				"specific federation sync config: %w", err)
-		}
-		uniConfigRPCs[i] = uniConfigRPC
+	return false
+}
+
+// marshalUniverseUpdateEvent maps a universe leaf event to its RPC
+// counterpart.
+func (r *rpcServer) marshalUniverseUpdateEvent(ctx context.Context,
+	event *universe.LeafEvent) (*unirpc.UniverseUpdateEvent, error) {
+
+	uniRoot, err := marshalUniverseRoot(event.UniverseRoot)
+	if err != nil {
+		return nil, err
 	}
 
-	return &unirpc.QueryFederationSyncConfigResponse{
-		GlobalSyncConfigs: globalConfigRPC,
-		AssetSyncConfigs:  uniConfigRPCs,
+	assetLeaf, err := r.marshalAssetLeaf(ctx, event.Leaf)
+	if err != nil {
+		return nil, err
+	}
+
+	var source unirpc.UniverseLeafSource
+	switch event.Source {
+	case universe.LeafSourceFederation:
+		source = unirpc.UniverseLeafSource_LEAF_SOURCE_FEDERATION
+
+	case universe.LeafSourceLocal:
+		source = unirpc.UniverseLeafSource_LEAF_SOURCE_LOCAL
+
+	default:
+		return nil, fmt.Errorf("unknown leaf source: %v", event.Source)
+	}
+
+	return &unirpc.UniverseUpdateEvent{
+		Index:        event.Index,
+		Timestamp:    event.Timestamp().UnixMicro(),
+		UniverseRoot: uniRoot,
+		LeafKey:      marshalLeafKey(event.Key),
+		AssetLeaf:    assetLeaf,
+		Source:       source,
+		Removed:      event.Removed,
 	}, nil
 }
 
@@ -3904,22 +9142,153 @@ func (r *rpcServer) VerifyAssetOwnership(ctx context.Context,
 	}, nil
 }
 
+// SignAssetMessage signs an arbitrary message with the script key of an owned
+// asset.
+func (r *rpcServer) SignAssetMessage(ctx context.Context,
+	req *wrpc.SignAssetMessageRequest) (*wrpc.SignAssetMessageResponse,
+	error) {
+
+	scriptKey, err := parseUserKey(req.ScriptKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid "+
+			"script key: %v", err)
+	}
+
+	if len(req.Message) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "a message "+
+			"must be specified")
+	}
+
+	isLocal, err := r.isLocalScriptKey(ctx, scriptKey)
+	if err != nil {
+		return nil, err
+	}
+	if !isLocal {
+		return nil, status.Errorf(codes.FailedPrecondition, "script "+
+			"key %x is not a script key of this node",
+			schnorr.SerializePubKey(scriptKey))
+	}
+
+	sig, err := r.cfg.AssetWallet.SignMessage(ctx, scriptKey, req.Message)
+	if err != nil {
+		return nil, fmt.Errorf("error signing message: %w", err)
+	}
+
+	return &wrpc.SignAssetMessageResponse{
+		Signature: sig.Serialize(),
+	}, nil
+}
+
+// VerifyAssetMessage verifies that a message was signed with the given script
+// key, and optionally that the script key controls a non-zero balance.
+func (r *rpcServer) VerifyAssetMessage(ctx context.Context,
+	req *wrpc.VerifyAssetMessageRequest) (*wrpc.VerifyAssetMessageResponse,
+	error) {
+
+	scriptKey, err := parseUserKey(req.ScriptKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid "+
+			"script key: %v", err)
+	}
+
+	sig, err := schnorr.ParseSignature(req.Signature)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid "+
+			"signature: %v", err)
+	}
+
+	err = tapscript.VerifyMessage(req.Message, scriptKey, sig)
+	switch {
+	case errors.Is(err, tapscript.ErrInvalidMessageSig):
+		return &wrpc.VerifyAssetMessageResponse{}, nil
+
+	case err != nil:
+		return nil, fmt.Errorf("error verifying message: %w", err)
+	}
+
+	resp := &wrpc.VerifyAssetMessageResponse{
+		Valid: true,
+	}
+	if !req.CheckBalance {
+		return resp, nil
+	}
+
+	resp.Balance, err = r.scriptKeyBalance(ctx, scriptKey)
+	if err != nil {
+		return nil, err
+	}
+	resp.Valid = resp.Balance > 0
+
+	return resp, nil
+}
+
+// scriptKeyBalance returns the total amount of the unspent assets known to
+// this node that are controlled by the given script key.
+func (r *rpcServer) scriptKeyBalance(ctx context.Context,
+	scriptKey *btcec.PublicKey) (uint64, error) {
+
+	assets, err := r.cfg.AssetStore.FetchAllAssets(ctx, false, true, nil)
+	if err != nil {
+		return 0, fmt.Errorf("unable to read chain assets: %w", err)
+	}
+
+	xOnlyKey := schnorr.SerializePubKey(scriptKey)
+
+	var balance uint64
+	for _, a := range assets {
+		assetKey := schnorr.SerializePubKey(a.ScriptKey.PubKey)
+		if bytes.Equal(assetKey, xOnlyKey) {
+			balance += a.Amount
+		}
+	}
+
+	return balance, nil
+}
+
 // UniverseStats returns a set of aggregate statistics for the current state
 // of the Universe.
 func (r *rpcServer) UniverseStats(ctx context.Context,
-	_ *unirpc.StatsRequest) (*unirpc.StatsResponse, error) {
+	req *unirpc.StatsRequest) (*unirpc.StatsResponse, error) {
 
 	universeStats, err := r.cfg.UniverseStats.AggregateSyncStats(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &unirpc.StatsResponse{
-		NumTotalAssets: int64(universeStats.NumTotalAssets),
-		NumTotalGroups: int64(universeStats.NumTotalGroups),
-		NumTotalSyncs:  int64(universeStats.NumTotalSyncs),
-		NumTotalProofs: int64(universeStats.NumTotalProofs),
-	}, nil
+	cacheStats := r.cfg.BaseUniverse.ProofCacheStats()
+
+	resp := &unirpc.StatsResponse{
+		NumTotalAssets:      int64(universeStats.NumTotalAssets),
+		NumTotalGroups:      int64(universeStats.NumTotalGroups),
+		NumTotalSyncs:       int64(universeStats.NumTotalSyncs),
+		NumTotalProofs:      int64(universeStats.NumTotalProofs),
+		NumTotalLeaves:      int64(universeStats.NumTotalLeaves),
+		NumTotalPushes:      int64(universeStats.NumTotalPushes),
+		NumProofCacheHits:   cacheStats.NumHits,
+		NumProofCacheMisses: cacheStats.NumMisses,
+	}
+
+	if !req.IncludeAssetStats {
+		return resp, nil
+	}
+
+	// The caller also wants the stats of each known asset, so we'll query
+	// for all of them without applying any filters.
+	assetStats, err := r.cfg.UniverseStats.QuerySyncStats(
+		ctx, universe.SyncStatsQuery{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query asset stats: %w", err)
+	}
+
+	resp.AssetStats = make(
+		[]*unirpc.AssetStatsSnapshot, len(assetStats.SyncStats),
+	)
+	for idx, snapshot := range assetStats.SyncStats {
+		resp.AssetStats[idx] = r.marshalAssetSyncSnapshot(ctx, snapshot)
+	}
+
+	return resp, nil
 }
 
 // marshalAssetSyncSnapshot maps a universe asset sync stat snapshot to the RPC
@@ -3962,18 +9331,9 @@ func (r *rpcServer) QueryAssetStats(ctx context.Context,
 	assetStats, err := r.cfg.UniverseStats.QuerySyncStats(
 		ctx, universe.SyncStatsQuery{
 			AssetNameFilter: req.AssetNameFilter,
-			AssetTypeFilter: func() *asset.Type {
-				switch req.AssetTypeFilter {
-				case unirpc.AssetTypeFilter_FILTER_ASSET_NORMAL:
-					return fn.Ptr(asset.Normal)
-
-				case unirpc.AssetTypeFilter_FILTER_ASSET_COLLECTIBLE:
-					return fn.Ptr(asset.Collectible)
-
-				default:
-					return nil
-				}
-			}(),
+			AssetTypeFilter: unmarshalAssetTypeFilter(
+				req.AssetTypeFilter,
+			),
 			AssetIDFilter: fn.ToArray[asset.ID](
 				req.AssetIdFilter,
 			),
@@ -4030,6 +9390,76 @@ func (r *rpcServer) getBlockTimestamp(ctx context.Context,
 	return int64(ts)
 }
 
+// QueryAssetSupply returns the total supply committed across all issuances of
+// an asset group minus the burned supply, along with the number of issuance
+// events.
+func (r *rpcServer) QueryAssetSupply(ctx context.Context,
+	req *unirpc.QueryAssetSupplyRequest,
+) (*unirpc.QueryAssetSupplyResponse, error) {
+
+	groupKeyBytes := req.GetGroupKey()
+	if groupKeyStr := req.GetGroupKeyStr(); groupKeyStr != "" {
+		var err error
+		groupKeyBytes, err = hex.DecodeString(groupKeyStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group key: %w", err)
+		}
+	}
+	if len(groupKeyBytes) == 0 {
+		return nil, fmt.Errorf("group key must be set")
+	}
+
+	groupKey, err := parseUserKey(groupKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid group key: %w", err)
+	}
+
+	supply, err := r.cfg.BaseUniverse.QueryAssetSupply(ctx, groupKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.QueryAssetSupplyResponse{
+		TotalSupply:       supply.TotalSupply,
+		NumIssuanceEvents: supply.NumIssuances,
+		BurnedSupply:      supply.BurnedSupply,
+	}, nil
+}
+
+// QueryAssetUtxos returns the current set of unspent outputs of an asset, as
+// derived from the issuance and transfer leaves of its universes.
+func (r *rpcServer) QueryAssetUtxos(ctx context.Context,
+	req *unirpc.QueryAssetUtxosRequest) (*unirpc.QueryAssetUtxosResponse,
+	error) {
+
+	universeID, err := UnmarshalUniID(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	utxoSet, err := r.cfg.BaseUniverse.QueryAssetUtxos(ctx, universeID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &unirpc.QueryAssetUtxosResponse{
+		Utxos:       make([]*unirpc.AssetUtxo, 0, len(utxoSet.Utxos)),
+		TotalAmount: utxoSet.TotalAmount,
+	}
+	for _, utxo := range utxoSet.Utxos {
+		utxo := utxo
+
+		resp.Utxos = append(resp.Utxos, &unirpc.AssetUtxo{
+			AssetId:   utxo.AssetID[:],
+			Outpoint:  utxo.OutPoint.String(),
+			ScriptKey: utxo.ScriptKey[:],
+			Amount:    utxo.Amount,
+		})
+	}
+
+	return resp, nil
+}
+
 // QueryEvents returns the number of sync and proof events for a given time
 // period, grouped by day.
 func (r *rpcServer) QueryEvents(ctx context.Context,
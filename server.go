@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"os/signal"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"syscall"
 
 	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/lightninglabs/lndclient"
@@ -138,6 +141,11 @@ func (s *Server) initialize(interceptorChain *rpcperms.InterceptorChain) error {
 		return fmt.Errorf("unable to start asset minter: %v", err)
 	}
 
+	if err := s.cfg.EmissionScheduler.Start(); err != nil {
+		return fmt.Errorf("unable to start emission scheduler: %v",
+			err)
+	}
+
 	// Next, we'll start the asset custodian.
 	if err := s.cfg.AssetCustodian.Start(); err != nil {
 		return fmt.Errorf("unable to start asset custodian: %v", err)
@@ -151,11 +159,40 @@ func (s *Server) initialize(interceptorChain *rpcperms.InterceptorChain) error {
 		return fmt.Errorf("unable to start chain porter: %v", err)
 	}
 
+	// We start watching for re-orged universe leaves before the
+	// federation starts syncing, so we don't miss any new leaves.
+	if err := s.cfg.UniverseReOrgWatcher.Start(); err != nil {
+		return fmt.Errorf("unable to start universe re-org "+
+			"watcher: %v", err)
+	}
+
 	if err := s.cfg.UniverseFederation.Start(); err != nil {
 		return fmt.Errorf("unable to start universe "+
 			"federation: %v", err)
 	}
 
+	if s.cfg.SpentLeafPruner != nil {
+		if err := s.cfg.SpentLeafPruner.Start(); err != nil {
+			return fmt.Errorf("unable to start spent leaf "+
+				"pruner: %v", err)
+		}
+	}
+
+	if s.cfg.ProofMailbox != nil {
+		if err := s.cfg.ProofMailbox.Start(); err != nil {
+			return fmt.Errorf("unable to start proof mailbox: %v",
+				err)
+		}
+	}
+
+	if s.cfg.LightningProofReceiver != nil {
+		err := s.cfg.LightningProofReceiver.Start()
+		if err != nil {
+			return fmt.Errorf("unable to start lightning proof "+
+				"receiver: %v", err)
+		}
+	}
+
 	if s.cfg.UniversePublicAccess {
 		err := s.cfg.UniverseFederation.SetAllowPublicAccess()
 		if err != nil {
@@ -261,9 +298,16 @@ func (s *Server) RunUntilShutdown(mainErrChan <-chan error) error {
 		return mkErr("unable to initialize RPC server: %v", err)
 	}
 
+	var rateLimiter *rpcperms.RateLimiter
+	if s.cfg.UniverseRateLimits != nil {
+		rateLimiter = rpcperms.NewRateLimiter(*s.cfg.UniverseRateLimits)
+	}
+
 	rpcServerOpts := interceptorChain.CreateServerOpts(
 		&rpcperms.InterceptorsOpts{
-			Prometheus: &s.cfg.Prometheus,
+			Prometheus:  &s.cfg.Prometheus,
+			RateLimiter: rateLimiter,
+			ReadOnly:    s.cfg.UniverseReadOnly,
 		},
 	)
 	serverOpts = append(serverOpts, rpcServerOpts...)
@@ -311,9 +355,10 @@ func (s *Server) RunUntilShutdown(mainErrChan <-chan error) error {
 
 	// If Prometheus monitoring is enabled, start the Prometheus exporter.
 	if s.cfg.Prometheus.Active {
-		// Set the gRPC server instance in the Prometheus exporter
-		// configuration.
+		// Set the gRPC server instance and the universe stats in the
+		// Prometheus exporter configuration.
 		s.cfg.Prometheus.RPCServer = grpcServer
+		s.cfg.Prometheus.UniverseStats = s.cfg.UniverseStats
 
 		promExporter, err := monitoring.NewPrometheusExporter(
 			&s.cfg.Prometheus,
@@ -332,6 +377,17 @@ func (s *Server) RunUntilShutdown(mainErrChan <-chan error) error {
 		}
 	}
 
+	// If the TLS certificate can be reloaded, then we'll do so whenever we
+	// receive a SIGHUP, which allows the certificate to be rotated
+	// without a restart.
+	if s.cfg.ReloadTLS != nil {
+		stopReloads := make(chan struct{})
+		defer close(stopReloads)
+
+		s.wg.Add(1)
+		go s.reloadTLSOnSignal(stopReloads)
+	}
+
 	srvrLog.Infof("Taproot Asset Daemon fully active!")
 
 	// Wait for shutdown signal from either a graceful server stop or from
@@ -360,6 +416,42 @@ func (s *Server) RunUntilShutdown(mainErrChan <-chan error) error {
 	return nil
 }
 
+// reloadTLSOnSignal reloads the TLS certificate of the gRPC and REST listeners
+// each time a SIGHUP is received, until the given channel is closed. If the
+// new certificate is invalid, then the current one is kept.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *Server) reloadTLSOnSignal(stop <-chan struct{}) {
+	defer s.wg.Done()
+
+	hupChan := make(chan os.Signal, 1)
+	signal.Notify(hupChan, syscall.SIGHUP)
+	defer signal.Stop(hupChan)
+
+	for {
+		select {
+		case <-hupChan:
+			srvrLog.Infof("Received SIGHUP, reloading TLS " +
+				"certificate")
+
+			if err := s.cfg.ReloadTLS(); err != nil {
+				srvrLog.Errorf("Unable to reload TLS "+
+					"certificate, keeping current one: %v",
+					err)
+				continue
+			}
+
+			srvrLog.Infof("TLS certificate reloaded")
+
+		case <-stop:
+			return
+
+		case <-s.quit:
+			return
+		}
+	}
+}
+
 // StartAsSubserver is an alternative to Start where the RPC server does not
 // create its own gRPC server but registers to an existing one. The same goes
 // for REST (if enabled), instead of creating an own mux and HTTP server, we
@@ -551,6 +643,9 @@ func (s *Server) Stop() error {
 	if err := s.rpcServer.Stop(); err != nil {
 		return err
 	}
+	if err := s.cfg.EmissionScheduler.Stop(); err != nil {
+		return err
+	}
 	if err := s.cfg.AssetMinter.Stop(); err != nil {
 		return err
 	}
@@ -570,6 +665,28 @@ func (s *Server) Stop() error {
 		return err
 	}
 
+	if err := s.cfg.UniverseReOrgWatcher.Stop(); err != nil {
+		return err
+	}
+
+	if s.cfg.SpentLeafPruner != nil {
+		if err := s.cfg.SpentLeafPruner.Stop(); err != nil {
+			return err
+		}
+	}
+
+	if s.cfg.ProofMailbox != nil {
+		if err := s.cfg.ProofMailbox.Stop(); err != nil {
+			return err
+		}
+	}
+
+	if s.cfg.LightningProofReceiver != nil {
+		if err := s.cfg.LightningProofReceiver.Stop(); err != nil {
+			return err
+		}
+	}
+
 	if s.macaroonService != nil {
 		err := s.macaroonService.Stop()
 		if err != nil {
@@ -20,9 +20,12 @@ import (
 	"github.com/jessevdk/go-flags"
 	"github.com/lightninglabs/lndclient"
 	tap "github.com/lightninglabs/taproot-assets"
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/monitoring"
 	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/rpcperms"
 	"github.com/lightninglabs/taproot-assets/tapdb"
+	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/cert"
 	"github.com/lightningnetwork/lnd/lncfg"
@@ -106,13 +109,50 @@ const (
 	// to sync Universe state with the federation.
 	defaultUniverseSyncInterval = time.Minute * 10
 
+	// defaultUniverseHealthCheckInterval is the default interval that
+	// we'll use to check the connectivity and sync status of the
+	// federation servers.
+	defaultUniverseHealthCheckInterval = time.Minute * 5
+
+	// defaultUniversePushRetryInterval is the default amount of time
+	// we'll wait before retrying a failed push of a proof to a federation
+	// server for the first time.
+	defaultUniversePushRetryInterval = time.Second * 30
+
 	// defaultUniverseSyncBatchSize is the default number of proofs we'll
-	// sync in a single batch.
+	// sync or push in a single batch.
 	defaultUniverseSyncBatchSize = 200
 
+	// defaultUniverseMaxProofDepth is the default maximum depth of the
+	// proof chain of an asset that we'll accept when syncing with a
+	// remote Universe.
+	defaultUniverseMaxProofDepth = 10_000
+
+	// defaultUniverseConflictPolicy is the default policy that decides
+	// which of two diverging universe leaves is retained.
+	defaultUniverseConflictPolicy = "highest-priority-peer"
+
+	// defaultUniverseProofCacheSize is the default maximum number of leaf
+	// queries whose proofs are cached by the Universe server.
+	defaultUniverseProofCacheSize = 10_000
+
+	// defaultUniverseProofCacheTTL is the default maximum amount of time
+	// a cached proof is served by the Universe server.
+	defaultUniverseProofCacheTTL = time.Minute * 10
+
+	// defaultUniverseSpentLeafPruneInterval is the default interval at
+	// which spent transfer leaves are pruned, if a retention policy is
+	// set.
+	defaultUniverseSpentLeafPruneInterval = time.Hour
+
 	// defaultReOrgSafeDepth is the default number of confirmations we'll
 	// wait for before considering a transaction safely buried in the chain.
 	defaultReOrgSafeDepth = 6
+
+	// defaultMinConfs is the default minimum number of confirmations the
+	// anchor transaction of a proof must have before we accept it. A
+	// proof is always anchored in a block, so this accepts any proof.
+	defaultMinConfs = 1
 )
 
 var (
@@ -243,14 +283,85 @@ type LndConfig struct {
 	TLSPath string `long:"tlspath" description:"Path to lnd tls certificate"`
 }
 
+// ProofMailboxConfig is the config for the proof courier mailbox, which allows
+// the sender of an asset to upload the proof to this node for the receiver to
+// download later.
+type ProofMailboxConfig struct {
+	Enable bool `long:"enable" description:"If true, proof files can be uploaded to this node through the Universe RPC, to be downloaded by the receiver of the asset later. The endpoints don't require a macaroon if allow-public-uni-proof-courier is set."`
+
+	ProofTTL time.Duration `long:"proofttl" description:"Amount of time an uploaded proof file is kept before it expires and is removed."`
+
+	UploadTokens []string `long:"uploadtoken" description:"A bearer token that authorizes uploads of proof files, passed in the HTTP Authorization header as 'Bearer <token>'. Either just the token, which is valid for all uploads, or of the form token=scope,scope,... where each scope is either scriptkey:<hex> or asset:<hex>, to only allow uploads of proof files for the given script keys or asset IDs. If set, uploads without a valid token are rejected. Can be specified multiple times."`
+
+	FetchTokens []string `long:"fetchtoken" description:"A bearer token that authorizes fetching proof files, passed in the HTTP Authorization header as 'Bearer <token>'. Either just the token, which is valid for all script keys, or of the form token=scriptkey:<hex>,... to only allow fetching the proof files of the given recipient script keys. If set, fetches without a valid token are rejected. Can be specified multiple times."`
+}
+
 // UniverseConfig is the config that houses any Universe related config
 // values.
 type UniverseConfig struct {
 	SyncInterval time.Duration `long:"syncinterval" description:"Amount of time to wait between universe syncs"`
 
+	HealthCheckInterval time.Duration `long:"healthcheckinterval" description:"Amount of time to wait between checks of the connectivity and sync status of the federation servers. Set to 0 to only check servers on demand."`
+
+	PushRetryInterval time.Duration `long:"pushretryinterval" description:"Amount of time to wait before retrying a failed push of a proof to a federation server. The wait time is doubled after each failed attempt."`
+
 	FederationServers []string `long:"federationserver" description:"The host:port of a Universe server peer with. These servers will be added as the default set of federation servers. Can be specified multiple times."`
 
 	PublicAccess bool `long:"public-access" description:"If true, and the Universe server is on a public interface, valid proof from remote parties will be accepted, and proofs will be queryable by remote parties. This applies to federation syncing as well as RPC insert and query."`
+
+	ReadOnly bool `long:"readonly" description:"If true, the Universe server runs as a read-only mirror: all RPCs that require a write permission (minting, sending, address creation, proof insertion, pruning, federation changes, etc.) are rejected, while queries, outbound syncs and universe verification without repair keep working."`
+
+	AllowAssets []string `long:"allowasset" description:"The hex encoded asset ID or group key of an asset that proofs are accepted for from remote parties, through a federation push or RPC insert. If set, proofs of all other assets are rejected. Can be specified multiple times."`
+
+	DenyAssets []string `long:"denyasset" description:"The hex encoded asset ID or group key of an asset that proofs are rejected for from remote parties, through a federation push or RPC insert. Takes precedence over allowasset. Can be specified multiple times."`
+
+	AcceptPushFrom []string `long:"acceptpushfrom" description:"The IP address or host name of a remote host that pushed proofs are accepted from through an RPC insert. If set, pushes from all other hosts are rejected, even if they're federation servers that are synced from. Can be specified multiple times."`
+
+	RateLimit *UniverseRateLimitConfig `group:"ratelimit" namespace:"ratelimit"`
+
+	SignRoots bool `long:"signroots" description:"If true, the multiverse roots returned by the Universe server are signed with the node identity key of the backing lnd node, so clients can verify their authenticity."`
+
+	SignAssetRoots bool `long:"signassetroots" description:"If true, the per-asset universe roots returned by the Universe server are signed as well. Requires signroots to be set."`
+
+	SyncBatchSize int `long:"syncbatchsize" description:"The number of leaves that are fetched from a remote Universe and inserted into the local Universe in a single batch while syncing. Larger batches speed up large syncs at the cost of holding more proofs in memory at once."`
+
+	MaxProofDepth int `long:"maxproofdepth" description:"The maximum depth of the proof chain of an asset that is accepted when syncing with a remote Universe. The sync of an asset Universe with a deeper proof chain is skipped. Set to 0 to disable the limit."`
+
+	ResumeSync bool `long:"resumesync" description:"If true, a sync with a federation server that was interrupted, for example by a restart, resumes from where it left off, skipping the asset universes that were already synced. Otherwise, every sync starts over from scratch."`
+
+	ProofCacheSize uint64 `long:"proofcachesize" description:"The maximum number of leaf queries whose proofs are cached, so the inclusion proofs don't need to be generated again for every request. Cached proofs are invalidated whenever the local Universe changes. Set to 0 to disable the cache."`
+
+	ProofCacheTTL time.Duration `long:"proofcachettl" description:"The maximum amount of time a cached proof is served before it is generated again. Set to 0 to serve cached proofs until they are evicted or invalidated."`
+
+	SpentLeafRetention uint32 `long:"spentleafretention" description:"If non-zero, the proofs of transfer leaves that are spent by a transfer with at least this number of confirmations are pruned from the local Universe. Pruned leaves keep their hash and sum, so the Universe roots don't change and can still be verified, but their proofs can no longer be served to syncers or clients that request the full transfer history of an asset. Set to 0 to keep the full history."`
+
+	SpentLeafPruneInterval time.Duration `long:"spentleafpruneinterval" description:"Amount of time to wait between prunes of the spent transfer leaves. Only used if spentleafretention is set."`
+
+	ConflictPolicy string `long:"conflictpolicy" description:"The local policy that decides which of two diverging leaves for the same asset output is retained when merging leaves from federation members. This is a local policy, not a consensus rule." choice:"highest-priority-peer" choice:"first-seen" choice:"longest-chain"`
+
+	Proxy *UniverseProxyConfig `group:"proxy" namespace:"proxy"`
+}
+
+// UniverseProxyConfig is the config of the SOCKS5 proxy that is used to connect
+// to remote Universe servers.
+type UniverseProxyConfig struct {
+	Addr string `long:"addr" description:"The host:port of a SOCKS5 proxy, such as a Tor daemon, that is used to connect to the federation servers and to sync with remote Universe servers. Host names are resolved by the proxy, which allows connecting to onion services. Can be overridden per federation server."`
+
+	User string `long:"user" description:"The optional user name used to authenticate with the SOCKS5 proxy."`
+
+	Password string `long:"password" description:"The optional password used to authenticate with the SOCKS5 proxy."`
+}
+
+// UniverseRateLimitConfig is the config that houses the per peer rate limits
+// of the Universe RPCs.
+type UniverseRateLimitConfig struct {
+	RequestsPerSecond float64 `long:"requestspersecond" description:"The sustained number of Universe RPC calls per second a single peer is allowed to make. Set to 0 to disable the limit."`
+
+	Burst int `long:"burst" description:"The number of Universe RPC calls a single peer is allowed to make at once on top of the sustained rate."`
+
+	MaxConcurrentSyncs int `long:"maxconcurrentsyncs" description:"The maximum number of sync calls (leaf keys, leaves, sync and export) a single peer is allowed to have in flight at the same time. Set to 0 to disable the limit."`
+
+	PeerLimits []string `long:"peerlimit" description:"Override the limits for a single peer, such as a federation member, identified by its host. Must be of the form host=requests_per_second,burst,max_concurrent_syncs. Can be specified multiple times."`
 }
 
 // Config is the main config for the tapd cli command.
@@ -274,9 +385,13 @@ type Config struct {
 
 	ReOrgSafeDepth int32 `long:"reorgsafedepth" description:"The number of confirmations we'll wait for before considering a transaction safely buried in the chain."`
 
+	MinConfs uint32 `long:"minconfs" description:"The minimum number of confirmations the anchor transaction of an issuance or transfer proof must have before the proof is accepted from a remote party, synced, imported or pushed to the federation. Proofs with a shallower anchor are rejected with a retryable error."`
+
 	// The following options are used to configure the proof courier.
-	DefaultProofCourierAddr string                    `long:"proofcourieraddr" description:"Default proof courier service address."`
-	HashMailCourier         *proof.HashMailCourierCfg `group:"proofcourier" namespace:"hashmailcourier"`
+	DefaultProofCourierAddr string                     `long:"proofcourieraddr" description:"Default proof courier service address."`
+	HashMailCourier         *proof.HashMailCourierCfg  `group:"proofcourier" namespace:"hashmailcourier"`
+	LightningCourier        *proof.LightningCourierCfg `group:"proofcourier" namespace:"lightningcourier"`
+	ProofMailbox            *ProofMailboxConfig        `group:"proofmailbox" namespace:"proofmailbox"`
 
 	ChainConf *ChainConfig
 	RpcConf   *RpcConfig
@@ -306,6 +421,18 @@ type Config struct {
 	rpcListeners  []net.Addr
 	restListeners []net.Addr
 
+	universeRateLimits *rpcperms.RateLimiterConfig
+
+	universeAssetPolicy universe.AssetPolicy
+
+	universeConflictPolicy universe.ConflictPolicy
+
+	universeProxy *universe.ProxyConfig
+
+	mailboxUploadTokens []proof.MailboxAuthToken
+
+	mailboxFetchTokens []proof.MailboxAuthToken
+
 	net tor.Net
 }
 
@@ -349,6 +476,7 @@ func DefaultConfig() Config {
 		Prometheus:              monitoring.DefaultPrometheusConfig(),
 		BatchMintingInterval:    defaultBatchMintingInterval,
 		ReOrgSafeDepth:          defaultReOrgSafeDepth,
+		MinConfs:                defaultMinConfs,
 		DefaultProofCourierAddr: defaultProofCourierAddr,
 		HashMailCourier: &proof.HashMailCourierCfg{
 			ReceiverAckTimeout: defaultProofTransferReceiverAckTimeout,
@@ -359,8 +487,22 @@ func DefaultConfig() Config {
 				MaxBackoff:       defaultProofTransferMaxBackoff,
 			},
 		},
+		LightningCourier: proof.DefaultLightningCourierCfg(),
+		ProofMailbox: &ProofMailboxConfig{
+			ProofTTL: proof.DefaultMailboxProofTTL,
+		},
 		Universe: &UniverseConfig{
-			SyncInterval: defaultUniverseSyncInterval,
+			SyncInterval:           defaultUniverseSyncInterval,
+			HealthCheckInterval:    defaultUniverseHealthCheckInterval,
+			PushRetryInterval:      defaultUniversePushRetryInterval,
+			SyncBatchSize:          defaultUniverseSyncBatchSize,
+			MaxProofDepth:          defaultUniverseMaxProofDepth,
+			ProofCacheSize:         defaultUniverseProofCacheSize,
+			ProofCacheTTL:          defaultUniverseProofCacheTTL,
+			SpentLeafPruneInterval: defaultUniverseSpentLeafPruneInterval,
+			ConflictPolicy:         defaultUniverseConflictPolicy,
+			RateLimit:              &UniverseRateLimitConfig{},
+			Proxy:                  &UniverseProxyConfig{},
 		},
 	}
 }
@@ -771,22 +913,282 @@ func ValidateConfig(cfg Config, cfgLogger btclog.Logger) (*Config, error) {
 		}
 	}
 
+	// Parse the per peer rate limits of the Universe RPCs.
+	cfg.universeRateLimits, err = parseUniverseRateLimits(
+		cfg.Universe.RateLimit,
+	)
+	if err != nil {
+		return nil, mkErr("error parsing universe rate limits: %v", err)
+	}
+
+	// Make sure the proof chunks fit into the onion payload of a payment.
+	lnCourier := cfg.LightningCourier
+	if lnCourier.ChunkSize <= 0 ||
+		lnCourier.ChunkSize > proof.MaxLightningChunkSize {
+
+		return nil, mkErr("lightning courier chunk size must be "+
+			"between 1 and %d bytes", proof.MaxLightningChunkSize)
+	}
+	if lnCourier.MaxProofSize <= 0 {
+		return nil, mkErr("lightning courier max proof size must be " +
+			"positive")
+	}
+
+	// Make sure uploaded proofs don't expire immediately.
+	if cfg.ProofMailbox.Enable && cfg.ProofMailbox.ProofTTL <= 0 {
+		return nil, mkErr("proof mailbox proof TTL must be positive")
+	}
+
+	// Parse the tokens that authorize requests to the proof mailbox.
+	cfg.mailboxUploadTokens, cfg.mailboxFetchTokens, err =
+		parseMailboxAuthTokens(cfg.ProofMailbox)
+	if err != nil {
+		return nil, mkErr("error parsing proof mailbox tokens: %v",
+			err)
+	}
+
+	// Parse the policy of which assets the Universe accepts proofs for.
+	cfg.universeAssetPolicy, err = parseUniverseAssetPolicy(cfg.Universe)
+	if err != nil {
+		return nil, mkErr("error parsing universe asset policy: %v",
+			err)
+	}
+
+	// Normalize the hosts the Universe accepts pushed proofs from.
+	cfg.Universe.AcceptPushFrom, err = fn.MapErr(
+		cfg.Universe.AcceptPushFrom, universe.NormalizePushHost,
+	)
+	if err != nil {
+		return nil, mkErr("error parsing universe push hosts: %v", err)
+	}
+
+	cfg.universeConflictPolicy, err = universe.ParseConflictPolicy(
+		cfg.Universe.ConflictPolicy,
+	)
+	if err != nil {
+		return nil, mkErr("error parsing universe conflict policy: %v",
+			err)
+	}
+
+	if cfg.Universe.SignAssetRoots && !cfg.Universe.SignRoots {
+		return nil, mkErr("universe signassetroots requires " +
+			"signroots to be set")
+	}
+
+	if cfg.Universe.SyncBatchSize <= 0 {
+		return nil, mkErr("universe sync batch size must be " +
+			"positive")
+	}
+
+	if cfg.Universe.MaxProofDepth < 0 {
+		return nil, mkErr("universe max proof depth must not be " +
+			"negative")
+	}
+
+	if cfg.Universe.ProofCacheTTL < 0 {
+		return nil, mkErr("universe proof cache TTL must not be " +
+			"negative")
+	}
+
+	if cfg.Universe.SpentLeafRetention != 0 &&
+		cfg.Universe.SpentLeafPruneInterval <= 0 {
+
+		return nil, mkErr("universe spent leaf prune interval must " +
+			"be positive")
+	}
+
+	// Parse the proxy used to connect to remote Universe servers.
+	cfg.universeProxy, err = parseUniverseProxy(cfg.Universe.Proxy)
+	if err != nil {
+		return nil, mkErr("error parsing universe proxy: %v", err)
+	}
+
 	// All good, return the sanitized result.
 	return &cfg, nil
 }
 
+// parseUniverseProxy parses the Universe proxy config. If no proxy address is
+// set, then nil is returned.
+func parseUniverseProxy(
+	cfg *UniverseProxyConfig) (*universe.ProxyConfig, error) {
+
+	if cfg.Addr == "" {
+		if cfg.User != "" || cfg.Password != "" {
+			return nil, fmt.Errorf("proxy credentials set " +
+				"without proxy address")
+		}
+
+		return nil, nil
+	}
+
+	proxyCfg := &universe.ProxyConfig{
+		Addr:     cfg.Addr,
+		User:     cfg.User,
+		Password: cfg.Password,
+	}
+	if err := proxyCfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return proxyCfg, nil
+}
+
+// parseUniverseAssetPolicy parses the allowed and denied assets of the Universe
+// config into an asset policy.
+func parseUniverseAssetPolicy(
+	cfg *UniverseConfig) (universe.AssetPolicy, error) {
+
+	allowList, err := fn.MapErr(
+		cfg.AllowAssets, universe.NewAssetPolicyRule,
+	)
+	if err != nil {
+		return universe.AssetPolicy{}, err
+	}
+
+	denyList, err := fn.MapErr(cfg.DenyAssets, universe.NewAssetPolicyRule)
+	if err != nil {
+		return universe.AssetPolicy{}, err
+	}
+
+	return universe.AssetPolicy{
+		AllowList: allowList,
+		DenyList:  denyList,
+	}, nil
+}
+
+// parseMailboxAuthTokens parses the upload and fetch tokens of the proof
+// mailbox config.
+func parseMailboxAuthTokens(cfg *ProofMailboxConfig) ([]proof.MailboxAuthToken,
+	[]proof.MailboxAuthToken, error) {
+
+	uploadTokens, err := fn.MapErr(
+		cfg.UploadTokens, proof.ParseMailboxAuthToken,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid upload token: %w", err)
+	}
+
+	fetchTokens, err := fn.MapErr(
+		cfg.FetchTokens, proof.ParseMailboxAuthToken,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid fetch token: %w", err)
+	}
+
+	// The asset of a proof file isn't known before it is fetched, so fetch
+	// tokens can only be tied to the script key of the recipient.
+	for _, token := range fetchTokens {
+		if len(token.AssetIDs) != 0 {
+			return nil, nil, fmt.Errorf("fetch tokens can't be " +
+				"scoped to asset IDs")
+		}
+	}
+
+	return uploadTokens, fetchTokens, nil
+}
+
+// parseUniverseRateLimits parses the Universe rate limit config into the
+// config of the RPC rate limiter.
+func parseUniverseRateLimits(
+	cfg *UniverseRateLimitConfig) (*rpcperms.RateLimiterConfig, error) {
+
+	defaultLimit := rpcperms.RateLimit{
+		RequestsPerSecond:  cfg.RequestsPerSecond,
+		Burst:              cfg.Burst,
+		MaxConcurrentSyncs: cfg.MaxConcurrentSyncs,
+	}
+	if err := validateRateLimit(defaultLimit); err != nil {
+		return nil, err
+	}
+
+	peerLimits := make(map[string]rpcperms.RateLimit, len(cfg.PeerLimits))
+	for _, peerLimit := range cfg.PeerLimits {
+		host, limitStr, ok := strings.Cut(peerLimit, "=")
+		if !ok || host == "" {
+			return nil, fmt.Errorf("invalid peer limit %v, "+
+				"expected host=requests_per_second,burst,"+
+				"max_concurrent_syncs", peerLimit)
+		}
+
+		parts := strings.Split(limitStr, ",")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid peer limit %v, "+
+				"expected 3 comma separated values", peerLimit)
+		}
+
+		reqsPerSecond, err := strconv.ParseFloat(parts[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid requests per second "+
+				"for peer %v: %w", host, err)
+		}
+		burst, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid burst for peer %v: %w",
+				host, err)
+		}
+		maxSyncs, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid max concurrent syncs "+
+				"for peer %v: %w", host, err)
+		}
+
+		limit := rpcperms.RateLimit{
+			RequestsPerSecond:  reqsPerSecond,
+			Burst:              burst,
+			MaxConcurrentSyncs: maxSyncs,
+		}
+		if err := validateRateLimit(limit); err != nil {
+			return nil, fmt.Errorf("invalid limit for peer %v: %w",
+				host, err)
+		}
+
+		peerLimits[host] = limit
+	}
+
+	return &rpcperms.RateLimiterConfig{
+		DefaultLimit: defaultLimit,
+		PeerLimits:   peerLimits,
+	}, nil
+}
+
+// validateRateLimit makes sure none of the values of the rate limit is
+// negative.
+func validateRateLimit(limit rpcperms.RateLimit) error {
+	switch {
+	case limit.RequestsPerSecond < 0:
+		return fmt.Errorf("requests per second cannot be negative")
+
+	case limit.Burst < 0:
+		return fmt.Errorf("burst cannot be negative")
+
+	case limit.MaxConcurrentSyncs < 0:
+		return fmt.Errorf("max concurrent syncs cannot be negative")
+	}
+
+	return nil
+}
+
 // getTLSConfig returns a TLS configuration for the gRPC server and credentials
-// and a proxy destination for the REST reverse proxy.
+// and a proxy destination for the REST reverse proxy. Unless Let's Encrypt is
+// used, a function to reload the TLS certificate from disk is returned as
+// well.
 func getTLSConfig(cfg *Config,
 	cfgLogger btclog.Logger) ([]grpc.ServerOption, []grpc.DialOption,
-	func(net.Addr) (net.Listener, error), error) {
+	func(net.Addr) (net.Listener, error), func() error, error) {
 
-	tlsCfg, restCreds, err := getCertificateConfig(cfg, cfgLogger)
+	tlsCfg, restCreds, reloader, err := getCertificateConfig(
+		cfg, cfgLogger,
+	)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("error generating "+
+		return nil, nil, nil, nil, fmt.Errorf("error generating "+
 			"certificate: %w", err)
 	}
 
+	var reloadTLS func() error
+	if reloader != nil {
+		reloadTLS = reloader.Reload
+	}
+
 	serverCreds := credentials.NewTLS(tlsCfg)
 	serverOpts := []grpc.ServerOption{grpc.Creds(serverCreds)}
 
@@ -818,13 +1220,14 @@ func getTLSConfig(cfg *Config,
 		return lncfg.TLSListenOnAddress(addr, tlsCfg)
 	}
 
-	return serverOpts, restDialOpts, restListen, nil
+	return serverOpts, restDialOpts, restListen, reloadTLS, nil
 }
 
 // getCertificateConfig returns a useable TLS config and set of transport
-// credentials given a valid configuration..
+// credentials given a valid configuration. If the TLS certificate is loaded
+// from disk, then the returned TLS reloader can be used to reload it.
 func getCertificateConfig(cfg *Config, cfgLogger btclog.Logger) (*tls.Config,
-	credentials.TransportCredentials, error) {
+	credentials.TransportCredentials, *tlsReloader, error) {
 
 	// If let's encrypt is active, then we'll use certmagic to issue a TLS
 	// certificate for the desried domain. In this case, we can skip
@@ -839,7 +1242,7 @@ func getCertificateConfig(cfg *Config, cfgLogger btclog.Logger) (*tls.Config,
 			cfg.RpcConf.LetsEncryptListen,
 		)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		cfgLogger.Infof("Setting up Let's Encrypt listener on "+
@@ -856,11 +1259,11 @@ func getCertificateConfig(cfg *Config, cfgLogger btclog.Logger) (*tls.Config,
 				cfg.RpcConf.LetsEncryptListen,
 			)
 			if err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 			port, err := strconv.ParseUint(portStr, 10, 16)
 			if err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 
 			issuerCfg.ListenHost = host
@@ -871,7 +1274,7 @@ func getCertificateConfig(cfg *Config, cfgLogger btclog.Logger) (*tls.Config,
 		certCfg.Issuers = append(certCfg.Issuers, issuer)
 		err = certCfg.ManageSync(nil, domainNames)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		tlsCfg := certCfg.TLSConfig()
@@ -879,7 +1282,7 @@ func getCertificateConfig(cfg *Config, cfgLogger btclog.Logger) (*tls.Config,
 
 		restCreds := credentials.NewTLS(&tls.Config{})
 
-		return tlsCfg, restCreds, nil
+		return tlsCfg, restCreds, nil, nil
 	}
 
 	// Ensure we create TLS key and certificate if they don't exist.
@@ -894,7 +1297,7 @@ func getCertificateConfig(cfg *Config, cfgLogger btclog.Logger) (*tls.Config,
 			cfg.RpcConf.TLSCertDuration,
 		)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		// Now that we have the certificate and key, we'll store them
@@ -904,17 +1307,17 @@ func getCertificateConfig(cfg *Config, cfgLogger btclog.Logger) (*tls.Config,
 			certBytes, keyBytes,
 		)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		cfgLogger.Infof("Done generating TLS certificates")
 	}
 
-	certData, parsedCert, err := cert.LoadCert(
+	_, parsedCert, err := cert.LoadCert(
 		cfg.RpcConf.TLSCertPath, cfg.RpcConf.TLSKeyPath,
 	)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	// We check whether the certificate we have on disk match the IPs and
@@ -929,7 +1332,7 @@ func getCertificateConfig(cfg *Config, cfgLogger btclog.Logger) (*tls.Config,
 			cfg.RpcConf.TLSDisableAutofill,
 		)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 	}
 
@@ -941,12 +1344,12 @@ func getCertificateConfig(cfg *Config, cfgLogger btclog.Logger) (*tls.Config,
 
 		err := os.Remove(cfg.RpcConf.TLSCertPath)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		err = os.Remove(cfg.RpcConf.TLSKeyPath)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		cfgLogger.Infof("Renewing TLS certificates...")
@@ -957,7 +1360,7 @@ func getCertificateConfig(cfg *Config, cfgLogger btclog.Logger) (*tls.Config,
 			cfg.RpcConf.TLSCertDuration,
 		)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		// Now that we have the certificate and key, we'll store them
@@ -967,31 +1370,29 @@ func getCertificateConfig(cfg *Config, cfgLogger btclog.Logger) (*tls.Config,
 			certBytes, keyBytes,
 		)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		cfgLogger.Infof("Done renewing TLS certificates")
-
-		// Reload the certificate data.
-		certData, _, err = cert.LoadCert(
-			cfg.RpcConf.TLSCertPath, cfg.RpcConf.TLSKeyPath,
-		)
-		if err != nil {
-			return nil, nil, err
-		}
 	}
 
-	tlsCfg := cert.TLSConfFromCert(certData)
-	tlsCfg.NextProtos = []string{http2.NextProtoTLS}
-
-	restCreds, err := credentials.NewClientTLSFromFile(
-		cfg.RpcConf.TLSCertPath, "",
+	// We serve the certificate through a reloader, so it can be replaced
+	// with a rotated certificate without restarting the listeners. The
+	// REST proxy pins the current certificate when connecting to the gRPC
+	// server.
+	reloader, err := newTLSReloader(
+		cfg.RpcConf.TLSCertPath, cfg.RpcConf.TLSKeyPath,
 	)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	return tlsCfg, restCreds, nil
+	tlsCfg := reloader.serverConfig()
+	tlsCfg.NextProtos = []string{http2.NextProtoTLS}
+
+	restCreds := credentials.NewTLS(reloader.clientConfig())
+
+	return tlsCfg, restCreds, reloader, nil
 }
 
 // fileExists reports whether the named file or directory exists.
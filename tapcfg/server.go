@@ -6,6 +6,7 @@ import (
 	"database/sql"
 	"encoding/binary"
 	"fmt"
+	"net"
 
 	"github.com/btcsuite/btclog"
 	"github.com/lightninglabs/lndclient"
@@ -13,6 +14,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/monitoring"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/tapdb"
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
@@ -30,6 +32,7 @@ import (
 // database backends implement.
 type databaseBackend interface {
 	tapdb.BatchedQuerier
+	tapdb.DBCompactor
 	WithTx(tx *sql.Tx) *sqlc.Queries
 }
 
@@ -126,6 +129,26 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	)
 	universeStats := tapdb.NewUniverseStats(uniStatsDB, defaultClock)
 
+	federationStore := tapdb.NewTransactionExecutor(db,
+		func(tx *sql.Tx) tapdb.UniverseServerStore {
+			return db.WithTx(tx)
+		},
+	)
+	federationDB := tapdb.NewUniverseFederationDB(
+		federationStore, defaultClock,
+	)
+
+	// All outbound connections to remote universe servers go through the
+	// configured proxy, unless a server specific proxy is set.
+	universeProxy := cfg.universeProxy
+	newRemoteDiffEngine := func(
+		addr universe.ServerAddr) (universe.DiffEngine, error) {
+
+		return tap.NewRpcUniverseDiff(
+			universe.WithDefaultProxy(addr, universeProxy),
+		)
+	}
+
 	headerVerifier := tapgarden.GenHeaderVerifier(
 		context.Background(), chainBridge,
 	)
@@ -142,17 +165,19 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		GroupVerifier:  groupVerifier,
 		Multiverse:     multiverse,
 		UniverseStats:  universeStats,
+		AssetPolicy:    cfg.universeAssetPolicy,
+		AcceptPushFrom: cfg.Universe.AcceptPushFrom,
+		LookupHost:     net.DefaultResolver.LookupHost,
+		MinConfs:       cfg.MinConfs,
+		ChainHeight:    chainBridge.CurrentHeight,
+		ProofCacheSize: cfg.Universe.ProofCacheSize,
+		ProofCacheTTL:  cfg.Universe.ProofCacheTTL,
+		ConflictPolicy: cfg.universeConflictPolicy,
+
+		LazyRoots:           federationDB,
+		NewRemoteDiffEngine: newRemoteDiffEngine,
 	}
 
-	federationStore := tapdb.NewTransactionExecutor(db,
-		func(tx *sql.Tx) tapdb.UniverseServerStore {
-			return db.WithTx(tx)
-		},
-	)
-	federationDB := tapdb.NewUniverseFederationDB(
-		federationStore, defaultClock,
-	)
-
 	proofFileStore, err := proof.NewFileArchiver(cfg.networkDir)
 	if err != nil {
 		return nil, fmt.Errorf("unable to open disk archive: %v", err)
@@ -230,12 +255,24 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	// TODO(ffranr): This logic is leftover for integration tests which
 	//  do not yet enable a proof courier. Remove once all integration tests
 	//  support a proof courier.
-	var proofCourierCfg *proof.CourierCfg
+	var (
+		proofCourierCfg   *proof.CourierCfg
+		lightningReceiver *proof.LightningProofReceiver
+	)
 	if cfg.HashMailCourier != nil {
+		lightningReceiver = proof.NewLightningProofReceiver(
+			proof.LightningReceiverConfig{
+				Invoices:     lndServices.Client,
+				MaxProofSize: cfg.LightningCourier.MaxProofSize,
+			},
+		)
 		proofCourierCfg = &proof.CourierCfg{
 			ReceiverAckTimeout: cfg.HashMailCourier.ReceiverAckTimeout,
 			BackoffCfg:         cfg.HashMailCourier.BackoffCfg,
 			DeliveryLog:        assetStore,
+			LightningCourier:   cfg.LightningCourier,
+			KeysendSender:      lndServices.Router,
+			LightningReceiver:  lightningReceiver,
 		}
 	}
 
@@ -269,11 +306,32 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 
 	baseUni := universe.NewMintingArchive(uniCfg)
 
+	newRemoteRegistrar := func(
+		addr universe.ServerAddr) (universe.Registrar, error) {
+
+		return tap.NewRpcUniverseRegistrar(
+			universe.WithDefaultProxy(addr, universeProxy),
+		)
+	}
+
+	universeReOrgWatcher := universe.NewLeafReOrgWatcher(
+		universe.LeafReOrgWatcherConfig{
+			ChainNotifier: chainBridge,
+			Archive:       baseUni,
+			SafeDepth:     uint32(cfg.ReOrgSafeDepth),
+		},
+	)
+
 	universeSyncer := universe.NewSimpleSyncer(universe.SimpleSyncCfg{
 		LocalDiffEngine:     baseUni,
-		NewRemoteDiffEngine: tap.NewRpcUniverseDiff,
+		NewRemoteDiffEngine: newRemoteDiffEngine,
 		LocalRegistrar:      baseUni,
-		SyncBatchSize:       defaultUniverseSyncBatchSize,
+		SyncBatchSize:       cfg.Universe.SyncBatchSize,
+		MaxProofDepth:       cfg.Universe.MaxProofDepth,
+		MinConfs:            cfg.MinConfs,
+		ChainHeight:         chainBridge.CurrentHeight,
+		LazyRoots:           federationDB,
+		Checkpoints:         federationDB,
 	})
 
 	var runtimeIDBytes [8]byte
@@ -283,24 +341,81 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	}
 
 	runtimeID := int64(binary.BigEndian.Uint64(runtimeIDBytes[:]))
+
+	// The proof courier mailbox is only created if it's enabled, other
+	// nodes can't upload proofs to us otherwise.
+	var proofMailbox *proof.Mailbox
+	if cfg.ProofMailbox.Enable {
+		mailboxDB := tapdb.NewTransactionExecutor(
+			db, func(tx *sql.Tx) tapdb.ProofMailboxStore {
+				return db.WithTx(tx)
+			},
+		)
+		proofMailbox = proof.NewMailbox(proof.MailboxConfig{
+			Store:        tapdb.NewProofMailboxDB(mailboxDB),
+			ProofTTL:     cfg.ProofMailbox.ProofTTL,
+			Clock:        defaultClock,
+			UploadTokens: cfg.mailboxUploadTokens,
+			FetchTokens:  cfg.mailboxFetchTokens,
+		})
+	}
+
+	// The spent leaf pruner is only created if a retention policy is set,
+	// the full transfer history is kept otherwise.
+	var spentLeafPruner *universe.SpentLeafPruner
+	if cfg.Universe.SpentLeafRetention != 0 {
+		spentLeafPruner = universe.NewSpentLeafPruner(
+			universe.SpentLeafPrunerConfig{
+				Archive:        baseUni,
+				ChainHeight:    chainBridge.CurrentHeight,
+				RetentionConfs: cfg.Universe.SpentLeafRetention,
+				PruneInterval:  cfg.Universe.SpentLeafPruneInterval,
+			},
+		)
+	}
+
+	// All leaves that are pushed out to the federation by the envoy are
+	// created locally (e.g. by minting), so we'll mark them as such.
+	localRegistrar := baseUni.RegistrarWithSource(universe.LeafSourceLocal)
 	universeFederation := universe.NewFederationEnvoy(
 		universe.FederationConfig{
 			FederationDB:            federationDB,
 			UniverseSyncer:          universeSyncer,
-			LocalRegistrar:          baseUni,
+			LocalRegistrar:          localRegistrar,
+			LocalDiffEngine:         baseUni,
+			UniverseStats:           universeStats,
 			SyncInterval:            cfg.Universe.SyncInterval,
-			NewRemoteRegistrar:      tap.NewRpcUniverseRegistrar,
+			HealthCheckInterval:     cfg.Universe.HealthCheckInterval,
+			PushRetryInterval:       cfg.Universe.PushRetryInterval,
+			PushObserver:            monitoring.ObservePush,
+			MinConfs:                cfg.MinConfs,
+			ChainHeight:             chainBridge.CurrentHeight,
+			ResumeSync:              cfg.Universe.ResumeSync,
+			NewRemoteRegistrar:      newRemoteRegistrar,
+			NewRemoteDiffEngine:     newRemoteDiffEngine,
 			StaticFederationMembers: federationMembers,
 			ServerChecker: func(addr universe.ServerAddr) error {
 				return tap.CheckFederationServer(
 					runtimeID, universe.DefaultTimeout,
-					addr,
+					universe.WithDefaultProxy(
+						addr, universeProxy,
+					),
 				)
 			},
 			ErrChan: mainErrChan,
 		},
 	)
 
+	// If enabled, the roots served by the universe server are signed with
+	// the node identity key of lnd.
+	var rootSigner universe.RootSigner
+	if cfg.Universe.SignRoots {
+		rootSigner, err = tap.NewLndRootSigner(lndServices)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	virtualTxSigner := tap.NewLndRpcVirtualTxSigner(lndServices)
 	coinSelect := tapfreighter.NewCoinSelect(assetStore)
 	assetWallet := tapfreighter.NewAssetWallet(&tapfreighter.WalletConfig{
@@ -314,30 +429,43 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		ChainParams:  &tapChainParams,
 	})
 
+	assetMinter := tapgarden.NewChainPlanter(tapgarden.PlanterConfig{
+		GardenKit: tapgarden.GardenKit{
+			Wallet:                walletAnchor,
+			ChainBridge:           chainBridge,
+			Log:                   assetMintingStore,
+			KeyRing:               keyRing,
+			GenSigner:             virtualTxSigner,
+			GenTxBuilder:          &tapscript.GroupTxBuilder{},
+			TxValidator:           &tap.ValidatorV0{},
+			ProofFiles:            proofFileStore,
+			Universe:              universeFederation,
+			ProofWatcher:          reOrgWatcher,
+			UniversePushBatchSize: defaultUniverseSyncBatchSize,
+			BatchFinalized:        monitoring.ObserveBatchDuration,
+		},
+		BatchTicker:  ticker.NewForce(cfg.BatchMintingInterval),
+		ProofUpdates: proofArchive,
+		ErrChan:      mainErrChan,
+	})
+
+	emissionScheduler := tapgarden.NewEmissionScheduler(
+		tapgarden.EmissionSchedulerConfig{
+			Log:         assetMintingStore,
+			ChainBridge: chainBridge,
+			Planter:     assetMinter,
+			ErrChan:     mainErrChan,
+		},
+	)
+
 	return &tap.Config{
-		DebugLevel:   cfg.DebugLevel,
-		RuntimeID:    runtimeID,
-		Lnd:          lndServices,
-		ChainParams:  cfg.ActiveNetParams,
-		ReOrgWatcher: reOrgWatcher,
-		AssetMinter: tapgarden.NewChainPlanter(tapgarden.PlanterConfig{
-			GardenKit: tapgarden.GardenKit{
-				Wallet:                walletAnchor,
-				ChainBridge:           chainBridge,
-				Log:                   assetMintingStore,
-				KeyRing:               keyRing,
-				GenSigner:             virtualTxSigner,
-				GenTxBuilder:          &tapscript.GroupTxBuilder{},
-				TxValidator:           &tap.ValidatorV0{},
-				ProofFiles:            proofFileStore,
-				Universe:              universeFederation,
-				ProofWatcher:          reOrgWatcher,
-				UniversePushBatchSize: defaultUniverseSyncBatchSize,
-			},
-			BatchTicker:  ticker.NewForce(cfg.BatchMintingInterval),
-			ProofUpdates: proofArchive,
-			ErrChan:      mainErrChan,
-		}),
+		DebugLevel:        cfg.DebugLevel,
+		RuntimeID:         runtimeID,
+		Lnd:               lndServices,
+		ChainParams:       cfg.ActiveNetParams,
+		ReOrgWatcher:      reOrgWatcher,
+		AssetMinter:       assetMinter,
+		EmissionScheduler: emissionScheduler,
 		AssetCustodian: tapgarden.NewCustodian(
 			&tapgarden.CustodianConfig{
 				ChainParams:  &tapChainParams,
@@ -358,6 +486,8 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		AddrBook:                addrBook,
 		DefaultProofCourierAddr: proofCourierAddr.Url(),
 		ProofArchive:            proofArchive,
+		ProofMailbox:            proofMailbox,
+		LightningProofReceiver:  lightningReceiver,
 		AssetWallet:             assetWallet,
 		CoinSelect:              coinSelect,
 		ChainPorter: tapfreighter.NewChainPorter(
@@ -372,18 +502,28 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 				Wallet:          walletAnchor,
 				KeyRing:         keyRing,
 				AssetWallet:     assetWallet,
+				CoinSelector:    coinSelect,
 				AssetProofs:     proofFileStore,
 				ProofCourierCfg: proofCourierCfg,
 				ProofWatcher:    reOrgWatcher,
+				Universe:        universeFederation,
 				ErrChan:         mainErrChan,
 			},
 		),
-		BaseUniverse:         baseUni,
-		UniverseSyncer:       universeSyncer,
-		UniverseFederation:   universeFederation,
-		UniverseStats:        universeStats,
-		UniversePublicAccess: cfg.Universe.PublicAccess,
-		LogWriter:            cfg.LogWriter,
+		BaseUniverse:           baseUni,
+		UniverseSyncer:         universeSyncer,
+		UniverseFederation:     universeFederation,
+		UniverseReOrgWatcher:   universeReOrgWatcher,
+		SpentLeafPruner:        spentLeafPruner,
+		SpentLeafRetention:     cfg.Universe.SpentLeafRetention,
+		UniverseStats:          universeStats,
+		UniverseProxy:          universeProxy,
+		UniversePublicAccess:   cfg.Universe.PublicAccess,
+		UniverseReadOnly:       cfg.Universe.ReadOnly,
+		ProofMinConfs:          cfg.MinConfs,
+		UniverseRootSigner:     rootSigner,
+		UniverseSignAssetRoots: cfg.Universe.SignAssetRoots,
+		LogWriter:              cfg.LogWriter,
 		DatabaseConfig: &tap.DatabaseConfig{
 			RootKeyStore: tapdb.NewRootKeyStore(rksDB),
 			MintingStore: assetMintingStore,
@@ -391,6 +531,7 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			TapAddrBook:  tapdbAddrBook,
 			Multiverse:   multiverse,
 			FederationDB: federationDB,
+			DBCompactor:  db,
 		},
 		Prometheus: cfg.Prometheus,
 	}, nil
@@ -405,7 +546,7 @@ func CreateServerFromConfig(cfg *Config, cfgLogger btclog.Logger,
 	// Given the config above, grab the TLS config which includes the set
 	// of dial options, and also the listeners we'll use to listen on the
 	// RPC system.
-	serverOpts, restDialOpts, restListen, err := getTLSConfig(
+	serverOpts, restDialOpts, restListen, reloadTLS, err := getTLSConfig(
 		cfg, cfgLogger,
 	)
 	if err != nil {
@@ -441,6 +582,7 @@ func CreateServerFromConfig(cfg *Config, cfgLogger btclog.Logger,
 		GrpcServerOpts:             serverOpts,
 		RestDialOpts:               restDialOpts,
 		RestListenFunc:             restListen,
+		ReloadTLS:                  reloadTLS,
 		WSPingInterval:             cfg.RpcConf.WSPingInterval,
 		WSPongWait:                 cfg.RpcConf.WSPongWait,
 		RestCORS:                   cfg.RpcConf.RestCORS,
@@ -452,6 +594,7 @@ func CreateServerFromConfig(cfg *Config, cfgLogger btclog.Logger,
 		LetsEncryptListen:          cfg.RpcConf.LetsEncryptListen,
 		LetsEncryptEmail:           cfg.RpcConf.LetsEncryptEmail,
 		LetsEncryptDomain:          cfg.RpcConf.LetsEncryptDomain,
+		UniverseRateLimits:         cfg.universeRateLimits,
 	}
 
 	return tap.NewServer(serverCfg), nil
@@ -0,0 +1,144 @@
+package tapcfg
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/cert"
+)
+
+// tlsReloader holds the TLS certificate used by the gRPC and REST listeners
+// and allows it to be swapped out for the certificate that is currently on
+// disk, without restarting the listeners. New connections are served with the
+// new certificate, while existing connections aren't affected.
+type tlsReloader struct {
+	*cert.TLSReloader
+
+	certPath string
+	keyPath  string
+
+	mu   sync.RWMutex
+	leaf *x509.Certificate
+}
+
+// newTLSReloader creates a new TLS reloader for the certificate and key at the
+// given paths, loading the initial certificate from disk.
+func newTLSReloader(certPath, keyPath string) (*tlsReloader, error) {
+	certBytes, keyBytes, leaf, err := loadValidCert(certPath, keyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	reloader, err := cert.NewTLSReloader(certBytes, keyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tlsReloader{
+		TLSReloader: reloader,
+		certPath:    certPath,
+		keyPath:     keyPath,
+		leaf:        leaf,
+	}, nil
+}
+
+// loadValidCert reads the TLS certificate and key at the given paths and makes
+// sure they form a valid key pair and that the certificate is currently valid.
+func loadValidCert(certPath, keyPath string) ([]byte, []byte,
+	*x509.Certificate, error) {
+
+	certBytes, err := os.ReadFile(certPath)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	keyBytes, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	_, leaf, err := cert.LoadCertFromBytes(certBytes, keyBytes)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	now := time.Now()
+	switch {
+	case now.Before(leaf.NotBefore):
+		return nil, nil, nil, fmt.Errorf("TLS certificate is not "+
+			"valid before %v", leaf.NotBefore)
+
+	case now.After(leaf.NotAfter):
+		return nil, nil, nil, fmt.Errorf("TLS certificate expired "+
+			"at %v", leaf.NotAfter)
+	}
+
+	return certBytes, keyBytes, leaf, nil
+}
+
+// Reload loads the TLS certificate and key from disk and, if they are valid,
+// uses them for all new connections. If the new certificate is invalid, then
+// the current certificate is kept and an error is returned.
+func (r *tlsReloader) Reload() error {
+	certBytes, keyBytes, leaf, err := loadValidCert(r.certPath, r.keyPath)
+	if err != nil {
+		return fmt.Errorf("invalid TLS certificate: %w", err)
+	}
+
+	// We hold the lock while swapping the certificate, so the REST proxy
+	// never sees the certificates go out of sync.
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.AttemptReload(certBytes, keyBytes); err != nil {
+		return fmt.Errorf("unable to reload TLS certificate: %w", err)
+	}
+	r.leaf = leaf
+
+	return nil
+}
+
+// verifyPeerCertificate makes sure the certificate presented by the server is
+// the current TLS certificate. It is used by the REST proxy when connecting to
+// the gRPC server, which pins the certificate instead of validating it against
+// a CA, as the certificate might be self-signed.
+func (r *tlsReloader) verifyPeerCertificate(rawCerts [][]byte,
+	_ [][]*x509.Certificate) error {
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(rawCerts) == 0 || !bytes.Equal(rawCerts[0], r.leaf.Raw) {
+		return fmt.Errorf("server certificate doesn't match the " +
+			"current TLS certificate")
+	}
+
+	return nil
+}
+
+// serverConfig returns a TLS config for the gRPC and REST listeners that
+// always serves the current TLS certificate.
+func (r *tlsReloader) serverConfig() *tls.Config {
+	tlsCfg := cert.TLSConfFromCert(tls.Certificate{})
+	tlsCfg.Certificates = nil
+	tlsCfg.GetCertificate = r.GetCertificateFunc()
+
+	return tlsCfg
+}
+
+// clientConfig returns a TLS config for the REST proxy's connection to the
+// gRPC server that only accepts the current TLS certificate.
+func (r *tlsReloader) clientConfig() *tls.Config {
+	return &tls.Config{
+		// We verify the certificate ourselves, by making sure it is
+		// the exact certificate we serve.
+		//nolint:gosec
+		InsecureSkipVerify:    true,
+		VerifyPeerCertificate: r.verifyPeerCertificate,
+		MinVersion:            tls.VersionTLS12,
+	}
+}
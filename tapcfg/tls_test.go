@@ -0,0 +1,146 @@
+package tapcfg
+
+import (
+	"crypto/tls"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/cert"
+	"github.com/stretchr/testify/require"
+)
+
+// genCertPair generates a new self-signed certificate and key that are valid
+// for the given duration, returning the PEM encoded certificate and key and
+// the raw DER encoded certificate.
+func genCertPair(t *testing.T, validity time.Duration) ([]byte, []byte,
+	[]byte) {
+
+	certBytes, keyBytes, err := cert.GenCertPair(
+		"tapd test", nil, nil, false, validity,
+	)
+	require.NoError(t, err)
+
+	_, leaf, err := cert.LoadCertFromBytes(certBytes, keyBytes)
+	require.NoError(t, err)
+
+	return certBytes, keyBytes, leaf.Raw
+}
+
+// writeCertPair writes the given certificate and key to the given paths.
+func writeCertPair(t *testing.T, certPath, keyPath string, certBytes,
+	keyBytes []byte) {
+
+	require.NoError(t, os.WriteFile(certPath, certBytes, 0600))
+	require.NoError(t, os.WriteFile(keyPath, keyBytes, 0600))
+}
+
+// servedCert returns the raw DER encoded certificate the reloader currently
+// serves to new connections.
+func servedCert(t *testing.T, r *tlsReloader) []byte {
+	tlsCert, err := r.GetCertificateFunc()(nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, tlsCert.Certificate)
+
+	return tlsCert.Certificate[0]
+}
+
+// handshake performs a TLS handshake between the server and client config of
+// the given reloader, returning the certificate the server presented.
+func handshake(t *testing.T, r *tlsReloader) []byte {
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	server := tls.Server(serverConn, r.serverConfig())
+	client := tls.Client(clientConn, r.clientConfig())
+
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- server.Handshake()
+	}()
+
+	require.NoError(t, client.Handshake())
+	require.NoError(t, <-serverErr)
+
+	peerCerts := client.ConnectionState().PeerCertificates
+	require.NotEmpty(t, peerCerts)
+
+	return peerCerts[0].Raw
+}
+
+// TestTLSReloader tests that reloading the TLS certificate only swaps it out
+// if the certificate and key on disk are valid, and that the REST proxy only
+// accepts the certificate that is currently served.
+func TestTLSReloader(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "tls.cert")
+	keyPath := filepath.Join(dir, "tls.key")
+
+	oldCert, oldKey, oldRaw := genCertPair(t, time.Hour)
+	writeCertPair(t, certPath, keyPath, oldCert, oldKey)
+
+	reloader, err := newTLSReloader(certPath, keyPath)
+	require.NoError(t, err)
+	require.Equal(t, oldRaw, servedCert(t, reloader))
+	require.NoError(t, reloader.verifyPeerCertificate(
+		[][]byte{oldRaw}, nil,
+	))
+
+	// requireOldCert asserts that a reload fails and that the old
+	// certificate is still served and accepted afterwards.
+	requireOldCert := func() {
+		t.Helper()
+
+		require.ErrorContains(
+			t, reloader.Reload(), "invalid TLS certificate",
+		)
+		require.Equal(t, oldRaw, servedCert(t, reloader))
+		require.NoError(t, reloader.verifyPeerCertificate(
+			[][]byte{oldRaw}, nil,
+		))
+	}
+
+	// A missing certificate or key is rejected.
+	require.NoError(t, os.Remove(keyPath))
+	requireOldCert()
+
+	require.NoError(t, os.Remove(certPath))
+	requireOldCert()
+
+	// So are files that don't contain a valid certificate and key.
+	writeCertPair(t, certPath, keyPath, []byte("foo"), []byte("bar"))
+	requireOldCert()
+
+	// A certificate that doesn't belong to the key is rejected.
+	newCert, newKey, newRaw := genCertPair(t, time.Hour)
+	writeCertPair(t, certPath, keyPath, newCert, oldKey)
+	requireOldCert()
+
+	// An expired certificate is rejected as well.
+	expiredCert, expiredKey, _ := genCertPair(t, -time.Hour)
+	writeCertPair(t, certPath, keyPath, expiredCert, expiredKey)
+	requireOldCert()
+
+	// Finally, a valid certificate and key are swapped in. From now on,
+	// only the new certificate is served and accepted by the REST proxy.
+	writeCertPair(t, certPath, keyPath, newCert, newKey)
+	require.NoError(t, reloader.Reload())
+	require.Equal(t, newRaw, servedCert(t, reloader))
+
+	require.NoError(t, reloader.verifyPeerCertificate(
+		[][]byte{newRaw}, nil,
+	))
+	require.Error(t, reloader.verifyPeerCertificate(
+		[][]byte{oldRaw}, nil,
+	))
+	require.Error(t, reloader.verifyPeerCertificate(nil, nil))
+
+	// New connections to the listeners are served with the new
+	// certificate, which the REST proxy accepts.
+	require.Equal(t, newRaw, handshake(t, reloader))
+}
@@ -44,6 +44,9 @@ type (
 	// AddrManaged is a type alias for setting an address as managed.
 	AddrManaged = sqlc.SetAddrManagedParams
 
+	// AddrRevoked is a type alias for setting an address as revoked.
+	AddrRevoked = sqlc.RevokeAddrParams
+
 	// UpsertAddrEvent is a type alias for creating a new address event or
 	// updating an existing one.
 	UpsertAddrEvent = sqlc.UpsertAddrEventParams
@@ -96,6 +99,11 @@ type AddrBook interface {
 	// into the database and returns the primary key.
 	UpsertInternalKey(ctx context.Context, arg InternalKey) (int64, error)
 
+	// QueryInternalKeys fetches the internal keys, optionally filtered by
+	// their key family.
+	QueryInternalKeys(ctx context.Context,
+		keyFamily sql.NullInt32) ([]sqlc.InternalKey, error)
+
 	// UpsertScriptKey inserts a new script key on disk into the DB.
 	UpsertScriptKey(context.Context, NewScriptKey) (int64, error)
 
@@ -103,6 +111,14 @@ type AddrBook interface {
 	// wallet.
 	SetAddrManaged(ctx context.Context, arg AddrManaged) error
 
+	// RevokeAddr sets an address as being revoked.
+	RevokeAddr(ctx context.Context, arg AddrRevoked) error
+
+	// CountRevokedAddrsByScriptKey returns the number of revoked addresses
+	// with the given tweaked script key.
+	CountRevokedAddrsByScriptKey(ctx context.Context,
+		tweakedScriptKey []byte) (int64, error)
+
 	// UpsertManagedUTXO inserts a new or updates an existing managed UTXO
 	// to disk and returns the primary key.
 	UpsertManagedUTXO(ctx context.Context, arg RawManagedUTXO) (int64,
@@ -456,6 +472,7 @@ func (t *TapAddressBook) QueryAddrs(ctx context.Context,
 				TaprootOutputKey: *taprootOutputKey,
 				CreationTime:     addr.CreationTime.UTC(),
 				ManagedAfter:     addr.ManagedFrom.Time.UTC(),
+				RevokedAt:        addr.RevokedAt.Time.UTC(),
 			})
 		}
 
@@ -603,6 +620,7 @@ func fetchAddr(ctx context.Context, db AddrBook, params *address.ChainParams,
 		InternalKeyDesc:  internalKeyDesc,
 		TaprootOutputKey: *taprootOutputKey,
 		CreationTime:     dbAddr.CreationTime.UTC(),
+		RevokedAt:        dbAddr.RevokedAt.Time.UTC(),
 	}, nil
 }
 
@@ -625,6 +643,48 @@ func (t *TapAddressBook) SetAddrManaged(ctx context.Context,
 	})
 }
 
+// RevokeAddr sets an address as being revoked, so payments to it are no longer
+// claimed.
+func (t *TapAddressBook) RevokeAddr(ctx context.Context,
+	addr *address.AddrWithKeyInfo, revokedAt time.Time) error {
+
+	var writeTxOpts AddrBookTxOptions
+	return t.db.ExecTx(ctx, &writeTxOpts, func(db AddrBook) error {
+		return db.RevokeAddr(ctx, AddrRevoked{
+			RevokedAt: sql.NullTime{
+				Time:  revokedAt.UTC(),
+				Valid: true,
+			},
+			TaprootOutputKey: schnorr.SerializePubKey(
+				&addr.TaprootOutputKey,
+			),
+		})
+	})
+}
+
+// HasRevokedAddr returns true if any revoked address uses the given script
+// key.
+func (t *TapAddressBook) HasRevokedAddr(ctx context.Context,
+	scriptKey *btcec.PublicKey) (bool, error) {
+
+	var (
+		numRevoked int64
+		readOpts   = NewAddrBookReadTx()
+	)
+	err := t.db.ExecTx(ctx, &readOpts, func(db AddrBook) error {
+		var err error
+		numRevoked, err = db.CountRevokedAddrsByScriptKey(
+			ctx, scriptKey.SerializeCompressed(),
+		)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return numRevoked > 0, nil
+}
+
 // InsertInternalKey inserts an internal key into the database to make sure it
 // is identified as a local key later on when importing proofs. The key can be
 // an internal key for an asset script key or the internal key of an anchor
@@ -646,6 +706,55 @@ func (t *TapAddressBook) InsertInternalKey(ctx context.Context,
 	})
 }
 
+// QueryInternalKeys returns the internal keys stored in the database, sorted by
+// their key family and index. If the key family is set, only the keys of that
+// family are returned.
+func (t *TapAddressBook) QueryInternalKeys(ctx context.Context,
+	keyFamily *keychain.KeyFamily) ([]keychain.KeyDescriptor, error) {
+
+	var familyFilter sql.NullInt32
+	if keyFamily != nil {
+		familyFilter = sqlInt32(*keyFamily)
+	}
+
+	var (
+		readOpts = NewAddrBookReadTx()
+		keys     []keychain.KeyDescriptor
+	)
+	err := t.db.ExecTx(ctx, &readOpts, func(db AddrBook) error {
+		dbKeys, err := db.QueryInternalKeys(ctx, familyFilter)
+		if err != nil {
+			return err
+		}
+
+		keys = make([]keychain.KeyDescriptor, 0, len(dbKeys))
+		for _, dbKey := range dbKeys {
+			rawKey, err := btcec.ParsePubKey(dbKey.RawKey)
+			if err != nil {
+				return fmt.Errorf("unable to parse raw key: %w",
+					err)
+			}
+
+			keys = append(keys, keychain.KeyDescriptor{
+				PubKey: rawKey,
+				KeyLocator: keychain.KeyLocator{
+					Family: keychain.KeyFamily(
+						dbKey.KeyFamily,
+					),
+					Index: uint32(dbKey.KeyIndex),
+				},
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
 // InsertScriptKey inserts an address related script key into the database, so
 // it can be recognized as belonging to the wallet when a transfer comes in
 // later on.
@@ -878,14 +987,16 @@ func fetchEvent(ctx context.Context, db AddrBook, eventID int64,
 		InternalKey:        internalKey,
 		ConfirmationHeight: uint32(dbEvent.ConfirmationHeight.Int32),
 		HasProof:           dbEvent.AssetProofID.Valid,
+		ReceivedAmount:     uint64(dbEvent.ReceivedAmount.Int64),
 	}, nil
 }
 
 // CompleteEvent updates an address event as being complete and links it with
-// the proof and asset that was imported/created for it.
+// the proof and asset that was imported/created for it. The asset amount that
+// was actually received is stored with the event.
 func (t *TapAddressBook) CompleteEvent(ctx context.Context,
 	event *address.Event, status address.Status,
-	anchorPoint wire.OutPoint) error {
+	anchorPoint wire.OutPoint, receivedAmt uint64) error {
 
 	scriptKeyBytes := event.Addr.ScriptKey.SerializeCompressed()
 
@@ -905,6 +1016,7 @@ func (t *TapAddressBook) CompleteEvent(ctx context.Context,
 			ChainTxnOutputIndex: int32(anchorPoint.Index),
 			AssetProofID:        sqlInt64(proofData.ProofID),
 			AssetID:             sqlInt64(proofData.AssetID),
+			ReceivedAmount:      sqlInt64(receivedAmt),
 		})
 		return err
 	})
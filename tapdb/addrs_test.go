@@ -14,6 +14,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
 	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/stretchr/testify/require"
 )
@@ -469,6 +470,80 @@ func TestAddrEventCreation(t *testing.T) {
 	}
 }
 
+// TestAddrEventReceivedAmount tests that the asset amount received by an
+// address event is stored and isn't reset by later status updates.
+func TestAddrEventReceivedAmount(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	addrBook, _ := newAddrBook(t, testClock)
+
+	ctx := context.Background()
+
+	proofCourierAddr := address.RandProofCourierAddr(t)
+	addr, assetGen, assetGroup := address.RandAddr(
+		t, chainParams, proofCourierAddr,
+	)
+
+	var writeTxOpts AddrBookTxOptions
+	err := addrBook.db.ExecTx(
+		ctx, &writeTxOpts, insertFullAssetGen(ctx, assetGen, assetGroup),
+	)
+	require.NoError(t, err)
+
+	err = addrBook.InsertAddrs(ctx, *addr)
+	require.NoError(t, err)
+
+	txn := randWalletTx()
+	confirmTx(txn)
+	event, err := addrBook.GetOrCreateEvent(
+		ctx, address.StatusTransactionConfirmed, addr, txn, 0,
+	)
+	require.NoError(t, err)
+	require.Zero(t, event.ReceivedAmount)
+	require.Equal(t, address.PaymentStatusUnknown, event.PaymentStatus())
+
+	// We store the received amount the same way the event is completed,
+	// but without linking it to a proof.
+	receivedAmt := addr.Amount
+	err = addrBook.db.ExecTx(ctx, &writeTxOpts, func(db AddrBook) error {
+		_, err := db.UpsertAddrEvent(ctx, UpsertAddrEvent{
+			TaprootOutputKey: schnorr.SerializePubKey(
+				&addr.TaprootOutputKey,
+			),
+			Status:              int16(address.StatusProofReceived),
+			Txid:                event.Outpoint.Hash[:],
+			ChainTxnOutputIndex: int32(event.Outpoint.Index),
+			ReceivedAmount:      sqlInt64(receivedAmt),
+		})
+		return err
+	})
+	require.NoError(t, err)
+
+	assertReceivedAmount := func(status address.Status) {
+		events, err := addrBook.QueryAddrEvents(
+			ctx, address.EventQueryParams{},
+		)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		require.Equal(t, status, events[0].Status)
+		require.Equal(t, receivedAmt, events[0].ReceivedAmount)
+		require.Equal(
+			t, address.PaymentStatusExact,
+			events[0].PaymentStatus(),
+		)
+	}
+	assertReceivedAmount(address.StatusProofReceived)
+
+	// Updating the status of the event doesn't reset the amount.
+	_, err = addrBook.GetOrCreateEvent(
+		ctx, address.StatusCompleted, addr, txn, 0,
+	)
+	require.NoError(t, err)
+	assertReceivedAmount(address.StatusCompleted)
+}
+
 // TestAddressEventQuery tests that we're able to properly retrieve rows based
 // on various combinations of the query parameters.
 func TestAddressEventQuery(t *testing.T) {
@@ -601,3 +676,166 @@ func TestAddressEventQuery(t *testing.T) {
 		})
 	}
 }
+
+// TestAddrRevocation tests that addresses can be revoked, and that an address
+// that already received a payment can't be revoked.
+func TestAddrRevocation(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	addrBook, _ := newAddrBook(t, testClock)
+	ctx := context.Background()
+
+	book := address.NewBook(address.BookConfig{
+		Store: addrBook,
+	})
+
+	var writeTxOpts AddrBookTxOptions
+
+	const numAddrs = 3
+	proofCourierAddr := address.RandProofCourierAddr(t)
+	addrs := make([]address.AddrWithKeyInfo, numAddrs)
+	for i := 0; i < numAddrs; i++ {
+		addr, assetGen, assetGroup := address.RandAddr(
+			t, chainParams, proofCourierAddr,
+		)
+
+		err := addrBook.db.ExecTx(
+			ctx, &writeTxOpts,
+			insertFullAssetGen(ctx, assetGen, assetGroup),
+		)
+		require.NoError(t, err)
+
+		addrs[i] = *addr
+	}
+	require.NoError(t, addrBook.InsertAddrs(ctx, addrs...))
+
+	// The last address receives a completed payment, so it can't be
+	// revoked anymore.
+	paidAddr := addrs[2]
+	txn := randWalletTx()
+	_, err := addrBook.GetOrCreateEvent(
+		ctx, address.StatusCompleted, &paidAddr, txn, 0,
+	)
+	require.NoError(t, err)
+
+	_, err = book.RevokeAddr(ctx, &paidAddr.TaprootOutputKey)
+	require.ErrorIs(t, err, address.ErrAddrAlreadyPaid)
+
+	// Revoking an unpaid address should mark it as revoked.
+	revokedAddr, err := book.RevokeAddr(ctx, &addrs[0].TaprootOutputKey)
+	require.NoError(t, err)
+	require.True(t, revokedAddr.IsRevoked())
+
+	dbAddr, err := addrBook.AddrByTaprootOutput(
+		ctx, &addrs[0].TaprootOutputKey,
+	)
+	require.NoError(t, err)
+	require.True(t, dbAddr.IsRevoked())
+	require.Equal(t, revokedAddr.RevokedAt.Unix(), dbAddr.RevokedAt.Unix())
+
+	// Revoking it again keeps the time of the first revocation.
+	revokedAgain, err := book.RevokeAddr(ctx, &addrs[0].TaprootOutputKey)
+	require.NoError(t, err)
+	require.Equal(
+		t, revokedAddr.RevokedAt.Unix(), revokedAgain.RevokedAt.Unix(),
+	)
+
+	// The revocation should also be reflected when querying all addresses.
+	dbAddrs, err := addrBook.QueryAddrs(ctx, address.QueryParams{})
+	require.NoError(t, err)
+	require.Len(t, dbAddrs, numAddrs)
+	for _, addr := range dbAddrs {
+		isRevoked := addr.TaprootOutputKey.IsEqual(
+			&addrs[0].TaprootOutputKey,
+		)
+		require.Equal(t, isRevoked, addr.IsRevoked())
+	}
+
+	// Only the script key of the revoked address should be reported as
+	// revoked.
+	for i, addr := range addrs {
+		revoked, err := book.IsScriptKeyRevoked(ctx, &addr.ScriptKey)
+		require.NoError(t, err)
+		require.Equal(t, i == 0, revoked)
+	}
+
+	// Revoking an unknown address should fail.
+	unknownAddr, _, _ := address.RandAddr(t, chainParams, proofCourierAddr)
+	_, err = book.RevokeAddr(ctx, &unknownAddr.TaprootOutputKey)
+	require.ErrorIs(t, err, address.ErrNoAddr)
+}
+
+// TestQueryInternalKeys tests that the internal keys stored in the database
+// can be listed, optionally filtered by their key family, and that the keys of
+// other parties are skipped by the address book.
+func TestQueryInternalKeys(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	addrBook, _ := newAddrBook(t, testClock)
+	ctx := context.Background()
+
+	book := address.NewBook(address.BookConfig{
+		Store: addrBook,
+	})
+
+	newKey := func(family, index uint32) keychain.KeyDescriptor {
+		keyDesc, _ := randKeyDesc(t)
+		keyDesc.Family = keychain.KeyFamily(family)
+		keyDesc.Index = index
+
+		return keyDesc
+	}
+
+	// We insert the keys out of order, to make sure they're sorted by
+	// their family and index. The last key has an empty key locator, like
+	// the keys of other parties.
+	var (
+		key5Idx2   = newKey(5, 2)
+		key7Idx0   = newKey(7, 0)
+		key5Idx1   = newKey(5, 1)
+		remoteKey  = newKey(0, 0)
+		familyFive = keychain.KeyFamily(5)
+	)
+	for _, key := range []keychain.KeyDescriptor{
+		key5Idx2, key7Idx0, key5Idx1, remoteKey,
+	} {
+		require.NoError(t, addrBook.InsertInternalKey(ctx, key))
+	}
+
+	assertKeys := func(expected, actual []keychain.KeyDescriptor) {
+		require.Len(t, actual, len(expected))
+		for idx := range expected {
+			require.Equal(
+				t, expected[idx].KeyLocator,
+				actual[idx].KeyLocator,
+			)
+			require.True(
+				t, expected[idx].PubKey.IsEqual(
+					actual[idx].PubKey,
+				),
+			)
+		}
+	}
+
+	dbKeys, err := addrBook.QueryInternalKeys(ctx, nil)
+	require.NoError(t, err)
+	assertKeys(
+		[]keychain.KeyDescriptor{
+			remoteKey, key5Idx1, key5Idx2, key7Idx0,
+		}, dbKeys,
+	)
+
+	dbKeys, err = addrBook.QueryInternalKeys(ctx, &familyFive)
+	require.NoError(t, err)
+	assertKeys([]keychain.KeyDescriptor{key5Idx1, key5Idx2}, dbKeys)
+
+	// The address book only lists the keys derived by the wallet.
+	localKeys, err := book.ListInternalKeys(ctx, nil)
+	require.NoError(t, err)
+	assertKeys(
+		[]keychain.KeyDescriptor{key5Idx1, key5Idx2, key7Idx0},
+		localKeys,
+	)
+}
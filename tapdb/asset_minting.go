@@ -35,6 +35,15 @@ type (
 	// on the batch key of the batch.
 	AssetSeedlingItem = sqlc.InsertAssetSeedlingIntoBatchParams
 
+	// BatchAssetLabels wraps the params needed to carry the labels of the
+	// seedlings of a batch over to its assets.
+	BatchAssetLabels = sqlc.InsertBatchAssetLabelsParams
+
+	// BatchAssetProofCourierAddrs wraps the params needed to carry the
+	// proof courier addresses of the seedlings of a batch over to its
+	// assets.
+	BatchAssetProofCourierAddrs = sqlc.InsertBatchAssetProofCourierAddrsParams
+
 	// MintingBatchI is an alias for a minting batch including the internal
 	// key info. This is used to query for batches where the state doesn't
 	// match a certain value.
@@ -92,6 +101,10 @@ type (
 	// ChainTxConf is used to mark a chain tx as being confirmed.
 	ChainTxConf = sqlc.ConfirmChainTxParams
 
+	// MintingBatchAnchor is an alias for the anchor transaction of a
+	// minting batch, along with its chain location if it's confirmed.
+	MintingBatchAnchor = sqlc.FetchMintingBatchAnchorRow
+
 	// GenesisAsset is used to insert the base information of an asset into
 	// the DB.
 	GenesisAsset = sqlc.UpsertGenesisAssetParams
@@ -116,6 +129,17 @@ type (
 	// NewAssetMeta wraps the params needed to insert a new asset meta on
 	// disk.
 	NewAssetMeta = sqlc.UpsertAssetMetaParams
+
+	// NewEmissionEvent wraps the params needed to insert a new emission
+	// event of a seedling on disk.
+	NewEmissionEvent = sqlc.InsertEmissionEventParams
+
+	// SeedlingEmissionEvent is an emission event of a seedling.
+	SeedlingEmissionEvent = sqlc.FetchSeedlingEmissionEventsRow
+
+	// PendingEmissionEvent is an emission event that wasn't issued yet,
+	// including the information needed to issue it.
+	PendingEmissionEvent = sqlc.QueryPendingEmissionEventsRow
 )
 
 // PendingAssetStore is a sub-set of the main sqlc.Querier interface that
@@ -186,6 +210,10 @@ type PendingAssetStore interface {
 	UpsertManagedUTXO(ctx context.Context, arg RawManagedUTXO) (int64,
 		error)
 
+	// DeleteManagedUTXO deletes the managed utxo identified by the passed
+	// serialized outpoint.
+	DeleteManagedUTXO(ctx context.Context, outpoint []byte) error
+
 	// AnchorPendingAssets associated an asset on disk with the transaction
 	// that once confirmed will mint the asset.
 	AnchorPendingAssets(ctx context.Context, arg AssetAnchor) error
@@ -197,6 +225,11 @@ type PendingAssetStore interface {
 	// ConfirmChainTx confirms an existing chain tx.
 	ConfirmChainTx(ctx context.Context, arg ChainTxConf) error
 
+	// FetchMintingBatchAnchor fetches the anchor transaction of the batch
+	// with the given key, along with its chain location if it's confirmed.
+	FetchMintingBatchAnchor(ctx context.Context,
+		rawKey []byte) (MintingBatchAnchor, error)
+
 	// FetchAssetsForBatch fetches all the assets created by a particular
 	// batch.
 	FetchAssetsForBatch(ctx context.Context, rawKey []byte) ([]AssetSprout,
@@ -212,6 +245,37 @@ type PendingAssetStore interface {
 	// FetchAssetMetaForAsset fetches the asset meta for a given asset.
 	FetchAssetMetaForAsset(ctx context.Context,
 		assetID []byte) (sqlc.FetchAssetMetaForAssetRow, error)
+
+	// InsertEmissionEvent inserts a new emission event of a seedling.
+	InsertEmissionEvent(ctx context.Context, arg NewEmissionEvent) error
+
+	// FetchSeedlingEmissionEvents fetches the emission schedule of a
+	// seedling.
+	FetchSeedlingEmissionEvents(ctx context.Context,
+		seedlingID int64) ([]SeedlingEmissionEvent, error)
+
+	// QueryPendingEmissionEvents fetches all emission events that weren't
+	// issued yet, optionally filtered by the group key of the asset.
+	QueryPendingEmissionEvents(ctx context.Context,
+		groupKey []byte) ([]PendingEmissionEvent, error)
+
+	// MarkEmissionEventIssued marks an emission event as issued.
+	MarkEmissionEventIssued(ctx context.Context, eventID int64) error
+
+	// InsertBatchAssetLabels carries the labels of the seedlings of a batch
+	// over to the assets that were created from them.
+	InsertBatchAssetLabels(ctx context.Context,
+		arg BatchAssetLabels) error
+
+	// FetchAssetLabel fetches the label of the asset with the given asset
+	// ID.
+	FetchAssetLabel(ctx context.Context, assetID []byte) (string, error)
+
+	// InsertBatchAssetProofCourierAddrs carries the proof courier
+	// addresses of the seedlings of a batch over to the assets that were
+	// created from them.
+	InsertBatchAssetProofCourierAddrs(ctx context.Context,
+		arg BatchAssetProofCourierAddrs) error
 }
 
 // AssetStoreTxOptions defines the set of db txn options the PendingAssetStore
@@ -290,6 +354,7 @@ func (a *AssetMintingStore) CommitMintingBatch(ctx context.Context,
 			BatchID:          batchID,
 			HeightHint:       int32(newBatch.HeightHint),
 			CreationTimeUnix: newBatch.CreationTime.UTC(),
+			BatchName:        sqlStr(newBatch.Name),
 		}); err != nil {
 			return fmt.Errorf("unable to insert minting "+
 				"batch: %w", err)
@@ -322,6 +387,10 @@ func (a *AssetMintingStore) CommitMintingBatch(ctx context.Context,
 				AssetSupply:     int64(seedling.Amount),
 				AssetMetaID:     assetMetaID,
 				EmissionEnabled: seedling.EnableEmission,
+				Label:           sqlStr(seedling.Label),
+				ProofCourierAddr: sqlStr(
+					seedling.ProofCourierAddr,
+				),
 			}
 
 			// If this seedling is being issued to an existing
@@ -353,10 +422,28 @@ func (a *AssetMintingStore) CommitMintingBatch(ctx context.Context,
 				dbSeedling.GroupAnchorID = sqlInt64(anchorID)
 			}
 
+			// Any custom keys of the seedling need to be inserted
+			// first, so we can reference them.
+			scriptKeyID, groupKeyID, err := upsertSeedlingKeys(
+				ctx, q, seedling,
+			)
+			if err != nil {
+				return err
+			}
+			dbSeedling.ScriptKeyID = scriptKeyID
+			dbSeedling.GroupInternalKeyID = groupKeyID
+
 			err = q.InsertAssetSeedling(ctx, dbSeedling)
 			if err != nil {
 				return err
 			}
+
+			err = insertEmissionSchedule(
+				ctx, q, rawBatchKey, seedling,
+			)
+			if err != nil {
+				return err
+			}
 		}
 
 		return nil
@@ -396,6 +483,10 @@ func (a *AssetMintingStore) AddSeedlingsToBatch(ctx context.Context,
 				AssetSupply:     int64(seedling.Amount),
 				AssetMetaID:     assetMetaID,
 				EmissionEnabled: seedling.EnableEmission,
+				Label:           sqlStr(seedling.Label),
+				ProofCourierAddr: sqlStr(
+					seedling.ProofCourierAddr,
+				),
 			}
 
 			// If this seedling is being issued to an existing
@@ -427,11 +518,29 @@ func (a *AssetMintingStore) AddSeedlingsToBatch(ctx context.Context,
 				dbSeedling.GroupAnchorID = sqlInt64(anchorID)
 			}
 
+			// Any custom keys of the seedling need to be inserted
+			// first, so we can reference them.
+			scriptKeyID, groupKeyID, err := upsertSeedlingKeys(
+				ctx, q, seedling,
+			)
+			if err != nil {
+				return err
+			}
+			dbSeedling.ScriptKeyID = scriptKeyID
+			dbSeedling.GroupInternalKeyID = groupKeyID
+
 			err = q.InsertAssetSeedlingIntoBatch(ctx, dbSeedling)
 			if err != nil {
 				return fmt.Errorf("unable to insert "+
 					"seedling into db: %v", err)
 			}
+
+			err = insertEmissionSchedule(
+				ctx, q, rawBatchKey, seedling,
+			)
+			if err != nil {
+				return err
+			}
 		}
 
 		return nil
@@ -451,6 +560,112 @@ func fetchSeedlingID(ctx context.Context, q PendingAssetStore,
 	return q.FetchSeedlingID(ctx, seedlingParams)
 }
 
+// parseSeedlingScriptKey parses the custom script key of a seedling.
+func parseSeedlingScriptKey(
+	dbSeedling sqlc.FetchSeedlingsForBatchRow) (*asset.ScriptKey, error) {
+
+	scriptKeyPub, err := btcec.ParsePubKey(dbSeedling.TweakedScriptKey)
+	if err != nil {
+		return nil, err
+	}
+	rawScriptKey, err := parseSeedlingKeyDesc(
+		dbSeedling.ScriptKeyRaw, dbSeedling.ScriptKeyFam,
+		dbSeedling.ScriptKeyIndex,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &asset.ScriptKey{
+		PubKey: scriptKeyPub,
+		TweakedScriptKey: &asset.TweakedScriptKey{
+			RawKey: rawScriptKey,
+			Tweak:  dbSeedling.ScriptKeyTweak,
+		},
+	}, nil
+}
+
+// parseSeedlingKeyDesc parses a key descriptor of a custom seedling key.
+func parseSeedlingKeyDesc(rawKey []byte, family,
+	index sql.NullInt32) (keychain.KeyDescriptor, error) {
+
+	pubKey, err := btcec.ParsePubKey(rawKey)
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+
+	return keychain.KeyDescriptor{
+		PubKey: pubKey,
+		KeyLocator: keychain.KeyLocator{
+			Family: extractSqlInt32[keychain.KeyFamily](family),
+			Index:  extractSqlInt32[uint32](index),
+		},
+	}, nil
+}
+
+// upsertSeedlingKeys inserts the custom script key and group internal key of
+// the given seedling, if any, and returns their primary keys.
+func upsertSeedlingKeys(ctx context.Context, q PendingAssetStore,
+	seedling *tapgarden.Seedling) (sql.NullInt64, sql.NullInt64, error) {
+
+	var scriptKeyID, groupKeyID sql.NullInt64
+	if seedling.ScriptKey != nil {
+		id, err := upsertScriptKey(ctx, *seedling.ScriptKey, q)
+		if err != nil {
+			return scriptKeyID, groupKeyID, err
+		}
+		scriptKeyID = sqlInt64(id)
+	}
+
+	if seedling.GroupInternalKey != nil {
+		groupKey := seedling.GroupInternalKey
+		id, err := q.UpsertInternalKey(ctx, InternalKey{
+			RawKey:    groupKey.PubKey.SerializeCompressed(),
+			KeyFamily: int32(groupKey.Family),
+			KeyIndex:  int32(groupKey.Index),
+		})
+		if err != nil {
+			return scriptKeyID, groupKeyID, fmt.Errorf("unable to "+
+				"insert group internal key: %w", err)
+		}
+		groupKeyID = sqlInt64(id)
+	}
+
+	return scriptKeyID, groupKeyID, nil
+}
+
+// insertEmissionSchedule inserts the emission schedule of a seedling that was
+// already inserted into the given batch. This is performed within the context
+// of a greater DB transaction.
+func insertEmissionSchedule(ctx context.Context, q PendingAssetStore,
+	rawBatchKey []byte, seedling *tapgarden.Seedling) error {
+
+	if len(seedling.EmissionSchedule) == 0 {
+		return nil
+	}
+
+	seedlingID, err := fetchSeedlingID(
+		ctx, q, rawBatchKey, seedling.AssetName,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to fetch seedling ID: %w", err)
+	}
+
+	for _, event := range seedling.EmissionSchedule {
+		err := q.InsertEmissionEvent(ctx, NewEmissionEvent{
+			SeedlingID:  seedlingID,
+			BlockHeight: int32(event.BlockHeight),
+			Amount:      int64(event.Amount),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert emission event: "+
+				"%w", err)
+		}
+	}
+
+	return nil
+}
+
 // fetchAssetSeedlings attempts to fetch a set of asset seedlings for a given
 // batch. This is performed within the context of a greater DB transaction.
 func fetchAssetSeedlings(ctx context.Context, q PendingAssetStore,
@@ -476,7 +691,9 @@ func fetchAssetSeedlings(ctx context.Context, q PendingAssetStore,
 			Amount: uint64(
 				dbSeedling.AssetSupply,
 			),
-			EnableEmission: dbSeedling.EmissionEnabled,
+			EnableEmission:   dbSeedling.EmissionEnabled,
+			Label:            dbSeedling.Label.String,
+			ProofCourierAddr: dbSeedling.ProofCourierAddr.String,
 		}
 
 		// Fetch the group info for seedlings with a specific group.
@@ -510,6 +727,45 @@ func fetchAssetSeedlings(ctx context.Context, q PendingAssetStore,
 			seedling.GroupAnchor = &seedlingAnchor.AssetName
 		}
 
+		// Restore the custom keys of the seedling, if any were
+		// specified.
+		if len(dbSeedling.TweakedScriptKey) != 0 {
+			seedling.ScriptKey, err = parseSeedlingScriptKey(
+				dbSeedling,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if len(dbSeedling.GroupKeyRaw) != 0 {
+			groupKey, err := parseSeedlingKeyDesc(
+				dbSeedling.GroupKeyRaw, dbSeedling.GroupKeyFam,
+				dbSeedling.GroupKeyIndex,
+			)
+			if err != nil {
+				return nil, err
+			}
+			seedling.GroupInternalKey = &groupKey
+		}
+
+		dbEvents, err := q.FetchSeedlingEmissionEvents(
+			ctx, dbSeedling.SeedlingID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch emission "+
+				"schedule: %w", err)
+		}
+		for _, dbEvent := range dbEvents {
+			event := tapgarden.EmissionEvent{
+				BlockHeight: uint32(dbEvent.BlockHeight),
+				Amount:      uint64(dbEvent.Amount),
+			}
+			seedling.EmissionSchedule = append(
+				seedling.EmissionSchedule, event,
+			)
+		}
+
 		if len(dbSeedling.MetaDataBlob) != 0 {
 			seedling.Meta = &proof.MetaReveal{
 				Data: dbSeedling.MetaDataBlob,
@@ -691,6 +947,30 @@ func fetchAssetMetas(ctx context.Context, db PendingAssetStore,
 	return assetMetas, nil
 }
 
+// fetchAssetLabels attempts to fetch the label for each of the passed assets.
+func fetchAssetLabels(ctx context.Context, db PendingAssetStore,
+	assets []*asset.Asset) (tapgarden.AssetLabels, error) {
+
+	assetLabels := make(tapgarden.AssetLabels)
+	for _, assetT := range assets {
+		assetID := assetT.ID()
+
+		label, err := db.FetchAssetLabel(ctx, assetID[:])
+		switch {
+		// If the asset doesn't have a label, then we can skip it.
+		case errors.Is(err, sql.ErrNoRows):
+			continue
+
+		case err != nil:
+			return nil, err
+		}
+
+		assetLabels[assetID] = label
+	}
+
+	return assetLabels, nil
+}
+
 // FetchNonFinalBatches fetches all the batches that aren't fully finalized on
 // disk.
 func (a *AssetMintingStore) FetchNonFinalBatches(
@@ -836,6 +1116,7 @@ func marshalMintingBatch(ctx context.Context, q PendingAssetStore,
 		},
 		HeightHint:   uint32(dbBatch.HeightHint),
 		CreationTime: dbBatch.CreationTimeUnix.UTC(),
+		Name:         dbBatch.BatchName.String,
 	}
 
 	batchState, err := tapgarden.NewBatchState(uint8(dbBatch.BatchState))
@@ -891,6 +1172,13 @@ func marshalMintingBatch(ctx context.Context, q PendingAssetStore,
 		batch.AssetMetas, err = fetchAssetMetas(
 			ctx, q, assetsInBatch,
 		)
+		if err != nil {
+			return nil, err
+		}
+
+		batch.AssetLabels, err = fetchAssetLabels(
+			ctx, q, assetsInBatch,
+		)
 	}
 	if err != nil {
 		return nil, err
@@ -965,6 +1253,29 @@ func (a *AssetMintingStore) AddSproutsToBatch(ctx context.Context,
 				"genesis: %w", err)
 		}
 
+		// The labels of the seedlings are only known locally, so we
+		// carry them over to the assets that were created from them.
+		err = q.InsertBatchAssetLabels(ctx, BatchAssetLabels{
+			GenesisPointID: genesisPointID,
+			BatchKey:       rawBatchKey,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert asset labels: %w",
+				err)
+		}
+
+		// The same goes for the proof courier addresses.
+		err = q.InsertBatchAssetProofCourierAddrs(
+			ctx, BatchAssetProofCourierAddrs{
+				GenesisPointID: genesisPointID,
+				BatchKey:       rawBatchKey,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to insert asset proof "+
+				"courier addresses: %w", err)
+		}
+
 		// With all the assets inserted, we'll now update the
 		// corresponding batch that references all these assets with
 		// the genesis packet, and genesis point information.
@@ -1004,6 +1315,35 @@ func (a *AssetMintingStore) CommitSignedGenesisTx(ctx context.Context,
 	batchKey *btcec.PublicKey, genesisPkt *tapgarden.FundedPsbt,
 	anchorOutputIndex uint32, merkleRoot []byte) error {
 
+	return a.commitGenesisTx(
+		ctx, batchKey, genesisPkt, anchorOutputIndex, merkleRoot, nil,
+	)
+}
+
+// CommitReplacementGenesisTx replaces the signed genesis transaction of a
+// batch that was already broadcast with a replacement transaction that spends
+// the same inputs, for example to bump its fee. The assets of the batch are
+// anchored to the new anchor output, and the anchor output of the replaced
+// transaction is removed.
+func (a *AssetMintingStore) CommitReplacementGenesisTx(ctx context.Context,
+	batchKey *btcec.PublicKey, genesisPkt *tapgarden.FundedPsbt,
+	anchorOutputIndex uint32, merkleRoot []byte,
+	replacedAnchor wire.OutPoint) error {
+
+	return a.commitGenesisTx(
+		ctx, batchKey, genesisPkt, anchorOutputIndex, merkleRoot,
+		&replacedAnchor,
+	)
+}
+
+// commitGenesisTx binds a fully signed genesis transaction to a batch on disk.
+// If a replaced anchor outpoint is given, then the managed UTXO of that
+// outpoint is removed once all assets were anchored to the new transaction.
+func (a *AssetMintingStore) commitGenesisTx(ctx context.Context,
+	batchKey *btcec.PublicKey, genesisPkt *tapgarden.FundedPsbt,
+	anchorOutputIndex uint32, merkleRoot []byte,
+	replacedAnchor *wire.OutPoint) error {
+
 	// The managed UTXO we'll insert only contains the raw tx of the
 	// genesis packet, so we'll extract that now.
 	//
@@ -1104,6 +1444,22 @@ func (a *AssetMintingStore) CommitSignedGenesisTx(ctx context.Context,
 			return fmt.Errorf("unable to anchor genesis tx: %w", err)
 		}
 
+		// If this transaction replaces a previous genesis transaction,
+		// then no assets are anchored to the old anchor output anymore,
+		// so we'll remove it.
+		if replacedAnchor != nil {
+			oldAnchor, err := encodeOutpoint(*replacedAnchor)
+			if err != nil {
+				return err
+			}
+
+			err = q.DeleteManagedUTXO(ctx, oldAnchor)
+			if err != nil {
+				return fmt.Errorf("unable to delete replaced "+
+					"anchor utxo: %w", err)
+			}
+		}
+
 		// Finally, update the batch state to BatchStateBroadcast.
 		return q.UpdateMintingBatchState(ctx, BatchStateUpdate{
 			RawKey:     rawBatchKey,
@@ -1160,6 +1516,70 @@ func (a *AssetMintingStore) MarkBatchConfirmed(ctx context.Context,
 	})
 }
 
+// FetchBatchAnchor fetches the anchor transaction of the batch with the given
+// key, along with its chain location if it's confirmed. If the batch has no
+// anchor transaction yet, then tapgarden.ErrBatchNotBroadcast is returned.
+func (a *AssetMintingStore) FetchBatchAnchor(ctx context.Context,
+	batchKey *btcec.PublicKey) (*tapgarden.BatchAnchor, error) {
+
+	var (
+		dbAnchor MintingBatchAnchor
+		readOpts = NewAssetStoreReadTx()
+	)
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q PendingAssetStore) error {
+		var err error
+		dbAnchor, err = q.FetchMintingBatchAnchor(
+			ctx, batchKey.SerializeCompressed(),
+		)
+		return err
+	})
+	switch {
+	case errors.Is(dbErr, sql.ErrNoRows):
+		return nil, tapgarden.ErrBatchNotBroadcast
+
+	case dbErr != nil:
+		return nil, fmt.Errorf("unable to fetch batch anchor: %w",
+			dbErr)
+	}
+
+	var anchorTx wire.MsgTx
+	err := anchorTx.Deserialize(bytes.NewReader(dbAnchor.RawTx))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode anchor tx: %w", err)
+	}
+
+	var anchorPoint wire.OutPoint
+	err = readOutPoint(
+		bytes.NewReader(dbAnchor.AnchorOutpoint), 0, 0, &anchorPoint,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode anchor outpoint: %w",
+			err)
+	}
+
+	batchAnchor := &tapgarden.BatchAnchor{
+		AnchorTx:    &anchorTx,
+		OutputIndex: anchorPoint.Index,
+	}
+
+	// The chain location is only known once the anchor transaction is
+	// confirmed.
+	if len(dbAnchor.BlockHash) > 0 {
+		blockHash, err := chainhash.NewHash(dbAnchor.BlockHash)
+		if err != nil {
+			return nil, err
+		}
+
+		batchAnchor.BlockHash = blockHash
+		batchAnchor.BlockHeight = extractSqlInt32[uint32](
+			dbAnchor.BlockHeight,
+		)
+		batchAnchor.TxIndex = extractSqlInt32[uint32](dbAnchor.TxIndex)
+	}
+
+	return batchAnchor, nil
+}
+
 // FetchGroupByGenesis fetches the asset group created by the genesis referenced
 // by the given ID.
 func (a *AssetMintingStore) FetchGroupByGenesis(ctx context.Context,
@@ -1206,6 +1626,89 @@ func (a *AssetMintingStore) FetchGroupByGroupKey(ctx context.Context,
 	return dbGroup, nil
 }
 
+// FetchPendingEmissions fetches all emission events that weren't issued yet.
+// If a group key is specified, only the events of the asset group with that
+// key are returned.
+func (a *AssetMintingStore) FetchPendingEmissions(ctx context.Context,
+	groupKey *btcec.PublicKey) ([]tapgarden.PendingEmission, error) {
+
+	var groupKeyFilter []byte
+	if groupKey != nil {
+		groupKeyFilter = groupKey.SerializeCompressed()
+	}
+
+	var emissions []tapgarden.PendingEmission
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q PendingAssetStore) error {
+		dbEvents, err := q.QueryPendingEmissionEvents(
+			ctx, groupKeyFilter,
+		)
+		if err != nil {
+			return err
+		}
+
+		emissions, err = fn.MapErr(dbEvents, parsePendingEmission)
+
+		return err
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return emissions, nil
+}
+
+// parsePendingEmission converts a pending emission event returned from a query
+// into its tapgarden form.
+func parsePendingEmission(
+	dbEvent PendingEmissionEvent) (tapgarden.PendingEmission, error) {
+
+	batchState, err := tapgarden.NewBatchState(uint8(dbEvent.BatchState))
+	if err != nil {
+		return tapgarden.PendingEmission{}, err
+	}
+
+	emission := tapgarden.PendingEmission{
+		EmissionEvent: tapgarden.EmissionEvent{
+			BlockHeight: uint32(dbEvent.BlockHeight),
+			Amount:      uint64(dbEvent.Amount),
+		},
+		ID:           dbEvent.EventID,
+		AssetName:    dbEvent.AssetName,
+		AssetVersion: asset.Version(dbEvent.AssetVersion),
+		BatchState:   batchState,
+	}
+
+	if len(dbEvent.GroupKey) != 0 {
+		emission.GroupKey, err = btcec.ParsePubKey(dbEvent.GroupKey)
+		if err != nil {
+			return emission, fmt.Errorf("invalid group key: %w",
+				err)
+		}
+	}
+
+	return emission, nil
+}
+
+// MarkEmissionsIssued marks the emission events with the given IDs as issued.
+func (a *AssetMintingStore) MarkEmissionsIssued(ctx context.Context,
+	eventIDs ...int64) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		for _, eventID := range eventIDs {
+			err := q.MarkEmissionEventIssued(ctx, eventID)
+			if err != nil {
+				return fmt.Errorf("unable to mark emission "+
+					"event %d as issued: %w", eventID, err)
+			}
+		}
+
+		return nil
+	})
+}
+
 // A compile-time assertion to ensure that AssetMintingStore meets the
 // tapgarden.MintingStore interface.
 var _ tapgarden.MintingStore = (*AssetMintingStore)(nil)
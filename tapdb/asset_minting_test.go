@@ -10,8 +10,10 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
@@ -520,7 +522,7 @@ func TestAddSproutsToBatch(t *testing.T) {
 
 	ctx := context.Background()
 	const numSeedlings = 5
-	assetStore, _, _ := newAssetStore(t)
+	assetStore, confAssets, _ := newAssetStore(t)
 
 	// First, we'll create a new batch, then add some sample seedlings.
 	// One random seedling will be a reissuance into a specific group.
@@ -537,6 +539,16 @@ func TestAddSproutsToBatch(t *testing.T) {
 		break
 	}
 
+	// We'll also label a random seedling and set a proof courier address
+	// for it, to make sure both are carried over to its asset.
+	var labeledSeedling *tapgarden.Seedling
+	for _, seedling := range mintingBatch.Seedlings {
+		seedling.Label = "labeled seedling"
+		seedling.ProofCourierAddr = "universerpc://courier:10029"
+		labeledSeedling = seedling
+		break
+	}
+
 	// First, we'll create a new batch, then add some sample seedlings.
 	require.NoError(t, assetStore.CommitMintingBatch(ctx, mintingBatch))
 
@@ -585,6 +597,35 @@ func TestAddSproutsToBatch(t *testing.T) {
 
 		return a.Version == seedling.AssetVersion
 	}))
+
+	// Only the asset of the labeled seedling should have a label.
+	assetLabels := mintingBatches[0].AssetLabels
+	require.Len(t, assetLabels, 1)
+	for _, a := range allAssets {
+		if a.Genesis.Tag != labeledSeedling.AssetName {
+			continue
+		}
+
+		require.Equal(t, labeledSeedling.Label, assetLabels[a.ID()])
+	}
+
+	// The same goes for the proof courier address, which is stored for
+	// the asset of the labeled seedling only.
+	for _, a := range allAssets {
+		courierAddr, err := confAssets.FetchAssetProofCourierAddr(
+			ctx, a.ID(),
+		)
+		require.NoError(t, err)
+
+		if a.Genesis.Tag != labeledSeedling.AssetName {
+			require.Empty(t, courierAddr)
+			continue
+		}
+
+		require.Equal(
+			t, labeledSeedling.ProofCourierAddr, courierAddr,
+		)
+	}
 }
 
 type randAssetCtx struct {
@@ -655,6 +696,10 @@ func TestCommitBatchChainActions(t *testing.T) {
 	// TODO(roasbeef): move the tx extraction up one layer?
 	randAssetCtx.genesisPkt.Pkt.Inputs[0].FinalScriptSig = []byte{}
 
+	// As long as the batch isn't broadcast, it has no anchor.
+	_, err := assetStore.FetchBatchAnchor(ctx, randAssetCtx.batchKey)
+	require.ErrorIs(t, err, tapgarden.ErrBatchNotBroadcast)
+
 	// With our assets inserted, we'll now commit the signed genesis packet
 	// to disk, along with the Taproot Asset script root that's stored
 	// alongside any managed UTXOs.
@@ -679,6 +724,15 @@ func TestCommitBatchChainActions(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, rawGenTx.Serialize(&rawTxBytes))
 
+	// The anchor of the batch should now be known, but not confirmed yet.
+	batchAnchor, err := assetStore.FetchBatchAnchor(
+		ctx, randAssetCtx.batchKey,
+	)
+	require.NoError(t, err)
+	require.Equal(t, rawGenTx.TxHash(), batchAnchor.AnchorTx.TxHash())
+	require.EqualValues(t, 2, batchAnchor.OutputIndex)
+	require.False(t, batchAnchor.Confirmed())
+
 	// Next, we'll verify that we're able to query for the chain
 	// transaction we just inserted above.
 	//
@@ -742,6 +796,16 @@ func TestCommitBatchChainActions(t *testing.T) {
 	)
 	require.Equal(t, txIndex, extractSqlInt32[uint32](dbGenTx.TxIndex))
 
+	// The anchor of the batch should now include its chain location.
+	batchAnchor, err = assetStore.FetchBatchAnchor(
+		ctx, randAssetCtx.batchKey,
+	)
+	require.NoError(t, err)
+	require.True(t, batchAnchor.Confirmed())
+	require.Equal(t, fakeBlockHash, *batchAnchor.BlockHash)
+	require.Equal(t, blockHeight, batchAnchor.BlockHeight)
+	require.Equal(t, txIndex, batchAnchor.TxIndex)
+
 	// If we query for the set of all active assets, then we should get
 	// back the same number of seedlings.
 	//
@@ -821,6 +885,7 @@ func TestCommitBatchChainActions(t *testing.T) {
 		require.True(t, ok)
 
 		require.Equal(t, newAsset.Amount, assetBalance.Balance)
+		require.EqualValues(t, 1, assetBalance.NumUtxos)
 	}
 
 	// We'll also now ensure that if we group by key group, then we're
@@ -851,11 +916,15 @@ func TestCommitBatchChainActions(t *testing.T) {
 
 		// One asset was minted into an existing group, so the value
 		// of the group genesis asset must be deducted from the group
-		// balance before comparing to the minted asset.
+		// balance before comparing to the minted asset. The group
+		// genesis asset isn't anchored in a UTXO, so only the UTXO of
+		// the minted asset is counted.
 		if bytes.Equal(groupKey[:], existingGroupKey[:]) {
 			assetBalance.Balance -= randAssetCtx.groupGenAmt
 		}
 
+		require.EqualValues(t, 1, assetBalance.NumUtxos)
+
 		require.Equal(t, newAsset.Amount, assetBalance.Balance)
 	}
 }
@@ -1137,6 +1206,159 @@ func TestGroupAnchors(t *testing.T) {
 	assertAssetsEqual(t, assetRoot, mintingBatches[0].RootAssetCommitment)
 }
 
+// TestEmissionSchedule tests that the emission schedule of a seedling is
+// stored along with the seedling, and that the pending emission events can be
+// queried by the group key of the minted asset once the batch is committed.
+func TestEmissionSchedule(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	assetStore, _, _ := newAssetStore(t)
+
+	// We'll create a batch with a single seedling that creates a new asset
+	// group and has an emission schedule.
+	mintingBatch := tapgarden.RandSeedlingMintingBatch(t, 1)
+	seedling := maps.Values(mintingBatch.Seedlings)[0]
+	seedling.AssetType = asset.Normal
+	seedling.EnableEmission = true
+	seedling.EmissionSchedule = []tapgarden.EmissionEvent{{
+		BlockHeight: 100,
+		Amount:      10,
+	}, {
+		BlockHeight: 200,
+		Amount:      20,
+	}}
+	require.NoError(t, assetStore.CommitMintingBatch(ctx, mintingBatch))
+
+	batchKey := mintingBatch.BatchKey.PubKey
+
+	// The emission schedule is returned as part of the seedling.
+	mintingBatchKeyed, err := assetStore.FetchMintingBatch(ctx, batchKey)
+	require.NoError(t, err)
+	assertBatchEqual(t, mintingBatch, mintingBatchKeyed)
+
+	// As long as the batch isn't committed, the group key of the asset
+	// isn't known yet.
+	emissions, err := assetStore.FetchPendingEmissions(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, emissions, 2)
+	for _, emission := range emissions {
+		require.Nil(t, emission.GroupKey)
+		require.Equal(
+			t, tapgarden.BatchStatePending, emission.BatchState,
+		)
+		require.Equal(t, seedling.AssetName, emission.AssetName)
+	}
+
+	// Once we add the sprouts to the batch, the pending emissions can be
+	// queried by the group key of the minted asset.
+	genesisPacket := randGenesisPacket(t)
+	assetRoot := seedlingsToAssetRoot(
+		t, genesisPacket.Pkt.UnsignedTx.TxIn[0].PreviousOutPoint,
+		mintingBatch.Seedlings, nil,
+	)
+	require.NoError(t, assetStore.AddSproutsToBatch(
+		ctx, batchKey, genesisPacket, assetRoot,
+	))
+
+	mintedAsset := assetRoot.CommittedAssets()[0]
+	groupKey := &mintedAsset.GroupKey.GroupPubKey
+
+	emissions, err = assetStore.FetchPendingEmissions(ctx, groupKey)
+	require.NoError(t, err)
+	require.Len(t, emissions, 2)
+	for idx, emission := range emissions {
+		require.Equal(
+			t, seedling.EmissionSchedule[idx],
+			emission.EmissionEvent,
+		)
+		require.True(t, groupKey.IsEqual(emission.GroupKey))
+		require.Equal(
+			t, tapgarden.BatchStateCommitted, emission.BatchState,
+		)
+		require.Equal(t, seedling.AssetVersion, emission.AssetVersion)
+	}
+
+	// An unknown group key doesn't have any pending emissions.
+	emissions, err = assetStore.FetchPendingEmissions(
+		ctx, test.RandPubKey(t),
+	)
+	require.NoError(t, err)
+	require.Empty(t, emissions)
+
+	// After the first event is marked as issued, only the second one is
+	// still pending.
+	emissions, err = assetStore.FetchPendingEmissions(ctx, groupKey)
+	require.NoError(t, err)
+	require.NoError(t, assetStore.MarkEmissionsIssued(ctx, emissions[0].ID))
+
+	emissions, err = assetStore.FetchPendingEmissions(ctx, groupKey)
+	require.NoError(t, err)
+	require.Len(t, emissions, 1)
+	require.Equal(
+		t, seedling.EmissionSchedule[1], emissions[0].EmissionEvent,
+	)
+}
+
+// TestSeedlingCustomKeys tests that the custom script keys and group internal
+// keys of seedlings are stored and restored correctly.
+func TestSeedlingCustomKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	assetStore, _, _ := newAssetStore(t)
+
+	randScriptKey := func(tweak []byte) *asset.ScriptKey {
+		rawKey, _ := randKeyDesc(t)
+		scriptKey := asset.NewScriptKeyBip86(rawKey)
+		if len(tweak) == 0 {
+			return &scriptKey
+		}
+
+		tweakedKey := txscript.ComputeTaprootOutputKey(
+			rawKey.PubKey, tweak,
+		)
+		scriptKey.PubKey, _ = schnorr.ParsePubKey(
+			schnorr.SerializePubKey(tweakedKey),
+		)
+		scriptKey.Tweak = tweak
+
+		return &scriptKey
+	}
+
+	// We'll create a batch where one seedling uses a custom BIP-0086
+	// script key and a custom group internal key.
+	mintingBatch := tapgarden.RandSeedlingMintingBatch(t, 2)
+	seedlings := maps.Values(mintingBatch.Seedlings)
+
+	groupInternalKey, _ := randKeyDesc(t)
+	seedlings[0].EnableEmission = true
+	seedlings[0].ScriptKey = randScriptKey(nil)
+	seedlings[0].GroupInternalKey = &groupInternalKey
+	require.NoError(t, assetStore.CommitMintingBatch(ctx, mintingBatch))
+
+	batchKey := mintingBatch.BatchKey.PubKey
+
+	// Seedlings added to an existing batch can also use custom keys, in
+	// this case a script key with a tap tweak.
+	newSeedlings := tapgarden.RandSeedlings(t, 1)
+	maps.Values(newSeedlings)[0].ScriptKey = randScriptKey(
+		test.RandBytes(32),
+	)
+	mintingBatch.Seedlings = mergeMap(mintingBatch.Seedlings, newSeedlings)
+	require.NoError(t, assetStore.AddSeedlingsToBatch(
+		ctx, batchKey, maps.Values(newSeedlings)...,
+	))
+
+	// The custom keys are returned as part of the seedlings, and the
+	// seedlings without custom keys are unchanged.
+	mintingBatchKeyed, err := assetStore.FetchMintingBatch(ctx, batchKey)
+	require.NoError(t, err)
+	assertBatchEqual(t, mintingBatch, mintingBatchKeyed)
+	require.Nil(t, mintingBatchKeyed.Seedlings[seedlings[1].AssetName].
+		ScriptKey)
+}
+
 func init() {
 	rand.Seed(time.Now().Unix())
 
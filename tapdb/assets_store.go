@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -292,6 +293,25 @@ type ActiveAssetsStore interface {
 	// FetchAssetMetaForAsset fetches the asset meta for a given asset.
 	FetchAssetMetaForAsset(ctx context.Context,
 		assetID []byte) (sqlc.FetchAssetMetaForAssetRow, error)
+
+	// FetchAssetProofCourierAddr fetches the proof courier address that
+	// was set for the asset with the given ID when it was minted.
+	FetchAssetProofCourierAddr(ctx context.Context,
+		assetID []byte) (string, error)
+
+	// FetchGenesisByAssetID attempts to fetch asset genesis information
+	// for a given asset ID.
+	FetchGenesisByAssetID(ctx context.Context,
+		assetID []byte) (sqlc.GenesisInfoView, error)
+
+	// UpsertAssetLabel inserts or updates the label of the asset with the
+	// given asset ID.
+	UpsertAssetLabel(ctx context.Context,
+		arg sqlc.UpsertAssetLabelParams) error
+
+	// DeleteAssetLabel removes the label of the asset with the given
+	// asset ID.
+	DeleteAssetLabel(ctx context.Context, assetID []byte) error
 }
 
 type InsertRecvProofTxAttemptParams = sqlc.InsertReceiverProofTransferAttemptParams
@@ -302,6 +322,7 @@ type AssetBalance struct {
 	ID           asset.ID
 	Version      int32
 	Balance      uint64
+	NumUtxos     uint64
 	Tag          string
 	MetaHash     [asset.MetaHashLen]byte
 	Type         asset.Type
@@ -314,6 +335,7 @@ type AssetBalance struct {
 type AssetGroupBalance struct {
 	GroupKey *btcec.PublicKey
 	Balance  uint64
+	NumUtxos uint64
 }
 
 // BatchedAssetStore combines the AssetStore interface with the BatchedTx
@@ -397,6 +419,9 @@ type ChainAsset struct {
 	// the time is in the past, then the lease is not valid and the UTXO is
 	// available for coin selection.
 	AnchorLeaseExpiry *time.Time
+
+	// Label is the human-readable label of the asset, if it has one.
+	Label string
 }
 
 // ManagedUTXO holds information about a given UTXO we manage.
@@ -762,6 +787,7 @@ func (a *AssetStore) dbAssetsToChainAssets(dbAssets []ConfirmedAsset,
 			AnchorInternalKey:      anchorInternalKey,
 			AnchorMerkleRoot:       sprout.AnchorMerkleRoot,
 			AnchorTapscriptSibling: sprout.AnchorTapscriptSibling,
+			Label:                  sprout.AssetLabel.String,
 		}
 
 		// We only set the lease info if the lease is actually still
@@ -810,6 +836,11 @@ func (a *AssetStore) constraintsToDbFilter(
 			groupKey := query.GroupKey.SerializeCompressed()
 			assetFilter.KeyGroupFilter = groupKey
 		}
+		if query.Label != "" {
+			assetFilter.LabelPattern = sqlStr(
+				containsPattern(query.Label),
+			)
+		}
 		// TODO(roasbeef): only want to allow asset ID or other and not
 		// both?
 	}
@@ -886,6 +917,17 @@ type AssetQueryFilters struct {
 	// MinAnchorHeight is the minimum block height the asset's anchor tx
 	// must have been confirmed at.
 	MinAnchorHeight int32
+
+	// Label, if set, only selects assets with a label that contains this
+	// string. The label is matched case-insensitively.
+	Label string
+}
+
+// containsPattern returns the SQL LIKE pattern that matches all lower case
+// strings that contain the given string, such as labels or asset names.
+func containsPattern(str string) string {
+	escaper := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+	return "%" + escaper.Replace(strings.ToLower(str)) + "%"
 }
 
 // QueryBalancesByAsset queries the balances for assets or alternatively
@@ -915,6 +957,7 @@ func (a *AssetStore) QueryBalancesByAsset(ctx context.Context,
 			assetIDBalance := AssetBalance{
 				Version:     assetBalance.Version,
 				Balance:     uint64(assetBalance.Balance),
+				NumUtxos:    uint64(assetBalance.NumUtxos),
 				Tag:         assetBalance.AssetTag,
 				Type:        asset.Type(assetBalance.AssetType),
 				OutputIndex: uint32(assetBalance.OutputIndex),
@@ -980,6 +1023,7 @@ func (a *AssetStore) QueryAssetBalancesByGroup(ctx context.Context,
 			balances[serializedKey] = AssetGroupBalance{
 				GroupKey: groupKey,
 				Balance:  uint64(groupBalance.Balance),
+				NumUtxos: uint64(groupBalance.NumUtxos),
 			}
 		}
 
@@ -2417,7 +2461,7 @@ func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
 
 	var (
 		writeTxOpts    AssetStoreTxOptions
-		localProofKeys []asset.SerializedKey
+		localProofKeys []tapfreighter.OutputProofKey
 	)
 	err := a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
 		// First, we'll fetch the asset transfer based on its outpoint
@@ -2440,11 +2484,14 @@ func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
 		}
 
 		// We'll keep around the IDs of the assets that we set to being
-		// spent. We'll need one of them as our template to create the
-		// new assets.
-		spentAssetIDs := make([]int64, len(inputs))
+		// spent, indexed by their asset ID. We'll need one of them as
+		// our template to create the new assets.
+		var (
+			spentAssetIDs = make(map[asset.ID]int64, len(inputs))
+			firstAssetID  asset.ID
+		)
 		for idx := range inputs {
-			spentAssetIDs[idx], err = q.SetAssetSpent(
+			spentAssetID, err := q.SetAssetSpent(
 				ctx, SetAssetSpentParams{
 					ScriptKey:  inputs[idx].ScriptKey,
 					GenAssetID: inputs[idx].AssetID,
@@ -2454,6 +2501,15 @@ func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
 				return fmt.Errorf("unable to set asset spent: "+
 					"%w", err)
 			}
+
+			var assetID asset.ID
+			copy(assetID[:], inputs[idx].AssetID)
+			if idx == 0 {
+				firstAssetID = assetID
+			}
+			if _, ok := spentAssetIDs[assetID]; !ok {
+				spentAssetIDs[assetID] = spentAssetID
+			}
 		}
 
 		// Now is the time to fetch our outputs and create new assets
@@ -2507,16 +2563,24 @@ func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
 				continue
 			}
 
-			// Since we define that a transfer can only move assets
-			// within the same asset ID, we can take any of the
-			// inputs as a template for the new asset, since the
-			// genesis and group key will be the same. We'll
-			// overwrite all other fields.
+			// A transfer can move multiple assets, but each output
+			// only spends inputs of the same asset ID. So we can
+			// take any of the inputs with the output's asset ID as
+			// a template for the new asset, since the genesis and
+			// group key will be the same. We'll overwrite all other
+			// fields.
 			//
 			// TODO(guggero): This will need an update once we want
 			// to support full lock_time and relative_lock_time
 			// support.
-			templateID := spentAssetIDs[0]
+			outputAssetID := witnessAssetID(
+				witnessData, firstAssetID,
+			)
+			templateID, ok := spentAssetIDs[outputAssetID]
+			if !ok {
+				return fmt.Errorf("no input found for output "+
+					"with asset ID %v", outputAssetID)
+			}
 			params := ApplyPendingOutput{
 				ScriptKeyID: out.ScriptKeyID,
 				AnchorUtxoID: sqlInt64(
@@ -2548,20 +2612,25 @@ func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
 					"witnesses: %w", err)
 			}
 
-			var scriptKey asset.SerializedKey
-			copy(scriptKey[:], out.ScriptKeyBytes)
-			receiverProof, ok := conf.FinalProofs[scriptKey]
+			proofKey := tapfreighter.OutputProofKey{
+				AssetID: outputAssetID,
+			}
+			copy(proofKey.ScriptKey[:], out.ScriptKeyBytes)
+			receiverProof, ok := conf.FinalProofs[proofKey]
 			if !ok {
 				return fmt.Errorf("no proof found for output "+
 					"with script key %x",
 					out.ScriptKeyBytes)
 			}
-			localProofKeys = append(localProofKeys, scriptKey)
+			localProofKeys = append(localProofKeys, proofKey)
 
 			// Now we can update the asset proof for the sender for
-			// this given delta.
+			// this given delta. Multiple outputs can share a
+			// script key (e.g. tombstones), so we target the new
+			// asset directly.
 			err = q.UpsertAssetProof(ctx, ProofUpdate{
 				TweakedScriptKey: out.ScriptKeyBytes,
+				AssetID:          sqlInt64(newAssetID),
 				ProofFile:        receiverProof.Blob,
 			})
 			if err != nil {
@@ -2621,6 +2690,32 @@ func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
 	return nil
 }
 
+// witnessAssetID returns the ID of the asset that is spent by the given
+// witnesses of a transfer output. For split outputs, the spent asset is
+// referenced by the split root's witness. If the witnesses don't reference a
+// spent asset, the given default asset ID is returned.
+func witnessAssetID(witnesses []asset.Witness, defaultID asset.ID) asset.ID {
+	if len(witnesses) == 0 {
+		return defaultID
+	}
+
+	witness := witnesses[0]
+	if witness.SplitCommitment != nil {
+		rootAsset := witness.SplitCommitment.RootAsset
+		if len(rootAsset.PrevWitnesses) == 0 {
+			return defaultID
+		}
+
+		witness = rootAsset.PrevWitnesses[0]
+	}
+
+	if witness.PrevID == nil || *witness.PrevID == asset.ZeroPrevID {
+		return defaultID
+	}
+
+	return witness.PrevID.ID
+}
+
 // reAnchorPassiveAssets re-anchors all passive assets that were anchored by
 // the given transfer output.
 func (a *AssetStore) reAnchorPassiveAssets(ctx context.Context,
@@ -2798,6 +2893,68 @@ func (a *AssetStore) QueryParcels(ctx context.Context,
 // database.
 var ErrAssetMetaNotFound = fmt.Errorf("asset meta not found")
 
+// ErrAssetNotFound is returned when an asset with a given asset ID is not found
+// in the database.
+var ErrAssetNotFound = fmt.Errorf("asset not found")
+
+// SetAssetLabel sets the label of all assets with the given asset ID. An empty
+// label removes the current label of the assets.
+func (a *AssetStore) SetAssetLabel(ctx context.Context, assetID asset.ID,
+	label string) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		_, err := q.FetchGenesisByAssetID(ctx, assetID[:])
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return ErrAssetNotFound
+
+		case err != nil:
+			return fmt.Errorf("unable to fetch asset genesis: %w",
+				err)
+		}
+
+		if label == "" {
+			return q.DeleteAssetLabel(ctx, assetID[:])
+		}
+
+		return q.UpsertAssetLabel(ctx, sqlc.UpsertAssetLabelParams{
+			AssetID: assetID[:],
+			Label:   label,
+		})
+	})
+}
+
+// FetchAssetProofCourierAddr fetches the proof courier address that was set
+// for the asset with the given ID when it was minted. An empty string is
+// returned if no proof courier address was set.
+func (a *AssetStore) FetchAssetProofCourierAddr(ctx context.Context,
+	assetID asset.ID) (string, error) {
+
+	var courierAddr string
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		addr, err := q.FetchAssetProofCourierAddr(ctx, assetID[:])
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		courierAddr = addr
+
+		return nil
+	})
+	if dbErr != nil {
+		return "", dbErr
+	}
+
+	return courierAddr, nil
+}
+
 // FetchAssetMetaForAsset attempts to fetch an asset meta based on an asset ID.
 func (a *AssetStore) FetchAssetMetaForAsset(ctx context.Context,
 	assetID asset.ID) (*proof.MetaReveal, error) {
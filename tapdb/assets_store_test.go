@@ -797,6 +797,95 @@ func TestFetchAllAssets(t *testing.T) {
 	}
 }
 
+// TestAssetLabels tests that labels can be set on assets, and that assets can
+// be filtered by their label.
+func TestAssetLabels(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	const numAssets = 3
+	assetGen := newAssetGenerator(t, numAssets, 3)
+	assetGen.genAssets(t, assetsStore, []assetDesc{{
+		assetGen:    assetGen.assetGens[0],
+		anchorPoint: assetGen.anchorPoints[0],
+		amt:         16,
+	}, {
+		assetGen:    assetGen.assetGens[1],
+		anchorPoint: assetGen.anchorPoints[0],
+		amt:         10,
+	}, {
+		assetGen:    assetGen.assetGens[2],
+		anchorPoint: assetGen.anchorPoints[1],
+		amt:         6,
+	}})
+
+	// The asset IDs are derived from the inserted assets, which we tell
+	// apart by their amount.
+	allAssets, err := assetsStore.FetchAllAssets(ctx, false, false, nil)
+	require.NoError(t, err)
+	require.Len(t, allAssets, numAssets)
+
+	assetIDs := make(map[uint64]asset.ID, numAssets)
+	for _, a := range allAssets {
+		assetIDs[a.Amount] = a.ID()
+	}
+	goldID, silverID, otherID := assetIDs[16], assetIDs[10], assetIDs[6]
+
+	require.NoError(t, assetsStore.SetAssetLabel(ctx, goldID, "Gold Bar"))
+	require.NoError(t, assetsStore.SetAssetLabel(
+		ctx, silverID, "silver_bar",
+	))
+
+	// Labels can only be set for known assets.
+	err = assetsStore.SetAssetLabel(ctx, asset.RandID(t), "unknown")
+	require.ErrorIs(t, err, ErrAssetNotFound)
+
+	fetchLabeled := func(label string) map[asset.ID]string {
+		assets, err := assetsStore.FetchAllAssets(
+			ctx, false, false, &AssetQueryFilters{
+				Label: label,
+			},
+		)
+		require.NoError(t, err)
+
+		labels := make(map[asset.ID]string, len(assets))
+		for _, a := range assets {
+			labels[a.ID()] = a.Label
+		}
+
+		return labels
+	}
+
+	// Without a label filter, all assets are returned, with their labels
+	// if they have one.
+	require.Equal(t, map[asset.ID]string{
+		goldID:   "Gold Bar",
+		silverID: "silver_bar",
+		otherID:  "",
+	}, fetchLabeled(""))
+
+	// Labels are matched case-insensitively, and wildcard characters of
+	// the filter are matched literally.
+	require.Equal(t, map[asset.ID]string{
+		goldID:   "Gold Bar",
+		silverID: "silver_bar",
+	}, fetchLabeled("BAR"))
+	require.Equal(t, map[asset.ID]string{
+		silverID: "silver_bar",
+	}, fetchLabeled("_"))
+	require.Empty(t, fetchLabeled("%"))
+
+	// Labels can be updated, and an empty label removes it.
+	require.NoError(t, assetsStore.SetAssetLabel(ctx, goldID, "gold coin"))
+	require.NoError(t, assetsStore.SetAssetLabel(ctx, silverID, ""))
+	require.Equal(t, map[asset.ID]string{
+		goldID: "gold coin",
+	}, fetchLabeled("gold"))
+	require.Empty(t, fetchLabeled("bar"))
+}
+
 // TestUTXOLeases tests that we're able to properly lease UTXOs in the DB,
 // update and then remove them again.
 func TestUTXOLeases(t *testing.T) {
@@ -1318,15 +1407,21 @@ func TestAssetExportLog(t *testing.T) {
 	))
 
 	assetID := inputAsset.ID()
-	proofs := map[asset.SerializedKey]*proof.AnnotatedProof{
-		asset.ToSerialized(newScriptKey.PubKey): {
+	proofs := map[tapfreighter.OutputProofKey]*proof.AnnotatedProof{
+		{
+			AssetID:   assetID,
+			ScriptKey: asset.ToSerialized(newScriptKey.PubKey),
+		}: {
 			Locator: proof.Locator{
 				AssetID:   &assetID,
 				ScriptKey: *newScriptKey.PubKey,
 			},
 			Blob: receiverBlob,
 		},
-		asset.ToSerialized(newScriptKey2.PubKey): {
+		{
+			AssetID:   assetID,
+			ScriptKey: asset.ToSerialized(newScriptKey2.PubKey),
+		}: {
 			Locator: proof.Locator{
 				AssetID:   &assetID,
 				ScriptKey: *newScriptKey2.PubKey,
@@ -0,0 +1,129 @@
+package tapdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/universe"
+)
+
+// burnTreeNS returns the namespace of the burn tree of the asset with the
+// given universe ID. The namespace doesn't depend on the proof type of the ID,
+// as there's only a single burn tree per asset (group).
+func burnTreeNS(id universe.Identifier) string {
+	return fmt.Sprintf("burn-%x", id.Bytes())
+}
+
+// isBurnLeaf returns true if the given leaf of the universe with the given ID
+// burns its asset. Burns are transfers, so only transfer leaves are indexed in
+// the burn tree.
+func isBurnLeaf(id universe.Identifier, leaf *universe.Leaf) bool {
+	return id.ProofType == universe.ProofTypeTransfer &&
+		leaf.Proof != nil && leaf.Proof.Asset.IsBurn()
+}
+
+// upsertBurnLeaf indexes the given burn leaf of the transfer universe with the
+// given ID in the burn tree of the asset. The burn is stored at the same key
+// as in the transfer universe, but the sum of the burn tree leaf is the burned
+// amount.
+//
+// NOTE: This function accepts a db transaction, as it's used when making
+// broader DB updates.
+func upsertBurnLeaf(ctx context.Context, dbTx BaseUniverseStore,
+	id universe.Identifier, key universe.LeafKey,
+	leafNode *mssmt.LeafNode, leaf *universe.Leaf) error {
+
+	burnTree := mssmt.NewCompactedTree(
+		newTreeStoreWrapperTx(dbTx, burnTreeNS(id)),
+	)
+
+	burnNode := mssmt.NewLeafNode(leafNode.Value, leaf.Proof.Asset.Amount)
+	_, err := burnTree.Insert(ctx, key.UniverseKey(), burnNode)
+	if err != nil {
+		return fmt.Errorf("unable to insert burn leaf: %w", err)
+	}
+
+	return nil
+}
+
+// deleteBurnLeaf removes the leaf stored at the given key from the burn tree
+// of the asset with the given universe ID, if there is one.
+//
+// NOTE: This function accepts a db transaction, as it's used when making
+// broader DB updates.
+func deleteBurnLeaf(ctx context.Context, dbTx BaseUniverseStore,
+	id universe.Identifier, key universe.LeafKey) error {
+
+	burnTree := mssmt.NewCompactedTree(
+		newTreeStoreWrapperTx(dbTx, burnTreeNS(id)),
+	)
+
+	smtKey := key.UniverseKey()
+	burnNode, err := burnTree.Get(ctx, smtKey)
+	if err != nil {
+		return err
+	}
+	if burnNode.IsEmpty() {
+		return nil
+	}
+
+	_, err = burnTree.Delete(ctx, smtKey)
+	if err != nil {
+		return fmt.Errorf("unable to delete burn leaf: %w", err)
+	}
+
+	return nil
+}
+
+// deleteBurnTree deletes the entire burn tree of the asset with the given
+// universe ID.
+//
+// NOTE: This function accepts a db transaction, as it's used when making
+// broader DB updates.
+func deleteBurnTree(ctx context.Context, dbTx BaseUniverseStore,
+	id universe.Identifier) error {
+
+	burnTree := mssmt.NewCompactedTree(
+		newTreeStoreWrapperTx(dbTx, burnTreeNS(id)),
+	)
+
+	if err := burnTree.DeleteAllNodes(ctx); err != nil {
+		return fmt.Errorf("failed to delete burn tree nodes: %w", err)
+	}
+
+	if err := burnTree.DeleteRoot(ctx); err != nil {
+		return fmt.Errorf("failed to delete burn tree root: %w", err)
+	}
+
+	return nil
+}
+
+// BurnRoot returns the root of the burn tree of the asset with the given
+// universe ID, the sum of which is the total burned amount. The burn tree
+// indexes all burns within the transfer universe of the asset, so the proof
+// type of the ID is ignored.
+//
+// NOTE: This is part of the universe.MultiverseArchive interface.
+func (b *MultiverseStore) BurnRoot(ctx context.Context,
+	id universe.Identifier) (mssmt.Node, error) {
+
+	var (
+		readTx   = NewBaseMultiverseReadTx()
+		burnRoot mssmt.Node
+	)
+	dbErr := b.db.ExecTx(ctx, &readTx, func(db BaseMultiverseStore) error {
+		burnTree := mssmt.NewCompactedTree(
+			newTreeStoreWrapperTx(db, burnTreeNS(id)),
+		)
+
+		var err error
+		burnRoot, err = burnTree.Root(ctx)
+		return err
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return burnRoot, nil
+}
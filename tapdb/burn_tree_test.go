@@ -0,0 +1,139 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/stretchr/testify/require"
+)
+
+// randTransferLeaf returns a transfer leaf of the asset with the given genesis
+// and group key, along with its leaf key. If burn is true, then the leaf burns
+// the given amount of the asset.
+func randTransferLeaf(t *testing.T, assetGen asset.Genesis,
+	id universe.Identifier, amt uint64, burn bool) (universe.LeafKey,
+	universe.Leaf) {
+
+	leaf := randMintingLeaf(t, assetGen, id.GroupKey)
+	leaf.Amt = amt
+	leaf.Proof.GenesisReveal = nil
+
+	prevID := asset.PrevID{
+		OutPoint:  test.RandOp(t),
+		ID:        assetGen.ID(),
+		ScriptKey: asset.RandSerializedKey(t),
+	}
+	leaf.Proof.Asset.Amount = amt
+	leaf.Proof.Asset.PrevWitnesses = []asset.Witness{{
+		PrevID: &prevID,
+	}}
+	if burn {
+		leaf.Proof.Asset.ScriptKey = asset.NewScriptKey(
+			asset.DeriveBurnKey(prevID),
+		)
+	}
+
+	return universe.LeafKey{
+		OutPoint:  test.RandOp(t),
+		ScriptKey: &leaf.Proof.Asset.ScriptKey,
+	}, leaf
+}
+
+// TestMultiverseBurnTree tests that the burns within the transfer universe of
+// an asset are indexed in its burn tree, the sum of which is the total burned
+// amount.
+func TestMultiverseBurnTree(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := NewTestDB(t)
+
+	multiverseDB := NewTransactionExecutor(db,
+		func(tx *sql.Tx) BaseMultiverseStore {
+			return db.WithTx(tx)
+		},
+	)
+	multiverse := NewMultiverseStore(multiverseDB)
+
+	id := randUniverseID(t, false, withProofType(
+		universe.ProofTypeTransfer,
+	))
+	assetGen := asset.RandGenesis(t, asset.Normal)
+
+	requireBurned := func(burned uint64) {
+		t.Helper()
+
+		burnRoot, err := multiverse.BurnRoot(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, burned, burnRoot.NodeSum())
+	}
+
+	// Without any burns, the burn tree is empty.
+	burnRoot, err := multiverse.BurnRoot(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, mssmt.EmptyTreeRootHash, burnRoot.NodeHash())
+
+	// A regular transfer doesn't change the burn tree.
+	transferKey, transferLeaf := randTransferLeaf(
+		t, assetGen, id, 50, false,
+	)
+	_, err = multiverse.UpsertProofLeaf(
+		ctx, id, transferKey, &transferLeaf, nil,
+	)
+	require.NoError(t, err)
+	requireBurned(0)
+
+	// Each burn increases the sum of the burn tree by the burned amount,
+	// while the transfer universe only counts the number of transfers.
+	firstKey, firstBurn := randTransferLeaf(t, assetGen, id, 10, true)
+	_, err = multiverse.UpsertProofLeaf(ctx, id, firstKey, &firstBurn, nil)
+	require.NoError(t, err)
+	requireBurned(10)
+
+	secondKey, secondBurn := randTransferLeaf(t, assetGen, id, 32, true)
+	uniProof, err := multiverse.UpsertProofLeaf(
+		ctx, id, secondKey, &secondBurn, nil,
+	)
+	require.NoError(t, err)
+	requireBurned(42)
+	require.EqualValues(t, 3, uniProof.UniverseRoot.NodeSum())
+
+	// Inserting the same burn again doesn't change the tree.
+	_, err = multiverse.UpsertProofLeaf(ctx, id, firstKey, &firstBurn, nil)
+	require.NoError(t, err)
+	requireBurned(42)
+
+	// There's only a single burn tree per asset, so the proof type of the
+	// ID doesn't matter.
+	issuanceID := id
+	issuanceID.ProofType = universe.ProofTypeIssuance
+	issuanceBurnRoot, err := multiverse.BurnRoot(ctx, issuanceID)
+	require.NoError(t, err)
+	require.EqualValues(t, 42, issuanceBurnRoot.NodeSum())
+
+	// The burns of one asset don't show up in the burn tree of another.
+	otherRoot, err := multiverse.BurnRoot(ctx, randUniverseID(t, false))
+	require.NoError(t, err)
+	require.Zero(t, otherRoot.NodeSum())
+
+	// Deleting a burn leaf removes it from the burn tree, deleting any
+	// other leaf leaves the burn tree untouched.
+	_, err = multiverse.DeleteProofLeaf(ctx, id, firstKey)
+	require.NoError(t, err)
+	requireBurned(32)
+
+	_, err = multiverse.DeleteProofLeaf(ctx, id, transferKey)
+	require.NoError(t, err)
+	requireBurned(32)
+
+	// Finally, deleting the transfer universe also deletes the burn tree.
+	transferUniverse, _ := newTestUniverseWithDb(db.BaseDB, id)
+	_, err = transferUniverse.DeleteUniverse(ctx)
+	require.NoError(t, err)
+	requireBurned(0)
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"math"
 	prand "math/rand"
+	"sync"
 	"time"
 
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
@@ -286,6 +287,10 @@ type BaseDB struct {
 	*sql.DB
 
 	*sqlc.Queries
+
+	// compactMtx makes sure only a single compaction of the database runs
+	// at a time.
+	compactMtx sync.Mutex
 }
 
 // BeginTx wraps the normal sql specific BeginTx method with the TxOptions
@@ -0,0 +1,155 @@
+package tapdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// universeTables is the set of tables that make up the universe store. These
+// tables see the most churn, as leaves and roots are constantly inserted,
+// replaced and pruned.
+var universeTables = []string{
+	"mssmt_nodes",
+	"mssmt_roots",
+	"universe_roots",
+	"universe_leaves",
+	"universe_events",
+	"universe_push_events",
+	"universe_root_history",
+	"universe_lazy_roots",
+}
+
+// CompactStats holds the result of a database compaction.
+type CompactStats struct {
+	// SizeBefore is the size of the database in bytes before the
+	// compaction.
+	SizeBefore int64
+
+	// SizeAfter is the size of the database in bytes after the compaction.
+	SizeAfter int64
+
+	// Duration is the time the compaction took.
+	Duration time.Duration
+}
+
+// DBCompactor is implemented by the database backends that are able to compact
+// the universe store online.
+type DBCompactor interface {
+	// CompactUniverse reclaims the space of dead rows of the universe
+	// store and rebuilds its indexes, using the compaction mechanism of
+	// the database backend.
+	CompactUniverse(ctx context.Context) (*CompactStats, error)
+}
+
+// compactDB runs the given maintenance statements one after the other, to
+// make sure locks are only held for the duration of a single statement, and
+// reports the size of the database before and after the compaction. Only a
+// single compaction runs at a time.
+func compactDB(ctx context.Context, db *BaseDB, sizeQuery string,
+	stmts []string) (*CompactStats, error) {
+
+	db.compactMtx.Lock()
+	defer db.compactMtx.Unlock()
+
+	dbSize := func() (int64, error) {
+		var size int64
+		err := db.DB.QueryRowContext(ctx, sizeQuery).Scan(&size)
+		if err != nil {
+			return 0, fmt.Errorf("unable to query database size: "+
+				"%w", err)
+		}
+
+		return size, nil
+	}
+
+	start := time.Now()
+
+	sizeBefore, err := dbSize()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stmt := range stmts {
+		log.Debugf("Compacting universe store: %v", stmt)
+
+		if _, err := db.DB.ExecContext(ctx, stmt); err != nil {
+			return nil, fmt.Errorf("unable to execute %q: %w", stmt,
+				err)
+		}
+	}
+
+	sizeAfter, err := dbSize()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &CompactStats{
+		SizeBefore: sizeBefore,
+		SizeAfter:  sizeAfter,
+		Duration:   time.Since(start),
+	}
+
+	log.Infof("Compacted universe store in %v, size before=%d bytes, "+
+		"after=%d bytes", stats.Duration, sizeBefore, sizeAfter)
+
+	return stats, nil
+}
+
+// CompactUniverse reclaims the space of dead rows of the universe store and
+// rebuilds its indexes. The indexes of the universe tables are rebuilt one
+// table at a time, after which the database file is vacuumed.
+//
+// NOTE: VACUUM holds the write lock of the database while the file is rebuilt,
+// so mutating operations are blocked until it completes. Readers aren't
+// blocked, as the database runs in WAL mode.
+//
+// NOTE: This is part of the DBCompactor interface.
+func (s *SqliteStore) CompactUniverse(
+	ctx context.Context) (*CompactStats, error) {
+
+	stmts := make([]string, 0, len(universeTables)+3)
+	for _, table := range universeTables {
+		stmts = append(stmts, fmt.Sprintf("REINDEX %s", table))
+	}
+	stmts = append(
+		stmts, "ANALYZE", "VACUUM", "PRAGMA wal_checkpoint(TRUNCATE)",
+	)
+
+	const sizeQuery = "SELECT page_count * page_size " +
+		"FROM pragma_page_count(), pragma_page_size()"
+
+	return compactDB(ctx, s.BaseDB, sizeQuery, stmts)
+}
+
+// CompactUniverse reclaims the space of dead rows of the universe store and
+// rebuilds its indexes. Each universe table is vacuumed and its indexes are
+// rebuilt concurrently, neither of which blocks reads or writes of the table.
+//
+// NOTE: A plain VACUUM makes the space of dead rows available for reuse, but
+// only returns the space at the end of a table to the operating system. So
+// the size of the database might not shrink significantly.
+//
+// NOTE: This is part of the DBCompactor interface.
+func (s *PostgresStore) CompactUniverse(
+	ctx context.Context) (*CompactStats, error) {
+
+	stmts := make([]string, 0, len(universeTables)*2)
+	for _, table := range universeTables {
+		stmts = append(
+			stmts, fmt.Sprintf("VACUUM (ANALYZE) %s", table),
+			fmt.Sprintf("REINDEX TABLE CONCURRENTLY %s", table),
+		)
+	}
+
+	const sizeQuery = "SELECT pg_database_size(current_database())"
+
+	return compactDB(ctx, s.BaseDB, sizeQuery, stmts)
+}
+
+// A compile-time assertion to make sure both database backends satisfy the
+// DBCompactor interface.
+var (
+	_ DBCompactor = (*SqliteStore)(nil)
+	_ DBCompactor = (*PostgresStore)(nil)
+)
@@ -0,0 +1,71 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCompactUniverse tests that compacting the universe store reports the
+// size of the database and leaves the universe trees intact.
+func TestCompactUniverse(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db := NewTestDB(t)
+
+	id := randUniverseID(t, false)
+	baseUniverse, _ := newTestUniverseWithDb(db.BaseDB, id)
+
+	multiverseDB := NewTransactionExecutor(db,
+		func(tx *sql.Tx) BaseMultiverseStore {
+			return db.WithTx(tx)
+		},
+	)
+	multiverse := NewMultiverseStore(multiverseDB)
+
+	// We'll insert a number of leaves and then delete most of them again,
+	// to leave behind some dead rows.
+	const numLeaves = 10
+	assetGen := asset.RandGenesis(t, asset.Normal)
+	leaves := make([]leafWithKey, numLeaves)
+	for i := range leaves {
+		leaves[i] = leafWithKey{
+			LeafKey: randLeafKey(t),
+			Leaf:    randMintingLeaf(t, assetGen, id.GroupKey),
+		}
+
+		_, err := multiverse.UpsertProofLeaf(
+			ctx, id, leaves[i].LeafKey, &leaves[i].Leaf, nil,
+		)
+		require.NoError(t, err)
+	}
+
+	var rootBefore mssmt.Node
+	for i := 0; i < numLeaves-1; i++ {
+		var err error
+		rootBefore, err = multiverse.DeleteProofLeaf(
+			ctx, id, leaves[i].LeafKey,
+		)
+		require.NoError(t, err)
+	}
+
+	stats, err := db.CompactUniverse(ctx)
+	require.NoError(t, err)
+	require.Positive(t, stats.SizeBefore)
+	require.Positive(t, stats.SizeAfter)
+
+	// The remaining universe tree should be unaffected by the compaction.
+	dbRoot, _, err := baseUniverse.RootNode(ctx)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(rootBefore, dbRoot))
+
+	lastLeaf := leaves[numLeaves-1]
+	_, err = multiverse.FetchProofLeaf(ctx, id, lastLeaf.LeafKey)
+	require.NoError(t, err)
+}
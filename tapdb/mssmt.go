@@ -35,6 +35,10 @@ type (
 
 	// UpdateRoot wraps the args we need to update a root node.
 	UpdateRoot = sqlc.UpsertRootNodeParams
+
+	// PruneLeaf wraps the args we need to prune the value of a compacted
+	// leaf.
+	PruneLeaf = sqlc.PruneCompactedLeafParams
 )
 
 // TreeStore is a sub-set of the main sqlc.Querier interface that contains
@@ -70,6 +74,10 @@ type TreeStore interface {
 	// UpsertRootNode allows us to update the root node in place for a
 	// given namespace.
 	UpsertRootNode(ctx context.Context, arg UpdateRoot) error
+
+	// PruneCompactedLeaf replaces the value of the compacted leaf stored
+	// at the given key with the leaf's hash, and marks the leaf as pruned.
+	PruneCompactedLeaf(ctx context.Context, arg PruneLeaf) (int64, error)
 }
 
 type TreeStoreTxOptions struct {
@@ -192,9 +200,10 @@ func (t *taprootAssetTreeStoreTx) InsertLeaf(leaf *mssmt.LeafNode) error {
 
 	if err := t.dbTx.InsertLeaf(t.ctx, NewLeaf{
 		HashKey:   hashKey[:],
-		Value:     leaf.Value,
+		Value:     storedLeafValue(leaf),
 		Sum:       int64(leaf.NodeSum()),
 		Namespace: t.namespace,
+		Pruned:    leaf.IsPruned(),
 	}); err != nil {
 		return fmt.Errorf("unable to insert leaf: %w", err)
 	}
@@ -202,6 +211,18 @@ func (t *taprootAssetTreeStoreTx) InsertLeaf(leaf *mssmt.LeafNode) error {
 	return nil
 }
 
+// storedLeafValue returns the value that is stored for the given leaf. As the
+// value of a pruned leaf is no longer known, the leaf's hash is stored in its
+// place, which allows the leaf to be restored as part of the tree.
+func storedLeafValue(leaf *mssmt.LeafNode) []byte {
+	if leaf.IsPruned() {
+		leafHash := leaf.NodeHash()
+		return leafHash[:]
+	}
+
+	return leaf.Value
+}
+
 // InsertCompactedLeaf stores a new compacted leaf keyed by its
 // NodeHash (not the insertion key).
 func (t *taprootAssetTreeStoreTx) InsertCompactedLeaf(
@@ -213,9 +234,10 @@ func (t *taprootAssetTreeStoreTx) InsertCompactedLeaf(
 	if err := t.dbTx.InsertCompactedLeaf(t.ctx, NewCompactedLeaf{
 		HashKey:   hashKey[:],
 		Key:       key[:],
-		Value:     leaf.Value,
+		Value:     storedLeafValue(leaf.LeafNode),
 		Sum:       int64(leaf.NodeSum()),
 		Namespace: t.namespace,
+		Pruned:    leaf.IsPruned(),
 	}); err != nil {
 		return fmt.Errorf("unable to insert compacted leaf: %w", err)
 	}
@@ -275,6 +297,27 @@ func newKey(data []byte) ([32]byte, error) {
 	return key, nil
 }
 
+// storedLeafNode restores the leaf node from the given stored node. For a
+// pruned leaf, the stored value is the hash of the leaf.
+func storedLeafNode(row StoredNode) (*mssmt.LeafNode, error) {
+	if row.Pruned {
+		leafHash, err := newKey(row.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pruned leaf hash: %w",
+				err)
+		}
+
+		return mssmt.NewPrunedLeafNode(leafHash, uint64(row.Sum)), nil
+	}
+
+	leaf := mssmt.NewLeafNode(row.Value, uint64(row.Sum))
+
+	// Precompute the node hash key.
+	leaf.NodeHash()
+
+	return leaf, nil
+}
+
 // GetChildren returns the left and right child of the node keyed by the given
 // NodeHash.
 func (t *taprootAssetTreeStoreTx) GetChildren(height int, hashKey mssmt.NodeHash) (
@@ -316,12 +359,10 @@ func (t *taprootAssetTreeStoreTx) GetChildren(height int, hashKey mssmt.NodeHash
 
 		// Since both children are nil, we can assume this is a leaf.
 		if row.LHashKey == nil && row.RHashKey == nil {
-			leaf := mssmt.NewLeafNode(
-				row.Value, uint64(row.Sum),
-			)
-
-			// Precompute the node hash key.
-			leaf.NodeHash()
+			leaf, err := storedLeafNode(row)
+			if err != nil {
+				return nil, nil, err
+			}
 
 			// We store the key for compacted leafs.
 			if row.Key != nil {
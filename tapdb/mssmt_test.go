@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"testing"
 
+	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
 	"github.com/stretchr/testify/require"
@@ -721,3 +722,66 @@ func TestTreeNamespaceIsolation(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+// TestTreeRootConsistency tests that a tree backed by the database computes
+// the same roots as a tree backed by the in-memory store, for the same series
+// of insertions and deletions. As the test database is selected by build tag,
+// this makes sure root recomputation is identical for all database backends.
+func TestTreeRootConsistency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	dbStore, _ := newTaprootAssetTreeStore(t, "root-consistency")
+	dbTree := mssmt.NewCompactedTree(dbStore)
+	memTree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+
+	assertRootsEq := func() {
+		t.Helper()
+
+		dbRoot, err := dbTree.Root(ctx)
+		require.NoError(t, err)
+		memRoot, err := memTree.Root(ctx)
+		require.NoError(t, err)
+
+		require.True(t, mssmt.IsEqualNode(memRoot, dbRoot))
+	}
+
+	// Both trees start out empty.
+	assertRootsEq()
+
+	const numLeaves = 50
+	keys := make([][32]byte, numLeaves)
+	for i := range keys {
+		keys[i] = test.RandHash()
+		leaf := mssmt.NewLeafNode(
+			test.RandBytes(32), mssmt.RandLeafAmount(),
+		)
+
+		_, err := dbTree.Insert(ctx, keys[i], leaf)
+		require.NoError(t, err)
+		_, err = memTree.Insert(ctx, keys[i], leaf)
+		require.NoError(t, err)
+
+		assertRootsEq()
+	}
+
+	// Replacing an existing leaf must result in the same root as well.
+	leaf := mssmt.NewLeafNode(test.RandBytes(32), mssmt.RandLeafAmount())
+	_, err := dbTree.Insert(ctx, keys[0], leaf)
+	require.NoError(t, err)
+	_, err = memTree.Insert(ctx, keys[0], leaf)
+	require.NoError(t, err)
+	assertRootsEq()
+
+	// Finally, we delete every other leaf, which requires the branches to
+	// be recomputed on the way up.
+	for i := 0; i < numLeaves; i += 2 {
+		_, err := dbTree.Delete(ctx, keys[i])
+		require.NoError(t, err)
+		_, err = memTree.Delete(ctx, keys[i])
+		require.NoError(t, err)
+
+		assertRootsEq()
+	}
+}
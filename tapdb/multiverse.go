@@ -3,6 +3,7 @@ package tapdb
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -24,6 +25,14 @@ const (
 
 type (
 	BaseUniverseRoot = sqlc.UniverseRootsRow
+
+	// UniverseRootsQuery is used to query for a subset of the universe
+	// roots.
+	UniverseRootsQuery = sqlc.UniverseRootsParams
+
+	// SearchRootsQuery is used to search for universe roots by the name of
+	// their asset.
+	SearchRootsQuery = sqlc.SearchUniverseRootsParams
 )
 
 // BaseMultiverseStore is used to interact with a set of base universe
@@ -31,7 +40,13 @@ type (
 type BaseMultiverseStore interface {
 	BaseUniverseStore
 
-	UniverseRoots(ctx context.Context) ([]BaseUniverseRoot, error)
+	UniverseRoots(ctx context.Context,
+		q UniverseRootsQuery) ([]BaseUniverseRoot, error)
+
+	// SearchUniverseRoots returns the universe roots of the assets with a
+	// lower case name that matches the given pattern.
+	SearchUniverseRoots(ctx context.Context,
+		q SearchRootsQuery) ([]sqlc.SearchUniverseRootsRow, error)
 }
 
 // BaseMultiverseOptions is the set of options for multiverse queries.
@@ -131,82 +146,76 @@ func (b *MultiverseStore) RootNode(ctx context.Context,
 }
 
 // RootNodes returns the complete set of known base universe root nodes for the
-// set of base universes tracked in the multiverse.
-func (b *MultiverseStore) RootNodes(
-	ctx context.Context) ([]universe.BaseRoot, error) {
+// set of base universes tracked in the multiverse that match the given query.
+func (b *MultiverseStore) RootNodes(ctx context.Context,
+	q universe.RootNodesQuery) ([]universe.BaseRoot, error) {
+
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
 
 	var (
 		uniRoots []universe.BaseRoot
 		readTx   = NewBaseMultiverseReadTx()
 	)
 
+	idRangeStart, idRangeEnd := q.IDRange()
 	dbErr := b.db.ExecTx(ctx, &readTx, func(db BaseMultiverseStore) error {
-		dbRoots, err := db.UniverseRoots(ctx)
+		dbRoots, err := db.UniverseRoots(ctx, UniverseRootsQuery{
+			IDRangeStart: idRangeStart,
+			IDRangeEnd:   idRangeEnd,
+		})
 		if err != nil {
 			return err
 		}
 
 		for _, dbRoot := range dbRoots {
-			var (
-				id            universe.Identifier
-				groupedAssets map[asset.ID]uint64
-			)
-
-			// Parse universe proof type and populate the universe
-			// ID.
-			id.ProofType, err = universe.ParseStrProofType(
-				dbRoot.ProofType,
-			)
+			uniRoot, err := parseUniverseRoot(ctx, db, dbRoot)
 			if err != nil {
 				return err
 			}
 
-			if dbRoot.AssetID != nil {
-				copy(id.AssetID[:], dbRoot.AssetID)
-			}
+			uniRoots = append(uniRoots, uniRoot)
+		}
 
-			if dbRoot.GroupKey != nil {
-				id.GroupKey, err = schnorr.ParsePubKey(
-					dbRoot.GroupKey,
-				)
-				if err != nil {
-					return err
-				}
-
-				groupLeaves, err := db.QueryUniverseLeaves(
-					ctx, UniverseLeafQuery{
-						Namespace: id.String(),
-					},
-				)
-				if err != nil {
-					return err
-				}
-
-				groupedAssets = make(
-					map[asset.ID]uint64, len(groupLeaves),
-				)
-				for _, leaf := range groupLeaves {
-					var id asset.ID
-					copy(id[:], leaf.AssetID)
-					groupedAssets[id] = uint64(leaf.SumAmt)
-				}
-			} else {
-				// For non-grouped assets, there's exactly one
-				// member, the asset itself.
-				groupedAssets = map[asset.ID]uint64{
-					id.AssetID: uint64(dbRoot.RootSum),
-				}
-			}
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return uniRoots, nil
+}
+
+// SearchRoots returns the base universe roots of the assets with a name that
+// contains the name of the given query, sorted by name.
+func (b *MultiverseStore) SearchRoots(ctx context.Context,
+	q universe.AssetSearchQuery) ([]universe.BaseRoot, error) {
+
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	var (
+		uniRoots []universe.BaseRoot
+		readTx   = NewBaseMultiverseReadTx()
+	)
+	dbErr := b.db.ExecTx(ctx, &readTx, func(db BaseMultiverseStore) error {
+		dbRoots, err := db.SearchUniverseRoots(ctx, SearchRootsQuery{
+			NamePattern: containsPattern(q.Name),
+			NumOffset:   int32(q.Offset),
+			NumLimit:    int32(q.Limit),
+		})
+		if err != nil {
+			return err
+		}
 
-			var nodeHash mssmt.NodeHash
-			copy(nodeHash[:], dbRoot.RootHash)
-			uniRoot := universe.BaseRoot{
-				ID: id,
-				Node: mssmt.NewComputedBranch(
-					nodeHash, uint64(dbRoot.RootSum),
-				),
-				AssetName:     dbRoot.AssetName,
-				GroupedAssets: groupedAssets,
+		for _, dbRoot := range dbRoots {
+			uniRoot, err := parseUniverseRoot(
+				ctx, db, BaseUniverseRoot(dbRoot),
+			)
+			if err != nil {
+				return err
 			}
 
 			uniRoots = append(uniRoots, uniRoot)
@@ -221,6 +230,80 @@ func (b *MultiverseStore) RootNodes(
 	return uniRoots, nil
 }
 
+// parseUniverseRoot parses a universe root as read from the database. For
+// grouped assets, the amounts of all assets of the group are looked up as
+// well.
+func parseUniverseRoot(ctx context.Context, db BaseMultiverseStore,
+	dbRoot BaseUniverseRoot) (universe.BaseRoot, error) {
+
+	var (
+		id            universe.Identifier
+		groupedAssets map[asset.ID]uint64
+		err           error
+	)
+
+	// Parse universe proof type and populate the universe ID.
+	id.ProofType, err = universe.ParseStrProofType(dbRoot.ProofType)
+	if err != nil {
+		return universe.BaseRoot{}, err
+	}
+
+	if dbRoot.AssetID != nil {
+		copy(id.AssetID[:], dbRoot.AssetID)
+	}
+
+	if dbRoot.GroupKey != nil {
+		id.GroupKey, err = schnorr.ParsePubKey(dbRoot.GroupKey)
+		if err != nil {
+			return universe.BaseRoot{}, err
+		}
+
+		groupLeaves, err := db.QueryUniverseLeaves(
+			ctx, UniverseLeafQuery{
+				Namespace: id.String(),
+			},
+		)
+		if err != nil {
+			return universe.BaseRoot{}, err
+		}
+
+		groupedAssets = make(map[asset.ID]uint64, len(groupLeaves))
+		for _, leaf := range groupLeaves {
+			var id asset.ID
+			copy(id[:], leaf.AssetID)
+			groupedAssets[id] = uint64(leaf.SumAmt)
+		}
+	} else {
+		// For non-grouped assets, there's exactly one member, the
+		// asset itself.
+		groupedAssets = map[asset.ID]uint64{
+			id.AssetID: uint64(dbRoot.RootSum),
+		}
+	}
+
+	var nodeHash mssmt.NodeHash
+	copy(nodeHash[:], dbRoot.RootHash)
+
+	// Leaves inserted before insertion times were recorded don't have a
+	// timestamp, in which case the last update time is unknown.
+	var lastUpdated time.Time
+	if dbRoot.LastUpdated > 0 {
+		lastUpdated = time.Unix(dbRoot.LastUpdated, 0).UTC()
+	}
+
+	return universe.BaseRoot{
+		ID: id,
+		Node: mssmt.NewComputedBranch(
+			nodeHash, uint64(dbRoot.RootSum),
+		),
+		AssetName:     dbRoot.AssetName,
+		AssetType:     extractSqlInt16[asset.Type](dbRoot.AssetType),
+		GroupedAssets: groupedAssets,
+		NumLeaves:     uint64(dbRoot.NumLeaves),
+		LastUpdated:   lastUpdated,
+	}, nil
+}
+
 // FetchProofLeaf returns a proof leaf for the target key. If the key doesn't
 // have a script key specified, then all the proof leafs for the minting
 // outpoint will be returned. If neither are specified, then all inserted proof
@@ -378,78 +461,351 @@ func (b *MultiverseStore) UpsertProofLeaf(ctx context.Context,
 	return issuanceProof, nil
 }
 
-// RegisterBatchIssuance inserts a new minting leaf batch within the multiverse
-// tree and the universe tree that corresponds to the given base key(s).
-func (b *MultiverseStore) RegisterBatchIssuance(ctx context.Context,
-	items []*universe.IssuanceItem) error {
+// DeleteProofLeaf deletes a proof leaf from the universe tree that corresponds
+// to the given key, and updates the multiverse tree accordingly. The new
+// universe root is returned, which is nil if the universe tree is now empty.
+// If the leaf doesn't exist, then this is a no-op.
+func (b *MultiverseStore) DeleteProofLeaf(ctx context.Context,
+	id universe.Identifier, key universe.LeafKey) (mssmt.Node, error) {
 
-	insertProof := func(item *universe.IssuanceItem,
-		dbTx BaseMultiverseStore) error {
+	var (
+		writeTx      BaseMultiverseOptions
+		universeRoot mssmt.Node
+	)
 
-		// Register issuance in the asset (group) specific universe
-		// tree.
-		_, universeRoot, err := universeUpsertProofLeaf(
-			ctx, dbTx, item.ID, item.Key, item.Leaf,
-			item.MetaReveal,
-		)
-		if err != nil {
-			return err
-		}
+	multiverseNS, err := namespaceForProof(id.ProofType)
+	if err != nil {
+		return nil, err
+	}
 
-		multiverseNS, err := namespaceForProof(item.ID.ProofType)
-		if err != nil {
+	execTxFunc := func(dbTx BaseMultiverseStore) error {
+		var (
+			deleted bool
+			err     error
+		)
+		universeRoot, deleted, err = universeDeleteProofLeaf(
+			ctx, dbTx, id, key,
+		)
+		if err != nil || !deleted {
 			return err
 		}
 
-		// Retrieve a handle to the multiverse tree so that we can
-		// update the tree by inserting a new issuance.
 		multiverseTree := mssmt.NewCompactedTree(
 			newTreeStoreWrapperTx(dbTx, multiverseNS),
 		)
+		leafNodeKey := id.Bytes()
 
-		// Construct a leaf node for insertion into the multiverse tree.
-		// The leaf node includes a reference to the lower tree via the
-		// lower tree root hash.
+		// If the universe tree is now empty, then we'll remove its leaf
+		// from the multiverse tree entirely.
+		if universeRoot == nil {
+			_, err = multiverseTree.Delete(ctx, leafNodeKey)
+			return err
+		}
+
+		// Otherwise, we'll update the multiverse leaf to commit to the
+		// new universe root.
 		universeRootHash := universeRoot.NodeHash()
 		assetGroupSum := universeRoot.NodeSum()
 
-		if item.ID.ProofType == universe.ProofTypeIssuance {
+		if id.ProofType == universe.ProofTypeIssuance {
 			assetGroupSum = 1
 		}
 
 		leafNode := mssmt.NewLeafNode(
 			universeRootHash[:], assetGroupSum,
 		)
+		_, err = multiverseTree.Insert(ctx, leafNodeKey, leafNode)
 
-		// Use asset ID (or asset group hash) as the upper tree leaf
-		// node key. This is the same as the asset specific universe ID.
-		leafNodeKey := item.ID.Bytes()
+		return err
+	}
+	dbErr := b.db.ExecTx(ctx, &writeTx, execTxFunc)
+	if dbErr != nil {
+		return nil, dbErr
+	}
 
-		_, err = multiverseTree.Insert(ctx, leafNodeKey, leafNode)
+	return universeRoot, nil
+}
+
+// multiverseLeafNode returns the multiverse leaf node that commits to the given
+// universe root.
+func multiverseLeafNode(id universe.Identifier,
+	universeRoot mssmt.Node) *mssmt.LeafNode {
+
+	universeRootHash := universeRoot.NodeHash()
+	assetGroupSum := universeRoot.NodeSum()
+
+	if id.ProofType == universe.ProofTypeIssuance {
+		assetGroupSum = 1
+	}
+
+	return mssmt.NewLeafNode(universeRootHash[:], assetGroupSum)
+}
+
+// storedUniverseLeafNode restores the MS-SMT leaf node of the given stored
+// universe leaf. For a pruned leaf, the stored value is the hash of the leaf.
+func storedUniverseLeafNode(leafNode UniverseLeafNode) (*mssmt.LeafNode,
+	error) {
+
+	if leafNode.LeafPruned {
+		leafHash, err := newKey(leafNode.LeafValue)
 		if err != nil {
-			return err
+			return nil, fmt.Errorf("invalid pruned leaf hash: %w",
+				err)
 		}
 
-		return nil
+		return mssmt.NewPrunedLeafNode(
+			leafHash, uint64(leafNode.LeafSum),
+		), nil
 	}
 
-	var writeTx BaseMultiverseOptions
-	dbErr := b.db.ExecTx(
-		ctx, &writeTx, func(store BaseMultiverseStore) error {
-			for idx := range items {
-				item := items[idx]
-				err := insertProof(item, store)
-				if err != nil {
-					return err
-				}
+	return mssmt.NewLeafNode(
+		leafNode.LeafValue, uint64(leafNode.LeafSum),
+	), nil
+}
+
+// VerifyUniverseRoot recomputes the root of the universe tree with the given
+// ID from its stored leaves, and compares it to the persisted root of the tree
+// and the root committed to in the multiverse tree. If repair is true and the
+// roots don't match, then the universe tree and its multiverse leaf are
+// rebuilt from the stored leaves.
+func (b *MultiverseStore) VerifyUniverseRoot(ctx context.Context,
+	id universe.Identifier,
+	repair bool) (*universe.RootVerification, error) {
+
+	multiverseNS, err := namespaceForProof(id.ProofType)
+	if err != nil {
+		return nil, err
+	}
+
+	// We only need a write transaction if we're going to repair the
+	// roots.
+	txOpts := NewBaseMultiverseReadTx()
+	if repair {
+		txOpts = BaseMultiverseOptions{}
+	}
+
+	var verification *universe.RootVerification
+	execTxFunc := func(dbTx BaseMultiverseStore) error {
+		verification = &universe.RootVerification{
+			ID: id,
+		}
+
+		namespace := id.String()
+		leafNodes, err := dbTx.FetchUniverseLeafNodes(ctx, namespace)
+		if err != nil {
+			return fmt.Errorf("unable to fetch universe leaves: %w",
+				err)
+		}
+		verification.NumLeaves = uint64(len(leafNodes))
+
+		// We'll recompute the root by inserting all stored leaves into
+		// a fresh in-memory tree.
+		leaves := make(map[[32]byte]*mssmt.LeafNode, len(leafNodes))
+		computedTree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+		for _, leafNode := range leafNodes {
+			var key [32]byte
+			copy(key[:], leafNode.LeafNodeKey)
+
+			leaves[key], err = storedUniverseLeafNode(leafNode)
+			if err != nil {
+				return err
 			}
+			_, err := computedTree.Insert(ctx, key, leaves[key])
+			if err != nil {
+				return err
+			}
+		}
+		computedRoot, err := computedTree.Root(ctx)
+		if err != nil {
+			return err
+		}
+		if len(leaves) != 0 {
+			verification.ComputedRoot = computedRoot
+		}
+
+		universeTree := mssmt.NewCompactedTree(
+			newTreeStoreWrapperTx(dbTx, namespace),
+		)
+		persistedRoot, err := universeTree.Root(ctx)
+		if err != nil {
+			return err
+		}
+		if persistedRoot.NodeHash() != mssmt.EmptyTree[0].NodeHash() {
+			verification.PersistedRoot = persistedRoot
+		}
+
+		multiverseTree := mssmt.NewCompactedTree(
+			newTreeStoreWrapperTx(dbTx, multiverseNS),
+		)
+		leafNodeKey := id.Bytes()
+		committedLeaf, err := multiverseTree.Get(ctx, leafNodeKey)
+		if err != nil {
+			return err
+		}
+		if !committedLeaf.IsEmpty() {
+			var rootHash mssmt.NodeHash
+			copy(rootHash[:], committedLeaf.Value)
 
+			verification.CommittedRoot = mssmt.NewComputedNode(
+				rootHash, committedLeaf.NodeSum(),
+			)
+		}
+
+		// An empty universe must neither have a persisted root nor a
+		// leaf in the multiverse tree.
+		emptyUniverse := len(leaves) == 0
+		if emptyUniverse {
+			verification.Mismatch = !committedLeaf.IsEmpty() ||
+				verification.PersistedRoot != nil
+		} else {
+			expectedLeaf := multiverseLeafNode(id, computedRoot)
+
+			verification.Mismatch = !mssmt.IsEqualNode(
+				persistedRoot, computedRoot,
+			) || !mssmt.IsEqualNode(committedLeaf, expectedLeaf)
+		}
+
+		if !verification.Mismatch || !repair {
 			return nil
-		},
-	)
+		}
+
+		// To repair the universe, we'll rebuild its tree from scratch,
+		// starting with the removal of all existing nodes.
+		if err := universeTree.DeleteAllNodes(ctx); err != nil {
+			return fmt.Errorf("unable to delete universe MS-SMT "+
+				"nodes: %w", err)
+		}
+		if err := universeTree.DeleteRoot(ctx); err != nil {
+			return fmt.Errorf("unable to delete universe MS-SMT "+
+				"root: %w", err)
+		}
+
+		// If there are no leaves to rebuild the tree from, then we'll
+		// remove the universe entirely, just like we do when the last
+		// leaf of a universe is deleted.
+		if emptyUniverse {
+			err := dbTx.DeleteUniverseLeaves(ctx, namespace)
+			if err != nil {
+				return fmt.Errorf("unable to delete universe "+
+					"leaves: %w", err)
+			}
+
+			err = dbTx.DeleteUniverseEvents(ctx, namespace)
+			if err != nil {
+				return fmt.Errorf("unable to delete universe "+
+					"events: %w", err)
+			}
+
+			err = dbTx.DeleteUniverseRoot(ctx, namespace)
+			if err != nil {
+				return fmt.Errorf("unable to delete universe "+
+					"root: %w", err)
+			}
+
+			_, err = multiverseTree.Delete(ctx, leafNodeKey)
+			if err != nil {
+				return err
+			}
+
+			verification.Repaired = true
+
+			return nil
+		}
+
+		for key, leaf := range leaves {
+			_, err := universeTree.Insert(ctx, key, leaf)
+			if err != nil {
+				return err
+			}
+		}
+
+		rebuiltRoot, err := universeTree.Root(ctx)
+		if err != nil {
+			return err
+		}
+		if !mssmt.IsEqualNode(rebuiltRoot, computedRoot) {
+			return fmt.Errorf("rebuilt universe root %v doesn't "+
+				"match computed root %v",
+				rebuiltRoot.NodeHash(), computedRoot.NodeHash())
+		}
+
+		_, err = multiverseTree.Insert(
+			ctx, leafNodeKey, multiverseLeafNode(id, computedRoot),
+		)
+		if err != nil {
+			return err
+		}
+
+		verification.Repaired = true
+
+		return nil
+	}
+	dbErr := b.db.ExecTx(ctx, &txOpts, execTxFunc)
 	if dbErr != nil {
-		return dbErr
+		return nil, dbErr
 	}
 
-	return nil
+	return verification, nil
+}
+
+// RegisterBatchIssuance inserts a new minting leaf batch within the multiverse
+// tree and the universe tree that corresponds to the given base key(s). The
+// multiverse leaf of each affected universe is only updated once, after all
+// leaves of the batch have been inserted.
+func (b *MultiverseStore) RegisterBatchIssuance(ctx context.Context,
+	items []*universe.IssuanceItem) error {
+
+	execTxFunc := func(dbTx BaseMultiverseStore) error {
+		// We'll insert all the leaves into their asset (group) specific
+		// universe trees first, only keeping track of the latest root
+		// of each of the affected universes.
+		var (
+			universeIDs   []universe.Identifier
+			universeRoots = make(map[string]mssmt.Node)
+		)
+		for _, item := range items {
+			universeRoot, err := universeUpsertLeaf(
+				ctx, dbTx, item.ID, item.Key, item.Leaf,
+				item.MetaReveal,
+			)
+			if err != nil {
+				return err
+			}
+
+			if _, ok := universeRoots[item.ID.String()]; !ok {
+				universeIDs = append(universeIDs, item.ID)
+			}
+			universeRoots[item.ID.String()] = universeRoot
+		}
+
+		// With all the leaves inserted, we'll now update the multiverse
+		// tree once for each of the affected universes. The leaf node
+		// includes a reference to the lower tree via the lower tree
+		// root hash, and is keyed by the asset specific universe ID.
+		for _, id := range universeIDs {
+			multiverseNS, err := namespaceForProof(id.ProofType)
+			if err != nil {
+				return err
+			}
+
+			multiverseTree := mssmt.NewCompactedTree(
+				newTreeStoreWrapperTx(dbTx, multiverseNS),
+			)
+
+			leafNode := multiverseLeafNode(
+				id, universeRoots[id.String()],
+			)
+			_, err = multiverseTree.Insert(
+				ctx, id.Bytes(), leafNode,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	var writeTx BaseMultiverseOptions
+	return b.db.ExecTx(ctx, &writeTx, execTxFunc)
 }
@@ -0,0 +1,185 @@
+package tapdb
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+)
+
+type (
+	// NewMailboxProof is used to insert a new proof file into the proof
+	// courier mailbox.
+	NewMailboxProof = sqlc.UpsertMailboxProofParams
+
+	// MailboxProofQuery is used to query the proof files of a script key
+	// from the proof courier mailbox.
+	MailboxProofQuery = sqlc.QueryMailboxProofsParams
+
+	// MailboxProof is a proof file of the proof courier mailbox returned
+	// from a query.
+	MailboxProof = sqlc.QueryMailboxProofsRow
+)
+
+// ProofMailboxStore is the set of queries needed to manage the proof files
+// stored in the proof courier mailbox.
+type ProofMailboxStore interface {
+	// UpsertMailboxProof inserts a new proof file into the mailbox, or
+	// updates the upload and expiry time of an existing one.
+	UpsertMailboxProof(ctx context.Context, arg NewMailboxProof) error
+
+	// QueryMailboxProofs returns the proof files of a script key that
+	// didn't expire yet.
+	QueryMailboxProofs(ctx context.Context,
+		arg MailboxProofQuery) ([]MailboxProof, error)
+
+	// DeleteExpiredMailboxProofs removes all expired proof files and
+	// returns the number of removed proof files.
+	DeleteExpiredMailboxProofs(ctx context.Context,
+		now time.Time) (int64, error)
+}
+
+// ProofMailboxTxOptions defines the set of db txn options the
+// ProofMailboxStore understands.
+type ProofMailboxTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (p *ProofMailboxTxOptions) ReadOnly() bool {
+	return p.readOnly
+}
+
+// NewProofMailboxReadTx returns a new read tx for the proof mailbox.
+func NewProofMailboxReadTx() ProofMailboxTxOptions {
+	return ProofMailboxTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedProofMailboxStore allows for batched DB transactions for the proof
+// mailbox store.
+type BatchedProofMailboxStore interface {
+	ProofMailboxStore
+
+	BatchedTx[ProofMailboxStore]
+}
+
+// ProofMailboxDB is a database backed implementation of the proof courier
+// mailbox store.
+type ProofMailboxDB struct {
+	db BatchedProofMailboxStore
+}
+
+// NewProofMailboxDB creates a new proof mailbox DB.
+func NewProofMailboxDB(db BatchedProofMailboxStore) *ProofMailboxDB {
+	return &ProofMailboxDB{
+		db: db,
+	}
+}
+
+// StoreMailboxProof stores the given proof file. If the same proof file was
+// already uploaded for the script key, then only its upload and expiry time
+// are updated.
+//
+// NOTE: This is part of the proof.MailboxStore interface.
+func (p *ProofMailboxDB) StoreMailboxProof(ctx context.Context,
+	mailboxProof proof.MailboxProof) error {
+
+	scriptKey := schnorr.SerializePubKey(mailboxProof.ScriptKey)
+	proofHash := sha256.Sum256(mailboxProof.Blob)
+
+	var writeTx ProofMailboxTxOptions
+	return p.db.ExecTx(ctx, &writeTx, func(db ProofMailboxStore) error {
+		return db.UpsertMailboxProof(ctx, NewMailboxProof{
+			ScriptKey:     scriptKey,
+			ProofFileHash: proofHash[:],
+			ProofFile:     mailboxProof.Blob,
+			UploadTime:    mailboxProof.UploadTime.UTC(),
+			ExpiryTime:    mailboxProof.ExpiryTime.UTC(),
+		})
+	})
+}
+
+// FetchMailboxProofs returns all proof files for the given script key that
+// didn't expire before the given time.
+//
+// NOTE: This is part of the proof.MailboxStore interface.
+func (p *ProofMailboxDB) FetchMailboxProofs(ctx context.Context,
+	scriptKey *btcec.PublicKey, now time.Time) ([]proof.MailboxProof,
+	error) {
+
+	var mailboxProofs []proof.MailboxProof
+
+	readTx := NewProofMailboxReadTx()
+	dbErr := p.db.ExecTx(ctx, &readTx, func(db ProofMailboxStore) error {
+		dbProofs, err := db.QueryMailboxProofs(ctx, MailboxProofQuery{
+			ScriptKey: schnorr.SerializePubKey(scriptKey),
+			Now:       now.UTC(),
+		})
+		if err != nil {
+			return err
+		}
+
+		mailboxProofs, err = fn.MapErr(dbProofs, parseMailboxProof)
+
+		return err
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return mailboxProofs, nil
+}
+
+// parseMailboxProof converts a proof file of the mailbox returned from a query
+// into its proof package form.
+func parseMailboxProof(dbProof MailboxProof) (proof.MailboxProof, error) {
+	scriptKey, err := schnorr.ParsePubKey(dbProof.ScriptKey)
+	if err != nil {
+		return proof.MailboxProof{}, fmt.Errorf("invalid script key: "+
+			"%w", err)
+	}
+
+	return proof.MailboxProof{
+		ScriptKey:  scriptKey,
+		Blob:       dbProof.ProofFile,
+		UploadTime: dbProof.UploadTime.UTC(),
+		ExpiryTime: dbProof.ExpiryTime.UTC(),
+	}, nil
+}
+
+// PruneMailboxProofs removes all proof files that expired before the given
+// time and returns the number of removed proof files.
+//
+// NOTE: This is part of the proof.MailboxStore interface.
+func (p *ProofMailboxDB) PruneMailboxProofs(ctx context.Context,
+	now time.Time) (int64, error) {
+
+	var numPruned int64
+
+	var writeTx ProofMailboxTxOptions
+	dbErr := p.db.ExecTx(ctx, &writeTx, func(db ProofMailboxStore) error {
+		var err error
+		numPruned, err = db.DeleteExpiredMailboxProofs(ctx, now.UTC())
+		return err
+	})
+	if dbErr != nil {
+		return 0, dbErr
+	}
+
+	return numPruned, nil
+}
+
+// A compile-time assertion to ensure that ProofMailboxDB meets the
+// proof.MailboxStore interface.
+var _ proof.MailboxStore = (*ProofMailboxDB)(nil)
@@ -0,0 +1,96 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestProofMailboxDB creates a new proof mailbox DB for testing.
+func newTestProofMailboxDB(t *testing.T) *ProofMailboxDB {
+	db := NewTestDB(t)
+
+	dbTxer := NewTransactionExecutor(db,
+		func(tx *sql.Tx) ProofMailboxStore {
+			return db.WithTx(tx)
+		},
+	)
+
+	return NewProofMailboxDB(dbTxer)
+}
+
+// TestProofMailboxDB tests that proof files can be stored in and fetched from
+// the proof mailbox, and that expired proof files are pruned.
+func TestProofMailboxDB(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mailboxDB := newTestProofMailboxDB(t)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	scriptKeyA := test.RandPubKey(t)
+	scriptKeyB := test.RandPubKey(t)
+
+	newProof := func(scriptKey *btcec.PublicKey,
+		ttl time.Duration) proof.MailboxProof {
+
+		return proof.MailboxProof{
+			ScriptKey:  scriptKey,
+			Blob:       test.RandBytes(100),
+			UploadTime: now,
+			ExpiryTime: now.Add(ttl),
+		}
+	}
+
+	proofA1 := newProof(scriptKeyA, time.Hour)
+	proofA2 := newProof(scriptKeyA, 2*time.Hour)
+	proofB := newProof(scriptKeyB, 3*time.Hour)
+	for _, p := range []proof.MailboxProof{proofA1, proofA2, proofB} {
+		require.NoError(t, mailboxDB.StoreMailboxProof(ctx, p))
+	}
+
+	// Only the proofs uploaded for the script key are returned.
+	proofs, err := mailboxDB.FetchMailboxProofs(ctx, scriptKeyA, now)
+	require.NoError(t, err)
+	require.Len(t, proofs, 2)
+	require.Equal(t, proofA1.Blob, proofs[0].Blob)
+	require.Equal(t, proofA2.Blob, proofs[1].Blob)
+	require.Equal(t, proofA1.ExpiryTime, proofs[0].ExpiryTime)
+
+	// Uploading the same proof again extends its lifetime instead of
+	// storing a duplicate.
+	proofA1.ExpiryTime = now.Add(4 * time.Hour)
+	require.NoError(t, mailboxDB.StoreMailboxProof(ctx, proofA1))
+
+	proofs, err = mailboxDB.FetchMailboxProofs(
+		ctx, scriptKeyA, now.Add(90*time.Minute),
+	)
+	require.NoError(t, err)
+	require.Len(t, proofs, 2)
+
+	// Expired proofs are no longer returned, and removed when pruning.
+	pruneTime := now.Add(150 * time.Minute)
+	proofs, err = mailboxDB.FetchMailboxProofs(ctx, scriptKeyA, pruneTime)
+	require.NoError(t, err)
+	require.Len(t, proofs, 1)
+	require.Equal(t, proofA1.Blob, proofs[0].Blob)
+
+	numPruned, err := mailboxDB.PruneMailboxProofs(ctx, pruneTime)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, numPruned)
+
+	proofs, err = mailboxDB.FetchMailboxProofs(ctx, scriptKeyB, pruneTime)
+	require.NoError(t, err)
+	require.Len(t, proofs, 1)
+	require.Equal(
+		t, schnorr.SerializePubKey(scriptKeyB),
+		schnorr.SerializePubKey(proofs[0].ScriptKey),
+	)
+}
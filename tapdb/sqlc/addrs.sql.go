@@ -11,11 +11,27 @@ import (
 	"time"
 )
 
+const countRevokedAddrsByScriptKey = `-- name: CountRevokedAddrsByScriptKey :one
+SELECT COUNT(*)
+FROM addrs
+JOIN script_keys
+  ON addrs.script_key_id = script_keys.script_key_id
+WHERE script_keys.tweaked_script_key = $1
+  AND addrs.revoked_at IS NOT NULL
+`
+
+func (q *Queries) CountRevokedAddrsByScriptKey(ctx context.Context, tweakedScriptKey []byte) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countRevokedAddrsByScriptKey, tweakedScriptKey)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const fetchAddrByTaprootOutputKey = `-- name: FetchAddrByTaprootOutputKey :one
 SELECT
     version, asset_version, genesis_asset_id, group_key, tapscript_sibling,
     taproot_output_key, amount, asset_type, creation_time, managed_from,
-    proof_courier_addr,
+    proof_courier_addr, revoked_at,
     script_keys.tweaked_script_key,
     script_keys.tweak AS script_key_tweak,
     raw_script_keys.raw_key as raw_script_key,
@@ -46,6 +62,7 @@ type FetchAddrByTaprootOutputKeyRow struct {
 	CreationTime     time.Time
 	ManagedFrom      sql.NullTime
 	ProofCourierAddr []byte
+	RevokedAt        sql.NullTime
 	TweakedScriptKey []byte
 	ScriptKeyTweak   []byte
 	RawScriptKey     []byte
@@ -71,6 +88,7 @@ func (q *Queries) FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutput
 		&i.CreationTime,
 		&i.ManagedFrom,
 		&i.ProofCourierAddr,
+		&i.RevokedAt,
 		&i.TweakedScriptKey,
 		&i.ScriptKeyTweak,
 		&i.RawScriptKey,
@@ -85,7 +103,7 @@ func (q *Queries) FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutput
 
 const fetchAddrEvent = `-- name: FetchAddrEvent :one
 SELECT
-    creation_time, status, asset_proof_id, asset_id,
+    creation_time, status, asset_proof_id, asset_id, received_amount,
     chain_txns.txid as txid,
     chain_txns.block_height as confirmation_height,
     chain_txn_output_index as output_index,
@@ -107,6 +125,7 @@ type FetchAddrEventRow struct {
 	Status             int16
 	AssetProofID       sql.NullInt64
 	AssetID            sql.NullInt64
+	ReceivedAmount     sql.NullInt64
 	Txid               []byte
 	ConfirmationHeight sql.NullInt32
 	OutputIndex        int32
@@ -123,6 +142,7 @@ func (q *Queries) FetchAddrEvent(ctx context.Context, id int64) (FetchAddrEventR
 		&i.Status,
 		&i.AssetProofID,
 		&i.AssetID,
+		&i.ReceivedAmount,
 		&i.Txid,
 		&i.ConfirmationHeight,
 		&i.OutputIndex,
@@ -137,7 +157,7 @@ const fetchAddrs = `-- name: FetchAddrs :many
 SELECT 
     version, asset_version, genesis_asset_id, group_key, tapscript_sibling,
     taproot_output_key, amount, asset_type, creation_time, managed_from,
-    proof_courier_addr,
+    proof_courier_addr, revoked_at,
     script_keys.tweaked_script_key,
     script_keys.tweak AS script_key_tweak,
     raw_script_keys.raw_key AS raw_script_key,
@@ -181,6 +201,7 @@ type FetchAddrsRow struct {
 	CreationTime     time.Time
 	ManagedFrom      sql.NullTime
 	ProofCourierAddr []byte
+	RevokedAt        sql.NullTime
 	TweakedScriptKey []byte
 	ScriptKeyTweak   []byte
 	RawScriptKey     []byte
@@ -218,6 +239,7 @@ func (q *Queries) FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]Fetch
 			&i.CreationTime,
 			&i.ManagedFrom,
 			&i.ProofCourierAddr,
+			&i.RevokedAt,
 			&i.TweakedScriptKey,
 			&i.ScriptKeyTweak,
 			&i.RawScriptKey,
@@ -329,6 +351,22 @@ func (q *Queries) QueryEventIDs(ctx context.Context, arg QueryEventIDsParams) ([
 	return items, nil
 }
 
+const revokeAddr = `-- name: RevokeAddr :exec
+UPDATE addrs
+SET revoked_at = $2
+WHERE taproot_output_key = $1
+`
+
+type RevokeAddrParams struct {
+	TaprootOutputKey []byte
+	RevokedAt        sql.NullTime
+}
+
+func (q *Queries) RevokeAddr(ctx context.Context, arg RevokeAddrParams) error {
+	_, err := q.db.ExecContext(ctx, revokeAddr, arg.TaprootOutputKey, arg.RevokedAt)
+	return err
+}
+
 const setAddrManaged = `-- name: SetAddrManaged :exec
 WITH target_addr(addr_id) AS (
     SELECT id
@@ -362,15 +400,16 @@ WITH target_addr(addr_id) AS (
 )
 INSERT INTO addr_events (
     creation_time, addr_id, status, chain_txn_id, chain_txn_output_index,
-    managed_utxo_id, asset_proof_id, asset_id
+    managed_utxo_id, asset_proof_id, asset_id, received_amount
 ) VALUES (
     $3, (SELECT addr_id FROM target_addr), $4,
-    (SELECT txn_id FROM target_chain_txn), $5, $6, $7, $8
+    (SELECT txn_id FROM target_chain_txn), $5, $6, $7, $8, $9
 )
 ON CONFLICT (addr_id, chain_txn_id, chain_txn_output_index)
     DO UPDATE SET status = EXCLUDED.status,
                   asset_proof_id = COALESCE(EXCLUDED.asset_proof_id, addr_events.asset_proof_id),
-                  asset_id = COALESCE(EXCLUDED.asset_id, addr_events.asset_id)
+                  asset_id = COALESCE(EXCLUDED.asset_id, addr_events.asset_id),
+                  received_amount = COALESCE(EXCLUDED.received_amount, addr_events.received_amount)
 RETURNING id
 `
 
@@ -383,6 +422,7 @@ type UpsertAddrEventParams struct {
 	ManagedUtxoID       int64
 	AssetProofID        sql.NullInt64
 	AssetID             sql.NullInt64
+	ReceivedAmount      sql.NullInt64
 }
 
 func (q *Queries) UpsertAddrEvent(ctx context.Context, arg UpsertAddrEventParams) (int64, error) {
@@ -395,6 +435,7 @@ func (q *Queries) UpsertAddrEvent(ctx context.Context, arg UpsertAddrEventParams
 		arg.ManagedUtxoID,
 		arg.AssetProofID,
 		arg.AssetID,
+		arg.ReceivedAmount,
 	)
 	var id int64
 	err := row.Scan(&id)
@@ -87,7 +87,7 @@ func (q *Queries) AllInternalKeys(ctx context.Context) ([]InternalKey, error) {
 }
 
 const allMintingBatches = `-- name: AllMintingBatches :many
-SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, key_id, raw_key, key_family, key_index 
+SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, batch_name, key_id, raw_key, key_family, key_index 
 FROM asset_minting_batches
 JOIN internal_keys 
 ON asset_minting_batches.batch_id = internal_keys.key_id
@@ -101,6 +101,7 @@ type AllMintingBatchesRow struct {
 	GenesisID         sql.NullInt64
 	HeightHint        int32
 	CreationTimeUnix  time.Time
+	BatchName         sql.NullString
 	KeyID             int64
 	RawKey            []byte
 	KeyFamily         int32
@@ -124,6 +125,7 @@ func (q *Queries) AllMintingBatches(ctx context.Context) ([]AllMintingBatchesRow
 			&i.GenesisID,
 			&i.HeightHint,
 			&i.CreationTimeUnix,
+			&i.BatchName,
 			&i.KeyID,
 			&i.RawKey,
 			&i.KeyFamily,
@@ -413,6 +415,20 @@ func (q *Queries) ConfirmChainTx(ctx context.Context, arg ConfirmChainTxParams)
 	return err
 }
 
+const deleteAssetLabel = `-- name: DeleteAssetLabel :exec
+DELETE FROM asset_labels
+WHERE gen_asset_id IN (
+    SELECT gen_asset_id
+    FROM genesis_assets
+    WHERE genesis_assets.asset_id = $1
+)
+`
+
+func (q *Queries) DeleteAssetLabel(ctx context.Context, assetID []byte) error {
+	_, err := q.db.ExecContext(ctx, deleteAssetLabel, assetID)
+	return err
+}
+
 const deleteExpiredUTXOLeases = `-- name: DeleteExpiredUTXOLeases :exec
 UPDATE managed_utxos
 SET lease_owner = NULL, lease_expiry = NULL
@@ -447,6 +463,21 @@ func (q *Queries) DeleteUTXOLease(ctx context.Context, outpoint []byte) error {
 	return err
 }
 
+const fetchAssetLabel = `-- name: FetchAssetLabel :one
+SELECT asset_labels.label
+FROM asset_labels
+JOIN genesis_assets
+    ON asset_labels.gen_asset_id = genesis_assets.gen_asset_id
+WHERE genesis_assets.asset_id = $1
+`
+
+func (q *Queries) FetchAssetLabel(ctx context.Context, assetID []byte) (string, error) {
+	row := q.db.QueryRowContext(ctx, fetchAssetLabel, assetID)
+	var label string
+	err := row.Scan(&label)
+	return label, err
+}
+
 const fetchAssetMeta = `-- name: FetchAssetMeta :one
 SELECT meta_data_hash, meta_data_blob, meta_data_type
 FROM assets_meta
@@ -540,6 +571,21 @@ func (q *Queries) FetchAssetProof(ctx context.Context, tweakedScriptKey []byte)
 	return i, err
 }
 
+const fetchAssetProofCourierAddr = `-- name: FetchAssetProofCourierAddr :one
+SELECT asset_proof_courier_addrs.proof_courier_addr
+FROM asset_proof_courier_addrs
+JOIN genesis_assets
+    ON asset_proof_courier_addrs.gen_asset_id = genesis_assets.gen_asset_id
+WHERE genesis_assets.asset_id = $1
+`
+
+func (q *Queries) FetchAssetProofCourierAddr(ctx context.Context, assetID []byte) (string, error) {
+	row := q.db.QueryRowContext(ctx, fetchAssetProofCourierAddr, assetID)
+	var proof_courier_addr string
+	err := row.Scan(&proof_courier_addr)
+	return proof_courier_addr, err
+}
+
 const fetchAssetProofs = `-- name: FetchAssetProofs :many
 WITH asset_info AS (
     SELECT assets.asset_id, script_keys.tweaked_script_key
@@ -1273,7 +1319,7 @@ WITH target_batch AS (
         ON batches.batch_id = keys.key_id
     WHERE keys.raw_key = $1
 )
-SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, key_id, raw_key, key_family, key_index
+SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, batch_name, key_id, raw_key, key_family, key_index
 FROM asset_minting_batches batches
 JOIN internal_keys keys
     ON batches.batch_id = keys.key_id
@@ -1288,6 +1334,7 @@ type FetchMintingBatchRow struct {
 	GenesisID         sql.NullInt64
 	HeightHint        int32
 	CreationTimeUnix  time.Time
+	BatchName         sql.NullString
 	KeyID             int64
 	RawKey            []byte
 	KeyFamily         int32
@@ -1305,6 +1352,7 @@ func (q *Queries) FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMi
 		&i.GenesisID,
 		&i.HeightHint,
 		&i.CreationTimeUnix,
+		&i.BatchName,
 		&i.KeyID,
 		&i.RawKey,
 		&i.KeyFamily,
@@ -1313,8 +1361,45 @@ func (q *Queries) FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMi
 	return i, err
 }
 
+const fetchMintingBatchAnchor = `-- name: FetchMintingBatchAnchor :one
+SELECT
+    txns.raw_tx, txns.block_height, txns.block_hash, txns.tx_index,
+    utxos.outpoint anchor_outpoint
+FROM asset_minting_batches batches
+JOIN internal_keys keys
+    ON batches.batch_id = keys.key_id
+JOIN genesis_points points
+    ON batches.genesis_id = points.genesis_id
+JOIN chain_txns txns
+    ON points.anchor_tx_id = txns.txn_id
+JOIN managed_utxos utxos
+    ON utxos.txn_id = txns.txn_id
+WHERE keys.raw_key = $1
+`
+
+type FetchMintingBatchAnchorRow struct {
+	RawTx          []byte
+	BlockHeight    sql.NullInt32
+	BlockHash      []byte
+	TxIndex        sql.NullInt32
+	AnchorOutpoint []byte
+}
+
+func (q *Queries) FetchMintingBatchAnchor(ctx context.Context, rawKey []byte) (FetchMintingBatchAnchorRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchMintingBatchAnchor, rawKey)
+	var i FetchMintingBatchAnchorRow
+	err := row.Scan(
+		&i.RawTx,
+		&i.BlockHeight,
+		&i.BlockHash,
+		&i.TxIndex,
+		&i.AnchorOutpoint,
+	)
+	return i, err
+}
+
 const fetchMintingBatchesByInverseState = `-- name: FetchMintingBatchesByInverseState :many
-SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, key_id, raw_key, key_family, key_index
+SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, batch_name, key_id, raw_key, key_family, key_index
 FROM asset_minting_batches batches
 JOIN internal_keys keys
     ON batches.batch_id = keys.key_id
@@ -1329,6 +1414,7 @@ type FetchMintingBatchesByInverseStateRow struct {
 	GenesisID         sql.NullInt64
 	HeightHint        int32
 	CreationTimeUnix  time.Time
+	BatchName         sql.NullString
 	KeyID             int64
 	RawKey            []byte
 	KeyFamily         int32
@@ -1352,6 +1438,7 @@ func (q *Queries) FetchMintingBatchesByInverseState(ctx context.Context, batchSt
 			&i.GenesisID,
 			&i.HeightHint,
 			&i.CreationTimeUnix,
+			&i.BatchName,
 			&i.KeyID,
 			&i.RawKey,
 			&i.KeyFamily,
@@ -1411,7 +1498,7 @@ func (q *Queries) FetchScriptKeyIDByTweakedKey(ctx context.Context, tweakedScrip
 }
 
 const fetchSeedlingByID = `-- name: FetchSeedlingByID :one
-SELECT seedling_id, asset_name, asset_version, asset_type, asset_supply, asset_meta_id, emission_enabled, batch_id, group_genesis_id, group_anchor_id
+SELECT seedling_id, asset_name, asset_version, asset_type, asset_supply, asset_meta_id, emission_enabled, batch_id, group_genesis_id, group_anchor_id, script_key_id, group_internal_key_id, label, proof_courier_addr
 FROM asset_seedlings
 WHERE seedling_id = $1
 `
@@ -1430,6 +1517,10 @@ func (q *Queries) FetchSeedlingByID(ctx context.Context, seedlingID int64) (Asse
 		&i.BatchID,
 		&i.GroupGenesisID,
 		&i.GroupAnchorID,
+		&i.ScriptKeyID,
+		&i.GroupInternalKeyID,
+		&i.Label,
+		&i.ProofCourierAddr,
 	)
 	return i, err
 }
@@ -1475,26 +1566,51 @@ WITH target_batch(batch_id) AS (
 SELECT seedling_id, asset_name, asset_type, asset_version, asset_supply, 
     assets_meta.meta_data_hash, assets_meta.meta_data_type, 
     assets_meta.meta_data_blob, emission_enabled, batch_id, 
-    group_genesis_id, group_anchor_id
+    group_genesis_id, group_anchor_id,
+    script_keys.tweak AS script_key_tweak,
+    script_keys.tweaked_script_key,
+    script_internal_keys.raw_key AS script_key_raw,
+    script_internal_keys.key_family AS script_key_fam,
+    script_internal_keys.key_index AS script_key_index,
+    group_internal_keys.raw_key AS group_key_raw,
+    group_internal_keys.key_family AS group_key_fam,
+    group_internal_keys.key_index AS group_key_index,
+    asset_seedlings.label, asset_seedlings.proof_courier_addr
 FROM asset_seedlings 
 LEFT JOIN assets_meta
     ON asset_seedlings.asset_meta_id = assets_meta.meta_id
+LEFT JOIN script_keys
+    ON asset_seedlings.script_key_id = script_keys.script_key_id
+LEFT JOIN internal_keys script_internal_keys
+    ON script_keys.internal_key_id = script_internal_keys.key_id
+LEFT JOIN internal_keys group_internal_keys
+    ON asset_seedlings.group_internal_key_id = group_internal_keys.key_id
 WHERE asset_seedlings.batch_id in (SELECT batch_id FROM target_batch)
 `
 
 type FetchSeedlingsForBatchRow struct {
-	SeedlingID      int64
-	AssetName       string
-	AssetType       int16
-	AssetVersion    int16
-	AssetSupply     int64
-	MetaDataHash    []byte
-	MetaDataType    sql.NullInt16
-	MetaDataBlob    []byte
-	EmissionEnabled bool
-	BatchID         int64
-	GroupGenesisID  sql.NullInt64
-	GroupAnchorID   sql.NullInt64
+	SeedlingID       int64
+	AssetName        string
+	AssetType        int16
+	AssetVersion     int16
+	AssetSupply      int64
+	MetaDataHash     []byte
+	MetaDataType     sql.NullInt16
+	MetaDataBlob     []byte
+	EmissionEnabled  bool
+	BatchID          int64
+	GroupGenesisID   sql.NullInt64
+	GroupAnchorID    sql.NullInt64
+	ScriptKeyTweak   []byte
+	TweakedScriptKey []byte
+	ScriptKeyRaw     []byte
+	ScriptKeyFam     sql.NullInt32
+	ScriptKeyIndex   sql.NullInt32
+	GroupKeyRaw      []byte
+	GroupKeyFam      sql.NullInt32
+	GroupKeyIndex    sql.NullInt32
+	Label            sql.NullString
+	ProofCourierAddr sql.NullString
 }
 
 func (q *Queries) FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]FetchSeedlingsForBatchRow, error) {
@@ -1519,6 +1635,16 @@ func (q *Queries) FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]
 			&i.BatchID,
 			&i.GroupGenesisID,
 			&i.GroupAnchorID,
+			&i.ScriptKeyTweak,
+			&i.TweakedScriptKey,
+			&i.ScriptKeyRaw,
+			&i.ScriptKeyFam,
+			&i.ScriptKeyIndex,
+			&i.GroupKeyRaw,
+			&i.GroupKeyFam,
+			&i.GroupKeyIndex,
+			&i.Label,
+			&i.ProofCourierAddr,
 		); err != nil {
 			return nil, err
 		}
@@ -1600,23 +1726,30 @@ func (q *Queries) GenesisPoints(ctx context.Context) ([]GenesisPoint, error) {
 const insertAssetSeedling = `-- name: InsertAssetSeedling :exec
 INSERT INTO asset_seedlings (
     asset_name, asset_type, asset_version, asset_supply, asset_meta_id,
-    emission_enabled, batch_id, group_genesis_id, group_anchor_id
+    emission_enabled, batch_id, group_genesis_id, group_anchor_id,
+    script_key_id, group_internal_key_id, label, proof_courier_addr
 ) VALUES (
    $1, $2, $3, $4, $5, $6, $7,
-   $8, $9
+   $8, $9,
+   $10, $11,
+   $12, $13
 )
 `
 
 type InsertAssetSeedlingParams struct {
-	AssetName       string
-	AssetType       int16
-	AssetVersion    int16
-	AssetSupply     int64
-	AssetMetaID     int64
-	EmissionEnabled bool
-	BatchID         int64
-	GroupGenesisID  sql.NullInt64
-	GroupAnchorID   sql.NullInt64
+	AssetName          string
+	AssetType          int16
+	AssetVersion       int16
+	AssetSupply        int64
+	AssetMetaID        int64
+	EmissionEnabled    bool
+	BatchID            int64
+	GroupGenesisID     sql.NullInt64
+	GroupAnchorID      sql.NullInt64
+	ScriptKeyID        sql.NullInt64
+	GroupInternalKeyID sql.NullInt64
+	Label              sql.NullString
+	ProofCourierAddr   sql.NullString
 }
 
 func (q *Queries) InsertAssetSeedling(ctx context.Context, arg InsertAssetSeedlingParams) error {
@@ -1630,6 +1763,10 @@ func (q *Queries) InsertAssetSeedling(ctx context.Context, arg InsertAssetSeedli
 		arg.BatchID,
 		arg.GroupGenesisID,
 		arg.GroupAnchorID,
+		arg.ScriptKeyID,
+		arg.GroupInternalKeyID,
+		arg.Label,
+		arg.ProofCourierAddr,
 	)
 	return err
 }
@@ -1647,24 +1784,31 @@ WITH target_key_id AS (
 )
 INSERT INTO asset_seedlings(
     asset_name, asset_type, asset_version, asset_supply, asset_meta_id,
-    emission_enabled, batch_id, group_genesis_id, group_anchor_id
+    emission_enabled, batch_id, group_genesis_id, group_anchor_id,
+    script_key_id, group_internal_key_id, label, proof_courier_addr
 ) VALUES (
     $2, $3, $4, $5, $6, $7,
     (SELECT key_id FROM target_key_id),
-    $8, $9
+    $8, $9,
+    $10, $11,
+    $12, $13
 )
 `
 
 type InsertAssetSeedlingIntoBatchParams struct {
-	RawKey          []byte
-	AssetName       string
-	AssetType       int16
-	AssetVersion    int16
-	AssetSupply     int64
-	AssetMetaID     int64
-	EmissionEnabled bool
-	GroupGenesisID  sql.NullInt64
-	GroupAnchorID   sql.NullInt64
+	RawKey             []byte
+	AssetName          string
+	AssetType          int16
+	AssetVersion       int16
+	AssetSupply        int64
+	AssetMetaID        int64
+	EmissionEnabled    bool
+	GroupGenesisID     sql.NullInt64
+	GroupAnchorID      sql.NullInt64
+	ScriptKeyID        sql.NullInt64
+	GroupInternalKeyID sql.NullInt64
+	Label              sql.NullString
+	ProofCourierAddr   sql.NullString
 }
 
 func (q *Queries) InsertAssetSeedlingIntoBatch(ctx context.Context, arg InsertAssetSeedlingIntoBatchParams) error {
@@ -1678,6 +1822,10 @@ func (q *Queries) InsertAssetSeedlingIntoBatch(ctx context.Context, arg InsertAs
 		arg.EmissionEnabled,
 		arg.GroupGenesisID,
 		arg.GroupAnchorID,
+		arg.ScriptKeyID,
+		arg.GroupInternalKeyID,
+		arg.Label,
+		arg.ProofCourierAddr,
 	)
 	return err
 }
@@ -1712,6 +1860,62 @@ func (q *Queries) InsertAssetWitness(ctx context.Context, arg InsertAssetWitness
 	return err
 }
 
+const insertBatchAssetLabels = `-- name: InsertBatchAssetLabels :exec
+WITH target_batch(batch_id) AS (
+    SELECT key_id
+    FROM internal_keys keys
+    WHERE keys.raw_key = $2
+)
+INSERT INTO asset_labels (gen_asset_id, label)
+SELECT genesis_assets.gen_asset_id, asset_seedlings.label
+FROM asset_seedlings
+JOIN genesis_assets
+    ON genesis_assets.asset_tag = asset_seedlings.asset_name AND
+       genesis_assets.genesis_point_id = $1
+WHERE asset_seedlings.batch_id IN (SELECT batch_id FROM target_batch) AND
+    asset_seedlings.label IS NOT NULL
+ON CONFLICT (gen_asset_id)
+    DO UPDATE SET label = EXCLUDED.label
+`
+
+type InsertBatchAssetLabelsParams struct {
+	GenesisPointID int64
+	BatchKey       []byte
+}
+
+func (q *Queries) InsertBatchAssetLabels(ctx context.Context, arg InsertBatchAssetLabelsParams) error {
+	_, err := q.db.ExecContext(ctx, insertBatchAssetLabels, arg.GenesisPointID, arg.BatchKey)
+	return err
+}
+
+const insertBatchAssetProofCourierAddrs = `-- name: InsertBatchAssetProofCourierAddrs :exec
+WITH target_batch(batch_id) AS (
+    SELECT key_id
+    FROM internal_keys keys
+    WHERE keys.raw_key = $2
+)
+INSERT INTO asset_proof_courier_addrs (gen_asset_id, proof_courier_addr)
+SELECT genesis_assets.gen_asset_id, asset_seedlings.proof_courier_addr
+FROM asset_seedlings
+JOIN genesis_assets
+    ON genesis_assets.asset_tag = asset_seedlings.asset_name AND
+       genesis_assets.genesis_point_id = $1
+WHERE asset_seedlings.batch_id IN (SELECT batch_id FROM target_batch) AND
+    asset_seedlings.proof_courier_addr IS NOT NULL
+ON CONFLICT (gen_asset_id)
+    DO UPDATE SET proof_courier_addr = EXCLUDED.proof_courier_addr
+`
+
+type InsertBatchAssetProofCourierAddrsParams struct {
+	GenesisPointID int64
+	BatchKey       []byte
+}
+
+func (q *Queries) InsertBatchAssetProofCourierAddrs(ctx context.Context, arg InsertBatchAssetProofCourierAddrsParams) error {
+	_, err := q.db.ExecContext(ctx, insertBatchAssetProofCourierAddrs, arg.GenesisPointID, arg.BatchKey)
+	return err
+}
+
 const insertNewAsset = `-- name: InsertNewAsset :one
 INSERT INTO assets (
     genesis_id, version, script_key_id, asset_group_witness_id, script_version, 
@@ -1754,24 +1958,31 @@ func (q *Queries) InsertNewAsset(ctx context.Context, arg InsertNewAssetParams)
 
 const newMintingBatch = `-- name: NewMintingBatch :exec
 INSERT INTO asset_minting_batches (
-    batch_state, batch_id, height_hint, creation_time_unix
-) VALUES (0, $1, $2, $3)
+    batch_state, batch_id, height_hint, creation_time_unix, batch_name
+) VALUES (0, $1, $2, $3, $4)
 `
 
 type NewMintingBatchParams struct {
 	BatchID          int64
 	HeightHint       int32
 	CreationTimeUnix time.Time
+	BatchName        sql.NullString
 }
 
 func (q *Queries) NewMintingBatch(ctx context.Context, arg NewMintingBatchParams) error {
-	_, err := q.db.ExecContext(ctx, newMintingBatch, arg.BatchID, arg.HeightHint, arg.CreationTimeUnix)
+	_, err := q.db.ExecContext(ctx, newMintingBatch,
+		arg.BatchID,
+		arg.HeightHint,
+		arg.CreationTimeUnix,
+		arg.BatchName,
+	)
 	return err
 }
 
 const queryAssetBalancesByAsset = `-- name: QueryAssetBalancesByAsset :many
 SELECT
     genesis_info_view.asset_id, version, SUM(amount) balance,
+    COUNT(DISTINCT assets.anchor_utxo_id) num_utxos,
     genesis_info_view.asset_tag, genesis_info_view.meta_hash,
     genesis_info_view.asset_type, genesis_info_view.output_index,
     genesis_info_view.prev_out AS genesis_point
@@ -1793,6 +2004,7 @@ type QueryAssetBalancesByAssetRow struct {
 	AssetID      []byte
 	Version      int32
 	Balance      int64
+	NumUtxos     int64
 	AssetTag     string
 	MetaHash     []byte
 	AssetType    int16
@@ -1817,6 +2029,7 @@ func (q *Queries) QueryAssetBalancesByAsset(ctx context.Context, assetIDFilter [
 			&i.AssetID,
 			&i.Version,
 			&i.Balance,
+			&i.NumUtxos,
 			&i.AssetTag,
 			&i.MetaHash,
 			&i.AssetType,
@@ -1838,7 +2051,8 @@ func (q *Queries) QueryAssetBalancesByAsset(ctx context.Context, assetIDFilter [
 
 const queryAssetBalancesByGroup = `-- name: QueryAssetBalancesByGroup :many
 SELECT
-    key_group_info_view.tweaked_group_key, SUM(amount) balance
+    key_group_info_view.tweaked_group_key, SUM(amount) balance,
+    COUNT(DISTINCT assets.anchor_utxo_id) num_utxos
 FROM assets
 JOIN key_group_info_view
     ON assets.genesis_id = key_group_info_view.gen_asset_id AND
@@ -1851,6 +2065,7 @@ GROUP BY key_group_info_view.tweaked_group_key
 type QueryAssetBalancesByGroupRow struct {
 	TweakedGroupKey []byte
 	Balance         int64
+	NumUtxos        int64
 }
 
 func (q *Queries) QueryAssetBalancesByGroup(ctx context.Context, keyGroupFilter []byte) ([]QueryAssetBalancesByGroupRow, error) {
@@ -1862,7 +2077,7 @@ func (q *Queries) QueryAssetBalancesByGroup(ctx context.Context, keyGroupFilter
 	var items []QueryAssetBalancesByGroupRow
 	for rows.Next() {
 		var i QueryAssetBalancesByGroupRow
-		if err := rows.Scan(&i.TweakedGroupKey, &i.Balance); err != nil {
+		if err := rows.Scan(&i.TweakedGroupKey, &i.Balance, &i.NumUtxos); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -1908,7 +2123,8 @@ SELECT
     utxos.lease_owner AS anchor_lease_owner,
     utxos.lease_expiry AS anchor_lease_expiry,
     utxo_internal_keys.raw_key AS anchor_internal_key,
-    split_commitment_root_hash, split_commitment_root_value
+    split_commitment_root_hash, split_commitment_root_value,
+    asset_labels.label AS asset_label
 FROM assets
 JOIN genesis_info_view
     ON assets.genesis_id = genesis_info_view.gen_asset_id AND
@@ -1940,11 +2156,16 @@ JOIN internal_keys utxo_internal_keys
 JOIN chain_txns txns
     ON utxos.txn_id = txns.txn_id AND
       COALESCE(txns.block_height, 0) >= COALESCE($6, txns.block_height, 0)
+LEFT JOIN asset_labels
+    ON assets.genesis_id = asset_labels.gen_asset_id
 WHERE (
     assets.amount >= COALESCE($7, assets.amount) AND
     assets.spent = COALESCE($8, assets.spent) AND
     (key_group_info_view.tweaked_group_key = $9 OR
-      $9 IS NULL)
+      $9 IS NULL) AND
+    (LOWER(asset_labels.label) LIKE CAST($10 AS TEXT)
+        ESCAPE '\' OR
+      $10 IS NULL)
 )
 `
 
@@ -1958,6 +2179,7 @@ type QueryAssetsParams struct {
 	MinAmt           sql.NullInt64
 	Spent            sql.NullBool
 	KeyGroupFilter   []byte
+	LabelPattern     sql.NullString
 }
 
 type QueryAssetsRow struct {
@@ -1999,12 +2221,15 @@ type QueryAssetsRow struct {
 	AnchorInternalKey        []byte
 	SplitCommitmentRootHash  []byte
 	SplitCommitmentRootValue sql.NullInt64
+	AssetLabel               sql.NullString
 }
 
 // We use a LEFT JOIN here as not every asset has a group key, so this'll
 // generate rows that have NULL values for the group key fields if an asset
 // doesn't have a group key. See the comment in fetchAssetSprouts for a work
 // around that needs to be used with this query until a sqlc bug is fixed.
+// We use a LEFT JOIN here as not every asset has a label. The label pattern is
+// matched case-insensitively, so it must be lower case.
 // This clause is used to select specific assets for a asset ID, general
 // channel balances, and also coin selection. We use the sqlc.narg feature to
 // make the entire statement evaluate to true, if none of these extra args are
@@ -2020,6 +2245,7 @@ func (q *Queries) QueryAssets(ctx context.Context, arg QueryAssetsParams) ([]Que
 		arg.MinAmt,
 		arg.Spent,
 		arg.KeyGroupFilter,
+		arg.LabelPattern,
 	)
 	if err != nil {
 		return nil, err
@@ -2067,6 +2293,43 @@ func (q *Queries) QueryAssets(ctx context.Context, arg QueryAssetsParams) ([]Que
 			&i.AnchorInternalKey,
 			&i.SplitCommitmentRootHash,
 			&i.SplitCommitmentRootValue,
+			&i.AssetLabel,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const queryInternalKeys = `-- name: QueryInternalKeys :many
+SELECT key_id, raw_key, key_family, key_index
+FROM internal_keys
+WHERE (key_family = $1 OR
+    $1 IS NULL)
+ORDER BY key_family, key_index
+`
+
+func (q *Queries) QueryInternalKeys(ctx context.Context, keyFamily sql.NullInt32) ([]InternalKey, error) {
+	rows, err := q.db.QueryContext(ctx, queryInternalKeys, keyFamily)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InternalKey
+	for rows.Next() {
+		var i InternalKey
+		if err := rows.Scan(
+			&i.KeyID,
+			&i.RawKey,
+			&i.KeyFamily,
+			&i.KeyIndex,
 		); err != nil {
 			return nil, err
 		}
@@ -2235,6 +2498,25 @@ func (q *Queries) UpsertAssetGroupWitness(ctx context.Context, arg UpsertAssetGr
 	return witness_id, err
 }
 
+const upsertAssetLabel = `-- name: UpsertAssetLabel :exec
+INSERT INTO asset_labels (gen_asset_id, label)
+SELECT gen_asset_id, $1
+FROM genesis_assets
+WHERE genesis_assets.asset_id = $2
+ON CONFLICT (gen_asset_id)
+    DO UPDATE SET label = EXCLUDED.label
+`
+
+type UpsertAssetLabelParams struct {
+	Label   string
+	AssetID []byte
+}
+
+func (q *Queries) UpsertAssetLabel(ctx context.Context, arg UpsertAssetLabelParams) error {
+	_, err := q.db.ExecContext(ctx, upsertAssetLabel, arg.Label, arg.AssetID)
+	return err
+}
+
 const upsertAssetMeta = `-- name: UpsertAssetMeta :one
 INSERT INTO assets_meta (
     meta_data_hash, meta_data_blob, meta_data_type
@@ -0,0 +1,4 @@
+-- tls_cert is an optional PEM encoded TLS certificate that is pinned for the
+-- universe server. If set, the certificate is used as the only trusted CA
+-- when connecting to the server.
+ALTER TABLE universe_servers ADD COLUMN tls_cert BLOB;
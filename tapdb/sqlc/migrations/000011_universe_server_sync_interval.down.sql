@@ -0,0 +1 @@
+ALTER TABLE universe_servers DROP COLUMN sync_interval_seconds;
@@ -0,0 +1,4 @@
+-- sync_interval_seconds is an optional, server specific interval at which
+-- we'll periodically sync with the universe server. If NULL, then the global
+-- sync interval of the node is used.
+ALTER TABLE universe_servers ADD COLUMN sync_interval_seconds BIGINT;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS universe_push_events_root_id_idx;
+DROP TABLE IF EXISTS universe_push_events;
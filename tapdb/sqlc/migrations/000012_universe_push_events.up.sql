@@ -0,0 +1,17 @@
+-- universe_push_events tracks each leaf that was successfully pushed out to a
+-- member of the universe federation.
+CREATE TABLE IF NOT EXISTS universe_push_events (
+    event_id BIGINT PRIMARY KEY,
+
+    universe_root_id BIGINT NOT NULL REFERENCES universe_roots(id) ON DELETE CASCADE,
+
+    -- server_host is the host of the federation member the leaf was pushed
+    -- to.
+    server_host TEXT NOT NULL,
+
+    event_time TIMESTAMP NOT NULL,
+
+    event_timestamp BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS universe_push_events_root_id_idx ON universe_push_events(universe_root_id);
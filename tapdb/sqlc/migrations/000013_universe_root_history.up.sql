@@ -0,0 +1,18 @@
+-- universe_root_history tracks the root of a universe tree after each leaf
+-- insertion, keyed by the block height of the inserted leaf. This allows us to
+-- look up the root of a universe as it was at a given block height.
+CREATE TABLE IF NOT EXISTS universe_root_history (
+    id BIGINT PRIMARY KEY,
+
+    universe_root_id BIGINT NOT NULL REFERENCES universe_roots(id) ON DELETE CASCADE,
+
+    -- block_height is the height of the block that confirmed the leaf that
+    -- was inserted.
+    block_height INTEGER NOT NULL,
+
+    root_hash BLOB NOT NULL CHECK(length(root_hash) = 32),
+
+    root_sum BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS universe_root_history_height_idx ON universe_root_history(universe_root_id, block_height);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS universe_root_history_hash_idx;
+ALTER TABLE universe_root_history DROP COLUMN leaf_node_key;
@@ -0,0 +1,7 @@
+-- leaf_node_key is the key of the leaf whose insertion resulted in the
+-- recorded root. This allows us to determine the set of leaves that were
+-- inserted into a universe after a given root. Rows recorded before this
+-- column was added don't have a leaf key.
+ALTER TABLE universe_root_history ADD COLUMN leaf_node_key BLOB;
+
+CREATE INDEX IF NOT EXISTS universe_root_history_hash_idx ON universe_root_history(universe_root_id, root_hash);
@@ -0,0 +1,31 @@
+-- universe_pending_pushes tracks each leaf that couldn't be pushed out to a
+-- member of the universe federation. These pushes are retried with a backoff
+-- until they succeed, or the server is removed from the federation.
+CREATE TABLE IF NOT EXISTS universe_pending_pushes (
+    id BIGINT PRIMARY KEY,
+
+    server_id BIGINT NOT NULL REFERENCES universe_servers(id) ON DELETE CASCADE,
+
+    -- The asset ID and group key identify the universe the leaf belongs to.
+    -- The group key supersedes the asset ID, so only one of them is set.
+    asset_id BLOB CHECK(length(asset_id) = 32),
+
+    group_key BLOB CHECK(length(group_key) = 33),
+
+    proof_type TEXT NOT NULL CHECK(proof_type IN ('issuance', 'transfer')),
+
+    -- The minting point and script key identify the leaf within the
+    -- universe.
+    minting_point BLOB NOT NULL,
+
+    script_key_bytes BLOB NOT NULL CHECK(length(script_key_bytes) = 32),
+
+    num_attempts INTEGER NOT NULL,
+
+    next_attempt_time TIMESTAMP NOT NULL,
+
+    -- last_error is the error of the last failed push attempt.
+    last_error TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS universe_pending_pushes_server_idx ON universe_pending_pushes(server_id);
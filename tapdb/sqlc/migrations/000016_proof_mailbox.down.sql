@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS proof_mailbox_expiry_idx;
+DROP TABLE IF EXISTS proof_mailbox;
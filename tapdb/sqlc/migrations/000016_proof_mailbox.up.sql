@@ -0,0 +1,26 @@
+-- proof_mailbox stores proof files that were uploaded to this node by the
+-- sender of an asset, so the receiver can download them later. This allows
+-- two nodes to complete a transfer without being online at the same time.
+CREATE TABLE IF NOT EXISTS proof_mailbox (
+    id BIGINT PRIMARY KEY,
+
+    -- script_key is the x-only script key of the asset the proof file was
+    -- uploaded for. The receiver uses this key to poll for new proofs.
+    script_key BLOB NOT NULL CHECK(length(script_key) = 32),
+
+    -- proof_file_hash is the SHA256 hash of the proof file, which is used to
+    -- detect the same proof being uploaded multiple times.
+    proof_file_hash BLOB NOT NULL CHECK(length(proof_file_hash) = 32),
+
+    proof_file BLOB NOT NULL,
+
+    upload_time TIMESTAMP NOT NULL,
+
+    -- expiry_time is the time after which the proof file is no longer
+    -- served and will be removed.
+    expiry_time TIMESTAMP NOT NULL,
+
+    UNIQUE(script_key, proof_file_hash)
+);
+
+CREATE INDEX IF NOT EXISTS proof_mailbox_expiry_idx ON proof_mailbox(expiry_time);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS asset_emission_events_issued_idx;
+DROP TABLE IF EXISTS asset_emission_events;
@@ -0,0 +1,24 @@
+-- asset_emission_events stores the emission schedule of a minted asset. Each
+-- event describes an amount of additional units of the asset that should be
+-- issued into the asset group once the block height of the event is reached.
+CREATE TABLE IF NOT EXISTS asset_emission_events (
+    event_id BIGINT PRIMARY KEY,
+
+    -- seedling_id references the seedling of the asset the emission schedule
+    -- was specified for. The group key of the asset is derived from the
+    -- seedling once its batch has been committed.
+    seedling_id BIGINT NOT NULL REFERENCES asset_seedlings(seedling_id),
+
+    block_height INTEGER NOT NULL,
+
+    amount BIGINT NOT NULL,
+
+    -- issued is set once the additional units of the event have been queued
+    -- for issuance.
+    issued BOOLEAN NOT NULL DEFAULT FALSE,
+
+    UNIQUE(seedling_id, block_height)
+);
+
+CREATE INDEX IF NOT EXISTS asset_emission_events_issued_idx
+    ON asset_emission_events(issued);
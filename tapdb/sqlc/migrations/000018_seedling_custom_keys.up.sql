@@ -0,0 +1,9 @@
+-- script_key_id references the optional script key that was specified for a
+-- seedling when minting. If NULL, a new script key is derived once the batch is
+-- finalized.
+ALTER TABLE asset_seedlings ADD COLUMN script_key_id BIGINT REFERENCES script_keys(script_key_id);
+
+-- group_internal_key_id references the optional internal key of the new asset
+-- group of a seedling. If NULL, a new internal key is derived once the batch is
+-- finalized.
+ALTER TABLE asset_seedlings ADD COLUMN group_internal_key_id BIGINT REFERENCES internal_keys(key_id);
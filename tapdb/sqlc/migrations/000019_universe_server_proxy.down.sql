@@ -0,0 +1,3 @@
+ALTER TABLE universe_servers DROP COLUMN proxy_password;
+ALTER TABLE universe_servers DROP COLUMN proxy_user;
+ALTER TABLE universe_servers DROP COLUMN proxy_addr;
@@ -0,0 +1,9 @@
+-- proxy_addr is the host:port of an optional, server specific SOCKS5 proxy
+-- that's used to connect to the universe server. If NULL, then the global
+-- proxy of the node is used, if any.
+ALTER TABLE universe_servers ADD COLUMN proxy_addr VARCHAR;
+
+-- proxy_user and proxy_password are the optional credentials used to
+-- authenticate with the proxy.
+ALTER TABLE universe_servers ADD COLUMN proxy_user VARCHAR;
+ALTER TABLE universe_servers ADD COLUMN proxy_password VARCHAR;
@@ -0,0 +1 @@
+ALTER TABLE universe_roots DROP COLUMN asset_type;
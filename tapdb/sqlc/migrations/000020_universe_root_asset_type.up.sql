@@ -0,0 +1,14 @@
+-- asset_type is the type of the assets of the universe. All assets of a
+-- universe, including all members of an asset group, have the same type. This
+-- allows filtering the universe roots by asset type without walking their
+-- leaves.
+ALTER TABLE universe_roots ADD COLUMN asset_type SMALLINT;
+
+-- We populate the asset type of the existing universe roots from the genesis
+-- of the asset the root was created for.
+UPDATE universe_roots
+SET asset_type = (
+    SELECT genesis_assets.asset_type
+    FROM genesis_assets
+    WHERE genesis_assets.asset_id = universe_roots.asset_id
+);
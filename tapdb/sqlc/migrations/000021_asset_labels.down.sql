@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS asset_labels;
+ALTER TABLE asset_seedlings DROP COLUMN label;
@@ -0,0 +1,15 @@
+-- label is an optional human-readable label of a seedling. The label is only
+-- stored locally and is not committed to on-chain. Once the batch of the
+-- seedling is committed, the label is carried over to the asset_labels table.
+ALTER TABLE asset_seedlings ADD COLUMN label TEXT;
+
+-- asset_labels stores the human-readable labels of assets. Labels are only
+-- stored locally and are not part of the asset itself, so they can be changed
+-- at any time.
+CREATE TABLE IF NOT EXISTS asset_labels (
+    -- gen_asset_id references the genesis of the labeled asset. All assets
+    -- with the same asset ID share a label.
+    gen_asset_id BIGINT PRIMARY KEY REFERENCES genesis_assets(gen_asset_id),
+
+    label TEXT NOT NULL
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS asset_proof_courier_addrs;
+ALTER TABLE asset_seedlings DROP COLUMN proof_courier_addr;
@@ -0,0 +1,15 @@
+-- proof_courier_addr is an optional proof courier address of a seedling. Once
+-- the batch of the seedling is committed, the address is carried over to the
+-- asset_proof_courier_addrs table.
+ALTER TABLE asset_seedlings ADD COLUMN proof_courier_addr TEXT;
+
+-- asset_proof_courier_addrs stores the proof courier address that is embedded
+-- in new addresses created for an asset, unless another address is specified
+-- when creating the address. The address is only stored locally.
+CREATE TABLE IF NOT EXISTS asset_proof_courier_addrs (
+    -- gen_asset_id references the genesis of the asset. All assets with the
+    -- same asset ID share a proof courier address.
+    gen_asset_id BIGINT PRIMARY KEY REFERENCES genesis_assets(gen_asset_id),
+
+    proof_courier_addr TEXT NOT NULL
+);
@@ -0,0 +1,2 @@
+ALTER TABLE universe_leaves DROP COLUMN source_priority;
+ALTER TABLE universe_servers DROP COLUMN priority;
@@ -0,0 +1,10 @@
+-- priority is the priority of the universe server within the federation.
+-- Servers with a higher priority are synced first, and leaves synced from them
+-- are never replaced by leaves synced from servers with a lower or equal
+-- priority.
+ALTER TABLE universe_servers ADD COLUMN priority BIGINT NOT NULL DEFAULT 0;
+
+-- source_priority is the priority of the federation server a leaf was synced
+-- from. This is NULL for leaves that weren't synced from a federation server,
+-- for example because they were created locally or pushed to us.
+ALTER TABLE universe_leaves ADD COLUMN source_priority BIGINT;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS genesis_assets_lower_tag_idx;
@@ -0,0 +1,4 @@
+-- The lower case asset tag is indexed to speed up the case-insensitive search
+-- of universe roots by the name of their asset.
+CREATE INDEX IF NOT EXISTS genesis_assets_lower_tag_idx
+    ON genesis_assets (LOWER(asset_tag));
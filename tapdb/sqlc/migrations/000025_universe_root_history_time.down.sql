@@ -0,0 +1 @@
+ALTER TABLE universe_root_history DROP COLUMN insertion_timestamp;
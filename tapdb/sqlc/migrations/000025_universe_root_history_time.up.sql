@@ -0,0 +1,4 @@
+-- insertion_timestamp is the Unix timestamp of the time the leaf that led to
+-- the root was inserted into the universe. This is 0 for entries that were
+-- recorded before the column was added.
+ALTER TABLE universe_root_history ADD COLUMN insertion_timestamp BIGINT NOT NULL DEFAULT 0;
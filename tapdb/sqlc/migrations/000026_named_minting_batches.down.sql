@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS minting_batch_name_idx;
+ALTER TABLE asset_minting_batches DROP COLUMN batch_name;
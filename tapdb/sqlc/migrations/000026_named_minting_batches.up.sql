@@ -0,0 +1,7 @@
+-- batch_name is the optional name of a minting batch. Named batches are only
+-- finalized on explicit request, so they can be used to accumulate assets over
+-- a longer period of time.
+ALTER TABLE asset_minting_batches ADD COLUMN batch_name TEXT;
+
+CREATE INDEX IF NOT EXISTS minting_batch_name_idx
+    ON asset_minting_batches (batch_name);
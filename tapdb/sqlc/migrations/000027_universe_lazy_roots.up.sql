@@ -0,0 +1,29 @@
+-- universe_lazy_roots tracks the universe roots that were imported by a roots
+-- only sync, without the leaves of the universe. The leaves are fetched from
+-- the source server once they're queried, and the lazy root is removed once
+-- the universe is fully backed by local leaves.
+CREATE TABLE IF NOT EXISTS universe_lazy_roots (
+    -- namespace is the string representation of the universe identifier, and
+    -- ensures that there's only a single lazy root per universe.
+    namespace VARCHAR NOT NULL PRIMARY KEY,
+
+    -- The asset ID and group key identify the universe of the root. The group
+    -- key supersedes the asset ID, so only one of them is set.
+    asset_id BLOB CHECK(length(asset_id) = 32),
+
+    group_key BLOB CHECK(length(group_key) = 33),
+
+    proof_type TEXT NOT NULL CHECK(proof_type IN ('issuance', 'transfer')),
+
+    root_hash BLOB NOT NULL CHECK(length(root_hash) = 32),
+
+    root_sum BIGINT NOT NULL,
+
+    asset_name TEXT NOT NULL,
+
+    -- source_host is the host of the universe server the root was synced
+    -- from, which the leaves are fetched from.
+    source_host TEXT NOT NULL,
+
+    synced_at TIMESTAMP NOT NULL
+);
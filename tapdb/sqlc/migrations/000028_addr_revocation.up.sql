@@ -0,0 +1,4 @@
+-- revoked_at is the time the address was revoked. Payments to a revoked
+-- address are no longer claimed by the wallet, and proofs for its script key
+-- are rejected. If the address isn't revoked, this field will be NULL.
+ALTER TABLE addrs ADD COLUMN revoked_at TIMESTAMP;
@@ -0,0 +1 @@
+ALTER TABLE addr_events DROP COLUMN received_amount;
@@ -0,0 +1,4 @@
+-- received_amount is the asset amount that was actually received by an
+-- inbound transfer, which might differ from the amount of the address it was
+-- sent to. This field is NULL until the proof of the transfer was received.
+ALTER TABLE addr_events ADD COLUMN received_amount BIGINT;
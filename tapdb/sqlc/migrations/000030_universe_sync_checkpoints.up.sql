@@ -0,0 +1,21 @@
+-- universe_sync_checkpoints tracks the universes that were completely synced
+-- by a sync with a remote universe server that didn't finish yet. If the sync
+-- is interrupted, then the next sync with the same server can resume from the
+-- checkpoint, skipping the universes that were already synced. The
+-- checkpoint of a sync is removed once the sync finishes.
+CREATE TABLE IF NOT EXISTS universe_sync_checkpoints (
+    -- server_host is the host of the universe server that is synced from.
+    server_host TEXT NOT NULL,
+
+    -- sync_type is the type of the sync, as a checkpoint of one sync type
+    -- doesn't cover the universes of another one.
+    sync_type TEXT NOT NULL,
+
+    -- namespace is the string representation of the universe identifier of
+    -- the synced universe.
+    namespace VARCHAR NOT NULL,
+
+    synced_at TIMESTAMP NOT NULL,
+
+    PRIMARY KEY (server_host, sync_type, namespace)
+);
@@ -0,0 +1 @@
+ALTER TABLE mssmt_nodes DROP COLUMN pruned;
@@ -0,0 +1,4 @@
+-- pruned is true if the value of a leaf node was pruned. The value of a
+-- pruned leaf is replaced by the leaf's node hash, so the leaf keeps
+-- committing to the same hash and sum, and the roots of its tree don't change.
+ALTER TABLE mssmt_nodes ADD COLUMN pruned BOOLEAN NOT NULL DEFAULT FALSE;
@@ -24,6 +24,7 @@ type Addr struct {
 	CreationTime     time.Time
 	ManagedFrom      sql.NullTime
 	ProofCourierAddr []byte
+	RevokedAt        sql.NullTime
 }
 
 type AddrEvent struct {
@@ -36,6 +37,7 @@ type AddrEvent struct {
 	ManagedUtxoID       int64
 	AssetProofID        sql.NullInt64
 	AssetID             sql.NullInt64
+	ReceivedAmount      sql.NullInt64
 }
 
 type Asset struct {
@@ -54,6 +56,14 @@ type Asset struct {
 	Spent                    bool
 }
 
+type AssetEmissionEvent struct {
+	EventID     int64
+	SeedlingID  int64
+	BlockHeight int32
+	Amount      int64
+	Issued      bool
+}
+
 type AssetGroup struct {
 	GroupID         int64
 	TweakedGroupKey []byte
@@ -69,6 +79,11 @@ type AssetGroupWitness struct {
 	GroupKeyID   int64
 }
 
+type AssetLabel struct {
+	GenAssetID int64
+	Label      string
+}
+
 type AssetMintingBatch struct {
 	BatchID           int64
 	BatchState        int16
@@ -77,6 +92,7 @@ type AssetMintingBatch struct {
 	GenesisID         sql.NullInt64
 	HeightHint        int32
 	CreationTimeUnix  time.Time
+	BatchName         sql.NullString
 }
 
 type AssetProof struct {
@@ -85,17 +101,26 @@ type AssetProof struct {
 	ProofFile []byte
 }
 
+type AssetProofCourierAddr struct {
+	GenAssetID       int64
+	ProofCourierAddr string
+}
+
 type AssetSeedling struct {
-	SeedlingID      int64
-	AssetName       string
-	AssetVersion    int16
-	AssetType       int16
-	AssetSupply     int64
-	AssetMetaID     int64
-	EmissionEnabled bool
-	BatchID         int64
-	GroupGenesisID  sql.NullInt64
-	GroupAnchorID   sql.NullInt64
+	SeedlingID         int64
+	AssetName          string
+	AssetVersion       int16
+	AssetType          int16
+	AssetSupply        int64
+	AssetMetaID        int64
+	EmissionEnabled    bool
+	BatchID            int64
+	GroupGenesisID     sql.NullInt64
+	GroupAnchorID      sql.NullInt64
+	ScriptKeyID        sql.NullInt64
+	GroupInternalKeyID sql.NullInt64
+	Label              sql.NullString
+	ProofCourierAddr   sql.NullString
 }
 
 type AssetTransfer struct {
@@ -245,6 +270,7 @@ type MssmtNode struct {
 	Value     []byte
 	Sum       int64
 	Namespace string
+	Pruned    bool
 }
 
 type MssmtRoot struct {
@@ -263,6 +289,15 @@ type PassiveAsset struct {
 	NewProof        []byte
 }
 
+type ProofMailbox struct {
+	ID            int64
+	ScriptKey     []byte
+	ProofFileHash []byte
+	ProofFile     []byte
+	UploadTime    time.Time
+	ExpiryTime    time.Time
+}
+
 type ReceiverProofTransferAttempt struct {
 	ProofLocatorHash []byte
 	TimeUnix         time.Time
@@ -283,6 +318,18 @@ type UniverseEvent struct {
 	EventTimestamp int64
 }
 
+type UniverseLazyRoot struct {
+	Namespace  string
+	AssetID    []byte
+	GroupKey   []byte
+	ProofType  string
+	RootHash   []byte
+	RootSum    int64
+	AssetName  string
+	SourceHost string
+	SyncedAt   time.Time
+}
+
 type UniverseLeafe struct {
 	ID                int64
 	AssetGenesisID    int64
@@ -291,6 +338,28 @@ type UniverseLeafe struct {
 	UniverseRootID    int64
 	LeafNodeKey       []byte
 	LeafNodeNamespace string
+	SourcePriority    sql.NullInt64
+}
+
+type UniversePendingPush struct {
+	ID              int64
+	ServerID        int64
+	AssetID         []byte
+	GroupKey        []byte
+	ProofType       string
+	MintingPoint    []byte
+	ScriptKeyBytes  []byte
+	NumAttempts     int32
+	NextAttemptTime time.Time
+	LastError       string
+}
+
+type UniversePushEvent struct {
+	EventID        int64
+	UniverseRootID int64
+	ServerHost     string
+	EventTime      time.Time
+	EventTimestamp int64
 }
 
 type UniverseRoot struct {
@@ -299,12 +368,29 @@ type UniverseRoot struct {
 	AssetID       []byte
 	GroupKey      []byte
 	ProofType     string
+	AssetType     sql.NullInt16
+}
+
+type UniverseRootHistory struct {
+	ID                 int64
+	UniverseRootID     int64
+	BlockHeight        int32
+	RootHash           []byte
+	RootSum            int64
+	LeafNodeKey        []byte
+	InsertionTimestamp int64
 }
 
 type UniverseServer struct {
-	ID           int64
-	ServerHost   string
-	LastSyncTime time.Time
+	ID                  int64
+	ServerHost          string
+	LastSyncTime        time.Time
+	TlsCert             []byte
+	SyncIntervalSeconds sql.NullInt64
+	ProxyAddr           sql.NullString
+	ProxyUser           sql.NullString
+	ProxyPassword       sql.NullString
+	Priority            int64
 }
 
 type UniverseStat struct {
@@ -313,3 +399,10 @@ type UniverseStat struct {
 	AssetID          []byte
 	GroupKey         []byte
 }
+
+type UniverseSyncCheckpoint struct {
+	ServerHost string
+	SyncType   string
+	Namespace  string
+	SyncedAt   time.Time
+}
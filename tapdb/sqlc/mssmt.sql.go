@@ -51,7 +51,7 @@ func (q *Queries) DeleteRoot(ctx context.Context, namespace string) (int64, erro
 }
 
 const fetchAllNodes = `-- name: FetchAllNodes :many
-SELECT hash_key, l_hash_key, r_hash_key, key, value, sum, namespace FROM mssmt_nodes
+SELECT hash_key, l_hash_key, r_hash_key, key, value, sum, namespace, pruned FROM mssmt_nodes
 `
 
 func (q *Queries) FetchAllNodes(ctx context.Context) ([]MssmtNode, error) {
@@ -71,6 +71,7 @@ func (q *Queries) FetchAllNodes(ctx context.Context) ([]MssmtNode, error) {
 			&i.Value,
 			&i.Sum,
 			&i.Namespace,
+			&i.Pruned,
 		); err != nil {
 			return nil, err
 		}
@@ -87,14 +88,14 @@ func (q *Queries) FetchAllNodes(ctx context.Context) ([]MssmtNode, error) {
 
 const fetchChildren = `-- name: FetchChildren :many
 WITH RECURSIVE mssmt_branches_cte (
-    hash_key, l_hash_key, r_hash_key, key, value, sum, namespace, depth
+    hash_key, l_hash_key, r_hash_key, key, value, sum, namespace, pruned, depth
 )
 AS (
-    SELECT r.hash_key, r.l_hash_key, r.r_hash_key, r.key, r.value, r.sum, r.namespace, 0 as depth
+    SELECT r.hash_key, r.l_hash_key, r.r_hash_key, r.key, r.value, r.sum, r.namespace, r.pruned, 0 as depth
     FROM mssmt_nodes r
     WHERE r.hash_key = $1 AND r.namespace = $2
     UNION ALL
-        SELECT n.hash_key, n.l_hash_key, n.r_hash_key, n.key, n.value, n.sum, n.namespace, depth+1
+        SELECT n.hash_key, n.l_hash_key, n.r_hash_key, n.key, n.value, n.sum, n.namespace, n.pruned, depth+1
         FROM mssmt_nodes n, mssmt_branches_cte b
         WHERE n.namespace=b.namespace AND (n.hash_key=b.l_hash_key OR n.hash_key=b.r_hash_key)
     /*
@@ -104,7 +105,7 @@ AS (
     from the level after that. In the future we may use this limit to fetch
     entire subtrees too.
     */
-) SELECT hash_key, l_hash_key, r_hash_key, key, value, sum, namespace, depth FROM mssmt_branches_cte WHERE depth < 3
+) SELECT hash_key, l_hash_key, r_hash_key, key, value, sum, namespace, pruned, depth FROM mssmt_branches_cte WHERE depth < 3
 `
 
 type FetchChildrenParams struct {
@@ -120,6 +121,7 @@ type FetchChildrenRow struct {
 	Value     []byte
 	Sum       int64
 	Namespace string
+	Pruned    bool
 	Depth     int32
 }
 
@@ -140,6 +142,7 @@ func (q *Queries) FetchChildren(ctx context.Context, arg FetchChildrenParams) ([
 			&i.Value,
 			&i.Sum,
 			&i.Namespace,
+			&i.Pruned,
 			&i.Depth,
 		); err != nil {
 			return nil, err
@@ -157,16 +160,16 @@ func (q *Queries) FetchChildren(ctx context.Context, arg FetchChildrenParams) ([
 
 const fetchChildrenSelfJoin = `-- name: FetchChildrenSelfJoin :many
 WITH subtree_cte (
-    hash_key, l_hash_key, r_hash_key, key, value, sum, namespace, depth
+    hash_key, l_hash_key, r_hash_key, key, value, sum, namespace, pruned, depth
 ) AS (
-  SELECT r.hash_key, r.l_hash_key, r.r_hash_key, r.key, r.value, r.sum, r.namespace, 0 as depth
+  SELECT r.hash_key, r.l_hash_key, r.r_hash_key, r.key, r.value, r.sum, r.namespace, r.pruned, 0 as depth
   FROM mssmt_nodes r
   WHERE r.hash_key = $1 AND r.namespace = $2
   UNION ALL
-    SELECT c.hash_key, c.l_hash_key, c.r_hash_key, c.key, c.value, c.sum, c.namespace, depth+1
+    SELECT c.hash_key, c.l_hash_key, c.r_hash_key, c.key, c.value, c.sum, c.namespace, c.pruned, depth+1
     FROM mssmt_nodes c
     INNER JOIN subtree_cte r ON r.l_hash_key=c.hash_key OR r.r_hash_key=c.hash_key
-) SELECT hash_key, l_hash_key, r_hash_key, key, value, sum, namespace, depth from subtree_cte WHERE depth < 3
+) SELECT hash_key, l_hash_key, r_hash_key, key, value, sum, namespace, pruned, depth from subtree_cte WHERE depth < 3
 `
 
 type FetchChildrenSelfJoinParams struct {
@@ -182,6 +185,7 @@ type FetchChildrenSelfJoinRow struct {
 	Value     []byte
 	Sum       int64
 	Namespace string
+	Pruned    bool
 	Depth     int32
 }
 
@@ -202,6 +206,7 @@ func (q *Queries) FetchChildrenSelfJoin(ctx context.Context, arg FetchChildrenSe
 			&i.Value,
 			&i.Sum,
 			&i.Namespace,
+			&i.Pruned,
 			&i.Depth,
 		); err != nil {
 			return nil, err
@@ -218,7 +223,7 @@ func (q *Queries) FetchChildrenSelfJoin(ctx context.Context, arg FetchChildrenSe
 }
 
 const fetchRootNode = `-- name: FetchRootNode :one
-SELECT nodes.hash_key, nodes.l_hash_key, nodes.r_hash_key, nodes.key, nodes.value, nodes.sum, nodes.namespace
+SELECT nodes.hash_key, nodes.l_hash_key, nodes.r_hash_key, nodes.key, nodes.value, nodes.sum, nodes.namespace, nodes.pruned
 FROM mssmt_nodes nodes
 JOIN mssmt_roots roots
     ON roots.root_hash = nodes.hash_key AND
@@ -236,6 +241,7 @@ func (q *Queries) FetchRootNode(ctx context.Context, namespace string) (MssmtNod
 		&i.Value,
 		&i.Sum,
 		&i.Namespace,
+		&i.Pruned,
 	)
 	return i, err
 }
@@ -267,8 +273,8 @@ func (q *Queries) InsertBranch(ctx context.Context, arg InsertBranchParams) erro
 
 const insertCompactedLeaf = `-- name: InsertCompactedLeaf :exec
 INSERT INTO mssmt_nodes (
-    hash_key, l_hash_key, r_hash_key, key, value, sum, namespace
-) VALUES ($1, NULL, NULL, $2, $3, $4, $5)
+    hash_key, l_hash_key, r_hash_key, key, value, sum, namespace, pruned
+) VALUES ($1, NULL, NULL, $2, $3, $4, $5, $6)
 `
 
 type InsertCompactedLeafParams struct {
@@ -277,6 +283,7 @@ type InsertCompactedLeafParams struct {
 	Value     []byte
 	Sum       int64
 	Namespace string
+	Pruned    bool
 }
 
 func (q *Queries) InsertCompactedLeaf(ctx context.Context, arg InsertCompactedLeafParams) error {
@@ -286,14 +293,15 @@ func (q *Queries) InsertCompactedLeaf(ctx context.Context, arg InsertCompactedLe
 		arg.Value,
 		arg.Sum,
 		arg.Namespace,
+		arg.Pruned,
 	)
 	return err
 }
 
 const insertLeaf = `-- name: InsertLeaf :exec
 INSERT INTO mssmt_nodes (
-    hash_key, l_hash_key, r_hash_key, key, value, sum, namespace
-) VALUES ($1, NULL, NULL, NULL, $2, $3, $4)
+    hash_key, l_hash_key, r_hash_key, key, value, sum, namespace, pruned
+) VALUES ($1, NULL, NULL, NULL, $2, $3, $4, $5)
 `
 
 type InsertLeafParams struct {
@@ -301,6 +309,7 @@ type InsertLeafParams struct {
 	Value     []byte
 	Sum       int64
 	Namespace string
+	Pruned    bool
 }
 
 func (q *Queries) InsertLeaf(ctx context.Context, arg InsertLeafParams) error {
@@ -309,10 +318,31 @@ func (q *Queries) InsertLeaf(ctx context.Context, arg InsertLeafParams) error {
 		arg.Value,
 		arg.Sum,
 		arg.Namespace,
+		arg.Pruned,
 	)
 	return err
 }
 
+const pruneCompactedLeaf = `-- name: PruneCompactedLeaf :execrows
+UPDATE mssmt_nodes
+SET value = $1, pruned = TRUE
+WHERE key = $2 AND namespace = $3 AND pruned = FALSE
+`
+
+type PruneCompactedLeafParams struct {
+	LeafHash  []byte
+	Key       []byte
+	Namespace string
+}
+
+func (q *Queries) PruneCompactedLeaf(ctx context.Context, arg PruneCompactedLeafParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, pruneCompactedLeaf, arg.LeafHash, arg.Key, arg.Namespace)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const upsertRootNode = `-- name: UpsertRootNode :exec
 INSERT INTO mssmt_roots (
     root_hash, namespace
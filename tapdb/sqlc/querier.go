@@ -22,14 +22,21 @@ type Querier interface {
 	BindMintingBatchWithTx(ctx context.Context, arg BindMintingBatchWithTxParams) error
 	ConfirmChainAnchorTx(ctx context.Context, arg ConfirmChainAnchorTxParams) error
 	ConfirmChainTx(ctx context.Context, arg ConfirmChainTxParams) error
+	CountRevokedAddrsByScriptKey(ctx context.Context, tweakedScriptKey []byte) (int64, error)
 	DeleteAllNodes(ctx context.Context, namespace string) (int64, error)
+	DeleteAssetLabel(ctx context.Context, assetID []byte) error
 	DeleteAssetWitnesses(ctx context.Context, assetID int64) error
+	DeleteExpiredMailboxProofs(ctx context.Context, now time.Time) (int64, error)
 	DeleteExpiredUTXOLeases(ctx context.Context, now sql.NullTime) error
+	DeleteLazyRoot(ctx context.Context, namespace string) error
 	DeleteManagedUTXO(ctx context.Context, outpoint []byte) error
 	DeleteNode(ctx context.Context, arg DeleteNodeParams) (int64, error)
+	DeletePendingPush(ctx context.Context, id int64) error
 	DeleteRoot(ctx context.Context, namespace string) (int64, error)
+	DeleteSyncCheckpoint(ctx context.Context, arg DeleteSyncCheckpointParams) error
 	DeleteUTXOLease(ctx context.Context, outpoint []byte) error
 	DeleteUniverseEvents(ctx context.Context, namespaceRoot string) error
+	DeleteUniverseLeaf(ctx context.Context, arg DeleteUniverseLeafParams) error
 	DeleteUniverseLeaves(ctx context.Context, namespace string) error
 	DeleteUniverseRoot(ctx context.Context, namespaceRoot string) error
 	DeleteUniverseServer(ctx context.Context, arg DeleteUniverseServerParams) error
@@ -37,10 +44,12 @@ type Querier interface {
 	FetchAddrEvent(ctx context.Context, id int64) (FetchAddrEventRow, error)
 	FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]FetchAddrsRow, error)
 	FetchAllNodes(ctx context.Context) ([]MssmtNode, error)
+	FetchAssetLabel(ctx context.Context, assetID []byte) (string, error)
 	FetchAssetMeta(ctx context.Context, metaID int64) (FetchAssetMetaRow, error)
 	FetchAssetMetaByHash(ctx context.Context, metaDataHash []byte) (FetchAssetMetaByHashRow, error)
 	FetchAssetMetaForAsset(ctx context.Context, assetID []byte) (FetchAssetMetaForAssetRow, error)
 	FetchAssetProof(ctx context.Context, tweakedScriptKey []byte) (FetchAssetProofRow, error)
+	FetchAssetProofCourierAddr(ctx context.Context, assetID []byte) (string, error)
 	FetchAssetProofs(ctx context.Context) ([]FetchAssetProofsRow, error)
 	FetchAssetProofsByAssetID(ctx context.Context, assetID []byte) ([]FetchAssetProofsByAssetIDRow, error)
 	FetchAssetWitnesses(ctx context.Context, assetID sql.NullInt64) ([]FetchAssetWitnessesRow, error)
@@ -61,20 +70,29 @@ type Querier interface {
 	// Sort and limit to return the genesis ID for initial genesis of the group.
 	FetchGroupByGroupKey(ctx context.Context, groupKey []byte) (FetchGroupByGroupKeyRow, error)
 	FetchGroupedAssets(ctx context.Context) ([]FetchGroupedAssetsRow, error)
+	FetchLazyRoot(ctx context.Context, namespace string) (UniverseLazyRoot, error)
 	FetchManagedUTXO(ctx context.Context, arg FetchManagedUTXOParams) (FetchManagedUTXORow, error)
 	FetchManagedUTXOs(ctx context.Context) ([]FetchManagedUTXOsRow, error)
 	FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMintingBatchRow, error)
+	FetchMintingBatchAnchor(ctx context.Context, rawKey []byte) (FetchMintingBatchAnchorRow, error)
 	FetchMintingBatchesByInverseState(ctx context.Context, batchState int16) ([]FetchMintingBatchesByInverseStateRow, error)
+	FetchPrunedUniverseKeys(ctx context.Context, namespace string) ([]FetchPrunedUniverseKeysRow, error)
 	FetchRootNode(ctx context.Context, namespace string) (MssmtNode, error)
 	FetchScriptKeyByTweakedKey(ctx context.Context, tweakedScriptKey []byte) (FetchScriptKeyByTweakedKeyRow, error)
 	FetchScriptKeyIDByTweakedKey(ctx context.Context, tweakedScriptKey []byte) (int64, error)
 	FetchSeedlingByID(ctx context.Context, seedlingID int64) (AssetSeedling, error)
+	FetchSeedlingEmissionEvents(ctx context.Context, seedlingID int64) ([]FetchSeedlingEmissionEventsRow, error)
 	FetchSeedlingID(ctx context.Context, arg FetchSeedlingIDParams) (int64, error)
 	FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]FetchSeedlingsForBatchRow, error)
 	FetchTransferInputs(ctx context.Context, transferID int64) ([]FetchTransferInputsRow, error)
 	FetchTransferOutputs(ctx context.Context, transferID int64) ([]FetchTransferOutputsRow, error)
 	FetchUniverseKeys(ctx context.Context, namespace string) ([]FetchUniverseKeysRow, error)
+	FetchUniverseKeysSince(ctx context.Context, arg FetchUniverseKeysSinceParams) ([]FetchUniverseKeysSinceRow, error)
+	FetchUniverseLeafNodes(ctx context.Context, namespace string) ([]FetchUniverseLeafNodesRow, error)
 	FetchUniverseRoot(ctx context.Context, namespace string) (FetchUniverseRootRow, error)
+	FetchUniverseRootAtHeight(ctx context.Context, arg FetchUniverseRootAtHeightParams) (FetchUniverseRootAtHeightRow, error)
+	FetchUniverseRootHistoryID(ctx context.Context, arg FetchUniverseRootHistoryIDParams) (int64, error)
+	FetchUniverseRootLastHeight(ctx context.Context, namespace string) (int32, error)
 	GenesisAssets(ctx context.Context) ([]GenesisAsset, error)
 	GenesisPoints(ctx context.Context) ([]GenesisPoint, error)
 	GetRootKey(ctx context.Context, id []byte) (Macaroon, error)
@@ -85,19 +103,27 @@ type Querier interface {
 	InsertAssetTransferInput(ctx context.Context, arg InsertAssetTransferInputParams) error
 	InsertAssetTransferOutput(ctx context.Context, arg InsertAssetTransferOutputParams) error
 	InsertAssetWitness(ctx context.Context, arg InsertAssetWitnessParams) error
+	InsertBatchAssetLabels(ctx context.Context, arg InsertBatchAssetLabelsParams) error
+	InsertBatchAssetProofCourierAddrs(ctx context.Context, arg InsertBatchAssetProofCourierAddrsParams) error
 	InsertBranch(ctx context.Context, arg InsertBranchParams) error
 	InsertCompactedLeaf(ctx context.Context, arg InsertCompactedLeafParams) error
+	InsertEmissionEvent(ctx context.Context, arg InsertEmissionEventParams) error
 	InsertLeaf(ctx context.Context, arg InsertLeafParams) error
 	InsertNewAsset(ctx context.Context, arg InsertNewAssetParams) (int64, error)
 	InsertNewProofEvent(ctx context.Context, arg InsertNewProofEventParams) error
+	InsertNewPushEvent(ctx context.Context, arg InsertNewPushEventParams) error
 	InsertNewSyncEvent(ctx context.Context, arg InsertNewSyncEventParams) error
 	InsertPassiveAsset(ctx context.Context, arg InsertPassiveAssetParams) error
+	InsertPendingPush(ctx context.Context, arg InsertPendingPushParams) error
 	InsertReceiverProofTransferAttempt(ctx context.Context, arg InsertReceiverProofTransferAttemptParams) error
 	InsertRootKey(ctx context.Context, arg InsertRootKeyParams) error
+	InsertUniverseRootHistory(ctx context.Context, arg InsertUniverseRootHistoryParams) error
 	InsertUniverseServer(ctx context.Context, arg InsertUniverseServerParams) error
 	ListUniverseServers(ctx context.Context) ([]UniverseServer, error)
 	LogServerSync(ctx context.Context, arg LogServerSyncParams) error
+	MarkEmissionEventIssued(ctx context.Context, eventID int64) error
 	NewMintingBatch(ctx context.Context, arg NewMintingBatchParams) error
+	PruneCompactedLeaf(ctx context.Context, arg PruneCompactedLeafParams) (int64, error)
 	// We use a LEFT JOIN here as not every asset has a group key, so this'll
 	// generate rows that have NULL values for the group key fields if an asset
 	// doesn't have a group key. See the comment in fetchAssetSprouts for a work
@@ -115,6 +141,8 @@ type Querier interface {
 	// generate rows that have NULL values for the group key fields if an asset
 	// doesn't have a group key. See the comment in fetchAssetSprouts for a work
 	// around that needs to be used with this query until a sqlc bug is fixed.
+	// We use a LEFT JOIN here as not every asset has a label. The label pattern is
+	// matched case-insensitively, so it must be lower case.
 	// This clause is used to select specific assets for a asset ID, general
 	// channel balances, and also coin selection. We use the sqlc.narg feature to
 	// make the entire statement evaluate to true, if none of these extra args are
@@ -123,24 +151,43 @@ type Querier interface {
 	QueryEventIDs(ctx context.Context, arg QueryEventIDsParams) ([]QueryEventIDsRow, error)
 	QueryFederationGlobalSyncConfigs(ctx context.Context) ([]FederationGlobalSyncConfig, error)
 	QueryFederationUniSyncConfigs(ctx context.Context) ([]FederationUniSyncConfig, error)
+	QueryInternalKeys(ctx context.Context, keyFamily sql.NullInt32) ([]InternalKey, error)
+	QueryLazyRoots(ctx context.Context) ([]UniverseLazyRoot, error)
+	QueryMailboxProofs(ctx context.Context, arg QueryMailboxProofsParams) ([]QueryMailboxProofsRow, error)
 	QueryPassiveAssets(ctx context.Context, transferID int64) ([]QueryPassiveAssetsRow, error)
+	// Once the batch of the seedling has been committed, the minted asset, and
+	// with it the group key the additional units are issued into, is known.
+	QueryPendingEmissionEvents(ctx context.Context, groupKey []byte) ([]QueryPendingEmissionEventsRow, error)
+	QueryPendingPushes(ctx context.Context) ([]QueryPendingPushesRow, error)
 	QueryReceiverProofTransferAttempt(ctx context.Context, proofLocatorHash []byte) ([]time.Time, error)
+	QuerySyncCheckpoint(ctx context.Context, arg QuerySyncCheckpointParams) ([]string, error)
 	// TODO(roasbeef): use the universe id instead for the grouping? so namespace
 	// root, simplifies queries
 	QueryUniverseAssetStats(ctx context.Context, arg QueryUniverseAssetStatsParams) ([]QueryUniverseAssetStatsRow, error)
 	QueryUniverseLeaves(ctx context.Context, arg QueryUniverseLeavesParams) ([]QueryUniverseLeavesRow, error)
 	QueryUniverseStats(ctx context.Context) (QueryUniverseStatsRow, error)
 	ReAnchorPassiveAssets(ctx context.Context, arg ReAnchorPassiveAssetsParams) error
+	RevokeAddr(ctx context.Context, arg RevokeAddrParams) error
+	// The name pattern is matched case-insensitively, so it must be lower case.
+	// The roots are sorted by name and namespace, so the results can be paged
+	// through reliably.
+	SearchUniverseRoots(ctx context.Context, arg SearchUniverseRootsParams) ([]SearchUniverseRootsRow, error)
 	SetAddrManaged(ctx context.Context, arg SetAddrManagedParams) error
 	SetAssetSpent(ctx context.Context, arg SetAssetSpentParams) (int64, error)
 	UniverseLeaves(ctx context.Context) ([]UniverseLeafe, error)
-	UniverseRoots(ctx context.Context) ([]UniverseRootsRow, error)
+	// An optional ID prefix is applied as a byte range over the group key for
+	// grouped assets, or the asset ID otherwise, so the indexes on both columns
+	// can be used to only walk the matching roots.
+	UniverseRoots(ctx context.Context, arg UniverseRootsParams) ([]UniverseRootsRow, error)
 	UpdateBatchGenesisTx(ctx context.Context, arg UpdateBatchGenesisTxParams) error
 	UpdateMintingBatchState(ctx context.Context, arg UpdateMintingBatchStateParams) error
+	UpdatePendingPush(ctx context.Context, arg UpdatePendingPushParams) error
 	UpdateUTXOLease(ctx context.Context, arg UpdateUTXOLeaseParams) error
+	UpdateUniverseServerPriority(ctx context.Context, arg UpdateUniverseServerPriorityParams) (int64, error)
 	UpsertAddrEvent(ctx context.Context, arg UpsertAddrEventParams) (int64, error)
 	UpsertAssetGroupKey(ctx context.Context, arg UpsertAssetGroupKeyParams) (int64, error)
 	UpsertAssetGroupWitness(ctx context.Context, arg UpsertAssetGroupWitnessParams) (int64, error)
+	UpsertAssetLabel(ctx context.Context, arg UpsertAssetLabelParams) error
 	UpsertAssetMeta(ctx context.Context, arg UpsertAssetMetaParams) (int64, error)
 	UpsertAssetProof(ctx context.Context, arg UpsertAssetProofParams) error
 	UpsertChainTx(ctx context.Context, arg UpsertChainTxParams) (int64, error)
@@ -149,9 +196,12 @@ type Querier interface {
 	UpsertGenesisAsset(ctx context.Context, arg UpsertGenesisAssetParams) (int64, error)
 	UpsertGenesisPoint(ctx context.Context, prevOut []byte) (int64, error)
 	UpsertInternalKey(ctx context.Context, arg UpsertInternalKeyParams) (int64, error)
+	UpsertLazyRoot(ctx context.Context, arg UpsertLazyRootParams) error
+	UpsertMailboxProof(ctx context.Context, arg UpsertMailboxProofParams) error
 	UpsertManagedUTXO(ctx context.Context, arg UpsertManagedUTXOParams) (int64, error)
 	UpsertRootNode(ctx context.Context, arg UpsertRootNodeParams) error
 	UpsertScriptKey(ctx context.Context, arg UpsertScriptKeyParams) (int64, error)
+	UpsertSyncCheckpoint(ctx context.Context, arg UpsertSyncCheckpointParams) error
 	UpsertUniverseLeaf(ctx context.Context, arg UpsertUniverseLeafParams) error
 	UpsertUniverseRoot(ctx context.Context, arg UpsertUniverseRootParams) (int64, error)
 }
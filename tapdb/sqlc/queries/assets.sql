@@ -10,8 +10,8 @@ RETURNING key_id;
 
 -- name: NewMintingBatch :exec
 INSERT INTO asset_minting_batches (
-    batch_state, batch_id, height_hint, creation_time_unix
-) VALUES (0, $1, $2, $3);
+    batch_state, batch_id, height_hint, creation_time_unix, batch_name
+) VALUES (0, $1, $2, $3, $4);
 
 -- name: FetchMintingBatchesByInverseState :many
 SELECT *
@@ -57,10 +57,13 @@ WHERE batch_id in (SELECT batch_id FROM target_batch);
 -- name: InsertAssetSeedling :exec
 INSERT INTO asset_seedlings (
     asset_name, asset_type, asset_version, asset_supply, asset_meta_id,
-    emission_enabled, batch_id, group_genesis_id, group_anchor_id
+    emission_enabled, batch_id, group_genesis_id, group_anchor_id,
+    script_key_id, group_internal_key_id, label, proof_courier_addr
 ) VALUES (
    $1, $2, $3, $4, $5, $6, $7,
-   sqlc.narg('group_genesis_id'), sqlc.narg('group_anchor_id')
+   sqlc.narg('group_genesis_id'), sqlc.narg('group_anchor_id'),
+   sqlc.narg('script_key_id'), sqlc.narg('group_internal_key_id'),
+   sqlc.narg('label'), sqlc.narg('proof_courier_addr')
 );
 
 -- name: FetchSeedlingID :one
@@ -89,6 +92,13 @@ WHERE seedling_id = @seedling_id;
 SELECT * 
 FROM internal_keys;
 
+-- name: QueryInternalKeys :many
+SELECT *
+FROM internal_keys
+WHERE (key_family = sqlc.narg('key_family') OR
+    sqlc.narg('key_family') IS NULL)
+ORDER BY key_family, key_index;
+
 -- name: AllMintingBatches :many
 SELECT * 
 FROM asset_minting_batches
@@ -108,11 +118,14 @@ WITH target_key_id AS (
 )
 INSERT INTO asset_seedlings(
     asset_name, asset_type, asset_version, asset_supply, asset_meta_id,
-    emission_enabled, batch_id, group_genesis_id, group_anchor_id
+    emission_enabled, batch_id, group_genesis_id, group_anchor_id,
+    script_key_id, group_internal_key_id, label, proof_courier_addr
 ) VALUES (
     $2, $3, $4, $5, $6, $7,
     (SELECT key_id FROM target_key_id),
-    sqlc.narg('group_genesis_id'), sqlc.narg('group_anchor_id')
+    sqlc.narg('group_genesis_id'), sqlc.narg('group_anchor_id'),
+    sqlc.narg('script_key_id'), sqlc.narg('group_internal_key_id'),
+    sqlc.narg('label'), sqlc.narg('proof_courier_addr')
 );
 
 -- name: FetchSeedlingsForBatch :many
@@ -126,10 +139,25 @@ WITH target_batch(batch_id) AS (
 SELECT seedling_id, asset_name, asset_type, asset_version, asset_supply, 
     assets_meta.meta_data_hash, assets_meta.meta_data_type, 
     assets_meta.meta_data_blob, emission_enabled, batch_id, 
-    group_genesis_id, group_anchor_id
+    group_genesis_id, group_anchor_id,
+    script_keys.tweak AS script_key_tweak,
+    script_keys.tweaked_script_key,
+    script_internal_keys.raw_key AS script_key_raw,
+    script_internal_keys.key_family AS script_key_fam,
+    script_internal_keys.key_index AS script_key_index,
+    group_internal_keys.raw_key AS group_key_raw,
+    group_internal_keys.key_family AS group_key_fam,
+    group_internal_keys.key_index AS group_key_index,
+    asset_seedlings.label, asset_seedlings.proof_courier_addr
 FROM asset_seedlings 
 LEFT JOIN assets_meta
     ON asset_seedlings.asset_meta_id = assets_meta.meta_id
+LEFT JOIN script_keys
+    ON asset_seedlings.script_key_id = script_keys.script_key_id
+LEFT JOIN internal_keys script_internal_keys
+    ON script_keys.internal_key_id = script_internal_keys.key_id
+LEFT JOIN internal_keys group_internal_keys
+    ON asset_seedlings.group_internal_key_id = group_internal_keys.key_id
 WHERE asset_seedlings.batch_id in (SELECT batch_id FROM target_batch);
 
 -- name: UpsertGenesisPoint :one
@@ -277,6 +305,7 @@ RETURNING assets.asset_id;
 -- name: QueryAssetBalancesByAsset :many
 SELECT
     genesis_info_view.asset_id, version, SUM(amount) balance,
+    COUNT(DISTINCT assets.anchor_utxo_id) num_utxos,
     genesis_info_view.asset_tag, genesis_info_view.meta_hash,
     genesis_info_view.asset_type, genesis_info_view.output_index,
     genesis_info_view.prev_out AS genesis_point
@@ -299,7 +328,8 @@ GROUP BY assets.genesis_id, genesis_info_view.asset_id,
 
 -- name: QueryAssetBalancesByGroup :many
 SELECT
-    key_group_info_view.tweaked_group_key, SUM(amount) balance
+    key_group_info_view.tweaked_group_key, SUM(amount) balance,
+    COUNT(DISTINCT assets.anchor_utxo_id) num_utxos
 FROM assets
 JOIN key_group_info_view
     ON assets.genesis_id = key_group_info_view.gen_asset_id AND
@@ -386,7 +416,8 @@ SELECT
     utxos.lease_owner AS anchor_lease_owner,
     utxos.lease_expiry AS anchor_lease_expiry,
     utxo_internal_keys.raw_key AS anchor_internal_key,
-    split_commitment_root_hash, split_commitment_root_value
+    split_commitment_root_hash, split_commitment_root_value,
+    asset_labels.label AS asset_label
 FROM assets
 JOIN genesis_info_view
     ON assets.genesis_id = genesis_info_view.gen_asset_id AND
@@ -422,6 +453,10 @@ JOIN internal_keys utxo_internal_keys
 JOIN chain_txns txns
     ON utxos.txn_id = txns.txn_id AND
       COALESCE(txns.block_height, 0) >= COALESCE(sqlc.narg('min_anchor_height'), txns.block_height, 0)
+-- We use a LEFT JOIN here as not every asset has a label. The label pattern is
+-- matched case-insensitively, so it must be lower case.
+LEFT JOIN asset_labels
+    ON assets.genesis_id = asset_labels.gen_asset_id
 -- This clause is used to select specific assets for a asset ID, general
 -- channel balances, and also coin selection. We use the sqlc.narg feature to
 -- make the entire statement evaluate to true, if none of these extra args are
@@ -430,7 +465,10 @@ WHERE (
     assets.amount >= COALESCE(sqlc.narg('min_amt'), assets.amount) AND
     assets.spent = COALESCE(sqlc.narg('spent'), assets.spent) AND
     (key_group_info_view.tweaked_group_key = sqlc.narg('key_group_filter') OR
-      sqlc.narg('key_group_filter') IS NULL)
+      sqlc.narg('key_group_filter') IS NULL) AND
+    (LOWER(asset_labels.label) LIKE CAST(sqlc.narg('label_pattern') AS TEXT)
+        ESCAPE '\' OR
+      sqlc.narg('label_pattern') IS NULL)
 );
 
 -- name: AllAssets :many
@@ -623,6 +661,21 @@ UPDATE chain_txns
 SET block_height = $2, block_hash = $3, tx_index = $4
 WHERE txn_id in (SELECT txn_id FROM target_txn);
 
+-- name: FetchMintingBatchAnchor :one
+SELECT
+    txns.raw_tx, txns.block_height, txns.block_hash, txns.tx_index,
+    utxos.outpoint anchor_outpoint
+FROM asset_minting_batches batches
+JOIN internal_keys keys
+    ON batches.batch_id = keys.key_id
+JOIN genesis_points points
+    ON batches.genesis_id = points.genesis_id
+JOIN chain_txns txns
+    ON points.anchor_tx_id = txns.txn_id
+JOIN managed_utxos utxos
+    ON utxos.txn_id = txns.txn_id
+WHERE keys.raw_key = $1;
+
 -- name: UpsertAssetProof :exec
 WITH target_asset(asset_id) AS (
     SELECT asset_id
@@ -790,3 +843,67 @@ FROM genesis_assets assets
 JOIN assets_meta
     ON assets.meta_data_id = assets_meta.meta_id
 WHERE assets.asset_id = $1;
+
+-- name: InsertBatchAssetLabels :exec
+WITH target_batch(batch_id) AS (
+    SELECT key_id
+    FROM internal_keys keys
+    WHERE keys.raw_key = @batch_key
+)
+INSERT INTO asset_labels (gen_asset_id, label)
+SELECT genesis_assets.gen_asset_id, asset_seedlings.label
+FROM asset_seedlings
+JOIN genesis_assets
+    ON genesis_assets.asset_tag = asset_seedlings.asset_name AND
+       genesis_assets.genesis_point_id = @genesis_point_id
+WHERE asset_seedlings.batch_id IN (SELECT batch_id FROM target_batch) AND
+    asset_seedlings.label IS NOT NULL
+ON CONFLICT (gen_asset_id)
+    DO UPDATE SET label = EXCLUDED.label;
+
+-- name: UpsertAssetLabel :exec
+INSERT INTO asset_labels (gen_asset_id, label)
+SELECT gen_asset_id, @label
+FROM genesis_assets
+WHERE genesis_assets.asset_id = @asset_id
+ON CONFLICT (gen_asset_id)
+    DO UPDATE SET label = EXCLUDED.label;
+
+-- name: DeleteAssetLabel :exec
+DELETE FROM asset_labels
+WHERE gen_asset_id IN (
+    SELECT gen_asset_id
+    FROM genesis_assets
+    WHERE genesis_assets.asset_id = @asset_id
+);
+
+-- name: FetchAssetLabel :one
+SELECT asset_labels.label
+FROM asset_labels
+JOIN genesis_assets
+    ON asset_labels.gen_asset_id = genesis_assets.gen_asset_id
+WHERE genesis_assets.asset_id = @asset_id;
+
+-- name: InsertBatchAssetProofCourierAddrs :exec
+WITH target_batch(batch_id) AS (
+    SELECT key_id
+    FROM internal_keys keys
+    WHERE keys.raw_key = @batch_key
+)
+INSERT INTO asset_proof_courier_addrs (gen_asset_id, proof_courier_addr)
+SELECT genesis_assets.gen_asset_id, asset_seedlings.proof_courier_addr
+FROM asset_seedlings
+JOIN genesis_assets
+    ON genesis_assets.asset_tag = asset_seedlings.asset_name AND
+       genesis_assets.genesis_point_id = @genesis_point_id
+WHERE asset_seedlings.batch_id IN (SELECT batch_id FROM target_batch) AND
+    asset_seedlings.proof_courier_addr IS NOT NULL
+ON CONFLICT (gen_asset_id)
+    DO UPDATE SET proof_courier_addr = EXCLUDED.proof_courier_addr;
+
+-- name: FetchAssetProofCourierAddr :one
+SELECT asset_proof_courier_addrs.proof_courier_addr
+FROM asset_proof_courier_addrs
+JOIN genesis_assets
+    ON asset_proof_courier_addrs.gen_asset_id = genesis_assets.gen_asset_id
+WHERE genesis_assets.asset_id = @asset_id;
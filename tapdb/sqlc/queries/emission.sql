@@ -0,0 +1,40 @@
+-- name: InsertEmissionEvent :exec
+INSERT INTO asset_emission_events (
+    seedling_id, block_height, amount
+) VALUES (
+    @seedling_id, @block_height, @amount
+);
+
+-- name: FetchSeedlingEmissionEvents :many
+SELECT block_height, amount
+FROM asset_emission_events
+WHERE seedling_id = @seedling_id
+ORDER BY block_height;
+
+-- name: QueryPendingEmissionEvents :many
+SELECT events.event_id, events.block_height, events.amount,
+    seedlings.asset_name, seedlings.asset_version, batches.batch_state,
+    asset_groups.tweaked_group_key AS group_key
+FROM asset_emission_events events
+JOIN asset_seedlings seedlings
+    ON events.seedling_id = seedlings.seedling_id
+JOIN asset_minting_batches batches
+    ON seedlings.batch_id = batches.batch_id
+-- Once the batch of the seedling has been committed, the minted asset, and
+-- with it the group key the additional units are issued into, is known.
+LEFT JOIN genesis_assets
+    ON genesis_assets.genesis_point_id = batches.genesis_id AND
+        genesis_assets.asset_tag = seedlings.asset_name
+LEFT JOIN asset_group_witnesses
+    ON asset_group_witnesses.gen_asset_id = genesis_assets.gen_asset_id
+LEFT JOIN asset_groups
+    ON asset_groups.group_id = asset_group_witnesses.group_key_id
+WHERE events.issued = FALSE AND
+    (asset_groups.tweaked_group_key = sqlc.narg('group_key') OR
+        sqlc.narg('group_key') IS NULL)
+ORDER BY events.block_height, events.event_id;
+
+-- name: MarkEmissionEventIssued :exec
+UPDATE asset_emission_events
+SET issued = TRUE
+WHERE event_id = @event_id;
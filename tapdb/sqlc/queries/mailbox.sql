@@ -0,0 +1,21 @@
+-- name: UpsertMailboxProof :exec
+INSERT INTO proof_mailbox (
+    script_key, proof_file_hash, proof_file, upload_time, expiry_time
+) VALUES (
+    @script_key, @proof_file_hash, @proof_file, @upload_time, @expiry_time
+)
+ON CONFLICT (script_key, proof_file_hash)
+    -- If the same proof is uploaded again, we only extend its lifetime.
+    DO UPDATE SET upload_time = EXCLUDED.upload_time,
+        expiry_time = EXCLUDED.expiry_time;
+
+-- name: QueryMailboxProofs :many
+SELECT script_key, proof_file, upload_time, expiry_time
+FROM proof_mailbox
+WHERE script_key = @script_key
+    AND expiry_time > @now
+ORDER BY upload_time, id;
+
+-- name: DeleteExpiredMailboxProofs :execrows
+DELETE FROM proof_mailbox
+WHERE expiry_time <= @now;
@@ -5,24 +5,29 @@ INSERT INTO mssmt_nodes (
 
 -- name: InsertLeaf :exec
 INSERT INTO mssmt_nodes (
-    hash_key, l_hash_key, r_hash_key, key, value, sum, namespace
-) VALUES ($1, NULL, NULL, NULL, $2, $3, $4);
+    hash_key, l_hash_key, r_hash_key, key, value, sum, namespace, pruned
+) VALUES ($1, NULL, NULL, NULL, $2, $3, $4, $5);
 
 -- name: InsertCompactedLeaf :exec
 INSERT INTO mssmt_nodes (
-    hash_key, l_hash_key, r_hash_key, key, value, sum, namespace
-) VALUES ($1, NULL, NULL, $2, $3, $4, $5);
+    hash_key, l_hash_key, r_hash_key, key, value, sum, namespace, pruned
+) VALUES ($1, NULL, NULL, $2, $3, $4, $5, $6);
+
+-- name: PruneCompactedLeaf :execrows
+UPDATE mssmt_nodes
+SET value = @leaf_hash, pruned = TRUE
+WHERE key = @key AND namespace = @namespace AND pruned = FALSE;
 
 -- name: FetchChildren :many
 WITH RECURSIVE mssmt_branches_cte (
-    hash_key, l_hash_key, r_hash_key, key, value, sum, namespace, depth
+    hash_key, l_hash_key, r_hash_key, key, value, sum, namespace, pruned, depth
 )
 AS (
-    SELECT r.hash_key, r.l_hash_key, r.r_hash_key, r.key, r.value, r.sum, r.namespace, 0 as depth
+    SELECT r.hash_key, r.l_hash_key, r.r_hash_key, r.key, r.value, r.sum, r.namespace, r.pruned, 0 as depth
     FROM mssmt_nodes r
     WHERE r.hash_key = $1 AND r.namespace = $2
     UNION ALL
-        SELECT n.hash_key, n.l_hash_key, n.r_hash_key, n.key, n.value, n.sum, n.namespace, depth+1
+        SELECT n.hash_key, n.l_hash_key, n.r_hash_key, n.key, n.value, n.sum, n.namespace, n.pruned, depth+1
         FROM mssmt_nodes n, mssmt_branches_cte b
         WHERE n.namespace=b.namespace AND (n.hash_key=b.l_hash_key OR n.hash_key=b.r_hash_key)
     /*
@@ -37,13 +42,13 @@ AS (
 
 -- name: FetchChildrenSelfJoin :many
 WITH subtree_cte (
-    hash_key, l_hash_key, r_hash_key, key, value, sum, namespace, depth
+    hash_key, l_hash_key, r_hash_key, key, value, sum, namespace, pruned, depth
 ) AS (
-  SELECT r.hash_key, r.l_hash_key, r.r_hash_key, r.key, r.value, r.sum, r.namespace, 0 as depth
+  SELECT r.hash_key, r.l_hash_key, r.r_hash_key, r.key, r.value, r.sum, r.namespace, r.pruned, 0 as depth
   FROM mssmt_nodes r
   WHERE r.hash_key = $1 AND r.namespace = $2
   UNION ALL
-    SELECT c.hash_key, c.l_hash_key, c.r_hash_key, c.key, c.value, c.sum, c.namespace, depth+1
+    SELECT c.hash_key, c.l_hash_key, c.r_hash_key, c.key, c.value, c.sum, c.namespace, c.pruned, depth+1
     FROM mssmt_nodes c
     INNER JOIN subtree_cte r ON r.l_hash_key=c.hash_key OR r.r_hash_key=c.hash_key
 ) SELECT * from subtree_cte WHERE depth < 3;
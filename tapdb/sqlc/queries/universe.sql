@@ -14,13 +14,15 @@ WHERE mssmt_nodes.namespace = @namespace;
 
 -- name: UpsertUniverseRoot :one
 INSERT INTO universe_roots (
-    namespace_root, asset_id, group_key, proof_type
+    namespace_root, asset_id, group_key, proof_type, asset_type
 ) VALUES (
-    @namespace_root, @asset_id, @group_key, @proof_type
+    @namespace_root, @asset_id, @group_key, @proof_type, @asset_type
 ) ON CONFLICT (namespace_root)
-    -- This is a NOP, namespace_root is the unique field that caused the
-    -- conflict.
-    DO UPDATE SET namespace_root = EXCLUDED.namespace_root
+    -- The namespace_root is the unique field that caused the conflict, so we
+    -- only fill in the asset type in case it wasn't known before.
+    DO UPDATE SET asset_type = COALESCE(
+        universe_roots.asset_type, EXCLUDED.asset_type
+    )
 RETURNING id;
 
 -- name: DeleteUniverseEvents :exec
@@ -39,23 +41,29 @@ WHERE namespace_root = @namespace_root;
 -- name: UpsertUniverseLeaf :exec
 INSERT INTO universe_leaves (
     asset_genesis_id, script_key_bytes, universe_root_id, leaf_node_key, 
-    leaf_node_namespace, minting_point
+    leaf_node_namespace, minting_point, source_priority
 ) VALUES (
     @asset_genesis_id, @script_key_bytes, @universe_root_id, @leaf_node_key,
-    @leaf_node_namespace, @minting_point
+    @leaf_node_namespace, @minting_point, @source_priority
 ) ON CONFLICT (minting_point, script_key_bytes)
     -- This is a NOP, minting_point and script_key_bytes are the unique fields
     -- that caused the conflict.
     DO UPDATE SET minting_point = EXCLUDED.minting_point,
-                  script_key_bytes = EXCLUDED.script_key_bytes;
+                  script_key_bytes = EXCLUDED.script_key_bytes,
+                  source_priority = EXCLUDED.source_priority;
 
 -- name: DeleteUniverseLeaves :exec
 DELETE FROM universe_leaves
 WHERE leaf_node_namespace = @namespace;
 
+-- name: DeleteUniverseLeaf :exec
+DELETE FROM universe_leaves
+WHERE leaf_node_namespace = @namespace AND leaf_node_key = @leaf_node_key;
+
 -- name: QueryUniverseLeaves :many
 SELECT leaves.script_key_bytes, gen.gen_asset_id, nodes.value genesis_proof, 
-       nodes.sum sum_amt, gen.asset_id
+       nodes.sum sum_amt, gen.asset_id, leaves.source_priority,
+       nodes.pruned
 FROM universe_leaves leaves
 JOIN mssmt_nodes nodes
     ON leaves.leaf_node_key = nodes.key AND
@@ -75,13 +83,134 @@ SELECT leaves.minting_point, leaves.script_key_bytes
 FROM universe_leaves leaves
 WHERE leaves.leaf_node_namespace = @namespace;
 
+-- name: FetchUniverseLeafNodes :many
+SELECT leaves.leaf_node_key, nodes.value leaf_value, nodes.sum leaf_sum,
+       nodes.pruned leaf_pruned
+FROM universe_leaves leaves
+JOIN mssmt_nodes nodes
+    ON leaves.leaf_node_key = nodes.key AND
+        leaves.leaf_node_namespace = nodes.namespace
+WHERE leaves.leaf_node_namespace = @namespace;
+
+-- name: FetchPrunedUniverseKeys :many
+SELECT leaves.minting_point, leaves.script_key_bytes
+FROM universe_leaves leaves
+JOIN mssmt_nodes nodes
+    ON leaves.leaf_node_key = nodes.key AND
+        leaves.leaf_node_namespace = nodes.namespace
+WHERE leaves.leaf_node_namespace = @namespace AND nodes.pruned = TRUE;
+
 -- name: UniverseLeaves :many
 SELECT * FROM universe_leaves;
 
+-- name: InsertUniverseRootHistory :exec
+INSERT INTO universe_root_history (
+    universe_root_id, block_height, root_hash, root_sum, leaf_node_key,
+    insertion_timestamp
+) VALUES (
+    @universe_root_id, @block_height, @root_hash, @root_sum, @leaf_node_key,
+    @insertion_timestamp
+);
+
+-- name: FetchUniverseRootHistoryID :one
+SELECT history.id
+FROM universe_root_history history
+JOIN universe_roots
+    ON history.universe_root_id = universe_roots.id
+WHERE universe_roots.namespace_root = @namespace AND
+    history.root_hash = @root_hash AND
+    history.leaf_node_key IS NOT NULL
+ORDER BY history.id DESC
+LIMIT 1;
+
+-- name: FetchUniverseKeysSince :many
+SELECT DISTINCT leaves.minting_point, leaves.script_key_bytes
+FROM universe_root_history history
+JOIN universe_roots
+    ON history.universe_root_id = universe_roots.id
+JOIN universe_leaves leaves
+    ON leaves.leaf_node_namespace = universe_roots.namespace_root AND
+       leaves.leaf_node_key = history.leaf_node_key
+WHERE universe_roots.namespace_root = @namespace AND
+    history.id > @history_id;
+
+-- name: FetchUniverseRootAtHeight :one
+SELECT history.root_hash, history.root_sum,
+       genesis_assets.asset_tag asset_name
+FROM universe_root_history history
+JOIN universe_roots
+    ON history.universe_root_id = universe_roots.id
+JOIN genesis_assets
+    ON genesis_assets.asset_id = universe_roots.asset_id
+WHERE universe_roots.namespace_root = @namespace AND
+    history.block_height <= @block_height
+ORDER BY history.block_height DESC, history.id DESC
+LIMIT 1;
+
+-- name: FetchUniverseRootLastHeight :one
+SELECT history.block_height
+FROM universe_root_history history
+JOIN universe_roots
+    ON history.universe_root_id = universe_roots.id
+WHERE universe_roots.namespace_root = @namespace
+ORDER BY history.block_height DESC
+LIMIT 1;
+
 -- name: UniverseRoots :many
 SELECT universe_roots.asset_id, group_key, proof_type,
-       mssmt_roots.root_hash root_hash, mssmt_nodes.sum root_sum,
-       genesis_assets.asset_tag asset_name
+       universe_roots.asset_type, mssmt_roots.root_hash root_hash,
+       mssmt_nodes.sum root_sum, genesis_assets.asset_tag asset_name,
+       (
+           SELECT COUNT(*)
+           FROM universe_leaves
+           WHERE universe_leaves.leaf_node_namespace =
+               universe_roots.namespace_root
+       ) AS num_leaves,
+       (
+           SELECT CAST(COALESCE(MAX(history.insertion_timestamp), 0) AS BIGINT)
+           FROM universe_root_history history
+           WHERE history.universe_root_id = universe_roots.id
+       ) AS last_updated
+FROM universe_roots
+JOIN mssmt_roots
+    ON universe_roots.namespace_root = mssmt_roots.namespace
+JOIN mssmt_nodes
+    ON mssmt_nodes.hash_key = mssmt_roots.root_hash AND
+       mssmt_nodes.namespace = mssmt_roots.namespace
+JOIN genesis_assets
+    ON genesis_assets.asset_id = universe_roots.asset_id
+-- An optional ID prefix is applied as a byte range over the group key for
+-- grouped assets, or the asset ID otherwise, so the indexes on both columns
+-- can be used to only walk the matching roots.
+WHERE (
+    universe_roots.group_key IS NOT NULL AND
+    (universe_roots.group_key >= sqlc.narg('id_range_start') OR
+        sqlc.narg('id_range_start') IS NULL) AND
+    (universe_roots.group_key < sqlc.narg('id_range_end') OR
+        sqlc.narg('id_range_end') IS NULL)
+) OR (
+    universe_roots.group_key IS NULL AND
+    (universe_roots.asset_id >= sqlc.narg('id_range_start') OR
+        sqlc.narg('id_range_start') IS NULL) AND
+    (universe_roots.asset_id < sqlc.narg('id_range_end') OR
+        sqlc.narg('id_range_end') IS NULL)
+);
+
+-- name: SearchUniverseRoots :many
+SELECT universe_roots.asset_id, group_key, proof_type,
+       universe_roots.asset_type, mssmt_roots.root_hash root_hash,
+       mssmt_nodes.sum root_sum, genesis_assets.asset_tag asset_name,
+       (
+           SELECT COUNT(*)
+           FROM universe_leaves
+           WHERE universe_leaves.leaf_node_namespace =
+               universe_roots.namespace_root
+       ) AS num_leaves,
+       (
+           SELECT CAST(COALESCE(MAX(history.insertion_timestamp), 0) AS BIGINT)
+           FROM universe_root_history history
+           WHERE history.universe_root_id = universe_roots.id
+       ) AS last_updated
 FROM universe_roots
 JOIN mssmt_roots
     ON universe_roots.namespace_root = mssmt_roots.namespace
@@ -89,19 +218,33 @@ JOIN mssmt_nodes
     ON mssmt_nodes.hash_key = mssmt_roots.root_hash AND
        mssmt_nodes.namespace = mssmt_roots.namespace
 JOIN genesis_assets
-    ON genesis_assets.asset_id = universe_roots.asset_id;
+    ON genesis_assets.asset_id = universe_roots.asset_id
+-- The name pattern is matched case-insensitively, so it must be lower case.
+WHERE LOWER(genesis_assets.asset_tag) LIKE CAST(@name_pattern AS TEXT)
+    ESCAPE '\'
+-- The roots are sorted by name and namespace, so the results can be paged
+-- through reliably.
+ORDER BY LOWER(genesis_assets.asset_tag), universe_roots.namespace_root
+LIMIT @num_limit OFFSET @num_offset;
 
 -- name: InsertUniverseServer :exec
 INSERT INTO universe_servers(
-    server_host, last_sync_time
+    server_host, last_sync_time, tls_cert, sync_interval_seconds, proxy_addr,
+    proxy_user, proxy_password, priority
 ) VALUES (
-    @server_host, @last_sync_time
+    @server_host, @last_sync_time, @tls_cert, @sync_interval_seconds,
+    @proxy_addr, @proxy_user, @proxy_password, @priority
 );
 
 -- name: DeleteUniverseServer :exec
 DELETE FROM universe_servers
 WHERE server_host = @target_server OR id = @target_id;
 
+-- name: UpdateUniverseServerPriority :execrows
+UPDATE universe_servers
+SET priority = @priority
+WHERE server_host = @target_server OR id = @target_id;
+
 -- name: LogServerSync :exec
 UPDATE universe_servers
 SET last_sync_time = @new_sync_time
@@ -110,6 +253,37 @@ WHERE server_host = @target_server;
 -- name: ListUniverseServers :many
 SELECT * FROM universe_servers;
 
+-- name: InsertPendingPush :exec
+INSERT INTO universe_pending_pushes (
+    server_id, asset_id, group_key, proof_type, minting_point,
+    script_key_bytes, num_attempts, next_attempt_time, last_error
+) VALUES (
+    @server_id, @asset_id, @group_key, @proof_type, @minting_point,
+    @script_key_bytes, @num_attempts, @next_attempt_time, @last_error
+);
+
+-- name: UpdatePendingPush :exec
+UPDATE universe_pending_pushes
+SET num_attempts = @num_attempts,
+    next_attempt_time = @next_attempt_time,
+    last_error = @last_error
+WHERE id = @id;
+
+-- name: DeletePendingPush :exec
+DELETE FROM universe_pending_pushes
+WHERE id = @id;
+
+-- name: QueryPendingPushes :many
+SELECT pushes.id, pushes.server_id, servers.server_host, servers.tls_cert,
+    servers.proxy_addr, servers.proxy_user, servers.proxy_password,
+    pushes.asset_id, pushes.group_key, pushes.proof_type,
+    pushes.minting_point, pushes.script_key_bytes, pushes.num_attempts,
+    pushes.next_attempt_time, pushes.last_error
+FROM universe_pending_pushes pushes
+JOIN universe_servers servers
+    ON pushes.server_id = servers.id
+ORDER BY pushes.id;
+
 -- name: InsertNewSyncEvent :exec
 WITH group_key_root_id AS (
     SELECT id
@@ -168,6 +342,34 @@ INSERT INTO universe_events (
     @event_time, @event_timestamp
 );
 
+-- name: InsertNewPushEvent :exec
+WITH group_key_root_id AS (
+    SELECT id
+    FROM universe_roots roots
+    WHERE group_key = @group_key_x_only
+        AND roots.proof_type = @proof_type
+), asset_id_root_id AS (
+    SELECT leaves.universe_root_id AS id
+    FROM universe_leaves leaves
+    JOIN universe_roots roots
+        ON leaves.universe_root_id = roots.id
+    JOIN genesis_info_view gen
+        ON leaves.asset_genesis_id = gen.gen_asset_id
+    WHERE gen.asset_id = @asset_id
+        AND roots.proof_type = @proof_type
+    LIMIT 1
+)
+INSERT INTO universe_push_events (
+    universe_root_id, server_host, event_time, event_timestamp
+) VALUES (
+        CASE WHEN length(@group_key_x_only) > 0 THEN (
+            SELECT id FROM group_key_root_id
+        ) ELSE (
+            SELECT id FROM asset_id_root_id
+        ) END,
+    @server_host, @event_time, @event_timestamp
+);
+
 -- name: QueryUniverseStats :one
 WITH stats AS (
     SELECT total_asset_syncs, total_asset_proofs
@@ -184,29 +386,59 @@ WITH stats AS (
          nodes.namespace = roots.namespace
     JOIN universe_roots uroots
       ON roots.namespace = uroots.namespace_root
+), leaf_ids AS (
+    SELECT id
+    FROM universe_leaves
+), push_ids AS (
+    SELECT event_id
+    FROM universe_push_events
 ), aggregated AS (
     SELECT COALESCE(SUM(stats.total_asset_syncs), 0) AS total_syncs,
            COALESCE(SUM(stats.total_asset_proofs), 0) AS total_proofs,
            0 AS total_num_groups,
-           0 AS total_num_assets
+           0 AS total_num_assets,
+           0 AS total_num_leaves,
+           0 AS total_pushes
     FROM stats
     UNION ALL
     SELECT 0 AS total_syncs,
            0 AS total_proofs,
            COALESCE(COUNT(group_ids.id), 0) AS total_num_groups,
-           0 AS total_num_assets
+           0 AS total_num_assets,
+           0 AS total_num_leaves,
+           0 AS total_pushes
     FROM group_ids
     UNION ALL
     SELECT 0 AS total_syncs,
            0 AS total_proofs,
            0 AS total_num_groups,
-           COALESCE(COUNT(asset_keys.hash_key), 0) AS total_num_assets
+           COALESCE(COUNT(asset_keys.hash_key), 0) AS total_num_assets,
+           0 AS total_num_leaves,
+           0 AS total_pushes
     FROM asset_keys
+    UNION ALL
+    SELECT 0 AS total_syncs,
+           0 AS total_proofs,
+           0 AS total_num_groups,
+           0 AS total_num_assets,
+           COALESCE(COUNT(leaf_ids.id), 0) AS total_num_leaves,
+           0 AS total_pushes
+    FROM leaf_ids
+    UNION ALL
+    SELECT 0 AS total_syncs,
+           0 AS total_proofs,
+           0 AS total_num_groups,
+           0 AS total_num_assets,
+           0 AS total_num_leaves,
+           COALESCE(COUNT(push_ids.event_id), 0) AS total_pushes
+    FROM push_ids
 )
 SELECT SUM(total_syncs) AS total_syncs,
        SUM(total_proofs) AS total_proofs,
        SUM(total_num_groups) AS total_num_groups,
-       SUM(total_num_assets) AS total_num_assets
+       SUM(total_num_assets) AS total_num_assets,
+       SUM(total_num_leaves) AS total_num_leaves,
+       SUM(total_pushes) AS total_pushes
 FROM aggregated;
 
 -- TODO(roasbeef): use the universe id instead for the grouping? so namespace
@@ -345,4 +577,55 @@ ON CONFLICT(namespace)
 -- name: QueryFederationUniSyncConfigs :many
 SELECT namespace, asset_id, group_key, proof_type, allow_sync_insert, allow_sync_export
 FROM federation_uni_sync_config
-ORDER BY group_key NULLS LAST, asset_id NULLS LAST, proof_type;
\ No newline at end of file
+ORDER BY group_key NULLS LAST, asset_id NULLS LAST, proof_type;
+-- name: UpsertLazyRoot :exec
+INSERT INTO universe_lazy_roots (
+    namespace, asset_id, group_key, proof_type, root_hash, root_sum,
+    asset_name, source_host, synced_at
+) VALUES (
+    @namespace, @asset_id, @group_key, @proof_type, @root_hash, @root_sum,
+    @asset_name, @source_host, @synced_at
+)
+ON CONFLICT(namespace)
+    DO UPDATE SET
+    root_hash = @root_hash,
+    root_sum = @root_sum,
+    asset_name = @asset_name,
+    source_host = @source_host,
+    synced_at = @synced_at;
+
+-- name: FetchLazyRoot :one
+SELECT namespace, asset_id, group_key, proof_type, root_hash, root_sum,
+    asset_name, source_host, synced_at
+FROM universe_lazy_roots
+WHERE namespace = @namespace;
+
+-- name: QueryLazyRoots :many
+SELECT namespace, asset_id, group_key, proof_type, root_hash, root_sum,
+    asset_name, source_host, synced_at
+FROM universe_lazy_roots
+ORDER BY namespace;
+
+-- name: DeleteLazyRoot :exec
+DELETE FROM universe_lazy_roots
+WHERE namespace = @namespace;
+
+-- name: UpsertSyncCheckpoint :exec
+INSERT INTO universe_sync_checkpoints (
+    server_host, sync_type, namespace, synced_at
+) VALUES (
+    @server_host, @sync_type, @namespace, @synced_at
+)
+ON CONFLICT(server_host, sync_type, namespace)
+    DO UPDATE SET
+    synced_at = @synced_at;
+
+-- name: QuerySyncCheckpoint :many
+SELECT namespace
+FROM universe_sync_checkpoints
+WHERE server_host = @server_host AND sync_type = @sync_type
+ORDER BY namespace;
+
+-- name: DeleteSyncCheckpoint :exec
+DELETE FROM universe_sync_checkpoints
+WHERE server_host = @server_host AND sync_type = @sync_type;
@@ -11,6 +11,41 @@ import (
 	"time"
 )
 
+const deleteLazyRoot = `-- name: DeleteLazyRoot :exec
+DELETE FROM universe_lazy_roots
+WHERE namespace = $1
+`
+
+func (q *Queries) DeleteLazyRoot(ctx context.Context, namespace string) error {
+	_, err := q.db.ExecContext(ctx, deleteLazyRoot, namespace)
+	return err
+}
+
+const deletePendingPush = `-- name: DeletePendingPush :exec
+DELETE FROM universe_pending_pushes
+WHERE id = $1
+`
+
+func (q *Queries) DeletePendingPush(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deletePendingPush, id)
+	return err
+}
+
+const deleteSyncCheckpoint = `-- name: DeleteSyncCheckpoint :exec
+DELETE FROM universe_sync_checkpoints
+WHERE server_host = $1 AND sync_type = $2
+`
+
+type DeleteSyncCheckpointParams struct {
+	ServerHost string
+	SyncType   string
+}
+
+func (q *Queries) DeleteSyncCheckpoint(ctx context.Context, arg DeleteSyncCheckpointParams) error {
+	_, err := q.db.ExecContext(ctx, deleteSyncCheckpoint, arg.ServerHost, arg.SyncType)
+	return err
+}
+
 const deleteUniverseEvents = `-- name: DeleteUniverseEvents :exec
 WITH root_id AS (
     SELECT id
@@ -26,6 +61,21 @@ func (q *Queries) DeleteUniverseEvents(ctx context.Context, namespaceRoot string
 	return err
 }
 
+const deleteUniverseLeaf = `-- name: DeleteUniverseLeaf :exec
+DELETE FROM universe_leaves
+WHERE leaf_node_namespace = $1 AND leaf_node_key = $2
+`
+
+type DeleteUniverseLeafParams struct {
+	Namespace   string
+	LeafNodeKey []byte
+}
+
+func (q *Queries) DeleteUniverseLeaf(ctx context.Context, arg DeleteUniverseLeafParams) error {
+	_, err := q.db.ExecContext(ctx, deleteUniverseLeaf, arg.Namespace, arg.LeafNodeKey)
+	return err
+}
+
 const deleteUniverseLeaves = `-- name: DeleteUniverseLeaves :exec
 DELETE FROM universe_leaves
 WHERE leaf_node_namespace = $1
@@ -61,6 +111,30 @@ func (q *Queries) DeleteUniverseServer(ctx context.Context, arg DeleteUniverseSe
 	return err
 }
 
+const fetchLazyRoot = `-- name: FetchLazyRoot :one
+SELECT namespace, asset_id, group_key, proof_type, root_hash, root_sum,
+    asset_name, source_host, synced_at
+FROM universe_lazy_roots
+WHERE namespace = $1
+`
+
+func (q *Queries) FetchLazyRoot(ctx context.Context, namespace string) (UniverseLazyRoot, error) {
+	row := q.db.QueryRowContext(ctx, fetchLazyRoot, namespace)
+	var i UniverseLazyRoot
+	err := row.Scan(
+		&i.Namespace,
+		&i.AssetID,
+		&i.GroupKey,
+		&i.ProofType,
+		&i.RootHash,
+		&i.RootSum,
+		&i.AssetName,
+		&i.SourceHost,
+		&i.SyncedAt,
+	)
+	return i, err
+}
+
 const fetchUniverseKeys = `-- name: FetchUniverseKeys :many
 SELECT leaves.minting_point, leaves.script_key_bytes
 FROM universe_leaves leaves
@@ -95,6 +169,133 @@ func (q *Queries) FetchUniverseKeys(ctx context.Context, namespace string) ([]Fe
 	return items, nil
 }
 
+const fetchUniverseKeysSince = `-- name: FetchUniverseKeysSince :many
+SELECT DISTINCT leaves.minting_point, leaves.script_key_bytes
+FROM universe_root_history history
+JOIN universe_roots
+    ON history.universe_root_id = universe_roots.id
+JOIN universe_leaves leaves
+    ON leaves.leaf_node_namespace = universe_roots.namespace_root AND
+       leaves.leaf_node_key = history.leaf_node_key
+WHERE universe_roots.namespace_root = $1 AND
+    history.id > $2
+`
+
+type FetchUniverseKeysSinceParams struct {
+	Namespace string
+	HistoryID int64
+}
+
+type FetchUniverseKeysSinceRow struct {
+	MintingPoint   []byte
+	ScriptKeyBytes []byte
+}
+
+func (q *Queries) FetchUniverseKeysSince(ctx context.Context, arg FetchUniverseKeysSinceParams) ([]FetchUniverseKeysSinceRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchUniverseKeysSince, arg.Namespace, arg.HistoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchUniverseKeysSinceRow
+	for rows.Next() {
+		var i FetchUniverseKeysSinceRow
+		if err := rows.Scan(&i.MintingPoint, &i.ScriptKeyBytes); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const fetchUniverseLeafNodes = `-- name: FetchUniverseLeafNodes :many
+SELECT leaves.leaf_node_key, nodes.value leaf_value, nodes.sum leaf_sum,
+       nodes.pruned leaf_pruned
+FROM universe_leaves leaves
+JOIN mssmt_nodes nodes
+    ON leaves.leaf_node_key = nodes.key AND
+        leaves.leaf_node_namespace = nodes.namespace
+WHERE leaves.leaf_node_namespace = $1
+`
+
+type FetchUniverseLeafNodesRow struct {
+	LeafNodeKey []byte
+	LeafValue   []byte
+	LeafSum     int64
+	LeafPruned  bool
+}
+
+func (q *Queries) FetchUniverseLeafNodes(ctx context.Context, namespace string) ([]FetchUniverseLeafNodesRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchUniverseLeafNodes, namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchUniverseLeafNodesRow
+	for rows.Next() {
+		var i FetchUniverseLeafNodesRow
+		if err := rows.Scan(
+			&i.LeafNodeKey,
+			&i.LeafValue,
+			&i.LeafSum,
+			&i.LeafPruned,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const fetchPrunedUniverseKeys = `-- name: FetchPrunedUniverseKeys :many
+SELECT leaves.minting_point, leaves.script_key_bytes
+FROM universe_leaves leaves
+JOIN mssmt_nodes nodes
+    ON leaves.leaf_node_key = nodes.key AND
+        leaves.leaf_node_namespace = nodes.namespace
+WHERE leaves.leaf_node_namespace = $1 AND nodes.pruned = TRUE
+`
+
+type FetchPrunedUniverseKeysRow struct {
+	MintingPoint   []byte
+	ScriptKeyBytes []byte
+}
+
+func (q *Queries) FetchPrunedUniverseKeys(ctx context.Context, namespace string) ([]FetchPrunedUniverseKeysRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchPrunedUniverseKeys, namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchPrunedUniverseKeysRow
+	for rows.Next() {
+		var i FetchPrunedUniverseKeysRow
+		if err := rows.Scan(&i.MintingPoint, &i.ScriptKeyBytes); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const fetchUniverseRoot = `-- name: FetchUniverseRoot :one
 SELECT universe_roots.asset_id, group_key, proof_type,
        mssmt_nodes.hash_key root_hash, mssmt_nodes.sum root_sum,
@@ -133,6 +334,79 @@ func (q *Queries) FetchUniverseRoot(ctx context.Context, namespace string) (Fetc
 	return i, err
 }
 
+const fetchUniverseRootAtHeight = `-- name: FetchUniverseRootAtHeight :one
+SELECT history.root_hash, history.root_sum,
+       genesis_assets.asset_tag asset_name
+FROM universe_root_history history
+JOIN universe_roots
+    ON history.universe_root_id = universe_roots.id
+JOIN genesis_assets
+    ON genesis_assets.asset_id = universe_roots.asset_id
+WHERE universe_roots.namespace_root = $1 AND
+    history.block_height <= $2
+ORDER BY history.block_height DESC, history.id DESC
+LIMIT 1
+`
+
+type FetchUniverseRootAtHeightParams struct {
+	Namespace   string
+	BlockHeight int32
+}
+
+type FetchUniverseRootAtHeightRow struct {
+	RootHash  []byte
+	RootSum   int64
+	AssetName string
+}
+
+func (q *Queries) FetchUniverseRootAtHeight(ctx context.Context, arg FetchUniverseRootAtHeightParams) (FetchUniverseRootAtHeightRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchUniverseRootAtHeight, arg.Namespace, arg.BlockHeight)
+	var i FetchUniverseRootAtHeightRow
+	err := row.Scan(&i.RootHash, &i.RootSum, &i.AssetName)
+	return i, err
+}
+
+const fetchUniverseRootHistoryID = `-- name: FetchUniverseRootHistoryID :one
+SELECT history.id
+FROM universe_root_history history
+JOIN universe_roots
+    ON history.universe_root_id = universe_roots.id
+WHERE universe_roots.namespace_root = $1 AND
+    history.root_hash = $2 AND
+    history.leaf_node_key IS NOT NULL
+ORDER BY history.id DESC
+LIMIT 1
+`
+
+type FetchUniverseRootHistoryIDParams struct {
+	Namespace string
+	RootHash  []byte
+}
+
+func (q *Queries) FetchUniverseRootHistoryID(ctx context.Context, arg FetchUniverseRootHistoryIDParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, fetchUniverseRootHistoryID, arg.Namespace, arg.RootHash)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const fetchUniverseRootLastHeight = `-- name: FetchUniverseRootLastHeight :one
+SELECT history.block_height
+FROM universe_root_history history
+JOIN universe_roots
+    ON history.universe_root_id = universe_roots.id
+WHERE universe_roots.namespace_root = $1
+ORDER BY history.block_height DESC
+LIMIT 1
+`
+
+func (q *Queries) FetchUniverseRootLastHeight(ctx context.Context, namespace string) (int32, error) {
+	row := q.db.QueryRowContext(ctx, fetchUniverseRootLastHeight, namespace)
+	var block_height int32
+	err := row.Scan(&block_height)
+	return block_height, err
+}
+
 const insertNewProofEvent = `-- name: InsertNewProofEvent :exec
 WITH group_key_root_id AS (
     SELECT id
@@ -182,6 +456,56 @@ func (q *Queries) InsertNewProofEvent(ctx context.Context, arg InsertNewProofEve
 	return err
 }
 
+const insertNewPushEvent = `-- name: InsertNewPushEvent :exec
+WITH group_key_root_id AS (
+    SELECT id
+    FROM universe_roots roots
+    WHERE group_key = $1
+        AND roots.proof_type = $5
+), asset_id_root_id AS (
+    SELECT leaves.universe_root_id AS id
+    FROM universe_leaves leaves
+    JOIN universe_roots roots
+        ON leaves.universe_root_id = roots.id
+    JOIN genesis_info_view gen
+        ON leaves.asset_genesis_id = gen.gen_asset_id
+    WHERE gen.asset_id = $6
+        AND roots.proof_type = $5
+    LIMIT 1
+)
+INSERT INTO universe_push_events (
+    universe_root_id, server_host, event_time, event_timestamp
+) VALUES (
+        CASE WHEN length($1) > 0 THEN (
+            SELECT id FROM group_key_root_id
+        ) ELSE (
+            SELECT id FROM asset_id_root_id
+        ) END,
+    $2, $3, $4
+)
+`
+
+type InsertNewPushEventParams struct {
+	GroupKeyXOnly  interface{}
+	ServerHost     string
+	EventTime      time.Time
+	EventTimestamp int64
+	ProofType      string
+	AssetID        []byte
+}
+
+func (q *Queries) InsertNewPushEvent(ctx context.Context, arg InsertNewPushEventParams) error {
+	_, err := q.db.ExecContext(ctx, insertNewPushEvent,
+		arg.GroupKeyXOnly,
+		arg.ServerHost,
+		arg.EventTime,
+		arg.EventTimestamp,
+		arg.ProofType,
+		arg.AssetID,
+	)
+	return err
+}
+
 const insertNewSyncEvent = `-- name: InsertNewSyncEvent :exec
 WITH group_key_root_id AS (
     SELECT id
@@ -231,26 +555,111 @@ func (q *Queries) InsertNewSyncEvent(ctx context.Context, arg InsertNewSyncEvent
 	return err
 }
 
+const insertPendingPush = `-- name: InsertPendingPush :exec
+INSERT INTO universe_pending_pushes (
+    server_id, asset_id, group_key, proof_type, minting_point,
+    script_key_bytes, num_attempts, next_attempt_time, last_error
+) VALUES (
+    $1, $2, $3, $4, $5,
+    $6, $7, $8, $9
+)
+`
+
+type InsertPendingPushParams struct {
+	ServerID        int64
+	AssetID         []byte
+	GroupKey        []byte
+	ProofType       string
+	MintingPoint    []byte
+	ScriptKeyBytes  []byte
+	NumAttempts     int32
+	NextAttemptTime time.Time
+	LastError       string
+}
+
+func (q *Queries) InsertPendingPush(ctx context.Context, arg InsertPendingPushParams) error {
+	_, err := q.db.ExecContext(ctx, insertPendingPush,
+		arg.ServerID,
+		arg.AssetID,
+		arg.GroupKey,
+		arg.ProofType,
+		arg.MintingPoint,
+		arg.ScriptKeyBytes,
+		arg.NumAttempts,
+		arg.NextAttemptTime,
+		arg.LastError,
+	)
+	return err
+}
+
+const insertUniverseRootHistory = `-- name: InsertUniverseRootHistory :exec
+INSERT INTO universe_root_history (
+    universe_root_id, block_height, root_hash, root_sum, leaf_node_key,
+    insertion_timestamp
+) VALUES (
+    $1, $2, $3, $4, $5,
+    $6
+)
+`
+
+type InsertUniverseRootHistoryParams struct {
+	UniverseRootID     int64
+	BlockHeight        int32
+	RootHash           []byte
+	RootSum            int64
+	LeafNodeKey        []byte
+	InsertionTimestamp int64
+}
+
+func (q *Queries) InsertUniverseRootHistory(ctx context.Context, arg InsertUniverseRootHistoryParams) error {
+	_, err := q.db.ExecContext(ctx, insertUniverseRootHistory,
+		arg.UniverseRootID,
+		arg.BlockHeight,
+		arg.RootHash,
+		arg.RootSum,
+		arg.LeafNodeKey,
+		arg.InsertionTimestamp,
+	)
+	return err
+}
+
 const insertUniverseServer = `-- name: InsertUniverseServer :exec
 INSERT INTO universe_servers(
-    server_host, last_sync_time
+    server_host, last_sync_time, tls_cert, sync_interval_seconds, proxy_addr,
+    proxy_user, proxy_password, priority
 ) VALUES (
-    $1, $2
+    $1, $2, $3, $4,
+    $5, $6, $7, $8
 )
 `
 
 type InsertUniverseServerParams struct {
-	ServerHost   string
-	LastSyncTime time.Time
+	ServerHost          string
+	LastSyncTime        time.Time
+	TlsCert             []byte
+	SyncIntervalSeconds sql.NullInt64
+	ProxyAddr           sql.NullString
+	ProxyUser           sql.NullString
+	ProxyPassword       sql.NullString
+	Priority            int64
 }
 
 func (q *Queries) InsertUniverseServer(ctx context.Context, arg InsertUniverseServerParams) error {
-	_, err := q.db.ExecContext(ctx, insertUniverseServer, arg.ServerHost, arg.LastSyncTime)
+	_, err := q.db.ExecContext(ctx, insertUniverseServer,
+		arg.ServerHost,
+		arg.LastSyncTime,
+		arg.TlsCert,
+		arg.SyncIntervalSeconds,
+		arg.ProxyAddr,
+		arg.ProxyUser,
+		arg.ProxyPassword,
+		arg.Priority,
+	)
 	return err
 }
 
 const listUniverseServers = `-- name: ListUniverseServers :many
-SELECT id, server_host, last_sync_time FROM universe_servers
+SELECT id, server_host, last_sync_time, tls_cert, sync_interval_seconds, proxy_addr, proxy_user, proxy_password, priority FROM universe_servers
 `
 
 func (q *Queries) ListUniverseServers(ctx context.Context) ([]UniverseServer, error) {
@@ -262,7 +671,17 @@ func (q *Queries) ListUniverseServers(ctx context.Context) ([]UniverseServer, er
 	var items []UniverseServer
 	for rows.Next() {
 		var i UniverseServer
-		if err := rows.Scan(&i.ID, &i.ServerHost, &i.LastSyncTime); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.ServerHost,
+			&i.LastSyncTime,
+			&i.TlsCert,
+			&i.SyncIntervalSeconds,
+			&i.ProxyAddr,
+			&i.ProxyUser,
+			&i.ProxyPassword,
+			&i.Priority,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -449,6 +868,150 @@ func (q *Queries) QueryFederationUniSyncConfigs(ctx context.Context) ([]Federati
 	return items, nil
 }
 
+const queryLazyRoots = `-- name: QueryLazyRoots :many
+SELECT namespace, asset_id, group_key, proof_type, root_hash, root_sum,
+    asset_name, source_host, synced_at
+FROM universe_lazy_roots
+ORDER BY namespace
+`
+
+func (q *Queries) QueryLazyRoots(ctx context.Context) ([]UniverseLazyRoot, error) {
+	rows, err := q.db.QueryContext(ctx, queryLazyRoots)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UniverseLazyRoot
+	for rows.Next() {
+		var i UniverseLazyRoot
+		if err := rows.Scan(
+			&i.Namespace,
+			&i.AssetID,
+			&i.GroupKey,
+			&i.ProofType,
+			&i.RootHash,
+			&i.RootSum,
+			&i.AssetName,
+			&i.SourceHost,
+			&i.SyncedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const queryPendingPushes = `-- name: QueryPendingPushes :many
+SELECT pushes.id, pushes.server_id, servers.server_host, servers.tls_cert,
+    servers.proxy_addr, servers.proxy_user, servers.proxy_password,
+    pushes.asset_id, pushes.group_key, pushes.proof_type,
+    pushes.minting_point, pushes.script_key_bytes, pushes.num_attempts,
+    pushes.next_attempt_time, pushes.last_error
+FROM universe_pending_pushes pushes
+JOIN universe_servers servers
+    ON pushes.server_id = servers.id
+ORDER BY pushes.id
+`
+
+type QueryPendingPushesRow struct {
+	ID              int64
+	ServerID        int64
+	ServerHost      string
+	TlsCert         []byte
+	ProxyAddr       sql.NullString
+	ProxyUser       sql.NullString
+	ProxyPassword   sql.NullString
+	AssetID         []byte
+	GroupKey        []byte
+	ProofType       string
+	MintingPoint    []byte
+	ScriptKeyBytes  []byte
+	NumAttempts     int32
+	NextAttemptTime time.Time
+	LastError       string
+}
+
+func (q *Queries) QueryPendingPushes(ctx context.Context) ([]QueryPendingPushesRow, error) {
+	rows, err := q.db.QueryContext(ctx, queryPendingPushes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueryPendingPushesRow
+	for rows.Next() {
+		var i QueryPendingPushesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ServerID,
+			&i.ServerHost,
+			&i.TlsCert,
+			&i.ProxyAddr,
+			&i.ProxyUser,
+			&i.ProxyPassword,
+			&i.AssetID,
+			&i.GroupKey,
+			&i.ProofType,
+			&i.MintingPoint,
+			&i.ScriptKeyBytes,
+			&i.NumAttempts,
+			&i.NextAttemptTime,
+			&i.LastError,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const querySyncCheckpoint = `-- name: QuerySyncCheckpoint :many
+SELECT namespace
+FROM universe_sync_checkpoints
+WHERE server_host = $1 AND sync_type = $2
+ORDER BY namespace
+`
+
+type QuerySyncCheckpointParams struct {
+	ServerHost string
+	SyncType   string
+}
+
+func (q *Queries) QuerySyncCheckpoint(ctx context.Context, arg QuerySyncCheckpointParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, querySyncCheckpoint, arg.ServerHost, arg.SyncType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var namespace string
+		if err := rows.Scan(&namespace); err != nil {
+			return nil, err
+		}
+		items = append(items, namespace)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const queryUniverseAssetStats = `-- name: QueryUniverseAssetStats :many
 
 WITH asset_supply AS (
@@ -601,7 +1164,8 @@ func (q *Queries) QueryUniverseAssetStats(ctx context.Context, arg QueryUniverse
 
 const queryUniverseLeaves = `-- name: QueryUniverseLeaves :many
 SELECT leaves.script_key_bytes, gen.gen_asset_id, nodes.value genesis_proof, 
-       nodes.sum sum_amt, gen.asset_id
+       nodes.sum sum_amt, gen.asset_id, leaves.source_priority,
+       nodes.pruned
 FROM universe_leaves leaves
 JOIN mssmt_nodes nodes
     ON leaves.leaf_node_key = nodes.key AND
@@ -629,6 +1193,8 @@ type QueryUniverseLeavesRow struct {
 	GenesisProof   []byte
 	SumAmt         int64
 	AssetID        []byte
+	SourcePriority sql.NullInt64
+	Pruned         bool
 }
 
 func (q *Queries) QueryUniverseLeaves(ctx context.Context, arg QueryUniverseLeavesParams) ([]QueryUniverseLeavesRow, error) {
@@ -646,6 +1212,8 @@ func (q *Queries) QueryUniverseLeaves(ctx context.Context, arg QueryUniverseLeav
 			&i.GenesisProof,
 			&i.SumAmt,
 			&i.AssetID,
+			&i.SourcePriority,
+			&i.Pruned,
 		); err != nil {
 			return nil, err
 		}
@@ -676,29 +1244,59 @@ WITH stats AS (
          nodes.namespace = roots.namespace
     JOIN universe_roots uroots
       ON roots.namespace = uroots.namespace_root
+), leaf_ids AS (
+    SELECT id
+    FROM universe_leaves
+), push_ids AS (
+    SELECT event_id
+    FROM universe_push_events
 ), aggregated AS (
     SELECT COALESCE(SUM(stats.total_asset_syncs), 0) AS total_syncs,
            COALESCE(SUM(stats.total_asset_proofs), 0) AS total_proofs,
            0 AS total_num_groups,
-           0 AS total_num_assets
+           0 AS total_num_assets,
+           0 AS total_num_leaves,
+           0 AS total_pushes
     FROM stats
     UNION ALL
     SELECT 0 AS total_syncs,
            0 AS total_proofs,
            COALESCE(COUNT(group_ids.id), 0) AS total_num_groups,
-           0 AS total_num_assets
+           0 AS total_num_assets,
+           0 AS total_num_leaves,
+           0 AS total_pushes
     FROM group_ids
     UNION ALL
     SELECT 0 AS total_syncs,
            0 AS total_proofs,
            0 AS total_num_groups,
-           COALESCE(COUNT(asset_keys.hash_key), 0) AS total_num_assets
+           COALESCE(COUNT(asset_keys.hash_key), 0) AS total_num_assets,
+           0 AS total_num_leaves,
+           0 AS total_pushes
     FROM asset_keys
+    UNION ALL
+    SELECT 0 AS total_syncs,
+           0 AS total_proofs,
+           0 AS total_num_groups,
+           0 AS total_num_assets,
+           COALESCE(COUNT(leaf_ids.id), 0) AS total_num_leaves,
+           0 AS total_pushes
+    FROM leaf_ids
+    UNION ALL
+    SELECT 0 AS total_syncs,
+           0 AS total_proofs,
+           0 AS total_num_groups,
+           0 AS total_num_assets,
+           0 AS total_num_leaves,
+           COALESCE(COUNT(push_ids.event_id), 0) AS total_pushes
+    FROM push_ids
 )
 SELECT SUM(total_syncs) AS total_syncs,
        SUM(total_proofs) AS total_proofs,
        SUM(total_num_groups) AS total_num_groups,
-       SUM(total_num_assets) AS total_num_assets
+       SUM(total_num_assets) AS total_num_assets,
+       SUM(total_num_leaves) AS total_num_leaves,
+       SUM(total_pushes) AS total_pushes
 FROM aggregated
 `
 
@@ -707,6 +1305,8 @@ type QueryUniverseStatsRow struct {
 	TotalProofs    int64
 	TotalNumGroups int64
 	TotalNumAssets int64
+	TotalNumLeaves int64
+	TotalPushes    int64
 }
 
 func (q *Queries) QueryUniverseStats(ctx context.Context) (QueryUniverseStatsRow, error) {
@@ -717,12 +1317,97 @@ func (q *Queries) QueryUniverseStats(ctx context.Context) (QueryUniverseStatsRow
 		&i.TotalProofs,
 		&i.TotalNumGroups,
 		&i.TotalNumAssets,
+		&i.TotalNumLeaves,
+		&i.TotalPushes,
 	)
 	return i, err
 }
 
+const searchUniverseRoots = `-- name: SearchUniverseRoots :many
+SELECT universe_roots.asset_id, group_key, proof_type,
+       universe_roots.asset_type, mssmt_roots.root_hash root_hash,
+       mssmt_nodes.sum root_sum, genesis_assets.asset_tag asset_name,
+       (
+           SELECT COUNT(*)
+           FROM universe_leaves
+           WHERE universe_leaves.leaf_node_namespace =
+               universe_roots.namespace_root
+       ) AS num_leaves,
+       (
+           SELECT CAST(COALESCE(MAX(history.insertion_timestamp), 0) AS BIGINT)
+           FROM universe_root_history history
+           WHERE history.universe_root_id = universe_roots.id
+       ) AS last_updated
+FROM universe_roots
+JOIN mssmt_roots
+    ON universe_roots.namespace_root = mssmt_roots.namespace
+JOIN mssmt_nodes
+    ON mssmt_nodes.hash_key = mssmt_roots.root_hash AND
+       mssmt_nodes.namespace = mssmt_roots.namespace
+JOIN genesis_assets
+    ON genesis_assets.asset_id = universe_roots.asset_id
+WHERE LOWER(genesis_assets.asset_tag) LIKE CAST($1 AS TEXT)
+    ESCAPE '\'
+ORDER BY LOWER(genesis_assets.asset_tag), universe_roots.namespace_root
+LIMIT $3 OFFSET $2
+`
+
+type SearchUniverseRootsParams struct {
+	NamePattern string
+	NumOffset   int32
+	NumLimit    int32
+}
+
+type SearchUniverseRootsRow struct {
+	AssetID     []byte
+	GroupKey    []byte
+	ProofType   string
+	AssetType   sql.NullInt16
+	RootHash    []byte
+	RootSum     int64
+	AssetName   string
+	NumLeaves   int64
+	LastUpdated int64
+}
+
+// The name pattern is matched case-insensitively, so it must be lower case.
+// The roots are sorted by name and namespace, so the results can be paged
+// through reliably.
+func (q *Queries) SearchUniverseRoots(ctx context.Context, arg SearchUniverseRootsParams) ([]SearchUniverseRootsRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchUniverseRoots, arg.NamePattern, arg.NumOffset, arg.NumLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchUniverseRootsRow
+	for rows.Next() {
+		var i SearchUniverseRootsRow
+		if err := rows.Scan(
+			&i.AssetID,
+			&i.GroupKey,
+			&i.ProofType,
+			&i.AssetType,
+			&i.RootHash,
+			&i.RootSum,
+			&i.AssetName,
+			&i.NumLeaves,
+			&i.LastUpdated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const universeLeaves = `-- name: UniverseLeaves :many
-SELECT id, asset_genesis_id, minting_point, script_key_bytes, universe_root_id, leaf_node_key, leaf_node_namespace FROM universe_leaves
+SELECT id, asset_genesis_id, minting_point, script_key_bytes, universe_root_id, leaf_node_key, leaf_node_namespace, source_priority FROM universe_leaves
 `
 
 func (q *Queries) UniverseLeaves(ctx context.Context) ([]UniverseLeafe, error) {
@@ -742,6 +1427,7 @@ func (q *Queries) UniverseLeaves(ctx context.Context) ([]UniverseLeafe, error) {
 			&i.UniverseRootID,
 			&i.LeafNodeKey,
 			&i.LeafNodeNamespace,
+			&i.SourcePriority,
 		); err != nil {
 			return nil, err
 		}
@@ -758,8 +1444,19 @@ func (q *Queries) UniverseLeaves(ctx context.Context) ([]UniverseLeafe, error) {
 
 const universeRoots = `-- name: UniverseRoots :many
 SELECT universe_roots.asset_id, group_key, proof_type,
-       mssmt_roots.root_hash root_hash, mssmt_nodes.sum root_sum,
-       genesis_assets.asset_tag asset_name
+       universe_roots.asset_type, mssmt_roots.root_hash root_hash,
+       mssmt_nodes.sum root_sum, genesis_assets.asset_tag asset_name,
+       (
+           SELECT COUNT(*)
+           FROM universe_leaves
+           WHERE universe_leaves.leaf_node_namespace =
+               universe_roots.namespace_root
+       ) AS num_leaves,
+       (
+           SELECT CAST(COALESCE(MAX(history.insertion_timestamp), 0) AS BIGINT)
+           FROM universe_root_history history
+           WHERE history.universe_root_id = universe_roots.id
+       ) AS last_updated
 FROM universe_roots
 JOIN mssmt_roots
     ON universe_roots.namespace_root = mssmt_roots.namespace
@@ -768,19 +1465,43 @@ JOIN mssmt_nodes
        mssmt_nodes.namespace = mssmt_roots.namespace
 JOIN genesis_assets
     ON genesis_assets.asset_id = universe_roots.asset_id
+WHERE (
+    universe_roots.group_key IS NOT NULL AND
+    (universe_roots.group_key >= $1 OR
+        $1 IS NULL) AND
+    (universe_roots.group_key < $2 OR
+        $2 IS NULL)
+) OR (
+    universe_roots.group_key IS NULL AND
+    (universe_roots.asset_id >= $1 OR
+        $1 IS NULL) AND
+    (universe_roots.asset_id < $2 OR
+        $2 IS NULL)
+)
 `
 
+type UniverseRootsParams struct {
+	IDRangeStart []byte
+	IDRangeEnd   []byte
+}
+
 type UniverseRootsRow struct {
-	AssetID   []byte
-	GroupKey  []byte
-	ProofType string
-	RootHash  []byte
-	RootSum   int64
-	AssetName string
+	AssetID     []byte
+	GroupKey    []byte
+	ProofType   string
+	AssetType   sql.NullInt16
+	RootHash    []byte
+	RootSum     int64
+	AssetName   string
+	NumLeaves   int64
+	LastUpdated int64
 }
 
-func (q *Queries) UniverseRoots(ctx context.Context) ([]UniverseRootsRow, error) {
-	rows, err := q.db.QueryContext(ctx, universeRoots)
+// An optional ID prefix is applied as a byte range over the group key for
+// grouped assets, or the asset ID otherwise, so the indexes on both columns
+// can be used to only walk the matching roots.
+func (q *Queries) UniverseRoots(ctx context.Context, arg UniverseRootsParams) ([]UniverseRootsRow, error) {
+	rows, err := q.db.QueryContext(ctx, universeRoots, arg.IDRangeStart, arg.IDRangeEnd)
 	if err != nil {
 		return nil, err
 	}
@@ -792,9 +1513,12 @@ func (q *Queries) UniverseRoots(ctx context.Context) ([]UniverseRootsRow, error)
 			&i.AssetID,
 			&i.GroupKey,
 			&i.ProofType,
+			&i.AssetType,
 			&i.RootHash,
 			&i.RootSum,
 			&i.AssetName,
+			&i.NumLeaves,
+			&i.LastUpdated,
 		); err != nil {
 			return nil, err
 		}
@@ -809,6 +1533,51 @@ func (q *Queries) UniverseRoots(ctx context.Context) ([]UniverseRootsRow, error)
 	return items, nil
 }
 
+const updatePendingPush = `-- name: UpdatePendingPush :exec
+UPDATE universe_pending_pushes
+SET num_attempts = $1,
+    next_attempt_time = $2,
+    last_error = $3
+WHERE id = $4
+`
+
+type UpdatePendingPushParams struct {
+	NumAttempts     int32
+	NextAttemptTime time.Time
+	LastError       string
+	ID              int64
+}
+
+func (q *Queries) UpdatePendingPush(ctx context.Context, arg UpdatePendingPushParams) error {
+	_, err := q.db.ExecContext(ctx, updatePendingPush,
+		arg.NumAttempts,
+		arg.NextAttemptTime,
+		arg.LastError,
+		arg.ID,
+	)
+	return err
+}
+
+const updateUniverseServerPriority = `-- name: UpdateUniverseServerPriority :execrows
+UPDATE universe_servers
+SET priority = $1
+WHERE server_host = $2 OR id = $3
+`
+
+type UpdateUniverseServerPriorityParams struct {
+	Priority     int64
+	TargetServer string
+	TargetID     int64
+}
+
+func (q *Queries) UpdateUniverseServerPriority(ctx context.Context, arg UpdateUniverseServerPriorityParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateUniverseServerPriority, arg.Priority, arg.TargetServer, arg.TargetID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const upsertFederationGlobalSyncConfig = `-- name: UpsertFederationGlobalSyncConfig :exec
 INSERT INTO federation_global_sync_config (
     proof_type, allow_sync_insert, allow_sync_export
@@ -865,18 +1634,91 @@ func (q *Queries) UpsertFederationUniSyncConfig(ctx context.Context, arg UpsertF
 	return err
 }
 
+const upsertLazyRoot = `-- name: UpsertLazyRoot :exec
+INSERT INTO universe_lazy_roots (
+    namespace, asset_id, group_key, proof_type, root_hash, root_sum,
+    asset_name, source_host, synced_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6,
+    $7, $8, $9
+)
+ON CONFLICT(namespace)
+    DO UPDATE SET
+    root_hash = $5,
+    root_sum = $6,
+    asset_name = $7,
+    source_host = $8,
+    synced_at = $9
+`
+
+type UpsertLazyRootParams struct {
+	Namespace  string
+	AssetID    []byte
+	GroupKey   []byte
+	ProofType  string
+	RootHash   []byte
+	RootSum    int64
+	AssetName  string
+	SourceHost string
+	SyncedAt   time.Time
+}
+
+func (q *Queries) UpsertLazyRoot(ctx context.Context, arg UpsertLazyRootParams) error {
+	_, err := q.db.ExecContext(ctx, upsertLazyRoot,
+		arg.Namespace,
+		arg.AssetID,
+		arg.GroupKey,
+		arg.ProofType,
+		arg.RootHash,
+		arg.RootSum,
+		arg.AssetName,
+		arg.SourceHost,
+		arg.SyncedAt,
+	)
+	return err
+}
+
+const upsertSyncCheckpoint = `-- name: UpsertSyncCheckpoint :exec
+INSERT INTO universe_sync_checkpoints (
+    server_host, sync_type, namespace, synced_at
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT(server_host, sync_type, namespace)
+    DO UPDATE SET
+    synced_at = $4
+`
+
+type UpsertSyncCheckpointParams struct {
+	ServerHost string
+	SyncType   string
+	Namespace  string
+	SyncedAt   time.Time
+}
+
+func (q *Queries) UpsertSyncCheckpoint(ctx context.Context, arg UpsertSyncCheckpointParams) error {
+	_, err := q.db.ExecContext(ctx, upsertSyncCheckpoint,
+		arg.ServerHost,
+		arg.SyncType,
+		arg.Namespace,
+		arg.SyncedAt,
+	)
+	return err
+}
+
 const upsertUniverseLeaf = `-- name: UpsertUniverseLeaf :exec
 INSERT INTO universe_leaves (
     asset_genesis_id, script_key_bytes, universe_root_id, leaf_node_key, 
-    leaf_node_namespace, minting_point
+    leaf_node_namespace, minting_point, source_priority
 ) VALUES (
     $1, $2, $3, $4,
-    $5, $6
+    $5, $6, $7
 ) ON CONFLICT (minting_point, script_key_bytes)
     -- This is a NOP, minting_point and script_key_bytes are the unique fields
     -- that caused the conflict.
     DO UPDATE SET minting_point = EXCLUDED.minting_point,
-                  script_key_bytes = EXCLUDED.script_key_bytes
+                  script_key_bytes = EXCLUDED.script_key_bytes,
+                  source_priority = EXCLUDED.source_priority
 `
 
 type UpsertUniverseLeafParams struct {
@@ -886,6 +1728,7 @@ type UpsertUniverseLeafParams struct {
 	LeafNodeKey       []byte
 	LeafNodeNamespace string
 	MintingPoint      []byte
+	SourcePriority    sql.NullInt64
 }
 
 func (q *Queries) UpsertUniverseLeaf(ctx context.Context, arg UpsertUniverseLeafParams) error {
@@ -896,19 +1739,22 @@ func (q *Queries) UpsertUniverseLeaf(ctx context.Context, arg UpsertUniverseLeaf
 		arg.LeafNodeKey,
 		arg.LeafNodeNamespace,
 		arg.MintingPoint,
+		arg.SourcePriority,
 	)
 	return err
 }
 
 const upsertUniverseRoot = `-- name: UpsertUniverseRoot :one
 INSERT INTO universe_roots (
-    namespace_root, asset_id, group_key, proof_type
+    namespace_root, asset_id, group_key, proof_type, asset_type
 ) VALUES (
-    $1, $2, $3, $4
+    $1, $2, $3, $4, $5
 ) ON CONFLICT (namespace_root)
-    -- This is a NOP, namespace_root is the unique field that caused the
-    -- conflict.
-    DO UPDATE SET namespace_root = EXCLUDED.namespace_root
+    -- The namespace_root is the unique field that caused the conflict, so we
+    -- only fill in the asset type in case it wasn't known before.
+    DO UPDATE SET asset_type = COALESCE(
+        universe_roots.asset_type, EXCLUDED.asset_type
+    )
 RETURNING id
 `
 
@@ -917,6 +1763,7 @@ type UpsertUniverseRootParams struct {
 	AssetID       []byte
 	GroupKey      []byte
 	ProofType     string
+	AssetType     sql.NullInt16
 }
 
 func (q *Queries) UpsertUniverseRoot(ctx context.Context, arg UpsertUniverseRootParams) (int64, error) {
@@ -925,6 +1772,7 @@ func (q *Queries) UpsertUniverseRoot(ctx context.Context, arg UpsertUniverseRoot
 		arg.AssetID,
 		arg.GroupKey,
 		arg.ProofType,
+		arg.AssetType,
 	)
 	var id int64
 	err := row.Scan(&id)
@@ -7,6 +7,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/wire"
@@ -38,6 +39,41 @@ type (
 
 	// UniverseLeaf is a universe leaf.
 	UniverseLeaf = sqlc.QueryUniverseLeavesRow
+
+	// DelUniverseLeaf is used to delete a single universe leaf.
+	DelUniverseLeaf = sqlc.DeleteUniverseLeafParams
+
+	// NewUniverseRootHistory is used to record the root of a universe
+	// after a leaf was inserted.
+	NewUniverseRootHistory = sqlc.InsertUniverseRootHistoryParams
+
+	// UniverseRootAtHeightQuery is used to query for the root of a
+	// universe at a given block height.
+	UniverseRootAtHeightQuery = sqlc.FetchUniverseRootAtHeightParams
+
+	// UniverseRootAtHeight is the root of a universe at a given block
+	// height.
+	UniverseRootAtHeight = sqlc.FetchUniverseRootAtHeightRow
+
+	// UniverseRootHistoryQuery is used to query for the most recent root
+	// history entry of a universe with a given root hash.
+	UniverseRootHistoryQuery = sqlc.FetchUniverseRootHistoryIDParams
+
+	// UniverseKeysSinceQuery is used to query for the set of leaf keys
+	// inserted into a universe after a given root history entry.
+	UniverseKeysSinceQuery = sqlc.FetchUniverseKeysSinceParams
+
+	// UniverseKeysSince is a leaf key inserted into a universe after a
+	// given root history entry.
+	UniverseKeysSince = sqlc.FetchUniverseKeysSinceRow
+
+	// UniverseLeafNode is the key and the MS-SMT leaf node of a leaf
+	// stored in a universe tree.
+	UniverseLeafNode = sqlc.FetchUniverseLeafNodesRow
+
+	// PrunedUniverseKeys is the set of leaf keys of the pruned leaves in
+	// a universe.
+	PrunedUniverseKeys = sqlc.FetchPrunedUniverseKeysRow
 )
 
 // BaseUniverseStore is the main interface for the Taproot Asset universe store.
@@ -62,6 +98,10 @@ type BaseUniverseStore interface {
 	// universe tree.
 	DeleteUniverseLeaves(ctx context.Context, namespace string) error
 
+	// DeleteUniverseLeaf is used to delete a single leaf that resides in a
+	// universe tree.
+	DeleteUniverseLeaf(ctx context.Context, arg DelUniverseLeaf) error
+
 	// DeleteUniverseRoot is used to delete the root of a universe tree.
 	DeleteUniverseRoot(ctx context.Context, namespace string) error
 
@@ -74,6 +114,32 @@ type BaseUniverseStore interface {
 	FetchUniverseRoot(ctx context.Context,
 		namespace string) (UniverseRoot, error)
 
+	// FetchUniverseRootAtHeight fetches the root of a universe as it was
+	// after the most recent leaf at or before the given block height was
+	// inserted.
+	FetchUniverseRootAtHeight(ctx context.Context,
+		arg UniverseRootAtHeightQuery) (UniverseRootAtHeight, error)
+
+	// FetchUniverseRootLastHeight fetches the block height of the most
+	// recent leaf that was inserted into a universe.
+	FetchUniverseRootLastHeight(ctx context.Context,
+		namespace string) (int32, error)
+
+	// InsertUniverseRootHistory records the root of a universe after a
+	// leaf was inserted.
+	InsertUniverseRootHistory(ctx context.Context,
+		arg NewUniverseRootHistory) error
+
+	// FetchUniverseRootHistoryID fetches the ID of the most recent root
+	// history entry of a universe with the given root hash.
+	FetchUniverseRootHistoryID(ctx context.Context,
+		arg UniverseRootHistoryQuery) (int64, error)
+
+	// FetchUniverseKeysSince fetches the set of keys of the leaves that
+	// were inserted into a universe after the given root history entry.
+	FetchUniverseKeysSince(ctx context.Context,
+		arg UniverseKeysSinceQuery) ([]UniverseKeysSince, error)
+
 	// UpsertUniverseLeaf upserts a Universe leaf in the database.
 	UpsertUniverseLeaf(ctx context.Context, arg UpsertUniverseLeaf) error
 
@@ -86,6 +152,16 @@ type BaseUniverseStore interface {
 	// for a given namespace.
 	FetchUniverseKeys(ctx context.Context,
 		namespace string) ([]UniverseKeys, error)
+
+	// FetchUniverseLeafNodes fetches the keys and MS-SMT leaf nodes of
+	// all leaves that are stored for a given namespace.
+	FetchUniverseLeafNodes(ctx context.Context,
+		namespace string) ([]UniverseLeafNode, error)
+
+	// FetchPrunedUniverseKeys fetches the set of keys of the leaves that
+	// were pruned for a given namespace.
+	FetchPrunedUniverseKeys(ctx context.Context,
+		namespace string) ([]PrunedUniverseKeys, error)
 }
 
 // BaseUniverseStoreOptions is the set of options for universe tree queries.
@@ -170,6 +246,70 @@ func (b *BaseUniverseTree) RootNode(ctx context.Context) (mssmt.Node, string,
 	), universeRoot.AssetName, nil
 }
 
+// RootNodeAtHeight returns the root node of the universe as it was after the
+// most recent leaf at or before the given block height was inserted.
+func (b *BaseUniverseTree) RootNodeAtHeight(ctx context.Context,
+	height uint32) (mssmt.Node, string, error) {
+
+	var universeRoot UniverseRootAtHeight
+
+	readTx := NewBaseUniverseReadTx()
+
+	dbErr := b.db.ExecTx(ctx, &readTx, func(db BaseUniverseStore) error {
+		dbRoot, err := db.FetchUniverseRootAtHeight(
+			ctx, UniverseRootAtHeightQuery{
+				Namespace:   b.smtNamespace,
+				BlockHeight: int32(height),
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		universeRoot = dbRoot
+		return nil
+	})
+	switch {
+	case errors.Is(dbErr, sql.ErrNoRows):
+		return nil, "", universe.ErrNoUniverseRoot
+	case dbErr != nil:
+		return nil, "", dbErr
+	}
+
+	var nodeHash mssmt.NodeHash
+	copy(nodeHash[:], universeRoot.RootHash)
+
+	return mssmt.NewComputedNode(
+		nodeHash, uint64(universeRoot.RootSum),
+	), universeRoot.AssetName, nil
+}
+
+// LastUpdateHeight returns the block height of the most recent leaf that was
+// inserted into the universe. If no leaf insertion was recorded for the
+// universe, then ErrNoUniverseRoot is returned.
+func (b *BaseUniverseTree) LastUpdateHeight(
+	ctx context.Context) (uint32, error) {
+
+	var height int32
+
+	readTx := NewBaseUniverseReadTx()
+	dbErr := b.db.ExecTx(ctx, &readTx, func(db BaseUniverseStore) error {
+		var err error
+		height, err = db.FetchUniverseRootLastHeight(
+			ctx, b.smtNamespace,
+		)
+		return err
+	})
+	switch {
+	case errors.Is(dbErr, sql.ErrNoRows):
+		return 0, universe.ErrNoUniverseRoot
+	case dbErr != nil:
+		return 0, dbErr
+	}
+
+	return uint32(height), nil
+}
+
 // treeStoreWrapperTx is a wrapper around the BaseUniverseStore that allows us
 // to re-use the internal transaction with the transaction SMT store.
 type treeStoreWrapperTx struct {
@@ -341,6 +481,43 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 	metaReveal *proof.MetaReveal) (*universe.Proof, mssmt.Node,
 	error) {
 
+	universeRoot, err := universeUpsertLeaf(
+		ctx, dbTx, id, key, leaf, metaReveal,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Finally, we'll obtain the merkle proof from the tree for the leaf we
+	// just inserted.
+	universeTree := mssmt.NewCompactedTree(
+		newTreeStoreWrapperTx(dbTx, id.String()),
+	)
+	smtKey := key.UniverseKey()
+	leafInclusionProof, err := universeTree.MerkleProof(ctx, smtKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &universe.Proof{
+		LeafKey:                key,
+		UniverseRoot:           universeRoot,
+		UniverseInclusionProof: leafInclusionProof,
+		Leaf:                   leaf,
+	}, universeRoot, nil
+}
+
+// universeUpsertLeaf upserts a proof leaf within the universe tree (stored at
+// the proof leaf key) and returns the new universe root. Unlike
+// universeUpsertProofLeaf, no inclusion proof is generated for the leaf, which
+// makes this cheaper to call when inserting many leaves at once.
+//
+// NOTE: This function accepts a db transaction, as it's used when making
+// broader DB updates.
+func universeUpsertLeaf(ctx context.Context, dbTx BaseUniverseStore,
+	id universe.Identifier, key universe.LeafKey, leaf *universe.Leaf,
+	metaReveal *proof.MetaReveal) (mssmt.Node, error) {
+
 	namespace := id.String()
 
 	// With the tree store created, we'll now obtain byte representation of
@@ -351,7 +528,7 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 	// so we'll convert that into raw bytes now.
 	leafNode, err := leaf.SmtLeafNode()
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	var groupKeyBytes []byte
@@ -361,14 +538,9 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 
 	mintingPointBytes, err := encodeOutpoint(key.OutPoint)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	var (
-		leafInclusionProof *mssmt.Proof
-		universeRoot       mssmt.Node
-	)
-
 	// First, we'll instantiate a new compact tree instance from the
 	// backing tree store.
 	universeTree := mssmt.NewCompactedTree(
@@ -380,7 +552,16 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 	// its SMT key.
 	_, err = universeTree.Insert(ctx, smtKey, leafNode)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+
+	// Burns are also indexed in the burn tree of the asset, so the burned
+	// supply is committed to in a tree of its own.
+	if isBurnLeaf(id, leaf) {
+		err = upsertBurnLeaf(ctx, dbTx, id, key, leafNode, leaf)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Next, we'll upsert the universe root in the DB, which gives
@@ -391,9 +572,10 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 		AssetID:       fn.ByteSlice(leaf.ID()),
 		GroupKey:      groupKeyBytes,
 		ProofType:     id.ProofType.String(),
+		AssetType:     sqlInt16(leaf.Genesis.Type),
 	})
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	// Before we insert the asset genesis, we'll insert the meta
@@ -403,14 +585,19 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 		ctx, dbTx, &leaf.Genesis, metaReveal,
 	)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	assetGenID, err := upsertAssetGen(
 		ctx, dbTx, leaf.Genesis, leaf.GroupKey, leaf.Proof,
 	)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+
+	var sourcePriority sql.NullInt64
+	if leaf.SourcePriority != nil {
+		sourcePriority = sqlInt64(*leaf.SourcePriority)
 	}
 
 	scriptKeyBytes := schnorr.SerializePubKey(key.ScriptKey.PubKey)
@@ -421,31 +608,126 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 		LeafNodeKey:       smtKey[:],
 		LeafNodeNamespace: namespace,
 		MintingPoint:      mintingPointBytes,
+		SourcePriority:    sourcePriority,
 	})
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	// Finally, we'll obtain the merkle proof from the tree for the
-	// leaf we just inserted.
-	leafInclusionProof, err = universeTree.MerkleProof(ctx, smtKey)
+	// With the insertion complete, we'll now fetch the root of the tree as
+	// it stands and return it to the caller.
+	universeRoot, err := universeTree.Root(ctx)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	// With the insertion complete, we'll now fetch the root of the tree as
-	// it stands and return it to the caller.
-	universeRoot, err = universeTree.Root(ctx)
+	// We also record the new root in the root history, keyed by the block
+	// height of the leaf, so the root can later be looked up as it was at
+	// a given height.
+	rootHash := universeRoot.NodeHash()
+	err = dbTx.InsertUniverseRootHistory(ctx, NewUniverseRootHistory{
+		UniverseRootID:     universeRootID,
+		BlockHeight:        int32(leaf.Proof.BlockHeight),
+		RootHash:           rootHash[:],
+		RootSum:            int64(universeRoot.NodeSum()),
+		LeafNodeKey:        smtKey[:],
+		InsertionTimestamp: time.Now().UTC().Unix(),
+	})
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	return &universe.Proof{
-		LeafKey:                key,
-		UniverseRoot:           universeRoot,
-		UniverseInclusionProof: leafInclusionProof,
-		Leaf:                   leaf,
-	}, universeRoot, nil
+	return universeRoot, nil
+}
+
+// universeDeleteProofLeaf deletes the proof leaf stored at the given key from
+// the universe tree. If the universe tree is empty after the leaf is removed,
+// then the universe root and all its events are deleted as well.
+//
+// This function returns the new universe root, which is nil if the universe
+// tree is now empty, and a bool indicating whether a leaf was actually
+// deleted.
+//
+// NOTE: This function accepts a db transaction, as it's used when making
+// broader DB updates.
+func universeDeleteProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
+	id universe.Identifier, key universe.LeafKey) (mssmt.Node, bool,
+	error) {
+
+	namespace := id.String()
+	smtKey := key.UniverseKey()
+
+	universeTree := mssmt.NewCompactedTree(
+		newTreeStoreWrapperTx(dbTx, namespace),
+	)
+
+	// If the leaf isn't present in the tree, then there's nothing to
+	// delete, so we'll just return the current root.
+	leafNode, err := universeTree.Get(ctx, smtKey)
+	if err != nil {
+		return nil, false, err
+	}
+	if leafNode.IsEmpty() {
+		universeRoot, err := universeTree.Root(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if universeRoot.NodeHash() == mssmt.EmptyTree[0].NodeHash() {
+			return nil, false, nil
+		}
+
+		return universeRoot, false, nil
+	}
+
+	_, err = universeTree.Delete(ctx, smtKey)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// If the leaf was a burn, then it's also removed from the burn tree.
+	if id.ProofType == universe.ProofTypeTransfer {
+		err = deleteBurnLeaf(ctx, dbTx, id, key)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	err = dbTx.DeleteUniverseLeaf(ctx, DelUniverseLeaf{
+		Namespace:   namespace,
+		LeafNodeKey: smtKey[:],
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to delete universe "+
+			"leaf: %w", err)
+	}
+
+	universeRoot, err := universeTree.Root(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// If there are still leaves left in the tree, then we're done here.
+	if universeRoot.NodeHash() != mssmt.EmptyTree[0].NodeHash() {
+		return universeRoot, true, nil
+	}
+
+	// Otherwise, this was the last leaf of the universe, so we'll also
+	// remove the universe root itself, along with any events that
+	// reference it.
+	err = dbTx.DeleteUniverseEvents(ctx, namespace)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to delete universe "+
+			"events: %w", err)
+	}
+
+	err = dbTx.DeleteUniverseRoot(ctx, namespace)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to delete universe "+
+			"root: %w", err)
+	}
+
+	return nil, true, nil
 }
 
 // FetchIssuanceProof returns an issuance proof for the target key. If the key
@@ -536,6 +818,17 @@ func universeFetchProofLeaf(ctx context.Context,
 		return nil, universe.ErrNoUniverseProofFound
 	}
 
+	// The proofs of pruned leaves are no longer known, so we can only
+	// return the leaves that weren't pruned.
+	universeLeaves = fn.Filter(
+		universeLeaves, func(leaf UniverseLeaf) bool {
+			return !leaf.Pruned
+		},
+	)
+	if len(universeLeaves) == 0 {
+		return nil, universe.ErrLeafPruned
+	}
+
 	// Now that we have all the leaves we need to query, we'll look each up
 	// them up in the universe tree, obtaining a merkle proof for each of
 	// them along the way.
@@ -585,6 +878,10 @@ func universeFetchProofLeaf(ctx context.Context,
 				Amt:   uint64(leaf.SumAmt),
 			},
 		}
+		if leaf.SourcePriority.Valid {
+			priority := extractSqlInt64[int](leaf.SourcePriority)
+			issuanceProof.Leaf.SourcePriority = &priority
+		}
 		if id.GroupKey != nil {
 			leafAssetGroup, err := fetchGroupByGenesis(
 				ctx, dbTx, leaf.GenAssetID,
@@ -624,27 +921,14 @@ func (b *BaseUniverseTree) MintingKeys(ctx context.Context,
 		}
 
 		return fn.ForEachErr(universeKeys, func(key UniverseKeys) error {
-			scriptKeyPub, err := schnorr.ParsePubKey(
-				key.ScriptKeyBytes,
-			)
-			if err != nil {
-				return err
-			}
-			scriptKey := asset.NewScriptKey(scriptKeyPub)
-
-			var genPoint wire.OutPoint
-			err = readOutPoint(
-				bytes.NewReader(key.MintingPoint), 0, 0,
-				&genPoint,
+			leafKey, err := decodeLeafKey(
+				key.MintingPoint, key.ScriptKeyBytes,
 			)
 			if err != nil {
 				return err
 			}
 
-			leafKeys = append(leafKeys, universe.LeafKey{
-				OutPoint:  genPoint,
-				ScriptKey: &scriptKey,
-			})
+			leafKeys = append(leafKeys, leafKey)
 
 			return nil
 		})
@@ -656,6 +940,89 @@ func (b *BaseUniverseTree) MintingKeys(ctx context.Context,
 	return leafKeys, nil
 }
 
+// MintingKeysSince returns the keys of all leaves that were inserted into the
+// universe after the universe had the given root hash. If the root hash isn't
+// known as a past root of the universe, then universe.ErrUnknownUniverseRoot
+// is returned.
+func (b *BaseUniverseTree) MintingKeysSince(ctx context.Context,
+	rootHash mssmt.NodeHash) ([]universe.LeafKey, error) {
+
+	var leafKeys []universe.LeafKey
+
+	readTx := NewBaseUniverseReadTx()
+	dbErr := b.db.ExecTx(ctx, &readTx, func(db BaseUniverseStore) error {
+		// We'll first find the point in the root history at which the
+		// universe had the given root. Only entries that also record
+		// the inserted leaf can be used, as we otherwise can't tell
+		// which leaves were inserted afterwards.
+		historyID, err := db.FetchUniverseRootHistoryID(
+			ctx, UniverseRootHistoryQuery{
+				Namespace: b.smtNamespace,
+				RootHash:  rootHash[:],
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		universeKeys, err := db.FetchUniverseKeysSince(
+			ctx, UniverseKeysSinceQuery{
+				Namespace: b.smtNamespace,
+				HistoryID: historyID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		return fn.ForEachErr(
+			universeKeys, func(key UniverseKeysSince) error {
+				leafKey, err := decodeLeafKey(
+					key.MintingPoint, key.ScriptKeyBytes,
+				)
+				if err != nil {
+					return err
+				}
+
+				leafKeys = append(leafKeys, leafKey)
+
+				return nil
+			},
+		)
+	})
+	switch {
+	case errors.Is(dbErr, sql.ErrNoRows):
+		return nil, universe.ErrUnknownUniverseRoot
+	case dbErr != nil:
+		return nil, dbErr
+	}
+
+	return leafKeys, nil
+}
+
+// decodeLeafKey decodes a universe leaf key from its serialized minting point
+// and script key.
+func decodeLeafKey(mintingPoint,
+	scriptKeyBytes []byte) (universe.LeafKey, error) {
+
+	scriptKeyPub, err := schnorr.ParsePubKey(scriptKeyBytes)
+	if err != nil {
+		return universe.LeafKey{}, err
+	}
+	scriptKey := asset.NewScriptKey(scriptKeyPub)
+
+	var genPoint wire.OutPoint
+	err = readOutPoint(bytes.NewReader(mintingPoint), 0, 0, &genPoint)
+	if err != nil {
+		return universe.LeafKey{}, err
+	}
+
+	return universe.LeafKey{
+		OutPoint:  genPoint,
+		ScriptKey: &scriptKey,
+	}, nil
+}
+
 // MintingLeaves returns all the minting leaves inserted into the universe.
 func (b *BaseUniverseTree) MintingLeaves(
 	ctx context.Context) ([]universe.Leaf, error) {
@@ -678,6 +1045,12 @@ func (b *BaseUniverseTree) MintingLeaves(
 		}
 
 		return fn.ForEachErr(universeLeaves, func(dbLeaf UniverseLeaf) error {
+			// The proof of a pruned leaf is no longer known, so
+			// we skip it.
+			if dbLeaf.Pruned {
+				return nil
+			}
+
 			// For each leaf, we'll decode the proof, and then also
 			// fetch the genesis asset information for that leaf.
 			leafAssetGen, err := fetchGenesis(
@@ -723,6 +1096,102 @@ func (b *BaseUniverseTree) MintingLeaves(
 	return leaves, nil
 }
 
+// PruneLeaves prunes the proofs of the leaves stored at the given keys. The
+// pruned leaves keep their hash and sum, so the universe root doesn't change
+// and inclusion proofs for the leaves can still be created, but their proofs
+// can no longer be served. The keys of the leaves that were pruned are
+// returned. Keys of leaves that don't exist or were already pruned are
+// skipped.
+func (b *BaseUniverseTree) PruneLeaves(ctx context.Context,
+	keys []universe.LeafKey) ([]universe.LeafKey, error) {
+
+	var (
+		writeTx BaseUniverseStoreOptions
+		pruned  []universe.LeafKey
+	)
+	dbErr := b.db.ExecTx(ctx, &writeTx, func(db BaseUniverseStore) error {
+		pruned = nil
+
+		universeTree := mssmt.NewCompactedTree(
+			newTreeStoreWrapperTx(db, b.smtNamespace),
+		)
+
+		for _, key := range keys {
+			smtKey := key.UniverseKey()
+			leaf, err := universeTree.Get(ctx, smtKey)
+			if err != nil {
+				return err
+			}
+
+			if leaf.IsEmpty() || leaf.IsPruned() {
+				continue
+			}
+
+			leafHash := leaf.NodeHash()
+			numPruned, err := db.PruneCompactedLeaf(ctx, PruneLeaf{
+				LeafHash:  leafHash[:],
+				Key:       smtKey[:],
+				Namespace: b.smtNamespace,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to prune leaf: %w",
+					err)
+			}
+
+			if numPruned != 0 {
+				pruned = append(pruned, key)
+			}
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return pruned, nil
+}
+
+// PrunedLeafKeys returns the keys of all the leaves of the universe that were
+// pruned.
+func (b *BaseUniverseTree) PrunedLeafKeys(
+	ctx context.Context) ([]universe.LeafKey, error) {
+
+	var leafKeys []universe.LeafKey
+
+	readTx := NewBaseUniverseReadTx()
+	dbErr := b.db.ExecTx(ctx, &readTx, func(db BaseUniverseStore) error {
+		leafKeys = nil
+
+		prunedKeys, err := db.FetchPrunedUniverseKeys(
+			ctx, b.smtNamespace,
+		)
+		if err != nil {
+			return err
+		}
+
+		return fn.ForEachErr(
+			prunedKeys, func(key PrunedUniverseKeys) error {
+				leafKey, err := decodeLeafKey(
+					key.MintingPoint, key.ScriptKeyBytes,
+				)
+				if err != nil {
+					return err
+				}
+
+				leafKeys = append(leafKeys, leafKey)
+
+				return nil
+			},
+		)
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return leafKeys, nil
+}
+
 // DeleteUniverse deletes the entire universe tree.
 func (b *BaseUniverseTree) DeleteUniverse(ctx context.Context) (string, error) {
 	var writeTx BaseUniverseStoreOptions
@@ -755,6 +1224,15 @@ func (b *BaseUniverseTree) DeleteUniverse(ctx context.Context) (string, error) {
 				"tree root: %w", err)
 		}
 
+		// The burn tree of the asset indexes the burns of the
+		// transfer universe, so it's deleted along with it.
+		if b.id.ProofType == universe.ProofTypeTransfer {
+			err = deleteBurnTree(ctx, db, b.id)
+			if err != nil {
+				return err
+			}
+		}
+
 		// Delete any events related to this universe.
 		err = db.DeleteUniverseEvents(ctx, b.smtNamespace)
 		if err != nil {
@@ -2,14 +2,17 @@ package tapdb
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"sort"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/clock"
@@ -23,6 +26,10 @@ type (
 	// DelUniverseServer is used to delete a universe server.
 	DelUniverseServer = sqlc.DeleteUniverseServerParams
 
+	// UniverseServerPriority is used to update the priority of a universe
+	// server.
+	UniverseServerPriority = sqlc.UpdateUniverseServerPriorityParams
+
 	// UpsertFedGlobalSyncConfigParams is used to set the global federation
 	// sync configuration for a given proof type.
 	UpsertFedGlobalSyncConfigParams = sqlc.UpsertFederationGlobalSyncConfigParams
@@ -38,6 +45,33 @@ type (
 	// FedUniSyncConfigs is the universe specific federation sync config
 	// returned from a query.
 	FedUniSyncConfigs = sqlc.FederationUniSyncConfig
+
+	// NewPendingPush is used to queue a new pending federation push.
+	NewPendingPush = sqlc.InsertPendingPushParams
+
+	// PendingPushUpdate is used to update an existing pending federation
+	// push.
+	PendingPushUpdate = sqlc.UpdatePendingPushParams
+
+	// PendingPush is a pending federation push returned from a query.
+	PendingPush = sqlc.QueryPendingPushesRow
+
+	// NewLazyRoot is used to insert or update the lazy root of a
+	// universe.
+	NewLazyRoot = sqlc.UpsertLazyRootParams
+
+	// LazyRoot is the lazy root of a universe returned from a query.
+	LazyRoot = sqlc.UniverseLazyRoot
+
+	// NewSyncCheckpoint is used to add a synced universe to the checkpoint
+	// of an ongoing sync.
+	NewSyncCheckpoint = sqlc.UpsertSyncCheckpointParams
+
+	// SyncCheckpointQuery is used to query the checkpoint of a sync.
+	SyncCheckpointQuery = sqlc.QuerySyncCheckpointParams
+
+	// DelSyncCheckpoint is used to delete the checkpoint of a sync.
+	DelSyncCheckpoint = sqlc.DeleteSyncCheckpointParams
 )
 
 var (
@@ -92,11 +126,53 @@ type UniverseServerStore interface {
 	// DeleteUniverseServer removes a universe server from the store.
 	DeleteUniverseServer(ctx context.Context, r DelUniverseServer) error
 
+	// UpdateUniverseServerPriority updates the priority of a universe
+	// server, returning the number of updated servers.
+	UpdateUniverseServerPriority(ctx context.Context,
+		arg UniverseServerPriority) (int64, error)
+
 	// LogServerSync marks that a server was just synced in the DB.
 	LogServerSync(ctx context.Context, arg sqlc.LogServerSyncParams) error
 
 	// ListUniverseServers returns the total set of all universe servers.
 	ListUniverseServers(ctx context.Context) ([]sqlc.UniverseServer, error)
+
+	// InsertPendingPush queues a new pending federation push.
+	InsertPendingPush(ctx context.Context, arg NewPendingPush) error
+
+	// UpdatePendingPush updates an existing pending federation push.
+	UpdatePendingPush(ctx context.Context, arg PendingPushUpdate) error
+
+	// DeletePendingPush removes a pending federation push.
+	DeletePendingPush(ctx context.Context, id int64) error
+
+	// QueryPendingPushes returns the set of all pending federation pushes.
+	QueryPendingPushes(ctx context.Context) ([]PendingPush, error)
+
+	// UpsertLazyRoot inserts or updates the lazy root of a universe.
+	UpsertLazyRoot(ctx context.Context, arg NewLazyRoot) error
+
+	// FetchLazyRoot returns the lazy root of the universe with the given
+	// namespace.
+	FetchLazyRoot(ctx context.Context, namespace string) (LazyRoot, error)
+
+	// QueryLazyRoots returns the lazy roots of all universes.
+	QueryLazyRoots(ctx context.Context) ([]LazyRoot, error)
+
+	// DeleteLazyRoot removes the lazy root of the universe with the given
+	// namespace.
+	DeleteLazyRoot(ctx context.Context, namespace string) error
+	// UpsertSyncCheckpoint adds a synced universe to the checkpoint of an
+	// ongoing sync.
+	UpsertSyncCheckpoint(ctx context.Context, arg NewSyncCheckpoint) error
+
+	// QuerySyncCheckpoint returns the namespaces of the universes in the
+	// checkpoint of a sync.
+	QuerySyncCheckpoint(ctx context.Context,
+		arg SyncCheckpointQuery) ([]string, error)
+
+	// DeleteSyncCheckpoint removes the checkpoint of a sync.
+	DeleteSyncCheckpoint(ctx context.Context, arg DelSyncCheckpoint) error
 }
 
 // UniverseFederationOptions is the database tx object for the universe server store.
@@ -158,9 +234,25 @@ func (u *UniverseFederationDB) UniverseServers(
 
 		uniServers = fn.Map(servers,
 			func(s sqlc.UniverseServer) universe.ServerAddr {
-				return universe.NewServerAddr(
+				addr := universe.NewServerAddr(
 					s.ID, s.ServerHost,
 				)
+				addr.TLSCert = s.TlsCert
+				addr.LastSyncTime = s.LastSyncTime.UTC()
+				addr.Priority = int(s.Priority)
+
+				if s.SyncIntervalSeconds.Valid {
+					addr.SyncInterval = time.Duration(
+						s.SyncIntervalSeconds.Int64,
+					) * time.Second
+				}
+
+				addr.Proxy = parseServerProxy(
+					s.ProxyAddr, s.ProxyUser,
+					s.ProxyPassword,
+				)
+
+				return addr
 			},
 		)
 
@@ -170,6 +262,22 @@ func (u *UniverseFederationDB) UniverseServers(
 	return uniServers, dbErr
 }
 
+// parseServerProxy parses the proxy config of a universe server from its
+// database columns. If no proxy address is set, then nil is returned.
+func parseServerProxy(addr, user,
+	password sql.NullString) *universe.ProxyConfig {
+
+	if !addr.Valid {
+		return nil
+	}
+
+	return &universe.ProxyConfig{
+		Addr:     addr.String,
+		User:     user.String,
+		Password: password.String,
+	}
+}
+
 // AddServers adds a slice of servers to the federation.
 func (u *UniverseFederationDB) AddServers(ctx context.Context,
 	addrs ...universe.ServerAddr) error {
@@ -180,7 +288,20 @@ func (u *UniverseFederationDB) AddServers(ctx context.Context,
 			addr := NewUniverseServer{
 				ServerHost:   a.HostStr(),
 				LastSyncTime: time.Now(),
+				TlsCert:      a.TLSCert,
+				Priority:     int64(a.Priority),
+			}
+			if a.SyncInterval > 0 {
+				addr.SyncIntervalSeconds = sqlInt64(
+					a.SyncInterval / time.Second,
+				)
+			}
+			if a.Proxy != nil {
+				addr.ProxyAddr = sqlStr(a.Proxy.Addr)
+				addr.ProxyUser = sqlStr(a.Proxy.User)
+				addr.ProxyPassword = sqlStr(a.Proxy.Password)
 			}
+
 			return db.InsertUniverseServer(ctx, addr)
 		})
 	})
@@ -222,6 +343,39 @@ func (u *UniverseFederationDB) RemoveServers(ctx context.Context,
 	})
 }
 
+// UpdateServerPriority updates the priority of the given server, which is
+// identified by its host or, if no host is set, its ID.
+func (u *UniverseFederationDB) UpdateServerPriority(ctx context.Context,
+	addr universe.ServerAddr, priority int) error {
+
+	// As when removing servers, we only target the ID if no host string
+	// is set.
+	uniID := int64(addr.ID)
+	if addr.HostStr() != "" {
+		uniID = -1
+	}
+
+	var writeTx UniverseFederationOptions
+	return u.db.ExecTx(ctx, &writeTx, func(db UniverseServerStore) error {
+		numUpdated, err := db.UpdateUniverseServerPriority(
+			ctx, UniverseServerPriority{
+				Priority:     int64(priority),
+				TargetServer: addr.HostStr(),
+				TargetID:     uniID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		if numUpdated == 0 {
+			return universe.ErrUnknownUniverseServer
+		}
+
+		return nil
+	})
+}
+
 // LogNewSyncs logs a new sync event for each server. This can be used to keep
 // track of the last time we synced with a remote server.
 func (u *UniverseFederationDB) LogNewSyncs(ctx context.Context,
@@ -238,6 +392,340 @@ func (u *UniverseFederationDB) LogNewSyncs(ctx context.Context,
 	})
 }
 
+// QueuePendingPush adds a new failed push to the set of pending pushes.
+func (u *UniverseFederationDB) QueuePendingPush(ctx context.Context,
+	push universe.PendingPush) error {
+
+	var (
+		uniID        = push.UniverseID
+		groupPubKey  []byte
+		assetIDBytes []byte
+	)
+
+	// The group key supersedes the asset ID, so we'll only store one of
+	// them.
+	if uniID.GroupKey != nil {
+		groupPubKey = uniID.GroupKey.SerializeCompressed()
+	} else {
+		assetIDBytes = uniID.AssetID[:]
+	}
+
+	mintingPointBytes, err := encodeOutpoint(push.Key.OutPoint)
+	if err != nil {
+		return err
+	}
+	scriptKeyBytes := schnorr.SerializePubKey(push.Key.ScriptKey.PubKey)
+
+	var writeTx UniverseFederationOptions
+	return u.db.ExecTx(ctx, &writeTx, func(db UniverseServerStore) error {
+		return db.InsertPendingPush(ctx, NewPendingPush{
+			ServerID:        push.ServerAddr.ID,
+			AssetID:         assetIDBytes,
+			GroupKey:        groupPubKey,
+			ProofType:       uniID.ProofType.String(),
+			MintingPoint:    mintingPointBytes,
+			ScriptKeyBytes:  scriptKeyBytes,
+			NumAttempts:     int32(push.NumAttempts),
+			NextAttemptTime: push.NextAttempt.UTC(),
+			LastError:       push.LastErr,
+		})
+	})
+}
+
+// UpdatePendingPush updates the number of attempts, the next attempt time and
+// the last error of an existing pending push.
+func (u *UniverseFederationDB) UpdatePendingPush(ctx context.Context,
+	push universe.PendingPush) error {
+
+	var writeTx UniverseFederationOptions
+	return u.db.ExecTx(ctx, &writeTx, func(db UniverseServerStore) error {
+		return db.UpdatePendingPush(ctx, PendingPushUpdate{
+			ID:              push.ID,
+			NumAttempts:     int32(push.NumAttempts),
+			NextAttemptTime: push.NextAttempt.UTC(),
+			LastError:       push.LastErr,
+		})
+	})
+}
+
+// RemovePendingPush removes a pending push, once it succeeded.
+func (u *UniverseFederationDB) RemovePendingPush(ctx context.Context,
+	id int64) error {
+
+	var writeTx UniverseFederationOptions
+	return u.db.ExecTx(ctx, &writeTx, func(db UniverseServerStore) error {
+		return db.DeletePendingPush(ctx, id)
+	})
+}
+
+// PendingPushes returns the set of all pending pushes. Pending pushes of
+// servers that were removed from the federation are removed along with the
+// server.
+func (u *UniverseFederationDB) PendingPushes(
+	ctx context.Context) ([]universe.PendingPush, error) {
+
+	var pushes []universe.PendingPush
+
+	readTx := NewUniverseFederationReadTx()
+	dbErr := u.db.ExecTx(ctx, &readTx, func(db UniverseServerStore) error {
+		dbPushes, err := db.QueryPendingPushes(ctx)
+		if err != nil {
+			return err
+		}
+
+		pushes = make([]universe.PendingPush, len(dbPushes))
+		for i, dbPush := range dbPushes {
+			proofType, err := universe.ParseStrProofType(
+				dbPush.ProofType,
+			)
+			if err != nil {
+				return err
+			}
+
+			uniID := universe.Identifier{
+				ProofType: proofType,
+			}
+			if dbPush.GroupKey != nil {
+				uniID.GroupKey, err = btcec.ParsePubKey(
+					dbPush.GroupKey,
+				)
+				if err != nil {
+					return fmt.Errorf("unable to parse "+
+						"group key: %w", err)
+				}
+			} else {
+				copy(uniID.AssetID[:], dbPush.AssetID)
+			}
+
+			leafKey, err := decodeLeafKey(
+				dbPush.MintingPoint, dbPush.ScriptKeyBytes,
+			)
+			if err != nil {
+				return err
+			}
+
+			serverAddr := universe.NewServerAddr(
+				dbPush.ServerID, dbPush.ServerHost,
+			)
+			serverAddr.TLSCert = dbPush.TlsCert
+			serverAddr.Proxy = parseServerProxy(
+				dbPush.ProxyAddr, dbPush.ProxyUser,
+				dbPush.ProxyPassword,
+			)
+
+			pushes[i] = universe.PendingPush{
+				ID:          dbPush.ID,
+				ServerAddr:  serverAddr,
+				UniverseID:  uniID,
+				Key:         leafKey,
+				NumAttempts: uint32(dbPush.NumAttempts),
+				NextAttempt: dbPush.NextAttemptTime.UTC(),
+				LastErr:     dbPush.LastError,
+			}
+		}
+
+		return nil
+	})
+
+	return pushes, dbErr
+}
+
+// UpsertLazyRoot inserts a new lazy root, or replaces the lazy root that is
+// already known for the same universe.
+func (u *UniverseFederationDB) UpsertLazyRoot(ctx context.Context,
+	root universe.LazyRoot) error {
+
+	var (
+		uniID        = root.ID
+		groupPubKey  []byte
+		assetIDBytes []byte
+	)
+
+	// The group key supersedes the asset ID, so we'll only store one of
+	// them.
+	if uniID.GroupKey != nil {
+		groupPubKey = uniID.GroupKey.SerializeCompressed()
+	} else {
+		assetIDBytes = uniID.AssetID[:]
+	}
+
+	rootHash := root.NodeHash()
+
+	var writeTx UniverseFederationOptions
+	return u.db.ExecTx(ctx, &writeTx, func(db UniverseServerStore) error {
+		return db.UpsertLazyRoot(ctx, NewLazyRoot{
+			Namespace:  uniID.String(),
+			AssetID:    assetIDBytes,
+			GroupKey:   groupPubKey,
+			ProofType:  uniID.ProofType.String(),
+			RootHash:   rootHash[:],
+			RootSum:    int64(root.NodeSum()),
+			AssetName:  root.AssetName,
+			SourceHost: root.SourceHost,
+			SyncedAt:   u.clock.Now().UTC(),
+		})
+	})
+}
+
+// FetchLazyRoot returns the lazy root of the given universe. If the universe
+// doesn't have a lazy root, then universe.ErrNoUniverseRoot is returned.
+func (u *UniverseFederationDB) FetchLazyRoot(ctx context.Context,
+	id universe.Identifier) (*universe.LazyRoot, error) {
+
+	var lazyRoot *universe.LazyRoot
+
+	readTx := NewUniverseFederationReadTx()
+	dbErr := u.db.ExecTx(ctx, &readTx, func(db UniverseServerStore) error {
+		dbRoot, err := db.FetchLazyRoot(ctx, id.String())
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return universe.ErrNoUniverseRoot
+
+		case err != nil:
+			return err
+		}
+
+		lazyRoot, err = parseLazyRoot(dbRoot)
+		return err
+	})
+
+	return lazyRoot, dbErr
+}
+
+// LazyRoots returns all known lazy roots.
+func (u *UniverseFederationDB) LazyRoots(
+	ctx context.Context) ([]universe.LazyRoot, error) {
+
+	var lazyRoots []universe.LazyRoot
+
+	readTx := NewUniverseFederationReadTx()
+	dbErr := u.db.ExecTx(ctx, &readTx, func(db UniverseServerStore) error {
+		dbRoots, err := db.QueryLazyRoots(ctx)
+		if err != nil {
+			return err
+		}
+
+		lazyRoots = make([]universe.LazyRoot, len(dbRoots))
+		for i, dbRoot := range dbRoots {
+			lazyRoot, err := parseLazyRoot(dbRoot)
+			if err != nil {
+				return err
+			}
+
+			lazyRoots[i] = *lazyRoot
+		}
+
+		return nil
+	})
+
+	return lazyRoots, dbErr
+}
+
+// DeleteLazyRoot removes the lazy root of the given universe. Removing a lazy
+// root that doesn't exist is a no-op.
+func (u *UniverseFederationDB) DeleteLazyRoot(ctx context.Context,
+	id universe.Identifier) error {
+
+	var writeTx UniverseFederationOptions
+	return u.db.ExecTx(ctx, &writeTx, func(db UniverseServerStore) error {
+		return db.DeleteLazyRoot(ctx, id.String())
+	})
+}
+
+// parseLazyRoot parses a lazy root returned from a query.
+func parseLazyRoot(dbRoot LazyRoot) (*universe.LazyRoot, error) {
+	proofType, err := universe.ParseStrProofType(dbRoot.ProofType)
+	if err != nil {
+		return nil, err
+	}
+
+	uniID := universe.Identifier{
+		ProofType: proofType,
+	}
+	if dbRoot.GroupKey != nil {
+		uniID.GroupKey, err = btcec.ParsePubKey(dbRoot.GroupKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse group key: %w",
+				err)
+		}
+	} else {
+		copy(uniID.AssetID[:], dbRoot.AssetID)
+	}
+
+	var rootHash mssmt.NodeHash
+	copy(rootHash[:], dbRoot.RootHash)
+
+	return &universe.LazyRoot{
+		BaseRoot: universe.BaseRoot{
+			ID: uniID,
+			Node: mssmt.NewComputedBranch(
+				rootHash, uint64(dbRoot.RootSum),
+			),
+			AssetName: dbRoot.AssetName,
+			Lazy:      true,
+		},
+		SourceHost: dbRoot.SourceHost,
+		SyncedAt:   dbRoot.SyncedAt.UTC(),
+	}, nil
+}
+
+// MarkUniverseSynced records that the given universe was completely synced by
+// the ongoing sync of the given type with the given server.
+func (u *UniverseFederationDB) MarkUniverseSynced(ctx context.Context,
+	server universe.ServerAddr, syncType universe.SyncType,
+	id universe.Identifier) error {
+
+	var writeTx UniverseFederationOptions
+	return u.db.ExecTx(ctx, &writeTx, func(db UniverseServerStore) error {
+		return db.UpsertSyncCheckpoint(ctx, NewSyncCheckpoint{
+			ServerHost: server.HostStr(),
+			SyncType:   syncType.String(),
+			Namespace:  id.String(),
+			SyncedAt:   u.clock.Now().UTC(),
+		})
+	})
+}
+
+// SyncCheckpoint returns the namespaces of the universes that were completely
+// synced by the last unfinished sync of the given type with the given server.
+func (u *UniverseFederationDB) SyncCheckpoint(ctx context.Context,
+	server universe.ServerAddr,
+	syncType universe.SyncType) (fn.Set[string], error) {
+
+	var namespaces []string
+
+	readTx := NewUniverseFederationReadTx()
+	dbErr := u.db.ExecTx(ctx, &readTx, func(db UniverseServerStore) error {
+		var err error
+		namespaces, err = db.QuerySyncCheckpoint(
+			ctx, SyncCheckpointQuery{
+				ServerHost: server.HostStr(),
+				SyncType:   syncType.String(),
+			},
+		)
+		return err
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return fn.NewSet(namespaces...), nil
+}
+
+// ClearSyncCheckpoint removes the checkpoint of the sync of the given type with
+// the given server.
+func (u *UniverseFederationDB) ClearSyncCheckpoint(ctx context.Context,
+	server universe.ServerAddr, syncType universe.SyncType) error {
+
+	var writeTx UniverseFederationOptions
+	return u.db.ExecTx(ctx, &writeTx, func(db UniverseServerStore) error {
+		return db.DeleteSyncCheckpoint(ctx, DelSyncCheckpoint{
+			ServerHost: server.HostStr(),
+			SyncType:   syncType.String(),
+		})
+	})
+}
+
 // UpsertFederationSyncConfig upserts both the global and universe specific
 // federation sync configs.
 func (u *UniverseFederationDB) UpsertFederationSyncConfig(
@@ -425,4 +913,6 @@ func (u *UniverseFederationDB) QueryFederationSyncConfigs(
 var (
 	_ universe.FederationLog          = (*UniverseFederationDB)(nil)
 	_ universe.FederationSyncConfigDB = (*UniverseFederationDB)(nil)
+	_ universe.LazyRootStore          = (*UniverseFederationDB)(nil)
+	_ universe.SyncCheckpointStore    = (*UniverseFederationDB)(nil)
 )
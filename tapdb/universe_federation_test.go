@@ -7,8 +7,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/clock"
@@ -30,6 +32,23 @@ func newTestFederationDb(t *testing.T,
 	return NewUniverseFederationDB(dbTxer, clock), db
 }
 
+// fetchServers fetches the set of universe servers from the DB. As the last
+// sync time is set by the DB, we make sure it's populated, then clear it so
+// the servers can be compared against the ones we inserted.
+func fetchServers(t *testing.T,
+	fedDB *UniverseFederationDB) []universe.ServerAddr {
+
+	dbAddrs, err := fedDB.UniverseServers(context.Background())
+	require.NoError(t, err)
+
+	for i := range dbAddrs {
+		require.False(t, dbAddrs[i].LastSyncTime.IsZero())
+		dbAddrs[i].LastSyncTime = time.Time{}
+	}
+
+	return dbAddrs
+}
+
 // TestUniverseFederationCRUD tests that we can add and remove servers from the
 // Universe DB, and also update the sync log.
 func TestUniverseFederationCRUD(t *testing.T) {
@@ -67,8 +86,7 @@ func TestUniverseFederationCRUD(t *testing.T) {
 	require.ErrorIs(t, err, universe.ErrDuplicateUniverse)
 
 	// Next, we should be able to fetch all the active hosts.
-	dbAddrs, err := fedDB.UniverseServers(ctx)
-	require.NoError(t, err)
+	dbAddrs := fetchServers(t, fedDB)
 
 	// The hosts we fetched should match exactly the ones we inserted.
 	// However these will have their ID populated.
@@ -84,8 +102,7 @@ func TestUniverseFederationCRUD(t *testing.T) {
 	require.NoError(t, err)
 
 	// We shouldn't be able to find the deleted addr in the DB anymore.
-	dbAddrs, err = fedDB.UniverseServers(ctx)
-	require.NoError(t, err)
+	dbAddrs = fetchServers(t, fedDB)
 	require.NotContains(t, dbAddrs, delAddr1)
 	require.NotContains(t, dbAddrs, delAddr2)
 	require.NotContains(t, dbAddrs, delAddr3)
@@ -98,6 +115,430 @@ func TestUniverseFederationCRUD(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// TestUniverseFederationTLSCert tests that a pinned TLS certificate is
+// persisted alongside the server record.
+func TestUniverseFederationTLSCert(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	fedDB, _ := newTestFederationDb(t, testClock)
+
+	ctx := context.Background()
+
+	// We'll add two servers, only one of which has a pinned cert.
+	pinnedAddr := universe.NewServerAddr(1, "localhost:10029")
+	pinnedAddr.TLSCert = test.RandBytes(64)
+	plainAddr := universe.NewServerAddr(2, "localhost:10030")
+
+	err := fedDB.AddServers(ctx, pinnedAddr, plainAddr)
+	require.NoError(t, err)
+
+	// When reading the servers back, the pinned cert should be returned
+	// for the first server only.
+	dbAddrs := fetchServers(t, fedDB)
+	require.Len(t, dbAddrs, 2)
+	require.Contains(t, dbAddrs, pinnedAddr)
+	require.Contains(t, dbAddrs, plainAddr)
+}
+
+// TestUniverseFederationProxy tests that a server specific proxy is persisted
+// alongside the server record.
+func TestUniverseFederationProxy(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	fedDB, _ := newTestFederationDb(t, testClock)
+
+	ctx := context.Background()
+
+	// We'll add three servers, one with an authenticated proxy, one with
+	// a proxy without credentials, and one without a proxy at all.
+	authAddr := universe.NewServerAddr(1, "universe.onion:10029")
+	authAddr.Proxy = &universe.ProxyConfig{
+		Addr:     "127.0.0.1:9050",
+		User:     "user",
+		Password: "password",
+	}
+	proxyAddr := universe.NewServerAddr(2, "localhost:10030")
+	proxyAddr.Proxy = &universe.ProxyConfig{
+		Addr: "127.0.0.1:9050",
+	}
+	plainAddr := universe.NewServerAddr(3, "localhost:10031")
+
+	err := fedDB.AddServers(ctx, authAddr, proxyAddr, plainAddr)
+	require.NoError(t, err)
+
+	dbAddrs := fetchServers(t, fedDB)
+	require.Len(t, dbAddrs, 3)
+	require.Contains(t, dbAddrs, authAddr)
+	require.Contains(t, dbAddrs, proxyAddr)
+	require.Contains(t, dbAddrs, plainAddr)
+}
+
+// TestUniverseFederationSyncInterval tests that a server specific sync
+// interval is persisted, and that logging a new sync updates the last sync
+// time of the server.
+func TestUniverseFederationSyncInterval(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	fedDB, _ := newTestFederationDb(t, testClock)
+
+	ctx := context.Background()
+
+	// We'll add two servers, only one of which has a specific sync
+	// interval.
+	customAddr := universe.NewServerAddr(1, "localhost:10029")
+	customAddr.SyncInterval = time.Minute * 5
+	defaultAddr := universe.NewServerAddr(2, "localhost:10030")
+
+	err := fedDB.AddServers(ctx, customAddr, defaultAddr)
+	require.NoError(t, err)
+
+	dbAddrs := fetchServers(t, fedDB)
+	require.Len(t, dbAddrs, 2)
+	require.Contains(t, dbAddrs, customAddr)
+	require.Contains(t, dbAddrs, defaultAddr)
+
+	// We'll now log a new sync for the first server in the future. The
+	// last sync time should be updated accordingly.
+	testClock.SetTime(time.Now().Add(time.Hour))
+	err = fedDB.LogNewSyncs(ctx, customAddr)
+	require.NoError(t, err)
+
+	dbAddrs, err = fedDB.UniverseServers(ctx)
+	require.NoError(t, err)
+	for _, addr := range dbAddrs {
+		switch addr.HostStr() {
+		case customAddr.HostStr():
+			require.Equal(
+				t, testClock.Now().Unix(),
+				addr.LastSyncTime.Unix(),
+			)
+
+		default:
+			require.True(t, addr.LastSyncTime.Before(
+				testClock.Now(),
+			))
+		}
+	}
+}
+
+// TestUniverseFederationPriority tests that the priority of a server is
+// persisted, and that it can be updated without re-adding the server.
+func TestUniverseFederationPriority(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	fedDB, _ := newTestFederationDb(t, testClock)
+
+	ctx := context.Background()
+
+	highAddr := universe.NewServerAddr(1, "localhost:10029")
+	highAddr.Priority = 10
+	defaultAddr := universe.NewServerAddr(2, "localhost:10030")
+
+	err := fedDB.AddServers(ctx, highAddr, defaultAddr)
+	require.NoError(t, err)
+
+	dbAddrs := fetchServers(t, fedDB)
+	require.Len(t, dbAddrs, 2)
+	require.Contains(t, dbAddrs, highAddr)
+	require.Contains(t, dbAddrs, defaultAddr)
+
+	// We'll now update the priority of the second server, once by its
+	// host and once by its ID.
+	err = fedDB.UpdateServerPriority(ctx, defaultAddr, 20)
+	require.NoError(t, err)
+
+	defaultAddr.Priority = 20
+	require.Contains(t, fetchServers(t, fedDB), defaultAddr)
+
+	err = fedDB.UpdateServerPriority(
+		ctx, universe.ServerAddr{ID: defaultAddr.ID}, -5,
+	)
+	require.NoError(t, err)
+
+	defaultAddr.Priority = -5
+	dbAddrs = fetchServers(t, fedDB)
+	require.Contains(t, dbAddrs, defaultAddr)
+	require.Contains(t, dbAddrs, highAddr)
+
+	// Updating a server that isn't part of the federation should fail.
+	err = fedDB.UpdateServerPriority(
+		ctx, universe.NewServerAddr(3, "localhost:10031"), 1,
+	)
+	require.ErrorIs(t, err, universe.ErrUnknownUniverseServer)
+}
+
+// TestUniverseFederationPendingPushes tests that we can queue, update and
+// remove pending pushes, and that the pending pushes of a server are removed
+// along with the server.
+func TestUniverseFederationPendingPushes(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	fedDB, _ := newTestFederationDb(t, testClock)
+
+	ctx := context.Background()
+
+	addrA := universe.NewServerAddr(1, "localhost:10029")
+	addrA.TLSCert = test.RandBytes(64)
+	addrB := universe.NewServerAddr(2, "localhost:10030")
+	addrB.Proxy = &universe.ProxyConfig{
+		Addr: "127.0.0.1:9050",
+	}
+
+	err := fedDB.AddServers(ctx, addrA, addrB)
+	require.NoError(t, err)
+
+	// We'll queue a push of a leaf of an asset ID universe to the first
+	// server, and a push of a leaf of a group key universe to both
+	// servers.
+	assetUniID := randUniverseID(t, false)
+	assetUniID.GroupKey = nil
+
+	groupUniID := randUniverseID(t, true)
+	groupUniID.AssetID = asset.ID{}
+
+	nextAttempt := time.Now().Add(time.Minute)
+	pushes := []universe.PendingPush{{
+		ServerAddr:  addrA,
+		UniverseID:  assetUniID,
+		Key:         randLeafKey(t),
+		NumAttempts: 1,
+		NextAttempt: nextAttempt,
+		LastErr:     "connection refused",
+	}, {
+		ServerAddr:  addrA,
+		UniverseID:  groupUniID,
+		Key:         randLeafKey(t),
+		NumAttempts: 1,
+		NextAttempt: nextAttempt,
+		LastErr:     "connection refused",
+	}, {
+		ServerAddr:  addrB,
+		UniverseID:  groupUniID,
+		Key:         randLeafKey(t),
+		NumAttempts: 1,
+		NextAttempt: nextAttempt,
+		LastErr:     "connection refused",
+	}}
+	for _, push := range pushes {
+		require.NoError(t, fedDB.QueuePendingPush(ctx, push))
+	}
+
+	assertPushes := func(expected ...universe.PendingPush) {
+		t.Helper()
+
+		dbPushes, err := fedDB.PendingPushes(ctx)
+		require.NoError(t, err)
+		require.Len(t, dbPushes, len(expected))
+
+		for i, dbPush := range dbPushes {
+			push := expected[i]
+
+			require.Equal(
+				t, push.ServerAddr.HostStr(),
+				dbPush.ServerAddr.HostStr(),
+			)
+			require.Equal(
+				t, push.ServerAddr.TLSCert,
+				dbPush.ServerAddr.TLSCert,
+			)
+			require.Equal(
+				t, push.ServerAddr.Proxy,
+				dbPush.ServerAddr.Proxy,
+			)
+			require.Equal(
+				t, push.UniverseID.String(),
+				dbPush.UniverseID.String(),
+			)
+			require.Equal(
+				t, push.Key.UniverseKey(),
+				dbPush.Key.UniverseKey(),
+			)
+			require.Equal(t, push.NumAttempts, dbPush.NumAttempts)
+			require.Equal(
+				t, push.NextAttempt.Unix(),
+				dbPush.NextAttempt.Unix(),
+			)
+			require.Equal(t, push.LastErr, dbPush.LastErr)
+		}
+	}
+	assertPushes(pushes...)
+
+	// We'll now update the first push after another failed attempt.
+	dbPushes, err := fedDB.PendingPushes(ctx)
+	require.NoError(t, err)
+
+	pushes[0].ID = dbPushes[0].ID
+	pushes[0].NumAttempts = 2
+	pushes[0].NextAttempt = nextAttempt.Add(time.Minute)
+	pushes[0].LastErr = "proof rejected"
+	require.NoError(t, fedDB.UpdatePendingPush(ctx, pushes[0]))
+	assertPushes(pushes...)
+
+	// Once the second push succeeds, it's removed.
+	require.NoError(t, fedDB.RemovePendingPush(ctx, dbPushes[1].ID))
+	assertPushes(pushes[0], pushes[2])
+
+	// Removing the first server from the federation also removes all its
+	// pending pushes.
+	require.NoError(t, fedDB.RemoveServers(ctx, addrA))
+	assertPushes(pushes[2])
+}
+
+// TestUniverseFederationLazyRoots tests that we can insert, update, query and
+// remove the lazy roots imported by a roots only sync.
+func TestUniverseFederationLazyRoots(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	fedDB, _ := newTestFederationDb(t, testClock)
+
+	ctx := context.Background()
+
+	assetUniID := randUniverseID(t, false)
+	assetUniID.GroupKey = nil
+
+	groupUniID := randUniverseID(t, true)
+	groupUniID.AssetID = asset.ID{}
+
+	// Before we insert anything, there are no lazy roots.
+	_, err := fedDB.FetchLazyRoot(ctx, assetUniID)
+	require.ErrorIs(t, err, universe.ErrNoUniverseRoot)
+
+	newLazyRoot := func(id universe.Identifier,
+		sum uint64) universe.LazyRoot {
+
+		return universe.LazyRoot{
+			BaseRoot: universe.BaseRoot{
+				ID: id,
+				Node: mssmt.NewComputedBranch(
+					mssmt.NodeHash(test.RandHash()), sum,
+				),
+				AssetName: "lazy-asset",
+			},
+			SourceHost: "universe.example.com:10029",
+		}
+	}
+	assertLazyRoot := func(expected universe.LazyRoot,
+		dbRoot universe.LazyRoot) {
+
+		t.Helper()
+
+		require.Equal(t, expected.ID.String(), dbRoot.ID.String())
+		require.Equal(t, expected.NodeHash(), dbRoot.NodeHash())
+		require.Equal(t, expected.NodeSum(), dbRoot.NodeSum())
+		require.Equal(t, expected.AssetName, dbRoot.AssetName)
+		require.Equal(t, expected.SourceHost, dbRoot.SourceHost)
+		require.True(t, dbRoot.Lazy)
+		require.Equal(
+			t, testClock.Now().Unix(), dbRoot.SyncedAt.Unix(),
+		)
+	}
+
+	assetRoot := newLazyRoot(assetUniID, 100)
+	groupRoot := newLazyRoot(groupUniID, 200)
+	require.NoError(t, fedDB.UpsertLazyRoot(ctx, assetRoot))
+	require.NoError(t, fedDB.UpsertLazyRoot(ctx, groupRoot))
+
+	dbRoot, err := fedDB.FetchLazyRoot(ctx, groupUniID)
+	require.NoError(t, err)
+	assertLazyRoot(groupRoot, *dbRoot)
+
+	// A later roots only sync replaces the lazy root of a universe.
+	assetRoot = newLazyRoot(assetUniID, 150)
+	require.NoError(t, fedDB.UpsertLazyRoot(ctx, assetRoot))
+
+	dbRoots, err := fedDB.LazyRoots(ctx)
+	require.NoError(t, err)
+	require.Len(t, dbRoots, 2)
+	for _, dbRoot := range dbRoots {
+		if dbRoot.ID.GroupKey != nil {
+			assertLazyRoot(groupRoot, dbRoot)
+		} else {
+			assertLazyRoot(assetRoot, dbRoot)
+		}
+	}
+
+	// Once a universe is fully backed, its lazy root is removed.
+	require.NoError(t, fedDB.DeleteLazyRoot(ctx, assetUniID))
+
+	_, err = fedDB.FetchLazyRoot(ctx, assetUniID)
+	require.ErrorIs(t, err, universe.ErrNoUniverseRoot)
+
+	dbRoots, err = fedDB.LazyRoots(ctx)
+	require.NoError(t, err)
+	require.Len(t, dbRoots, 1)
+	assertLazyRoot(groupRoot, dbRoots[0])
+}
+
+// TestUniverseFederationSyncCheckpoint tests that we're able to record,
+// fetch and clear the checkpoint of a sync with a universe server.
+func TestUniverseFederationSyncCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	fedDB, _ := newTestFederationDb(t, testClock)
+
+	ctx := context.Background()
+
+	server := universe.NewServerAddrFromStr("universe.example.com:10029")
+	otherServer := universe.NewServerAddrFromStr("other.example.com:10029")
+
+	assetUniID := randUniverseID(t, false)
+	groupUniID := randUniverseID(t, true)
+
+	// Before anything is synced, the checkpoint is empty.
+	checkpoint, err := fedDB.SyncCheckpoint(ctx, server, universe.SyncFull)
+	require.NoError(t, err)
+	require.Empty(t, checkpoint)
+
+	// Marking the same universe twice is fine.
+	for _, id := range []universe.Identifier{
+		assetUniID, groupUniID, assetUniID,
+	} {
+		err := fedDB.MarkUniverseSynced(
+			ctx, server, universe.SyncFull, id,
+		)
+		require.NoError(t, err)
+	}
+	err = fedDB.MarkUniverseSynced(
+		ctx, otherServer, universe.SyncFull, assetUniID,
+	)
+	require.NoError(t, err)
+
+	checkpoint, err = fedDB.SyncCheckpoint(ctx, server, universe.SyncFull)
+	require.NoError(t, err)
+	require.Equal(t, fn.NewSet(
+		assetUniID.String(), groupUniID.String(),
+	), checkpoint)
+
+	// The checkpoint is specific to the sync type.
+	checkpoint, err = fedDB.SyncCheckpoint(
+		ctx, server, universe.SyncIssuance,
+	)
+	require.NoError(t, err)
+	require.Empty(t, checkpoint)
+
+	// Clearing the checkpoint of a server doesn't affect the checkpoint
+	// of another server.
+	err = fedDB.ClearSyncCheckpoint(ctx, server, universe.SyncFull)
+	require.NoError(t, err)
+
+	checkpoint, err = fedDB.SyncCheckpoint(ctx, server, universe.SyncFull)
+	require.NoError(t, err)
+	require.Empty(t, checkpoint)
+
+	checkpoint, err = fedDB.SyncCheckpoint(
+		ctx, otherServer, universe.SyncFull,
+	)
+	require.NoError(t, err)
+	require.Equal(t, fn.NewSet(assetUniID.String()), checkpoint)
+}
+
 // TestFederationConfigDefault tests that we're able to fetch the default
 // federation config.
 func TestFederationConfigDefault(t *testing.T) {
@@ -25,6 +25,10 @@ type (
 	// leaf sync.
 	NewSyncEvent = sqlc.InsertNewSyncEventParams
 
+	// NewPushEvent is used to create a new event that logs a Universe leaf
+	// being pushed to a federation server.
+	NewPushEvent = sqlc.InsertNewPushEventParams
+
 	// UniverseStatsQuery is used to query the stats for a given universe.
 	UniverseStatsQuery = sqlc.QueryUniverseAssetStatsParams
 
@@ -60,6 +64,9 @@ type UniverseStatsStore interface {
 	// InsertNewSyncEvent inserts a new sync event into the database.
 	InsertNewSyncEvent(ctx context.Context, arg NewSyncEvent) error
 
+	// InsertNewPushEvent inserts a new push event into the database.
+	InsertNewPushEvent(ctx context.Context, arg NewPushEvent) error
+
 	// QueryUniverseStats returns the aggregated stats for the entire
 	QueryUniverseStats(ctx context.Context) (AggregateStats, error)
 
@@ -229,6 +236,30 @@ func (u *UniverseStats) LogNewProofEvents(ctx context.Context,
 	})
 }
 
+// LogPushEvent logs that a leaf of the target universe was pushed to the given
+// federation server.
+func (u *UniverseStats) LogPushEvent(ctx context.Context,
+	uniID universe.Identifier, key universe.LeafKey,
+	serverHost string) error {
+
+	var writeTxOpts UniverseStatsOptions
+	return u.db.ExecTx(ctx, &writeTxOpts, func(db UniverseStatsStore) error {
+		var groupKeyXOnly []byte
+		if uniID.GroupKey != nil {
+			groupKeyXOnly = schnorr.SerializePubKey(uniID.GroupKey)
+		}
+
+		return db.InsertNewPushEvent(ctx, NewPushEvent{
+			EventTime:      u.clock.Now().UTC(),
+			EventTimestamp: u.clock.Now().UTC().Unix(),
+			AssetID:        uniID.AssetID[:],
+			GroupKeyXOnly:  groupKeyXOnly,
+			ProofType:      uniID.ProofType.String(),
+			ServerHost:     serverHost,
+		})
+	})
+}
+
 // AggregateSyncStats returns stats aggregated over all assets within the
 // Universe.
 func (u *UniverseStats) AggregateSyncStats(
@@ -270,6 +301,18 @@ func (u *UniverseStats) AggregateSyncStats(
 		if err != nil {
 			return err
 		}
+		stats.NumTotalLeaves, err = parseCoalesceNumericType[uint64](
+			uniStats.TotalNumLeaves,
+		)
+		if err != nil {
+			return err
+		}
+		stats.NumTotalPushes, err = parseCoalesceNumericType[uint64](
+			uniStats.TotalPushes,
+		)
+		if err != nil {
+			return err
+		}
 
 		return nil
 	})
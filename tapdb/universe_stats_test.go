@@ -93,6 +93,15 @@ func (u *uniStatsHarness) logSyncEventByIndex(i int) {
 	require.NoError(u.t, err)
 }
 
+func (u *uniStatsHarness) logPushEventByIndex(i int) {
+	ctx := context.Background()
+	err := u.db.LogPushEvent(
+		ctx, u.assetUniverses[i].id, u.universeLeaves[i].LeafKey,
+		"localhost:10029",
+	)
+	require.NoError(u.t, err)
+}
+
 func (u *uniStatsHarness) assertUniverseStatsEqual(t *testing.T,
 	stats universe.AggregateStats) {
 
@@ -134,6 +143,7 @@ func TestUniverseStatsEvents(t *testing.T) {
 		NumTotalGroups: numGroups,
 		NumTotalProofs: 0,
 		NumTotalSyncs:  0,
+		NumTotalLeaves: numTranches,
 	})
 
 	// Now that we have our assets, we'll insert a new sync event for each
@@ -153,6 +163,7 @@ func TestUniverseStatsEvents(t *testing.T) {
 		NumTotalGroups: numGroups,
 		NumTotalProofs: numTranches,
 		NumTotalSyncs:  0,
+		NumTotalLeaves: numTranches,
 	})
 
 	// Next, we'll simulate a new sync event for a random asset. If we
@@ -167,6 +178,20 @@ func TestUniverseStatsEvents(t *testing.T) {
 		NumTotalGroups: numGroups,
 		NumTotalProofs: numTranches,
 		NumTotalSyncs:  1,
+		NumTotalLeaves: numTranches,
+	})
+
+	// Pushing the same asset out to a federation server should only bump
+	// the number of pushes.
+	sh.logPushEventByIndex(assetToSync)
+
+	sh.assertUniverseStatsEqual(t, universe.AggregateStats{
+		NumTotalAssets: numTranches,
+		NumTotalGroups: numGroups,
+		NumTotalProofs: numTranches,
+		NumTotalSyncs:  1,
+		NumTotalLeaves: numTranches,
+		NumTotalPushes: 1,
 	})
 
 	// We'll now query for the set of Universe events. There should be 4
@@ -229,6 +254,8 @@ func TestUniverseStatsEvents(t *testing.T) {
 		NumTotalGroups: numGroups,
 		NumTotalProofs: numTranches - 1,
 		NumTotalSyncs:  0,
+		NumTotalLeaves: numTranches - 1,
+		NumTotalPushes: 0,
 	})
 }
 
@@ -3,13 +3,16 @@ package tapdb
 import (
 	"context"
 	"database/sql"
+	"encoding/hex"
 	"math"
 	"math/rand"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
@@ -355,6 +358,148 @@ func TestUniverseIssuanceProofs(t *testing.T) {
 	require.ErrorIs(t, err, universe.ErrNoUniverseRoot)
 }
 
+// TestUniverseRootHistory tests that the root of a universe can be looked up as
+// it was at a given block height.
+func TestUniverseRootHistory(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	id := randUniverseID(t, false)
+	baseUniverse, _ := newTestUniverse(t, id)
+
+	assetGen := asset.RandGenesis(t, asset.Normal)
+
+	// We'll insert three leaves, each confirmed at a different height,
+	// and note the root after each insertion.
+	heights := []uint32{100, 200, 300}
+	roots := make([]mssmt.Node, len(heights))
+	for i, height := range heights {
+		leaf := randMintingLeaf(t, assetGen, id.GroupKey)
+		leaf.Proof.BlockHeight = height
+
+		_, err := baseUniverse.RegisterIssuance(
+			ctx, randLeafKey(t), &leaf, nil,
+		)
+		require.NoError(t, err)
+
+		roots[i], _, err = baseUniverse.RootNode(ctx)
+		require.NoError(t, err)
+	}
+
+	// Before the first leaf was confirmed, there was no root yet.
+	_, _, err := baseUniverse.RootNodeAtHeight(ctx, heights[0]-1)
+	require.ErrorIs(t, err, universe.ErrNoUniverseRoot)
+
+	// At or after the height of each leaf, we expect the root that was
+	// created by inserting that leaf.
+	for i, height := range heights {
+		root, assetName, err := baseUniverse.RootNodeAtHeight(
+			ctx, height,
+		)
+		require.NoError(t, err)
+		require.True(t, mssmt.IsEqualNode(roots[i], root))
+		require.Equal(t, assetGen.Tag, assetName)
+
+		root, _, err = baseUniverse.RootNodeAtHeight(ctx, height+50)
+		require.NoError(t, err)
+		require.True(t, mssmt.IsEqualNode(roots[i], root))
+	}
+
+	// Once the universe is deleted, its history is gone as well.
+	_, err = baseUniverse.DeleteUniverse(ctx)
+	require.NoError(t, err)
+
+	_, _, err = baseUniverse.RootNodeAtHeight(ctx, heights[2])
+	require.ErrorIs(t, err, universe.ErrNoUniverseRoot)
+}
+
+// TestUniverseLastUpdateHeight tests that the height of the most recent leaf
+// inserted into a universe is returned, regardless of the insertion order.
+func TestUniverseLastUpdateHeight(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	id := randUniverseID(t, false)
+	baseUniverse, _ := newTestUniverse(t, id)
+
+	// Without any leaves, the last update height isn't known. Deleting
+	// such an empty universe should succeed as well.
+	_, err := baseUniverse.LastUpdateHeight(ctx)
+	require.ErrorIs(t, err, universe.ErrNoUniverseRoot)
+
+	_, err = baseUniverse.DeleteUniverse(ctx)
+	require.NoError(t, err)
+
+	assetGen := asset.RandGenesis(t, asset.Normal)
+	for _, height := range []uint32{100, 250, 180} {
+		leaf := randMintingLeaf(t, assetGen, id.GroupKey)
+		leaf.Proof.BlockHeight = height
+
+		_, err := baseUniverse.RegisterIssuance(
+			ctx, randLeafKey(t), &leaf, nil,
+		)
+		require.NoError(t, err)
+	}
+
+	height, err := baseUniverse.LastUpdateHeight(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 250, height)
+
+	// Once the universe is deleted, the height is no longer known.
+	_, err = baseUniverse.DeleteUniverse(ctx)
+	require.NoError(t, err)
+
+	_, err = baseUniverse.LastUpdateHeight(ctx)
+	require.ErrorIs(t, err, universe.ErrNoUniverseRoot)
+}
+
+// TestUniverseMintingKeysSince tests that we can fetch the set of keys that
+// were inserted into a universe since it had a given root.
+func TestUniverseMintingKeysSince(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	id := randUniverseID(t, false)
+	baseUniverse, _ := newTestUniverse(t, id)
+
+	assetGen := asset.RandGenesis(t, asset.Normal)
+
+	// We'll insert a few leaves, noting the key and the resulting root
+	// after each insertion.
+	const numLeaves = 4
+	keys := make([]universe.LeafKey, numLeaves)
+	roots := make([]mssmt.Node, numLeaves)
+	for i := 0; i < numLeaves; i++ {
+		keys[i] = randLeafKey(t)
+		leaf := randMintingLeaf(t, assetGen, id.GroupKey)
+
+		_, err := baseUniverse.RegisterIssuance(
+			ctx, keys[i], &leaf, nil,
+		)
+		require.NoError(t, err)
+
+		roots[i], _, err = baseUniverse.RootNode(ctx)
+		require.NoError(t, err)
+	}
+
+	// For each root, we expect exactly the keys inserted afterwards.
+	for i, root := range roots {
+		keysSince, err := baseUniverse.MintingKeysSince(
+			ctx, root.NodeHash(),
+		)
+		require.NoError(t, err)
+		require.ElementsMatch(t, keys[i+1:], keysSince)
+	}
+
+	// A root the universe never had is unknown.
+	randRoot := mssmt.NodeHash(asset.RandID(t))
+	_, err := baseUniverse.MintingKeysSince(ctx, randRoot)
+	require.ErrorIs(t, err, universe.ErrUnknownUniverseRoot)
+}
+
 // TestUniverseMetaBlob tests that leaves inserted with a meta reveal can be
 // properly retrieved.
 func TestUniverseMetaBlob(t *testing.T) {
@@ -439,7 +584,12 @@ func TestUniverseTreeIsolation(t *testing.T) {
 	groupLeaf, err := insertRandLeaf(t, ctx, groupUniverse, nil)
 	require.NoError(t, err)
 
-	normalLeaf, err := insertRandLeaf(t, ctx, normalUniverse, nil)
+	// The non-grouped universe holds a collectible, so we can make sure the
+	// asset type is tracked for each root.
+	collectibleGen := asset.RandGenesis(t, asset.Collectible)
+	normalLeaf, err := insertRandLeaf(
+		t, ctx, normalUniverse, &collectibleGen,
+	)
 	require.NoError(t, err)
 
 	// We should be able to get the roots for both of the trees.
@@ -463,7 +613,9 @@ func TestUniverseTreeIsolation(t *testing.T) {
 	)
 	multiverse := NewMultiverseStore(multiverseDB)
 
-	rootNodes, err := multiverse.RootNodes(ctx)
+	rootNodes, err := multiverse.RootNodes(
+		ctx, universe.RootNodesQuery{},
+	)
 	require.NoError(t, err)
 
 	// We should be able to find both of the roots we've inserted above.
@@ -479,6 +631,15 @@ func TestUniverseTreeIsolation(t *testing.T) {
 		return false
 	}))
 
+	// Each of the universes holds exactly one leaf, and carries the time
+	// that leaf was inserted as its last update time.
+	for _, root := range rootNodes {
+		require.EqualValues(t, 1, root.NumLeaves)
+		require.WithinDuration(
+			t, time.Now(), root.LastUpdated, time.Minute,
+		)
+	}
+
 	// Similarly, each of the roots should have the proper proof type set.
 	require.True(t, fn.All(rootNodes, func(root universe.BaseRoot) bool {
 		switch root.ID.ProofType {
@@ -503,6 +664,57 @@ func TestUniverseTreeIsolation(t *testing.T) {
 		}
 	}
 
+	// Each root should also carry the asset type of its leaves.
+	for _, root := range rootNodes {
+		switch {
+		case mssmt.IsEqualNode(root.Node, groupRoot):
+			require.Equal(
+				t, groupLeaf.Leaf.Genesis.Type, root.AssetType,
+			)
+
+		case mssmt.IsEqualNode(root.Node, normalRoot):
+			require.Equal(
+				t, normalLeaf.Leaf.Genesis.Type, root.AssetType,
+			)
+		}
+	}
+
+	// Querying by an ID prefix should only return the matching root. The
+	// group key is matched for grouped universes, the asset ID otherwise.
+	// We use an odd length prefix to also cover partial bytes.
+	rootIDHex := func(id universe.Identifier) string {
+		if id.GroupKey != nil {
+			return hex.EncodeToString(
+				schnorr.SerializePubKey(id.GroupKey),
+			)
+		}
+
+		return hex.EncodeToString(id.AssetID[:])
+	}
+	noRootsPrefix := "0000"
+	for _, root := range rootNodes {
+		idHex := rootIDHex(root.ID)
+		prefixRoots, err := multiverse.RootNodes(
+			ctx, universe.RootNodesQuery{
+				IDPrefix: idHex[:5],
+			},
+		)
+		require.NoError(t, err)
+		require.Len(t, prefixRoots, 1)
+		require.True(t, mssmt.IsEqualNode(prefixRoots[0].Node, root))
+
+		if strings.HasPrefix(idHex, noRootsPrefix) {
+			noRootsPrefix = "ffff"
+		}
+	}
+
+	// A prefix that matches neither universe should return no roots.
+	noRoots, err := multiverse.RootNodes(ctx, universe.RootNodesQuery{
+		IDPrefix: noRootsPrefix,
+	})
+	require.NoError(t, err)
+	require.Empty(t, noRoots)
+
 	// We should be able to delete one Universe with no effect on the other.
 	normalNamespace, err := normalUniverse.DeleteUniverse(ctx)
 	require.NoError(t, err)
@@ -514,12 +726,92 @@ func TestUniverseTreeIsolation(t *testing.T) {
 	require.ErrorIs(t, err, universe.ErrNoUniverseRoot)
 
 	// The deleted universe should not be present in the multiverse.
-	rootNodes, err = multiverse.RootNodes(ctx)
+	rootNodes, err = multiverse.RootNodes(
+		ctx, universe.RootNodesQuery{},
+	)
 	require.NoError(t, err)
 	require.Len(t, rootNodes, 1)
 	require.True(t, mssmt.IsEqualNode(rootNodes[0].Node, groupRoot))
 }
 
+// TestMultiverseSearchRoots tests that we're able to search for universe roots
+// by asset name, case-insensitively and with pagination.
+func TestMultiverseSearchRoots(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db := NewTestDB(t)
+
+	// We'll create an issuance universe for each of the following asset
+	// names. The names contain LIKE wildcards to make sure they're matched
+	// literally.
+	assetNames := []string{"Foo_Bar", "foobaz", "baz%", "QUUX"}
+	for _, name := range assetNames {
+		gen := asset.RandGenesis(t, asset.Normal)
+		gen.Tag = name
+
+		id := randUniverseID(
+			t, false, withProofType(universe.ProofTypeIssuance),
+		)
+		baseUniverse, _ := newTestUniverseWithDb(db.BaseDB, id)
+
+		_, err := insertRandLeaf(t, ctx, baseUniverse, &gen)
+		require.NoError(t, err)
+	}
+
+	multiverseDB := NewTransactionExecutor(db,
+		func(tx *sql.Tx) BaseMultiverseStore {
+			return db.WithTx(tx)
+		},
+	)
+	multiverse := NewMultiverseStore(multiverseDB)
+
+	searchNames := func(name string, offset, limit int) []string {
+		roots, err := multiverse.SearchRoots(
+			ctx, universe.AssetSearchQuery{
+				Name:   name,
+				Offset: offset,
+				Limit:  limit,
+			},
+		)
+		require.NoError(t, err)
+
+		return fn.Map(roots, func(root universe.BaseRoot) string {
+			return root.AssetName
+		})
+	}
+
+	// The name should be matched case-insensitively and the results
+	// sorted by name.
+	require.Equal(
+		t, []string{"Foo_Bar", "foobaz"}, searchNames("FOO", 0, 10),
+	)
+	require.Equal(t, []string{"QUUX"}, searchNames("uu", 0, 10))
+
+	// Wildcards in the name should be matched literally.
+	require.Equal(t, []string{"Foo_Bar"}, searchNames("o_b", 0, 10))
+	require.Equal(t, []string{"baz%"}, searchNames("%", 0, 10))
+	require.Empty(t, searchNames("nope", 0, 10))
+
+	// An empty name matches all roots, which we can page through.
+	require.Equal(t, []string{"baz%", "Foo_Bar"}, searchNames("", 0, 2))
+	require.Equal(t, []string{"foobaz", "QUUX"}, searchNames("", 2, 2))
+	require.Empty(t, searchNames("", 4, 2))
+
+	// An invalid offset or limit should be rejected.
+	_, err := multiverse.SearchRoots(ctx, universe.AssetSearchQuery{
+		Limit: 0,
+	})
+	require.Error(t, err)
+
+	_, err = multiverse.SearchRoots(ctx, universe.AssetSearchQuery{
+		Offset: -1,
+		Limit:  1,
+	})
+	require.Error(t, err)
+}
+
 // TestUniverseLeafQuery tests that we're able to properly query for the set of
 // leaves in a Universe based on either the outpoint or the script key.
 func TestUniverseLeafQuery(t *testing.T) {
@@ -899,3 +1191,302 @@ func TestMultiverseRootSum(t *testing.T) {
 		})
 	}
 }
+
+// TestMultiverseDeleteProofLeaf tests that we're able to delete individual
+// leaves from a universe tree, and that the universe and multiverse roots are
+// updated accordingly.
+func TestMultiverseDeleteProofLeaf(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db := NewTestDB(t)
+
+	id := randUniverseID(t, false)
+	baseUniverse, _ := newTestUniverseWithDb(db.BaseDB, id)
+
+	multiverseDB := NewTransactionExecutor(db,
+		func(tx *sql.Tx) BaseMultiverseStore {
+			return db.WithTx(tx)
+		},
+	)
+	multiverse := NewMultiverseStore(multiverseDB)
+
+	// We'll start by inserting two leaves into the same universe.
+	assetGen := asset.RandGenesis(t, asset.Normal)
+	leaves := make([]leafWithKey, 2)
+	for i := range leaves {
+		leaves[i] = leafWithKey{
+			LeafKey: randLeafKey(t),
+			Leaf:    randMintingLeaf(t, assetGen, id.GroupKey),
+		}
+
+		_, err := multiverse.UpsertProofLeaf(
+			ctx, id, leaves[i].LeafKey, &leaves[i].Leaf, nil,
+		)
+		require.NoError(t, err)
+	}
+
+	// If we delete the first leaf, then the new root should only commit to
+	// the second leaf.
+	newRoot, err := multiverse.DeleteProofLeaf(ctx, id, leaves[0].LeafKey)
+	require.NoError(t, err)
+	require.Equal(t, leaves[1].Leaf.Amt, newRoot.NodeSum())
+
+	dbRoot, _, err := baseUniverse.RootNode(ctx)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(newRoot, dbRoot))
+
+	_, err = multiverse.FetchProofLeaf(ctx, id, leaves[0].LeafKey)
+	require.ErrorIs(t, err, universe.ErrNoUniverseProofFound)
+
+	// The multiverse leaf for the universe should commit to the new root.
+	uniProofs, err := multiverse.FetchProofLeaf(
+		ctx, id, leaves[1].LeafKey,
+	)
+	require.NoError(t, err)
+	require.Len(t, uniProofs, 1)
+	require.True(
+		t, mssmt.IsEqualNode(newRoot, uniProofs[0].UniverseRoot),
+	)
+
+	// Deleting the same leaf again should be a no-op that returns the
+	// current root.
+	sameRoot, err := multiverse.DeleteProofLeaf(ctx, id, leaves[0].LeafKey)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(newRoot, sameRoot))
+
+	// Once we delete the last leaf, the universe should be gone entirely,
+	// along with its leaf in the multiverse.
+	newRoot, err = multiverse.DeleteProofLeaf(ctx, id, leaves[1].LeafKey)
+	require.NoError(t, err)
+	require.Nil(t, newRoot)
+
+	_, _, err = baseUniverse.RootNode(ctx)
+	require.ErrorIs(t, err, universe.ErrNoUniverseRoot)
+
+	multiverseRoot, err := multiverse.RootNode(ctx, id.ProofType)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(
+		mssmt.EmptyTree[0], multiverseRoot.Node,
+	))
+
+	// Deleting a leaf from an empty universe should also be a no-op.
+	newRoot, err = multiverse.DeleteProofLeaf(ctx, id, leaves[1].LeafKey)
+	require.NoError(t, err)
+	require.Nil(t, newRoot)
+}
+
+// TestMultiverseRegisterBatchIssuance tests that a batch of leaves spanning
+// multiple universes is inserted into the universe trees, and that the
+// multiverse tree commits to the final root of each of the universes.
+func TestMultiverseRegisterBatchIssuance(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	multiverse, _ := newTestMultiverse(t)
+
+	const numLeaves = 3
+	ids := []universe.Identifier{
+		randUniverseID(t, false), randUniverseID(t, true),
+	}
+
+	// We'll interleave the leaves of both universes within the batch.
+	var items []*universe.IssuanceItem
+	for i := 0; i < numLeaves; i++ {
+		for _, id := range ids {
+			assetGen := asset.RandGenesis(t, asset.Normal)
+			leaf := randMintingLeaf(t, assetGen, id.GroupKey)
+			items = append(items, &universe.IssuanceItem{
+				ID:   id,
+				Key:  randLeafKey(t),
+				Leaf: &leaf,
+			})
+		}
+	}
+
+	err := multiverse.RegisterBatchIssuance(ctx, items)
+	require.NoError(t, err)
+
+	for _, id := range ids {
+		verification, err := multiverse.VerifyUniverseRoot(
+			ctx, id, false,
+		)
+		require.NoError(t, err)
+		require.False(t, verification.Mismatch)
+		require.EqualValues(t, numLeaves, verification.NumLeaves)
+	}
+
+	// Each of the leaves should be retrievable with a valid multiverse
+	// inclusion proof.
+	for _, item := range items {
+		proofs, err := multiverse.FetchProofLeaf(ctx, item.ID, item.Key)
+		require.NoError(t, err)
+		require.Len(t, proofs, 1)
+
+		leafNode, err := proofs[0].Leaf.SmtLeafNode()
+		require.NoError(t, err)
+		root := proofs[0].UniverseInclusionProof.Root(
+			item.Key.UniverseKey(), leafNode,
+		)
+		require.True(t, mssmt.IsEqualNode(
+			root, proofs[0].UniverseRoot,
+		))
+	}
+}
+
+// TestMultiverseVerifyUniverseRoot tests that mismatches between the persisted
+// universe and multiverse roots and the leaves stored for a universe are
+// detected, and that they can be repaired.
+func TestMultiverseVerifyUniverseRoot(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db := NewTestDB(t)
+
+	id := randUniverseID(t, false)
+	baseUniverse, _ := newTestUniverseWithDb(db.BaseDB, id)
+
+	multiverseDB := NewTransactionExecutor(db,
+		func(tx *sql.Tx) BaseMultiverseStore {
+			return db.WithTx(tx)
+		},
+	)
+	multiverse := NewMultiverseStore(multiverseDB)
+
+	assetGen := asset.RandGenesis(t, asset.Normal)
+	leaves := make([]leafWithKey, 3)
+	for i := range leaves {
+		leaves[i] = leafWithKey{
+			LeafKey: randLeafKey(t),
+			Leaf:    randMintingLeaf(t, assetGen, id.GroupKey),
+		}
+
+		_, err := multiverse.UpsertProofLeaf(
+			ctx, id, leaves[i].LeafKey, &leaves[i].Leaf, nil,
+		)
+		require.NoError(t, err)
+	}
+
+	uniRoot, _, err := baseUniverse.RootNode(ctx)
+	require.NoError(t, err)
+	multiverseRoot, err := multiverse.RootNode(ctx, id.ProofType)
+	require.NoError(t, err)
+
+	// A consistent universe shouldn't be reported as a mismatch.
+	verification, err := multiverse.VerifyUniverseRoot(ctx, id, true)
+	require.NoError(t, err)
+	require.False(t, verification.Mismatch)
+	require.False(t, verification.Repaired)
+	require.EqualValues(t, len(leaves), verification.NumLeaves)
+	require.True(t, mssmt.IsEqualNode(uniRoot, verification.ComputedRoot))
+	require.True(t, mssmt.IsEqualNode(uniRoot, verification.PersistedRoot))
+
+	// corruptTree inserts a random leaf into the MS-SMT with the given
+	// namespace, without updating any of the other universe tables.
+	corruptTree := func(namespace string, key [32]byte) {
+		var writeTx BaseMultiverseOptions
+		err := multiverseDB.ExecTx(
+			ctx, &writeTx, func(dbTx BaseMultiverseStore) error {
+				tree := mssmt.NewCompactedTree(
+					newTreeStoreWrapperTx(dbTx, namespace),
+				)
+				leaf := mssmt.NewLeafNode(test.RandBytes(32), 1)
+
+				_, err := tree.Insert(ctx, key, leaf)
+				return err
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	// We'll now insert a leaf into the universe tree that doesn't have a
+	// universe leaf, so the persisted root no longer matches the leaves.
+	var randKey [32]byte
+	copy(randKey[:], test.RandBytes(32))
+	corruptTree(id.String(), randKey)
+
+	verification, err = multiverse.VerifyUniverseRoot(ctx, id, false)
+	require.NoError(t, err)
+	require.True(t, verification.Mismatch)
+	require.False(t, verification.Repaired)
+	require.True(t, mssmt.IsEqualNode(uniRoot, verification.ComputedRoot))
+	require.False(t, mssmt.IsEqualNode(
+		uniRoot, verification.PersistedRoot,
+	))
+
+	// Without the repair flag, the persisted root should be unchanged.
+	dbRoot, _, err := baseUniverse.RootNode(ctx)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(verification.PersistedRoot, dbRoot))
+
+	// Once repaired, the persisted root should match the original root
+	// again, and the universe should verify without a mismatch.
+	verification, err = multiverse.VerifyUniverseRoot(ctx, id, true)
+	require.NoError(t, err)
+	require.True(t, verification.Mismatch)
+	require.True(t, verification.Repaired)
+
+	dbRoot, _, err = baseUniverse.RootNode(ctx)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(uniRoot, dbRoot))
+
+	verification, err = multiverse.VerifyUniverseRoot(ctx, id, false)
+	require.NoError(t, err)
+	require.False(t, verification.Mismatch)
+
+	// All leaves should still be retrievable with valid proofs.
+	for _, leaf := range leaves {
+		uniProofs, err := multiverse.FetchProofLeaf(
+			ctx, id, leaf.LeafKey,
+		)
+		require.NoError(t, err)
+		require.Len(t, uniProofs, 1)
+
+		uniProof := uniProofs[0]
+		leafNode, err := uniProof.Leaf.SmtLeafNode()
+		require.NoError(t, err)
+		proofRoot := uniProof.UniverseInclusionProof.Root(
+			leaf.LeafKey.UniverseKey(), leafNode,
+		)
+		require.True(t, mssmt.IsEqualNode(uniRoot, proofRoot))
+	}
+
+	// Next, we'll corrupt the leaf of the universe in the multiverse tree.
+	multiverseNS, err := namespaceForProof(id.ProofType)
+	require.NoError(t, err)
+	corruptTree(multiverseNS, id.Bytes())
+
+	verification, err = multiverse.VerifyUniverseRoot(ctx, id, false)
+	require.NoError(t, err)
+	require.True(t, verification.Mismatch)
+	require.True(t, mssmt.IsEqualNode(uniRoot, verification.PersistedRoot))
+	require.NotEqual(
+		t, uniRoot.NodeHash(), verification.CommittedRoot.NodeHash(),
+	)
+
+	// Repairing the universe should also restore the multiverse root.
+	verification, err = multiverse.VerifyUniverseRoot(ctx, id, true)
+	require.NoError(t, err)
+	require.True(t, verification.Repaired)
+
+	newMultiverseRoot, err := multiverse.RootNode(ctx, id.ProofType)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(
+		multiverseRoot.Node, newMultiverseRoot.Node,
+	))
+
+	// Finally, a universe that isn't known at all shouldn't be reported as
+	// a mismatch.
+	verification, err = multiverse.VerifyUniverseRoot(
+		ctx, randUniverseID(t, false), true,
+	)
+	require.NoError(t, err)
+	require.False(t, verification.Mismatch)
+	require.Zero(t, verification.NumLeaves)
+	require.Nil(t, verification.PersistedRoot)
+	require.Nil(t, verification.CommittedRoot)
+	require.Nil(t, verification.ComputedRoot)
+}
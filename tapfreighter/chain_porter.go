@@ -11,6 +11,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -19,6 +20,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/tappsbt"
 	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
@@ -54,6 +56,10 @@ type ChainPorterConfig struct {
 	// virtual transactions.
 	AssetWallet Wallet
 
+	// CoinSelector is used to release the asset coins selected for a send
+	// to an address if the send fails before it is committed to disk.
+	CoinSelector CoinSelector
+
 	// AssetProofs is used to write the proof files on disk for the
 	// receiver during a transfer.
 	//
@@ -68,6 +74,12 @@ type ChainPorterConfig struct {
 	// to be confirmed safely with a minimum number of confirmations.
 	ProofWatcher proof.Watcher
 
+	// Universe is used to register the proofs of confirmed asset burns as
+	// transfer leaves, which indexes them in the burn tree of the burned
+	// asset and makes the burned supply provable to anyone syncing the
+	// universe. If nil, burns aren't registered.
+	Universe universe.BatchRegistrar
+
 	// ErrChan is the main error channel the custodian will report back
 	// critical errors to the main server.
 	ErrChan chan<- error
@@ -94,6 +106,14 @@ type ChainPorter struct {
 	// subscriptionID.
 	subscriberMtx sync.Mutex
 
+	// transferStates tracks the state of all outbound transfers that were
+	// broadcast but aren't completed yet, keyed by their anchor transaction
+	// hash.
+	transferStates map[chainhash.Hash]TransferState
+
+	// transferStateMtx guards the transferStates map.
+	transferStateMtx sync.RWMutex
+
 	*fn.ContextGuard
 }
 
@@ -104,9 +124,10 @@ func NewChainPorter(cfg *ChainPorterConfig) *ChainPorter {
 		map[uint64]*fn.EventReceiver[fn.Event],
 	)
 	return &ChainPorter{
-		cfg:         cfg,
-		exportReqs:  make(chan Parcel),
-		subscribers: subscribers,
+		cfg:            cfg,
+		exportReqs:     make(chan Parcel),
+		subscribers:    subscribers,
+		transferStates: make(map[chainhash.Hash]TransferState),
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: tapgarden.DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -248,6 +269,13 @@ func (p *ChainPorter) advanceState(pkg *sendPackage, kit *parcelKit) {
 
 		updatedPkg, err := p.stateStep(*pkg)
 		if err != nil {
+			// If we fail before the transfer was committed to
+			// disk, nothing was broadcast yet, so we can release
+			// the coins we selected to make them available again.
+			if pkg.SendState <= SendStateLogCommit {
+				p.releaseParcelCoins(pkg)
+			}
+
 			kit.errChan <- err
 			log.Errorf("Error evaluating state (%v): %v",
 				pkg.SendState, err)
@@ -258,6 +286,32 @@ func (p *ChainPorter) advanceState(pkg *sendPackage, kit *parcelKit) {
 	}
 }
 
+// releaseParcelCoins releases the asset coins that were selected to fund the
+// send of an address parcel, making them available for coin selection again.
+func (p *ChainPorter) releaseParcelCoins(pkg *sendPackage) {
+	// Only the coins selected by the porter itself are released. The
+	// inputs of pre-signed parcels are managed by the caller.
+	_, ok := pkg.Parcel.(*AddressParcel)
+	if !ok || len(pkg.VirtualPackets) == 0 || p.cfg.CoinSelector == nil {
+		return
+	}
+
+	var outpoints []wire.OutPoint
+	for _, vPkt := range pkg.VirtualPackets {
+		for _, in := range vPkt.Inputs {
+			outpoints = append(outpoints, in.PrevID.OutPoint)
+		}
+	}
+
+	ctx, cancel := p.WithCtxQuit()
+	defer cancel()
+
+	err := p.cfg.CoinSelector.ReleaseCoins(ctx, outpoints...)
+	if err != nil {
+		log.Errorf("Unable to release coins of failed send: %v", err)
+	}
+}
+
 // waitForTransferTxConf waits for the confirmation of the final transaction
 // within the delta. Once confirmed, the parcel will be marked as delivered on
 // chain, with the goroutine cleaning up its state.
@@ -383,10 +437,8 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 	}
 
 	sendPkg.FinalProofs = make(
-		map[asset.SerializedKey]*proof.AnnotatedProof,
-		len(parcel.Outputs),
+		map[OutputProofKey]*proof.AnnotatedProof, len(parcel.Outputs),
 	)
-	firstInput := parcel.Inputs[0]
 	for idx := range parcel.Outputs {
 		out := parcel.Outputs[idx]
 
@@ -418,6 +470,18 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 				"%d: %w", idx, err)
 		}
 
+		// A transfer can send multiple assets, but each output only
+		// spends the inputs of the same asset ID.
+		outputAssetID := proofSuffix.Asset.ID()
+		inputs := fn.Filter(parcel.Inputs, func(in TransferInput) bool {
+			return in.ID == outputAssetID
+		})
+		if len(inputs) == 0 {
+			return fmt.Errorf("no input found for output %d with "+
+				"asset ID %v", idx, outputAssetID)
+		}
+		firstInput := inputs[0]
+
 		// The suffix is complete, so we need to fetch the input proof
 		// in order to append the suffix to it.
 		inputProofFile, err := p.fetchInputProof(ctx, firstInput)
@@ -427,9 +491,9 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 
 		// Are there more inputs? Then this is a merge, and we need to
 		// add those additional files to the suffix as well.
-		for idx := 1; idx < len(parcel.Inputs); idx++ {
+		for idx := 1; idx < len(inputs); idx++ {
 			additionalInputProofFile, err := p.fetchInputProof(
-				ctx, parcel.Inputs[idx],
+				ctx, inputs[idx],
 			)
 			if err != nil {
 				return fmt.Errorf("error fetching input "+
@@ -463,8 +527,11 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 			Locator: outputProofLocator,
 			Blob:    outputProofBuf.Bytes(),
 		}
-		serializedScriptKey := asset.ToSerialized(out.ScriptKey.PubKey)
-		sendPkg.FinalProofs[serializedScriptKey] = outputProof
+		proofKey := OutputProofKey{
+			AssetID:   outputAssetID,
+			ScriptKey: asset.ToSerialized(out.ScriptKey.PubKey),
+		}
+		sendPkg.FinalProofs[proofKey] = outputProof
 
 		// Import proof into proof archive.
 		log.Infof("Importing proof for output %d into local Proof "+
@@ -480,6 +547,29 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 		log.Debugf("Updated proofs for output %d (new_len=%d)",
 			idx, inputProofFile.NumProofs())
 
+		// Burns are registered with the universe, so the destroyed
+		// supply can be verified by anyone syncing it. The universe
+		// needs the previous proofs to verify the burn, so we register
+		// the full proof chain of the burned asset. If this fails, the
+		// parcel is resumed from this state on restart, and registering
+		// the same leaves again is a no-op.
+		if p.cfg.Universe != nil && isBurnOutput(out) {
+			burnItems, err := newBurnUniverseItems(inputProofFile)
+			if err != nil {
+				return fmt.Errorf("unable to create universe "+
+					"leaves for burn of output %d: %w", idx,
+					err)
+			}
+
+			err = p.cfg.Universe.RegisterNewIssuanceBatch(
+				ctx, burnItems,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to register burn of "+
+					"output %d: %w", idx, err)
+			}
+		}
+
 		// The proof is created after a single confirmation. To make
 		// sure we notice if the anchor transaction is re-organized out
 		// of the chain, we give the proof to the re-org watcher and
@@ -504,6 +594,63 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 	return nil
 }
 
+// isBurnOutput returns true if the given transfer output burns its asset.
+func isBurnOutput(out TransferOutput) bool {
+	return len(out.WitnessData) > 0 && asset.IsBurnKey(
+		out.ScriptKey.PubKey, out.WitnessData[0],
+	)
+}
+
+// newBurnUniverseItems creates the universe leaves for each proof within the
+// proof file of a burn output, the last one of which is the burn itself. The
+// genesis proof is registered as an issuance leaf, all other proofs as
+// transfer leaves.
+func newBurnUniverseItems(
+	burnFile *proof.File) ([]*universe.IssuanceItem, error) {
+
+	items := make([]*universe.IssuanceItem, 0, burnFile.NumProofs())
+	for idx := 0; idx < burnFile.NumProofs(); idx++ {
+		transitionProof, err := burnFile.ProofAt(uint32(idx))
+		if err != nil {
+			return nil, err
+		}
+		proofAsset := &transitionProof.Asset
+
+		proofType, err := universe.NewProofTypeFromAssetProof(
+			transitionProof,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		uniID := universe.Identifier{
+			AssetID:   proofAsset.ID(),
+			ProofType: proofType,
+		}
+		if proofAsset.GroupKey != nil {
+			uniID.GroupKey = &proofAsset.GroupKey.GroupPubKey
+		}
+
+		items = append(items, &universe.IssuanceItem{
+			ID: uniID,
+			Key: universe.LeafKey{
+				OutPoint:  transitionProof.OutPoint(),
+				ScriptKey: &proofAsset.ScriptKey,
+			},
+			Leaf: &universe.Leaf{
+				GenesisWithGroup: universe.GenesisWithGroup{
+					Genesis:  proofAsset.Genesis,
+					GroupKey: proofAsset.GroupKey,
+				},
+				Proof: transitionProof,
+				Amt:   proofAsset.Amount,
+			},
+		})
+	}
+
+	return items, nil
+}
+
 // fetchInputProof fetches a proof for the given input from the proof archive.
 func (p *ChainPorter) fetchInputProof(ctx context.Context,
 	input TransferInput) (*proof.File, error) {
@@ -598,39 +745,19 @@ func (p *ChainPorter) transferReceiverProof(pkg *sendPackage) error {
 	deliver := func(ctx context.Context, out TransferOutput) error {
 		key := out.ScriptKey.PubKey
 
-		// If this is an output that is going to our own node/wallet,
-		// we don't need to transfer the proof.
-		if out.ScriptKey.TweakedScriptKey != nil && out.ScriptKeyLocal {
-			log.Debugf("Not transferring proof for local output "+
-				"script key %x", key.SerializeCompressed())
-			return nil
-		}
-
-		// Un-spendable means this is a tombstone output resulting from
-		// a split.
-		unSpendable, err := out.ScriptKey.IsUnSpendable()
+		// Outputs going to our own node/wallet, tombstones and burns
+		// are kept local and don't require a proof transfer.
+		deliveryRequired, err := out.ProofDeliveryRequired()
 		if err != nil {
-			return fmt.Errorf("error checking if script key is "+
-				"unspendable: %w", err)
+			return err
 		}
-		if unSpendable {
-			log.Debugf("Not transferring proof for un-spendable "+
-				"output script key %x",
+		if !deliveryRequired {
+			log.Debugf("Not transferring proof for local, "+
+				"un-spendable or burn output script key %x",
 				key.SerializeCompressed())
 			return nil
 		}
 
-		// Burns are also always kept local and not sent to any
-		// receiver.
-		if len(out.WitnessData) > 0 && asset.IsBurnKey(
-			out.ScriptKey.PubKey, out.WitnessData[0],
-		) {
-
-			log.Debugf("Not transferring proof for burn script "+
-				"key %x", key.SerializeCompressed())
-			return nil
-		}
-
 		// We just look for the full proof in the list of final proofs
 		// by matching the content of the proof suffix.
 		var receiverProof *proof.AnnotatedProof
@@ -755,6 +882,8 @@ func (p *ChainPorter) transferReceiverProof(pkg *sendPackage) error {
 			"confirmation: %w", err)
 	}
 
+	p.setTransferState(pkg.OutboundPkg, TransferStateCompleted)
+
 	pkg.SendState = SendStateComplete
 	return nil
 }
@@ -846,18 +975,30 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 			return nil, fmt.Errorf("unable to cast parcel to " +
 				"address parcel")
 		}
-		fundSendRes, outputIdxToAddr, err :=
-			p.cfg.AssetWallet.FundAddressSend(
-				ctx, addrParcel.destAddrs...,
-			)
+		fundedVPkts, err := p.cfg.AssetWallet.FundAddressSend(
+			ctx, addrParcel.coinSelectStrategy,
+			addrParcel.changeOpts, addrParcel.destAddrs...,
+		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to fund address send: "+
 				"%w", err)
 		}
 
-		currentPkg.VirtualPacket = fundSendRes.VPacket
-		currentPkg.InputCommitments = fundSendRes.InputCommitments
-		currentPkg.OutputIdxToAddr = outputIdxToAddr
+		// We get one virtual packet for each asset ID we send, all of
+		// which will be anchored in the same transaction.
+		for _, fundedVPkt := range fundedVPkts {
+			currentPkg.VirtualPackets = append(
+				currentPkg.VirtualPackets, fundedVPkt.VPacket,
+			)
+			currentPkg.InputCommitments = append(
+				currentPkg.InputCommitments,
+				fundedVPkt.InputCommitments,
+			)
+			currentPkg.OutputIdxToAddr = append(
+				currentPkg.OutputIdxToAddr,
+				fundedVPkt.OutputIdxToAddr,
+			)
+		}
 
 		currentPkg.SendState = SendStateVirtualSign
 
@@ -866,18 +1007,20 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 	// At this point, we have everything we need to sign our _virtual_
 	// transaction on the Taproot Asset layer.
 	case SendStateVirtualSign:
-		vPacket := currentPkg.VirtualPacket
-		receiverScriptKey := vPacket.Outputs[1].ScriptKey.PubKey
-		log.Infof("Generating Taproot Asset witnesses for send to: %x",
-			receiverScriptKey.SerializeCompressed())
-
-		// Now we'll use the signer to sign all the inputs for the new
-		// Taproot Asset leaves. The witness data for each input will be
-		// assigned for us.
-		_, err := p.cfg.AssetWallet.SignVirtualPacket(vPacket)
-		if err != nil {
-			return nil, fmt.Errorf("unable to sign and commit "+
-				"virtual packet: %w", err)
+		for _, vPacket := range currentPkg.VirtualPackets {
+			receiverScriptKey := vPacket.Outputs[1].ScriptKey.PubKey
+			log.Infof("Generating Taproot Asset witnesses for "+
+				"send to: %x",
+				receiverScriptKey.SerializeCompressed())
+
+			// Now we'll use the signer to sign all the inputs for
+			// the new Taproot Asset leaves. The witness data for
+			// each input will be assigned for us.
+			_, err := p.cfg.AssetWallet.SignVirtualPacket(vPacket)
+			if err != nil {
+				return nil, fmt.Errorf("unable to sign and "+
+					"commit virtual packet: %w", err)
+			}
 		}
 
 		currentPkg.SendState = SendStateAnchorSign
@@ -920,21 +1063,23 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		readableFeeRate := feeRate.FeePerKVByte().String()
 		log.Infof("sending with fee rate: %v", readableFeeRate)
 
-		vPacket := currentPkg.VirtualPacket
-		firstRecipient, err := vPacket.FirstNonSplitRootOutput()
-		if err != nil {
-			return nil, fmt.Errorf("unable to get first "+
-				"interactive output: %w", err)
+		for _, vPacket := range currentPkg.VirtualPackets {
+			firstRecipient, err := vPacket.FirstNonSplitRootOutput()
+			if err != nil {
+				return nil, fmt.Errorf("unable to get first "+
+					"interactive output: %w", err)
+			}
+			receiverScriptKey := firstRecipient.ScriptKey.PubKey
+			log.Infof("Constructing new Taproot Asset commitments "+
+				"for send to: %x",
+				receiverScriptKey.SerializeCompressed())
 		}
-		receiverScriptKey := firstRecipient.ScriptKey.PubKey
-		log.Infof("Constructing new Taproot Asset commitments for "+
-			"send to: %x", receiverScriptKey.SerializeCompressed())
 
 		// Gather passive assets virtual packets and sign them.
 		wallet := p.cfg.AssetWallet
 
 		currentPkg.PassiveAssets, err = wallet.SignPassiveAssets(
-			vPacket, currentPkg.InputCommitments,
+			currentPkg.VirtualPackets, currentPkg.InputCommitments,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to sign passive "+
@@ -951,7 +1096,7 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		anchorTx, err := wallet.AnchorVirtualTransactions(
 			ctx, &AnchorVTxnsParams{
 				FeeRate:            feeRate,
-				VPkts:              []*tappsbt.VPacket{vPacket},
+				VPkts:              currentPkg.VirtualPackets,
 				InputCommitments:   currentPkg.InputCommitments,
 				PassiveAssetsVPkts: passiveVPackets,
 			},
@@ -1056,6 +1201,10 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		// notification via the transaction broadcast response channel.
 		currentPkg.deliverTxBroadcastResp()
 
+		p.setTransferState(
+			currentPkg.OutboundPkg, TransferStateUnconfirmed,
+		)
+
 		// Set send state to the next state to evaluate.
 		currentPkg.SendState = SendStateWaitTxConf
 		return &currentPkg, nil
@@ -1064,6 +1213,13 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 	// for the transfer transaction to confirm on-chain.
 	case SendStateWaitTxConf:
 		err := p.waitForTransferTxConf(&currentPkg)
+		if err == nil && currentPkg.SendState == SendStateStoreProofs {
+			p.setTransferState(
+				currentPkg.OutboundPkg,
+				TransferStateAwaitingProofDelivery,
+			)
+		}
+
 		return &currentPkg, err
 
 	// At this point, the transfer transaction is confirmed on-chain. We go
@@ -1148,6 +1304,41 @@ func (p *ChainPorter) publishSubscriberEvent(event fn.Event) {
 	}
 }
 
+// setTransferState records the new state of the given outbound transfer and
+// notifies all subscribers about the change. Completed transfers are no longer
+// tracked, as their state is fully reflected on disk.
+func (p *ChainPorter) setTransferState(parcel *OutboundParcel,
+	state TransferState) {
+
+	anchorTxHash := parcel.AnchorTx.TxHash()
+
+	p.transferStateMtx.Lock()
+	if state == TransferStateCompleted {
+		delete(p.transferStates, anchorTxHash)
+	} else {
+		p.transferStates[anchorTxHash] = state
+	}
+	p.transferStateMtx.Unlock()
+
+	log.Debugf("Transfer (anchor_txid=%v) is now in state %v",
+		anchorTxHash, state)
+
+	p.publishSubscriberEvent(NewTransferStateEvent(parcel, state))
+}
+
+// TransferState returns the state of the in-flight outbound transfer with the
+// given anchor transaction hash. False is returned if the transfer isn't
+// currently being processed by the porter.
+func (p *ChainPorter) TransferState(
+	anchorTxHash chainhash.Hash) (TransferState, bool) {
+
+	p.transferStateMtx.RLock()
+	defer p.transferStateMtx.RUnlock()
+
+	state, ok := p.transferStates[anchorTxHash]
+	return state, ok
+}
+
 // A compile-time assertion to make sure ChainPorter satisfies the
 // fn.EventPublisher interface.
 var _ fn.EventPublisher[fn.Event, bool] = (*ChainPorter)(nil)
@@ -1174,3 +1365,33 @@ func NewExecuteSendStateEvent(state SendState) *ExecuteSendStateEvent {
 		SendState: state,
 	}
 }
+
+// TransferStateEvent is an event which is sent to the ChainPorter's event
+// subscribers when the state of an outbound transfer changes after its anchor
+// transaction was broadcast.
+type TransferStateEvent struct {
+	// timestamp is the time the event was created.
+	timestamp time.Time
+
+	// Parcel is the outbound parcel of the transfer.
+	Parcel *OutboundParcel
+
+	// State is the new state of the transfer.
+	State TransferState
+}
+
+// Timestamp returns the timestamp of the event.
+func (e *TransferStateEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// NewTransferStateEvent creates a new TransferStateEvent.
+func NewTransferStateEvent(parcel *OutboundParcel,
+	state TransferState) *TransferStateEvent {
+
+	return &TransferStateEvent{
+		timestamp: time.Now().UTC(),
+		Parcel:    parcel,
+		State:     state,
+	}
+}
@@ -1,17 +1,312 @@
 package tapfreighter
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/build"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRunChainPorter(t *testing.T) {
 	t.Parallel()
 }
 
+// randTransitionProof returns a proof for the given asset, anchored in a random
+// transaction.
+func randTransitionProof(t *testing.T, a *asset.Asset) proof.Proof {
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(t),
+	})
+	anchorTx.AddTxOut(createDummyOutput())
+
+	return proof.Proof{
+		AnchorTx: *anchorTx,
+		Asset:    *a,
+		InclusionProof: proof.TaprootProof{
+			InternalKey: test.RandPubKey(t),
+		},
+	}
+}
+
+// TestNewBurnUniverseItems tests that burn outputs are detected, and that the
+// full proof chain of a burn is registered with the universe of the burned
+// asset.
+func TestNewBurnUniverseItems(t *testing.T) {
+	t.Parallel()
+
+	genesisAsset := asset.RandAsset(t, asset.Normal)
+	require.True(t, genesisAsset.IsGenesisAsset())
+	genesisProof := randTransitionProof(t, genesisAsset)
+
+	prevID := asset.PrevID{
+		OutPoint:  genesisProof.OutPoint(),
+		ID:        genesisAsset.ID(),
+		ScriptKey: asset.ToSerialized(genesisAsset.ScriptKey.PubKey),
+	}
+	witness := []asset.Witness{{
+		PrevID: &prevID,
+	}}
+
+	burnAsset := genesisAsset.Copy()
+	burnAsset.Amount = 42
+	burnAsset.PrevWitnesses = witness
+	burnAsset.ScriptKey = asset.NewScriptKey(asset.DeriveBurnKey(prevID))
+	burnProof := randTransitionProof(t, burnAsset)
+
+	burnOut := TransferOutput{
+		Anchor: Anchor{
+			OutPoint: burnProof.OutPoint(),
+		},
+		ScriptKey:   burnAsset.ScriptKey,
+		Amount:      burnAsset.Amount,
+		WitnessData: witness,
+	}
+	require.True(t, isBurnOutput(burnOut))
+
+	// An output to a regular script key, or one without any witness, isn't
+	// a burn.
+	regularOut := burnOut
+	regularOut.ScriptKey = asset.RandScriptKey(t)
+	require.False(t, isBurnOutput(regularOut))
+
+	noWitnessOut := burnOut
+	noWitnessOut.WitnessData = nil
+	require.False(t, isBurnOutput(noWitnessOut))
+
+	burnFile, err := proof.NewFile(proof.V0, genesisProof, burnProof)
+	require.NoError(t, err)
+
+	items, err := newBurnUniverseItems(burnFile)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	// The genesis proof is registered as an issuance leaf, the burn as a
+	// transfer leaf that's keyed by the burn output.
+	require.Equal(t, universe.ProofTypeIssuance, items[0].ID.ProofType)
+	require.Equal(t, universe.ProofTypeTransfer, items[1].ID.ProofType)
+
+	burnItem := items[1]
+	require.Equal(t, burnAsset.ID(), burnItem.ID.AssetID)
+	require.Equal(t, burnOut.Anchor.OutPoint, burnItem.Key.OutPoint)
+	require.True(t, burnItem.Key.ScriptKey.PubKey.IsEqual(
+		burnAsset.ScriptKey.PubKey,
+	))
+	require.True(t, burnItem.Leaf.Proof.Asset.IsBurn())
+	require.Equal(t, burnAsset.Amount, burnItem.Leaf.Amt)
+
+	// For grouped assets, the group key is part of the universe ID.
+	for _, item := range items {
+		if burnAsset.GroupKey != nil {
+			require.True(t, item.ID.GroupKey.IsEqual(
+				&burnAsset.GroupKey.GroupPubKey,
+			))
+		} else {
+			require.Nil(t, item.ID.GroupKey)
+		}
+	}
+}
+
+// TestProofDeliveryRequired tests that only outputs going to a remote receiver
+// require their proof to be delivered.
+func TestProofDeliveryRequired(t *testing.T) {
+	t.Parallel()
+
+	prevID := asset.PrevID{
+		OutPoint:  test.RandOp(t),
+		ID:        asset.RandID(t),
+		ScriptKey: asset.RandSerializedKey(t),
+	}
+
+	remoteOut := TransferOutput{
+		ScriptKey: asset.RandScriptKey(t),
+	}
+	localOut := TransferOutput{
+		ScriptKey: asset.NewScriptKeyBip86(test.PubToKeyDesc(
+			test.RandPubKey(t),
+		)),
+		ScriptKeyLocal: true,
+	}
+	tombstoneOut := TransferOutput{
+		ScriptKey: asset.NUMSScriptKey,
+	}
+	burnOut := TransferOutput{
+		ScriptKey: asset.NewScriptKey(asset.DeriveBurnKey(prevID)),
+		WitnessData: []asset.Witness{{
+			PrevID: &prevID,
+		}},
+	}
+
+	testCases := []struct {
+		name     string
+		out      TransferOutput
+		required bool
+	}{{
+		name:     "remote output",
+		out:      remoteOut,
+		required: true,
+	}, {
+		name:     "local output",
+		out:      localOut,
+		required: false,
+	}, {
+		name:     "tombstone output",
+		out:      tombstoneOut,
+		required: false,
+	}, {
+		name:     "burn output",
+		out:      burnOut,
+		required: false,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			required, err := tc.out.ProofDeliveryRequired()
+			require.NoError(t, err)
+			require.Equal(t, tc.required, required)
+		})
+	}
+}
+
+// TestTransferStateTracking tests that the porter tracks the state of
+// in-flight transfers and notifies subscribers about each state change.
+func TestTransferStateTracking(t *testing.T) {
+	t.Parallel()
+
+	porter := NewChainPorter(&ChainPorterConfig{})
+
+	subscriber := fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize)
+	defer subscriber.Stop()
+	require.NoError(t, porter.RegisterSubscriber(subscriber, false, false))
+
+	parcel := &OutboundParcel{
+		AnchorTx: wire.NewMsgTx(2),
+	}
+	parcel.AnchorTx.AddTxOut(createDummyOutput())
+	anchorTxHash := parcel.AnchorTx.TxHash()
+
+	_, ok := porter.TransferState(anchorTxHash)
+	require.False(t, ok)
+
+	assertStateEvent := func(state TransferState) {
+		t.Helper()
+
+		select {
+		case event := <-subscriber.NewItemCreated.ChanOut():
+			stateEvent, ok := event.(*TransferStateEvent)
+			require.True(t, ok)
+			require.Equal(t, parcel, stateEvent.Parcel)
+			require.Equal(t, state, stateEvent.State)
+
+		case <-time.After(time.Second):
+			t.Fatalf("no transfer state event received")
+		}
+	}
+
+	for _, state := range []TransferState{
+		TransferStateUnconfirmed, TransferStateAwaitingProofDelivery,
+	} {
+		porter.setTransferState(parcel, state)
+		assertStateEvent(state)
+
+		trackedState, ok := porter.TransferState(anchorTxHash)
+		require.True(t, ok)
+		require.Equal(t, state, trackedState)
+	}
+
+	// Once completed, the transfer is no longer tracked in memory.
+	porter.setTransferState(parcel, TransferStateCompleted)
+	assertStateEvent(TransferStateCompleted)
+
+	_, ok = porter.TransferState(anchorTxHash)
+	require.False(t, ok)
+}
+
+// mockCoinSelector is a mock implementation of the CoinSelector interface
+// that records the released coins.
+type mockCoinSelector struct {
+	released []wire.OutPoint
+}
+
+func (m *mockCoinSelector) SelectCoins(context.Context,
+	CommitmentConstraints, MultiCommitmentSelectStrategy) (
+	[]*AnchoredCommitment, error) {
+
+	return nil, nil
+}
+
+func (m *mockCoinSelector) ReleaseCoins(_ context.Context,
+	utxoOutpoints ...wire.OutPoint) error {
+
+	m.released = append(m.released, utxoOutpoints...)
+	return nil
+}
+
+// TestReleaseParcelCoins tests that the coins selected for a failed send to
+// an address are released, while the inputs of pre-signed parcels are left
+// untouched.
+func TestReleaseParcelCoins(t *testing.T) {
+	t.Parallel()
+
+	coinSelector := &mockCoinSelector{}
+	porter := NewChainPorter(&ChainPorterConfig{
+		CoinSelector: coinSelector,
+	})
+
+	inputs := []wire.OutPoint{
+		test.RandOp(t), test.RandOp(t), test.RandOp(t),
+	}
+	vPackets := []*tappsbt.VPacket{{}, {}}
+	for idx, op := range inputs {
+		// The last input belongs to the second packet, as if a second
+		// asset ID was sent in the same transfer.
+		vPacket := vPackets[0]
+		if idx == len(inputs)-1 {
+			vPacket = vPackets[1]
+		}
+
+		vPacket.Inputs = append(vPacket.Inputs, &tappsbt.VInput{
+			PrevID: asset.PrevID{
+				OutPoint: op,
+			},
+		})
+	}
+
+	// The inputs of a pre-signed parcel are managed by the caller, so they
+	// aren't released.
+	porter.releaseParcelCoins(&sendPackage{
+		Parcel:         NewPreSignedParcel(vPackets[0], nil),
+		VirtualPackets: vPackets[:1],
+	})
+	require.Empty(t, coinSelector.released)
+
+	// Nothing is released if no coins were selected yet.
+	addrParcel := NewAddressParcel(nil, 0, ChangeOptions{})
+	porter.releaseParcelCoins(&sendPackage{
+		Parcel: addrParcel,
+	})
+	require.Empty(t, coinSelector.released)
+
+	// The coins selected for all packets of an address parcel are
+	// released.
+	porter.releaseParcelCoins(&sendPackage{
+		Parcel:         addrParcel,
+		VirtualPackets: vPackets,
+	})
+	require.Equal(t, inputs, coinSelector.released)
+}
+
 func init() {
 	rand.Seed(time.Now().Unix())
 
@@ -114,8 +114,53 @@ const (
 	// descending amounts and selects the first subset which cumulatively
 	// sums to at least the minimum target amount.
 	PreferMaxAmount MultiCommitmentSelectStrategy = iota
+
+	// PreferMinAmount is a strategy which considers commitments in order of
+	// ascending amounts and selects the first subset which cumulatively
+	// sums to at least the minimum target amount. This consolidates small
+	// commitments, at the cost of using more inputs.
+	PreferMinAmount
+
+	// MinimizeInputs is a strategy which selects the smallest possible
+	// number of commitments. Among the selections with that number of
+	// inputs, the last commitment is chosen to be the smallest one that
+	// still reaches the minimum target amount.
+	MinimizeInputs
+
+	// MinimizeChange is a strategy which attempts to select a subset of
+	// commitments whose sum is as close as possible to the minimum target
+	// amount, preferring an exact match that requires no change output.
+	MinimizeChange
+
+	// ConsolidateAll is a strategy which selects all eligible commitments,
+	// consolidating them into the outputs of the transfer. The selection
+	// fails if they don't sum to at least the minimum target amount.
+	ConsolidateAll
 )
 
+// String returns a human-readable string for the select strategy.
+func (s MultiCommitmentSelectStrategy) String() string {
+	switch s {
+	case PreferMaxAmount:
+		return "PreferMaxAmount"
+
+	case PreferMinAmount:
+		return "PreferMinAmount"
+
+	case MinimizeInputs:
+		return "MinimizeInputs"
+
+	case MinimizeChange:
+		return "MinimizeChange"
+
+	case ConsolidateAll:
+		return "ConsolidateAll"
+
+	default:
+		return fmt.Sprintf("<unknown_strategy(%d)>", s)
+	}
+}
+
 // CoinSelector is an interface that describes the functionality used in
 // selecting coins during the asset send process.
 type CoinSelector interface {
@@ -214,6 +259,30 @@ type TransferOutput struct {
 	ProofCourierAddr []byte
 }
 
+// ProofDeliveryRequired returns true if the proof for this output needs to be
+// delivered to a receiver outside of this daemon.
+func (out *TransferOutput) ProofDeliveryRequired() (bool, error) {
+	// If this is an output that is going to our own node/wallet, we don't
+	// need to transfer the proof.
+	if out.ScriptKey.TweakedScriptKey != nil && out.ScriptKeyLocal {
+		return false, nil
+	}
+
+	// Un-spendable means this is a tombstone output resulting from a
+	// split.
+	unSpendable, err := out.ScriptKey.IsUnSpendable()
+	if err != nil {
+		return false, fmt.Errorf("error checking if script key is "+
+			"unspendable: %w", err)
+	}
+	if unSpendable {
+		return false, nil
+	}
+
+	// Burns are also always kept local and not sent to any receiver.
+	return !isBurnOutput(*out), nil
+}
+
 // OutboundParcel represents the database level delta of an outbound Taproot
 // Asset parcel (outbound spend). A spend will destroy a series of assets listed
 // as inputs, and re-create them as new outputs. Along the way some assets may
@@ -249,6 +318,18 @@ type OutboundParcel struct {
 	Outputs []TransferOutput
 }
 
+// OutputProofKey identifies the final proof of a transfer output. The script
+// key alone isn't unique within a transfer that sends multiple assets, as all
+// tombstone outputs use the same NUMS script key, so the asset ID is included
+// as well.
+type OutputProofKey struct {
+	// AssetID is the ID of the asset the output commits to.
+	AssetID asset.ID
+
+	// ScriptKey is the serialized script key of the output.
+	ScriptKey asset.SerializedKey
+}
+
 // AssetConfirmEvent is used to mark a batched spend as confirmed on disk.
 type AssetConfirmEvent struct {
 	// AnchorTXID is the anchor transaction's hash that was previously
@@ -267,7 +348,7 @@ type AssetConfirmEvent struct {
 
 	// FinalProofs is the set of final full proof chain files that are going
 	// to be stored on disk, one for each output in the outbound parcel.
-	FinalProofs map[asset.SerializedKey]*proof.AnnotatedProof
+	FinalProofs map[OutputProofKey]*proof.AnnotatedProof
 
 	// PassiveAssetProofFiles is the set of passive asset proof files that
 	// are re-anchored during the parcel confirmation process.
@@ -337,6 +418,10 @@ type WalletAnchor interface {
 	// SignPsbt signs all the inputs it can in the passed-in PSBT packet,
 	// returning a new one with updated signature/witness data.
 	SignPsbt(ctx context.Context, packet *psbt.Packet) (*psbt.Packet, error)
+
+	// ReleaseUTXOs releases the given wallet UTXOs that were leased when
+	// funding a PSBT, making them available for coin selection again.
+	ReleaseUTXOs(ctx context.Context, utxos ...wire.OutPoint) error
 }
 
 // KeyRing aliases into the KeyRing of the tapgarden package.
@@ -353,6 +438,11 @@ type Porter interface {
 	// returned with the pending transfer information.
 	RequestShipment(req Parcel) (*OutboundParcel, error)
 
+	// TransferState returns the state of the in-flight outbound transfer
+	// with the given anchor transaction hash. False is returned if the
+	// transfer isn't currently being processed by the porter.
+	TransferState(anchorTxHash chainhash.Hash) (TransferState, bool)
+
 	// Start signals that the asset minter should being operations.
 	Start() error
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -101,6 +102,42 @@ func (s SendState) String() string {
 	}
 }
 
+// TransferState is an enum that describes the externally visible state of an
+// outbound transfer once its anchor transaction has been broadcast.
+type TransferState uint8
+
+const (
+	// TransferStateUnconfirmed is the state of a transfer whose anchor
+	// transaction was broadcast but hasn't confirmed yet.
+	TransferStateUnconfirmed TransferState = iota
+
+	// TransferStateAwaitingProofDelivery is the state of a transfer whose
+	// anchor transaction confirmed, but the proofs haven't yet been stored
+	// and delivered to all receivers.
+	TransferStateAwaitingProofDelivery
+
+	// TransferStateCompleted is the state of a transfer that confirmed and
+	// for which all proofs were delivered.
+	TransferStateCompleted
+)
+
+// String returns a human-readable version of TransferState.
+func (s TransferState) String() string {
+	switch s {
+	case TransferStateUnconfirmed:
+		return "TransferStateUnconfirmed"
+
+	case TransferStateAwaitingProofDelivery:
+		return "TransferStateAwaitingProofDelivery"
+
+	case TransferStateCompleted:
+		return "TransferStateCompleted"
+
+	default:
+		return fmt.Sprintf("<unknown_state(%d)>", s)
+	}
+}
+
 // Parcel is an interface that each parcel type must implement.
 type Parcel interface {
 	// pkg returns the send package that should be delivered.
@@ -135,6 +172,14 @@ type AddressParcel struct {
 	// transferFeeRate is an optional manually-set feerate specified when
 	// requesting an asset transfer.
 	transferFeeRate *chainfee.SatPerKWeight
+
+	// coinSelectStrategy is the strategy used to select the asset coins
+	// that fund the transfer.
+	coinSelectStrategy MultiCommitmentSelectStrategy
+
+	// changeOpts controls where the change of the transfer goes and
+	// whether the inputs are consolidated.
+	changeOpts ChangeOptions
 }
 
 // A compile-time assertion to ensure AddressParcel implements the parcel
@@ -143,15 +188,18 @@ var _ Parcel = (*AddressParcel)(nil)
 
 // NewAddressParcel creates a new AddressParcel.
 func NewAddressParcel(feeRate *chainfee.SatPerKWeight,
-	destAddrs ...*address.Tap) *AddressParcel {
+	coinSelectStrategy MultiCommitmentSelectStrategy,
+	changeOpts ChangeOptions, destAddrs ...*address.Tap) *AddressParcel {
 
 	return &AddressParcel{
 		parcelKit: &parcelKit{
 			respChan: make(chan *OutboundParcel, 1),
 			errChan:  make(chan error, 1),
 		},
-		destAddrs:       destAddrs,
-		transferFeeRate: feeRate,
+		destAddrs:          destAddrs,
+		transferFeeRate:    feeRate,
+		coinSelectStrategy: coinSelectStrategy,
+		changeOpts:         changeOpts,
 	}
 }
 
@@ -273,10 +321,12 @@ func (p *PreSignedParcel) pkg() *sendPackage {
 	// Initialize a package the signed virtual transaction and input
 	// commitment.
 	return &sendPackage{
-		Parcel:           p,
-		SendState:        SendStateAnchorSign,
-		VirtualPacket:    p.vPkt,
-		InputCommitments: p.inputCommitments,
+		Parcel:         p,
+		SendState:      SendStateAnchorSign,
+		VirtualPackets: []*tappsbt.VPacket{p.vPkt},
+		InputCommitments: []tappsbt.InputCommitments{
+			p.inputCommitments,
+		},
 	}
 }
 
@@ -296,17 +346,21 @@ type sendPackage struct {
 	// SendState is the current send state of this parcel.
 	SendState SendState
 
-	// VirtualPacket is the virtual packet that we'll use to construct the
-	// virtual asset transition transaction.
-	VirtualPacket *tappsbt.VPacket
+	// VirtualPackets are the virtual packets that we'll use to construct
+	// the virtual asset transition transactions, one for each asset ID
+	// that is being sent. All of them are anchored in the same BTC level
+	// transaction.
+	VirtualPackets []*tappsbt.VPacket
 
-	// OutputIdxToAddr is a map from a VPacket's VOutput index to its
-	// associated Tap address.
-	OutputIdxToAddr tappsbt.OutputIdxToAddr
+	// OutputIdxToAddr holds, for each virtual packet and in the same
+	// order, a map from the VPacket's VOutput index to its associated Tap
+	// address.
+	OutputIdxToAddr []tappsbt.OutputIdxToAddr
 
-	// InputCommitments is a map from virtual package input index to its
-	// associated Taproot Asset commitment.
-	InputCommitments tappsbt.InputCommitments
+	// InputCommitments holds, for each virtual packet and in the same
+	// order, a map from virtual package input index to its associated
+	// Taproot Asset commitment.
+	InputCommitments []tappsbt.InputCommitments
 
 	// PassiveAssets is the data used in re-anchoring passive assets.
 	PassiveAssets []*PassiveAssetReAnchor
@@ -324,8 +378,8 @@ type sendPackage struct {
 
 	// FinalProofs is the set of final full proof chain files that are going
 	// to be stored on disk, one for each output in the outbound parcel,
-	// keyed by their script key.
-	FinalProofs map[asset.SerializedKey]*proof.AnnotatedProof
+	// keyed by their asset ID and script key.
+	FinalProofs map[OutputProofKey]*proof.AnnotatedProof
 
 	// TransferTxConfEvent contains transfer transaction on-chain
 	// confirmation data.
@@ -352,7 +406,6 @@ func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 		passiveAsset.NewWitnessData = signedAsset.PrevWitnesses
 	}
 
-	vPkt := s.VirtualPacket
 	anchorTXID := s.AnchorTx.FinalTx.TxHash()
 	parcel := &OutboundParcel{
 		AnchorTx:           s.AnchorTx.FinalTx,
@@ -360,13 +413,19 @@ func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 		// TODO(bhandras): use clock.Clock instead.
 		TransferTime:  time.Now(),
 		ChainFees:     s.AnchorTx.ChainFees,
-		Inputs:        make([]TransferInput, len(vPkt.Inputs)),
-		Outputs:       make([]TransferOutput, len(vPkt.Outputs)),
 		PassiveAssets: s.PassiveAssets,
 	}
 
-	for idx := range vPkt.Inputs {
-		vIn := vPkt.Inputs[idx]
+	// The inputs and outputs of all virtual packets are stored as a
+	// single transfer, as they are all anchored in the same transaction.
+	var vInputs []*tappsbt.VInput
+	for _, vPkt := range s.VirtualPackets {
+		vInputs = append(vInputs, vPkt.Inputs...)
+	}
+
+	parcel.Inputs = make([]TransferInput, len(vInputs))
+	for idx := range vInputs {
+		vIn := vInputs[idx]
 
 		// We don't know the actual outpoint the input is spending, so
 		// we need to look it up by the pkScript in the anchor TX.
@@ -401,146 +460,181 @@ func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 		}
 	}
 
-	outputCommitments := s.AnchorTx.OutputCommitments
-	for idx := range vPkt.Outputs {
-		vOut := vPkt.Outputs[idx]
-
-		// Convert any proof courier address associated with this output
-		// to bytes for db storage.
-		var proofCourierAddrBytes []byte
-		if s.OutputIdxToAddr != nil {
-			if addr, ok := s.OutputIdxToAddr[idx]; ok {
-				proofCourierAddrBytes = []byte(
-					addr.ProofCourierAddr.String(),
-				)
+	passivesAssigned := false
+	for pktIdx, vPkt := range s.VirtualPackets {
+		for idx := range vPkt.Outputs {
+			out, err := s.prepareOutput(
+				pktIdx, idx, anchorTXID, !passivesAssigned,
+			)
+			if err != nil {
+				return nil, err
 			}
-		}
 
-		anchorInternalKey := keychain.KeyDescriptor{
-			PubKey: vOut.AnchorOutputInternalKey,
-		}
-		if vOut.AnchorOutputBip32Derivation != nil {
-			var err error
-			anchorInternalKey, err = vOut.AnchorKeyToDesc()
-			if err != nil {
-				return nil, fmt.Errorf("unable to get anchor "+
-					"key desc: %w", err)
+			// The passive assets are only counted once, on the
+			// first output that can carry them.
+			if out.Anchor.NumPassiveAssets > 0 {
+				passivesAssigned = true
 			}
+
+			parcel.Outputs = append(parcel.Outputs, *out)
 		}
+	}
 
-		preimageBytes, siblingHash, err := commitment.MaybeEncodeTapscriptPreimage(
-			vOut.AnchorOutputTapscriptSibling,
-		)
+	return parcel, nil
+}
+
+// prepareOutput prepares the given output of the virtual packet with the given
+// index for storing to the database. The passive assets are only counted on the
+// output if assignPassives is true.
+func (s *sendPackage) prepareOutput(pktIdx, idx int, anchorTXID chainhash.Hash,
+	assignPassives bool) (*TransferOutput, error) {
+
+	vPkt := s.VirtualPackets[pktIdx]
+	vOut := vPkt.Outputs[idx]
+	outputCommitments := s.AnchorTx.OutputCommitments
+
+	// Convert any proof courier address associated with this output to
+	// bytes for db storage.
+	var proofCourierAddrBytes []byte
+	if pktIdx < len(s.OutputIdxToAddr) && s.OutputIdxToAddr[pktIdx] != nil {
+		if addr, ok := s.OutputIdxToAddr[pktIdx][idx]; ok {
+			proofCourierAddrBytes = []byte(
+				addr.ProofCourierAddr.String(),
+			)
+		}
+	}
+
+	anchorInternalKey := keychain.KeyDescriptor{
+		PubKey: vOut.AnchorOutputInternalKey,
+	}
+	if vOut.AnchorOutputBip32Derivation != nil {
+		var err error
+		anchorInternalKey, err = vOut.AnchorKeyToDesc()
 		if err != nil {
-			return nil, fmt.Errorf("unable to encode tapscript "+
-				"preimage: %w", err)
+			return nil, fmt.Errorf("unable to get anchor key "+
+				"desc: %w", err)
 		}
+	}
 
-		outCommitment := outputCommitments[vOut.AnchorOutputIndex]
-		merkleRoot := outCommitment.TapscriptRoot(siblingHash)
-		taprootAssetRoot := outCommitment.TapscriptRoot(nil)
+	preimageBytes, siblingHash, err := commitment.MaybeEncodeTapscriptPreimage(
+		vOut.AnchorOutputTapscriptSibling,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode tapscript preimage: "+
+			"%w", err)
+	}
 
-		var (
-			numPassiveAssets    uint32
-			proofSuffixBuf      bytes.Buffer
-			witness             []asset.Witness
-			splitCommitmentRoot mssmt.Node
-		)
+	outCommitment := outputCommitments[vOut.AnchorOutputIndex]
+	merkleRoot := outCommitment.TapscriptRoot(siblingHash)
+	taprootAssetRoot := outCommitment.TapscriptRoot(nil)
 
-		// If there are passive assets, they are always committed to the
-		// output that is marked as the split root.
-		if vOut.Type.CanCarryPassive() {
-			numPassiveAssets = uint32(len(s.PassiveAssets))
-		}
+	var (
+		numPassiveAssets    uint32
+		proofSuffixBuf      bytes.Buffer
+		witness             []asset.Witness
+		splitCommitmentRoot mssmt.Node
+	)
 
-		// Either we have an asset that we commit to or we have an
-		// output just for the passive assets, which we mark as an
-		// interactive split root.
-		switch {
-		// This is a "valid" output for just carrying passive assets
-		// (marked as interactive split root and not committing to an
-		// active asset transfer).
-		case vOut.Interactive && vOut.Type.IsSplitRoot() && vOut.Asset == nil:
-			vOut.Type = tappsbt.TypePassiveAssetsOnly
-
-		// In any other case we expect an active asset transfer to be
-		// committed to.
-		case vOut.Asset != nil:
-			proofSuffix, err := s.createProofSuffix(idx)
-			if err != nil {
-				return nil, fmt.Errorf("unable to create "+
-					"proof %d: %w", idx, err)
-			}
-			err = proofSuffix.Encode(&proofSuffixBuf)
-			if err != nil {
-				return nil, fmt.Errorf("unable to encode "+
-					"proof %d: %w", idx, err)
-			}
-			witness = vOut.Asset.PrevWitnesses
-			splitCommitmentRoot = vOut.Asset.SplitCommitmentRoot
+	// If there are passive assets, they are always committed to the output
+	// that is marked as the split root.
+	if vOut.Type.CanCarryPassive() && assignPassives {
+		numPassiveAssets = uint32(len(s.PassiveAssets))
+	}
 
-		default:
-			return nil, fmt.Errorf("invalid output %d, asset "+
-				"missing and not marked for passive assets",
-				idx)
+	// Either we have an asset that we commit to or we have an output just
+	// for the passive assets, which we mark as an interactive split root.
+	switch {
+	// This is a "valid" output for just carrying passive assets (marked as
+	// interactive split root and not committing to an active asset
+	// transfer).
+	case vOut.Interactive && vOut.Type.IsSplitRoot() && vOut.Asset == nil:
+		vOut.Type = tappsbt.TypePassiveAssetsOnly
+
+	// In any other case we expect an active asset transfer to be committed
+	// to.
+	case vOut.Asset != nil:
+		proofSuffix, err := s.createProofSuffix(vPkt, idx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create proof %d: %w",
+				idx, err)
+		}
+		err = proofSuffix.Encode(&proofSuffixBuf)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode proof %d: %w",
+				idx, err)
 		}
+		witness = vOut.Asset.PrevWitnesses
+		splitCommitmentRoot = vOut.Asset.SplitCommitmentRoot
 
-		txOut := s.AnchorTx.FinalTx.TxOut[vOut.AnchorOutputIndex]
-		parcel.Outputs[idx] = TransferOutput{
-			Anchor: Anchor{
-				OutPoint: wire.OutPoint{
-					Hash:  anchorTXID,
-					Index: vOut.AnchorOutputIndex,
-				},
-				Value:            btcutil.Amount(txOut.Value),
-				InternalKey:      anchorInternalKey,
-				TaprootAssetRoot: taprootAssetRoot[:],
-				MerkleRoot:       merkleRoot[:],
-				TapscriptSibling: preimageBytes,
-				NumPassiveAssets: numPassiveAssets,
+	default:
+		return nil, fmt.Errorf("invalid output %d, asset missing and "+
+			"not marked for passive assets", idx)
+	}
+
+	txOut := s.AnchorTx.FinalTx.TxOut[vOut.AnchorOutputIndex]
+	return &TransferOutput{
+		Anchor: Anchor{
+			OutPoint: wire.OutPoint{
+				Hash:  anchorTXID,
+				Index: vOut.AnchorOutputIndex,
 			},
-			Type:                vOut.Type,
-			ScriptKey:           vOut.ScriptKey,
-			Amount:              vOut.Amount,
-			AssetVersion:        vOut.AssetVersion,
-			WitnessData:         witness,
-			SplitCommitmentRoot: splitCommitmentRoot,
-			ProofSuffix:         proofSuffixBuf.Bytes(),
-			ProofCourierAddr:    proofCourierAddrBytes,
-		}
+			Value:            btcutil.Amount(txOut.Value),
+			InternalKey:      anchorInternalKey,
+			TaprootAssetRoot: taprootAssetRoot[:],
+			MerkleRoot:       merkleRoot[:],
+			TapscriptSibling: preimageBytes,
+			NumPassiveAssets: numPassiveAssets,
+		},
+		Type:                vOut.Type,
+		ScriptKey:           vOut.ScriptKey,
+		Amount:              vOut.Amount,
+		AssetVersion:        vOut.AssetVersion,
+		WitnessData:         witness,
+		SplitCommitmentRoot: splitCommitmentRoot,
+		ProofSuffix:         proofSuffixBuf.Bytes(),
+		ProofCourierAddr:    proofCourierAddrBytes,
+	}, nil
+}
+
+// allOutputs returns the outputs of all virtual packets of the send package.
+func (s *sendPackage) allOutputs() []*tappsbt.VOutput {
+	var outputs []*tappsbt.VOutput
+	for _, vPkt := range s.VirtualPackets {
+		outputs = append(outputs, vPkt.Outputs...)
 	}
 
-	return parcel, nil
+	return outputs
+}
+
+// isAnchorOutput returns true if any of the virtual outputs of the send package
+// is committed to the anchor output with the given index.
+func (s *sendPackage) isAnchorOutput(idx uint32) bool {
+	return fn.Any(s.allOutputs(), func(vOut *tappsbt.VOutput) bool {
+		return vOut.AnchorOutputIndex == idx
+	})
 }
 
 // createProofSuffix creates the new proof for the given output. This is the
 // final state transition that will be added to the proofs of the receiver. The
 // proof returned will have all the Taproot Asset level proof information, but
 // contains dummy data for the on-chain part.
-func (s *sendPackage) createProofSuffix(outIndex int) (*proof.Proof, error) {
-	inputPrevID := s.VirtualPacket.Inputs[0].PrevID
+func (s *sendPackage) createProofSuffix(vPkt *tappsbt.VPacket,
+	outIndex int) (*proof.Proof, error) {
 
-	params, err := proofParams(s.AnchorTx, s.VirtualPacket, outIndex)
+	inputPrevID := vPkt.Inputs[0].PrevID
+
+	params, err := proofParams(
+		s.AnchorTx, vPkt, outIndex, s.allOutputs(),
+	)
 	if err != nil {
 		return nil, err
 	}
 
 	// We also need to account for any P2TR change outputs.
 	if len(s.AnchorTx.FundedPsbt.Pkt.UnsignedTx.TxOut) > 1 {
-		isAnchor := func(idx uint32) bool {
-			for outIdx := range s.VirtualPacket.Outputs {
-				vOut := s.VirtualPacket.Outputs[outIdx]
-				if vOut.AnchorOutputIndex == idx {
-					return true
-				}
-			}
-
-			return false
-		}
-
 		err := proof.AddExclusionProofs(
 			&params.BaseProofParams, s.AnchorTx.FundedPsbt.Pkt,
-			isAnchor,
+			s.isAnchorOutput,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("error adding exclusion "+
@@ -583,9 +677,12 @@ func newParams(anchorTx *AnchorTransaction, a *asset.Asset, outputIndex int,
 }
 
 // proofParams creates the set of parameters that will be used to create the
-// proofs for the sender and receiver.
+// proofs for the sender and receiver. The given outputs are the outputs of all
+// virtual packets anchored in the anchor transaction, which all need an
+// exclusion proof if they don't commit to the same anchor output.
 func proofParams(anchorTx *AnchorTransaction, vPkt *tappsbt.VPacket,
-	outIndex int) (*proof.TransitionParams, error) {
+	outIndex int, allOutputs []*tappsbt.VOutput) (*proof.TransitionParams,
+	error) {
 
 	outputCommitments := anchorTx.OutputCommitments
 
@@ -610,12 +707,15 @@ func proofParams(anchorTx *AnchorTransaction, vPkt *tappsbt.VPacket,
 			rootOut.AnchorOutputTapscriptSibling,
 		)
 
-		// Add exclusion proofs for all the other outputs.
+		// Add exclusion proofs for all the other outputs. Any output
+		// that is committed to the same anchor output as our root
+		// output is covered by the inclusion proof already.
 		err = addOtherOutputExclusionProofs(
-			vPkt.Outputs, rootOut.Asset, rootParams,
+			allOutputs, rootOut.Asset, rootParams,
 			outputCommitments,
-			func(i int, _ *tappsbt.VOutput) bool {
-				return i == outIndex
+			func(_ int, vOut *tappsbt.VOutput) bool {
+				return vOut == rootOut ||
+					vOut.AnchorOutputIndex == rootIndex
 			},
 		)
 		if err != nil {
@@ -668,14 +768,14 @@ func proofParams(anchorTx *AnchorTransaction, vPkt *tappsbt.VPacket,
 
 	// Add exclusion proofs for all the other outputs.
 	err = addOtherOutputExclusionProofs(
-		vPkt.Outputs, splitOut.Asset, splitParams, outputCommitments,
-		func(i int, vOut *tappsbt.VOutput) bool {
+		allOutputs, splitOut.Asset, splitParams, outputCommitments,
+		func(_ int, vOut *tappsbt.VOutput) bool {
 			// We don't need exclusion proofs for:
 			//	- The split output itself.
 			//	- The split root output.
 			//	- Any output that is committed to the same
 			//	  anchor output as our split output.
-			return i == outIndex || vOut == splitRootOut ||
+			return vOut == splitOut || vOut == splitRootOut ||
 				vOut.AnchorOutputIndex == splitIndex
 		},
 	)
@@ -749,7 +849,7 @@ func (s *sendPackage) createReAnchorProof(
 	// normally contains asset change. But it can also be that the split
 	// root output was just created for the passive assets, if there is no
 	// active transfer or no change.
-	passiveCarrierOut, err := s.VirtualPacket.PassiveAssetsOutput()
+	passiveCarrierOut, err := passiveAssetsOutput(s.VirtualPackets)
 	if err != nil {
 		return nil, fmt.Errorf("anchor output for passive assets not "+
 			"found: %w", err)
@@ -771,7 +871,7 @@ func (s *sendPackage) createReAnchorProof(
 	// provide an exclusion proof of the passive asset for each of the other
 	// BTC level outputs.
 	err = addOtherOutputExclusionProofs(
-		s.VirtualPacket.Outputs, passiveOut.Asset, passiveParams,
+		s.allOutputs(), passiveOut.Asset, passiveParams,
 		outputCommitments, func(i int, vOut *tappsbt.VOutput) bool {
 			return vOut.AnchorOutputIndex == passiveOutputIndex
 		},
@@ -783,20 +883,9 @@ func (s *sendPackage) createReAnchorProof(
 	// Add exclusion proof(s) for any P2TR (=BIP-0086, not carrying any
 	// assets) change outputs.
 	if len(s.AnchorTx.FundedPsbt.Pkt.UnsignedTx.TxOut) > 1 {
-		isAnchor := func(idx uint32) bool {
-			for outIdx := range s.VirtualPacket.Outputs {
-				vOut := s.VirtualPacket.Outputs[outIdx]
-				if vOut.AnchorOutputIndex == idx {
-					return true
-				}
-			}
-
-			return false
-		}
-
 		err := proof.AddExclusionProofs(
 			&passiveParams.BaseProofParams,
-			s.AnchorTx.FundedPsbt.Pkt, isAnchor,
+			s.AnchorTx.FundedPsbt.Pkt, s.isAnchorOutput,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("error adding exclusion "+
@@ -64,6 +64,11 @@ var (
 	// assets of an anchor output, which is not supported.
 	ErrFullBurnNotSupported = errors.New("burning all assets of an " +
 		"anchor output is not supported")
+
+	// ErrUnknownChangeScriptKey is returned when the change of a send is
+	// requested to go to a script key that isn't known to the wallet.
+	ErrUnknownChangeScriptKey = errors.New("change script key not " +
+		"known to the wallet")
 )
 
 // AnchorTransaction is a type that holds all information about a BTC level
@@ -92,21 +97,49 @@ type AnchorTransaction struct {
 	OutputCommitments map[uint32]*commitment.TapCommitment
 }
 
+// AnchorFeeEstimate is the estimated size and on-chain fee of a BTC level
+// anchor transaction.
+type AnchorFeeEstimate struct {
+	// Weight is the estimated weight of the fully signed anchor
+	// transaction.
+	Weight int64
+
+	// FeeRate is the fee rate the anchor transaction was funded with.
+	FeeRate chainfee.SatPerKWeight
+
+	// TotalFee is the total amount of sats the anchor transaction would
+	// pay in on-chain fees.
+	TotalFee btcutil.Amount
+}
+
+// VSize returns the estimated virtual size of the anchor transaction.
+func (e *AnchorFeeEstimate) VSize() int64 {
+	return (e.Weight + blockchain.WitnessScaleFactor - 1) /
+		blockchain.WitnessScaleFactor
+}
+
 // Wallet is an interface for funding and signing asset transfers.
 type Wallet interface {
-	// FundAddressSend funds a virtual transaction, selecting assets to
-	// spend in order to pay the given address. It also returns supporting
-	// data which assists in processing the virtual transaction: passive
-	// asset re-anchors and the Taproot Asset level commitment of the
-	// selected assets.
+	// FundAddressSend funds one virtual transaction for each distinct
+	// asset ID of the given addresses, selecting assets to spend in order
+	// to pay them. All returned virtual transactions are meant to be
+	// anchored in the same BTC level transaction. Each funded packet also
+	// carries supporting data which assists in processing the virtual
+	// transaction: the Taproot Asset level commitment of the selected
+	// assets and the addresses paid by its outputs. The given strategy is
+	// used for coin selection, and the change options control where the
+	// change goes.
 	FundAddressSend(ctx context.Context,
-		receiverAddrs ...*address.Tap) (*FundedVPacket,
-		tappsbt.OutputIdxToAddr, error)
+		strategy MultiCommitmentSelectStrategy,
+		changeOpts ChangeOptions,
+		receiverAddrs ...*address.Tap) ([]*FundedVPacket, error)
 
 	// FundPacket funds a virtual transaction, selecting assets to spend
-	// in order to pay the given recipient. The selected input is then added
-	// to the given virtual transaction.
+	// in order to pay the given recipient using the given coin selection
+	// strategy. The selected input is then added to the given virtual
+	// transaction.
 	FundPacket(ctx context.Context, fundDesc *tapscript.FundingDescriptor,
+		strategy MultiCommitmentSelectStrategy,
 		vPkt *tappsbt.VPacket) (*FundedVPacket, error)
 
 	// FundBurn funds a virtual transaction for burning the given amount of
@@ -120,11 +153,12 @@ type Wallet interface {
 		optFuncs ...SignVirtualPacketOption) ([]uint32, error)
 
 	// SignPassiveAssets creates and signs the passive asset packets for the
-	// given input commitment and virtual packet that contains the active
-	// asset transfer.
-	SignPassiveAssets(vPkt *tappsbt.VPacket,
-		inputCommitments tappsbt.InputCommitments) ([]*PassiveAssetReAnchor,
-		error)
+	// given input commitments and virtual packets that contain the active
+	// asset transfers. The input commitments are expected to be in the
+	// same order as the virtual packets.
+	SignPassiveAssets(vPkts []*tappsbt.VPacket,
+		inputCommitments []tappsbt.InputCommitments) (
+		[]*PassiveAssetReAnchor, error)
 
 	// AnchorVirtualTransactions creates a BTC level anchor transaction that
 	// anchors all the virtual transactions of the given packets (for both
@@ -137,10 +171,24 @@ type Wallet interface {
 	AnchorVirtualTransactions(ctx context.Context,
 		params *AnchorVTxnsParams) (*AnchorTransaction, error)
 
+	// EstimateAnchorTxFee funds a template of the BTC level anchor
+	// transaction for the given virtual transactions, without signing or
+	// broadcasting it, and returns its estimated size and fee. Any wallet
+	// UTXOs leased while funding the template are released again.
+	EstimateAnchorTxFee(ctx context.Context,
+		params *AnchorVTxnsParams) (*AnchorFeeEstimate, error)
+
 	// SignOwnershipProof creates and signs an ownership proof for the given
 	// owned asset. The ownership proof consists of a valid witness of a
 	// signed virtual packet that spends the asset fully to the NUMS key.
 	SignOwnershipProof(ownedAsset *asset.Asset) (wire.TxWitness, error)
+
+	// SignMessage signs the given message with the given script key, which
+	// must be a script key of this wallet. The signature is a schnorr
+	// signature over the BIP-0322 virtual transaction that commits to the
+	// message.
+	SignMessage(ctx context.Context, scriptKey *btcec.PublicKey,
+		msg []byte) (*schnorr.Signature, error)
 }
 
 // AddrBook is an interface that provides access to the address book.
@@ -164,9 +212,10 @@ type AnchorVTxnsParams struct {
 	// anchored by the anchor transaction.
 	VPkts []*tappsbt.VPacket
 
-	// InputCommitments is a map from virtual package input index to its
+	// InputCommitments holds, for each of the virtual transactions above
+	// and in the same order, a map from virtual package input index to its
 	// associated Taproot Assets commitment.
-	InputCommitments tappsbt.InputCommitments
+	InputCommitments []tappsbt.InputCommitments
 
 	// PassiveAssetsVPkts is a list of all the virtual transactions which
 	// re-anchor passive assets.
@@ -284,42 +333,45 @@ func (s *CoinSelect) selectForAmount(minTotalAmount uint64,
 	strategy MultiCommitmentSelectStrategy) ([]*AnchoredCommitment,
 	error) {
 
-	// Select the first subset of eligible commitments which cumulatively
-	// sum to at least the minimum required amount.
 	var selectedCommitments []*AnchoredCommitment
-	amountSum := uint64(0)
 
 	switch strategy {
 	case PreferMaxAmount:
 		// Sort eligible commitments from the largest amount to
-		// smallest.
-		sort.Slice(
-			eligibleCommitments, func(i, j int) bool {
-				isLess := eligibleCommitments[i].Asset.Amount <
-					eligibleCommitments[j].Asset.Amount
-
-				// Negate the result to sort in descending
-				// order.
-				return !isLess
-			},
+		// smallest, then select the first subset of eligible
+		// commitments which cumulatively sum to at least the minimum
+		// required amount.
+		sortCommitmentsByAmount(eligibleCommitments, true)
+		selectedCommitments = selectInOrder(
+			minTotalAmount, eligibleCommitments,
 		)
 
-		// Select the first subset of eligible commitments which
-		// cumulatively sum to at least the minimum required amount.
-		for _, anchoredCommitment := range eligibleCommitments {
-			selectedCommitments = append(
-				selectedCommitments, anchoredCommitment,
-			)
+	case PreferMinAmount:
+		// Sort eligible commitments from the smallest amount to
+		// largest, then select the first subset of eligible
+		// commitments which cumulatively sum to at least the minimum
+		// required amount.
+		sortCommitmentsByAmount(eligibleCommitments, false)
+		selectedCommitments = selectInOrder(
+			minTotalAmount, eligibleCommitments,
+		)
 
-			// Keep track of the total amount of assets we've seen
-			// so far.
-			amountSum += uint64(anchoredCommitment.Asset.Amount)
-			if amountSum >= minTotalAmount {
-				// At this point a target min amount was
-				// specified and has been reached.
-				break
-			}
-		}
+	case MinimizeInputs:
+		selectedCommitments = selectMinInputs(
+			minTotalAmount, eligibleCommitments,
+		)
+
+	case MinimizeChange:
+		selectedCommitments = selectMinChange(
+			minTotalAmount, eligibleCommitments,
+		)
+
+	case ConsolidateAll:
+		// Sort eligible commitments from the largest amount to
+		// smallest, so the inputs are in the same order as with the
+		// default strategy, then select all of them.
+		sortCommitmentsByAmount(eligibleCommitments, true)
+		selectedCommitments = eligibleCommitments
 
 	default:
 		return nil, fmt.Errorf("unknown multi coin selection "+
@@ -328,12 +380,169 @@ func (s *CoinSelect) selectForAmount(minTotalAmount uint64,
 
 	// Having examined all the eligible commitments, return an error if the
 	// minimal funding amount was not reached.
-	if amountSum < minTotalAmount {
+	if sumCommitments(selectedCommitments) < minTotalAmount {
 		return nil, ErrMatchingAssetsNotFound
 	}
 	return selectedCommitments, nil
 }
 
+// sortCommitmentsByAmount sorts the given commitments in place by their asset
+// amount, either in descending or ascending order.
+func sortCommitmentsByAmount(commitments []*AnchoredCommitment,
+	descending bool) {
+
+	sort.SliceStable(commitments, func(i, j int) bool {
+		if descending {
+			return commitments[i].Asset.Amount >
+				commitments[j].Asset.Amount
+		}
+
+		return commitments[i].Asset.Amount < commitments[j].Asset.Amount
+	})
+}
+
+// sumCommitments returns the total asset amount of the given commitments.
+func sumCommitments(commitments []*AnchoredCommitment) uint64 {
+	var sum uint64
+	for _, c := range commitments {
+		sum += c.Asset.Amount
+	}
+
+	return sum
+}
+
+// selectInOrder selects the first subset of the given commitments, in the
+// order given, which cumulatively sums to at least the minimum required amount.
+// If the amount can't be reached, all commitments are returned.
+func selectInOrder(minTotalAmount uint64,
+	commitments []*AnchoredCommitment) []*AnchoredCommitment {
+
+	var (
+		selectedCommitments []*AnchoredCommitment
+		amountSum           uint64
+	)
+	for _, anchoredCommitment := range commitments {
+		selectedCommitments = append(
+			selectedCommitments, anchoredCommitment,
+		)
+
+		// Keep track of the total amount of assets we've seen so far.
+		amountSum += anchoredCommitment.Asset.Amount
+		if amountSum >= minTotalAmount {
+			break
+		}
+	}
+
+	return selectedCommitments
+}
+
+// selectMinInputs selects the smallest number of commitments that cumulatively
+// sum to at least the minimum required amount. The largest commitments are
+// used to fill up the target, with the last input being the smallest
+// commitment that is still large enough to reach it. This keeps the number of
+// inputs minimal while reducing the change amount.
+func selectMinInputs(minTotalAmount uint64,
+	commitments []*AnchoredCommitment) []*AnchoredCommitment {
+
+	// Taking the largest commitments first gives us the minimum number of
+	// inputs required to reach the target amount.
+	sortCommitmentsByAmount(commitments, true)
+	selectedCommitments := selectInOrder(minTotalAmount, commitments)
+	numSelected := len(selectedCommitments)
+	if numSelected == 0 ||
+		sumCommitments(selectedCommitments) < minTotalAmount {
+
+		return selectedCommitments
+	}
+
+	// All but the last input are the largest commitments. For the last
+	// input, we pick the smallest of the remaining commitments that still
+	// covers what's left of the target amount. Since the commitments are
+	// sorted in descending order, the last one that fits is the smallest.
+	fixed := selectedCommitments[:numSelected-1]
+	remaining := minTotalAmount - sumCommitments(fixed)
+	lastInput := selectedCommitments[numSelected-1]
+	for _, c := range commitments[numSelected:] {
+		if c.Asset.Amount < remaining {
+			break
+		}
+
+		lastInput = c
+	}
+
+	result := make([]*AnchoredCommitment, 0, numSelected)
+	result = append(result, fixed...)
+
+	return append(result, lastInput)
+}
+
+// selectMinChange selects a subset of the given commitments whose sum is as
+// close as possible to the minimum required amount, with an exact match being
+// preferred. Finding the optimal subset is a subset sum problem, so a greedy
+// approximation is used: commitments are added from largest to smallest as long
+// as they don't overshoot the target. If that doesn't produce an exact match,
+// the gap is closed with the smallest unused commitment that covers it, or a
+// single commitment is used, whichever results in less change. Ties are
+// resolved in favor of fewer inputs.
+func selectMinChange(minTotalAmount uint64,
+	commitments []*AnchoredCommitment) []*AnchoredCommitment {
+
+	sortCommitmentsByAmount(commitments, true)
+
+	var (
+		greedySelection []*AnchoredCommitment
+		unused          []*AnchoredCommitment
+		remaining       = minTotalAmount
+	)
+	for _, c := range commitments {
+		if remaining > 0 && c.Asset.Amount <= remaining {
+			greedySelection = append(greedySelection, c)
+			remaining -= c.Asset.Amount
+
+			continue
+		}
+
+		unused = append(unused, c)
+	}
+
+	// An exact match doesn't require any change, so we're done.
+	if remaining == 0 {
+		return greedySelection
+	}
+
+	// The smallest unused commitment that covers the remaining amount
+	// completes the greedy selection with the least change. Any unused
+	// commitment was larger than the remaining amount at the time it was
+	// considered, so if there is none, the target can't be reached.
+	if len(unused) == 0 {
+		return greedySelection
+	}
+	closer := unused[len(unused)-1]
+	greedySelection = append(greedySelection, closer)
+	greedyChange := sumCommitments(greedySelection) - minTotalAmount
+
+	// Alternatively, the smallest single commitment that covers the whole
+	// target amount might result in less change.
+	var single *AnchoredCommitment
+	for _, c := range commitments {
+		if c.Asset.Amount < minTotalAmount {
+			break
+		}
+
+		single = c
+	}
+	if single == nil {
+		return greedySelection
+	}
+
+	singleChange := single.Asset.Amount - minTotalAmount
+	if singleChange <= greedyChange {
+		return []*AnchoredCommitment{single}
+	}
+
+	return greedySelection
+}
+
 var _ CoinSelector = (*CoinSelect)(nil)
 
 // WalletConfig holds the configuration for a new Wallet.
@@ -392,43 +601,264 @@ type FundedVPacket struct {
 	// transfer.
 	VPacket *tappsbt.VPacket
 
+	// OutputIdxToAddr is a map from the virtual transaction's output index
+	// to the Taproot Asset address it pays. This is only set for packets
+	// that were funded to pay addresses.
+	OutputIdxToAddr tappsbt.OutputIdxToAddr
+
 	// InputCommitments is a map from virtual package input index to its
 	// associated Taproot Asset commitment.
 	InputCommitments tappsbt.InputCommitments
 }
 
-// FundAddressSend funds a virtual transaction, selecting assets to spend in
-// order to pay the given address. It also returns supporting data which assists
-// in processing the virtual transaction: passive asset re-anchors and the
-// Taproot Asset level commitment of the selected assets.
+// ChangeOptions controls where the change of an address send goes and how the
+// inputs of the send are selected.
+type ChangeOptions struct {
+	// ScriptKey is the optional script key the change is sent to, which
+	// must be known to the wallet. If this is nil, a new script key is
+	// derived for the change. The change is always anchored in an output
+	// with a newly derived internal key.
+	ScriptKey *btcec.PublicKey
+
+	// ConsolidateInputs, if true, spends all spendable coins of the sent
+	// assets, consolidating them into the change output. The coin
+	// selection strategy of the send has no effect in that case.
+	ConsolidateInputs bool
+}
+
+// FundAddressSend funds one virtual transaction for each distinct asset ID of
+// the given addresses, selecting assets to spend in order to pay them. All
+// returned virtual transactions are meant to be anchored in the same BTC level
+// transaction, so they share a single change output. Each funded packet also
+// carries supporting data which assists in processing the virtual
+// transaction: the Taproot Asset level commitment of the selected assets and
+// the addresses paid by its outputs. The given strategy is used for coin
+// selection, unless the change options ask for the inputs to be consolidated.
 //
 // NOTE: This is part of the Wallet interface.
 func (f *AssetWallet) FundAddressSend(ctx context.Context,
-	receiverAddrs ...*address.Tap) (*FundedVPacket,
-	tappsbt.OutputIdxToAddr, error) {
-
-	// We start by creating a new virtual transaction that will be used to
-	// hold the asset transfer. Because sending to an address is always a
-	// non-interactive process, we can use this function that always creates
-	// a change output.
-	vPkt, outputIdxToAddr, err := tappsbt.FromAddresses(receiverAddrs, 1)
-	if err != nil {
-		return nil, nil, fmt.Errorf("unable to create virtual "+
-			"transaction from addresses: %w", err)
+	strategy MultiCommitmentSelectStrategy, changeOpts ChangeOptions,
+	receiverAddrs ...*address.Tap) ([]*FundedVPacket, error) {
+
+	if len(receiverAddrs) == 0 {
+		return nil, fmt.Errorf("at least one address must be " +
+			"specified")
 	}
 
-	fundDesc, err := tapscript.DescribeAddrs(receiverAddrs)
-	if err != nil {
-		return nil, nil, fmt.Errorf("unable to describe recipients: "+
-			"%w", err)
+	if changeOpts.ConsolidateInputs {
+		strategy = ConsolidateAll
 	}
 
-	fundedVPkt, err := f.FundPacket(ctx, fundDesc, vPkt)
-	if err != nil {
-		return nil, nil, err
+	// We only send change to script keys we know, otherwise the change
+	// could neither be tracked nor spent.
+	var changeScriptKey *asset.ScriptKey
+	if changeOpts.ScriptKey != nil {
+		tweakedKey, err := f.cfg.AddrBook.FetchScriptKey(
+			ctx, changeOpts.ScriptKey,
+		)
+		switch {
+		case errors.Is(err, address.ErrScriptKeyNotFound):
+			return nil, fmt.Errorf("%w: %x",
+				ErrUnknownChangeScriptKey,
+				changeOpts.ScriptKey.SerializeCompressed())
+
+		case err != nil:
+			return nil, fmt.Errorf("cannot fetch change script "+
+				"key: %w", err)
+		}
+
+		changeScriptKey = &asset.ScriptKey{
+			PubKey:           changeOpts.ScriptKey,
+			TweakedScriptKey: tweakedKey,
+		}
+	}
+
+	// Within a single virtual transaction, we expect only to have inputs
+	// and outputs of the same asset ID. So we group the addresses by their
+	// asset ID, keeping the order in which the asset IDs first appear.
+	var (
+		assetIDs    []asset.ID
+		addrsByID   = make(map[asset.ID][]*address.Tap)
+		fundedVPkts = make([]*FundedVPacket, 0, len(receiverAddrs))
+		leasedCoins []*AnchoredCommitment
+		usedCoins   []*AnchoredCommitment
+	)
+	for _, addr := range receiverAddrs {
+		if _, ok := addrsByID[addr.AssetID]; !ok {
+			assetIDs = append(assetIDs, addr.AssetID)
+		}
+		addrsByID[addr.AssetID] = append(addrsByID[addr.AssetID], addr)
 	}
 
-	return fundedVPkt, outputIdxToAddr, nil
+	// If we return with an error, we want to release the coins we've
+	// selected.
+	success := false
+	defer func() {
+		if !success && len(leasedCoins) > 0 {
+			outpoints := fn.Map(
+				leasedCoins,
+				func(c *AnchoredCommitment) wire.OutPoint {
+					return c.AnchorPoint
+				},
+			)
+			err := f.cfg.CoinSelector.ReleaseCoins(
+				ctx, outpoints...,
+			)
+			if err != nil {
+				log.Errorf("Unable to release coins: %v", err)
+			}
+		}
+	}()
+
+	// The change output is always anchored at output index 0, so the
+	// recipients of the first packet start at index 1. The recipients of
+	// all following packets continue where the previous packet left off.
+	nextOutputIndex := uint32(1)
+	for _, assetID := range assetIDs {
+		addrs := addrsByID[assetID]
+
+		// We start by creating a new virtual transaction that will be
+		// used to hold the asset transfer. Because sending to an
+		// address is always a non-interactive process, we can use this
+		// function that always creates a change output.
+		vPkt, outputIdxToAddr, err := tappsbt.FromAddresses(
+			addrs, nextOutputIndex,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create virtual "+
+				"transaction from addresses: %w", err)
+		}
+		nextOutputIndex += uint32(len(addrs))
+
+		// The input and address networks must match.
+		if !address.IsForNet(
+			vPkt.ChainParams.TapHRP, f.cfg.ChainParams,
+		) {
+
+			return nil, address.ErrMismatchedHRP
+		}
+
+		fundDesc, err := tapscript.DescribeAddrs(addrs)
+		if err != nil {
+			return nil, fmt.Errorf("unable to describe "+
+				"recipients: %w", err)
+		}
+
+		// All packets commit their change to the same anchor output,
+		// so they need to agree on the anchor's internal key. We use
+		// the one that was derived for the first packet.
+		if len(fundedVPkts) > 0 {
+			firstPkt := fundedVPkts[0].VPacket
+			firstChange, err := firstPkt.SplitRootOutput()
+			if err != nil {
+				return nil, fmt.Errorf("unable to find change "+
+					"output: %w", err)
+			}
+
+			changeOut := vPkt.Outputs[0]
+			changeOut.AnchorOutputInternalKey =
+				firstChange.AnchorOutputInternalKey
+			changeOut.AnchorOutputBip32Derivation =
+				firstChange.AnchorOutputBip32Derivation
+			changeOut.AnchorOutputTaprootBip32Derivation =
+				firstChange.AnchorOutputTaprootBip32Derivation
+		}
+
+		// The anchor outputs we're already spending for previous
+		// packets might also hold units of this asset. Those would
+		// otherwise need to be carried along as passive assets, so we
+		// spend them first and only select additional coins if they
+		// don't cover the amount to send.
+		inputs := anchoredAssetsOfID(usedCoins, fundDesc)
+		inputAmt := fn.Reduce(
+			inputs, func(sum uint64, c *AnchoredCommitment) uint64 {
+				return sum + c.Asset.Amount
+			},
+		)
+		//
+		// When consolidating, we always select all remaining coins.
+		if inputAmt < fundDesc.Amount || strategy == ConsolidateAll {
+			var minAmt uint64
+			if inputAmt < fundDesc.Amount {
+				minAmt = fundDesc.Amount - inputAmt
+			}
+
+			constraints := CommitmentConstraints{
+				GroupKey: fundDesc.GroupKey,
+				AssetID:  &fundDesc.ID,
+				MinAmt:   minAmt,
+			}
+			selectedCoins, err := f.cfg.CoinSelector.SelectCoins(
+				ctx, constraints, strategy,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			leasedCoins = append(leasedCoins, selectedCoins...)
+			inputs = append(inputs, selectedCoins...)
+		}
+
+		fundedVPkt, err := f.fundPacketWithInputs(
+			ctx, fundDesc, vPkt, inputs, changeScriptKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+		fundedVPkt.OutputIdxToAddr = outputIdxToAddr
+
+		fundedVPkts = append(fundedVPkts, fundedVPkt)
+		usedCoins = append(usedCoins, inputs...)
+	}
+
+	// Don't release the coins we've selected, as so far we've been
+	// successful.
+	success = true
+	return fundedVPkts, nil
+}
+
+// anchoredAssetsOfID returns the spendable assets of the given funding
+// descriptor's asset ID that are committed to any of the anchor outputs of the
+// given coins, just enough to cover the descriptor's amount if possible. Each
+// anchor output is only considered once.
+func anchoredAssetsOfID(coins []*AnchoredCommitment,
+	fundDesc *tapscript.FundingDescriptor) []*AnchoredCommitment {
+
+	var (
+		result   []*AnchoredCommitment
+		total    uint64
+		anchored = make(map[wire.OutPoint]struct{})
+	)
+	for _, coin := range coins {
+		if _, ok := anchored[coin.AnchorPoint]; ok {
+			continue
+		}
+		anchored[coin.AnchorPoint] = struct{}{}
+
+		for _, a := range coin.Commitment.CommittedAssets() {
+			if total >= fundDesc.Amount {
+				return result
+			}
+
+			if a.ID() != fundDesc.ID || a.Amount == 0 ||
+				a.IsUnSpendable() || a.IsBurn() {
+
+				continue
+			}
+
+			result = append(result, &AnchoredCommitment{
+				AnchorPoint:       coin.AnchorPoint,
+				AnchorOutputValue: coin.AnchorOutputValue,
+				InternalKey:       coin.InternalKey,
+				TapscriptSibling:  coin.TapscriptSibling,
+				Commitment:        coin.Commitment,
+				Asset:             a,
+			})
+			total += a.Amount
+		}
+	}
+
+	return result
 }
 
 // passiveAssetVPacket creates a virtual packet for the given passive asset.
@@ -495,10 +925,11 @@ func (f *AssetWallet) passiveAssetVPacket(passiveAsset *asset.Asset,
 }
 
 // FundPacket funds a virtual transaction, selecting assets to spend in order to
-// pay the given recipient. The selected input is then added to the given
-// virtual transaction.
+// pay the given recipient using the given coin selection strategy. The selected
+// input is then added to the given virtual transaction.
 func (f *AssetWallet) FundPacket(ctx context.Context,
 	fundDesc *tapscript.FundingDescriptor,
+	strategy MultiCommitmentSelectStrategy,
 	vPkt *tappsbt.VPacket) (*FundedVPacket, error) {
 
 	// The input and address networks must match.
@@ -515,13 +946,15 @@ func (f *AssetWallet) FundPacket(ctx context.Context,
 		MinAmt:   fundDesc.Amount,
 	}
 	selectedCommitments, err := f.cfg.CoinSelector.SelectCoins(
-		ctx, constraints, PreferMaxAmount,
+		ctx, constraints, strategy,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return f.fundPacketWithInputs(ctx, fundDesc, vPkt, selectedCommitments)
+	return f.fundPacketWithInputs(
+		ctx, fundDesc, vPkt, selectedCommitments, nil,
+	)
 }
 
 // FundBurn funds a virtual transaction for burning the given amount of units of
@@ -637,7 +1070,7 @@ func (f *AssetWallet) FundBurn(ctx context.Context,
 	// The virtual transaction is now ready to be further enriched with the
 	// split commitment and other data.
 	fundedPkt, err := f.fundPacketWithInputs(
-		ctx, fundDesc, vPkt, selectedCommitments,
+		ctx, fundDesc, vPkt, selectedCommitments, nil,
 	)
 	if err != nil {
 		return nil, err
@@ -676,10 +1109,13 @@ func (f *AssetWallet) FundBurn(ctx context.Context,
 	return fundedPkt, nil
 }
 
-// fundPacketWithInputs funds a virtual transaction with the given inputs.
+// fundPacketWithInputs funds a virtual transaction with the given inputs. If a
+// change script key is given, any change that doesn't already go to a
+// spendable script key is sent to it instead of a newly derived key.
 func (f *AssetWallet) fundPacketWithInputs(ctx context.Context,
 	fundDesc *tapscript.FundingDescriptor, vPkt *tappsbt.VPacket,
-	selectedCommitments []*AnchoredCommitment) (*FundedVPacket, error) {
+	selectedCommitments []*AnchoredCommitment,
+	changeScriptKey *asset.ScriptKey) (*FundedVPacket, error) {
 
 	log.Infof("Selected %v asset inputs for send of %d to %x",
 		len(selectedCommitments), fundDesc.Amount, fundDesc.ID[:])
@@ -765,7 +1201,7 @@ func (f *AssetWallet) fundPacketWithInputs(ctx context.Context,
 		tapCommitment := inputCommitments[idx]
 
 		passiveCommitments, err := removeActiveCommitments(
-			tapCommitment, vPkt,
+			tapCommitment, vPkt.Inputs,
 		)
 		if err != nil {
 			return nil, err
@@ -829,8 +1265,12 @@ func (f *AssetWallet) fundPacketWithInputs(ctx context.Context,
 			return nil, fmt.Errorf("cannot determine if script "+
 				"key is spendable: %w", err)
 		}
-		if unSpendable && !fullValue {
-			changeScriptKey, err := f.cfg.KeyRing.DeriveNextKey(
+		switch {
+		case unSpendable && !fullValue && changeScriptKey != nil:
+			changeOut.ScriptKey = *changeScriptKey
+
+		case unSpendable && !fullValue:
+			newScriptKey, err := f.cfg.KeyRing.DeriveNextKey(
 				ctx, asset.TaprootAssetsKeyFamily,
 			)
 			if err != nil {
@@ -840,7 +1280,7 @@ func (f *AssetWallet) fundPacketWithInputs(ctx context.Context,
 			// We'll assume BIP-0086 everywhere, and use the tweaked
 			// key from here on out.
 			changeOut.ScriptKey = asset.NewScriptKeyBip86(
-				changeScriptKey,
+				newScriptKey,
 			)
 		}
 
@@ -1167,9 +1607,10 @@ func pruneTombstonesAndBurns(
 }
 
 // removeActiveCommitments removes all active commitments from the given input
-// commitment and only returns a tree of passive commitments.
+// commitment and only returns a tree of passive commitments. The active assets
+// are the assets spent by the given virtual inputs.
 func removeActiveCommitments(inputCommitment *commitment.TapCommitment,
-	vPkt *tappsbt.VPacket) (commitment.AssetCommitments, error) {
+	vInputs []*tappsbt.VInput) (commitment.AssetCommitments, error) {
 
 	// Gather passive assets found in the commitment. This creates a copy of
 	// the commitment map, so we can remove things freely.
@@ -1245,7 +1686,7 @@ func removeActiveCommitments(inputCommitment *commitment.TapCommitment,
 
 	// Remove input assets (the assets being spent) from list of assets to
 	// re-sign.
-	for _, vIn := range vPkt.Inputs {
+	for _, vIn := range vInputs {
 		key := vIn.Asset().TapCommitmentKey()
 		assetCommitment, ok := passiveCommitments[key]
 		if !ok {
@@ -1262,48 +1703,142 @@ func removeActiveCommitments(inputCommitment *commitment.TapCommitment,
 	return passiveCommitments, nil
 }
 
-// SignPassiveAssets creates and signs the passive asset packets for the given
-// virtual packet and input Taproot Asset commitments.
-func (f *AssetWallet) SignPassiveAssets(vPkt *tappsbt.VPacket,
-	inputCommitments tappsbt.InputCommitments) ([]*PassiveAssetReAnchor,
-	error) {
+// removeForeignInputs returns a copy of the given input commitments of the
+// given virtual packet in which all assets spent by any of the other virtual
+// packets are removed.
+func removeForeignInputs(inputCommitments tappsbt.InputCommitments,
+	vPkt *tappsbt.VPacket,
+	allVPkts []*tappsbt.VPacket) (tappsbt.InputCommitments, error) {
 
-	// Gather passive assets found in each input Taproot Asset commitment.
-	var passiveAssets []*PassiveAssetReAnchor
-	for inputIdx := range inputCommitments {
-		tapCommitment := inputCommitments[inputIdx]
+	foreignInputs := make(map[asset.PrevID]struct{})
+	for _, otherPkt := range allVPkts {
+		if otherPkt == vPkt {
+			continue
+		}
 
-		// Each virtual input is associated with a distinct Taproot
-		// Asset commitment. Therefore, each input may be associated
-		// with a distinct set of passive assets.
-		passiveCommitments, err := removeActiveCommitments(
-			tapCommitment, vPkt,
+		for _, vIn := range otherPkt.Inputs {
+			foreignInputs[vIn.PrevID] = struct{}{}
+		}
+	}
+
+	result := make(tappsbt.InputCommitments, len(inputCommitments))
+	for idx, inputCommitment := range inputCommitments {
+		anchorPoint := vPkt.Inputs[idx].PrevID.OutPoint
+		committedAssets := fn.Filter(
+			inputCommitment.CommittedAssets(),
+			func(a *asset.Asset) bool {
+				prevID := asset.PrevID{
+					OutPoint: anchorPoint,
+					ID:       a.ID(),
+					ScriptKey: asset.ToSerialized(
+						a.ScriptKey.PubKey,
+					),
+				}
+				_, isForeign := foreignInputs[prevID]
+
+				return !isForeign
+			},
 		)
+
+		var err error
+		result[idx], err = commitment.FromAssets(committedAssets...)
 		if err != nil {
-			return nil, err
-		}
-		if len(passiveCommitments) == 0 {
-			continue
+			return nil, fmt.Errorf("unable to create input "+
+				"commitment: %w", err)
 		}
+	}
+
+	return result, nil
+}
 
-		// When there are left over passive assets, we know we have a
-		// change output present, since we created one in a previous
-		// step if there was none to begin with.
-		anchorPoint := vPkt.Inputs[inputIdx].PrevID.OutPoint
+// passiveAssetsOutput returns the output that carries the passive assets of
+// the given virtual packets. Since all packets of a transfer share the same
+// change anchor output, the first passive asset carrying output is returned.
+func passiveAssetsOutput(vPkts []*tappsbt.VPacket) (*tappsbt.VOutput, error) {
+	for _, vPkt := range vPkts {
 		passiveOut, err := vPkt.PassiveAssetsOutput()
-		if err != nil {
-			return nil, fmt.Errorf("missing passive asset "+
-				"carrying output: %w", err)
+		if err == nil {
+			return passiveOut, nil
 		}
+	}
 
-		changeInternalKey, err := passiveOut.AnchorKeyToDesc()
-		if err != nil {
-			return nil, fmt.Errorf("unable to get change "+
-				"internal key: %w", err)
-		}
+	return nil, fmt.Errorf("no passive assets carrying output found")
+}
+
+// SignPassiveAssets creates and signs the passive asset packets for the given
+// virtual packets and input Taproot Asset commitments. The input commitments
+// are expected to be in the same order as the virtual packets.
+func (f *AssetWallet) SignPassiveAssets(vPkts []*tappsbt.VPacket,
+	inputCommitments []tappsbt.InputCommitments) ([]*PassiveAssetReAnchor,
+	error) {
 
-		for _, passiveCommitment := range passiveCommitments {
-			for _, passiveAsset := range passiveCommitment.Assets() {
+	if len(vPkts) != len(inputCommitments) {
+		return nil, fmt.Errorf("number of virtual packets (%d) does "+
+			"not match number of input commitment sets (%d)",
+			len(vPkts), len(inputCommitments))
+	}
+
+	// An asset that is spent by any of the packets is active and must not
+	// be re-anchored as a passive asset.
+	var activeInputs []*tappsbt.VInput
+	for _, vPkt := range vPkts {
+		activeInputs = append(activeInputs, vPkt.Inputs...)
+	}
+
+	// Gather passive assets found in each input Taproot Asset commitment.
+	var (
+		passiveAssets []*PassiveAssetReAnchor
+		seenAnchors   = make(map[wire.OutPoint]struct{})
+	)
+	for pktIdx, vPkt := range vPkts {
+		for inputIdx := range inputCommitments[pktIdx] {
+			tapCommitment := inputCommitments[pktIdx][inputIdx]
+
+			// Multiple inputs can be committed to the same anchor
+			// output, but we only want to re-anchor its passive
+			// assets once.
+			anchorPoint := vPkt.Inputs[inputIdx].PrevID.OutPoint
+			if _, ok := seenAnchors[anchorPoint]; ok {
+				continue
+			}
+			seenAnchors[anchorPoint] = struct{}{}
+
+			// Each virtual input is associated with a distinct
+			// Taproot Asset commitment. Therefore, each input may
+			// be associated with a distinct set of passive assets.
+			passiveCommitments, err := removeActiveCommitments(
+				tapCommitment, activeInputs,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if len(passiveCommitments) == 0 {
+				continue
+			}
+
+			// When there are left over passive assets, we know we
+			// have a change output present, since we created one
+			// in a previous step if there was none to begin with.
+			passiveOut, err := passiveAssetsOutput(vPkts)
+			if err != nil {
+				return nil, fmt.Errorf("missing passive asset "+
+					"carrying output: %w", err)
+			}
+
+			changeInternalKey, err := passiveOut.AnchorKeyToDesc()
+			if err != nil {
+				return nil, fmt.Errorf("unable to get change "+
+					"internal key: %w", err)
+			}
+
+			var assets []*asset.Asset
+			for _, passiveCommitment := range passiveCommitments {
+				for _, a := range passiveCommitment.Assets() {
+					assets = append(assets, a)
+				}
+			}
+
+			for _, passiveAsset := range assets {
 				passivePkt := f.passiveAssetVPacket(
 					passiveAsset, anchorPoint,
 					passiveOut.AnchorOutputIndex,
@@ -1347,27 +1882,53 @@ func (f *AssetWallet) SignPassiveAssets(vPkt *tappsbt.VPacket,
 func (f *AssetWallet) AnchorVirtualTransactions(ctx context.Context,
 	params *AnchorVTxnsParams) (*AnchorTransaction, error) {
 
-	// We currently only support anchoring a single virtual transaction.
-	//
-	// TODO(guggero): Support merging and anchoring multiple virtual
-	// transactions.
-	if len(params.VPkts) != 1 {
-		return nil, fmt.Errorf("only a single virtual transaction is " +
-			"supported for now")
+	if len(params.VPkts) == 0 {
+		return nil, fmt.Errorf("no virtual transactions to anchor")
+	}
+	if len(params.VPkts) != len(params.InputCommitments) {
+		return nil, fmt.Errorf("number of virtual transactions (%d) "+
+			"does not match number of input commitment sets (%d)",
+			len(params.VPkts), len(params.InputCommitments))
 	}
 	vPacket := params.VPkts[0]
 
-	outputCommitments, err := tapscript.CreateOutputCommitments(
-		params.InputCommitments, vPacket, params.PassiveAssetsVPkts,
+	// Each virtual transaction commits to its own set of outputs. If
+	// multiple virtual transactions spend assets from the same anchor
+	// output, the assets spent by the other transactions must not be
+	// carried over into the change of this one.
+	var (
+		allOutputs        []*tappsbt.VOutput
+		outputCommitments = make(
+			[][]*commitment.TapCommitment, len(params.VPkts),
+		)
 	)
-	if err != nil {
-		return nil, fmt.Errorf("unable to create new output "+
-			"commitments: %w", err)
+	for idx, vPkt := range params.VPkts {
+		inputCommitments := params.InputCommitments[idx]
+		if len(params.VPkts) > 1 {
+			var err error
+			inputCommitments, err = removeForeignInputs(
+				inputCommitments, vPkt, params.VPkts,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		var err error
+		outputCommitments[idx], err = tapscript.CreateOutputCommitments(
+			inputCommitments, vPkt, params.PassiveAssetsVPkts,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create new output "+
+				"commitments: %w", err)
+		}
+
+		allOutputs = append(allOutputs, vPkt.Outputs...)
 	}
 
 	// Construct our template PSBT to commits to the set of dummy locators
 	// we use to make fee estimation work.
-	sendPacket, err := tapscript.CreateAnchorTx(vPacket.Outputs)
+	sendPacket, err := tapscript.CreateAnchorTx(allOutputs)
 	if err != nil {
 		return nil, fmt.Errorf("error creating anchor TX: %w", err)
 	}
@@ -1403,8 +1964,9 @@ func (f *AssetWallet) AnchorVirtualTransactions(ctx context.Context,
 
 	// First, we'll update the PSBT packets to insert the _real_ outputs we
 	// need to commit to the asset transfer.
-	mergedCommitments, err := tapscript.UpdateTaprootOutputKeys(
-		signAnchorPkt, vPacket, outputCommitments,
+	updateKeys := tapscript.UpdateTaprootOutputKeysForPackets
+	mergedCommitments, err := updateKeys(
+		signAnchorPkt, params.VPkts, outputCommitments,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error updating taproot output keys: %w",
@@ -1415,7 +1977,7 @@ func (f *AssetWallet) AnchorVirtualTransactions(ctx context.Context,
 	// add our anchor inputs as well, since the wallet can sign for
 	// it itself.
 	err = addAnchorPsbtInputs(
-		signAnchorPkt, vPacket, params.FeeRate,
+		signAnchorPkt, params.VPkts, params.FeeRate,
 		f.cfg.ChainParams.Params,
 	)
 	if err != nil {
@@ -1471,6 +2033,80 @@ func (f *AssetWallet) AnchorVirtualTransactions(ctx context.Context,
 	}, nil
 }
 
+// EstimateAnchorTxFee funds a template of the BTC level anchor transaction for
+// the given virtual transactions, without signing or broadcasting it, and
+// returns its estimated size and fee. Any wallet UTXOs leased while funding
+// the template are released again.
+func (f *AssetWallet) EstimateAnchorTxFee(ctx context.Context,
+	params *AnchorVTxnsParams) (*AnchorFeeEstimate, error) {
+
+	if len(params.VPkts) == 0 {
+		return nil, fmt.Errorf("no virtual transactions to anchor")
+	}
+
+	// The real output keys only change the content of the Taproot outputs
+	// but not their size, so the template with the dummy outputs is
+	// sufficient for estimating the fee.
+	var allOutputs []*tappsbt.VOutput
+	for _, vPkt := range params.VPkts {
+		allOutputs = append(allOutputs, vPkt.Outputs...)
+	}
+
+	sendPacket, err := tapscript.CreateAnchorTx(allOutputs)
+	if err != nil {
+		return nil, fmt.Errorf("error creating anchor TX: %w", err)
+	}
+
+	anchorPkt, err := f.cfg.Wallet.FundPsbt(
+		ctx, sendPacket, 1, params.FeeRate,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fund psbt: %w", err)
+	}
+
+	// We won't publish this transaction, so we release the wallet inputs
+	// again once we're done, regardless of the outcome.
+	defer func() {
+		err := f.cfg.Wallet.ReleaseUTXOs(ctx, anchorPkt.LockedUTXOs...)
+		if err != nil {
+			log.Errorf("Unable to release UTXOs of fee estimation "+
+				"PSBT: %v", err)
+		}
+	}()
+
+	vPacket := params.VPkts[0]
+	adjustFundedPsbt(&anchorPkt, int64(vPacket.Inputs[0].Anchor.Value))
+
+	// Adding the anchor inputs also adjusts the change output to pay for
+	// the additional weight of the asset inputs.
+	err = addAnchorPsbtInputs(
+		anchorPkt.Pkt, params.VPkts, params.FeeRate,
+		f.cfg.ChainParams.Params,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error adding anchor input: %w", err)
+	}
+
+	weight, err := estimateAnchorTxWeight(
+		anchorPkt.Pkt, f.cfg.ChainParams.Params,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to estimate weight: %w", err)
+	}
+
+	totalFee, err := tapgarden.GetTxFee(anchorPkt.Pkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get on-chain fees for psbt: "+
+			"%w", err)
+	}
+
+	return &AnchorFeeEstimate{
+		Weight:   weight,
+		FeeRate:  params.FeeRate,
+		TotalFee: btcutil.Amount(totalFee),
+	}, nil
+}
+
 // SignOwnershipProof creates and signs an ownership proof for the given owned
 // asset. The ownership proof consists of a signed virtual packet that spends
 // the asset fully to the NUMS key.
@@ -1494,6 +2130,25 @@ func (f *AssetWallet) SignOwnershipProof(
 	return vPkt.Outputs[0].Asset.PrevWitnesses[0].TxWitness, nil
 }
 
+// SignMessage signs the given message with the given script key, which must
+// be a script key of this wallet.
+func (f *AssetWallet) SignMessage(ctx context.Context,
+	scriptKey *btcec.PublicKey, msg []byte) (*schnorr.Signature, error) {
+
+	tweakedKey, err := f.cfg.AddrBook.FetchScriptKey(ctx, scriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch script key: %w", err)
+	}
+
+	log.Infof("Signing message with script key %x",
+		scriptKey.SerializeCompressed())
+
+	return tapscript.SignMessage(msg, asset.ScriptKey{
+		PubKey:           scriptKey,
+		TweakedScriptKey: tweakedKey,
+	}, f.cfg.Signer)
+}
+
 // inputAnchorPkScript returns the top-level Taproot output script of the input
 // anchor output as well as the Taproot Asset script root of the output (the
 // Taproot tweak).
@@ -1623,16 +2278,30 @@ func adjustFundedPsbt(fPkt *tapgarden.FundedPsbt, anchorInputValue int64) {
 	fPkt.ChangeOutputIndex = int32(maxOutputIndex)
 }
 
-// addAnchorPsbtInputs adds anchor information from all inputs to the PSBT
-// packet. This is called after the PSBT has been funded, but before signing.
-func addAnchorPsbtInputs(btcPkt *psbt.Packet, vPkt *tappsbt.VPacket,
+// addAnchorPsbtInputs adds anchor information from all inputs of the given
+// virtual packets to the PSBT packet. This is called after the PSBT has been
+// funded, but before signing.
+func addAnchorPsbtInputs(btcPkt *psbt.Packet, vPkts []*tappsbt.VPacket,
 	feeRate chainfee.SatPerKWeight, params *chaincfg.Params) error {
 
-	for idx := range vPkt.Inputs {
+	var vInputs []*tappsbt.VInput
+	for _, vPkt := range vPkts {
+		vInputs = append(vInputs, vPkt.Inputs...)
+	}
+
+	addedInputs := make(map[wire.OutPoint]struct{})
+	for idx := range vInputs {
+		// Multiple virtual inputs can spend assets from the same
+		// anchor output, which we only need to spend once.
+		vIn := vInputs[idx]
+		if _, ok := addedInputs[vIn.PrevID.OutPoint]; ok {
+			continue
+		}
+		addedInputs[vIn.PrevID.OutPoint] = struct{}{}
+
 		// With the BIP-0032 information completed, we'll now add the
 		// information as a partial input and also add the input to the
 		// unsigned transaction.
-		vIn := vPkt.Inputs[idx]
 		btcPkt.Inputs = append(btcPkt.Inputs, psbt.PInput{
 			WitnessUtxo: &wire.TxOut{
 				Value:    int64(vIn.Anchor.Value),
@@ -1656,13 +2325,58 @@ func addAnchorPsbtInputs(btcPkt *psbt.Packet, vPkt *tappsbt.VPacket,
 	// Now that we've added an extra input, we'll want to re-calculate the
 	// total weight of the transaction, so we can ensure we're paying
 	// enough in fees.
-	var (
-		weightEstimator     input.TxWeightEstimator
-		inputAmt, outputAmt int64
-	)
+	totalWeight, err := estimateAnchorTxWeight(btcPkt, params)
+	if err != nil {
+		return err
+	}
+
+	var inputAmt, outputAmt int64
 	for _, pIn := range btcPkt.Inputs {
 		inputAmt += pIn.WitnessUtxo.Value
+	}
+	for _, txOut := range btcPkt.UnsignedTx.TxOut {
+		outputAmt += txOut.Value
+	}
+
+	// With this, we can now calculate the total fee we need to pay. We'll
+	// also make sure to round up the required fee to the floor.
+	requiredFee := feeRate.FeeForWeight(totalWeight)
+
+	// Given the current fee (which doesn't account for our input) and the
+	// total fee we want to pay, we'll adjust the wallet's change output
+	// accordingly.
+	//
+	// Earlier in adjustFundedPsbt we set wallet's change output to be the
+	// very last output in the transaction.
+	lastIdx := len(btcPkt.UnsignedTx.TxOut) - 1
+	currentFee := inputAmt - outputAmt
+	feeDelta := int64(requiredFee) - currentFee
+	changeValue := btcPkt.UnsignedTx.TxOut[lastIdx].Value
+
+	// The fee may exceed the total value of the change output, which means
+	// this spend is impossible with the given inputs and fee rate.
+	if changeValue-feeDelta < 0 {
+		return fmt.Errorf("fee of %d sats exceeds change amount of %d"+
+			"sats", requiredFee, changeValue)
+	}
 
+	btcPkt.UnsignedTx.TxOut[lastIdx].Value -= feeDelta
+
+	log.Infof("Adjusting send pkt by delta of %v from %d sats to %d sats",
+		feeDelta, currentFee, requiredFee)
+
+	return nil
+}
+
+// estimateAnchorTxWeight estimates the weight of the given anchor transaction
+// once all its inputs are signed. Asset anchor inputs are always spent through
+// the key spend path of the Taproot Asset commitment tweaked output key, so
+// their witness consists of a single Schnorr signature.
+func estimateAnchorTxWeight(btcPkt *psbt.Packet,
+	params *chaincfg.Params) (int64, error) {
+
+	var weightEstimator input.TxWeightEstimator
+	for _, pIn := range btcPkt.Inputs {
 		inputPkScript := pIn.WitnessUtxo.PkScript
 		switch {
 		case txscript.IsPayToWitnessPubKeyHash(inputPkScript):
@@ -1676,18 +2390,16 @@ func addAnchorPsbtInputs(btcPkt *psbt.Packet, vPkt *tappsbt.VPacket,
 				txscript.SigHashDefault,
 			)
 		default:
-			return fmt.Errorf("unknown pkScript: %x",
+			return 0, fmt.Errorf("unknown pkScript: %x",
 				inputPkScript)
 		}
 	}
 	for _, txOut := range btcPkt.UnsignedTx.TxOut {
-		outputAmt += txOut.Value
-
 		addrType, _, _, err := txscript.ExtractPkScriptAddrs(
 			txOut.PkScript, params,
 		)
 		if err != nil {
-			return err
+			return 0, err
 		}
 
 		switch addrType {
@@ -1700,40 +2412,12 @@ func addAnchorPsbtInputs(btcPkt *psbt.Packet, vPkt *tappsbt.VPacket,
 		case txscript.WitnessV1TaprootTy:
 			weightEstimator.AddP2TROutput()
 		default:
-			return fmt.Errorf("unknwon pkscript: %x",
+			return 0, fmt.Errorf("unknwon pkscript: %x",
 				txOut.PkScript)
 		}
 	}
 
-	// With this, we can now calculate the total fee we need to pay. We'll
-	// also make sure to round up the required fee to the floor.
-	totalWeight := int64(weightEstimator.Weight())
-	requiredFee := feeRate.FeeForWeight(totalWeight)
-
-	// Given the current fee (which doesn't account for our input) and the
-	// total fee we want to pay, we'll adjust the wallet's change output
-	// accordingly.
-	//
-	// Earlier in adjustFundedPsbt we set wallet's change output to be the
-	// very last output in the transaction.
-	lastIdx := len(btcPkt.UnsignedTx.TxOut) - 1
-	currentFee := inputAmt - outputAmt
-	feeDelta := int64(requiredFee) - currentFee
-	changeValue := btcPkt.UnsignedTx.TxOut[lastIdx].Value
-
-	// The fee may exceed the total value of the change output, which means
-	// this spend is impossible with the given inputs and fee rate.
-	if changeValue-feeDelta < 0 {
-		return fmt.Errorf("fee of %d sats exceeds change amount of %d"+
-			"sats", requiredFee, changeValue)
-	}
-
-	btcPkt.UnsignedTx.TxOut[lastIdx].Value -= feeDelta
-
-	log.Infof("Adjusting send pkt by delta of %v from %d sats to %d sats",
-		feeDelta, currentFee, requiredFee)
-
-	return nil
+	return int64(weightEstimator.Weight()), nil
 }
 
 // copyPsbt creates a deep copy of a PSBT packet by serializing and
@@ -36,6 +36,21 @@ func (m *mockCoinLister) DeleteExpiredLeases(ctx context.Context) error {
 	return nil
 }
 
+// commitmentsWithAmounts creates a list of anchored commitments with the given
+// asset amounts.
+func commitmentsWithAmounts(amounts ...uint64) []*AnchoredCommitment {
+	commitments := make([]*AnchoredCommitment, len(amounts))
+	for idx, amount := range amounts {
+		commitments[idx] = &AnchoredCommitment{
+			Asset: &asset.Asset{
+				Amount: amount,
+			},
+		}
+	}
+
+	return commitments
+}
+
 // TestCoinSelection tests that the coin selection logic behaves as expected.
 func TestCoinSelection(t *testing.T) {
 	t.Parallel()
@@ -130,6 +145,127 @@ func TestCoinSelection(t *testing.T) {
 				},
 			},
 		},
+
+		// Test that when the PreferMinAmount strategy is employed
+		// the smallest commitments are selected first.
+		{
+			minTotalAmount: 1000,
+			eligibleCommitments: commitmentsWithAmounts(
+				2000, 10, 999,
+			),
+			strategy:                 PreferMinAmount,
+			checkSelectedCommitments: true,
+			expectedCommitments: commitmentsWithAmounts(
+				10, 999,
+			),
+		},
+
+		// Test that when the MinimizeInputs strategy is employed a
+		// single commitment is selected, which is the smallest one
+		// that covers the target amount.
+		{
+			minTotalAmount: 1000,
+			eligibleCommitments: commitmentsWithAmounts(
+				5000, 10, 1200, 990,
+			),
+			strategy:                 MinimizeInputs,
+			checkSelectedCommitments: true,
+			expectedCommitments:      commitmentsWithAmounts(1200),
+		},
+
+		// Test that when the MinimizeInputs strategy is employed and
+		// multiple commitments are needed, the last one is the
+		// smallest that still reaches the target amount.
+		{
+			minTotalAmount: 1000,
+			eligibleCommitments: commitmentsWithAmounts(
+				600, 100, 500, 450,
+			),
+			strategy:                 MinimizeInputs,
+			checkSelectedCommitments: true,
+			expectedCommitments: commitmentsWithAmounts(
+				600, 450,
+			),
+		},
+
+		// Test that when the MinimizeChange strategy is employed an
+		// exact match is preferred over fewer inputs.
+		{
+			minTotalAmount: 1000,
+			eligibleCommitments: commitmentsWithAmounts(
+				1500, 700, 200, 100, 50,
+			),
+			strategy:                 MinimizeChange,
+			checkSelectedCommitments: true,
+			expectedCommitments: commitmentsWithAmounts(
+				700, 200, 100,
+			),
+		},
+
+		// Test that when the MinimizeChange strategy is employed and
+		// there is no exact match, the selection with the least change
+		// is chosen.
+		{
+			minTotalAmount: 1000,
+			eligibleCommitments: commitmentsWithAmounts(
+				1010, 900, 300,
+			),
+			strategy:                 MinimizeChange,
+			checkSelectedCommitments: true,
+			expectedCommitments:      commitmentsWithAmounts(1010),
+		},
+		{
+			minTotalAmount: 1000,
+			eligibleCommitments: commitmentsWithAmounts(
+				2000, 900, 120,
+			),
+			strategy:                 MinimizeChange,
+			checkSelectedCommitments: true,
+			expectedCommitments: commitmentsWithAmounts(
+				900, 120,
+			),
+		},
+
+		// Test that when the ConsolidateAll strategy is employed all
+		// commitments are selected, even if fewer would be enough.
+		{
+			minTotalAmount: 1000,
+			eligibleCommitments: commitmentsWithAmounts(
+				300, 2000, 50,
+			),
+			strategy:                 ConsolidateAll,
+			checkSelectedCommitments: true,
+			expectedCommitments: commitmentsWithAmounts(
+				2000, 300, 50,
+			),
+		},
+
+		// Test that all strategies fail if the target amount can't be
+		// reached.
+		{
+			minTotalAmount:      1000,
+			eligibleCommitments: commitmentsWithAmounts(400, 500),
+			strategy:            PreferMinAmount,
+			expectedSomeErr:     true,
+		},
+		{
+			minTotalAmount:      1000,
+			eligibleCommitments: commitmentsWithAmounts(400, 500),
+			strategy:            MinimizeInputs,
+			expectedSomeErr:     true,
+		},
+		{
+			minTotalAmount:      1000,
+			eligibleCommitments: commitmentsWithAmounts(400, 500),
+			strategy:            MinimizeChange,
+			expectedSomeErr:     true,
+		},
+		{
+			minTotalAmount:      1000,
+			eligibleCommitments: commitmentsWithAmounts(400, 500),
+			strategy:            ConsolidateAll,
+			expectedSomeErr:     true,
+		},
 	}
 
 	// Execute test cases.
@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/proof"
@@ -18,6 +20,10 @@ import (
 // that asset, if it has one.
 type AssetMetas map[asset.SerializedKey]*proof.MetaReveal
 
+// AssetLabels maps the ID of an asset to its human-readable label, if it has
+// one.
+type AssetLabels map[asset.ID]string
+
 // MintingBatch packages the pending state of a batch, this includes the batch
 // key, the state of the batch and the assets to be created.
 //
@@ -37,6 +43,11 @@ type MintingBatch struct {
 	// BatchKey is the unique identifier for a batch.
 	BatchKey keychain.KeyDescriptor
 
+	// Name is the optional name of the batch. A named batch isn't
+	// finalized by the batch ticker or on restart, but stays open for new
+	// seedlings until it's finalized explicitly.
+	Name string
+
 	// Seedlings is the set of seedlings for this batch. This maps an
 	// asset's name to the seedling itself.
 	//
@@ -63,6 +74,13 @@ type MintingBatch struct {
 	// reveal for that asset, if it has one.
 	AssetMetas AssetMetas
 
+	// AssetLabels maps the ID of an asset to its label, if it has one.
+	//
+	// NOTE: This field is only set if the batch is read from disk and is
+	// at least in BatchStateCommitted. Before that, the labels are part of
+	// the seedlings.
+	AssetLabels AssetLabels
+
 	// mintingPubKey is the top-level Taproot output key that will be used
 	// to commit to the Taproot Asset commitment above.
 	mintingPubKey *btcec.PublicKey
@@ -149,3 +167,37 @@ func (m *MintingBatch) State() BatchState {
 func (m *MintingBatch) UpdateState(state BatchState) {
 	m.batchState.Store(uint32(state))
 }
+
+// BatchAnchor is the on-chain anchor of a minting batch, which can be used to
+// independently verify that the batch was mined.
+type BatchAnchor struct {
+	// AnchorTx is the signed minting transaction of the batch.
+	AnchorTx *wire.MsgTx
+
+	// OutputIndex is the index of the output of the anchor transaction
+	// that commits to the assets of the batch.
+	OutputIndex uint32
+
+	// BlockHash is the hash of the block the anchor transaction was
+	// confirmed in. This is nil if the anchor transaction isn't confirmed
+	// yet.
+	BlockHash *chainhash.Hash
+
+	// BlockHeight is the height of the block the anchor transaction was
+	// confirmed in.
+	BlockHeight uint32
+
+	// TxIndex is the index of the anchor transaction within the block it
+	// was confirmed in.
+	TxIndex uint32
+
+	// TxMerkleProof is the proof of the inclusion of the anchor
+	// transaction in the block it was confirmed in. This is only set by the
+	// planter, once the anchor transaction is confirmed.
+	TxMerkleProof *proof.TxMerkleProof
+}
+
+// Confirmed returns true if the anchor transaction of the batch is confirmed.
+func (b *BatchAnchor) Confirmed() bool {
+	return b.BlockHash != nil
+}
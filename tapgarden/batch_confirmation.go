@@ -0,0 +1,309 @@
+package tapgarden
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+// BatchConfEventType is the type of event emitted while tracking the
+// confirmations of the anchor transaction of a minting batch.
+type BatchConfEventType uint8
+
+const (
+	// BatchConfEventUpdate is emitted when the number of confirmations of
+	// the anchor transaction changes while it's still below the target.
+	BatchConfEventUpdate BatchConfEventType = iota
+
+	// BatchConfEventTargetReached is emitted when the anchor transaction
+	// reaches the target number of confirmations.
+	BatchConfEventTargetReached
+
+	// BatchConfEventRollback is emitted when a re-org drops the number of
+	// confirmations of the anchor transaction, either below the target
+	// after it was reached, or below the last reported number before
+	// that. If the anchor transaction was re-organized out of the chain,
+	// the number of confirmations is zero.
+	BatchConfEventRollback
+)
+
+// String returns a human-readable string for the event type.
+func (t BatchConfEventType) String() string {
+	switch t {
+	case BatchConfEventUpdate:
+		return "Update"
+
+	case BatchConfEventTargetReached:
+		return "TargetReached"
+
+	case BatchConfEventRollback:
+		return "Rollback"
+
+	default:
+		return fmt.Sprintf("<unknown_event_type(%d)>", t)
+	}
+}
+
+// BatchConfEvent is an event emitted while tracking the confirmations of the
+// anchor transaction of a minting batch.
+type BatchConfEvent struct {
+	// Type is the type of the event.
+	Type BatchConfEventType
+
+	// AnchorTxid is the txid of the anchor transaction of the batch.
+	AnchorTxid chainhash.Hash
+
+	// NumConfs is the current number of confirmations of the anchor
+	// transaction.
+	NumConfs uint32
+
+	// TargetConfs is the number of confirmations the caller is waiting
+	// for.
+	TargetConfs uint32
+
+	// BlockHash is the hash of the block the anchor transaction is
+	// confirmed in. This is nil if the anchor transaction is unconfirmed.
+	BlockHash *chainhash.Hash
+
+	// BlockHeight is the height of the block the anchor transaction is
+	// confirmed in. This is zero if the anchor transaction is unconfirmed.
+	BlockHeight uint32
+
+	// Timestamp is the time the event was created.
+	Timestamp time.Time
+}
+
+// batchConfTracker keeps track of the confirmations of an anchor transaction
+// and decides which events need to be emitted when the chain changes.
+type batchConfTracker struct {
+	anchorTxid  chainhash.Hash
+	targetConfs uint32
+
+	// confHeight and confHash identify the block the anchor transaction
+	// is currently confirmed in. The height is zero and the hash is nil if
+	// the transaction is unconfirmed.
+	confHeight uint32
+	confHash   *chainhash.Hash
+
+	bestHeight uint32
+
+	// lastConfs is the number of confirmations at the last evaluation.
+	lastConfs uint32
+
+	// reached is true if the target was reached and hasn't been rolled
+	// back since.
+	reached bool
+
+	// notified is true once the first event was emitted.
+	notified bool
+}
+
+// numConfs returns the current number of confirmations of the anchor
+// transaction.
+func (t *batchConfTracker) numConfs() uint32 {
+	if t.confHeight == 0 || t.bestHeight < t.confHeight {
+		return 0
+	}
+
+	return t.bestHeight - t.confHeight + 1
+}
+
+// confirmed updates the block the anchor transaction is confirmed in.
+func (t *batchConfTracker) confirmed(height uint32, hash *chainhash.Hash) {
+	t.confHeight = height
+	t.confHash = hash
+
+	// The confirmation proves that the chain is at least this high, even
+	// if we haven't been notified of the block yet.
+	if t.bestHeight < height {
+		t.bestHeight = height
+	}
+}
+
+// reorged marks the anchor transaction as re-organized out of the chain.
+func (t *batchConfTracker) reorged() {
+	t.confHeight = 0
+	t.confHash = nil
+}
+
+// newBlock updates the height of the best block of the chain.
+func (t *batchConfTracker) newBlock(height uint32) {
+	t.bestHeight = height
+}
+
+// nextEvent returns the event that needs to be emitted for the current state
+// of the chain, or nil if nothing changed since the last event.
+func (t *batchConfTracker) nextEvent() *BatchConfEvent {
+	confs := t.numConfs()
+	defer func() {
+		t.lastConfs = confs
+	}()
+
+	var eventType BatchConfEventType
+	switch {
+	case confs < t.targetConfs && (t.reached || confs < t.lastConfs):
+		eventType = BatchConfEventRollback
+		t.reached = false
+
+	case !t.reached && confs >= t.targetConfs:
+		eventType = BatchConfEventTargetReached
+		t.reached = true
+
+	// Once the target is reached, we stay quiet until a re-org rolls it
+	// back. Before that, we report every change, and always the initial
+	// state.
+	case !t.reached && (confs != t.lastConfs || !t.notified):
+		eventType = BatchConfEventUpdate
+
+	default:
+		return nil
+	}
+	t.notified = true
+
+	event := &BatchConfEvent{
+		Type:        eventType,
+		AnchorTxid:  t.anchorTxid,
+		NumConfs:    confs,
+		TargetConfs: t.targetConfs,
+		Timestamp:   time.Now(),
+	}
+	if t.confHeight != 0 {
+		event.BlockHash = t.confHash
+		event.BlockHeight = t.confHeight
+	}
+
+	return event
+}
+
+// WatchBatchConfirmation tracks the confirmations of the anchor transaction
+// of the given batch until the context is canceled, handing each event to the
+// given callback. The current state is always reported first. Once the target
+// number of confirmations is reached, only a re-org that rolls the anchor
+// transaction back below the target is reported, after which the
+// confirmations are reported again until the target is reached once more.
+func (c *ChainPlanter) WatchBatchConfirmation(ctx context.Context,
+	batchKey *btcec.PublicKey, targetConfs uint32,
+	notify func(*BatchConfEvent) error) error {
+
+	if targetConfs == 0 {
+		return fmt.Errorf("target number of confirmations must be at " +
+			"least 1")
+	}
+
+	batch, err := c.cfg.Log.FetchMintingBatch(ctx, batchKey)
+	if err != nil {
+		return fmt.Errorf("unable to fetch batch: %w", err)
+	}
+
+	anchor, err := c.cfg.Log.FetchBatchAnchor(ctx, batchKey)
+	if err != nil {
+		return fmt.Errorf("unable to fetch batch anchor: %w", err)
+	}
+
+	anchorTx := anchor.AnchorTx
+	if int(anchor.OutputIndex) >= len(anchorTx.TxOut) {
+		return fmt.Errorf("invalid anchor output index %d",
+			anchor.OutputIndex)
+	}
+	pkScript := anchorTx.TxOut[anchor.OutputIndex].PkScript
+
+	tracker := &batchConfTracker{
+		anchorTxid:  anchorTx.TxHash(),
+		targetConfs: targetConfs,
+	}
+
+	bestHeight, err := c.cfg.ChainBridge.CurrentHeight(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch current height: %w", err)
+	}
+	tracker.newBlock(bestHeight)
+
+	// We seed the tracker with the confirmation we already know of, so we
+	// don't report the anchor transaction as unconfirmed before the chain
+	// backend notifies us of it.
+	if anchor.Confirmed() {
+		tracker.confirmed(anchor.BlockHeight, anchor.BlockHash)
+	}
+
+	ctxc, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	newBlocks, blockErr, err := c.cfg.ChainBridge.RegisterBlockEpochNtfn(
+		ctxc,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to register for block epoch "+
+			"notifications: %w", err)
+	}
+
+	// We only register for a single confirmation, but keep the
+	// registration open, so we're notified again if the anchor
+	// transaction is confirmed in a different block after a re-org.
+	reOrgChan := make(chan struct{}, 1)
+	confEvent, confErr, err := c.cfg.ChainBridge.RegisterConfirmationsNtfn(
+		ctxc, &tracker.anchorTxid, pkScript, 1, batch.HeightHint,
+		false, reOrgChan,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to register for conf ntfn: %w", err)
+	}
+	defer confEvent.Cancel()
+
+	log.Infof("Watching anchor TX %v of batch %x until it reaches %d "+
+		"confirmations", tracker.anchorTxid,
+		batchKey.SerializeCompressed(), targetConfs)
+
+	for {
+		if event := tracker.nextEvent(); event != nil {
+			if err := notify(event); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case conf, ok := <-confEvent.Confirmed:
+			if !ok {
+				return fmt.Errorf("confirmation notifications " +
+					"closed")
+			}
+
+			tracker.confirmed(conf.BlockHeight, conf.BlockHash)
+
+		case <-reOrgChan:
+			log.Infof("Anchor TX %v of batch %x was re-organized "+
+				"out of the chain", tracker.anchorTxid,
+				batchKey.SerializeCompressed())
+
+			tracker.reorged()
+
+		case height := <-newBlocks:
+			tracker.newBlock(uint32(height))
+
+		case err := <-confErr:
+			if fn.IsCanceled(err) {
+				return ctx.Err()
+			}
+
+			return fmt.Errorf("error while waiting for conf: %w",
+				err)
+
+		case err := <-blockErr:
+			if fn.IsCanceled(err) {
+				return ctx.Err()
+			}
+
+			return fmt.Errorf("unable to receive new block "+
+				"notifications: %w", err)
+
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-c.Quit:
+			return fmt.Errorf("planter shutting down")
+		}
+	}
+}
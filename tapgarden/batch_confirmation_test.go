@@ -0,0 +1,100 @@
+package tapgarden
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBatchConfTracker tests that the confirmation tracker of a batch emits
+// the expected events as the chain grows and re-orgs.
+func TestBatchConfTracker(t *testing.T) {
+	t.Parallel()
+
+	var (
+		blockA = chainhash.Hash{0xaa}
+		blockB = chainhash.Hash{0xbb}
+	)
+
+	tracker := &batchConfTracker{
+		targetConfs: 3,
+	}
+	tracker.newBlock(100)
+
+	// expectEvent asserts the next event of the tracker, or that there is
+	// none if nil is given.
+	expectEvent := func(eventType *BatchConfEventType, numConfs uint32) {
+		t.Helper()
+
+		event := tracker.nextEvent()
+		if eventType == nil {
+			require.Nil(t, event)
+			return
+		}
+
+		require.NotNil(t, event)
+		require.Equal(t, *eventType, event.Type)
+		require.Equal(t, numConfs, event.NumConfs)
+		require.EqualValues(t, 3, event.TargetConfs)
+	}
+
+	update := BatchConfEventUpdate
+	reached := BatchConfEventTargetReached
+	rollback := BatchConfEventRollback
+
+	// The initial state is always reported, even if unconfirmed. Nothing
+	// is reported as long as the state doesn't change.
+	expectEvent(&update, 0)
+	expectEvent(nil, 0)
+	tracker.newBlock(101)
+	expectEvent(nil, 0)
+
+	// The anchor transaction confirms and gains confirmations until the
+	// target is reached.
+	tracker.confirmed(102, &blockA)
+	expectEvent(&update, 1)
+	tracker.newBlock(103)
+	expectEvent(&update, 2)
+	tracker.newBlock(104)
+	expectEvent(&reached, 3)
+
+	// Further confirmations aren't reported.
+	tracker.newBlock(105)
+	expectEvent(nil, 0)
+
+	// A re-org takes the anchor transaction out of the chain, which rolls
+	// back the target.
+	tracker.reorged()
+	expectEvent(&rollback, 0)
+
+	// It confirms again in a different block, which is reported until
+	// the target is reached again.
+	tracker.newBlock(106)
+	tracker.confirmed(106, &blockB)
+	event := tracker.nextEvent()
+	require.NotNil(t, event)
+	require.Equal(t, BatchConfEventUpdate, event.Type)
+	require.EqualValues(t, 1, event.NumConfs)
+	require.Equal(t, &blockB, event.BlockHash)
+	require.EqualValues(t, 106, event.BlockHeight)
+
+	// A re-org before the target is reached is reported as a rollback
+	// as well.
+	tracker.reorged()
+	expectEvent(&rollback, 0)
+	tracker.confirmed(107, &blockA)
+	expectEvent(&update, 1)
+	tracker.newBlock(109)
+	expectEvent(&reached, 3)
+
+	// A tracker that is started for an anchor transaction that already
+	// reached the target reports that right away.
+	tracker = &batchConfTracker{
+		targetConfs: 3,
+	}
+	tracker.newBlock(200)
+	tracker.confirmed(190, &blockA)
+	expectEvent(&reached, 11)
+	expectEvent(nil, 0)
+}
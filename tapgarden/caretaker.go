@@ -1,6 +1,7 @@
 package tapgarden
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -13,6 +14,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/mempool"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/neutrino/cache/lru"
@@ -24,6 +26,8 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapscript"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"golang.org/x/exp/maps"
 	"golang.org/x/sync/errgroup"
@@ -46,6 +50,26 @@ var (
 	// key attached, and the asset is not the anchor asset for the group.
 	// This is true for any asset created via reissuance.
 	ErrGenesisNotGroupAnchor = errors.New("genesis not group anchor")
+
+	// ErrBatchAlreadyBroadcast is an error returned if a batch is
+	// cancelled after its minting transaction has already been broadcast.
+	ErrBatchAlreadyBroadcast = errors.New("minting transaction already " +
+		"broadcast")
+
+	// ErrBatchAlreadyConfirmed is an error returned if the fee of a batch
+	// is bumped after its minting transaction has already confirmed.
+	ErrBatchAlreadyConfirmed = errors.New("minting transaction already " +
+		"confirmed")
+
+	// ErrBatchNotBroadcast is an error returned if the fee of a batch is
+	// bumped before its minting transaction has been broadcast.
+	ErrBatchNotBroadcast = errors.New("minting transaction not broadcast")
+
+	// MinFeeBumpIncrement is the minimum fee rate a replacement minting
+	// transaction must pay on top of the fee rate of the transaction it
+	// replaces. This matches the default incremental relay fee of
+	// bitcoind.
+	MinFeeBumpIncrement = chainfee.SatPerKVByte(1000).FeePerKWeight()
 )
 
 const (
@@ -74,6 +98,11 @@ type BatchCaretakerConfig struct {
 	// finalizing a batch.
 	BatchFeeRate *chainfee.SatPerKWeight
 
+	// GenesisPolicy is an optional policy specified when finalizing a
+	// batch that determines the wallet UTXO that is spent as the genesis
+	// point of the minting transaction.
+	GenesisPolicy *GenesisPointPolicy
+
 	GardenKit
 
 	// BroadcastCompleteChan is used to signal back to the caller that the
@@ -131,6 +160,15 @@ type BatchCaretaker struct {
 	// the Taproot Asset commitment.
 	anchorOutputIndex uint32
 
+	// bumpFeeReqs is used to deliver requests to bump the fee of the
+	// broadcast minting transaction to the caretaker.
+	bumpFeeReqs chan *bumpFeeReq
+
+	// confCancel cancels the confirmation notification registered for
+	// the current minting transaction. This is only accessed by the main
+	// caretaker goroutine.
+	confCancel func()
+
 	// ContextGuard provides a wait group and main quit channel that can be
 	// used to create guarded contexts.
 	*fn.ContextGuard
@@ -142,9 +180,10 @@ type BatchCaretaker struct {
 // TODO(roasbeef): rename to Cultivator?
 func NewBatchCaretaker(cfg *BatchCaretakerConfig) *BatchCaretaker {
 	return &BatchCaretaker{
-		batchKey:  asset.ToSerialized(cfg.Batch.BatchKey.PubKey),
-		cfg:       cfg,
-		confEvent: make(chan *chainntnfs.TxConfirmation, 1),
+		batchKey:    asset.ToSerialized(cfg.Batch.BatchKey.PubKey),
+		cfg:         cfg,
+		confEvent:   make(chan *chainntnfs.TxConfirmation, 1),
+		bumpFeeReqs: make(chan *bumpFeeReq),
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -202,6 +241,8 @@ func (b *BatchCaretaker) Cancel() CancelResp {
 
 		return CancelResp{&finalBatchState, err}
 
+	// In the committed state, the genesis transaction has been funded, so
+	// we'll also need to release the inputs the wallet leased for it.
 	case BatchStateCommitted:
 		finalBatchState := BatchStateSproutCancelled
 		err := b.cfg.Log.UpdateBatchState(
@@ -213,17 +254,233 @@ func (b *BatchCaretaker) Cancel() CancelResp {
 				"cancel failed: %w", batchKey, batchState, err)
 		}
 
+		// The leased inputs aren't persisted along with the genesis
+		// packet, so after a restart we can't release them here and
+		// rely on the leases to expire instead.
+		genesisPkt := b.cfg.Batch.GenesisPacket
+		if genesisPkt != nil && len(genesisPkt.LockedUTXOs) > 0 {
+			releaseErr := b.cfg.Wallet.ReleaseUTXOs(
+				ctx, genesisPkt.LockedUTXOs...,
+			)
+			if releaseErr != nil {
+				log.Warnf("BatchCaretaker(%x), unable to "+
+					"release genesis inputs: %v", batchKey,
+					releaseErr)
+			}
+		}
+
 		b.cfg.BroadcastErrChan <- fmt.Errorf("caretaker canceled")
 
 		return CancelResp{&finalBatchState, err}
 
+	// Once the minting transaction has been broadcast, the batch can no
+	// longer be cancelled.
 	default:
-		err := fmt.Errorf("BatchCaretaker(%x), batch not cancellable",
-			b.cfg.Batch.BatchKey.PubKey.SerializeCompressed())
+		err := fmt.Errorf("BatchCaretaker(%x), batch not cancellable: "+
+			"%w", batchKey, ErrBatchAlreadyBroadcast)
 		return CancelResp{nil, err}
 	}
 }
 
+// bumpFeeReq is a request to replace the broadcast minting transaction of a
+// batch with one that pays a higher fee.
+type bumpFeeReq struct {
+	// feeRate is the fee rate the replacement transaction should pay.
+	feeRate chainfee.SatPerKWeight
+
+	resp chan *chainhash.Hash
+	err  chan error
+}
+
+// BumpFee replaces the broadcast minting transaction of the batch with one that
+// pays the given fee rate, and returns the txid of the replacement
+// transaction. The fee is paid for by reducing the change output of the
+// minting transaction.
+func (b *BatchCaretaker) BumpFee(
+	feeRate chainfee.SatPerKWeight) (*chainhash.Hash, error) {
+
+	switch b.cfg.Batch.State() {
+	case BatchStateBroadcast:
+
+	case BatchStateConfirmed, BatchStateFinalized:
+		return nil, ErrBatchAlreadyConfirmed
+
+	default:
+		return nil, ErrBatchNotBroadcast
+	}
+
+	req := &bumpFeeReq{
+		feeRate: feeRate,
+		resp:    make(chan *chainhash.Hash, 1),
+		err:     make(chan error, 1),
+	}
+
+	// The caretaker only accepts fee bump requests while waiting for the
+	// minting transaction to confirm, and shuts down once the batch is
+	// finalized. So if the request can't be delivered, we check whether
+	// the transaction confirmed in the meantime.
+	if !fn.SendOrQuit(b.bumpFeeReqs, req, b.Quit) {
+		switch b.cfg.Batch.State() {
+		case BatchStateConfirmed, BatchStateFinalized:
+			return nil, ErrBatchAlreadyConfirmed
+		}
+
+		return nil, fmt.Errorf("BatchCaretaker(%x), shutting down",
+			b.batchKey[:])
+	}
+
+	return fn.RecvResp(req.resp, req.err, b.Quit)
+}
+
+// bumpFee creates, broadcasts and commits a replacement for the broadcast
+// minting transaction that pays the given fee rate. The replacement spends the
+// same inputs, so the genesis point and with it the assets of the batch stay
+// the same. Only the change output is reduced to pay for the higher fee.
+func (b *BatchCaretaker) bumpFee(
+	feeRate chainfee.SatPerKWeight) (*chainhash.Hash, error) {
+
+	if b.confInfo != nil {
+		return nil, ErrBatchAlreadyConfirmed
+	}
+
+	genesisPkt := b.cfg.Batch.GenesisPacket
+	changeIndex := genesisPkt.ChangeOutputIndex
+	if changeIndex < 0 {
+		return nil, fmt.Errorf("minting transaction has no change " +
+			"output to pay for a higher fee")
+	}
+
+	// The anchor output is the output that isn't the change output, just
+	// like when we created the minting transaction.
+	anchorOutputIndex := uint32(0)
+	if changeIndex == 0 {
+		anchorOutputIndex = 1
+	}
+
+	prevTx, err := psbt.Extract(genesisPkt.Pkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract minting tx: %w", err)
+	}
+	prevFee, err := GetTxFee(genesisPkt.Pkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get on-chain fees for "+
+			"psbt: %w", err)
+	}
+
+	// The replacement will have the same weight as the transaction it
+	// replaces, as only the value of the change output changes. To be
+	// relayed, it must pay at least the minimum increment on top of the
+	// fee rate of the replaced transaction.
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(prevTx))
+	prevFeeRate := chainfee.SatPerKWeight(prevFee * 1000 / weight)
+	minFeeRate := prevFeeRate + MinFeeBumpIncrement
+	if feeRate < minFeeRate {
+		return nil, fmt.Errorf("fee rate %v below minimum of %v for "+
+			"replacing minting tx with fee rate %v",
+			feeRate.FeePerKVByte(), minFeeRate.FeePerKVByte(),
+			prevFeeRate.FeePerKVByte())
+	}
+
+	feeIncrease := int64(feeRate.FeeForWeight(weight)) - prevFee
+	changeOutput := prevTx.TxOut[changeIndex]
+	newChangeValue := changeOutput.Value - feeIncrease
+	dustLimit := mempool.GetDustThreshold(changeOutput)
+	if newChangeValue < dustLimit {
+		return nil, fmt.Errorf("change output of %d sats too small "+
+			"to pay for fee increase of %d sats",
+			changeOutput.Value, feeIncrease)
+	}
+
+	// We'll create the replacement from a copy of the signed packet, so
+	// we still have the original one in case anything goes wrong. The
+	// inputs keep all the information needed for signing, so we only
+	// need to remove the final witnesses before signing again.
+	var pktBuf bytes.Buffer
+	if err := genesisPkt.Pkt.Serialize(&pktBuf); err != nil {
+		return nil, err
+	}
+	newPkt, err := psbt.NewFromRawBytes(&pktBuf, false)
+	if err != nil {
+		return nil, err
+	}
+	for idx := range newPkt.Inputs {
+		newPkt.Inputs[idx].FinalScriptSig = nil
+		newPkt.Inputs[idx].FinalScriptWitness = nil
+	}
+	newPkt.UnsignedTx.TxOut[changeIndex].Value = newChangeValue
+
+	ctx, cancel := b.WithCtxQuit()
+	defer cancel()
+	signedPkt, err := b.cfg.Wallet.SignAndFinalizePsbt(ctx, newPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign psbt: %w", err)
+	}
+
+	signedTx, err := psbt.Extract(signedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract psbt: %w", err)
+	}
+	err = blockchain.CheckTransactionSanity(btcutil.NewTx(signedTx))
+	if err != nil {
+		return nil, fmt.Errorf("replacement genesis TX failed final "+
+			"checks: %w", err)
+	}
+
+	chainFees, err := GetTxFee(signedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get on-chain fees for "+
+			"psbt: %w", err)
+	}
+
+	// We only commit the replacement once it was accepted, so we keep
+	// waiting for the original transaction if the replacement is
+	// rejected, for example because the original already confirmed.
+	err = b.cfg.ChainBridge.PublishTransaction(ctx, signedTx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to publish replacement "+
+			"transaction: %w", err)
+	}
+
+	newGenesisPkt := &FundedPsbt{
+		Pkt:               signedPkt,
+		ChangeOutputIndex: changeIndex,
+		ChainFees:         chainFees,
+		LockedUTXOs:       genesisPkt.LockedUTXOs,
+	}
+
+	_, tapRoot, err := b.cfg.Batch.MintingOutputKey()
+	if err != nil {
+		return nil, err
+	}
+	err = b.cfg.Log.CommitReplacementGenesisTx(
+		ctx, b.cfg.Batch.BatchKey.PubKey, newGenesisPkt,
+		anchorOutputIndex, tapRoot, wire.OutPoint{
+			Hash:  prevTx.TxHash(),
+			Index: anchorOutputIndex,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to commit replacement genesis "+
+			"tx: %w", err)
+	}
+
+	b.cfg.Batch.GenesisPacket = newGenesisPkt
+
+	// The original transaction can no longer confirm, so we'll wait for
+	// the replacement instead.
+	if err := b.waitForConfirmation(signedTx); err != nil {
+		return nil, err
+	}
+
+	newTxid := signedTx.TxHash()
+
+	log.Infof("BatchCaretaker(%x): replaced minting tx %v with %v, "+
+		"absolute fee: %d sats", b.batchKey[:], prevTx.TxHash(),
+		newTxid, chainFees)
+
+	return &newTxid, nil
+}
+
 // advanceStateUntil attempts to advance the internal state machine until the
 // target state has been reached.
 func (b *BatchCaretaker) advanceStateUntil(currentState,
@@ -329,7 +586,6 @@ func (b *BatchCaretaker) assetCultivator() {
 	// minting transaction, so we'll wait until we need to exit, or we get
 	// the confirmation notification.
 	//
-	// TODO(roasbeef): eventually should attempt to RBF if needed?
 	for {
 		select {
 		// We've received the confirmation notification, so we can
@@ -359,6 +615,21 @@ func (b *BatchCaretaker) assetCultivator() {
 			b.cfg.SignalCompletion()
 			return
 
+		// A request to bump the fee of the minting transaction has
+		// arrived, so we'll replace the transaction with one that pays
+		// the requested fee rate.
+		case req := <-b.bumpFeeReqs:
+			txid, err := b.bumpFee(req.feeRate)
+			if err != nil {
+				log.Warnf("BatchCaretaker(%x): unable to bump "+
+					"fee: %v", b.batchKey[:], err)
+
+				req.err <- err
+				continue
+			}
+
+			req.resp <- txid
+
 		case <-b.cfg.CancelReqChan:
 			b.cfg.CancelRespChan <- b.Cancel()
 
@@ -380,6 +651,36 @@ func (b *BatchCaretaker) fundGenesisPsbt(ctx context.Context) (*FundedPsbt, erro
 
 	txTemplate := wire.NewMsgTx(2)
 	txTemplate.AddTxOut(&DummyGenesisTxOut)
+
+	// If a genesis point policy was specified for this batch, we'll select
+	// the wallet UTXO that satisfies it and add it as the first input of
+	// the template. The wallet won't add any other inputs to a template
+	// that already has inputs, so the UTXO becomes the genesis point.
+	var genesisUtxo *lnwallet.Utxo
+	if b.cfg.GenesisPolicy != nil {
+		utxos, err := b.cfg.Wallet.ListUnspent(ctx, 1)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list wallet UTXOs: "+
+				"%w", err)
+		}
+
+		genesisUtxo, err = SelectGenesisUtxo(
+			utxos, *b.cfg.GenesisPolicy,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("BatchCaretaker(%x): using UTXO %v with value %v "+
+			"as genesis point (policy: %v)", b.batchKey[:],
+			genesisUtxo.OutPoint, genesisUtxo.Value,
+			b.cfg.GenesisPolicy)
+
+		txTemplate.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: genesisUtxo.OutPoint,
+		})
+	}
+
 	genesisPkt, err := psbt.NewFromUnsignedTx(txTemplate)
 	if err != nil {
 		return nil, fmt.Errorf("unable to make psbt packet: %w", err)
@@ -415,12 +716,101 @@ func (b *BatchCaretaker) fundGenesisPsbt(ctx context.Context) (*FundedPsbt, erro
 		return nil, fmt.Errorf("unable to fund psbt: %w", err)
 	}
 
+	// Make sure the wallet kept the selected UTXO as the first input, as
+	// the asset IDs would otherwise not be derived from it.
+	genesisTx := fundedGenesisPkt.Pkt.UnsignedTx
+	if genesisUtxo != nil &&
+		extractGenesisOutpoint(genesisTx) != genesisUtxo.OutPoint {
+
+		return nil, fmt.Errorf("wallet didn't spend UTXO %v as "+
+			"genesis point", genesisUtxo.OutPoint)
+	}
+
+	// We'll signal replaceability on all inputs, so the fee of the
+	// minting transaction can be bumped later on if it gets stuck.
+	for _, txIn := range genesisTx.TxIn {
+		txIn.Sequence = mempool.MaxRBFSequence
+	}
+
 	log.Infof("BatchCaretaker(%x): funded GenesisPacket", b.batchKey[:])
 	log.Tracef("GenesisPacket: %v", spew.Sdump(fundedGenesisPkt))
 
 	return &fundedGenesisPkt, nil
 }
 
+// anchorOutputIndex returns the index of the output of the given genesis
+// packet that commits to the assets of the batch. If the change output is
+// first, then our commitment is second, and vice versa.
+func anchorOutputIndex(genesisPkt *FundedPsbt) uint32 {
+	if genesisPkt.ChangeOutputIndex == 0 {
+		return 1
+	}
+
+	return 0
+}
+
+// commitGenesisPsbt turns the seedlings of the batch into sprouts that are
+// committed to in the anchor output of the given funded genesis packet, and
+// writes the sprouts along with the genesis packet to disk.
+func (b *BatchCaretaker) commitGenesisPsbt(ctx context.Context,
+	genesisTxPkt *FundedPsbt) error {
+
+	genesisPoint := extractGenesisOutpoint(genesisTxPkt.Pkt.UnsignedTx)
+	b.anchorOutputIndex = anchorOutputIndex(genesisTxPkt)
+
+	// First, we'll turn all the seedlings into actual taproot assets.
+	tapCommitment, err := b.seedlingsToAssetSprouts(
+		ctx, genesisPoint, b.anchorOutputIndex,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to map seedlings to sprouts: %v", err)
+	}
+
+	b.cfg.Batch.RootAssetCommitment = tapCommitment
+
+	// With the commitment Taproot Asset root SMT constructed, we'll map
+	// that into the tapscript root we'll insert into the genesis
+	// transaction.
+	genesisScript, err := b.cfg.Batch.genesisScript()
+	if err != nil {
+		return fmt.Errorf("unable to create genesis script: %v", err)
+	}
+
+	anchorOutput := genesisTxPkt.Pkt.UnsignedTx.TxOut[b.anchorOutputIndex]
+	anchorOutput.PkScript = genesisScript
+
+	log.Infof("BatchCaretaker(%x): committing sprouts to disk",
+		b.batchKey[:])
+
+	// With all our commitments created, we'll commit them to disk,
+	// replacing the existing seedlings we had created for each of these
+	// assets.
+	err = b.cfg.Log.AddSproutsToBatch(
+		ctx, b.cfg.Batch.BatchKey.PubKey,
+		genesisTxPkt, b.cfg.Batch.RootAssetCommitment,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to commit batch: %w", err)
+	}
+
+	b.cfg.Batch.GenesisPacket = genesisTxPkt
+
+	// Now that we know the script key for all the assets, we'll populate
+	// the asset metas map as we need that to create the asset proofs. On
+	// restart, we'll get these in the batch pre-populated.
+	for _, newAsset := range tapCommitment.CommittedAssets() {
+		seedling, ok := b.cfg.Batch.Seedlings[newAsset.Tag]
+		if !ok {
+			continue
+		}
+
+		scriptKey := asset.ToSerialized(newAsset.ScriptKey.PubKey)
+		b.cfg.Batch.AssetMetas[scriptKey] = seedling.Meta
+	}
+
+	return nil
+}
+
 // extractGenesisOutpoint extracts the genesis point (the first output from the
 // genesis transaction).
 func extractGenesisOutpoint(tx *wire.MsgTx) wire.OutPoint {
@@ -449,34 +839,30 @@ func (b *BatchCaretaker) seedlingsToAssetSprouts(ctx context.Context,
 	for _, seedlingName := range orderedSeedlings {
 		seedling := b.cfg.Batch.Seedlings[seedlingName]
 
-		assetGen := asset.Genesis{
-			FirstPrevOut: genesisPoint,
-			Tag:          seedling.AssetName,
-			OutputIndex:  assetOutputIndex,
-			Type:         seedling.AssetType,
-		}
-
-		// If the seedling has a meta data reveal set, then we'll bind
-		// that by including the hash of the meta data in the asset
-		// genesis.
-		if seedling.Meta != nil {
-			assetGen.MetaHash = seedling.Meta.MetaHash()
-		}
+		assetGen := seedling.Genesis(genesisPoint, assetOutputIndex)
 
-		scriptKey, err := b.cfg.KeyRing.DeriveNextKey(
-			ctx, asset.TaprootAssetsKeyFamily,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to obtain script "+
-				"key: %w", err)
+		// Unless a custom script key was specified for the seedling,
+		// we'll derive a new BIP-0086 script key for the asset.
+		var tweakedScriptKey asset.ScriptKey
+		if seedling.ScriptKey != nil {
+			tweakedScriptKey = *seedling.ScriptKey
+		} else {
+			scriptKey, err := b.cfg.KeyRing.DeriveNextKey(
+				ctx, asset.TaprootAssetsKeyFamily,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to obtain "+
+					"script key: %w", err)
+			}
+			tweakedScriptKey = asset.NewScriptKeyBip86(scriptKey)
 		}
-		tweakedScriptKey := asset.NewScriptKeyBip86(scriptKey)
 
 		var (
 			amount         uint64
 			groupInfo      *asset.AssetGroup
 			protoAsset     *asset.Asset
 			sproutGroupKey *asset.GroupKey
+			err            error
 		)
 
 		// Determine the amount for the actual asset.
@@ -528,16 +914,14 @@ func (b *BatchCaretaker) seedlingsToAssetSprouts(ctx context.Context,
 		}
 
 		// If emission is enabled without a group key specified,
-		// then we'll need to generate another public key,
-		// then use that to derive the key group signature
-		// along with the tweaked key group.
+		// then we'll need to generate another public key (unless a
+		// custom group internal key was specified), then use that to
+		// derive the key group signature along with the tweaked key
+		// group.
 		if seedling.EnableEmission {
-			rawGroupKey, err := b.cfg.KeyRing.DeriveNextKey(
-				ctx, asset.TaprootAssetsKeyFamily,
-			)
+			rawGroupKey, err := b.groupInternalKey(ctx, seedling)
 			if err != nil {
-				return nil, fmt.Errorf("unable to derive "+
-					"group key: %w", err)
+				return nil, err
 			}
 
 			sproutGroupKey, err = asset.DeriveGroupKey(
@@ -585,6 +969,114 @@ func (b *BatchCaretaker) seedlingsToAssetSprouts(ctx context.Context,
 	return commitment.FromAssets(newAssets...)
 }
 
+// groupInternalKey returns the internal key of the new asset group of the
+// given seedling. If no custom internal key was specified, a new key is
+// derived.
+func (b *BatchCaretaker) groupInternalKey(ctx context.Context,
+	seedling *Seedling) (keychain.KeyDescriptor, error) {
+
+	if seedling.GroupInternalKey != nil {
+		return *seedling.GroupInternalKey, nil
+	}
+
+	rawGroupKey, err := b.cfg.KeyRing.DeriveNextKey(
+		ctx, asset.TaprootAssetsKeyFamily,
+	)
+	if err != nil {
+		return rawGroupKey, fmt.Errorf("unable to derive group key: %w",
+			err)
+	}
+
+	return rawGroupKey, nil
+}
+
+// waitForConfirmation registers for a confirmation notification of the given
+// minting transaction, and launches a goroutine that delivers the confirmation
+// to the caretaker. Any previous registration is cancelled, as the transaction
+// it was made for was replaced.
+func (b *BatchCaretaker) waitForConfirmation(signedTx *wire.MsgTx) error {
+	if b.confCancel != nil {
+		b.confCancel()
+	}
+
+	// We make sure to request that the block is included as well, since
+	// we need this to construct the proof files for each of the assets
+	// later.
+	heightHint := b.cfg.Batch.HeightHint
+	txHash := signedTx.TxHash()
+	confCtx, confCancel := b.WithCtxQuitNoTimeout()
+	confNtfn, errChan, err := b.cfg.ChainBridge.RegisterConfirmationsNtfn(
+		confCtx, &txHash, signedTx.TxOut[0].PkScript, 1,
+		heightHint, true, nil,
+	)
+	if err != nil {
+		confCancel()
+		return fmt.Errorf("unable to register for minting tx conf: %v",
+			err)
+	}
+	b.confCancel = confCancel
+
+	// Launch a goroutine that'll notify us when the transaction
+	// confirms.
+	//
+	// TODO(roasbeef): make blocking here?
+	b.Wg.Add(1)
+	go func() {
+		defer confCancel()
+		defer b.Wg.Done()
+
+		var confEvent *chainntnfs.TxConfirmation
+		select {
+		case confEvent = <-confNtfn.Confirmed:
+			log.Debugf("Got chain confirmation: %v",
+				confEvent.Tx.TxHash())
+
+		case err := <-errChan:
+			b.cfg.ErrChan <- fmt.Errorf("error getting "+
+				"confirmation: %w", err)
+			return
+
+		// If the context is done, then either we're shutting down or
+		// the transaction was replaced and we no longer wait for it
+		// to confirm.
+		case <-confCtx.Done():
+			log.Debugf("Skipping TX confirmation of %v, context "+
+				"done", txHash)
+			return
+
+		case <-b.cfg.CancelReqChan:
+			b.cfg.CancelRespChan <- b.Cancel()
+
+		case <-b.Quit:
+			log.Debugf("Skipping TX confirmation, exiting")
+			return
+		}
+
+		if confEvent == nil {
+			b.cfg.ErrChan <- fmt.Errorf("got empty " +
+				"confirmation event in batch")
+			return
+		}
+
+		select {
+		case b.confEvent <- confEvent:
+
+		case <-confCtx.Done():
+			log.Debugf("Skipping TX confirmation, context " +
+				"done")
+
+		case <-b.cfg.CancelReqChan:
+			b.cfg.CancelRespChan <- b.Cancel()
+
+		case <-b.Quit:
+			log.Debugf("Skipping TX confirmation, exiting")
+			return
+		}
+	}()
+
+	return nil
+}
+
 // stateStep attempts to transition the state machine from one state to
 // another. Two states are terminal: the broadcast state, and the finalized
 // state.
@@ -625,69 +1117,10 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 			return 0, err
 		}
 
-		genesisPoint := extractGenesisOutpoint(
-			genesisTxPkt.Pkt.UnsignedTx,
-		)
-
-		// If the change output is first, then our commitment is second,
-		// and vice versa.
-		b.anchorOutputIndex = 0
-		if genesisTxPkt.ChangeOutputIndex == 0 {
-			b.anchorOutputIndex = 1
-		}
-
-		// First, we'll turn all the seedlings into actual taproot assets.
-		tapCommitment, err := b.seedlingsToAssetSprouts(
-			ctx, genesisPoint, b.anchorOutputIndex,
-		)
-		if err != nil {
-			return 0, fmt.Errorf("unable to map seedlings to "+
-				"sprouts: %v", err)
-		}
-
-		b.cfg.Batch.RootAssetCommitment = tapCommitment
-
-		// With the commitment Taproot Asset root SMT constructed, we'll
-		// map that into the tapscript root we'll insert into the
-		// genesis transaction.
-		genesisScript, err := b.cfg.Batch.genesisScript()
-		if err != nil {
-			return 0, fmt.Errorf("unable to create genesis "+
-				"script: %v", err)
-		}
-
-		genesisTxPkt.Pkt.UnsignedTx.TxOut[b.anchorOutputIndex].PkScript = genesisScript
-
-		log.Infof("BatchCaretaker(%x): committing sprouts to disk",
-			b.batchKey[:])
-
-		// With all our commitments created, we'll commit them to disk,
-		// replacing the existing seedlings we had created for each of
-		// these assets.
-		err = b.cfg.Log.AddSproutsToBatch(
-			ctx, b.cfg.Batch.BatchKey.PubKey,
-			genesisTxPkt, b.cfg.Batch.RootAssetCommitment,
-		)
-		if err != nil {
-			return 0, fmt.Errorf("unable to commit batch: %w", err)
-		}
-
-		b.cfg.Batch.GenesisPacket = genesisTxPkt
-
-		// Now that we know the script key for all the assets, we'll
-		// populate the asset metas map as we need that to create the
-		// asset proofs. On restart, we'll get these in the batch
-		// pre-populated.
-		for _, newAsset := range tapCommitment.CommittedAssets() {
-			seedling, ok := b.cfg.Batch.Seedlings[newAsset.Tag]
-			if !ok {
-				continue
-			}
-
-			scriptKey := asset.ToSerialized(
-				newAsset.ScriptKey.PubKey,
-			)
-			b.cfg.Batch.AssetMetas[scriptKey] = seedling.Meta
+		// With the genesis packet funded, we'll commit the assets of
+		// the batch to its anchor output.
+		if err := b.commitGenesisPsbt(ctx, genesisTxPkt); err != nil {
+			return 0, err
 		}
 
 		log.Infof("BatchCaretaker(%x): transition states: %v -> %v",
@@ -703,17 +1136,29 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 		log.Infof("BatchCaretaker(%x): finalizing GenesisPacket",
 			b.batchKey[:])
 
+		// The anchor output index isn't known yet if we're resuming
+		// the batch from this state, so we'll derive it from the
+		// genesis packet.
+		b.anchorOutputIndex = anchorOutputIndex(
+			b.cfg.Batch.GenesisPacket,
+		)
+
 		// First, we'll have the wallet sign the PSBT is created, which
-		// was then modified.
-		//
-		// TODO(roasbeef): only execute if finalized? or missing sig
+		// was then modified. If the minting transaction was funded and
+		// signed by an external wallet, then the packet is already
+		// complete and there's nothing left for our wallet to sign.
 		ctx, cancel := b.WithCtxQuit()
 		defer cancel()
-		signedPkt, err := b.cfg.Wallet.SignAndFinalizePsbt(
-			ctx, b.cfg.Batch.GenesisPacket.Pkt,
-		)
-		if err != nil {
-			return 0, fmt.Errorf("unable to sign psbt: %w", err)
+		signedPkt := b.cfg.Batch.GenesisPacket.Pkt
+		if !signedPkt.IsComplete() {
+			var err error
+			signedPkt, err = b.cfg.Wallet.SignAndFinalizePsbt(
+				ctx, b.cfg.Batch.GenesisPacket.Pkt,
+			)
+			if err != nil {
+				return 0, fmt.Errorf("unable to sign psbt: %w",
+					err)
+			}
 		}
 
 		// Final TX sanity check.
@@ -820,78 +1265,12 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 		}
 
 		// Now we'll wait for a confirmation as we reach our terminal
-		// state that requires an on-chain event to shift from. We make
-		// sure to request that the block is included as well, since we
-		// need this to construct the proof files for each of the
-		// assets later.
-		//
-		// TODO(roasbeef): eventually want to be able to RBF the bump
-		heightHint := b.cfg.Batch.HeightHint
-		txHash := signedTx.TxHash()
-		confCtx, confCancel := b.WithCtxQuitNoTimeout()
-		confNtfn, errChan, err := b.cfg.ChainBridge.RegisterConfirmationsNtfn(
-			confCtx, &txHash, signedTx.TxOut[0].PkScript, 1,
-			heightHint, true, nil,
-		)
+		// state that requires an on-chain event to shift from.
+		err = b.waitForConfirmation(signedTx)
 		if err != nil {
-			return 0, fmt.Errorf("unable to register for "+
-				"minting tx conf: %v", err)
+			return 0, err
 		}
 
-		// Launch a goroutine that'll notify us when the transaction
-		// confirms.
-		//
-		// TODO(roasbeef): make blocking here?
-		b.Wg.Add(1)
-		go func() {
-			defer confCancel()
-			defer b.Wg.Done()
-
-			var confEvent *chainntnfs.TxConfirmation
-			select {
-			case confEvent = <-confNtfn.Confirmed:
-				log.Debugf("Got chain confirmation: %v",
-					confEvent.Tx.TxHash())
-
-			case err := <-errChan:
-				b.cfg.ErrChan <- fmt.Errorf("error getting "+
-					"confirmation: %w", err)
-				return
-
-			case <-confCtx.Done():
-				log.Debugf("Skipping TX confirmation, context " +
-					"done")
-
-			case <-b.cfg.CancelReqChan:
-				b.cfg.CancelRespChan <- b.Cancel()
-
-			case <-b.Quit:
-				log.Debugf("Skipping TX confirmation, exiting")
-				return
-			}
-
-			if confEvent == nil {
-				b.cfg.ErrChan <- fmt.Errorf("got empty " +
-					"confirmation event in batch")
-				return
-			}
-
-			select {
-			case b.confEvent <- confEvent:
-
-			case <-confCtx.Done():
-				log.Debugf("Skipping TX confirmation, context " +
-					"done")
-
-			case <-b.cfg.CancelReqChan:
-				b.cfg.CancelRespChan <- b.Cancel()
-
-			case <-b.Quit:
-				log.Debugf("Skipping TX confirmation, exiting")
-				return
-			}
-		}()
-
 		log.Infof("BatchCaretaker(%x): transition states: %v -> %v",
 			b.batchKey, BatchStateBroadcast, BatchStateBroadcast)
 
@@ -1063,6 +1442,12 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 			return 0, fmt.Errorf("error watching proof: %w", err)
 		}
 
+		if b.cfg.BatchFinalized != nil {
+			b.cfg.BatchFinalized(
+				time.Since(b.cfg.Batch.CreationTime),
+			)
+		}
+
 		log.Infof("BatchCaretaker(%x): transition states: %v -> %v",
 			b.batchKey, BatchStateConfirmed, BatchStateFinalized)
 
@@ -217,6 +217,14 @@ func (c *Custodian) watchInboundAssets() {
 			lastDetectHeight = event.ConfirmationHeight
 		}
 
+		// The address might have been revoked after the payment was
+		// detected, in which case we no longer claim it.
+		if event.Addr.IsRevoked() {
+			log.Infof("Ignoring pending inbound asset event %v of "+
+				"revoked address", event.Outpoint)
+			continue
+		}
+
 		c.events[event.Outpoint] = event
 
 		// Maybe a proof was delivered while we were shutting down or
@@ -470,6 +478,15 @@ func (c *Custodian) mapToTapAddr(walletTx *lndclient.Transaction,
 		return nil, fmt.Errorf("unable to encode address: %v", err)
 	}
 
+	// Payments to a revoked address are no longer claimed, so we don't
+	// create an event for them and never attempt to receive their proof.
+	if addr.IsRevoked() {
+		log.Infof("Ignoring inbound asset transfer (asset_id=%x) for "+
+			"revoked Taproot Asset address %s in %s",
+			addr.AssetID[:], addrStr, op.String())
+		return nil, nil
+	}
+
 	// Make sure we have an event registered for the transaction, since it
 	// is now clear that it is an incoming asset that is being received with
 	// a Taproot Asset address.
@@ -654,8 +671,21 @@ func (c *Custodian) setReceiveCompleted(event *address.Event,
 		Index: lastProof.InclusionProof.OutputIndex,
 	}
 
+	// The sender might have sent a different amount than requested by the
+	// address. We still take custody of the assets, but flag the mismatch
+	// so it can be reconciled.
+	receivedAmt := lastProof.Asset.Amount
+	paymentStatus := address.NewPaymentStatus(
+		event.Addr.Amount, receivedAmt,
+	)
+	if paymentStatus != address.PaymentStatusExact {
+		log.Warnf("Received %d assets on address with amount %d "+
+			"(outpoint=%v, status=%v)", receivedAmt,
+			event.Addr.Amount, event.Outpoint, paymentStatus)
+	}
+
 	return c.cfg.AddrBook.CompleteEvent(
-		ctxt, event, address.StatusCompleted, anchorPoint,
+		ctxt, event, address.StatusCompleted, anchorPoint, receivedAmt,
 	)
 }
 
@@ -0,0 +1,339 @@
+package tapgarden
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+// EmissionBatchName is the name of the named batch the emission scheduler
+// issues the additional units of due emission events in. Using a dedicated
+// batch makes sure the scheduler never adds seedlings to, or finalizes, a
+// batch of the user.
+const EmissionBatchName = "tapd-emission"
+
+// PendingEmission is an emission event of a minted asset that wasn't issued
+// yet.
+type PendingEmission struct {
+	EmissionEvent
+
+	// ID is the database ID of the emission event.
+	ID int64
+
+	// AssetName is the name of the asset the emission schedule was
+	// specified for. The additional units are issued under the same name.
+	AssetName string
+
+	// AssetVersion is the version of the asset the emission schedule was
+	// specified for.
+	AssetVersion asset.Version
+
+	// BatchState is the state of the batch the asset was minted in.
+	BatchState BatchState
+
+	// GroupKey is the key of the asset group the additional units are
+	// issued into. This is nil if the batch the asset was minted in wasn't
+	// committed yet.
+	GroupKey *btcec.PublicKey
+}
+
+// EmissionSchedulerConfig is the config for the EmissionScheduler.
+type EmissionSchedulerConfig struct {
+	// Log is used to fetch the pending emission events and to mark them
+	// as issued.
+	Log MintingStore
+
+	// ChainBridge is used to be notified of new blocks.
+	ChainBridge ChainBridge
+
+	// Planter is used to queue and finalize the batches that issue the
+	// additional units of an asset.
+	Planter Planter
+
+	// ErrChan is the main error channel the scheduler will report back
+	// critical errors to the main server.
+	ErrChan chan<- error
+}
+
+// EmissionScheduler watches the chain for the block heights of the emission
+// schedules of minted assets. Once the height of an emission event is reached,
+// the additional units are issued into the asset group by minting a new batch.
+type EmissionScheduler struct {
+	cfg EmissionSchedulerConfig
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*fn.ContextGuard
+}
+
+// NewEmissionScheduler creates a new emission scheduler from the given config.
+func NewEmissionScheduler(cfg EmissionSchedulerConfig) *EmissionScheduler {
+	return &EmissionScheduler{
+		cfg: cfg,
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start starts the goroutine that issues emission events once their block
+// height is reached.
+func (e *EmissionScheduler) Start() error {
+	log.Infof("Starting emission scheduler")
+
+	e.Wg.Add(1)
+	go e.scheduler()
+
+	return nil
+}
+
+// Stop stops the emission scheduler.
+func (e *EmissionScheduler) Stop() error {
+	log.Infof("Stopping emission scheduler")
+
+	close(e.Quit)
+	e.Wg.Wait()
+
+	return nil
+}
+
+// FetchSchedule returns the emission events of the asset group with the given
+// key that weren't issued yet.
+func (e *EmissionScheduler) FetchSchedule(ctx context.Context,
+	groupKey *btcec.PublicKey) ([]PendingEmission, error) {
+
+	emissions, err := e.cfg.Log.FetchPendingEmissions(ctx, groupKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch emissions: %w", err)
+	}
+
+	// Events of cancelled batches will never be issued, so we don't report
+	// them as part of the schedule.
+	return fn.Filter(emissions, func(emission PendingEmission) bool {
+		return emission.BatchState != BatchStateSeedlingCancelled &&
+			emission.BatchState != BatchStateSproutCancelled
+	}), nil
+}
+
+// scheduler is the main goroutine of the emission scheduler. It checks for
+// due emission events on startup and on every new block.
+//
+// NOTE: This function MUST be run as a goroutine.
+func (e *EmissionScheduler) scheduler() {
+	defer e.Wg.Done()
+
+	runCtx, cancel := e.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	newBlockChan, blockErr, err := e.cfg.ChainBridge.RegisterBlockEpochNtfn(
+		runCtx,
+	)
+	if err != nil {
+		e.reportErr(fmt.Errorf("unable to register for block epoch "+
+			"notifications: %w", err))
+		return
+	}
+
+	// Issue any events that became due while we were offline.
+	currentHeight, err := e.cfg.ChainBridge.CurrentHeight(runCtx)
+	if err != nil {
+		e.reportErr(fmt.Errorf("unable to get current height: %w",
+			err))
+		return
+	}
+	e.issueDueEmissions(currentHeight)
+
+	for {
+		select {
+		case newHeight := <-newBlockChan:
+			e.issueDueEmissions(uint32(newHeight))
+
+		case err := <-blockErr:
+			e.reportErr(fmt.Errorf("unable to receive new block "+
+				"notifications: %w", err))
+			return
+
+		case <-e.Quit:
+			return
+		}
+	}
+}
+
+// dueEmission is the issuance of the additional units of all due emission
+// events of a single asset.
+type dueEmission struct {
+	// seedling is the seedling that issues the additional units.
+	seedling *Seedling
+
+	// eventIDs are the IDs of the emission events issued by the seedling.
+	eventIDs []int64
+}
+
+// collectDueEmissions groups the emission events that are due at the given
+// height by asset, so all due events of an asset are issued in a single
+// seedling. Events are only due once the batch that minted the asset is
+// finalized.
+func collectDueEmissions(emissions []PendingEmission,
+	height uint32) []*dueEmission {
+
+	var (
+		dueEmissions []*dueEmission
+		byName       = make(map[string]*dueEmission)
+	)
+	for _, emission := range emissions {
+		if emission.BlockHeight > height ||
+			emission.BatchState != BatchStateFinalized ||
+			emission.GroupKey == nil {
+
+			continue
+		}
+
+		// The assets of a batch are identified by their name, so if
+		// two assets of different groups share a name, the second one
+		// has to wait for the next batch.
+		due, ok := byName[emission.AssetName]
+		switch {
+		case !ok:
+			due = &dueEmission{
+				seedling: newEmissionSeedling(emission),
+			}
+			byName[emission.AssetName] = due
+			dueEmissions = append(dueEmissions, due)
+
+		case !due.seedling.GroupInfo.GroupPubKey.IsEqual(
+			emission.GroupKey,
+		):
+			continue
+		}
+
+		due.seedling.Amount += emission.Amount
+		due.eventIDs = append(due.eventIDs, emission.ID)
+	}
+
+	return dueEmissions
+}
+
+// newEmissionSeedling creates a new seedling that issues additional units of
+// the asset of the given emission event into its asset group. The amount is
+// set by the caller.
+func newEmissionSeedling(emission PendingEmission) *Seedling {
+	return &Seedling{
+		AssetVersion: emission.AssetVersion,
+		AssetType:    asset.Normal,
+		AssetName:    emission.AssetName,
+		BatchName:    EmissionBatchName,
+		GroupInfo: &asset.AssetGroup{
+			GroupKey: &asset.GroupKey{
+				GroupPubKey: *emission.GroupKey,
+			},
+		},
+	}
+}
+
+// issueDueEmissions queues a seedling for every asset with emission events
+// that are due at the given height into the dedicated emission batch, then
+// finalizes that batch. If the emission batch of a previous run couldn't be
+// finalized, then finalizing it is retried first.
+func (e *EmissionScheduler) issueDueEmissions(height uint32) {
+	ctx, cancel := e.WithCtxQuit()
+	defer cancel()
+
+	// The events of a batch that's still open were already marked as
+	// issued when they were queued, so we need to retry finalizing it
+	// until it succeeds. Otherwise, the events would never be issued.
+	err := e.finalizeEmissionBatch()
+	switch {
+	case errors.Is(err, ErrNoOpenBatch):
+
+	case err != nil:
+		log.Errorf("Unable to finalize pending emission batch, "+
+			"retrying at next block: %v", err)
+		return
+
+	default:
+		log.Infof("Finalized pending emission batch at height %d",
+			height)
+	}
+
+	emissions, err := e.cfg.Log.FetchPendingEmissions(ctx, nil)
+	if err != nil {
+		log.Errorf("Unable to fetch pending emissions: %v", err)
+		return
+	}
+
+	dueEmissions := collectDueEmissions(emissions, height)
+	if len(dueEmissions) == 0 {
+		return
+	}
+
+	var numQueued int
+	for _, due := range dueEmissions {
+		log.Infof("Issuing %d units of asset %v at height %d",
+			due.seedling.Amount, due.seedling.AssetName, height)
+
+		if err := e.queueSeedling(due.seedling); err != nil {
+			log.Warnf("Unable to queue emission of asset %v: %v",
+				due.seedling.AssetName, err)
+			continue
+		}
+
+		// Once the seedling is part of the emission batch, the events
+		// are issued by finalizing that batch, which is retried until
+		// it succeeds. So we mark them right away to make sure they're
+		// never queued twice.
+		err := e.cfg.Log.MarkEmissionsIssued(ctx, due.eventIDs...)
+		if err != nil {
+			log.Errorf("Unable to mark emissions as issued: %v",
+				err)
+			return
+		}
+
+		numQueued++
+	}
+
+	if numQueued == 0 {
+		return
+	}
+
+	if err := e.finalizeEmissionBatch(); err != nil {
+		log.Errorf("Unable to finalize emission batch, retrying at "+
+			"next block: %v", err)
+	}
+}
+
+// finalizeEmissionBatch finalizes the dedicated emission batch. If there is
+// no open emission batch, then ErrNoOpenBatch is returned.
+func (e *EmissionScheduler) finalizeEmissionBatch() error {
+	_, err := e.cfg.Planter.FinalizeBatch(EmissionBatchName, nil, nil)
+	return err
+}
+
+// queueSeedling queues the given seedling with the planter and waits for it to
+// be added to the emission batch.
+func (e *EmissionScheduler) queueSeedling(seedling *Seedling) error {
+	updates, err := e.cfg.Planter.QueueNewSeedling(seedling)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case update := <-updates:
+		return update.Error
+
+	case <-e.Quit:
+		return fmt.Errorf("emission scheduler shutting down")
+	}
+}
+
+// reportErr reports an error to the main server.
+func (e *EmissionScheduler) reportErr(err error) {
+	select {
+	case e.cfg.ErrChan <- err:
+	case <-e.Quit:
+	}
+}
@@ -0,0 +1,238 @@
+package tapgarden
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCollectDueEmissions tests that only emission events of finalized
+// batches that reached their block height are issued, and that all due events
+// of an asset are issued in a single seedling.
+func TestCollectDueEmissions(t *testing.T) {
+	t.Parallel()
+
+	groupKeyA := test.RandPubKey(t)
+	groupKeyB := test.RandPubKey(t)
+
+	newEmission := func(id int64, name string, height uint32,
+		amount uint64) PendingEmission {
+
+		return PendingEmission{
+			EmissionEvent: EmissionEvent{
+				BlockHeight: height,
+				Amount:      amount,
+			},
+			ID:         id,
+			AssetName:  name,
+			BatchState: BatchStateFinalized,
+			GroupKey:   groupKeyA,
+		}
+	}
+
+	// The batch of this event isn't finalized yet.
+	notFinalized := newEmission(3, "asset-a", 100, 1)
+	notFinalized.BatchState = BatchStateBroadcast
+
+	// This event belongs to a different group with the same asset name,
+	// so it can't be issued in the same batch.
+	sameName := newEmission(4, "asset-a", 100, 1)
+	sameName.GroupKey = groupKeyB
+
+	emissions := []PendingEmission{
+		newEmission(1, "asset-a", 100, 10),
+		newEmission(2, "asset-a", 150, 20),
+		notFinalized,
+		sameName,
+		newEmission(5, "asset-b", 120, 30),
+		newEmission(6, "asset-b", 200, 40),
+	}
+
+	dueEmissions := collectDueEmissions(emissions, 150)
+	require.Len(t, dueEmissions, 2)
+
+	dueA := dueEmissions[0]
+	require.Equal(t, "asset-a", dueA.seedling.AssetName)
+	require.EqualValues(t, 30, dueA.seedling.Amount)
+	require.Equal(t, []int64{1, 2}, dueA.eventIDs)
+	require.True(t, dueA.seedling.GroupInfo.GroupPubKey.IsEqual(groupKeyA))
+
+	dueB := dueEmissions[1]
+	require.Equal(t, "asset-b", dueB.seedling.AssetName)
+	require.EqualValues(t, 30, dueB.seedling.Amount)
+	require.Equal(t, []int64{5}, dueB.eventIDs)
+
+	// Nothing is due before the first event height.
+	require.Empty(t, collectDueEmissions(emissions, 99))
+}
+
+// mockEmissionStore is a minting store that only keeps track of emission
+// events.
+type mockEmissionStore struct {
+	MintingStore
+
+	emissions []PendingEmission
+	issued    map[int64]bool
+}
+
+// FetchPendingEmissions returns all emission events that weren't issued yet.
+func (m *mockEmissionStore) FetchPendingEmissions(context.Context,
+	*btcec.PublicKey) ([]PendingEmission, error) {
+
+	var pending []PendingEmission
+	for _, emission := range m.emissions {
+		if !m.issued[emission.ID] {
+			pending = append(pending, emission)
+		}
+	}
+
+	return pending, nil
+}
+
+// MarkEmissionsIssued marks the emission events with the given IDs as issued.
+func (m *mockEmissionStore) MarkEmissionsIssued(_ context.Context,
+	eventIDs ...int64) error {
+
+	for _, eventID := range eventIDs {
+		m.issued[eventID] = true
+	}
+
+	return nil
+}
+
+// mockEmissionPlanter is a planter that keeps track of the seedlings queued
+// into, and the finalization attempts of, the named batches.
+type mockEmissionPlanter struct {
+	Planter
+
+	// openBatches are the seedlings of the named batches that weren't
+	// finalized yet.
+	openBatches map[string][]*Seedling
+
+	// finalized are the seedlings of all finalized batches.
+	finalized []*Seedling
+
+	// finalizeErr is returned when finalizing an open batch, if set.
+	finalizeErr error
+}
+
+// QueueNewSeedling adds the seedling to the named batch it specifies.
+func (m *mockEmissionPlanter) QueueNewSeedling(
+	req *Seedling) (SeedlingUpdates, error) {
+
+	m.openBatches[req.BatchName] = append(
+		m.openBatches[req.BatchName], req,
+	)
+
+	updates := make(SeedlingUpdates, 1)
+	updates <- SeedlingUpdate{NewState: MintingStateSeed}
+
+	return updates, nil
+}
+
+// FinalizeBatch finalizes the open batch with the given name.
+func (m *mockEmissionPlanter) FinalizeBatch(batchName string,
+	_ *chainfee.SatPerKWeight, _ *GenesisPointPolicy) (*MintingBatch,
+	error) {
+
+	seedlings, ok := m.openBatches[batchName]
+	switch {
+	case !ok:
+		return nil, fmt.Errorf("%w named %v", ErrNoOpenBatch,
+			batchName)
+
+	case m.finalizeErr != nil:
+		return nil, m.finalizeErr
+	}
+
+	delete(m.openBatches, batchName)
+	m.finalized = append(m.finalized, seedlings...)
+
+	return &MintingBatch{Name: batchName}, nil
+}
+
+// TestIssueDueEmissions tests that due emission events are issued in the
+// dedicated emission batch without touching the batch of the user, and that a
+// failed finalization of that batch is retried at the next block.
+func TestIssueDueEmissions(t *testing.T) {
+	t.Parallel()
+
+	groupKey := test.RandPubKey(t)
+	newEmission := func(id int64, name string,
+		height uint32) PendingEmission {
+
+		return PendingEmission{
+			EmissionEvent: EmissionEvent{
+				BlockHeight: height,
+				Amount:      10,
+			},
+			ID:         id,
+			AssetName:  name,
+			BatchState: BatchStateFinalized,
+			GroupKey:   groupKey,
+		}
+	}
+
+	store := &mockEmissionStore{
+		emissions: []PendingEmission{
+			newEmission(1, "asset-a", 100),
+			newEmission(2, "asset-b", 100),
+			newEmission(3, "asset-a", 200),
+		},
+		issued: make(map[int64]bool),
+	}
+
+	// The user has a pending batch of their own, which must be left
+	// alone by the scheduler.
+	userSeedling := &Seedling{AssetName: "user-asset"}
+	planter := &mockEmissionPlanter{
+		openBatches: map[string][]*Seedling{
+			"": {userSeedling},
+		},
+		finalizeErr: errors.New("insufficient funds"),
+	}
+
+	scheduler := NewEmissionScheduler(EmissionSchedulerConfig{
+		Log:     store,
+		Planter: planter,
+	})
+
+	// The due events are queued into the emission batch, but it can't be
+	// finalized yet.
+	scheduler.issueDueEmissions(100)
+	require.Len(t, planter.openBatches[EmissionBatchName], 2)
+	require.Empty(t, planter.finalized)
+	require.True(t, store.issued[1])
+	require.True(t, store.issued[2])
+
+	// At the next block, finalizing the emission batch is retried without
+	// queuing the same events again.
+	scheduler.issueDueEmissions(101)
+	require.Len(t, planter.openBatches[EmissionBatchName], 2)
+	require.Empty(t, planter.finalized)
+
+	// Once the batch can be funded, the retry succeeds.
+	planter.finalizeErr = nil
+	scheduler.issueDueEmissions(102)
+	require.NotContains(t, planter.openBatches, EmissionBatchName)
+	require.Len(t, planter.finalized, 2)
+	for _, seedling := range planter.finalized {
+		require.Equal(t, EmissionBatchName, seedling.BatchName)
+	}
+
+	// The next due event is issued in a new emission batch.
+	scheduler.issueDueEmissions(200)
+	require.NotContains(t, planter.openBatches, EmissionBatchName)
+	require.Len(t, planter.finalized, 3)
+	require.Equal(t, "asset-a", planter.finalized[2].AssetName)
+	require.True(t, store.issued[3])
+
+	// The batch of the user was never touched.
+	require.Equal(t, []*Seedling{userSeedling}, planter.openBatches[""])
+}
@@ -0,0 +1,88 @@
+package tapgarden
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+var (
+	// ErrGenesisUtxoUnavailable is returned when no wallet UTXO that
+	// satisfies the genesis point policy of a batch is available for
+	// spending.
+	ErrGenesisUtxoUnavailable = errors.New("genesis UTXO not available")
+)
+
+// GenesisPointPolicy constrains the wallet UTXO that is spent as the genesis
+// point of a minting transaction. As the genesis point is committed to by the
+// IDs of the minted assets, this gives control over the asset IDs of a batch
+// before it is funded.
+type GenesisPointPolicy struct {
+	// Outpoint is the optional wallet UTXO that must be spent as the
+	// genesis point.
+	Outpoint *wire.OutPoint
+
+	// MinValue is the minimum value of the wallet UTXO that is spent as
+	// the genesis point.
+	MinValue btcutil.Amount
+}
+
+// String returns a human-readable description of the policy.
+func (p GenesisPointPolicy) String() string {
+	if p.Outpoint != nil {
+		return fmt.Sprintf("outpoint=%v, min_value=%v", p.Outpoint,
+			p.MinValue)
+	}
+
+	return fmt.Sprintf("min_value=%v", p.MinValue)
+}
+
+// SelectGenesisUtxo selects the UTXO that satisfies the given genesis point
+// policy from the given set of spendable wallet UTXOs. If the policy doesn't
+// require a specific outpoint, then the smallest UTXO with at least the
+// minimum value is selected, to leave the larger UTXOs for other uses.
+func SelectGenesisUtxo(utxos []*lnwallet.Utxo,
+	policy GenesisPointPolicy) (*lnwallet.Utxo, error) {
+
+	if policy.Outpoint != nil {
+		for _, utxo := range utxos {
+			if utxo.OutPoint != *policy.Outpoint {
+				continue
+			}
+
+			if utxo.Value < policy.MinValue {
+				return nil, fmt.Errorf("%w: value %v of "+
+					"UTXO %v below minimum of %v",
+					ErrGenesisUtxoUnavailable, utxo.Value,
+					utxo.OutPoint, policy.MinValue)
+			}
+
+			return utxo, nil
+		}
+
+		return nil, fmt.Errorf("%w: UTXO %v not found in wallet or "+
+			"already leased", ErrGenesisUtxoUnavailable,
+			policy.Outpoint)
+	}
+
+	candidates := make([]*lnwallet.Utxo, 0, len(utxos))
+	for _, utxo := range utxos {
+		if utxo.Value >= policy.MinValue {
+			candidates = append(candidates, utxo)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("%w: no UTXO with a value of at least "+
+			"%v", ErrGenesisUtxoUnavailable, policy.MinValue)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Value < candidates[j].Value
+	})
+
+	return candidates[0], nil
+}
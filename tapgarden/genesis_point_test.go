@@ -0,0 +1,93 @@
+package tapgarden
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSelectGenesisUtxo tests that the genesis UTXO is selected according to
+// the genesis point policy.
+func TestSelectGenesisUtxo(t *testing.T) {
+	t.Parallel()
+
+	newUtxo := func(value btcutil.Amount) *lnwallet.Utxo {
+		return &lnwallet.Utxo{
+			Value:    value,
+			OutPoint: test.RandOp(t),
+		}
+	}
+	utxos := []*lnwallet.Utxo{
+		newUtxo(50_000), newUtxo(10_000), newUtxo(20_000),
+		newUtxo(20_000),
+	}
+	unknownOutpoint := test.RandOp(t)
+
+	testCases := []struct {
+		name     string
+		policy   GenesisPointPolicy
+		expected *lnwallet.Utxo
+		err      string
+	}{{
+		name:     "smallest utxo above min value",
+		policy:   GenesisPointPolicy{MinValue: 15_000},
+		expected: utxos[2],
+	}, {
+		name:     "min value equal to utxo value",
+		policy:   GenesisPointPolicy{MinValue: 50_000},
+		expected: utxos[0],
+	}, {
+		name:   "no utxo above min value",
+		policy: GenesisPointPolicy{MinValue: 50_001},
+		err:    "no UTXO with a value of at least",
+	}, {
+		name: "specific outpoint",
+		policy: GenesisPointPolicy{
+			Outpoint: &utxos[1].OutPoint,
+		},
+		expected: utxos[1],
+	}, {
+		name: "specific outpoint with min value",
+		policy: GenesisPointPolicy{
+			Outpoint: &utxos[3].OutPoint,
+			MinValue: 20_000,
+		},
+		expected: utxos[3],
+	}, {
+		name: "specific outpoint below min value",
+		policy: GenesisPointPolicy{
+			Outpoint: &utxos[1].OutPoint,
+			MinValue: 20_000,
+		},
+		err: "below minimum",
+	}, {
+		name: "unknown outpoint",
+		policy: GenesisPointPolicy{
+			Outpoint: &unknownOutpoint,
+		},
+		err: "not found in wallet",
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			utxo, err := SelectGenesisUtxo(utxos, tc.policy)
+			if tc.err != "" {
+				require.ErrorIs(
+					t, err, ErrGenesisUtxoUnavailable,
+				)
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, utxo)
+		})
+	}
+}
@@ -36,20 +36,65 @@ type Planter interface {
 	// details of a specific batch.
 	ListBatches(batchKey *btcec.PublicKey) ([]*MintingBatch, error)
 
+	// PendingBatch returns the current pending batch, or nil if there is
+	// no pending batch.
+	PendingBatch() (*MintingBatch, error)
+
 	// CancelSeedling attempts to cancel the creation of a new asset
 	// identified by its name. If the seedling has already progressed to a
 	// point where the genesis PSBT has been broadcasted, an error is
 	// returned.
 	CancelSeedling() error
 
-	// FinalizeBatch signals that the asset minter should finalize
-	// the current batch, if one exists.
-	FinalizeBatch(feeRate *chainfee.SatPerKWeight) (*MintingBatch, error)
+	// FinalizeBatch signals that the asset minter should finalize the
+	// named batch with the given name, or the current batch if no name is
+	// given. The optional genesis point policy determines the wallet UTXO
+	// that is spent as the genesis point of the minting transaction.
+	FinalizeBatch(batchName string, feeRate *chainfee.SatPerKWeight,
+		genesisPolicy *GenesisPointPolicy) (*MintingBatch, error)
 
 	// CancelBatch signals that the asset minter should cancel the
-	// current batch, if one exists.
+	// current batch, if one exists. The key of the cancelled batch is
+	// returned, or nil if there was no batch to cancel.
 	CancelBatch() (*btcec.PublicKey, error)
 
+	// BumpBatchFee signals that the asset minter should replace the
+	// broadcast minting transaction of the given batch with one that pays
+	// the given fee rate. The txid of the replacement is returned.
+	BumpBatchFee(batchKey *btcec.PublicKey,
+		feeRate chainfee.SatPerKWeight) (*chainhash.Hash, error)
+
+	// CommitBatchPsbt commits the named batch with the given name, or the
+	// current batch if no name is given, to a minting transaction that is
+	// funded by an external wallet. The given funded PSBT must contain the
+	// anchor output at the given index. The committed batch is returned,
+	// with its genesis packet holding the unsigned minting transaction.
+	CommitBatchPsbt(batchName string, fundedPkt *psbt.Packet,
+		anchorOutputIndex uint32) (*MintingBatch, error)
+
+	// PublishBatchPsbt finalizes and broadcasts the signed minting
+	// transaction of a batch that was committed with CommitBatchPsbt.
+	PublishBatchPsbt(batchKey *btcec.PublicKey,
+		signedPkt *psbt.Packet) (*MintingBatch, error)
+
+	// FetchBatchAnchor returns the anchor transaction of the given batch,
+	// along with a proof of its inclusion in a block if it's confirmed.
+	FetchBatchAnchor(ctx context.Context,
+		batchKey *btcec.PublicKey) (*BatchAnchor, error)
+
+	// ListMints returns the issuance history of the node, which is every
+	// batch that was ever submitted for minting and matches the given
+	// query, including cancelled and failed batches.
+	ListMints(ctx context.Context,
+		query MintHistoryQuery) ([]*MintRecord, error)
+
+	// WatchBatchConfirmation tracks the confirmations of the anchor
+	// transaction of the given batch until the context is canceled,
+	// handing each event to the given callback. A re-org that rolls the
+	// anchor transaction back is reported as well.
+	WatchBatchConfirmation(ctx context.Context, batchKey *btcec.PublicKey,
+		targetConfs uint32, notify func(*BatchConfEvent) error) error
+
 	// Start signals that the asset minter should being operations.
 	Start() error
 
@@ -96,6 +141,11 @@ const (
 	// BatchStateSproutCancelled denotes that a batch has been cancelled
 	// after being passed to a caretaker and sprouting.
 	BatchStateSproutCancelled BatchState = 7
+
+	// BatchStateAwaitingSignature denotes that a batch was committed to a
+	// minting transaction that is funded by an external wallet, and that
+	// the signed minting transaction wasn't handed back yet.
+	BatchStateAwaitingSignature BatchState = 8
 )
 
 // String returns a human-readable string for the target batch state.
@@ -125,6 +175,9 @@ func (b BatchState) String() string {
 	case BatchStateSproutCancelled:
 		return "BatchStateSproutCancelled"
 
+	case BatchStateAwaitingSignature:
+		return "BatchStateAwaitingSignature"
+
 	default:
 		return fmt.Sprintf("UnknownState(%d)", b)
 	}
@@ -158,6 +211,9 @@ func NewBatchState(state uint8) (BatchState, error) {
 	case BatchStateSproutCancelled:
 		return BatchStateSproutCancelled, nil
 
+	case BatchStateAwaitingSignature:
+		return BatchStateAwaitingSignature, nil
+
 	default:
 		return BatchStateSproutCancelled,
 			fmt.Errorf("unknown batch state: %v", state)
@@ -217,6 +273,16 @@ type MintingStore interface {
 		genesisTx *FundedPsbt, anchorOutputIndex uint32,
 		tapRoot []byte) error
 
+	// CommitReplacementGenesisTx replaces the signed genesis transaction
+	// of a batch that was already broadcast with a replacement
+	// transaction, such as one that pays a higher fee. The assets of the
+	// batch are re-anchored to the new transaction, and the anchor output
+	// of the replaced transaction is removed.
+	CommitReplacementGenesisTx(ctx context.Context,
+		batchKey *btcec.PublicKey, genesisTx *FundedPsbt,
+		anchorOutputIndex uint32, tapRoot []byte,
+		replacedAnchor wire.OutPoint) error
+
 	// MarkBatchConfirmed marks the batch as confirmed on chain. The passed
 	// block location information determines where exactly in the chain the
 	// batch was confirmed.
@@ -227,6 +293,13 @@ type MintingStore interface {
 		blockHash *chainhash.Hash, blockHeight uint32,
 		txIndex uint32, mintingProofs proof.AssetBlobs) error
 
+	// FetchBatchAnchor fetches the anchor transaction of the batch with
+	// the given key, along with its chain location if it's confirmed. If
+	// the batch has no anchor transaction yet, then ErrBatchNotBroadcast
+	// is returned.
+	FetchBatchAnchor(ctx context.Context,
+		batchKey *btcec.PublicKey) (*BatchAnchor, error)
+
 	// FetchGroupByGenesis fetches the asset group created by the genesis
 	// referenced by the given ID.
 	FetchGroupByGenesis(ctx context.Context,
@@ -236,6 +309,16 @@ type MintingStore interface {
 	// key, including the genesis information used to create the group.
 	FetchGroupByGroupKey(ctx context.Context,
 		groupKey *btcec.PublicKey) (*asset.AssetGroup, error)
+
+	// FetchPendingEmissions fetches all emission events that weren't
+	// issued yet. If a group key is specified, only the events of the
+	// asset group with that key are returned.
+	FetchPendingEmissions(ctx context.Context,
+		groupKey *btcec.PublicKey) ([]PendingEmission, error)
+
+	// MarkEmissionsIssued marks the emission events with the given IDs as
+	// issued.
+	MarkEmissionsIssued(ctx context.Context, eventIDs ...int64) error
 }
 
 // ChainBridge is our bridge to the target chain. It's used to get confirmation
@@ -315,14 +398,19 @@ type WalletAnchor interface {
 	// P2TR output.
 	ImportTaprootOutput(context.Context, *btcec.PublicKey) (btcutil.Address, error)
 
-	// UnlockInput unlocks the set of target inputs after a batch is
-	// abandoned.
-	UnlockInput(context.Context) error
+	// ReleaseUTXOs releases the given wallet UTXOs that were leased when
+	// funding a PSBT, making them available for coin selection again.
+	ReleaseUTXOs(ctx context.Context, utxos ...wire.OutPoint) error
 
 	// ListUnspentImportScripts lists all UTXOs of the imported Taproot
 	// scripts.
 	ListUnspentImportScripts(ctx context.Context) ([]*lnwallet.Utxo, error)
 
+	// ListUnspent lists all UTXOs of the default wallet account with at
+	// least the given number of confirmations that aren't leased.
+	ListUnspent(ctx context.Context, minConfs uint32) ([]*lnwallet.Utxo,
+		error)
+
 	// ListTransactions returns all known transactions of the backing lnd
 	// node. It takes a start and end block height which can be used to
 	// limit the block range that we query over. These values can be left
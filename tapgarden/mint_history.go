@@ -0,0 +1,112 @@
+package tapgarden
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+// MintHistoryQuery is the set of filters that determine which batches of the
+// issuance history of the node are returned.
+type MintHistoryQuery struct {
+	// StartTime, if set, only includes batches that were created at or
+	// after this time.
+	StartTime time.Time
+
+	// EndTime, if set, only includes batches that were created at or
+	// before this time.
+	EndTime time.Time
+
+	// States, if set, only includes batches that are in one of these
+	// states.
+	States []BatchState
+}
+
+// matches returns true if the given batch matches the query.
+func (q *MintHistoryQuery) matches(batch *MintingBatch) bool {
+	if !q.StartTime.IsZero() && batch.CreationTime.Before(q.StartTime) {
+		return false
+	}
+
+	if !q.EndTime.IsZero() && batch.CreationTime.After(q.EndTime) {
+		return false
+	}
+
+	if len(q.States) == 0 {
+		return true
+	}
+
+	return fn.Any(q.States, func(state BatchState) bool {
+		return state == batch.State()
+	})
+}
+
+// MintRecord is a single entry of the issuance history of the node, which is
+// a batch that was submitted for minting along with its on-chain anchor.
+type MintRecord struct {
+	// Batch is the minting batch. The batch holds the minted assets once
+	// it is committed to a minting transaction, and only the seedlings
+	// before that.
+	Batch *MintingBatch
+
+	// Anchor is the on-chain anchor of the batch. This is nil if the
+	// minting transaction of the batch was never broadcast. The merkle
+	// proof of the anchor is never set.
+	Anchor *BatchAnchor
+}
+
+// ListMints returns the issuance history of the node, which is every batch
+// that was ever submitted for minting and matches the given query, including
+// the batches that were cancelled or failed. The records are sorted by the
+// creation time of their batch, from oldest to newest.
+func (c *ChainPlanter) ListMints(ctx context.Context,
+	query MintHistoryQuery) ([]*MintRecord, error) {
+
+	batches, err := c.cfg.Log.FetchAllBatches(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch batches: %w", err)
+	}
+
+	batches = fn.Filter(batches, query.matches)
+	sort.SliceStable(batches, func(i, j int) bool {
+		return batches[i].CreationTime.Before(batches[j].CreationTime)
+	})
+
+	records := make([]*MintRecord, 0, len(batches))
+	for _, batch := range batches {
+		record := &MintRecord{
+			Batch: batch,
+		}
+
+		// Batches that never got past the seedling stage don't have a
+		// minting transaction.
+		if batch.GenesisPacket == nil {
+			records = append(records, record)
+			continue
+		}
+
+		batchKey := batch.BatchKey.PubKey
+		anchor, err := c.cfg.Log.FetchBatchAnchor(ctx, batchKey)
+		switch {
+		// The minting transaction of a batch is only stored once it's
+		// signed, so a committed batch might not have an anchor yet.
+		case errors.Is(err, ErrBatchNotBroadcast):
+
+		case err != nil:
+			return nil, fmt.Errorf("unable to fetch anchor of "+
+				"batch %x: %w", batchKey.SerializeCompressed(),
+				err)
+
+		default:
+			record.Anchor = anchor
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/rand"
+	"sync"
 	"testing"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/btcsuite/btcwallet/waddrmgr"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightningnetwork/lnd/chainntnfs"
@@ -66,6 +68,10 @@ func RandSeedlingMintingBatch(t testing.TB, numSeedlings int) *MintingBatch {
 	}
 }
 
+// MockInputValue is the value of the input the mock wallet anchor adds to
+// each PSBT it funds.
+const MockInputValue = 100000
+
 type MockWalletAnchor struct {
 	FundPsbtSignal     chan *FundedPsbt
 	SignPsbtSignal     chan struct{}
@@ -77,6 +83,10 @@ type MockWalletAnchor struct {
 
 	Transactions  []lndclient.Transaction
 	ImportedUtxos []*lnwallet.Utxo
+	WalletUtxos   []*lnwallet.Utxo
+
+	releasedMtx   sync.Mutex
+	releasedUtxos []wire.OutPoint
 }
 
 func NewMockWalletAnchor() *MockWalletAnchor {
@@ -94,20 +104,34 @@ func NewMockWalletAnchor() *MockWalletAnchor {
 func (m *MockWalletAnchor) FundPsbt(_ context.Context, packet *psbt.Packet,
 	_ uint32, _ chainfee.SatPerKWeight) (FundedPsbt, error) {
 
-	// Take the PSBT packet and add an additional input and output to
-	// simulate the wallet funding the transaction.
-	packet.UnsignedTx.AddTxIn(&wire.TxIn{
-		PreviousOutPoint: wire.OutPoint{
+	// Just like lnd, we only add an input to simulate the wallet funding
+	// the transaction if the template doesn't have any inputs yet. The
+	// inputs of the template are expected to be wallet UTXOs.
+	var lockedUtxos []wire.OutPoint
+	if len(packet.UnsignedTx.TxIn) == 0 {
+		utxo := wire.OutPoint{
 			Index: rand.Uint32(),
-		},
-	})
-	packet.Inputs = append(packet.Inputs, psbt.PInput{
-		WitnessUtxo: &wire.TxOut{
-			Value:    100000,
+		}
+		packet.UnsignedTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: utxo,
+		})
+		packet.Inputs = append(packet.Inputs, psbt.PInput{})
+		lockedUtxos = append(lockedUtxos, utxo)
+	}
+	for idx, txIn := range packet.UnsignedTx.TxIn {
+		value := btcutil.Amount(MockInputValue)
+		for _, utxo := range m.WalletUtxos {
+			if utxo.OutPoint == txIn.PreviousOutPoint {
+				value = utxo.Value
+			}
+		}
+
+		packet.Inputs[idx].WitnessUtxo = &wire.TxOut{
+			Value:    int64(value),
 			PkScript: []byte{0x1},
-		},
-		SighashType: txscript.SigHashDefault,
-	})
+		}
+		packet.Inputs[idx].SighashType = txscript.SigHashDefault
+	}
 	packet.UnsignedTx.AddTxOut(&wire.TxOut{
 		Value:    50000,
 		PkScript: []byte{0x2},
@@ -119,6 +143,7 @@ func (m *MockWalletAnchor) FundPsbt(_ context.Context, packet *psbt.Packet,
 	pkt := FundedPsbt{
 		Pkt:               packet,
 		ChangeOutputIndex: 1,
+		LockedUTXOs:       lockedUtxos,
 	}
 
 	m.FundPsbtSignal <- &pkt
@@ -163,10 +188,26 @@ func (m *MockWalletAnchor) ImportTaprootOutput(ctx context.Context,
 	)
 }
 
-func (m *MockWalletAnchor) UnlockInput(_ context.Context) error {
+// ReleaseUTXOs records the given UTXOs as released.
+func (m *MockWalletAnchor) ReleaseUTXOs(_ context.Context,
+	utxos ...wire.OutPoint) error {
+
+	m.releasedMtx.Lock()
+	defer m.releasedMtx.Unlock()
+
+	m.releasedUtxos = append(m.releasedUtxos, utxos...)
+
 	return nil
 }
 
+// ReleasedUTXOs returns all UTXOs that were released so far.
+func (m *MockWalletAnchor) ReleasedUTXOs() []wire.OutPoint {
+	m.releasedMtx.Lock()
+	defer m.releasedMtx.Unlock()
+
+	return append([]wire.OutPoint(nil), m.releasedUtxos...)
+}
+
 // ListUnspentImportScripts lists all UTXOs of the imported Taproot scripts.
 func (m *MockWalletAnchor) ListUnspentImportScripts(
 	ctx context.Context) ([]*lnwallet.Utxo, error) {
@@ -181,6 +222,16 @@ func (m *MockWalletAnchor) ListUnspentImportScripts(
 	return m.ImportedUtxos, nil
 }
 
+// ListUnspent lists all UTXOs of the default wallet account with at least the
+// given number of confirmations that aren't leased.
+func (m *MockWalletAnchor) ListUnspent(_ context.Context,
+	minConfs uint32) ([]*lnwallet.Utxo, error) {
+
+	return fn.Filter(m.WalletUtxos, func(utxo *lnwallet.Utxo) bool {
+		return utxo.Confirmations >= int64(minConfs)
+	}), nil
+}
+
 // ImportTapscript imports a Taproot output script into the wallet to track it
 // on-chain in a watch-only manner.
 func (m *MockWalletAnchor) ImportTapscript(_ context.Context,
@@ -371,7 +422,6 @@ func GenMockGroupVerifier() func(*btcec.PublicKey) error {
 }
 
 type MockKeyRing struct {
-	FamIndex keychain.KeyFamily
 	KeyIndex uint32
 
 	Keys map[keychain.KeyLocator]*btcec.PrivateKey
@@ -404,7 +454,6 @@ func (m *MockKeyRing) DeriveNextKey(ctx context.Context,
 	}
 
 	defer func() {
-		m.FamIndex++
 		m.KeyIndex++
 	}()
 
@@ -415,7 +464,7 @@ func (m *MockKeyRing) DeriveNextKey(ctx context.Context,
 
 	loc := keychain.KeyLocator{
 		Index:  m.KeyIndex,
-		Family: m.FamIndex,
+		Family: keyFam,
 	}
 
 	m.Keys[loc] = priv
@@ -446,8 +495,11 @@ func (m *MockKeyRing) DeriveKey(ctx context.Context,
 	return keychain.KeyDescriptor{}, nil
 }
 
-func (m *MockKeyRing) IsLocalKey(context.Context, keychain.KeyDescriptor) bool {
-	return true
+func (m *MockKeyRing) IsLocalKey(_ context.Context,
+	desc keychain.KeyDescriptor) bool {
+
+	priv, ok := m.Keys[desc.KeyLocator]
+	return ok && priv.PubKey().IsEqual(desc.PubKey)
 }
 
 type MockGenSigner struct {
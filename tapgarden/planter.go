@@ -1,12 +1,20 @@
 package tapgarden
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
 
+	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -19,6 +27,10 @@ import (
 	"golang.org/x/exp/maps"
 )
 
+// ErrNoOpenBatch is returned if a named batch is finalized or committed that
+// doesn't exist or is no longer open for new seedlings.
+var ErrNoOpenBatch = errors.New("no open batch")
+
 // GardenKit holds the set of shared fundamental interfaces all sub-systems of
 // the tapgarden need to function.
 type GardenKit struct {
@@ -65,6 +77,12 @@ type GardenKit struct {
 	// UniversePushBatchSize is the number of minted items to push to the
 	// local universe in a single batch.
 	UniversePushBatchSize int
+
+	// BatchFinalized is an optional function that's called once a batch
+	// is confirmed and finalized, with the time that passed since the
+	// batch was created. This can be used to export metrics about the
+	// minting process.
+	BatchFinalized func(duration time.Duration)
 }
 
 // PlanterConfig is the main config for the ChainPlanter.
@@ -172,8 +190,62 @@ const (
 	reqTypeListBatches
 	reqTypeFinalizeBatch
 	reqTypeCancelBatch
+	reqTypeBumpBatchFee
+	reqTypeCommitBatchPsbt
+	reqTypePublishBatchPsbt
 )
 
+// finalizeParams are the parameters of a request to finalize a batch.
+type finalizeParams struct {
+	// batchName is the name of the named batch to finalize. If this is
+	// empty, then the current pending batch is finalized.
+	batchName string
+
+	// feeRate is the optional fee rate the minting transaction should
+	// pay.
+	feeRate *chainfee.SatPerKWeight
+
+	// genesisPolicy is the optional policy that determines the wallet UTXO
+	// that is spent as the genesis point of the minting transaction.
+	genesisPolicy *GenesisPointPolicy
+}
+
+// bumpFeeParams are the parameters of a request to bump the fee of the minting
+// transaction of a batch.
+type bumpFeeParams struct {
+	// batchKey is the key of the batch to bump the fee of.
+	batchKey *btcec.PublicKey
+
+	// feeRate is the fee rate the replacement transaction should pay.
+	feeRate chainfee.SatPerKWeight
+}
+
+// commitPsbtParams are the parameters of a request to commit a batch to a
+// minting transaction that is funded by an external wallet.
+type commitPsbtParams struct {
+	// batchName is the name of the named batch to commit. If this is
+	// empty, then the current pending batch is committed.
+	batchName string
+
+	// fundedPkt is the externally funded minting transaction.
+	fundedPkt *psbt.Packet
+
+	// anchorOutputIndex is the index of the output of the minting
+	// transaction that will commit to the assets of the batch.
+	anchorOutputIndex uint32
+}
+
+// publishPsbtParams are the parameters of a request to publish the externally
+// signed minting transaction of a batch.
+type publishPsbtParams struct {
+	// batchKey is the key of the batch the minting transaction belongs
+	// to.
+	batchKey *btcec.PublicKey
+
+	// signedPkt is the signed minting transaction.
+	signedPkt *psbt.Packet
+}
+
 // ChainPlanter is responsible for accepting new incoming requests to create
 // taproot assets. The planter will periodically batch those requests into a new
 // minting batch, which is handed off to a caretaker. While batches are
@@ -192,6 +264,17 @@ type ChainPlanter struct {
 	// these will exist at any given time.
 	pendingBatch *MintingBatch
 
+	// namedBatches are the pending batches that were given a name, keyed
+	// by their name. Unlike the pending batch, named batches are only
+	// frozen on explicit request.
+	namedBatches map[string]*MintingBatch
+
+	// externalBatches are the batches that were committed to a minting
+	// transaction funded by an external wallet, keyed by their batch key.
+	// They're kept here until their signed minting transaction is
+	// published.
+	externalBatches map[BatchKey]*MintingBatch
+
 	// caretakers maps a batch key (which is used as the internal key for
 	// the transaction that mints the assets) to the caretaker that will
 	// progress the batch through the final phases.
@@ -215,6 +298,8 @@ type ChainPlanter struct {
 func NewChainPlanter(cfg PlanterConfig) *ChainPlanter {
 	return &ChainPlanter{
 		cfg:               cfg,
+		namedBatches:      make(map[string]*MintingBatch),
+		externalBatches:   make(map[BatchKey]*MintingBatch),
 		caretakers:        make(map[BatchKey]*BatchCaretaker),
 		completionSignals: make(chan BatchKey),
 		seedlingReqs:      make(chan *Seedling),
@@ -229,7 +314,8 @@ func NewChainPlanter(cfg PlanterConfig) *ChainPlanter {
 // newCaretakerForBatch creates a new BatchCaretaker for a given batch and
 // inserts it into the caretaker map.
 func (c *ChainPlanter) newCaretakerForBatch(batch *MintingBatch,
-	feeRate *chainfee.SatPerKWeight) *BatchCaretaker {
+	feeRate *chainfee.SatPerKWeight,
+	genesisPolicy *GenesisPointPolicy) *BatchCaretaker {
 
 	batchKey := asset.ToSerialized(batch.BatchKey.PubKey)
 	batchConfig := &BatchCaretakerConfig{
@@ -248,6 +334,9 @@ func (c *ChainPlanter) newCaretakerForBatch(batch *MintingBatch,
 	if feeRate != nil {
 		batchConfig.BatchFeeRate = feeRate
 	}
+	if genesisPolicy != nil {
+		batchConfig.GenesisPolicy = genesisPolicy
+	}
 
 	caretaker := NewBatchCaretaker(batchConfig)
 	c.caretakers[batchKey] = caretaker
@@ -294,6 +383,36 @@ func (c *ChainPlanter) Start() error {
 				continue
 			}
 
+			// Named batches that are still pending stay open for
+			// new seedlings until they're finalized explicitly.
+			if batchState == BatchStatePending && batch.Name != "" {
+				log.Infof("Restoring named MintingBatch(%v) "+
+					"with %v assets", batch.Name,
+					len(batch.Seedlings))
+
+				batch.AssetMetas = make(AssetMetas)
+				c.namedBatches[batch.Name] = batch
+
+				continue
+			}
+
+			// Batches funded by an external wallet can only
+			// progress once their signed minting transaction is
+			// handed back.
+			if batchState == BatchStateAwaitingSignature {
+				batchKey := asset.ToSerialized(
+					batch.BatchKey.PubKey,
+				)
+
+				log.Infof("Restoring MintingBatch(%x) "+
+					"awaiting signed minting transaction",
+					batchKey[:])
+
+				c.externalBatches[batchKey] = batch
+
+				continue
+			}
+
 			log.Infof("Launching ChainCaretaker(%x)",
 				batch.BatchKey.PubKey.SerializeCompressed())
 
@@ -305,7 +424,7 @@ func (c *ChainPlanter) Start() error {
 			}
 
 			// TODO(jhb): Log manual fee rates?
-			caretaker := c.newCaretakerForBatch(batch, nil)
+			caretaker := c.newCaretakerForBatch(batch, nil, nil)
 			if err := caretaker.Start(); err != nil {
 				startErr = err
 				return
@@ -386,17 +505,18 @@ func listBatches(ctx context.Context, batchStore MintingStore,
 }
 
 // canCancelBatch returns a batch key if the planter is in a state where a batch
-// can be cancelled. This does not account for the state of a caretaker that
-// may be managing a batch.
+// can be cancelled. If there is no batch to cancel, a nil key is returned. This
+// does not account for the state of a caretaker that may be managing a batch.
 func (c *ChainPlanter) canCancelBatch() (*btcec.PublicKey, error) {
 	caretakerCount := len(c.caretakers)
 
 	switch caretakerCount {
 	case 0:
 		// If there are no caretakers, the only batch we could cancel
-		// would be the current pending batch.
+		// would be the current pending batch. If there's no pending
+		// batch either, there's nothing to cancel.
 		if c.pendingBatch == nil {
-			return nil, fmt.Errorf("no pending batch")
+			return nil, nil
 		}
 
 		return c.pendingBatch.BatchKey.PubKey, nil
@@ -494,7 +614,7 @@ func (c *ChainPlanter) gardener() {
 				continue
 			}
 
-			_, err := c.finalizeBatch(nil)
+			_, err := c.finalizeBatch(c.pendingBatch, nil, nil)
 			if err != nil {
 				c.cfg.ErrChan <- fmt.Errorf("unable to freeze "+
 					"minting batch: %w", err)
@@ -513,7 +633,7 @@ func (c *ChainPlanter) gardener() {
 			// seedling (soon to be a sprout) by committing it to
 			// disk as part of the latest batch.
 			ctx, cancel := c.WithCtxQuit()
-			err := c.prepAssetSeedling(ctx, req)
+			batch, err := c.prepAssetSeedling(ctx, req)
 			cancel()
 			if err != nil {
 				// Something went wrong, so then an error
@@ -533,7 +653,7 @@ func (c *ChainPlanter) gardener() {
 			// TODO(roasbeef): extend the ticker by a certain
 			// portion?
 			req.updates <- SeedlingUpdate{
-				PendingBatch: c.pendingBatch,
+				PendingBatch: batch,
 				NewState:     MintingStateSeed,
 			}
 
@@ -590,24 +710,34 @@ func (c *ChainPlanter) gardener() {
 				req.Resolve(batches)
 
 			case reqTypeFinalizeBatch:
-				if c.pendingBatch == nil {
-					req.Error(fmt.Errorf("no pending batch"))
+				params, err := typedParam[finalizeParams](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad finalize "+
+						"params: %w", err))
 					break
 				}
 
-				batchKey := c.pendingBatch.BatchKey.PubKey
-				log.Infof("Finalizing batch %x",
-					batchKey.SerializeCompressed())
+				batch := c.pendingBatchByName(params.batchName)
+				switch {
+				case batch == nil && params.batchName != "":
+					req.Error(fmt.Errorf("%w named %v",
+						ErrNoOpenBatch,
+						params.batchName))
+					continue
 
-				feeRate, err :=
-					typedParam[*chainfee.SatPerKWeight](req)
-				if err != nil {
-					req.Error(fmt.Errorf("bad fee rate: "+
-						"%w", err))
-					break
+				case batch == nil:
+					req.Error(fmt.Errorf("no pending batch"))
+					continue
 				}
 
-				caretaker, err := c.finalizeBatch(*feeRate)
+				batchKey := batch.BatchKey.PubKey
+				log.Infof("Finalizing batch %x",
+					batchKey.SerializeCompressed())
+
+				caretaker, err := c.finalizeBatch(
+					batch, params.feeRate,
+					params.genesisPolicy,
+				)
 				if err != nil {
 					c.cfg.ErrChan <- fmt.Errorf("unable "+
 						"to freeze minting batch: %w",
@@ -632,7 +762,114 @@ func (c *ChainPlanter) gardener() {
 				// Now that we have a caretaker launched for
 				// this batch and broadcast its minting
 				// transaction, we can remove the pending batch.
-				c.pendingBatch = nil
+				c.setPendingBatch(params.batchName, nil)
+
+			case reqTypeCommitBatchPsbt:
+				params, err := typedParam[commitPsbtParams](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad commit "+
+						"params: %w", err))
+					break
+				}
+
+				batch := c.pendingBatchByName(params.batchName)
+				switch {
+				case batch == nil && params.batchName != "":
+					req.Error(fmt.Errorf("%w named %v",
+						ErrNoOpenBatch,
+						params.batchName))
+					continue
+
+				case batch == nil:
+					req.Error(fmt.Errorf("no pending " +
+						"batch"))
+					continue
+				}
+
+				err = c.commitExternalBatch(
+					batch, params.fundedPkt,
+					params.anchorOutputIndex,
+				)
+				if err != nil {
+					req.Error(err)
+					break
+				}
+
+				// The batch is no longer open for new
+				// seedlings, so we'll keep it aside until the
+				// signed minting transaction arrives.
+				c.setPendingBatch(params.batchName, nil)
+
+				batchKey := asset.ToSerialized(
+					batch.BatchKey.PubKey,
+				)
+				c.externalBatches[batchKey] = batch
+
+				req.Resolve(batch)
+
+			case reqTypePublishBatchPsbt:
+				params, err := typedParam[publishPsbtParams](
+					req,
+				)
+				if err != nil {
+					req.Error(fmt.Errorf("bad publish "+
+						"params: %w", err))
+					break
+				}
+
+				batchKey := asset.ToSerialized(params.batchKey)
+				batch, ok := c.externalBatches[batchKey]
+				if !ok {
+					req.Error(fmt.Errorf("no batch with "+
+						"key %x awaiting a signed "+
+						"minting transaction",
+						batchKey[:]))
+					break
+				}
+
+				unsignedPkt := batch.GenesisPacket
+				caretaker, err := c.publishExternalBatch(
+					batch, params.signedPkt,
+				)
+				if err != nil {
+					req.Error(err)
+					break
+				}
+
+				// We now wait for the caretaker to either
+				// broadcast the batch or fail to do so.
+				select {
+				case <-caretaker.cfg.BroadcastCompleteChan:
+					delete(c.externalBatches, batchKey)
+					req.Resolve(batch)
+
+				case err := <-caretaker.cfg.BroadcastErrChan:
+					req.Error(err)
+
+					if err := caretaker.Stop(); err != nil {
+						log.Warnf("unable to stop "+
+							"caretaker: %v", err)
+					}
+					delete(c.caretakers, batchKey)
+
+					// If the signed minting transaction
+					// wasn't stored yet, then the batch
+					// can still be published again.
+					state := batch.State()
+					if state != BatchStateCommitted {
+						delete(c.externalBatches,
+							batchKey)
+						continue
+					}
+
+					batch.GenesisPacket = unsignedPkt
+					batch.UpdateState(
+						BatchStateAwaitingSignature,
+					)
+
+				case <-c.Quit:
+					return
+				}
 
 			case reqTypeCancelBatch:
 				batchKey, err := c.canCancelBatch()
@@ -641,6 +878,13 @@ func (c *ChainPlanter) gardener() {
 					break
 				}
 
+				// Cancelling without an active batch is a
+				// no-op.
+				if batchKey == nil {
+					req.Return(batchKey, nil)
+					break
+				}
+
 				// Attempt to cancel the current batch, and then
 				// clear the pending batch in the planter.
 				ctx, cancel := c.WithCtxQuit()
@@ -651,6 +895,33 @@ func (c *ChainPlanter) gardener() {
 				// Always return the key of the batch we tried
 				// to cancel.
 				req.Return(batchKey, err)
+
+			case reqTypeBumpBatchFee:
+				params, err := typedParam[bumpFeeParams](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad fee bump "+
+						"params: %w", err))
+					break
+				}
+
+				caretaker, err := c.bumpFeeCaretaker(
+					params.batchKey,
+				)
+				if err != nil {
+					req.Error(err)
+					break
+				}
+
+				// Bumping the fee requires signing and
+				// broadcasting a new transaction, so we'll
+				// wait for the caretaker in a new goroutine to
+				// not block the planter.
+				go func() {
+					txid, err := caretaker.BumpFee(
+						params.feeRate,
+					)
+					req.Return(txid, err)
+				}()
 			}
 
 		case <-c.Quit:
@@ -659,18 +930,74 @@ func (c *ChainPlanter) gardener() {
 	}
 }
 
-// finalizeBatch creates a new caretaker for the batch and starts it.
-func (c *ChainPlanter) finalizeBatch(
-	feeRate *chainfee.SatPerKWeight) (*BatchCaretaker, error) {
+// pendingBatchByName returns the open named batch with the given name, or the
+// regular pending batch if the name is empty. Nil is returned if there's no
+// such batch.
+func (c *ChainPlanter) pendingBatchByName(name string) *MintingBatch {
+	if name == "" {
+		return c.pendingBatch
+	}
+
+	return c.namedBatches[name]
+}
+
+// setPendingBatch sets the open named batch with the given name, or the regular
+// pending batch if the name is empty. A nil batch removes the batch.
+func (c *ChainPlanter) setPendingBatch(name string, batch *MintingBatch) {
+	switch {
+	case name == "":
+		c.pendingBatch = batch
+
+	case batch == nil:
+		delete(c.namedBatches, name)
+
+	default:
+		c.namedBatches[name] = batch
+	}
+}
+
+// bumpFeeCaretaker returns the caretaker of the batch with the given key, if
+// the minting transaction of the batch can still be replaced.
+func (c *ChainPlanter) bumpFeeCaretaker(
+	batchKey *btcec.PublicKey) (*BatchCaretaker, error) {
+
+	caretaker, ok := c.caretakers[asset.ToSerialized(batchKey)]
+	if ok {
+		return caretaker, nil
+	}
+
+	// Without a caretaker, the batch either doesn't exist, was never
+	// broadcast, or is already confirmed. We'll check the batch on disk to
+	// return a useful error.
+	ctx, cancel := c.WithCtxQuit()
+	batch, err := c.cfg.Log.FetchMintingBatch(ctx, batchKey)
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch batch: %w", err)
+	}
+
+	switch batch.State() {
+	case BatchStateConfirmed, BatchStateFinalized:
+		return nil, ErrBatchAlreadyConfirmed
+
+	default:
+		return nil, ErrBatchNotBroadcast
+	}
+}
+
+// finalizeBatch creates a new caretaker for the given batch and starts it.
+func (c *ChainPlanter) finalizeBatch(batch *MintingBatch,
+	feeRate *chainfee.SatPerKWeight,
+	genesisPolicy *GenesisPointPolicy) (*BatchCaretaker, error) {
 
 	// Prep the new care taker that'll be launched assuming the call below
 	// to freeze the batch succeeds.
-	caretaker := c.newCaretakerForBatch(c.pendingBatch, feeRate)
+	caretaker := c.newCaretakerForBatch(batch, feeRate, genesisPolicy)
 
 	// At this point, we have a non-empty batch, so we'll first finalize it
 	// on disk. This means no further seedlings can be added to this batch.
 	ctx, cancel := c.WithCtxQuit()
-	err := freezeMintingBatch(ctx, c.cfg.Log, c.pendingBatch)
+	err := freezeMintingBatch(ctx, c.cfg.Log, batch)
 	cancel()
 	if err != nil {
 		return nil, fmt.Errorf("unable to freeze minting batch: %w",
@@ -687,8 +1014,219 @@ func (c *ChainPlanter) finalizeBatch(
 	return caretaker, nil
 }
 
+// externalGenesisPacket validates the given minting transaction that was funded
+// by an external wallet, and returns it as a funded genesis packet. Besides the
+// anchor output at the given index, the transaction may only have a single
+// change output.
+func externalGenesisPacket(fundedPkt *psbt.Packet,
+	anchorOutputIndex uint32) (*FundedPsbt, error) {
+
+	tx := fundedPkt.UnsignedTx
+	switch {
+	case len(tx.TxIn) == 0:
+		return nil, fmt.Errorf("minting transaction has no inputs")
+
+	case len(tx.TxOut) > 2:
+		return nil, fmt.Errorf("minting transaction can only have " +
+			"an anchor output and an optional change output")
+
+	case int(anchorOutputIndex) >= len(tx.TxOut):
+		return nil, fmt.Errorf("anchor output index %d out of range",
+			anchorOutputIndex)
+
+	case tx.TxOut[anchorOutputIndex].Value < int64(GenesisAmtSats):
+		return nil, fmt.Errorf("anchor output must carry at least %v",
+			GenesisAmtSats)
+	}
+
+	// We need to know the value of all inputs to be able to compute the
+	// fee of the minting transaction.
+	for idx, input := range fundedPkt.Inputs {
+		if input.WitnessUtxo == nil && input.NonWitnessUtxo == nil {
+			return nil, fmt.Errorf("input %d of minting "+
+				"transaction is missing its UTXO", idx)
+		}
+	}
+
+	// The minting proofs contain an exclusion proof for the change output,
+	// which we can only create for a P2TR change output if we know its
+	// BIP-0086 internal key.
+	for idx, txOut := range tx.TxOut {
+		if uint32(idx) == anchorOutputIndex ||
+			!txscript.IsPayToTaproot(txOut.PkScript) {
+
+			continue
+		}
+
+		output := fundedPkt.Outputs[idx]
+		if len(output.TaprootInternalKey) != schnorr.PubKeyBytesLen ||
+			len(output.TaprootTapTree) != 0 {
+
+			return nil, fmt.Errorf("P2TR change output %d must "+
+				"declare its BIP-0086 internal key", idx)
+		}
+	}
+
+	// The anchor output is derived from the change output index later on,
+	// so we'll mark the other output as change, if there is one.
+	changeIndex := int32(-1)
+	if len(tx.TxOut) == 2 {
+		changeIndex = int32(1 - anchorOutputIndex)
+	}
+
+	return &FundedPsbt{
+		Pkt:               fundedPkt,
+		ChangeOutputIndex: changeIndex,
+	}, nil
+}
+
+// commitExternalBatch freezes the given batch and commits its assets to the
+// anchor output of the given minting transaction, which is funded by an
+// external wallet. The batch then awaits its signed minting transaction.
+func (c *ChainPlanter) commitExternalBatch(batch *MintingBatch,
+	fundedPkt *psbt.Packet, anchorOutputIndex uint32) error {
+
+	genesisPkt, err := externalGenesisPacket(fundedPkt, anchorOutputIndex)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := c.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	batchKey := batch.BatchKey.PubKey
+	if err := freezeMintingBatch(ctx, c.cfg.Log, batch); err != nil {
+		return fmt.Errorf("unable to freeze minting batch: %w", err)
+	}
+	batch.UpdateState(BatchStateFrozen)
+
+	// The caretaker of the batch is only launched once the signed minting
+	// transaction is handed back, but we already need it to turn the
+	// seedlings into sprouts.
+	caretaker := NewBatchCaretaker(&BatchCaretakerConfig{
+		Batch:     batch,
+		GardenKit: c.cfg.GardenKit,
+	})
+	err = caretaker.commitGenesisPsbt(ctx, genesisPkt)
+	if err != nil {
+		// We'll re-open the batch, so it can still be finalized
+		// normally.
+		batch.RootAssetCommitment = nil
+		batch.UpdateState(BatchStatePending)
+
+		stateErr := c.cfg.Log.UpdateBatchState(
+			ctx, batchKey, BatchStatePending,
+		)
+		if stateErr != nil {
+			log.Errorf("Unable to re-open MintingBatch(%x): %v",
+				batchKey.SerializeCompressed(), stateErr)
+		}
+
+		return err
+	}
+
+	err = c.cfg.Log.UpdateBatchState(
+		ctx, batchKey, BatchStateAwaitingSignature,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to update batch state: %w", err)
+	}
+	batch.UpdateState(BatchStateAwaitingSignature)
+
+	log.Infof("MintingBatch(%x) awaiting signed minting transaction %v",
+		batchKey.SerializeCompressed(), fundedPkt.UnsignedTx.TxHash())
+
+	return nil
+}
+
+// publishExternalBatch verifies that the given signed minting transaction is
+// the one the given batch was committed to, and then launches a caretaker to
+// broadcast it.
+func (c *ChainPlanter) publishExternalBatch(batch *MintingBatch,
+	signedPkt *psbt.Packet) (*BatchCaretaker, error) {
+
+	genesisPkt := batch.GenesisPacket
+	unsignedTx := genesisPkt.Pkt.UnsignedTx
+	signedTx := signedPkt.UnsignedTx
+
+	// The anchor output must be untouched, otherwise the assets of the
+	// batch would be lost. Any other change to the transaction is caught
+	// by comparing the txids.
+	anchorIdx := anchorOutputIndex(genesisPkt)
+	anchorOutput := unsignedTx.TxOut[anchorIdx]
+	switch {
+	case len(signedTx.TxOut) <= int(anchorIdx) ||
+		!bytes.Equal(signedTx.TxOut[anchorIdx].PkScript,
+			anchorOutput.PkScript) ||
+		signedTx.TxOut[anchorIdx].Value != anchorOutput.Value:
+
+		return nil, fmt.Errorf("signed minting transaction doesn't " +
+			"preserve the anchor output")
+
+	case signedTx.TxHash() != unsignedTx.TxHash():
+		return nil, fmt.Errorf("signed minting transaction %v "+
+			"doesn't match committed minting transaction %v",
+			signedTx.TxHash(), unsignedTx.TxHash())
+	}
+
+	// External signers don't always carry over the UTXO information of
+	// the inputs, which we need to compute the fee.
+	for idx := range signedPkt.Inputs {
+		input := &signedPkt.Inputs[idx]
+		if input.WitnessUtxo == nil && input.NonWitnessUtxo == nil {
+			unsignedInput := genesisPkt.Pkt.Inputs[idx]
+			input.WitnessUtxo = unsignedInput.WitnessUtxo
+			input.NonWitnessUtxo = unsignedInput.NonWitnessUtxo
+		}
+	}
+
+	// The same goes for the internal key of the change output, which is
+	// needed for the exclusion proof of the output.
+	for idx := range signedPkt.Outputs {
+		output := &signedPkt.Outputs[idx]
+		if len(output.TaprootInternalKey) == 0 {
+			output.TaprootInternalKey =
+				genesisPkt.Pkt.Outputs[idx].TaprootInternalKey
+		}
+	}
+
+	if err := psbt.MaybeFinalizeAll(signedPkt); err != nil {
+		return nil, fmt.Errorf("unable to finalize signed minting "+
+			"transaction: %w", err)
+	}
+
+	finalTx, err := psbt.Extract(signedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract signed minting "+
+			"transaction: %w", err)
+	}
+
+	err = blockchain.CheckTransactionSanity(btcutil.NewTx(finalTx))
+	if err != nil {
+		return nil, fmt.Errorf("signed minting transaction failed "+
+			"final checks: %w", err)
+	}
+
+	// With the packet fully signed, the caretaker will skip signing and
+	// carry on with broadcasting the minting transaction.
+	batch.GenesisPacket = &FundedPsbt{
+		Pkt:               signedPkt,
+		ChangeOutputIndex: genesisPkt.ChangeOutputIndex,
+	}
+	batch.UpdateState(BatchStateCommitted)
+
+	caretaker := c.newCaretakerForBatch(batch, nil, nil)
+	if err := caretaker.Start(); err != nil {
+		return nil, fmt.Errorf("unable to start new caretaker: %w", err)
+	}
+
+	return caretaker, nil
+}
+
 // PendingBatch returns the current pending batch. If there's no pending batch,
-// then an error is returned.
+// then nil is returned.
+//
+// NOTE: This is part of the Planter interface.
 func (c *ChainPlanter) PendingBatch() (*MintingBatch, error) {
 	req := newStateReq[*MintingBatch](reqTypePendingBatch)
 
@@ -725,11 +1263,21 @@ func (c *ChainPlanter) ListBatches(batchKey *btcec.PublicKey) ([]*MintingBatch,
 	return <-req.resp, <-req.err
 }
 
-// FinalizeBatch sends a signal to the planter to finalize the current batch.
-func (c *ChainPlanter) FinalizeBatch(
-	feeRate *chainfee.SatPerKWeight) (*MintingBatch, error) {
-
-	req := newStateParamReq[*MintingBatch](reqTypeFinalizeBatch, feeRate)
+// FinalizeBatch sends a signal to the planter to finalize the named batch with
+// the given name, or the current batch if no name is given. If a genesis point
+// policy is given, then the genesis point of the minting transaction is
+// selected according to it.
+func (c *ChainPlanter) FinalizeBatch(batchName string,
+	feeRate *chainfee.SatPerKWeight,
+	genesisPolicy *GenesisPointPolicy) (*MintingBatch, error) {
+
+	req := newStateParamReq[*MintingBatch](
+		reqTypeFinalizeBatch, finalizeParams{
+			batchName:     batchName,
+			feeRate:       feeRate,
+			genesisPolicy: genesisPolicy,
+		},
+	)
 
 	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
 		return nil, fmt.Errorf("chain planter shutting down")
@@ -738,7 +1286,9 @@ func (c *ChainPlanter) FinalizeBatch(
 	return <-req.resp, <-req.err
 }
 
-// CancelBatch sends a signal to the planter to cancel the current batch.
+// CancelBatch sends a signal to the planter to cancel the current batch. The
+// key of the cancelled batch is returned, or nil if there was no active batch
+// to cancel. Batches with a broadcast minting transaction can't be cancelled.
 func (c *ChainPlanter) CancelBatch() (*btcec.PublicKey, error) {
 	req := newStateReq[*btcec.PublicKey](reqTypeCancelBatch)
 
@@ -749,15 +1299,135 @@ func (c *ChainPlanter) CancelBatch() (*btcec.PublicKey, error) {
 	return <-req.resp, <-req.err
 }
 
+// BumpBatchFee sends a signal to the planter to replace the broadcast minting
+// transaction of the given batch with one that pays the given fee rate. The
+// txid of the replacement transaction is returned.
+func (c *ChainPlanter) BumpBatchFee(batchKey *btcec.PublicKey,
+	feeRate chainfee.SatPerKWeight) (*chainhash.Hash, error) {
+
+	req := newStateParamReq[*chainhash.Hash](
+		reqTypeBumpBatchFee, bumpFeeParams{
+			batchKey: batchKey,
+			feeRate:  feeRate,
+		},
+	)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// CommitBatchPsbt sends a signal to the planter to commit the named batch with
+// the given name, or the current batch if no name is given, to the given
+// minting transaction that is funded by an external wallet.
+func (c *ChainPlanter) CommitBatchPsbt(batchName string,
+	fundedPkt *psbt.Packet, anchorOutputIndex uint32) (*MintingBatch,
+	error) {
+
+	req := newStateParamReq[*MintingBatch](
+		reqTypeCommitBatchPsbt, commitPsbtParams{
+			batchName:         batchName,
+			fundedPkt:         fundedPkt,
+			anchorOutputIndex: anchorOutputIndex,
+		},
+	)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// PublishBatchPsbt sends a signal to the planter to finalize and broadcast the
+// signed minting transaction of a batch that was committed with
+// CommitBatchPsbt.
+func (c *ChainPlanter) PublishBatchPsbt(batchKey *btcec.PublicKey,
+	signedPkt *psbt.Packet) (*MintingBatch, error) {
+
+	req := newStateParamReq[*MintingBatch](
+		reqTypePublishBatchPsbt, publishPsbtParams{
+			batchKey:  batchKey,
+			signedPkt: signedPkt,
+		},
+	)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// FetchBatchAnchor returns the anchor transaction of the given batch. If the
+// anchor transaction is confirmed, then a proof of its inclusion in the block
+// it was confirmed in is returned as well.
+func (c *ChainPlanter) FetchBatchAnchor(ctx context.Context,
+	batchKey *btcec.PublicKey) (*BatchAnchor, error) {
+
+	batchAnchor, err := c.cfg.Log.FetchBatchAnchor(ctx, batchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !batchAnchor.Confirmed() {
+		return batchAnchor, nil
+	}
+
+	// To prove the inclusion of the anchor transaction, we need all the
+	// transactions of the block it was confirmed in.
+	block, err := c.cfg.ChainBridge.GetBlock(ctx, *batchAnchor.BlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch block %v: %w",
+			batchAnchor.BlockHash, err)
+	}
+
+	txIndex := int(batchAnchor.TxIndex)
+	if txIndex >= len(block.Transactions) ||
+		block.Transactions[txIndex].TxHash() !=
+			batchAnchor.AnchorTx.TxHash() {
+
+		return nil, fmt.Errorf("anchor tx %v not found at index %d "+
+			"of block %v", batchAnchor.AnchorTx.TxHash(), txIndex,
+			batchAnchor.BlockHash)
+	}
+
+	batchAnchor.TxMerkleProof, err = proof.NewTxMerkleProof(
+		block.Transactions, txIndex,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create merkle proof: %w",
+			err)
+	}
+
+	return batchAnchor, nil
+}
+
 // prepAssetSeedling performs some basic validation for the Seedling, then
-// either adds it to an existing pending batch or creates a new batch for it. A
-// bool indicating if a new batch should immediately be created is returned.
+// either adds it to an existing pending batch or creates a new batch for it. If
+// the seedling references a named batch, then the named batch is used instead
+// of the regular pending batch. The batch the seedling was added to is
+// returned.
 func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
-	req *Seedling) error {
+	req *Seedling) (*MintingBatch, error) {
 
 	// First, we'll perform some basic validation for the seedling.
 	if err := req.validateFields(); err != nil {
-		return err
+		return nil, err
+	}
+
+	// Any emission event with a block height that was already reached is
+	// issued immediately as part of the genesis of the asset.
+	if len(req.EmissionSchedule) > 0 {
+		currentHeight, err := c.cfg.ChainBridge.CurrentHeight(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get current "+
+				"height: %w", err)
+		}
+
+		req.issuePastEmissions(currentHeight)
 	}
 
 	// If emission is enabled and a group key is specified, we need to
@@ -769,29 +1439,53 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 		if err != nil {
 			groupKeyBytes := req.GroupInfo.GroupPubKey.
 				SerializeCompressed()
-			return fmt.Errorf("group key %x not found: %w",
+			return nil, fmt.Errorf("group key %x not found: %w",
 				groupKeyBytes, err,
 			)
 		}
 
 		if err := req.validateGroupKey(*groupInfo); err != nil {
-			return err
+			return nil, err
+		}
+
+		// The key family alone doesn't prove that we hold the internal
+		// key of the group, so we'll also make sure our wallet can
+		// actually derive it, as we otherwise can't produce a valid
+		// group witness for the new asset.
+		rawKey := groupInfo.GroupKey.RawKey
+		if !c.cfg.KeyRing.IsLocalKey(ctx, rawKey) {
+			groupKeyBytes := req.GroupInfo.GroupPubKey.
+				SerializeCompressed()
+			return nil, fmt.Errorf("can't sign with group key "+
+				"%x: %w", groupKeyBytes, ErrGroupKeyNotLocal)
 		}
 
 		req.GroupInfo = groupInfo
 	}
 
+	// A custom group internal key is used to sign the group witness of the
+	// new asset, so our wallet must be able to derive it.
+	if req.GroupInternalKey != nil {
+		if !c.cfg.KeyRing.IsLocalKey(ctx, *req.GroupInternalKey) {
+			groupKeyBytes := req.GroupInternalKey.PubKey.
+				SerializeCompressed()
+			return nil, fmt.Errorf("%w: can't sign with key %x",
+				ErrInvalidGroupInternalKey, groupKeyBytes)
+		}
+	}
+
 	// If a group anchor is specified, we need to ensure that the anchor
 	// seedling is already in the batch and has emission enabled.
+	batch := c.pendingBatchByName(req.BatchName)
 	if req.GroupAnchor != nil {
-		if c.pendingBatch == nil {
-			return fmt.Errorf("batch empty, group anchor %v "+
+		if batch == nil {
+			return nil, fmt.Errorf("batch empty, group anchor %v "+
 				"invalid", *req.GroupAnchor)
 		}
 
-		err := c.pendingBatch.validateGroupAnchor(req)
+		err := batch.validateGroupAnchor(req)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -800,8 +1494,9 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 	switch {
 	// No batch, so we'll create a new one with only this seedling as part
 	// of the batch.
-	case c.pendingBatch == nil:
-		log.Infof("Creating new MintingBatch w/ %v", req)
+	case batch == nil:
+		log.Infof("Creating new MintingBatch(name=%q) w/ %v",
+			req.BatchName, req)
 
 		// To create a new batch we'll first need to grab a new
 		// internal key, which'll be used in the output we create, and
@@ -810,15 +1505,15 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 			ctx, asset.TaprootAssetsKeyFamily,
 		)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		ctx, cancel := c.WithCtxQuit()
 		defer cancel()
 		currentHeight, err := c.cfg.ChainBridge.CurrentHeight(ctx)
 		if err != nil {
-			return fmt.Errorf("unable to get current height: %v",
-				err)
+			return nil, fmt.Errorf("unable to get current "+
+				"height: %v", err)
 		}
 
 		// Create a new batch and commit it to disk so we can pick up
@@ -827,6 +1522,7 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 			CreationTime: time.Now(),
 			HeightHint:   currentHeight,
 			BatchKey:     newInternalKey,
+			Name:         req.BatchName,
 			Seedlings: map[string]*Seedling{
 				req.AssetName: req,
 			},
@@ -837,15 +1533,17 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 		defer cancel()
 		err = c.cfg.Log.CommitMintingBatch(ctx, newBatch)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		c.pendingBatch = newBatch
+		c.setPendingBatch(req.BatchName, newBatch)
+		batch = newBatch
 
 	// A batch already exists, so we'll add this seedling to the batch,
 	// committing it to disk fully before we move on.
-	case c.pendingBatch != nil:
-		log.Infof("Adding %v to existing MintingBatch", req)
+	default:
+		log.Infof("Adding %v to existing MintingBatch(name=%q)", req,
+			req.BatchName)
 
 		// First attempt to add the seedling to our pending batch, if
 		// this name is already taken (in the batch), then an error
@@ -853,25 +1551,24 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 		//
 		// TODO(roasbeef): unique constraint below? will trigger on the
 		// name?
-		if err := c.pendingBatch.addSeedling(req); err != nil {
-			return err
+		if err := batch.addSeedling(req); err != nil {
+			return nil, err
 		}
 
 		// Now that we know the seedling is ok, we'll write it to disk.
 		ctx, cancel := c.WithCtxQuit()
 		defer cancel()
 		err := c.cfg.Log.AddSeedlingsToBatch(
-			ctx, c.pendingBatch.BatchKey.PubKey, req,
+			ctx, batch.BatchKey.PubKey, req,
 		)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	// Now that we have the batch committed to disk, we'll return back to
-	// the caller if we should finalize the batch immediately or not based
-	// on its preference.
-	return nil
+	// Now that we have the batch committed to disk, we'll return it back
+	// to the caller.
+	return batch, nil
 }
 
 // updateMintingProofs is called by the re-org watcher when it detects a re-org
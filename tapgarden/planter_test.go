@@ -12,9 +12,12 @@ import (
 
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/mempool"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
@@ -30,6 +33,8 @@ import (
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/ticker"
 	"github.com/stretchr/testify/require"
 )
@@ -307,7 +312,7 @@ func (t *mintingTestHarness) cancelMintingBatch(noBatch bool) *btcec.PublicKey {
 
 	batchKey, err := t.planter.CancelBatch()
 	if noBatch {
-		require.ErrorContains(t, err, "no pending batch")
+		require.NoError(t, err)
 		require.Nil(t, batchKey)
 		return nil
 	}
@@ -318,7 +323,7 @@ func (t *mintingTestHarness) cancelMintingBatch(noBatch bool) *btcec.PublicKey {
 	}
 
 	if err != nil {
-		require.ErrorContains(t, err, "batch not cancellable")
+		require.ErrorIs(t, err, tapgarden.ErrBatchAlreadyBroadcast)
 		require.NotNil(t, batchKey)
 		return batchKey
 	}
@@ -497,6 +502,15 @@ func (t *mintingTestHarness) assertSeedlingsMatchSprouts(
 		assetsByTag[asset.Genesis.Tag] = asset
 	}
 
+	// The genesis point is the first input of the minting transaction, and
+	// the assets are committed to in the output that isn't the change.
+	genesisPkt := pendingBatch.GenesisPacket
+	genesisPoint := genesisPkt.Pkt.UnsignedTx.TxIn[0].PreviousOutPoint
+	anchorOutputIndex := uint32(0)
+	if genesisPkt.ChangeOutputIndex == 0 {
+		anchorOutputIndex = 1
+	}
+
 	for _, seedling := range seedlings {
 		assetSprout, ok := assetsByTag[seedling.AssetName]
 		if !ok {
@@ -516,6 +530,21 @@ func (t *mintingTestHarness) assertSeedlingsMatchSprouts(
 		require.Equal(
 			t, seedling.EnableEmission, assetSprout.GroupKey != nil,
 		)
+
+		// The asset ID and group key previewed from the seedling must
+		// match the ones of the minted asset.
+		gen := seedling.Genesis(genesisPoint, anchorOutputIndex)
+		require.Equal(t, assetSprout.ID(), gen.ID())
+
+		if seedling.EnableEmission {
+			groupKey, err := tapgarden.NewGroupPubKey(
+				assetSprout.GroupKey.RawKey.PubKey, gen,
+			)
+			require.NoError(t, err)
+			require.True(t, groupKey.IsEqual(
+				&assetSprout.GroupKey.GroupPubKey,
+			))
+		}
 	}
 }
 
@@ -709,6 +738,252 @@ func testBasicAssetCreation(t *mintingTestHarness) {
 	t.assertNumCaretakersActive(0)
 }
 
+// testMintingFeeBump tests that the fee of a broadcast minting transaction can
+// be bumped, and that the batch is then finalized with the replacement
+// transaction.
+func testMintingFeeBump(t *mintingTestHarness) {
+	t.refreshChainPlanter()
+
+	// We'll first create a batch and take it all the way to broadcast.
+	const numSeedlings = 3
+	seedlings := t.newRandSeedlings(numSeedlings)
+	t.queueSeedlingsInBatch(seedlings...)
+
+	t.assertPendingBatchExists(numSeedlings)
+	batchKey := t.tickMintingBatch(false).BatchKey.PubKey
+	t.assertGenesisTxFunded()
+
+	for i := 0; i < numSeedlings; i++ {
+		t.assertKeyDerived()
+
+		if seedlings[i].EnableEmission {
+			t.assertKeyDerived()
+		}
+	}
+
+	t.assertGenesisPsbtFinalized()
+	tx := t.assertTxPublished()
+	t.assertConfReqSent(tx, nil)
+
+	// The minting transaction should signal replaceability.
+	for _, txIn := range tx.TxIn {
+		require.EqualValues(t, mempool.MaxRBFSequence, txIn.Sequence)
+	}
+
+	// A fee rate that doesn't exceed the current one by the minimum
+	// increment should be rejected.
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(tx))
+	var outputValue int64
+	for _, txOut := range tx.TxOut {
+		outputValue += txOut.Value
+	}
+	prevFee := tapgarden.MockInputValue - outputValue
+	prevFeeRate := chainfee.SatPerKWeight(prevFee * 1000 / weight)
+
+	_, err := t.planter.BumpBatchFee(batchKey, prevFeeRate)
+	require.ErrorContains(t, err, "below minimum")
+
+	// A fee rate above the minimum should result in the replacement
+	// transaction being published.
+	feeRate := prevFeeRate + tapgarden.MinFeeBumpIncrement
+	bumpResult := make(chan *chainhash.Hash, 1)
+	bumpErr := make(chan error, 1)
+	go func() {
+		txid, err := t.planter.BumpBatchFee(batchKey, feeRate)
+		bumpResult <- txid
+		bumpErr <- err
+	}()
+
+	_, err = fn.RecvOrTimeout(t.wallet.SignPsbtSignal, defaultTimeout)
+	require.NoError(t, err, "psbt sign req not sent")
+
+	// The caretaker should now wait for the replacement transaction to
+	// confirm. To ensure the proofs are constructed properly, we'll make a
+	// "fake" block that includes the replacement transaction.
+	replacementTx := t.assertTxPublished()
+	merkleTree := blockchain.BuildMerkleTreeStore(
+		[]*btcutil.Tx{btcutil.NewTx(replacementTx)}, false,
+	)
+	merkleRoot := merkleTree[len(merkleTree)-1]
+	blockHeader := wire.NewBlockHeader(
+		0, chaincfg.MainNetParams.GenesisHash, merkleRoot, 0, 0,
+	)
+	block := &wire.MsgBlock{
+		Header:       *blockHeader,
+		Transactions: []*wire.MsgTx{replacementTx},
+	}
+	sendConfNtfn := t.assertConfReqSent(replacementTx, block)
+
+	txid, err := <-bumpResult, <-bumpErr
+	require.NoError(t, err)
+	require.Equal(t, replacementTx.TxHash(), *txid)
+	require.Equal(t, tx.TxIn[0].PreviousOutPoint,
+		replacementTx.TxIn[0].PreviousOutPoint)
+	require.Equal(t, tx.TxOut[0], replacementTx.TxOut[0])
+
+	var replacementValue int64
+	for _, txOut := range replacementTx.TxOut {
+		replacementValue += txOut.Value
+	}
+	require.EqualValues(
+		t, feeRate.FeeForWeight(weight),
+		tapgarden.MockInputValue-replacementValue,
+	)
+
+	// The batch on disk should now refer to the replacement transaction.
+	batch, err := t.store.FetchMintingBatch(
+		context.Background(), batchKey,
+	)
+	require.NoError(t, err)
+	require.Equal(t, *txid, batch.GenesisPacket.Pkt.UnsignedTx.TxHash())
+
+	// We now confirm the replacement transaction, which should finalize
+	// the batch.
+	sendConfNtfn()
+
+	t.assertNoError()
+	t.assertNumCaretakersActive(0)
+	t.assertBatchState(batchKey, tapgarden.BatchStateFinalized)
+
+	// Once the batch is confirmed, the fee can no longer be bumped.
+	_, err = t.planter.BumpBatchFee(batchKey, feeRate*2)
+	require.ErrorIs(t, err, tapgarden.ErrBatchAlreadyConfirmed)
+}
+
+// mintPendingBatch takes the pending batch with the given seedlings all the
+// way to confirmation, and returns the assets that were minted.
+func (t *mintingTestHarness) mintPendingBatch(
+	seedlings ...*tapgarden.Seedling) []*asset.Asset {
+
+	t.Helper()
+
+	batchKey := t.tickMintingBatch(false).BatchKey.PubKey
+	t.assertGenesisTxFunded()
+
+	// We expect a script key to be derived for each seedling, and an
+	// additional group key for each seedling that creates a new group.
+	for i := range seedlings {
+		t.assertKeyDerived()
+
+		if seedlings[i].EnableEmission {
+			t.assertKeyDerived()
+		}
+	}
+
+	t.assertGenesisPsbtFinalized()
+	tx := t.assertTxPublished()
+
+	merkleTree := blockchain.BuildMerkleTreeStore(
+		[]*btcutil.Tx{btcutil.NewTx(tx)}, false,
+	)
+	merkleRoot := merkleTree[len(merkleTree)-1]
+	blockHeader := wire.NewBlockHeader(
+		0, chaincfg.MainNetParams.GenesisHash, merkleRoot, 0, 0,
+	)
+	block := &wire.MsgBlock{
+		Header:       *blockHeader,
+		Transactions: []*wire.MsgTx{tx},
+	}
+	sendConfNtfn := t.assertConfReqSent(tx, block)
+	sendConfNtfn()
+
+	t.assertNoError()
+	t.assertNumCaretakersActive(0)
+	t.assertBatchState(batchKey, tapgarden.BatchStateFinalized)
+
+	batch, err := t.store.FetchMintingBatch(context.Background(), batchKey)
+	require.NoError(t, err)
+
+	return batch.RootAssetCommitment.CommittedAssets()
+}
+
+// assertSeedlingRejected asserts that the given seedling is rejected by the
+// planter with the given error.
+func (t *mintingTestHarness) assertSeedlingRejected(
+	seedling *tapgarden.Seedling, expectedErr error) {
+
+	t.Helper()
+
+	updates, err := t.planter.QueueNewSeedling(seedling)
+	require.NoError(t, err)
+
+	update, err := fn.RecvOrTimeout(updates, defaultTimeout)
+	require.NoError(t, err)
+	require.ErrorIs(t, update.Error, expectedErr)
+}
+
+// testMintingGroupKeyReissuance tests that additional supply can be minted
+// into an existing asset group we control, and that attempts to mint into a
+// group we don't control are rejected.
+func testMintingGroupKeyReissuance(t *mintingTestHarness) {
+	t.refreshChainPlanter()
+
+	// We'll first mint a new asset with emission enabled, which creates a
+	// new asset group.
+	groupAnchor := &tapgarden.Seedling{
+		AssetVersion:   asset.V0,
+		AssetType:      asset.Normal,
+		AssetName:      "group-anchor",
+		Amount:         1000,
+		EnableEmission: true,
+	}
+	t.queueSeedlingsInBatch(groupAnchor)
+	t.assertPendingBatchExists(1)
+
+	mintedAssets := t.mintPendingBatch(groupAnchor)
+	require.Len(t, mintedAssets, 1)
+	require.NotNil(t, mintedAssets[0].GroupKey)
+
+	groupPubKey := mintedAssets[0].GroupKey.GroupPubKey
+	newGroupSeedling := func(name string,
+		groupKey btcec.PublicKey) *tapgarden.Seedling {
+
+		return &tapgarden.Seedling{
+			AssetVersion: asset.V0,
+			AssetType:    asset.Normal,
+			AssetName:    name,
+			Amount:       500,
+			GroupInfo: &asset.AssetGroup{
+				GroupKey: &asset.GroupKey{
+					GroupPubKey: groupKey,
+				},
+			},
+		}
+	}
+
+	// Minting into a group that doesn't exist should be rejected.
+	t.assertSeedlingRejected(
+		newGroupSeedling("unknown-group", *test.RandPubKey(t)),
+		sql.ErrNoRows,
+	)
+
+	// Minting into the existing group should result in a new asset with
+	// the same group key, but a new genesis.
+	reissuance := newGroupSeedling("reissuance", groupPubKey)
+	t.queueSeedlingsInBatch(reissuance)
+	t.assertPendingBatchExists(1)
+
+	reissuedAssets := t.mintPendingBatch(reissuance)
+	require.Len(t, reissuedAssets, 1)
+	require.NotNil(t, reissuedAssets[0].GroupKey)
+	require.True(t, groupPubKey.IsEqual(
+		&reissuedAssets[0].GroupKey.GroupPubKey,
+	))
+	require.NotEqual(t, mintedAssets[0].ID(), reissuedAssets[0].ID())
+	require.EqualValues(t, 500, reissuedAssets[0].Amount)
+
+	// If we no longer control the internal key of the group, then we
+	// can't produce a valid group witness, so minting into the group
+	// should be rejected.
+	rawGroupKey := mintedAssets[0].GroupKey.RawKey
+	delete(t.keyRing.Keys, rawGroupKey.KeyLocator)
+
+	t.assertSeedlingRejected(
+		newGroupSeedling("not-local", groupPubKey),
+		tapgarden.ErrGroupKeyNotLocal,
+	)
+}
+
 func testMintingTicker(t *mintingTestHarness) {
 	// First, create a new chain planter instance using the supplied test
 	// harness.
@@ -855,7 +1130,7 @@ func testMintingCancelFinalize(t *mintingTestHarness) {
 
 	// A single caretaker should have been launched as well. Next, assert
 	// that the caretaker has requested a genesis tx to be funded.
-	_ = t.assertGenesisTxFunded()
+	genesisPkt := t.assertGenesisTxFunded()
 	t.assertNumCaretakersActive(1)
 
 	// For each seedling created above, we expect a new set of keys to be
@@ -875,6 +1150,11 @@ func testMintingCancelFinalize(t *mintingTestHarness) {
 	t.assertNoPendingBatch()
 	t.assertBatchState(secondBatchKey, tapgarden.BatchStateSproutCancelled)
 
+	// The inputs the wallet leased to fund the minting transaction should
+	// have been released again.
+	require.NotEmpty(t, genesisPkt.LockedUTXOs)
+	require.Equal(t, genesisPkt.LockedUTXOs, t.wallet.ReleasedUTXOs())
+
 	// We can make another 5 random seedlings and continue with minting.
 	seedlings = t.newRandSeedlings(numSeedlings)
 	t.queueSeedlingsInBatch(seedlings...)
@@ -960,6 +1240,464 @@ func testMintingCancelFinalize(t *mintingTestHarness) {
 	t.assertNumCaretakersActive(0)
 }
 
+// testMintingNamedBatch tests that a named batch isn't frozen by the batch
+// ticker, survives a restart of the planter and can be finalized explicitly.
+func testMintingNamedBatch(t *mintingTestHarness) {
+	t.refreshChainPlanter()
+
+	// We'll queue a few seedlings into a named batch.
+	const (
+		numSeedlings = 3
+		batchName    = "named-batch"
+	)
+	seedlings := t.newRandSeedlings(numSeedlings)
+	for _, seedling := range seedlings {
+		seedling.BatchName = batchName
+	}
+	t.queueSeedlingsInBatch(seedlings...)
+	t.assertSeedlingsExist(seedlings, nil)
+
+	// The named batch isn't the regular pending batch, so a tick of the
+	// batch ticker shouldn't freeze it.
+	t.assertNoPendingBatch()
+	t.tickMintingBatch(true)
+	t.assertNumCaretakersActive(0)
+	t.assertBatchState(t.batchKey.PubKey, tapgarden.BatchStatePending)
+
+	// After a restart, the named batch should still be open and no
+	// caretaker should have been launched for it.
+	t.refreshChainPlanter()
+	t.assertNumCaretakersActive(0)
+	t.assertBatchState(t.batchKey.PubKey, tapgarden.BatchStatePending)
+
+	// Finalizing a batch with an unknown name should fail.
+	_, err := t.planter.FinalizeBatch("unknown", nil, nil)
+	require.ErrorContains(t, err, "no open batch named")
+
+	// Adding another seedling to the named batch should add it to the
+	// existing batch instead of creating a new one.
+	extraSeedling := t.newRandSeedlings(1)[0]
+	extraSeedling.BatchName = batchName
+	updates, err := t.planter.QueueNewSeedling(extraSeedling)
+	require.NoError(t, err)
+	update, err := fn.RecvOrTimeout(updates, defaultTimeout)
+	require.NoError(t, err)
+	require.NoError(t, update.Error)
+	require.Equal(t, batchName, update.PendingBatch.Name)
+	require.True(
+		t, t.batchKey.PubKey.IsEqual(
+			update.PendingBatch.BatchKey.PubKey,
+		),
+	)
+	seedlings = append(seedlings, extraSeedling)
+
+	// We can now finalize the named batch explicitly. The call only
+	// returns once the minting transaction was broadcast, so we'll make it
+	// in the background while we drive the caretaker forward.
+	type finalizeResult struct {
+		batch *tapgarden.MintingBatch
+		err   error
+	}
+	finalizeResp := make(chan finalizeResult, 1)
+	go func() {
+		batch, err := t.planter.FinalizeBatch(batchName, nil, nil)
+		finalizeResp <- finalizeResult{batch: batch, err: err}
+	}()
+
+	// The planter only serves other requests once the minting
+	// transaction was broadcast, so we can't query the number of active
+	// caretakers before that.
+	_ = t.assertGenesisTxFunded()
+
+	for i := range seedlings {
+		t.assertKeyDerived()
+
+		if seedlings[i].EnableEmission {
+			t.assertKeyDerived()
+		}
+	}
+
+	t.assertSeedlingsMatchSprouts(seedlings)
+	t.assertGenesisPsbtFinalized()
+	tx := t.assertTxPublished()
+
+	merkleTree := blockchain.BuildMerkleTreeStore(
+		[]*btcutil.Tx{btcutil.NewTx(tx)}, false,
+	)
+	merkleRoot := merkleTree[len(merkleTree)-1]
+	blockHeader := wire.NewBlockHeader(
+		0, chaincfg.MainNetParams.GenesisHash, merkleRoot, 0, 0,
+	)
+	block := &wire.MsgBlock{
+		Header:       *blockHeader,
+		Transactions: []*wire.MsgTx{tx},
+	}
+	sendConfNtfn := t.assertConfReqSent(tx, block)
+
+	resp, err := fn.RecvOrTimeout(finalizeResp, defaultTimeout)
+	require.NoError(t, err)
+	require.NoError(t, resp.err)
+	require.Equal(t, batchName, resp.batch.Name)
+
+	sendConfNtfn()
+
+	t.assertNoError()
+	t.assertNumCaretakersActive(0)
+	t.assertBatchState(t.batchKey.PubKey, tapgarden.BatchStateFinalized)
+
+	// Once finalized, the name can no longer be used to finalize a batch.
+	_, err = t.planter.FinalizeBatch(batchName, nil, nil)
+	require.ErrorContains(t, err, "no open batch named")
+}
+
+// testMintingGenesisPolicy tests that the genesis point of a batch is selected
+// according to the genesis point policy given when finalizing the batch.
+func testMintingGenesisPolicy(t *mintingTestHarness) {
+	t.refreshChainPlanter()
+
+	// The wallet has a few UTXOs, only two of which are large enough for
+	// our policy. The smallest of them should be selected.
+	utxos := []*lnwallet.Utxo{{
+		Value:         10_000,
+		Confirmations: 6,
+		OutPoint:      test.RandOp(t.T),
+	}, {
+		Value:         200_000,
+		Confirmations: 6,
+		OutPoint:      test.RandOp(t.T),
+	}, {
+		Value:         50_000,
+		Confirmations: 6,
+		OutPoint:      test.RandOp(t.T),
+	}}
+	t.wallet.WalletUtxos = utxos
+	genesisPoint := utxos[2].OutPoint
+
+	const (
+		numSeedlings = 2
+		batchName    = "genesis-policy"
+	)
+	seedlings := t.newRandSeedlings(numSeedlings)
+	for _, seedling := range seedlings {
+		seedling.BatchName = batchName
+	}
+	t.queueSeedlingsInBatch(seedlings...)
+	t.assertSeedlingsExist(seedlings, nil)
+
+	type finalizeResult struct {
+		batch *tapgarden.MintingBatch
+		err   error
+	}
+	finalizeResp := make(chan finalizeResult, 1)
+	go func() {
+		batch, err := t.planter.FinalizeBatch(
+			batchName, nil, &tapgarden.GenesisPointPolicy{
+				MinValue: 40_000,
+			},
+		)
+		finalizeResp <- finalizeResult{batch: batch, err: err}
+	}()
+
+	// The selected UTXO must be the only input of the minting
+	// transaction.
+	fundedPkt := t.assertGenesisTxFunded()
+	genesisTx := fundedPkt.Pkt.UnsignedTx
+	require.Len(t, genesisTx.TxIn, 1)
+	require.Equal(t, genesisPoint, genesisTx.TxIn[0].PreviousOutPoint)
+
+	for i := range seedlings {
+		t.assertKeyDerived()
+
+		if seedlings[i].EnableEmission {
+			t.assertKeyDerived()
+		}
+	}
+
+	t.assertSeedlingsMatchSprouts(seedlings)
+	t.assertGenesisPsbtFinalized()
+	tx := t.assertTxPublished()
+
+	merkleTree := blockchain.BuildMerkleTreeStore(
+		[]*btcutil.Tx{btcutil.NewTx(tx)}, false,
+	)
+	merkleRoot := merkleTree[len(merkleTree)-1]
+	blockHeader := wire.NewBlockHeader(
+		0, chaincfg.MainNetParams.GenesisHash, merkleRoot, 0, 0,
+	)
+	block := &wire.MsgBlock{
+		Header:       *blockHeader,
+		Transactions: []*wire.MsgTx{tx},
+	}
+	sendConfNtfn := t.assertConfReqSent(tx, block)
+
+	// All the assets of the batch must be derived from the selected
+	// genesis point.
+	resp, err := fn.RecvOrTimeout(finalizeResp, defaultTimeout)
+	require.NoError(t, err)
+	require.NoError(t, resp.err)
+	sprouts := resp.batch.RootAssetCommitment.CommittedAssets()
+	require.Len(t, sprouts, numSeedlings)
+	for _, sprout := range sprouts {
+		require.Equal(t, genesisPoint, sprout.Genesis.FirstPrevOut)
+	}
+
+	sendConfNtfn()
+
+	t.assertNoError()
+	t.assertNumCaretakersActive(0)
+	t.assertBatchState(t.batchKey.PubKey, tapgarden.BatchStateFinalized)
+}
+
+// testMintingHistory tests that the issuance history includes both cancelled
+// and minted batches, and that it can be filtered by state and creation time.
+func testMintingHistory(t *mintingTestHarness) {
+	t.refreshChainPlanter()
+
+	ctx := context.Background()
+
+	// We'll first cancel a batch before it is ever committed, and then
+	// mint a second one all the way to confirmation.
+	cancelledSeedlings := t.newRandSeedlings(1)
+	t.queueSeedlingsInBatch(cancelledSeedlings...)
+	t.assertPendingBatchExists(1)
+	cancelledKey := t.cancelMintingBatch(false)
+	t.assertBatchState(cancelledKey, tapgarden.BatchStateSeedlingCancelled)
+
+	seedlings := t.newRandSeedlings(2)
+	t.queueSeedlingsInBatch(seedlings...)
+	t.assertPendingBatchExists(2)
+	mintedAssets := t.mintPendingBatch(seedlings...)
+	require.Len(t, mintedAssets, 2)
+
+	// Both batches are part of the history, sorted by creation time. Only
+	// the minted batch has an anchor.
+	records, err := t.planter.ListMints(ctx, tapgarden.MintHistoryQuery{})
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	cancelled, minted := records[0], records[1]
+	require.True(t, cancelledKey.IsEqual(cancelled.Batch.BatchKey.PubKey))
+	require.Len(t, cancelled.Batch.Seedlings, 1)
+	require.Nil(t, cancelled.Anchor)
+
+	require.Equal(t, tapgarden.BatchStateFinalized, minted.Batch.State())
+	require.NotNil(t, minted.Anchor)
+	require.True(t, minted.Anchor.Confirmed())
+	require.Nil(t, minted.Anchor.TxMerkleProof)
+	require.Len(
+		t, minted.Batch.RootAssetCommitment.CommittedAssets(), 2,
+	)
+
+	// Filtering by state only returns the matching batch.
+	records, err = t.planter.ListMints(ctx, tapgarden.MintHistoryQuery{
+		States: []tapgarden.BatchState{
+			tapgarden.BatchStateSeedlingCancelled,
+			tapgarden.BatchStateSproutCancelled,
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.True(t, cancelledKey.IsEqual(records[0].Batch.BatchKey.PubKey))
+
+	// No batch was created before the first one, while the minted batch
+	// is the last one created.
+	records, err = t.planter.ListMints(ctx, tapgarden.MintHistoryQuery{
+		EndTime: cancelled.Batch.CreationTime.Add(-time.Second),
+	})
+	require.NoError(t, err)
+	require.Empty(t, records)
+
+	records, err = t.planter.ListMints(ctx, tapgarden.MintHistoryQuery{
+		StartTime: minted.Batch.CreationTime,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, records)
+	require.True(t, minted.Batch.BatchKey.PubKey.IsEqual(
+		records[len(records)-1].Batch.BatchKey.PubKey,
+	))
+}
+
+// testMintingExternalPsbt tests that a batch can be minted with a minting
+// transaction that is funded and signed by an external wallet.
+func testMintingExternalPsbt(t *mintingTestHarness) {
+	t.refreshChainPlanter()
+
+	const numSeedlings = 3
+	seedlings := t.newRandSeedlings(numSeedlings)
+	t.queueSeedlingsInBatch(seedlings...)
+	t.assertPendingBatchExists(numSeedlings)
+
+	// We'll now create the minting transaction as an external wallet
+	// would, with a change output and the anchor output of the batch.
+	changeInternalKey := test.RandPubKey(t)
+	changeScript, err := txscript.PayToTaprootScript(
+		txscript.ComputeTaprootKeyNoScript(changeInternalKey),
+	)
+	require.NoError(t, err)
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(t.T),
+	})
+	tx.AddTxOut(&wire.TxOut{
+		Value:    90_000,
+		PkScript: changeScript,
+	})
+	tx.AddTxOut(&wire.TxOut{
+		Value:    int64(tapgarden.GenesisAmtSats),
+		PkScript: tapscript.GenesisDummyScript[:],
+	})
+	fundedPkt, err := psbt.NewFromUnsignedTx(tx)
+	require.NoError(t, err)
+	fundedPkt.Inputs[0].WitnessUtxo = &wire.TxOut{
+		Value:    100_000,
+		PkScript: changeScript,
+	}
+
+	// An anchor output index that doesn't exist should be rejected
+	// without touching the batch, as should a P2TR change output that we
+	// can't create an exclusion proof for.
+	_, err = t.planter.CommitBatchPsbt("", fundedPkt, 2)
+	require.ErrorContains(t, err, "out of range")
+	_, err = t.planter.CommitBatchPsbt("", fundedPkt, 1)
+	require.ErrorContains(t, err, "must declare its BIP-0086 internal key")
+	t.assertPendingBatchExists(numSeedlings)
+
+	fundedPkt.Outputs[0].TaprootInternalKey = schnorr.SerializePubKey(
+		changeInternalKey,
+	)
+
+	// Committing the batch to the anchor output turns the seedlings into
+	// sprouts, so we'll make the call in the background while we serve
+	// the key derivation requests.
+	type commitResult struct {
+		batch *tapgarden.MintingBatch
+		err   error
+	}
+	commitResp := make(chan commitResult, 1)
+	go func() {
+		batch, err := t.planter.CommitBatchPsbt("", fundedPkt, 1)
+		commitResp <- commitResult{batch: batch, err: err}
+	}()
+
+	for i := range seedlings {
+		t.assertKeyDerived()
+
+		if seedlings[i].EnableEmission {
+			t.assertKeyDerived()
+		}
+	}
+
+	resp, err := fn.RecvOrTimeout(commitResp, defaultTimeout)
+	require.NoError(t, err)
+	require.NoError(t, resp.err)
+
+	// The anchor output should now commit to the assets of the batch,
+	// while the rest of the transaction is left untouched.
+	unsignedPkt := resp.batch.GenesisPacket.Pkt
+	require.True(t, txscript.IsPayToTaproot(
+		unsignedPkt.UnsignedTx.TxOut[1].PkScript,
+	))
+	require.Equal(t, changeScript, unsignedPkt.UnsignedTx.TxOut[0].PkScript)
+
+	require.Len(
+		t, resp.batch.RootAssetCommitment.CommittedAssets(),
+		numSeedlings,
+	)
+
+	t.assertNoPendingBatch()
+	t.assertNumCaretakersActive(0)
+	t.assertBatchState(
+		t.batchKey.PubKey, tapgarden.BatchStateAwaitingSignature,
+	)
+
+	// The batch should still await its signature after a restart.
+	t.refreshChainPlanter()
+	t.assertNumCaretakersActive(0)
+	t.assertBatchState(
+		t.batchKey.PubKey, tapgarden.BatchStateAwaitingSignature,
+	)
+
+	// copyPkt returns a signed copy of the committed minting
+	// transaction.
+	copyPkt := func() *psbt.Packet {
+		var b bytes.Buffer
+		require.NoError(t, unsignedPkt.Serialize(&b))
+
+		signedPkt, err := psbt.NewFromRawBytes(&b, false)
+		require.NoError(t, err)
+
+		var witness bytes.Buffer
+		err = psbt.WriteTxWitness(
+			&witness, wire.TxWitness{bytes.Repeat([]byte{1}, 64)},
+		)
+		require.NoError(t, err)
+		signedPkt.Inputs[0].FinalScriptWitness = witness.Bytes()
+
+		return signedPkt
+	}
+
+	// A signed transaction that spends the batch differently than what
+	// it was committed to should be rejected.
+	tamperedPkt := copyPkt()
+	tamperedPkt.UnsignedTx.TxOut[0].Value -= 1_000
+	_, err = t.planter.PublishBatchPsbt(t.batchKey.PubKey, tamperedPkt)
+	require.ErrorContains(t, err, "doesn't match committed")
+
+	tamperedPkt = copyPkt()
+	tamperedPkt.UnsignedTx.TxOut[1].PkScript = changeScript
+	_, err = t.planter.PublishBatchPsbt(t.batchKey.PubKey, tamperedPkt)
+	require.ErrorContains(t, err, "doesn't preserve the anchor output")
+
+	t.assertBatchState(
+		t.batchKey.PubKey, tapgarden.BatchStateAwaitingSignature,
+	)
+
+	// We'll now hand back the signed minting transaction. As the packet
+	// is already fully signed, our wallet isn't asked to sign it, and the
+	// transaction is broadcast right away.
+	publishResp := make(chan commitResult, 1)
+	go func() {
+		batch, err := t.planter.PublishBatchPsbt(
+			t.batchKey.PubKey, copyPkt(),
+		)
+		publishResp <- commitResult{batch: batch, err: err}
+	}()
+
+	_, err = fn.RecvOrTimeout(t.wallet.ImportPubKeySignal, defaultTimeout)
+	require.NoError(t, err)
+
+	tx = t.assertTxPublished()
+	require.Equal(t, unsignedPkt.UnsignedTx.TxHash(), tx.TxHash())
+
+	merkleTree := blockchain.BuildMerkleTreeStore(
+		[]*btcutil.Tx{btcutil.NewTx(tx)}, false,
+	)
+	merkleRoot := merkleTree[len(merkleTree)-1]
+	blockHeader := wire.NewBlockHeader(
+		0, chaincfg.MainNetParams.GenesisHash, merkleRoot, 0, 0,
+	)
+	block := &wire.MsgBlock{
+		Header:       *blockHeader,
+		Transactions: []*wire.MsgTx{tx},
+	}
+	sendConfNtfn := t.assertConfReqSent(tx, block)
+
+	resp, err = fn.RecvOrTimeout(publishResp, defaultTimeout)
+	require.NoError(t, err)
+	require.NoError(t, resp.err)
+
+	sendConfNtfn()
+
+	t.assertNoError()
+	t.assertNumCaretakersActive(0)
+	t.assertBatchState(t.batchKey.PubKey, tapgarden.BatchStateFinalized)
+
+	// The batch can't be published a second time.
+	_, err = t.planter.PublishBatchPsbt(t.batchKey.PubKey, copyPkt())
+	require.ErrorContains(t, err, "awaiting a signed minting transaction")
+}
+
 // mintingStoreTestCase is used to programmatically run a series of test cases
 // that are parametrized based on a fresh minting store.
 type mintingStoreTestCase struct {
@@ -985,6 +1723,36 @@ var testCases = []mintingStoreTestCase{
 		interval: minterInterval,
 		testFunc: testMintingCancelFinalize,
 	},
+	{
+		name:     "minting_fee_bump",
+		interval: defaultInterval,
+		testFunc: testMintingFeeBump,
+	},
+	{
+		name:     "minting_group_key_reissuance",
+		interval: defaultInterval,
+		testFunc: testMintingGroupKeyReissuance,
+	},
+	{
+		name:     "minting_named_batch",
+		interval: minterInterval,
+		testFunc: testMintingNamedBatch,
+	},
+	{
+		name:     "minting_external_psbt",
+		interval: defaultInterval,
+		testFunc: testMintingExternalPsbt,
+	},
+	{
+		name:     "minting_genesis_policy",
+		interval: minterInterval,
+		testFunc: testMintingGenesisPolicy,
+	},
+	{
+		name:     "minting_history",
+		interval: defaultInterval,
+		testFunc: testMintingHistory,
+	},
 }
 
 // TestBatchedAssetIssuance runs a test of tests to ensure that the set of
@@ -1,10 +1,17 @@
 package tapgarden
 
 import (
+	"bytes"
 	"fmt"
+	"math"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/keychain"
 )
 
 var (
@@ -17,8 +24,57 @@ var (
 	// ErrInvalidAssetAmt is returned in an asset request has an invalid
 	// amount.
 	ErrInvalidAssetAmt = fmt.Errorf("asset amt cannot be zero")
+
+	// ErrInvalidCollectibleAmt is returned if an asset request for a
+	// collectible has an amount other than one.
+	ErrInvalidCollectibleAmt = fmt.Errorf("collectible asset amt must " +
+		"be one")
+
+	// ErrGroupKeyNotLocal is returned if an asset request specifies the
+	// group key of an asset group whose internal key isn't controlled by
+	// the local wallet.
+	ErrGroupKeyNotLocal = fmt.Errorf("group key not controlled by local " +
+		"wallet")
+
+	// ErrInvalidEmissionSchedule is returned if an asset request specifies
+	// an emission schedule that can't be honored.
+	ErrInvalidEmissionSchedule = fmt.Errorf("invalid emission schedule")
+
+	// ErrInvalidScriptKey is returned if an asset request specifies a
+	// custom script key that isn't well-formed.
+	ErrInvalidScriptKey = fmt.Errorf("invalid script key")
+
+	// ErrInvalidGroupInternalKey is returned if an asset request specifies
+	// a custom group internal key that can't be used.
+	ErrInvalidGroupInternalKey = fmt.Errorf("invalid group internal key")
+
+	// ErrInvalidAssetLabel is returned if an asset request specifies a
+	// label that is too long.
+	ErrInvalidAssetLabel = fmt.Errorf("invalid asset label")
+
+	// ErrInvalidProofCourierAddr is returned if an asset request specifies
+	// a proof courier address that isn't a well-formed courier URL.
+	ErrInvalidProofCourierAddr = fmt.Errorf("invalid proof courier " +
+		"address")
+)
+
+const (
+	// MaxAssetLabelLength is the maximum byte length of the label of an
+	// asset.
+	MaxAssetLabelLength = 256
 )
 
+// ValidateAssetLabel makes sure the given asset label isn't too long. An empty
+// label is valid, it means the asset isn't labeled.
+func ValidateAssetLabel(label string) error {
+	if len(label) > MaxAssetLabelLength {
+		return fmt.Errorf("%w: label exceeds %d bytes",
+			ErrInvalidAssetLabel, MaxAssetLabelLength)
+	}
+
+	return nil
+}
+
 // MintingState is an enum that tracks an asset through the various minting
 // stages.
 type MintingState uint8
@@ -62,6 +118,17 @@ type SeedlingUpdate struct {
 // seedling back to the caller.
 type SeedlingUpdates chan SeedlingUpdate
 
+// EmissionEvent describes an amount of additional units of an asset that will
+// be issued into the asset group once a certain block height is reached.
+type EmissionEvent struct {
+	// BlockHeight is the block height at which the additional units are
+	// issued.
+	BlockHeight uint32
+
+	// Amount is the number of additional units to issue.
+	Amount uint64
+}
+
 // Seedling is an adolescent Taproot asset that will one day bloom into a fully
 // grown plant.
 type Seedling struct {
@@ -93,6 +160,37 @@ type Seedling struct {
 	// same group key as the anchor asset.
 	GroupAnchor *string
 
+	// EmissionSchedule is the set of future issuance events of the asset.
+	// Once the block height of an event is reached, the additional units
+	// are issued into the asset group automatically.
+	EmissionSchedule []EmissionEvent
+
+	// ScriptKey is an optional script key the asset will be locked to. If
+	// this isn't set, a new BIP-0086 script key is derived from the
+	// wallet once the batch is finalized.
+	ScriptKey *asset.ScriptKey
+
+	// GroupInternalKey is an optional internal key for the new asset group
+	// of the asset, which can only be set if emission is enabled. If this
+	// isn't set, a new internal key is derived from the wallet once the
+	// batch is finalized.
+	GroupInternalKey *keychain.KeyDescriptor
+
+	// Label is an optional human-readable label of the asset. The label is
+	// only stored locally and isn't committed to on-chain.
+	Label string
+
+	// ProofCourierAddr is an optional proof courier address that is
+	// embedded in addresses created for the asset, unless another address
+	// is specified when creating the address. The address is only stored
+	// locally and isn't committed to on-chain.
+	ProofCourierAddr string
+
+	// BatchName is the optional name of the named batch the seedling is
+	// added to. The named batch is created if it doesn't exist yet. If
+	// this isn't set, the seedling is added to the regular pending batch.
+	BatchName string
+
 	// update is used to send updates w.r.t the state of the batch.
 	updates SeedlingUpdates
 }
@@ -114,24 +212,152 @@ func (c Seedling) validateFields() error {
 	//
 	// TODO(roasbeef): lift into new constant?
 	case c.AssetType != asset.Normal && c.AssetType != asset.Collectible:
-		return fmt.Errorf("%v: %v", int(c.AssetType),
+		return fmt.Errorf("%v: %w", int(c.AssetType),
 			ErrInvalidAssetType)
 
 	// Creating an asset with zero available supply is not allowed.
 	case c.Amount == 0:
 		return ErrInvalidAssetAmt
+
+	// Collectibles are unique, so exactly one unit must be created.
+	case c.AssetType == asset.Collectible && c.Amount != 1:
+		return ErrInvalidCollectibleAmt
+
+	// A custom group internal key is only used when creating a new asset
+	// group.
+	case c.GroupInternalKey != nil && !c.EnableEmission:
+		return fmt.Errorf("%w: emission must be enabled",
+			ErrInvalidGroupInternalKey)
+	}
+
+	if err := ValidateAssetLabel(c.Label); err != nil {
+		return err
+	}
+
+	if c.ProofCourierAddr != "" {
+		_, err := proof.ParseCourierAddrString(c.ProofCourierAddr)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidProofCourierAddr,
+				err)
+		}
+	}
+
+	if err := c.validateScriptKey(); err != nil {
+		return err
+	}
+
+	return c.validateEmissionSchedule()
+}
+
+// validateScriptKey makes sure that the custom script key of the seedling, if
+// any, is well-formed. The internal key of the script key must be known, so
+// the minted asset can be tracked and later spent.
+func (c Seedling) validateScriptKey() error {
+	if c.ScriptKey == nil {
+		return nil
+	}
+
+	tweakedKey := c.ScriptKey.TweakedScriptKey
+	switch {
+	case c.ScriptKey.PubKey == nil:
+		return fmt.Errorf("%w: missing public key", ErrInvalidScriptKey)
+
+	case tweakedKey == nil || tweakedKey.RawKey.PubKey == nil:
+		return fmt.Errorf("%w: missing internal key",
+			ErrInvalidScriptKey)
+	}
+
+	// The script key must be the result of applying the tweak to the
+	// internal key, or the BIP-0086 tweak if there is no tweak.
+	rawKey := tweakedKey.RawKey.PubKey
+	expectedKey := txscript.ComputeTaprootKeyNoScript(rawKey)
+	if len(tweakedKey.Tweak) > 0 {
+		expectedKey = txscript.ComputeTaprootOutputKey(
+			rawKey, tweakedKey.Tweak,
+		)
+	}
+
+	if !bytes.Equal(
+		schnorr.SerializePubKey(expectedKey),
+		schnorr.SerializePubKey(c.ScriptKey.PubKey),
+	) {
+
+		return fmt.Errorf("%w: key doesn't match tweaked internal key",
+			ErrInvalidScriptKey)
+	}
+
+	return nil
+}
+
+// validateEmissionSchedule makes sure that the emission schedule of the
+// seedling, if any, can be honored.
+func (c Seedling) validateEmissionSchedule() error {
+	if len(c.EmissionSchedule) == 0 {
+		return nil
+	}
+
+	// Additional units can only be issued into an asset group, and only
+	// normal assets have a supply that can be extended.
+	switch {
+	case c.AssetType != asset.Normal:
+		return fmt.Errorf("%w: only normal assets can have an "+
+			"emission schedule", ErrInvalidEmissionSchedule)
+
+	case !c.EnableEmission && c.GroupInfo == nil && c.GroupAnchor == nil:
+		return fmt.Errorf("%w: asset must be part of an asset group",
+			ErrInvalidEmissionSchedule)
+	}
+
+	totalAmount := c.Amount
+	heights := make(map[uint32]struct{}, len(c.EmissionSchedule))
+	for _, event := range c.EmissionSchedule {
+		if event.Amount == 0 {
+			return fmt.Errorf("%w: zero amount at height %d",
+				ErrInvalidEmissionSchedule, event.BlockHeight)
+		}
+
+		if event.Amount > math.MaxUint64-totalAmount {
+			return fmt.Errorf("%w: total amount overflows",
+				ErrInvalidEmissionSchedule)
+		}
+		totalAmount += event.Amount
+
+		if _, ok := heights[event.BlockHeight]; ok {
+			return fmt.Errorf("%w: duplicate height %d",
+				ErrInvalidEmissionSchedule, event.BlockHeight)
+		}
+		heights[event.BlockHeight] = struct{}{}
 	}
 
 	return nil
 }
 
+// issuePastEmissions adds the amounts of all emission events with a block
+// height that was already reached to the amount of the seedling, so they're
+// issued immediately as part of the genesis. Those events are removed from
+// the emission schedule.
+func (c *Seedling) issuePastEmissions(currentHeight uint32) {
+	var futureEvents []EmissionEvent
+	for _, event := range c.EmissionSchedule {
+		if event.BlockHeight > currentHeight {
+			futureEvents = append(futureEvents, event)
+			continue
+		}
+
+		c.Amount += event.Amount
+	}
+
+	c.EmissionSchedule = futureEvents
+}
+
 // validateGroupKey attempts to validate that the non-zero group key provided
 // with a seedling is owned by the daemon and can be used with this seedling.
 func (c Seedling) validateGroupKey(group asset.AssetGroup) error {
 	// We must be able to sign with the group key.
 	if !group.GroupKey.IsLocal() {
 		groupKeyBytes := c.GroupInfo.GroupPubKey.SerializeCompressed()
-		return fmt.Errorf("can't sign with group key %x", groupKeyBytes)
+		return fmt.Errorf("can't sign with group key %x: %w",
+			groupKeyBytes, ErrGroupKeyNotLocal)
 	}
 
 	// The seedling asset type must match the group asset type.
@@ -148,6 +374,39 @@ func (c Seedling) HasGroupKey() bool {
 	return c.GroupInfo != nil && c.GroupInfo.GroupKey != nil
 }
 
+// Genesis returns the genesis of the asset that is minted for the seedling,
+// given the genesis point of the minting transaction and the index of the
+// output that commits to the assets of the batch. The asset ID derived from
+// the genesis commits to the genesis point, so a different genesis point
+// results in a different asset ID.
+func (c Seedling) Genesis(genesisPoint wire.OutPoint,
+	anchorOutputIndex uint32) asset.Genesis {
+
+	assetGen := asset.Genesis{
+		FirstPrevOut: genesisPoint,
+		Tag:          c.AssetName,
+		OutputIndex:  anchorOutputIndex,
+		Type:         c.AssetType,
+	}
+
+	// If the seedling has a meta data reveal set, then we'll bind that by
+	// including the hash of the meta data in the asset genesis.
+	if c.Meta != nil {
+		assetGen.MetaHash = c.Meta.MetaHash()
+	}
+
+	return assetGen
+}
+
+// NewGroupPubKey returns the tweaked key of a new asset group with the given
+// internal key, that is anchored by the asset with the given genesis.
+func NewGroupPubKey(internalKey *btcec.PublicKey,
+	anchorGen asset.Genesis) (*btcec.PublicKey, error) {
+
+	genesisTweak := anchorGen.ID()
+	return asset.GroupPubKey(internalKey, genesisTweak[:], nil)
+}
+
 // String returns a human-readable representation for the AssetSeedling.
 func (c Seedling) String() string {
 	return fmt.Sprintf("AssetSeedling(name=%v, type=%v, amt=%v, "+
@@ -0,0 +1,273 @@
+package tapgarden
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSeedlingValidateFields tests that seedlings with an invalid type,
+// amount, label or proof courier address are rejected.
+func TestSeedlingValidateFields(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		assetType   asset.Type
+		amount      uint64
+		label       string
+		courierAddr string
+		err         error
+	}{{
+		name:      "normal asset",
+		assetType: asset.Normal,
+		amount:    1000,
+	}, {
+		name:      "normal asset zero amount",
+		assetType: asset.Normal,
+		amount:    0,
+		err:       ErrInvalidAssetAmt,
+	}, {
+		name:      "collectible",
+		assetType: asset.Collectible,
+		amount:    1,
+	}, {
+		name:      "collectible zero amount",
+		assetType: asset.Collectible,
+		amount:    0,
+		err:       ErrInvalidAssetAmt,
+	}, {
+		name:      "collectible amount above one",
+		assetType: asset.Collectible,
+		amount:    2,
+		err:       ErrInvalidCollectibleAmt,
+	}, {
+		name:      "unknown asset type",
+		assetType: asset.Type(2),
+		amount:    1,
+		err:       ErrInvalidAssetType,
+	}, {
+		name:      "max length label",
+		assetType: asset.Normal,
+		amount:    1000,
+		label:     strings.Repeat("a", MaxAssetLabelLength),
+	}, {
+		name:      "label too long",
+		assetType: asset.Normal,
+		amount:    1000,
+		label:     strings.Repeat("a", MaxAssetLabelLength+1),
+		err:       ErrInvalidAssetLabel,
+	}, {
+		name:        "proof courier address",
+		assetType:   asset.Normal,
+		amount:      1000,
+		courierAddr: "universerpc://localhost:10029",
+	}, {
+		name:        "proof courier address without scheme",
+		assetType:   asset.Normal,
+		amount:      1000,
+		courierAddr: "localhost:10029",
+		err:         ErrInvalidProofCourierAddr,
+	}, {
+		name:        "proof courier address unknown scheme",
+		assetType:   asset.Normal,
+		amount:      1000,
+		courierAddr: "https://localhost:10029",
+		err:         ErrInvalidProofCourierAddr,
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			seedling := Seedling{
+				AssetType:        testCase.assetType,
+				AssetName:        "test-asset",
+				Amount:           testCase.amount,
+				Label:            testCase.label,
+				ProofCourierAddr: testCase.courierAddr,
+			}
+
+			err := seedling.validateFields()
+			require.ErrorIs(t, err, testCase.err)
+		})
+	}
+}
+
+// TestSeedlingEmissionSchedule tests that invalid emission schedules are
+// rejected, and that emission events with a block height that was already
+// reached are added to the amount of the seedling.
+func TestSeedlingEmissionSchedule(t *testing.T) {
+	t.Parallel()
+
+	anchorName := "anchor"
+	testCases := []struct {
+		name     string
+		seedling Seedling
+		err      error
+	}{{
+		name: "new group",
+		seedling: Seedling{
+			AssetType:      asset.Normal,
+			EnableEmission: true,
+		},
+	}, {
+		name: "group anchor",
+		seedling: Seedling{
+			AssetType:   asset.Normal,
+			GroupAnchor: &anchorName,
+		},
+	}, {
+		name: "not grouped",
+		seedling: Seedling{
+			AssetType: asset.Normal,
+		},
+		err: ErrInvalidEmissionSchedule,
+	}, {
+		name: "collectible",
+		seedling: Seedling{
+			AssetType:      asset.Collectible,
+			EnableEmission: true,
+		},
+		err: ErrInvalidEmissionSchedule,
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			seedling := testCase.seedling
+			seedling.AssetName = "test-asset"
+			seedling.Amount = 1
+			seedling.EmissionSchedule = []EmissionEvent{{
+				BlockHeight: 100,
+				Amount:      10,
+			}}
+
+			err := seedling.validateFields()
+			require.ErrorIs(t, err, testCase.err)
+		})
+	}
+
+	seedling := Seedling{
+		AssetType:      asset.Normal,
+		AssetName:      "test-asset",
+		Amount:         1000,
+		EnableEmission: true,
+	}
+
+	// Events without an amount or with a duplicate height are rejected.
+	seedling.EmissionSchedule = []EmissionEvent{{BlockHeight: 100}}
+	err := seedling.validateFields()
+	require.ErrorIs(t, err, ErrInvalidEmissionSchedule)
+
+	seedling.EmissionSchedule = []EmissionEvent{{
+		BlockHeight: 100,
+		Amount:      10,
+	}, {
+		BlockHeight: 100,
+		Amount:      20,
+	}}
+	err = seedling.validateFields()
+	require.ErrorIs(t, err, ErrInvalidEmissionSchedule)
+
+	// Events with a height that was already reached are added to the
+	// amount of the initial issuance.
+	seedling.EmissionSchedule = []EmissionEvent{{
+		BlockHeight: 100,
+		Amount:      10,
+	}, {
+		BlockHeight: 200,
+		Amount:      20,
+	}, {
+		BlockHeight: 300,
+		Amount:      30,
+	}}
+	require.NoError(t, seedling.validateFields())
+
+	seedling.issuePastEmissions(200)
+	require.EqualValues(t, 1030, seedling.Amount)
+	require.Equal(t, []EmissionEvent{{
+		BlockHeight: 300,
+		Amount:      30,
+	}}, seedling.EmissionSchedule)
+}
+
+// TestSeedlingCustomKeys tests that custom script keys must be well-formed and
+// that a custom group internal key can only be used for a new asset group.
+func TestSeedlingCustomKeys(t *testing.T) {
+	t.Parallel()
+
+	rawKey := keychain.KeyDescriptor{
+		PubKey: test.RandPubKey(t),
+	}
+	bip86Key := asset.NewScriptKeyBip86(rawKey)
+
+	tweak := test.RandBytes(32)
+	tweakedKey := asset.NewScriptKey(
+		txscript.ComputeTaprootOutputKey(rawKey.PubKey, tweak),
+	)
+	tweakedKey.TweakedScriptKey = &asset.TweakedScriptKey{
+		RawKey: rawKey,
+		Tweak:  tweak,
+	}
+
+	wrongTweakKey := tweakedKey
+	wrongTweakKey.TweakedScriptKey = &asset.TweakedScriptKey{
+		RawKey: rawKey,
+		Tweak:  test.RandBytes(32),
+	}
+
+	testCases := []struct {
+		name             string
+		scriptKey        *asset.ScriptKey
+		groupInternalKey *keychain.KeyDescriptor
+		enableEmission   bool
+		err              error
+	}{{
+		name:      "bip86 script key",
+		scriptKey: &bip86Key,
+	}, {
+		name:      "tweaked script key",
+		scriptKey: &tweakedKey,
+	}, {
+		name:      "script key without internal key",
+		scriptKey: &asset.ScriptKey{PubKey: bip86Key.PubKey},
+		err:       ErrInvalidScriptKey,
+	}, {
+		name:      "script key with wrong tweak",
+		scriptKey: &wrongTweakKey,
+		err:       ErrInvalidScriptKey,
+	}, {
+		name:             "group internal key",
+		groupInternalKey: &rawKey,
+		enableEmission:   true,
+	}, {
+		name:             "group internal key without emission",
+		groupInternalKey: &rawKey,
+		err:              ErrInvalidGroupInternalKey,
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			seedling := Seedling{
+				AssetType:        asset.Normal,
+				AssetName:        "test-asset",
+				Amount:           1,
+				EnableEmission:   testCase.enableEmission,
+				ScriptKey:        testCase.scriptKey,
+				GroupInternalKey: testCase.groupInternalKey,
+			}
+
+			err := seedling.validateFields()
+			require.ErrorIs(t, err, testCase.err)
+		})
+	}
+}
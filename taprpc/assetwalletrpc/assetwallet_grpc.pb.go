@@ -38,6 +38,12 @@ type AssetWalletClient interface {
 	// the NextScriptKey RPC instead, to make sure the tweaked Taproot output key
 	// is also recognized as a local key.
 	NextInternalKey(ctx context.Context, in *NextInternalKeyRequest, opts ...grpc.CallOption) (*NextInternalKeyResponse, error)
+	// ListInternalKeys lists the internal keys that were derived by the asset
+	// wallet, along with their key family and index. These are the keys used as
+	// internal keys of anchor outputs and of script keys. The keys are sorted by
+	// their key family and index, which allows external coordination and backup
+	// of the key hierarchy.
+	ListInternalKeys(ctx context.Context, in *ListInternalKeysRequest, opts ...grpc.CallOption) (*ListInternalKeysResponse, error)
 	// NextScriptKey derives the next script key (and its corresponding internal
 	// key) and stores them both in the database to make sure they are identified
 	// as local keys later on when importing proofs.
@@ -50,6 +56,17 @@ type AssetWalletClient interface {
 	// VerifyAssetOwnership verifies the asset ownership proof embedded in the
 	// given transition proof of an asset and returns true if the proof is valid.
 	VerifyAssetOwnership(ctx context.Context, in *VerifyAssetOwnershipRequest, opts ...grpc.CallOption) (*VerifyAssetOwnershipResponse, error)
+	// tapcli: `assets signmessage`
+	// SignAssetMessage signs an arbitrary message with the script key of an owned
+	// asset, to prove control of the asset off-chain, for example to log in to a
+	// web service. The signature is a 64-byte schnorr signature over the
+	// BIP-0322 virtual transaction that commits to the message.
+	SignAssetMessage(ctx context.Context, in *SignAssetMessageRequest, opts ...grpc.CallOption) (*SignAssetMessageResponse, error)
+	// tapcli: `assets verifymessage`
+	// VerifyAssetMessage verifies that a message was signed with the given
+	// script key. It can optionally also confirm that the script key currently
+	// controls a non-zero balance of assets known to this node.
+	VerifyAssetMessage(ctx context.Context, in *VerifyAssetMessageRequest, opts ...grpc.CallOption) (*VerifyAssetMessageResponse, error)
 	// RemoveUTXOLease removes the lease/lock/reservation of the given managed
 	// UTXO.
 	RemoveUTXOLease(ctx context.Context, in *RemoveUTXOLeaseRequest, opts ...grpc.CallOption) (*RemoveUTXOLeaseResponse, error)
@@ -99,6 +116,15 @@ func (c *assetWalletClient) NextInternalKey(ctx context.Context, in *NextInterna
 	return out, nil
 }
 
+func (c *assetWalletClient) ListInternalKeys(ctx context.Context, in *ListInternalKeysRequest, opts ...grpc.CallOption) (*ListInternalKeysResponse, error) {
+	out := new(ListInternalKeysResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/ListInternalKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *assetWalletClient) NextScriptKey(ctx context.Context, in *NextScriptKeyRequest, opts ...grpc.CallOption) (*NextScriptKeyResponse, error) {
 	out := new(NextScriptKeyResponse)
 	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/NextScriptKey", in, out, opts...)
@@ -126,6 +152,24 @@ func (c *assetWalletClient) VerifyAssetOwnership(ctx context.Context, in *Verify
 	return out, nil
 }
 
+func (c *assetWalletClient) SignAssetMessage(ctx context.Context, in *SignAssetMessageRequest, opts ...grpc.CallOption) (*SignAssetMessageResponse, error) {
+	out := new(SignAssetMessageResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/SignAssetMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) VerifyAssetMessage(ctx context.Context, in *VerifyAssetMessageRequest, opts ...grpc.CallOption) (*VerifyAssetMessageResponse, error) {
+	out := new(VerifyAssetMessageResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/VerifyAssetMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *assetWalletClient) RemoveUTXOLease(ctx context.Context, in *RemoveUTXOLeaseRequest, opts ...grpc.CallOption) (*RemoveUTXOLeaseResponse, error) {
 	out := new(RemoveUTXOLeaseResponse)
 	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/RemoveUTXOLease", in, out, opts...)
@@ -158,6 +202,12 @@ type AssetWalletServer interface {
 	// the NextScriptKey RPC instead, to make sure the tweaked Taproot output key
 	// is also recognized as a local key.
 	NextInternalKey(context.Context, *NextInternalKeyRequest) (*NextInternalKeyResponse, error)
+	// ListInternalKeys lists the internal keys that were derived by the asset
+	// wallet, along with their key family and index. These are the keys used as
+	// internal keys of anchor outputs and of script keys. The keys are sorted by
+	// their key family and index, which allows external coordination and backup
+	// of the key hierarchy.
+	ListInternalKeys(context.Context, *ListInternalKeysRequest) (*ListInternalKeysResponse, error)
 	// NextScriptKey derives the next script key (and its corresponding internal
 	// key) and stores them both in the database to make sure they are identified
 	// as local keys later on when importing proofs.
@@ -170,6 +220,17 @@ type AssetWalletServer interface {
 	// VerifyAssetOwnership verifies the asset ownership proof embedded in the
 	// given transition proof of an asset and returns true if the proof is valid.
 	VerifyAssetOwnership(context.Context, *VerifyAssetOwnershipRequest) (*VerifyAssetOwnershipResponse, error)
+	// tapcli: `assets signmessage`
+	// SignAssetMessage signs an arbitrary message with the script key of an owned
+	// asset, to prove control of the asset off-chain, for example to log in to a
+	// web service. The signature is a 64-byte schnorr signature over the
+	// BIP-0322 virtual transaction that commits to the message.
+	SignAssetMessage(context.Context, *SignAssetMessageRequest) (*SignAssetMessageResponse, error)
+	// tapcli: `assets verifymessage`
+	// VerifyAssetMessage verifies that a message was signed with the given
+	// script key. It can optionally also confirm that the script key currently
+	// controls a non-zero balance of assets known to this node.
+	VerifyAssetMessage(context.Context, *VerifyAssetMessageRequest) (*VerifyAssetMessageResponse, error)
 	// RemoveUTXOLease removes the lease/lock/reservation of the given managed
 	// UTXO.
 	RemoveUTXOLease(context.Context, *RemoveUTXOLeaseRequest) (*RemoveUTXOLeaseResponse, error)
@@ -192,6 +253,9 @@ func (UnimplementedAssetWalletServer) AnchorVirtualPsbts(context.Context, *Ancho
 func (UnimplementedAssetWalletServer) NextInternalKey(context.Context, *NextInternalKeyRequest) (*NextInternalKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NextInternalKey not implemented")
 }
+func (UnimplementedAssetWalletServer) ListInternalKeys(context.Context, *ListInternalKeysRequest) (*ListInternalKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInternalKeys not implemented")
+}
 func (UnimplementedAssetWalletServer) NextScriptKey(context.Context, *NextScriptKeyRequest) (*NextScriptKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NextScriptKey not implemented")
 }
@@ -201,6 +265,12 @@ func (UnimplementedAssetWalletServer) ProveAssetOwnership(context.Context, *Prov
 func (UnimplementedAssetWalletServer) VerifyAssetOwnership(context.Context, *VerifyAssetOwnershipRequest) (*VerifyAssetOwnershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyAssetOwnership not implemented")
 }
+func (UnimplementedAssetWalletServer) SignAssetMessage(context.Context, *SignAssetMessageRequest) (*SignAssetMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignAssetMessage not implemented")
+}
+func (UnimplementedAssetWalletServer) VerifyAssetMessage(context.Context, *VerifyAssetMessageRequest) (*VerifyAssetMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAssetMessage not implemented")
+}
 func (UnimplementedAssetWalletServer) RemoveUTXOLease(context.Context, *RemoveUTXOLeaseRequest) (*RemoveUTXOLeaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveUTXOLease not implemented")
 }
@@ -289,6 +359,24 @@ func _AssetWallet_NextInternalKey_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AssetWallet_ListInternalKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInternalKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).ListInternalKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/ListInternalKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).ListInternalKeys(ctx, req.(*ListInternalKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AssetWallet_NextScriptKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NextScriptKeyRequest)
 	if err := dec(in); err != nil {
@@ -343,6 +431,42 @@ func _AssetWallet_VerifyAssetOwnership_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AssetWallet_SignAssetMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignAssetMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).SignAssetMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/SignAssetMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).SignAssetMessage(ctx, req.(*SignAssetMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_VerifyAssetMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAssetMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).VerifyAssetMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/VerifyAssetMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).VerifyAssetMessage(ctx, req.(*VerifyAssetMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AssetWallet_RemoveUTXOLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveUTXOLeaseRequest)
 	if err := dec(in); err != nil {
@@ -384,6 +508,10 @@ var AssetWallet_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NextInternalKey",
 			Handler:    _AssetWallet_NextInternalKey_Handler,
 		},
+		{
+			MethodName: "ListInternalKeys",
+			Handler:    _AssetWallet_ListInternalKeys_Handler,
+		},
 		{
 			MethodName: "NextScriptKey",
 			Handler:    _AssetWallet_NextScriptKey_Handler,
@@ -396,6 +524,14 @@ var AssetWallet_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyAssetOwnership",
 			Handler:    _AssetWallet_VerifyAssetOwnership_Handler,
 		},
+		{
+			MethodName: "SignAssetMessage",
+			Handler:    _AssetWallet_SignAssetMessage_Handler,
+		},
+		{
+			MethodName: "VerifyAssetMessage",
+			Handler:    _AssetWallet_VerifyAssetMessage_Handler,
+		},
 		{
 			MethodName: "RemoveUTXOLease",
 			Handler:    _AssetWallet_RemoveUTXOLease_Handler,
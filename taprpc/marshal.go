@@ -77,6 +77,7 @@ func MarshalAsset(ctx context.Context, a *asset.Asset,
 			AssetId:      assetID[:],
 			OutputIndex:  a.Genesis.OutputIndex,
 			Version:      int32(assetVersion),
+			AssetType:    AssetType(a.Genesis.Type),
 		},
 		AssetType:        AssetType(a.Type),
 		Amount:           a.Amount,
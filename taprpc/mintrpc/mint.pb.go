@@ -33,6 +33,7 @@ const (
 	BatchState_BATCH_STATE_FINALIZED          BatchState = 6
 	BatchState_BATCH_STATE_SEEDLING_CANCELLED BatchState = 7
 	BatchState_BATCH_STATE_SPROUT_CANCELLED   BatchState = 8
+	BatchState_BATCH_STATE_AWAITING_SIGNATURE BatchState = 9
 )
 
 // Enum value maps for BatchState.
@@ -47,6 +48,7 @@ var (
 		6: "BATCH_STATE_FINALIZED",
 		7: "BATCH_STATE_SEEDLING_CANCELLED",
 		8: "BATCH_STATE_SPROUT_CANCELLED",
+		9: "BATCH_STATE_AWAITING_SIGNATURE",
 	}
 	BatchState_value = map[string]int32{
 		"BATCH_STATE_UNKNOWN":            0,
@@ -58,6 +60,7 @@ var (
 		"BATCH_STATE_FINALIZED":          6,
 		"BATCH_STATE_SEEDLING_CANCELLED": 7,
 		"BATCH_STATE_SPROUT_CANCELLED":   8,
+		"BATCH_STATE_AWAITING_SIGNATURE": 9,
 	}
 )
 
@@ -88,6 +91,61 @@ func (BatchState) EnumDescriptor() ([]byte, []int) {
 	return file_mintrpc_mint_proto_rawDescGZIP(), []int{0}
 }
 
+type BatchConfEventType int32
+
+const (
+	// The number of confirmations of the minting transaction changed while it's
+	// still below the target. This is also sent for the initial state.
+	BatchConfEventType_BATCH_CONF_EVENT_UPDATE BatchConfEventType = 0
+	// The minting transaction reached the target number of confirmations.
+	BatchConfEventType_BATCH_CONF_EVENT_TARGET_REACHED BatchConfEventType = 1
+	// A re-org dropped the number of confirmations of the minting transaction,
+	// either below the target after it was reached, or below the last reported
+	// number before that.
+	BatchConfEventType_BATCH_CONF_EVENT_ROLLBACK BatchConfEventType = 2
+)
+
+// Enum value maps for BatchConfEventType.
+var (
+	BatchConfEventType_name = map[int32]string{
+		0: "BATCH_CONF_EVENT_UPDATE",
+		1: "BATCH_CONF_EVENT_TARGET_REACHED",
+		2: "BATCH_CONF_EVENT_ROLLBACK",
+	}
+	BatchConfEventType_value = map[string]int32{
+		"BATCH_CONF_EVENT_UPDATE":         0,
+		"BATCH_CONF_EVENT_TARGET_REACHED": 1,
+		"BATCH_CONF_EVENT_ROLLBACK":       2,
+	}
+)
+
+func (x BatchConfEventType) Enum() *BatchConfEventType {
+	p := new(BatchConfEventType)
+	*p = x
+	return p
+}
+
+func (x BatchConfEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchConfEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mintrpc_mint_proto_enumTypes[1].Descriptor()
+}
+
+func (BatchConfEventType) Type() protoreflect.EnumType {
+	return &file_mintrpc_mint_proto_enumTypes[1]
+}
+
+func (x BatchConfEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchConfEventType.Descriptor instead.
+func (BatchConfEventType) EnumDescriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{1}
+}
+
 type MintAsset struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -103,13 +161,42 @@ type MintAsset struct {
 	// The total amount of units of the new asset that should be created. If the
 	// AssetType is Collectible, then this field cannot be set.
 	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	// The specific group key this asset should be minted with.
+	// The specific group key this asset should be minted with. If set, the new
+	// asset is minted into the existing asset group with that key, which
+	// requires the internal key of the group to be controlled by the local
+	// wallet.
 	GroupKey []byte `protobuf:"bytes,5,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
 	// The name of the asset in the batch that will anchor a new asset group.
 	// This asset will be minted with the same group key as the anchor asset.
 	GroupAnchor string `protobuf:"bytes,6,opt,name=group_anchor,json=groupAnchor,proto3" json:"group_anchor,omitempty"`
 	// The version of asset to mint.
 	AssetVersion taprpc.AssetVersion `protobuf:"varint,7,opt,name=asset_version,json=assetVersion,proto3,enum=taprpc.AssetVersion" json:"asset_version,omitempty"`
+	// The schedule of future issuance events of the asset. Once the block height
+	// of an event is reached, the amount of the event is issued into the asset
+	// group automatically. Events with a block height that was already reached
+	// at mint time are added to the amount of the initial issuance. An emission
+	// schedule can only be set for normal assets that are part of an asset
+	// group.
+	EmissionSchedule []*EmissionEvent `protobuf:"bytes,8,rep,name=emission_schedule,json=emissionSchedule,proto3" json:"emission_schedule,omitempty"`
+	// The optional script key to lock the new asset to. The key descriptor of the
+	// internal key must be set, and the script key must be the result of applying
+	// the tap tweak (or a BIP-86 tweak if empty) to the internal key. If not set,
+	// a new BIP-86 script key is derived from the wallet.
+	ScriptKey *taprpc.ScriptKey `protobuf:"bytes,9,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The optional internal key of the new asset group. This can only be set if
+	// emission is enabled, and the key must be controlled by the wallet, as it's
+	// used to sign the group witness. If not set, a new internal key is derived
+	// from the wallet.
+	GroupInternalKey *taprpc.KeyDescriptor `protobuf:"bytes,10,opt,name=group_internal_key,json=groupInternalKey,proto3" json:"group_internal_key,omitempty"`
+	// An optional human-readable label of the asset. The label is only stored
+	// locally and is not committed to on-chain, so it doesn't affect the final
+	// asset ID. It can be changed later with the SetAssetLabel RPC.
+	Label string `protobuf:"bytes,11,opt,name=label,proto3" json:"label,omitempty"`
+	// An optional proof courier address that is embedded into all addresses
+	// created for the asset, unless overridden when creating an address. If not
+	// set, the default proof courier address of the node is used. The address
+	// is only stored locally and doesn't affect the final asset ID.
+	ProofCourierAddr string `protobuf:"bytes,12,opt,name=proof_courier_addr,json=proofCourierAddr,proto3" json:"proof_courier_addr,omitempty"`
 }
 
 func (x *MintAsset) Reset() {
@@ -193,6 +280,98 @@ func (x *MintAsset) GetAssetVersion() taprpc.AssetVersion {
 	return taprpc.AssetVersion(0)
 }
 
+func (x *MintAsset) GetEmissionSchedule() []*EmissionEvent {
+	if x != nil {
+		return x.EmissionSchedule
+	}
+	return nil
+}
+
+func (x *MintAsset) GetScriptKey() *taprpc.ScriptKey {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *MintAsset) GetGroupInternalKey() *taprpc.KeyDescriptor {
+	if x != nil {
+		return x.GroupInternalKey
+	}
+	return nil
+}
+
+func (x *MintAsset) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *MintAsset) GetProofCourierAddr() string {
+	if x != nil {
+		return x.ProofCourierAddr
+	}
+	return ""
+}
+
+type EmissionEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The block height at which the additional units are issued.
+	BlockHeight uint32 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The amount of additional units to issue.
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *EmissionEvent) Reset() {
+	*x = EmissionEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmissionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmissionEvent) ProtoMessage() {}
+
+func (x *EmissionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmissionEvent.ProtoReflect.Descriptor instead.
+func (*EmissionEvent) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EmissionEvent) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *EmissionEvent) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
 type MintAssetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -207,12 +386,18 @@ type MintAssetRequest struct {
 	// response. This is mainly to avoid a lot of data being transmitted and
 	// possibly printed on the command line in the case of a very large batch.
 	ShortResponse bool `protobuf:"varint,3,opt,name=short_response,json=shortResponse,proto3" json:"short_response,omitempty"`
+	// The optional name of the batch to add the asset to. A named batch is
+	// created if it doesn't exist yet. Unlike the regular pending batch, a named
+	// batch is never finalized by the batch ticker and stays open across
+	// restarts, until it is finalized explicitly by FinalizeBatch. The name
+	// tapd-emission is reserved for the batch that issues due emission events.
+	BatchName string `protobuf:"bytes,4,opt,name=batch_name,json=batchName,proto3" json:"batch_name,omitempty"`
 }
 
 func (x *MintAssetRequest) Reset() {
 	*x = MintAssetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[1]
+		mi := &file_mintrpc_mint_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -225,7 +410,7 @@ func (x *MintAssetRequest) String() string {
 func (*MintAssetRequest) ProtoMessage() {}
 
 func (x *MintAssetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[1]
+	mi := &file_mintrpc_mint_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -238,7 +423,7 @@ func (x *MintAssetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MintAssetRequest.ProtoReflect.Descriptor instead.
 func (*MintAssetRequest) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{1}
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *MintAssetRequest) GetAsset() *MintAsset {
@@ -262,6 +447,13 @@ func (x *MintAssetRequest) GetShortResponse() bool {
 	return false
 }
 
+func (x *MintAssetRequest) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
+	}
+	return ""
+}
+
 type MintAssetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -274,7 +466,7 @@ type MintAssetResponse struct {
 func (x *MintAssetResponse) Reset() {
 	*x = MintAssetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[2]
+		mi := &file_mintrpc_mint_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -287,7 +479,7 @@ func (x *MintAssetResponse) String() string {
 func (*MintAssetResponse) ProtoMessage() {}
 
 func (x *MintAssetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[2]
+	mi := &file_mintrpc_mint_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,7 +492,7 @@ func (x *MintAssetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MintAssetResponse.ProtoReflect.Descriptor instead.
 func (*MintAssetResponse) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{2}
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *MintAssetResponse) GetPendingBatch() *MintingBatch {
@@ -323,12 +515,19 @@ type MintingBatch struct {
 	Assets []*MintAsset `protobuf:"bytes,2,rep,name=assets,proto3" json:"assets,omitempty"`
 	// The state of the batch.
 	State BatchState `protobuf:"varint,3,opt,name=state,proto3,enum=mintrpc.BatchState" json:"state,omitempty"`
+	// The name of the batch, if it is a named batch.
+	BatchName string `protobuf:"bytes,4,opt,name=batch_name,json=batchName,proto3" json:"batch_name,omitempty"`
+	// The genesis point of the minting transaction in the form txid:vout, which
+	// is the outpoint spent by the first input of the minting transaction and is
+	// committed to by the IDs of the assets of the batch. This is only set once
+	// the batch is committed to a minting transaction.
+	GenesisPoint string `protobuf:"bytes,5,opt,name=genesis_point,json=genesisPoint,proto3" json:"genesis_point,omitempty"`
 }
 
 func (x *MintingBatch) Reset() {
 	*x = MintingBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[3]
+		mi := &file_mintrpc_mint_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -341,7 +540,7 @@ func (x *MintingBatch) String() string {
 func (*MintingBatch) ProtoMessage() {}
 
 func (x *MintingBatch) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[3]
+	mi := &file_mintrpc_mint_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -354,7 +553,7 @@ func (x *MintingBatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MintingBatch.ProtoReflect.Descriptor instead.
 func (*MintingBatch) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{3}
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *MintingBatch) GetBatchKey() []byte {
@@ -378,6 +577,20 @@ func (x *MintingBatch) GetState() BatchState {
 	return BatchState_BATCH_STATE_UNKNOWN
 }
 
+func (x *MintingBatch) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
+	}
+	return ""
+}
+
+func (x *MintingBatch) GetGenesisPoint() string {
+	if x != nil {
+		return x.GenesisPoint
+	}
+	return ""
+}
+
 type FinalizeBatchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -389,12 +602,22 @@ type FinalizeBatchRequest struct {
 	ShortResponse bool `protobuf:"varint,1,opt,name=short_response,json=shortResponse,proto3" json:"short_response,omitempty"`
 	// The optional fee rate to use for the minting transaction, in sat/kw.
 	FeeRate uint32 `protobuf:"varint,2,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	// The optional fee rate to use for the minting transaction, in sat/vByte.
+	// Can't be set together with fee_rate.
+	SatPerVbyte uint64 `protobuf:"varint,3,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+	// The optional name of the named batch to finalize. If empty, the regular
+	// pending batch is finalized.
+	BatchName string `protobuf:"bytes,4,opt,name=batch_name,json=batchName,proto3" json:"batch_name,omitempty"`
+	// The optional policy that determines which wallet UTXO is spent as the
+	// genesis point of the minting transaction. If not set, the genesis point is
+	// selected by the wallet.
+	GenesisPolicy *GenesisPointPolicy `protobuf:"bytes,5,opt,name=genesis_policy,json=genesisPolicy,proto3" json:"genesis_policy,omitempty"`
 }
 
 func (x *FinalizeBatchRequest) Reset() {
 	*x = FinalizeBatchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[4]
+		mi := &file_mintrpc_mint_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -407,7 +630,7 @@ func (x *FinalizeBatchRequest) String() string {
 func (*FinalizeBatchRequest) ProtoMessage() {}
 
 func (x *FinalizeBatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[4]
+	mi := &file_mintrpc_mint_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,7 +643,7 @@ func (x *FinalizeBatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalizeBatchRequest.ProtoReflect.Descriptor instead.
 func (*FinalizeBatchRequest) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{4}
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *FinalizeBatchRequest) GetShortResponse() bool {
@@ -437,6 +660,27 @@ func (x *FinalizeBatchRequest) GetFeeRate() uint32 {
 	return 0
 }
 
+func (x *FinalizeBatchRequest) GetSatPerVbyte() uint64 {
+	if x != nil {
+		return x.SatPerVbyte
+	}
+	return 0
+}
+
+func (x *FinalizeBatchRequest) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
+	}
+	return ""
+}
+
+func (x *FinalizeBatchRequest) GetGenesisPolicy() *GenesisPointPolicy {
+	if x != nil {
+		return x.GenesisPolicy
+	}
+	return nil
+}
+
 type FinalizeBatchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -449,7 +693,7 @@ type FinalizeBatchResponse struct {
 func (x *FinalizeBatchResponse) Reset() {
 	*x = FinalizeBatchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[5]
+		mi := &file_mintrpc_mint_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -462,7 +706,7 @@ func (x *FinalizeBatchResponse) String() string {
 func (*FinalizeBatchResponse) ProtoMessage() {}
 
 func (x *FinalizeBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[5]
+	mi := &file_mintrpc_mint_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -475,7 +719,7 @@ func (x *FinalizeBatchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalizeBatchResponse.ProtoReflect.Descriptor instead.
 func (*FinalizeBatchResponse) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{5}
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *FinalizeBatchResponse) GetBatch() *MintingBatch {
@@ -494,7 +738,7 @@ type CancelBatchRequest struct {
 func (x *CancelBatchRequest) Reset() {
 	*x = CancelBatchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[6]
+		mi := &file_mintrpc_mint_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -507,7 +751,7 @@ func (x *CancelBatchRequest) String() string {
 func (*CancelBatchRequest) ProtoMessage() {}
 
 func (x *CancelBatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[6]
+	mi := &file_mintrpc_mint_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,7 +764,7 @@ func (x *CancelBatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelBatchRequest.ProtoReflect.Descriptor instead.
 func (*CancelBatchRequest) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{6}
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{7}
 }
 
 type CancelBatchResponse struct {
@@ -528,14 +772,15 @@ type CancelBatchResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The internal public key of the batch.
+	// The internal public key of the cancelled batch. This is empty if there
+	// was no batch to cancel.
 	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
 }
 
 func (x *CancelBatchResponse) Reset() {
 	*x = CancelBatchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[7]
+		mi := &file_mintrpc_mint_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -548,7 +793,7 @@ func (x *CancelBatchResponse) String() string {
 func (*CancelBatchResponse) ProtoMessage() {}
 
 func (x *CancelBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[7]
+	mi := &file_mintrpc_mint_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -561,7 +806,7 @@ func (x *CancelBatchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelBatchResponse.ProtoReflect.Descriptor instead.
 func (*CancelBatchResponse) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{7}
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CancelBatchResponse) GetBatchKey() []byte {
@@ -583,12 +828,20 @@ type ListBatchRequest struct {
 	//	*ListBatchRequest_BatchKey
 	//	*ListBatchRequest_BatchKeyStr
 	Filter isListBatchRequest_Filter `protobuf_oneof:"filter"`
+	// If set, only assets with a label that contains this string are listed, and
+	// batches without any such asset are omitted. The label is matched
+	// case-insensitively.
+	LabelFilter string `protobuf:"bytes,3,opt,name=label_filter,json=labelFilter,proto3" json:"label_filter,omitempty"`
+	// If true, only batches that are still open for new assets are listed. This
+	// includes the regular pending batch and all named batches that weren't
+	// finalized yet.
+	OpenOnly bool `protobuf:"varint,4,opt,name=open_only,json=openOnly,proto3" json:"open_only,omitempty"`
 }
 
 func (x *ListBatchRequest) Reset() {
 	*x = ListBatchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[8]
+		mi := &file_mintrpc_mint_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -601,7 +854,7 @@ func (x *ListBatchRequest) String() string {
 func (*ListBatchRequest) ProtoMessage() {}
 
 func (x *ListBatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[8]
+	mi := &file_mintrpc_mint_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -614,7 +867,7 @@ func (x *ListBatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBatchRequest.ProtoReflect.Descriptor instead.
 func (*ListBatchRequest) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{8}
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{9}
 }
 
 func (m *ListBatchRequest) GetFilter() isListBatchRequest_Filter {
@@ -638,6 +891,20 @@ func (x *ListBatchRequest) GetBatchKeyStr() string {
 	return ""
 }
 
+func (x *ListBatchRequest) GetLabelFilter() string {
+	if x != nil {
+		return x.LabelFilter
+	}
+	return ""
+}
+
+func (x *ListBatchRequest) GetOpenOnly() bool {
+	if x != nil {
+		return x.OpenOnly
+	}
+	return false
+}
+
 type isListBatchRequest_Filter interface {
 	isListBatchRequest_Filter()
 }
@@ -669,7 +936,7 @@ type ListBatchResponse struct {
 func (x *ListBatchResponse) Reset() {
 	*x = ListBatchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[9]
+		mi := &file_mintrpc_mint_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -682,7 +949,7 @@ func (x *ListBatchResponse) String() string {
 func (*ListBatchResponse) ProtoMessage() {}
 
 func (x *ListBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[9]
+	mi := &file_mintrpc_mint_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -695,7 +962,7 @@ func (x *ListBatchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBatchResponse.ProtoReflect.Descriptor instead.
 func (*ListBatchResponse) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{9}
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListBatchResponse) GetBatches() []*MintingBatch {
@@ -705,186 +972,1976 @@ func (x *ListBatchResponse) GetBatches() []*MintingBatch {
 	return nil
 }
 
-var File_mintrpc_mint_proto protoreflect.FileDescriptor
+type BumpBatchFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_mintrpc_mint_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x1a, 0x13, 0x74,
-	0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x96, 0x02, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x12, 0x30, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
-	0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70,
-	0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x09, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a,
-	0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72,
-	0x12, 0x39, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
-	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x10,
-	0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x28, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f,
-	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x0a, 0x11, 0x4d, 0x69,
-	0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3a, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63,
-	0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0c, 0x70,
-	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0x82, 0x01, 0x0a, 0x0c,
-	0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x06, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
-	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x22, 0x58, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72,
-	0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x22, 0x44, 0x0a, 0x15, 0x46, 0x69,
-	0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e,
-	0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68,
-	0x22, 0x14, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x22, 0x61, 0x0a, 0x10, 0x4c, 0x69,
-	0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a,
-	0x0d, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79,
-	0x53, 0x74, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x44, 0x0a,
-	0x11, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69,
-	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x65, 0x73, 0x2a, 0x88, 0x02, 0x0a, 0x0a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x42,
-	0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x44, 0x4e, 0x49,
-	0x4e, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x5a, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15,
-	0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d,
-	0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54,
-	0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x05, 0x12, 0x19, 0x0a,
-	0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x4e,
-	0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x06, 0x12, 0x22, 0x0a, 0x1e, 0x42, 0x41, 0x54, 0x43,
-	0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x45, 0x44, 0x4c, 0x49, 0x4e, 0x47,
-	0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x07, 0x12, 0x20, 0x0a, 0x1c,
-	0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x52, 0x4f,
-	0x55, 0x54, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x08, 0x32, 0xaa,
-	0x02, 0x0a, 0x04, 0x4d, 0x69, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d,
-	0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x46,
-	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x2e, 0x6d,
-	0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x69,
-	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x43,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x6d, 0x69, 0x6e,
-	0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
-	0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x38, 0x5a, 0x36, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e,
-	0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2d,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2f, 0x6d, 0x69,
-	0x6e, 0x74, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	// The key of the batch to bump the fee of.
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// The fee rate to use for the replacement minting transaction, in
+	// sat/vByte. Must exceed the fee rate of the current minting transaction
+	// by at least the minimum relay fee increment of 1 sat/vByte.
+	SatPerVbyte uint64 `protobuf:"varint,2,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
 }
 
-var (
-	file_mintrpc_mint_proto_rawDescOnce sync.Once
-	file_mintrpc_mint_proto_rawDescData = file_mintrpc_mint_proto_rawDesc
-)
+func (x *BumpBatchFeeRequest) Reset() {
+	*x = BumpBatchFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_mintrpc_mint_proto_rawDescGZIP() []byte {
-	file_mintrpc_mint_proto_rawDescOnce.Do(func() {
-		file_mintrpc_mint_proto_rawDescData = protoimpl.X.CompressGZIP(file_mintrpc_mint_proto_rawDescData)
-	})
-	return file_mintrpc_mint_proto_rawDescData
+func (x *BumpBatchFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_mintrpc_mint_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_mintrpc_mint_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
-var file_mintrpc_mint_proto_goTypes = []interface{}{
-	(BatchState)(0),               // 0: mintrpc.BatchState
-	(*MintAsset)(nil),             // 1: mintrpc.MintAsset
-	(*MintAssetRequest)(nil),      // 2: mintrpc.MintAssetRequest
-	(*MintAssetResponse)(nil),     // 3: mintrpc.MintAssetResponse
-	(*MintingBatch)(nil),          // 4: mintrpc.MintingBatch
-	(*FinalizeBatchRequest)(nil),  // 5: mintrpc.FinalizeBatchRequest
-	(*FinalizeBatchResponse)(nil), // 6: mintrpc.FinalizeBatchResponse
-	(*CancelBatchRequest)(nil),    // 7: mintrpc.CancelBatchRequest
-	(*CancelBatchResponse)(nil),   // 8: mintrpc.CancelBatchResponse
-	(*ListBatchRequest)(nil),      // 9: mintrpc.ListBatchRequest
-	(*ListBatchResponse)(nil),     // 10: mintrpc.ListBatchResponse
-	(taprpc.AssetType)(0),         // 11: taprpc.AssetType
-	(*taprpc.AssetMeta)(nil),      // 12: taprpc.AssetMeta
-	(taprpc.AssetVersion)(0),      // 13: taprpc.AssetVersion
+func (*BumpBatchFeeRequest) ProtoMessage() {}
+
+func (x *BumpBatchFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_mintrpc_mint_proto_depIdxs = []int32{
-	11, // 0: mintrpc.MintAsset.asset_type:type_name -> taprpc.AssetType
-	12, // 1: mintrpc.MintAsset.asset_meta:type_name -> taprpc.AssetMeta
-	13, // 2: mintrpc.MintAsset.asset_version:type_name -> taprpc.AssetVersion
-	1,  // 3: mintrpc.MintAssetRequest.asset:type_name -> mintrpc.MintAsset
-	4,  // 4: mintrpc.MintAssetResponse.pending_batch:type_name -> mintrpc.MintingBatch
-	1,  // 5: mintrpc.MintingBatch.assets:type_name -> mintrpc.MintAsset
-	0,  // 6: mintrpc.MintingBatch.state:type_name -> mintrpc.BatchState
-	4,  // 7: mintrpc.FinalizeBatchResponse.batch:type_name -> mintrpc.MintingBatch
-	4,  // 8: mintrpc.ListBatchResponse.batches:type_name -> mintrpc.MintingBatch
-	2,  // 9: mintrpc.Mint.MintAsset:input_type -> mintrpc.MintAssetRequest
-	5,  // 10: mintrpc.Mint.FinalizeBatch:input_type -> mintrpc.FinalizeBatchRequest
-	7,  // 11: mintrpc.Mint.CancelBatch:input_type -> mintrpc.CancelBatchRequest
-	9,  // 12: mintrpc.Mint.ListBatches:input_type -> mintrpc.ListBatchRequest
-	3,  // 13: mintrpc.Mint.MintAsset:output_type -> mintrpc.MintAssetResponse
-	6,  // 14: mintrpc.Mint.FinalizeBatch:output_type -> mintrpc.FinalizeBatchResponse
-	8,  // 15: mintrpc.Mint.CancelBatch:output_type -> mintrpc.CancelBatchResponse
-	10, // 16: mintrpc.Mint.ListBatches:output_type -> mintrpc.ListBatchResponse
-	13, // [13:17] is the sub-list for method output_type
-	9,  // [9:13] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+
+// Deprecated: Use BumpBatchFeeRequest.ProtoReflect.Descriptor instead.
+func (*BumpBatchFeeRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{11}
 }
 
-func init() { file_mintrpc_mint_proto_init() }
-func file_mintrpc_mint_proto_init() {
-	if File_mintrpc_mint_proto != nil {
-		return
+func (x *BumpBatchFeeRequest) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_mintrpc_mint_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MintAsset); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
+	return nil
+}
+
+func (x *BumpBatchFeeRequest) GetSatPerVbyte() uint64 {
+	if x != nil {
+		return x.SatPerVbyte
+	}
+	return 0
+}
+
+type BumpBatchFeeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The txid of the replacement minting transaction.
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+}
+
+func (x *BumpBatchFeeResponse) Reset() {
+	*x = BumpBatchFeeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpBatchFeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpBatchFeeResponse) ProtoMessage() {}
+
+func (x *BumpBatchFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpBatchFeeResponse.ProtoReflect.Descriptor instead.
+func (*BumpBatchFeeResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BumpBatchFeeResponse) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+type FetchEmissionScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The key of the asset group to fetch the emission schedule for.
+	//
+	// Types that are assignable to Group:
+	//
+	//	*FetchEmissionScheduleRequest_GroupKey
+	//	*FetchEmissionScheduleRequest_GroupKeyStr
+	Group isFetchEmissionScheduleRequest_Group `protobuf_oneof:"group"`
+}
+
+func (x *FetchEmissionScheduleRequest) Reset() {
+	*x = FetchEmissionScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchEmissionScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchEmissionScheduleRequest) ProtoMessage() {}
+
+func (x *FetchEmissionScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchEmissionScheduleRequest.ProtoReflect.Descriptor instead.
+func (*FetchEmissionScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{13}
+}
+
+func (m *FetchEmissionScheduleRequest) GetGroup() isFetchEmissionScheduleRequest_Group {
+	if m != nil {
+		return m.Group
+	}
+	return nil
+}
+
+func (x *FetchEmissionScheduleRequest) GetGroupKey() []byte {
+	if x, ok := x.GetGroup().(*FetchEmissionScheduleRequest_GroupKey); ok {
+		return x.GroupKey
+	}
+	return nil
+}
+
+func (x *FetchEmissionScheduleRequest) GetGroupKeyStr() string {
+	if x, ok := x.GetGroup().(*FetchEmissionScheduleRequest_GroupKeyStr); ok {
+		return x.GroupKeyStr
+	}
+	return ""
+}
+
+type isFetchEmissionScheduleRequest_Group interface {
+	isFetchEmissionScheduleRequest_Group()
+}
+
+type FetchEmissionScheduleRequest_GroupKey struct {
+	// The group key specified as raw bytes (gRPC only).
+	GroupKey []byte `protobuf:"bytes,1,opt,name=group_key,json=groupKey,proto3,oneof"`
+}
+
+type FetchEmissionScheduleRequest_GroupKeyStr struct {
+	// The group key specified as a hex encoded string (use this for
+	// REST).
+	GroupKeyStr string `protobuf:"bytes,2,opt,name=group_key_str,json=groupKeyStr,proto3,oneof"`
+}
+
+func (*FetchEmissionScheduleRequest_GroupKey) isFetchEmissionScheduleRequest_Group() {}
+
+func (*FetchEmissionScheduleRequest_GroupKeyStr) isFetchEmissionScheduleRequest_Group() {}
+
+type ScheduledEmission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the asset the emission schedule was specified for. The
+	// additional units are issued under the same name.
+	AssetName string `protobuf:"bytes,1,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	// The block height at which the additional units are issued.
+	BlockHeight uint32 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The amount of additional units to issue.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *ScheduledEmission) Reset() {
+	*x = ScheduledEmission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledEmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledEmission) ProtoMessage() {}
+
+func (x *ScheduledEmission) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledEmission.ProtoReflect.Descriptor instead.
+func (*ScheduledEmission) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ScheduledEmission) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
+	}
+	return ""
+}
+
+func (x *ScheduledEmission) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *ScheduledEmission) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type FetchEmissionScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The emission events that weren't issued yet, ordered by block height.
+	Emissions []*ScheduledEmission `protobuf:"bytes,1,rep,name=emissions,proto3" json:"emissions,omitempty"`
+}
+
+func (x *FetchEmissionScheduleResponse) Reset() {
+	*x = FetchEmissionScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchEmissionScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchEmissionScheduleResponse) ProtoMessage() {}
+
+func (x *FetchEmissionScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchEmissionScheduleResponse.ProtoReflect.Descriptor instead.
+func (*FetchEmissionScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FetchEmissionScheduleResponse) GetEmissions() []*ScheduledEmission {
+	if x != nil {
+		return x.Emissions
+	}
+	return nil
+}
+
+type FetchBatchAnchorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The key of the batch to fetch the anchor of.
+	//
+	// Types that are assignable to Batch:
+	//
+	//	*FetchBatchAnchorRequest_BatchKey
+	//	*FetchBatchAnchorRequest_BatchKeyStr
+	Batch isFetchBatchAnchorRequest_Batch `protobuf_oneof:"batch"`
+}
+
+func (x *FetchBatchAnchorRequest) Reset() {
+	*x = FetchBatchAnchorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchBatchAnchorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchBatchAnchorRequest) ProtoMessage() {}
+
+func (x *FetchBatchAnchorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchBatchAnchorRequest.ProtoReflect.Descriptor instead.
+func (*FetchBatchAnchorRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{16}
+}
+
+func (m *FetchBatchAnchorRequest) GetBatch() isFetchBatchAnchorRequest_Batch {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
+func (x *FetchBatchAnchorRequest) GetBatchKey() []byte {
+	if x, ok := x.GetBatch().(*FetchBatchAnchorRequest_BatchKey); ok {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *FetchBatchAnchorRequest) GetBatchKeyStr() string {
+	if x, ok := x.GetBatch().(*FetchBatchAnchorRequest_BatchKeyStr); ok {
+		return x.BatchKeyStr
+	}
+	return ""
+}
+
+type isFetchBatchAnchorRequest_Batch interface {
+	isFetchBatchAnchorRequest_Batch()
+}
+
+type FetchBatchAnchorRequest_BatchKey struct {
+	// The batch key specified as raw bytes (gRPC only).
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3,oneof"`
+}
+
+type FetchBatchAnchorRequest_BatchKeyStr struct {
+	// The batch key specified as a hex encoded string (use this for
+	// REST).
+	BatchKeyStr string `protobuf:"bytes,2,opt,name=batch_key_str,json=batchKeyStr,proto3,oneof"`
+}
+
+func (*FetchBatchAnchorRequest_BatchKey) isFetchBatchAnchorRequest_Batch() {}
+
+func (*FetchBatchAnchorRequest_BatchKeyStr) isFetchBatchAnchorRequest_Batch() {}
+
+type FetchBatchAnchorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The raw signed minting transaction of the batch.
+	AnchorTx []byte `protobuf:"bytes,1,opt,name=anchor_tx,json=anchorTx,proto3" json:"anchor_tx,omitempty"`
+	// The txid of the minting transaction.
+	AnchorTxid string `protobuf:"bytes,2,opt,name=anchor_txid,json=anchorTxid,proto3" json:"anchor_txid,omitempty"`
+	// The index of the output of the minting transaction that commits to the
+	// assets of the batch.
+	OutputIndex uint32 `protobuf:"varint,3,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	// Whether the minting transaction is confirmed. If it isn't, then none of
+	// the fields below are set.
+	Confirmed bool `protobuf:"varint,4,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// The hash of the block the minting transaction was confirmed in.
+	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// The height of the block the minting transaction was confirmed in.
+	BlockHeight uint32 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The index of the minting transaction within the block.
+	TxIndex uint32 `protobuf:"varint,7,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// The serialized merkle proof of the inclusion of the minting
+	// transaction in the block.
+	TxMerkleProof []byte `protobuf:"bytes,8,opt,name=tx_merkle_proof,json=txMerkleProof,proto3" json:"tx_merkle_proof,omitempty"`
+}
+
+func (x *FetchBatchAnchorResponse) Reset() {
+	*x = FetchBatchAnchorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchBatchAnchorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchBatchAnchorResponse) ProtoMessage() {}
+
+func (x *FetchBatchAnchorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchBatchAnchorResponse.ProtoReflect.Descriptor instead.
+func (*FetchBatchAnchorResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *FetchBatchAnchorResponse) GetAnchorTx() []byte {
+	if x != nil {
+		return x.AnchorTx
+	}
+	return nil
+}
+
+func (x *FetchBatchAnchorResponse) GetAnchorTxid() string {
+	if x != nil {
+		return x.AnchorTxid
+	}
+	return ""
+}
+
+func (x *FetchBatchAnchorResponse) GetOutputIndex() uint32 {
+	if x != nil {
+		return x.OutputIndex
+	}
+	return 0
+}
+
+func (x *FetchBatchAnchorResponse) GetConfirmed() bool {
+	if x != nil {
+		return x.Confirmed
+	}
+	return false
+}
+
+func (x *FetchBatchAnchorResponse) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *FetchBatchAnchorResponse) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *FetchBatchAnchorResponse) GetTxIndex() uint32 {
+	if x != nil {
+		return x.TxIndex
+	}
+	return 0
+}
+
+func (x *FetchBatchAnchorResponse) GetTxMerkleProof() []byte {
+	if x != nil {
+		return x.TxMerkleProof
+	}
+	return nil
+}
+
+type PreviewAssetIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The asset to preview the ID of, as it would be passed to MintAsset. Only
+	// the type, name and meta data of the asset affect the asset ID.
+	Asset *MintAsset `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	// If true, then the asset anchors a new asset group, as it would when minted
+	// with emission enabled.
+	EnableEmission bool `protobuf:"varint,2,opt,name=enable_emission,json=enableEmission,proto3" json:"enable_emission,omitempty"`
+	// The genesis point of the minting transaction in the form txid:vout, which
+	// is the outpoint spent by the first input of the minting transaction.
+	GenesisPoint string `protobuf:"bytes,3,opt,name=genesis_point,json=genesisPoint,proto3" json:"genesis_point,omitempty"`
+	// The index of the output of the minting transaction that commits to the
+	// assets of the batch.
+	AnchorOutputIndex uint32 `protobuf:"varint,4,opt,name=anchor_output_index,json=anchorOutputIndex,proto3" json:"anchor_output_index,omitempty"`
+}
+
+func (x *PreviewAssetIDRequest) Reset() {
+	*x = PreviewAssetIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewAssetIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewAssetIDRequest) ProtoMessage() {}
+
+func (x *PreviewAssetIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewAssetIDRequest.ProtoReflect.Descriptor instead.
+func (*PreviewAssetIDRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PreviewAssetIDRequest) GetAsset() *MintAsset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+func (x *PreviewAssetIDRequest) GetEnableEmission() bool {
+	if x != nil {
+		return x.EnableEmission
+	}
+	return false
+}
+
+func (x *PreviewAssetIDRequest) GetGenesisPoint() string {
+	if x != nil {
+		return x.GenesisPoint
+	}
+	return ""
+}
+
+func (x *PreviewAssetIDRequest) GetAnchorOutputIndex() uint32 {
+	if x != nil {
+		return x.AnchorOutputIndex
+	}
+	return 0
+}
+
+type PreviewAssetIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID the asset would be minted with.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The group key the asset would be minted with. This is only set if the
+	// asset is minted into an existing asset group, or if it anchors a new
+	// asset group and the internal key of the group was specified.
+	GroupKey []byte `protobuf:"bytes,2,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
+}
+
+func (x *PreviewAssetIDResponse) Reset() {
+	*x = PreviewAssetIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewAssetIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewAssetIDResponse) ProtoMessage() {}
+
+func (x *PreviewAssetIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewAssetIDResponse.ProtoReflect.Descriptor instead.
+func (*PreviewAssetIDResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PreviewAssetIDResponse) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *PreviewAssetIDResponse) GetGroupKey() []byte {
+	if x != nil {
+		return x.GroupKey
+	}
+	return nil
+}
+
+type CommitBatchPsbtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The serialized minting transaction PSBT, funded by an external wallet.
+	// The UTXO information of all inputs must be set.
+	FundedPsbt []byte `protobuf:"bytes,1,opt,name=funded_psbt,json=fundedPsbt,proto3" json:"funded_psbt,omitempty"`
+	// The index of the output of the minting transaction that commits to the
+	// assets of the batch. The output must carry at least 1000 satoshis.
+	AnchorOutputIndex uint32 `protobuf:"varint,2,opt,name=anchor_output_index,json=anchorOutputIndex,proto3" json:"anchor_output_index,omitempty"`
+	// The optional name of the named batch to commit. If empty, the regular
+	// pending batch is committed.
+	BatchName string `protobuf:"bytes,3,opt,name=batch_name,json=batchName,proto3" json:"batch_name,omitempty"`
+	// If true, then the assets currently in the batch won't be returned in the
+	// response.
+	ShortResponse bool `protobuf:"varint,4,opt,name=short_response,json=shortResponse,proto3" json:"short_response,omitempty"`
+}
+
+func (x *CommitBatchPsbtRequest) Reset() {
+	*x = CommitBatchPsbtRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitBatchPsbtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitBatchPsbtRequest) ProtoMessage() {}
+
+func (x *CommitBatchPsbtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitBatchPsbtRequest.ProtoReflect.Descriptor instead.
+func (*CommitBatchPsbtRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CommitBatchPsbtRequest) GetFundedPsbt() []byte {
+	if x != nil {
+		return x.FundedPsbt
+	}
+	return nil
+}
+
+func (x *CommitBatchPsbtRequest) GetAnchorOutputIndex() uint32 {
+	if x != nil {
+		return x.AnchorOutputIndex
+	}
+	return 0
+}
+
+func (x *CommitBatchPsbtRequest) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
+	}
+	return ""
+}
+
+func (x *CommitBatchPsbtRequest) GetShortResponse() bool {
+	if x != nil {
+		return x.ShortResponse
+	}
+	return false
+}
+
+type CommitBatchPsbtResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The committed batch.
+	Batch *MintingBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	// The serialized minting transaction PSBT with the anchor output committing
+	// to the assets of the batch, which must be signed by the external wallet.
+	UnsignedPsbt []byte `protobuf:"bytes,2,opt,name=unsigned_psbt,json=unsignedPsbt,proto3" json:"unsigned_psbt,omitempty"`
+}
+
+func (x *CommitBatchPsbtResponse) Reset() {
+	*x = CommitBatchPsbtResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitBatchPsbtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitBatchPsbtResponse) ProtoMessage() {}
+
+func (x *CommitBatchPsbtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitBatchPsbtResponse.ProtoReflect.Descriptor instead.
+func (*CommitBatchPsbtResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CommitBatchPsbtResponse) GetBatch() *MintingBatch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+func (x *CommitBatchPsbtResponse) GetUnsignedPsbt() []byte {
+	if x != nil {
+		return x.UnsignedPsbt
+	}
+	return nil
+}
+
+type PublishBatchPsbtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The key of the batch the minting transaction belongs to, serialized in
+	// compressed format.
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// The serialized and fully signed minting transaction PSBT.
+	SignedPsbt []byte `protobuf:"bytes,2,opt,name=signed_psbt,json=signedPsbt,proto3" json:"signed_psbt,omitempty"`
+	// If true, then the assets of the batch won't be returned in the response.
+	ShortResponse bool `protobuf:"varint,3,opt,name=short_response,json=shortResponse,proto3" json:"short_response,omitempty"`
+}
+
+func (x *PublishBatchPsbtRequest) Reset() {
+	*x = PublishBatchPsbtRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishBatchPsbtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishBatchPsbtRequest) ProtoMessage() {}
+
+func (x *PublishBatchPsbtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishBatchPsbtRequest.ProtoReflect.Descriptor instead.
+func (*PublishBatchPsbtRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PublishBatchPsbtRequest) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *PublishBatchPsbtRequest) GetSignedPsbt() []byte {
+	if x != nil {
+		return x.SignedPsbt
+	}
+	return nil
+}
+
+func (x *PublishBatchPsbtRequest) GetShortResponse() bool {
+	if x != nil {
+		return x.ShortResponse
+	}
+	return false
+}
+
+type PublishBatchPsbtResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The broadcast batch.
+	Batch *MintingBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+}
+
+func (x *PublishBatchPsbtResponse) Reset() {
+	*x = PublishBatchPsbtResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishBatchPsbtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishBatchPsbtResponse) ProtoMessage() {}
+
+func (x *PublishBatchPsbtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishBatchPsbtResponse.ProtoReflect.Descriptor instead.
+func (*PublishBatchPsbtResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PublishBatchPsbtResponse) GetBatch() *MintingBatch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+type GenesisPointPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The wallet UTXO to spend as the genesis point of the minting transaction,
+	// in the form txid:vout. As the genesis point is committed to by the IDs of
+	// the assets of the batch, this allows the asset IDs to be derived before
+	// the batch is finalized. Finalizing the batch fails if the UTXO isn't
+	// available for spending in the wallet.
+	Outpoint string `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// The minimum value in satoshis of the wallet UTXO that is spent as the
+	// genesis point of the minting transaction. Finalizing the batch fails if no
+	// such UTXO is available for spending in the wallet.
+	MinValueSat uint64 `protobuf:"varint,2,opt,name=min_value_sat,json=minValueSat,proto3" json:"min_value_sat,omitempty"`
+}
+
+func (x *GenesisPointPolicy) Reset() {
+	*x = GenesisPointPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisPointPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisPointPolicy) ProtoMessage() {}
+
+func (x *GenesisPointPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenesisPointPolicy.ProtoReflect.Descriptor instead.
+func (*GenesisPointPolicy) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GenesisPointPolicy) GetOutpoint() string {
+	if x != nil {
+		return x.Outpoint
+	}
+	return ""
+}
+
+func (x *GenesisPointPolicy) GetMinValueSat() uint64 {
+	if x != nil {
+		return x.MinValueSat
+	}
+	return 0
+}
+
+type ListMintsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, only batches that were created at or after this unix timestamp in
+	// seconds are listed.
+	StartTimestamp int64 `protobuf:"varint,1,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	// If set, only batches that were created at or before this unix timestamp
+	// in seconds are listed.
+	EndTimestamp int64 `protobuf:"varint,2,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	// If set, only batches in one of these states are listed.
+	States []BatchState `protobuf:"varint,3,rep,packed,name=states,proto3,enum=mintrpc.BatchState" json:"states,omitempty"`
+}
+
+func (x *ListMintsRequest) Reset() {
+	*x = ListMintsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMintsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMintsRequest) ProtoMessage() {}
+
+func (x *ListMintsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMintsRequest.ProtoReflect.Descriptor instead.
+func (*ListMintsRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListMintsRequest) GetStartTimestamp() int64 {
+	if x != nil {
+		return x.StartTimestamp
+	}
+	return 0
+}
+
+func (x *ListMintsRequest) GetEndTimestamp() int64 {
+	if x != nil {
+		return x.EndTimestamp
+	}
+	return 0
+}
+
+func (x *ListMintsRequest) GetStates() []BatchState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+type MintedAsset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the asset. This is only set once the batch is committed to a
+	// minting transaction, as the asset ID commits to its genesis point.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The name of the asset.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The type of the asset.
+	AssetType taprpc.AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=taprpc.AssetType" json:"asset_type,omitempty"`
+	// The amount of the asset that was minted.
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The group key of the asset, if it is part of an asset group.
+	GroupKey []byte `protobuf:"bytes,5,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
+	// The label of the asset, if it has one.
+	Label string `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *MintedAsset) Reset() {
+	*x = MintedAsset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MintedAsset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintedAsset) ProtoMessage() {}
+
+func (x *MintedAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintedAsset.ProtoReflect.Descriptor instead.
+func (*MintedAsset) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MintedAsset) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *MintedAsset) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MintedAsset) GetAssetType() taprpc.AssetType {
+	if x != nil {
+		return x.AssetType
+	}
+	return taprpc.AssetType(0)
+}
+
+func (x *MintedAsset) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *MintedAsset) GetGroupKey() []byte {
+	if x != nil {
+		return x.GroupKey
+	}
+	return nil
+}
+
+func (x *MintedAsset) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type MintRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The key of the batch the assets were minted in.
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// The name of the batch, if it is a named batch.
+	BatchName string `protobuf:"bytes,2,opt,name=batch_name,json=batchName,proto3" json:"batch_name,omitempty"`
+	// The state of the batch. A batch that failed to be minted is in one of the
+	// cancelled states.
+	State BatchState `protobuf:"varint,3,opt,name=state,proto3,enum=mintrpc.BatchState" json:"state,omitempty"`
+	// The unix timestamp in seconds of when the batch was created.
+	CreationTimestamp int64 `protobuf:"varint,4,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	// The assets of the batch.
+	Assets []*MintedAsset `protobuf:"bytes,5,rep,name=assets,proto3" json:"assets,omitempty"`
+	// The txid of the minting transaction of the batch. This is only set once
+	// the minting transaction is signed.
+	AnchorTxid string `protobuf:"bytes,6,opt,name=anchor_txid,json=anchorTxid,proto3" json:"anchor_txid,omitempty"`
+	// The height of the block the minting transaction was confirmed in. This is
+	// only set once the minting transaction is confirmed.
+	BlockHeight uint32 `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The hash of the block the minting transaction was confirmed in. This is
+	// only set once the minting transaction is confirmed.
+	BlockHash string `protobuf:"bytes,8,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+}
+
+func (x *MintRecord) Reset() {
+	*x = MintRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MintRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintRecord) ProtoMessage() {}
+
+func (x *MintRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintRecord.ProtoReflect.Descriptor instead.
+func (*MintRecord) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MintRecord) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *MintRecord) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
+	}
+	return ""
+}
+
+func (x *MintRecord) GetState() BatchState {
+	if x != nil {
+		return x.State
+	}
+	return BatchState_BATCH_STATE_UNKNOWN
+}
+
+func (x *MintRecord) GetCreationTimestamp() int64 {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return 0
+}
+
+func (x *MintRecord) GetAssets() []*MintedAsset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *MintRecord) GetAnchorTxid() string {
+	if x != nil {
+		return x.AnchorTxid
+	}
+	return ""
+}
+
+func (x *MintRecord) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *MintRecord) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+type ListMintsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The batches of the issuance history, from oldest to newest.
+	Mints []*MintRecord `protobuf:"bytes,1,rep,name=mints,proto3" json:"mints,omitempty"`
+}
+
+func (x *ListMintsResponse) Reset() {
+	*x = ListMintsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMintsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMintsResponse) ProtoMessage() {}
+
+func (x *ListMintsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMintsResponse.ProtoReflect.Descriptor instead.
+func (*ListMintsResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListMintsResponse) GetMints() []*MintRecord {
+	if x != nil {
+		return x.Mints
+	}
+	return nil
+}
+
+type SubscribeBatchConfirmationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The batch key specified as raw bytes (gRPC only).
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// The batch key specified as a hex encoded string (use this for REST).
+	BatchKeyStr string `protobuf:"bytes,2,opt,name=batch_key_str,json=batchKeyStr,proto3" json:"batch_key_str,omitempty"`
+	// The number of confirmations of the minting transaction to wait for. Must
+	// be at least 1.
+	TargetConfs uint32 `protobuf:"varint,3,opt,name=target_confs,json=targetConfs,proto3" json:"target_confs,omitempty"`
+}
+
+func (x *SubscribeBatchConfirmationRequest) Reset() {
+	*x = SubscribeBatchConfirmationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeBatchConfirmationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeBatchConfirmationRequest) ProtoMessage() {}
+
+func (x *SubscribeBatchConfirmationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeBatchConfirmationRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeBatchConfirmationRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SubscribeBatchConfirmationRequest) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *SubscribeBatchConfirmationRequest) GetBatchKeyStr() string {
+	if x != nil {
+		return x.BatchKeyStr
+	}
+	return ""
+}
+
+func (x *SubscribeBatchConfirmationRequest) GetTargetConfs() uint32 {
+	if x != nil {
+		return x.TargetConfs
+	}
+	return 0
+}
+
+type BatchConfirmationEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The type of the event.
+	Type BatchConfEventType `protobuf:"varint,1,opt,name=type,proto3,enum=mintrpc.BatchConfEventType" json:"type,omitempty"`
+	// The key of the batch.
+	BatchKey []byte `protobuf:"bytes,2,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// The txid of the minting transaction of the batch.
+	AnchorTxid string `protobuf:"bytes,3,opt,name=anchor_txid,json=anchorTxid,proto3" json:"anchor_txid,omitempty"`
+	// The current number of confirmations of the minting transaction.
+	NumConfs uint32 `protobuf:"varint,4,opt,name=num_confs,json=numConfs,proto3" json:"num_confs,omitempty"`
+	// The number of confirmations that is waited for.
+	TargetConfs uint32 `protobuf:"varint,5,opt,name=target_confs,json=targetConfs,proto3" json:"target_confs,omitempty"`
+	// The height of the block the minting transaction is confirmed in, or zero
+	// if it's unconfirmed.
+	BlockHeight uint32 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The hash of the block the minting transaction is confirmed in, or empty if
+	// it's unconfirmed.
+	BlockHash string `protobuf:"bytes,7,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// The unix timestamp in microseconds of when the event was created.
+	Timestamp int64 `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *BatchConfirmationEvent) Reset() {
+	*x = BatchConfirmationEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchConfirmationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchConfirmationEvent) ProtoMessage() {}
+
+func (x *BatchConfirmationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchConfirmationEvent.ProtoReflect.Descriptor instead.
+func (*BatchConfirmationEvent) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BatchConfirmationEvent) GetType() BatchConfEventType {
+	if x != nil {
+		return x.Type
+	}
+	return BatchConfEventType_BATCH_CONF_EVENT_UPDATE
+}
+
+func (x *BatchConfirmationEvent) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *BatchConfirmationEvent) GetAnchorTxid() string {
+	if x != nil {
+		return x.AnchorTxid
+	}
+	return ""
+}
+
+func (x *BatchConfirmationEvent) GetNumConfs() uint32 {
+	if x != nil {
+		return x.NumConfs
+	}
+	return 0
+}
+
+func (x *BatchConfirmationEvent) GetTargetConfs() uint32 {
+	if x != nil {
+		return x.TargetConfs
+	}
+	return 0
+}
+
+func (x *BatchConfirmationEvent) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *BatchConfirmationEvent) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *BatchConfirmationEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+var File_mintrpc_mint_proto protoreflect.FileDescriptor
+
+var file_mintrpc_mint_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x1a, 0x13, 0x74,
+	0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x96, 0x04, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x12, 0x30, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x09, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a,
+	0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x12, 0x39, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x11, 0x65,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x10,
+	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x30, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b,
+	0x65, 0x79, 0x12, 0x43, 0x0a, 0x12, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x2c, 0x0a,
+	0x12, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x22, 0x4a, 0x0a, 0x0d, 0x45,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xab, 0x01, 0x0a, 0x10, 0x4d, 0x69, 0x6e, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x05,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4f, 0x0a, 0x11, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0xc6, 0x01, 0x0a, 0x0c, 0x4d, 0x69, 0x6e, 0x74, 0x69,
+	0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d,
+	0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73,
+	0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22,
+	0xdf, 0x01, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x61,
+	0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a,
+	0x0e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x22, 0x44, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x32, 0x0a,
+	0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65,
+	0x79, 0x22, 0xa1, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b,
+	0x65, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b,
+	0x0a, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x4f, 0x6e, 0x6c, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x44, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0x56, 0x0a, 0x13, 0x42,
+	0x75, 0x6d, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12,
+	0x22, 0x0a, 0x0d, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62,
+	0x79, 0x74, 0x65, 0x22, 0x2a, 0x0a, 0x14, 0x42, 0x75, 0x6d, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22,
+	0x6c, 0x0a, 0x1c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x24,
+	0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65,
+	0x79, 0x53, 0x74, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x6d, 0x0a,
+	0x11, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x59, 0x0a, 0x1d,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x09, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x67, 0x0a, 0x17, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65,
+	0x79, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73,
+	0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x22, 0x9e, 0x02, 0x0a, 0x18, 0x46, 0x65, 0x74, 0x63, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x78, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x78, 0x5f,
+	0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0d, 0x74, 0x78, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x22, 0xbf, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x69, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23,
+	0x0a, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x11, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0x50, 0x0a, 0x16, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x4b, 0x65, 0x79, 0x22, 0xaf, 0x01, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x73, 0x62, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x50, 0x73, 0x62,
+	0x74, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6b, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x23, 0x0a, 0x0d, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x73, 0x62, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x50, 0x73, 0x62, 0x74, 0x22, 0x7e, 0x0a, 0x17, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x73, 0x62, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x73, 0x62, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x18, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x22, 0x54, 0x0a,
+	0x12, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x22, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x53, 0x61, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x73, 0x22, 0xb9, 0x01, 0x0a, 0x0b, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22,
+	0xb3, 0x02, 0x0a, 0x0a, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d,
+	0x69, 0x6e, 0x74, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54,
+	0x78, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0x3e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x05,
+	0x6d, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x21, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x73, 0x22,
+	0xa7, 0x02, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x78, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75,
+	0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2a, 0xac, 0x02, 0x0a, 0x0a, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x41, 0x54, 0x43,
+	0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x50, 0x45, 0x44, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x41,
+	0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x5a, 0x45, 0x4e,
+	0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a,
+	0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x52, 0x4f,
+	0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43,
+	0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45,
+	0x44, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x06, 0x12, 0x22,
+	0x0a, 0x1e, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x45,
+	0x45, 0x44, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44,
+	0x10, 0x07, 0x12, 0x20, 0x0a, 0x1c, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x53, 0x50, 0x52, 0x4f, 0x55, 0x54, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c,
+	0x45, 0x44, 0x10, 0x08, 0x12, 0x22, 0x0a, 0x1e, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x41, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x49, 0x47,
+	0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x09, 0x2a, 0x75, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x6f, 0x6e, 0x66, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b,
+	0x0a, 0x17, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x42,
+	0x41, 0x54, 0x43, 0x48, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x43, 0x48, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x1d, 0x0a, 0x19, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x32,
+	0xeb, 0x07, 0x0a, 0x04, 0x4d, 0x69, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d,
+	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x2e,
+	0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d,
+	0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4b, 0x0a, 0x0c, 0x42, 0x75, 0x6d, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x46, 0x65, 0x65,
+	0x12, 0x1c, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a,
+	0x15, 0x46, 0x65, 0x74, 0x63, 0x68, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x25, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x45, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x46, 0x65, 0x74, 0x63, 0x68, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b,
+	0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x6d,
+	0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x51, 0x0a, 0x0e, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x2e,
+	0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54,
+	0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x73, 0x62,
+	0x74, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x50, 0x73, 0x62, 0x74, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50,
+	0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x69, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x38, 0x5a,
+	0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f,
+	0x74, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2f,
+	0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mintrpc_mint_proto_rawDescOnce sync.Once
+	file_mintrpc_mint_proto_rawDescData = file_mintrpc_mint_proto_rawDesc
+)
+
+func file_mintrpc_mint_proto_rawDescGZIP() []byte {
+	file_mintrpc_mint_proto_rawDescOnce.Do(func() {
+		file_mintrpc_mint_proto_rawDescData = protoimpl.X.CompressGZIP(file_mintrpc_mint_proto_rawDescData)
+	})
+	return file_mintrpc_mint_proto_rawDescData
+}
+
+var file_mintrpc_mint_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_mintrpc_mint_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_mintrpc_mint_proto_goTypes = []interface{}{
+	(BatchState)(0),                           // 0: mintrpc.BatchState
+	(BatchConfEventType)(0),                   // 1: mintrpc.BatchConfEventType
+	(*MintAsset)(nil),                         // 2: mintrpc.MintAsset
+	(*EmissionEvent)(nil),                     // 3: mintrpc.EmissionEvent
+	(*MintAssetRequest)(nil),                  // 4: mintrpc.MintAssetRequest
+	(*MintAssetResponse)(nil),                 // 5: mintrpc.MintAssetResponse
+	(*MintingBatch)(nil),                      // 6: mintrpc.MintingBatch
+	(*FinalizeBatchRequest)(nil),              // 7: mintrpc.FinalizeBatchRequest
+	(*FinalizeBatchResponse)(nil),             // 8: mintrpc.FinalizeBatchResponse
+	(*CancelBatchRequest)(nil),                // 9: mintrpc.CancelBatchRequest
+	(*CancelBatchResponse)(nil),               // 10: mintrpc.CancelBatchResponse
+	(*ListBatchRequest)(nil),                  // 11: mintrpc.ListBatchRequest
+	(*ListBatchResponse)(nil),                 // 12: mintrpc.ListBatchResponse
+	(*BumpBatchFeeRequest)(nil),               // 13: mintrpc.BumpBatchFeeRequest
+	(*BumpBatchFeeResponse)(nil),              // 14: mintrpc.BumpBatchFeeResponse
+	(*FetchEmissionScheduleRequest)(nil),      // 15: mintrpc.FetchEmissionScheduleRequest
+	(*ScheduledEmission)(nil),                 // 16: mintrpc.ScheduledEmission
+	(*FetchEmissionScheduleResponse)(nil),     // 17: mintrpc.FetchEmissionScheduleResponse
+	(*FetchBatchAnchorRequest)(nil),           // 18: mintrpc.FetchBatchAnchorRequest
+	(*FetchBatchAnchorResponse)(nil),          // 19: mintrpc.FetchBatchAnchorResponse
+	(*PreviewAssetIDRequest)(nil),             // 20: mintrpc.PreviewAssetIDRequest
+	(*PreviewAssetIDResponse)(nil),            // 21: mintrpc.PreviewAssetIDResponse
+	(*CommitBatchPsbtRequest)(nil),            // 22: mintrpc.CommitBatchPsbtRequest
+	(*CommitBatchPsbtResponse)(nil),           // 23: mintrpc.CommitBatchPsbtResponse
+	(*PublishBatchPsbtRequest)(nil),           // 24: mintrpc.PublishBatchPsbtRequest
+	(*PublishBatchPsbtResponse)(nil),          // 25: mintrpc.PublishBatchPsbtResponse
+	(*GenesisPointPolicy)(nil),                // 26: mintrpc.GenesisPointPolicy
+	(*ListMintsRequest)(nil),                  // 27: mintrpc.ListMintsRequest
+	(*MintedAsset)(nil),                       // 28: mintrpc.MintedAsset
+	(*MintRecord)(nil),                        // 29: mintrpc.MintRecord
+	(*ListMintsResponse)(nil),                 // 30: mintrpc.ListMintsResponse
+	(*SubscribeBatchConfirmationRequest)(nil), // 31: mintrpc.SubscribeBatchConfirmationRequest
+	(*BatchConfirmationEvent)(nil),            // 32: mintrpc.BatchConfirmationEvent
+	(taprpc.AssetType)(0),                     // 33: taprpc.AssetType
+	(*taprpc.AssetMeta)(nil),                  // 34: taprpc.AssetMeta
+	(taprpc.AssetVersion)(0),                  // 35: taprpc.AssetVersion
+	(*taprpc.ScriptKey)(nil),                  // 36: taprpc.ScriptKey
+	(*taprpc.KeyDescriptor)(nil),              // 37: taprpc.KeyDescriptor
+}
+var file_mintrpc_mint_proto_depIdxs = []int32{
+	33, // 0: mintrpc.MintAsset.asset_type:type_name -> taprpc.AssetType
+	34, // 1: mintrpc.MintAsset.asset_meta:type_name -> taprpc.AssetMeta
+	35, // 2: mintrpc.MintAsset.asset_version:type_name -> taprpc.AssetVersion
+	3,  // 3: mintrpc.MintAsset.emission_schedule:type_name -> mintrpc.EmissionEvent
+	36, // 4: mintrpc.MintAsset.script_key:type_name -> taprpc.ScriptKey
+	37, // 5: mintrpc.MintAsset.group_internal_key:type_name -> taprpc.KeyDescriptor
+	2,  // 6: mintrpc.MintAssetRequest.asset:type_name -> mintrpc.MintAsset
+	6,  // 7: mintrpc.MintAssetResponse.pending_batch:type_name -> mintrpc.MintingBatch
+	2,  // 8: mintrpc.MintingBatch.assets:type_name -> mintrpc.MintAsset
+	0,  // 9: mintrpc.MintingBatch.state:type_name -> mintrpc.BatchState
+	26, // 10: mintrpc.FinalizeBatchRequest.genesis_policy:type_name -> mintrpc.GenesisPointPolicy
+	6,  // 11: mintrpc.FinalizeBatchResponse.batch:type_name -> mintrpc.MintingBatch
+	6,  // 12: mintrpc.ListBatchResponse.batches:type_name -> mintrpc.MintingBatch
+	16, // 13: mintrpc.FetchEmissionScheduleResponse.emissions:type_name -> mintrpc.ScheduledEmission
+	2,  // 14: mintrpc.PreviewAssetIDRequest.asset:type_name -> mintrpc.MintAsset
+	6,  // 15: mintrpc.CommitBatchPsbtResponse.batch:type_name -> mintrpc.MintingBatch
+	6,  // 16: mintrpc.PublishBatchPsbtResponse.batch:type_name -> mintrpc.MintingBatch
+	0,  // 17: mintrpc.ListMintsRequest.states:type_name -> mintrpc.BatchState
+	33, // 18: mintrpc.MintedAsset.asset_type:type_name -> taprpc.AssetType
+	0,  // 19: mintrpc.MintRecord.state:type_name -> mintrpc.BatchState
+	28, // 20: mintrpc.MintRecord.assets:type_name -> mintrpc.MintedAsset
+	29, // 21: mintrpc.ListMintsResponse.mints:type_name -> mintrpc.MintRecord
+	1,  // 22: mintrpc.BatchConfirmationEvent.type:type_name -> mintrpc.BatchConfEventType
+	4,  // 23: mintrpc.Mint.MintAsset:input_type -> mintrpc.MintAssetRequest
+	7,  // 24: mintrpc.Mint.FinalizeBatch:input_type -> mintrpc.FinalizeBatchRequest
+	9,  // 25: mintrpc.Mint.CancelBatch:input_type -> mintrpc.CancelBatchRequest
+	11, // 26: mintrpc.Mint.ListBatches:input_type -> mintrpc.ListBatchRequest
+	27, // 27: mintrpc.Mint.ListMints:input_type -> mintrpc.ListMintsRequest
+	13, // 28: mintrpc.Mint.BumpBatchFee:input_type -> mintrpc.BumpBatchFeeRequest
+	15, // 29: mintrpc.Mint.FetchEmissionSchedule:input_type -> mintrpc.FetchEmissionScheduleRequest
+	18, // 30: mintrpc.Mint.FetchBatchAnchor:input_type -> mintrpc.FetchBatchAnchorRequest
+	31, // 31: mintrpc.Mint.SubscribeBatchConfirmation:input_type -> mintrpc.SubscribeBatchConfirmationRequest
+	20, // 32: mintrpc.Mint.PreviewAssetID:input_type -> mintrpc.PreviewAssetIDRequest
+	22, // 33: mintrpc.Mint.CommitBatchPsbt:input_type -> mintrpc.CommitBatchPsbtRequest
+	24, // 34: mintrpc.Mint.PublishBatchPsbt:input_type -> mintrpc.PublishBatchPsbtRequest
+	5,  // 35: mintrpc.Mint.MintAsset:output_type -> mintrpc.MintAssetResponse
+	8,  // 36: mintrpc.Mint.FinalizeBatch:output_type -> mintrpc.FinalizeBatchResponse
+	10, // 37: mintrpc.Mint.CancelBatch:output_type -> mintrpc.CancelBatchResponse
+	12, // 38: mintrpc.Mint.ListBatches:output_type -> mintrpc.ListBatchResponse
+	30, // 39: mintrpc.Mint.ListMints:output_type -> mintrpc.ListMintsResponse
+	14, // 40: mintrpc.Mint.BumpBatchFee:output_type -> mintrpc.BumpBatchFeeResponse
+	17, // 41: mintrpc.Mint.FetchEmissionSchedule:output_type -> mintrpc.FetchEmissionScheduleResponse
+	19, // 42: mintrpc.Mint.FetchBatchAnchor:output_type -> mintrpc.FetchBatchAnchorResponse
+	32, // 43: mintrpc.Mint.SubscribeBatchConfirmation:output_type -> mintrpc.BatchConfirmationEvent
+	21, // 44: mintrpc.Mint.PreviewAssetID:output_type -> mintrpc.PreviewAssetIDResponse
+	23, // 45: mintrpc.Mint.CommitBatchPsbt:output_type -> mintrpc.CommitBatchPsbtResponse
+	25, // 46: mintrpc.Mint.PublishBatchPsbt:output_type -> mintrpc.PublishBatchPsbtResponse
+	35, // [35:47] is the sub-list for method output_type
+	23, // [23:35] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_mintrpc_mint_proto_init() }
+func file_mintrpc_mint_proto_init() {
+	if File_mintrpc_mint_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_mintrpc_mint_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintAsset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
 			default:
@@ -892,7 +2949,7 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MintAssetRequest); i {
+			switch v := v.(*EmissionEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -904,7 +2961,7 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MintAssetResponse); i {
+			switch v := v.(*MintAssetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -916,7 +2973,7 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MintingBatch); i {
+			switch v := v.(*MintAssetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -928,7 +2985,7 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FinalizeBatchRequest); i {
+			switch v := v.(*MintingBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -940,7 +2997,7 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FinalizeBatchResponse); i {
+			switch v := v.(*FinalizeBatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -952,7 +3009,7 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelBatchRequest); i {
+			switch v := v.(*FinalizeBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -964,7 +3021,7 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelBatchResponse); i {
+			switch v := v.(*CancelBatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -976,7 +3033,7 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBatchRequest); i {
+			switch v := v.(*CancelBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -988,6 +3045,18 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListBatchResponse); i {
 			case 0:
 				return &v.state
@@ -999,18 +3068,266 @@ func file_mintrpc_mint_proto_init() {
 				return nil
 			}
 		}
+		file_mintrpc_mint_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpBatchFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpBatchFeeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchEmissionScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledEmission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchEmissionScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchBatchAnchorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchBatchAnchorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewAssetIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewAssetIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitBatchPsbtRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitBatchPsbtResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishBatchPsbtRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishBatchPsbtResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisPointPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMintsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintedAsset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMintsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeBatchConfirmationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchConfirmationEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_mintrpc_mint_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_mintrpc_mint_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*ListBatchRequest_BatchKey)(nil),
 		(*ListBatchRequest_BatchKeyStr)(nil),
 	}
+	file_mintrpc_mint_proto_msgTypes[13].OneofWrappers = []interface{}{
+		(*FetchEmissionScheduleRequest_GroupKey)(nil),
+		(*FetchEmissionScheduleRequest_GroupKeyStr)(nil),
+	}
+	file_mintrpc_mint_proto_msgTypes[16].OneofWrappers = []interface{}{
+		(*FetchBatchAnchorRequest_BatchKey)(nil),
+		(*FetchBatchAnchorRequest_BatchKeyStr)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mintrpc_mint_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      2,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
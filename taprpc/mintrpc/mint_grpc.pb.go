@@ -29,12 +29,73 @@ type MintClient interface {
 	// FinalizeBatch will attempt to finalize the current pending batch.
 	FinalizeBatch(ctx context.Context, in *FinalizeBatchRequest, opts ...grpc.CallOption) (*FinalizeBatchResponse, error)
 	// tapcli: `assets mint cancel`
-	// CancelBatch will attempt to cancel the current pending batch.
+	// CancelBatch will attempt to cancel the current pending batch. Any inputs
+	// leased to fund the batch are released. A batch can no longer be cancelled
+	// once its minting transaction has been broadcast. If there is no active
+	// batch, this call is a no-op.
 	CancelBatch(ctx context.Context, in *CancelBatchRequest, opts ...grpc.CallOption) (*CancelBatchResponse, error)
 	// tapcli: `assets mint batches`
 	// ListBatches lists the set of batches submitted to the daemon, including
 	// pending and cancelled batches.
 	ListBatches(ctx context.Context, in *ListBatchRequest, opts ...grpc.CallOption) (*ListBatchResponse, error)
+	// tapcli: `assets mint history`
+	// ListMints lists the issuance history of the node, which is every batch that
+	// was ever submitted for minting, including cancelled and failed batches. As
+	// opposed to the assets owned by the node, this includes minted assets that
+	// were sent away since. The batches can be filtered by their creation time
+	// and state.
+	ListMints(ctx context.Context, in *ListMintsRequest, opts ...grpc.CallOption) (*ListMintsResponse, error)
+	// tapcli: `assets mint bump`
+	// BumpBatchFee will attempt to replace the broadcast minting transaction of
+	// a batch with one that pays a higher fee, using replace-by-fee (RBF). The
+	// fee increase is paid for by the change output of the minting transaction.
+	// An error is returned if the minting transaction is already confirmed.
+	BumpBatchFee(ctx context.Context, in *BumpBatchFeeRequest, opts ...grpc.CallOption) (*BumpBatchFeeResponse, error)
+	// tapcli: `assets mint emission`
+	// FetchEmissionSchedule returns the remaining emission schedule of the asset
+	// group with the given key, meaning all scheduled issuance events that
+	// weren't issued yet. The group key of a new asset group is only known once
+	// the batch that creates it has been committed.
+	FetchEmissionSchedule(ctx context.Context, in *FetchEmissionScheduleRequest, opts ...grpc.CallOption) (*FetchEmissionScheduleResponse, error)
+	// tapcli: `assets mint anchor`
+	// FetchBatchAnchor returns the on-chain anchor of the batch with the given
+	// key, meaning the raw minting transaction and the index of the output that
+	// commits to the assets of the batch. Once the minting transaction is
+	// confirmed, the block it was confirmed in and a merkle proof of the
+	// transaction's inclusion in that block are returned as well, so the anchor
+	// can be verified independently.
+	FetchBatchAnchor(ctx context.Context, in *FetchBatchAnchorRequest, opts ...grpc.CallOption) (*FetchBatchAnchorResponse, error)
+	// tapcli: `assets mint confirmations`
+	// SubscribeBatchConfirmation tracks the confirmations of the minting
+	// transaction of a batch until the stream is closed. An event is sent for the
+	// current state, for each new confirmation until the target number of
+	// confirmations is reached, and once the target is reached. If a re-org rolls
+	// the minting transaction back, a rollback event is sent and the
+	// confirmations are reported again until the target is reached once more.
+	SubscribeBatchConfirmation(ctx context.Context, in *SubscribeBatchConfirmationRequest, opts ...grpc.CallOption) (Mint_SubscribeBatchConfirmationClient, error)
+	// tapcli: `assets mint preview`
+	// PreviewAssetID computes the asset ID an asset would be minted with, given
+	// its genesis parameters and the genesis point of the minting transaction,
+	// without minting the asset. If the asset anchors a new asset group and the
+	// internal key of the group is specified, then the group key is returned as
+	// well. The asset ID commits to the genesis point, so minting the asset with
+	// a different genesis point (e.g. because the minting transaction was funded
+	// with other inputs) results in a different asset ID.
+	PreviewAssetID(ctx context.Context, in *PreviewAssetIDRequest, opts ...grpc.CallOption) (*PreviewAssetIDResponse, error)
+	// tapcli: `assets mint psbt commit`
+	// CommitBatchPsbt commits the assets of a batch to an output of a minting
+	// transaction that was funded by an external wallet, instead of funding the
+	// minting transaction with the internal wallet. The batch is frozen and the
+	// returned PSBT, in which the anchor output now commits to the assets of the
+	// batch, must then be signed by the external wallet and handed back with
+	// PublishBatchPsbt. Besides the anchor output, the minting transaction can
+	// only have a single change output.
+	CommitBatchPsbt(ctx context.Context, in *CommitBatchPsbtRequest, opts ...grpc.CallOption) (*CommitBatchPsbtResponse, error)
+	// tapcli: `assets mint psbt publish`
+	// PublishBatchPsbt finalizes and broadcasts the signed minting transaction
+	// of a batch that was committed with CommitBatchPsbt. The signed transaction
+	// must be the exact transaction that was returned by CommitBatchPsbt.
+	PublishBatchPsbt(ctx context.Context, in *PublishBatchPsbtRequest, opts ...grpc.CallOption) (*PublishBatchPsbtResponse, error)
 }
 
 type mintClient struct {
@@ -81,6 +142,101 @@ func (c *mintClient) ListBatches(ctx context.Context, in *ListBatchRequest, opts
 	return out, nil
 }
 
+func (c *mintClient) ListMints(ctx context.Context, in *ListMintsRequest, opts ...grpc.CallOption) (*ListMintsResponse, error) {
+	out := new(ListMintsResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/ListMints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) BumpBatchFee(ctx context.Context, in *BumpBatchFeeRequest, opts ...grpc.CallOption) (*BumpBatchFeeResponse, error) {
+	out := new(BumpBatchFeeResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/BumpBatchFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) FetchEmissionSchedule(ctx context.Context, in *FetchEmissionScheduleRequest, opts ...grpc.CallOption) (*FetchEmissionScheduleResponse, error) {
+	out := new(FetchEmissionScheduleResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/FetchEmissionSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) FetchBatchAnchor(ctx context.Context, in *FetchBatchAnchorRequest, opts ...grpc.CallOption) (*FetchBatchAnchorResponse, error) {
+	out := new(FetchBatchAnchorResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/FetchBatchAnchor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) SubscribeBatchConfirmation(ctx context.Context, in *SubscribeBatchConfirmationRequest, opts ...grpc.CallOption) (Mint_SubscribeBatchConfirmationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Mint_ServiceDesc.Streams[0], "/mintrpc.Mint/SubscribeBatchConfirmation", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mintSubscribeBatchConfirmationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Mint_SubscribeBatchConfirmationClient interface {
+	Recv() (*BatchConfirmationEvent, error)
+	grpc.ClientStream
+}
+
+type mintSubscribeBatchConfirmationClient struct {
+	grpc.ClientStream
+}
+
+func (x *mintSubscribeBatchConfirmationClient) Recv() (*BatchConfirmationEvent, error) {
+	m := new(BatchConfirmationEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mintClient) PreviewAssetID(ctx context.Context, in *PreviewAssetIDRequest, opts ...grpc.CallOption) (*PreviewAssetIDResponse, error) {
+	out := new(PreviewAssetIDResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/PreviewAssetID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) CommitBatchPsbt(ctx context.Context, in *CommitBatchPsbtRequest, opts ...grpc.CallOption) (*CommitBatchPsbtResponse, error) {
+	out := new(CommitBatchPsbtResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/CommitBatchPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) PublishBatchPsbt(ctx context.Context, in *PublishBatchPsbtRequest, opts ...grpc.CallOption) (*PublishBatchPsbtResponse, error) {
+	out := new(PublishBatchPsbtResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/PublishBatchPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MintServer is the server API for Mint service.
 // All implementations must embed UnimplementedMintServer
 // for forward compatibility
@@ -96,12 +252,73 @@ type MintServer interface {
 	// FinalizeBatch will attempt to finalize the current pending batch.
 	FinalizeBatch(context.Context, *FinalizeBatchRequest) (*FinalizeBatchResponse, error)
 	// tapcli: `assets mint cancel`
-	// CancelBatch will attempt to cancel the current pending batch.
+	// CancelBatch will attempt to cancel the current pending batch. Any inputs
+	// leased to fund the batch are released. A batch can no longer be cancelled
+	// once its minting transaction has been broadcast. If there is no active
+	// batch, this call is a no-op.
 	CancelBatch(context.Context, *CancelBatchRequest) (*CancelBatchResponse, error)
 	// tapcli: `assets mint batches`
 	// ListBatches lists the set of batches submitted to the daemon, including
 	// pending and cancelled batches.
 	ListBatches(context.Context, *ListBatchRequest) (*ListBatchResponse, error)
+	// tapcli: `assets mint history`
+	// ListMints lists the issuance history of the node, which is every batch that
+	// was ever submitted for minting, including cancelled and failed batches. As
+	// opposed to the assets owned by the node, this includes minted assets that
+	// were sent away since. The batches can be filtered by their creation time
+	// and state.
+	ListMints(context.Context, *ListMintsRequest) (*ListMintsResponse, error)
+	// tapcli: `assets mint bump`
+	// BumpBatchFee will attempt to replace the broadcast minting transaction of
+	// a batch with one that pays a higher fee, using replace-by-fee (RBF). The
+	// fee increase is paid for by the change output of the minting transaction.
+	// An error is returned if the minting transaction is already confirmed.
+	BumpBatchFee(context.Context, *BumpBatchFeeRequest) (*BumpBatchFeeResponse, error)
+	// tapcli: `assets mint emission`
+	// FetchEmissionSchedule returns the remaining emission schedule of the asset
+	// group with the given key, meaning all scheduled issuance events that
+	// weren't issued yet. The group key of a new asset group is only known once
+	// the batch that creates it has been committed.
+	FetchEmissionSchedule(context.Context, *FetchEmissionScheduleRequest) (*FetchEmissionScheduleResponse, error)
+	// tapcli: `assets mint anchor`
+	// FetchBatchAnchor returns the on-chain anchor of the batch with the given
+	// key, meaning the raw minting transaction and the index of the output that
+	// commits to the assets of the batch. Once the minting transaction is
+	// confirmed, the block it was confirmed in and a merkle proof of the
+	// transaction's inclusion in that block are returned as well, so the anchor
+	// can be verified independently.
+	FetchBatchAnchor(context.Context, *FetchBatchAnchorRequest) (*FetchBatchAnchorResponse, error)
+	// tapcli: `assets mint confirmations`
+	// SubscribeBatchConfirmation tracks the confirmations of the minting
+	// transaction of a batch until the stream is closed. An event is sent for the
+	// current state, for each new confirmation until the target number of
+	// confirmations is reached, and once the target is reached. If a re-org rolls
+	// the minting transaction back, a rollback event is sent and the
+	// confirmations are reported again until the target is reached once more.
+	SubscribeBatchConfirmation(*SubscribeBatchConfirmationRequest, Mint_SubscribeBatchConfirmationServer) error
+	// tapcli: `assets mint preview`
+	// PreviewAssetID computes the asset ID an asset would be minted with, given
+	// its genesis parameters and the genesis point of the minting transaction,
+	// without minting the asset. If the asset anchors a new asset group and the
+	// internal key of the group is specified, then the group key is returned as
+	// well. The asset ID commits to the genesis point, so minting the asset with
+	// a different genesis point (e.g. because the minting transaction was funded
+	// with other inputs) results in a different asset ID.
+	PreviewAssetID(context.Context, *PreviewAssetIDRequest) (*PreviewAssetIDResponse, error)
+	// tapcli: `assets mint psbt commit`
+	// CommitBatchPsbt commits the assets of a batch to an output of a minting
+	// transaction that was funded by an external wallet, instead of funding the
+	// minting transaction with the internal wallet. The batch is frozen and the
+	// returned PSBT, in which the anchor output now commits to the assets of the
+	// batch, must then be signed by the external wallet and handed back with
+	// PublishBatchPsbt. Besides the anchor output, the minting transaction can
+	// only have a single change output.
+	CommitBatchPsbt(context.Context, *CommitBatchPsbtRequest) (*CommitBatchPsbtResponse, error)
+	// tapcli: `assets mint psbt publish`
+	// PublishBatchPsbt finalizes and broadcasts the signed minting transaction
+	// of a batch that was committed with CommitBatchPsbt. The signed transaction
+	// must be the exact transaction that was returned by CommitBatchPsbt.
+	PublishBatchPsbt(context.Context, *PublishBatchPsbtRequest) (*PublishBatchPsbtResponse, error)
 	mustEmbedUnimplementedMintServer()
 }
 
@@ -121,6 +338,30 @@ func (UnimplementedMintServer) CancelBatch(context.Context, *CancelBatchRequest)
 func (UnimplementedMintServer) ListBatches(context.Context, *ListBatchRequest) (*ListBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBatches not implemented")
 }
+func (UnimplementedMintServer) ListMints(context.Context, *ListMintsRequest) (*ListMintsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMints not implemented")
+}
+func (UnimplementedMintServer) BumpBatchFee(context.Context, *BumpBatchFeeRequest) (*BumpBatchFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpBatchFee not implemented")
+}
+func (UnimplementedMintServer) FetchEmissionSchedule(context.Context, *FetchEmissionScheduleRequest) (*FetchEmissionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchEmissionSchedule not implemented")
+}
+func (UnimplementedMintServer) FetchBatchAnchor(context.Context, *FetchBatchAnchorRequest) (*FetchBatchAnchorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchBatchAnchor not implemented")
+}
+func (UnimplementedMintServer) SubscribeBatchConfirmation(*SubscribeBatchConfirmationRequest, Mint_SubscribeBatchConfirmationServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeBatchConfirmation not implemented")
+}
+func (UnimplementedMintServer) PreviewAssetID(context.Context, *PreviewAssetIDRequest) (*PreviewAssetIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewAssetID not implemented")
+}
+func (UnimplementedMintServer) CommitBatchPsbt(context.Context, *CommitBatchPsbtRequest) (*CommitBatchPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitBatchPsbt not implemented")
+}
+func (UnimplementedMintServer) PublishBatchPsbt(context.Context, *PublishBatchPsbtRequest) (*PublishBatchPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishBatchPsbt not implemented")
+}
 func (UnimplementedMintServer) mustEmbedUnimplementedMintServer() {}
 
 // UnsafeMintServer may be embedded to opt out of forward compatibility for this service.
@@ -206,6 +447,153 @@ func _Mint_ListBatches_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mint_ListMints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).ListMints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/ListMints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).ListMints(ctx, req.(*ListMintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_BumpBatchFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpBatchFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).BumpBatchFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/BumpBatchFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).BumpBatchFee(ctx, req.(*BumpBatchFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_FetchEmissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchEmissionScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).FetchEmissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/FetchEmissionSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).FetchEmissionSchedule(ctx, req.(*FetchEmissionScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_FetchBatchAnchor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchBatchAnchorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).FetchBatchAnchor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/FetchBatchAnchor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).FetchBatchAnchor(ctx, req.(*FetchBatchAnchorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_SubscribeBatchConfirmation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeBatchConfirmationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MintServer).SubscribeBatchConfirmation(m, &mintSubscribeBatchConfirmationServer{stream})
+}
+
+type Mint_SubscribeBatchConfirmationServer interface {
+	Send(*BatchConfirmationEvent) error
+	grpc.ServerStream
+}
+
+type mintSubscribeBatchConfirmationServer struct {
+	grpc.ServerStream
+}
+
+func (x *mintSubscribeBatchConfirmationServer) Send(m *BatchConfirmationEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Mint_PreviewAssetID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewAssetIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).PreviewAssetID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/PreviewAssetID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).PreviewAssetID(ctx, req.(*PreviewAssetIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_CommitBatchPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitBatchPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).CommitBatchPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/CommitBatchPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).CommitBatchPsbt(ctx, req.(*CommitBatchPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_PublishBatchPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishBatchPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).PublishBatchPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/PublishBatchPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).PublishBatchPsbt(ctx, req.(*PublishBatchPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Mint_ServiceDesc is the grpc.ServiceDesc for Mint service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,7 +617,41 @@ var Mint_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListBatches",
 			Handler:    _Mint_ListBatches_Handler,
 		},
+		{
+			MethodName: "ListMints",
+			Handler:    _Mint_ListMints_Handler,
+		},
+		{
+			MethodName: "BumpBatchFee",
+			Handler:    _Mint_BumpBatchFee_Handler,
+		},
+		{
+			MethodName: "FetchEmissionSchedule",
+			Handler:    _Mint_FetchEmissionSchedule_Handler,
+		},
+		{
+			MethodName: "FetchBatchAnchor",
+			Handler:    _Mint_FetchBatchAnchor_Handler,
+		},
+		{
+			MethodName: "PreviewAssetID",
+			Handler:    _Mint_PreviewAssetID_Handler,
+		},
+		{
+			MethodName: "CommitBatchPsbt",
+			Handler:    _Mint_CommitBatchPsbt_Handler,
+		},
+		{
+			MethodName: "PublishBatchPsbt",
+			Handler:    _Mint_PublishBatchPsbt_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeBatchConfirmation",
+			Handler:       _Mint_SubscribeBatchConfirmation_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "mintrpc/mint.proto",
 }
@@ -25,6 +25,11 @@ type TaprootAssetsClient interface {
 	// ListUtxos lists the UTXOs managed by the target daemon, and the assets they
 	// hold.
 	ListUtxos(ctx context.Context, in *ListUtxosRequest, opts ...grpc.CallOption) (*ListUtxosResponse, error)
+	// tapcli: `assets setlabel`
+	// SetAssetLabel sets the human-readable label of all assets with the given
+	// asset ID. The label is only stored locally and is not committed to
+	// on-chain. An empty label removes the current label.
+	SetAssetLabel(ctx context.Context, in *SetAssetLabelRequest, opts ...grpc.CallOption) (*SetAssetLabelResponse, error)
 	// tapcli: `assets groups`
 	// ListGroups lists the asset groups known to the target daemon, and the assets
 	// held in each group.
@@ -33,8 +38,16 @@ type TaprootAssetsClient interface {
 	// ListBalances lists asset balances
 	ListBalances(ctx context.Context, in *ListBalancesRequest, opts ...grpc.CallOption) (*ListBalancesResponse, error)
 	// tapcli: `assets transfers`
-	// ListTransfers lists outbound asset transfers tracked by the target daemon.
+	// ListTransfers lists outbound asset transfers tracked by the target daemon,
+	// including their confirmation and proof delivery status. Inbound transfers
+	// are tracked as address events and can be listed with AddrReceives, or
+	// included along with their payment status by setting include_receives.
 	ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error)
+	// tapcli: `assets transfers --follow`
+	// SubscribeTransfers registers a subscription to state updates of outbound
+	// asset transfers. An event is sent each time a transfer was broadcast,
+	// confirmed or completed its proof delivery.
+	SubscribeTransfers(ctx context.Context, in *SubscribeTransfersRequest, opts ...grpc.CallOption) (TaprootAssets_SubscribeTransfersClient, error)
 	// tapcli: `stop`
 	// StopDaemon will send a shutdown request to the interrupt handler, triggering
 	// a graceful shutdown of the daemon.
@@ -47,14 +60,28 @@ type TaprootAssetsClient interface {
 	DebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*DebugLevelResponse, error)
 	// tapcli: `addrs query`
 	// QueryAddrs queries the set of Taproot Asset addresses stored in the
-	// database.
+	// database, together with whether each of them has been paid.
 	QueryAddrs(ctx context.Context, in *QueryAddrRequest, opts ...grpc.CallOption) (*QueryAddrResponse, error)
+	// tapcli: `addrs list`
+	// ListAddrs lists the Taproot Asset addresses stored in the database together
+	// with their paid and revocation status. Revoked addresses are only returned
+	// if explicitly requested.
+	ListAddrs(ctx context.Context, in *ListAddrsRequest, opts ...grpc.CallOption) (*ListAddrsResponse, error)
 	// tapcli: `addrs new`
 	// NewAddr makes a new address from the set of request params.
 	NewAddr(ctx context.Context, in *NewAddrRequest, opts ...grpc.CallOption) (*Addr, error)
+	// tapcli: `addrs revoke`
+	// RevokeAddr revokes a Taproot Asset address that hasn't received a payment
+	// yet. Payments to a revoked address are no longer claimed by the wallet,
+	// and proofs for its script key are rejected by the local universe server
+	// when it acts as the proof courier. An already paid address can't be
+	// revoked.
+	RevokeAddr(ctx context.Context, in *RevokeAddrRequest, opts ...grpc.CallOption) (*RevokeAddrResponse, error)
 	// tapcli: `addrs decode`
 	// DecodeAddr decode a Taproot Asset address into a partial asset message that
-	// represents the asset it wants to receive.
+	// represents the asset it wants to receive. The checksum and the network
+	// prefix of the address are validated, and an InvalidArgument error is
+	// returned for malformed addresses.
 	DecodeAddr(ctx context.Context, in *DecodeAddrRequest, opts ...grpc.CallOption) (*Addr, error)
 	// tapcli: `addrs receives`
 	// List all receives for incoming asset transfers for addresses that were
@@ -62,32 +89,106 @@ type TaprootAssetsClient interface {
 	AddrReceives(ctx context.Context, in *AddrReceivesRequest, opts ...grpc.CallOption) (*AddrReceivesResponse, error)
 	// tapcli: `proofs verify`
 	// VerifyProof attempts to verify a given proof file that claims to be anchored
-	// at the specified genesis point.
+	// at the specified genesis point. Each proof in the file is verified in order
+	// and the result of each step is returned. If a universe host is specified,
+	// each proof is also checked to be included in the universe of that server.
+	// The proof is not imported and no local state is modified.
 	VerifyProof(ctx context.Context, in *ProofFile, opts ...grpc.CallOption) (*VerifyProofResponse, error)
 	// tapcli: `proofs decode`
 	// DecodeProof attempts to decode a given proof file into human readable
 	// format.
 	DecodeProof(ctx context.Context, in *DecodeProofRequest, opts ...grpc.CallOption) (*DecodeProofResponse, error)
+	// tapcli: `proofs decodecommitment`
+	// DecodeCommitment reconstructs the Taproot Asset commitment of an anchor
+	// output from the proofs of all assets it's claimed to commit to, and checks
+	// that it matches the taproot output key of the anchor output. This allows
+	// independently verifying on-chain that an output commits to exactly the
+	// claimed set of assets. The proofs themselves aren't verified.
+	DecodeCommitment(ctx context.Context, in *DecodeCommitmentRequest, opts ...grpc.CallOption) (*DecodeCommitmentResponse, error)
 	// tapcli: `proofs export`
 	// ExportProof exports the latest raw proof file anchored at the specified
-	// script_key.
+	// script_key. The returned file can be verified with VerifyProof and
+	// imported into another node with ImportProof. A NotFound error is returned
+	// if no proof is known for the asset, and a FailedPrecondition error if
+	// owned_only is set but the asset isn't held by this node.
 	ExportProof(ctx context.Context, in *ExportProofRequest, opts ...grpc.CallOption) (*ProofFile, error)
+	// tapcli: `proofs exportall`
+	// ExportProofs exports a full proof file for each leaf of the local issuance
+	// and transfer universes of an asset or asset group. One message is streamed
+	// per leaf, named by the outpoint and script key of the leaf. Each proof file
+	// can be verified with VerifyProof and imported with ImportProof. A leaf
+	// whose proof file can't be built is reported with the reason instead.
+	ExportProofs(ctx context.Context, in *ExportProofsRequest, opts ...grpc.CallOption) (TaprootAssets_ExportProofsClient, error)
+	// tapcli: `proofs import`
+	// ImportProof imports a proof file of an asset that was received
+	// out-of-band, for example one created with ExportProof on another node. The
+	// full proof chain is verified, and the script key of the final proof must
+	// belong to this node, unless track_only is set. The asset is then recorded
+	// as owned by this node and its proofs are inserted into the local universe.
+	// An Unavailable error is returned if the anchor transaction of the final
+	// proof doesn't have the minimum number of confirmations yet, in which case
+	// the import can be retried later.
+	ImportProof(ctx context.Context, in *ImportProofRequest, opts ...grpc.CallOption) (*ImportProofResponse, error)
+	// tapcli: `proofs claim`
+	// ClaimFromUniverse imports an asset that was sent to this node by pulling
+	// its proof from a universe, instead of waiting for the sender to deliver
+	// it. The transfer universe of the asset is queried for the leaf with the
+	// given script key, the full proof chain is fetched and verified, and the
+	// asset is imported as with ImportProof. A NotFound error is returned if the
+	// universe doesn't have the leaf yet, in which case the claim can be retried
+	// later.
+	ClaimFromUniverse(ctx context.Context, in *ClaimFromUniverseRequest, opts ...grpc.CallOption) (*ImportProofResponse, error)
+	// tapcli: `proofs verifygroup`
+	// VerifyGroupMembership verifies that two assets were issued into the same
+	// asset group. The genesis proof of each asset is verified, which includes
+	// the group witness that proves the asset was issued by the holder of the
+	// group key. The shared group key is returned if both assets are part of the
+	// same group, otherwise the reason for the mismatch is returned. This allows
+	// telling the assets of a genuine issuer apart from lookalike assets.
+	VerifyGroupMembership(ctx context.Context, in *VerifyGroupMembershipRequest, opts ...grpc.CallOption) (*VerifyGroupMembershipResponse, error)
 	// tapcli: `assets send`
 	// SendAsset uses one or multiple passed Taproot Asset address(es) to attempt
 	// to complete an asset send. The method returns information w.r.t the on chain
 	// send, as well as the proof file information the receiver needs to fully
-	// receive the asset.
+	// receive the asset. The strategy used to select the asset UTXOs that fund
+	// the send can be chosen, and the selected UTXOs are returned. Sending more
+	// units than the available balance is rejected. If the send fails before
+	// the anchor transaction is broadcast, the selected asset UTXOs are released
+	// again. Addresses of different assets can be paid at once, in which case
+	// all of them are paid by a single anchor transaction.
 	SendAsset(ctx context.Context, in *SendAssetRequest, opts ...grpc.CallOption) (*SendAssetResponse, error)
+	// tapcli: `assets estimatefee`
+	// EstimateTransferFee estimates the on-chain fee and virtual size of the
+	// anchor transaction of a send to the given Taproot Asset address(es). The
+	// asset UTXOs are selected and the anchor transaction is constructed the same
+	// way as for SendAsset, but nothing is signed or broadcast and all selected
+	// UTXOs are released again. An error is returned if the requested amounts
+	// can't be paid with the available asset UTXOs.
+	EstimateTransferFee(ctx context.Context, in *EstimateTransferFeeRequest, opts ...grpc.CallOption) (*EstimateTransferFeeResponse, error)
 	// tapcli: `assets burn`
 	// BurnAsset burns the given number of units of a given asset by sending them
 	// to a provably un-spendable script key. Burning means irrevocably destroying
 	// a certain number of assets, reducing the total supply of the asset. Because
 	// burning is such a destructive and non-reversible operation, some specific
-	// values need to be set in the request to avoid accidental burns.
+	// values need to be set in the request to avoid accidental burns. Burning
+	// more units than the available balance is rejected. Once the burn is
+	// confirmed, its proof is registered as a leaf of the asset's transfer
+	// Universe and indexed in the asset's burn tree, which reduces the supply
+	// reported to anyone syncing the Universe.
 	BurnAsset(ctx context.Context, in *BurnAssetRequest, opts ...grpc.CallOption) (*BurnAssetResponse, error)
 	// tapcli: `getinfo`
 	// GetInfo returns the information for the node.
 	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	// tapcli: `bakemacaroon`
+	// BakeMacaroon bakes a new macaroon that only grants the given set of
+	// permissions. This can be used to hand out scoped macaroons, for example a
+	// macaroon that only grants read access to the local universe for public
+	// sync clients.
+	BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error)
+	// tapcli: `listpermissions`
+	// ListPermissions lists the entity/action pairs that are required to call
+	// each of the RPC methods of tapd.
+	ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error)
 	// SubscribeSendAssetEventNtfns registers a subscription to the event
 	// notification stream which relates to the asset sending process.
 	SubscribeSendAssetEventNtfns(ctx context.Context, in *SubscribeSendAssetEventNtfnsRequest, opts ...grpc.CallOption) (TaprootAssets_SubscribeSendAssetEventNtfnsClient, error)
@@ -122,6 +223,15 @@ func (c *taprootAssetsClient) ListUtxos(ctx context.Context, in *ListUtxosReques
 	return out, nil
 }
 
+func (c *taprootAssetsClient) SetAssetLabel(ctx context.Context, in *SetAssetLabelRequest, opts ...grpc.CallOption) (*SetAssetLabelResponse, error) {
+	out := new(SetAssetLabelResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/SetAssetLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error) {
 	out := new(ListGroupsResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListGroups", in, out, opts...)
@@ -149,6 +259,38 @@ func (c *taprootAssetsClient) ListTransfers(ctx context.Context, in *ListTransfe
 	return out, nil
 }
 
+func (c *taprootAssetsClient) SubscribeTransfers(ctx context.Context, in *SubscribeTransfersRequest, opts ...grpc.CallOption) (TaprootAssets_SubscribeTransfersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TaprootAssets_ServiceDesc.Streams[0], "/taprpc.TaprootAssets/SubscribeTransfers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &taprootAssetsSubscribeTransfersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TaprootAssets_SubscribeTransfersClient interface {
+	Recv() (*TransferEvent, error)
+	grpc.ClientStream
+}
+
+type taprootAssetsSubscribeTransfersClient struct {
+	grpc.ClientStream
+}
+
+func (x *taprootAssetsSubscribeTransfersClient) Recv() (*TransferEvent, error) {
+	m := new(TransferEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *taprootAssetsClient) StopDaemon(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error) {
 	out := new(StopResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/StopDaemon", in, out, opts...)
@@ -176,6 +318,15 @@ func (c *taprootAssetsClient) QueryAddrs(ctx context.Context, in *QueryAddrReque
 	return out, nil
 }
 
+func (c *taprootAssetsClient) ListAddrs(ctx context.Context, in *ListAddrsRequest, opts ...grpc.CallOption) (*ListAddrsResponse, error) {
+	out := new(ListAddrsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListAddrs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) NewAddr(ctx context.Context, in *NewAddrRequest, opts ...grpc.CallOption) (*Addr, error) {
 	out := new(Addr)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/NewAddr", in, out, opts...)
@@ -185,6 +336,15 @@ func (c *taprootAssetsClient) NewAddr(ctx context.Context, in *NewAddrRequest, o
 	return out, nil
 }
 
+func (c *taprootAssetsClient) RevokeAddr(ctx context.Context, in *RevokeAddrRequest, opts ...grpc.CallOption) (*RevokeAddrResponse, error) {
+	out := new(RevokeAddrResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/RevokeAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) DecodeAddr(ctx context.Context, in *DecodeAddrRequest, opts ...grpc.CallOption) (*Addr, error) {
 	out := new(Addr)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/DecodeAddr", in, out, opts...)
@@ -221,6 +381,15 @@ func (c *taprootAssetsClient) DecodeProof(ctx context.Context, in *DecodeProofRe
 	return out, nil
 }
 
+func (c *taprootAssetsClient) DecodeCommitment(ctx context.Context, in *DecodeCommitmentRequest, opts ...grpc.CallOption) (*DecodeCommitmentResponse, error) {
+	out := new(DecodeCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/DecodeCommitment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) ExportProof(ctx context.Context, in *ExportProofRequest, opts ...grpc.CallOption) (*ProofFile, error) {
 	out := new(ProofFile)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ExportProof", in, out, opts...)
@@ -230,6 +399,65 @@ func (c *taprootAssetsClient) ExportProof(ctx context.Context, in *ExportProofRe
 	return out, nil
 }
 
+func (c *taprootAssetsClient) ExportProofs(ctx context.Context, in *ExportProofsRequest, opts ...grpc.CallOption) (TaprootAssets_ExportProofsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TaprootAssets_ServiceDesc.Streams[1], "/taprpc.TaprootAssets/ExportProofs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &taprootAssetsExportProofsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TaprootAssets_ExportProofsClient interface {
+	Recv() (*ExportedProof, error)
+	grpc.ClientStream
+}
+
+type taprootAssetsExportProofsClient struct {
+	grpc.ClientStream
+}
+
+func (x *taprootAssetsExportProofsClient) Recv() (*ExportedProof, error) {
+	m := new(ExportedProof)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *taprootAssetsClient) ImportProof(ctx context.Context, in *ImportProofRequest, opts ...grpc.CallOption) (*ImportProofResponse, error) {
+	out := new(ImportProofResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ImportProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ClaimFromUniverse(ctx context.Context, in *ClaimFromUniverseRequest, opts ...grpc.CallOption) (*ImportProofResponse, error) {
+	out := new(ImportProofResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ClaimFromUniverse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) VerifyGroupMembership(ctx context.Context, in *VerifyGroupMembershipRequest, opts ...grpc.CallOption) (*VerifyGroupMembershipResponse, error) {
+	out := new(VerifyGroupMembershipResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/VerifyGroupMembership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) SendAsset(ctx context.Context, in *SendAssetRequest, opts ...grpc.CallOption) (*SendAssetResponse, error) {
 	out := new(SendAssetResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/SendAsset", in, out, opts...)
@@ -239,6 +467,15 @@ func (c *taprootAssetsClient) SendAsset(ctx context.Context, in *SendAssetReques
 	return out, nil
 }
 
+func (c *taprootAssetsClient) EstimateTransferFee(ctx context.Context, in *EstimateTransferFeeRequest, opts ...grpc.CallOption) (*EstimateTransferFeeResponse, error) {
+	out := new(EstimateTransferFeeResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/EstimateTransferFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) BurnAsset(ctx context.Context, in *BurnAssetRequest, opts ...grpc.CallOption) (*BurnAssetResponse, error) {
 	out := new(BurnAssetResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/BurnAsset", in, out, opts...)
@@ -257,8 +494,26 @@ func (c *taprootAssetsClient) GetInfo(ctx context.Context, in *GetInfoRequest, o
 	return out, nil
 }
 
+func (c *taprootAssetsClient) BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error) {
+	out := new(BakeMacaroonResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/BakeMacaroon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error) {
+	out := new(ListPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) SubscribeSendAssetEventNtfns(ctx context.Context, in *SubscribeSendAssetEventNtfnsRequest, opts ...grpc.CallOption) (TaprootAssets_SubscribeSendAssetEventNtfnsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &TaprootAssets_ServiceDesc.Streams[0], "/taprpc.TaprootAssets/SubscribeSendAssetEventNtfns", opts...)
+	stream, err := c.cc.NewStream(ctx, &TaprootAssets_ServiceDesc.Streams[2], "/taprpc.TaprootAssets/SubscribeSendAssetEventNtfns", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -309,6 +564,11 @@ type TaprootAssetsServer interface {
 	// ListUtxos lists the UTXOs managed by the target daemon, and the assets they
 	// hold.
 	ListUtxos(context.Context, *ListUtxosRequest) (*ListUtxosResponse, error)
+	// tapcli: `assets setlabel`
+	// SetAssetLabel sets the human-readable label of all assets with the given
+	// asset ID. The label is only stored locally and is not committed to
+	// on-chain. An empty label removes the current label.
+	SetAssetLabel(context.Context, *SetAssetLabelRequest) (*SetAssetLabelResponse, error)
 	// tapcli: `assets groups`
 	// ListGroups lists the asset groups known to the target daemon, and the assets
 	// held in each group.
@@ -317,8 +577,16 @@ type TaprootAssetsServer interface {
 	// ListBalances lists asset balances
 	ListBalances(context.Context, *ListBalancesRequest) (*ListBalancesResponse, error)
 	// tapcli: `assets transfers`
-	// ListTransfers lists outbound asset transfers tracked by the target daemon.
+	// ListTransfers lists outbound asset transfers tracked by the target daemon,
+	// including their confirmation and proof delivery status. Inbound transfers
+	// are tracked as address events and can be listed with AddrReceives, or
+	// included along with their payment status by setting include_receives.
 	ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error)
+	// tapcli: `assets transfers --follow`
+	// SubscribeTransfers registers a subscription to state updates of outbound
+	// asset transfers. An event is sent each time a transfer was broadcast,
+	// confirmed or completed its proof delivery.
+	SubscribeTransfers(*SubscribeTransfersRequest, TaprootAssets_SubscribeTransfersServer) error
 	// tapcli: `stop`
 	// StopDaemon will send a shutdown request to the interrupt handler, triggering
 	// a graceful shutdown of the daemon.
@@ -331,14 +599,28 @@ type TaprootAssetsServer interface {
 	DebugLevel(context.Context, *DebugLevelRequest) (*DebugLevelResponse, error)
 	// tapcli: `addrs query`
 	// QueryAddrs queries the set of Taproot Asset addresses stored in the
-	// database.
+	// database, together with whether each of them has been paid.
 	QueryAddrs(context.Context, *QueryAddrRequest) (*QueryAddrResponse, error)
+	// tapcli: `addrs list`
+	// ListAddrs lists the Taproot Asset addresses stored in the database together
+	// with their paid and revocation status. Revoked addresses are only returned
+	// if explicitly requested.
+	ListAddrs(context.Context, *ListAddrsRequest) (*ListAddrsResponse, error)
 	// tapcli: `addrs new`
 	// NewAddr makes a new address from the set of request params.
 	NewAddr(context.Context, *NewAddrRequest) (*Addr, error)
+	// tapcli: `addrs revoke`
+	// RevokeAddr revokes a Taproot Asset address that hasn't received a payment
+	// yet. Payments to a revoked address are no longer claimed by the wallet,
+	// and proofs for its script key are rejected by the local universe server
+	// when it acts as the proof courier. An already paid address can't be
+	// revoked.
+	RevokeAddr(context.Context, *RevokeAddrRequest) (*RevokeAddrResponse, error)
 	// tapcli: `addrs decode`
 	// DecodeAddr decode a Taproot Asset address into a partial asset message that
-	// represents the asset it wants to receive.
+	// represents the asset it wants to receive. The checksum and the network
+	// prefix of the address are validated, and an InvalidArgument error is
+	// returned for malformed addresses.
 	DecodeAddr(context.Context, *DecodeAddrRequest) (*Addr, error)
 	// tapcli: `addrs receives`
 	// List all receives for incoming asset transfers for addresses that were
@@ -346,32 +628,106 @@ type TaprootAssetsServer interface {
 	AddrReceives(context.Context, *AddrReceivesRequest) (*AddrReceivesResponse, error)
 	// tapcli: `proofs verify`
 	// VerifyProof attempts to verify a given proof file that claims to be anchored
-	// at the specified genesis point.
+	// at the specified genesis point. Each proof in the file is verified in order
+	// and the result of each step is returned. If a universe host is specified,
+	// each proof is also checked to be included in the universe of that server.
+	// The proof is not imported and no local state is modified.
 	VerifyProof(context.Context, *ProofFile) (*VerifyProofResponse, error)
 	// tapcli: `proofs decode`
 	// DecodeProof attempts to decode a given proof file into human readable
 	// format.
 	DecodeProof(context.Context, *DecodeProofRequest) (*DecodeProofResponse, error)
+	// tapcli: `proofs decodecommitment`
+	// DecodeCommitment reconstructs the Taproot Asset commitment of an anchor
+	// output from the proofs of all assets it's claimed to commit to, and checks
+	// that it matches the taproot output key of the anchor output. This allows
+	// independently verifying on-chain that an output commits to exactly the
+	// claimed set of assets. The proofs themselves aren't verified.
+	DecodeCommitment(context.Context, *DecodeCommitmentRequest) (*DecodeCommitmentResponse, error)
 	// tapcli: `proofs export`
 	// ExportProof exports the latest raw proof file anchored at the specified
-	// script_key.
+	// script_key. The returned file can be verified with VerifyProof and
+	// imported into another node with ImportProof. A NotFound error is returned
+	// if no proof is known for the asset, and a FailedPrecondition error if
+	// owned_only is set but the asset isn't held by this node.
 	ExportProof(context.Context, *ExportProofRequest) (*ProofFile, error)
+	// tapcli: `proofs exportall`
+	// ExportProofs exports a full proof file for each leaf of the local issuance
+	// and transfer universes of an asset or asset group. One message is streamed
+	// per leaf, named by the outpoint and script key of the leaf. Each proof file
+	// can be verified with VerifyProof and imported with ImportProof. A leaf
+	// whose proof file can't be built is reported with the reason instead.
+	ExportProofs(*ExportProofsRequest, TaprootAssets_ExportProofsServer) error
+	// tapcli: `proofs import`
+	// ImportProof imports a proof file of an asset that was received
+	// out-of-band, for example one created with ExportProof on another node. The
+	// full proof chain is verified, and the script key of the final proof must
+	// belong to this node, unless track_only is set. The asset is then recorded
+	// as owned by this node and its proofs are inserted into the local universe.
+	// An Unavailable error is returned if the anchor transaction of the final
+	// proof doesn't have the minimum number of confirmations yet, in which case
+	// the import can be retried later.
+	ImportProof(context.Context, *ImportProofRequest) (*ImportProofResponse, error)
+	// tapcli: `proofs claim`
+	// ClaimFromUniverse imports an asset that was sent to this node by pulling
+	// its proof from a universe, instead of waiting for the sender to deliver
+	// it. The transfer universe of the asset is queried for the leaf with the
+	// given script key, the full proof chain is fetched and verified, and the
+	// asset is imported as with ImportProof. A NotFound error is returned if the
+	// universe doesn't have the leaf yet, in which case the claim can be retried
+	// later.
+	ClaimFromUniverse(context.Context, *ClaimFromUniverseRequest) (*ImportProofResponse, error)
+	// tapcli: `proofs verifygroup`
+	// VerifyGroupMembership verifies that two assets were issued into the same
+	// asset group. The genesis proof of each asset is verified, which includes
+	// the group witness that proves the asset was issued by the holder of the
+	// group key. The shared group key is returned if both assets are part of the
+	// same group, otherwise the reason for the mismatch is returned. This allows
+	// telling the assets of a genuine issuer apart from lookalike assets.
+	VerifyGroupMembership(context.Context, *VerifyGroupMembershipRequest) (*VerifyGroupMembershipResponse, error)
 	// tapcli: `assets send`
 	// SendAsset uses one or multiple passed Taproot Asset address(es) to attempt
 	// to complete an asset send. The method returns information w.r.t the on chain
 	// send, as well as the proof file information the receiver needs to fully
-	// receive the asset.
+	// receive the asset. The strategy used to select the asset UTXOs that fund
+	// the send can be chosen, and the selected UTXOs are returned. Sending more
+	// units than the available balance is rejected. If the send fails before
+	// the anchor transaction is broadcast, the selected asset UTXOs are released
+	// again. Addresses of different assets can be paid at once, in which case
+	// all of them are paid by a single anchor transaction.
 	SendAsset(context.Context, *SendAssetRequest) (*SendAssetResponse, error)
+	// tapcli: `assets estimatefee`
+	// EstimateTransferFee estimates the on-chain fee and virtual size of the
+	// anchor transaction of a send to the given Taproot Asset address(es). The
+	// asset UTXOs are selected and the anchor transaction is constructed the same
+	// way as for SendAsset, but nothing is signed or broadcast and all selected
+	// UTXOs are released again. An error is returned if the requested amounts
+	// can't be paid with the available asset UTXOs.
+	EstimateTransferFee(context.Context, *EstimateTransferFeeRequest) (*EstimateTransferFeeResponse, error)
 	// tapcli: `assets burn`
 	// BurnAsset burns the given number of units of a given asset by sending them
 	// to a provably un-spendable script key. Burning means irrevocably destroying
 	// a certain number of assets, reducing the total supply of the asset. Because
 	// burning is such a destructive and non-reversible operation, some specific
-	// values need to be set in the request to avoid accidental burns.
+	// values need to be set in the request to avoid accidental burns. Burning
+	// more units than the available balance is rejected. Once the burn is
+	// confirmed, its proof is registered as a leaf of the asset's transfer
+	// Universe and indexed in the asset's burn tree, which reduces the supply
+	// reported to anyone syncing the Universe.
 	BurnAsset(context.Context, *BurnAssetRequest) (*BurnAssetResponse, error)
 	// tapcli: `getinfo`
 	// GetInfo returns the information for the node.
 	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	// tapcli: `bakemacaroon`
+	// BakeMacaroon bakes a new macaroon that only grants the given set of
+	// permissions. This can be used to hand out scoped macaroons, for example a
+	// macaroon that only grants read access to the local universe for public
+	// sync clients.
+	BakeMacaroon(context.Context, *BakeMacaroonRequest) (*BakeMacaroonResponse, error)
+	// tapcli: `listpermissions`
+	// ListPermissions lists the entity/action pairs that are required to call
+	// each of the RPC methods of tapd.
+	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
 	// SubscribeSendAssetEventNtfns registers a subscription to the event
 	// notification stream which relates to the asset sending process.
 	SubscribeSendAssetEventNtfns(*SubscribeSendAssetEventNtfnsRequest, TaprootAssets_SubscribeSendAssetEventNtfnsServer) error
@@ -391,6 +747,9 @@ func (UnimplementedTaprootAssetsServer) ListAssets(context.Context, *ListAssetRe
 func (UnimplementedTaprootAssetsServer) ListUtxos(context.Context, *ListUtxosRequest) (*ListUtxosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUtxos not implemented")
 }
+func (UnimplementedTaprootAssetsServer) SetAssetLabel(context.Context, *SetAssetLabelRequest) (*SetAssetLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAssetLabel not implemented")
+}
 func (UnimplementedTaprootAssetsServer) ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListGroups not implemented")
 }
@@ -400,6 +759,9 @@ func (UnimplementedTaprootAssetsServer) ListBalances(context.Context, *ListBalan
 func (UnimplementedTaprootAssetsServer) ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTransfers not implemented")
 }
+func (UnimplementedTaprootAssetsServer) SubscribeTransfers(*SubscribeTransfersRequest, TaprootAssets_SubscribeTransfersServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTransfers not implemented")
+}
 func (UnimplementedTaprootAssetsServer) StopDaemon(context.Context, *StopRequest) (*StopResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopDaemon not implemented")
 }
@@ -409,9 +771,15 @@ func (UnimplementedTaprootAssetsServer) DebugLevel(context.Context, *DebugLevelR
 func (UnimplementedTaprootAssetsServer) QueryAddrs(context.Context, *QueryAddrRequest) (*QueryAddrResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryAddrs not implemented")
 }
+func (UnimplementedTaprootAssetsServer) ListAddrs(context.Context, *ListAddrsRequest) (*ListAddrsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAddrs not implemented")
+}
 func (UnimplementedTaprootAssetsServer) NewAddr(context.Context, *NewAddrRequest) (*Addr, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NewAddr not implemented")
 }
+func (UnimplementedTaprootAssetsServer) RevokeAddr(context.Context, *RevokeAddrRequest) (*RevokeAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAddr not implemented")
+}
 func (UnimplementedTaprootAssetsServer) DecodeAddr(context.Context, *DecodeAddrRequest) (*Addr, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DecodeAddr not implemented")
 }
@@ -424,18 +792,42 @@ func (UnimplementedTaprootAssetsServer) VerifyProof(context.Context, *ProofFile)
 func (UnimplementedTaprootAssetsServer) DecodeProof(context.Context, *DecodeProofRequest) (*DecodeProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DecodeProof not implemented")
 }
+func (UnimplementedTaprootAssetsServer) DecodeCommitment(context.Context, *DecodeCommitmentRequest) (*DecodeCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeCommitment not implemented")
+}
 func (UnimplementedTaprootAssetsServer) ExportProof(context.Context, *ExportProofRequest) (*ProofFile, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportProof not implemented")
 }
+func (UnimplementedTaprootAssetsServer) ExportProofs(*ExportProofsRequest, TaprootAssets_ExportProofsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportProofs not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ImportProof(context.Context, *ImportProofRequest) (*ImportProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportProof not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ClaimFromUniverse(context.Context, *ClaimFromUniverseRequest) (*ImportProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimFromUniverse not implemented")
+}
+func (UnimplementedTaprootAssetsServer) VerifyGroupMembership(context.Context, *VerifyGroupMembershipRequest) (*VerifyGroupMembershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyGroupMembership not implemented")
+}
 func (UnimplementedTaprootAssetsServer) SendAsset(context.Context, *SendAssetRequest) (*SendAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendAsset not implemented")
 }
+func (UnimplementedTaprootAssetsServer) EstimateTransferFee(context.Context, *EstimateTransferFeeRequest) (*EstimateTransferFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateTransferFee not implemented")
+}
 func (UnimplementedTaprootAssetsServer) BurnAsset(context.Context, *BurnAssetRequest) (*BurnAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BurnAsset not implemented")
 }
 func (UnimplementedTaprootAssetsServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
 }
+func (UnimplementedTaprootAssetsServer) BakeMacaroon(context.Context, *BakeMacaroonRequest) (*BakeMacaroonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BakeMacaroon not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPermissions not implemented")
+}
 func (UnimplementedTaprootAssetsServer) SubscribeSendAssetEventNtfns(*SubscribeSendAssetEventNtfnsRequest, TaprootAssets_SubscribeSendAssetEventNtfnsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeSendAssetEventNtfns not implemented")
 }
@@ -491,6 +883,24 @@ func _TaprootAssets_ListUtxos_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_SetAssetLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAssetLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).SetAssetLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/SetAssetLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).SetAssetLabel(ctx, req.(*SetAssetLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaprootAssets_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListGroupsRequest)
 	if err := dec(in); err != nil {
@@ -545,6 +955,27 @@ func _TaprootAssets_ListTransfers_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_SubscribeTransfers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTransfersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaprootAssetsServer).SubscribeTransfers(m, &taprootAssetsSubscribeTransfersServer{stream})
+}
+
+type TaprootAssets_SubscribeTransfersServer interface {
+	Send(*TransferEvent) error
+	grpc.ServerStream
+}
+
+type taprootAssetsSubscribeTransfersServer struct {
+	grpc.ServerStream
+}
+
+func (x *taprootAssetsSubscribeTransfersServer) Send(m *TransferEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _TaprootAssets_StopDaemon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StopRequest)
 	if err := dec(in); err != nil {
@@ -599,6 +1030,24 @@ func _TaprootAssets_QueryAddrs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_ListAddrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAddrsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListAddrs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListAddrs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListAddrs(ctx, req.(*ListAddrsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaprootAssets_NewAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NewAddrRequest)
 	if err := dec(in); err != nil {
@@ -617,6 +1066,24 @@ func _TaprootAssets_NewAddr_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_RevokeAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).RevokeAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/RevokeAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).RevokeAddr(ctx, req.(*RevokeAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaprootAssets_DecodeAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DecodeAddrRequest)
 	if err := dec(in); err != nil {
@@ -689,6 +1156,24 @@ func _TaprootAssets_DecodeProof_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_DecodeCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).DecodeCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/DecodeCommitment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).DecodeCommitment(ctx, req.(*DecodeCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaprootAssets_ExportProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExportProofRequest)
 	if err := dec(in); err != nil {
@@ -707,6 +1192,81 @@ func _TaprootAssets_ExportProof_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_ExportProofs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportProofsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaprootAssetsServer).ExportProofs(m, &taprootAssetsExportProofsServer{stream})
+}
+
+type TaprootAssets_ExportProofsServer interface {
+	Send(*ExportedProof) error
+	grpc.ServerStream
+}
+
+type taprootAssetsExportProofsServer struct {
+	grpc.ServerStream
+}
+
+func (x *taprootAssetsExportProofsServer) Send(m *ExportedProof) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TaprootAssets_ImportProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ImportProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ImportProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ImportProof(ctx, req.(*ImportProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ClaimFromUniverse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimFromUniverseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ClaimFromUniverse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ClaimFromUniverse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ClaimFromUniverse(ctx, req.(*ClaimFromUniverseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_VerifyGroupMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyGroupMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).VerifyGroupMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/VerifyGroupMembership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).VerifyGroupMembership(ctx, req.(*VerifyGroupMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaprootAssets_SendAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendAssetRequest)
 	if err := dec(in); err != nil {
@@ -725,6 +1285,24 @@ func _TaprootAssets_SendAsset_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_EstimateTransferFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateTransferFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).EstimateTransferFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/EstimateTransferFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).EstimateTransferFee(ctx, req.(*EstimateTransferFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaprootAssets_BurnAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BurnAssetRequest)
 	if err := dec(in); err != nil {
@@ -761,6 +1339,42 @@ func _TaprootAssets_GetInfo_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_BakeMacaroon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BakeMacaroonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).BakeMacaroon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/BakeMacaroon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).BakeMacaroon(ctx, req.(*BakeMacaroonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListPermissions(ctx, req.(*ListPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaprootAssets_SubscribeSendAssetEventNtfns_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeSendAssetEventNtfnsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -815,6 +1429,10 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUtxos",
 			Handler:    _TaprootAssets_ListUtxos_Handler,
 		},
+		{
+			MethodName: "SetAssetLabel",
+			Handler:    _TaprootAssets_SetAssetLabel_Handler,
+		},
 		{
 			MethodName: "ListGroups",
 			Handler:    _TaprootAssets_ListGroups_Handler,
@@ -839,10 +1457,18 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryAddrs",
 			Handler:    _TaprootAssets_QueryAddrs_Handler,
 		},
+		{
+			MethodName: "ListAddrs",
+			Handler:    _TaprootAssets_ListAddrs_Handler,
+		},
 		{
 			MethodName: "NewAddr",
 			Handler:    _TaprootAssets_NewAddr_Handler,
 		},
+		{
+			MethodName: "RevokeAddr",
+			Handler:    _TaprootAssets_RevokeAddr_Handler,
+		},
 		{
 			MethodName: "DecodeAddr",
 			Handler:    _TaprootAssets_DecodeAddr_Handler,
@@ -859,14 +1485,34 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DecodeProof",
 			Handler:    _TaprootAssets_DecodeProof_Handler,
 		},
+		{
+			MethodName: "DecodeCommitment",
+			Handler:    _TaprootAssets_DecodeCommitment_Handler,
+		},
 		{
 			MethodName: "ExportProof",
 			Handler:    _TaprootAssets_ExportProof_Handler,
 		},
+		{
+			MethodName: "ImportProof",
+			Handler:    _TaprootAssets_ImportProof_Handler,
+		},
+		{
+			MethodName: "ClaimFromUniverse",
+			Handler:    _TaprootAssets_ClaimFromUniverse_Handler,
+		},
+		{
+			MethodName: "VerifyGroupMembership",
+			Handler:    _TaprootAssets_VerifyGroupMembership_Handler,
+		},
 		{
 			MethodName: "SendAsset",
 			Handler:    _TaprootAssets_SendAsset_Handler,
 		},
+		{
+			MethodName: "EstimateTransferFee",
+			Handler:    _TaprootAssets_EstimateTransferFee_Handler,
+		},
 		{
 			MethodName: "BurnAsset",
 			Handler:    _TaprootAssets_BurnAsset_Handler,
@@ -875,12 +1521,30 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInfo",
 			Handler:    _TaprootAssets_GetInfo_Handler,
 		},
+		{
+			MethodName: "BakeMacaroon",
+			Handler:    _TaprootAssets_BakeMacaroon_Handler,
+		},
+		{
+			MethodName: "ListPermissions",
+			Handler:    _TaprootAssets_ListPermissions_Handler,
+		},
 		{
 			MethodName: "FetchAssetMeta",
 			Handler:    _TaprootAssets_FetchAssetMeta_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeTransfers",
+			Handler:       _TaprootAssets_SubscribeTransfers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportProofs",
+			Handler:       _TaprootAssets_ExportProofs_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeSendAssetEventNtfns",
 			Handler:       _TaprootAssets_SubscribeSendAssetEventNtfns_Handler,
@@ -79,6 +79,17 @@ const (
 	// A syncing mode that indicates that all asset proofs should be synced.
 	// This includes normal transfers as well.
 	UniverseSyncMode_SYNC_FULL UniverseSyncMode = 1
+	// A syncing mode that syncs all asset proofs like SYNC_FULL, but only
+	// fetches the leaves inserted since the current local root of each asset
+	// instead of diffing the full set of leaf keys. If the remote Universe
+	// doesn't know the local root of an asset, then a full diff is performed
+	// for that asset instead, which is flagged in the result.
+	UniverseSyncMode_SYNC_DELTA UniverseSyncMode = 2
+	// A syncing mode that only imports the roots of all assets (and their
+	// sums), without any of the leaves. The leaves are fetched from the remote
+	// Universe once a proof is queried and then stored locally. The imported
+	// roots are flagged as lazy until all their leaves are stored locally.
+	UniverseSyncMode_SYNC_ROOTS_ONLY UniverseSyncMode = 3
 )
 
 // Enum value maps for UniverseSyncMode.
@@ -86,10 +97,14 @@ var (
 	UniverseSyncMode_name = map[int32]string{
 		0: "SYNC_ISSUANCE_ONLY",
 		1: "SYNC_FULL",
+		2: "SYNC_DELTA",
+		3: "SYNC_ROOTS_ONLY",
 	}
 	UniverseSyncMode_value = map[string]int32{
 		"SYNC_ISSUANCE_ONLY": 0,
 		"SYNC_FULL":          1,
+		"SYNC_DELTA":         2,
+		"SYNC_ROOTS_ONLY":    3,
 	}
 )
 
@@ -120,6 +135,121 @@ func (UniverseSyncMode) EnumDescriptor() ([]byte, []int) {
 	return file_universerpc_universe_proto_rawDescGZIP(), []int{1}
 }
 
+type RootDiffStatus int32
+
+const (
+	// The local Universe contains all leaves of the remote Universe, plus
+	// additional ones. This is also the case if the remote Universe server
+	// doesn't know the asset at all.
+	RootDiffStatus_ROOT_DIFF_LOCAL_AHEAD RootDiffStatus = 0
+	// The remote Universe contains all leaves of the local Universe, plus
+	// additional ones. This is also the case if the asset isn't known
+	// locally at all.
+	RootDiffStatus_ROOT_DIFF_REMOTE_AHEAD RootDiffStatus = 1
+	// Both the local and the remote Universe contain leaves the other side
+	// doesn't know about.
+	RootDiffStatus_ROOT_DIFF_DIVERGED RootDiffStatus = 2
+)
+
+// Enum value maps for RootDiffStatus.
+var (
+	RootDiffStatus_name = map[int32]string{
+		0: "ROOT_DIFF_LOCAL_AHEAD",
+		1: "ROOT_DIFF_REMOTE_AHEAD",
+		2: "ROOT_DIFF_DIVERGED",
+	}
+	RootDiffStatus_value = map[string]int32{
+		"ROOT_DIFF_LOCAL_AHEAD":  0,
+		"ROOT_DIFF_REMOTE_AHEAD": 1,
+		"ROOT_DIFF_DIVERGED":     2,
+	}
+)
+
+func (x RootDiffStatus) Enum() *RootDiffStatus {
+	p := new(RootDiffStatus)
+	*p = x
+	return p
+}
+
+func (x RootDiffStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RootDiffStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_universerpc_universe_proto_enumTypes[2].Descriptor()
+}
+
+func (RootDiffStatus) Type() protoreflect.EnumType {
+	return &file_universerpc_universe_proto_enumTypes[2]
+}
+
+func (x RootDiffStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RootDiffStatus.Descriptor instead.
+func (RootDiffStatus) EnumDescriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{2}
+}
+
+type FederationEventType int32
+
+const (
+	// The event carries the full membership of the federation. This is always
+	// the first event of a subscription.
+	FederationEventType_FEDERATION_EVENT_MEMBERSHIP FederationEventType = 0
+	// A server was added to the federation.
+	FederationEventType_FEDERATION_EVENT_SERVER_ADDED FederationEventType = 1
+	// A server was removed from the federation.
+	FederationEventType_FEDERATION_EVENT_SERVER_REMOVED FederationEventType = 2
+	// The reachability, sync or stale status of a server changed, or the
+	// server was checked for the first time.
+	FederationEventType_FEDERATION_EVENT_HEALTH_CHANGED FederationEventType = 3
+)
+
+// Enum value maps for FederationEventType.
+var (
+	FederationEventType_name = map[int32]string{
+		0: "FEDERATION_EVENT_MEMBERSHIP",
+		1: "FEDERATION_EVENT_SERVER_ADDED",
+		2: "FEDERATION_EVENT_SERVER_REMOVED",
+		3: "FEDERATION_EVENT_HEALTH_CHANGED",
+	}
+	FederationEventType_value = map[string]int32{
+		"FEDERATION_EVENT_MEMBERSHIP":     0,
+		"FEDERATION_EVENT_SERVER_ADDED":   1,
+		"FEDERATION_EVENT_SERVER_REMOVED": 2,
+		"FEDERATION_EVENT_HEALTH_CHANGED": 3,
+	}
+)
+
+func (x FederationEventType) Enum() *FederationEventType {
+	p := new(FederationEventType)
+	*p = x
+	return p
+}
+
+func (x FederationEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FederationEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_universerpc_universe_proto_enumTypes[3].Descriptor()
+}
+
+func (FederationEventType) Type() protoreflect.EnumType {
+	return &file_universerpc_universe_proto_enumTypes[3]
+}
+
+func (x FederationEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FederationEventType.Descriptor instead.
+func (FederationEventType) EnumDescriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{3}
+}
+
 type AssetQuerySort int32
 
 const (
@@ -168,11 +298,11 @@ func (x AssetQuerySort) String() string {
 }
 
 func (AssetQuerySort) Descriptor() protoreflect.EnumDescriptor {
-	return file_universerpc_universe_proto_enumTypes[2].Descriptor()
+	return file_universerpc_universe_proto_enumTypes[4].Descriptor()
 }
 
 func (AssetQuerySort) Type() protoreflect.EnumType {
-	return &file_universerpc_universe_proto_enumTypes[2]
+	return &file_universerpc_universe_proto_enumTypes[4]
 }
 
 func (x AssetQuerySort) Number() protoreflect.EnumNumber {
@@ -181,7 +311,7 @@ func (x AssetQuerySort) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AssetQuerySort.Descriptor instead.
 func (AssetQuerySort) EnumDescriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{2}
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{4}
 }
 
 type SortDirection int32
@@ -214,11 +344,11 @@ func (x SortDirection) String() string {
 }
 
 func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
-	return file_universerpc_universe_proto_enumTypes[3].Descriptor()
+	return file_universerpc_universe_proto_enumTypes[5].Descriptor()
 }
 
 func (SortDirection) Type() protoreflect.EnumType {
-	return &file_universerpc_universe_proto_enumTypes[3]
+	return &file_universerpc_universe_proto_enumTypes[5]
 }
 
 func (x SortDirection) Number() protoreflect.EnumNumber {
@@ -227,7 +357,7 @@ func (x SortDirection) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SortDirection.Descriptor instead.
 func (SortDirection) EnumDescriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{3}
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{5}
 }
 
 type AssetTypeFilter int32
@@ -263,11 +393,11 @@ func (x AssetTypeFilter) String() string {
 }
 
 func (AssetTypeFilter) Descriptor() protoreflect.EnumDescriptor {
-	return file_universerpc_universe_proto_enumTypes[4].Descriptor()
+	return file_universerpc_universe_proto_enumTypes[6].Descriptor()
 }
 
 func (AssetTypeFilter) Type() protoreflect.EnumType {
-	return &file_universerpc_universe_proto_enumTypes[4]
+	return &file_universerpc_universe_proto_enumTypes[6]
 }
 
 func (x AssetTypeFilter) Number() protoreflect.EnumNumber {
@@ -276,13 +406,77 @@ func (x AssetTypeFilter) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AssetTypeFilter.Descriptor instead.
 func (AssetTypeFilter) EnumDescriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{4}
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{6}
+}
+
+type UniverseLeafSource int32
+
+const (
+	// The leaf was pushed to us by, or synced from, a remote Universe server.
+	UniverseLeafSource_LEAF_SOURCE_FEDERATION UniverseLeafSource = 0
+	// The leaf was created locally, for example as a result of minting.
+	UniverseLeafSource_LEAF_SOURCE_LOCAL UniverseLeafSource = 1
+)
+
+// Enum value maps for UniverseLeafSource.
+var (
+	UniverseLeafSource_name = map[int32]string{
+		0: "LEAF_SOURCE_FEDERATION",
+		1: "LEAF_SOURCE_LOCAL",
+	}
+	UniverseLeafSource_value = map[string]int32{
+		"LEAF_SOURCE_FEDERATION": 0,
+		"LEAF_SOURCE_LOCAL":      1,
+	}
+)
+
+func (x UniverseLeafSource) Enum() *UniverseLeafSource {
+	p := new(UniverseLeafSource)
+	*p = x
+	return p
+}
+
+func (x UniverseLeafSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UniverseLeafSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_universerpc_universe_proto_enumTypes[7].Descriptor()
+}
+
+func (UniverseLeafSource) Type() protoreflect.EnumType {
+	return &file_universerpc_universe_proto_enumTypes[7]
+}
+
+func (x UniverseLeafSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UniverseLeafSource.Descriptor instead.
+func (UniverseLeafSource) EnumDescriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{7}
 }
 
 type AssetRootRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The number of universe roots to skip before returning results. The
+	// roots are sorted by their namespace, so this can be used together with
+	// the limit to reliably page through all known roots.
+	Offset int32 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The maximum number of universe roots to return. If zero, then all roots
+	// starting at the offset are returned.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// An optional filter that restricts the returned roots to universes of
+	// the given asset type. If no roots match the filter, an empty result is
+	// returned.
+	AssetTypeFilter AssetTypeFilter `protobuf:"varint,3,opt,name=asset_type_filter,json=assetTypeFilter,proto3,enum=universerpc.AssetTypeFilter" json:"asset_type_filter,omitempty"`
+	// An optional lower case hex prefix that restricts the returned roots to
+	// universes whose asset ID (or 32-byte x-only group key for grouped
+	// assets) starts with the prefix.
+	IdPrefix string `protobuf:"bytes,4,opt,name=id_prefix,json=idPrefix,proto3" json:"id_prefix,omitempty"`
 }
 
 func (x *AssetRootRequest) Reset() {
@@ -317,21 +511,52 @@ func (*AssetRootRequest) Descriptor() ([]byte, []int) {
 	return file_universerpc_universe_proto_rawDescGZIP(), []int{0}
 }
 
-type MerkleSumNode struct {
+func (x *AssetRootRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *AssetRootRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *AssetRootRequest) GetAssetTypeFilter() AssetTypeFilter {
+	if x != nil {
+		return x.AssetTypeFilter
+	}
+	return AssetTypeFilter_FILTER_ASSET_NONE
+}
+
+func (x *AssetRootRequest) GetIdPrefix() string {
+	if x != nil {
+		return x.IdPrefix
+	}
+	return ""
+}
+
+type SearchAssetsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The MS-SMT root hash for the branch node.
-	RootHash []byte `protobuf:"bytes,1,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
-	// The root sum of the branch node. This is hashed to create the root_hash
-	// along with the left and right siblings. This value represents the total
-	// known supply of the asset.
-	RootSum int64 `protobuf:"varint,2,opt,name=root_sum,json=rootSum,proto3" json:"root_sum,omitempty"`
+	// The string the name of an asset must contain for its universe roots to
+	// be returned. The name is matched case-insensitively. If empty, then the
+	// roots of all assets are returned.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The number of matching universe roots to skip before returning results.
+	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The maximum number of universe roots to return. If zero, then a default
+	// limit of 100 is used.
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *MerkleSumNode) Reset() {
-	*x = MerkleSumNode{}
+func (x *SearchAssetsRequest) Reset() {
+	*x = SearchAssetsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_universerpc_universe_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -339,13 +564,13 @@ func (x *MerkleSumNode) Reset() {
 	}
 }
 
-func (x *MerkleSumNode) String() string {
+func (x *SearchAssetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MerkleSumNode) ProtoMessage() {}
+func (*SearchAssetsRequest) ProtoMessage() {}
 
-func (x *MerkleSumNode) ProtoReflect() protoreflect.Message {
+func (x *SearchAssetsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_universerpc_universe_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -357,42 +582,48 @@ func (x *MerkleSumNode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MerkleSumNode.ProtoReflect.Descriptor instead.
-func (*MerkleSumNode) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchAssetsRequest.ProtoReflect.Descriptor instead.
+func (*SearchAssetsRequest) Descriptor() ([]byte, []int) {
 	return file_universerpc_universe_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *MerkleSumNode) GetRootHash() []byte {
+func (x *SearchAssetsRequest) GetName() string {
 	if x != nil {
-		return x.RootHash
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *MerkleSumNode) GetRootSum() int64 {
+func (x *SearchAssetsRequest) GetOffset() int32 {
 	if x != nil {
-		return x.RootSum
+		return x.Offset
 	}
 	return 0
 }
 
-type ID struct {
+func (x *SearchAssetsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchAssetsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Id:
-	//
-	//	*ID_AssetId
-	//	*ID_AssetIdStr
-	//	*ID_GroupKey
-	//	*ID_GroupKeyStr
-	Id        isID_Id   `protobuf_oneof:"id"`
-	ProofType ProofType `protobuf:"varint,5,opt,name=proof_type,json=proofType,proto3,enum=universerpc.ProofType" json:"proof_type,omitempty"`
+	// The universe roots of the matching assets, sorted by asset name. Each
+	// root carries the name of the asset and the IDs of the assets known to
+	// exist within the universe.
+	Roots []*UniverseRoot `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	// The offset to use to fetch the next page of matching roots. If zero,
+	// then there are no more roots to fetch.
+	NextOffset int32 `protobuf:"varint,2,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
 }
 
-func (x *ID) Reset() {
-	*x = ID{}
+func (x *SearchAssetsResponse) Reset() {
+	*x = SearchAssetsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_universerpc_universe_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -400,13 +631,13 @@ func (x *ID) Reset() {
 	}
 }
 
-func (x *ID) String() string {
+func (x *SearchAssetsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ID) ProtoMessage() {}
+func (*SearchAssetsResponse) ProtoMessage() {}
 
-func (x *ID) ProtoReflect() protoreflect.Message {
+func (x *SearchAssetsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_universerpc_universe_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -418,109 +649,38 @@ func (x *ID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ID.ProtoReflect.Descriptor instead.
-func (*ID) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchAssetsResponse.ProtoReflect.Descriptor instead.
+func (*SearchAssetsResponse) Descriptor() ([]byte, []int) {
 	return file_universerpc_universe_proto_rawDescGZIP(), []int{2}
 }
 
-func (m *ID) GetId() isID_Id {
-	if m != nil {
-		return m.Id
-	}
-	return nil
-}
-
-func (x *ID) GetAssetId() []byte {
-	if x, ok := x.GetId().(*ID_AssetId); ok {
-		return x.AssetId
-	}
-	return nil
-}
-
-func (x *ID) GetAssetIdStr() string {
-	if x, ok := x.GetId().(*ID_AssetIdStr); ok {
-		return x.AssetIdStr
-	}
-	return ""
-}
-
-func (x *ID) GetGroupKey() []byte {
-	if x, ok := x.GetId().(*ID_GroupKey); ok {
-		return x.GroupKey
+func (x *SearchAssetsResponse) GetRoots() []*UniverseRoot {
+	if x != nil {
+		return x.Roots
 	}
 	return nil
 }
 
-func (x *ID) GetGroupKeyStr() string {
-	if x, ok := x.GetId().(*ID_GroupKeyStr); ok {
-		return x.GroupKeyStr
-	}
-	return ""
-}
-
-func (x *ID) GetProofType() ProofType {
+func (x *SearchAssetsResponse) GetNextOffset() int32 {
 	if x != nil {
-		return x.ProofType
+		return x.NextOffset
 	}
-	return ProofType_PROOF_TYPE_UNSPECIFIED
-}
-
-type isID_Id interface {
-	isID_Id()
-}
-
-type ID_AssetId struct {
-	// The 32-byte asset ID specified as raw bytes (gRPC only).
-	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3,oneof"`
-}
-
-type ID_AssetIdStr struct {
-	// The 32-byte asset ID encoded as a hex string (use this for REST).
-	AssetIdStr string `protobuf:"bytes,2,opt,name=asset_id_str,json=assetIdStr,proto3,oneof"`
-}
-
-type ID_GroupKey struct {
-	// The 32-byte asset group key specified as raw bytes (gRPC only).
-	GroupKey []byte `protobuf:"bytes,3,opt,name=group_key,json=groupKey,proto3,oneof"`
-}
-
-type ID_GroupKeyStr struct {
-	// The 32-byte asset group key encoded as hex string (use this for
-	// REST).
-	GroupKeyStr string `protobuf:"bytes,4,opt,name=group_key_str,json=groupKeyStr,proto3,oneof"`
+	return 0
 }
 
-func (*ID_AssetId) isID_Id() {}
-
-func (*ID_AssetIdStr) isID_Id() {}
-
-func (*ID_GroupKey) isID_Id() {}
-
-func (*ID_GroupKeyStr) isID_Id() {}
-
-type UniverseRoot struct {
+type QueryAssetGenesisRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// The asset ID or group key of the Universe to query the genesis
+	// information for. The proof type of the ID is ignored, the genesis is
+	// always looked up in the issuance Universe.
 	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The merkle sum sparse merkle tree root associated with the above
-	// universe ID.
-	MssmtRoot *MerkleSumNode `protobuf:"bytes,3,opt,name=mssmt_root,json=mssmtRoot,proto3" json:"mssmt_root,omitempty"`
-	// The name of the asset.
-	AssetName string `protobuf:"bytes,4,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
-	// A map of hex encoded asset IDs to the number of units minted for that
-	// asset. This only contains more than one entry for grouped assets and in
-	// that case represents the whole list of assets currently known to exist
-	// within the group. For single (non-grouped) assets, this is equal to the
-	// asset ID above and the sum in the mssmt_root. A hex encoded string is
-	// used as the map key because gRPC does not support using raw bytes for a
-	// map key.
-	AmountsByAssetId map[string]uint64 `protobuf:"bytes,5,rep,name=amounts_by_asset_id,json=amountsByAssetId,proto3" json:"amounts_by_asset_id,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (x *UniverseRoot) Reset() {
-	*x = UniverseRoot{}
+func (x *QueryAssetGenesisRequest) Reset() {
+	*x = QueryAssetGenesisRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_universerpc_universe_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -528,13 +688,13 @@ func (x *UniverseRoot) Reset() {
 	}
 }
 
-func (x *UniverseRoot) String() string {
+func (x *QueryAssetGenesisRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UniverseRoot) ProtoMessage() {}
+func (*QueryAssetGenesisRequest) ProtoMessage() {}
 
-func (x *UniverseRoot) ProtoReflect() protoreflect.Message {
+func (x *QueryAssetGenesisRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_universerpc_universe_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -546,51 +706,30 @@ func (x *UniverseRoot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UniverseRoot.ProtoReflect.Descriptor instead.
-func (*UniverseRoot) Descriptor() ([]byte, []int) {
+// Deprecated: Use QueryAssetGenesisRequest.ProtoReflect.Descriptor instead.
+func (*QueryAssetGenesisRequest) Descriptor() ([]byte, []int) {
 	return file_universerpc_universe_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *UniverseRoot) GetId() *ID {
+func (x *QueryAssetGenesisRequest) GetId() *ID {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-func (x *UniverseRoot) GetMssmtRoot() *MerkleSumNode {
-	if x != nil {
-		return x.MssmtRoot
-	}
-	return nil
-}
-
-func (x *UniverseRoot) GetAssetName() string {
-	if x != nil {
-		return x.AssetName
-	}
-	return ""
-}
-
-func (x *UniverseRoot) GetAmountsByAssetId() map[string]uint64 {
-	if x != nil {
-		return x.AmountsByAssetId
-	}
-	return nil
-}
-
-type AssetRootResponse struct {
+type QueryAssetGenesisResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A map of the set of known universe roots for each asset. The key in the
-	// map is the 32-byte asset_id or group key hash.
-	UniverseRoots map[string]*UniverseRoot `protobuf:"bytes,1,rep,name=universe_roots,json=universeRoots,proto3" json:"universe_roots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The genesis information of the asset, or of each asset within the
+	// group if a group key was queried, sorted by asset ID.
+	GenesisInfos []*taprpc.GenesisInfo `protobuf:"bytes,1,rep,name=genesis_infos,json=genesisInfos,proto3" json:"genesis_infos,omitempty"`
 }
 
-func (x *AssetRootResponse) Reset() {
-	*x = AssetRootResponse{}
+func (x *QueryAssetGenesisResponse) Reset() {
+	*x = QueryAssetGenesisResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_universerpc_universe_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -598,13 +737,13 @@ func (x *AssetRootResponse) Reset() {
 	}
 }
 
-func (x *AssetRootResponse) String() string {
+func (x *QueryAssetGenesisResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetRootResponse) ProtoMessage() {}
+func (*QueryAssetGenesisResponse) ProtoMessage() {}
 
-func (x *AssetRootResponse) ProtoReflect() protoreflect.Message {
+func (x *QueryAssetGenesisResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_universerpc_universe_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -616,29 +755,33 @@ func (x *AssetRootResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetRootResponse.ProtoReflect.Descriptor instead.
-func (*AssetRootResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use QueryAssetGenesisResponse.ProtoReflect.Descriptor instead.
+func (*QueryAssetGenesisResponse) Descriptor() ([]byte, []int) {
 	return file_universerpc_universe_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AssetRootResponse) GetUniverseRoots() map[string]*UniverseRoot {
+func (x *QueryAssetGenesisResponse) GetGenesisInfos() []*taprpc.GenesisInfo {
 	if x != nil {
-		return x.UniverseRoots
+		return x.GenesisInfos
 	}
 	return nil
 }
 
-type AssetRootQuery struct {
+type MerkleSumNode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// An ID value to uniquely identify a Universe root.
-	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The MS-SMT root hash for the branch node.
+	RootHash []byte `protobuf:"bytes,1,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
+	// The root sum of the branch node. This is hashed to create the root_hash
+	// along with the left and right siblings. This value represents the total
+	// known supply of the asset.
+	RootSum int64 `protobuf:"varint,2,opt,name=root_sum,json=rootSum,proto3" json:"root_sum,omitempty"`
 }
 
-func (x *AssetRootQuery) Reset() {
-	*x = AssetRootQuery{}
+func (x *MerkleSumNode) Reset() {
+	*x = MerkleSumNode{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_universerpc_universe_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -646,13 +789,13 @@ func (x *AssetRootQuery) Reset() {
 	}
 }
 
-func (x *AssetRootQuery) String() string {
+func (x *MerkleSumNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetRootQuery) ProtoMessage() {}
+func (*MerkleSumNode) ProtoMessage() {}
 
-func (x *AssetRootQuery) ProtoReflect() protoreflect.Message {
+func (x *MerkleSumNode) ProtoReflect() protoreflect.Message {
 	mi := &file_universerpc_universe_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -664,31 +807,42 @@ func (x *AssetRootQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetRootQuery.ProtoReflect.Descriptor instead.
-func (*AssetRootQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use MerkleSumNode.ProtoReflect.Descriptor instead.
+func (*MerkleSumNode) Descriptor() ([]byte, []int) {
 	return file_universerpc_universe_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *AssetRootQuery) GetId() *ID {
+func (x *MerkleSumNode) GetRootHash() []byte {
 	if x != nil {
-		return x.Id
+		return x.RootHash
 	}
 	return nil
 }
 
-type QueryRootResponse struct {
+func (x *MerkleSumNode) GetRootSum() int64 {
+	if x != nil {
+		return x.RootSum
+	}
+	return 0
+}
+
+type ID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The issuance universe root for the given asset ID or group key.
-	IssuanceRoot *UniverseRoot `protobuf:"bytes,1,opt,name=issuance_root,json=issuanceRoot,proto3" json:"issuance_root,omitempty"`
-	// The transfer universe root for the given asset ID or group key.
-	TransferRoot *UniverseRoot `protobuf:"bytes,2,opt,name=transfer_root,json=transferRoot,proto3" json:"transfer_root,omitempty"`
+	// Types that are assignable to Id:
+	//
+	//	*ID_AssetId
+	//	*ID_AssetIdStr
+	//	*ID_GroupKey
+	//	*ID_GroupKeyStr
+	Id        isID_Id   `protobuf_oneof:"id"`
+	ProofType ProofType `protobuf:"varint,5,opt,name=proof_type,json=proofType,proto3,enum=universerpc.ProofType" json:"proof_type,omitempty"`
 }
 
-func (x *QueryRootResponse) Reset() {
-	*x = QueryRootResponse{}
+func (x *ID) Reset() {
+	*x = ID{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_universerpc_universe_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -696,13 +850,13 @@ func (x *QueryRootResponse) Reset() {
 	}
 }
 
-func (x *QueryRootResponse) String() string {
+func (x *ID) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRootResponse) ProtoMessage() {}
+func (*ID) ProtoMessage() {}
 
-func (x *QueryRootResponse) ProtoReflect() protoreflect.Message {
+func (x *ID) ProtoReflect() protoreflect.Message {
 	mi := &file_universerpc_universe_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -714,139 +868,152 @@ func (x *QueryRootResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryRootResponse.ProtoReflect.Descriptor instead.
-func (*QueryRootResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ID.ProtoReflect.Descriptor instead.
+func (*ID) Descriptor() ([]byte, []int) {
 	return file_universerpc_universe_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *QueryRootResponse) GetIssuanceRoot() *UniverseRoot {
-	if x != nil {
-		return x.IssuanceRoot
+func (m *ID) GetId() isID_Id {
+	if m != nil {
+		return m.Id
 	}
 	return nil
 }
 
-func (x *QueryRootResponse) GetTransferRoot() *UniverseRoot {
-	if x != nil {
-		return x.TransferRoot
+func (x *ID) GetAssetId() []byte {
+	if x, ok := x.GetId().(*ID_AssetId); ok {
+		return x.AssetId
 	}
 	return nil
 }
 
-type DeleteRootQuery struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// An ID value to uniquely identify a Universe root.
-	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *ID) GetAssetIdStr() string {
+	if x, ok := x.GetId().(*ID_AssetIdStr); ok {
+		return x.AssetIdStr
+	}
+	return ""
 }
 
-func (x *DeleteRootQuery) Reset() {
-	*x = DeleteRootQuery{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ID) GetGroupKey() []byte {
+	if x, ok := x.GetId().(*ID_GroupKey); ok {
+		return x.GroupKey
 	}
+	return nil
 }
 
-func (x *DeleteRootQuery) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ID) GetGroupKeyStr() string {
+	if x, ok := x.GetId().(*ID_GroupKeyStr); ok {
+		return x.GroupKeyStr
+	}
+	return ""
 }
 
-func (*DeleteRootQuery) ProtoMessage() {}
-
-func (x *DeleteRootQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ID) GetProofType() ProofType {
+	if x != nil {
+		return x.ProofType
 	}
-	return mi.MessageOf(x)
+	return ProofType_PROOF_TYPE_UNSPECIFIED
 }
 
-// Deprecated: Use DeleteRootQuery.ProtoReflect.Descriptor instead.
-func (*DeleteRootQuery) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{7}
+type isID_Id interface {
+	isID_Id()
 }
 
-func (x *DeleteRootQuery) GetId() *ID {
-	if x != nil {
-		return x.Id
-	}
-	return nil
+type ID_AssetId struct {
+	// The 32-byte asset ID specified as raw bytes (gRPC only).
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3,oneof"`
 }
 
-type DeleteRootResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type ID_AssetIdStr struct {
+	// The 32-byte asset ID encoded as a hex string (use this for REST).
+	AssetIdStr string `protobuf:"bytes,2,opt,name=asset_id_str,json=assetIdStr,proto3,oneof"`
 }
 
-func (x *DeleteRootResponse) Reset() {
-	*x = DeleteRootResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type ID_GroupKey struct {
+	// The 32-byte asset group key specified as raw bytes (gRPC only).
+	GroupKey []byte `protobuf:"bytes,3,opt,name=group_key,json=groupKey,proto3,oneof"`
 }
 
-func (x *DeleteRootResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type ID_GroupKeyStr struct {
+	// The 32-byte asset group key encoded as hex string (use this for
+	// REST).
+	GroupKeyStr string `protobuf:"bytes,4,opt,name=group_key_str,json=groupKeyStr,proto3,oneof"`
 }
 
-func (*DeleteRootResponse) ProtoMessage() {}
+func (*ID_AssetId) isID_Id() {}
 
-func (x *DeleteRootResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
+func (*ID_AssetIdStr) isID_Id() {}
 
-// Deprecated: Use DeleteRootResponse.ProtoReflect.Descriptor instead.
-func (*DeleteRootResponse) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{8}
-}
+func (*ID_GroupKey) isID_Id() {}
 
-type Outpoint struct {
+func (*ID_GroupKeyStr) isID_Id() {}
+
+type UniverseRoot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The output as a hex encoded (and reversed!) string.
-	HashStr string `protobuf:"bytes,1,opt,name=hash_str,json=hashStr,proto3" json:"hash_str,omitempty"`
-	// The index of the output.
-	Index int32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The merkle sum sparse merkle tree root associated with the above
+	// universe ID.
+	MssmtRoot *MerkleSumNode `protobuf:"bytes,3,opt,name=mssmt_root,json=mssmtRoot,proto3" json:"mssmt_root,omitempty"`
+	// The name of the asset.
+	AssetName string `protobuf:"bytes,4,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	// A map of hex encoded asset IDs to the number of units minted for that
+	// asset. This only contains more than one entry for grouped assets and in
+	// that case represents the whole list of assets currently known to exist
+	// within the group. For single (non-grouped) assets, this is equal to the
+	// asset ID above and the sum in the mssmt_root. A hex encoded string is
+	// used as the map key because gRPC does not support using raw bytes for a
+	// map key.
+	AmountsByAssetId map[string]uint64 `protobuf:"bytes,5,rep,name=amounts_by_asset_id,json=amountsByAssetId,proto3" json:"amounts_by_asset_id,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// The number of leaves in the universe tree. This is only set when
+	// querying for all universe roots.
+	NumLeaves uint64 `protobuf:"varint,6,opt,name=num_leaves,json=numLeaves,proto3" json:"num_leaves,omitempty"`
+	// The DER encoded ECDSA signature of the server's node identity key over
+	// the SHA256 digest of the message
+	// "taproot-assets/universe-root" || proof_type || id || root_hash ||
+	// root_sum, where the proof type is a single byte (1 for issuance, 2 for
+	// transfer), the id is the asset ID or the SHA256 hash of the 32-byte
+	// x-only group key and the root sum is an 8-byte big endian integer. This is only set by AssetRoots if the
+	// server is configured to sign asset roots.
+	Signature []byte `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	// The Unix timestamp of the time the most recent leaf was inserted into
+	// the universe tree. Clients caching universe state can use this to only
+	// re-sync roots that changed since they were cached. This is only set
+	// when querying for all universe roots and is zero if the time is
+	// unknown, for example for leaves inserted by older versions.
+	LastUpdated int64 `protobuf:"varint,8,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	// If true, then the root was imported by a SYNC_ROOTS_ONLY sync and not
+	// all leaves of the universe are stored locally yet. Missing leaves are
+	// fetched from the Universe server the root was synced from once they're
+	// queried.
+	Lazy bool `protobuf:"varint,9,opt,name=lazy,proto3" json:"lazy,omitempty"`
+	// The root of the burn tree of the asset, the sum of which is the total
+	// amount of all burns within the transfer universe of the asset. This is
+	// only set for transfer universe roots that aren't lazy. The remaining
+	// supply of the asset is the sum of its issuance universe root minus the
+	// sum of its burn tree.
+	BurnRoot *MerkleSumNode `protobuf:"bytes,10,opt,name=burn_root,json=burnRoot,proto3" json:"burn_root,omitempty"`
 }
 
-func (x *Outpoint) Reset() {
-	*x = Outpoint{}
+func (x *UniverseRoot) Reset() {
+	*x = UniverseRoot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[9]
+		mi := &file_universerpc_universe_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Outpoint) String() string {
+func (x *UniverseRoot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Outpoint) ProtoMessage() {}
+func (*UniverseRoot) ProtoMessage() {}
 
-func (x *Outpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[9]
+func (x *UniverseRoot) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -857,64 +1024,110 @@ func (x *Outpoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Outpoint.ProtoReflect.Descriptor instead.
-func (*Outpoint) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use UniverseRoot.ProtoReflect.Descriptor instead.
+func (*UniverseRoot) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Outpoint) GetHashStr() string {
+func (x *UniverseRoot) GetId() *ID {
 	if x != nil {
-		return x.HashStr
+		return x.Id
 	}
-	return ""
+	return nil
 }
 
-func (x *Outpoint) GetIndex() int32 {
+func (x *UniverseRoot) GetMssmtRoot() *MerkleSumNode {
 	if x != nil {
-		return x.Index
+		return x.MssmtRoot
+	}
+	return nil
+}
+
+func (x *UniverseRoot) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
+	}
+	return ""
+}
+
+func (x *UniverseRoot) GetAmountsByAssetId() map[string]uint64 {
+	if x != nil {
+		return x.AmountsByAssetId
+	}
+	return nil
+}
+
+func (x *UniverseRoot) GetNumLeaves() uint64 {
+	if x != nil {
+		return x.NumLeaves
 	}
 	return 0
 }
 
-type AssetKey struct {
+func (x *UniverseRoot) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *UniverseRoot) GetLastUpdated() int64 {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return 0
+}
+
+func (x *UniverseRoot) GetLazy() bool {
+	if x != nil {
+		return x.Lazy
+	}
+	return false
+}
+
+func (x *UniverseRoot) GetBurnRoot() *MerkleSumNode {
+	if x != nil {
+		return x.BurnRoot
+	}
+	return nil
+}
+
+type AssetRootResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The outpoint of the asset key, either as a single hex encoded string, or
-	// an unrolled outpoint.
-	//
-	// Types that are assignable to Outpoint:
-	//
-	//	*AssetKey_OpStr
-	//	*AssetKey_Op
-	Outpoint isAssetKey_Outpoint `protobuf_oneof:"outpoint"`
-	// The script key of the asset.
-	//
-	// Types that are assignable to ScriptKey:
-	//
-	//	*AssetKey_ScriptKeyBytes
-	//	*AssetKey_ScriptKeyStr
-	ScriptKey isAssetKey_ScriptKey `protobuf_oneof:"script_key"`
+	// A map of the set of known universe roots for each asset. The key in the
+	// map is the 32-byte asset_id or group key hash.
+	UniverseRoots map[string]*UniverseRoot `protobuf:"bytes,1,rep,name=universe_roots,json=universeRoots,proto3" json:"universe_roots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The total number of known universe roots, independent of the offset and
+	// limit of the request.
+	TotalCount int64 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// The offset to use to fetch the next page of universe roots. If zero,
+	// then there are no more roots to fetch.
+	NextOffset int32 `protobuf:"varint,3,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
+	// The 33-byte compressed node identity key the universe roots are signed
+	// with. This is only set if the server is configured to sign asset roots.
+	SignerPubkey []byte `protobuf:"bytes,4,opt,name=signer_pubkey,json=signerPubkey,proto3" json:"signer_pubkey,omitempty"`
 }
 
-func (x *AssetKey) Reset() {
-	*x = AssetKey{}
+func (x *AssetRootResponse) Reset() {
+	*x = AssetRootResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[10]
+		mi := &file_universerpc_universe_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssetKey) String() string {
+func (x *AssetRootResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetKey) ProtoMessage() {}
+func (*AssetRootResponse) ProtoMessage() {}
 
-func (x *AssetKey) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[10]
+func (x *AssetRootResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -925,111 +1138,69 @@ func (x *AssetKey) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetKey.ProtoReflect.Descriptor instead.
-func (*AssetKey) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use AssetRootResponse.ProtoReflect.Descriptor instead.
+func (*AssetRootResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{8}
 }
 
-func (m *AssetKey) GetOutpoint() isAssetKey_Outpoint {
-	if m != nil {
-		return m.Outpoint
+func (x *AssetRootResponse) GetUniverseRoots() map[string]*UniverseRoot {
+	if x != nil {
+		return x.UniverseRoots
 	}
 	return nil
 }
 
-func (x *AssetKey) GetOpStr() string {
-	if x, ok := x.GetOutpoint().(*AssetKey_OpStr); ok {
-		return x.OpStr
-	}
-	return ""
-}
-
-func (x *AssetKey) GetOp() *Outpoint {
-	if x, ok := x.GetOutpoint().(*AssetKey_Op); ok {
-		return x.Op
+func (x *AssetRootResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
 	}
-	return nil
+	return 0
 }
 
-func (m *AssetKey) GetScriptKey() isAssetKey_ScriptKey {
-	if m != nil {
-		return m.ScriptKey
+func (x *AssetRootResponse) GetNextOffset() int32 {
+	if x != nil {
+		return x.NextOffset
 	}
-	return nil
+	return 0
 }
 
-func (x *AssetKey) GetScriptKeyBytes() []byte {
-	if x, ok := x.GetScriptKey().(*AssetKey_ScriptKeyBytes); ok {
-		return x.ScriptKeyBytes
+func (x *AssetRootResponse) GetSignerPubkey() []byte {
+	if x != nil {
+		return x.SignerPubkey
 	}
 	return nil
 }
 
-func (x *AssetKey) GetScriptKeyStr() string {
-	if x, ok := x.GetScriptKey().(*AssetKey_ScriptKeyStr); ok {
-		return x.ScriptKeyStr
-	}
-	return ""
-}
-
-type isAssetKey_Outpoint interface {
-	isAssetKey_Outpoint()
-}
-
-type AssetKey_OpStr struct {
-	OpStr string `protobuf:"bytes,1,opt,name=op_str,json=opStr,proto3,oneof"`
-}
-
-type AssetKey_Op struct {
-	Op *Outpoint `protobuf:"bytes,2,opt,name=op,proto3,oneof"`
-}
-
-func (*AssetKey_OpStr) isAssetKey_Outpoint() {}
-
-func (*AssetKey_Op) isAssetKey_Outpoint() {}
-
-type isAssetKey_ScriptKey interface {
-	isAssetKey_ScriptKey()
-}
-
-type AssetKey_ScriptKeyBytes struct {
-	ScriptKeyBytes []byte `protobuf:"bytes,3,opt,name=script_key_bytes,json=scriptKeyBytes,proto3,oneof"`
-}
-
-type AssetKey_ScriptKeyStr struct {
-	ScriptKeyStr string `protobuf:"bytes,4,opt,name=script_key_str,json=scriptKeyStr,proto3,oneof"`
-}
-
-func (*AssetKey_ScriptKeyBytes) isAssetKey_ScriptKey() {}
-
-func (*AssetKey_ScriptKeyStr) isAssetKey_ScriptKey() {}
-
-type AssetLeafKeyResponse struct {
+type AssetRootQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The set of asset leaf keys for the given asset ID or group key.
-	AssetKeys []*AssetKey `protobuf:"bytes,1,rep,name=asset_keys,json=assetKeys,proto3" json:"asset_keys,omitempty"`
+	// An ID value to uniquely identify a Universe root.
+	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// If non-zero, then the roots are returned as they were after the most
+	// recent leaf at or before this block height was inserted. Root history is
+	// only available for leaves inserted after the history was introduced.
+	AtHeight uint32 `protobuf:"varint,2,opt,name=at_height,json=atHeight,proto3" json:"at_height,omitempty"`
 }
 
-func (x *AssetLeafKeyResponse) Reset() {
-	*x = AssetLeafKeyResponse{}
+func (x *AssetRootQuery) Reset() {
+	*x = AssetRootQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[11]
+		mi := &file_universerpc_universe_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssetLeafKeyResponse) String() string {
+func (x *AssetRootQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetLeafKeyResponse) ProtoMessage() {}
+func (*AssetRootQuery) ProtoMessage() {}
 
-func (x *AssetLeafKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[11]
+func (x *AssetRootQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1040,48 +1211,53 @@ func (x *AssetLeafKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetLeafKeyResponse.ProtoReflect.Descriptor instead.
-func (*AssetLeafKeyResponse) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use AssetRootQuery.ProtoReflect.Descriptor instead.
+func (*AssetRootQuery) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *AssetLeafKeyResponse) GetAssetKeys() []*AssetKey {
+func (x *AssetRootQuery) GetId() *ID {
 	if x != nil {
-		return x.AssetKeys
+		return x.Id
 	}
 	return nil
 }
 
-type AssetLeaf struct {
+func (x *AssetRootQuery) GetAtHeight() uint32 {
+	if x != nil {
+		return x.AtHeight
+	}
+	return 0
+}
+
+type QueryRootResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The asset included in the leaf.
-	Asset *taprpc.Asset `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
-	// The asset issuance proof, which proves that the asset specified above
-	// was issued properly. This is always just an individual mint/transfer
-	// proof and never a proof file.
-	IssuanceProof []byte `protobuf:"bytes,2,opt,name=issuance_proof,json=issuanceProof,proto3" json:"issuance_proof,omitempty"`
+	// The issuance universe root for the given asset ID or group key.
+	IssuanceRoot *UniverseRoot `protobuf:"bytes,1,opt,name=issuance_root,json=issuanceRoot,proto3" json:"issuance_root,omitempty"`
+	// The transfer universe root for the given asset ID or group key.
+	TransferRoot *UniverseRoot `protobuf:"bytes,2,opt,name=transfer_root,json=transferRoot,proto3" json:"transfer_root,omitempty"`
 }
 
-func (x *AssetLeaf) Reset() {
-	*x = AssetLeaf{}
+func (x *QueryRootResponse) Reset() {
+	*x = QueryRootResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[12]
+		mi := &file_universerpc_universe_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssetLeaf) String() string {
+func (x *QueryRootResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetLeaf) ProtoMessage() {}
+func (*QueryRootResponse) ProtoMessage() {}
 
-func (x *AssetLeaf) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[12]
+func (x *QueryRootResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1092,51 +1268,52 @@ func (x *AssetLeaf) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetLeaf.ProtoReflect.Descriptor instead.
-func (*AssetLeaf) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use QueryRootResponse.ProtoReflect.Descriptor instead.
+func (*QueryRootResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *AssetLeaf) GetAsset() *taprpc.Asset {
+func (x *QueryRootResponse) GetIssuanceRoot() *UniverseRoot {
 	if x != nil {
-		return x.Asset
+		return x.IssuanceRoot
 	}
 	return nil
 }
 
-func (x *AssetLeaf) GetIssuanceProof() []byte {
+func (x *QueryRootResponse) GetTransferRoot() *UniverseRoot {
 	if x != nil {
-		return x.IssuanceProof
+		return x.TransferRoot
 	}
 	return nil
 }
 
-type AssetLeafResponse struct {
+type MultiverseRootRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The set of asset leaves for the given asset ID or group key.
-	Leaves []*AssetLeaf `protobuf:"bytes,1,rep,name=leaves,proto3" json:"leaves,omitempty"`
+	// The proof type to return the multiverse root for. This must be
+	// specified.
+	ProofType ProofType `protobuf:"varint,1,opt,name=proof_type,json=proofType,proto3,enum=universerpc.ProofType" json:"proof_type,omitempty"`
 }
 
-func (x *AssetLeafResponse) Reset() {
-	*x = AssetLeafResponse{}
+func (x *MultiverseRootRequest) Reset() {
+	*x = MultiverseRootRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[13]
+		mi := &file_universerpc_universe_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssetLeafResponse) String() string {
+func (x *MultiverseRootRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetLeafResponse) ProtoMessage() {}
+func (*MultiverseRootRequest) ProtoMessage() {}
 
-func (x *AssetLeafResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[13]
+func (x *MultiverseRootRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,46 +1324,54 @@ func (x *AssetLeafResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetLeafResponse.ProtoReflect.Descriptor instead.
-func (*AssetLeafResponse) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use MultiverseRootRequest.ProtoReflect.Descriptor instead.
+func (*MultiverseRootRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *AssetLeafResponse) GetLeaves() []*AssetLeaf {
+func (x *MultiverseRootRequest) GetProofType() ProofType {
 	if x != nil {
-		return x.Leaves
+		return x.ProofType
 	}
-	return nil
+	return ProofType_PROOF_TYPE_UNSPECIFIED
 }
 
-type UniverseKey struct {
+type MultiverseRootResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The ID of the asset to query for.
-	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The asset key to query for.
-	LeafKey *AssetKey `protobuf:"bytes,2,opt,name=leaf_key,json=leafKey,proto3" json:"leaf_key,omitempty"`
-}
-
-func (x *UniverseKey) Reset() {
-	*x = UniverseKey{}
+	// The root of the multiverse tree for the given proof type.
+	MultiverseRoot *MerkleSumNode `protobuf:"bytes,1,opt,name=multiverse_root,json=multiverseRoot,proto3" json:"multiverse_root,omitempty"`
+	// The DER encoded ECDSA signature of the server's node identity key over
+	// the SHA256 digest of the message
+	// "taproot-assets/multiverse-root" || proof_type || root_hash || root_sum,
+	// where the proof type is a single byte (1 for issuance, 2 for transfer)
+	// and the root sum is an 8-byte big endian integer. This is only set if the server is configured to sign
+	// roots.
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// The 33-byte compressed node identity key the root is signed with. This
+	// is only set if the server is configured to sign roots.
+	SignerPubkey []byte `protobuf:"bytes,3,opt,name=signer_pubkey,json=signerPubkey,proto3" json:"signer_pubkey,omitempty"`
+}
+
+func (x *MultiverseRootResponse) Reset() {
+	*x = MultiverseRootResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[14]
+		mi := &file_universerpc_universe_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UniverseKey) String() string {
+func (x *MultiverseRootResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UniverseKey) ProtoMessage() {}
+func (*MultiverseRootResponse) ProtoMessage() {}
 
-func (x *UniverseKey) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[14]
+func (x *MultiverseRootResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1197,64 +1382,58 @@ func (x *UniverseKey) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UniverseKey.ProtoReflect.Descriptor instead.
-func (*UniverseKey) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use MultiverseRootResponse.ProtoReflect.Descriptor instead.
+func (*MultiverseRootResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *UniverseKey) GetId() *ID {
+func (x *MultiverseRootResponse) GetMultiverseRoot() *MerkleSumNode {
 	if x != nil {
-		return x.Id
+		return x.MultiverseRoot
 	}
 	return nil
 }
 
-func (x *UniverseKey) GetLeafKey() *AssetKey {
+func (x *MultiverseRootResponse) GetSignature() []byte {
 	if x != nil {
-		return x.LeafKey
+		return x.Signature
 	}
 	return nil
 }
 
-type AssetProofResponse struct {
+func (x *MultiverseRootResponse) GetSignerPubkey() []byte {
+	if x != nil {
+		return x.SignerPubkey
+	}
+	return nil
+}
+
+type DeleteRootQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The request original request for the issuance proof.
-	Req *UniverseKey `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
-	// The Universe root that includes this asset leaf.
-	UniverseRoot *UniverseRoot `protobuf:"bytes,2,opt,name=universe_root,json=universeRoot,proto3" json:"universe_root,omitempty"`
-	// An inclusion proof for the asset leaf included below. The value is that
-	// issuance proof itself, with a sum value of the amount of the asset.
-	UniverseInclusionProof []byte `protobuf:"bytes,3,opt,name=universe_inclusion_proof,json=universeInclusionProof,proto3" json:"universe_inclusion_proof,omitempty"`
-	// The asset leaf itself, which includes the asset and the issuance proof.
-	AssetLeaf *AssetLeaf `protobuf:"bytes,4,opt,name=asset_leaf,json=assetLeaf,proto3" json:"asset_leaf,omitempty"`
-	// MultiverseRoot is the root of the multiverse tree that includes this
-	// asset leaf.
-	MultiverseRoot *MerkleSumNode `protobuf:"bytes,5,opt,name=multiverse_root,json=multiverseRoot,proto3" json:"multiverse_root,omitempty"`
-	// MultiverseInclusionProof is the inclusion proof for the asset leaf in the
-	// multiverse.
-	MultiverseInclusionProof []byte `protobuf:"bytes,6,opt,name=multiverse_inclusion_proof,json=multiverseInclusionProof,proto3" json:"multiverse_inclusion_proof,omitempty"`
+	// An ID value to uniquely identify a Universe root.
+	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *AssetProofResponse) Reset() {
-	*x = AssetProofResponse{}
+func (x *DeleteRootQuery) Reset() {
+	*x = DeleteRootQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[15]
+		mi := &file_universerpc_universe_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssetProofResponse) String() string {
+func (x *DeleteRootQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetProofResponse) ProtoMessage() {}
+func (*DeleteRootQuery) ProtoMessage() {}
 
-func (x *AssetProofResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[15]
+func (x *DeleteRootQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1265,81 +1444,83 @@ func (x *AssetProofResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetProofResponse.ProtoReflect.Descriptor instead.
-func (*AssetProofResponse) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use DeleteRootQuery.ProtoReflect.Descriptor instead.
+func (*DeleteRootQuery) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *AssetProofResponse) GetReq() *UniverseKey {
+func (x *DeleteRootQuery) GetId() *ID {
 	if x != nil {
-		return x.Req
+		return x.Id
 	}
 	return nil
 }
 
-func (x *AssetProofResponse) GetUniverseRoot() *UniverseRoot {
-	if x != nil {
-		return x.UniverseRoot
-	}
-	return nil
+type DeleteRootResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AssetProofResponse) GetUniverseInclusionProof() []byte {
-	if x != nil {
-		return x.UniverseInclusionProof
+func (x *DeleteRootResponse) Reset() {
+	*x = DeleteRootResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *AssetProofResponse) GetAssetLeaf() *AssetLeaf {
-	if x != nil {
-		return x.AssetLeaf
-	}
-	return nil
+func (x *DeleteRootResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AssetProofResponse) GetMultiverseRoot() *MerkleSumNode {
-	if x != nil {
-		return x.MultiverseRoot
+func (*DeleteRootResponse) ProtoMessage() {}
+
+func (x *DeleteRootResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *AssetProofResponse) GetMultiverseInclusionProof() []byte {
-	if x != nil {
-		return x.MultiverseInclusionProof
-	}
-	return nil
+// Deprecated: Use DeleteRootResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRootResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{14}
 }
 
-type AssetProof struct {
+type DeleteUniverseLeafRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The ID of the asset to insert the proof for.
+	// The Universe key of the leaf to delete. The proof type of the Universe
+	// ID must be specified.
 	Key *UniverseKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// The asset leaf to insert into the Universe tree.
-	AssetLeaf *AssetLeaf `protobuf:"bytes,4,opt,name=asset_leaf,json=assetLeaf,proto3" json:"asset_leaf,omitempty"`
 }
 
-func (x *AssetProof) Reset() {
-	*x = AssetProof{}
+func (x *DeleteUniverseLeafRequest) Reset() {
+	*x = DeleteUniverseLeafRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[16]
+		mi := &file_universerpc_universe_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssetProof) String() string {
+func (x *DeleteUniverseLeafRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetProof) ProtoMessage() {}
+func (*DeleteUniverseLeafRequest) ProtoMessage() {}
 
-func (x *AssetProof) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[16]
+func (x *DeleteUniverseLeafRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1350,48 +1531,45 @@ func (x *AssetProof) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetProof.ProtoReflect.Descriptor instead.
-func (*AssetProof) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use DeleteUniverseLeafRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUniverseLeafRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *AssetProof) GetKey() *UniverseKey {
+func (x *DeleteUniverseLeafRequest) GetKey() *UniverseKey {
 	if x != nil {
 		return x.Key
 	}
 	return nil
 }
 
-func (x *AssetProof) GetAssetLeaf() *AssetLeaf {
-	if x != nil {
-		return x.AssetLeaf
-	}
-	return nil
-}
-
-type InfoRequest struct {
+type DeleteUniverseLeafResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The Universe root after the leaf was deleted. If the Universe tree is
+	// empty after the deletion, then the root is left blank.
+	UniverseRoot *UniverseRoot `protobuf:"bytes,1,opt,name=universe_root,json=universeRoot,proto3" json:"universe_root,omitempty"`
 }
 
-func (x *InfoRequest) Reset() {
-	*x = InfoRequest{}
+func (x *DeleteUniverseLeafResponse) Reset() {
+	*x = DeleteUniverseLeafResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[17]
+		mi := &file_universerpc_universe_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InfoRequest) String() string {
+func (x *DeleteUniverseLeafResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InfoRequest) ProtoMessage() {}
+func (*DeleteUniverseLeafResponse) ProtoMessage() {}
 
-func (x *InfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[17]
+func (x *DeleteUniverseLeafResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1402,41 +1580,46 @@ func (x *InfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InfoRequest.ProtoReflect.Descriptor instead.
-func (*InfoRequest) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use DeleteUniverseLeafResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUniverseLeafResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{16}
 }
 
-type InfoResponse struct {
+func (x *DeleteUniverseLeafResponse) GetUniverseRoot() *UniverseRoot {
+	if x != nil {
+		return x.UniverseRoot
+	}
+	return nil
+}
+
+type Outpoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A pseudo-random runtime ID for the current instance of the Universe
-	// server, changes with each restart. Mainly used to identify identical
-	// servers when they are exposed under different hostnames/ports.
-	RuntimeId int64 `protobuf:"varint,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
-	// The number of assets known to this Universe server.
-	NumAssets uint64 `protobuf:"varint,2,opt,name=num_assets,json=numAssets,proto3" json:"num_assets,omitempty"`
+	// The output as a hex encoded (and reversed!) string.
+	HashStr string `protobuf:"bytes,1,opt,name=hash_str,json=hashStr,proto3" json:"hash_str,omitempty"`
+	// The index of the output.
+	Index int32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (x *InfoResponse) Reset() {
-	*x = InfoResponse{}
+func (x *Outpoint) Reset() {
+	*x = Outpoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[18]
+		mi := &file_universerpc_universe_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InfoResponse) String() string {
+func (x *Outpoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InfoResponse) ProtoMessage() {}
+func (*Outpoint) ProtoMessage() {}
 
-func (x *InfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[18]
+func (x *Outpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1447,50 +1630,64 @@ func (x *InfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InfoResponse.ProtoReflect.Descriptor instead.
-func (*InfoResponse) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use Outpoint.ProtoReflect.Descriptor instead.
+func (*Outpoint) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *InfoResponse) GetRuntimeId() int64 {
+func (x *Outpoint) GetHashStr() string {
 	if x != nil {
-		return x.RuntimeId
+		return x.HashStr
 	}
-	return 0
+	return ""
 }
 
-func (x *InfoResponse) GetNumAssets() uint64 {
+func (x *Outpoint) GetIndex() int32 {
 	if x != nil {
-		return x.NumAssets
+		return x.Index
 	}
 	return 0
 }
 
-type SyncTarget struct {
+type AssetKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The outpoint of the asset key, either as a single hex encoded string, or
+	// an unrolled outpoint.
+	//
+	// Types that are assignable to Outpoint:
+	//
+	//	*AssetKey_OpStr
+	//	*AssetKey_Op
+	Outpoint isAssetKey_Outpoint `protobuf_oneof:"outpoint"`
+	// The script key of the asset.
+	//
+	// Types that are assignable to ScriptKey:
+	//
+	//	*AssetKey_ScriptKeyBytes
+	//	*AssetKey_ScriptKeyStr
+	ScriptKey isAssetKey_ScriptKey `protobuf_oneof:"script_key"`
 }
 
-func (x *SyncTarget) Reset() {
-	*x = SyncTarget{}
+func (x *AssetKey) Reset() {
+	*x = AssetKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[19]
+		mi := &file_universerpc_universe_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SyncTarget) String() string {
+func (x *AssetKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncTarget) ProtoMessage() {}
+func (*AssetKey) ProtoMessage() {}
 
-func (x *SyncTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[19]
+func (x *AssetKey) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1501,116 +1698,111 @@ func (x *SyncTarget) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncTarget.ProtoReflect.Descriptor instead.
-func (*SyncTarget) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use AssetKey.ProtoReflect.Descriptor instead.
+func (*AssetKey) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SyncTarget) GetId() *ID {
-	if x != nil {
-		return x.Id
+func (m *AssetKey) GetOutpoint() isAssetKey_Outpoint {
+	if m != nil {
+		return m.Outpoint
 	}
 	return nil
 }
 
-type SyncRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AssetKey) GetOpStr() string {
+	if x, ok := x.GetOutpoint().(*AssetKey_OpStr); ok {
+		return x.OpStr
+	}
+	return ""
+}
 
-	// TODO(roasbeef): accept connection type? so can pass along self-signed
-	// cert, also brontide based RPC handshake
-	UniverseHost string `protobuf:"bytes,1,opt,name=universe_host,json=universeHost,proto3" json:"universe_host,omitempty"`
-	// The sync mode. This determines what type of proofs are synced.
-	SyncMode UniverseSyncMode `protobuf:"varint,2,opt,name=sync_mode,json=syncMode,proto3,enum=universerpc.UniverseSyncMode" json:"sync_mode,omitempty"`
-	// The set of assets to sync. If none are specified, then all assets are
-	// synced.
-	SyncTargets []*SyncTarget `protobuf:"bytes,3,rep,name=sync_targets,json=syncTargets,proto3" json:"sync_targets,omitempty"`
+func (x *AssetKey) GetOp() *Outpoint {
+	if x, ok := x.GetOutpoint().(*AssetKey_Op); ok {
+		return x.Op
+	}
+	return nil
 }
 
-func (x *SyncRequest) Reset() {
-	*x = SyncRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (m *AssetKey) GetScriptKey() isAssetKey_ScriptKey {
+	if m != nil {
+		return m.ScriptKey
 	}
+	return nil
 }
 
-func (x *SyncRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AssetKey) GetScriptKeyBytes() []byte {
+	if x, ok := x.GetScriptKey().(*AssetKey_ScriptKeyBytes); ok {
+		return x.ScriptKeyBytes
+	}
+	return nil
 }
 
-func (*SyncRequest) ProtoMessage() {}
-
-func (x *SyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AssetKey) GetScriptKeyStr() string {
+	if x, ok := x.GetScriptKey().(*AssetKey_ScriptKeyStr); ok {
+		return x.ScriptKeyStr
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use SyncRequest.ProtoReflect.Descriptor instead.
-func (*SyncRequest) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{20}
+type isAssetKey_Outpoint interface {
+	isAssetKey_Outpoint()
 }
 
-func (x *SyncRequest) GetUniverseHost() string {
-	if x != nil {
-		return x.UniverseHost
-	}
-	return ""
+type AssetKey_OpStr struct {
+	OpStr string `protobuf:"bytes,1,opt,name=op_str,json=opStr,proto3,oneof"`
 }
 
-func (x *SyncRequest) GetSyncMode() UniverseSyncMode {
-	if x != nil {
-		return x.SyncMode
-	}
-	return UniverseSyncMode_SYNC_ISSUANCE_ONLY
+type AssetKey_Op struct {
+	Op *Outpoint `protobuf:"bytes,2,opt,name=op,proto3,oneof"`
 }
 
-func (x *SyncRequest) GetSyncTargets() []*SyncTarget {
-	if x != nil {
-		return x.SyncTargets
-	}
-	return nil
+func (*AssetKey_OpStr) isAssetKey_Outpoint() {}
+
+func (*AssetKey_Op) isAssetKey_Outpoint() {}
+
+type isAssetKey_ScriptKey interface {
+	isAssetKey_ScriptKey()
 }
 
-type SyncedUniverse struct {
+type AssetKey_ScriptKeyBytes struct {
+	ScriptKeyBytes []byte `protobuf:"bytes,3,opt,name=script_key_bytes,json=scriptKeyBytes,proto3,oneof"`
+}
+
+type AssetKey_ScriptKeyStr struct {
+	ScriptKeyStr string `protobuf:"bytes,4,opt,name=script_key_str,json=scriptKeyStr,proto3,oneof"`
+}
+
+func (*AssetKey_ScriptKeyBytes) isAssetKey_ScriptKey() {}
+
+func (*AssetKey_ScriptKeyStr) isAssetKey_ScriptKey() {}
+
+type AssetLeafKeyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The old Universe root for the synced asset.
-	OldAssetRoot *UniverseRoot `protobuf:"bytes,1,opt,name=old_asset_root,json=oldAssetRoot,proto3" json:"old_asset_root,omitempty"`
-	// The new Universe root for the synced asset.
-	NewAssetRoot *UniverseRoot `protobuf:"bytes,2,opt,name=new_asset_root,json=newAssetRoot,proto3" json:"new_asset_root,omitempty"`
-	// The set of new asset leaves that were synced.
-	NewAssetLeaves []*AssetLeaf `protobuf:"bytes,3,rep,name=new_asset_leaves,json=newAssetLeaves,proto3" json:"new_asset_leaves,omitempty"`
+	// The set of asset leaf keys for the given asset ID or group key.
+	AssetKeys []*AssetKey `protobuf:"bytes,1,rep,name=asset_keys,json=assetKeys,proto3" json:"asset_keys,omitempty"`
 }
 
-func (x *SyncedUniverse) Reset() {
-	*x = SyncedUniverse{}
+func (x *AssetLeafKeyResponse) Reset() {
+	*x = AssetLeafKeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[21]
+		mi := &file_universerpc_universe_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SyncedUniverse) String() string {
+func (x *AssetLeafKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncedUniverse) ProtoMessage() {}
+func (*AssetLeafKeyResponse) ProtoMessage() {}
 
-func (x *SyncedUniverse) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[21]
+func (x *AssetLeafKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1621,55 +1813,47 @@ func (x *SyncedUniverse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncedUniverse.ProtoReflect.Descriptor instead.
-func (*SyncedUniverse) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *SyncedUniverse) GetOldAssetRoot() *UniverseRoot {
-	if x != nil {
-		return x.OldAssetRoot
-	}
-	return nil
-}
-
-func (x *SyncedUniverse) GetNewAssetRoot() *UniverseRoot {
-	if x != nil {
-		return x.NewAssetRoot
-	}
-	return nil
+// Deprecated: Use AssetLeafKeyResponse.ProtoReflect.Descriptor instead.
+func (*AssetLeafKeyResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SyncedUniverse) GetNewAssetLeaves() []*AssetLeaf {
+func (x *AssetLeafKeyResponse) GetAssetKeys() []*AssetKey {
 	if x != nil {
-		return x.NewAssetLeaves
+		return x.AssetKeys
 	}
 	return nil
 }
 
-type StatsRequest struct {
+type AssetLeafKeysSinceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The ID of the Universe to query.
+	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The root hash of the Universe known to the caller. Only the keys of
+	// leaves inserted after the Universe had this root are returned.
+	RootHash []byte `protobuf:"bytes,2,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
 }
 
-func (x *StatsRequest) Reset() {
-	*x = StatsRequest{}
+func (x *AssetLeafKeysSinceRequest) Reset() {
+	*x = AssetLeafKeysSinceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[22]
+		mi := &file_universerpc_universe_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatsRequest) String() string {
+func (x *AssetLeafKeysSinceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsRequest) ProtoMessage() {}
+func (*AssetLeafKeysSinceRequest) ProtoMessage() {}
 
-func (x *StatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[22]
+func (x *AssetLeafKeysSinceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1680,37 +1864,55 @@ func (x *StatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsRequest.ProtoReflect.Descriptor instead.
-func (*StatsRequest) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use AssetLeafKeysSinceRequest.ProtoReflect.Descriptor instead.
+func (*AssetLeafKeysSinceRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{20}
 }
 
-type SyncResponse struct {
+func (x *AssetLeafKeysSinceRequest) GetId() *ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *AssetLeafKeysSinceRequest) GetRootHash() []byte {
+	if x != nil {
+		return x.RootHash
+	}
+	return nil
+}
+
+type AssetLeafKeysSinceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The set of synced asset Universes.
-	SyncedUniverses []*SyncedUniverse `protobuf:"bytes,1,rep,name=synced_universes,json=syncedUniverses,proto3" json:"synced_universes,omitempty"`
+	// The set of asset leaf keys inserted after the Universe had the given
+	// root hash.
+	AssetKeys []*AssetKey `protobuf:"bytes,1,rep,name=asset_keys,json=assetKeys,proto3" json:"asset_keys,omitempty"`
+	// If true, then the given root hash isn't known as a current or past
+	// root of the Universe, so no keys are returned.
+	UnknownRoot bool `protobuf:"varint,2,opt,name=unknown_root,json=unknownRoot,proto3" json:"unknown_root,omitempty"`
 }
 
-func (x *SyncResponse) Reset() {
-	*x = SyncResponse{}
+func (x *AssetLeafKeysSinceResponse) Reset() {
+	*x = AssetLeafKeysSinceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[23]
+		mi := &file_universerpc_universe_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SyncResponse) String() string {
+func (x *AssetLeafKeysSinceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncResponse) ProtoMessage() {}
+func (*AssetLeafKeysSinceResponse) ProtoMessage() {}
 
-func (x *SyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[23]
+func (x *AssetLeafKeysSinceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1721,44 +1923,59 @@ func (x *SyncResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncResponse.ProtoReflect.Descriptor instead.
-func (*SyncResponse) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use AssetLeafKeysSinceResponse.ProtoReflect.Descriptor instead.
+func (*AssetLeafKeysSinceResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *SyncResponse) GetSyncedUniverses() []*SyncedUniverse {
+func (x *AssetLeafKeysSinceResponse) GetAssetKeys() []*AssetKey {
 	if x != nil {
-		return x.SyncedUniverses
+		return x.AssetKeys
 	}
 	return nil
 }
 
-type UniverseFederationServer struct {
+func (x *AssetLeafKeysSinceResponse) GetUnknownRoot() bool {
+	if x != nil {
+		return x.UnknownRoot
+	}
+	return false
+}
+
+type AssetLeavesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
-	Id   int32  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-}
-
-func (x *UniverseFederationServer) Reset() {
-	*x = UniverseFederationServer{}
+	// The ID of the universe to query the leaves of.
+	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The number of leaves to skip, in the order of their leaf keys.
+	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The maximum number of leaves to return. If zero, then all leaves
+	// starting at the offset are returned.
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// If true, then the full asset and proof of each leaf are included in
+	// the response. Otherwise, only the leaf keys and amounts are returned.
+	IncludeAssets bool `protobuf:"varint,4,opt,name=include_assets,json=includeAssets,proto3" json:"include_assets,omitempty"`
+}
+
+func (x *AssetLeavesRequest) Reset() {
+	*x = AssetLeavesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[24]
+		mi := &file_universerpc_universe_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UniverseFederationServer) String() string {
+func (x *AssetLeavesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UniverseFederationServer) ProtoMessage() {}
+func (*AssetLeavesRequest) ProtoMessage() {}
 
-func (x *UniverseFederationServer) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[24]
+func (x *AssetLeavesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1769,48 +1986,73 @@ func (x *UniverseFederationServer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UniverseFederationServer.ProtoReflect.Descriptor instead.
-func (*UniverseFederationServer) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use AssetLeavesRequest.ProtoReflect.Descriptor instead.
+func (*AssetLeavesRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UniverseFederationServer) GetHost() string {
+func (x *AssetLeavesRequest) GetId() *ID {
 	if x != nil {
-		return x.Host
+		return x.Id
 	}
-	return ""
+	return nil
 }
 
-func (x *UniverseFederationServer) GetId() int32 {
+func (x *AssetLeavesRequest) GetOffset() int32 {
 	if x != nil {
-		return x.Id
+		return x.Offset
 	}
 	return 0
 }
 
-type ListFederationServersRequest struct {
+func (x *AssetLeavesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *AssetLeavesRequest) GetIncludeAssets() bool {
+	if x != nil {
+		return x.IncludeAssets
+	}
+	return false
+}
+
+type AssetLeaf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The asset included in the leaf.
+	Asset *taprpc.Asset `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	// The asset issuance proof, which proves that the asset specified above
+	// was issued properly. This is always just an individual mint/transfer
+	// proof and never a proof file.
+	IssuanceProof []byte `protobuf:"bytes,2,opt,name=issuance_proof,json=issuanceProof,proto3" json:"issuance_proof,omitempty"`
+	// The key of the leaf within the universe tree.
+	LeafKey *AssetKey `protobuf:"bytes,3,opt,name=leaf_key,json=leafKey,proto3" json:"leaf_key,omitempty"`
+	// The amount of units associated with the leaf.
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *ListFederationServersRequest) Reset() {
-	*x = ListFederationServersRequest{}
+func (x *AssetLeaf) Reset() {
+	*x = AssetLeaf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[25]
+		mi := &file_universerpc_universe_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListFederationServersRequest) String() string {
+func (x *AssetLeaf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFederationServersRequest) ProtoMessage() {}
+func (*AssetLeaf) ProtoMessage() {}
 
-func (x *ListFederationServersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[25]
+func (x *AssetLeaf) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1821,83 +2063,71 @@ func (x *ListFederationServersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFederationServersRequest.ProtoReflect.Descriptor instead.
-func (*ListFederationServersRequest) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use AssetLeaf.ProtoReflect.Descriptor instead.
+func (*AssetLeaf) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{23}
 }
 
-type ListFederationServersResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Servers []*UniverseFederationServer `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
-}
-
-func (x *ListFederationServersResponse) Reset() {
-	*x = ListFederationServersResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AssetLeaf) GetAsset() *taprpc.Asset {
+	if x != nil {
+		return x.Asset
 	}
+	return nil
 }
 
-func (x *ListFederationServersResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListFederationServersResponse) ProtoMessage() {}
-
-func (x *ListFederationServersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AssetLeaf) GetIssuanceProof() []byte {
+	if x != nil {
+		return x.IssuanceProof
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListFederationServersResponse.ProtoReflect.Descriptor instead.
-func (*ListFederationServersResponse) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{26}
+func (x *AssetLeaf) GetLeafKey() *AssetKey {
+	if x != nil {
+		return x.LeafKey
+	}
+	return nil
 }
 
-func (x *ListFederationServersResponse) GetServers() []*UniverseFederationServer {
+func (x *AssetLeaf) GetAmount() uint64 {
 	if x != nil {
-		return x.Servers
+		return x.Amount
 	}
-	return nil
+	return 0
 }
 
-type AddFederationServerRequest struct {
+type AssetLeafResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Servers []*UniverseFederationServer `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	// The set of asset leaves for the given asset ID or group key.
+	Leaves []*AssetLeaf `protobuf:"bytes,1,rep,name=leaves,proto3" json:"leaves,omitempty"`
+	// The total number of leaves in the universe, independent of the offset
+	// and limit of the request.
+	TotalCount int64 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// The offset to use to fetch the next page of leaves. If zero, then
+	// there are no more leaves to fetch.
+	NextOffset int32 `protobuf:"varint,3,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
 }
 
-func (x *AddFederationServerRequest) Reset() {
-	*x = AddFederationServerRequest{}
+func (x *AssetLeafResponse) Reset() {
+	*x = AssetLeafResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[27]
+		mi := &file_universerpc_universe_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddFederationServerRequest) String() string {
+func (x *AssetLeafResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddFederationServerRequest) ProtoMessage() {}
+func (*AssetLeafResponse) ProtoMessage() {}
 
-func (x *AddFederationServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[27]
+func (x *AssetLeafResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1908,41 +2138,61 @@ func (x *AddFederationServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddFederationServerRequest.ProtoReflect.Descriptor instead.
-func (*AddFederationServerRequest) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use AssetLeafResponse.ProtoReflect.Descriptor instead.
+func (*AssetLeafResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *AddFederationServerRequest) GetServers() []*UniverseFederationServer {
+func (x *AssetLeafResponse) GetLeaves() []*AssetLeaf {
 	if x != nil {
-		return x.Servers
+		return x.Leaves
 	}
 	return nil
 }
 
-type AddFederationServerResponse struct {
+func (x *AssetLeafResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *AssetLeafResponse) GetNextOffset() int32 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
+type UniverseKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The ID of the asset to query for.
+	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The asset key to query for. When querying for a proof, the script key
+	// can be omitted to look up the leaf by its outpoint only.
+	LeafKey *AssetKey `protobuf:"bytes,2,opt,name=leaf_key,json=leafKey,proto3" json:"leaf_key,omitempty"`
 }
 
-func (x *AddFederationServerResponse) Reset() {
-	*x = AddFederationServerResponse{}
+func (x *UniverseKey) Reset() {
+	*x = UniverseKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[28]
+		mi := &file_universerpc_universe_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddFederationServerResponse) String() string {
+func (x *UniverseKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddFederationServerResponse) ProtoMessage() {}
+func (*UniverseKey) ProtoMessage() {}
 
-func (x *AddFederationServerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[28]
+func (x *UniverseKey) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1953,36 +2203,64 @@ func (x *AddFederationServerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddFederationServerResponse.ProtoReflect.Descriptor instead.
-func (*AddFederationServerResponse) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use UniverseKey.ProtoReflect.Descriptor instead.
+func (*UniverseKey) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{25}
 }
 
-type DeleteFederationServerRequest struct {
+func (x *UniverseKey) GetId() *ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UniverseKey) GetLeafKey() *AssetKey {
+	if x != nil {
+		return x.LeafKey
+	}
+	return nil
+}
+
+type AssetProofResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Servers []*UniverseFederationServer `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	// The request original request for the issuance proof.
+	Req *UniverseKey `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
+	// The Universe root that includes this asset leaf.
+	UniverseRoot *UniverseRoot `protobuf:"bytes,2,opt,name=universe_root,json=universeRoot,proto3" json:"universe_root,omitempty"`
+	// An inclusion proof for the asset leaf included below. The value is that
+	// issuance proof itself, with a sum value of the amount of the asset.
+	UniverseInclusionProof []byte `protobuf:"bytes,3,opt,name=universe_inclusion_proof,json=universeInclusionProof,proto3" json:"universe_inclusion_proof,omitempty"`
+	// The asset leaf itself, which includes the asset and the issuance proof.
+	AssetLeaf *AssetLeaf `protobuf:"bytes,4,opt,name=asset_leaf,json=assetLeaf,proto3" json:"asset_leaf,omitempty"`
+	// MultiverseRoot is the root of the multiverse tree that includes this
+	// asset leaf.
+	MultiverseRoot *MerkleSumNode `protobuf:"bytes,5,opt,name=multiverse_root,json=multiverseRoot,proto3" json:"multiverse_root,omitempty"`
+	// MultiverseInclusionProof is the inclusion proof for the asset leaf in the
+	// multiverse.
+	MultiverseInclusionProof []byte `protobuf:"bytes,6,opt,name=multiverse_inclusion_proof,json=multiverseInclusionProof,proto3" json:"multiverse_inclusion_proof,omitempty"`
 }
 
-func (x *DeleteFederationServerRequest) Reset() {
-	*x = DeleteFederationServerRequest{}
+func (x *AssetProofResponse) Reset() {
+	*x = AssetProofResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[29]
+		mi := &file_universerpc_universe_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteFederationServerRequest) String() string {
+func (x *AssetProofResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFederationServerRequest) ProtoMessage() {}
+func (*AssetProofResponse) ProtoMessage() {}
 
-func (x *DeleteFederationServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[29]
+func (x *AssetProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1993,84 +2271,93 @@ func (x *DeleteFederationServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFederationServerRequest.ProtoReflect.Descriptor instead.
-func (*DeleteFederationServerRequest) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use AssetProofResponse.ProtoReflect.Descriptor instead.
+func (*AssetProofResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *DeleteFederationServerRequest) GetServers() []*UniverseFederationServer {
+func (x *AssetProofResponse) GetReq() *UniverseKey {
 	if x != nil {
-		return x.Servers
+		return x.Req
 	}
 	return nil
 }
 
-type DeleteFederationServerResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AssetProofResponse) GetUniverseRoot() *UniverseRoot {
+	if x != nil {
+		return x.UniverseRoot
+	}
+	return nil
 }
 
-func (x *DeleteFederationServerResponse) Reset() {
-	*x = DeleteFederationServerResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[30]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AssetProofResponse) GetUniverseInclusionProof() []byte {
+	if x != nil {
+		return x.UniverseInclusionProof
 	}
+	return nil
 }
 
-func (x *DeleteFederationServerResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AssetProofResponse) GetAssetLeaf() *AssetLeaf {
+	if x != nil {
+		return x.AssetLeaf
+	}
+	return nil
 }
 
-func (*DeleteFederationServerResponse) ProtoMessage() {}
-
-func (x *DeleteFederationServerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[30]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AssetProofResponse) GetMultiverseRoot() *MerkleSumNode {
+	if x != nil {
+		return x.MultiverseRoot
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DeleteFederationServerResponse.ProtoReflect.Descriptor instead.
-func (*DeleteFederationServerResponse) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{30}
+func (x *AssetProofResponse) GetMultiverseInclusionProof() []byte {
+	if x != nil {
+		return x.MultiverseInclusionProof
+	}
+	return nil
 }
 
-type StatsResponse struct {
+type UniverseInclusionProof struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NumTotalAssets int64 `protobuf:"varint,1,opt,name=num_total_assets,json=numTotalAssets,proto3" json:"num_total_assets,omitempty"`
-	NumTotalGroups int64 `protobuf:"varint,2,opt,name=num_total_groups,json=numTotalGroups,proto3" json:"num_total_groups,omitempty"`
-	NumTotalSyncs  int64 `protobuf:"varint,3,opt,name=num_total_syncs,json=numTotalSyncs,proto3" json:"num_total_syncs,omitempty"`
-	NumTotalProofs int64 `protobuf:"varint,4,opt,name=num_total_proofs,json=numTotalProofs,proto3" json:"num_total_proofs,omitempty"`
-}
-
-func (x *StatsResponse) Reset() {
-	*x = StatsResponse{}
+	// The request original request for the inclusion proof.
+	Req *UniverseKey `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
+	// The Universe root that the asset leaf is committed to within.
+	UniverseRoot *MerkleSumNode `protobuf:"bytes,2,opt,name=universe_root,json=universeRoot,proto3" json:"universe_root,omitempty"`
+	// The key of the asset leaf within the MS-SMT, which is computed as
+	// sha256(outpoint || script_key).
+	LeafKey []byte `protobuf:"bytes,3,opt,name=leaf_key,json=leafKey,proto3" json:"leaf_key,omitempty"`
+	// The raw value of the asset leaf, which is the serialized issuance or
+	// transfer proof of the asset.
+	LeafValue []byte `protobuf:"bytes,4,opt,name=leaf_value,json=leafValue,proto3" json:"leaf_value,omitempty"`
+	// The claimed sum value of the asset leaf.
+	LeafSum uint64 `protobuf:"varint,5,opt,name=leaf_sum,json=leafSum,proto3" json:"leaf_sum,omitempty"`
+	// The compressed MS-SMT inclusion proof for the asset leaf. Together with
+	// the leaf key, value and sum, this can be used to reconstruct the
+	// Universe root.
+	UniverseInclusionProof []byte `protobuf:"bytes,6,opt,name=universe_inclusion_proof,json=universeInclusionProof,proto3" json:"universe_inclusion_proof,omitempty"`
+}
+
+func (x *UniverseInclusionProof) Reset() {
+	*x = UniverseInclusionProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[31]
+		mi := &file_universerpc_universe_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatsResponse) String() string {
+func (x *UniverseInclusionProof) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsResponse) ProtoMessage() {}
+func (*UniverseInclusionProof) ProtoMessage() {}
 
-func (x *StatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[31]
+func (x *UniverseInclusionProof) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2081,70 +2368,81 @@ func (x *StatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
-func (*StatsResponse) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use UniverseInclusionProof.ProtoReflect.Descriptor instead.
+func (*UniverseInclusionProof) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *StatsResponse) GetNumTotalAssets() int64 {
+func (x *UniverseInclusionProof) GetReq() *UniverseKey {
 	if x != nil {
-		return x.NumTotalAssets
+		return x.Req
 	}
-	return 0
+	return nil
 }
 
-func (x *StatsResponse) GetNumTotalGroups() int64 {
+func (x *UniverseInclusionProof) GetUniverseRoot() *MerkleSumNode {
 	if x != nil {
-		return x.NumTotalGroups
+		return x.UniverseRoot
 	}
-	return 0
+	return nil
 }
 
-func (x *StatsResponse) GetNumTotalSyncs() int64 {
+func (x *UniverseInclusionProof) GetLeafKey() []byte {
 	if x != nil {
-		return x.NumTotalSyncs
+		return x.LeafKey
 	}
-	return 0
+	return nil
 }
 
-func (x *StatsResponse) GetNumTotalProofs() int64 {
+func (x *UniverseInclusionProof) GetLeafValue() []byte {
 	if x != nil {
-		return x.NumTotalProofs
+		return x.LeafValue
 	}
-	return 0
+	return nil
 }
 
-type AssetStatsQuery struct {
-	state         protoimpl.MessageState
+func (x *UniverseInclusionProof) GetLeafSum() uint64 {
+	if x != nil {
+		return x.LeafSum
+	}
+	return 0
+}
+
+func (x *UniverseInclusionProof) GetUniverseInclusionProof() []byte {
+	if x != nil {
+		return x.UniverseInclusionProof
+	}
+	return nil
+}
+
+type AssetProof struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssetNameFilter string          `protobuf:"bytes,1,opt,name=asset_name_filter,json=assetNameFilter,proto3" json:"asset_name_filter,omitempty"`
-	AssetIdFilter   []byte          `protobuf:"bytes,2,opt,name=asset_id_filter,json=assetIdFilter,proto3" json:"asset_id_filter,omitempty"`
-	AssetTypeFilter AssetTypeFilter `protobuf:"varint,3,opt,name=asset_type_filter,json=assetTypeFilter,proto3,enum=universerpc.AssetTypeFilter" json:"asset_type_filter,omitempty"`
-	SortBy          AssetQuerySort  `protobuf:"varint,4,opt,name=sort_by,json=sortBy,proto3,enum=universerpc.AssetQuerySort" json:"sort_by,omitempty"`
-	Offset          int32           `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit           int32           `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
-	Direction       SortDirection   `protobuf:"varint,7,opt,name=direction,proto3,enum=universerpc.SortDirection" json:"direction,omitempty"`
+	// The ID of the asset to insert the proof for.
+	Key *UniverseKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The asset leaf to insert into the Universe tree.
+	AssetLeaf *AssetLeaf `protobuf:"bytes,4,opt,name=asset_leaf,json=assetLeaf,proto3" json:"asset_leaf,omitempty"`
 }
 
-func (x *AssetStatsQuery) Reset() {
-	*x = AssetStatsQuery{}
+func (x *AssetProof) Reset() {
+	*x = AssetProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[32]
+		mi := &file_universerpc_universe_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssetStatsQuery) String() string {
+func (x *AssetProof) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetStatsQuery) ProtoMessage() {}
+func (*AssetProof) ProtoMessage() {}
 
-func (x *AssetStatsQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[32]
+func (x *AssetProof) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2155,102 +2453,104 @@ func (x *AssetStatsQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetStatsQuery.ProtoReflect.Descriptor instead.
-func (*AssetStatsQuery) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use AssetProof.ProtoReflect.Descriptor instead.
+func (*AssetProof) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *AssetStatsQuery) GetAssetNameFilter() string {
+func (x *AssetProof) GetKey() *UniverseKey {
 	if x != nil {
-		return x.AssetNameFilter
+		return x.Key
 	}
-	return ""
+	return nil
 }
 
-func (x *AssetStatsQuery) GetAssetIdFilter() []byte {
+func (x *AssetProof) GetAssetLeaf() *AssetLeaf {
 	if x != nil {
-		return x.AssetIdFilter
+		return x.AssetLeaf
 	}
 	return nil
 }
 
-func (x *AssetStatsQuery) GetAssetTypeFilter() AssetTypeFilter {
-	if x != nil {
-		return x.AssetTypeFilter
-	}
-	return AssetTypeFilter_FILTER_ASSET_NONE
+type InsertProofsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The proofs to insert into the Universe trees.
+	Proofs []*AssetProof `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs,omitempty"`
 }
 
-func (x *AssetStatsQuery) GetSortBy() AssetQuerySort {
-	if x != nil {
-		return x.SortBy
+func (x *InsertProofsRequest) Reset() {
+	*x = InsertProofsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return AssetQuerySort_SORT_BY_NONE
 }
 
-func (x *AssetStatsQuery) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
+func (x *InsertProofsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AssetStatsQuery) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
+func (*InsertProofsRequest) ProtoMessage() {}
+
+func (x *InsertProofsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AssetStatsQuery) GetDirection() SortDirection {
+// Deprecated: Use InsertProofsRequest.ProtoReflect.Descriptor instead.
+func (*InsertProofsRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *InsertProofsRequest) GetProofs() []*AssetProof {
 	if x != nil {
-		return x.Direction
+		return x.Proofs
 	}
-	return SortDirection_SORT_DIRECTION_ASC
+	return nil
 }
 
-type AssetStatsSnapshot struct {
+type InsertProofResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The group key of the asset group. If this is empty, then the asset is
-	// not part of a group.
-	GroupKey []byte `protobuf:"bytes,1,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
-	// The total supply of the asset group. If the asset is not part of an asset
-	// group then this is always zero.
-	GroupSupply int64 `protobuf:"varint,2,opt,name=group_supply,json=groupSupply,proto3" json:"group_supply,omitempty"`
-	// The group anchor that was used to group assets together into an asset
-	// group. This is only set if the asset is part of an asset group.
-	GroupAnchor *AssetStatsAsset `protobuf:"bytes,3,opt,name=group_anchor,json=groupAnchor,proto3" json:"group_anchor,omitempty"`
-	// If the asset is not part of an asset group, then this is the asset the
-	// stats below refer to.
-	Asset *AssetStatsAsset `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
-	// The total number of syncs either for the asset group or the single asset
-	// if it is not part of a group.
-	TotalSyncs int64 `protobuf:"varint,5,opt,name=total_syncs,json=totalSyncs,proto3" json:"total_syncs,omitempty"`
-	// The total number of proofs either for the asset group or the single asset
-	// if it is not part of a group.
-	TotalProofs int64 `protobuf:"varint,6,opt,name=total_proofs,json=totalProofs,proto3" json:"total_proofs,omitempty"`
+	// The Universe key of the proof.
+	Key *UniverseKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Whether the proof is now part of the Universe tree, either because it
+	// was inserted or because it was already known.
+	Success bool `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// The reason the proof was rejected, if it wasn't inserted.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *AssetStatsSnapshot) Reset() {
-	*x = AssetStatsSnapshot{}
+func (x *InsertProofResult) Reset() {
+	*x = InsertProofResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[33]
+		mi := &file_universerpc_universe_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssetStatsSnapshot) String() string {
+func (x *InsertProofResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetStatsSnapshot) ProtoMessage() {}
+func (*InsertProofResult) ProtoMessage() {}
 
-func (x *AssetStatsSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[33]
+func (x *InsertProofResult) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2261,84 +2561,63 @@ func (x *AssetStatsSnapshot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetStatsSnapshot.ProtoReflect.Descriptor instead.
-func (*AssetStatsSnapshot) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *AssetStatsSnapshot) GetGroupKey() []byte {
-	if x != nil {
-		return x.GroupKey
-	}
-	return nil
-}
-
-func (x *AssetStatsSnapshot) GetGroupSupply() int64 {
-	if x != nil {
-		return x.GroupSupply
-	}
-	return 0
-}
-
-func (x *AssetStatsSnapshot) GetGroupAnchor() *AssetStatsAsset {
-	if x != nil {
-		return x.GroupAnchor
-	}
-	return nil
+// Deprecated: Use InsertProofResult.ProtoReflect.Descriptor instead.
+func (*InsertProofResult) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *AssetStatsSnapshot) GetAsset() *AssetStatsAsset {
+func (x *InsertProofResult) GetKey() *UniverseKey {
 	if x != nil {
-		return x.Asset
+		return x.Key
 	}
 	return nil
 }
 
-func (x *AssetStatsSnapshot) GetTotalSyncs() int64 {
+func (x *InsertProofResult) GetSuccess() bool {
 	if x != nil {
-		return x.TotalSyncs
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *AssetStatsSnapshot) GetTotalProofs() int64 {
+func (x *InsertProofResult) GetError() string {
 	if x != nil {
-		return x.TotalProofs
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-type AssetStatsAsset struct {
+type InsertProofsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssetId          []byte           `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
-	GenesisPoint     string           `protobuf:"bytes,2,opt,name=genesis_point,json=genesisPoint,proto3" json:"genesis_point,omitempty"`
-	TotalSupply      int64            `protobuf:"varint,3,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
-	AssetName        string           `protobuf:"bytes,4,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
-	AssetType        taprpc.AssetType `protobuf:"varint,5,opt,name=asset_type,json=assetType,proto3,enum=taprpc.AssetType" json:"asset_type,omitempty"`
-	GenesisHeight    int32            `protobuf:"varint,6,opt,name=genesis_height,json=genesisHeight,proto3" json:"genesis_height,omitempty"`
-	GenesisTimestamp int64            `protobuf:"varint,7,opt,name=genesis_timestamp,json=genesisTimestamp,proto3" json:"genesis_timestamp,omitempty"`
+	// The result of each proof of the request, in the same order as the
+	// proofs of the request.
+	Results []*InsertProofResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// The number of proofs that are now part of their Universe tree.
+	NumInserted uint32 `protobuf:"varint,2,opt,name=num_inserted,json=numInserted,proto3" json:"num_inserted,omitempty"`
+	// The number of proofs that were rejected.
+	NumFailed uint32 `protobuf:"varint,3,opt,name=num_failed,json=numFailed,proto3" json:"num_failed,omitempty"`
 }
 
-func (x *AssetStatsAsset) Reset() {
-	*x = AssetStatsAsset{}
+func (x *InsertProofsResponse) Reset() {
+	*x = InsertProofsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[34]
+		mi := &file_universerpc_universe_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssetStatsAsset) String() string {
+func (x *InsertProofsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetStatsAsset) ProtoMessage() {}
+func (*InsertProofsResponse) ProtoMessage() {}
 
-func (x *AssetStatsAsset) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[34]
+func (x *InsertProofsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2349,85 +2628,55 @@ func (x *AssetStatsAsset) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetStatsAsset.ProtoReflect.Descriptor instead.
-func (*AssetStatsAsset) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use InsertProofsResponse.ProtoReflect.Descriptor instead.
+func (*InsertProofsResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *AssetStatsAsset) GetAssetId() []byte {
+func (x *InsertProofsResponse) GetResults() []*InsertProofResult {
 	if x != nil {
-		return x.AssetId
+		return x.Results
 	}
 	return nil
 }
 
-func (x *AssetStatsAsset) GetGenesisPoint() string {
-	if x != nil {
-		return x.GenesisPoint
-	}
-	return ""
-}
-
-func (x *AssetStatsAsset) GetTotalSupply() int64 {
-	if x != nil {
-		return x.TotalSupply
-	}
-	return 0
-}
-
-func (x *AssetStatsAsset) GetAssetName() string {
-	if x != nil {
-		return x.AssetName
-	}
-	return ""
-}
-
-func (x *AssetStatsAsset) GetAssetType() taprpc.AssetType {
-	if x != nil {
-		return x.AssetType
-	}
-	return taprpc.AssetType(0)
-}
-
-func (x *AssetStatsAsset) GetGenesisHeight() int32 {
+func (x *InsertProofsResponse) GetNumInserted() uint32 {
 	if x != nil {
-		return x.GenesisHeight
+		return x.NumInserted
 	}
 	return 0
 }
 
-func (x *AssetStatsAsset) GetGenesisTimestamp() int64 {
+func (x *InsertProofsResponse) GetNumFailed() uint32 {
 	if x != nil {
-		return x.GenesisTimestamp
+		return x.NumFailed
 	}
 	return 0
 }
 
-type UniverseAssetStats struct {
+type InfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	AssetStats []*AssetStatsSnapshot `protobuf:"bytes,1,rep,name=asset_stats,json=assetStats,proto3" json:"asset_stats,omitempty"`
 }
 
-func (x *UniverseAssetStats) Reset() {
-	*x = UniverseAssetStats{}
+func (x *InfoRequest) Reset() {
+	*x = InfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[35]
+		mi := &file_universerpc_universe_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UniverseAssetStats) String() string {
+func (x *InfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UniverseAssetStats) ProtoMessage() {}
+func (*InfoRequest) ProtoMessage() {}
 
-func (x *UniverseAssetStats) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[35]
+func (x *InfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2438,44 +2687,41 @@ func (x *UniverseAssetStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UniverseAssetStats.ProtoReflect.Descriptor instead.
-func (*UniverseAssetStats) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *UniverseAssetStats) GetAssetStats() []*AssetStatsSnapshot {
-	if x != nil {
-		return x.AssetStats
-	}
-	return nil
+// Deprecated: Use InfoRequest.ProtoReflect.Descriptor instead.
+func (*InfoRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{32}
 }
 
-type QueryEventsRequest struct {
+type InfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StartTimestamp int64 `protobuf:"varint,1,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
-	EndTimestamp   int64 `protobuf:"varint,2,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
-}
-
-func (x *QueryEventsRequest) Reset() {
-	*x = QueryEventsRequest{}
+	// A pseudo-random runtime ID for the current instance of the Universe
+	// server, changes with each restart. Mainly used to identify identical
+	// servers when they are exposed under different hostnames/ports.
+	RuntimeId int64 `protobuf:"varint,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	// The number of assets known to this Universe server.
+	NumAssets uint64 `protobuf:"varint,2,opt,name=num_assets,json=numAssets,proto3" json:"num_assets,omitempty"`
+}
+
+func (x *InfoResponse) Reset() {
+	*x = InfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[36]
+		mi := &file_universerpc_universe_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryEventsRequest) String() string {
+func (x *InfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryEventsRequest) ProtoMessage() {}
+func (*InfoResponse) ProtoMessage() {}
 
-func (x *QueryEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[36]
+func (x *InfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2486,50 +2732,50 @@ func (x *QueryEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryEventsRequest.ProtoReflect.Descriptor instead.
-func (*QueryEventsRequest) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use InfoResponse.ProtoReflect.Descriptor instead.
+func (*InfoResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *QueryEventsRequest) GetStartTimestamp() int64 {
+func (x *InfoResponse) GetRuntimeId() int64 {
 	if x != nil {
-		return x.StartTimestamp
+		return x.RuntimeId
 	}
 	return 0
 }
 
-func (x *QueryEventsRequest) GetEndTimestamp() int64 {
+func (x *InfoResponse) GetNumAssets() uint64 {
 	if x != nil {
-		return x.EndTimestamp
+		return x.NumAssets
 	}
 	return 0
 }
 
-type QueryEventsResponse struct {
+type SyncTarget struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Events []*GroupedUniverseEvents `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *QueryEventsResponse) Reset() {
-	*x = QueryEventsResponse{}
+func (x *SyncTarget) Reset() {
+	*x = SyncTarget{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[37]
+		mi := &file_universerpc_universe_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryEventsResponse) String() string {
+func (x *SyncTarget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryEventsResponse) ProtoMessage() {}
+func (*SyncTarget) ProtoMessage() {}
 
-func (x *QueryEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[37]
+func (x *SyncTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2540,46 +2786,78 @@ func (x *QueryEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryEventsResponse.ProtoReflect.Descriptor instead.
-func (*QueryEventsResponse) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use SyncTarget.ProtoReflect.Descriptor instead.
+func (*SyncTarget) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *QueryEventsResponse) GetEvents() []*GroupedUniverseEvents {
+func (x *SyncTarget) GetId() *ID {
 	if x != nil {
-		return x.Events
+		return x.Id
 	}
 	return nil
 }
 
-type GroupedUniverseEvents struct {
+type SyncRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The date the events occurred on, formatted as YYYY-MM-DD.
-	Date           string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	SyncEvents     uint64 `protobuf:"varint,2,opt,name=sync_events,json=syncEvents,proto3" json:"sync_events,omitempty"`
-	NewProofEvents uint64 `protobuf:"varint,3,opt,name=new_proof_events,json=newProofEvents,proto3" json:"new_proof_events,omitempty"`
+	// TODO(roasbeef): accept connection type? so can pass along self-signed
+	// cert, also brontide based RPC handshake
+	UniverseHost string `protobuf:"bytes,1,opt,name=universe_host,json=universeHost,proto3" json:"universe_host,omitempty"`
+	// The sync mode. This determines what type of proofs are synced.
+	SyncMode UniverseSyncMode `protobuf:"varint,2,opt,name=sync_mode,json=syncMode,proto3,enum=universerpc.UniverseSyncMode" json:"sync_mode,omitempty"`
+	// The set of assets to sync. If none are specified, then all assets are
+	// synced. If the remote Universe server doesn't know about one of the
+	// specified assets, then an entry with the sync_error field set is
+	// returned for it.
+	SyncTargets []*SyncTarget `protobuf:"bytes,3,rep,name=sync_targets,json=syncTargets,proto3" json:"sync_targets,omitempty"`
+	// If true, then the diff against the remote Universe server is computed
+	// and returned, but none of the new leaves are inserted into the local
+	// Universe. This can be used to review the changes a sync would apply.
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// An optional lower case hex prefix that restricts the sync to universes
+	// whose asset ID (or 32-byte x-only group key for grouped assets) starts
+	// with the prefix. The prefix is passed on to the remote Universe server,
+	// so only the matching roots are fetched. If empty, all universes are
+	// synced.
+	IdPrefix string `protobuf:"bytes,5,opt,name=id_prefix,json=idPrefix,proto3" json:"id_prefix,omitempty"`
+	// If non-zero, overrides the minimum number of confirmations the anchor
+	// transaction of a new leaf must have to be synced. Leaves with a
+	// shallower anchor are skipped and synced by a later sync. If zero, the
+	// minimum configured for the node is used.
+	MinConfs uint32 `protobuf:"varint,6,opt,name=min_confs,json=minConfs,proto3" json:"min_confs,omitempty"`
+	// The multiverse root of the remote Universe server as returned in the
+	// multiverse_root field of a previous sync. If the remote multiverse root
+	// still matches, then the sync returns immediately without comparing any
+	// of the asset Universes, and multiverse_unchanged is set in the
+	// response.
+	KnownMultiverseRoot []byte `protobuf:"bytes,7,opt,name=known_multiverse_root,json=knownMultiverseRoot,proto3" json:"known_multiverse_root,omitempty"`
+	// If true, then the asset Universes that were already synced by a
+	// previous sync of the same type with the same server that was
+	// interrupted are skipped, instead of starting the sync over from
+	// scratch.
+	ResumeSync bool `protobuf:"varint,8,opt,name=resume_sync,json=resumeSync,proto3" json:"resume_sync,omitempty"`
 }
 
-func (x *GroupedUniverseEvents) Reset() {
-	*x = GroupedUniverseEvents{}
+func (x *SyncRequest) Reset() {
+	*x = SyncRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[38]
+		mi := &file_universerpc_universe_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupedUniverseEvents) String() string {
+func (x *SyncRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupedUniverseEvents) ProtoMessage() {}
+func (*SyncRequest) ProtoMessage() {}
 
-func (x *GroupedUniverseEvents) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[38]
+func (x *SyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2590,58 +2868,119 @@ func (x *GroupedUniverseEvents) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupedUniverseEvents.ProtoReflect.Descriptor instead.
-func (*GroupedUniverseEvents) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use SyncRequest.ProtoReflect.Descriptor instead.
+func (*SyncRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GroupedUniverseEvents) GetDate() string {
+func (x *SyncRequest) GetUniverseHost() string {
 	if x != nil {
-		return x.Date
+		return x.UniverseHost
 	}
 	return ""
 }
 
-func (x *GroupedUniverseEvents) GetSyncEvents() uint64 {
+func (x *SyncRequest) GetSyncMode() UniverseSyncMode {
 	if x != nil {
-		return x.SyncEvents
+		return x.SyncMode
 	}
-	return 0
+	return UniverseSyncMode_SYNC_ISSUANCE_ONLY
 }
 
-func (x *GroupedUniverseEvents) GetNewProofEvents() uint64 {
+func (x *SyncRequest) GetSyncTargets() []*SyncTarget {
 	if x != nil {
-		return x.NewProofEvents
+		return x.SyncTargets
+	}
+	return nil
+}
+
+func (x *SyncRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *SyncRequest) GetIdPrefix() string {
+	if x != nil {
+		return x.IdPrefix
+	}
+	return ""
+}
+
+func (x *SyncRequest) GetMinConfs() uint32 {
+	if x != nil {
+		return x.MinConfs
 	}
 	return 0
 }
 
-type SetFederationSyncConfigRequest struct {
+func (x *SyncRequest) GetKnownMultiverseRoot() []byte {
+	if x != nil {
+		return x.KnownMultiverseRoot
+	}
+	return nil
+}
+
+func (x *SyncRequest) GetResumeSync() bool {
+	if x != nil {
+		return x.ResumeSync
+	}
+	return false
+}
+
+type SyncedUniverse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GlobalSyncConfigs []*GlobalFederationSyncConfig `protobuf:"bytes,1,rep,name=global_sync_configs,json=globalSyncConfigs,proto3" json:"global_sync_configs,omitempty"`
-	AssetSyncConfigs  []*AssetFederationSyncConfig  `protobuf:"bytes,2,rep,name=asset_sync_configs,json=assetSyncConfigs,proto3" json:"asset_sync_configs,omitempty"`
+	// The old Universe root for the synced asset.
+	OldAssetRoot *UniverseRoot `protobuf:"bytes,1,opt,name=old_asset_root,json=oldAssetRoot,proto3" json:"old_asset_root,omitempty"`
+	// The new Universe root for the synced asset.
+	NewAssetRoot *UniverseRoot `protobuf:"bytes,2,opt,name=new_asset_root,json=newAssetRoot,proto3" json:"new_asset_root,omitempty"`
+	// The set of new asset leaves that were synced.
+	NewAssetLeaves []*AssetLeaf `protobuf:"bytes,3,rep,name=new_asset_leaves,json=newAssetLeaves,proto3" json:"new_asset_leaves,omitempty"`
+	// The number of new issuance leaves that were pulled from the remote
+	// Universe server for this asset.
+	NumIssuanceLeaves uint64 `protobuf:"varint,4,opt,name=num_issuance_leaves,json=numIssuanceLeaves,proto3" json:"num_issuance_leaves,omitempty"`
+	// The number of new transfer leaves that were pulled from the remote
+	// Universe server for this asset.
+	NumTransferLeaves uint64 `protobuf:"varint,5,opt,name=num_transfer_leaves,json=numTransferLeaves,proto3" json:"num_transfer_leaves,omitempty"`
+	// If non-empty, the sync of this asset Universe failed with the given
+	// error. A failure to sync a single asset doesn't abort the sync of the
+	// remaining assets.
+	SyncError string `protobuf:"bytes,6,opt,name=sync_error,json=syncError,proto3" json:"sync_error,omitempty"`
+	// If true, then a delta sync was requested, but the remote Universe
+	// didn't know the local root of this asset, so a full diff of all leaf
+	// keys was performed instead.
+	DeltaFallback bool `protobuf:"varint,7,opt,name=delta_fallback,json=deltaFallback,proto3" json:"delta_fallback,omitempty"`
+	// If true, then the sync of this asset Universe was skipped because the
+	// proof chain of one of its assets exceeded the maximum proof depth
+	// configured on the syncing node. The sync_error field is set as well.
+	SkippedTooDeep bool `protobuf:"varint,8,opt,name=skipped_too_deep,json=skippedTooDeep,proto3" json:"skipped_too_deep,omitempty"`
+	// The number of new leaves of the remote Universe that weren't synced
+	// because their anchor transaction didn't have the minimum number of
+	// confirmations yet. These leaves are synced by a later sync.
+	NumShallowLeaves uint64 `protobuf:"varint,9,opt,name=num_shallow_leaves,json=numShallowLeaves,proto3" json:"num_shallow_leaves,omitempty"`
 }
 
-func (x *SetFederationSyncConfigRequest) Reset() {
-	*x = SetFederationSyncConfigRequest{}
+func (x *SyncedUniverse) Reset() {
+	*x = SyncedUniverse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[39]
+		mi := &file_universerpc_universe_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetFederationSyncConfigRequest) String() string {
+func (x *SyncedUniverse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetFederationSyncConfigRequest) ProtoMessage() {}
+func (*SyncedUniverse) ProtoMessage() {}
 
-func (x *SetFederationSyncConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[39]
+func (x *SyncedUniverse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2652,99 +2991,101 @@ func (x *SetFederationSyncConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetFederationSyncConfigRequest.ProtoReflect.Descriptor instead.
-func (*SetFederationSyncConfigRequest) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use SyncedUniverse.ProtoReflect.Descriptor instead.
+func (*SyncedUniverse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *SetFederationSyncConfigRequest) GetGlobalSyncConfigs() []*GlobalFederationSyncConfig {
+func (x *SyncedUniverse) GetOldAssetRoot() *UniverseRoot {
 	if x != nil {
-		return x.GlobalSyncConfigs
+		return x.OldAssetRoot
 	}
 	return nil
 }
 
-func (x *SetFederationSyncConfigRequest) GetAssetSyncConfigs() []*AssetFederationSyncConfig {
+func (x *SyncedUniverse) GetNewAssetRoot() *UniverseRoot {
 	if x != nil {
-		return x.AssetSyncConfigs
+		return x.NewAssetRoot
 	}
 	return nil
 }
 
-type SetFederationSyncConfigResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SyncedUniverse) GetNewAssetLeaves() []*AssetLeaf {
+	if x != nil {
+		return x.NewAssetLeaves
+	}
+	return nil
 }
 
-func (x *SetFederationSyncConfigResponse) Reset() {
-	*x = SetFederationSyncConfigResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[40]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SyncedUniverse) GetNumIssuanceLeaves() uint64 {
+	if x != nil {
+		return x.NumIssuanceLeaves
 	}
+	return 0
 }
 
-func (x *SetFederationSyncConfigResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SyncedUniverse) GetNumTransferLeaves() uint64 {
+	if x != nil {
+		return x.NumTransferLeaves
+	}
+	return 0
 }
 
-func (*SetFederationSyncConfigResponse) ProtoMessage() {}
+func (x *SyncedUniverse) GetSyncError() string {
+	if x != nil {
+		return x.SyncError
+	}
+	return ""
+}
 
-func (x *SetFederationSyncConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[40]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SyncedUniverse) GetDeltaFallback() bool {
+	if x != nil {
+		return x.DeltaFallback
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use SetFederationSyncConfigResponse.ProtoReflect.Descriptor instead.
-func (*SetFederationSyncConfigResponse) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{40}
+func (x *SyncedUniverse) GetSkippedTooDeep() bool {
+	if x != nil {
+		return x.SkippedTooDeep
+	}
+	return false
 }
 
-// GlobalFederationSyncConfig is a global proof type specific configuration
-// for universe federation syncing.
-type GlobalFederationSyncConfig struct {
+func (x *SyncedUniverse) GetNumShallowLeaves() uint64 {
+	if x != nil {
+		return x.NumShallowLeaves
+	}
+	return 0
+}
+
+type StatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// proof_type is the universe proof type which this config applies to.
-	ProofType ProofType `protobuf:"varint,1,opt,name=proof_type,json=proofType,proto3,enum=universerpc.ProofType" json:"proof_type,omitempty"`
-	// allow_sync_insert is a boolean that indicates whether leaves from
-	// universes of the given proof type have may be inserted via federation
-	// sync.
-	AllowSyncInsert bool `protobuf:"varint,2,opt,name=allow_sync_insert,json=allowSyncInsert,proto3" json:"allow_sync_insert,omitempty"`
-	// allow_sync_export is a boolean that indicates whether leaves from
-	// universes of the given proof type have may be exported via federation
-	// sync.
-	AllowSyncExport bool `protobuf:"varint,3,opt,name=allow_sync_export,json=allowSyncExport,proto3" json:"allow_sync_export,omitempty"`
+	// If true, then the response will also include the stats of each known
+	// asset.
+	IncludeAssetStats bool `protobuf:"varint,1,opt,name=include_asset_stats,json=includeAssetStats,proto3" json:"include_asset_stats,omitempty"`
 }
 
-func (x *GlobalFederationSyncConfig) Reset() {
-	*x = GlobalFederationSyncConfig{}
+func (x *StatsRequest) Reset() {
+	*x = StatsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[41]
+		mi := &file_universerpc_universe_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GlobalFederationSyncConfig) String() string {
+func (x *StatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlobalFederationSyncConfig) ProtoMessage() {}
+func (*StatsRequest) ProtoMessage() {}
 
-func (x *GlobalFederationSyncConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[41]
+func (x *StatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2755,68 +3096,114 @@ func (x *GlobalFederationSyncConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlobalFederationSyncConfig.ProtoReflect.Descriptor instead.
-func (*GlobalFederationSyncConfig) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use StatsRequest.ProtoReflect.Descriptor instead.
+func (*StatsRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GlobalFederationSyncConfig) GetProofType() ProofType {
+func (x *StatsRequest) GetIncludeAssetStats() bool {
 	if x != nil {
-		return x.ProofType
+		return x.IncludeAssetStats
 	}
-	return ProofType_PROOF_TYPE_UNSPECIFIED
+	return false
 }
 
-func (x *GlobalFederationSyncConfig) GetAllowSyncInsert() bool {
+type SyncResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The set of synced asset Universes.
+	SyncedUniverses []*SyncedUniverse `protobuf:"bytes,1,rep,name=synced_universes,json=syncedUniverses,proto3" json:"synced_universes,omitempty"`
+	// If true, then the multiverse root of the remote Universe server matched
+	// the known_multiverse_root of the request, so nothing was synced.
+	MultiverseUnchanged bool `protobuf:"varint,2,opt,name=multiverse_unchanged,json=multiverseUnchanged,proto3" json:"multiverse_unchanged,omitempty"`
+	// The multiverse root of the remote Universe server at the start of the
+	// sync. This summarizes the multiverse trees covered by the sync mode and
+	// can be passed as known_multiverse_root to a later sync to skip it if
+	// nothing changed in the meantime.
+	MultiverseRoot []byte `protobuf:"bytes,3,opt,name=multiverse_root,json=multiverseRoot,proto3" json:"multiverse_root,omitempty"`
+}
+
+func (x *SyncResponse) Reset() {
+	*x = SyncResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncResponse) ProtoMessage() {}
+
+func (x *SyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncResponse.ProtoReflect.Descriptor instead.
+func (*SyncResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SyncResponse) GetSyncedUniverses() []*SyncedUniverse {
 	if x != nil {
-		return x.AllowSyncInsert
+		return x.SyncedUniverses
 	}
-	return false
+	return nil
 }
 
-func (x *GlobalFederationSyncConfig) GetAllowSyncExport() bool {
+func (x *SyncResponse) GetMultiverseUnchanged() bool {
 	if x != nil {
-		return x.AllowSyncExport
+		return x.MultiverseUnchanged
 	}
 	return false
 }
 
-// AssetFederationSyncConfig is an asset universe specific configuration for
-// federation syncing.
-type AssetFederationSyncConfig struct {
+func (x *SyncResponse) GetMultiverseRoot() []byte {
+	if x != nil {
+		return x.MultiverseRoot
+	}
+	return nil
+}
+
+type SyncAllRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the ID of the universe to configure.
-	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// allow_sync_insert is a boolean that indicates whether leaves from
-	// universes of the given proof type have may be inserted via federation
-	// sync.
-	AllowSyncInsert bool `protobuf:"varint,2,opt,name=allow_sync_insert,json=allowSyncInsert,proto3" json:"allow_sync_insert,omitempty"`
-	// allow_sync_export is a boolean that indicates whether leaves from
-	// universes of the given proof type have may be exported via federation
-	// sync.
-	AllowSyncExport bool `protobuf:"varint,3,opt,name=allow_sync_export,json=allowSyncExport,proto3" json:"allow_sync_export,omitempty"`
+	// The sync mode. This determines what type of proofs are synced.
+	SyncMode UniverseSyncMode `protobuf:"varint,1,opt,name=sync_mode,json=syncMode,proto3,enum=universerpc.UniverseSyncMode" json:"sync_mode,omitempty"`
 }
 
-func (x *AssetFederationSyncConfig) Reset() {
-	*x = AssetFederationSyncConfig{}
+func (x *SyncAllRequest) Reset() {
+	*x = SyncAllRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[42]
+		mi := &file_universerpc_universe_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssetFederationSyncConfig) String() string {
+func (x *SyncAllRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetFederationSyncConfig) ProtoMessage() {}
+func (*SyncAllRequest) ProtoMessage() {}
 
-func (x *AssetFederationSyncConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[42]
+func (x *SyncAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2827,58 +3214,136 @@ func (x *AssetFederationSyncConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetFederationSyncConfig.ProtoReflect.Descriptor instead.
-func (*AssetFederationSyncConfig) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use SyncAllRequest.ProtoReflect.Descriptor instead.
+func (*SyncAllRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *AssetFederationSyncConfig) GetId() *ID {
+func (x *SyncAllRequest) GetSyncMode() UniverseSyncMode {
 	if x != nil {
-		return x.Id
+		return x.SyncMode
 	}
-	return nil
+	return UniverseSyncMode_SYNC_ISSUANCE_ONLY
 }
 
-func (x *AssetFederationSyncConfig) GetAllowSyncInsert() bool {
+type MemberSyncResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The host of the federation member.
+	UniverseHost string `protobuf:"bytes,1,opt,name=universe_host,json=universeHost,proto3" json:"universe_host,omitempty"`
+	// True if the sync with the federation member succeeded. Individual
+	// asset Universes may still have failed to sync, which is reported in
+	// their sync_error field.
+	Success bool `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// If non-empty, the sync with the federation member failed with the
+	// given error.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// The set of asset Universes synced from the federation member.
+	SyncedUniverses []*SyncedUniverse `protobuf:"bytes,4,rep,name=synced_universes,json=syncedUniverses,proto3" json:"synced_universes,omitempty"`
+	// The number of new leaves that were inserted into the local Universe
+	// from this federation member.
+	NumLeaves uint64 `protobuf:"varint,5,opt,name=num_leaves,json=numLeaves,proto3" json:"num_leaves,omitempty"`
+}
+
+func (x *MemberSyncResult) Reset() {
+	*x = MemberSyncResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemberSyncResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberSyncResult) ProtoMessage() {}
+
+func (x *MemberSyncResult) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberSyncResult.ProtoReflect.Descriptor instead.
+func (*MemberSyncResult) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *MemberSyncResult) GetUniverseHost() string {
 	if x != nil {
-		return x.AllowSyncInsert
+		return x.UniverseHost
 	}
-	return false
+	return ""
 }
 
-func (x *AssetFederationSyncConfig) GetAllowSyncExport() bool {
+func (x *MemberSyncResult) GetSuccess() bool {
 	if x != nil {
-		return x.AllowSyncExport
+		return x.Success
 	}
 	return false
 }
 
-type QueryFederationSyncConfigRequest struct {
+func (x *MemberSyncResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MemberSyncResult) GetSyncedUniverses() []*SyncedUniverse {
+	if x != nil {
+		return x.SyncedUniverses
+	}
+	return nil
+}
+
+func (x *MemberSyncResult) GetNumLeaves() uint64 {
+	if x != nil {
+		return x.NumLeaves
+	}
+	return 0
+}
+
+type SyncAllResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Target universe ID(s).
-	Id []*ID `protobuf:"bytes,1,rep,name=id,proto3" json:"id,omitempty"`
+	// The sync result of each federation member.
+	Members []*MemberSyncResult `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	// The total number of distinct new leaves that were merged into the
+	// local Universe across all federation members. A leaf served by
+	// multiple members is only counted once.
+	TotalLeavesMerged uint64 `protobuf:"varint,2,opt,name=total_leaves_merged,json=totalLeavesMerged,proto3" json:"total_leaves_merged,omitempty"`
 }
 
-func (x *QueryFederationSyncConfigRequest) Reset() {
-	*x = QueryFederationSyncConfigRequest{}
+func (x *SyncAllResponse) Reset() {
+	*x = SyncAllResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[43]
+		mi := &file_universerpc_universe_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryFederationSyncConfigRequest) String() string {
+func (x *SyncAllResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryFederationSyncConfigRequest) ProtoMessage() {}
+func (*SyncAllResponse) ProtoMessage() {}
 
-func (x *QueryFederationSyncConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[43]
+func (x *SyncAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2889,726 +3354,7979 @@ func (x *QueryFederationSyncConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryFederationSyncConfigRequest.ProtoReflect.Descriptor instead.
-func (*QueryFederationSyncConfigRequest) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use SyncAllResponse.ProtoReflect.Descriptor instead.
+func (*SyncAllResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *QueryFederationSyncConfigRequest) GetId() []*ID {
+func (x *SyncAllResponse) GetMembers() []*MemberSyncResult {
 	if x != nil {
-		return x.Id
+		return x.Members
 	}
 	return nil
 }
 
-type QueryFederationSyncConfigResponse struct {
+func (x *SyncAllResponse) GetTotalLeavesMerged() uint64 {
+	if x != nil {
+		return x.TotalLeavesMerged
+	}
+	return 0
+}
+
+type SyncProgress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GlobalSyncConfigs []*GlobalFederationSyncConfig `protobuf:"bytes,1,rep,name=global_sync_configs,json=globalSyncConfigs,proto3" json:"global_sync_configs,omitempty"`
-	AssetSyncConfigs  []*AssetFederationSyncConfig  `protobuf:"bytes,2,rep,name=asset_sync_configs,json=assetSyncConfigs,proto3" json:"asset_sync_configs,omitempty"`
+	// The total number of Universes that are synced.
+	NumUniverses uint32 `protobuf:"varint,1,opt,name=num_universes,json=numUniverses,proto3" json:"num_universes,omitempty"`
+	// The number of Universes whose sync is finished, either successfully or
+	// with an error.
+	NumUniversesDone uint32 `protobuf:"varint,2,opt,name=num_universes_done,json=numUniversesDone,proto3" json:"num_universes_done,omitempty"`
+	// The total number of new leaves inserted into the local Universe so
+	// far.
+	NumLeavesInserted uint64 `protobuf:"varint,3,opt,name=num_leaves_inserted,json=numLeavesInserted,proto3" json:"num_leaves_inserted,omitempty"`
+	// The ID of the Universe that caused this progress event. Not set for
+	// the initial event that is sent once the set of Universes to sync is
+	// known.
+	CurrentId *ID `protobuf:"bytes,4,opt,name=current_id,json=currentId,proto3" json:"current_id,omitempty"`
+}
+
+func (x *SyncProgress) Reset() {
+	*x = SyncProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *QueryFederationSyncConfigResponse) Reset() {
-	*x = QueryFederationSyncConfigResponse{}
+func (x *SyncProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncProgress) ProtoMessage() {}
+
+func (x *SyncProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncProgress.ProtoReflect.Descriptor instead.
+func (*SyncProgress) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SyncProgress) GetNumUniverses() uint32 {
+	if x != nil {
+		return x.NumUniverses
+	}
+	return 0
+}
+
+func (x *SyncProgress) GetNumUniversesDone() uint32 {
+	if x != nil {
+		return x.NumUniversesDone
+	}
+	return 0
+}
+
+func (x *SyncProgress) GetNumLeavesInserted() uint64 {
+	if x != nil {
+		return x.NumLeavesInserted
+	}
+	return 0
+}
+
+func (x *SyncProgress) GetCurrentId() *ID {
+	if x != nil {
+		return x.CurrentId
+	}
+	return nil
+}
+
+type SyncProgressEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//
+	//	*SyncProgressEvent_Progress
+	//	*SyncProgressEvent_Summary
+	Event isSyncProgressEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *SyncProgressEvent) Reset() {
+	*x = SyncProgressEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_universerpc_universe_proto_msgTypes[44]
+		mi := &file_universerpc_universe_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryFederationSyncConfigResponse) String() string {
+func (x *SyncProgressEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryFederationSyncConfigResponse) ProtoMessage() {}
+func (*SyncProgressEvent) ProtoMessage() {}
 
-func (x *QueryFederationSyncConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_universerpc_universe_proto_msgTypes[44]
+func (x *SyncProgressEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use QueryFederationSyncConfigResponse.ProtoReflect.Descriptor instead.
-func (*QueryFederationSyncConfigResponse) Descriptor() ([]byte, []int) {
-	return file_universerpc_universe_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *QueryFederationSyncConfigResponse) GetGlobalSyncConfigs() []*GlobalFederationSyncConfig {
-	if x != nil {
-		return x.GlobalSyncConfigs
-	}
-	return nil
-}
-
-func (x *QueryFederationSyncConfigResponse) GetAssetSyncConfigs() []*AssetFederationSyncConfig {
-	if x != nil {
-		return x.AssetSyncConfigs
-	}
-	return nil
-}
-
-var File_universerpc_universe_proto protoreflect.FileDescriptor
-
-var file_universerpc_universe_proto_rawDesc = []byte{
-	0x0a, 0x1a, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2f, 0x75, 0x6e,
-	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x75, 0x6e,
-	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x1a, 0x13, 0x74, 0x61, 0x70, 0x72, 0x6f,
-	0x6f, 0x74, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x12,
-	0x0a, 0x10, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x47, 0x0a, 0x0d, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x53, 0x75, 0x6d, 0x4e,
-	0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68,
-	0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x73, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x75, 0x6d, 0x22, 0xc7, 0x01, 0x0a, 0x02,
-	0x49, 0x44, 0x12, 0x1b, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12,
-	0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64,
-	0x53, 0x74, 0x72, 0x12, 0x1d, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b,
-	0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f,
-	0x73, 0x74, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6f,
-	0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x75,
-	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x54, 0x79, 0x70, 0x65, 0x42,
-	0x04, 0x0a, 0x02, 0x69, 0x64, 0x22, 0xae, 0x02, 0x0a, 0x0c, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63,
-	0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x6d, 0x73, 0x73, 0x6d, 0x74,
-	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x6e,
-	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
-	0x53, 0x75, 0x6d, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x6d, 0x73, 0x73, 0x6d, 0x74, 0x52, 0x6f,
-	0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x5e, 0x0a, 0x13, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x79, 0x5f,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
-	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69,
-	0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x42, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x10, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49,
-	0x64, 0x1a, 0x43, 0x0a, 0x15, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x49, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xca, 0x01, 0x0a, 0x11, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0e,
-	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72,
-	0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f,
-	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73,
-	0x65, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x1a, 0x5b, 0x0a, 0x12, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76,
-	0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x31, 0x0a, 0x0e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e,
-	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x93, 0x01, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d,
-	0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
-	0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x0c,
-	0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3e, 0x0a, 0x0d,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
-	0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x0c,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x32, 0x0a, 0x0f,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
-	0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x6e,
-	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a, 0x08, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x61, 0x73, 0x68, 0x53, 0x74, 0x72, 0x12, 0x14, 0x0a,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x22, 0xba, 0x01, 0x0a, 0x08, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4b, 0x65, 0x79,
-	0x12, 0x17, 0x0a, 0x06, 0x6f, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x05, 0x6f, 0x70, 0x53, 0x74, 0x72, 0x12, 0x27, 0x0a, 0x02, 0x6f, 0x70, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
-	0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x02,
-	0x6f, 0x70, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79,
-	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x0e,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x26,
-	0x0a, 0x0e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79,
-	0x22, 0x4c, 0x0a, 0x14, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x4b, 0x65, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x75,
-	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x4b, 0x65, 0x79, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x57,
-	0x0a, 0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x12, 0x23, 0x0a, 0x05, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x61, 0x70,
-	0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x12, 0x25, 0x0a, 0x0e, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f,
-	0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e,
-	0x63, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x43, 0x0a, 0x11, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x4c, 0x65, 0x61, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06,
-	0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x75,
-	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x4c, 0x65, 0x61, 0x66, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x22, 0x60, 0x0a, 0x0b,
-	0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x08,
-	0x6c, 0x65, 0x61, 0x66, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x66, 0x4b, 0x65, 0x79, 0x22, 0xf4,
-	0x02, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x03, 0x72, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63,
-	0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x72, 0x65,
-	0x71, 0x12, 0x3e, 0x0a, 0x0d, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x72, 0x6f,
-	0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65,
-	0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52,
-	0x6f, 0x6f, 0x74, 0x52, 0x0c, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f,
-	0x74, 0x12, 0x38, 0x0a, 0x18, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x16, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x49, 0x6e, 0x63,
-	0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x35, 0x0a, 0x0a, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x65, 0x61, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x65,
-	0x61, 0x66, 0x12, 0x43, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
-	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x6e,
-	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
-	0x53, 0x75, 0x6d, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65,
-	0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3c, 0x0a, 0x1a, 0x6d, 0x75, 0x6c, 0x74, 0x69,
-	0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
-	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x18, 0x6d, 0x75, 0x6c,
-	0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x6f, 0x0a, 0x0a, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x12, 0x2a, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55,
-	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x35, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x65, 0x61, 0x66, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
-	0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x09, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x22, 0x0d, 0x0a, 0x0b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x0c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x73, 0x22, 0x2d, 0x0a, 0x0a, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0xaa, 0x01, 0x0a, 0x0b, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x68,
-	0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x6e, 0x69, 0x76, 0x65,
-	0x72, 0x73, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x5f,
-	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x75, 0x6e, 0x69,
-	0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73,
-	0x65, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x4d,
-	0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x75, 0x6e, 0x69, 0x76,
-	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x22,
-	0xd4, 0x01, 0x0a, 0x0e, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x6f, 0x6c, 0x64, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
-	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x6e, 0x69,
-	0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73,
-	0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x0c, 0x6f, 0x6c, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
-	0x6f, 0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x6e,
-	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x40, 0x0a, 0x10, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x0e, 0x6e, 0x65, 0x77, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x56, 0x0a, 0x0c, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x10, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64,
-	0x5f, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x53,
-	0x79, 0x6e, 0x63, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x0f, 0x73,
-	0x79, 0x6e, 0x63, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x22, 0x3e,
-	0x0a, 0x18, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1e,
-	0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x60,
-	0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3f, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55,
-	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
-	0x22, 0x5d, 0x0a, 0x1a, 0x41, 0x64, 0x64, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f,
-	0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x25, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e,
-	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22,
-	0x1d, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x60,
-	0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x3f, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55,
-	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
-	0x22, 0x20, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0xb5, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
-	0x6e, 0x75, 0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x28,
-	0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x79, 0x6e, 0x63, 0x73,
-	0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x72,
-	0x6f, 0x6f, 0x66, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x54,
-	0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x22, 0xcd, 0x02, 0x0a, 0x0f, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2a,
-	0x0a, 0x11, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x12, 0x48, 0x0a, 0x11, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
-	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0f, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x07,
-	0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
-	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74,
-	0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x38, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
-	0x63, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8d, 0x02, 0x0a, 0x12, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x21,
-	0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x75, 0x70, 0x70, 0x6c,
-	0x79, 0x12, 0x3f, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x6e, 0x63, 0x68,
-	0x6f, 0x72, 0x12, 0x32, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
-	0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x73, 0x79, 0x6e, 0x63, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x53, 0x79, 0x6e, 0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x22, 0x99, 0x02, 0x0a, 0x0f, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x19,
-	0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21,
-	0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c,
-	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x30, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x67, 0x65, 0x6e, 0x65,
-	0x73, 0x69, 0x73, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x67, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x56, 0x0a, 0x12, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x0b,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x62,
-	0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x23, 0x0a,
-	0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x22, 0x51, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x6e, 0x69, 0x76,
-	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x55,
-	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x06, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x76, 0x0a, 0x15, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64,
-	0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6e,
-	0x65, 0x77, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xcf, 0x01,
-	0x0a, 0x1e, 0x53, 0x65, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x57, 0x0a, 0x13, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
-	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x6c, 0x6f, 0x62,
-	0x61, 0x6c, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x79,
-	0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x54, 0x0a, 0x12, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
-	0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x10, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22,
-	0x21, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0xab, 0x01, 0x0a, 0x1a, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x46, 0x65, 0x64,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
-	0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x70,
-	0x72, 0x6f, 0x6f, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f,
-	0x77, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e,
-	0x73, 0x65, 0x72, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x79,
-	0x6e, 0x63, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x22, 0x94, 0x01, 0x0a, 0x19, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x6e, 0x69,
-	0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x2a, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x6e,
-	0x73, 0x65, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f,
-	0x77, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x79, 0x6e,
-	0x63, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x43, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0xd2, 0x01, 0x0a,
-	0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x57, 0x0a, 0x13, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x73, 0x79, 0x6e,
-	0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x27, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x6c,
-	0x6f, 0x62, 0x61, 0x6c, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79,
-	0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
-	0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x54, 0x0a, 0x12, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x10, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x73, 0x2a, 0x59, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a,
-	0x0a, 0x16, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52,
-	0x4f, 0x4f, 0x46, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x41, 0x4e, 0x43,
-	0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x10, 0x02, 0x2a, 0x39, 0x0a, 0x10,
-	0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65,
-	0x12, 0x16, 0x0a, 0x12, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x41, 0x4e, 0x43,
-	0x45, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x59, 0x4e, 0x43,
-	0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x01, 0x2a, 0xd1, 0x01, 0x0a, 0x0e, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x4f,
-	0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12,
-	0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x4e, 0x41,
-	0x4d, 0x45, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f,
-	0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x49, 0x44, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x4f,
-	0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x54, 0x4f,
-	0x54, 0x41, 0x4c, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x53, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x53,
-	0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x50, 0x52, 0x4f,
-	0x4f, 0x46, 0x53, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59,
-	0x5f, 0x47, 0x45, 0x4e, 0x45, 0x53, 0x49, 0x53, 0x5f, 0x48, 0x45, 0x49, 0x47, 0x48, 0x54, 0x10,
-	0x06, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x54, 0x4f, 0x54,
-	0x41, 0x4c, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4c, 0x59, 0x10, 0x07, 0x2a, 0x40, 0x0a, 0x0d, 0x53,
-	0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12,
-	0x53, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41,
-	0x53, 0x43, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x49, 0x52,
-	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x01, 0x2a, 0x5f, 0x0a,
-	0x0f, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x12, 0x15, 0x0a, 0x11, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54,
-	0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x49, 0x4c, 0x54, 0x45,
-	0x52, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x01,
-	0x12, 0x1c, 0x0a, 0x18, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54,
-	0x5f, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x32, 0xbd,
-	0x0b, 0x0a, 0x08, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x75, 0x6e, 0x69, 0x76,
-	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65,
-	0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x75, 0x6e,
-	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
-	0x6f, 0x6f, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x1e, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65,
-	0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x6f, 0x6f, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1c, 0x2e, 0x75, 0x6e,
-	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x6f, 0x6f, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x76,
-	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f,
-	0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x0f, 0x2e, 0x75, 0x6e,
-	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44, 0x1a, 0x21, 0x2e, 0x75,
-	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x4c, 0x65, 0x61, 0x66, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3e, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x12, 0x0f,
-	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44, 0x1a,
-	0x1e, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x47, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x18, 0x2e,
-	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76,
-	0x65, 0x72, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x1a, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x65,
-	0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x17, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66,
-	0x1a, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3b, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x2e, 0x75, 0x6e, 0x69, 0x76,
-	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
-	0x63, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43,
-	0x0a, 0x0c, 0x53, 0x79, 0x6e, 0x63, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x18,
-	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x79, 0x6e,
-	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65,
-	0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x29, 0x2e, 0x75,
-	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46,
-	0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x75, 0x6e, 0x69,
-	0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x65, 0x64, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
-	0x63, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a,
-	0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x64, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
-	0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x46, 0x0a, 0x0d, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x12, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x75,
-	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x75, 0x6e,
-	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x76,
-	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x50, 0x0a, 0x0b, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x76,
-	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x6e, 0x69,
-	0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x17,
-	0x53, 0x65, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e,
-	0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72,
-	0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x7a, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x64, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e,
-	0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
-	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3c,
-	0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67,
-	0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x6f,
-	0x6f, 0x74, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
-	0x2f, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_universerpc_universe_proto_rawDescOnce sync.Once
-	file_universerpc_universe_proto_rawDescData = file_universerpc_universe_proto_rawDesc
-)
-
-func file_universerpc_universe_proto_rawDescGZIP() []byte {
-	file_universerpc_universe_proto_rawDescOnce.Do(func() {
-		file_universerpc_universe_proto_rawDescData = protoimpl.X.CompressGZIP(file_universerpc_universe_proto_rawDescData)
-	})
-	return file_universerpc_universe_proto_rawDescData
-}
-
-var file_universerpc_universe_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_universerpc_universe_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
-var file_universerpc_universe_proto_goTypes = []interface{}{
-	(ProofType)(0),                            // 0: universerpc.ProofType
-	(UniverseSyncMode)(0),                     // 1: universerpc.UniverseSyncMode
-	(AssetQuerySort)(0),                       // 2: universerpc.AssetQuerySort
-	(SortDirection)(0),                        // 3: universerpc.SortDirection
-	(AssetTypeFilter)(0),                      // 4: universerpc.AssetTypeFilter
-	(*AssetRootRequest)(nil),                  // 5: universerpc.AssetRootRequest
-	(*MerkleSumNode)(nil),                     // 6: universerpc.MerkleSumNode
-	(*ID)(nil),                                // 7: universerpc.ID
-	(*UniverseRoot)(nil),                      // 8: universerpc.UniverseRoot
-	(*AssetRootResponse)(nil),                 // 9: universerpc.AssetRootResponse
-	(*AssetRootQuery)(nil),                    // 10: universerpc.AssetRootQuery
-	(*QueryRootResponse)(nil),                 // 11: universerpc.QueryRootResponse
-	(*DeleteRootQuery)(nil),                   // 12: universerpc.DeleteRootQuery
-	(*DeleteRootResponse)(nil),                // 13: universerpc.DeleteRootResponse
-	(*Outpoint)(nil),                          // 14: universerpc.Outpoint
-	(*AssetKey)(nil),                          // 15: universerpc.AssetKey
-	(*AssetLeafKeyResponse)(nil),              // 16: universerpc.AssetLeafKeyResponse
-	(*AssetLeaf)(nil),                         // 17: universerpc.AssetLeaf
-	(*AssetLeafResponse)(nil),                 // 18: universerpc.AssetLeafResponse
-	(*UniverseKey)(nil),                       // 19: universerpc.UniverseKey
-	(*AssetProofResponse)(nil),                // 20: universerpc.AssetProofResponse
-	(*AssetProof)(nil),                        // 21: universerpc.AssetProof
-	(*InfoRequest)(nil),                       // 22: universerpc.InfoRequest
-	(*InfoResponse)(nil),                      // 23: universerpc.InfoResponse
-	(*SyncTarget)(nil),                        // 24: universerpc.SyncTarget
-	(*SyncRequest)(nil),                       // 25: universerpc.SyncRequest
-	(*SyncedUniverse)(nil),                    // 26: universerpc.SyncedUniverse
-	(*StatsRequest)(nil),                      // 27: universerpc.StatsRequest
-	(*SyncResponse)(nil),                      // 28: universerpc.SyncResponse
-	(*UniverseFederationServer)(nil),          // 29: universerpc.UniverseFederationServer
-	(*ListFederationServersRequest)(nil),      // 30: universerpc.ListFederationServersRequest
-	(*ListFederationServersResponse)(nil),     // 31: universerpc.ListFederationServersResponse
-	(*AddFederationServerRequest)(nil),        // 32: universerpc.AddFederationServerRequest
-	(*AddFederationServerResponse)(nil),       // 33: universerpc.AddFederationServerResponse
-	(*DeleteFederationServerRequest)(nil),     // 34: universerpc.DeleteFederationServerRequest
-	(*DeleteFederationServerResponse)(nil),    // 35: universerpc.DeleteFederationServerResponse
-	(*StatsResponse)(nil),                     // 36: universerpc.StatsResponse
-	(*AssetStatsQuery)(nil),                   // 37: universerpc.AssetStatsQuery
-	(*AssetStatsSnapshot)(nil),                // 38: universerpc.AssetStatsSnapshot
-	(*AssetStatsAsset)(nil),                   // 39: universerpc.AssetStatsAsset
-	(*UniverseAssetStats)(nil),                // 40: universerpc.UniverseAssetStats
-	(*QueryEventsRequest)(nil),                // 41: universerpc.QueryEventsRequest
-	(*QueryEventsResponse)(nil),               // 42: universerpc.QueryEventsResponse
-	(*GroupedUniverseEvents)(nil),             // 43: universerpc.GroupedUniverseEvents
-	(*SetFederationSyncConfigRequest)(nil),    // 44: universerpc.SetFederationSyncConfigRequest
-	(*SetFederationSyncConfigResponse)(nil),   // 45: universerpc.SetFederationSyncConfigResponse
-	(*GlobalFederationSyncConfig)(nil),        // 46: universerpc.GlobalFederationSyncConfig
-	(*AssetFederationSyncConfig)(nil),         // 47: universerpc.AssetFederationSyncConfig
-	(*QueryFederationSyncConfigRequest)(nil),  // 48: universerpc.QueryFederationSyncConfigRequest
-	(*QueryFederationSyncConfigResponse)(nil), // 49: universerpc.QueryFederationSyncConfigResponse
-	nil,                   // 50: universerpc.UniverseRoot.AmountsByAssetIdEntry
-	nil,                   // 51: universerpc.AssetRootResponse.UniverseRootsEntry
-	(*taprpc.Asset)(nil),  // 52: taprpc.Asset
-	(taprpc.AssetType)(0), // 53: taprpc.AssetType
-}
-var file_universerpc_universe_proto_depIdxs = []int32{
-	0,  // 0: universerpc.ID.proof_type:type_name -> universerpc.ProofType
-	7,  // 1: universerpc.UniverseRoot.id:type_name -> universerpc.ID
-	6,  // 2: universerpc.UniverseRoot.mssmt_root:type_name -> universerpc.MerkleSumNode
-	50, // 3: universerpc.UniverseRoot.amounts_by_asset_id:type_name -> universerpc.UniverseRoot.AmountsByAssetIdEntry
-	51, // 4: universerpc.AssetRootResponse.universe_roots:type_name -> universerpc.AssetRootResponse.UniverseRootsEntry
-	7,  // 5: universerpc.AssetRootQuery.id:type_name -> universerpc.ID
-	8,  // 6: universerpc.QueryRootResponse.issuance_root:type_name -> universerpc.UniverseRoot
-	8,  // 7: universerpc.QueryRootResponse.transfer_root:type_name -> universerpc.UniverseRoot
-	7,  // 8: universerpc.DeleteRootQuery.id:type_name -> universerpc.ID
-	14, // 9: universerpc.AssetKey.op:type_name -> universerpc.Outpoint
-	15, // 10: universerpc.AssetLeafKeyResponse.asset_keys:type_name -> universerpc.AssetKey
-	52, // 11: universerpc.AssetLeaf.asset:type_name -> taprpc.Asset
-	17, // 12: universerpc.AssetLeafResponse.leaves:type_name -> universerpc.AssetLeaf
-	7,  // 13: universerpc.UniverseKey.id:type_name -> universerpc.ID
-	15, // 14: universerpc.UniverseKey.leaf_key:type_name -> universerpc.AssetKey
-	19, // 15: universerpc.AssetProofResponse.req:type_name -> universerpc.UniverseKey
-	8,  // 16: universerpc.AssetProofResponse.universe_root:type_name -> universerpc.UniverseRoot
-	17, // 17: universerpc.AssetProofResponse.asset_leaf:type_name -> universerpc.AssetLeaf
-	6,  // 18: universerpc.AssetProofResponse.multiverse_root:type_name -> universerpc.MerkleSumNode
-	19, // 19: universerpc.AssetProof.key:type_name -> universerpc.UniverseKey
-	17, // 20: universerpc.AssetProof.asset_leaf:type_name -> universerpc.AssetLeaf
-	7,  // 21: universerpc.SyncTarget.id:type_name -> universerpc.ID
-	1,  // 22: universerpc.SyncRequest.sync_mode:type_name -> universerpc.UniverseSyncMode
-	24, // 23: universerpc.SyncRequest.sync_targets:type_name -> universerpc.SyncTarget
-	8,  // 24: universerpc.SyncedUniverse.old_asset_root:type_name -> universerpc.UniverseRoot
-	8,  // 25: universerpc.SyncedUniverse.new_asset_root:type_name -> universerpc.UniverseRoot
-	17, // 26: universerpc.SyncedUniverse.new_asset_leaves:type_name -> universerpc.AssetLeaf
-	26, // 27: universerpc.SyncResponse.synced_universes:type_name -> universerpc.SyncedUniverse
-	29, // 28: universerpc.ListFederationServersResponse.servers:type_name -> universerpc.UniverseFederationServer
-	29, // 29: universerpc.AddFederationServerRequest.servers:type_name -> universerpc.UniverseFederationServer
-	29, // 30: universerpc.DeleteFederationServerRequest.servers:type_name -> universerpc.UniverseFederationServer
-	4,  // 31: universerpc.AssetStatsQuery.asset_type_filter:type_name -> universerpc.AssetTypeFilter
-	2,  // 32: universerpc.AssetStatsQuery.sort_by:type_name -> universerpc.AssetQuerySort
-	3,  // 33: universerpc.AssetStatsQuery.direction:type_name -> universerpc.SortDirection
-	39, // 34: universerpc.AssetStatsSnapshot.group_anchor:type_name -> universerpc.AssetStatsAsset
-	39, // 35: universerpc.AssetStatsSnapshot.asset:type_name -> universerpc.AssetStatsAsset
-	53, // 36: universerpc.AssetStatsAsset.asset_type:type_name -> taprpc.AssetType
-	38, // 37: universerpc.UniverseAssetStats.asset_stats:type_name -> universerpc.AssetStatsSnapshot
-	43, // 38: universerpc.QueryEventsResponse.events:type_name -> universerpc.GroupedUniverseEvents
-	46, // 39: universerpc.SetFederationSyncConfigRequest.global_sync_configs:type_name -> universerpc.GlobalFederationSyncConfig
-	47, // 40: universerpc.SetFederationSyncConfigRequest.asset_sync_configs:type_name -> universerpc.AssetFederationSyncConfig
-	0,  // 41: universerpc.GlobalFederationSyncConfig.proof_type:type_name -> universerpc.ProofType
-	7,  // 42: universerpc.AssetFederationSyncConfig.id:type_name -> universerpc.ID
-	7,  // 43: universerpc.QueryFederationSyncConfigRequest.id:type_name -> universerpc.ID
-	46, // 44: universerpc.QueryFederationSyncConfigResponse.global_sync_configs:type_name -> universerpc.GlobalFederationSyncConfig
-	47, // 45: universerpc.QueryFederationSyncConfigResponse.asset_sync_configs:type_name -> universerpc.AssetFederationSyncConfig
-	8,  // 46: universerpc.AssetRootResponse.UniverseRootsEntry.value:type_name -> universerpc.UniverseRoot
-	5,  // 47: universerpc.Universe.AssetRoots:input_type -> universerpc.AssetRootRequest
-	10, // 48: universerpc.Universe.QueryAssetRoots:input_type -> universerpc.AssetRootQuery
-	12, // 49: universerpc.Universe.DeleteAssetRoot:input_type -> universerpc.DeleteRootQuery
-	7,  // 50: universerpc.Universe.AssetLeafKeys:input_type -> universerpc.ID
-	7,  // 51: universerpc.Universe.AssetLeaves:input_type -> universerpc.ID
-	19, // 52: universerpc.Universe.QueryProof:input_type -> universerpc.UniverseKey
-	21, // 53: universerpc.Universe.InsertProof:input_type -> universerpc.AssetProof
-	22, // 54: universerpc.Universe.Info:input_type -> universerpc.InfoRequest
-	25, // 55: universerpc.Universe.SyncUniverse:input_type -> universerpc.SyncRequest
-	30, // 56: universerpc.Universe.ListFederationServers:input_type -> universerpc.ListFederationServersRequest
-	32, // 57: universerpc.Universe.AddFederationServer:input_type -> universerpc.AddFederationServerRequest
-	34, // 58: universerpc.Universe.DeleteFederationServer:input_type -> universerpc.DeleteFederationServerRequest
-	27, // 59: universerpc.Universe.UniverseStats:input_type -> universerpc.StatsRequest
-	37, // 60: universerpc.Universe.QueryAssetStats:input_type -> universerpc.AssetStatsQuery
-	41, // 61: universerpc.Universe.QueryEvents:input_type -> universerpc.QueryEventsRequest
-	44, // 62: universerpc.Universe.SetFederationSyncConfig:input_type -> universerpc.SetFederationSyncConfigRequest
-	48, // 63: universerpc.Universe.QueryFederationSyncConfig:input_type -> universerpc.QueryFederationSyncConfigRequest
-	9,  // 64: universerpc.Universe.AssetRoots:output_type -> universerpc.AssetRootResponse
-	11, // 65: universerpc.Universe.QueryAssetRoots:output_type -> universerpc.QueryRootResponse
-	13, // 66: universerpc.Universe.DeleteAssetRoot:output_type -> universerpc.DeleteRootResponse
-	16, // 67: universerpc.Universe.AssetLeafKeys:output_type -> universerpc.AssetLeafKeyResponse
-	18, // 68: universerpc.Universe.AssetLeaves:output_type -> universerpc.AssetLeafResponse
-	20, // 69: universerpc.Universe.QueryProof:output_type -> universerpc.AssetProofResponse
-	20, // 70: universerpc.Universe.InsertProof:output_type -> universerpc.AssetProofResponse
-	23, // 71: universerpc.Universe.Info:output_type -> universerpc.InfoResponse
-	28, // 72: universerpc.Universe.SyncUniverse:output_type -> universerpc.SyncResponse
-	31, // 73: universerpc.Universe.ListFederationServers:output_type -> universerpc.ListFederationServersResponse
-	33, // 74: universerpc.Universe.AddFederationServer:output_type -> universerpc.AddFederationServerResponse
-	35, // 75: universerpc.Universe.DeleteFederationServer:output_type -> universerpc.DeleteFederationServerResponse
-	36, // 76: universerpc.Universe.UniverseStats:output_type -> universerpc.StatsResponse
-	40, // 77: universerpc.Universe.QueryAssetStats:output_type -> universerpc.UniverseAssetStats
-	42, // 78: universerpc.Universe.QueryEvents:output_type -> universerpc.QueryEventsResponse
-	45, // 79: universerpc.Universe.SetFederationSyncConfig:output_type -> universerpc.SetFederationSyncConfigResponse
-	49, // 80: universerpc.Universe.QueryFederationSyncConfig:output_type -> universerpc.QueryFederationSyncConfigResponse
-	64, // [64:81] is the sub-list for method output_type
-	47, // [47:64] is the sub-list for method input_type
-	47, // [47:47] is the sub-list for extension type_name
-	47, // [47:47] is the sub-list for extension extendee
-	0,  // [0:47] is the sub-list for field type_name
-}
-
-func init() { file_universerpc_universe_proto_init() }
-func file_universerpc_universe_proto_init() {
-	if File_universerpc_universe_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_universerpc_universe_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetRootRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncProgressEvent.ProtoReflect.Descriptor instead.
+func (*SyncProgressEvent) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{43}
+}
+
+func (m *SyncProgressEvent) GetEvent() isSyncProgressEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *SyncProgressEvent) GetProgress() *SyncProgress {
+	if x, ok := x.GetEvent().(*SyncProgressEvent_Progress); ok {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *SyncProgressEvent) GetSummary() *SyncResponse {
+	if x, ok := x.GetEvent().(*SyncProgressEvent_Summary); ok {
+		return x.Summary
+	}
+	return nil
+}
+
+type isSyncProgressEvent_Event interface {
+	isSyncProgressEvent_Event()
+}
+
+type SyncProgressEvent_Progress struct {
+	// A progress update of the ongoing sync.
+	Progress *SyncProgress `protobuf:"bytes,1,opt,name=progress,proto3,oneof"`
+}
+
+type SyncProgressEvent_Summary struct {
+	// The summary of the finished sync. This is always the last event.
+	Summary *SyncResponse `protobuf:"bytes,2,opt,name=summary,proto3,oneof"`
+}
+
+func (*SyncProgressEvent_Progress) isSyncProgressEvent_Event() {}
+
+func (*SyncProgressEvent_Summary) isSyncProgressEvent_Event() {}
+
+type DiffUniverseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The host of the remote Universe server to compare the local roots with.
+	UniverseHost string `protobuf:"bytes,1,opt,name=universe_host,json=universeHost,proto3" json:"universe_host,omitempty"`
+}
+
+func (x *DiffUniverseRequest) Reset() {
+	*x = DiffUniverseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffUniverseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffUniverseRequest) ProtoMessage() {}
+
+func (x *DiffUniverseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffUniverseRequest.ProtoReflect.Descriptor instead.
+func (*DiffUniverseRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DiffUniverseRequest) GetUniverseHost() string {
+	if x != nil {
+		return x.UniverseHost
+	}
+	return ""
+}
+
+type UniverseRootDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the asset Universe whose roots differ.
+	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The root of the local Universe. Not set if the asset isn't known
+	// locally.
+	LocalRoot *MerkleSumNode `protobuf:"bytes,2,opt,name=local_root,json=localRoot,proto3" json:"local_root,omitempty"`
+	// The root of the remote Universe. Not set if the asset isn't known to the
+	// remote Universe server.
+	RemoteRoot *MerkleSumNode `protobuf:"bytes,3,opt,name=remote_root,json=remoteRoot,proto3" json:"remote_root,omitempty"`
+	// Which side of the comparison is ahead of the other one.
+	Status RootDiffStatus `protobuf:"varint,4,opt,name=status,proto3,enum=universerpc.RootDiffStatus" json:"status,omitempty"`
+	// The number of leaves the side that is ahead has on top of the leaves of
+	// the other side. Zero if the Universes diverged.
+	NumNewLeaves uint64 `protobuf:"varint,5,opt,name=num_new_leaves,json=numNewLeaves,proto3" json:"num_new_leaves,omitempty"`
+}
+
+func (x *UniverseRootDiff) Reset() {
+	*x = UniverseRootDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UniverseRootDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UniverseRootDiff) ProtoMessage() {}
+
+func (x *UniverseRootDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UniverseRootDiff.ProtoReflect.Descriptor instead.
+func (*UniverseRootDiff) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UniverseRootDiff) GetId() *ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UniverseRootDiff) GetLocalRoot() *MerkleSumNode {
+	if x != nil {
+		return x.LocalRoot
+	}
+	return nil
+}
+
+func (x *UniverseRootDiff) GetRemoteRoot() *MerkleSumNode {
+	if x != nil {
+		return x.RemoteRoot
+	}
+	return nil
+}
+
+func (x *UniverseRootDiff) GetStatus() RootDiffStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RootDiffStatus_ROOT_DIFF_LOCAL_AHEAD
+}
+
+func (x *UniverseRootDiff) GetNumNewLeaves() uint64 {
+	if x != nil {
+		return x.NumNewLeaves
+	}
+	return 0
+}
+
+type DiffUniverseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The set of asset Universes whose roots differ between the local and the
+	// remote Universe server. Empty if both are in sync.
+	Diffs []*UniverseRootDiff `protobuf:"bytes,1,rep,name=diffs,proto3" json:"diffs,omitempty"`
+}
+
+func (x *DiffUniverseResponse) Reset() {
+	*x = DiffUniverseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffUniverseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffUniverseResponse) ProtoMessage() {}
+
+func (x *DiffUniverseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffUniverseResponse.ProtoReflect.Descriptor instead.
+func (*DiffUniverseResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DiffUniverseResponse) GetDiffs() []*UniverseRootDiff {
+	if x != nil {
+		return x.Diffs
+	}
+	return nil
+}
+
+type VerifyUniverseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the asset Universe to verify. If not set, then all local
+	// Universes are verified. If the proof type is unspecified, then both the
+	// issuance and the transfer Universe of the asset are verified.
+	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// If true, the persisted roots of Universes that don't match the roots
+	// recomputed from their leaves are rewritten to match.
+	Repair bool `protobuf:"varint,2,opt,name=repair,proto3" json:"repair,omitempty"`
+}
+
+func (x *VerifyUniverseRequest) Reset() {
+	*x = VerifyUniverseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyUniverseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyUniverseRequest) ProtoMessage() {}
+
+func (x *VerifyUniverseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyUniverseRequest.ProtoReflect.Descriptor instead.
+func (*VerifyUniverseRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *VerifyUniverseRequest) GetId() *ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *VerifyUniverseRequest) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+type UniverseRootMismatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the asset Universe whose roots don't match.
+	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The root of the Universe as it was persisted before the verification.
+	// Not set if no root was persisted.
+	PersistedRoot *MerkleSumNode `protobuf:"bytes,2,opt,name=persisted_root,json=persistedRoot,proto3" json:"persisted_root,omitempty"`
+	// The root of the Universe as committed to in the multiverse tree. Not
+	// set if the multiverse tree doesn't commit to the Universe. The sum of
+	// the node is always one for issuance Universes.
+	CommittedRoot *MerkleSumNode `protobuf:"bytes,3,opt,name=committed_root,json=committedRoot,proto3" json:"committed_root,omitempty"`
+	// The root recomputed from the leaves stored for the Universe. Not set if
+	// no leaves are stored.
+	ComputedRoot *MerkleSumNode `protobuf:"bytes,4,opt,name=computed_root,json=computedRoot,proto3" json:"computed_root,omitempty"`
+	// The number of leaves stored for the Universe.
+	NumLeaves uint64 `protobuf:"varint,5,opt,name=num_leaves,json=numLeaves,proto3" json:"num_leaves,omitempty"`
+	// Whether the persisted roots were rewritten to match the recomputed
+	// root.
+	Repaired bool `protobuf:"varint,6,opt,name=repaired,proto3" json:"repaired,omitempty"`
+}
+
+func (x *UniverseRootMismatch) Reset() {
+	*x = UniverseRootMismatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UniverseRootMismatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UniverseRootMismatch) ProtoMessage() {}
+
+func (x *UniverseRootMismatch) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UniverseRootMismatch.ProtoReflect.Descriptor instead.
+func (*UniverseRootMismatch) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UniverseRootMismatch) GetId() *ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UniverseRootMismatch) GetPersistedRoot() *MerkleSumNode {
+	if x != nil {
+		return x.PersistedRoot
+	}
+	return nil
+}
+
+func (x *UniverseRootMismatch) GetCommittedRoot() *MerkleSumNode {
+	if x != nil {
+		return x.CommittedRoot
+	}
+	return nil
+}
+
+func (x *UniverseRootMismatch) GetComputedRoot() *MerkleSumNode {
+	if x != nil {
+		return x.ComputedRoot
+	}
+	return nil
+}
+
+func (x *UniverseRootMismatch) GetNumLeaves() uint64 {
+	if x != nil {
+		return x.NumLeaves
+	}
+	return 0
+}
+
+func (x *UniverseRootMismatch) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+type VerifyUniverseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of Universe trees that were verified.
+	NumChecked uint64 `protobuf:"varint,1,opt,name=num_checked,json=numChecked,proto3" json:"num_checked,omitempty"`
+	// The number of Universe trees whose roots didn't match.
+	NumMismatched uint64 `protobuf:"varint,2,opt,name=num_mismatched,json=numMismatched,proto3" json:"num_mismatched,omitempty"`
+	// The number of Universe trees whose roots were repaired.
+	NumRepaired uint64 `protobuf:"varint,3,opt,name=num_repaired,json=numRepaired,proto3" json:"num_repaired,omitempty"`
+	// The set of Universe trees whose roots didn't match.
+	Mismatches []*UniverseRootMismatch `protobuf:"bytes,4,rep,name=mismatches,proto3" json:"mismatches,omitempty"`
+}
+
+func (x *VerifyUniverseResponse) Reset() {
+	*x = VerifyUniverseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyUniverseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyUniverseResponse) ProtoMessage() {}
+
+func (x *VerifyUniverseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyUniverseResponse.ProtoReflect.Descriptor instead.
+func (*VerifyUniverseResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *VerifyUniverseResponse) GetNumChecked() uint64 {
+	if x != nil {
+		return x.NumChecked
+	}
+	return 0
+}
+
+func (x *VerifyUniverseResponse) GetNumMismatched() uint64 {
+	if x != nil {
+		return x.NumMismatched
+	}
+	return 0
+}
+
+func (x *VerifyUniverseResponse) GetNumRepaired() uint64 {
+	if x != nil {
+		return x.NumRepaired
+	}
+	return 0
+}
+
+func (x *VerifyUniverseResponse) GetMismatches() []*UniverseRootMismatch {
+	if x != nil {
+		return x.Mismatches
+	}
+	return nil
+}
+
+type PruneUniverseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If true, only assets that had their full issued supply burned are
+	// pruned.
+	ZeroSupply bool `protobuf:"varint,1,opt,name=zero_supply,json=zeroSupply,proto3" json:"zero_supply,omitempty"`
+	// If non-zero, only assets that didn't have any new leaf inserted into
+	// their Universe trees within this many blocks of the current best block
+	// are pruned.
+	OlderThanBlocks uint32 `protobuf:"varint,2,opt,name=older_than_blocks,json=olderThanBlocks,proto3" json:"older_than_blocks,omitempty"`
+	// If true, the assets that would be pruned are returned without
+	// removing them.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *PruneUniverseRequest) Reset() {
+	*x = PruneUniverseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruneUniverseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneUniverseRequest) ProtoMessage() {}
+
+func (x *PruneUniverseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneUniverseRequest.ProtoReflect.Descriptor instead.
+func (*PruneUniverseRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PruneUniverseRequest) GetZeroSupply() bool {
+	if x != nil {
+		return x.ZeroSupply
+	}
+	return false
+}
+
+func (x *PruneUniverseRequest) GetOlderThanBlocks() uint32 {
+	if x != nil {
+		return x.OlderThanBlocks
+	}
+	return 0
+}
+
+func (x *PruneUniverseRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type PrunedAsset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the pruned asset. The proof type is unspecified, as both the
+	// issuance and the transfer Universe of the asset are pruned.
+	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The name of the pruned asset.
+	AssetName string `protobuf:"bytes,2,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	// The total amount of the asset that was issued.
+	IssuedSupply uint64 `protobuf:"varint,3,opt,name=issued_supply,json=issuedSupply,proto3" json:"issued_supply,omitempty"`
+	// The amount of the asset that is known to be burned. Only set if the
+	// zero_supply criterion was used.
+	BurnedSupply uint64 `protobuf:"varint,4,opt,name=burned_supply,json=burnedSupply,proto3" json:"burned_supply,omitempty"`
+	// The block height of the most recent leaf inserted into the Universe
+	// trees of the asset. Only set if the older_than_blocks criterion was
+	// used.
+	LastUpdateHeight uint32 `protobuf:"varint,5,opt,name=last_update_height,json=lastUpdateHeight,proto3" json:"last_update_height,omitempty"`
+}
+
+func (x *PrunedAsset) Reset() {
+	*x = PrunedAsset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrunedAsset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrunedAsset) ProtoMessage() {}
+
+func (x *PrunedAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrunedAsset.ProtoReflect.Descriptor instead.
+func (*PrunedAsset) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PrunedAsset) GetId() *ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *PrunedAsset) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
+	}
+	return ""
+}
+
+func (x *PrunedAsset) GetIssuedSupply() uint64 {
+	if x != nil {
+		return x.IssuedSupply
+	}
+	return 0
+}
+
+func (x *PrunedAsset) GetBurnedSupply() uint64 {
+	if x != nil {
+		return x.BurnedSupply
+	}
+	return 0
+}
+
+func (x *PrunedAsset) GetLastUpdateHeight() uint32 {
+	if x != nil {
+		return x.LastUpdateHeight
+	}
+	return 0
+}
+
+type PruneUniverseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The assets that were pruned, or that would be pruned in case of a dry
+	// run.
+	PrunedAssets []*PrunedAsset `protobuf:"bytes,1,rep,name=pruned_assets,json=prunedAssets,proto3" json:"pruned_assets,omitempty"`
+	// Whether this was a dry run, in which case nothing was removed.
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *PruneUniverseResponse) Reset() {
+	*x = PruneUniverseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruneUniverseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneUniverseResponse) ProtoMessage() {}
+
+func (x *PruneUniverseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneUniverseResponse.ProtoReflect.Descriptor instead.
+func (*PruneUniverseResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PruneUniverseResponse) GetPrunedAssets() []*PrunedAsset {
+	if x != nil {
+		return x.PrunedAssets
+	}
+	return nil
+}
+
+func (x *PruneUniverseResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type UniverseFederationServer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Id   int32  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// An optional PEM encoded TLS certificate of the server. If set, the
+	// certificate is pinned and used as the only trusted CA when connecting
+	// to the server.
+	TlsCert []byte `protobuf:"bytes,3,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
+	// An optional, server specific interval in seconds at which the local
+	// Universe periodically syncs with the server. If zero, then the global
+	// sync interval of the node is used.
+	SyncIntervalSeconds uint64 `protobuf:"varint,4,opt,name=sync_interval_seconds,json=syncIntervalSeconds,proto3" json:"sync_interval_seconds,omitempty"`
+	// The unix timestamp of the last successful sync with the server. This
+	// is only set when listing the federation servers.
+	LastSyncTimestamp int64 `protobuf:"varint,5,opt,name=last_sync_timestamp,json=lastSyncTimestamp,proto3" json:"last_sync_timestamp,omitempty"`
+	// The health of the server as determined by the last health check. This
+	// is only set when listing or checking the federation servers, and only
+	// if the server was already checked.
+	Health *FederationServerHealth `protobuf:"bytes,6,opt,name=health,proto3" json:"health,omitempty"`
+	// The number of proofs that couldn't be pushed to the server yet, and
+	// are queued to be retried. This is only set when listing the federation
+	// servers.
+	NumPendingPushes uint64 `protobuf:"varint,7,opt,name=num_pending_pushes,json=numPendingPushes,proto3" json:"num_pending_pushes,omitempty"`
+	// The host:port of an optional, server specific SOCKS5 proxy, such as a
+	// Tor daemon, that is used to connect to the server. Host names are
+	// resolved by the proxy, which allows connecting to onion services. If
+	// not set, then the global proxy of the node is used, if any.
+	ProxyAddr string `protobuf:"bytes,8,opt,name=proxy_addr,json=proxyAddr,proto3" json:"proxy_addr,omitempty"`
+	// The optional user name used to authenticate with the proxy.
+	ProxyUser string `protobuf:"bytes,9,opt,name=proxy_user,json=proxyUser,proto3" json:"proxy_user,omitempty"`
+	// The optional password used to authenticate with the proxy. This is
+	// never returned when listing the federation servers.
+	ProxyPassword string `protobuf:"bytes,10,opt,name=proxy_password,json=proxyPassword,proto3" json:"proxy_password,omitempty"`
+	// The priority of the server within the federation. Servers with a
+	// higher priority are synced first, and leaves synced from them are
+	// never replaced by diverging leaves synced from servers with a lower or
+	// equal priority. Defaults to zero.
+	Priority int32 `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (x *UniverseFederationServer) Reset() {
+	*x = UniverseFederationServer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UniverseFederationServer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UniverseFederationServer) ProtoMessage() {}
+
+func (x *UniverseFederationServer) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UniverseFederationServer.ProtoReflect.Descriptor instead.
+func (*UniverseFederationServer) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UniverseFederationServer) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *UniverseFederationServer) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UniverseFederationServer) GetTlsCert() []byte {
+	if x != nil {
+		return x.TlsCert
+	}
+	return nil
+}
+
+func (x *UniverseFederationServer) GetSyncIntervalSeconds() uint64 {
+	if x != nil {
+		return x.SyncIntervalSeconds
+	}
+	return 0
+}
+
+func (x *UniverseFederationServer) GetLastSyncTimestamp() int64 {
+	if x != nil {
+		return x.LastSyncTimestamp
+	}
+	return 0
+}
+
+func (x *UniverseFederationServer) GetHealth() *FederationServerHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *UniverseFederationServer) GetNumPendingPushes() uint64 {
+	if x != nil {
+		return x.NumPendingPushes
+	}
+	return 0
+}
+
+func (x *UniverseFederationServer) GetProxyAddr() string {
+	if x != nil {
+		return x.ProxyAddr
+	}
+	return ""
+}
+
+func (x *UniverseFederationServer) GetProxyUser() string {
+	if x != nil {
+		return x.ProxyUser
+	}
+	return ""
+}
+
+func (x *UniverseFederationServer) GetProxyPassword() string {
+	if x != nil {
+		return x.ProxyPassword
+	}
+	return ""
+}
+
+func (x *UniverseFederationServer) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type FederationServerHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unix timestamp of the last health check of the server.
+	LastCheckTimestamp int64 `protobuf:"varint,1,opt,name=last_check_timestamp,json=lastCheckTimestamp,proto3" json:"last_check_timestamp,omitempty"`
+	// The unix timestamp of the last time the server could be reached. This
+	// is zero if the server was never reached.
+	LastContactTimestamp int64 `protobuf:"varint,2,opt,name=last_contact_timestamp,json=lastContactTimestamp,proto3" json:"last_contact_timestamp,omitempty"`
+	// Whether the server could be reached during the last health check.
+	Reachable bool `protobuf:"varint,3,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	// Whether all universe roots the server exports, and the local Universe
+	// accepts for sync insert, match the local universe roots.
+	InSync bool `protobuf:"varint,4,opt,name=in_sync,json=inSync,proto3" json:"in_sync,omitempty"`
+	// Whether the server couldn't be reached for several health check
+	// intervals. Stale servers are only flagged and are not removed from the
+	// federation automatically.
+	Stale bool `protobuf:"varint,5,opt,name=stale,proto3" json:"stale,omitempty"`
+	// The error that prevented the server from being reached during the
+	// last health check, if any.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *FederationServerHealth) Reset() {
+	*x = FederationServerHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FederationServerHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederationServerHealth) ProtoMessage() {}
+
+func (x *FederationServerHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederationServerHealth.ProtoReflect.Descriptor instead.
+func (*FederationServerHealth) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *FederationServerHealth) GetLastCheckTimestamp() int64 {
+	if x != nil {
+		return x.LastCheckTimestamp
+	}
+	return 0
+}
+
+func (x *FederationServerHealth) GetLastContactTimestamp() int64 {
+	if x != nil {
+		return x.LastContactTimestamp
+	}
+	return 0
+}
+
+func (x *FederationServerHealth) GetReachable() bool {
+	if x != nil {
+		return x.Reachable
+	}
+	return false
+}
+
+func (x *FederationServerHealth) GetInSync() bool {
+	if x != nil {
+		return x.InSync
+	}
+	return false
+}
+
+func (x *FederationServerHealth) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *FederationServerHealth) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SubscribeFederationEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribeFederationEventsRequest) Reset() {
+	*x = SubscribeFederationEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeFederationEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeFederationEventsRequest) ProtoMessage() {}
+
+func (x *SubscribeFederationEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeFederationEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeFederationEventsRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{55}
+}
+
+type FederationEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The type of the event.
+	Type FederationEventType `protobuf:"varint,1,opt,name=type,proto3,enum=universerpc.FederationEventType" json:"type,omitempty"`
+	// The unix timestamp in microseconds of the event.
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The server that was added, removed or whose health changed. For health
+	// change events, the new health of the server is set. This is not set for
+	// membership events.
+	Server *UniverseFederationServer `protobuf:"bytes,3,opt,name=server,proto3" json:"server,omitempty"`
+	// The full set of federation servers, including their health if they
+	// were already checked. This is only set for membership events.
+	Members []*UniverseFederationServer `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *FederationEvent) Reset() {
+	*x = FederationEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FederationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederationEvent) ProtoMessage() {}
+
+func (x *FederationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederationEvent.ProtoReflect.Descriptor instead.
+func (*FederationEvent) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *FederationEvent) GetType() FederationEventType {
+	if x != nil {
+		return x.Type
+	}
+	return FederationEventType_FEDERATION_EVENT_MEMBERSHIP
+}
+
+func (x *FederationEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *FederationEvent) GetServer() *UniverseFederationServer {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+func (x *FederationEvent) GetMembers() []*UniverseFederationServer {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type ListFederationServersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListFederationServersRequest) Reset() {
+	*x = ListFederationServersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFederationServersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFederationServersRequest) ProtoMessage() {}
+
+func (x *ListFederationServersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFederationServersRequest.ProtoReflect.Descriptor instead.
+func (*ListFederationServersRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{57}
+}
+
+type ListFederationServersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Servers []*UniverseFederationServer `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+}
+
+func (x *ListFederationServersResponse) Reset() {
+	*x = ListFederationServersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFederationServersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFederationServersResponse) ProtoMessage() {}
+
+func (x *ListFederationServersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFederationServersResponse.ProtoReflect.Descriptor instead.
+func (*ListFederationServersResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListFederationServersResponse) GetServers() []*UniverseFederationServer {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+type AddFederationServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Servers []*UniverseFederationServer `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	// If true, then the full local Universe state is pushed to the added
+	// servers once the initial sync with them has completed.
+	PushOnRegister bool `protobuf:"varint,2,opt,name=push_on_register,json=pushOnRegister,proto3" json:"push_on_register,omitempty"`
+}
+
+func (x *AddFederationServerRequest) Reset() {
+	*x = AddFederationServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddFederationServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFederationServerRequest) ProtoMessage() {}
+
+func (x *AddFederationServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFederationServerRequest.ProtoReflect.Descriptor instead.
+func (*AddFederationServerRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AddFederationServerRequest) GetServers() []*UniverseFederationServer {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+func (x *AddFederationServerRequest) GetPushOnRegister() bool {
+	if x != nil {
+		return x.PushOnRegister
+	}
+	return false
+}
+
+type FailedLeafPush struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The host of the server the leaf couldn't be pushed to.
+	ServerHost string `protobuf:"bytes,1,opt,name=server_host,json=serverHost,proto3" json:"server_host,omitempty"`
+	// The key of the leaf that couldn't be pushed.
+	Key *UniverseKey `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// The error returned when pushing the leaf.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *FailedLeafPush) Reset() {
+	*x = FailedLeafPush{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FailedLeafPush) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailedLeafPush) ProtoMessage() {}
+
+func (x *FailedLeafPush) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailedLeafPush.ProtoReflect.Descriptor instead.
+func (*FailedLeafPush) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *FailedLeafPush) GetServerHost() string {
+	if x != nil {
+		return x.ServerHost
+	}
+	return ""
+}
+
+func (x *FailedLeafPush) GetKey() *UniverseKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *FailedLeafPush) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type AddFederationServerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The set of leaves that couldn't be pushed to the added servers. This is
+	// only set if push_on_register was set in the request.
+	FailedPushes []*FailedLeafPush `protobuf:"bytes,1,rep,name=failed_pushes,json=failedPushes,proto3" json:"failed_pushes,omitempty"`
+}
+
+func (x *AddFederationServerResponse) Reset() {
+	*x = AddFederationServerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddFederationServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFederationServerResponse) ProtoMessage() {}
+
+func (x *AddFederationServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFederationServerResponse.ProtoReflect.Descriptor instead.
+func (*AddFederationServerResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AddFederationServerResponse) GetFailedPushes() []*FailedLeafPush {
+	if x != nil {
+		return x.FailedPushes
+	}
+	return nil
+}
+
+type DeleteFederationServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Servers []*UniverseFederationServer `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+}
+
+func (x *DeleteFederationServerRequest) Reset() {
+	*x = DeleteFederationServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFederationServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFederationServerRequest) ProtoMessage() {}
+
+func (x *DeleteFederationServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFederationServerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFederationServerRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DeleteFederationServerRequest) GetServers() []*UniverseFederationServer {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+type DeleteFederationServerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteFederationServerResponse) Reset() {
+	*x = DeleteFederationServerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFederationServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFederationServerResponse) ProtoMessage() {}
+
+func (x *DeleteFederationServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFederationServerResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFederationServerResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{63}
+}
+
+type SetFederationServerPriorityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The host of the federation server to update. If not set, the server is
+	// identified by its ID instead.
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// The ID of the federation server to update. This is only used if the
+	// host isn't set.
+	Id int32 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The new priority of the server.
+	Priority int32 `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (x *SetFederationServerPriorityRequest) Reset() {
+	*x = SetFederationServerPriorityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFederationServerPriorityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFederationServerPriorityRequest) ProtoMessage() {}
+
+func (x *SetFederationServerPriorityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFederationServerPriorityRequest.ProtoReflect.Descriptor instead.
+func (*SetFederationServerPriorityRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SetFederationServerPriorityRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *SetFederationServerPriorityRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetFederationServerPriorityRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type SetFederationServerPriorityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The updated server.
+	Server *UniverseFederationServer `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+}
+
+func (x *SetFederationServerPriorityResponse) Reset() {
+	*x = SetFederationServerPriorityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFederationServerPriorityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFederationServerPriorityResponse) ProtoMessage() {}
+
+func (x *SetFederationServerPriorityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFederationServerPriorityResponse.ProtoReflect.Descriptor instead.
+func (*SetFederationServerPriorityResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SetFederationServerPriorityResponse) GetServer() *UniverseFederationServer {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+type CheckFederationServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The host of the federation server to check.
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+}
+
+func (x *CheckFederationServerRequest) Reset() {
+	*x = CheckFederationServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckFederationServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckFederationServerRequest) ProtoMessage() {}
+
+func (x *CheckFederationServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckFederationServerRequest.ProtoReflect.Descriptor instead.
+func (*CheckFederationServerRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CheckFederationServerRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type CheckFederationServerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The checked server, including its updated health.
+	Server *UniverseFederationServer `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+}
+
+func (x *CheckFederationServerResponse) Reset() {
+	*x = CheckFederationServerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckFederationServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckFederationServerResponse) ProtoMessage() {}
+
+func (x *CheckFederationServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckFederationServerResponse.ProtoReflect.Descriptor instead.
+func (*CheckFederationServerResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CheckFederationServerResponse) GetServer() *UniverseFederationServer {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+type StatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumTotalAssets int64 `protobuf:"varint,1,opt,name=num_total_assets,json=numTotalAssets,proto3" json:"num_total_assets,omitempty"`
+	NumTotalGroups int64 `protobuf:"varint,2,opt,name=num_total_groups,json=numTotalGroups,proto3" json:"num_total_groups,omitempty"`
+	NumTotalSyncs  int64 `protobuf:"varint,3,opt,name=num_total_syncs,json=numTotalSyncs,proto3" json:"num_total_syncs,omitempty"`
+	NumTotalProofs int64 `protobuf:"varint,4,opt,name=num_total_proofs,json=numTotalProofs,proto3" json:"num_total_proofs,omitempty"`
+	NumTotalLeaves int64 `protobuf:"varint,5,opt,name=num_total_leaves,json=numTotalLeaves,proto3" json:"num_total_leaves,omitempty"`
+	// The total number of leaves that were pushed to members of the
+	// Universe federation.
+	NumTotalPushes int64 `protobuf:"varint,6,opt,name=num_total_pushes,json=numTotalPushes,proto3" json:"num_total_pushes,omitempty"`
+	// The stats of each known asset. This is only set if include_asset_stats
+	// was set in the request.
+	AssetStats []*AssetStatsSnapshot `protobuf:"bytes,7,rep,name=asset_stats,json=assetStats,proto3" json:"asset_stats,omitempty"`
+	// The number of proof queries since the last restart that were served
+	// from the proof cache.
+	NumProofCacheHits uint64 `protobuf:"varint,8,opt,name=num_proof_cache_hits,json=numProofCacheHits,proto3" json:"num_proof_cache_hits,omitempty"`
+	// The number of proof queries since the last restart that weren't
+	// served from the proof cache, because the proofs weren't cached yet or
+	// the cached proofs were stale.
+	NumProofCacheMisses uint64 `protobuf:"varint,9,opt,name=num_proof_cache_misses,json=numProofCacheMisses,proto3" json:"num_proof_cache_misses,omitempty"`
+}
+
+func (x *StatsResponse) Reset() {
+	*x = StatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse) ProtoMessage() {}
+
+func (x *StatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
+func (*StatsResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *StatsResponse) GetNumTotalAssets() int64 {
+	if x != nil {
+		return x.NumTotalAssets
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetNumTotalGroups() int64 {
+	if x != nil {
+		return x.NumTotalGroups
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetNumTotalSyncs() int64 {
+	if x != nil {
+		return x.NumTotalSyncs
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetNumTotalProofs() int64 {
+	if x != nil {
+		return x.NumTotalProofs
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetNumTotalLeaves() int64 {
+	if x != nil {
+		return x.NumTotalLeaves
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetNumTotalPushes() int64 {
+	if x != nil {
+		return x.NumTotalPushes
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetAssetStats() []*AssetStatsSnapshot {
+	if x != nil {
+		return x.AssetStats
+	}
+	return nil
+}
+
+func (x *StatsResponse) GetNumProofCacheHits() uint64 {
+	if x != nil {
+		return x.NumProofCacheHits
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetNumProofCacheMisses() uint64 {
+	if x != nil {
+		return x.NumProofCacheMisses
+	}
+	return 0
+}
+
+type AssetStatsQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssetNameFilter string          `protobuf:"bytes,1,opt,name=asset_name_filter,json=assetNameFilter,proto3" json:"asset_name_filter,omitempty"`
+	AssetIdFilter   []byte          `protobuf:"bytes,2,opt,name=asset_id_filter,json=assetIdFilter,proto3" json:"asset_id_filter,omitempty"`
+	AssetTypeFilter AssetTypeFilter `protobuf:"varint,3,opt,name=asset_type_filter,json=assetTypeFilter,proto3,enum=universerpc.AssetTypeFilter" json:"asset_type_filter,omitempty"`
+	SortBy          AssetQuerySort  `protobuf:"varint,4,opt,name=sort_by,json=sortBy,proto3,enum=universerpc.AssetQuerySort" json:"sort_by,omitempty"`
+	Offset          int32           `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit           int32           `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Direction       SortDirection   `protobuf:"varint,7,opt,name=direction,proto3,enum=universerpc.SortDirection" json:"direction,omitempty"`
+}
+
+func (x *AssetStatsQuery) Reset() {
+	*x = AssetStatsQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetStatsQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetStatsQuery) ProtoMessage() {}
+
+func (x *AssetStatsQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetStatsQuery.ProtoReflect.Descriptor instead.
+func (*AssetStatsQuery) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AssetStatsQuery) GetAssetNameFilter() string {
+	if x != nil {
+		return x.AssetNameFilter
+	}
+	return ""
+}
+
+func (x *AssetStatsQuery) GetAssetIdFilter() []byte {
+	if x != nil {
+		return x.AssetIdFilter
+	}
+	return nil
+}
+
+func (x *AssetStatsQuery) GetAssetTypeFilter() AssetTypeFilter {
+	if x != nil {
+		return x.AssetTypeFilter
+	}
+	return AssetTypeFilter_FILTER_ASSET_NONE
+}
+
+func (x *AssetStatsQuery) GetSortBy() AssetQuerySort {
+	if x != nil {
+		return x.SortBy
+	}
+	return AssetQuerySort_SORT_BY_NONE
+}
+
+func (x *AssetStatsQuery) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *AssetStatsQuery) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *AssetStatsQuery) GetDirection() SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return SortDirection_SORT_DIRECTION_ASC
+}
+
+type QueryAssetSupplyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Group:
+	//
+	//	*QueryAssetSupplyRequest_GroupKey
+	//	*QueryAssetSupplyRequest_GroupKeyStr
+	Group isQueryAssetSupplyRequest_Group `protobuf_oneof:"group"`
+}
+
+func (x *QueryAssetSupplyRequest) Reset() {
+	*x = QueryAssetSupplyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAssetSupplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAssetSupplyRequest) ProtoMessage() {}
+
+func (x *QueryAssetSupplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAssetSupplyRequest.ProtoReflect.Descriptor instead.
+func (*QueryAssetSupplyRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{70}
+}
+
+func (m *QueryAssetSupplyRequest) GetGroup() isQueryAssetSupplyRequest_Group {
+	if m != nil {
+		return m.Group
+	}
+	return nil
+}
+
+func (x *QueryAssetSupplyRequest) GetGroupKey() []byte {
+	if x, ok := x.GetGroup().(*QueryAssetSupplyRequest_GroupKey); ok {
+		return x.GroupKey
+	}
+	return nil
+}
+
+func (x *QueryAssetSupplyRequest) GetGroupKeyStr() string {
+	if x, ok := x.GetGroup().(*QueryAssetSupplyRequest_GroupKeyStr); ok {
+		return x.GroupKeyStr
+	}
+	return ""
+}
+
+type isQueryAssetSupplyRequest_Group interface {
+	isQueryAssetSupplyRequest_Group()
+}
+
+type QueryAssetSupplyRequest_GroupKey struct {
+	// The 32-byte asset group key specified as raw bytes (gRPC only).
+	GroupKey []byte `protobuf:"bytes,1,opt,name=group_key,json=groupKey,proto3,oneof"`
+}
+
+type QueryAssetSupplyRequest_GroupKeyStr struct {
+	// The 32-byte asset group key encoded as hex string (use this for
+	// REST).
+	GroupKeyStr string `protobuf:"bytes,2,opt,name=group_key_str,json=groupKeyStr,proto3,oneof"`
+}
+
+func (*QueryAssetSupplyRequest_GroupKey) isQueryAssetSupplyRequest_Group() {}
+
+func (*QueryAssetSupplyRequest_GroupKeyStr) isQueryAssetSupplyRequest_Group() {}
+
+type QueryAssetSupplyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The total supply committed across all issuances of the asset group,
+	// minus the burned supply.
+	TotalSupply uint64 `protobuf:"varint,1,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	// The number of issuance events of the asset group.
+	NumIssuanceEvents uint64 `protobuf:"varint,2,opt,name=num_issuance_events,json=numIssuanceEvents,proto3" json:"num_issuance_events,omitempty"`
+	// The total amount of all known burns of the asset group, which is the
+	// sum of the group's burn tree.
+	BurnedSupply uint64 `protobuf:"varint,3,opt,name=burned_supply,json=burnedSupply,proto3" json:"burned_supply,omitempty"`
+}
+
+func (x *QueryAssetSupplyResponse) Reset() {
+	*x = QueryAssetSupplyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAssetSupplyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAssetSupplyResponse) ProtoMessage() {}
+
+func (x *QueryAssetSupplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAssetSupplyResponse.ProtoReflect.Descriptor instead.
+func (*QueryAssetSupplyResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *QueryAssetSupplyResponse) GetTotalSupply() uint64 {
+	if x != nil {
+		return x.TotalSupply
+	}
+	return 0
+}
+
+func (x *QueryAssetSupplyResponse) GetNumIssuanceEvents() uint64 {
+	if x != nil {
+		return x.NumIssuanceEvents
+	}
+	return 0
+}
+
+func (x *QueryAssetSupplyResponse) GetBurnedSupply() uint64 {
+	if x != nil {
+		return x.BurnedSupply
+	}
+	return 0
+}
+
+type AssetStatsSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The group key of the asset group. If this is empty, then the asset is
+	// not part of a group.
+	GroupKey []byte `protobuf:"bytes,1,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
+	// The total supply of the asset group. If the asset is not part of an asset
+	// group then this is always zero.
+	GroupSupply int64 `protobuf:"varint,2,opt,name=group_supply,json=groupSupply,proto3" json:"group_supply,omitempty"`
+	// The group anchor that was used to group assets together into an asset
+	// group. This is only set if the asset is part of an asset group.
+	GroupAnchor *AssetStatsAsset `protobuf:"bytes,3,opt,name=group_anchor,json=groupAnchor,proto3" json:"group_anchor,omitempty"`
+	// If the asset is not part of an asset group, then this is the asset the
+	// stats below refer to.
+	Asset *AssetStatsAsset `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	// The total number of syncs either for the asset group or the single asset
+	// if it is not part of a group.
+	TotalSyncs int64 `protobuf:"varint,5,opt,name=total_syncs,json=totalSyncs,proto3" json:"total_syncs,omitempty"`
+	// The total number of proofs either for the asset group or the single asset
+	// if it is not part of a group.
+	TotalProofs int64 `protobuf:"varint,6,opt,name=total_proofs,json=totalProofs,proto3" json:"total_proofs,omitempty"`
+}
+
+func (x *AssetStatsSnapshot) Reset() {
+	*x = AssetStatsSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetStatsSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetStatsSnapshot) ProtoMessage() {}
+
+func (x *AssetStatsSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetStatsSnapshot.ProtoReflect.Descriptor instead.
+func (*AssetStatsSnapshot) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AssetStatsSnapshot) GetGroupKey() []byte {
+	if x != nil {
+		return x.GroupKey
+	}
+	return nil
+}
+
+func (x *AssetStatsSnapshot) GetGroupSupply() int64 {
+	if x != nil {
+		return x.GroupSupply
+	}
+	return 0
+}
+
+func (x *AssetStatsSnapshot) GetGroupAnchor() *AssetStatsAsset {
+	if x != nil {
+		return x.GroupAnchor
+	}
+	return nil
+}
+
+func (x *AssetStatsSnapshot) GetAsset() *AssetStatsAsset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+func (x *AssetStatsSnapshot) GetTotalSyncs() int64 {
+	if x != nil {
+		return x.TotalSyncs
+	}
+	return 0
+}
+
+func (x *AssetStatsSnapshot) GetTotalProofs() int64 {
+	if x != nil {
+		return x.TotalProofs
+	}
+	return 0
+}
+
+type AssetStatsAsset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssetId          []byte           `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	GenesisPoint     string           `protobuf:"bytes,2,opt,name=genesis_point,json=genesisPoint,proto3" json:"genesis_point,omitempty"`
+	TotalSupply      int64            `protobuf:"varint,3,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	AssetName        string           `protobuf:"bytes,4,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	AssetType        taprpc.AssetType `protobuf:"varint,5,opt,name=asset_type,json=assetType,proto3,enum=taprpc.AssetType" json:"asset_type,omitempty"`
+	GenesisHeight    int32            `protobuf:"varint,6,opt,name=genesis_height,json=genesisHeight,proto3" json:"genesis_height,omitempty"`
+	GenesisTimestamp int64            `protobuf:"varint,7,opt,name=genesis_timestamp,json=genesisTimestamp,proto3" json:"genesis_timestamp,omitempty"`
+}
+
+func (x *AssetStatsAsset) Reset() {
+	*x = AssetStatsAsset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetStatsAsset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetStatsAsset) ProtoMessage() {}
+
+func (x *AssetStatsAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetStatsAsset.ProtoReflect.Descriptor instead.
+func (*AssetStatsAsset) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AssetStatsAsset) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *AssetStatsAsset) GetGenesisPoint() string {
+	if x != nil {
+		return x.GenesisPoint
+	}
+	return ""
+}
+
+func (x *AssetStatsAsset) GetTotalSupply() int64 {
+	if x != nil {
+		return x.TotalSupply
+	}
+	return 0
+}
+
+func (x *AssetStatsAsset) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
+	}
+	return ""
+}
+
+func (x *AssetStatsAsset) GetAssetType() taprpc.AssetType {
+	if x != nil {
+		return x.AssetType
+	}
+	return taprpc.AssetType(0)
+}
+
+func (x *AssetStatsAsset) GetGenesisHeight() int32 {
+	if x != nil {
+		return x.GenesisHeight
+	}
+	return 0
+}
+
+func (x *AssetStatsAsset) GetGenesisTimestamp() int64 {
+	if x != nil {
+		return x.GenesisTimestamp
+	}
+	return 0
+}
+
+type UniverseAssetStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssetStats []*AssetStatsSnapshot `protobuf:"bytes,1,rep,name=asset_stats,json=assetStats,proto3" json:"asset_stats,omitempty"`
+}
+
+func (x *UniverseAssetStats) Reset() {
+	*x = UniverseAssetStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UniverseAssetStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UniverseAssetStats) ProtoMessage() {}
+
+func (x *UniverseAssetStats) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UniverseAssetStats.ProtoReflect.Descriptor instead.
+func (*UniverseAssetStats) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UniverseAssetStats) GetAssetStats() []*AssetStatsSnapshot {
+	if x != nil {
+		return x.AssetStats
+	}
+	return nil
+}
+
+type QueryEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTimestamp int64 `protobuf:"varint,1,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	EndTimestamp   int64 `protobuf:"varint,2,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+}
+
+func (x *QueryEventsRequest) Reset() {
+	*x = QueryEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEventsRequest) ProtoMessage() {}
+
+func (x *QueryEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryEventsRequest.ProtoReflect.Descriptor instead.
+func (*QueryEventsRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *QueryEventsRequest) GetStartTimestamp() int64 {
+	if x != nil {
+		return x.StartTimestamp
+	}
+	return 0
+}
+
+func (x *QueryEventsRequest) GetEndTimestamp() int64 {
+	if x != nil {
+		return x.EndTimestamp
+	}
+	return 0
+}
+
+type QueryEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*GroupedUniverseEvents `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *QueryEventsResponse) Reset() {
+	*x = QueryEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEventsResponse) ProtoMessage() {}
+
+func (x *QueryEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryEventsResponse.ProtoReflect.Descriptor instead.
+func (*QueryEventsResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *QueryEventsResponse) GetEvents() []*GroupedUniverseEvents {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type GroupedUniverseEvents struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The date the events occurred on, formatted as YYYY-MM-DD.
+	Date           string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	SyncEvents     uint64 `protobuf:"varint,2,opt,name=sync_events,json=syncEvents,proto3" json:"sync_events,omitempty"`
+	NewProofEvents uint64 `protobuf:"varint,3,opt,name=new_proof_events,json=newProofEvents,proto3" json:"new_proof_events,omitempty"`
+}
+
+func (x *GroupedUniverseEvents) Reset() {
+	*x = GroupedUniverseEvents{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupedUniverseEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupedUniverseEvents) ProtoMessage() {}
+
+func (x *GroupedUniverseEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupedUniverseEvents.ProtoReflect.Descriptor instead.
+func (*GroupedUniverseEvents) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GroupedUniverseEvents) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GroupedUniverseEvents) GetSyncEvents() uint64 {
+	if x != nil {
+		return x.SyncEvents
+	}
+	return 0
+}
+
+func (x *GroupedUniverseEvents) GetNewProofEvents() uint64 {
+	if x != nil {
+		return x.NewProofEvents
+	}
+	return 0
+}
+
+type SetFederationSyncConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GlobalSyncConfigs []*GlobalFederationSyncConfig `protobuf:"bytes,1,rep,name=global_sync_configs,json=globalSyncConfigs,proto3" json:"global_sync_configs,omitempty"`
+	AssetSyncConfigs  []*AssetFederationSyncConfig  `protobuf:"bytes,2,rep,name=asset_sync_configs,json=assetSyncConfigs,proto3" json:"asset_sync_configs,omitempty"`
+}
+
+func (x *SetFederationSyncConfigRequest) Reset() {
+	*x = SetFederationSyncConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFederationSyncConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFederationSyncConfigRequest) ProtoMessage() {}
+
+func (x *SetFederationSyncConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFederationSyncConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetFederationSyncConfigRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SetFederationSyncConfigRequest) GetGlobalSyncConfigs() []*GlobalFederationSyncConfig {
+	if x != nil {
+		return x.GlobalSyncConfigs
+	}
+	return nil
+}
+
+func (x *SetFederationSyncConfigRequest) GetAssetSyncConfigs() []*AssetFederationSyncConfig {
+	if x != nil {
+		return x.AssetSyncConfigs
+	}
+	return nil
+}
+
+type SetFederationSyncConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetFederationSyncConfigResponse) Reset() {
+	*x = SetFederationSyncConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFederationSyncConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFederationSyncConfigResponse) ProtoMessage() {}
+
+func (x *SetFederationSyncConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFederationSyncConfigResponse.ProtoReflect.Descriptor instead.
+func (*SetFederationSyncConfigResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{79}
+}
+
+// GlobalFederationSyncConfig is a global proof type specific configuration
+// for universe federation syncing.
+type GlobalFederationSyncConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proof_type is the universe proof type which this config applies to.
+	ProofType ProofType `protobuf:"varint,1,opt,name=proof_type,json=proofType,proto3,enum=universerpc.ProofType" json:"proof_type,omitempty"`
+	// allow_sync_insert is a boolean that indicates whether leaves from
+	// universes of the given proof type have may be inserted via federation
+	// sync.
+	AllowSyncInsert bool `protobuf:"varint,2,opt,name=allow_sync_insert,json=allowSyncInsert,proto3" json:"allow_sync_insert,omitempty"`
+	// allow_sync_export is a boolean that indicates whether leaves from
+	// universes of the given proof type have may be exported via federation
+	// sync.
+	AllowSyncExport bool `protobuf:"varint,3,opt,name=allow_sync_export,json=allowSyncExport,proto3" json:"allow_sync_export,omitempty"`
+}
+
+func (x *GlobalFederationSyncConfig) Reset() {
+	*x = GlobalFederationSyncConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GlobalFederationSyncConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GlobalFederationSyncConfig) ProtoMessage() {}
+
+func (x *GlobalFederationSyncConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GlobalFederationSyncConfig.ProtoReflect.Descriptor instead.
+func (*GlobalFederationSyncConfig) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GlobalFederationSyncConfig) GetProofType() ProofType {
+	if x != nil {
+		return x.ProofType
+	}
+	return ProofType_PROOF_TYPE_UNSPECIFIED
+}
+
+func (x *GlobalFederationSyncConfig) GetAllowSyncInsert() bool {
+	if x != nil {
+		return x.AllowSyncInsert
+	}
+	return false
+}
+
+func (x *GlobalFederationSyncConfig) GetAllowSyncExport() bool {
+	if x != nil {
+		return x.AllowSyncExport
+	}
+	return false
+}
+
+// AssetFederationSyncConfig is an asset universe specific configuration for
+// federation syncing.
+type AssetFederationSyncConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the ID of the universe to configure.
+	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// allow_sync_insert is a boolean that indicates whether leaves from
+	// universes of the given proof type have may be inserted via federation
+	// sync.
+	AllowSyncInsert bool `protobuf:"varint,2,opt,name=allow_sync_insert,json=allowSyncInsert,proto3" json:"allow_sync_insert,omitempty"`
+	// allow_sync_export is a boolean that indicates whether leaves from
+	// universes of the given proof type have may be exported via federation
+	// sync.
+	AllowSyncExport bool `protobuf:"varint,3,opt,name=allow_sync_export,json=allowSyncExport,proto3" json:"allow_sync_export,omitempty"`
+}
+
+func (x *AssetFederationSyncConfig) Reset() {
+	*x = AssetFederationSyncConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetFederationSyncConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetFederationSyncConfig) ProtoMessage() {}
+
+func (x *AssetFederationSyncConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetFederationSyncConfig.ProtoReflect.Descriptor instead.
+func (*AssetFederationSyncConfig) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *AssetFederationSyncConfig) GetId() *ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *AssetFederationSyncConfig) GetAllowSyncInsert() bool {
+	if x != nil {
+		return x.AllowSyncInsert
+	}
+	return false
+}
+
+func (x *AssetFederationSyncConfig) GetAllowSyncExport() bool {
+	if x != nil {
+		return x.AllowSyncExport
+	}
+	return false
+}
+
+type QueryFederationSyncConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Target universe ID(s).
+	Id []*ID `protobuf:"bytes,1,rep,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *QueryFederationSyncConfigRequest) Reset() {
+	*x = QueryFederationSyncConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryFederationSyncConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFederationSyncConfigRequest) ProtoMessage() {}
+
+func (x *QueryFederationSyncConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryFederationSyncConfigRequest.ProtoReflect.Descriptor instead.
+func (*QueryFederationSyncConfigRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *QueryFederationSyncConfigRequest) GetId() []*ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type QueryFederationSyncConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GlobalSyncConfigs []*GlobalFederationSyncConfig `protobuf:"bytes,1,rep,name=global_sync_configs,json=globalSyncConfigs,proto3" json:"global_sync_configs,omitempty"`
+	AssetSyncConfigs  []*AssetFederationSyncConfig  `protobuf:"bytes,2,rep,name=asset_sync_configs,json=assetSyncConfigs,proto3" json:"asset_sync_configs,omitempty"`
+}
+
+func (x *QueryFederationSyncConfigResponse) Reset() {
+	*x = QueryFederationSyncConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryFederationSyncConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFederationSyncConfigResponse) ProtoMessage() {}
+
+func (x *QueryFederationSyncConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryFederationSyncConfigResponse.ProtoReflect.Descriptor instead.
+func (*QueryFederationSyncConfigResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *QueryFederationSyncConfigResponse) GetGlobalSyncConfigs() []*GlobalFederationSyncConfig {
+	if x != nil {
+		return x.GlobalSyncConfigs
+	}
+	return nil
+}
+
+func (x *QueryFederationSyncConfigResponse) GetAssetSyncConfigs() []*AssetFederationSyncConfig {
+	if x != nil {
+		return x.AssetSyncConfigs
+	}
+	return nil
+}
+
+type UploadMailboxProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The raw proof file to upload.
+	RawProofFile []byte `protobuf:"bytes,1,opt,name=raw_proof_file,json=rawProofFile,proto3" json:"raw_proof_file,omitempty"`
+}
+
+func (x *UploadMailboxProofRequest) Reset() {
+	*x = UploadMailboxProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadMailboxProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadMailboxProofRequest) ProtoMessage() {}
+
+func (x *UploadMailboxProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadMailboxProofRequest.ProtoReflect.Descriptor instead.
+func (*UploadMailboxProofRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *UploadMailboxProofRequest) GetRawProofFile() []byte {
+	if x != nil {
+		return x.RawProofFile
+	}
+	return nil
+}
+
+type MailboxProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The 32-byte x-only script key the proof file was uploaded for.
+	ScriptKey []byte `protobuf:"bytes,1,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The raw proof file.
+	RawProofFile []byte `protobuf:"bytes,2,opt,name=raw_proof_file,json=rawProofFile,proto3" json:"raw_proof_file,omitempty"`
+	// The unix timestamp in seconds of when the proof file was (last)
+	// uploaded.
+	UploadTimestamp int64 `protobuf:"varint,3,opt,name=upload_timestamp,json=uploadTimestamp,proto3" json:"upload_timestamp,omitempty"`
+	// The unix timestamp in seconds after which the proof file expires and
+	// is removed from the mailbox.
+	ExpiryTimestamp int64 `protobuf:"varint,4,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty"`
+}
+
+func (x *MailboxProof) Reset() {
+	*x = MailboxProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailboxProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailboxProof) ProtoMessage() {}
+
+func (x *MailboxProof) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailboxProof.ProtoReflect.Descriptor instead.
+func (*MailboxProof) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *MailboxProof) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *MailboxProof) GetRawProofFile() []byte {
+	if x != nil {
+		return x.RawProofFile
+	}
+	return nil
+}
+
+func (x *MailboxProof) GetUploadTimestamp() int64 {
+	if x != nil {
+		return x.UploadTimestamp
+	}
+	return 0
+}
+
+func (x *MailboxProof) GetExpiryTimestamp() int64 {
+	if x != nil {
+		return x.ExpiryTimestamp
+	}
+	return 0
+}
+
+type UploadMailboxProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The stored proof file.
+	Proof *MailboxProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (x *UploadMailboxProofResponse) Reset() {
+	*x = UploadMailboxProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadMailboxProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadMailboxProofResponse) ProtoMessage() {}
+
+func (x *UploadMailboxProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadMailboxProofResponse.ProtoReflect.Descriptor instead.
+func (*UploadMailboxProofResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *UploadMailboxProofResponse) GetProof() *MailboxProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+type FetchMailboxProofsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Key:
+	//
+	//	*FetchMailboxProofsRequest_ScriptKey
+	//	*FetchMailboxProofsRequest_ScriptKeyStr
+	Key isFetchMailboxProofsRequest_Key `protobuf_oneof:"key"`
+}
+
+func (x *FetchMailboxProofsRequest) Reset() {
+	*x = FetchMailboxProofsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchMailboxProofsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchMailboxProofsRequest) ProtoMessage() {}
+
+func (x *FetchMailboxProofsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchMailboxProofsRequest.ProtoReflect.Descriptor instead.
+func (*FetchMailboxProofsRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{87}
+}
+
+func (m *FetchMailboxProofsRequest) GetKey() isFetchMailboxProofsRequest_Key {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (x *FetchMailboxProofsRequest) GetScriptKey() []byte {
+	if x, ok := x.GetKey().(*FetchMailboxProofsRequest_ScriptKey); ok {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *FetchMailboxProofsRequest) GetScriptKeyStr() string {
+	if x, ok := x.GetKey().(*FetchMailboxProofsRequest_ScriptKeyStr); ok {
+		return x.ScriptKeyStr
+	}
+	return ""
+}
+
+type isFetchMailboxProofsRequest_Key interface {
+	isFetchMailboxProofsRequest_Key()
+}
+
+type FetchMailboxProofsRequest_ScriptKey struct {
+	// The script key to fetch the proof files for, either in the 32-byte
+	// x-only or the 33-byte compressed format.
+	ScriptKey []byte `protobuf:"bytes,1,opt,name=script_key,json=scriptKey,proto3,oneof"`
+}
+
+type FetchMailboxProofsRequest_ScriptKeyStr struct {
+	// The script key to fetch the proof files for, either in the 32-byte
+	// x-only or the 33-byte compressed format, encoded as a hex string.
+	ScriptKeyStr string `protobuf:"bytes,2,opt,name=script_key_str,json=scriptKeyStr,proto3,oneof"`
+}
+
+func (*FetchMailboxProofsRequest_ScriptKey) isFetchMailboxProofsRequest_Key() {}
+
+func (*FetchMailboxProofsRequest_ScriptKeyStr) isFetchMailboxProofsRequest_Key() {}
+
+type FetchMailboxProofsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The proof files uploaded for the script key that didn't expire yet.
+	Proofs []*MailboxProof `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs,omitempty"`
+}
+
+func (x *FetchMailboxProofsResponse) Reset() {
+	*x = FetchMailboxProofsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchMailboxProofsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchMailboxProofsResponse) ProtoMessage() {}
+
+func (x *FetchMailboxProofsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchMailboxProofsResponse.ProtoReflect.Descriptor instead.
+func (*FetchMailboxProofsResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *FetchMailboxProofsResponse) GetProofs() []*MailboxProof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+type AssetPolicyRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Rule:
+	//
+	//	*AssetPolicyRule_AssetId
+	//	*AssetPolicyRule_GroupKey
+	Rule isAssetPolicyRule_Rule `protobuf_oneof:"rule"`
+}
+
+func (x *AssetPolicyRule) Reset() {
+	*x = AssetPolicyRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetPolicyRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetPolicyRule) ProtoMessage() {}
+
+func (x *AssetPolicyRule) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetPolicyRule.ProtoReflect.Descriptor instead.
+func (*AssetPolicyRule) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{89}
+}
+
+func (m *AssetPolicyRule) GetRule() isAssetPolicyRule_Rule {
+	if m != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+func (x *AssetPolicyRule) GetAssetId() []byte {
+	if x, ok := x.GetRule().(*AssetPolicyRule_AssetId); ok {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *AssetPolicyRule) GetGroupKey() []byte {
+	if x, ok := x.GetRule().(*AssetPolicyRule_GroupKey); ok {
+		return x.GroupKey
+	}
+	return nil
+}
+
+type isAssetPolicyRule_Rule interface {
+	isAssetPolicyRule_Rule()
+}
+
+type AssetPolicyRule_AssetId struct {
+	// The 32-byte ID of the asset the rule applies to.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3,oneof"`
+}
+
+type AssetPolicyRule_GroupKey struct {
+	// The 33-byte compressed key of the asset group the rule applies
+	// to. The rule applies to all assets of the group.
+	GroupKey []byte `protobuf:"bytes,2,opt,name=group_key,json=groupKey,proto3,oneof"`
+}
+
+func (*AssetPolicyRule_AssetId) isAssetPolicyRule_Rule() {}
+
+func (*AssetPolicyRule_GroupKey) isAssetPolicyRule_Rule() {}
+
+type AssetPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The set of rules of which one must match an asset for its proofs to be
+	// accepted. If empty, then proofs of all assets that aren't denied are
+	// accepted.
+	AllowList []*AssetPolicyRule `protobuf:"bytes,1,rep,name=allow_list,json=allowList,proto3" json:"allow_list,omitempty"`
+	// The set of rules that, if any of them matches an asset, cause its
+	// proofs to be rejected. The deny list takes precedence over the allow
+	// list.
+	DenyList []*AssetPolicyRule `protobuf:"bytes,2,rep,name=deny_list,json=denyList,proto3" json:"deny_list,omitempty"`
+}
+
+func (x *AssetPolicy) Reset() {
+	*x = AssetPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetPolicy) ProtoMessage() {}
+
+func (x *AssetPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetPolicy.ProtoReflect.Descriptor instead.
+func (*AssetPolicy) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AssetPolicy) GetAllowList() []*AssetPolicyRule {
+	if x != nil {
+		return x.AllowList
+	}
+	return nil
+}
+
+func (x *AssetPolicy) GetDenyList() []*AssetPolicyRule {
+	if x != nil {
+		return x.DenyList
+	}
+	return nil
+}
+
+type SetAssetPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The new asset policy that replaces the current one.
+	Policy *AssetPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *SetAssetPolicyRequest) Reset() {
+	*x = SetAssetPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAssetPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAssetPolicyRequest) ProtoMessage() {}
+
+func (x *SetAssetPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAssetPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetAssetPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SetAssetPolicyRequest) GetPolicy() *AssetPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type SetAssetPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetAssetPolicyResponse) Reset() {
+	*x = SetAssetPolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAssetPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAssetPolicyResponse) ProtoMessage() {}
+
+func (x *SetAssetPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAssetPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetAssetPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{92}
+}
+
+type QueryAssetPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryAssetPolicyRequest) Reset() {
+	*x = QueryAssetPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAssetPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAssetPolicyRequest) ProtoMessage() {}
+
+func (x *QueryAssetPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAssetPolicyRequest.ProtoReflect.Descriptor instead.
+func (*QueryAssetPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{93}
+}
+
+type QueryAssetPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The current asset policy.
+	Policy *AssetPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *QueryAssetPolicyResponse) Reset() {
+	*x = QueryAssetPolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAssetPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAssetPolicyResponse) ProtoMessage() {}
+
+func (x *QueryAssetPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAssetPolicyResponse.ProtoReflect.Descriptor instead.
+func (*QueryAssetPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *QueryAssetPolicyResponse) GetPolicy() *AssetPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type SubscribeUniverseUpdatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// An optional set of asset IDs or group keys to filter the events on. If
+	// the proof type of an ID is unspecified, then events for both the
+	// issuance and transfer universe are sent. If empty, then events for all
+	// universes are sent.
+	Ids []*ID `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// If non-zero, then any recent events with an index greater than this
+	// value are delivered before any new events. This should be set to the
+	// index of the last event received when resuming a subscription. Event
+	// indexes are reset when the daemon restarts.
+	StartIndex uint64 `protobuf:"varint,2,opt,name=start_index,json=startIndex,proto3" json:"start_index,omitempty"`
+}
+
+func (x *SubscribeUniverseUpdatesRequest) Reset() {
+	*x = SubscribeUniverseUpdatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeUniverseUpdatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeUniverseUpdatesRequest) ProtoMessage() {}
+
+func (x *SubscribeUniverseUpdatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeUniverseUpdatesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeUniverseUpdatesRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *SubscribeUniverseUpdatesRequest) GetIds() []*ID {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *SubscribeUniverseUpdatesRequest) GetStartIndex() uint64 {
+	if x != nil {
+		return x.StartIndex
+	}
+	return 0
+}
+
+type UniverseUpdateEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The index of the event, which can be used to resume a subscription.
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// The unix timestamp in microseconds of the event.
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The Universe root after the new leaf was inserted.
+	UniverseRoot *UniverseRoot `protobuf:"bytes,3,opt,name=universe_root,json=universeRoot,proto3" json:"universe_root,omitempty"`
+	// The key the new leaf was inserted at.
+	LeafKey *AssetKey `protobuf:"bytes,4,opt,name=leaf_key,json=leafKey,proto3" json:"leaf_key,omitempty"`
+	// The new leaf itself.
+	AssetLeaf *AssetLeaf `protobuf:"bytes,5,opt,name=asset_leaf,json=assetLeaf,proto3" json:"asset_leaf,omitempty"`
+	// The source of the new leaf.
+	Source UniverseLeafSource `protobuf:"varint,6,opt,name=source,proto3,enum=universerpc.UniverseLeafSource" json:"source,omitempty"`
+	// If true, the leaf was removed from the Universe tree instead of
+	// inserted, because its anchor transaction was re-organized out of the
+	// chain. The Universe root is then the root after the removal.
+	Removed bool `protobuf:"varint,7,opt,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (x *UniverseUpdateEvent) Reset() {
+	*x = UniverseUpdateEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UniverseUpdateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UniverseUpdateEvent) ProtoMessage() {}
+
+func (x *UniverseUpdateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UniverseUpdateEvent.ProtoReflect.Descriptor instead.
+func (*UniverseUpdateEvent) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *UniverseUpdateEvent) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *UniverseUpdateEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *UniverseUpdateEvent) GetUniverseRoot() *UniverseRoot {
+	if x != nil {
+		return x.UniverseRoot
+	}
+	return nil
+}
+
+func (x *UniverseUpdateEvent) GetLeafKey() *AssetKey {
+	if x != nil {
+		return x.LeafKey
+	}
+	return nil
+}
+
+func (x *UniverseUpdateEvent) GetAssetLeaf() *AssetLeaf {
+	if x != nil {
+		return x.AssetLeaf
+	}
+	return nil
+}
+
+func (x *UniverseUpdateEvent) GetSource() UniverseLeafSource {
+	if x != nil {
+		return x.Source
+	}
+	return UniverseLeafSource_LEAF_SOURCE_FEDERATION
+}
+
+func (x *UniverseUpdateEvent) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+type ExportUniverseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// An optional set of asset IDs or group keys to export. If the proof type
+	// of an ID is unspecified, then both the issuance and transfer universe
+	// are exported. If empty, then all known universes are exported.
+	Ids []*ID `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *ExportUniverseRequest) Reset() {
+	*x = ExportUniverseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportUniverseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUniverseRequest) ProtoMessage() {}
+
+func (x *ExportUniverseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUniverseRequest.ProtoReflect.Descriptor instead.
+func (*ExportUniverseRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ExportUniverseRequest) GetIds() []*ID {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type UniverseArchiveChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A chunk of the serialized Universe archive.
+	ChunkData []byte `protobuf:"bytes,1,opt,name=chunk_data,json=chunkData,proto3" json:"chunk_data,omitempty"`
+}
+
+func (x *UniverseArchiveChunk) Reset() {
+	*x = UniverseArchiveChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UniverseArchiveChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UniverseArchiveChunk) ProtoMessage() {}
+
+func (x *UniverseArchiveChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UniverseArchiveChunk.ProtoReflect.Descriptor instead.
+func (*UniverseArchiveChunk) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *UniverseArchiveChunk) GetChunkData() []byte {
+	if x != nil {
+		return x.ChunkData
+	}
+	return nil
+}
+
+type ImportUniverseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of universes contained in the archive.
+	NumUniverses uint32 `protobuf:"varint,1,opt,name=num_universes,json=numUniverses,proto3" json:"num_universes,omitempty"`
+	// The number of leaves that were newly inserted.
+	NumImportedLeaves uint64 `protobuf:"varint,2,opt,name=num_imported_leaves,json=numImportedLeaves,proto3" json:"num_imported_leaves,omitempty"`
+	// The number of leaves that were already known locally and therefore
+	// skipped.
+	NumExistingLeaves uint64 `protobuf:"varint,3,opt,name=num_existing_leaves,json=numExistingLeaves,proto3" json:"num_existing_leaves,omitempty"`
+}
+
+func (x *ImportUniverseResponse) Reset() {
+	*x = ImportUniverseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportUniverseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUniverseResponse) ProtoMessage() {}
+
+func (x *ImportUniverseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUniverseResponse.ProtoReflect.Descriptor instead.
+func (*ImportUniverseResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ImportUniverseResponse) GetNumUniverses() uint32 {
+	if x != nil {
+		return x.NumUniverses
+	}
+	return 0
+}
+
+func (x *ImportUniverseResponse) GetNumImportedLeaves() uint64 {
+	if x != nil {
+		return x.NumImportedLeaves
+	}
+	return 0
+}
+
+func (x *ImportUniverseResponse) GetNumExistingLeaves() uint64 {
+	if x != nil {
+		return x.NumExistingLeaves
+	}
+	return 0
+}
+
+type SetAcceptPushFromRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hosts, either as IP address or host name, that pushed proofs are
+	// accepted from. A port, if specified, is ignored. If empty, then pushes
+	// from all hosts are accepted.
+	Hosts []string `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+}
+
+func (x *SetAcceptPushFromRequest) Reset() {
+	*x = SetAcceptPushFromRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAcceptPushFromRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAcceptPushFromRequest) ProtoMessage() {}
+
+func (x *SetAcceptPushFromRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAcceptPushFromRequest.ProtoReflect.Descriptor instead.
+func (*SetAcceptPushFromRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *SetAcceptPushFromRequest) GetHosts() []string {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+type SetAcceptPushFromResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetAcceptPushFromResponse) Reset() {
+	*x = SetAcceptPushFromResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAcceptPushFromResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAcceptPushFromResponse) ProtoMessage() {}
+
+func (x *SetAcceptPushFromResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAcceptPushFromResponse.ProtoReflect.Descriptor instead.
+func (*SetAcceptPushFromResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{101}
+}
+
+type QueryAcceptPushFromRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryAcceptPushFromRequest) Reset() {
+	*x = QueryAcceptPushFromRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAcceptPushFromRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAcceptPushFromRequest) ProtoMessage() {}
+
+func (x *QueryAcceptPushFromRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAcceptPushFromRequest.ProtoReflect.Descriptor instead.
+func (*QueryAcceptPushFromRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{102}
+}
+
+type QueryAcceptPushFromResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hosts that pushed proofs are accepted from. If empty, then pushes
+	// from all hosts are accepted.
+	Hosts []string `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+}
+
+func (x *QueryAcceptPushFromResponse) Reset() {
+	*x = QueryAcceptPushFromResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAcceptPushFromResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAcceptPushFromResponse) ProtoMessage() {}
+
+func (x *QueryAcceptPushFromResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAcceptPushFromResponse.ProtoReflect.Descriptor instead.
+func (*QueryAcceptPushFromResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *QueryAcceptPushFromResponse) GetHosts() []string {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+type CompactUniverseDBRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CompactUniverseDBRequest) Reset() {
+	*x = CompactUniverseDBRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompactUniverseDBRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactUniverseDBRequest) ProtoMessage() {}
+
+func (x *CompactUniverseDBRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactUniverseDBRequest.ProtoReflect.Descriptor instead.
+func (*CompactUniverseDBRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{104}
+}
+
+type CompactUniverseDBResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The size of the database in bytes before the compaction.
+	SizeBeforeBytes int64 `protobuf:"varint,1,opt,name=size_before_bytes,json=sizeBeforeBytes,proto3" json:"size_before_bytes,omitempty"`
+	// The size of the database in bytes after the compaction.
+	SizeAfterBytes int64 `protobuf:"varint,2,opt,name=size_after_bytes,json=sizeAfterBytes,proto3" json:"size_after_bytes,omitempty"`
+	// The time the compaction took, in milliseconds.
+	DurationMs int64 `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+}
+
+func (x *CompactUniverseDBResponse) Reset() {
+	*x = CompactUniverseDBResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompactUniverseDBResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactUniverseDBResponse) ProtoMessage() {}
+
+func (x *CompactUniverseDBResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactUniverseDBResponse.ProtoReflect.Descriptor instead.
+func (*CompactUniverseDBResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *CompactUniverseDBResponse) GetSizeBeforeBytes() int64 {
+	if x != nil {
+		return x.SizeBeforeBytes
+	}
+	return 0
+}
+
+func (x *CompactUniverseDBResponse) GetSizeAfterBytes() int64 {
+	if x != nil {
+		return x.SizeAfterBytes
+	}
+	return 0
+}
+
+func (x *CompactUniverseDBResponse) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type ListFederationRootsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum number of federation servers that are queried at the same
+	// time. Defaults to 8 if not set.
+	MaxConcurrency uint32 `protobuf:"varint,1,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	// The time in seconds a single federation server has to return its roots.
+	// Defaults to 30 seconds if not set.
+	ServerTimeoutSeconds uint32 `protobuf:"varint,2,opt,name=server_timeout_seconds,json=serverTimeoutSeconds,proto3" json:"server_timeout_seconds,omitempty"`
+}
+
+func (x *ListFederationRootsRequest) Reset() {
+	*x = ListFederationRootsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFederationRootsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFederationRootsRequest) ProtoMessage() {}
+
+func (x *ListFederationRootsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFederationRootsRequest.ProtoReflect.Descriptor instead.
+func (*ListFederationRootsRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListFederationRootsRequest) GetMaxConcurrency() uint32 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+func (x *ListFederationRootsRequest) GetServerTimeoutSeconds() uint32 {
+	if x != nil {
+		return x.ServerTimeoutSeconds
+	}
+	return 0
+}
+
+type FederationServerRoots struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The universe roots the federation server exports.
+	Roots []*UniverseRoot `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	// The error that prevented the roots of the federation server from being
+	// fetched, if any.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *FederationServerRoots) Reset() {
+	*x = FederationServerRoots{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FederationServerRoots) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederationServerRoots) ProtoMessage() {}
+
+func (x *FederationServerRoots) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederationServerRoots.ProtoReflect.Descriptor instead.
+func (*FederationServerRoots) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *FederationServerRoots) GetRoots() []*UniverseRoot {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+func (x *FederationServerRoots) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListFederationRootsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The roots of each federation server, keyed by the host of the server.
+	ServerRoots map[string]*FederationServerRoots `protobuf:"bytes,1,rep,name=server_roots,json=serverRoots,proto3" json:"server_roots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ListFederationRootsResponse) Reset() {
+	*x = ListFederationRootsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFederationRootsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFederationRootsResponse) ProtoMessage() {}
+
+func (x *ListFederationRootsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFederationRootsResponse.ProtoReflect.Descriptor instead.
+func (*ListFederationRootsResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListFederationRootsResponse) GetServerRoots() map[string]*FederationServerRoots {
+	if x != nil {
+		return x.ServerRoots
+	}
+	return nil
+}
+
+type QueryAssetUtxosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The asset ID or group key of the Universe to query the unspent outputs
+	// of. The proof type of the ID is ignored, as both the issuance and the
+	// transfer Universe are walked.
+	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *QueryAssetUtxosRequest) Reset() {
+	*x = QueryAssetUtxosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAssetUtxosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAssetUtxosRequest) ProtoMessage() {}
+
+func (x *QueryAssetUtxosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAssetUtxosRequest.ProtoReflect.Descriptor instead.
+func (*QueryAssetUtxosRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *QueryAssetUtxosRequest) GetId() *ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type AssetUtxo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The 32-byte ID of the asset held by the output.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The outpoint of the on-chain output the asset is anchored in, in the
+	// form txid:index.
+	Outpoint string `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// The script key that owns the asset.
+	ScriptKey []byte `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The number of asset units held by the output.
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *AssetUtxo) Reset() {
+	*x = AssetUtxo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetUtxo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetUtxo) ProtoMessage() {}
+
+func (x *AssetUtxo) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetUtxo.ProtoReflect.Descriptor instead.
+func (*AssetUtxo) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *AssetUtxo) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *AssetUtxo) GetOutpoint() string {
+	if x != nil {
+		return x.Outpoint
+	}
+	return ""
+}
+
+func (x *AssetUtxo) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *AssetUtxo) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type QueryAssetUtxosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unspent outputs of the asset, sorted by outpoint and script key.
+	Utxos []*AssetUtxo `protobuf:"bytes,1,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	// The total number of asset units held by the unspent outputs.
+	TotalAmount uint64 `protobuf:"varint,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+}
+
+func (x *QueryAssetUtxosResponse) Reset() {
+	*x = QueryAssetUtxosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAssetUtxosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAssetUtxosResponse) ProtoMessage() {}
+
+func (x *QueryAssetUtxosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAssetUtxosResponse.ProtoReflect.Descriptor instead.
+func (*QueryAssetUtxosResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *QueryAssetUtxosResponse) GetUtxos() []*AssetUtxo {
+	if x != nil {
+		return x.Utxos
+	}
+	return nil
+}
+
+func (x *QueryAssetUtxosResponse) GetTotalAmount() uint64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+type PruneSpentLeavesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The minimum number of confirmations the transfer that spends a leaf
+	// must have for the leaf to be pruned. If not set, then the retention
+	// policy configured for the node is used.
+	MinConfirmations uint32 `protobuf:"varint,1,opt,name=min_confirmations,json=minConfirmations,proto3" json:"min_confirmations,omitempty"`
+	// If true, the leaves that would be pruned are only returned, without
+	// pruning them.
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *PruneSpentLeavesRequest) Reset() {
+	*x = PruneSpentLeavesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruneSpentLeavesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneSpentLeavesRequest) ProtoMessage() {}
+
+func (x *PruneSpentLeavesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneSpentLeavesRequest.ProtoReflect.Descriptor instead.
+func (*PruneSpentLeavesRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *PruneSpentLeavesRequest) GetMinConfirmations() uint32 {
+	if x != nil {
+		return x.MinConfirmations
+	}
+	return 0
+}
+
+func (x *PruneSpentLeavesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type PrunedLeaf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the transfer Universe the leaf belongs to.
+	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The key of the leaf within the Universe.
+	LeafKey *AssetKey `protobuf:"bytes,2,opt,name=leaf_key,json=leafKey,proto3" json:"leaf_key,omitempty"`
+	// The amount of the asset held by the output of the leaf. The amount
+	// remains committed to by the Universe root.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The block height at which the transfer that spends the output of the
+	// leaf was confirmed.
+	SpentHeight uint32 `protobuf:"varint,4,opt,name=spent_height,json=spentHeight,proto3" json:"spent_height,omitempty"`
+}
+
+func (x *PrunedLeaf) Reset() {
+	*x = PrunedLeaf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrunedLeaf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrunedLeaf) ProtoMessage() {}
+
+func (x *PrunedLeaf) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrunedLeaf.ProtoReflect.Descriptor instead.
+func (*PrunedLeaf) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *PrunedLeaf) GetId() *ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *PrunedLeaf) GetLeafKey() *AssetKey {
+	if x != nil {
+		return x.LeafKey
+	}
+	return nil
+}
+
+func (x *PrunedLeaf) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PrunedLeaf) GetSpentHeight() uint32 {
+	if x != nil {
+		return x.SpentHeight
+	}
+	return 0
+}
+
+type PruneSpentLeavesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The leaves that were pruned, or that would be pruned in case of a dry
+	// run.
+	PrunedLeaves []*PrunedLeaf `protobuf:"bytes,1,rep,name=pruned_leaves,json=prunedLeaves,proto3" json:"pruned_leaves,omitempty"`
+	// Whether this was a dry run, in which case nothing was pruned.
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *PruneSpentLeavesResponse) Reset() {
+	*x = PruneSpentLeavesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruneSpentLeavesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneSpentLeavesResponse) ProtoMessage() {}
+
+func (x *PruneSpentLeavesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneSpentLeavesResponse.ProtoReflect.Descriptor instead.
+func (*PruneSpentLeavesResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *PruneSpentLeavesResponse) GetPrunedLeaves() []*PrunedLeaf {
+	if x != nil {
+		return x.PrunedLeaves
+	}
+	return nil
+}
+
+func (x *PruneSpentLeavesResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ListPrunedLeavesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the Universe to list the pruned leaves of.
+	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListPrunedLeavesRequest) Reset() {
+	*x = ListPrunedLeavesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPrunedLeavesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPrunedLeavesRequest) ProtoMessage() {}
+
+func (x *ListPrunedLeavesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPrunedLeavesRequest.ProtoReflect.Descriptor instead.
+func (*ListPrunedLeavesRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ListPrunedLeavesRequest) GetId() *ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type ListPrunedLeavesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The keys of the leaves that were pruned.
+	LeafKeys []*AssetKey `protobuf:"bytes,1,rep,name=leaf_keys,json=leafKeys,proto3" json:"leaf_keys,omitempty"`
+}
+
+func (x *ListPrunedLeavesResponse) Reset() {
+	*x = ListPrunedLeavesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPrunedLeavesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPrunedLeavesResponse) ProtoMessage() {}
+
+func (x *ListPrunedLeavesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPrunedLeavesResponse.ProtoReflect.Descriptor instead.
+func (*ListPrunedLeavesResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ListPrunedLeavesResponse) GetLeafKeys() []*AssetKey {
+	if x != nil {
+		return x.LeafKeys
+	}
+	return nil
+}
+
+type QueryAssetRootsBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The IDs of the assets to query the Universe roots for. The proof type
+	// of the IDs is ignored, as both the issuance and the transfer roots are
+	// returned for each asset.
+	Ids []*ID `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// If non-zero, then the roots are returned as they were after the most
+	// recent leaf at or before this block height was inserted. Root history is
+	// only available for leaves inserted after the history was introduced.
+	AtHeight uint32 `protobuf:"varint,2,opt,name=at_height,json=atHeight,proto3" json:"at_height,omitempty"`
+}
+
+func (x *QueryAssetRootsBatchRequest) Reset() {
+	*x = QueryAssetRootsBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAssetRootsBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAssetRootsBatchRequest) ProtoMessage() {}
+
+func (x *QueryAssetRootsBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAssetRootsBatchRequest.ProtoReflect.Descriptor instead.
+func (*QueryAssetRootsBatchRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *QueryAssetRootsBatchRequest) GetIds() []*ID {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *QueryAssetRootsBatchRequest) GetAtHeight() uint32 {
+	if x != nil {
+		return x.AtHeight
+	}
+	return 0
+}
+
+type AssetRootsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID the roots were queried for, as given in the request.
+	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Whether any root was found for the given ID. If false, then neither an
+	// issuance nor a transfer root is known for the asset, or proof export is
+	// disabled for its Universe.
+	Found bool `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	// The issuance universe root for the given asset ID or group key.
+	IssuanceRoot *UniverseRoot `protobuf:"bytes,3,opt,name=issuance_root,json=issuanceRoot,proto3" json:"issuance_root,omitempty"`
+	// The transfer universe root for the given asset ID or group key.
+	TransferRoot *UniverseRoot `protobuf:"bytes,4,opt,name=transfer_root,json=transferRoot,proto3" json:"transfer_root,omitempty"`
+}
+
+func (x *AssetRootsResult) Reset() {
+	*x = AssetRootsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetRootsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetRootsResult) ProtoMessage() {}
+
+func (x *AssetRootsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetRootsResult.ProtoReflect.Descriptor instead.
+func (*AssetRootsResult) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *AssetRootsResult) GetId() *ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *AssetRootsResult) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *AssetRootsResult) GetIssuanceRoot() *UniverseRoot {
+	if x != nil {
+		return x.IssuanceRoot
+	}
+	return nil
+}
+
+func (x *AssetRootsResult) GetTransferRoot() *UniverseRoot {
+	if x != nil {
+		return x.TransferRoot
+	}
+	return nil
+}
+
+type QueryAssetRootsBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The roots of each of the requested assets, in the same order as the IDs
+	// of the request.
+	Results []*AssetRootsResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *QueryAssetRootsBatchResponse) Reset() {
+	*x = QueryAssetRootsBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAssetRootsBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAssetRootsBatchResponse) ProtoMessage() {}
+
+func (x *QueryAssetRootsBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAssetRootsBatchResponse.ProtoReflect.Descriptor instead.
+func (*QueryAssetRootsBatchResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *QueryAssetRootsBatchResponse) GetResults() []*AssetRootsResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ComputeUniverseRootRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The leaves of the Universe tree to compute the root of. Each leaf must
+	// specify its leaf key and its proof, while the asset of the leaf is
+	// ignored. All leaves must have distinct leaf keys.
+	Leaves []*AssetLeaf `protobuf:"bytes,1,rep,name=leaves,proto3" json:"leaves,omitempty"`
+	// The optional root the computed root is compared to, for example the
+	// root claimed by a Universe server for the given leaves.
+	ExpectedRoot *MerkleSumNode `protobuf:"bytes,2,opt,name=expected_root,json=expectedRoot,proto3" json:"expected_root,omitempty"`
+}
+
+func (x *ComputeUniverseRootRequest) Reset() {
+	*x = ComputeUniverseRootRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComputeUniverseRootRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComputeUniverseRootRequest) ProtoMessage() {}
+
+func (x *ComputeUniverseRootRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComputeUniverseRootRequest.ProtoReflect.Descriptor instead.
+func (*ComputeUniverseRootRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ComputeUniverseRootRequest) GetLeaves() []*AssetLeaf {
+	if x != nil {
+		return x.Leaves
+	}
+	return nil
+}
+
+func (x *ComputeUniverseRootRequest) GetExpectedRoot() *MerkleSumNode {
+	if x != nil {
+		return x.ExpectedRoot
+	}
+	return nil
+}
+
+type ComputeUniverseRootResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The root of the Universe tree that holds exactly the given leaves.
+	Root *MerkleSumNode `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// Whether the computed root matches the expected root. Always false if no
+	// expected root was given.
+	MatchesExpected bool `protobuf:"varint,2,opt,name=matches_expected,json=matchesExpected,proto3" json:"matches_expected,omitempty"`
+}
+
+func (x *ComputeUniverseRootResponse) Reset() {
+	*x = ComputeUniverseRootResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComputeUniverseRootResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComputeUniverseRootResponse) ProtoMessage() {}
+
+func (x *ComputeUniverseRootResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComputeUniverseRootResponse.ProtoReflect.Descriptor instead.
+func (*ComputeUniverseRootResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ComputeUniverseRootResponse) GetRoot() *MerkleSumNode {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *ComputeUniverseRootResponse) GetMatchesExpected() bool {
+	if x != nil {
+		return x.MatchesExpected
+	}
+	return false
+}
+
+type UniverseSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the Universe to create the snapshot of.
+	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The block height to create the snapshot at. If not set, the snapshot is
+	// created at the current best height.
+	BlockHeight uint32 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (x *UniverseSnapshotRequest) Reset() {
+	*x = UniverseSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UniverseSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UniverseSnapshotRequest) ProtoMessage() {}
+
+func (x *UniverseSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UniverseSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*UniverseSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *UniverseSnapshotRequest) GetId() *ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UniverseSnapshotRequest) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+type UniverseSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the Universe.
+	Id *ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The root of the Universe tree that holds exactly the leaves that were
+	// confirmed at or before the snapshot height. The sum of the root is the
+	// total amount committed to by the leaves.
+	Root *MerkleSumNode `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	// The number of leaves committed to by the root.
+	NumLeaves uint64 `protobuf:"varint,3,opt,name=num_leaves,json=numLeaves,proto3" json:"num_leaves,omitempty"`
+	// The height of the block the snapshot was created at.
+	BlockHeight uint32 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The hash of the block at the snapshot height.
+	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+}
+
+func (x *UniverseSnapshot) Reset() {
+	*x = UniverseSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UniverseSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UniverseSnapshot) ProtoMessage() {}
+
+func (x *UniverseSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UniverseSnapshot.ProtoReflect.Descriptor instead.
+func (*UniverseSnapshot) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *UniverseSnapshot) GetId() *ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UniverseSnapshot) GetRoot() *MerkleSumNode {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *UniverseSnapshot) GetNumLeaves() uint64 {
+	if x != nil {
+		return x.NumLeaves
+	}
+	return 0
+}
+
+func (x *UniverseSnapshot) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *UniverseSnapshot) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+type UniverseSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The snapshot of the Universe.
+	Snapshot *UniverseSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// The DER encoded ECDSA signature of the server's node identity key over the
+	// SHA256 digest of the message
+	// "taproot-assets/universe-snapshot" || proof_type || id || block_height ||
+	// block_hash || num_leaves || root_hash || root_sum, where the proof type is
+	// a single byte (1 for issuance, 2 for transfer), the id is the asset ID or
+	// the SHA256 hash of the 32-byte x-only group key, the block height is a
+	// 4-byte and the number of leaves and the root sum are 8-byte big endian
+	// integers, and the block hash is in internal byte order.
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// The 33-byte compressed node identity key the snapshot is signed with.
+	SignerPubkey []byte `protobuf:"bytes,3,opt,name=signer_pubkey,json=signerPubkey,proto3" json:"signer_pubkey,omitempty"`
+}
+
+func (x *UniverseSnapshotResponse) Reset() {
+	*x = UniverseSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_universerpc_universe_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UniverseSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UniverseSnapshotResponse) ProtoMessage() {}
+
+func (x *UniverseSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_universerpc_universe_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UniverseSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*UniverseSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_universerpc_universe_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *UniverseSnapshotResponse) GetSnapshot() *UniverseSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *UniverseSnapshotResponse) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *UniverseSnapshotResponse) GetSignerPubkey() []byte {
+	if x != nil {
+		return x.SignerPubkey
+	}
+	return nil
+}
+
+var File_universerpc_universe_proto protoreflect.FileDescriptor
+
+var file_universerpc_universe_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2f, 0x75, 0x6e,
+	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x75, 0x6e,
+	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x1a, 0x13, 0x74, 0x61, 0x70, 0x72, 0x6f,
+	0x6f, 0x74, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa7,
+	0x01, 0x0a, 0x10, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x48, 0x0a, 0x11, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x75,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0f, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x69,
+	0x64, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x69, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x57, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x68, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x72, 0x6f, 0x6f,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52,
+	0x6f, 0x6f, 0x74, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3b, 0x0a, 0x18, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
+	0x63, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x55, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74,
+	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22,
+	0x47, 0x0a, 0x0d, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x53, 0x75, 0x6d, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x19, 0x0a,
+	0x08, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x73, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x75, 0x6d, 0x22, 0xc7, 0x01, 0x0a, 0x02, 0x49, 0x44, 0x12,
+	0x1b, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x00, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x53, 0x74, 0x72,
+	0x12, 0x1d, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12,
+	0x24, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b,
+	0x65, 0x79, 0x53, 0x74, 0x72, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x75, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x54, 0x79, 0x70, 0x65, 0x42, 0x04, 0x0a, 0x02,
+	0x69, 0x64, 0x22, 0xdb, 0x03, 0x0a, 0x0c, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52,
+	0x6f, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x6d, 0x73, 0x73, 0x6d, 0x74, 0x5f, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x53, 0x75, 0x6d,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x6d, 0x73, 0x73, 0x6d, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x5e,
+	0x0a, 0x13, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x75, 0x6e,
+	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6c, 0x61, 0x7a, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x61,
+	0x7a, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x53, 0x75, 0x6d, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x08, 0x62, 0x75, 0x72, 0x6e, 0x52, 0x6f, 0x6f, 0x74, 0x1a, 0x43, 0x0a, 0x15, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xb1, 0x02, 0x0a, 0x11, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31,
+	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0d, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x1a, 0x5b, 0x0a, 0x12, 0x55, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x4e, 0x0a, 0x0e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f,
+	0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63,
+	0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x74, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x74, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x6f,
+	0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x69, 0x73,
+	0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e,
+	0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x0c, 0x69, 0x73,
+	0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e,
+	0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x0c, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x4e, 0x0a, 0x15, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x54, 0x79, 0x70, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x16, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65, 0x72,
+	0x6b, 0x6c, 0x65, 0x53, 0x75, 0x6d, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0e, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0c, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0x32, 0x0a,
+	0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e,
+	0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x22, 0x5c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e,
+	0x0a, 0x0d, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74,
+	0x52, 0x0c, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x3b,
+	0x0a, 0x08, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61,
+	0x73, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x61,
+	0x73, 0x68, 0x53, 0x74, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xba, 0x01, 0x0a, 0x08,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x06, 0x6f, 0x70, 0x5f, 0x73,
+	0x74, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x6f, 0x70, 0x53, 0x74,
+	0x72, 0x12, 0x27, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x0e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65,
+	0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x0c, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x42, 0x0a,
+	0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x4c, 0x0a, 0x14, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x4c, 0x65, 0x61, 0x66, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x34, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x59, 0x0a, 0x19, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c,
+	0x65, 0x61, 0x66, 0x4b, 0x65, 0x79, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x22, 0x75, 0x0a, 0x1a, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x4b, 0x65,
+	0x79, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x34, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x12, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x6e,
+	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c,
+	0x65, 0x61, 0x66, 0x12, 0x23, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x73, 0x73, 0x75,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12,
+	0x30, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x66, 0x4b, 0x65,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x11, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x22, 0x60, 0x0a, 0x0b, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4b, 0x65, 0x79,
+	0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x66,
+	0x4b, 0x65, 0x79, 0x22, 0xf4, 0x02, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x03, 0x72, 0x65,
+	0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4b, 0x65,
+	0x79, 0x52, 0x03, 0x72, 0x65, 0x71, 0x12, 0x3e, 0x0a, 0x0d, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x0c, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x12, 0x35, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x65, 0x61, 0x66, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x09, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x12, 0x43, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4d,
+	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x53, 0x75, 0x6d, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0e, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3c, 0x0a, 0x1a,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x18, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x49, 0x6e, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x94, 0x02, 0x0a, 0x16, 0x55,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x2a, 0x0a, 0x03, 0x72, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63,
+	0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x72, 0x65,
+	0x71, 0x12, 0x3f, 0x0a, 0x0d, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x53, 0x75, 0x6d,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f,
+	0x6f, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x66, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x66, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x6c, 0x65, 0x61, 0x66, 0x5f, 0x73, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x6c, 0x65, 0x61, 0x66, 0x53, 0x75, 0x6d, 0x12, 0x38, 0x0a, 0x18, 0x75, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16, 0x75, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x22, 0x6f, 0x0a, 0x0a, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12,
+	0x2a, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x75,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x0a, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x65, 0x61, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x65,
+	0x61, 0x66, 0x22, 0x46, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x75, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x22, 0x6f, 0x0a, 0x11, 0x49, 0x6e,
+	0x73, 0x65, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x2a, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x75,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x92, 0x01, 0x0a, 0x14,
+	0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x22, 0x0d, 0x0a, 0x0b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x4c, 0x0a, 0x0c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x22, 0x2d, 0x0a,
+	0x0a, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0xd2, 0x02, 0x0a,
+	0x0b, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x48, 0x6f, 0x73,
+	0x74, 0x12, 0x3a, 0x0a, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a,
+	0x0c, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x0b, 0x73, 0x79,
+	0x6e, 0x63, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79,
+	0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x73, 0x12, 0x32, 0x0a, 0x15,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x79, 0x6e,
+	0x63, 0x22, 0xd2, 0x03, 0x0a, 0x0e, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x6f, 0x6c, 0x64, 0x5f, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x0c, 0x6f, 0x6c, 0x64, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x40, 0x0a, 0x10, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x0e, 0x6e, 0x65, 0x77, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x75, 0x6d, 0x5f,
+	0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6e, 0x75, 0x6d, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e,
+	0x63, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x75, 0x6d, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6e, 0x75, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x6e, 0x63,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x79,
+	0x6e, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x74, 0x61,
+	0x5f, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x28,
+	0x0a, 0x10, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6f, 0x5f, 0x64, 0x65,
+	0x65, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x54, 0x6f, 0x6f, 0x44, 0x65, 0x65, 0x70, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x75, 0x6d, 0x5f,
+	0x73, 0x68, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6e, 0x75, 0x6d, 0x53, 0x68, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x22, 0x3e, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x0c, 0x53, 0x79, 0x6e, 0x63, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x10, 0x73, 0x79, 0x6e, 0x63, 0x65,
+	0x64, 0x5f, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x0f,
+	0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x12,
+	0x31, 0x0a, 0x14, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x75, 0x6e,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x55, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x4c, 0x0a, 0x0e, 0x53,
+	0x79, 0x6e, 0x63, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a,
+	0x09, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x08, 0x73, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0xce, 0x01, 0x0a, 0x10, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x48,
+	0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x46, 0x0a, 0x10, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x75, 0x6e,
+	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63,
+	0x65, 0x64, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x0f, 0x73, 0x79, 0x6e, 0x63,
+	0x65, 0x64, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e,
+	0x75, 0x6d, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x6e, 0x75, 0x6d, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x22, 0x7a, 0x0a, 0x0f, 0x53, 0x79,
+	0x6e, 0x63, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x22, 0xc1, 0x01, 0x0a, 0x0c, 0x53, 0x79, 0x6e, 0x63, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x75,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x6e, 0x75, 0x6d, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12,
+	0x6e, 0x75, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x5f, 0x64, 0x6f,
+	0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6e, 0x75, 0x6d, 0x55, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x75,
+	0x6d, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6e, 0x75, 0x6d, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x73, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x0a, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44, 0x52,
+	0x09, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x8c, 0x01, 0x0a, 0x11, 0x53,
+	0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x37, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x3a, 0x0a, 0x13, 0x44, 0x69, 0x66,
+	0x66, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x68, 0x6f, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x48, 0x6f, 0x73, 0x74, 0x22, 0x86, 0x02, 0x0a, 0x10, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x44, 0x69, 0x66, 0x66, 0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x53, 0x75, 0x6d, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x6e,
+	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
+	0x53, 0x75, 0x6d, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52,
+	0x6f, 0x6f, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x5f,
+	0x6e, 0x65, 0x77, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x4e, 0x65, 0x77, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x22, 0x4b,
+	0x0a, 0x14, 0x44, 0x69, 0x66, 0x66, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74,
+	0x44, 0x69, 0x66, 0x66, 0x52, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x22, 0x50, 0x0a, 0x15, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49,
+	0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x22, 0xb9, 0x02,
+	0x0a, 0x14, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4d, 0x69,
+	0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63,
+	0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x41, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x53, 0x75, 0x6d, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0d, 0x70, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63,
+	0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x53, 0x75, 0x6d, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0d,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3f, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x70, 0x63, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x53, 0x75, 0x6d, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x22, 0xc6, 0x01, 0x0a, 0x16, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x5f, 0x6d, 0x69, 0x73,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6e,
+	0x75, 0x6d, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x12,
+	0x41, 0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
+	0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4d, 0x69,
+	0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x22, 0x7c, 0x0a, 0x14, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x55, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x7a, 0x65,
+	0x72, 0x6f, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x7a, 0x65, 0x72, 0x6f, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x61, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x68, 0x61,
+	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x22, 0xc5, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x53,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f,
+	0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x75,
+	0x72, 0x6e, 0x65, 0x64, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x6f, 0x0a, 0x15, 0x50, 0x72, 0x75, 0x6e,
+	0x65, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x52, 0x0c, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xa9, 0x03, 0x0a, 0x18, 0x55, 0x6e,
+	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6c,
+	0x73, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x6c,
+	0x73, 0x43, 0x65, 0x72, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3b, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x6e, 0x75, 0x6d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x75,
+	0x73, 0x68, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xe3, 0x01, 0x0a, 0x16, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12,
+	0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x34, 0x0a, 0x16, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63,
+	0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x61,
+	0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6e, 0x5f, 0x73, 0x79, 0x6e,
+	0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x22, 0x0a, 0x20, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0xe5, 0x01, 0x0a, 0x0f, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e,
+	0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x46, 0x65,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x06,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x46, 0x65,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x07,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x1e, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x60, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x1a, 0x41, 0x64,
+	0x64, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x75, 0x73,
+	0x68, 0x5f, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x75, 0x73, 0x68, 0x4f, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x22, 0x73, 0x0a, 0x0e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4c, 0x65, 0x61,
+	0x66, 0x50, 0x75, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
+	0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5f, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x46,
+	0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x4c, 0x65, 0x61, 0x66, 0x50, 0x75, 0x73, 0x68, 0x52, 0x0c, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x50, 0x75, 0x73, 0x68, 0x65, 0x73, 0x22, 0x60, 0x0a, 0x1d, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x07, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6e,
+	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0x20, 0x0a, 0x1e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64, 0x0a,
+	0x22, 0x53, 0x65, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x22, 0x64, 0x0a, 0x23, 0x53, 0x65, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0x32, 0x0a, 0x1c, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x5e, 0x0a,
+	0x1d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d,
+	0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0xb1, 0x03,
+	0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x73, 0x79, 0x6e, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x75,
+	0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x79, 0x6e, 0x63, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6e,
+	0x75, 0x6d, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x6e, 0x75, 0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x12,
+	0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x75, 0x73,
+	0x68, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x50, 0x75, 0x73, 0x68, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6e,
+	0x75, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x68,
+	0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6e, 0x75, 0x6d, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x43, 0x61, 0x63, 0x68, 0x65, 0x48, 0x69, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x16,
+	0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f,
+	0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6e, 0x75,
+	0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x65,
+	0x73, 0x22, 0xcd, 0x02, 0x0a, 0x0f, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x11, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x0f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x6f, 0x72,
+	0x74, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x75, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x67, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x09,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x53, 0x74,
+	0x72, 0x42, 0x07, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x92, 0x01, 0x0a, 0x18, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x75,
+	0x6d, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6e, 0x75, 0x6d, 0x49, 0x73, 0x73, 0x75,
+	0x61, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x75,
+	0x72, 0x6e, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x22,
+	0x8d, 0x02, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x3f, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x75,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x32, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x79, 0x6e, 0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x22,
+	0x99, 0x02, 0x0a, 0x0f, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x67, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2b,
+	0x0a, 0x11, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x67, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x56, 0x0a, 0x12, 0x55,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x22, 0x62, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x51, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x76, 0x0a, 0x15, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x5f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x79,
+	0x6e, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x77, 0x5f,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x6e, 0x65, 0x77, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x13, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f,
+	0x73, 0x79, 0x6e, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x67, 0x6c, 0x6f,
+	0x62, 0x61, 0x6c, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x54,
+	0x0a, 0x12, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46, 0x65,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x10, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x22, 0x21, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xab, 0x01, 0x0a, 0x1a, 0x47, 0x6c, 0x6f, 0x62,
+	0x61, 0x6c, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x75, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a,
+	0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53,
+	0x79, 0x6e, 0x63, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x79, 0x6e, 0x63, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x19, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46,
+	0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x79,
+	0x6e, 0x63, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74,
+	0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x65,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x43, 0x0a, 0x20,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0xd2, 0x01, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x13, 0x67, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x70, 0x63, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x67,
+	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+	0x12, 0x54, 0x0a, 0x12, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x10, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x41, 0x0a, 0x19, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x61, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x72, 0x61, 0x77,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x0c, 0x4d, 0x61,
+	0x69, 0x6c, 0x62, 0x6f, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x61, 0x77,
+	0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0c, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x29, 0x0a, 0x10, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x75, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x4d, 0x0a, 0x1a, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d,
+	0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63,
+	0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x22, 0x6b, 0x0a, 0x19, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x69,
+	0x6c, 0x62, 0x6f, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b,
+	0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x42, 0x05, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x22, 0x4f, 0x0a, 0x1a, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f,
+	0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x31, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61,
+	0x69, 0x6c, 0x62, 0x6f, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x73, 0x22, 0x55, 0x0a, 0x0f, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65,
+	0x79, 0x42, 0x06, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x0b, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3b, 0x0a, 0x0a, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x64, 0x65, 0x6e, 0x79, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x75, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x64, 0x65, 0x6e, 0x79, 0x4c, 0x69, 0x73,
+	0x74, 0x22, 0x49, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x75, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x18, 0x0a, 0x16,
+	0x53, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x4c, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
+	0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22,
+	0x65, 0x0a, 0x1f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x55, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44,
+	0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xc5, 0x02, 0x0a, 0x13, 0x55, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x3e, 0x0a, 0x0d, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x52, 0x6f, 0x6f, 0x74, 0x52, 0x0c, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f,
+	0x6f, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x07, 0x6c, 0x65, 0x61,
+	0x66, 0x4b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x65,
+	0x61, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x66,
+	0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x12, 0x37, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x75, 0x6e,
+	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x4c, 0x65, 0x61, 0x66, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x3a,
+	0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x70, 0x63, 0x2e, 0x49, 0x44, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x35, 0x0a, 0x14, 0x55, 0x6e,
+	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x44, 0x61, 0x74,
+	0x61, 0x22, 0x9d, 0x01, 0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x6e, 0x75, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
+	0x6e, 0x75, 0x6d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4c, 0x65, 0x61, 0x76, 0x65,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x75, 0x6d, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
+	0x6e, 0x75, 0x6d, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x61, 0x76, 0x65,
+	0x73, 0x22, 0x30, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x75,
+	0x73, 0x68, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f,
+	0x73, 0x74, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x50, 0x75, 0x73, 0x68, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1c, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50,
+	0x75, 0x73, 0x68, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x33,
+	0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x75, 0x73,
+	0x68, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f,
+	0x73, 0x74, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x55, 0x6e,
+	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x44, 0x42, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x92, 0x01, 0x0a, 0x19, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x55, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x44, 0x42, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
+	0x11, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x69, 0x7a,
+	0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x69, 0x7a, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x7b, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x61, 0x78,
+	0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x34, 0x0a, 0x16, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x22, 0x5e, 0x0a, 0x15, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x72, 0x6f,
+	0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x52, 0x6f, 0x6f, 0x74, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0xdf, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5c, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x1a,
+	0x62, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x70, 0x63, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x39, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x79,
+	0x0a, 0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6a, 0x0a, 0x17, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x05, 0x75, 0x74, 0x78,
+	0x6f, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5f, 0x0a, 0x17, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x53, 0x70,
+	0x65, 0x6e, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6d, 0x69, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x75, 0x6e, 0x65,
+	0x64, 0x4c, 0x65, 0x61, 0x66, 0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e,
+	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x52,
+	0x07, 0x6c, 0x65, 0x61, 0x66, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x71, 0x0a, 0x18, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x53, 0x70, 0x65, 0x6e,
+	0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3c, 0x0a, 0x0d, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x4c, 0x65, 0x61, 0x66, 0x52,
+	0x0c, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x3a, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72,
+	0x75, 0x6e, 0x65, 0x64, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x4e, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
+	0x0a, 0x09, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x4b, 0x65,
+	0x79, 0x73, 0x22, 0x5d, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x6f, 0x6f, 0x74, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44, 0x52,
+	0x03, 0x69, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0xc9, 0x01, 0x0a, 0x10, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63,
+	0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x3e, 0x0a,
+	0x0d, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52,
+	0x0c, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3e, 0x0a,
+	0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52,
+	0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x57, 0x0a,
+	0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x73,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x1a, 0x43, 0x6f, 0x6d, 0x70, 0x75,
+	0x74, 0x65, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x06, 0x6c,
+	0x65, 0x61, 0x76, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c,
+	0x65, 0x53, 0x75, 0x6d, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x78, 0x0a, 0x1b, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
+	0x63, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x53, 0x75, 0x6d, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x22, 0x5d, 0x0a, 0x17, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0xc4, 0x01, 0x0a, 0x10, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49,
+	0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
+	0x63, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x53, 0x75, 0x6d, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x65, 0x61,
+	0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0x98, 0x01, 0x0a, 0x18, 0x55, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x2a, 0x59, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a,
+	0x0a, 0x16, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52,
+	0x4f, 0x4f, 0x46, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x41, 0x4e, 0x43,
+	0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x10, 0x02, 0x2a, 0x5e, 0x0a, 0x10,
+	0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x16, 0x0a, 0x12, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x41, 0x4e, 0x43,
+	0x45, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x59, 0x4e, 0x43,
+	0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x59, 0x4e, 0x43, 0x5f,
+	0x44, 0x45, 0x4c, 0x54, 0x41, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x59, 0x4e, 0x43, 0x5f,
+	0x52, 0x4f, 0x4f, 0x54, 0x53, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x03, 0x2a, 0x5f, 0x0a, 0x0e,
+	0x52, 0x6f, 0x6f, 0x74, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19,
+	0x0a, 0x15, 0x52, 0x4f, 0x4f, 0x54, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x4c, 0x4f, 0x43, 0x41,
+	0x4c, 0x5f, 0x41, 0x48, 0x45, 0x41, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x4f, 0x4f,
+	0x54, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x41, 0x48,
+	0x45, 0x41, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x4f, 0x4f, 0x54, 0x5f, 0x44, 0x49,
+	0x46, 0x46, 0x5f, 0x44, 0x49, 0x56, 0x45, 0x52, 0x47, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xa3, 0x01,
+	0x0a, 0x13, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x46, 0x45, 0x44, 0x45, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52,
+	0x53, 0x48, 0x49, 0x50, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x46, 0x45, 0x44, 0x45, 0x52, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45,
+	0x52, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x46, 0x45, 0x44,
+	0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45,
+	0x52, 0x56, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x23,
+	0x0a, 0x1f, 0x46, 0x45, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
+	0x44, 0x10, 0x03, 0x2a, 0xd1, 0x01, 0x0a, 0x0e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42,
+	0x59, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x4f, 0x52, 0x54,
+	0x5f, 0x42, 0x59, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x01,
+	0x12, 0x14, 0x0a, 0x10, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x41, 0x53, 0x53, 0x45,
+	0x54, 0x5f, 0x49, 0x44, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42,
+	0x59, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x03, 0x12, 0x17,
+	0x0a, 0x13, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f,
+	0x53, 0x59, 0x4e, 0x43, 0x53, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x4f, 0x52, 0x54, 0x5f,
+	0x42, 0x59, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x53, 0x10,
+	0x05, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x47, 0x45, 0x4e,
+	0x45, 0x53, 0x49, 0x53, 0x5f, 0x48, 0x45, 0x49, 0x47, 0x48, 0x54, 0x10, 0x06, 0x12, 0x18, 0x0a,
+	0x14, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x53,
+	0x55, 0x50, 0x50, 0x4c, 0x59, 0x10, 0x07, 0x2a, 0x40, 0x0a, 0x0d, 0x53, 0x6f, 0x72, 0x74, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x4f, 0x52, 0x54,
+	0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x00,
+	0x12, 0x17, 0x0a, 0x13, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x01, 0x2a, 0x5f, 0x0a, 0x0f, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x15, 0x0a, 0x11,
+	0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x53,
+	0x53, 0x45, 0x54, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18,
+	0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x4c,
+	0x4c, 0x45, 0x43, 0x54, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x2a, 0x47, 0x0a, 0x12, 0x55, 0x6e,
+	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4c, 0x65, 0x61, 0x66, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x45, 0x41, 0x46, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
+	0x46, 0x45, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11,
+	0x4c, 0x45, 0x41, 0x46, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x41,
+	0x4c, 0x10, 0x01, 0x32, 0xe2, 0x24, 0x0a, 0x08, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x12, 0x4b, 0x0a, 0x0a, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x1d,
+	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a,
+	0x0f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x73,
+	0x12, 0x1b, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x1e, 0x2e,
+	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a,
+	0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x73,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x6f,
+	0x6f, 0x74, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x75, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x75,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x62, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x12, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x75, 0x6e,
+	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f,
+	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50,
+	0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f,
+	0x74, 0x12, 0x1c, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a,
+	0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x65, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x4c, 0x65, 0x61, 0x66, 0x12, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4c, 0x65, 0x61, 0x66, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x4c, 0x65, 0x61, 0x66, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x0f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x44, 0x1a, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x65, 0x61,
+	0x66, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x4b, 0x65, 0x79, 0x73, 0x53, 0x69, 0x6e,
+	0x63, 0x65, 0x12, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x4b, 0x65, 0x79, 0x73, 0x53, 0x69,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x75, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x65,
+	0x61, 0x66, 0x4b, 0x65, 0x79, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x73, 0x12, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x12, 0x18, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e,
+	0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x1a, 0x1f, 0x2e, 0x75, 0x6e,
+	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x12,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x12, 0x18, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63,
+	0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x1a, 0x23, 0x2e, 0x75,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x12, 0x47, 0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x12, 0x17, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x1a, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x49, 0x6e,
+	0x73, 0x65, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x20, 0x2e, 0x75, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x75,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72,
+	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x65, 0x0a, 0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f,
+	0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4d,
+	0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x26, 0x2e, 0x75,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x70, 0x63, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
+	0x04, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x53, 0x79,
+	0x6e, 0x63, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x18, 0x2e, 0x75, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x50, 0x0a, 0x12, 0x53, 0x79, 0x6e, 0x63, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x18, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x79,
+	0x6e, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30,
+	0x01, 0x12, 0x44, 0x0a, 0x07, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x6c, 0x6c, 0x12, 0x1b, 0x2e, 0x75,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x75, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x6c, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x44, 0x69, 0x66, 0x66, 0x55,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x55, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x22,
+	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63,
+	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x70, 0x75,
+	0x74, 0x65, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x27,
+	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x65, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x24, 0x2e, 0x75, 0x6e,
+	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e,
+	0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x50, 0x72, 0x75, 0x6e,
+	0x65, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x55, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x75,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65,
+	0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5f, 0x0a, 0x10, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x75, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x53, 0x70,
+	0x65, 0x6e, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5f, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x75, 0x6e,
+	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72,
+	0x75, 0x6e, 0x65, 0x64, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x55, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x44, 0x42, 0x12, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x55, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x44, 0x42, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x44, 0x42, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12,
+	0x29, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x46, 0x65, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x27, 0x2e,
+	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x46,
+	0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x71, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x75, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46,
+	0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x2f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x15, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46,
+	0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12,
+	0x29, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x65,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x27, 0x2e,
+	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x46, 0x0a, 0x0d, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x75,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x75, 0x6e,
+	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x5f, 0x0a, 0x10, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x24,
+	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0f, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x23,
+	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
+	0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x17, 0x53,
+	0x65, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x7a, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2d,
+	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a,
+	0x0e, 0x53, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x22, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65,
+	0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x24, 0x2e, 0x75,
+	0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x53, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x75, 0x73, 0x68, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x25,
+	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x75, 0x73, 0x68, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x75, 0x73,
+	0x68, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a,
+	0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x75, 0x73, 0x68,
+	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x75,
+	0x73, 0x68, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x75, 0x73, 0x68, 0x46, 0x72, 0x6f, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x55, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e,
+	0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x6a, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e,
+	0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30,
+	0x01, 0x12, 0x59, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x12, 0x22, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70,
+	0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x5a, 0x0a, 0x0e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x21,
+	0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x1a, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2d, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2f, 0x75, 0x6e, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_universerpc_universe_proto_rawDescOnce sync.Once
+	file_universerpc_universe_proto_rawDescData = file_universerpc_universe_proto_rawDesc
+)
+
+func file_universerpc_universe_proto_rawDescGZIP() []byte {
+	file_universerpc_universe_proto_rawDescOnce.Do(func() {
+		file_universerpc_universe_proto_rawDescData = protoimpl.X.CompressGZIP(file_universerpc_universe_proto_rawDescData)
+	})
+	return file_universerpc_universe_proto_rawDescData
+}
+
+var file_universerpc_universe_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_universerpc_universe_proto_msgTypes = make([]protoimpl.MessageInfo, 128)
+var file_universerpc_universe_proto_goTypes = []interface{}{
+	(ProofType)(0),                              // 0: universerpc.ProofType
+	(UniverseSyncMode)(0),                       // 1: universerpc.UniverseSyncMode
+	(RootDiffStatus)(0),                         // 2: universerpc.RootDiffStatus
+	(FederationEventType)(0),                    // 3: universerpc.FederationEventType
+	(AssetQuerySort)(0),                         // 4: universerpc.AssetQuerySort
+	(SortDirection)(0),                          // 5: universerpc.SortDirection
+	(AssetTypeFilter)(0),                        // 6: universerpc.AssetTypeFilter
+	(UniverseLeafSource)(0),                     // 7: universerpc.UniverseLeafSource
+	(*AssetRootRequest)(nil),                    // 8: universerpc.AssetRootRequest
+	(*SearchAssetsRequest)(nil),                 // 9: universerpc.SearchAssetsRequest
+	(*SearchAssetsResponse)(nil),                // 10: universerpc.SearchAssetsResponse
+	(*QueryAssetGenesisRequest)(nil),            // 11: universerpc.QueryAssetGenesisRequest
+	(*QueryAssetGenesisResponse)(nil),           // 12: universerpc.QueryAssetGenesisResponse
+	(*MerkleSumNode)(nil),                       // 13: universerpc.MerkleSumNode
+	(*ID)(nil),                                  // 14: universerpc.ID
+	(*UniverseRoot)(nil),                        // 15: universerpc.UniverseRoot
+	(*AssetRootResponse)(nil),                   // 16: universerpc.AssetRootResponse
+	(*AssetRootQuery)(nil),                      // 17: universerpc.AssetRootQuery
+	(*QueryRootResponse)(nil),                   // 18: universerpc.QueryRootResponse
+	(*MultiverseRootRequest)(nil),               // 19: universerpc.MultiverseRootRequest
+	(*MultiverseRootResponse)(nil),              // 20: universerpc.MultiverseRootResponse
+	(*DeleteRootQuery)(nil),                     // 21: universerpc.DeleteRootQuery
+	(*DeleteRootResponse)(nil),                  // 22: universerpc.DeleteRootResponse
+	(*DeleteUniverseLeafRequest)(nil),           // 23: universerpc.DeleteUniverseLeafRequest
+	(*DeleteUniverseLeafResponse)(nil),          // 24: universerpc.DeleteUniverseLeafResponse
+	(*Outpoint)(nil),                            // 25: universerpc.Outpoint
+	(*AssetKey)(nil),                            // 26: universerpc.AssetKey
+	(*AssetLeafKeyResponse)(nil),                // 27: universerpc.AssetLeafKeyResponse
+	(*AssetLeafKeysSinceRequest)(nil),           // 28: universerpc.AssetLeafKeysSinceRequest
+	(*AssetLeafKeysSinceResponse)(nil),          // 29: universerpc.AssetLeafKeysSinceResponse
+	(*AssetLeavesRequest)(nil),                  // 30: universerpc.AssetLeavesRequest
+	(*AssetLeaf)(nil),                           // 31: universerpc.AssetLeaf
+	(*AssetLeafResponse)(nil),                   // 32: universerpc.AssetLeafResponse
+	(*UniverseKey)(nil),                         // 33: universerpc.UniverseKey
+	(*AssetProofResponse)(nil),                  // 34: universerpc.AssetProofResponse
+	(*UniverseInclusionProof)(nil),              // 35: universerpc.UniverseInclusionProof
+	(*AssetProof)(nil),                          // 36: universerpc.AssetProof
+	(*InsertProofsRequest)(nil),                 // 37: universerpc.InsertProofsRequest
+	(*InsertProofResult)(nil),                   // 38: universerpc.InsertProofResult
+	(*InsertProofsResponse)(nil),                // 39: universerpc.InsertProofsResponse
+	(*InfoRequest)(nil),                         // 40: universerpc.InfoRequest
+	(*InfoResponse)(nil),                        // 41: universerpc.InfoResponse
+	(*SyncTarget)(nil),                          // 42: universerpc.SyncTarget
+	(*SyncRequest)(nil),                         // 43: universerpc.SyncRequest
+	(*SyncedUniverse)(nil),                      // 44: universerpc.SyncedUniverse
+	(*StatsRequest)(nil),                        // 45: universerpc.StatsRequest
+	(*SyncResponse)(nil),                        // 46: universerpc.SyncResponse
+	(*SyncAllRequest)(nil),                      // 47: universerpc.SyncAllRequest
+	(*MemberSyncResult)(nil),                    // 48: universerpc.MemberSyncResult
+	(*SyncAllResponse)(nil),                     // 49: universerpc.SyncAllResponse
+	(*SyncProgress)(nil),                        // 50: universerpc.SyncProgress
+	(*SyncProgressEvent)(nil),                   // 51: universerpc.SyncProgressEvent
+	(*DiffUniverseRequest)(nil),                 // 52: universerpc.DiffUniverseRequest
+	(*UniverseRootDiff)(nil),                    // 53: universerpc.UniverseRootDiff
+	(*DiffUniverseResponse)(nil),                // 54: universerpc.DiffUniverseResponse
+	(*VerifyUniverseRequest)(nil),               // 55: universerpc.VerifyUniverseRequest
+	(*UniverseRootMismatch)(nil),                // 56: universerpc.UniverseRootMismatch
+	(*VerifyUniverseResponse)(nil),              // 57: universerpc.VerifyUniverseResponse
+	(*PruneUniverseRequest)(nil),                // 58: universerpc.PruneUniverseRequest
+	(*PrunedAsset)(nil),                         // 59: universerpc.PrunedAsset
+	(*PruneUniverseResponse)(nil),               // 60: universerpc.PruneUniverseResponse
+	(*UniverseFederationServer)(nil),            // 61: universerpc.UniverseFederationServer
+	(*FederationServerHealth)(nil),              // 62: universerpc.FederationServerHealth
+	(*SubscribeFederationEventsRequest)(nil),    // 63: universerpc.SubscribeFederationEventsRequest
+	(*FederationEvent)(nil),                     // 64: universerpc.FederationEvent
+	(*ListFederationServersRequest)(nil),        // 65: universerpc.ListFederationServersRequest
+	(*ListFederationServersResponse)(nil),       // 66: universerpc.ListFederationServersResponse
+	(*AddFederationServerRequest)(nil),          // 67: universerpc.AddFederationServerRequest
+	(*FailedLeafPush)(nil),                      // 68: universerpc.FailedLeafPush
+	(*AddFederationServerResponse)(nil),         // 69: universerpc.AddFederationServerResponse
+	(*DeleteFederationServerRequest)(nil),       // 70: universerpc.DeleteFederationServerRequest
+	(*DeleteFederationServerResponse)(nil),      // 71: universerpc.DeleteFederationServerResponse
+	(*SetFederationServerPriorityRequest)(nil),  // 72: universerpc.SetFederationServerPriorityRequest
+	(*SetFederationServerPriorityResponse)(nil), // 73: universerpc.SetFederationServerPriorityResponse
+	(*CheckFederationServerRequest)(nil),        // 74: universerpc.CheckFederationServerRequest
+	(*CheckFederationServerResponse)(nil),       // 75: universerpc.CheckFederationServerResponse
+	(*StatsResponse)(nil),                       // 76: universerpc.StatsResponse
+	(*AssetStatsQuery)(nil),                     // 77: universerpc.AssetStatsQuery
+	(*QueryAssetSupplyRequest)(nil),             // 78: universerpc.QueryAssetSupplyRequest
+	(*QueryAssetSupplyResponse)(nil),            // 79: universerpc.QueryAssetSupplyResponse
+	(*AssetStatsSnapshot)(nil),                  // 80: universerpc.AssetStatsSnapshot
+	(*AssetStatsAsset)(nil),                     // 81: universerpc.AssetStatsAsset
+	(*UniverseAssetStats)(nil),                  // 82: universerpc.UniverseAssetStats
+	(*QueryEventsRequest)(nil),                  // 83: universerpc.QueryEventsRequest
+	(*QueryEventsResponse)(nil),                 // 84: universerpc.QueryEventsResponse
+	(*GroupedUniverseEvents)(nil),               // 85: universerpc.GroupedUniverseEvents
+	(*SetFederationSyncConfigRequest)(nil),      // 86: universerpc.SetFederationSyncConfigRequest
+	(*SetFederationSyncConfigResponse)(nil),     // 87: universerpc.SetFederationSyncConfigResponse
+	(*GlobalFederationSyncConfig)(nil),          // 88: universerpc.GlobalFederationSyncConfig
+	(*AssetFederationSyncConfig)(nil),           // 89: universerpc.AssetFederationSyncConfig
+	(*QueryFederationSyncConfigRequest)(nil),    // 90: universerpc.QueryFederationSyncConfigRequest
+	(*QueryFederationSyncConfigResponse)(nil),   // 91: universerpc.QueryFederationSyncConfigResponse
+	(*UploadMailboxProofRequest)(nil),           // 92: universerpc.UploadMailboxProofRequest
+	(*MailboxProof)(nil),                        // 93: universerpc.MailboxProof
+	(*UploadMailboxProofResponse)(nil),          // 94: universerpc.UploadMailboxProofResponse
+	(*FetchMailboxProofsRequest)(nil),           // 95: universerpc.FetchMailboxProofsRequest
+	(*FetchMailboxProofsResponse)(nil),          // 96: universerpc.FetchMailboxProofsResponse
+	(*AssetPolicyRule)(nil),                     // 97: universerpc.AssetPolicyRule
+	(*AssetPolicy)(nil),                         // 98: universerpc.AssetPolicy
+	(*SetAssetPolicyRequest)(nil),               // 99: universerpc.SetAssetPolicyRequest
+	(*SetAssetPolicyResponse)(nil),              // 100: universerpc.SetAssetPolicyResponse
+	(*QueryAssetPolicyRequest)(nil),             // 101: universerpc.QueryAssetPolicyRequest
+	(*QueryAssetPolicyResponse)(nil),            // 102: universerpc.QueryAssetPolicyResponse
+	(*SubscribeUniverseUpdatesRequest)(nil),     // 103: universerpc.SubscribeUniverseUpdatesRequest
+	(*UniverseUpdateEvent)(nil),                 // 104: universerpc.UniverseUpdateEvent
+	(*ExportUniverseRequest)(nil),               // 105: universerpc.ExportUniverseRequest
+	(*UniverseArchiveChunk)(nil),                // 106: universerpc.UniverseArchiveChunk
+	(*ImportUniverseResponse)(nil),              // 107: universerpc.ImportUniverseResponse
+	(*SetAcceptPushFromRequest)(nil),            // 108: universerpc.SetAcceptPushFromRequest
+	(*SetAcceptPushFromResponse)(nil),           // 109: universerpc.SetAcceptPushFromResponse
+	(*QueryAcceptPushFromRequest)(nil),          // 110: universerpc.QueryAcceptPushFromRequest
+	(*QueryAcceptPushFromResponse)(nil),         // 111: universerpc.QueryAcceptPushFromResponse
+	(*CompactUniverseDBRequest)(nil),            // 112: universerpc.CompactUniverseDBRequest
+	(*CompactUniverseDBResponse)(nil),           // 113: universerpc.CompactUniverseDBResponse
+	(*ListFederationRootsRequest)(nil),          // 114: universerpc.ListFederationRootsRequest
+	(*FederationServerRoots)(nil),               // 115: universerpc.FederationServerRoots
+	(*ListFederationRootsResponse)(nil),         // 116: universerpc.ListFederationRootsResponse
+	(*QueryAssetUtxosRequest)(nil),              // 117: universerpc.QueryAssetUtxosRequest
+	(*AssetUtxo)(nil),                           // 118: universerpc.AssetUtxo
+	(*QueryAssetUtxosResponse)(nil),             // 119: universerpc.QueryAssetUtxosResponse
+	(*PruneSpentLeavesRequest)(nil),             // 120: universerpc.PruneSpentLeavesRequest
+	(*PrunedLeaf)(nil),                          // 121: universerpc.PrunedLeaf
+	(*PruneSpentLeavesResponse)(nil),            // 122: universerpc.PruneSpentLeavesResponse
+	(*ListPrunedLeavesRequest)(nil),             // 123: universerpc.ListPrunedLeavesRequest
+	(*ListPrunedLeavesResponse)(nil),            // 124: universerpc.ListPrunedLeavesResponse
+	(*QueryAssetRootsBatchRequest)(nil),         // 125: universerpc.QueryAssetRootsBatchRequest
+	(*AssetRootsResult)(nil),                    // 126: universerpc.AssetRootsResult
+	(*QueryAssetRootsBatchResponse)(nil),        // 127: universerpc.QueryAssetRootsBatchResponse
+	(*ComputeUniverseRootRequest)(nil),          // 128: universerpc.ComputeUniverseRootRequest
+	(*ComputeUniverseRootResponse)(nil),         // 129: universerpc.ComputeUniverseRootResponse
+	(*UniverseSnapshotRequest)(nil),             // 130: universerpc.UniverseSnapshotRequest
+	(*UniverseSnapshot)(nil),                    // 131: universerpc.UniverseSnapshot
+	(*UniverseSnapshotResponse)(nil),            // 132: universerpc.UniverseSnapshotResponse
+	nil,                                         // 133: universerpc.UniverseRoot.AmountsByAssetIdEntry
+	nil,                                         // 134: universerpc.AssetRootResponse.UniverseRootsEntry
+	nil,                                         // 135: universerpc.ListFederationRootsResponse.ServerRootsEntry
+	(*taprpc.GenesisInfo)(nil),                  // 136: taprpc.GenesisInfo
+	(*taprpc.Asset)(nil),                        // 137: taprpc.Asset
+	(taprpc.AssetType)(0),                       // 138: taprpc.AssetType
+}
+var file_universerpc_universe_proto_depIdxs = []int32{
+	6,   // 0: universerpc.AssetRootRequest.asset_type_filter:type_name -> universerpc.AssetTypeFilter
+	15,  // 1: universerpc.SearchAssetsResponse.roots:type_name -> universerpc.UniverseRoot
+	14,  // 2: universerpc.QueryAssetGenesisRequest.id:type_name -> universerpc.ID
+	136, // 3: universerpc.QueryAssetGenesisResponse.genesis_infos:type_name -> taprpc.GenesisInfo
+	0,   // 4: universerpc.ID.proof_type:type_name -> universerpc.ProofType
+	14,  // 5: universerpc.UniverseRoot.id:type_name -> universerpc.ID
+	13,  // 6: universerpc.UniverseRoot.mssmt_root:type_name -> universerpc.MerkleSumNode
+	133, // 7: universerpc.UniverseRoot.amounts_by_asset_id:type_name -> universerpc.UniverseRoot.AmountsByAssetIdEntry
+	13,  // 8: universerpc.UniverseRoot.burn_root:type_name -> universerpc.MerkleSumNode
+	134, // 9: universerpc.AssetRootResponse.universe_roots:type_name -> universerpc.AssetRootResponse.UniverseRootsEntry
+	14,  // 10: universerpc.AssetRootQuery.id:type_name -> universerpc.ID
+	15,  // 11: universerpc.QueryRootResponse.issuance_root:type_name -> universerpc.UniverseRoot
+	15,  // 12: universerpc.QueryRootResponse.transfer_root:type_name -> universerpc.UniverseRoot
+	0,   // 13: universerpc.MultiverseRootRequest.proof_type:type_name -> universerpc.ProofType
+	13,  // 14: universerpc.MultiverseRootResponse.multiverse_root:type_name -> universerpc.MerkleSumNode
+	14,  // 15: universerpc.DeleteRootQuery.id:type_name -> universerpc.ID
+	33,  // 16: universerpc.DeleteUniverseLeafRequest.key:type_name -> universerpc.UniverseKey
+	15,  // 17: universerpc.DeleteUniverseLeafResponse.universe_root:type_name -> universerpc.UniverseRoot
+	25,  // 18: universerpc.AssetKey.op:type_name -> universerpc.Outpoint
+	26,  // 19: universerpc.AssetLeafKeyResponse.asset_keys:type_name -> universerpc.AssetKey
+	14,  // 20: universerpc.AssetLeafKeysSinceRequest.id:type_name -> universerpc.ID
+	26,  // 21: universerpc.AssetLeafKeysSinceResponse.asset_keys:type_name -> universerpc.AssetKey
+	14,  // 22: universerpc.AssetLeavesRequest.id:type_name -> universerpc.ID
+	137, // 23: universerpc.AssetLeaf.asset:type_name -> taprpc.Asset
+	26,  // 24: universerpc.AssetLeaf.leaf_key:type_name -> universerpc.AssetKey
+	31,  // 25: universerpc.AssetLeafResponse.leaves:type_name -> universerpc.AssetLeaf
+	14,  // 26: universerpc.UniverseKey.id:type_name -> universerpc.ID
+	26,  // 27: universerpc.UniverseKey.leaf_key:type_name -> universerpc.AssetKey
+	33,  // 28: universerpc.AssetProofResponse.req:type_name -> universerpc.UniverseKey
+	15,  // 29: universerpc.AssetProofResponse.universe_root:type_name -> universerpc.UniverseRoot
+	31,  // 30: universerpc.AssetProofResponse.asset_leaf:type_name -> universerpc.AssetLeaf
+	13,  // 31: universerpc.AssetProofResponse.multiverse_root:type_name -> universerpc.MerkleSumNode
+	33,  // 32: universerpc.UniverseInclusionProof.req:type_name -> universerpc.UniverseKey
+	13,  // 33: universerpc.UniverseInclusionProof.universe_root:type_name -> universerpc.MerkleSumNode
+	33,  // 34: universerpc.AssetProof.key:type_name -> universerpc.UniverseKey
+	31,  // 35: universerpc.AssetProof.asset_leaf:type_name -> universerpc.AssetLeaf
+	36,  // 36: universerpc.InsertProofsRequest.proofs:type_name -> universerpc.AssetProof
+	33,  // 37: universerpc.InsertProofResult.key:type_name -> universerpc.UniverseKey
+	38,  // 38: universerpc.InsertProofsResponse.results:type_name -> universerpc.InsertProofResult
+	14,  // 39: universerpc.SyncTarget.id:type_name -> universerpc.ID
+	1,   // 40: universerpc.SyncRequest.sync_mode:type_name -> universerpc.UniverseSyncMode
+	42,  // 41: universerpc.SyncRequest.sync_targets:type_name -> universerpc.SyncTarget
+	15,  // 42: universerpc.SyncedUniverse.old_asset_root:type_name -> universerpc.UniverseRoot
+	15,  // 43: universerpc.SyncedUniverse.new_asset_root:type_name -> universerpc.UniverseRoot
+	31,  // 44: universerpc.SyncedUniverse.new_asset_leaves:type_name -> universerpc.AssetLeaf
+	44,  // 45: universerpc.SyncResponse.synced_universes:type_name -> universerpc.SyncedUniverse
+	1,   // 46: universerpc.SyncAllRequest.sync_mode:type_name -> universerpc.UniverseSyncMode
+	44,  // 47: universerpc.MemberSyncResult.synced_universes:type_name -> universerpc.SyncedUniverse
+	48,  // 48: universerpc.SyncAllResponse.members:type_name -> universerpc.MemberSyncResult
+	14,  // 49: universerpc.SyncProgress.current_id:type_name -> universerpc.ID
+	50,  // 50: universerpc.SyncProgressEvent.progress:type_name -> universerpc.SyncProgress
+	46,  // 51: universerpc.SyncProgressEvent.summary:type_name -> universerpc.SyncResponse
+	14,  // 52: universerpc.UniverseRootDiff.id:type_name -> universerpc.ID
+	13,  // 53: universerpc.UniverseRootDiff.local_root:type_name -> universerpc.MerkleSumNode
+	13,  // 54: universerpc.UniverseRootDiff.remote_root:type_name -> universerpc.MerkleSumNode
+	2,   // 55: universerpc.UniverseRootDiff.status:type_name -> universerpc.RootDiffStatus
+	53,  // 56: universerpc.DiffUniverseResponse.diffs:type_name -> universerpc.UniverseRootDiff
+	14,  // 57: universerpc.VerifyUniverseRequest.id:type_name -> universerpc.ID
+	14,  // 58: universerpc.UniverseRootMismatch.id:type_name -> universerpc.ID
+	13,  // 59: universerpc.UniverseRootMismatch.persisted_root:type_name -> universerpc.MerkleSumNode
+	13,  // 60: universerpc.UniverseRootMismatch.committed_root:type_name -> universerpc.MerkleSumNode
+	13,  // 61: universerpc.UniverseRootMismatch.computed_root:type_name -> universerpc.MerkleSumNode
+	56,  // 62: universerpc.VerifyUniverseResponse.mismatches:type_name -> universerpc.UniverseRootMismatch
+	14,  // 63: universerpc.PrunedAsset.id:type_name -> universerpc.ID
+	59,  // 64: universerpc.PruneUniverseResponse.pruned_assets:type_name -> universerpc.PrunedAsset
+	62,  // 65: universerpc.UniverseFederationServer.health:type_name -> universerpc.FederationServerHealth
+	3,   // 66: universerpc.FederationEvent.type:type_name -> universerpc.FederationEventType
+	61,  // 67: universerpc.FederationEvent.server:type_name -> universerpc.UniverseFederationServer
+	61,  // 68: universerpc.FederationEvent.members:type_name -> universerpc.UniverseFederationServer
+	61,  // 69: universerpc.ListFederationServersResponse.servers:type_name -> universerpc.UniverseFederationServer
+	61,  // 70: universerpc.AddFederationServerRequest.servers:type_name -> universerpc.UniverseFederationServer
+	33,  // 71: universerpc.FailedLeafPush.key:type_name -> universerpc.UniverseKey
+	68,  // 72: universerpc.AddFederationServerResponse.failed_pushes:type_name -> universerpc.FailedLeafPush
+	61,  // 73: universerpc.DeleteFederationServerRequest.servers:type_name -> universerpc.UniverseFederationServer
+	61,  // 74: universerpc.SetFederationServerPriorityResponse.server:type_name -> universerpc.UniverseFederationServer
+	61,  // 75: universerpc.CheckFederationServerResponse.server:type_name -> universerpc.UniverseFederationServer
+	80,  // 76: universerpc.StatsResponse.asset_stats:type_name -> universerpc.AssetStatsSnapshot
+	6,   // 77: universerpc.AssetStatsQuery.asset_type_filter:type_name -> universerpc.AssetTypeFilter
+	4,   // 78: universerpc.AssetStatsQuery.sort_by:type_name -> universerpc.AssetQuerySort
+	5,   // 79: universerpc.AssetStatsQuery.direction:type_name -> universerpc.SortDirection
+	81,  // 80: universerpc.AssetStatsSnapshot.group_anchor:type_name -> universerpc.AssetStatsAsset
+	81,  // 81: universerpc.AssetStatsSnapshot.asset:type_name -> universerpc.AssetStatsAsset
+	138, // 82: universerpc.AssetStatsAsset.asset_type:type_name -> taprpc.AssetType
+	80,  // 83: universerpc.UniverseAssetStats.asset_stats:type_name -> universerpc.AssetStatsSnapshot
+	85,  // 84: universerpc.QueryEventsResponse.events:type_name -> universerpc.GroupedUniverseEvents
+	88,  // 85: universerpc.SetFederationSyncConfigRequest.global_sync_configs:type_name -> universerpc.GlobalFederationSyncConfig
+	89,  // 86: universerpc.SetFederationSyncConfigRequest.asset_sync_configs:type_name -> universerpc.AssetFederationSyncConfig
+	0,   // 87: universerpc.GlobalFederationSyncConfig.proof_type:type_name -> universerpc.ProofType
+	14,  // 88: universerpc.AssetFederationSyncConfig.id:type_name -> universerpc.ID
+	14,  // 89: universerpc.QueryFederationSyncConfigRequest.id:type_name -> universerpc.ID
+	88,  // 90: universerpc.QueryFederationSyncConfigResponse.global_sync_configs:type_name -> universerpc.GlobalFederationSyncConfig
+	89,  // 91: universerpc.QueryFederationSyncConfigResponse.asset_sync_configs:type_name -> universerpc.AssetFederationSyncConfig
+	93,  // 92: universerpc.UploadMailboxProofResponse.proof:type_name -> universerpc.MailboxProof
+	93,  // 93: universerpc.FetchMailboxProofsResponse.proofs:type_name -> universerpc.MailboxProof
+	97,  // 94: universerpc.AssetPolicy.allow_list:type_name -> universerpc.AssetPolicyRule
+	97,  // 95: universerpc.AssetPolicy.deny_list:type_name -> universerpc.AssetPolicyRule
+	98,  // 96: universerpc.SetAssetPolicyRequest.policy:type_name -> universerpc.AssetPolicy
+	98,  // 97: universerpc.QueryAssetPolicyResponse.policy:type_name -> universerpc.AssetPolicy
+	14,  // 98: universerpc.SubscribeUniverseUpdatesRequest.ids:type_name -> universerpc.ID
+	15,  // 99: universerpc.UniverseUpdateEvent.universe_root:type_name -> universerpc.UniverseRoot
+	26,  // 100: universerpc.UniverseUpdateEvent.leaf_key:type_name -> universerpc.AssetKey
+	31,  // 101: universerpc.UniverseUpdateEvent.asset_leaf:type_name -> universerpc.AssetLeaf
+	7,   // 102: universerpc.UniverseUpdateEvent.source:type_name -> universerpc.UniverseLeafSource
+	14,  // 103: universerpc.ExportUniverseRequest.ids:type_name -> universerpc.ID
+	15,  // 104: universerpc.FederationServerRoots.roots:type_name -> universerpc.UniverseRoot
+	135, // 105: universerpc.ListFederationRootsResponse.server_roots:type_name -> universerpc.ListFederationRootsResponse.ServerRootsEntry
+	14,  // 106: universerpc.QueryAssetUtxosRequest.id:type_name -> universerpc.ID
+	118, // 107: universerpc.QueryAssetUtxosResponse.utxos:type_name -> universerpc.AssetUtxo
+	14,  // 108: universerpc.PrunedLeaf.id:type_name -> universerpc.ID
+	26,  // 109: universerpc.PrunedLeaf.leaf_key:type_name -> universerpc.AssetKey
+	121, // 110: universerpc.PruneSpentLeavesResponse.pruned_leaves:type_name -> universerpc.PrunedLeaf
+	14,  // 111: universerpc.ListPrunedLeavesRequest.id:type_name -> universerpc.ID
+	26,  // 112: universerpc.ListPrunedLeavesResponse.leaf_keys:type_name -> universerpc.AssetKey
+	14,  // 113: universerpc.QueryAssetRootsBatchRequest.ids:type_name -> universerpc.ID
+	14,  // 114: universerpc.AssetRootsResult.id:type_name -> universerpc.ID
+	15,  // 115: universerpc.AssetRootsResult.issuance_root:type_name -> universerpc.UniverseRoot
+	15,  // 116: universerpc.AssetRootsResult.transfer_root:type_name -> universerpc.UniverseRoot
+	126, // 117: universerpc.QueryAssetRootsBatchResponse.results:type_name -> universerpc.AssetRootsResult
+	31,  // 118: universerpc.ComputeUniverseRootRequest.leaves:type_name -> universerpc.AssetLeaf
+	13,  // 119: universerpc.ComputeUniverseRootRequest.expected_root:type_name -> universerpc.MerkleSumNode
+	13,  // 120: universerpc.ComputeUniverseRootResponse.root:type_name -> universerpc.MerkleSumNode
+	14,  // 121: universerpc.UniverseSnapshotRequest.id:type_name -> universerpc.ID
+	14,  // 122: universerpc.UniverseSnapshot.id:type_name -> universerpc.ID
+	13,  // 123: universerpc.UniverseSnapshot.root:type_name -> universerpc.MerkleSumNode
+	131, // 124: universerpc.UniverseSnapshotResponse.snapshot:type_name -> universerpc.UniverseSnapshot
+	15,  // 125: universerpc.AssetRootResponse.UniverseRootsEntry.value:type_name -> universerpc.UniverseRoot
+	115, // 126: universerpc.ListFederationRootsResponse.ServerRootsEntry.value:type_name -> universerpc.FederationServerRoots
+	8,   // 127: universerpc.Universe.AssetRoots:input_type -> universerpc.AssetRootRequest
+	17,  // 128: universerpc.Universe.QueryAssetRoots:input_type -> universerpc.AssetRootQuery
+	125, // 129: universerpc.Universe.QueryAssetRootsBatch:input_type -> universerpc.QueryAssetRootsBatchRequest
+	9,   // 130: universerpc.Universe.SearchAssets:input_type -> universerpc.SearchAssetsRequest
+	11,  // 131: universerpc.Universe.QueryAssetGenesis:input_type -> universerpc.QueryAssetGenesisRequest
+	19,  // 132: universerpc.Universe.MultiverseRoot:input_type -> universerpc.MultiverseRootRequest
+	21,  // 133: universerpc.Universe.DeleteAssetRoot:input_type -> universerpc.DeleteRootQuery
+	23,  // 134: universerpc.Universe.DeleteUniverseLeaf:input_type -> universerpc.DeleteUniverseLeafRequest
+	14,  // 135: universerpc.Universe.AssetLeafKeys:input_type -> universerpc.ID
+	28,  // 136: universerpc.Universe.AssetLeafKeysSince:input_type -> universerpc.AssetLeafKeysSinceRequest
+	30,  // 137: universerpc.Universe.AssetLeaves:input_type -> universerpc.AssetLeavesRequest
+	33,  // 138: universerpc.Universe.QueryProof:input_type -> universerpc.UniverseKey
+	33,  // 139: universerpc.Universe.QueryUniverseProof:input_type -> universerpc.UniverseKey
+	36,  // 140: universerpc.Universe.InsertProof:input_type -> universerpc.AssetProof
+	37,  // 141: universerpc.Universe.InsertProofs:input_type -> universerpc.InsertProofsRequest
+	92,  // 142: universerpc.Universe.UploadMailboxProof:input_type -> universerpc.UploadMailboxProofRequest
+	95,  // 143: universerpc.Universe.FetchMailboxProofs:input_type -> universerpc.FetchMailboxProofsRequest
+	40,  // 144: universerpc.Universe.Info:input_type -> universerpc.InfoRequest
+	43,  // 145: universerpc.Universe.SyncUniverse:input_type -> universerpc.SyncRequest
+	43,  // 146: universerpc.Universe.SyncUniverseStream:input_type -> universerpc.SyncRequest
+	47,  // 147: universerpc.Universe.SyncAll:input_type -> universerpc.SyncAllRequest
+	52,  // 148: universerpc.Universe.DiffUniverse:input_type -> universerpc.DiffUniverseRequest
+	55,  // 149: universerpc.Universe.VerifyUniverse:input_type -> universerpc.VerifyUniverseRequest
+	128, // 150: universerpc.Universe.ComputeUniverseRoot:input_type -> universerpc.ComputeUniverseRootRequest
+	130, // 151: universerpc.Universe.ExportUniverseSnapshot:input_type -> universerpc.UniverseSnapshotRequest
+	58,  // 152: universerpc.Universe.PruneUniverse:input_type -> universerpc.PruneUniverseRequest
+	120, // 153: universerpc.Universe.PruneSpentLeaves:input_type -> universerpc.PruneSpentLeavesRequest
+	123, // 154: universerpc.Universe.ListPrunedLeaves:input_type -> universerpc.ListPrunedLeavesRequest
+	112, // 155: universerpc.Universe.CompactUniverseDB:input_type -> universerpc.CompactUniverseDBRequest
+	65,  // 156: universerpc.Universe.ListFederationServers:input_type -> universerpc.ListFederationServersRequest
+	67,  // 157: universerpc.Universe.AddFederationServer:input_type -> universerpc.AddFederationServerRequest
+	70,  // 158: universerpc.Universe.DeleteFederationServer:input_type -> universerpc.DeleteFederationServerRequest
+	72,  // 159: universerpc.Universe.SetFederationServerPriority:input_type -> universerpc.SetFederationServerPriorityRequest
+	74,  // 160: universerpc.Universe.CheckFederationServer:input_type -> universerpc.CheckFederationServerRequest
+	114, // 161: universerpc.Universe.ListFederationRoots:input_type -> universerpc.ListFederationRootsRequest
+	45,  // 162: universerpc.Universe.UniverseStats:input_type -> universerpc.StatsRequest
+	77,  // 163: universerpc.Universe.QueryAssetStats:input_type -> universerpc.AssetStatsQuery
+	78,  // 164: universerpc.Universe.QueryAssetSupply:input_type -> universerpc.QueryAssetSupplyRequest
+	117, // 165: universerpc.Universe.QueryAssetUtxos:input_type -> universerpc.QueryAssetUtxosRequest
+	83,  // 166: universerpc.Universe.QueryEvents:input_type -> universerpc.QueryEventsRequest
+	86,  // 167: universerpc.Universe.SetFederationSyncConfig:input_type -> universerpc.SetFederationSyncConfigRequest
+	90,  // 168: universerpc.Universe.QueryFederationSyncConfig:input_type -> universerpc.QueryFederationSyncConfigRequest
+	99,  // 169: universerpc.Universe.SetAssetPolicy:input_type -> universerpc.SetAssetPolicyRequest
+	101, // 170: universerpc.Universe.QueryAssetPolicy:input_type -> universerpc.QueryAssetPolicyRequest
+	108, // 171: universerpc.Universe.SetAcceptPushFrom:input_type -> universerpc.SetAcceptPushFromRequest
+	110, // 172: universerpc.Universe.QueryAcceptPushFrom:input_type -> universerpc.QueryAcceptPushFromRequest
+	103, // 173: universerpc.Universe.SubscribeUniverseUpdates:input_type -> universerpc.SubscribeUniverseUpdatesRequest
+	63,  // 174: universerpc.Universe.SubscribeFederationEvents:input_type -> universerpc.SubscribeFederationEventsRequest
+	105, // 175: universerpc.Universe.ExportUniverse:input_type -> universerpc.ExportUniverseRequest
+	106, // 176: universerpc.Universe.ImportUniverse:input_type -> universerpc.UniverseArchiveChunk
+	16,  // 177: universerpc.Universe.AssetRoots:output_type -> universerpc.AssetRootResponse
+	18,  // 178: universerpc.Universe.QueryAssetRoots:output_type -> universerpc.QueryRootResponse
+	127, // 179: universerpc.Universe.QueryAssetRootsBatch:output_type -> universerpc.QueryAssetRootsBatchResponse
+	10,  // 180: universerpc.Universe.SearchAssets:output_type -> universerpc.SearchAssetsResponse
+	12,  // 181: universerpc.Universe.QueryAssetGenesis:output_type -> universerpc.QueryAssetGenesisResponse
+	20,  // 182: universerpc.Universe.MultiverseRoot:output_type -> universerpc.MultiverseRootResponse
+	22,  // 183: universerpc.Universe.DeleteAssetRoot:output_type -> universerpc.DeleteRootResponse
+	24,  // 184: universerpc.Universe.DeleteUniverseLeaf:output_type -> universerpc.DeleteUniverseLeafResponse
+	27,  // 185: universerpc.Universe.AssetLeafKeys:output_type -> universerpc.AssetLeafKeyResponse
+	29,  // 186: universerpc.Universe.AssetLeafKeysSince:output_type -> universerpc.AssetLeafKeysSinceResponse
+	32,  // 187: universerpc.Universe.AssetLeaves:output_type -> universerpc.AssetLeafResponse
+	34,  // 188: universerpc.Universe.QueryProof:output_type -> universerpc.AssetProofResponse
+	35,  // 189: universerpc.Universe.QueryUniverseProof:output_type -> universerpc.UniverseInclusionProof
+	34,  // 190: universerpc.Universe.InsertProof:output_type -> universerpc.AssetProofResponse
+	39,  // 191: universerpc.Universe.InsertProofs:output_type -> universerpc.InsertProofsResponse
+	94,  // 192: universerpc.Universe.UploadMailboxProof:output_type -> universerpc.UploadMailboxProofResponse
+	96,  // 193: universerpc.Universe.FetchMailboxProofs:output_type -> universerpc.FetchMailboxProofsResponse
+	41,  // 194: universerpc.Universe.Info:output_type -> universerpc.InfoResponse
+	46,  // 195: universerpc.Universe.SyncUniverse:output_type -> universerpc.SyncResponse
+	51,  // 196: universerpc.Universe.SyncUniverseStream:output_type -> universerpc.SyncProgressEvent
+	49,  // 197: universerpc.Universe.SyncAll:output_type -> universerpc.SyncAllResponse
+	54,  // 198: universerpc.Universe.DiffUniverse:output_type -> universerpc.DiffUniverseResponse
+	57,  // 199: universerpc.Universe.VerifyUniverse:output_type -> universerpc.VerifyUniverseResponse
+	129, // 200: universerpc.Universe.ComputeUniverseRoot:output_type -> universerpc.ComputeUniverseRootResponse
+	132, // 201: universerpc.Universe.ExportUniverseSnapshot:output_type -> universerpc.UniverseSnapshotResponse
+	60,  // 202: universerpc.Universe.PruneUniverse:output_type -> universerpc.PruneUniverseResponse
+	122, // 203: universerpc.Universe.PruneSpentLeaves:output_type -> universerpc.PruneSpentLeavesResponse
+	124, // 204: universerpc.Universe.ListPrunedLeaves:output_type -> universerpc.ListPrunedLeavesResponse
+	113, // 205: universerpc.Universe.CompactUniverseDB:output_type -> universerpc.CompactUniverseDBResponse
+	66,  // 206: universerpc.Universe.ListFederationServers:output_type -> universerpc.ListFederationServersResponse
+	69,  // 207: universerpc.Universe.AddFederationServer:output_type -> universerpc.AddFederationServerResponse
+	71,  // 208: universerpc.Universe.DeleteFederationServer:output_type -> universerpc.DeleteFederationServerResponse
+	73,  // 209: universerpc.Universe.SetFederationServerPriority:output_type -> universerpc.SetFederationServerPriorityResponse
+	75,  // 210: universerpc.Universe.CheckFederationServer:output_type -> universerpc.CheckFederationServerResponse
+	116, // 211: universerpc.Universe.ListFederationRoots:output_type -> universerpc.ListFederationRootsResponse
+	76,  // 212: universerpc.Universe.UniverseStats:output_type -> universerpc.StatsResponse
+	82,  // 213: universerpc.Universe.QueryAssetStats:output_type -> universerpc.UniverseAssetStats
+	79,  // 214: universerpc.Universe.QueryAssetSupply:output_type -> universerpc.QueryAssetSupplyResponse
+	119, // 215: universerpc.Universe.QueryAssetUtxos:output_type -> universerpc.QueryAssetUtxosResponse
+	84,  // 216: universerpc.Universe.QueryEvents:output_type -> universerpc.QueryEventsResponse
+	87,  // 217: universerpc.Universe.SetFederationSyncConfig:output_type -> universerpc.SetFederationSyncConfigResponse
+	91,  // 218: universerpc.Universe.QueryFederationSyncConfig:output_type -> universerpc.QueryFederationSyncConfigResponse
+	100, // 219: universerpc.Universe.SetAssetPolicy:output_type -> universerpc.SetAssetPolicyResponse
+	102, // 220: universerpc.Universe.QueryAssetPolicy:output_type -> universerpc.QueryAssetPolicyResponse
+	109, // 221: universerpc.Universe.SetAcceptPushFrom:output_type -> universerpc.SetAcceptPushFromResponse
+	111, // 222: universerpc.Universe.QueryAcceptPushFrom:output_type -> universerpc.QueryAcceptPushFromResponse
+	104, // 223: universerpc.Universe.SubscribeUniverseUpdates:output_type -> universerpc.UniverseUpdateEvent
+	64,  // 224: universerpc.Universe.SubscribeFederationEvents:output_type -> universerpc.FederationEvent
+	106, // 225: universerpc.Universe.ExportUniverse:output_type -> universerpc.UniverseArchiveChunk
+	107, // 226: universerpc.Universe.ImportUniverse:output_type -> universerpc.ImportUniverseResponse
+	177, // [177:227] is the sub-list for method output_type
+	127, // [127:177] is the sub-list for method input_type
+	127, // [127:127] is the sub-list for extension type_name
+	127, // [127:127] is the sub-list for extension extendee
+	0,   // [0:127] is the sub-list for field type_name
+}
+
+func init() { file_universerpc_universe_proto_init() }
+func file_universerpc_universe_proto_init() {
+	if File_universerpc_universe_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_universerpc_universe_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetRootRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchAssetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchAssetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAssetGenesisRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAssetGenesisResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MerkleSumNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniverseRoot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetRootResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetRootQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRootResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiverseRootRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiverseRootResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRootQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRootResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUniverseLeafRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUniverseLeafResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Outpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetLeafKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetLeafKeysSinceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetLeafKeysSinceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetLeavesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetLeaf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetLeafResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniverseKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniverseInclusionProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertProofsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertProofResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertProofsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncedUniverse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncAllRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberSyncResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncAllResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncProgressEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffUniverseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniverseRootDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffUniverseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyUniverseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniverseRootMismatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyUniverseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PruneUniverseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrunedAsset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PruneUniverseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniverseFederationServer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FederationServerHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeFederationEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FederationEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFederationServersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFederationServersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFederationServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FailedLeafPush); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFederationServerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFederationServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFederationServerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFederationServerPriorityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFederationServerPriorityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckFederationServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckFederationServerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetStatsQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAssetSupplyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAssetSupplyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetStatsSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetStatsAsset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniverseAssetStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEventsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3619,8 +11337,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MerkleSumNode); i {
+		file_universerpc_universe_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupedUniverseEvents); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3631,8 +11349,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ID); i {
+		file_universerpc_universe_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFederationSyncConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3643,8 +11361,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UniverseRoot); i {
+		file_universerpc_universe_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFederationSyncConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3655,8 +11373,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetRootResponse); i {
+		file_universerpc_universe_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GlobalFederationSyncConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3667,8 +11385,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetRootQuery); i {
+		file_universerpc_universe_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetFederationSyncConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3679,8 +11397,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRootResponse); i {
+		file_universerpc_universe_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryFederationSyncConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3691,8 +11409,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRootQuery); i {
+		file_universerpc_universe_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryFederationSyncConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3703,8 +11421,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRootResponse); i {
+		file_universerpc_universe_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadMailboxProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3715,8 +11433,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Outpoint); i {
+		file_universerpc_universe_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MailboxProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3727,8 +11445,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetKey); i {
+		file_universerpc_universe_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadMailboxProofResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3739,8 +11457,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetLeafKeyResponse); i {
+		file_universerpc_universe_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchMailboxProofsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3751,8 +11469,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetLeaf); i {
+		file_universerpc_universe_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchMailboxProofsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3763,8 +11481,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetLeafResponse); i {
+		file_universerpc_universe_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetPolicyRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3775,8 +11493,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UniverseKey); i {
+		file_universerpc_universe_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3787,8 +11505,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetProofResponse); i {
+		file_universerpc_universe_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAssetPolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3799,8 +11517,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetProof); i {
+		file_universerpc_universe_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAssetPolicyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3811,8 +11529,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InfoRequest); i {
+		file_universerpc_universe_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAssetPolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3823,8 +11541,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InfoResponse); i {
+		file_universerpc_universe_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAssetPolicyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3835,8 +11553,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncTarget); i {
+		file_universerpc_universe_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeUniverseUpdatesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3847,8 +11565,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncRequest); i {
+		file_universerpc_universe_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniverseUpdateEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3859,8 +11577,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncedUniverse); i {
+		file_universerpc_universe_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUniverseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3871,8 +11589,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsRequest); i {
+		file_universerpc_universe_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniverseArchiveChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3883,8 +11601,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncResponse); i {
+		file_universerpc_universe_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportUniverseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3895,8 +11613,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UniverseFederationServer); i {
+		file_universerpc_universe_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAcceptPushFromRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3907,8 +11625,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFederationServersRequest); i {
+		file_universerpc_universe_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAcceptPushFromResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3919,8 +11637,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFederationServersResponse); i {
+		file_universerpc_universe_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAcceptPushFromRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3931,8 +11649,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFederationServerRequest); i {
+		file_universerpc_universe_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAcceptPushFromResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3943,8 +11661,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFederationServerResponse); i {
+		file_universerpc_universe_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompactUniverseDBRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3955,8 +11673,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteFederationServerRequest); i {
+		file_universerpc_universe_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompactUniverseDBResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3967,8 +11685,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteFederationServerResponse); i {
+		file_universerpc_universe_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFederationRootsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3979,8 +11697,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsResponse); i {
+		file_universerpc_universe_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FederationServerRoots); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3991,8 +11709,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetStatsQuery); i {
+		file_universerpc_universe_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFederationRootsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4003,8 +11721,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetStatsSnapshot); i {
+		file_universerpc_universe_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAssetUtxosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4015,8 +11733,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetStatsAsset); i {
+		file_universerpc_universe_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetUtxo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4027,8 +11745,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UniverseAssetStats); i {
+		file_universerpc_universe_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAssetUtxosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4039,8 +11757,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryEventsRequest); i {
+		file_universerpc_universe_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PruneSpentLeavesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4051,8 +11769,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryEventsResponse); i {
+		file_universerpc_universe_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrunedLeaf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4063,8 +11781,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupedUniverseEvents); i {
+		file_universerpc_universe_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PruneSpentLeavesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4075,8 +11793,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetFederationSyncConfigRequest); i {
+		file_universerpc_universe_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPrunedLeavesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4087,8 +11805,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetFederationSyncConfigResponse); i {
+		file_universerpc_universe_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPrunedLeavesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4099,8 +11817,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GlobalFederationSyncConfig); i {
+		file_universerpc_universe_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAssetRootsBatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4111,8 +11829,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetFederationSyncConfig); i {
+		file_universerpc_universe_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetRootsResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4123,8 +11841,8 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryFederationSyncConfigRequest); i {
+		file_universerpc_universe_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAssetRootsBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4135,8 +11853,56 @@ func file_universerpc_universe_proto_init() {
 				return nil
 			}
 		}
-		file_universerpc_universe_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryFederationSyncConfigResponse); i {
+		file_universerpc_universe_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComputeUniverseRootRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComputeUniverseRootResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniverseSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniverseSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_universerpc_universe_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniverseSnapshotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4148,25 +11914,41 @@ func file_universerpc_universe_proto_init() {
 			}
 		}
 	}
-	file_universerpc_universe_proto_msgTypes[2].OneofWrappers = []interface{}{
+	file_universerpc_universe_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*ID_AssetId)(nil),
 		(*ID_AssetIdStr)(nil),
 		(*ID_GroupKey)(nil),
 		(*ID_GroupKeyStr)(nil),
 	}
-	file_universerpc_universe_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_universerpc_universe_proto_msgTypes[18].OneofWrappers = []interface{}{
 		(*AssetKey_OpStr)(nil),
 		(*AssetKey_Op)(nil),
 		(*AssetKey_ScriptKeyBytes)(nil),
 		(*AssetKey_ScriptKeyStr)(nil),
 	}
+	file_universerpc_universe_proto_msgTypes[43].OneofWrappers = []interface{}{
+		(*SyncProgressEvent_Progress)(nil),
+		(*SyncProgressEvent_Summary)(nil),
+	}
+	file_universerpc_universe_proto_msgTypes[70].OneofWrappers = []interface{}{
+		(*QueryAssetSupplyRequest_GroupKey)(nil),
+		(*QueryAssetSupplyRequest_GroupKeyStr)(nil),
+	}
+	file_universerpc_universe_proto_msgTypes[87].OneofWrappers = []interface{}{
+		(*FetchMailboxProofsRequest_ScriptKey)(nil),
+		(*FetchMailboxProofsRequest_ScriptKeyStr)(nil),
+	}
+	file_universerpc_universe_proto_msgTypes[89].OneofWrappers = []interface{}{
+		(*AssetPolicyRule_AssetId)(nil),
+		(*AssetPolicyRule_GroupKey)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_universerpc_universe_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   47,
+			NumEnums:      8,
+			NumMessages:   128,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
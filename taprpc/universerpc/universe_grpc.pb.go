@@ -21,15 +21,58 @@ type UniverseClient interface {
 	// tapcli: `universe roots`
 	// AssetRoots queries for the known Universe roots associated with each known
 	// asset. These roots represent the supply/audit state for each known asset.
+	// All roots can also be exported as CSV or JSON through the REST endpoint
+	// GET /v1/taproot-assets/universe/roots/export?format=csv|json, which pages
+	// through this call and streams the result. If the server is configured to
+	// sign asset roots, then each root carries a signature by the node identity
+	// key of the server.
 	AssetRoots(ctx context.Context, in *AssetRootRequest, opts ...grpc.CallOption) (*AssetRootResponse, error)
 	// tapcli: `universe roots`
 	// QueryAssetRoots attempts to locate the current Universe root for a specific
-	// asset. This asset can be identified by its asset ID or group key.
+	// asset. This asset can be identified by its asset ID or group key. If a
+	// block height is specified, then the historical roots as they were at that
+	// height are returned instead.
 	QueryAssetRoots(ctx context.Context, in *AssetRootQuery, opts ...grpc.CallOption) (*QueryRootResponse, error)
+	// tapcli: `universe roots-batch`
+	// QueryAssetRootsBatch attempts to locate the current Universe roots for a
+	// list of assets in a single call. The results are returned in the same
+	// order as the given IDs, and each result indicates whether any root was
+	// found for its asset. If a block height is specified, then the historical
+	// roots as they were at that height are returned instead.
+	QueryAssetRootsBatch(ctx context.Context, in *QueryAssetRootsBatchRequest, opts ...grpc.CallOption) (*QueryAssetRootsBatchResponse, error)
+	// tapcli: `universe search`
+	// SearchAssets returns the known Universe roots of all assets with a name
+	// (genesis tag) that contains the given string. The name is matched
+	// case-insensitively and the roots are sorted by name, so the offset and
+	// limit can be used to page through the results.
+	SearchAssets(ctx context.Context, in *SearchAssetsRequest, opts ...grpc.CallOption) (*SearchAssetsResponse, error)
+	// tapcli: `universe genesis`
+	// QueryAssetGenesis returns the genesis information of an asset, as recorded
+	// in the issuance leaves of its Universe. The asset can be identified by its
+	// asset ID or group key. For a group key, the genesis information of each
+	// asset known to exist within the group is returned. A NotFound error is
+	// returned if the Universe has no issuance leaf for the given ID.
+	QueryAssetGenesis(ctx context.Context, in *QueryAssetGenesisRequest, opts ...grpc.CallOption) (*QueryAssetGenesisResponse, error)
+	// tapcli: `universe multiverse`
+	// MultiverseRoot returns the root of the multiverse tree for the given proof
+	// type. The multiverse tree is an MS-SMT that commits to all the Universe
+	// roots known to the server, keyed by their Universe ID. Two servers with
+	// the same Universe state produce the same multiverse root, which allows
+	// their state to be compared without fetching each Universe root. If the
+	// server is configured to sign roots, then the root is signed with the node
+	// identity key of the server, which allows clients to verify its
+	// authenticity.
+	MultiverseRoot(ctx context.Context, in *MultiverseRootRequest, opts ...grpc.CallOption) (*MultiverseRootResponse, error)
 	// tapcli: `universe delete`
 	// DeleteAssetRoot deletes the Universe root for a specific asset, including
 	// all asoociated universe keys, leaves, and events.
 	DeleteAssetRoot(ctx context.Context, in *DeleteRootQuery, opts ...grpc.CallOption) (*DeleteRootResponse, error)
+	// tapcli: `universe proofs delete`
+	// DeleteUniverseLeaf deletes a single leaf from a local Universe tree based
+	// on its UniverseKey. The Universe root is recomputed and persisted, and the
+	// new root is returned. If the leaf doesn't exist, then this is a no-op and
+	// the current root is returned.
+	DeleteUniverseLeaf(ctx context.Context, in *DeleteUniverseLeafRequest, opts ...grpc.CallOption) (*DeleteUniverseLeafResponse, error)
 	// tapcli: `universe keys`
 	// AssetLeafKeys queries for the set of Universe keys associated with a given
 	// asset_id or group_key. Each key takes the form: (outpoint, script_key),
@@ -38,27 +81,73 @@ type UniverseClient interface {
 	// the asset within the Taproot Asset commitment for the given asset_id or
 	// group_key.
 	AssetLeafKeys(ctx context.Context, in *ID, opts ...grpc.CallOption) (*AssetLeafKeyResponse, error)
+	// AssetLeafKeysSince queries for the set of Universe keys that were inserted
+	// into the Universe of a given asset_id or group_key after the Universe had
+	// the given root hash. This allows a client that already synced the Universe
+	// up to that root to only fetch the new leaves. If the root hash isn't known
+	// to the server, for example because the client is on a diverged branch,
+	// then the unknown_root flag is set in the response and the client should
+	// fall back to a full sync using AssetLeafKeys.
+	AssetLeafKeysSince(ctx context.Context, in *AssetLeafKeysSinceRequest, opts ...grpc.CallOption) (*AssetLeafKeysSinceResponse, error)
 	// tapcli: `universe leaves`
 	// AssetLeaves queries for the set of asset leaves (the values in the Universe
 	// MS-SMT tree) for a given asset_id or group_key. These represents either
 	// asset issuance events (they have a genesis witness) or asset transfers that
-	// took place on chain. The leaves contain a normal Taproot Asset proof, as
-	// well as details for the asset.
-	AssetLeaves(ctx context.Context, in *ID, opts ...grpc.CallOption) (*AssetLeafResponse, error)
+	// took place on chain. Each leaf contains its leaf key and amount, and
+	// optionally a normal Taproot Asset proof, as well as details for the asset.
+	// The leaves are returned in a deterministic order, so the offset and limit
+	// can be used to page through them.
+	AssetLeaves(ctx context.Context, in *AssetLeavesRequest, opts ...grpc.CallOption) (*AssetLeafResponse, error)
 	// tapcli: `universe proofs query`
 	// QueryProof attempts to query for an issuance or transfer proof for a given
 	// asset based on its UniverseKey. A UniverseKey is composed of the Universe
 	// ID (asset_id/group_key) and also a leaf key (outpoint || script_key). If
 	// found, then the issuance proof is returned that includes an inclusion proof
 	// to the known Universe root, as well as a Taproot Asset state transition or
-	// issuance proof for the said asset.
+	// issuance proof for the said asset. The script key of the leaf key can be
+	// omitted, in which case the leaf is looked up by its outpoint only.
 	QueryProof(ctx context.Context, in *UniverseKey, opts ...grpc.CallOption) (*AssetProofResponse, error)
+	// tapcli: `universe proofs inclusion`
+	// QueryUniverseProof attempts to query for the MS-SMT inclusion proof of a
+	// given asset leaf based on its UniverseKey. The response contains all the
+	// data required to independently verify that the leaf is committed to within
+	// the returned Universe root, without needing to trust the root value
+	// reported by the server.
+	QueryUniverseProof(ctx context.Context, in *UniverseKey, opts ...grpc.CallOption) (*UniverseInclusionProof, error)
 	// tapcli: `universe proofs insert`
 	// InsertProof attempts to insert a new issuance or transfer proof into the
 	// Universe tree specified by the UniverseKey. If valid, then the proof is
 	// inserted into the database, with a new Universe root returned for the
-	// updated asset_id/group_key.
+	// updated asset_id/group_key. An Unavailable error is returned if the anchor
+	// transaction of the proof doesn't have the minimum number of confirmations
+	// configured for the server yet, in which case the insert can be retried
+	// later.
 	InsertProof(ctx context.Context, in *AssetProof, opts ...grpc.CallOption) (*AssetProofResponse, error)
+	// tapcli: `universe proofs insert-batch`
+	// InsertProofs attempts to insert a batch of new issuance or transfer proofs
+	// into the Universe trees specified by their UniverseKeys. All proofs are
+	// validated, and the valid ones are inserted even if other proofs of the
+	// batch are invalid. The root of each affected Universe tree is only updated
+	// once for the whole batch. The result of each proof is returned in the same
+	// order as the proofs of the request.
+	InsertProofs(ctx context.Context, in *InsertProofsRequest, opts ...grpc.CallOption) (*InsertProofsResponse, error)
+	// tapcli: `universe mailbox upload`
+	// UploadMailboxProof uploads a proof file to the proof courier mailbox of
+	// the server, so the receiver of the asset can download it later. The proof
+	// file is stored under the script key of the asset of its last proof and
+	// expires after the proof TTL configured on the server. Only the structure
+	// of the proof file is validated, the proofs themselves are not verified.
+	// If the server requires upload tokens, then a valid token must be passed
+	// in the authorization metadata or HTTP header as 'Bearer <token>'.
+	UploadMailboxProof(ctx context.Context, in *UploadMailboxProofRequest, opts ...grpc.CallOption) (*UploadMailboxProofResponse, error)
+	// tapcli: `universe mailbox fetch`
+	// FetchMailboxProofs returns all proof files in the proof courier mailbox
+	// of the server that were uploaded for the given script key and didn't
+	// expire yet.
+	// If the server requires fetch tokens, then a token that is valid for the
+	// script key must be passed in the authorization metadata or HTTP header as
+	// 'Bearer <token>'.
+	FetchMailboxProofs(ctx context.Context, in *FetchMailboxProofsRequest, opts ...grpc.CallOption) (*FetchMailboxProofsResponse, error)
 	// tapcli: `universe info`
 	// Info returns a set of information about the current state of the Universe.
 	Info(ctx context.Context, in *InfoRequest, opts ...grpc.CallOption) (*InfoResponse, error)
@@ -69,6 +158,80 @@ type UniverseClient interface {
 	// the latest known root for each asset, performing tree based reconciliation
 	// to arrive at a new shared root.
 	SyncUniverse(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error)
+	// tapcli: `universe sync --progress`
+	// SyncUniverseStream is a server-streaming variant of SyncUniverse that
+	// emits progress events as the sync proceeds, followed by a final event that
+	// carries the same summary that SyncUniverse returns. Dry runs aren't
+	// supported by this call.
+	SyncUniverseStream(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (Universe_SyncUniverseStreamClient, error)
+	// tapcli: `universe sync --all`
+	// SyncAll syncs the local Universe with all configured federation members
+	// concurrently. As each member only adds the leaves that are missing
+	// locally, the local Universe ends up as the union of the Universes of all
+	// members. Universes that fail to sync because they depend on leaves of
+	// another member are retried once all members were synced. The result of
+	// each member is reported individually, a failure to sync with one member
+	// doesn't abort the sync with the others.
+	SyncAll(ctx context.Context, in *SyncAllRequest, opts ...grpc.CallOption) (*SyncAllResponse, error)
+	// tapcli: `universe diff`
+	// DiffUniverse takes host information for a remote Universe server and
+	// compares its roots with the local ones. The multiverse roots are compared
+	// first, and the per-asset roots are only fetched if they differ. For every
+	// asset whose roots differ, the side that is ahead is returned. No leaves are
+	// inserted into the local Universe.
+	DiffUniverse(ctx context.Context, in *DiffUniverseRequest, opts ...grpc.CallOption) (*DiffUniverseResponse, error)
+	// tapcli: `universe verify`
+	// VerifyUniverse recomputes the MS-SMT roots of local Universe trees from
+	// their stored leaves and compares them to the persisted roots, reporting any
+	// mismatches. If repair is set, then the persisted roots of mismatching
+	// Universe trees are rewritten to match the recomputed roots.
+	VerifyUniverse(ctx context.Context, in *VerifyUniverseRequest, opts ...grpc.CallOption) (*VerifyUniverseResponse, error)
+	// tapcli: `universe compute-root`
+	// ComputeUniverseRoot computes the MS-SMT root of a Universe tree that holds
+	// exactly the given leaves, without accessing any local Universe state. This
+	// allows clients to cross-check the root claimed by a Universe server
+	// against the leaves it serves. Duplicate leaf keys are rejected.
+	ComputeUniverseRoot(ctx context.Context, in *ComputeUniverseRootRequest, opts ...grpc.CallOption) (*ComputeUniverseRootResponse, error)
+	// tapcli: `universe snapshot`
+	// ExportUniverseSnapshot creates a snapshot of the Universe of an asset as it
+	// was at the given block height. The snapshot commits to the root, sum and
+	// number of the leaves that were confirmed at or before that height, as well
+	// as to the hash of the block at that height. It is signed with the node
+	// identity key of the server, so it can serve as an attestation of the
+	// Universe state, for example for audits. The root can be recomputed from the
+	// leaves with ComputeUniverseRoot. This requires the server to be configured
+	// to sign roots.
+	ExportUniverseSnapshot(ctx context.Context, in *UniverseSnapshotRequest, opts ...grpc.CallOption) (*UniverseSnapshotResponse, error)
+	// tapcli: `universe prune`
+	// PruneUniverse removes the issuance and transfer Universe trees of all
+	// assets that match the given criteria, for example assets that had their
+	// full supply burned or that weren't updated for a number of blocks. A
+	// summary of the pruned assets is returned. Assets that don't match all of
+	// the given criteria are left untouched. If dry_run is set, then the assets
+	// that would be pruned are returned without removing them.
+	PruneUniverse(ctx context.Context, in *PruneUniverseRequest, opts ...grpc.CallOption) (*PruneUniverseResponse, error)
+	// tapcli: `universe prune spent`
+	// PruneSpentLeaves prunes the proofs of all transfer leaves that are spent
+	// by a transfer with at least the given number of confirmations. Pruned
+	// leaves keep their hash and sum, so the Universe and multiverse roots don't
+	// change and can still be verified, and the unspent outputs of an asset are
+	// never pruned. The proofs of pruned leaves are gone for good though, so they
+	// can no longer be served to syncers or clients that request the full
+	// transfer history of an asset. If dry_run is set, then the leaves that would
+	// be pruned are returned without pruning them.
+	PruneSpentLeaves(ctx context.Context, in *PruneSpentLeavesRequest, opts ...grpc.CallOption) (*PruneSpentLeavesResponse, error)
+	// tapcli: `universe prune list`
+	// ListPrunedLeaves returns the keys of all leaves of the given Universe that
+	// were pruned. The proofs of these leaves can no longer be fetched.
+	ListPrunedLeaves(ctx context.Context, in *ListPrunedLeavesRequest, opts ...grpc.CallOption) (*ListPrunedLeavesResponse, error)
+	// tapcli: `universe compact`
+	// CompactUniverseDB compacts the Universe store of the database, reclaiming
+	// the space of dead rows and rebuilding its indexes using the compaction
+	// mechanism of the database backend. The compaction runs online, though
+	// mutating operations might be blocked while the database file is rebuilt
+	// on SQLite. The size of the database before and after the compaction is
+	// returned.
+	CompactUniverseDB(ctx context.Context, in *CompactUniverseDBRequest, opts ...grpc.CallOption) (*CompactUniverseDBResponse, error)
 	// tapcli: `universe federation list`
 	// ListFederationServers lists the set of servers that make up the federation
 	// of the local Universe server. This servers are used to push out new proofs,
@@ -77,16 +240,39 @@ type UniverseClient interface {
 	// tapcli: `universe federation add`
 	// AddFederationServer adds a new server to the federation of the local
 	// Universe server. Once a server is added, this call can also optionally be
-	// used to trigger a sync of the remote server.
+	// used to trigger a sync of the remote server. If push_on_register is set,
+	// the full local Universe state is pushed to the new server after the sync
+	// completes, and any leaves the server rejected are returned.
 	AddFederationServer(ctx context.Context, in *AddFederationServerRequest, opts ...grpc.CallOption) (*AddFederationServerResponse, error)
 	// tapcli: `universe federation del`
 	// DeleteFederationServer removes a server from the federation of the local
 	// Universe server.
 	DeleteFederationServer(ctx context.Context, in *DeleteFederationServerRequest, opts ...grpc.CallOption) (*DeleteFederationServerResponse, error)
+	// tapcli: `universe federation set-priority`
+	// SetFederationServerPriority updates the priority of a server of the
+	// federation of the local Universe server, without having to remove and
+	// re-add the server.
+	SetFederationServerPriority(ctx context.Context, in *SetFederationServerPriorityRequest, opts ...grpc.CallOption) (*SetFederationServerPriorityResponse, error)
+	// tapcli: `universe federation check`
+	// CheckFederationServer probes a server of the federation of the local
+	// Universe server on demand. The server is dialed and its universe roots are
+	// compared to the local roots, and the resulting connectivity and sync status
+	// is returned.
+	CheckFederationServer(ctx context.Context, in *CheckFederationServerRequest, opts ...grpc.CallOption) (*CheckFederationServerResponse, error)
+	// tapcli: `universe federation roots`
+	// ListFederationRoots fetches the universe roots of all servers of the
+	// federation of the local Universe server in a single call. The servers are
+	// queried concurrently, and each server has to return its roots within a
+	// timeout, so a single slow server doesn't stall the whole call. The roots
+	// of each server, or the error that prevented them from being fetched, are
+	// returned keyed by the host of the server.
+	ListFederationRoots(ctx context.Context, in *ListFederationRootsRequest, opts ...grpc.CallOption) (*ListFederationRootsResponse, error)
 	// tapcli: `universe stats`
 	// UniverseStats returns a set of aggregate statistics for the current state
 	// of the Universe. Stats returned include: total number of syncs, total
-	// number of proofs, and total number of known assets.
+	// number of proofs, total number of known assets, total number of leaves and
+	// total number of leaves pushed to federation members. Optionally, a per
+	// asset breakdown of the stats can be included.
 	UniverseStats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
 	// tapcli `universe stats assets`
 	// QueryAssetStats returns a set of statistics for a given set of assets.
@@ -94,6 +280,21 @@ type UniverseClient interface {
 	// asset type. Pagination is supported via the offset and limit params.
 	// Results can also be sorted based on any of the main query params.
 	QueryAssetStats(ctx context.Context, in *AssetStatsQuery, opts ...grpc.CallOption) (*UniverseAssetStats, error)
+	// tapcli: `universe supply`
+	// QueryAssetSupply returns the total supply committed across all issuances
+	// of an asset group minus the burned supply, along with the number of
+	// issuance events. The issued supply is the sum of all issuance leaves of
+	// the group's issuance universe, which matches the sum committed to by the
+	// universe root. The burned supply is the sum of the group's burn tree. A
+	// zero supply is returned for an unknown group.
+	QueryAssetSupply(ctx context.Context, in *QueryAssetSupplyRequest, opts ...grpc.CallOption) (*QueryAssetSupplyResponse, error)
+	// tapcli: `universe utxos`
+	// QueryAssetUtxos returns the current set of unspent outputs of an asset,
+	// along with the total amount they hold. The set is derived from the
+	// issuance and transfer leaves of the asset's Universes, by excluding all
+	// outputs that are spent by a transfer leaf. The set is only complete if
+	// the Universe knows about the full transfer history of the asset.
+	QueryAssetUtxos(ctx context.Context, in *QueryAssetUtxosRequest, opts ...grpc.CallOption) (*QueryAssetUtxosResponse, error)
 	// tapcli `universe stats events`
 	// QueryEvents returns the number of sync and proof events for a given time
 	// period, grouped by day.
@@ -104,6 +305,55 @@ type UniverseClient interface {
 	// QueryFederationSyncConfig queries the universe federation sync configuration
 	// settings.
 	QueryFederationSyncConfig(ctx context.Context, in *QueryFederationSyncConfigRequest, opts ...grpc.CallOption) (*QueryFederationSyncConfigResponse, error)
+	// tapcli: `universe policy set`
+	// SetAssetPolicy replaces the policy that determines which assets the
+	// Universe server accepts proofs for from remote parties, either through a
+	// federation push or InsertProof. The new policy takes effect immediately
+	// but isn't persisted, so it's reset to the configured policy on restart.
+	SetAssetPolicy(ctx context.Context, in *SetAssetPolicyRequest, opts ...grpc.CallOption) (*SetAssetPolicyResponse, error)
+	// tapcli: `universe policy get`
+	// QueryAssetPolicy queries the current policy that determines which assets
+	// the Universe server accepts proofs for from remote parties.
+	QueryAssetPolicy(ctx context.Context, in *QueryAssetPolicyRequest, opts ...grpc.CallOption) (*QueryAssetPolicyResponse, error)
+	// tapcli: `universe policy setpush`
+	// SetAcceptPushFrom replaces the list of remote hosts the Universe server
+	// accepts pushed proofs from, through InsertProof or InsertProofs. If the
+	// list is empty, then pushes from all hosts are accepted. The list is
+	// independent of the federation servers that are synced from. The new list
+	// takes effect immediately but isn't persisted, so it's reset to the
+	// configured list on restart.
+	SetAcceptPushFrom(ctx context.Context, in *SetAcceptPushFromRequest, opts ...grpc.CallOption) (*SetAcceptPushFromResponse, error)
+	// tapcli: `universe policy getpush`
+	// QueryAcceptPushFrom queries the current list of remote hosts the Universe
+	// server accepts pushed proofs from.
+	QueryAcceptPushFrom(ctx context.Context, in *QueryAcceptPushFromRequest, opts ...grpc.CallOption) (*QueryAcceptPushFromResponse, error)
+	// SubscribeUniverseUpdates subscribes to new leaves being inserted into any of
+	// the local Universe trees, either as a result of local minting or of a
+	// federation push or sync. Each event carries the updated root, the new leaf
+	// and a monotonically increasing index that can be used to resume the
+	// subscription after a disconnect. Leaves that are removed again because
+	// their anchor transaction was re-organized out of the chain are reported
+	// as removal events.
+	SubscribeUniverseUpdates(ctx context.Context, in *SubscribeUniverseUpdatesRequest, opts ...grpc.CallOption) (Universe_SubscribeUniverseUpdatesClient, error)
+	// SubscribeFederationEvents subscribes to changes of the federation of the
+	// local Universe server. The first event carries the full set of federation
+	// servers at the time the subscription is started. It is followed by an
+	// event for each server that is added to or removed from the federation, and
+	// for each change of the reachability, sync or stale status of a server.
+	SubscribeFederationEvents(ctx context.Context, in *SubscribeFederationEventsRequest, opts ...grpc.CallOption) (Universe_SubscribeFederationEventsClient, error)
+	// tapcli: `universe archive export`
+	// ExportUniverse exports the local Universe trees into a portable archive
+	// that contains the roots, leaves and proofs of each exported universe. The
+	// serialized archive is streamed back in chunks that need to be concatenated
+	// in order.
+	ExportUniverse(ctx context.Context, in *ExportUniverseRequest, opts ...grpc.CallOption) (Universe_ExportUniverseClient, error)
+	// tapcli: `universe archive import`
+	// ImportUniverse imports a Universe archive that was created by
+	// ExportUniverse. The serialized archive is streamed in chunks. The archived
+	// trees are checked against their roots and each leaf's proof is verified
+	// before it is inserted. Leaves that are already known locally are skipped,
+	// so importing an archive merges it with the local Universe state.
+	ImportUniverse(ctx context.Context, opts ...grpc.CallOption) (Universe_ImportUniverseClient, error)
 }
 
 type universeClient struct {
@@ -132,6 +382,42 @@ func (c *universeClient) QueryAssetRoots(ctx context.Context, in *AssetRootQuery
 	return out, nil
 }
 
+func (c *universeClient) QueryAssetRootsBatch(ctx context.Context, in *QueryAssetRootsBatchRequest, opts ...grpc.CallOption) (*QueryAssetRootsBatchResponse, error) {
+	out := new(QueryAssetRootsBatchResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/QueryAssetRootsBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) SearchAssets(ctx context.Context, in *SearchAssetsRequest, opts ...grpc.CallOption) (*SearchAssetsResponse, error) {
+	out := new(SearchAssetsResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/SearchAssets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) QueryAssetGenesis(ctx context.Context, in *QueryAssetGenesisRequest, opts ...grpc.CallOption) (*QueryAssetGenesisResponse, error) {
+	out := new(QueryAssetGenesisResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/QueryAssetGenesis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) MultiverseRoot(ctx context.Context, in *MultiverseRootRequest, opts ...grpc.CallOption) (*MultiverseRootResponse, error) {
+	out := new(MultiverseRootResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/MultiverseRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) DeleteAssetRoot(ctx context.Context, in *DeleteRootQuery, opts ...grpc.CallOption) (*DeleteRootResponse, error) {
 	out := new(DeleteRootResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/DeleteAssetRoot", in, out, opts...)
@@ -141,6 +427,15 @@ func (c *universeClient) DeleteAssetRoot(ctx context.Context, in *DeleteRootQuer
 	return out, nil
 }
 
+func (c *universeClient) DeleteUniverseLeaf(ctx context.Context, in *DeleteUniverseLeafRequest, opts ...grpc.CallOption) (*DeleteUniverseLeafResponse, error) {
+	out := new(DeleteUniverseLeafResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/DeleteUniverseLeaf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) AssetLeafKeys(ctx context.Context, in *ID, opts ...grpc.CallOption) (*AssetLeafKeyResponse, error) {
 	out := new(AssetLeafKeyResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/AssetLeafKeys", in, out, opts...)
@@ -150,7 +445,16 @@ func (c *universeClient) AssetLeafKeys(ctx context.Context, in *ID, opts ...grpc
 	return out, nil
 }
 
-func (c *universeClient) AssetLeaves(ctx context.Context, in *ID, opts ...grpc.CallOption) (*AssetLeafResponse, error) {
+func (c *universeClient) AssetLeafKeysSince(ctx context.Context, in *AssetLeafKeysSinceRequest, opts ...grpc.CallOption) (*AssetLeafKeysSinceResponse, error) {
+	out := new(AssetLeafKeysSinceResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/AssetLeafKeysSince", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) AssetLeaves(ctx context.Context, in *AssetLeavesRequest, opts ...grpc.CallOption) (*AssetLeafResponse, error) {
 	out := new(AssetLeafResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/AssetLeaves", in, out, opts...)
 	if err != nil {
@@ -168,6 +472,15 @@ func (c *universeClient) QueryProof(ctx context.Context, in *UniverseKey, opts .
 	return out, nil
 }
 
+func (c *universeClient) QueryUniverseProof(ctx context.Context, in *UniverseKey, opts ...grpc.CallOption) (*UniverseInclusionProof, error) {
+	out := new(UniverseInclusionProof)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/QueryUniverseProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) InsertProof(ctx context.Context, in *AssetProof, opts ...grpc.CallOption) (*AssetProofResponse, error) {
 	out := new(AssetProofResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/InsertProof", in, out, opts...)
@@ -177,6 +490,33 @@ func (c *universeClient) InsertProof(ctx context.Context, in *AssetProof, opts .
 	return out, nil
 }
 
+func (c *universeClient) InsertProofs(ctx context.Context, in *InsertProofsRequest, opts ...grpc.CallOption) (*InsertProofsResponse, error) {
+	out := new(InsertProofsResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/InsertProofs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) UploadMailboxProof(ctx context.Context, in *UploadMailboxProofRequest, opts ...grpc.CallOption) (*UploadMailboxProofResponse, error) {
+	out := new(UploadMailboxProofResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/UploadMailboxProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) FetchMailboxProofs(ctx context.Context, in *FetchMailboxProofsRequest, opts ...grpc.CallOption) (*FetchMailboxProofsResponse, error) {
+	out := new(FetchMailboxProofsResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/FetchMailboxProofs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) Info(ctx context.Context, in *InfoRequest, opts ...grpc.CallOption) (*InfoResponse, error) {
 	out := new(InfoResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/Info", in, out, opts...)
@@ -195,6 +535,119 @@ func (c *universeClient) SyncUniverse(ctx context.Context, in *SyncRequest, opts
 	return out, nil
 }
 
+func (c *universeClient) SyncUniverseStream(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (Universe_SyncUniverseStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Universe_ServiceDesc.Streams[0], "/universerpc.Universe/SyncUniverseStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &universeSyncUniverseStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Universe_SyncUniverseStreamClient interface {
+	Recv() (*SyncProgressEvent, error)
+	grpc.ClientStream
+}
+
+type universeSyncUniverseStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *universeSyncUniverseStreamClient) Recv() (*SyncProgressEvent, error) {
+	m := new(SyncProgressEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *universeClient) SyncAll(ctx context.Context, in *SyncAllRequest, opts ...grpc.CallOption) (*SyncAllResponse, error) {
+	out := new(SyncAllResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/SyncAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) DiffUniverse(ctx context.Context, in *DiffUniverseRequest, opts ...grpc.CallOption) (*DiffUniverseResponse, error) {
+	out := new(DiffUniverseResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/DiffUniverse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) VerifyUniverse(ctx context.Context, in *VerifyUniverseRequest, opts ...grpc.CallOption) (*VerifyUniverseResponse, error) {
+	out := new(VerifyUniverseResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/VerifyUniverse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) ComputeUniverseRoot(ctx context.Context, in *ComputeUniverseRootRequest, opts ...grpc.CallOption) (*ComputeUniverseRootResponse, error) {
+	out := new(ComputeUniverseRootResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/ComputeUniverseRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) ExportUniverseSnapshot(ctx context.Context, in *UniverseSnapshotRequest, opts ...grpc.CallOption) (*UniverseSnapshotResponse, error) {
+	out := new(UniverseSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/ExportUniverseSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) PruneUniverse(ctx context.Context, in *PruneUniverseRequest, opts ...grpc.CallOption) (*PruneUniverseResponse, error) {
+	out := new(PruneUniverseResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/PruneUniverse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) PruneSpentLeaves(ctx context.Context, in *PruneSpentLeavesRequest, opts ...grpc.CallOption) (*PruneSpentLeavesResponse, error) {
+	out := new(PruneSpentLeavesResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/PruneSpentLeaves", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) ListPrunedLeaves(ctx context.Context, in *ListPrunedLeavesRequest, opts ...grpc.CallOption) (*ListPrunedLeavesResponse, error) {
+	out := new(ListPrunedLeavesResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/ListPrunedLeaves", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) CompactUniverseDB(ctx context.Context, in *CompactUniverseDBRequest, opts ...grpc.CallOption) (*CompactUniverseDBResponse, error) {
+	out := new(CompactUniverseDBResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/CompactUniverseDB", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) ListFederationServers(ctx context.Context, in *ListFederationServersRequest, opts ...grpc.CallOption) (*ListFederationServersResponse, error) {
 	out := new(ListFederationServersResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/ListFederationServers", in, out, opts...)
@@ -222,6 +675,33 @@ func (c *universeClient) DeleteFederationServer(ctx context.Context, in *DeleteF
 	return out, nil
 }
 
+func (c *universeClient) SetFederationServerPriority(ctx context.Context, in *SetFederationServerPriorityRequest, opts ...grpc.CallOption) (*SetFederationServerPriorityResponse, error) {
+	out := new(SetFederationServerPriorityResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/SetFederationServerPriority", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) CheckFederationServer(ctx context.Context, in *CheckFederationServerRequest, opts ...grpc.CallOption) (*CheckFederationServerResponse, error) {
+	out := new(CheckFederationServerResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/CheckFederationServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) ListFederationRoots(ctx context.Context, in *ListFederationRootsRequest, opts ...grpc.CallOption) (*ListFederationRootsResponse, error) {
+	out := new(ListFederationRootsResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/ListFederationRoots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) UniverseStats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
 	out := new(StatsResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/UniverseStats", in, out, opts...)
@@ -240,6 +720,24 @@ func (c *universeClient) QueryAssetStats(ctx context.Context, in *AssetStatsQuer
 	return out, nil
 }
 
+func (c *universeClient) QueryAssetSupply(ctx context.Context, in *QueryAssetSupplyRequest, opts ...grpc.CallOption) (*QueryAssetSupplyResponse, error) {
+	out := new(QueryAssetSupplyResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/QueryAssetSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) QueryAssetUtxos(ctx context.Context, in *QueryAssetUtxosRequest, opts ...grpc.CallOption) (*QueryAssetUtxosResponse, error) {
+	out := new(QueryAssetUtxosResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/QueryAssetUtxos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) QueryEvents(ctx context.Context, in *QueryEventsRequest, opts ...grpc.CallOption) (*QueryEventsResponse, error) {
 	out := new(QueryEventsResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/QueryEvents", in, out, opts...)
@@ -267,6 +765,172 @@ func (c *universeClient) QueryFederationSyncConfig(ctx context.Context, in *Quer
 	return out, nil
 }
 
+func (c *universeClient) SetAssetPolicy(ctx context.Context, in *SetAssetPolicyRequest, opts ...grpc.CallOption) (*SetAssetPolicyResponse, error) {
+	out := new(SetAssetPolicyResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/SetAssetPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) QueryAssetPolicy(ctx context.Context, in *QueryAssetPolicyRequest, opts ...grpc.CallOption) (*QueryAssetPolicyResponse, error) {
+	out := new(QueryAssetPolicyResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/QueryAssetPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) SetAcceptPushFrom(ctx context.Context, in *SetAcceptPushFromRequest, opts ...grpc.CallOption) (*SetAcceptPushFromResponse, error) {
+	out := new(SetAcceptPushFromResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/SetAcceptPushFrom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) QueryAcceptPushFrom(ctx context.Context, in *QueryAcceptPushFromRequest, opts ...grpc.CallOption) (*QueryAcceptPushFromResponse, error) {
+	out := new(QueryAcceptPushFromResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/QueryAcceptPushFrom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) SubscribeUniverseUpdates(ctx context.Context, in *SubscribeUniverseUpdatesRequest, opts ...grpc.CallOption) (Universe_SubscribeUniverseUpdatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Universe_ServiceDesc.Streams[1], "/universerpc.Universe/SubscribeUniverseUpdates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &universeSubscribeUniverseUpdatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Universe_SubscribeUniverseUpdatesClient interface {
+	Recv() (*UniverseUpdateEvent, error)
+	grpc.ClientStream
+}
+
+type universeSubscribeUniverseUpdatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *universeSubscribeUniverseUpdatesClient) Recv() (*UniverseUpdateEvent, error) {
+	m := new(UniverseUpdateEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *universeClient) SubscribeFederationEvents(ctx context.Context, in *SubscribeFederationEventsRequest, opts ...grpc.CallOption) (Universe_SubscribeFederationEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Universe_ServiceDesc.Streams[2], "/universerpc.Universe/SubscribeFederationEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &universeSubscribeFederationEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Universe_SubscribeFederationEventsClient interface {
+	Recv() (*FederationEvent, error)
+	grpc.ClientStream
+}
+
+type universeSubscribeFederationEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *universeSubscribeFederationEventsClient) Recv() (*FederationEvent, error) {
+	m := new(FederationEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *universeClient) ExportUniverse(ctx context.Context, in *ExportUniverseRequest, opts ...grpc.CallOption) (Universe_ExportUniverseClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Universe_ServiceDesc.Streams[3], "/universerpc.Universe/ExportUniverse", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &universeExportUniverseClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Universe_ExportUniverseClient interface {
+	Recv() (*UniverseArchiveChunk, error)
+	grpc.ClientStream
+}
+
+type universeExportUniverseClient struct {
+	grpc.ClientStream
+}
+
+func (x *universeExportUniverseClient) Recv() (*UniverseArchiveChunk, error) {
+	m := new(UniverseArchiveChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *universeClient) ImportUniverse(ctx context.Context, opts ...grpc.CallOption) (Universe_ImportUniverseClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Universe_ServiceDesc.Streams[4], "/universerpc.Universe/ImportUniverse", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &universeImportUniverseClient{stream}
+	return x, nil
+}
+
+type Universe_ImportUniverseClient interface {
+	Send(*UniverseArchiveChunk) error
+	CloseAndRecv() (*ImportUniverseResponse, error)
+	grpc.ClientStream
+}
+
+type universeImportUniverseClient struct {
+	grpc.ClientStream
+}
+
+func (x *universeImportUniverseClient) Send(m *UniverseArchiveChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *universeImportUniverseClient) CloseAndRecv() (*ImportUniverseResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportUniverseResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // UniverseServer is the server API for Universe service.
 // All implementations must embed UnimplementedUniverseServer
 // for forward compatibility
@@ -274,15 +938,58 @@ type UniverseServer interface {
 	// tapcli: `universe roots`
 	// AssetRoots queries for the known Universe roots associated with each known
 	// asset. These roots represent the supply/audit state for each known asset.
+	// All roots can also be exported as CSV or JSON through the REST endpoint
+	// GET /v1/taproot-assets/universe/roots/export?format=csv|json, which pages
+	// through this call and streams the result. If the server is configured to
+	// sign asset roots, then each root carries a signature by the node identity
+	// key of the server.
 	AssetRoots(context.Context, *AssetRootRequest) (*AssetRootResponse, error)
 	// tapcli: `universe roots`
 	// QueryAssetRoots attempts to locate the current Universe root for a specific
-	// asset. This asset can be identified by its asset ID or group key.
+	// asset. This asset can be identified by its asset ID or group key. If a
+	// block height is specified, then the historical roots as they were at that
+	// height are returned instead.
 	QueryAssetRoots(context.Context, *AssetRootQuery) (*QueryRootResponse, error)
+	// tapcli: `universe roots-batch`
+	// QueryAssetRootsBatch attempts to locate the current Universe roots for a
+	// list of assets in a single call. The results are returned in the same
+	// order as the given IDs, and each result indicates whether any root was
+	// found for its asset. If a block height is specified, then the historical
+	// roots as they were at that height are returned instead.
+	QueryAssetRootsBatch(context.Context, *QueryAssetRootsBatchRequest) (*QueryAssetRootsBatchResponse, error)
+	// tapcli: `universe search`
+	// SearchAssets returns the known Universe roots of all assets with a name
+	// (genesis tag) that contains the given string. The name is matched
+	// case-insensitively and the roots are sorted by name, so the offset and
+	// limit can be used to page through the results.
+	SearchAssets(context.Context, *SearchAssetsRequest) (*SearchAssetsResponse, error)
+	// tapcli: `universe genesis`
+	// QueryAssetGenesis returns the genesis information of an asset, as recorded
+	// in the issuance leaves of its Universe. The asset can be identified by its
+	// asset ID or group key. For a group key, the genesis information of each
+	// asset known to exist within the group is returned. A NotFound error is
+	// returned if the Universe has no issuance leaf for the given ID.
+	QueryAssetGenesis(context.Context, *QueryAssetGenesisRequest) (*QueryAssetGenesisResponse, error)
+	// tapcli: `universe multiverse`
+	// MultiverseRoot returns the root of the multiverse tree for the given proof
+	// type. The multiverse tree is an MS-SMT that commits to all the Universe
+	// roots known to the server, keyed by their Universe ID. Two servers with
+	// the same Universe state produce the same multiverse root, which allows
+	// their state to be compared without fetching each Universe root. If the
+	// server is configured to sign roots, then the root is signed with the node
+	// identity key of the server, which allows clients to verify its
+	// authenticity.
+	MultiverseRoot(context.Context, *MultiverseRootRequest) (*MultiverseRootResponse, error)
 	// tapcli: `universe delete`
 	// DeleteAssetRoot deletes the Universe root for a specific asset, including
 	// all asoociated universe keys, leaves, and events.
 	DeleteAssetRoot(context.Context, *DeleteRootQuery) (*DeleteRootResponse, error)
+	// tapcli: `universe proofs delete`
+	// DeleteUniverseLeaf deletes a single leaf from a local Universe tree based
+	// on its UniverseKey. The Universe root is recomputed and persisted, and the
+	// new root is returned. If the leaf doesn't exist, then this is a no-op and
+	// the current root is returned.
+	DeleteUniverseLeaf(context.Context, *DeleteUniverseLeafRequest) (*DeleteUniverseLeafResponse, error)
 	// tapcli: `universe keys`
 	// AssetLeafKeys queries for the set of Universe keys associated with a given
 	// asset_id or group_key. Each key takes the form: (outpoint, script_key),
@@ -291,27 +998,73 @@ type UniverseServer interface {
 	// the asset within the Taproot Asset commitment for the given asset_id or
 	// group_key.
 	AssetLeafKeys(context.Context, *ID) (*AssetLeafKeyResponse, error)
+	// AssetLeafKeysSince queries for the set of Universe keys that were inserted
+	// into the Universe of a given asset_id or group_key after the Universe had
+	// the given root hash. This allows a client that already synced the Universe
+	// up to that root to only fetch the new leaves. If the root hash isn't known
+	// to the server, for example because the client is on a diverged branch,
+	// then the unknown_root flag is set in the response and the client should
+	// fall back to a full sync using AssetLeafKeys.
+	AssetLeafKeysSince(context.Context, *AssetLeafKeysSinceRequest) (*AssetLeafKeysSinceResponse, error)
 	// tapcli: `universe leaves`
 	// AssetLeaves queries for the set of asset leaves (the values in the Universe
 	// MS-SMT tree) for a given asset_id or group_key. These represents either
 	// asset issuance events (they have a genesis witness) or asset transfers that
-	// took place on chain. The leaves contain a normal Taproot Asset proof, as
-	// well as details for the asset.
-	AssetLeaves(context.Context, *ID) (*AssetLeafResponse, error)
+	// took place on chain. Each leaf contains its leaf key and amount, and
+	// optionally a normal Taproot Asset proof, as well as details for the asset.
+	// The leaves are returned in a deterministic order, so the offset and limit
+	// can be used to page through them.
+	AssetLeaves(context.Context, *AssetLeavesRequest) (*AssetLeafResponse, error)
 	// tapcli: `universe proofs query`
 	// QueryProof attempts to query for an issuance or transfer proof for a given
 	// asset based on its UniverseKey. A UniverseKey is composed of the Universe
 	// ID (asset_id/group_key) and also a leaf key (outpoint || script_key). If
 	// found, then the issuance proof is returned that includes an inclusion proof
 	// to the known Universe root, as well as a Taproot Asset state transition or
-	// issuance proof for the said asset.
+	// issuance proof for the said asset. The script key of the leaf key can be
+	// omitted, in which case the leaf is looked up by its outpoint only.
 	QueryProof(context.Context, *UniverseKey) (*AssetProofResponse, error)
+	// tapcli: `universe proofs inclusion`
+	// QueryUniverseProof attempts to query for the MS-SMT inclusion proof of a
+	// given asset leaf based on its UniverseKey. The response contains all the
+	// data required to independently verify that the leaf is committed to within
+	// the returned Universe root, without needing to trust the root value
+	// reported by the server.
+	QueryUniverseProof(context.Context, *UniverseKey) (*UniverseInclusionProof, error)
 	// tapcli: `universe proofs insert`
 	// InsertProof attempts to insert a new issuance or transfer proof into the
 	// Universe tree specified by the UniverseKey. If valid, then the proof is
 	// inserted into the database, with a new Universe root returned for the
-	// updated asset_id/group_key.
+	// updated asset_id/group_key. An Unavailable error is returned if the anchor
+	// transaction of the proof doesn't have the minimum number of confirmations
+	// configured for the server yet, in which case the insert can be retried
+	// later.
 	InsertProof(context.Context, *AssetProof) (*AssetProofResponse, error)
+	// tapcli: `universe proofs insert-batch`
+	// InsertProofs attempts to insert a batch of new issuance or transfer proofs
+	// into the Universe trees specified by their UniverseKeys. All proofs are
+	// validated, and the valid ones are inserted even if other proofs of the
+	// batch are invalid. The root of each affected Universe tree is only updated
+	// once for the whole batch. The result of each proof is returned in the same
+	// order as the proofs of the request.
+	InsertProofs(context.Context, *InsertProofsRequest) (*InsertProofsResponse, error)
+	// tapcli: `universe mailbox upload`
+	// UploadMailboxProof uploads a proof file to the proof courier mailbox of
+	// the server, so the receiver of the asset can download it later. The proof
+	// file is stored under the script key of the asset of its last proof and
+	// expires after the proof TTL configured on the server. Only the structure
+	// of the proof file is validated, the proofs themselves are not verified.
+	// If the server requires upload tokens, then a valid token must be passed
+	// in the authorization metadata or HTTP header as 'Bearer <token>'.
+	UploadMailboxProof(context.Context, *UploadMailboxProofRequest) (*UploadMailboxProofResponse, error)
+	// tapcli: `universe mailbox fetch`
+	// FetchMailboxProofs returns all proof files in the proof courier mailbox
+	// of the server that were uploaded for the given script key and didn't
+	// expire yet.
+	// If the server requires fetch tokens, then a token that is valid for the
+	// script key must be passed in the authorization metadata or HTTP header as
+	// 'Bearer <token>'.
+	FetchMailboxProofs(context.Context, *FetchMailboxProofsRequest) (*FetchMailboxProofsResponse, error)
 	// tapcli: `universe info`
 	// Info returns a set of information about the current state of the Universe.
 	Info(context.Context, *InfoRequest) (*InfoResponse, error)
@@ -322,6 +1075,80 @@ type UniverseServer interface {
 	// the latest known root for each asset, performing tree based reconciliation
 	// to arrive at a new shared root.
 	SyncUniverse(context.Context, *SyncRequest) (*SyncResponse, error)
+	// tapcli: `universe sync --progress`
+	// SyncUniverseStream is a server-streaming variant of SyncUniverse that
+	// emits progress events as the sync proceeds, followed by a final event that
+	// carries the same summary that SyncUniverse returns. Dry runs aren't
+	// supported by this call.
+	SyncUniverseStream(*SyncRequest, Universe_SyncUniverseStreamServer) error
+	// tapcli: `universe sync --all`
+	// SyncAll syncs the local Universe with all configured federation members
+	// concurrently. As each member only adds the leaves that are missing
+	// locally, the local Universe ends up as the union of the Universes of all
+	// members. Universes that fail to sync because they depend on leaves of
+	// another member are retried once all members were synced. The result of
+	// each member is reported individually, a failure to sync with one member
+	// doesn't abort the sync with the others.
+	SyncAll(context.Context, *SyncAllRequest) (*SyncAllResponse, error)
+	// tapcli: `universe diff`
+	// DiffUniverse takes host information for a remote Universe server and
+	// compares its roots with the local ones. The multiverse roots are compared
+	// first, and the per-asset roots are only fetched if they differ. For every
+	// asset whose roots differ, the side that is ahead is returned. No leaves are
+	// inserted into the local Universe.
+	DiffUniverse(context.Context, *DiffUniverseRequest) (*DiffUniverseResponse, error)
+	// tapcli: `universe verify`
+	// VerifyUniverse recomputes the MS-SMT roots of local Universe trees from
+	// their stored leaves and compares them to the persisted roots, reporting any
+	// mismatches. If repair is set, then the persisted roots of mismatching
+	// Universe trees are rewritten to match the recomputed roots.
+	VerifyUniverse(context.Context, *VerifyUniverseRequest) (*VerifyUniverseResponse, error)
+	// tapcli: `universe compute-root`
+	// ComputeUniverseRoot computes the MS-SMT root of a Universe tree that holds
+	// exactly the given leaves, without accessing any local Universe state. This
+	// allows clients to cross-check the root claimed by a Universe server
+	// against the leaves it serves. Duplicate leaf keys are rejected.
+	ComputeUniverseRoot(context.Context, *ComputeUniverseRootRequest) (*ComputeUniverseRootResponse, error)
+	// tapcli: `universe snapshot`
+	// ExportUniverseSnapshot creates a snapshot of the Universe of an asset as it
+	// was at the given block height. The snapshot commits to the root, sum and
+	// number of the leaves that were confirmed at or before that height, as well
+	// as to the hash of the block at that height. It is signed with the node
+	// identity key of the server, so it can serve as an attestation of the
+	// Universe state, for example for audits. The root can be recomputed from the
+	// leaves with ComputeUniverseRoot. This requires the server to be configured
+	// to sign roots.
+	ExportUniverseSnapshot(context.Context, *UniverseSnapshotRequest) (*UniverseSnapshotResponse, error)
+	// tapcli: `universe prune`
+	// PruneUniverse removes the issuance and transfer Universe trees of all
+	// assets that match the given criteria, for example assets that had their
+	// full supply burned or that weren't updated for a number of blocks. A
+	// summary of the pruned assets is returned. Assets that don't match all of
+	// the given criteria are left untouched. If dry_run is set, then the assets
+	// that would be pruned are returned without removing them.
+	PruneUniverse(context.Context, *PruneUniverseRequest) (*PruneUniverseResponse, error)
+	// tapcli: `universe prune spent`
+	// PruneSpentLeaves prunes the proofs of all transfer leaves that are spent
+	// by a transfer with at least the given number of confirmations. Pruned
+	// leaves keep their hash and sum, so the Universe and multiverse roots don't
+	// change and can still be verified, and the unspent outputs of an asset are
+	// never pruned. The proofs of pruned leaves are gone for good though, so they
+	// can no longer be served to syncers or clients that request the full
+	// transfer history of an asset. If dry_run is set, then the leaves that would
+	// be pruned are returned without pruning them.
+	PruneSpentLeaves(context.Context, *PruneSpentLeavesRequest) (*PruneSpentLeavesResponse, error)
+	// tapcli: `universe prune list`
+	// ListPrunedLeaves returns the keys of all leaves of the given Universe that
+	// were pruned. The proofs of these leaves can no longer be fetched.
+	ListPrunedLeaves(context.Context, *ListPrunedLeavesRequest) (*ListPrunedLeavesResponse, error)
+	// tapcli: `universe compact`
+	// CompactUniverseDB compacts the Universe store of the database, reclaiming
+	// the space of dead rows and rebuilding its indexes using the compaction
+	// mechanism of the database backend. The compaction runs online, though
+	// mutating operations might be blocked while the database file is rebuilt
+	// on SQLite. The size of the database before and after the compaction is
+	// returned.
+	CompactUniverseDB(context.Context, *CompactUniverseDBRequest) (*CompactUniverseDBResponse, error)
 	// tapcli: `universe federation list`
 	// ListFederationServers lists the set of servers that make up the federation
 	// of the local Universe server. This servers are used to push out new proofs,
@@ -330,16 +1157,39 @@ type UniverseServer interface {
 	// tapcli: `universe federation add`
 	// AddFederationServer adds a new server to the federation of the local
 	// Universe server. Once a server is added, this call can also optionally be
-	// used to trigger a sync of the remote server.
+	// used to trigger a sync of the remote server. If push_on_register is set,
+	// the full local Universe state is pushed to the new server after the sync
+	// completes, and any leaves the server rejected are returned.
 	AddFederationServer(context.Context, *AddFederationServerRequest) (*AddFederationServerResponse, error)
 	// tapcli: `universe federation del`
 	// DeleteFederationServer removes a server from the federation of the local
 	// Universe server.
 	DeleteFederationServer(context.Context, *DeleteFederationServerRequest) (*DeleteFederationServerResponse, error)
+	// tapcli: `universe federation set-priority`
+	// SetFederationServerPriority updates the priority of a server of the
+	// federation of the local Universe server, without having to remove and
+	// re-add the server.
+	SetFederationServerPriority(context.Context, *SetFederationServerPriorityRequest) (*SetFederationServerPriorityResponse, error)
+	// tapcli: `universe federation check`
+	// CheckFederationServer probes a server of the federation of the local
+	// Universe server on demand. The server is dialed and its universe roots are
+	// compared to the local roots, and the resulting connectivity and sync status
+	// is returned.
+	CheckFederationServer(context.Context, *CheckFederationServerRequest) (*CheckFederationServerResponse, error)
+	// tapcli: `universe federation roots`
+	// ListFederationRoots fetches the universe roots of all servers of the
+	// federation of the local Universe server in a single call. The servers are
+	// queried concurrently, and each server has to return its roots within a
+	// timeout, so a single slow server doesn't stall the whole call. The roots
+	// of each server, or the error that prevented them from being fetched, are
+	// returned keyed by the host of the server.
+	ListFederationRoots(context.Context, *ListFederationRootsRequest) (*ListFederationRootsResponse, error)
 	// tapcli: `universe stats`
 	// UniverseStats returns a set of aggregate statistics for the current state
 	// of the Universe. Stats returned include: total number of syncs, total
-	// number of proofs, and total number of known assets.
+	// number of proofs, total number of known assets, total number of leaves and
+	// total number of leaves pushed to federation members. Optionally, a per
+	// asset breakdown of the stats can be included.
 	UniverseStats(context.Context, *StatsRequest) (*StatsResponse, error)
 	// tapcli `universe stats assets`
 	// QueryAssetStats returns a set of statistics for a given set of assets.
@@ -347,6 +1197,21 @@ type UniverseServer interface {
 	// asset type. Pagination is supported via the offset and limit params.
 	// Results can also be sorted based on any of the main query params.
 	QueryAssetStats(context.Context, *AssetStatsQuery) (*UniverseAssetStats, error)
+	// tapcli: `universe supply`
+	// QueryAssetSupply returns the total supply committed across all issuances
+	// of an asset group minus the burned supply, along with the number of
+	// issuance events. The issued supply is the sum of all issuance leaves of
+	// the group's issuance universe, which matches the sum committed to by the
+	// universe root. The burned supply is the sum of the group's burn tree. A
+	// zero supply is returned for an unknown group.
+	QueryAssetSupply(context.Context, *QueryAssetSupplyRequest) (*QueryAssetSupplyResponse, error)
+	// tapcli: `universe utxos`
+	// QueryAssetUtxos returns the current set of unspent outputs of an asset,
+	// along with the total amount they hold. The set is derived from the
+	// issuance and transfer leaves of the asset's Universes, by excluding all
+	// outputs that are spent by a transfer leaf. The set is only complete if
+	// the Universe knows about the full transfer history of the asset.
+	QueryAssetUtxos(context.Context, *QueryAssetUtxosRequest) (*QueryAssetUtxosResponse, error)
 	// tapcli `universe stats events`
 	// QueryEvents returns the number of sync and proof events for a given time
 	// period, grouped by day.
@@ -357,6 +1222,55 @@ type UniverseServer interface {
 	// QueryFederationSyncConfig queries the universe federation sync configuration
 	// settings.
 	QueryFederationSyncConfig(context.Context, *QueryFederationSyncConfigRequest) (*QueryFederationSyncConfigResponse, error)
+	// tapcli: `universe policy set`
+	// SetAssetPolicy replaces the policy that determines which assets the
+	// Universe server accepts proofs for from remote parties, either through a
+	// federation push or InsertProof. The new policy takes effect immediately
+	// but isn't persisted, so it's reset to the configured policy on restart.
+	SetAssetPolicy(context.Context, *SetAssetPolicyRequest) (*SetAssetPolicyResponse, error)
+	// tapcli: `universe policy get`
+	// QueryAssetPolicy queries the current policy that determines which assets
+	// the Universe server accepts proofs for from remote parties.
+	QueryAssetPolicy(context.Context, *QueryAssetPolicyRequest) (*QueryAssetPolicyResponse, error)
+	// tapcli: `universe policy setpush`
+	// SetAcceptPushFrom replaces the list of remote hosts the Universe server
+	// accepts pushed proofs from, through InsertProof or InsertProofs. If the
+	// list is empty, then pushes from all hosts are accepted. The list is
+	// independent of the federation servers that are synced from. The new list
+	// takes effect immediately but isn't persisted, so it's reset to the
+	// configured list on restart.
+	SetAcceptPushFrom(context.Context, *SetAcceptPushFromRequest) (*SetAcceptPushFromResponse, error)
+	// tapcli: `universe policy getpush`
+	// QueryAcceptPushFrom queries the current list of remote hosts the Universe
+	// server accepts pushed proofs from.
+	QueryAcceptPushFrom(context.Context, *QueryAcceptPushFromRequest) (*QueryAcceptPushFromResponse, error)
+	// SubscribeUniverseUpdates subscribes to new leaves being inserted into any of
+	// the local Universe trees, either as a result of local minting or of a
+	// federation push or sync. Each event carries the updated root, the new leaf
+	// and a monotonically increasing index that can be used to resume the
+	// subscription after a disconnect. Leaves that are removed again because
+	// their anchor transaction was re-organized out of the chain are reported
+	// as removal events.
+	SubscribeUniverseUpdates(*SubscribeUniverseUpdatesRequest, Universe_SubscribeUniverseUpdatesServer) error
+	// SubscribeFederationEvents subscribes to changes of the federation of the
+	// local Universe server. The first event carries the full set of federation
+	// servers at the time the subscription is started. It is followed by an
+	// event for each server that is added to or removed from the federation, and
+	// for each change of the reachability, sync or stale status of a server.
+	SubscribeFederationEvents(*SubscribeFederationEventsRequest, Universe_SubscribeFederationEventsServer) error
+	// tapcli: `universe archive export`
+	// ExportUniverse exports the local Universe trees into a portable archive
+	// that contains the roots, leaves and proofs of each exported universe. The
+	// serialized archive is streamed back in chunks that need to be concatenated
+	// in order.
+	ExportUniverse(*ExportUniverseRequest, Universe_ExportUniverseServer) error
+	// tapcli: `universe archive import`
+	// ImportUniverse imports a Universe archive that was created by
+	// ExportUniverse. The serialized archive is streamed in chunks. The archived
+	// trees are checked against their roots and each leaf's proof is verified
+	// before it is inserted. Leaves that are already known locally are skipped,
+	// so importing an archive merges it with the local Universe state.
+	ImportUniverse(Universe_ImportUniverseServer) error
 	mustEmbedUnimplementedUniverseServer()
 }
 
@@ -370,27 +1284,87 @@ func (UnimplementedUniverseServer) AssetRoots(context.Context, *AssetRootRequest
 func (UnimplementedUniverseServer) QueryAssetRoots(context.Context, *AssetRootQuery) (*QueryRootResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryAssetRoots not implemented")
 }
-func (UnimplementedUniverseServer) DeleteAssetRoot(context.Context, *DeleteRootQuery) (*DeleteRootResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssetRoot not implemented")
+func (UnimplementedUniverseServer) QueryAssetRootsBatch(context.Context, *QueryAssetRootsBatchRequest) (*QueryAssetRootsBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAssetRootsBatch not implemented")
 }
-func (UnimplementedUniverseServer) AssetLeafKeys(context.Context, *ID) (*AssetLeafKeyResponse, error) {
+func (UnimplementedUniverseServer) SearchAssets(context.Context, *SearchAssetsRequest) (*SearchAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAssets not implemented")
+}
+func (UnimplementedUniverseServer) QueryAssetGenesis(context.Context, *QueryAssetGenesisRequest) (*QueryAssetGenesisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAssetGenesis not implemented")
+}
+func (UnimplementedUniverseServer) MultiverseRoot(context.Context, *MultiverseRootRequest) (*MultiverseRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiverseRoot not implemented")
+}
+func (UnimplementedUniverseServer) DeleteAssetRoot(context.Context, *DeleteRootQuery) (*DeleteRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssetRoot not implemented")
+}
+func (UnimplementedUniverseServer) DeleteUniverseLeaf(context.Context, *DeleteUniverseLeafRequest) (*DeleteUniverseLeafResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUniverseLeaf not implemented")
+}
+func (UnimplementedUniverseServer) AssetLeafKeys(context.Context, *ID) (*AssetLeafKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AssetLeafKeys not implemented")
 }
-func (UnimplementedUniverseServer) AssetLeaves(context.Context, *ID) (*AssetLeafResponse, error) {
+func (UnimplementedUniverseServer) AssetLeafKeysSince(context.Context, *AssetLeafKeysSinceRequest) (*AssetLeafKeysSinceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetLeafKeysSince not implemented")
+}
+func (UnimplementedUniverseServer) AssetLeaves(context.Context, *AssetLeavesRequest) (*AssetLeafResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AssetLeaves not implemented")
 }
 func (UnimplementedUniverseServer) QueryProof(context.Context, *UniverseKey) (*AssetProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryProof not implemented")
 }
+func (UnimplementedUniverseServer) QueryUniverseProof(context.Context, *UniverseKey) (*UniverseInclusionProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryUniverseProof not implemented")
+}
 func (UnimplementedUniverseServer) InsertProof(context.Context, *AssetProof) (*AssetProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertProof not implemented")
 }
+func (UnimplementedUniverseServer) InsertProofs(context.Context, *InsertProofsRequest) (*InsertProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertProofs not implemented")
+}
+func (UnimplementedUniverseServer) UploadMailboxProof(context.Context, *UploadMailboxProofRequest) (*UploadMailboxProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadMailboxProof not implemented")
+}
+func (UnimplementedUniverseServer) FetchMailboxProofs(context.Context, *FetchMailboxProofsRequest) (*FetchMailboxProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchMailboxProofs not implemented")
+}
 func (UnimplementedUniverseServer) Info(context.Context, *InfoRequest) (*InfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Info not implemented")
 }
 func (UnimplementedUniverseServer) SyncUniverse(context.Context, *SyncRequest) (*SyncResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncUniverse not implemented")
 }
+func (UnimplementedUniverseServer) SyncUniverseStream(*SyncRequest, Universe_SyncUniverseStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SyncUniverseStream not implemented")
+}
+func (UnimplementedUniverseServer) SyncAll(context.Context, *SyncAllRequest) (*SyncAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncAll not implemented")
+}
+func (UnimplementedUniverseServer) DiffUniverse(context.Context, *DiffUniverseRequest) (*DiffUniverseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffUniverse not implemented")
+}
+func (UnimplementedUniverseServer) VerifyUniverse(context.Context, *VerifyUniverseRequest) (*VerifyUniverseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyUniverse not implemented")
+}
+func (UnimplementedUniverseServer) ComputeUniverseRoot(context.Context, *ComputeUniverseRootRequest) (*ComputeUniverseRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComputeUniverseRoot not implemented")
+}
+func (UnimplementedUniverseServer) ExportUniverseSnapshot(context.Context, *UniverseSnapshotRequest) (*UniverseSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUniverseSnapshot not implemented")
+}
+func (UnimplementedUniverseServer) PruneUniverse(context.Context, *PruneUniverseRequest) (*PruneUniverseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneUniverse not implemented")
+}
+func (UnimplementedUniverseServer) PruneSpentLeaves(context.Context, *PruneSpentLeavesRequest) (*PruneSpentLeavesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneSpentLeaves not implemented")
+}
+func (UnimplementedUniverseServer) ListPrunedLeaves(context.Context, *ListPrunedLeavesRequest) (*ListPrunedLeavesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPrunedLeaves not implemented")
+}
+func (UnimplementedUniverseServer) CompactUniverseDB(context.Context, *CompactUniverseDBRequest) (*CompactUniverseDBResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompactUniverseDB not implemented")
+}
 func (UnimplementedUniverseServer) ListFederationServers(context.Context, *ListFederationServersRequest) (*ListFederationServersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFederationServers not implemented")
 }
@@ -400,12 +1374,27 @@ func (UnimplementedUniverseServer) AddFederationServer(context.Context, *AddFede
 func (UnimplementedUniverseServer) DeleteFederationServer(context.Context, *DeleteFederationServerRequest) (*DeleteFederationServerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteFederationServer not implemented")
 }
+func (UnimplementedUniverseServer) SetFederationServerPriority(context.Context, *SetFederationServerPriorityRequest) (*SetFederationServerPriorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFederationServerPriority not implemented")
+}
+func (UnimplementedUniverseServer) CheckFederationServer(context.Context, *CheckFederationServerRequest) (*CheckFederationServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckFederationServer not implemented")
+}
+func (UnimplementedUniverseServer) ListFederationRoots(context.Context, *ListFederationRootsRequest) (*ListFederationRootsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFederationRoots not implemented")
+}
 func (UnimplementedUniverseServer) UniverseStats(context.Context, *StatsRequest) (*StatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UniverseStats not implemented")
 }
 func (UnimplementedUniverseServer) QueryAssetStats(context.Context, *AssetStatsQuery) (*UniverseAssetStats, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryAssetStats not implemented")
 }
+func (UnimplementedUniverseServer) QueryAssetSupply(context.Context, *QueryAssetSupplyRequest) (*QueryAssetSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAssetSupply not implemented")
+}
+func (UnimplementedUniverseServer) QueryAssetUtxos(context.Context, *QueryAssetUtxosRequest) (*QueryAssetUtxosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAssetUtxos not implemented")
+}
 func (UnimplementedUniverseServer) QueryEvents(context.Context, *QueryEventsRequest) (*QueryEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryEvents not implemented")
 }
@@ -415,6 +1404,30 @@ func (UnimplementedUniverseServer) SetFederationSyncConfig(context.Context, *Set
 func (UnimplementedUniverseServer) QueryFederationSyncConfig(context.Context, *QueryFederationSyncConfigRequest) (*QueryFederationSyncConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryFederationSyncConfig not implemented")
 }
+func (UnimplementedUniverseServer) SetAssetPolicy(context.Context, *SetAssetPolicyRequest) (*SetAssetPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAssetPolicy not implemented")
+}
+func (UnimplementedUniverseServer) QueryAssetPolicy(context.Context, *QueryAssetPolicyRequest) (*QueryAssetPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAssetPolicy not implemented")
+}
+func (UnimplementedUniverseServer) SetAcceptPushFrom(context.Context, *SetAcceptPushFromRequest) (*SetAcceptPushFromResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAcceptPushFrom not implemented")
+}
+func (UnimplementedUniverseServer) QueryAcceptPushFrom(context.Context, *QueryAcceptPushFromRequest) (*QueryAcceptPushFromResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAcceptPushFrom not implemented")
+}
+func (UnimplementedUniverseServer) SubscribeUniverseUpdates(*SubscribeUniverseUpdatesRequest, Universe_SubscribeUniverseUpdatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeUniverseUpdates not implemented")
+}
+func (UnimplementedUniverseServer) SubscribeFederationEvents(*SubscribeFederationEventsRequest, Universe_SubscribeFederationEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeFederationEvents not implemented")
+}
+func (UnimplementedUniverseServer) ExportUniverse(*ExportUniverseRequest, Universe_ExportUniverseServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportUniverse not implemented")
+}
+func (UnimplementedUniverseServer) ImportUniverse(Universe_ImportUniverseServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportUniverse not implemented")
+}
 func (UnimplementedUniverseServer) mustEmbedUnimplementedUniverseServer() {}
 
 // UnsafeUniverseServer may be embedded to opt out of forward compatibility for this service.
@@ -434,212 +1447,629 @@ func _Universe_AssetRoots_Handler(srv interface{}, ctx context.Context, dec func
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UniverseServer).AssetRoots(ctx, in)
+		return srv.(UniverseServer).AssetRoots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/AssetRoots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).AssetRoots(ctx, req.(*AssetRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_QueryAssetRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetRootQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).QueryAssetRoots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/QueryAssetRoots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).QueryAssetRoots(ctx, req.(*AssetRootQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_QueryAssetRootsBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetRootsBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).QueryAssetRootsBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/QueryAssetRootsBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).QueryAssetRootsBatch(ctx, req.(*QueryAssetRootsBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_SearchAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).SearchAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/SearchAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).SearchAssets(ctx, req.(*SearchAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_QueryAssetGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetGenesisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).QueryAssetGenesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/QueryAssetGenesis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).QueryAssetGenesis(ctx, req.(*QueryAssetGenesisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_MultiverseRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiverseRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).MultiverseRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/MultiverseRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).MultiverseRoot(ctx, req.(*MultiverseRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_DeleteAssetRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRootQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).DeleteAssetRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/DeleteAssetRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).DeleteAssetRoot(ctx, req.(*DeleteRootQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_DeleteUniverseLeaf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUniverseLeafRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).DeleteUniverseLeaf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/DeleteUniverseLeaf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).DeleteUniverseLeaf(ctx, req.(*DeleteUniverseLeafRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_AssetLeafKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).AssetLeafKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/AssetLeafKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).AssetLeafKeys(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_AssetLeafKeysSince_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetLeafKeysSinceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).AssetLeafKeysSince(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/AssetLeafKeysSince",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).AssetLeafKeysSince(ctx, req.(*AssetLeafKeysSinceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_AssetLeaves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetLeavesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).AssetLeaves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/AssetLeaves",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).AssetLeaves(ctx, req.(*AssetLeavesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_QueryProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UniverseKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).QueryProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/QueryProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).QueryProof(ctx, req.(*UniverseKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_QueryUniverseProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UniverseKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).QueryUniverseProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/QueryUniverseProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).QueryUniverseProof(ctx, req.(*UniverseKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_InsertProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).InsertProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/InsertProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).InsertProof(ctx, req.(*AssetProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_InsertProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).InsertProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/InsertProofs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).InsertProofs(ctx, req.(*InsertProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_UploadMailboxProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadMailboxProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).UploadMailboxProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/UploadMailboxProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).UploadMailboxProof(ctx, req.(*UploadMailboxProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_FetchMailboxProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchMailboxProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).FetchMailboxProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/FetchMailboxProofs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).FetchMailboxProofs(ctx, req.(*FetchMailboxProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_Info_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).Info(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/Info",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).Info(ctx, req.(*InfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_SyncUniverse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).SyncUniverse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/SyncUniverse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).SyncUniverse(ctx, req.(*SyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_SyncUniverseStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SyncRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UniverseServer).SyncUniverseStream(m, &universeSyncUniverseStreamServer{stream})
+}
+
+type Universe_SyncUniverseStreamServer interface {
+	Send(*SyncProgressEvent) error
+	grpc.ServerStream
+}
+
+type universeSyncUniverseStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *universeSyncUniverseStreamServer) Send(m *SyncProgressEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Universe_SyncAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).SyncAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/SyncAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).SyncAll(ctx, req.(*SyncAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_DiffUniverse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffUniverseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).DiffUniverse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/DiffUniverse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).DiffUniverse(ctx, req.(*DiffUniverseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_VerifyUniverse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyUniverseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).VerifyUniverse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/VerifyUniverse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).VerifyUniverse(ctx, req.(*VerifyUniverseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_ComputeUniverseRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComputeUniverseRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).ComputeUniverseRoot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/universerpc.Universe/AssetRoots",
+		FullMethod: "/universerpc.Universe/ComputeUniverseRoot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UniverseServer).AssetRoots(ctx, req.(*AssetRootRequest))
+		return srv.(UniverseServer).ComputeUniverseRoot(ctx, req.(*ComputeUniverseRootRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Universe_QueryAssetRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssetRootQuery)
+func _Universe_ExportUniverseSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UniverseSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UniverseServer).QueryAssetRoots(ctx, in)
+		return srv.(UniverseServer).ExportUniverseSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/universerpc.Universe/QueryAssetRoots",
+		FullMethod: "/universerpc.Universe/ExportUniverseSnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UniverseServer).QueryAssetRoots(ctx, req.(*AssetRootQuery))
+		return srv.(UniverseServer).ExportUniverseSnapshot(ctx, req.(*UniverseSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Universe_DeleteAssetRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRootQuery)
+func _Universe_PruneUniverse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneUniverseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UniverseServer).DeleteAssetRoot(ctx, in)
+		return srv.(UniverseServer).PruneUniverse(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/universerpc.Universe/DeleteAssetRoot",
+		FullMethod: "/universerpc.Universe/PruneUniverse",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UniverseServer).DeleteAssetRoot(ctx, req.(*DeleteRootQuery))
+		return srv.(UniverseServer).PruneUniverse(ctx, req.(*PruneUniverseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Universe_AssetLeafKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ID)
+func _Universe_PruneSpentLeaves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneSpentLeavesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UniverseServer).AssetLeafKeys(ctx, in)
+		return srv.(UniverseServer).PruneSpentLeaves(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/universerpc.Universe/AssetLeafKeys",
+		FullMethod: "/universerpc.Universe/PruneSpentLeaves",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UniverseServer).AssetLeafKeys(ctx, req.(*ID))
+		return srv.(UniverseServer).PruneSpentLeaves(ctx, req.(*PruneSpentLeavesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Universe_AssetLeaves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ID)
+func _Universe_ListPrunedLeaves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPrunedLeavesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UniverseServer).AssetLeaves(ctx, in)
+		return srv.(UniverseServer).ListPrunedLeaves(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/universerpc.Universe/AssetLeaves",
+		FullMethod: "/universerpc.Universe/ListPrunedLeaves",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UniverseServer).AssetLeaves(ctx, req.(*ID))
+		return srv.(UniverseServer).ListPrunedLeaves(ctx, req.(*ListPrunedLeavesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Universe_QueryProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UniverseKey)
+func _Universe_CompactUniverseDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactUniverseDBRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UniverseServer).QueryProof(ctx, in)
+		return srv.(UniverseServer).CompactUniverseDB(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/universerpc.Universe/QueryProof",
+		FullMethod: "/universerpc.Universe/CompactUniverseDB",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UniverseServer).QueryProof(ctx, req.(*UniverseKey))
+		return srv.(UniverseServer).CompactUniverseDB(ctx, req.(*CompactUniverseDBRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Universe_InsertProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssetProof)
+func _Universe_ListFederationServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFederationServersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UniverseServer).InsertProof(ctx, in)
+		return srv.(UniverseServer).ListFederationServers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/universerpc.Universe/InsertProof",
+		FullMethod: "/universerpc.Universe/ListFederationServers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UniverseServer).InsertProof(ctx, req.(*AssetProof))
+		return srv.(UniverseServer).ListFederationServers(ctx, req.(*ListFederationServersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Universe_Info_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InfoRequest)
+func _Universe_AddFederationServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFederationServerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UniverseServer).Info(ctx, in)
+		return srv.(UniverseServer).AddFederationServer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/universerpc.Universe/Info",
+		FullMethod: "/universerpc.Universe/AddFederationServer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UniverseServer).Info(ctx, req.(*InfoRequest))
+		return srv.(UniverseServer).AddFederationServer(ctx, req.(*AddFederationServerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Universe_SyncUniverse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SyncRequest)
+func _Universe_DeleteFederationServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFederationServerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UniverseServer).SyncUniverse(ctx, in)
+		return srv.(UniverseServer).DeleteFederationServer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/universerpc.Universe/SyncUniverse",
+		FullMethod: "/universerpc.Universe/DeleteFederationServer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UniverseServer).SyncUniverse(ctx, req.(*SyncRequest))
+		return srv.(UniverseServer).DeleteFederationServer(ctx, req.(*DeleteFederationServerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Universe_ListFederationServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListFederationServersRequest)
+func _Universe_SetFederationServerPriority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFederationServerPriorityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UniverseServer).ListFederationServers(ctx, in)
+		return srv.(UniverseServer).SetFederationServerPriority(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/universerpc.Universe/ListFederationServers",
+		FullMethod: "/universerpc.Universe/SetFederationServerPriority",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UniverseServer).ListFederationServers(ctx, req.(*ListFederationServersRequest))
+		return srv.(UniverseServer).SetFederationServerPriority(ctx, req.(*SetFederationServerPriorityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Universe_AddFederationServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddFederationServerRequest)
+func _Universe_CheckFederationServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckFederationServerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UniverseServer).AddFederationServer(ctx, in)
+		return srv.(UniverseServer).CheckFederationServer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/universerpc.Universe/AddFederationServer",
+		FullMethod: "/universerpc.Universe/CheckFederationServer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UniverseServer).AddFederationServer(ctx, req.(*AddFederationServerRequest))
+		return srv.(UniverseServer).CheckFederationServer(ctx, req.(*CheckFederationServerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Universe_DeleteFederationServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteFederationServerRequest)
+func _Universe_ListFederationRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFederationRootsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UniverseServer).DeleteFederationServer(ctx, in)
+		return srv.(UniverseServer).ListFederationRoots(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/universerpc.Universe/DeleteFederationServer",
+		FullMethod: "/universerpc.Universe/ListFederationRoots",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UniverseServer).DeleteFederationServer(ctx, req.(*DeleteFederationServerRequest))
+		return srv.(UniverseServer).ListFederationRoots(ctx, req.(*ListFederationRootsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -680,6 +2110,42 @@ func _Universe_QueryAssetStats_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_QueryAssetSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).QueryAssetSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/QueryAssetSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).QueryAssetSupply(ctx, req.(*QueryAssetSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_QueryAssetUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetUtxosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).QueryAssetUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/QueryAssetUtxos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).QueryAssetUtxos(ctx, req.(*QueryAssetUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Universe_QueryEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryEventsRequest)
 	if err := dec(in); err != nil {
@@ -734,6 +2200,167 @@ func _Universe_QueryFederationSyncConfig_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_SetAssetPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAssetPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).SetAssetPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/SetAssetPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).SetAssetPolicy(ctx, req.(*SetAssetPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_QueryAssetPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).QueryAssetPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/QueryAssetPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).QueryAssetPolicy(ctx, req.(*QueryAssetPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_SetAcceptPushFrom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAcceptPushFromRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).SetAcceptPushFrom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/SetAcceptPushFrom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).SetAcceptPushFrom(ctx, req.(*SetAcceptPushFromRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_QueryAcceptPushFrom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAcceptPushFromRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).QueryAcceptPushFrom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/QueryAcceptPushFrom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).QueryAcceptPushFrom(ctx, req.(*QueryAcceptPushFromRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_SubscribeUniverseUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeUniverseUpdatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UniverseServer).SubscribeUniverseUpdates(m, &universeSubscribeUniverseUpdatesServer{stream})
+}
+
+type Universe_SubscribeUniverseUpdatesServer interface {
+	Send(*UniverseUpdateEvent) error
+	grpc.ServerStream
+}
+
+type universeSubscribeUniverseUpdatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *universeSubscribeUniverseUpdatesServer) Send(m *UniverseUpdateEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Universe_SubscribeFederationEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeFederationEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UniverseServer).SubscribeFederationEvents(m, &universeSubscribeFederationEventsServer{stream})
+}
+
+type Universe_SubscribeFederationEventsServer interface {
+	Send(*FederationEvent) error
+	grpc.ServerStream
+}
+
+type universeSubscribeFederationEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *universeSubscribeFederationEventsServer) Send(m *FederationEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Universe_ExportUniverse_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportUniverseRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UniverseServer).ExportUniverse(m, &universeExportUniverseServer{stream})
+}
+
+type Universe_ExportUniverseServer interface {
+	Send(*UniverseArchiveChunk) error
+	grpc.ServerStream
+}
+
+type universeExportUniverseServer struct {
+	grpc.ServerStream
+}
+
+func (x *universeExportUniverseServer) Send(m *UniverseArchiveChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Universe_ImportUniverse_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UniverseServer).ImportUniverse(&universeImportUniverseServer{stream})
+}
+
+type Universe_ImportUniverseServer interface {
+	SendAndClose(*ImportUniverseResponse) error
+	Recv() (*UniverseArchiveChunk, error)
+	grpc.ServerStream
+}
+
+type universeImportUniverseServer struct {
+	grpc.ServerStream
+}
+
+func (x *universeImportUniverseServer) SendAndClose(m *ImportUniverseResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *universeImportUniverseServer) Recv() (*UniverseArchiveChunk, error) {
+	m := new(UniverseArchiveChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Universe_ServiceDesc is the grpc.ServiceDesc for Universe service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -749,14 +2376,38 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryAssetRoots",
 			Handler:    _Universe_QueryAssetRoots_Handler,
 		},
+		{
+			MethodName: "QueryAssetRootsBatch",
+			Handler:    _Universe_QueryAssetRootsBatch_Handler,
+		},
+		{
+			MethodName: "SearchAssets",
+			Handler:    _Universe_SearchAssets_Handler,
+		},
+		{
+			MethodName: "QueryAssetGenesis",
+			Handler:    _Universe_QueryAssetGenesis_Handler,
+		},
+		{
+			MethodName: "MultiverseRoot",
+			Handler:    _Universe_MultiverseRoot_Handler,
+		},
 		{
 			MethodName: "DeleteAssetRoot",
 			Handler:    _Universe_DeleteAssetRoot_Handler,
 		},
+		{
+			MethodName: "DeleteUniverseLeaf",
+			Handler:    _Universe_DeleteUniverseLeaf_Handler,
+		},
 		{
 			MethodName: "AssetLeafKeys",
 			Handler:    _Universe_AssetLeafKeys_Handler,
 		},
+		{
+			MethodName: "AssetLeafKeysSince",
+			Handler:    _Universe_AssetLeafKeysSince_Handler,
+		},
 		{
 			MethodName: "AssetLeaves",
 			Handler:    _Universe_AssetLeaves_Handler,
@@ -765,10 +2416,26 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryProof",
 			Handler:    _Universe_QueryProof_Handler,
 		},
+		{
+			MethodName: "QueryUniverseProof",
+			Handler:    _Universe_QueryUniverseProof_Handler,
+		},
 		{
 			MethodName: "InsertProof",
 			Handler:    _Universe_InsertProof_Handler,
 		},
+		{
+			MethodName: "InsertProofs",
+			Handler:    _Universe_InsertProofs_Handler,
+		},
+		{
+			MethodName: "UploadMailboxProof",
+			Handler:    _Universe_UploadMailboxProof_Handler,
+		},
+		{
+			MethodName: "FetchMailboxProofs",
+			Handler:    _Universe_FetchMailboxProofs_Handler,
+		},
 		{
 			MethodName: "Info",
 			Handler:    _Universe_Info_Handler,
@@ -777,6 +2444,42 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SyncUniverse",
 			Handler:    _Universe_SyncUniverse_Handler,
 		},
+		{
+			MethodName: "SyncAll",
+			Handler:    _Universe_SyncAll_Handler,
+		},
+		{
+			MethodName: "DiffUniverse",
+			Handler:    _Universe_DiffUniverse_Handler,
+		},
+		{
+			MethodName: "VerifyUniverse",
+			Handler:    _Universe_VerifyUniverse_Handler,
+		},
+		{
+			MethodName: "ComputeUniverseRoot",
+			Handler:    _Universe_ComputeUniverseRoot_Handler,
+		},
+		{
+			MethodName: "ExportUniverseSnapshot",
+			Handler:    _Universe_ExportUniverseSnapshot_Handler,
+		},
+		{
+			MethodName: "PruneUniverse",
+			Handler:    _Universe_PruneUniverse_Handler,
+		},
+		{
+			MethodName: "PruneSpentLeaves",
+			Handler:    _Universe_PruneSpentLeaves_Handler,
+		},
+		{
+			MethodName: "ListPrunedLeaves",
+			Handler:    _Universe_ListPrunedLeaves_Handler,
+		},
+		{
+			MethodName: "CompactUniverseDB",
+			Handler:    _Universe_CompactUniverseDB_Handler,
+		},
 		{
 			MethodName: "ListFederationServers",
 			Handler:    _Universe_ListFederationServers_Handler,
@@ -789,6 +2492,18 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFederationServer",
 			Handler:    _Universe_DeleteFederationServer_Handler,
 		},
+		{
+			MethodName: "SetFederationServerPriority",
+			Handler:    _Universe_SetFederationServerPriority_Handler,
+		},
+		{
+			MethodName: "CheckFederationServer",
+			Handler:    _Universe_CheckFederationServer_Handler,
+		},
+		{
+			MethodName: "ListFederationRoots",
+			Handler:    _Universe_ListFederationRoots_Handler,
+		},
 		{
 			MethodName: "UniverseStats",
 			Handler:    _Universe_UniverseStats_Handler,
@@ -797,6 +2512,14 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryAssetStats",
 			Handler:    _Universe_QueryAssetStats_Handler,
 		},
+		{
+			MethodName: "QueryAssetSupply",
+			Handler:    _Universe_QueryAssetSupply_Handler,
+		},
+		{
+			MethodName: "QueryAssetUtxos",
+			Handler:    _Universe_QueryAssetUtxos_Handler,
+		},
 		{
 			MethodName: "QueryEvents",
 			Handler:    _Universe_QueryEvents_Handler,
@@ -809,7 +2532,49 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryFederationSyncConfig",
 			Handler:    _Universe_QueryFederationSyncConfig_Handler,
 		},
+		{
+			MethodName: "SetAssetPolicy",
+			Handler:    _Universe_SetAssetPolicy_Handler,
+		},
+		{
+			MethodName: "QueryAssetPolicy",
+			Handler:    _Universe_QueryAssetPolicy_Handler,
+		},
+		{
+			MethodName: "SetAcceptPushFrom",
+			Handler:    _Universe_SetAcceptPushFrom_Handler,
+		},
+		{
+			MethodName: "QueryAcceptPushFrom",
+			Handler:    _Universe_QueryAcceptPushFrom_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SyncUniverseStream",
+			Handler:       _Universe_SyncUniverseStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeUniverseUpdates",
+			Handler:       _Universe_SubscribeUniverseUpdates_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeFederationEvents",
+			Handler:       _Universe_SubscribeFederationEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportUniverse",
+			Handler:       _Universe_ExportUniverse_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportUniverse",
+			Handler:       _Universe_ImportUniverse_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "universerpc/universe.proto",
 }
@@ -0,0 +1,153 @@
+package tapscript
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/input"
+)
+
+var (
+	// ErrInvalidMessageSig is returned when a message signature isn't a
+	// valid signature of the given message by the given script key.
+	ErrInvalidMessageSig = errors.New("invalid message signature")
+
+	// messageTag is the tag of the tagged hash a signed message is
+	// committed to, as defined in BIP-0322.
+	messageTag = []byte("BIP0322-signed-message")
+)
+
+// MessageHash returns the BIP-0322 tagged hash of the given message.
+func MessageHash(msg []byte) chainhash.Hash {
+	return *chainhash.TaggedHash(messageTag, msg)
+}
+
+// messageSigningTx returns the virtual transaction that is signed to sign the
+// given message with the given script key, along with the output it spends.
+// The transactions follow the "simple" signature format of BIP-0322: the
+// spent output pays to the script key and is created by a transaction that
+// commits to the hash of the message, so a signature can't be replayed for
+// another message or key.
+func messageSigningTx(msg []byte, scriptKey *btcec.PublicKey) (*wire.MsgTx,
+	*wire.TxOut, error) {
+
+	pkScript, err := PayToTaprootScript(scriptKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	msgHash := MessageHash(msg)
+	sigScript, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_0).
+		AddData(msgHash[:]).
+		Script()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	toSpend := wire.NewMsgTx(0)
+	toSpend.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Index: math.MaxUint32,
+		},
+		SignatureScript: sigScript,
+	})
+	toSpend.AddTxOut(&wire.TxOut{
+		PkScript: pkScript,
+	})
+
+	toSign := wire.NewMsgTx(0)
+	toSign.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Hash: toSpend.TxHash(),
+		},
+	})
+	toSign.AddTxOut(&wire.TxOut{
+		PkScript: []byte{txscript.OP_RETURN},
+	})
+
+	return toSign, toSpend.TxOut[0], nil
+}
+
+// SignMessage signs the given message with the given script key, which must
+// carry the key descriptor of its internal key and its tweak. The signature
+// is created over the BIP-0322 virtual transaction that commits to the
+// message, which allows the signer to sign for any type of script key.
+func SignMessage(msg []byte, scriptKey asset.ScriptKey,
+	signer Signer) (*schnorr.Signature, error) {
+
+	if scriptKey.TweakedScriptKey == nil {
+		return nil, fmt.Errorf("script key %x is missing its key "+
+			"descriptor", scriptKey.PubKey.SerializeCompressed())
+	}
+
+	toSign, prevOut, err := messageSigningTx(msg, scriptKey.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Script keys without a tweak are BIP-0086 keys, others commit to a
+	// tapscript root that the internal key is tweaked with.
+	signDesc := &lndclient.SignDescriptor{
+		KeyDesc:    scriptKey.RawKey,
+		SignMethod: input.TaprootKeySpendBIP0086SignMethod,
+		Output:     prevOut,
+		HashType:   txscript.SigHashDefault,
+		InputIndex: 0,
+	}
+	if len(scriptKey.Tweak) > 0 {
+		signDesc.SignMethod = input.TaprootKeySpendSignMethod
+		signDesc.TapTweak = scriptKey.Tweak
+	}
+
+	sig, err := signer.SignVirtualTx(signDesc, toSign, prevOut)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign message: %w", err)
+	}
+
+	// We make sure the signer actually signed with the given script key,
+	// so we never hand out a signature that can't be verified.
+	if err := VerifyMessage(msg, scriptKey.PubKey, sig); err != nil {
+		return nil, err
+	}
+
+	return sig, nil
+}
+
+// VerifyMessage returns an error wrapping ErrInvalidMessageSig if the given
+// signature isn't a valid signature of the given message by the given script
+// key.
+func VerifyMessage(msg []byte, scriptKey *btcec.PublicKey,
+	sig *schnorr.Signature) error {
+
+	toSign, prevOut, err := messageSigningTx(msg, scriptKey)
+	if err != nil {
+		return err
+	}
+
+	prevOutFetcher := txscript.NewCannedPrevOutputFetcher(
+		prevOut.PkScript, prevOut.Value,
+	)
+	sigHash, err := txscript.CalcTaprootSignatureHash(
+		txscript.NewTxSigHashes(toSign, prevOutFetcher),
+		txscript.SigHashDefault, toSign, 0, prevOutFetcher,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to compute signature hash: %w", err)
+	}
+
+	if !sig.Verify(sigHash, scriptKey) {
+		return fmt.Errorf("%w: signature doesn't match script key %x",
+			ErrInvalidMessageSig, scriptKey.SerializeCompressed())
+	}
+
+	return nil
+}
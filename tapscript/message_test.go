@@ -0,0 +1,114 @@
+package tapscript_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMessageHash tests that messages are hashed according to the BIP-0322
+// test vectors.
+func TestMessageHash(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		msg  string
+		hash string
+	}{{
+		msg: "",
+		hash: "c90c269c4f8fcbe6880f72a721ddfbf1914268a794cbb21cfafee1" +
+			"3770ae19f1",
+	}, {
+		msg: "Hello World",
+		hash: "f0eb03b1a75ac6d9847f55c624a99169b5dccba2a31f5b23bea77b" +
+			"a270de0a7a",
+	}}
+
+	for _, tc := range testCases {
+		msgHash := tapscript.MessageHash([]byte(tc.msg))
+		require.Equal(t, tc.hash, hex.EncodeToString(msgHash[:]))
+	}
+}
+
+// TestSignVerifyMessage tests that a message signed with a script key can be
+// verified, for both BIP-0086 script keys and script keys with a tapscript
+// root.
+func TestSignVerifyMessage(t *testing.T) {
+	t.Parallel()
+
+	privKey := test.RandPrivKey(t)
+	signer := tapscript.NewMockSigner(privKey)
+	rawKey := keychain.KeyDescriptor{
+		PubKey: privKey.PubKey(),
+	}
+
+	tapscriptRoot := test.RandBytes(32)
+	tweakedKey := txscript.ComputeTaprootOutputKey(
+		rawKey.PubKey, tapscriptRoot,
+	)
+	tweakedKey, err := schnorr.ParsePubKey(
+		schnorr.SerializePubKey(tweakedKey),
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		scriptKey asset.ScriptKey
+	}{{
+		name:      "bip86 script key",
+		scriptKey: asset.NewScriptKeyBip86(rawKey),
+	}, {
+		name: "script key with tapscript root",
+		scriptKey: asset.ScriptKey{
+			PubKey: tweakedKey,
+			TweakedScriptKey: &asset.TweakedScriptKey{
+				RawKey: rawKey,
+				Tweak:  tapscriptRoot,
+			},
+		},
+	}}
+
+	msg := []byte("login challenge 1234")
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sig, err := tapscript.SignMessage(
+				msg, tc.scriptKey, signer,
+			)
+			require.NoError(t, err)
+
+			err = tapscript.VerifyMessage(
+				msg, tc.scriptKey.PubKey, sig,
+			)
+			require.NoError(t, err)
+
+			// The signature must not be valid for another message
+			// or another key.
+			err = tapscript.VerifyMessage(
+				[]byte("other message"), tc.scriptKey.PubKey,
+				sig,
+			)
+			require.ErrorIs(t, err, tapscript.ErrInvalidMessageSig)
+
+			err = tapscript.VerifyMessage(
+				msg, test.RandPubKey(t), sig,
+			)
+			require.ErrorIs(t, err, tapscript.ErrInvalidMessageSig)
+		})
+	}
+
+	// A script key without its key descriptor can't be used for signing.
+	_, err = tapscript.SignMessage(
+		msg, asset.NewScriptKey(rawKey.PubKey), signer,
+	)
+	require.ErrorContains(t, err, "missing its key descriptor")
+}
@@ -1054,10 +1054,64 @@ func UpdateTaprootOutputKeys(btcPacket *psbt.Packet, vPkt *tappsbt.VPacket,
 	outputCommitments []*commitment.TapCommitment) (
 	map[uint32]*commitment.TapCommitment, error) {
 
+	return updateTaprootOutputKeys(
+		btcPacket, vPkt.Outputs, outputCommitments,
+	)
+}
+
+// UpdateTaprootOutputKeysForPackets updates a PSBT with outputs embedding the
+// TapCommitments of multiple virtual packets that are anchored in the same BTC
+// level transaction. The output commitments are expected to be in the same
+// order as the virtual packets. Outputs of different packets that reference
+// the same anchor output are merged into a single commitment.
+func UpdateTaprootOutputKeysForPackets(btcPacket *psbt.Packet,
+	vPkts []*tappsbt.VPacket,
+	outputCommitments [][]*commitment.TapCommitment) (
+	map[uint32]*commitment.TapCommitment, error) {
+
+	if len(vPkts) != len(outputCommitments) {
+		return nil, fmt.Errorf("number of virtual packets (%d) does "+
+			"not match number of output commitment sets (%d)",
+			len(vPkts), len(outputCommitments))
+	}
+
+	var (
+		allOutputs     []*tappsbt.VOutput
+		allCommitments []*commitment.TapCommitment
+	)
+	for idx := range vPkts {
+		vPkt := vPkts[idx]
+		if len(vPkt.Outputs) != len(outputCommitments[idx]) {
+			return nil, ErrMissingTapCommitment
+		}
+
+		allOutputs = append(allOutputs, vPkt.Outputs...)
+		allCommitments = append(
+			allCommitments, outputCommitments[idx]...,
+		)
+	}
+
+	// Outputs of different packets that are committed to the same anchor
+	// output must agree on the anchor output information.
+	err := assertAnchorsEqual(&tappsbt.VPacket{Outputs: allOutputs})
+	if err != nil {
+		return nil, err
+	}
+
+	return updateTaprootOutputKeys(btcPacket, allOutputs, allCommitments)
+}
+
+// updateTaprootOutputKeys updates a PSBT with outputs embedding the given
+// TapCommitments, one for each of the given virtual outputs.
+func updateTaprootOutputKeys(btcPacket *psbt.Packet,
+	outputs []*tappsbt.VOutput,
+	outputCommitments []*commitment.TapCommitment) (
+	map[uint32]*commitment.TapCommitment, error) {
+
 	// Add the commitment outputs to the BTC level PSBT now.
 	anchorCommitments := make(map[uint32]*commitment.TapCommitment)
-	for idx := range vPkt.Outputs {
-		vOut := vPkt.Outputs[idx]
+	for idx := range outputs {
+		vOut := outputs[idx]
 		vOutCommitment := outputCommitments[idx]
 
 		// The commitment must be defined at this point.
@@ -19,6 +19,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/proof"
@@ -1585,8 +1586,113 @@ var updateTaprootOutputKeysTestCases = []testCase{{
 		return nil
 	},
 	err: nil,
+}, {
+	name: "multiple packets sharing an anchor output",
+	f: func(t *testing.T) error {
+		state := initSpendScenario(t)
+		vPkts, outputCommitments := createMultiPacketSpend(t, state)
+
+		allOutputs := append(vPkts[0].Outputs, vPkts[1].Outputs...)
+		btcPkt, err := tapscript.CreateAnchorTx(allOutputs)
+		require.NoError(t, err)
+
+		updateKeys := tapscript.UpdateTaprootOutputKeysForPackets
+		anchorCommitments, err := updateKeys(
+			btcPkt, vPkts, outputCommitments,
+		)
+		require.NoError(t, err)
+
+		// The change of the first packet and the collectible of the
+		// second packet are committed to the same anchor output.
+		sharedCommitment := anchorCommitments[0]
+		committedAssets := sharedCommitment.CommittedAssets()
+		require.Len(t, committedAssets, 2)
+
+		committedIDs := fn.Map(
+			committedAssets, func(a *asset.Asset) asset.ID {
+				return a.ID()
+			},
+		)
+		require.ElementsMatch(t, []asset.ID{
+			state.asset2.ID(), state.asset1CollectGroup.ID(),
+		}, committedIDs)
+
+		expectedScript, err := tapscript.PayToAddrScript(
+			state.spenderPubKey, nil, *sharedCommitment,
+		)
+		require.NoError(t, err)
+		require.Equal(
+			t, expectedScript, btcPkt.UnsignedTx.TxOut[0].PkScript,
+		)
+
+		return nil
+	},
+	err: nil,
+}, {
+	name: "multiple packets with different anchor info",
+	f: func(t *testing.T) error {
+		state := initSpendScenario(t)
+		vPkts, outputCommitments := createMultiPacketSpend(t, state)
+
+		// The second packet now disagrees with the first one on the
+		// internal key of the shared anchor output.
+		vPkts[1].Outputs[0].AnchorOutputInternalKey =
+			&state.receiverPubKey
+
+		allOutputs := append(vPkts[0].Outputs, vPkts[1].Outputs...)
+		btcPkt, err := tapscript.CreateAnchorTx(allOutputs)
+		require.NoError(t, err)
+
+		_, err = tapscript.UpdateTaprootOutputKeysForPackets(
+			btcPkt, vPkts, outputCommitments,
+		)
+		return err
+	},
+	err: tapscript.ErrInvalidAnchorInfo,
 }}
 
+// createMultiPacketSpend creates two signed virtual packets of different assets
+// that are meant to be anchored in the same transaction. The change of the
+// first packet and the full value send of the second packet are committed to
+// the same anchor output.
+func createMultiPacketSpend(t *testing.T, state spendData) ([]*tappsbt.VPacket,
+	[][]*commitment.TapCommitment) {
+
+	normalPkt := createPacket(
+		state.address1, state.asset2PrevID, state,
+		state.asset2InputAssets, false,
+	)
+	collectPkt := createPacket(
+		state.address1CollectGroup, state.asset1CollectGroupPrevID,
+		state, state.asset1CollectGroupInputAssets, true,
+	)
+	collectPkt.Outputs[0].AnchorOutputIndex = 0
+	collectPkt.Outputs[0].AnchorOutputInternalKey = &state.spenderPubKey
+
+	vPkts := []*tappsbt.VPacket{normalPkt, collectPkt}
+	inputCommitments := []*commitment.TapCommitment{
+		&state.asset2TapTree, &state.asset1CollectGroupTapTree,
+	}
+	outputCommitments := make([][]*commitment.TapCommitment, len(vPkts))
+	for idx, vPkt := range vPkts {
+		err := tapscript.PrepareOutputAssets(context.Background(), vPkt)
+		require.NoError(t, err)
+		err = tapscript.SignVirtualTransaction(
+			vPkt, state.signer, state.validator,
+		)
+		require.NoError(t, err)
+
+		outputCommitments[idx], err = tapscript.CreateOutputCommitments(
+			tappsbt.InputCommitments{
+				0: inputCommitments[idx],
+			}, vPkt, nil,
+		)
+		require.NoError(t, err)
+	}
+
+	return vPkts, outputCommitments
+}
+
 func createSpend(t *testing.T, state *spendData, inputSet commitment.InputSet,
 	full bool) (*psbt.Packet, *tappsbt.VPacket,
 	[]*commitment.TapCommitment) {
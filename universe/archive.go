@@ -0,0 +1,501 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// ArchivePrefixMagicBytes are the magic bytes that are written at the
+	// start of every universe archive.
+	ArchivePrefixMagicBytes = [4]byte{'T', 'A', 'P', 'U'}
+
+	// ErrArchiveInvalid is returned when a universe archive can't be
+	// decoded or its content doesn't match the committed roots.
+	ErrArchiveInvalid = errors.New("universe archive is invalid")
+)
+
+// ArchiveVersion denotes the versioning scheme for universe archives.
+type ArchiveVersion uint32
+
+const (
+	// ArchiveV0 is the first version of the universe archive format.
+	ArchiveV0 ArchiveVersion = 0
+
+	// ArchiveMaxNumUniverses is the maximum number of universes we allow
+	// within a single archive, to avoid OOM attacks when decoding.
+	ArchiveMaxNumUniverses = 1_000_000
+
+	// ArchiveMaxNumLeaves is the maximum number of leaves we allow within
+	// a single universe of an archive.
+	ArchiveMaxNumLeaves = proof.FileMaxNumProofs
+)
+
+// ArchiveLeaf is a single leaf of a universe within an archive.
+type ArchiveLeaf struct {
+	// Key is the key the leaf is stored at within the universe tree.
+	Key LeafKey
+
+	// Proof is the issuance or transfer proof of the leaf.
+	Proof *proof.Proof
+}
+
+// ArchiveUniverse is the full content of a single universe within an archive.
+type ArchiveUniverse struct {
+	// ID is the identifier of the universe.
+	ID Identifier
+
+	// Root is the root of the universe tree at the time of the export.
+	Root mssmt.Node
+
+	// Leaves is the set of leaves of the universe tree.
+	Leaves []ArchiveLeaf
+}
+
+// ArchiveFile is a portable archive of the universe trees known to a node,
+// which can be used to back up or bootstrap a universe server.
+type ArchiveFile struct {
+	// Version is the version of the archive format.
+	Version ArchiveVersion
+
+	// Universes is the set of universes contained in the archive.
+	Universes []ArchiveUniverse
+}
+
+// ArchiveImportResult is the result of importing an archive.
+type ArchiveImportResult struct {
+	// NumUniverses is the number of universes contained in the archive.
+	NumUniverses int
+
+	// NumImported is the number of leaves that were newly inserted.
+	NumImported int
+
+	// NumExisting is the number of leaves that were already known and
+	// therefore skipped.
+	NumExisting int
+}
+
+// Encode encodes the archive into `w`.
+func (f *ArchiveFile) Encode(w io.Writer) error {
+	if _, err := w.Write(ArchivePrefixMagicBytes[:]); err != nil {
+		return err
+	}
+
+	err := binary.Write(w, binary.BigEndian, uint32(f.Version))
+	if err != nil {
+		return err
+	}
+
+	var tlvBuf [8]byte
+	err = tlv.WriteVarInt(w, uint64(len(f.Universes)), &tlvBuf)
+	if err != nil {
+		return err
+	}
+	for idx := range f.Universes {
+		err := encodeArchiveUniverse(w, &f.Universes[idx])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Decode decodes an archive from `r`. Only the known archive versions can be
+// decoded.
+func (f *ArchiveFile) Decode(r io.Reader) error {
+	var prefixMagicBytes [4]byte
+	if _, err := io.ReadFull(r, prefixMagicBytes[:]); err != nil {
+		return err
+	}
+	if prefixMagicBytes != ArchivePrefixMagicBytes {
+		return fmt.Errorf("%w: invalid prefix magic bytes, expected "+
+			"%s, got %s", ErrArchiveInvalid,
+			string(ArchivePrefixMagicBytes[:]),
+			string(prefixMagicBytes[:]))
+	}
+
+	var version uint32
+	if err := binary.Read(r, binary.BigEndian, &version); err != nil {
+		return err
+	}
+	f.Version = ArchiveVersion(version)
+	if f.Version != ArchiveV0 {
+		return fmt.Errorf("%w: unknown archive version %d",
+			ErrArchiveInvalid, version)
+	}
+
+	var tlvBuf [8]byte
+	numUniverses, err := tlv.ReadVarInt(r, &tlvBuf)
+	if err != nil {
+		return err
+	}
+	if numUniverses > ArchiveMaxNumUniverses {
+		return fmt.Errorf("%w: too many universes", ErrArchiveInvalid)
+	}
+
+	f.Universes = make([]ArchiveUniverse, numUniverses)
+	for idx := range f.Universes {
+		err := decodeArchiveUniverse(r, &f.Universes[idx])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// encodeArchiveUniverse encodes a single universe of an archive into `w`.
+func encodeArchiveUniverse(w io.Writer, u *ArchiveUniverse) error {
+	var tlvBuf [8]byte
+
+	// First, we'll write the universe ID, which is made up of the proof
+	// type, the asset ID and the optional group key.
+	if _, err := w.Write([]byte{byte(u.ID.ProofType)}); err != nil {
+		return err
+	}
+	if _, err := w.Write(u.ID.AssetID[:]); err != nil {
+		return err
+	}
+
+	var groupKeyBytes []byte
+	if u.ID.GroupKey != nil {
+		groupKeyBytes = u.ID.GroupKey.SerializeCompressed()
+	}
+	err := tlv.WriteVarInt(w, uint64(len(groupKeyBytes)), &tlvBuf)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(groupKeyBytes); err != nil {
+		return err
+	}
+
+	// Next, the root hash and sum, so the tree can be verified once it has
+	// been rebuilt from the leaves.
+	rootHash := u.Root.NodeHash()
+	if _, err := w.Write(rootHash[:]); err != nil {
+		return err
+	}
+	err = binary.Write(w, binary.BigEndian, u.Root.NodeSum())
+	if err != nil {
+		return err
+	}
+
+	// Finally, each leaf is written as its key followed by the length
+	// prefixed proof.
+	err = tlv.WriteVarInt(w, uint64(len(u.Leaves)), &tlvBuf)
+	if err != nil {
+		return err
+	}
+	for _, leaf := range u.Leaves {
+		if leaf.Key.ScriptKey == nil || leaf.Proof == nil {
+			return fmt.Errorf("leaf of universe %v is incomplete",
+				u.ID.String())
+		}
+
+		op := leaf.Key.OutPoint
+		if _, err := w.Write(op.Hash[:]); err != nil {
+			return err
+		}
+		err := binary.Write(w, binary.BigEndian, op.Index)
+		if err != nil {
+			return err
+		}
+		scriptKey := leaf.Key.ScriptKey.PubKey.SerializeCompressed()
+		if _, err := w.Write(scriptKey); err != nil {
+			return err
+		}
+
+		var proofBuf bytes.Buffer
+		if err := leaf.Proof.Encode(&proofBuf); err != nil {
+			return err
+		}
+		err = tlv.WriteVarInt(w, uint64(proofBuf.Len()), &tlvBuf)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(proofBuf.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeArchiveUniverse decodes a single universe of an archive from `r`.
+func decodeArchiveUniverse(r io.Reader, u *ArchiveUniverse) error {
+	var (
+		tlvBuf    [8]byte
+		proofType [1]byte
+	)
+	if _, err := io.ReadFull(r, proofType[:]); err != nil {
+		return err
+	}
+	u.ID.ProofType = ProofType(proofType[0])
+	if _, err := io.ReadFull(r, u.ID.AssetID[:]); err != nil {
+		return err
+	}
+
+	groupKeyLen, err := tlv.ReadVarInt(r, &tlvBuf)
+	if err != nil {
+		return err
+	}
+	switch groupKeyLen {
+	case 0:
+	case btcec.PubKeyBytesLenCompressed:
+		groupKeyBytes := make([]byte, groupKeyLen)
+		if _, err := io.ReadFull(r, groupKeyBytes); err != nil {
+			return err
+		}
+		u.ID.GroupKey, err = btcec.ParsePubKey(groupKeyBytes)
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("%w: invalid group key length %d",
+			ErrArchiveInvalid, groupKeyLen)
+	}
+
+	var (
+		rootHash mssmt.NodeHash
+		rootSum  uint64
+	)
+	if _, err := io.ReadFull(r, rootHash[:]); err != nil {
+		return err
+	}
+	if err := binary.Read(r, binary.BigEndian, &rootSum); err != nil {
+		return err
+	}
+	u.Root = mssmt.NewComputedBranch(rootHash, rootSum)
+
+	numLeaves, err := tlv.ReadVarInt(r, &tlvBuf)
+	if err != nil {
+		return err
+	}
+	if numLeaves > ArchiveMaxNumLeaves {
+		return fmt.Errorf("%w: too many leaves in universe",
+			ErrArchiveInvalid)
+	}
+
+	u.Leaves = make([]ArchiveLeaf, numLeaves)
+	for idx := range u.Leaves {
+		leaf := &u.Leaves[idx]
+
+		op := &leaf.Key.OutPoint
+		if _, err := io.ReadFull(r, op.Hash[:]); err != nil {
+			return err
+		}
+		err := binary.Read(r, binary.BigEndian, &op.Index)
+		if err != nil {
+			return err
+		}
+
+		var scriptKeyBytes [btcec.PubKeyBytesLenCompressed]byte
+		if _, err := io.ReadFull(r, scriptKeyBytes[:]); err != nil {
+			return err
+		}
+		scriptPubKey, err := btcec.ParsePubKey(scriptKeyBytes[:])
+		if err != nil {
+			return err
+		}
+		scriptKey := asset.NewScriptKey(scriptPubKey)
+		leaf.Key.ScriptKey = &scriptKey
+
+		numProofBytes, err := tlv.ReadVarInt(r, &tlvBuf)
+		if err != nil {
+			return err
+		}
+		if numProofBytes > proof.FileMaxProofSizeBytes {
+			return fmt.Errorf("%w: proof in archive too large",
+				ErrArchiveInvalid)
+		}
+
+		proofBytes := make([]byte, numProofBytes)
+		if _, err := io.ReadFull(r, proofBytes); err != nil {
+			return err
+		}
+
+		leaf.Proof = &proof.Proof{}
+		err = leaf.Proof.Decode(bytes.NewReader(proofBytes))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newLeaf creates the universe leaf for the archived proof.
+func (l *ArchiveLeaf) newLeaf() *Leaf {
+	return &Leaf{
+		GenesisWithGroup: GenesisWithGroup{
+			Genesis:  l.Proof.Asset.Genesis,
+			GroupKey: l.Proof.Asset.GroupKey,
+		},
+		Proof: l.Proof,
+		Amt:   l.Proof.Asset.Amount,
+	}
+}
+
+// verifyRoot rebuilds the universe tree from the archived leaves and makes
+// sure it matches the archived root.
+func (u *ArchiveUniverse) verifyRoot(ctx context.Context) error {
+	root, err := ComputeRoot(ctx, u.Leaves)
+	switch {
+	case errors.Is(err, ErrDuplicateLeafKey):
+		return fmt.Errorf("%w: %v in universe %v", ErrArchiveInvalid,
+			err, u.ID.StringForLog())
+
+	case err != nil:
+		return err
+	}
+
+	if !mssmt.IsEqualNode(root, u.Root) {
+		return fmt.Errorf("%w: root mismatch for universe %v",
+			ErrArchiveInvalid, u.ID.StringForLog())
+	}
+
+	return nil
+}
+
+// ExportArchive creates an archive of the universes known to the local node.
+// If a filter is specified, then only the universes it matches are exported.
+func (a *MintingArchive) ExportArchive(ctx context.Context,
+	filter func(Identifier) bool) (*ArchiveFile, error) {
+
+	log.Infof("Exporting Universe archive")
+
+	roots, err := a.cfg.Multiverse.RootNodes(ctx, RootNodesQuery{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch universe roots: %w",
+			err)
+	}
+
+	archive := &ArchiveFile{
+		Version: ArchiveV0,
+	}
+	for _, root := range roots {
+		if filter != nil && !filter(root.ID) {
+			continue
+		}
+
+		leafKeys, err := a.UniverseLeafKeys(ctx, root.ID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch leaf keys for "+
+				"universe %v: %w", root.ID.StringForLog(), err)
+		}
+
+		uni := ArchiveUniverse{
+			ID:     root.ID,
+			Root:   root.Node,
+			Leaves: make([]ArchiveLeaf, 0, len(leafKeys)),
+		}
+		for _, key := range leafKeys {
+			// We query the multiverse directly, as we don't want
+			// the export to show up as a set of sync events.
+			proofs, err := a.cfg.Multiverse.FetchProofLeaf(
+				ctx, root.ID, key,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to fetch leaf "+
+					"for universe %v: %w",
+					root.ID.StringForLog(), err)
+			}
+			if len(proofs) != 1 {
+				return nil, fmt.Errorf("expected a single "+
+					"proof for leaf, got %d", len(proofs))
+			}
+
+			uni.Leaves = append(uni.Leaves, ArchiveLeaf{
+				Key:   key,
+				Proof: proofs[0].Leaf.Proof,
+			})
+		}
+
+		archive.Universes = append(archive.Universes, uni)
+	}
+
+	return archive, nil
+}
+
+// ImportArchive imports all universes of the given archive. The archived trees
+// are first verified against their committed roots, then each leaf that isn't
+// yet known locally is verified and inserted. Leaves that are already known
+// are skipped, so importing an archive merges it with the local state.
+func (a *MintingArchive) ImportArchive(ctx context.Context,
+	archive *ArchiveFile) (*ArchiveImportResult, error) {
+
+	log.Infof("Importing Universe archive with %d universes",
+		len(archive.Universes))
+
+	// Transfer proofs can only be verified once the issuance proofs they
+	// spend are known, so we'll import all issuance universes first.
+	universes := make([]*ArchiveUniverse, len(archive.Universes))
+	for idx := range archive.Universes {
+		universes[idx] = &archive.Universes[idx]
+	}
+	sort.SliceStable(universes, func(i, j int) bool {
+		return universes[i].ID.ProofType < universes[j].ID.ProofType
+	})
+
+	result := &ArchiveImportResult{
+		NumUniverses: len(universes),
+	}
+	for _, uni := range universes {
+		if err := uni.verifyRoot(ctx); err != nil {
+			return nil, err
+		}
+
+		localKeys, err := a.UniverseLeafKeys(ctx, uni.ID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch leaf keys for "+
+				"universe %v: %w", uni.ID.StringForLog(), err)
+		}
+		knownKeys := make(map[[32]byte]struct{}, len(localKeys))
+		for _, key := range localKeys {
+			knownKeys[key.UniverseKey()] = struct{}{}
+		}
+
+		var newItems []*IssuanceItem
+		for idx := range uni.Leaves {
+			leaf := &uni.Leaves[idx]
+
+			if _, ok := knownKeys[leaf.Key.UniverseKey()]; ok {
+				result.NumExisting++
+				continue
+			}
+
+			newItems = append(newItems, &IssuanceItem{
+				ID:         uni.ID,
+				Key:        leaf.Key,
+				Leaf:       leaf.newLeaf(),
+				MetaReveal: leaf.Proof.MetaReveal,
+			})
+		}
+
+		if len(newItems) == 0 {
+			continue
+		}
+
+		err = a.RegisterNewIssuanceBatch(ctx, newItems)
+		if err != nil {
+			return nil, fmt.Errorf("unable to import universe "+
+				"%v: %w", uni.ID.StringForLog(), err)
+		}
+
+		result.NumImported += len(newItems)
+	}
+
+	return result, nil
+}
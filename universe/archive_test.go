@@ -0,0 +1,157 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"slices"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// randArchiveUniverse returns an archived universe with the given number of
+// random leaves and a root that commits to them.
+func randArchiveUniverse(t *testing.T, numLeaves int,
+	withGroupKey bool) ArchiveUniverse {
+
+	ctx := context.Background()
+
+	uni := ArchiveUniverse{
+		ID: Identifier{
+			AssetID:   asset.RandID(t),
+			ProofType: ProofTypeIssuance,
+		},
+	}
+	if withGroupKey {
+		uni.ID.GroupKey = test.RandPubKey(t)
+	}
+
+	tree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+	witness := wire.TxWitness{[]byte("foo")}
+	for i := 0; i < numLeaves; i++ {
+		leaf := ArchiveLeaf{
+			Key: randLeafKey(t),
+			Proof: &proof.Proof{
+				BlockHeader: wire.BlockHeader{
+					Version: int32(i),
+				},
+				AnchorTx: wire.MsgTx{
+					Version: 2,
+					TxIn: []*wire.TxIn{{
+						Witness: witness,
+					}},
+				},
+				Asset: *asset.RandAsset(t, asset.Normal),
+				InclusionProof: proof.TaprootProof{
+					InternalKey: test.RandPubKey(t),
+				},
+			},
+		}
+		uni.Leaves = append(uni.Leaves, leaf)
+
+		leafNode, err := leaf.newLeaf().SmtLeafNode()
+		require.NoError(t, err)
+
+		_, err = tree.Insert(ctx, leaf.Key.UniverseKey(), leafNode)
+		require.NoError(t, err)
+	}
+
+	root, err := tree.Root(ctx)
+	require.NoError(t, err)
+	uni.Root = root
+
+	return uni
+}
+
+// encodeArchive returns the serialized form of the given archive.
+func encodeArchive(t *testing.T, archive *ArchiveFile) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, archive.Encode(&buf))
+
+	return buf.Bytes()
+}
+
+// TestArchiveFileEncoding tests that a universe archive can be encoded and
+// decoded again, and that the decoded trees match their archived roots.
+func TestArchiveFileEncoding(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	archive := &ArchiveFile{
+		Version: ArchiveV0,
+		Universes: []ArchiveUniverse{
+			randArchiveUniverse(t, 3, false),
+			randArchiveUniverse(t, 2, true),
+			randArchiveUniverse(t, 0, false),
+		},
+	}
+	archiveBytes := encodeArchive(t, archive)
+
+	var decoded ArchiveFile
+	require.NoError(t, decoded.Decode(bytes.NewReader(archiveBytes)))
+	require.Equal(t, archiveBytes, encodeArchive(t, &decoded))
+
+	require.Len(t, decoded.Universes, len(archive.Universes))
+	for idx := range decoded.Universes {
+		uni := &decoded.Universes[idx]
+		require.Equal(t, archive.Universes[idx].ID, uni.ID)
+		require.Len(t, uni.Leaves, len(archive.Universes[idx].Leaves))
+		require.NoError(t, uni.verifyRoot(ctx))
+	}
+
+	// If a leaf is missing, the rebuilt tree no longer matches the root.
+	decoded.Universes[0].Leaves = decoded.Universes[0].Leaves[1:]
+	err := decoded.Universes[0].verifyRoot(ctx)
+	require.ErrorIs(t, err, ErrArchiveInvalid)
+
+	// An archive with invalid magic bytes or an unknown version can't be
+	// decoded.
+	invalidMagic := bytes.Clone(archiveBytes)
+	invalidMagic[0] ^= 1
+	err = decoded.Decode(bytes.NewReader(invalidMagic))
+	require.ErrorIs(t, err, ErrArchiveInvalid)
+
+	unknownVersion := bytes.Clone(archiveBytes)
+	binary.BigEndian.PutUint32(unknownVersion[4:], 1)
+	err = decoded.Decode(bytes.NewReader(unknownVersion))
+	require.ErrorIs(t, err, ErrArchiveInvalid)
+}
+
+// TestComputeRoot tests that the root computed from a set of leaves doesn't
+// depend on their order, and that duplicate leaf keys are rejected.
+func TestComputeRoot(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	uni := randArchiveUniverse(t, 4, false)
+
+	root, err := ComputeRoot(ctx, uni.Leaves)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(uni.Root, root))
+
+	reversed := slices.Clone(uni.Leaves)
+	slices.Reverse(reversed)
+	root, err = ComputeRoot(ctx, reversed)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(uni.Root, root))
+
+	// Without any leaves, the root of an empty tree is returned.
+	root, err = ComputeRoot(ctx, nil)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(mssmt.EmptyTree[0], root))
+
+	// A second leaf with the same key is rejected, even if the proof is
+	// different.
+	duplicate := uni.Leaves[1]
+	duplicate.Proof = uni.Leaves[2].Proof
+	_, err = ComputeRoot(ctx, append(slices.Clone(uni.Leaves), duplicate))
+	require.ErrorIs(t, err, ErrDuplicateLeafKey)
+}
@@ -9,12 +9,27 @@ import (
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
 )
 
 const (
 	// DefaultTimeout is the default timeout we use for RPC and database
 	// operations.
 	DefaultTimeout = 30 * time.Second
+
+	// minSyncCheckInterval is the minimum amount of time we'll wait
+	// between checking whether any of the federation servers are due for
+	// a new sync.
+	minSyncCheckInterval = time.Second
+
+	// DefaultPushRetryInterval is the default amount of time we'll wait
+	// before retrying a failed push to a federation server for the first
+	// time.
+	DefaultPushRetryInterval = time.Second * 30
+
+	// maxPushRetryBackoff is the maximum amount of time we'll wait between
+	// two attempts to push a leaf to a federation server.
+	maxPushRetryBackoff = time.Hour
 )
 
 // FederationConfig is a config that the FederationEnvoy will use to
@@ -38,6 +53,15 @@ type FederationConfig struct {
 	// to the federation.
 	LocalRegistrar BatchRegistrar
 
+	// LocalDiffEngine is the diff engine of the local universe. This'll be
+	// used to fetch the full local universe state when pushing it out to
+	// a federation server.
+	LocalDiffEngine DiffEngine
+
+	// UniverseStats is used to log each leaf that was successfully pushed
+	// out to a member of the federation.
+	UniverseStats Telemetry
+
 	// SyncInterval is the period that we'll use to synchronize with the
 	// set of Universe servers.
 	SyncInterval time.Duration
@@ -54,6 +78,42 @@ type FederationConfig struct {
 	// ServerChecker is a function that can be used to check if a server is
 	// operational and not the local daemon.
 	ServerChecker func(ServerAddr) error
+
+	// NewRemoteDiffEngine is a function that returns a new diff engine
+	// for the target remote Universe. This'll be used to compare the
+	// roots of federation servers to our local roots.
+	NewRemoteDiffEngine func(ServerAddr) (DiffEngine, error)
+
+	// HealthCheckInterval is the period that we'll use to check the
+	// connectivity and sync status of the federation servers. If zero,
+	// then servers are only checked on demand.
+	HealthCheckInterval time.Duration
+
+	// PushRetryInterval is the amount of time we'll wait before retrying
+	// a failed push to a federation server for the first time. The wait
+	// time is doubled after each failed attempt. If zero, then
+	// DefaultPushRetryInterval is used.
+	PushRetryInterval time.Duration
+
+	// PushObserver is an optional function that's called with the result
+	// of every attempt to push a leaf to a federation server. This can be
+	// used to export metrics about the federation pushes.
+	PushObserver func(serverHost string, err error)
+
+	// MinConfs is the minimum number of confirmations the anchor
+	// transaction of a leaf must have before it's pushed to the federation.
+	// Pushes of shallower leaves are queued and retried until the anchor
+	// is buried deep enough. A value of one or less pushes all leaves
+	// right away.
+	MinConfs uint32
+
+	// ChainHeight returns the current height of the main chain. This is
+	// only required if MinConfs is larger than one.
+	ChainHeight ChainHeightFunc
+	// ResumeSync indicates that the periodic syncs with the federation
+	// servers should resume from the checkpoint of a previous sync that
+	// was interrupted, instead of starting over from scratch.
+	ResumeSync bool
 }
 
 // FederationPushReq is used to push out new updates to all or some members of
@@ -73,6 +133,23 @@ type FederationPushReq struct {
 	err  chan error
 }
 
+// FailedLeafPush describes a leaf of the local universe that couldn't be pushed
+// to a federation server.
+type FailedLeafPush struct {
+	// ServerAddr is the address of the server the leaf couldn't be pushed
+	// to.
+	ServerAddr ServerAddr
+
+	// ID is the identifier of the universe the leaf belongs to.
+	ID Identifier
+
+	// Key is the key of the leaf.
+	Key LeafKey
+
+	// Err is the error that prevented the leaf from being pushed.
+	Err error
+}
+
 // FederationIssuanceBatchPushReq is used to push out a batch of new issuance
 // events to all or some members of the federation.
 type FederationIssuanceBatchPushReq struct {
@@ -98,6 +175,27 @@ type FederationEnvoy struct {
 	pushRequests chan *FederationPushReq
 
 	batchPushRequests chan *FederationIssuanceBatchPushReq
+
+	// lastSyncAttempts tracks the last time we attempted to sync with
+	// each of the federation servers, keyed by host. This is only
+	// accessed by the syncer goroutine.
+	lastSyncAttempts map[string]time.Time
+
+	// serverHealth tracks the health of each of the federation servers as
+	// determined by the last health check, keyed by host.
+	serverHealth map[string]ServerHealth
+
+	healthMtx sync.Mutex
+
+	// fedEvents is used to notify subscribers of changes of the federation
+	// membership and of the health of its servers.
+	fedEvents *fn.EventDistributor[*FederationEvent]
+
+	// eventMtx is held while the federation membership is changed and the
+	// corresponding events are published. This ensures that a new
+	// subscriber doesn't miss any change that happened after its initial
+	// membership snapshot.
+	eventMtx sync.Mutex
 }
 
 // NewFederationEnvoy creates a new federation envoy from the passed config.
@@ -106,6 +204,9 @@ func NewFederationEnvoy(cfg FederationConfig) *FederationEnvoy {
 		cfg:               cfg,
 		pushRequests:      make(chan *FederationPushReq),
 		batchPushRequests: make(chan *FederationIssuanceBatchPushReq),
+		lastSyncAttempts:  make(map[string]time.Time),
+		serverHealth:      make(map[string]ServerHealth),
+		fedEvents:         fn.NewEventDistributor[*FederationEvent](),
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -150,6 +251,16 @@ func (f *FederationEnvoy) Start() error {
 		f.Wg.Add(1)
 
 		go f.syncer()
+
+		if f.cfg.HealthCheckInterval > 0 {
+			f.Wg.Add(1)
+
+			go f.healthChecker()
+		}
+
+		f.Wg.Add(1)
+
+		go f.pushRetrier()
 	})
 
 	return nil
@@ -197,13 +308,26 @@ func (f *FederationEnvoy) syncServerState(ctx context.Context,
 		return err
 	}
 
-	if len(diff) == 0 {
-		return nil
-	}
+	// A failure to sync an individual root doesn't abort the whole sync,
+	// so we'll log any such failures here and only keep the successful
+	// diffs.
+	diff = fn.Filter(diff, func(d AssetSyncDiff) bool {
+		if d.Failed() {
+			log.Warnf("Unable to sync UniverseRoot(%v) with "+
+				"server=%v: %v", d.NewUniverseRoot.ID.String(),
+				addr.HostStr(), d.SyncErr)
+
+			return false
+		}
+
+		return true
+	})
 
 	// If we synced anything from the server, then we'll log that here.
-	log.Infof("Synced new Universe leaves from server=%v, diff_size=%v",
-		spew.Sdump(addr), len(diff))
+	if len(diff) != 0 {
+		log.Infof("Synced new Universe leaves from server=%v, "+
+			"diff_size=%v", spew.Sdump(addr), len(diff))
+	}
 
 	// Log a new sync event in the background now that we know we were able
 	// to contract the remote server.
@@ -251,25 +375,31 @@ func (f *FederationEnvoy) pushProofToFederation(uniID Identifier, key LeafKey,
 	ctx, cancel = f.WithCtxQuitNoTimeout()
 	defer cancel()
 
-	// To push a new proof out, we'll attempt to dial to the remote
-	// registrar, then will attempt to push the new proof directly to the
-	// register.
+	// To push a new proof out, we'll attempt to push the new proof
+	// directly to the remote registrar. If that fails, then we'll queue
+	// the push, so it's retried in the background until it succeeds.
 	pushNewProof := func(ctx context.Context, addr ServerAddr) error {
-		remoteUniverseServer, err := f.cfg.NewRemoteRegistrar(addr)
-		if err != nil {
-			log.Warnf("cannot push proof unable to connect "+
-				"to remote server(%v): %v", addr.HostStr(),
-				err)
+		pushErr := f.pushLeaf(ctx, addr, uniID, key, leaf)
+		if pushErr == nil {
 			return nil
 		}
 
-		_, err = remoteUniverseServer.RegisterIssuance(
-			ctx, uniID, key, leaf,
-		)
+		log.Warnf("Queueing push to server(%v) for retry: %v",
+			addr.HostStr(), pushErr)
+
+		err := f.cfg.FederationDB.QueuePendingPush(ctx, PendingPush{
+			ServerAddr:  addr,
+			UniverseID:  uniID,
+			Key:         key,
+			NumAttempts: 1,
+			NextAttempt: time.Now().Add(f.pushRetryBackoff(1)),
+			LastErr:     pushErr.Error(),
+		})
 		if err != nil {
-			log.Warnf("cannot push proof to remote "+
-				"server(%v): %v", addr.HostStr(), err)
+			log.Errorf("unable to queue push to server(%v): %v",
+				addr.HostStr(), err)
 		}
+
 		return nil
 	}
 
@@ -277,12 +407,123 @@ func (f *FederationEnvoy) pushProofToFederation(uniID Identifier, key LeafKey,
 	// servers in parallel.
 	err = fn.ParSlice(ctx, fedServers, pushNewProof)
 	if err != nil {
-		// TODO(roasbeef): retry in the background until successful?
 		log.Errorf("unable to push proof to federation: %v", err)
 		return
 	}
 }
 
+// pushLeaf attempts to dial to the remote registrar of the given server, then
+// pushes the given leaf directly to it. A successful push is logged as a new
+// push event.
+func (f *FederationEnvoy) pushLeaf(ctx context.Context, addr ServerAddr,
+	uniID Identifier, key LeafKey, leaf *Leaf) error {
+
+	// We don't propagate leaves that aren't buried deep enough yet. This
+	// isn't a failure of the server, so it isn't reported to the push
+	// observer.
+	if err := f.checkProofConfs(ctx, leaf.Proof); err != nil {
+		return err
+	}
+
+	remoteUniverseServer, err := f.cfg.NewRemoteRegistrar(addr)
+	if err != nil {
+		err = fmt.Errorf("unable to connect to remote server(%v): %w",
+			addr.HostStr(), err)
+		f.observePush(addr, err)
+
+		return err
+	}
+
+	_, err = remoteUniverseServer.RegisterIssuance(ctx, uniID, key, leaf)
+	if err != nil {
+		err = fmt.Errorf("unable to push proof to remote server(%v): "+
+			"%w", addr.HostStr(), err)
+		f.observePush(addr, err)
+
+		return err
+	}
+
+	f.observePush(addr, nil)
+
+	err = f.cfg.UniverseStats.LogPushEvent(ctx, uniID, key, addr.HostStr())
+	if err != nil {
+		log.Warnf("unable to log push event for server(%v): %v",
+			addr.HostStr(), err)
+	}
+
+	return nil
+}
+
+// checkProofConfs returns an error wrapping ErrInsufficientConfs if the anchor
+// transaction of the given proof has fewer than the minimum number of
+// confirmations required to push it to the federation.
+func (f *FederationEnvoy) checkProofConfs(ctx context.Context,
+	p *proof.Proof) error {
+
+	height, err := currentHeightForConfs(
+		ctx, f.cfg.MinConfs, f.cfg.ChainHeight,
+	)
+	if err != nil {
+		return err
+	}
+
+	return CheckProofConfs(p, f.cfg.MinConfs, height)
+}
+
+// observePush reports the result of a push attempt to the given server to the
+// push observer, if one is set.
+func (f *FederationEnvoy) observePush(addr ServerAddr, err error) {
+	if f.cfg.PushObserver != nil {
+		f.cfg.PushObserver(addr.HostStr(), err)
+	}
+}
+
+// syncInterval returns the interval at which we should sync with the given
+// server. If the server doesn't have a specific interval set, then the global
+// sync interval is used.
+func (f *FederationEnvoy) syncInterval(addr ServerAddr) time.Duration {
+	if addr.SyncInterval > 0 {
+		return addr.SyncInterval
+	}
+
+	return f.cfg.SyncInterval
+}
+
+// nextSyncTime returns the time at which the given server is due for the next
+// sync. This is based on the later of the last successful sync and the last
+// sync attempt, so a server that is unreachable isn't retried on every check.
+func (f *FederationEnvoy) nextSyncTime(addr ServerAddr) time.Time {
+	lastSync := addr.LastSyncTime
+	if lastAttempt, ok := f.lastSyncAttempts[addr.HostStr()]; ok &&
+		lastAttempt.After(lastSync) {
+
+		lastSync = lastAttempt
+	}
+
+	return lastSync.Add(f.syncInterval(addr))
+}
+
+// nextSyncDelay returns the amount of time to wait until the next server in
+// the given set is due for a sync. The delay is never longer than the global
+// sync interval, so newly added servers are picked up in time.
+func (f *FederationEnvoy) nextSyncDelay(servers []ServerAddr,
+	now time.Time) time.Duration {
+
+	delay := f.cfg.SyncInterval
+	for _, s := range servers {
+		serverDelay := f.nextSyncTime(s).Sub(now)
+		if serverDelay < delay {
+			delay = serverDelay
+		}
+	}
+
+	if delay < minSyncCheckInterval {
+		delay = minSyncCheckInterval
+	}
+
+	return delay
+}
+
 // syncer is the main goroutine that's responsible for interacting with the
 // federation envoy. It also accepts incoming requests to push out new updates
 // to the federation.
@@ -293,15 +534,15 @@ func (f *FederationEnvoy) syncer() {
 
 	// TODO(roasbeef): trigger new sync on start up?
 
-	syncTicker := time.NewTicker(f.cfg.SyncInterval)
-	defer syncTicker.Stop()
+	syncTimer := time.NewTimer(f.cfg.SyncInterval)
+	defer syncTimer.Stop()
 
 	for {
 		select {
 		// A new sync event has just been triggered, so we'll attempt
-		// to synchronize state with all the active universe servers in
-		// the federation.
-		case <-syncTicker.C:
+		// to synchronize state with all the universe servers in the
+		// federation that are due for a new sync.
+		case <-syncTimer.C:
 			ctx, cancel := f.WithCtxQuit()
 
 			fedServers, err := f.cfg.FederationDB.UniverseServers(
@@ -317,9 +558,26 @@ func (f *FederationEnvoy) syncer() {
 			}
 			cancel()
 
+			now := time.Now()
+			isDue := func(s ServerAddr) bool {
+				return !f.nextSyncTime(s).After(now)
+			}
+			dueServers := fn.Filter(fedServers, isDue)
+			for _, s := range dueServers {
+				f.lastSyncAttempts[s.HostStr()] = now
+			}
+
+			// Now that we know which servers we'll sync with, we'll
+			// schedule the next sync check.
+			syncTimer.Reset(f.nextSyncDelay(fedServers, now))
+
+			if len(dueServers) == 0 {
+				continue
+			}
+
 			log.Infof("Synchronizing with %v federation members",
-				len(fedServers))
-			err = f.SyncServers(fedServers)
+				len(dueServers))
+			err = f.SyncServers(dueServers)
 			if err != nil {
 				log.Warnf("unable to sync with federation "+
 					"server: %v", err)
@@ -456,13 +714,145 @@ func (f *FederationEnvoy) AddServer(addrs ...ServerAddr) error {
 	log.Infof("Adding new Universe server to Federation, addrs=%v",
 		spew.Sdump(addrs))
 
-	if err := f.cfg.FederationDB.AddServers(ctx, addrs...); err != nil {
+	err := f.addServers(ctx, addrs)
+	if err != nil {
 		return err
 	}
 
 	return f.SyncServers(addrs)
 }
 
+// addServers adds the given servers to the federation and notifies
+// subscribers of the new members.
+func (f *FederationEnvoy) addServers(ctx context.Context,
+	addrs []ServerAddr) error {
+
+	f.eventMtx.Lock()
+	defer f.eventMtx.Unlock()
+
+	if err := f.cfg.FederationDB.AddServers(ctx, addrs...); err != nil {
+		return err
+	}
+
+	f.fedEvents.NotifySubscribers(
+		newServerEvents(FederationEventServerAdded, addrs)...,
+	)
+
+	return nil
+}
+
+// UpdateServerPriority updates the priority of the given federation server.
+// The new priority is used from the next sync with the server onwards.
+func (f *FederationEnvoy) UpdateServerPriority(addr ServerAddr,
+	priority int) error {
+
+	ctx, cancel := f.WithCtxQuit()
+	defer cancel()
+
+	log.Infof("Updating priority of Universe server=%v to %d",
+		addr.HostStr(), priority)
+
+	return f.cfg.FederationDB.UpdateServerPriority(ctx, addr, priority)
+}
+
+// PushLocalState pushes all leaves of the local universe trees that are enabled
+// for proof export to the given federation server. Leaves the remote server
+// rejects don't abort the push, but are returned as a set of failed pushes
+// instead.
+func (f *FederationEnvoy) PushLocalState(ctx context.Context,
+	addr ServerAddr) ([]FailedLeafPush, error) {
+
+	syncConfigs, err := f.QuerySyncConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteRegistrar, err := f.cfg.NewRemoteRegistrar(addr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to remote "+
+			"server(%v): %w", addr.HostStr(), err)
+	}
+
+	localRoots, err := f.cfg.LocalDiffEngine.RootNodes(
+		ctx, RootNodesQuery{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch local roots: %w", err)
+	}
+
+	height, err := currentHeightForConfs(
+		ctx, f.cfg.MinConfs, f.cfg.ChainHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Pushing local state of %v universes to server(%v)",
+		len(localRoots), addr.HostStr())
+
+	var failedPushes []FailedLeafPush
+	for _, root := range localRoots {
+		if !syncConfigs.IsSyncExportEnabled(root.ID) {
+			continue
+		}
+
+		leafKeys, err := f.cfg.LocalDiffEngine.UniverseLeafKeys(
+			ctx, root.ID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch leaf keys for "+
+				"universe(%v): %w", root.ID.StringForLog(), err)
+		}
+
+		for _, key := range leafKeys {
+			proofs, err := f.cfg.LocalDiffEngine.FetchIssuanceProof(
+				ctx, root.ID, key,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to fetch local "+
+					"proof: %w", err)
+			}
+
+			// Leaves that aren't buried deep enough yet are
+			// reported as failed pushes without contacting the
+			// server.
+			leafProof := proofs[0].Leaf.Proof
+			err = CheckProofConfs(leafProof, f.cfg.MinConfs, height)
+			if err == nil {
+				_, err = remoteRegistrar.RegisterIssuance(
+					ctx, root.ID, key, proofs[0].Leaf,
+				)
+			}
+			if err != nil {
+				log.Warnf("Unable to push leaf of "+
+					"universe(%v) to server(%v): %v",
+					root.ID.StringForLog(), addr.HostStr(),
+					err)
+
+				failedPushes = append(failedPushes,
+					FailedLeafPush{
+						ServerAddr: addr,
+						ID:         root.ID,
+						Key:        key,
+						Err:        err,
+					},
+				)
+				continue
+			}
+
+			err = f.cfg.UniverseStats.LogPushEvent(
+				ctx, root.ID, key, addr.HostStr(),
+			)
+			if err != nil {
+				log.Warnf("unable to log push event for "+
+					"server(%v): %v", addr.HostStr(), err)
+			}
+		}
+	}
+
+	return failedPushes, nil
+}
+
 // QuerySyncConfigs returns the current sync configs for the federation.
 func (f *FederationEnvoy) QuerySyncConfigs(
 	ctx context.Context) (*SyncConfigs, error) {
@@ -491,6 +881,7 @@ func (f *FederationEnvoy) SyncServers(serverAddrs []ServerAddr) error {
 	if err != nil {
 		return err
 	}
+	syncConfigs.ResumeSync = f.cfg.ResumeSync
 
 	syncServer := func(ctx context.Context, serverAddr ServerAddr) error {
 		err := f.syncServerState(ctx, serverAddr, *syncConfigs)
@@ -501,9 +892,17 @@ func (f *FederationEnvoy) SyncServers(serverAddrs []ServerAddr) error {
 		return nil
 	}
 
-	err = fn.ParSlice(ctx, serverAddrs, syncServer)
-	if err != nil {
-		log.Warnf("unable to sync with server: %w", err)
+	// Servers with a higher priority are synced first, so their leaves
+	// are known before we sync with servers of a lower priority. Servers
+	// of the same priority are synced in parallel.
+	tiers := priorityTiers(serverAddrs, func(a ServerAddr) int {
+		return a.Priority
+	})
+	for _, tier := range tiers {
+		err = fn.ParSlice(ctx, tier, syncServer)
+		if err != nil {
+			log.Warnf("unable to sync with server: %w", err)
+		}
 	}
 
 	return nil
@@ -541,6 +940,20 @@ type SyncConfigs struct {
 
 	// UniSyncConfigs are the universe specific configs.
 	UniSyncConfigs []*FedUniSyncConfig
+
+	// IDPrefix optionally restricts the sync to the universes whose asset
+	// ID or group key starts with the given lower case hex prefix.
+	IDPrefix string
+
+	// MinConfs optionally overrides the minimum number of confirmations
+	// the anchor transaction of a synced leaf must have. If zero, then the
+	// minimum configured for the syncer is used.
+	MinConfs uint32
+
+	// ResumeSync indicates that the universes that were already synced by
+	// a previous, interrupted sync of the same type with the same server
+	// should be skipped, instead of starting the sync over from scratch.
+	ResumeSync bool
 }
 
 // IsSyncInsertEnabled returns true if the given universe is configured to allow
@@ -0,0 +1,292 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFederationEnvoySyncSchedule tests that the federation envoy takes the
+// server specific sync interval, the last successful sync and the last sync
+// attempt into account when deciding when to sync with a server.
+func TestFederationEnvoySyncSchedule(t *testing.T) {
+	t.Parallel()
+
+	const globalInterval = time.Minute * 10
+
+	envoy := NewFederationEnvoy(FederationConfig{
+		SyncInterval: globalInterval,
+	})
+
+	now := time.Now()
+
+	// The first server uses the global sync interval and was last synced
+	// a minute ago.
+	defaultServer := NewServerAddr(1, "localhost:10029")
+	defaultServer.LastSyncTime = now.Add(-time.Minute)
+
+	// The second server has a shorter sync interval and was last synced
+	// two minutes ago, so it's due for a sync.
+	customServer := NewServerAddr(2, "localhost:10030")
+	customServer.SyncInterval = time.Minute
+	customServer.LastSyncTime = now.Add(-time.Minute * 2)
+
+	require.Equal(
+		t, now.Add(globalInterval-time.Minute),
+		envoy.nextSyncTime(defaultServer),
+	)
+	require.False(t, envoy.nextSyncTime(customServer).After(now))
+
+	// As one of the servers is already due, we should check again as soon
+	// as possible.
+	servers := []ServerAddr{defaultServer, customServer}
+	require.Equal(
+		t, minSyncCheckInterval, envoy.nextSyncDelay(servers, now),
+	)
+
+	// Once we've attempted to sync with the second server, it should only
+	// be due again after its sync interval, even if the sync failed.
+	envoy.lastSyncAttempts[customServer.HostStr()] = now
+	require.Equal(
+		t, now.Add(time.Minute), envoy.nextSyncTime(customServer),
+	)
+	require.Equal(t, time.Minute, envoy.nextSyncDelay(servers, now))
+
+	// Without any servers, we'll fall back to the global sync interval.
+	require.Equal(t, globalInterval, envoy.nextSyncDelay(nil, now))
+}
+
+// mockFederationDB is a mock implementation of the FederationDB interface that
+// serves a static set of servers and sync configs, and keeps track of pending
+// pushes in memory.
+type mockFederationDB struct {
+	FederationDB
+
+	servers []ServerAddr
+
+	globalConfigs []*FedGlobalSyncConfig
+
+	pendingPushes map[int64]PendingPush
+
+	loggedSyncs []ServerAddr
+
+	nextPushID int64
+
+	mtx sync.Mutex
+}
+
+func (m *mockFederationDB) UniverseServers(
+	context.Context) ([]ServerAddr, error) {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return append([]ServerAddr(nil), m.servers...), nil
+}
+
+func (m *mockFederationDB) AddServers(_ context.Context,
+	addrs ...ServerAddr) error {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.servers = append(m.servers, addrs...)
+
+	return nil
+}
+
+func (m *mockFederationDB) RemoveServers(_ context.Context,
+	addrs ...ServerAddr) error {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.servers = fn.Filter(m.servers, func(s ServerAddr) bool {
+		return !fn.Any(addrs, func(a ServerAddr) bool {
+			return a.HostStr() == s.HostStr()
+		})
+	})
+
+	return nil
+}
+
+func (m *mockFederationDB) QueryFederationSyncConfigs(
+	context.Context) ([]*FedGlobalSyncConfig, []*FedUniSyncConfig, error) {
+
+	return m.globalConfigs, nil, nil
+}
+
+func (m *mockFederationDB) LogNewSyncs(_ context.Context,
+	addrs ...ServerAddr) error {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.loggedSyncs = append(m.loggedSyncs, addrs...)
+
+	return nil
+}
+
+func (m *mockFederationDB) QueuePendingPush(_ context.Context,
+	push PendingPush) error {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.pendingPushes == nil {
+		m.pendingPushes = make(map[int64]PendingPush)
+	}
+
+	m.nextPushID++
+	push.ID = m.nextPushID
+	m.pendingPushes[push.ID] = push
+
+	return nil
+}
+
+func (m *mockFederationDB) UpdatePendingPush(_ context.Context,
+	push PendingPush) error {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.pendingPushes[push.ID] = push
+
+	return nil
+}
+
+func (m *mockFederationDB) RemovePendingPush(_ context.Context,
+	id int64) error {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	delete(m.pendingPushes, id)
+
+	return nil
+}
+
+func (m *mockFederationDB) PendingPushes(
+	context.Context) ([]PendingPush, error) {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	pushes := make([]PendingPush, 0, len(m.pendingPushes))
+	for _, push := range m.pendingPushes {
+		pushes = append(pushes, push)
+	}
+
+	return pushes, nil
+}
+
+// mockRegistrar is a mock implementation of the Registrar interface that
+// records all registered leaves, and rejects the leaves with the given keys.
+type mockRegistrar struct {
+	rejectedKeys map[[32]byte]struct{}
+
+	registeredKeys []LeafKey
+}
+
+func (m *mockRegistrar) RegisterIssuance(_ context.Context, _ Identifier,
+	key LeafKey, leaf *Leaf) (*Proof, error) {
+
+	if _, ok := m.rejectedKeys[key.UniverseKey()]; ok {
+		return nil, fmt.Errorf("leaf rejected")
+	}
+
+	m.registeredKeys = append(m.registeredKeys, key)
+
+	return &Proof{LeafKey: key, Leaf: leaf}, nil
+}
+
+// mockTelemetry is a mock implementation of the Telemetry interface that only
+// counts the number of logged push events.
+type mockTelemetry struct {
+	Telemetry
+
+	numPushes int
+}
+
+func (m *mockTelemetry) LogPushEvent(context.Context, Identifier, LeafKey,
+	string) error {
+
+	m.numPushes++
+	return nil
+}
+
+// randLeafKey returns a random leaf key.
+func randLeafKey(t *testing.T) LeafKey {
+	scriptKey := asset.RandScriptKey(t)
+	return LeafKey{
+		OutPoint:  test.RandOp(t),
+		ScriptKey: &scriptKey,
+	}
+}
+
+// TestFederationEnvoyPushLocalState tests that the federation envoy pushes all
+// leaves of the local universes that are enabled for export to a server, and
+// that leaves rejected by the server are reported back.
+func TestFederationEnvoyPushLocalState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// We'll create an issuance and a transfer universe with two leaves
+	// each. Only the issuance universe is enabled for proof export.
+	issuanceRoot := randBaseRoot(t)
+	transferRoot := randBaseRoot(t)
+	transferRoot.ID.ProofType = ProofTypeTransfer
+
+	localEngine := newMockDiffEngine(issuanceRoot, transferRoot)
+	acceptedKey, rejectedKey := randLeafKey(t), randLeafKey(t)
+	localEngine.leafKeys[issuanceRoot.ID] = []LeafKey{
+		acceptedKey, rejectedKey,
+	}
+	localEngine.leafKeys[transferRoot.ID] = []LeafKey{
+		randLeafKey(t), randLeafKey(t),
+	}
+
+	registrar := &mockRegistrar{
+		rejectedKeys: map[[32]byte]struct{}{
+			rejectedKey.UniverseKey(): {},
+		},
+	}
+	telemetry := &mockTelemetry{}
+
+	envoy := NewFederationEnvoy(FederationConfig{
+		FederationDB: &mockFederationDB{
+			globalConfigs: []*FedGlobalSyncConfig{{
+				ProofType:       ProofTypeIssuance,
+				AllowSyncExport: true,
+			}},
+		},
+		LocalDiffEngine: localEngine,
+		NewRemoteRegistrar: func(ServerAddr) (Registrar, error) {
+			return registrar, nil
+		},
+		UniverseStats: telemetry,
+	})
+
+	serverAddr := NewServerAddrFromStr("localhost:10029")
+	failedPushes, err := envoy.PushLocalState(ctx, serverAddr)
+	require.NoError(t, err)
+
+	// Only the accepted leaf of the issuance universe should have been
+	// registered and logged, while the rejected one should be reported.
+	require.Equal(t, []LeafKey{acceptedKey}, registrar.registeredKeys)
+	require.Equal(t, 1, telemetry.numPushes)
+
+	require.Len(t, failedPushes, 1)
+	require.Equal(t, serverAddr, failedPushes[0].ServerAddr)
+	require.Equal(t, issuanceRoot.ID, failedPushes[0].ID)
+	require.Equal(t, rejectedKey, failedPushes[0].Key)
+	require.ErrorContains(t, failedPushes[0].Err, "leaf rejected")
+}
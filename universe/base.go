@@ -6,15 +6,24 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/proof"
 )
 
+const (
+	// DefaultLeafEventBacklog is the default number of recent leaf events
+	// that are kept in memory for subscribers that resume a subscription.
+	DefaultLeafEventBacklog = 1000
+)
+
 // MintingArchiveConfig is the main config for the minting archive. This
 // includes all the items required to interact with the set of relevant base
 // universes.
@@ -40,6 +49,61 @@ type MintingArchiveConfig struct {
 	// external/internal queries to the base universe instance.
 	UniverseStats Telemetry
 
+	// LeafEventBacklog is the maximum number of recent leaf events that
+	// are kept in memory, so they can be delivered to subscribers that
+	// resume their subscription. If zero, DefaultLeafEventBacklog is used.
+	LeafEventBacklog int
+
+	// AssetPolicy is the initial policy that determines which assets
+	// leaves are accepted for from remote parties. The policy can be
+	// updated at runtime with SetAssetPolicy.
+	AssetPolicy AssetPolicy
+
+	// AcceptPushFrom is the initial list of remote hosts that pushed
+	// proofs are accepted from. If empty, pushes from all hosts are
+	// accepted. The list can be updated at runtime with
+	// SetAcceptPushFrom.
+	AcceptPushFrom []string
+
+	// LookupHost is used to resolve the host names of the AcceptPushFrom
+	// list. If nil, only pushes from hosts that are listed by their IP
+	// address are accepted.
+	LookupHost HostLookupFunc
+
+	// MinConfs is the minimum number of confirmations the anchor
+	// transaction of a proof inserted by a remote party must have. Proofs
+	// with a shallower anchor are rejected with ErrInsufficientConfs. A
+	// value of one or less accepts any proof.
+	MinConfs uint32
+
+	// ChainHeight returns the current height of the main chain. This is
+	// only required if MinConfs is larger than one.
+	ChainHeight ChainHeightFunc
+
+	// ProofCacheSize is the maximum number of leaf queries whose proofs
+	// are cached, so they don't need to be generated again for every
+	// request. If zero, proofs are not cached.
+	ProofCacheSize uint64
+
+	// ProofCacheTTL is the maximum amount of time a cached proof is
+	// served. If zero, cached proofs are served until they're evicted or
+	// the universe they're located within changes.
+	ProofCacheTTL time.Duration
+
+	// ConflictPolicy determines which of two diverging leaves with the
+	// same key is retained when a leaf from a remote party is merged into
+	// a universe. This is a local policy, not a consensus rule.
+	ConflictPolicy ConflictPolicy
+
+	// LazyRoots is used to look up the universe roots that were imported
+	// by a roots only sync. If nil, lazy roots aren't supported.
+	LazyRoots LazyRootStore
+
+	// NewRemoteDiffEngine returns a new diff engine tied to a remote
+	// universe. This is used to fetch the leaves of lazy roots from the
+	// server they were synced from.
+	NewRemoteDiffEngine func(ServerAddr) (DiffEngine, error)
+
 	// TODO(roasbeef): query re genesis asset known?
 
 	// TODO(roasbeef): load all at once, or lazy load dynamic?
@@ -59,19 +123,161 @@ type MintingArchive struct {
 	// instances for the archive.
 	baseUniverses map[Identifier]BaseBackend
 
+	// leafEvents is used to notify subscribers of new leaves that are
+	// inserted into any of the base universes.
+	leafEvents *fn.EventDistributor[*LeafEvent]
+
+	// eventBacklog is the set of most recent leaf events. This is used to
+	// deliver missed events to subscribers that resume a subscription.
+	eventBacklog []*LeafEvent
+
+	// nextEventIndex is the index that'll be assigned to the next leaf
+	// event.
+	nextEventIndex uint64
+
+	// eventMtx guards the event backlog and the next event index. It
+	// also ensures that new events aren't delivered to a subscriber
+	// before the backlog.
+	eventMtx sync.Mutex
+
+	// assetPolicy is the current policy that determines which assets
+	// leaves are accepted for from remote parties.
+	assetPolicy AssetPolicy
+
+	// acceptPushFrom is the current list of remote hosts that pushed
+	// proofs are accepted from.
+	acceptPushFrom []string
+
+	// policyMtx guards the asset policy and the push allow list.
+	policyMtx sync.RWMutex
+
+	// proofCache caches recently served universe proofs. This is nil if
+	// proof caching is disabled.
+	proofCache *proofCache
+
+	// pruneMtx is held for reading while new leaves are inserted, and for
+	// writing while assets are pruned or re-organized leaves are removed.
+	// This ensures that no leaf is inserted for an asset while we decide
+	// whether to prune it or one of its leaves.
+	pruneMtx sync.RWMutex
+
 	sync.RWMutex
 }
 
 // NewMintingArchive creates a new minting archive based on the passed config.
 func NewMintingArchive(cfg MintingArchiveConfig) *MintingArchive {
+	if cfg.LeafEventBacklog == 0 {
+		cfg.LeafEventBacklog = DefaultLeafEventBacklog
+	}
+
 	a := &MintingArchive{
-		cfg:           cfg,
-		baseUniverses: make(map[Identifier]BaseBackend),
+		cfg:            cfg,
+		baseUniverses:  make(map[Identifier]BaseBackend),
+		leafEvents:     fn.NewEventDistributor[*LeafEvent](),
+		nextEventIndex: 1,
+		assetPolicy:    cfg.AssetPolicy,
+		acceptPushFrom: cfg.AcceptPushFrom,
+	}
+
+	if cfg.ProofCacheSize != 0 {
+		a.proofCache = newProofCache(
+			cfg.ProofCacheSize, cfg.ProofCacheTTL,
+		)
 	}
 
 	return a
 }
 
+// ProofCacheStats returns the hit and miss counters of the proof cache. All
+// counters are zero if proof caching is disabled.
+func (a *MintingArchive) ProofCacheStats() ProofCacheStats {
+	if a.proofCache == nil {
+		return ProofCacheStats{}
+	}
+
+	return a.proofCache.stats()
+}
+
+// invalidateProofCache marks all cached proofs as stale. This must be called
+// after every write to the multiverse, even if it failed, as the write might
+// have been partially applied.
+func (a *MintingArchive) invalidateProofCache() {
+	if a.proofCache == nil {
+		return
+	}
+
+	a.proofCache.invalidate()
+}
+
+// AssetPolicy returns the current policy that determines which assets leaves
+// are accepted for from remote parties.
+func (a *MintingArchive) AssetPolicy() AssetPolicy {
+	a.policyMtx.RLock()
+	defer a.policyMtx.RUnlock()
+
+	return a.assetPolicy
+}
+
+// SetAssetPolicy replaces the policy that determines which assets leaves are
+// accepted for from remote parties. The new policy applies to all leaves
+// inserted after the call returns.
+func (a *MintingArchive) SetAssetPolicy(policy AssetPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	a.policyMtx.Lock()
+	defer a.policyMtx.Unlock()
+
+	a.assetPolicy = policy
+
+	log.Infof("Updated universe asset policy: num_allowed=%v, "+
+		"num_denied=%v", len(policy.AllowList), len(policy.DenyList))
+
+	return nil
+}
+
+// AcceptPushFrom returns the current list of remote hosts that pushed proofs
+// are accepted from. An empty list means pushes from all hosts are accepted.
+func (a *MintingArchive) AcceptPushFrom() []string {
+	a.policyMtx.RLock()
+	defer a.policyMtx.RUnlock()
+
+	return append([]string(nil), a.acceptPushFrom...)
+}
+
+// SetAcceptPushFrom replaces the list of remote hosts that pushed proofs are
+// accepted from. This list is independent of the federation servers we sync
+// from.
+func (a *MintingArchive) SetAcceptPushFrom(hosts []string) error {
+	normalized, err := fn.MapErr(hosts, NormalizePushHost)
+	if err != nil {
+		return err
+	}
+
+	a.policyMtx.Lock()
+	defer a.policyMtx.Unlock()
+
+	a.acceptPushFrom = normalized
+
+	log.Infof("Updated universe push allow list: num_hosts=%v",
+		len(normalized))
+
+	return nil
+}
+
+// CheckPushHost returns an error wrapping ErrPushNotAllowed if proofs pushed
+// by the given remote host aren't accepted.
+func (a *MintingArchive) CheckPushHost(ctx context.Context, host string) error {
+	err := checkPushHost(ctx, a.AcceptPushFrom(), host, a.cfg.LookupHost)
+	if err != nil {
+		log.Warnf("Rejecting proof push: %v", err)
+		return err
+	}
+
+	return nil
+}
+
 // fetchUniverse returns the base universe instance for the passed identifier.
 // The universe will be loaded in on demand if it has not been seen before.
 func (a *MintingArchive) fetchUniverse(id Identifier) BaseBackend {
@@ -108,12 +314,48 @@ func withBaseUni[T any](fetcher uniFetcher, id Identifier,
 }
 
 // RootNode returns the root node of the base universe corresponding to the
-// passed ID.
+// passed ID. If the universe has a lazy root, then the lazy root is returned.
 func (a *MintingArchive) RootNode(ctx context.Context,
 	id Identifier) (BaseRoot, error) {
 
 	log.Debugf("Looking up root node for base Universe %v", spew.Sdump(id))
 
+	root, err := a.localRootNode(ctx, id)
+
+	root, err = a.withLazyRoot(ctx, id, root, err)
+	if err != nil {
+		return BaseRoot{}, err
+	}
+
+	return a.withBurnRoot(ctx, root)
+}
+
+// withBurnRoot sets the root of the burn tree of the asset on the given
+// universe root. The burn tree only indexes the burns within the local
+// transfer universe, so the burn root isn't set for any other root, or if the
+// transfer universe has a lazy root.
+func (a *MintingArchive) withBurnRoot(ctx context.Context,
+	root BaseRoot) (BaseRoot, error) {
+
+	if root.ID.ProofType != ProofTypeTransfer || root.Lazy {
+		return root, nil
+	}
+
+	burnRoot, err := a.cfg.Multiverse.BurnRoot(ctx, root.ID)
+	if err != nil {
+		return BaseRoot{}, fmt.Errorf("unable to fetch burn root: %w",
+			err)
+	}
+	root.BurnRoot = burnRoot
+
+	return root, nil
+}
+
+// localRootNode returns the root node of the local leaves of the base universe
+// corresponding to the passed ID, ignoring any lazy root.
+func (a *MintingArchive) localRootNode(ctx context.Context,
+	id Identifier) (BaseRoot, error) {
+
 	return withBaseUni(a, id, func(baseUni BaseBackend) (BaseRoot, error) {
 		smtNode, assetName, err := baseUni.RootNode(ctx)
 		if err != nil {
@@ -128,20 +370,101 @@ func (a *MintingArchive) RootNode(ctx context.Context,
 	})
 }
 
-// RootNodes returns the set of root nodes for all known base universes assets.
-func (a *MintingArchive) RootNodes(ctx context.Context) ([]BaseRoot, error) {
-	log.Debugf("Fetching all known Universe roots")
+// RootNodeAtHeight returns the root node of the base universe corresponding to
+// the passed ID as it was at the given block height. This is the root after the
+// most recent leaf at or before that height was inserted.
+func (a *MintingArchive) RootNodeAtHeight(ctx context.Context, id Identifier,
+	height uint32) (BaseRoot, error) {
+
+	log.Debugf("Looking up root node for base Universe %v at height %d",
+		spew.Sdump(id), height)
+
+	return withBaseUni(a, id, func(baseUni BaseBackend) (BaseRoot, error) {
+		smtNode, assetName, err := baseUni.RootNodeAtHeight(ctx, height)
+		if err != nil {
+			return BaseRoot{}, err
+		}
+
+		return BaseRoot{
+			ID:        id,
+			Node:      smtNode,
+			AssetName: assetName,
+		}, nil
+	})
+}
+
+// RootNodes returns the set of root nodes for all known base universes assets
+// that match the given query.
+func (a *MintingArchive) RootNodes(ctx context.Context,
+	q RootNodesQuery) ([]BaseRoot, error) {
+
+	log.Debugf("Fetching all known Universe roots (id_prefix=%q)",
+		q.IDPrefix)
+
+	roots, err := a.cfg.Multiverse.RootNodes(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	roots, err = a.mergeLazyRoots(ctx, q, roots)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx := range roots {
+		roots[idx], err = a.withBurnRoot(ctx, roots[idx])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return roots, nil
+}
+
+// SearchRoots returns the root nodes of all known base universe assets with a
+// name that matches the given query.
+func (a *MintingArchive) SearchRoots(ctx context.Context,
+	q AssetSearchQuery) ([]BaseRoot, error) {
+
+	log.Debugf("Searching Universe roots (name=%q, offset=%v, limit=%v)",
+		q.Name, q.Offset, q.Limit)
+
+	return a.cfg.Multiverse.SearchRoots(ctx, q)
+}
+
+// MultiverseRoot returns the root of the multiverse tree for the given proof
+// type. The multiverse tree commits to all the known universe roots of that
+// proof type, so it can be used to compare the state of two universes.
+func (a *MintingArchive) MultiverseRoot(ctx context.Context,
+	proofType ProofType) (*MultiverseRoot, error) {
 
-	return a.cfg.Multiverse.RootNodes(ctx)
+	log.Debugf("Fetching multiverse root for proof type: %v", proofType)
+
+	return a.cfg.Multiverse.RootNode(ctx, proofType)
 }
 
 // RegisterIssuance attempts to register a new issuance proof for a new minting
 // event for the specified base universe identifier. This method will return an
 // error if the passed minting proof is invalid. If the leaf is already known,
 // then no action is taken and the existing issuance commitment proof returned.
+//
+// NOTE: Any new leaf is treated as originating from the federation. Use
+// RegistrarWithSource to register leaves created locally.
 func (a *MintingArchive) RegisterIssuance(ctx context.Context, id Identifier,
 	key LeafKey, leaf *Leaf) (*Proof, error) {
 
+	return a.registerIssuance(ctx, id, key, leaf, LeafSourceFederation)
+}
+
+// registerIssuance attempts to register a new issuance proof for the specified
+// base universe identifier, notifying subscribers of the new leaf with the
+// given source.
+func (a *MintingArchive) registerIssuance(ctx context.Context, id Identifier,
+	key LeafKey, leaf *Leaf, source LeafSource) (*Proof, error) {
+
+	a.pruneMtx.RLock()
+	defer a.pruneMtx.RUnlock()
+
 	log.Debugf("Inserting new proof into Universe: id=%v, base_key=%v",
 		id.StringForLog(), spew.Sdump(key))
 
@@ -163,6 +486,25 @@ func (a *MintingArchive) RegisterIssuance(ctx context.Context, id Identifier,
 		return nil, err
 	}
 
+	// Leaves inserted by remote parties must be accepted by the asset
+	// policy of this universe, and be buried deep enough in the chain.
+	if source == LeafSourceFederation {
+		err := a.AssetPolicy().CheckAsset(&newProof.Asset)
+		if err != nil {
+			log.Warnf("Rejecting proof for universe %v: %v",
+				id.StringForLog(), err)
+
+			return nil, err
+		}
+
+		if err := a.checkProofConfs(ctx, newProof); err != nil {
+			log.Debugf("Rejecting proof for universe %v: %v",
+				id.StringForLog(), err)
+
+			return nil, err
+		}
+	}
+
 	// We'll first check to see if we already know of this leaf within the
 	// multiverse. If so, then we'll return the existing issuance proof.
 	issuanceProofs, err := a.cfg.Multiverse.FetchProofLeaf(ctx, id, key)
@@ -181,6 +523,16 @@ func (a *MintingArchive) RegisterIssuance(ctx context.Context, id Identifier,
 			return issuanceProof, nil
 		}
 
+		// Diverging leaves from remote parties are subject to the
+		// conflict policy. If the existing leaf is retained, then it
+		// is returned as is.
+		if source == LeafSourceFederation && !a.replacesLeaf(
+			id, key, leaf, issuanceProof.Leaf,
+		) {
+
+			return issuanceProof, nil
+		}
+
 	case errors.Is(err, ErrNoUniverseProofFound):
 		// Don't return an error if we don't find the proof. We will
 		// continue on to insert the new proof.
@@ -218,6 +570,7 @@ func (a *MintingArchive) RegisterIssuance(ctx context.Context, id Identifier,
 	issuanceProof, err := a.cfg.Multiverse.UpsertProofLeaf(
 		ctx, id, key, leaf, assetSnapshot.MetaReveal,
 	)
+	a.invalidateProofCache()
 	if err != nil {
 		return nil, fmt.Errorf("unable to register new "+
 			"issuance: %v", err)
@@ -235,9 +588,31 @@ func (a *MintingArchive) RegisterIssuance(ctx context.Context, id Identifier,
 		}
 	}()
 
+	// Notify any subscribers of the new leaf and the updated root.
+	a.publishLeafEvents(newLeafEvent(BaseRoot{
+		ID:   id,
+		Node: issuanceProof.UniverseRoot,
+	}, key, leaf, source))
+
 	return issuanceProof, nil
 }
 
+// checkProofConfs returns an error wrapping ErrInsufficientConfs if the anchor
+// transaction of the given proof has fewer than the configured minimum number
+// of confirmations.
+func (a *MintingArchive) checkProofConfs(ctx context.Context,
+	p *proof.Proof) error {
+
+	height, err := currentHeightForConfs(
+		ctx, a.cfg.MinConfs, a.cfg.ChainHeight,
+	)
+	if err != nil {
+		return err
+	}
+
+	return CheckProofConfs(p, a.cfg.MinConfs, height)
+}
+
 // verifyIssuanceProof verifies the passed minting leaf is a valid issuance
 // proof, returning the asset snapshot if so.
 func (a *MintingArchive) verifyIssuanceProof(ctx context.Context, id Identifier,
@@ -298,9 +673,23 @@ func extractBatchDeps(batch []*IssuanceItem) map[UniverseKey]*asset.Asset {
 // target universe tree (based on the ID), stored at the base key(s). We assume
 // the proofs within the batch have already been checked that they don't yet
 // exist in the local database.
+//
+// NOTE: Any new leaf is treated as originating from the federation. Use
+// RegistrarWithSource to register leaves created locally.
 func (a *MintingArchive) RegisterNewIssuanceBatch(ctx context.Context,
 	items []*IssuanceItem) error {
 
+	return a.registerNewIssuanceBatch(ctx, items, LeafSourceFederation)
+}
+
+// registerNewIssuanceBatch inserts a batch of new minting leaves, notifying
+// subscribers of the new leaves with the given source.
+func (a *MintingArchive) registerNewIssuanceBatch(ctx context.Context,
+	items []*IssuanceItem, source LeafSource) error {
+
+	a.pruneMtx.RLock()
+	defer a.pruneMtx.RUnlock()
+
 	log.Infof("Verifying %d new proofs for insertion into Universe",
 		len(items))
 
@@ -387,6 +776,7 @@ func (a *MintingArchive) RegisterNewIssuanceBatch(ctx context.Context,
 	log.Infof("Inserting %d verified group anchor proofs into Universe",
 		len(anchorItems))
 	err = a.cfg.Multiverse.RegisterBatchIssuance(ctx, anchorItems)
+	a.invalidateProofCache()
 	if err != nil {
 		return fmt.Errorf("unable to register new group anchor "+
 			"issuance proofs: %w", err)
@@ -400,6 +790,7 @@ func (a *MintingArchive) RegisterNewIssuanceBatch(ctx context.Context,
 	log.Infof("Inserting %d verified proofs into Universe",
 		len(nonAnchorItems))
 	err = a.cfg.Multiverse.RegisterBatchIssuance(ctx, nonAnchorItems)
+	a.invalidateProofCache()
 	if err != nil {
 		return fmt.Errorf("unable to register new issuance proofs: %w",
 			err)
@@ -419,98 +810,551 @@ func (a *MintingArchive) RegisterNewIssuanceBatch(ctx context.Context,
 		}
 	}()
 
+	// Notify any subscribers of the new leaves and the updated roots.
+	a.publishBatchLeafEvents(ctx, items, source)
+
 	return nil
 }
 
-// UniverseKey represents the key used to locate an item within a universe.
-type UniverseKey [32]byte
+// RegisterProofBatch verifies and inserts a batch of new issuance or transfer
+// proofs. Unlike RegisterNewIssuanceBatch, an invalid proof doesn't cause the
+// whole batch to be rejected. Instead, all valid proofs are inserted and the
+// returned slice holds the result for each of the passed items, in the same
+// order. The result is nil if the proof was inserted or is already known. A
+// proof that spends the asset of an invalid proof of the same batch is
+// rejected as well. An error is only returned if the valid proofs couldn't be
+// inserted.
+//
+// NOTE: Any new leaf is treated as originating from the federation.
+func (a *MintingArchive) RegisterProofBatch(ctx context.Context,
+	items []*IssuanceItem) ([]error, error) {
 
-// getPrevAssetSnapshot returns the previous asset snapshot for the passed
-// proof. If the proof is a genesis proof, then nil is returned.
-func (a *MintingArchive) getPrevAssetSnapshot(ctx context.Context,
-	uniID Identifier, newProof proof.Proof,
-	batchAssets map[UniverseKey]*asset.Asset) (*proof.AssetSnapshot, error) {
+	a.pruneMtx.RLock()
+	defer a.pruneMtx.RUnlock()
 
-	// If this is a genesis proof, then there is no previous asset (and
-	// therefore no previous asset snapshot).
-	if newProof.Asset.IsGenesisAsset() {
-		return nil, nil
+	log.Infof("Verifying %d new proofs for batch insertion into Universe",
+		len(items))
+
+	itemErrs := make([]error, len(items))
+
+	// We'll first run all the checks that don't require verifying the
+	// proof itself, skipping any proof that we already know of. As in
+	// registerNewIssuanceBatch, group anchors are verified and stored
+	// before any other proof, as reissuances into the same group may be
+	// part of this batch.
+	var (
+		newIdxs, anchorIdxs, nonAnchorIdxs []int
+		newItems                           []*IssuanceItem
+	)
+	for idx, item := range items {
+		known, err := a.checkBatchItem(ctx, item)
+		switch {
+		case err != nil:
+			itemErrs[idx] = err
+			continue
+
+		case known:
+			continue
+		}
+
+		newIdxs = append(newIdxs, idx)
+		newItems = append(newItems, item)
+
+		if item.Leaf.Proof.GroupKeyReveal != nil {
+			anchorIdxs = append(anchorIdxs, idx)
+		} else {
+			nonAnchorIdxs = append(nonAnchorIdxs, idx)
+		}
 	}
 
-	// Query for proof associated with the previous asset.
-	prevID, err := newProof.Asset.PrimaryPrevID()
-	if err != nil {
-		return nil, err
+	batchDeps := extractBatchDeps(newItems)
+
+	// verifyItems verifies the items at the given indexes in parallel,
+	// recording the result of each verification.
+	verifyItems := func(idxs []int) error {
+		return fn.ParSlice(
+			ctx, idxs, func(ctx context.Context, idx int) error {
+				itemErrs[idx] = a.verifyBatchItem(
+					ctx, items[idx], batchDeps,
+				)
+
+				return nil
+			},
+		)
 	}
 
-	if prevID == nil {
-		return nil, fmt.Errorf("no previous asset ID found")
+	// validItems returns the items at the given indexes that haven't been
+	// rejected.
+	validItems := func(idxs []int) []*IssuanceItem {
+		valid := make([]*IssuanceItem, 0, len(idxs))
+		for _, idx := range idxs {
+			if itemErrs[idx] == nil {
+				valid = append(valid, items[idx])
+			}
+		}
+
+		return valid
 	}
 
-	// Parse script key for previous asset.
-	prevScriptKeyPubKey, err := btcec.ParsePubKey(
-		prevID.ScriptKey[:],
-	)
+	err := verifyItems(anchorIdxs)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse previous "+
-			"script key: %v", err)
+		return nil, fmt.Errorf("unable to verify issuance proofs: %w",
+			err)
 	}
-	prevScriptKey := asset.NewScriptKey(prevScriptKeyPubKey)
 
-	prevLeafKey := LeafKey{
-		OutPoint:  prevID.OutPoint,
-		ScriptKey: &prevScriptKey,
+	anchorItems := validItems(anchorIdxs)
+	if len(anchorItems) > 0 {
+		log.Infof("Inserting %d verified group anchor proofs into "+
+			"Universe", len(anchorItems))
+		err = a.cfg.Multiverse.RegisterBatchIssuance(ctx, anchorItems)
+		a.invalidateProofCache()
+		if err != nil {
+			return nil, fmt.Errorf("unable to register new group "+
+				"anchor issuance proofs: %w", err)
+		}
 	}
 
-	// First, we'll check if the prev asset that we need is already amongst
-	// the batch we have, if so then we won't have it in our universe tree
-	// yet, so we'll return it directly.
-	if batchAssets != nil {
-		newAsset := newProof.Asset
-		newScriptKey := newAsset.ScriptKey.PubKey.SerializeCompressed()
+	err = verifyItems(nonAnchorIdxs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to verify issuance proofs: %w",
+			err)
+	}
 
-		inputAsset, ok := batchAssets[prevLeafKey.UniverseKey()]
-		if ok {
-			log.Debugf("script_key=%x spends item in batch, "+
-				"universe_key=%x using batch input",
-				newScriptKey, prevLeafKey.UniverseKey())
+	// Any proof that was verified against the asset of a rejected proof
+	// from this batch must be rejected as well.
+	rejectInvalidBatchDeps(items, newIdxs, itemErrs)
 
-			return &proof.AssetSnapshot{
-				Asset:    inputAsset,
-				OutPoint: prevID.OutPoint,
-			}, nil
+	nonAnchorItems := validItems(nonAnchorIdxs)
+	if len(nonAnchorItems) > 0 {
+		log.Infof("Inserting %d verified proofs into Universe",
+			len(nonAnchorItems))
+		err = a.cfg.Multiverse.RegisterBatchIssuance(
+			ctx, nonAnchorItems,
+		)
+		a.invalidateProofCache()
+		if err != nil {
+			return nil, fmt.Errorf("unable to register new "+
+				"issuance proofs: %w", err)
 		}
 	}
 
-	// If we can't find the previous asset in the batch, then we'll query
-	// our local universe.
-	prevProofs, err := a.cfg.Multiverse.FetchProofLeaf(
-		ctx, uniID, prevLeafKey,
+	insertedItems := make(
+		[]*IssuanceItem, 0, len(anchorItems)+len(nonAnchorItems),
 	)
+	insertedItems = append(insertedItems, anchorItems...)
+	insertedItems = append(insertedItems, nonAnchorItems...)
+	if len(insertedItems) == 0 {
+		return itemErrs, nil
+	}
 
-	// If we've failed in finding the previous proof in the transfer
-	// universe, we will try to find it in the issuance universe.
-	if uniID.ProofType == ProofTypeTransfer &&
-		errors.Is(err, ErrNoUniverseProofFound) {
-
-		uniID.ProofType = ProofTypeIssuance
-		prevProofs, err = a.cfg.Multiverse.FetchProofLeaf(
-			ctx, uniID, prevLeafKey,
+	// Log a sync event for the newly inserted leaves in the background as
+	// an async goroutine.
+	ids := fn.Map(insertedItems, func(item *IssuanceItem) Identifier {
+		return item.ID
+	})
+	go func() {
+		err := a.cfg.UniverseStats.LogNewProofEvents(
+			context.Background(), ids...,
 		)
+		if err != nil {
+			log.Warnf("unable to log new proof events: %v", err)
+		}
+	}()
+
+	// Notify any subscribers of the new leaves and the updated roots.
+	a.publishBatchLeafEvents(ctx, insertedItems, LeafSourceFederation)
+
+	return itemErrs, nil
+}
+
+// checkBatchItem runs the checks of a new batch item that don't require the
+// proof to be verified. It returns true if the proof of the item is already
+// known, in which case it doesn't need to be inserted.
+func (a *MintingArchive) checkBatchItem(ctx context.Context,
+	item *IssuanceItem) (bool, error) {
+
+	newProof := item.Leaf.Proof
+
+	// If unspecified, set universe ID proof type based on leaf proof type.
+	if item.ID.ProofType == ProofTypeUnspecified {
+		var err error
+		item.ID.ProofType, err = NewProofTypeFromAssetProof(newProof)
+		if err != nil {
+			return false, err
+		}
 	}
+
+	// Ensure that the target universe ID proof type corresponds to the
+	// leaf proof type.
+	err := ValidateProofUniverseType(newProof, item.ID)
 	if err != nil {
-		return nil, fmt.Errorf("unable to fetch previous "+
-			"proof: %v, id=%v, leaf_key=%v, new_script_key=%x", err,
-			spew.Sdump(uniID), spew.Sdump(prevLeafKey),
-			newProof.Asset.ScriptKey.PubKey.SerializeCompressed())
+		return false, err
 	}
 
-	prevProof := prevProofs[0].Leaf.Proof
+	// Leaves inserted by remote parties must be accepted by the asset
+	// policy of this universe.
+	err = a.AssetPolicy().CheckAsset(&newProof.Asset)
+	if err != nil {
+		log.Warnf("Rejecting proof for universe %v: %v",
+			item.ID.StringForLog(), err)
 
-	// TODO(roasbeef): need more than one snapshot, for inputs
+		return false, err
+	}
 
-	// Construct minimal asset snapshot for previous asset.
-	// This is a minimal the proof verification result for the
+	if err := a.checkProofConfs(ctx, newProof); err != nil {
+		log.Debugf("Rejecting proof for universe %v: %v",
+			item.ID.StringForLog(), err)
+
+		return false, err
+	}
+
+	// As with single insertions, an existing proof is only updated if the
+	// mint TX was re-organized out of the chain.
+	issuanceProofs, err := a.cfg.Multiverse.FetchProofLeaf(
+		ctx, item.ID, item.Key,
+	)
+	switch {
+	case err == nil && len(issuanceProofs) > 0:
+		existingLeaf := issuanceProofs[0].Leaf
+		existingProof := existingLeaf.Proof
+		if existingProof.BlockHeader.BlockHash() ==
+			newProof.BlockHeader.BlockHash() {
+
+			return true, nil
+		}
+
+		// The leaves diverge, so the conflict policy decides which
+		// of them we retain.
+		if !a.replacesLeaf(item.ID, item.Key, item.Leaf, existingLeaf) {
+			return true, nil
+		}
+
+		return false, nil
+
+	case errors.Is(err, ErrNoUniverseProofFound):
+		return false, nil
+
+	case err != nil:
+		return false, err
+	}
+
+	return false, nil
+}
+
+// replacesLeaf returns true if the new leaf from a remote party replaces the
+// diverging existing leaf, according to the configured conflict policy. If
+// either of the leaves is rejected, then both candidates are logged.
+func (a *MintingArchive) replacesLeaf(id Identifier, key LeafKey, newLeaf,
+	existingLeaf *Leaf) bool {
+
+	policy := a.cfg.ConflictPolicy
+	replace := policy.ReplacesLeaf(
+		newLeaf, existingLeaf, a.cfg.HeaderVerifier,
+	)
+
+	kept, rejected := existingLeaf, newLeaf
+	if replace {
+		kept, rejected = newLeaf, existingLeaf
+	}
+
+	log.Warnf("Conflicting leaves for universe %v (key=%x), retaining "+
+		"%v and rejecting %v according to conflict policy %v",
+		id.StringForLog(), key.UniverseKey(),
+		describeConflictLeaf(kept), describeConflictLeaf(rejected),
+		policy)
+
+	return replace
+}
+
+// verifyBatchItem verifies the proof of a batch item, using the assets of the
+// batch to look up the previous asset of the proof if needed.
+func (a *MintingArchive) verifyBatchItem(ctx context.Context,
+	item *IssuanceItem, batchDeps map[UniverseKey]*asset.Asset) error {
+
+	prevAssets, err := a.getPrevAssetSnapshot(
+		ctx, item.ID, *item.Leaf.Proof, batchDeps,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to fetch previous asset snapshot: %w",
+			err)
+	}
+
+	assetSnapshot, err := a.verifyIssuanceProof(
+		ctx, item.ID, item.Key, item.Leaf, prevAssets,
+	)
+	if err != nil {
+		return err
+	}
+
+	item.MetaReveal = assetSnapshot.MetaReveal
+
+	return nil
+}
+
+// rejectInvalidBatchDeps marks any of the items at the given indexes that
+// spends the asset of a rejected item at those indexes as rejected. As the
+// newly rejected items may be spent within the batch as well, this is repeated
+// until no more items are rejected.
+func rejectInvalidBatchDeps(items []*IssuanceItem, idxs []int,
+	itemErrs []error) {
+
+	for {
+		rejectedKeys := make(map[UniverseKey]struct{})
+		for _, idx := range idxs {
+			if itemErrs[idx] != nil {
+				key := items[idx].Key.UniverseKey()
+				rejectedKeys[key] = struct{}{}
+			}
+		}
+
+		var numRejected int
+		for _, idx := range idxs {
+			newProof := items[idx].Leaf.Proof
+			if itemErrs[idx] != nil ||
+				newProof.Asset.IsGenesisAsset() {
+
+				continue
+			}
+
+			// An item without a valid previous asset would've
+			// failed verification already.
+			_, prevLeafKey, err := prevAssetLeafKey(*newProof)
+			if err != nil {
+				continue
+			}
+
+			prevKey := prevLeafKey.UniverseKey()
+			if _, ok := rejectedKeys[prevKey]; ok {
+				itemErrs[idx] = fmt.Errorf("input proof "+
+					"(leaf_key=%x) of batch is invalid",
+					prevKey[:])
+				numRejected++
+			}
+		}
+
+		if numRejected == 0 {
+			return
+		}
+	}
+}
+
+// publishBatchLeafEvents publishes a new leaf event for each item of a batch
+// that was just inserted. As the batch insertion doesn't return the updated
+// roots, we'll look up the new root of each distinct universe.
+func (a *MintingArchive) publishBatchLeafEvents(ctx context.Context,
+	items []*IssuanceItem, source LeafSource) {
+
+	roots := make(map[string]BaseRoot)
+	events := make([]*LeafEvent, 0, len(items))
+	for _, item := range items {
+		root, ok := roots[item.ID.String()]
+		if !ok {
+			var err error
+			root, err = a.localRootNode(ctx, item.ID)
+			if err != nil {
+				log.Warnf("Unable to fetch root for leaf "+
+					"event (id=%v): %v",
+					item.ID.StringForLog(), err)
+
+				continue
+			}
+
+			roots[item.ID.String()] = root
+		}
+
+		events = append(
+			events, newLeafEvent(root, item.Key, item.Leaf, source),
+		)
+	}
+
+	a.publishLeafEvents(events...)
+}
+
+// publishLeafEvents assigns an index to each of the passed events, adds them
+// to the event backlog and then notifies all active subscribers.
+func (a *MintingArchive) publishLeafEvents(events ...*LeafEvent) {
+	a.eventMtx.Lock()
+	defer a.eventMtx.Unlock()
+
+	for _, event := range events {
+		event.Index = a.nextEventIndex
+		a.nextEventIndex++
+	}
+
+	a.eventBacklog = append(a.eventBacklog, events...)
+	if len(a.eventBacklog) > a.cfg.LeafEventBacklog {
+		numPruned := len(a.eventBacklog) - a.cfg.LeafEventBacklog
+		a.eventBacklog = append(
+			[]*LeafEvent(nil), a.eventBacklog[numPruned:]...,
+		)
+	}
+
+	a.leafEvents.NotifySubscribers(events...)
+}
+
+// RegisterSubscriber adds a new subscriber for receiving new leaf events. The
+// deliverExisting boolean indicates whether events from the in-memory backlog
+// should be delivered when the subscription is started. In that case, only
+// events with an index greater than deliverFrom are delivered.
+//
+// NOTE: This is part of the fn.EventPublisher interface.
+func (a *MintingArchive) RegisterSubscriber(
+	receiver *fn.EventReceiver[*LeafEvent], deliverExisting bool,
+	deliverFrom uint64) error {
+
+	a.eventMtx.Lock()
+	defer a.eventMtx.Unlock()
+
+	a.leafEvents.RegisterSubscriber(receiver)
+
+	// No delivery of existing items requested, we're done here.
+	if !deliverExisting {
+		return nil
+	}
+
+	for _, event := range a.eventBacklog {
+		if event.Index <= deliverFrom {
+			continue
+		}
+
+		receiver.NewItemCreated.ChanIn() <- event
+	}
+
+	return nil
+}
+
+// RemoveSubscriber removes the given subscriber and also stops it from
+// processing events.
+//
+// NOTE: This is part of the fn.EventPublisher interface.
+func (a *MintingArchive) RemoveSubscriber(
+	subscriber *fn.EventReceiver[*LeafEvent]) error {
+
+	return a.leafEvents.RemoveSubscriber(subscriber)
+}
+
+// RegistrarWithSource returns a batch registrar backed by the archive that
+// marks all new leaves as originating from the given source when notifying
+// subscribers.
+func (a *MintingArchive) RegistrarWithSource(
+	source LeafSource) BatchRegistrar {
+
+	return &sourceRegistrar{
+		archive: a,
+		source:  source,
+	}
+}
+
+// sourceRegistrar is a BatchRegistrar that registers new leaves with the
+// minting archive using a fixed leaf source.
+type sourceRegistrar struct {
+	archive *MintingArchive
+	source  LeafSource
+}
+
+// RegisterIssuance inserts a new minting leaf within the target universe tree
+// (based on the ID), stored at the base key.
+//
+// NOTE: This is part of the universe.Registrar interface.
+func (s *sourceRegistrar) RegisterIssuance(ctx context.Context, id Identifier,
+	key LeafKey, leaf *Leaf) (*Proof, error) {
+
+	return s.archive.registerIssuance(ctx, id, key, leaf, s.source)
+}
+
+// RegisterNewIssuanceBatch inserts a batch of new minting leaves within the
+// target universe tree (based on the ID), stored at the base key(s).
+//
+// NOTE: This is part of the universe.BatchRegistrar interface.
+func (s *sourceRegistrar) RegisterNewIssuanceBatch(ctx context.Context,
+	items []*IssuanceItem) error {
+
+	return s.archive.registerNewIssuanceBatch(ctx, items, s.source)
+}
+
+// newLeafEvent creates a new leaf event for the given root, key and leaf.
+func newLeafEvent(root BaseRoot, key LeafKey, leaf *Leaf,
+	source LeafSource) *LeafEvent {
+
+	return &LeafEvent{
+		timestamp:    time.Now().UTC(),
+		UniverseRoot: root,
+		Key:          key,
+		Leaf:         leaf,
+		Source:       source,
+	}
+}
+
+// UniverseKey represents the key used to locate an item within a universe.
+type UniverseKey [32]byte
+
+// getPrevAssetSnapshot returns the previous asset snapshot for the passed
+// proof. If the proof is a genesis proof, then nil is returned.
+func (a *MintingArchive) getPrevAssetSnapshot(ctx context.Context,
+	uniID Identifier, newProof proof.Proof,
+	batchAssets map[UniverseKey]*asset.Asset) (*proof.AssetSnapshot, error) {
+
+	// If this is a genesis proof, then there is no previous asset (and
+	// therefore no previous asset snapshot).
+	if newProof.Asset.IsGenesisAsset() {
+		return nil, nil
+	}
+
+	// Query for proof associated with the previous asset.
+	prevID, prevLeafKey, err := prevAssetLeafKey(newProof)
+	if err != nil {
+		return nil, err
+	}
+
+	// First, we'll check if the prev asset that we need is already amongst
+	// the batch we have, if so then we won't have it in our universe tree
+	// yet, so we'll return it directly.
+	if batchAssets != nil {
+		newAsset := newProof.Asset
+		newScriptKey := newAsset.ScriptKey.PubKey.SerializeCompressed()
+
+		inputAsset, ok := batchAssets[prevLeafKey.UniverseKey()]
+		if ok {
+			log.Debugf("script_key=%x spends item in batch, "+
+				"universe_key=%x using batch input",
+				newScriptKey, prevLeafKey.UniverseKey())
+
+			return &proof.AssetSnapshot{
+				Asset:    inputAsset,
+				OutPoint: prevID.OutPoint,
+			}, nil
+		}
+	}
+
+	// If we can't find the previous asset in the batch, then we'll query
+	// our local universe.
+	prevProofs, err := a.cfg.Multiverse.FetchProofLeaf(
+		ctx, uniID, prevLeafKey,
+	)
+
+	// If we've failed in finding the previous proof in the transfer
+	// universe, we will try to find it in the issuance universe.
+	if uniID.ProofType == ProofTypeTransfer &&
+		errors.Is(err, ErrNoUniverseProofFound) {
+
+		uniID.ProofType = ProofTypeIssuance
+		prevProofs, err = a.cfg.Multiverse.FetchProofLeaf(
+			ctx, uniID, prevLeafKey,
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch previous "+
+			"proof: %v, id=%v, leaf_key=%v, new_script_key=%x", err,
+			spew.Sdump(uniID), spew.Sdump(prevLeafKey),
+			newProof.Asset.ScriptKey.PubKey.SerializeCompressed())
+	}
+
+	prevProof := prevProofs[0].Leaf.Proof
+
+	// TODO(roasbeef): need more than one snapshot, for inputs
+
+	// Construct minimal asset snapshot for previous asset.
+	// This is a minimal the proof verification result for the
 	// previous (input) asset. We know that it was already verified
 	// as it was present in the multiverse/universe archive.
 	return &proof.AssetSnapshot{
@@ -519,8 +1363,39 @@ func (a *MintingArchive) getPrevAssetSnapshot(ctx context.Context,
 	}, nil
 }
 
+// prevAssetLeafKey returns the primary previous asset ID of the asset in the
+// passed (non-genesis) proof, along with the universe leaf key of the proof
+// for that previous asset.
+func prevAssetLeafKey(newProof proof.Proof) (*asset.PrevID, LeafKey, error) {
+	prevID, err := newProof.Asset.PrimaryPrevID()
+	if err != nil {
+		return nil, LeafKey{}, err
+	}
+
+	if prevID == nil {
+		return nil, LeafKey{}, fmt.Errorf("no previous asset ID found")
+	}
+
+	// Parse script key for previous asset.
+	prevScriptKeyPubKey, err := btcec.ParsePubKey(
+		prevID.ScriptKey[:],
+	)
+	if err != nil {
+		return nil, LeafKey{}, fmt.Errorf("unable to parse previous "+
+			"script key: %v", err)
+	}
+	prevScriptKey := asset.NewScriptKey(prevScriptKeyPubKey)
+
+	return prevID, LeafKey{
+		OutPoint:  prevID.OutPoint,
+		ScriptKey: &prevScriptKey,
+	}, nil
+}
+
 // FetchIssuanceProof attempts to fetch an issuance proof for the target base
-// leaf based on the universe identifier (assetID/groupKey).
+// leaf based on the universe identifier (assetID/groupKey). If proof caching
+// is enabled, proofs that were already served for the current multiverse state
+// are returned from the cache.
 func (a *MintingArchive) FetchIssuanceProof(ctx context.Context, id Identifier,
 	key LeafKey) ([]*Proof, error) {
 
@@ -542,7 +1417,48 @@ func (a *MintingArchive) FetchIssuanceProof(ctx context.Context, id Identifier,
 		}()
 	}()
 
-	return a.cfg.Multiverse.FetchProofLeaf(ctx, id, key)
+	if a.proofCache == nil {
+		return a.fetchProofLeaf(ctx, id, key)
+	}
+
+	proofs, generation, ok := a.proofCache.fetch(id, key)
+	if ok {
+		return proofs, nil
+	}
+
+	proofs, err := a.fetchProofLeaf(ctx, id, key)
+	if err != nil {
+		return nil, err
+	}
+
+	a.proofCache.add(id, key, proofs, generation)
+
+	return proofs, nil
+}
+
+// fetchProofLeaf fetches the proof of the target leaf from the multiverse. If
+// the leaf isn't known locally but the universe has a lazy root, then the proof
+// is fetched from the server the lazy root was synced from instead.
+func (a *MintingArchive) fetchProofLeaf(ctx context.Context, id Identifier,
+	key LeafKey) ([]*Proof, error) {
+
+	proofs, err := a.cfg.Multiverse.FetchProofLeaf(ctx, id, key)
+	if !errors.Is(err, ErrNoUniverseProofFound) &&
+		!errors.Is(err, ErrNoUniverseRoot) {
+
+		return proofs, err
+	}
+
+	lazyRoot, lazyErr := a.fetchLazyRoot(ctx, id)
+	switch {
+	case lazyErr != nil:
+		return nil, lazyErr
+
+	case lazyRoot == nil:
+		return nil, err
+	}
+
+	return a.fetchLazyProof(ctx, lazyRoot, key)
 }
 
 // UniverseLeafKeys returns the set of leaf keys known for the specified
@@ -557,6 +1473,19 @@ func (a *MintingArchive) UniverseLeafKeys(ctx context.Context,
 	})
 }
 
+// UniverseLeafKeysSince returns the set of leaf keys inserted into the
+// specified universe after it had the given root hash.
+func (a *MintingArchive) UniverseLeafKeysSince(ctx context.Context,
+	id Identifier, rootHash mssmt.NodeHash) ([]LeafKey, error) {
+
+	log.Debugf("Retrieving keys for Universe: id=%v since root=%v",
+		id.StringForLog(), rootHash)
+
+	return withBaseUni(a, id, func(baseUni BaseBackend) ([]LeafKey, error) {
+		return baseUni.MintingKeysSince(ctx, rootHash)
+	})
+}
+
 // MintingLeaves returns the set of minting leaves known for the specified base
 // universe.
 func (a *MintingArchive) MintingLeaves(ctx context.Context,
@@ -572,6 +1501,84 @@ func (a *MintingArchive) MintingLeaves(ctx context.Context,
 	)
 }
 
+// AssetSupply is the total supply of an asset group, as committed to by the
+// issuance universe and the burn tree of the group.
+type AssetSupply struct {
+	// TotalSupply is the sum of the amounts of all issuance leaves of the
+	// group, minus the burned supply.
+	TotalSupply uint64
+
+	// BurnedSupply is the sum of the burn tree of the group, which is the
+	// total amount of all known burns.
+	BurnedSupply uint64
+
+	// NumIssuances is the number of issuance leaves of the group.
+	NumIssuances uint64
+}
+
+// QueryAssetSupply returns the total supply committed across all issuances
+// of the asset group with the given key, minus the supply that was burned.
+// The issued supply is summed over the issuance leaves of the group and
+// checked against the sum of the issuance universe root, the burned supply is
+// the sum of the burn tree of the group. A zero supply is returned for an
+// unknown group.
+func (a *MintingArchive) QueryAssetSupply(ctx context.Context,
+	groupKey *btcec.PublicKey) (*AssetSupply, error) {
+
+	id := Identifier{
+		GroupKey:  groupKey,
+		ProofType: ProofTypeIssuance,
+	}
+
+	log.Debugf("Querying asset supply for Universe: id=%v",
+		id.StringForLog())
+
+	root, err := a.localRootNode(ctx, id)
+	switch {
+	case errors.Is(err, ErrNoUniverseRoot):
+		return &AssetSupply{}, nil
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch universe root: %w", err)
+	}
+
+	leaves, err := a.MintingLeaves(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch issuance leaves: %w",
+			err)
+	}
+
+	var issuedSupply uint64
+	for _, leaf := range leaves {
+		issuedSupply += leaf.Amt
+	}
+
+	// The sum of the leaves must match the sum committed to by the root,
+	// otherwise the leaves don't reflect the state of the tree.
+	if issuedSupply != root.NodeSum() {
+		return nil, fmt.Errorf("sum of issuance leaves (%d) doesn't "+
+			"match universe root sum (%d)", issuedSupply,
+			root.NodeSum())
+	}
+
+	burnRoot, err := a.cfg.Multiverse.BurnRoot(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch burn root: %w", err)
+	}
+
+	burnedSupply := burnRoot.NodeSum()
+	if burnedSupply > issuedSupply {
+		return nil, fmt.Errorf("burned supply (%d) exceeds issued "+
+			"supply (%d)", burnedSupply, issuedSupply)
+	}
+
+	return &AssetSupply{
+		TotalSupply:  issuedSupply - burnedSupply,
+		BurnedSupply: burnedSupply,
+		NumIssuances: uint64(len(leaves)),
+	}, nil
+}
+
 // DeleteRoot deletes all universe leaves, and the universe root, for the
 // specified base universe.
 func (a *MintingArchive) DeleteRoot(ctx context.Context,
@@ -579,14 +1586,249 @@ func (a *MintingArchive) DeleteRoot(ctx context.Context,
 
 	log.Debugf("Deleting universe tree for Universe: id=%v", id.String())
 
+	if a.cfg.LazyRoots != nil {
+		err := a.cfg.LazyRoots.DeleteLazyRoot(ctx, id)
+		if err != nil {
+			return "", fmt.Errorf("unable to delete lazy root: %w",
+				err)
+		}
+	}
+
 	uniStr, err := withBaseUni(a, id, func(baseUni BaseBackend) (string,
 		error) {
 
 		return baseUni.DeleteUniverse(ctx)
 	})
+	a.invalidateProofCache()
 
 	if err != nil {
 		log.Tracef("Failed to delete universe tree: %w", err)
 	}
 	return uniStr, err
 }
+
+// DeleteLeaf deletes the leaf stored at the given key from the specified base
+// universe, returning the new universe root. If the universe is empty after
+// the leaf is removed, then the returned root has no node set. Deleting a leaf
+// that doesn't exist is a no-op.
+func (a *MintingArchive) DeleteLeaf(ctx context.Context, id Identifier,
+	key LeafKey) (BaseRoot, error) {
+
+	log.Debugf("Deleting universe leaf: id=%v, base_key=%v",
+		id.StringForLog(), spew.Sdump(key))
+
+	newRoot, err := a.cfg.Multiverse.DeleteProofLeaf(ctx, id, key)
+	a.invalidateProofCache()
+	if err != nil {
+		return BaseRoot{}, err
+	}
+
+	return BaseRoot{
+		ID:   id,
+		Node: newRoot,
+	}, nil
+}
+
+// RemoveReOrgedLeaf removes the leaf stored at the given key from the
+// specified base universe if its proof is still anchored in the block with the
+// given hash, which was re-organized out of the chain. Subscribers are notified
+// of the removal and the new universe root. False is returned if the leaf
+// doesn't exist or was already updated with a proof for another block.
+func (a *MintingArchive) RemoveReOrgedLeaf(ctx context.Context, id Identifier,
+	key LeafKey, blockHash chainhash.Hash) (bool, error) {
+
+	// We hold the insertion lock, so an updated proof for the same leaf
+	// can't be inserted between our check and the removal of the leaf.
+	a.pruneMtx.Lock()
+	defer a.pruneMtx.Unlock()
+
+	leafProofs, err := a.cfg.Multiverse.FetchProofLeaf(ctx, id, key)
+	switch {
+	case errors.Is(err, ErrNoUniverseProofFound):
+		return false, nil
+
+	case err != nil:
+		return false, fmt.Errorf("unable to fetch leaf: %w", err)
+
+	case len(leafProofs) == 0:
+		return false, nil
+	}
+
+	leaf := leafProofs[0].Leaf
+	if leaf.Proof.BlockHeader.BlockHash() != blockHash {
+		log.Debugf("Leaf of universe %v was already updated after "+
+			"re-org of block %v, not removing it",
+			id.StringForLog(), blockHash)
+
+		return false, nil
+	}
+
+	newRoot, err := a.DeleteLeaf(ctx, id, key)
+	if err != nil {
+		return false, fmt.Errorf("unable to delete leaf: %w", err)
+	}
+
+	log.Infof("Removed leaf of universe %v anchored in re-organized "+
+		"block %v", id.StringForLog(), blockHash)
+
+	event := newLeafEvent(newRoot, key, leaf, LeafSourceFederation)
+	event.Removed = true
+	a.publishLeafEvents(event)
+
+	return true, nil
+}
+
+// PruneUniverses removes the issuance and transfer universes of all assets
+// that match the given criteria, and returns the assets that were pruned. If
+// the criteria specify a dry run, then the matching assets are only returned.
+// Assets are identified by their issuance universe, so a transfer universe
+// without a known issuance universe is never pruned.
+func (a *MintingArchive) PruneUniverses(ctx context.Context,
+	criteria PruneCriteria) ([]PrunedAsset, error) {
+
+	if !criteria.ZeroSupply && criteria.MaxUpdateHeight == 0 {
+		return nil, fmt.Errorf("at least one prune criterion must be " +
+			"set")
+	}
+
+	// We hold the prune mutex for the whole operation, so no new leaves
+	// can be inserted by a concurrent sync between deciding to prune an
+	// asset and actually removing its universes.
+	a.pruneMtx.Lock()
+	defer a.pruneMtx.Unlock()
+
+	roots, err := a.cfg.Multiverse.RootNodes(ctx, RootNodesQuery{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch universe roots: %w",
+			err)
+	}
+
+	var pruned []PrunedAsset
+	for _, root := range roots {
+		if root.ID.ProofType != ProofTypeIssuance {
+			continue
+		}
+
+		prunedAsset, match, err := a.checkPruneCriteria(
+			ctx, root, criteria,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if !match {
+			continue
+		}
+
+		pruned = append(pruned, *prunedAsset)
+
+		if criteria.DryRun {
+			continue
+		}
+
+		for _, id := range pruneIDs(root.ID) {
+			_, err := a.DeleteRoot(ctx, id)
+			if err != nil {
+				return nil, fmt.Errorf("unable to prune "+
+					"universe %v: %w", id.StringForLog(),
+					err)
+			}
+		}
+
+		log.Infof("Pruned universes of asset %v",
+			prunedAsset.ID.StringForLog())
+	}
+
+	return pruned, nil
+}
+
+// checkPruneCriteria determines whether the asset of the given issuance root
+// matches the prune criteria.
+func (a *MintingArchive) checkPruneCriteria(ctx context.Context,
+	root BaseRoot, criteria PruneCriteria) (*PrunedAsset, bool, error) {
+
+	prunedAsset := &PrunedAsset{
+		ID:           root.ID,
+		AssetName:    root.AssetName,
+		IssuedSupply: root.NodeSum(),
+	}
+	prunedAsset.ID.ProofType = ProofTypeUnspecified
+
+	if criteria.MaxUpdateHeight != 0 {
+		var (
+			height uint32
+			known  bool
+		)
+		for _, id := range pruneIDs(root.ID) {
+			baseUni := a.fetchUniverse(id)
+			uniHeight, err := baseUni.LastUpdateHeight(ctx)
+			switch {
+			// Without any recorded leaf insertion, the universe
+			// doesn't tell us anything about the asset's age.
+			case errors.Is(err, ErrNoUniverseRoot):
+				continue
+
+			case err != nil:
+				return nil, false, fmt.Errorf("unable to "+
+					"fetch last update height: %w", err)
+			}
+
+			known = true
+			if uniHeight > height {
+				height = uniHeight
+			}
+		}
+
+		// If we don't know when the asset was last updated, we can't
+		// tell whether it's old enough to be pruned.
+		if !known || height > criteria.MaxUpdateHeight {
+			return nil, false, nil
+		}
+
+		prunedAsset.LastUpdateHeight = height
+	}
+
+	if criteria.ZeroSupply {
+		transferID := root.ID
+		transferID.ProofType = ProofTypeTransfer
+
+		transferLeaves, err := a.MintingLeaves(ctx, transferID)
+		if err != nil {
+			return nil, false, fmt.Errorf("unable to fetch "+
+				"transfer leaves: %w", err)
+		}
+
+		burned := burnedSupply(transferLeaves)
+		if burned < prunedAsset.IssuedSupply {
+			return nil, false, nil
+		}
+
+		prunedAsset.BurnedSupply = burned
+	}
+
+	return prunedAsset, true, nil
+}
+
+// pruneIDs returns the IDs of the issuance and the transfer universe of the
+// asset with the given universe ID.
+func pruneIDs(id Identifier) []Identifier {
+	issuanceID, transferID := id, id
+	issuanceID.ProofType = ProofTypeIssuance
+	transferID.ProofType = ProofTypeTransfer
+
+	return []Identifier{issuanceID, transferID}
+}
+
+// burnedSupply returns the total amount of all burns within the given
+// transfer leaves.
+func burnedSupply(leaves []Leaf) uint64 {
+	var burned uint64
+	for _, leaf := range leaves {
+		if leaf.Proof == nil || !leaf.Proof.Asset.IsBurn() {
+			continue
+		}
+
+		burned += leaf.Amt
+	}
+
+	return burned
+}
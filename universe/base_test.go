@@ -0,0 +1,366 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLeafEventBacklog tests that subscribers resuming a subscription are
+// delivered the events from the backlog after the given index, and that the
+// backlog is bounded.
+func TestLeafEventBacklog(t *testing.T) {
+	t.Parallel()
+
+	archive := NewMintingArchive(MintingArchiveConfig{
+		LeafEventBacklog: 3,
+	})
+
+	// Publish five events, only the last three of which should be kept in
+	// the backlog.
+	root := randBaseRoot(t)
+	for i := 0; i < 5; i++ {
+		archive.publishLeafEvents(
+			newLeafEvent(root, LeafKey{}, nil, LeafSourceLocal),
+		)
+	}
+	require.Len(t, archive.eventBacklog, 3)
+	require.EqualValues(t, 3, archive.eventBacklog[0].Index)
+
+	// A subscriber that last saw the event with index 3 should be
+	// delivered the events with index 4 and 5.
+	receiver := fn.NewEventReceiver[*LeafEvent](fn.DefaultQueueSize)
+	require.NoError(t, archive.RegisterSubscriber(receiver, true, 3))
+
+	for _, expectedIndex := range []uint64{4, 5} {
+		select {
+		case event := <-receiver.NewItemCreated.ChanOut():
+			require.Equal(t, expectedIndex, event.Index)
+			require.Equal(t, LeafSourceLocal, event.Source)
+
+		case <-time.After(time.Second):
+			t.Fatalf("expected event %d", expectedIndex)
+		}
+	}
+
+	// Any new event should be delivered to the subscriber as well.
+	archive.publishLeafEvents(
+		newLeafEvent(root, LeafKey{}, nil, LeafSourceFederation),
+	)
+	select {
+	case event := <-receiver.NewItemCreated.ChanOut():
+		require.EqualValues(t, 6, event.Index)
+		require.Equal(t, LeafSourceFederation, event.Source)
+
+	case <-time.After(time.Second):
+		t.Fatalf("expected new event")
+	}
+
+	require.NoError(t, archive.RemoveSubscriber(receiver))
+}
+
+// batchItemSpending returns a new batch item with an asset that spends the
+// asset stored at the given leaf key. If no leaf key is given, the asset is a
+// genesis asset.
+func batchItemSpending(t *testing.T, prevKey *LeafKey) *IssuanceItem {
+	newAsset := asset.RandAsset(t, asset.Normal)
+	newAsset.PrevWitnesses = []asset.Witness{{
+		PrevID: &asset.PrevID{},
+	}}
+	if prevKey != nil {
+		newAsset.PrevWitnesses[0].PrevID = &asset.PrevID{
+			OutPoint: prevKey.OutPoint,
+			ID:       newAsset.ID(),
+			ScriptKey: asset.ToSerialized(
+				prevKey.ScriptKey.PubKey,
+			),
+		}
+	}
+
+	return &IssuanceItem{
+		Key: randLeafKey(t),
+		Leaf: &Leaf{
+			Proof: &proof.Proof{
+				Asset: *newAsset,
+			},
+		},
+	}
+}
+
+// TestRejectInvalidBatchDeps tests that batch items spending the asset of a
+// rejected item of the same batch are rejected as well, transitively.
+func TestRejectInvalidBatchDeps(t *testing.T) {
+	t.Parallel()
+
+	// The batch consists of a rejected genesis item, a chain of two items
+	// spending it, and an unrelated chain of a valid genesis item and an
+	// item spending it.
+	invalidGenesis := batchItemSpending(t, nil)
+	spendInvalid := batchItemSpending(t, &invalidGenesis.Key)
+	spendSpendInvalid := batchItemSpending(t, &spendInvalid.Key)
+	validGenesis := batchItemSpending(t, nil)
+	spendValid := batchItemSpending(t, &validGenesis.Key)
+
+	require.True(t, invalidGenesis.Leaf.Proof.Asset.IsGenesisAsset())
+	require.False(t, spendInvalid.Leaf.Proof.Asset.IsGenesisAsset())
+
+	// The items are passed in reverse order of their dependencies, to
+	// make sure rejections are propagated regardless of the order.
+	items := []*IssuanceItem{
+		spendSpendInvalid, spendInvalid, invalidGenesis, spendValid,
+		validGenesis,
+	}
+	itemErrs := make([]error, len(items))
+	itemErrs[2] = errors.New("invalid proof")
+
+	// Only the first four items are part of the new items, so the last
+	// item is never inspected.
+	rejectInvalidBatchDeps(items, []int{0, 1, 2, 3}, itemErrs)
+
+	require.ErrorContains(t, itemErrs[0], "of batch is invalid")
+	require.ErrorContains(t, itemErrs[1], "of batch is invalid")
+	require.EqualError(t, itemErrs[2], "invalid proof")
+	require.NoError(t, itemErrs[3])
+	require.NoError(t, itemErrs[4])
+}
+
+// transferLeaf returns a transfer leaf of the given amount, which is a burn if
+// requested.
+func transferLeaf(t *testing.T, amt uint64, burn bool) Leaf {
+	prevID := asset.PrevID{
+		OutPoint:  test.RandOp(t),
+		ID:        asset.RandID(t),
+		ScriptKey: asset.RandSerializedKey(t),
+	}
+
+	newAsset := asset.RandAsset(t, asset.Normal)
+	newAsset.Amount = amt
+	newAsset.PrevWitnesses = []asset.Witness{{
+		PrevID: &prevID,
+	}}
+	if burn {
+		newAsset.ScriptKey = asset.NewScriptKey(
+			asset.DeriveBurnKey(prevID),
+		)
+	}
+
+	return Leaf{
+		Proof: &proof.Proof{
+			Asset: *newAsset,
+		},
+		Amt: amt,
+	}
+}
+
+// TestBurnedSupply tests that only the amounts of burn leaves are counted
+// towards the burned supply of an asset.
+func TestBurnedSupply(t *testing.T) {
+	t.Parallel()
+
+	leaves := []Leaf{
+		transferLeaf(t, 10, true),
+		transferLeaf(t, 7, false),
+		transferLeaf(t, 5, true),
+		{Amt: 3},
+	}
+	require.True(t, leaves[0].Proof.Asset.IsBurn())
+	require.False(t, leaves[1].Proof.Asset.IsBurn())
+
+	require.EqualValues(t, 15, burnedSupply(leaves))
+	require.Zero(t, burnedSupply(nil))
+}
+
+// TestPruneUniversesNoCriteria tests that pruning without any criteria is
+// rejected, as it would otherwise remove all universes.
+func TestPruneUniversesNoCriteria(t *testing.T) {
+	t.Parallel()
+
+	archive := NewMintingArchive(MintingArchiveConfig{})
+
+	_, err := archive.PruneUniverses(
+		context.Background(), PruneCriteria{DryRun: true},
+	)
+	require.ErrorContains(t, err, "at least one prune criterion")
+}
+
+// TestOutranksLeaf tests that a diverging leaf only replaces an existing leaf
+// if it was synced from a server with a higher priority, or if either leaf
+// wasn't synced from a federation server at all.
+func TestOutranksLeaf(t *testing.T) {
+	t.Parallel()
+
+	leafWithPriority := func(priority *int) *Leaf {
+		return &Leaf{
+			SourcePriority: priority,
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		newPriority      *int
+		existingPriority *int
+		outranks         bool
+	}{{
+		name:             "higher priority",
+		newPriority:      fn.Ptr(2),
+		existingPriority: fn.Ptr(1),
+		outranks:         true,
+	}, {
+		name:             "equal priority",
+		newPriority:      fn.Ptr(1),
+		existingPriority: fn.Ptr(1),
+		outranks:         false,
+	}, {
+		name:             "lower priority",
+		newPriority:      fn.Ptr(-1),
+		existingPriority: fn.Ptr(0),
+		outranks:         false,
+	}, {
+		name:             "existing leaf not synced",
+		newPriority:      fn.Ptr(0),
+		existingPriority: nil,
+		outranks:         true,
+	}, {
+		name:             "new leaf not synced",
+		newPriority:      nil,
+		existingPriority: fn.Ptr(5),
+		outranks:         true,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.outranks, outranksLeaf(
+				leafWithPriority(tc.newPriority),
+				leafWithPriority(tc.existingPriority),
+			))
+		})
+	}
+}
+
+// mockSupplyUniverse is a mock base universe that serves a static set of
+// issuance leaves and a root with the given sum.
+type mockSupplyUniverse struct {
+	BaseBackend
+
+	leaves []Leaf
+
+	rootSum uint64
+
+	prunedKeys []LeafKey
+}
+
+func (m *mockSupplyUniverse) RootNode(
+	context.Context) (mssmt.Node, string, error) {
+
+	if len(m.leaves) == 0 {
+		return nil, "", ErrNoUniverseRoot
+	}
+
+	return mssmt.NewComputedBranch(mssmt.EmptyTreeRootHash, m.rootSum),
+		"", nil
+}
+
+func (m *mockSupplyUniverse) MintingLeaves(context.Context) ([]Leaf, error) {
+	return m.leaves, nil
+}
+
+// mockBurnMultiverse is a mock multiverse that serves burn roots with a static
+// sum for each asset group.
+type mockBurnMultiverse struct {
+	MultiverseArchive
+
+	burned map[*btcec.PublicKey]uint64
+}
+
+func (m *mockBurnMultiverse) BurnRoot(_ context.Context,
+	id Identifier) (mssmt.Node, error) {
+
+	return mssmt.NewComputedBranch(
+		mssmt.EmptyTreeRootHash, m.burned[id.GroupKey],
+	), nil
+}
+
+// TestQueryAssetSupply tests that the supply of an asset group is summed over
+// all issuance leaves minus the sum of its burn tree, that it's checked against
+// the root sum and that an unknown group has a zero supply.
+func TestQueryAssetSupply(t *testing.T) {
+	t.Parallel()
+
+	knownKey := test.RandPubKey(t)
+	burnedKey := test.RandPubKey(t)
+	unknownKey := test.RandPubKey(t)
+	badKey := test.RandPubKey(t)
+	overBurnedKey := test.RandPubKey(t)
+
+	universes := map[*btcec.PublicKey]*mockSupplyUniverse{
+		knownKey: {
+			leaves:  []Leaf{{Amt: 100}, {Amt: 50}, {Amt: 7}},
+			rootSum: 157,
+		},
+		burnedKey: {
+			leaves:  []Leaf{{Amt: 100}, {Amt: 50}},
+			rootSum: 150,
+		},
+		unknownKey: {},
+		badKey: {
+			leaves:  []Leaf{{Amt: 100}},
+			rootSum: 99,
+		},
+		overBurnedKey: {
+			leaves:  []Leaf{{Amt: 100}},
+			rootSum: 100,
+		},
+	}
+	archive := NewMintingArchive(MintingArchiveConfig{
+		NewBaseTree: func(id Identifier) BaseBackend {
+			require.Equal(t, ProofTypeIssuance, id.ProofType)
+			return universes[id.GroupKey]
+		},
+		Multiverse: &mockBurnMultiverse{
+			burned: map[*btcec.PublicKey]uint64{
+				burnedKey:     30,
+				overBurnedKey: 101,
+			},
+		},
+	})
+
+	ctx := context.Background()
+
+	supply, err := archive.QueryAssetSupply(ctx, knownKey)
+	require.NoError(t, err)
+	require.Equal(t, &AssetSupply{
+		TotalSupply:  157,
+		NumIssuances: 3,
+	}, supply)
+
+	// The burned supply is subtracted from the issued supply.
+	supply, err = archive.QueryAssetSupply(ctx, burnedKey)
+	require.NoError(t, err)
+	require.Equal(t, &AssetSupply{
+		TotalSupply:  120,
+		BurnedSupply: 30,
+		NumIssuances: 2,
+	}, supply)
+
+	supply, err = archive.QueryAssetSupply(ctx, unknownKey)
+	require.NoError(t, err)
+	require.Equal(t, &AssetSupply{}, supply)
+
+	_, err = archive.QueryAssetSupply(ctx, badKey)
+	require.ErrorContains(t, err, "doesn't match universe root sum")
+
+	_, err = archive.QueryAssetSupply(ctx, overBurnedKey)
+	require.ErrorContains(t, err, "exceeds issued supply")
+}
@@ -0,0 +1,120 @@
+package universe
+
+import (
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+// ConflictPolicy determines which of two diverging leaves with the same leaf
+// key is retained when a leaf from a remote party is merged into the local
+// Universe. Leaves diverge if they commit to a different anchor block, for
+// example because of a double-spend attempt that was anchored differently.
+//
+// NOTE: The conflict policy is a purely local policy. It only governs which
+// leaf this node keeps, it is not a consensus rule, so different Universe
+// servers may retain different leaves for the same key.
+type ConflictPolicy uint8
+
+const (
+	// ConflictPolicyHighestPriority retains the leaf synced from the
+	// federation server with the highest priority. A diverging leaf
+	// replaces the existing one unless both were synced from a federation
+	// server and the server of the new leaf doesn't have a higher
+	// priority.
+	ConflictPolicyHighestPriority ConflictPolicy = iota
+
+	// ConflictPolicyFirstSeen always retains the leaf that was inserted
+	// first, a diverging leaf never replaces it.
+	ConflictPolicyFirstSeen
+
+	// ConflictPolicyLongestChain retains the leaf anchored in the local
+	// node's best chain. If both leaves are anchored in the best chain,
+	// then the leaf with the most confirmations is retained.
+	ConflictPolicyLongestChain
+)
+
+// String returns the string representation of the conflict policy.
+func (c ConflictPolicy) String() string {
+	switch c {
+	case ConflictPolicyHighestPriority:
+		return "highest-priority-peer"
+	case ConflictPolicyFirstSeen:
+		return "first-seen"
+	case ConflictPolicyLongestChain:
+		return "longest-chain"
+	}
+
+	return fmt.Sprintf("unknown(%v)", int(c))
+}
+
+// ParseConflictPolicy returns the conflict policy corresponding to the given
+// string.
+func ParseConflictPolicy(policyStr string) (ConflictPolicy, error) {
+	switch policyStr {
+	case "highest-priority-peer":
+		return ConflictPolicyHighestPriority, nil
+	case "first-seen":
+		return ConflictPolicyFirstSeen, nil
+	case "longest-chain":
+		return ConflictPolicyLongestChain, nil
+	default:
+		return 0, fmt.Errorf("unknown conflict policy: %v", policyStr)
+	}
+}
+
+// ReplacesLeaf returns true if, according to the conflict policy, the new leaf
+// replaces the diverging existing leaf. The header verifier is used to check
+// whether the anchor of the existing leaf is still part of the best chain.
+func (c ConflictPolicy) ReplacesLeaf(newLeaf, existingLeaf *Leaf,
+	headerVerifier proof.HeaderVerifier) bool {
+
+	switch c {
+	case ConflictPolicyFirstSeen:
+		return false
+
+	case ConflictPolicyLongestChain:
+		// An existing leaf that is no longer anchored in the best
+		// chain is always replaced. The new leaf is verified against
+		// the best chain before it is inserted.
+		existingProof := existingLeaf.Proof
+		err := headerVerifier(
+			existingProof.BlockHeader, existingProof.BlockHeight,
+		)
+		if err != nil {
+			return true
+		}
+
+		return newLeaf.Proof.BlockHeight < existingProof.BlockHeight
+
+	default:
+		return outranksLeaf(newLeaf, existingLeaf)
+	}
+}
+
+// outranksLeaf returns true if the new leaf may replace the diverging existing
+// leaf. This is the case unless both leaves were synced from a federation
+// server and the new leaf's server doesn't have a higher priority.
+func outranksLeaf(newLeaf, existingLeaf *Leaf) bool {
+	if newLeaf.SourcePriority == nil ||
+		existingLeaf.SourcePriority == nil {
+
+		return true
+	}
+
+	return *newLeaf.SourcePriority > *existingLeaf.SourcePriority
+}
+
+// describeConflictLeaf returns a short description of a conflicting leaf for
+// logging purposes.
+func describeConflictLeaf(leaf *Leaf) string {
+	priority := "none"
+	if leaf.SourcePriority != nil {
+		priority = fmt.Sprintf("%d", *leaf.SourcePriority)
+	}
+
+	p := leaf.Proof
+	return fmt.Sprintf("(anchor=%v, block=%v, height=%d, "+
+		"source_priority=%v)", p.OutPoint(), p.BlockHeader.BlockHash(),
+		p.BlockHeight, priority)
+}
@@ -0,0 +1,127 @@
+package universe
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseConflictPolicy tests that all conflict policies can be parsed from
+// their string representation.
+func TestParseConflictPolicy(t *testing.T) {
+	t.Parallel()
+
+	policies := []ConflictPolicy{
+		ConflictPolicyHighestPriority, ConflictPolicyFirstSeen,
+		ConflictPolicyLongestChain,
+	}
+	for _, policy := range policies {
+		parsed, err := ParseConflictPolicy(policy.String())
+		require.NoError(t, err)
+		require.Equal(t, policy, parsed)
+	}
+
+	_, err := ParseConflictPolicy("most-recent")
+	require.ErrorContains(t, err, "unknown conflict policy")
+}
+
+// TestConflictPolicyReplacesLeaf tests that each conflict policy retains the
+// expected leaf of two diverging leaves.
+func TestConflictPolicyReplacesLeaf(t *testing.T) {
+	t.Parallel()
+
+	conflictLeaf := func(height uint32, priority *int) *Leaf {
+		return &Leaf{
+			Proof: &proof.Proof{
+				BlockHeader: wire.BlockHeader{
+					Timestamp: time.Unix(int64(height), 0),
+				},
+				BlockHeight: height,
+			},
+			SourcePriority: priority,
+		}
+	}
+
+	// The header verifier only accepts blocks at a height below 1000, so
+	// leaves at a higher height are seen as re-organized out of the best
+	// chain.
+	headerVerifier := func(_ wire.BlockHeader, height uint32) error {
+		if height >= 1000 {
+			return fmt.Errorf("block not in best chain")
+		}
+
+		return nil
+	}
+
+	testCases := []struct {
+		name     string
+		policy   ConflictPolicy
+		newLeaf  *Leaf
+		existing *Leaf
+		replaces bool
+	}{{
+		name:     "highest priority, higher new priority",
+		policy:   ConflictPolicyHighestPriority,
+		newLeaf:  conflictLeaf(200, fn.Ptr(2)),
+		existing: conflictLeaf(100, fn.Ptr(1)),
+		replaces: true,
+	}, {
+		name:     "highest priority, lower new priority",
+		policy:   ConflictPolicyHighestPriority,
+		newLeaf:  conflictLeaf(100, fn.Ptr(1)),
+		existing: conflictLeaf(200, fn.Ptr(2)),
+		replaces: false,
+	}, {
+		name:     "first seen, higher new priority",
+		policy:   ConflictPolicyFirstSeen,
+		newLeaf:  conflictLeaf(100, fn.Ptr(2)),
+		existing: conflictLeaf(200, fn.Ptr(1)),
+		replaces: false,
+	}, {
+		name:     "first seen, existing re-organized",
+		policy:   ConflictPolicyFirstSeen,
+		newLeaf:  conflictLeaf(100, nil),
+		existing: conflictLeaf(2000, nil),
+		replaces: false,
+	}, {
+		name:     "longest chain, new leaf deeper",
+		policy:   ConflictPolicyLongestChain,
+		newLeaf:  conflictLeaf(100, fn.Ptr(1)),
+		existing: conflictLeaf(200, fn.Ptr(2)),
+		replaces: true,
+	}, {
+		name:     "longest chain, existing leaf deeper",
+		policy:   ConflictPolicyLongestChain,
+		newLeaf:  conflictLeaf(200, fn.Ptr(2)),
+		existing: conflictLeaf(100, fn.Ptr(1)),
+		replaces: false,
+	}, {
+		name:     "longest chain, equal depth",
+		policy:   ConflictPolicyLongestChain,
+		newLeaf:  conflictLeaf(100, nil),
+		existing: conflictLeaf(100, nil),
+		replaces: false,
+	}, {
+		name:     "longest chain, existing re-organized",
+		policy:   ConflictPolicyLongestChain,
+		newLeaf:  conflictLeaf(1500, nil),
+		existing: conflictLeaf(1200, nil),
+		replaces: true,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.replaces, tc.policy.ReplacesLeaf(
+				tc.newLeaf, tc.existing, headerVerifier,
+			))
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+var (
+	// ErrInsufficientConfs is returned when the anchor transaction of a
+	// proof doesn't have the required minimum number of confirmations.
+	// This error is transient, the proof is accepted once its anchor is
+	// buried deep enough in the chain.
+	ErrInsufficientConfs = errors.New("proof anchor has insufficient " +
+		"confirmations")
+)
+
+// ChainHeightFunc returns the current height of the main chain.
+type ChainHeightFunc func(ctx context.Context) (uint32, error)
+
+// ProofConfs returns the number of confirmations the anchor transaction of the
+// given proof has at the given chain height.
+func ProofConfs(p *proof.Proof, currentHeight uint32) uint32 {
+	if currentHeight < p.BlockHeight {
+		return 0
+	}
+
+	return currentHeight - p.BlockHeight + 1
+}
+
+// CheckProofConfs returns an error wrapping ErrInsufficientConfs if the anchor
+// transaction of the given proof has fewer than minConfs confirmations at the
+// given chain height. As a proof always commits to the block its anchor is
+// mined in, any minimum of one or less is always met.
+func CheckProofConfs(p *proof.Proof, minConfs, currentHeight uint32) error {
+	if minConfs <= 1 {
+		return nil
+	}
+
+	confs := ProofConfs(p, currentHeight)
+	if confs < minConfs {
+		return fmt.Errorf("%w: anchor %v has %d confirmations, %d "+
+			"required", ErrInsufficientConfs, p.OutPoint(), confs,
+			minConfs)
+	}
+
+	return nil
+}
+
+// currentHeightForConfs returns the current chain height if the given minimum
+// number of confirmations requires a check, and zero otherwise. This allows
+// callers to skip querying the chain if any proof is accepted.
+func currentHeightForConfs(ctx context.Context, minConfs uint32,
+	chainHeight ChainHeightFunc) (uint32, error) {
+
+	if minConfs <= 1 {
+		return 0, nil
+	}
+
+	if chainHeight == nil {
+		return 0, fmt.Errorf("unable to check proof confirmations, " +
+			"no chain height source")
+	}
+
+	height, err := chainHeight(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to fetch current chain "+
+			"height: %w", err)
+	}
+
+	return height, nil
+}
@@ -0,0 +1,132 @@
+package universe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckProofConfs tests that the confirmations of a proof anchor are
+// computed correctly and checked against the minimum.
+func TestCheckProofConfs(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		blockHeight   uint32
+		currentHeight uint32
+		minConfs      uint32
+		confs         uint32
+		valid         bool
+	}{{
+		name:          "no minimum",
+		blockHeight:   100,
+		currentHeight: 100,
+		confs:         1,
+		valid:         true,
+	}, {
+		name:          "single conf",
+		blockHeight:   100,
+		currentHeight: 100,
+		minConfs:      1,
+		confs:         1,
+		valid:         true,
+	}, {
+		name:          "exact minimum",
+		blockHeight:   100,
+		currentHeight: 105,
+		minConfs:      6,
+		confs:         6,
+		valid:         true,
+	}, {
+		name:          "insufficient confs",
+		blockHeight:   100,
+		currentHeight: 104,
+		minConfs:      6,
+		confs:         5,
+	}, {
+		name:          "chain behind anchor",
+		blockHeight:   100,
+		currentHeight: 99,
+		minConfs:      2,
+		confs:         0,
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			p := &proof.Proof{
+				BlockHeight: testCase.blockHeight,
+			}
+			require.Equal(
+				t, testCase.confs,
+				ProofConfs(p, testCase.currentHeight),
+			)
+
+			err := CheckProofConfs(
+				p, testCase.minConfs, testCase.currentHeight,
+			)
+			if testCase.valid {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, ErrInsufficientConfs)
+		})
+	}
+}
+
+// TestMintingArchiveMinConfs tests that the minting archive rejects leaves
+// inserted by remote parties with an anchor that doesn't have the minimum
+// number of confirmations.
+func TestMintingArchiveMinConfs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	newAsset := asset.RandAsset(t, asset.Normal)
+	id := Identifier{
+		AssetID: newAsset.ID(),
+	}
+
+	archive := NewMintingArchive(MintingArchiveConfig{
+		MinConfs: 6,
+		ChainHeight: func(context.Context) (uint32, error) {
+			return 100, nil
+		},
+	})
+
+	leaf := &Leaf{
+		Proof: &proof.Proof{
+			Asset:       *newAsset,
+			BlockHeight: 98,
+		},
+		Amt: newAsset.Amount,
+	}
+
+	_, err := archive.RegisterIssuance(ctx, id, randLeafKey(t), leaf)
+	require.ErrorIs(t, err, ErrInsufficientConfs)
+
+	// Shallow leaves of a batch are reported per leaf, without failing
+	// the whole batch.
+	itemErrs, err := archive.RegisterProofBatch(ctx, []*IssuanceItem{{
+		ID:   id,
+		Key:  randLeafKey(t),
+		Leaf: leaf,
+	}})
+	require.NoError(t, err)
+	require.Len(t, itemErrs, 1)
+	require.ErrorIs(t, itemErrs[0], ErrInsufficientConfs)
+
+	// Without a chain height source, the minimum can't be checked, so the
+	// leaf is rejected as well.
+	archive = NewMintingArchive(MintingArchiveConfig{
+		MinConfs: 6,
+	})
+	_, err = archive.RegisterIssuance(ctx, id, randLeafKey(t), leaf)
+	require.ErrorContains(t, err, "no chain height source")
+}
@@ -0,0 +1,171 @@
+package universe
+
+import (
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+// FederationEventType is the type of federation event.
+type FederationEventType uint8
+
+const (
+	// FederationEventMembership is the first event delivered to a new
+	// subscriber, carrying the full membership of the federation at the
+	// time the subscription was started.
+	FederationEventMembership FederationEventType = iota
+
+	// FederationEventServerAdded is sent when a server was added to the
+	// federation.
+	FederationEventServerAdded
+
+	// FederationEventServerRemoved is sent when a server was removed from
+	// the federation.
+	FederationEventServerRemoved
+
+	// FederationEventHealthChanged is sent when the reachability, sync or
+	// stale status of a server changed, or when a server was checked for
+	// the first time.
+	FederationEventHealthChanged
+)
+
+// String returns a human-readable version of the event type.
+func (t FederationEventType) String() string {
+	switch t {
+	case FederationEventMembership:
+		return "membership"
+
+	case FederationEventServerAdded:
+		return "server_added"
+
+	case FederationEventServerRemoved:
+		return "server_removed"
+
+	case FederationEventHealthChanged:
+		return "health_changed"
+
+	default:
+		return "unknown"
+	}
+}
+
+// FederationEvent describes a change of the set of federation servers or of
+// the health of one of them.
+type FederationEvent struct {
+	// Type is the type of the event.
+	Type FederationEventType
+
+	// Timestamp is the time the event was created.
+	Timestamp time.Time
+
+	// Server is the server that was added, removed or whose health
+	// changed. This isn't set for membership events.
+	Server ServerAddr
+
+	// Health is the new health of the server. This is only set for health
+	// change events.
+	Health ServerHealth
+
+	// Members is the full set of federation servers. This is only set for
+	// membership events.
+	Members []ServerAddr
+}
+
+// newServerEvents creates a new event of the given type for each of the given
+// servers.
+func newServerEvents(eventType FederationEventType,
+	addrs []ServerAddr) []*FederationEvent {
+
+	now := time.Now()
+
+	return fn.Map(addrs, func(addr ServerAddr) *FederationEvent {
+		return &FederationEvent{
+			Type:      eventType,
+			Timestamp: now,
+			Server:    addr,
+		}
+	})
+}
+
+// healthChanged returns true if the status flags of the server differ between
+// the two health snapshots.
+func healthChanged(oldHealth, newHealth ServerHealth) bool {
+	return oldHealth.Reachable != newHealth.Reachable ||
+		oldHealth.InSync != newHealth.InSync ||
+		oldHealth.Stale != newHealth.Stale
+}
+
+// RemoveServer removes the given servers from the federation.
+func (f *FederationEnvoy) RemoveServer(addrs ...ServerAddr) error {
+	ctx, cancel := f.WithCtxQuit()
+	defer cancel()
+
+	log.Infof("Removing Universe servers from Federation, addrs=%v",
+		fn.Map(addrs, func(a ServerAddr) string {
+			return a.HostStr()
+		}))
+
+	f.eventMtx.Lock()
+	defer f.eventMtx.Unlock()
+
+	err := f.cfg.FederationDB.RemoveServers(ctx, addrs...)
+	if err != nil {
+		return err
+	}
+
+	f.fedEvents.NotifySubscribers(
+		newServerEvents(FederationEventServerRemoved, addrs)...,
+	)
+
+	return nil
+}
+
+// RegisterSubscriber adds a new subscriber for receiving federation events.
+// If deliverExisting is true, then an event with the current membership of the
+// federation is delivered before any other event. The deliverFrom parameter
+// is ignored, as federation events aren't indexed.
+//
+// NOTE: This is part of the fn.EventPublisher interface.
+func (f *FederationEnvoy) RegisterSubscriber(
+	receiver *fn.EventReceiver[*FederationEvent], deliverExisting bool,
+	_ uint64) error {
+
+	// We hold the event mutex while querying the current members, so no
+	// membership change can happen between the query and the registration
+	// that the subscriber would miss.
+	f.eventMtx.Lock()
+	defer f.eventMtx.Unlock()
+
+	if !deliverExisting {
+		f.fedEvents.RegisterSubscriber(receiver)
+		return nil
+	}
+
+	ctx, cancel := f.WithCtxQuit()
+	defer cancel()
+
+	members, err := f.cfg.FederationDB.UniverseServers(ctx)
+	if err != nil {
+		return err
+	}
+
+	f.fedEvents.RegisterSubscriber(receiver)
+
+	receiver.NewItemCreated.ChanIn() <- &FederationEvent{
+		Type:      FederationEventMembership,
+		Timestamp: time.Now(),
+		Members:   members,
+	}
+
+	return nil
+}
+
+// RemoveSubscriber removes the given subscriber and also stops it from
+// processing events.
+//
+// NOTE: This is part of the fn.EventPublisher interface.
+func (f *FederationEnvoy) RemoveSubscriber(
+	subscriber *fn.EventReceiver[*FederationEvent]) error {
+
+	return f.fedEvents.RemoveSubscriber(subscriber)
+}
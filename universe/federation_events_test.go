@@ -0,0 +1,110 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/stretchr/testify/require"
+)
+
+// receiveFederationEvent waits for the next federation event delivered to the
+// given receiver.
+func receiveFederationEvent(t *testing.T,
+	receiver *fn.EventReceiver[*FederationEvent]) *FederationEvent {
+
+	select {
+	case event := <-receiver.NewItemCreated.ChanOut():
+		return event
+
+	case <-time.After(time.Second):
+		t.Fatalf("expected federation event")
+		return nil
+	}
+}
+
+// TestFederationEvents tests that subscribers are delivered the current
+// federation membership first, followed by events for added and removed
+// servers, and for changes of a server's health.
+func TestFederationEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	sharedRoot := randBaseRoot(t)
+	localEngine := newMockDiffEngine(sharedRoot)
+	remoteEngine := newMockDiffEngine(sharedRoot)
+
+	existingServer := NewServerAddrFromStr("localhost:10029")
+	newServer := NewServerAddrFromStr("localhost:10030")
+
+	var remoteErr error
+	envoy := NewFederationEnvoy(FederationConfig{
+		FederationDB: &mockFederationDB{
+			servers: []ServerAddr{existingServer},
+		},
+		LocalDiffEngine: localEngine,
+		NewRemoteDiffEngine: func(ServerAddr) (DiffEngine, error) {
+			if remoteErr != nil {
+				return nil, remoteErr
+			}
+
+			return remoteEngine, nil
+		},
+		HealthCheckInterval: time.Minute,
+	})
+
+	receiver := fn.NewEventReceiver[*FederationEvent](fn.DefaultQueueSize)
+	require.NoError(t, envoy.RegisterSubscriber(receiver, true, 0))
+	t.Cleanup(func() {
+		require.NoError(t, envoy.RemoveSubscriber(receiver))
+	})
+
+	// The first event carries the current membership.
+	event := receiveFederationEvent(t, receiver)
+	require.Equal(t, FederationEventMembership, event.Type)
+	require.Equal(t, []ServerAddr{existingServer}, event.Members)
+
+	// Adding and removing servers results in an event for each server.
+	require.NoError(t, envoy.addServers(ctx, []ServerAddr{newServer}))
+	event = receiveFederationEvent(t, receiver)
+	require.Equal(t, FederationEventServerAdded, event.Type)
+	require.Equal(t, newServer, event.Server)
+
+	require.NoError(t, envoy.RemoveServer(existingServer))
+	event = receiveFederationEvent(t, receiver)
+	require.Equal(t, FederationEventServerRemoved, event.Type)
+	require.Equal(t, existingServer, event.Server)
+
+	// The first health check of a server is always reported.
+	_, err := envoy.CheckServerHealth(ctx, newServer)
+	require.NoError(t, err)
+	event = receiveFederationEvent(t, receiver)
+	require.Equal(t, FederationEventHealthChanged, event.Type)
+	require.Equal(t, newServer, event.Server)
+	require.True(t, event.Health.Reachable)
+	require.True(t, event.Health.InSync)
+
+	// Another check without a change of the server's status isn't
+	// reported, while the server becoming unreachable is.
+	_, err = envoy.CheckServerHealth(ctx, newServer)
+	require.NoError(t, err)
+
+	remoteErr = fmt.Errorf("connection refused")
+	_, err = envoy.CheckServerHealth(ctx, newServer)
+	require.NoError(t, err)
+
+	event = receiveFederationEvent(t, receiver)
+	require.Equal(t, FederationEventHealthChanged, event.Type)
+	require.False(t, event.Health.Reachable)
+	require.ErrorContains(t, event.Health.Err, "connection refused")
+
+	select {
+	case event := <-receiver.NewItemCreated.ChanOut():
+		t.Fatalf("unexpected federation event: %v", event.Type)
+
+	default:
+	}
+}
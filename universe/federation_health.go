@@ -0,0 +1,268 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+const (
+	// staleServerIntervals is the number of health check intervals after
+	// which a federation server we weren't able to reach is flagged as
+	// stale.
+	staleServerIntervals = 3
+)
+
+// ServerHealth describes the connectivity and sync status of a federation
+// server, as determined by the last health check of the server.
+type ServerHealth struct {
+	// LastCheck is the time of the last health check of the server.
+	LastCheck time.Time
+
+	// LastContact is the last time we were able to reach the server. This
+	// is the zero time if we were never able to reach the server.
+	LastContact time.Time
+
+	// Reachable is true if we were able to reach the server during the
+	// last health check.
+	Reachable bool
+
+	// InSync is true if all universe roots the server exports and we
+	// accept for sync insert match our local universe roots.
+	InSync bool
+
+	// Stale is true if we haven't been able to reach the server for
+	// longer than the stale threshold. Stale servers are only flagged,
+	// they aren't removed from the federation.
+	Stale bool
+
+	// Err is the error that prevented us from reaching the server during
+	// the last health check, if any.
+	Err error
+}
+
+// ServerHealth returns the health of the given federation server as
+// determined by its last health check. False is returned if the server wasn't
+// checked yet.
+func (f *FederationEnvoy) ServerHealth(addr ServerAddr) (ServerHealth, bool) {
+	f.healthMtx.Lock()
+	defer f.healthMtx.Unlock()
+
+	health, ok := f.serverHealth[addr.HostStr()]
+	if !ok {
+		return ServerHealth{}, false
+	}
+
+	// We only determine whether the server is stale when the health is
+	// queried, so the flag is also up to date if the server is no longer
+	// checked periodically.
+	health.Stale = f.isStale(health)
+
+	return health, true
+}
+
+// isStale returns true if we haven't been able to reach the server with the
+// given health for longer than the stale threshold.
+func (f *FederationEnvoy) isStale(health ServerHealth) bool {
+	interval := f.cfg.HealthCheckInterval
+	if interval == 0 {
+		interval = f.cfg.SyncInterval
+	}
+
+	return time.Since(health.LastContact) > interval*staleServerIntervals
+}
+
+// CheckServerHealth probes the given federation server by fetching its
+// universe roots and comparing them to our local roots. The resulting health
+// is recorded for the server and returned. An error is only returned if the
+// local state needed for the comparison can't be fetched, failing to reach
+// the server is reflected in the returned health instead.
+func (f *FederationEnvoy) CheckServerHealth(ctx context.Context,
+	addr ServerAddr) (ServerHealth, error) {
+
+	syncConfigs, err := f.QuerySyncConfigs(ctx)
+	if err != nil {
+		return ServerHealth{}, err
+	}
+
+	localRoots, err := f.cfg.LocalDiffEngine.RootNodes(
+		ctx, RootNodesQuery{},
+	)
+	if err != nil {
+		return ServerHealth{}, fmt.Errorf("unable to fetch local "+
+			"roots: %w", err)
+	}
+
+	now := time.Now()
+
+	f.healthMtx.Lock()
+	health, checkedBefore := f.serverHealth[addr.HostStr()]
+	f.healthMtx.Unlock()
+
+	prevHealth := health
+	prevHealth.Stale = f.isStale(prevHealth)
+
+	health.LastCheck = now
+	health.Reachable = false
+	health.InSync = false
+	health.Err = nil
+
+	remoteRoots, err := f.fetchRemoteRoots(ctx, addr)
+	if err == nil {
+		health.LastContact = now
+		health.Reachable = true
+		health.InSync = rootsInSync(
+			localRoots, remoteRoots, syncConfigs,
+		)
+	} else {
+		health.Err = err
+	}
+
+	f.healthMtx.Lock()
+	f.serverHealth[addr.HostStr()] = health
+	f.healthMtx.Unlock()
+
+	health.Stale = f.isStale(health)
+
+	// Subscribers are notified if the status of the server changed, or if
+	// we learned about its status for the first time.
+	if !checkedBefore || healthChanged(prevHealth, health) {
+		f.eventMtx.Lock()
+		f.fedEvents.NotifySubscribers(&FederationEvent{
+			Type:      FederationEventHealthChanged,
+			Timestamp: now,
+			Server:    addr,
+			Health:    health,
+		})
+		f.eventMtx.Unlock()
+	}
+
+	return health, nil
+}
+
+// fetchRemoteRoots connects to the given federation server and fetches the
+// set of universe roots it exports.
+func (f *FederationEnvoy) fetchRemoteRoots(ctx context.Context,
+	addr ServerAddr) ([]BaseRoot, error) {
+
+	remoteDiffEngine, err := f.cfg.NewRemoteDiffEngine(addr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to server(%v): %w",
+			addr.HostStr(), err)
+	}
+
+	remoteRoots, err := remoteDiffEngine.RootNodes(ctx, RootNodesQuery{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch roots of server(%v): "+
+			"%w", addr.HostStr(), err)
+	}
+
+	return remoteRoots, nil
+}
+
+// rootsInSync returns true if all remote roots of universes that are enabled
+// for sync insert match the local roots of the same universes.
+func rootsInSync(localRoots, remoteRoots []BaseRoot,
+	syncConfigs *SyncConfigs) bool {
+
+	localRootsByID := make(map[string]BaseRoot, len(localRoots))
+	for _, root := range localRoots {
+		localRootsByID[root.ID.String()] = root
+	}
+
+	for _, remoteRoot := range remoteRoots {
+		if !syncConfigs.IsSyncInsertEnabled(remoteRoot.ID) {
+			continue
+		}
+
+		localRoot, ok := localRootsByID[remoteRoot.ID.String()]
+		if !ok {
+			return false
+		}
+
+		if localRoot.Node.NodeHash() != remoteRoot.Node.NodeHash() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checkFederationHealth checks the health of all current federation servers
+// in parallel.
+func (f *FederationEnvoy) checkFederationHealth() {
+	ctx, cancel := f.WithCtxQuit()
+	defer cancel()
+
+	fedServers, err := f.cfg.FederationDB.UniverseServers(ctx)
+	if err != nil {
+		log.Warnf("Unable to fetch set of universe servers: %v", err)
+		return
+	}
+
+	// We'll forget about the health of any server that was removed from
+	// the federation in the meantime.
+	activeHosts := fn.NewSet(fn.Map(fedServers, func(s ServerAddr) string {
+		return s.HostStr()
+	})...)
+
+	f.healthMtx.Lock()
+	for host := range f.serverHealth {
+		if !activeHosts.Contains(host) {
+			delete(f.serverHealth, host)
+		}
+	}
+	f.healthMtx.Unlock()
+
+	checkServer := func(ctx context.Context, addr ServerAddr) error {
+		health, err := f.CheckServerHealth(ctx, addr)
+		switch {
+		case err != nil:
+			log.Warnf("Unable to check health of server(%v): %v",
+				addr.HostStr(), err)
+
+		case health.Stale:
+			log.Warnf("Federation server(%v) is stale, last "+
+				"contact: %v, err: %v", addr.HostStr(),
+				health.LastContact, health.Err)
+
+		case !health.Reachable:
+			log.Warnf("Federation server(%v) is unreachable: %v",
+				addr.HostStr(), health.Err)
+
+		case !health.InSync:
+			log.Debugf("Federation server(%v) isn't in sync",
+				addr.HostStr())
+		}
+
+		return nil
+	}
+
+	err = fn.ParSlice(ctx, fedServers, checkServer)
+	if err != nil {
+		log.Warnf("Unable to check health of federation: %v", err)
+	}
+}
+
+// healthChecker is the goroutine that periodically checks the health of all
+// federation servers.
+//
+// NOTE: This function MUST be run as a goroutine.
+func (f *FederationEnvoy) healthChecker() {
+	defer f.Wg.Done()
+
+	healthTicker := time.NewTicker(f.cfg.HealthCheckInterval)
+	defer healthTicker.Stop()
+
+	for {
+		select {
+		case <-healthTicker.C:
+			f.checkFederationHealth()
+
+		case <-f.Quit:
+			return
+		}
+	}
+}
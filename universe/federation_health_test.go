@@ -0,0 +1,109 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestFederationEnvoyServerHealth tests that the federation envoy correctly
+// determines whether a server is reachable, in sync with the local universe
+// and stale.
+func TestFederationEnvoyServerHealth(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	const healthCheckInterval = time.Minute
+
+	sharedRoot := randBaseRoot(t)
+	localEngine := newMockDiffEngine(sharedRoot)
+	remoteEngine := newMockDiffEngine(sharedRoot)
+
+	var remoteErr error
+	envoy := NewFederationEnvoy(FederationConfig{
+		FederationDB: &mockFederationDB{
+			globalConfigs: []*FedGlobalSyncConfig{{
+				ProofType:       ProofTypeIssuance,
+				AllowSyncInsert: true,
+			}},
+		},
+		LocalDiffEngine: localEngine,
+		NewRemoteDiffEngine: func(ServerAddr) (DiffEngine, error) {
+			if remoteErr != nil {
+				return nil, remoteErr
+			}
+
+			return remoteEngine, nil
+		},
+		HealthCheckInterval: healthCheckInterval,
+	})
+
+	serverAddr := NewServerAddrFromStr("localhost:10029")
+
+	// Before the first check, we don't know anything about the server.
+	_, ok := envoy.ServerHealth(serverAddr)
+	require.False(t, ok)
+
+	// The server has the same roots as we do, so it's in sync.
+	health, err := envoy.CheckServerHealth(ctx, serverAddr)
+	require.NoError(t, err)
+	require.True(t, health.Reachable)
+	require.True(t, health.InSync)
+	require.False(t, health.Stale)
+	require.NoError(t, health.Err)
+	require.Equal(t, health.LastCheck, health.LastContact)
+
+	storedHealth, ok := envoy.ServerHealth(serverAddr)
+	require.True(t, ok)
+	require.Equal(t, health, storedHealth)
+
+	// A root of a transfer universe we don't accept for sync insert doesn't
+	// affect the sync status, while a new issuance root does.
+	transferRoot := randBaseRoot(t)
+	transferRoot.ID.ProofType = ProofTypeTransfer
+	remoteEngine.roots[transferRoot.ID] = transferRoot
+
+	health, err = envoy.CheckServerHealth(ctx, serverAddr)
+	require.NoError(t, err)
+	require.True(t, health.InSync)
+
+	issuanceRoot := randBaseRoot(t)
+	remoteEngine.roots[issuanceRoot.ID] = issuanceRoot
+
+	health, err = envoy.CheckServerHealth(ctx, serverAddr)
+	require.NoError(t, err)
+	require.True(t, health.Reachable)
+	require.False(t, health.InSync)
+
+	// Once the server can no longer be reached, it's flagged as
+	// unreachable, but we still remember the last time we reached it.
+	lastContact := health.LastContact
+	remoteErr = fmt.Errorf("connection refused")
+
+	health, err = envoy.CheckServerHealth(ctx, serverAddr)
+	require.NoError(t, err)
+	require.False(t, health.Reachable)
+	require.False(t, health.InSync)
+	require.False(t, health.Stale)
+	require.ErrorIs(t, health.Err, remoteErr)
+	require.Equal(t, lastContact, health.LastContact)
+
+	// If we haven't reached the server for several health check
+	// intervals, it's flagged as stale.
+	envoy.serverHealth[serverAddr.HostStr()] = ServerHealth{
+		LastContact: time.Now().Add(
+			-healthCheckInterval * (staleServerIntervals + 1),
+		),
+	}
+	health, err = envoy.CheckServerHealth(ctx, serverAddr)
+	require.NoError(t, err)
+	require.True(t, health.Stale)
+
+	storedHealth, ok = envoy.ServerHealth(serverAddr)
+	require.True(t, ok)
+	require.True(t, storedHealth.Stale)
+}
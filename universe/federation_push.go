@@ -0,0 +1,149 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+// pushRetryInterval returns the amount of time we'll wait before retrying a
+// failed push for the first time.
+func (f *FederationEnvoy) pushRetryInterval() time.Duration {
+	if f.cfg.PushRetryInterval > 0 {
+		return f.cfg.PushRetryInterval
+	}
+
+	return DefaultPushRetryInterval
+}
+
+// pushRetryBackoff returns the amount of time we'll wait before the next
+// attempt of a push that already failed the given number of times. The
+// backoff is doubled after each failed attempt, up to a maximum.
+func (f *FederationEnvoy) pushRetryBackoff(numAttempts uint32) time.Duration {
+	backoff := f.pushRetryInterval()
+	for i := uint32(1); i < numAttempts; i++ {
+		backoff *= 2
+		if backoff >= maxPushRetryBackoff {
+			return maxPushRetryBackoff
+		}
+	}
+
+	return backoff
+}
+
+// NumPendingPushes returns the number of pending pushes for each federation
+// server, keyed by host.
+func (f *FederationEnvoy) NumPendingPushes(
+	ctx context.Context) (map[string]uint64, error) {
+
+	pushes, err := f.cfg.FederationDB.PendingPushes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	numPushes := make(map[string]uint64)
+	for _, push := range pushes {
+		numPushes[push.ServerAddr.HostStr()]++
+	}
+
+	return numPushes, nil
+}
+
+// retryPendingPushes retries all pending pushes that are due in parallel.
+func (f *FederationEnvoy) retryPendingPushes(ctx context.Context) error {
+	pushes, err := f.cfg.FederationDB.PendingPushes(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	duePushes := fn.Filter(pushes, func(p PendingPush) bool {
+		return !p.NextAttempt.After(now)
+	})
+	if len(duePushes) == 0 {
+		return nil
+	}
+
+	log.Infof("Retrying %v pending pushes to federation members",
+		len(duePushes))
+
+	return fn.ParSlice(ctx, duePushes, f.retryPendingPush)
+}
+
+// retryPendingPush retries a single pending push. If the push succeeds, it's
+// removed from the set of pending pushes, otherwise the next attempt is
+// scheduled with an increased backoff.
+func (f *FederationEnvoy) retryPendingPush(ctx context.Context,
+	push PendingPush) error {
+
+	// We only keep track of the leaf key, so we'll first fetch the leaf
+	// itself from the local universe. If the leaf no longer exists
+	// locally, then there's nothing left to push.
+	proofs, err := f.cfg.LocalDiffEngine.FetchIssuanceProof(
+		ctx, push.UniverseID, push.Key,
+	)
+	switch {
+	case errors.Is(err, ErrNoUniverseProofFound) ||
+		(err == nil && len(proofs) == 0):
+
+		log.Warnf("Leaf of pending push to server(%v) no longer "+
+			"exists, removing push", push.ServerAddr.HostStr())
+
+		return f.cfg.FederationDB.RemovePendingPush(ctx, push.ID)
+
+	case err != nil:
+		log.Warnf("Unable to fetch leaf of pending push to "+
+			"server(%v): %v", push.ServerAddr.HostStr(), err)
+
+		return nil
+	}
+
+	pushErr := f.pushLeaf(
+		ctx, push.ServerAddr, push.UniverseID, push.Key, proofs[0].Leaf,
+	)
+	if pushErr == nil {
+		log.Infof("Pending push to server(%v) succeeded after %v "+
+			"failed attempts", push.ServerAddr.HostStr(),
+			push.NumAttempts)
+
+		return f.cfg.FederationDB.RemovePendingPush(ctx, push.ID)
+	}
+
+	push.NumAttempts++
+	push.NextAttempt = time.Now().Add(f.pushRetryBackoff(push.NumAttempts))
+	push.LastErr = pushErr.Error()
+
+	log.Debugf("Pending push to server(%v) failed, next attempt at %v: "+
+		"%v", push.ServerAddr.HostStr(), push.NextAttempt, pushErr)
+
+	return f.cfg.FederationDB.UpdatePendingPush(ctx, push)
+}
+
+// pushRetrier is the goroutine that periodically retries the pending pushes
+// to the federation servers that are due.
+//
+// NOTE: This function MUST be run as a goroutine.
+func (f *FederationEnvoy) pushRetrier() {
+	defer f.Wg.Done()
+
+	retryTicker := time.NewTicker(f.pushRetryInterval())
+	defer retryTicker.Stop()
+
+	for {
+		select {
+		case <-retryTicker.C:
+			ctx, cancel := f.WithCtxQuitNoTimeout()
+			err := f.retryPendingPushes(ctx)
+			cancel()
+			if err != nil {
+				log.Warnf("Unable to retry pending pushes: %v",
+					err)
+			}
+
+		case <-f.Quit:
+			return
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestFederationEnvoyPendingPushes tests that a push to a federation server
+// that fails is queued, and retried with an increasing backoff until it
+// succeeds.
+func TestFederationEnvoyPendingPushes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	const retryInterval = time.Minute
+
+	root := randBaseRoot(t)
+	leafKey := randLeafKey(t)
+	localEngine := newMockDiffEngine(root)
+	localEngine.leafKeys[root.ID] = []LeafKey{leafKey}
+
+	serverAddr := NewServerAddr(1, "localhost:10029")
+	fedDB := &mockFederationDB{
+		servers: []ServerAddr{serverAddr},
+	}
+
+	registrar := &mockRegistrar{}
+	telemetry := &mockTelemetry{}
+
+	// Every push attempt is reported to the push observer.
+	var numFailed, numSucceeded int
+	pushObserver := func(serverHost string, err error) {
+		require.Equal(t, serverAddr.HostStr(), serverHost)
+
+		if err != nil {
+			numFailed++
+			return
+		}
+
+		numSucceeded++
+	}
+
+	remoteErr := fmt.Errorf("connection refused")
+	envoy := NewFederationEnvoy(FederationConfig{
+		FederationDB:    fedDB,
+		LocalDiffEngine: localEngine,
+		NewRemoteRegistrar: func(ServerAddr) (Registrar, error) {
+			if remoteErr != nil {
+				return nil, remoteErr
+			}
+
+			return registrar, nil
+		},
+		UniverseStats:     telemetry,
+		PushRetryInterval: retryInterval,
+		PushObserver:      pushObserver,
+	})
+
+	// The backoff is doubled after each failed attempt, up to a maximum.
+	require.Equal(t, retryInterval, envoy.pushRetryBackoff(1))
+	require.Equal(t, retryInterval*4, envoy.pushRetryBackoff(3))
+	require.Equal(t, maxPushRetryBackoff, envoy.pushRetryBackoff(100))
+
+	// The server is offline, so the push of the new leaf should be queued
+	// for retry.
+	envoy.pushProofToFederation(root.ID, leafKey, &Leaf{})
+
+	pushes, err := fedDB.PendingPushes(ctx)
+	require.NoError(t, err)
+	require.Len(t, pushes, 1)
+	require.Equal(t, serverAddr, pushes[0].ServerAddr)
+	require.Equal(t, root.ID, pushes[0].UniverseID)
+	require.Equal(t, leafKey, pushes[0].Key)
+	require.EqualValues(t, 1, pushes[0].NumAttempts)
+	require.True(t, pushes[0].NextAttempt.After(time.Now()))
+	require.Contains(t, pushes[0].LastErr, remoteErr.Error())
+
+	numPending, err := envoy.NumPendingPushes(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, numPending[serverAddr.HostStr()])
+
+	// The push isn't due yet, so it isn't retried.
+	require.NoError(t, envoy.retryPendingPushes(ctx))
+
+	pushes, err = fedDB.PendingPushes(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, pushes[0].NumAttempts)
+
+	// Once it's due, the push is retried. As the server is still offline,
+	// the next attempt is scheduled with an increased backoff.
+	pushes[0].NextAttempt = time.Now()
+	require.NoError(t, fedDB.UpdatePendingPush(ctx, pushes[0]))
+
+	before := time.Now()
+	require.NoError(t, envoy.retryPendingPushes(ctx))
+
+	pushes, err = fedDB.PendingPushes(ctx)
+	require.NoError(t, err)
+	require.Len(t, pushes, 1)
+	require.EqualValues(t, 2, pushes[0].NumAttempts)
+	require.False(t, pushes[0].NextAttempt.Before(
+		before.Add(retryInterval*2),
+	))
+
+	// Once the server is back online, the retry succeeds and the push is
+	// removed.
+	remoteErr = nil
+	pushes[0].NextAttempt = time.Now()
+	require.NoError(t, fedDB.UpdatePendingPush(ctx, pushes[0]))
+	require.NoError(t, envoy.retryPendingPushes(ctx))
+
+	require.Equal(t, []LeafKey{leafKey}, registrar.registeredKeys)
+	require.Equal(t, 1, telemetry.numPushes)
+	require.Equal(t, 2, numFailed)
+	require.Equal(t, 1, numSucceeded)
+
+	numPending, err = envoy.NumPendingPushes(ctx)
+	require.NoError(t, err)
+	require.Empty(t, numPending)
+}
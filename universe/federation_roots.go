@@ -0,0 +1,101 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+const (
+	// DefaultFederationRootsConcurrency is the default number of federation
+	// servers that are queried for their roots at the same time.
+	DefaultFederationRootsConcurrency = 8
+
+	// DefaultFederationRootsTimeout is the default time a single federation
+	// server has to return its roots, before we give up on it.
+	DefaultFederationRootsTimeout = 30 * time.Second
+)
+
+// FederationRoots is the set of universe roots exported by a single server of
+// the federation, or the error that prevented us from fetching them.
+type FederationRoots struct {
+	// Server is the federation server the roots were fetched from.
+	Server ServerAddr
+
+	// Roots is the set of universe roots the server exports.
+	Roots []BaseRoot
+
+	// Err is the error that prevented us from fetching the roots of the
+	// server, if any.
+	Err error
+}
+
+// FetchFederationRoots fetches the universe roots of all servers of the
+// federation concurrently. At most maxConcurrency servers are queried at the
+// same time, and each server has to return its roots within the given timeout,
+// so a single slow server doesn't stall the whole call. A server we fail to
+// fetch the roots of doesn't fail the call, the error is returned as part of
+// the result of the server instead. The results are returned in the order of
+// the federation servers.
+func (f *FederationEnvoy) FetchFederationRoots(ctx context.Context,
+	maxConcurrency int, timeout time.Duration) ([]FederationRoots, error) {
+
+	if maxConcurrency <= 0 {
+		maxConcurrency = DefaultFederationRootsConcurrency
+	}
+	if timeout <= 0 {
+		timeout = DefaultFederationRootsTimeout
+	}
+
+	fedServers, err := f.cfg.FederationDB.UniverseServers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch federation servers: "+
+			"%w", err)
+	}
+
+	var (
+		resultsMtx sync.Mutex
+		results    = make(map[string]FederationRoots, len(fedServers))
+	)
+	fetchRoots := func(ctx context.Context, addr ServerAddr) error {
+		ctxt, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		roots, err := f.fetchRemoteRoots(ctxt, addr)
+		if err != nil {
+			log.Debugf("Unable to fetch roots of federation "+
+				"server(%v): %v", addr.HostStr(), err)
+		}
+
+		resultsMtx.Lock()
+		results[addr.HostStr()] = FederationRoots{
+			Server: addr,
+			Roots:  roots,
+			Err:    err,
+		}
+		resultsMtx.Unlock()
+
+		return nil
+	}
+
+	err = fn.ParSliceLimit(ctx, maxConcurrency, fedServers, fetchRoots)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the call itself was canceled, then the errors of the servers
+	// don't tell us anything about the servers.
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	fedRoots := make([]FederationRoots, 0, len(fedServers))
+	for _, addr := range fedServers {
+		fedRoots = append(fedRoots, results[addr.HostStr()])
+	}
+
+	return fedRoots, nil
+}
@@ -0,0 +1,84 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// blockingDiffEngine is a diff engine that never returns its roots, until the
+// context of the call is canceled.
+type blockingDiffEngine struct {
+	DiffEngine
+}
+
+func (b *blockingDiffEngine) RootNodes(ctx context.Context,
+	_ RootNodesQuery) ([]BaseRoot, error) {
+
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+// TestFetchFederationRoots tests that the roots of all federation servers are
+// fetched, and that a server that can't be reached or doesn't respond in time
+// doesn't fail the whole call.
+func TestFetchFederationRoots(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	healthyAddr := NewServerAddrFromStr("healthy:10029")
+	offlineAddr := NewServerAddrFromStr("offline:10029")
+	slowAddr := NewServerAddrFromStr("slow:10029")
+
+	root := randBaseRoot(t)
+	offlineErr := fmt.Errorf("connection refused")
+
+	envoy := NewFederationEnvoy(FederationConfig{
+		FederationDB: &mockFederationDB{
+			servers: []ServerAddr{
+				healthyAddr, offlineAddr, slowAddr,
+			},
+		},
+		NewRemoteDiffEngine: func(addr ServerAddr) (DiffEngine, error) {
+			switch addr.HostStr() {
+			case offlineAddr.HostStr():
+				return nil, offlineErr
+
+			case slowAddr.HostStr():
+				return &blockingDiffEngine{}, nil
+
+			default:
+				return newMockDiffEngine(root), nil
+			}
+		},
+	})
+
+	const timeout = 50 * time.Millisecond
+	fedRoots, err := envoy.FetchFederationRoots(ctx, 2, timeout)
+	require.NoError(t, err)
+	require.Len(t, fedRoots, 3)
+
+	// The results are returned in the order of the federation servers.
+	require.Equal(t, healthyAddr.HostStr(), fedRoots[0].Server.HostStr())
+	require.NoError(t, fedRoots[0].Err)
+	require.Equal(t, []BaseRoot{root}, fedRoots[0].Roots)
+
+	require.Equal(t, offlineAddr.HostStr(), fedRoots[1].Server.HostStr())
+	require.ErrorIs(t, fedRoots[1].Err, offlineErr)
+	require.Empty(t, fedRoots[1].Roots)
+
+	require.Equal(t, slowAddr.HostStr(), fedRoots[2].Server.HostStr())
+	require.ErrorIs(t, fedRoots[2].Err, context.DeadlineExceeded)
+	require.Empty(t, fedRoots[2].Roots)
+
+	// If the call itself is canceled, then an error is returned instead of
+	// the errors of the single servers.
+	ctxc, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = envoy.FetchFederationRoots(ctxc, 2, timeout)
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,240 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+// MemberSyncResult is the result of syncing the local universe with a single
+// member of the federation as part of a sync with all members.
+type MemberSyncResult struct {
+	// Server is the federation member that was synced with.
+	Server ServerAddr
+
+	// Diffs is the set of universe diffs that were synced from the member.
+	// Individual diffs may have failed, which is reflected in their sync
+	// error.
+	Diffs []AssetSyncDiff
+
+	// NumLeaves is the number of new leaves that were inserted into the
+	// local universe from this member.
+	NumLeaves uint64
+
+	// Err is the error that aborted the sync with the member, if any.
+	Err error
+}
+
+// FederationSyncResult is the result of syncing the local universe with all
+// members of the federation.
+type FederationSyncResult struct {
+	// Members is the sync result of each federation member.
+	Members []MemberSyncResult
+
+	// NumLeavesMerged is the total number of distinct new leaves that were
+	// inserted into the local universe across all members. A leaf that
+	// was served by multiple members is only counted once.
+	NumLeavesMerged uint64
+}
+
+// mergedLeafKey uniquely identifies a leaf across all universes.
+type mergedLeafKey struct {
+	uniID   string
+	leafKey [32]byte
+}
+
+// SyncAll syncs the local universe with all members of the federation
+// concurrently. As every member only adds the leaves we're missing, the local
+// universe ends up as the union of the universes of all members, even if two
+// members each have leaves the other one lacks.
+//
+// A leaf served by one member may depend on a proof that only another member
+// knows about. So once all members were synced, the universes that failed to
+// sync are retried, as the leaves merged from the other members may have
+// resolved the missing dependency.
+func (f *FederationEnvoy) SyncAll(ctx context.Context,
+	syncType SyncType) (*FederationSyncResult, error) {
+
+	servers, err := f.cfg.FederationDB.UniverseServers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch federation "+
+			"servers: %w", err)
+	}
+
+	syncConfigs, err := f.QuerySyncConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*MemberSyncResult, len(servers))
+	for i, server := range servers {
+		members[i] = &MemberSyncResult{
+			Server: server,
+		}
+	}
+
+	// We'll sync with the members in tiers of descending priority, so the
+	// leaves of the members we trust most are known before we sync with
+	// the others. Within a tier, we sync with all members in parallel. A
+	// failure to sync with a single member doesn't abort the sync with the
+	// others, it's reported in the result of the member instead.
+	syncMember := func(ctx context.Context, m *MemberSyncResult) error {
+		log.Infof("Syncing Universe state with federation member "+
+			"server=%v", m.Server.HostStr())
+
+		m.Diffs, m.Err = f.cfg.UniverseSyncer.SyncUniverse(
+			ctx, m.Server, syncType, *syncConfigs,
+		)
+		if m.Err != nil {
+			log.Warnf("Unable to sync with federation member "+
+				"server=%v: %v", m.Server.HostStr(), m.Err)
+		}
+
+		return nil
+	}
+	tiers := priorityTiers(members, func(m *MemberSyncResult) int {
+		return m.Server.Priority
+	})
+	for _, tier := range tiers {
+		err = fn.ParSlice(ctx, tier, syncMember)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// If we merged any new leaves, then we'll retry the universes that
+	// failed to sync, one member after the other in order of descending
+	// priority.
+	if countMergedLeaves(members) > 0 {
+		for _, tier := range tiers {
+			for _, m := range tier {
+				f.retryFailedDiffs(
+					ctx, m, syncType, *syncConfigs,
+				)
+			}
+		}
+	}
+
+	result := &FederationSyncResult{
+		Members:         make([]MemberSyncResult, 0, len(members)),
+		NumLeavesMerged: countMergedLeaves(members),
+	}
+	for _, m := range members {
+		if m.Err == nil {
+			err := f.cfg.FederationDB.LogNewSyncs(ctx, m.Server)
+			if err != nil {
+				log.Warnf("Unable to log new sync: %v", err)
+			}
+		}
+
+		for _, diff := range m.Diffs {
+			if !diff.Failed() {
+				m.NumLeaves += uint64(len(diff.NewLeafProofs))
+			}
+		}
+
+		result.Members = append(result.Members, *m)
+	}
+
+	log.Infof("Synced with %d federation members, merged %d new leaves",
+		len(members), result.NumLeavesMerged)
+
+	return result, nil
+}
+
+// retryFailedDiffs syncs the universes that previously failed to sync with
+// the given member once more. The failed diffs of the member are replaced with
+// the results of the retry.
+func (f *FederationEnvoy) retryFailedDiffs(ctx context.Context,
+	m *MemberSyncResult, syncType SyncType, syncConfigs SyncConfigs) {
+
+	if m.Err != nil {
+		return
+	}
+
+	var (
+		retryIDs []Identifier
+		diffs    []AssetSyncDiff
+	)
+	for _, diff := range m.Diffs {
+		if diff.Failed() {
+			retryIDs = append(retryIDs, diff.NewUniverseRoot.ID)
+			continue
+		}
+
+		diffs = append(diffs, diff)
+	}
+	if len(retryIDs) == 0 {
+		return
+	}
+
+	log.Infof("Retrying sync of %d universes with federation member "+
+		"server=%v", len(retryIDs), m.Server.HostStr())
+
+	retryDiffs, err := f.cfg.UniverseSyncer.SyncUniverse(
+		ctx, m.Server, syncType, syncConfigs, retryIDs...,
+	)
+	if err != nil {
+		// The first attempt did reach the member, so we'll keep its
+		// results and only log the failed retry.
+		log.Warnf("Unable to retry sync with federation member "+
+			"server=%v: %v", m.Server.HostStr(), err)
+		return
+	}
+
+	m.Diffs = append(diffs, retryDiffs...)
+}
+
+// countMergedLeaves returns the number of distinct new leaves across the
+// successful diffs of all given members.
+func countMergedLeaves(members []*MemberSyncResult) uint64 {
+	merged := make(map[mergedLeafKey]struct{})
+	for _, m := range members {
+		for _, diff := range m.Diffs {
+			if diff.Failed() {
+				continue
+			}
+
+			uniID := diff.NewUniverseRoot.ID.String()
+			for _, leaf := range diff.NewLeafProofs {
+				if leaf.Proof == nil {
+					continue
+				}
+
+				key := LeafKey{
+					OutPoint:  leaf.Proof.OutPoint(),
+					ScriptKey: &leaf.Proof.Asset.ScriptKey,
+				}
+				merged[mergedLeafKey{
+					uniID:   uniID,
+					leafKey: key.UniverseKey(),
+				}] = struct{}{}
+			}
+		}
+	}
+
+	return uint64(len(merged))
+}
+
+// priorityTiers groups the given items into tiers of equal priority, ordered
+// by descending priority. The order of the items within a tier is retained.
+func priorityTiers[T any](items []T, priority func(T) int) [][]T {
+	sorted := make([]T, len(items))
+	copy(sorted, items)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return priority(sorted[i]) > priority(sorted[j])
+	})
+
+	var tiers [][]T
+	for i, item := range sorted {
+		if i == 0 || priority(item) != priority(sorted[i-1]) {
+			tiers = append(tiers, nil)
+		}
+
+		tiers[len(tiers)-1] = append(tiers[len(tiers)-1], item)
+	}
+
+	return tiers
+}
@@ -0,0 +1,210 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// mockMemberSyncer is a mock implementation of the Syncer interface that
+// serves a static set of diffs for each federation member. A universe that is
+// listed as failing for a member fails on the first sync attempt only.
+type mockMemberSyncer struct {
+	Syncer
+
+	diffs map[string][]AssetSyncDiff
+
+	failOnce map[string]Identifier
+
+	errs map[string]error
+
+	retries map[string][]Identifier
+
+	mtx sync.Mutex
+}
+
+func (m *mockMemberSyncer) SyncUniverse(_ context.Context, host ServerAddr,
+	_ SyncType, _ SyncConfigs,
+	idsToSync ...Identifier) ([]AssetSyncDiff, error) {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if err := m.errs[host.HostStr()]; err != nil {
+		return nil, err
+	}
+
+	// A sync of specific universes is a retry, which always succeeds.
+	if len(idsToSync) > 0 {
+		m.retries[host.HostStr()] = idsToSync
+
+		var diffs []AssetSyncDiff
+		for _, diff := range m.diffs[host.HostStr()] {
+			for _, id := range idsToSync {
+				if diff.NewUniverseRoot.ID == id {
+					diffs = append(diffs, diff)
+				}
+			}
+		}
+
+		return diffs, nil
+	}
+
+	failID, shouldFail := m.failOnce[host.HostStr()]
+
+	diffs := make([]AssetSyncDiff, 0, len(m.diffs[host.HostStr()]))
+	for _, diff := range m.diffs[host.HostStr()] {
+		if shouldFail && diff.NewUniverseRoot.ID == failID {
+			diff = AssetSyncDiff{
+				NewUniverseRoot: BaseRoot{ID: failID},
+				SyncErr:         fmt.Errorf("missing proof"),
+			}
+		}
+
+		diffs = append(diffs, diff)
+	}
+
+	return diffs, nil
+}
+
+// randSyncLeaf returns a leaf with a random genesis asset.
+func randSyncLeaf(t *testing.T) *Leaf {
+	return &Leaf{
+		Proof: &proof.Proof{
+			Asset: randGenesisAsset(t),
+		},
+	}
+}
+
+// TestFederationEnvoySyncAll tests that syncing with all federation members
+// merges the leaves of all members, retries universes that failed to sync and
+// reports the result of each member.
+func TestFederationEnvoySyncAll(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var (
+		serverA = NewServerAddrFromStr("alice:10029")
+		serverB = NewServerAddrFromStr("bob:10029")
+		serverC = NewServerAddrFromStr("carol:10029")
+	)
+
+	uniID := randBaseRoot(t).ID
+	otherUniID := Identifier{
+		AssetID:   asset.RandID(t),
+		ProofType: ProofTypeTransfer,
+	}
+
+	// Both alice and bob have a leaf the other one lacks, and they share
+	// another leaf. Bob also serves a leaf of another universe that fails
+	// to sync at first, as it depends on the leaves of alice.
+	var (
+		leafA      = randSyncLeaf(t)
+		leafB      = randSyncLeaf(t)
+		sharedLeaf = randSyncLeaf(t)
+		otherLeaf  = randSyncLeaf(t)
+	)
+	syncer := &mockMemberSyncer{
+		diffs: map[string][]AssetSyncDiff{
+			serverA.HostStr(): {{
+				NewUniverseRoot: BaseRoot{ID: uniID},
+				NewLeafProofs:   []*Leaf{leafA, sharedLeaf},
+			}},
+			serverB.HostStr(): {{
+				NewUniverseRoot: BaseRoot{ID: uniID},
+				NewLeafProofs:   []*Leaf{leafB, sharedLeaf},
+			}, {
+				NewUniverseRoot: BaseRoot{ID: otherUniID},
+				NewLeafProofs:   []*Leaf{otherLeaf},
+			}},
+		},
+		failOnce: map[string]Identifier{
+			serverB.HostStr(): otherUniID,
+		},
+		errs: map[string]error{
+			serverC.HostStr(): fmt.Errorf("unreachable"),
+		},
+		retries: make(map[string][]Identifier),
+	}
+
+	fedDB := &mockFederationDB{
+		servers: []ServerAddr{serverA, serverB, serverC},
+	}
+	envoy := NewFederationEnvoy(FederationConfig{
+		FederationDB:   fedDB,
+		UniverseSyncer: syncer,
+	})
+
+	result, err := envoy.SyncAll(ctx, SyncFull)
+	require.NoError(t, err)
+
+	// The shared leaf is only counted once.
+	require.EqualValues(t, 4, result.NumLeavesMerged)
+	require.Len(t, result.Members, 3)
+
+	resultA := result.Members[0]
+	require.Equal(t, serverA, resultA.Server)
+	require.NoError(t, resultA.Err)
+	require.EqualValues(t, 2, resultA.NumLeaves)
+
+	// The failed universe of bob was retried, and the retry replaced the
+	// failed diff.
+	resultB := result.Members[1]
+	require.Equal(t, serverB, resultB.Server)
+	require.NoError(t, resultB.Err)
+	require.EqualValues(t, 3, resultB.NumLeaves)
+	require.Len(t, resultB.Diffs, 2)
+	for _, diff := range resultB.Diffs {
+		require.False(t, diff.Failed())
+	}
+	require.Equal(
+		t, []Identifier{otherUniID}, syncer.retries[serverB.HostStr()],
+	)
+	require.NotContains(t, syncer.retries, serverA.HostStr())
+
+	resultC := result.Members[2]
+	require.Equal(t, serverC, resultC.Server)
+	require.ErrorContains(t, resultC.Err, "unreachable")
+	require.Zero(t, resultC.NumLeaves)
+
+	// Only the members we were able to sync with are logged.
+	require.ElementsMatch(
+		t, []ServerAddr{serverA, serverB}, fedDB.loggedSyncs,
+	)
+}
+
+// TestPriorityTiers tests that servers are grouped into tiers of descending
+// priority, retaining their order within each tier.
+func TestPriorityTiers(t *testing.T) {
+	t.Parallel()
+
+	newAddr := func(host string, priority int) ServerAddr {
+		addr := NewServerAddrFromStr(host)
+		addr.Priority = priority
+
+		return addr
+	}
+
+	a := newAddr("a", 0)
+	b := newAddr("b", 5)
+	c := newAddr("c", -1)
+	d := newAddr("d", 5)
+	e := newAddr("e", 0)
+
+	tiers := priorityTiers(
+		[]ServerAddr{a, b, c, d, e}, func(s ServerAddr) int {
+			return s.Priority
+		},
+	)
+	require.Equal(t, [][]ServerAddr{{b, d}, {a, e}, {c}}, tiers)
+
+	require.Empty(t, priorityTiers(nil, func(s ServerAddr) int {
+		return s.Priority
+	}))
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -23,6 +24,10 @@ var (
 	// ErrNoUniverseRoot is returned when no universe root is found.
 	ErrNoUniverseRoot = fmt.Errorf("no universe root found")
 
+	// ErrUnknownUniverseRoot is returned when a root hash isn't known as
+	// a current or past root of a universe.
+	ErrUnknownUniverseRoot = fmt.Errorf("unknown universe root")
+
 	// ErrNoUniverseServers is returned when no active Universe servers are
 	// found in the DB.
 	ErrNoUniverseServers = fmt.Errorf("no active federation servers")
@@ -31,9 +36,18 @@ var (
 	// to the DB already exists.
 	ErrDuplicateUniverse = fmt.Errorf("universe server already added")
 
+	// ErrUnknownUniverseServer is returned when a Universe server that is
+	// being updated isn't a member of the federation.
+	ErrUnknownUniverseServer = fmt.Errorf("unknown universe server")
+
 	// ErrNoUniverseProofFound is returned when a user attempts to look up
 	// a key in the universe that actually points to the empty leaf.
 	ErrNoUniverseProofFound = fmt.Errorf("no universe proof found")
+
+	// ErrLeafPruned is returned when a user attempts to look up the proof
+	// of a leaf that was pruned. A pruned leaf is still part of the
+	// universe tree, but its proof is no longer stored.
+	ErrLeafPruned = fmt.Errorf("universe leaf was pruned")
 )
 
 // Identifier is the identifier for a universe.
@@ -116,6 +130,11 @@ type Leaf struct {
 
 	// Amt is the amount of units associated with the coin.
 	Amt uint64
+
+	// SourcePriority is the priority of the federation server the leaf was
+	// synced from. This is nil if the leaf wasn't synced from a federation
+	// server. The priority isn't committed to in the universe tree.
+	SourcePriority *int
 }
 
 // SmtLeafNode returns the SMT leaf node for the given leaf.
@@ -221,6 +240,17 @@ type BaseBackend interface {
 	// RootNode returns the root node for a given base universe.
 	RootNode(context.Context) (mssmt.Node, string, error)
 
+	// RootNodeAtHeight returns the root node for a given base universe as
+	// it was after the most recent leaf at or before the given block
+	// height was inserted.
+	RootNodeAtHeight(ctx context.Context,
+		height uint32) (mssmt.Node, string, error)
+
+	// LastUpdateHeight returns the block height of the most recent leaf
+	// that was inserted into the universe. If no leaf insertion was
+	// recorded, then ErrNoUniverseRoot is returned.
+	LastUpdateHeight(ctx context.Context) (uint32, error)
+
 	// RegisterIssuance inserts a new minting leaf within the universe
 	// tree, stored at the base key. The metaReveal type is purely
 	// optional, and should be specified if the genesis proof committed to
@@ -231,7 +261,8 @@ type BaseBackend interface {
 	// FetchIssuanceProof returns an issuance proof for the target key. If
 	// the key doesn't have a script key specified, then all the proofs for
 	// the minting outpoint will be returned. If neither are specified,
-	// then proofs for all the inserted leaves will be returned.
+	// then proofs for all the inserted leaves will be returned. If all
+	// the matching leaves were pruned, then ErrLeafPruned is returned.
 	//
 	// TODO(roasbeef): can eventually do multi-proofs for the SMT
 	FetchIssuanceProof(ctx context.Context,
@@ -240,6 +271,12 @@ type BaseBackend interface {
 	// MintingKeys returns all the keys inserted in the universe.
 	MintingKeys(ctx context.Context) ([]LeafKey, error)
 
+	// MintingKeysSince returns the keys of all leaves inserted into the
+	// universe after it had the given root hash. ErrUnknownUniverseRoot
+	// is returned if the root hash isn't a known past root.
+	MintingKeysSince(ctx context.Context,
+		rootHash mssmt.NodeHash) ([]LeafKey, error)
+
 	// MintingLeaves returns all the minting leaves inserted into the
 	// universe.
 	MintingLeaves(ctx context.Context) ([]Leaf, error)
@@ -247,6 +284,15 @@ type BaseBackend interface {
 	// DeleteUniverse deletes all leaves, and the root, for a given base
 	// universe.
 	DeleteUniverse(ctx context.Context) (string, error)
+
+	// PruneLeaves prunes the proofs of the leaves stored at the given
+	// keys, returning the keys of the leaves that were pruned. A pruned
+	// leaf keeps its hash and sum, so the universe root doesn't change,
+	// but its proof can no longer be fetched.
+	PruneLeaves(ctx context.Context, keys []LeafKey) ([]LeafKey, error)
+
+	// PrunedLeafKeys returns the keys of all the leaves that were pruned.
+	PrunedLeafKeys(ctx context.Context) ([]LeafKey, error)
 }
 
 // BaseRoot is the ms-smt root for a base universe. This root can be used to
@@ -261,9 +307,157 @@ type BaseRoot struct {
 	// performance reasons.
 	AssetName string
 
+	// AssetType is the type of the assets in this universe.
+	AssetType asset.Type
+
 	// GroupedAssets is an optional map of asset IDs to the minted amount.
 	// This is only set for grouped assets.
 	GroupedAssets map[asset.ID]uint64
+
+	// NumLeaves is the number of leaves in the universe tree. This might
+	// not always be set for performance reasons.
+	NumLeaves uint64
+
+	// LastUpdated is the time the most recent leaf was inserted into the
+	// universe tree. This is the zero time if it is unknown, or not set for
+	// performance reasons.
+	LastUpdated time.Time
+
+	// Lazy is true if the root was imported by a roots only sync, which
+	// means that the leaves of the universe aren't all stored locally.
+	// Missing leaves are fetched from the server the root was synced from
+	// once they're queried.
+	Lazy bool
+
+	// BurnRoot is the root of the burn tree of the asset, the sum of which
+	// is the total burned amount. This is only set for the roots of
+	// transfer universes, as the burn tree indexes their burn leaves.
+	BurnRoot mssmt.Node
+}
+
+// RootNodesQuery restricts the set of universe roots that is returned when
+// querying the root nodes of all universes.
+type RootNodesQuery struct {
+	// IDPrefix is an optional lower case hex prefix of the asset ID (or
+	// the 32-byte x-only group key for grouped assets) of the universes to
+	// return. If empty, then all roots are returned.
+	IDPrefix string
+}
+
+// Validate returns an error if the ID prefix of the query is invalid.
+func (q RootNodesQuery) Validate() error {
+	if len(q.IDPrefix) > sha256.Size*2 {
+		return fmt.Errorf("ID prefix too long: max %d hex characters",
+			sha256.Size*2)
+	}
+
+	for _, c := range q.IDPrefix {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return fmt.Errorf("ID prefix must be lower case hex, "+
+				"got %q", q.IDPrefix)
+		}
+	}
+
+	return nil
+}
+
+// Matches returns true if the given universe ID matches the query.
+func (q RootNodesQuery) Matches(id Identifier) bool {
+	if q.IDPrefix == "" {
+		return true
+	}
+
+	idBytes := id.AssetID[:]
+	if id.GroupKey != nil {
+		idBytes = schnorr.SerializePubKey(id.GroupKey)
+	}
+
+	return strings.HasPrefix(hex.EncodeToString(idBytes), q.IDPrefix)
+}
+
+// IDRange returns the byte range that all asset IDs or group keys matching the
+// ID prefix of the query fall into. The lower bound is inclusive and the upper
+// bound is exclusive. The upper bound is nil if the range is open ended, both
+// bounds are nil if the query has no prefix. The query must be valid.
+func (q RootNodesQuery) IDRange() ([]byte, []byte) {
+	if q.IDPrefix == "" {
+		return nil, nil
+	}
+
+	// An odd length prefix is padded with a zero nibble, which results in
+	// the smallest ID that starts with the prefix.
+	padNibble := func(prefix []byte) []byte {
+		if len(prefix)%2 != 0 {
+			prefix = append(prefix, '0')
+		}
+
+		bounds, _ := hex.DecodeString(string(prefix))
+		return bounds
+	}
+
+	start := padNibble([]byte(q.IDPrefix))
+
+	// The upper bound is the prefix incremented by one, which we get by
+	// incrementing the last nibble that isn't 'f' and dropping all the
+	// nibbles after it.
+	end := []byte(q.IDPrefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] == 'f' {
+			continue
+		}
+
+		switch end[i] {
+		case '9':
+			end[i] = 'a'
+		default:
+			end[i]++
+		}
+
+		return start, padNibble(end[:i+1])
+	}
+
+	// The prefix consists of 'f' nibbles only, so there is no upper bound.
+	return start, nil
+}
+
+// PruneCriteria is the set of criteria that determines which assets are pruned
+// from the universe. An asset is only pruned if it matches all the criteria
+// that are set.
+type PruneCriteria struct {
+	// ZeroSupply, if set, selects the assets that have their full issued
+	// supply burned.
+	ZeroSupply bool
+
+	// MaxUpdateHeight, if non-zero, selects the assets that didn't have
+	// any leaf inserted into their universe trees after this block height.
+	MaxUpdateHeight uint32
+
+	// DryRun, if set, only determines the assets that would be pruned
+	// without actually removing them.
+	DryRun bool
+}
+
+// PrunedAsset describes an asset that was pruned from the universe, or that
+// would be pruned in case of a dry run.
+type PrunedAsset struct {
+	// ID identifies the universes of the asset. The proof type isn't set,
+	// as both the issuance and the transfer universe are pruned.
+	ID Identifier
+
+	// AssetName is the name of the asset.
+	AssetName string
+
+	// IssuedSupply is the total amount of the asset that was issued.
+	IssuedSupply uint64
+
+	// BurnedSupply is the amount of the asset that is known to be burned.
+	// This is only set if the zero supply criterion was used.
+	BurnedSupply uint64
+
+	// LastUpdateHeight is the block height of the most recent leaf that
+	// was inserted into the universes of the asset. This is only set if
+	// the update height criterion was used.
+	LastUpdateHeight uint32
 }
 
 // MultiverseRoot is the ms-smt root for a multiverse. This root can be used to
@@ -276,14 +470,60 @@ type MultiverseRoot struct {
 	mssmt.Node
 }
 
+// AssetSearchQuery is a query for the universe roots of assets by their name.
+type AssetSearchQuery struct {
+	// Name is the string the name (genesis tag) of an asset must contain
+	// for its universe roots to be returned. It is matched
+	// case-insensitively. If empty, then all roots are returned.
+	Name string
+
+	// Offset is the number of matching roots to skip. The roots are sorted
+	// by name, so this can be used together with the limit to page through
+	// the results.
+	Offset int
+
+	// Limit is the maximum number of roots to return.
+	Limit int
+}
+
+// Validate returns an error if the offset or limit of the query is invalid.
+func (q AssetSearchQuery) Validate() error {
+	switch {
+	case q.Offset < 0:
+		return fmt.Errorf("offset must not be negative")
+
+	case q.Limit <= 0:
+		return fmt.Errorf("limit must be positive")
+	}
+
+	return nil
+}
+
 // MultiverseArchive is an interface used to keep track of the set of universe
 // roots that we know of. The BaseBackend interface is used to interact with a
 // particular base universe, while this is used to obtain aggregate information
 // about the universes.
 type MultiverseArchive interface {
 	// RootNodes returns the complete set of known root nodes for the set
-	// of assets tracked in the base Universe.
-	RootNodes(ctx context.Context) ([]BaseRoot, error)
+	// of assets tracked in the base Universe that match the given query.
+	RootNodes(ctx context.Context, q RootNodesQuery) ([]BaseRoot, error)
+
+	// SearchRoots returns the root nodes of the assets with a name that
+	// matches the given query, sorted by name.
+	SearchRoots(ctx context.Context, q AssetSearchQuery) ([]BaseRoot, error)
+
+	// RootNode returns the root multiverse node for the given proof type.
+	RootNode(ctx context.Context,
+		proofType ProofType) (*MultiverseRoot, error)
+
+	// BurnRoot returns the root of the burn tree of the asset with the
+	// given universe ID. The burn tree indexes all burns within the
+	// transfer universe of the asset, so its sum is the total burned
+	// amount. As inserting a leaf can't decrease the sum of an MS-SMT,
+	// the remaining supply of the asset is the sum of its issuance
+	// universe minus the sum of its burn tree. The proof type of the ID
+	// is ignored.
+	BurnRoot(ctx context.Context, id Identifier) (mssmt.Node, error)
 
 	// UpsertProofLeaf upserts a proof leaf within the multiverse tree and
 	// the universe tree that corresponds to the given key.
@@ -296,6 +536,14 @@ type MultiverseArchive interface {
 	// base key(s).
 	RegisterBatchIssuance(ctx context.Context, items []*IssuanceItem) error
 
+	// DeleteProofLeaf deletes a proof leaf from the universe tree that
+	// corresponds to the given key, and updates the multiverse tree
+	// accordingly. The new universe root is returned, which is nil if the
+	// universe tree is now empty. If the leaf doesn't exist, then this is
+	// a no-op.
+	DeleteProofLeaf(ctx context.Context, id Identifier,
+		key LeafKey) (mssmt.Node, error)
+
 	// FetchProofLeaf returns a proof leaf for the target key. If the key
 	// doesn't have a script key specified, then all the proof leafs for the
 	// minting outpoint will be returned. If neither are specified, then all
@@ -303,6 +551,14 @@ type MultiverseArchive interface {
 	FetchProofLeaf(ctx context.Context, id Identifier,
 		key LeafKey) ([]*Proof, error)
 
+	// VerifyUniverseRoot recomputes the root of the universe tree with the
+	// given ID from its stored leaves, and compares it to the persisted
+	// root of the tree and the root committed to in the multiverse tree.
+	// If repair is true and the roots don't match, then the universe tree
+	// and its multiverse leaf are rebuilt from the stored leaves.
+	VerifyUniverseRoot(ctx context.Context, id Identifier,
+		repair bool) (*RootVerification, error)
+
 	// TODO(roasbeef): other stats stuff here, like total number of assets, etc
 	//  * also eventually want pull/fetch stats, can be pulled out into another instance
 }
@@ -355,13 +611,25 @@ const (
 // resolveUniverseAddr maps an RPC universe host (of the form 'host' or
 // 'host:port') into a net.Addr.
 func resolverUniverseAddr(uniAddr string) (net.Addr, error) {
+	hostPort, err := universeHostPort(uniAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return net.ResolveTCPAddr("tcp", hostPort)
+}
+
+// universeHostPort returns the host:port of the given universe address, using
+// the default universe RPC port if no port is specified. No name resolution
+// takes place.
+func universeHostPort(uniAddr string) (string, error) {
 	var (
 		host string
 		port int
 	)
 
 	if len(uniAddr) == 0 {
-		return nil, fmt.Errorf("universe host cannot be empty")
+		return "", fmt.Errorf("universe host cannot be empty")
 	}
 
 	// Split the address into its host and port components.
@@ -376,15 +644,40 @@ func resolverUniverseAddr(uniAddr string) (net.Addr, error) {
 		host = h
 		portNum, err := strconv.Atoi(p)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 		port = portNum
 	}
 
-	// TODO(roasbeef): add tor support
+	return net.JoinHostPort(host, strconv.Itoa(port)), nil
+}
 
-	hostPort := net.JoinHostPort(host, strconv.Itoa(port))
-	return net.ResolveTCPAddr("tcp", hostPort)
+// ProxyConfig is the config of a SOCKS5 proxy that's used to connect to a
+// remote universe server, for example a Tor daemon to reach universe servers
+// that are only available as onion services.
+type ProxyConfig struct {
+	// Addr is the host:port of the SOCKS5 proxy.
+	Addr string
+
+	// User is the optional user name used to authenticate with the proxy.
+	User string
+
+	// Password is the optional password used to authenticate with the
+	// proxy.
+	Password string
+}
+
+// Validate returns an error if the proxy config is invalid.
+func (p *ProxyConfig) Validate() error {
+	if _, _, err := net.SplitHostPort(p.Addr); err != nil {
+		return fmt.Errorf("invalid proxy address %v: %w", p.Addr, err)
+	}
+
+	if p.User == "" && p.Password != "" {
+		return fmt.Errorf("proxy password set without user")
+	}
+
+	return nil
 }
 
 // ServerAddr wraps the reachable network address of a remote universe
@@ -401,6 +694,31 @@ type ServerAddr struct {
 	// cached the first time so resolution doesn't need to be hit
 	// repeatedly.
 	addr net.Addr
+
+	// TLSCert is an optional PEM encoded TLS certificate that is pinned for
+	// the remote universe. If set, this certificate is used as the only
+	// trusted CA when connecting to the server.
+	TLSCert []byte
+
+	// SyncInterval is an optional, server specific interval at which
+	// we'll periodically sync with the remote universe. If zero, then the
+	// global sync interval is used.
+	SyncInterval time.Duration
+
+	// LastSyncTime is the last time we successfully synced with the remote
+	// universe.
+	LastSyncTime time.Time
+
+	// Proxy is an optional, server specific SOCKS5 proxy that's used to
+	// connect to the remote universe. If nil, then the global proxy of the
+	// node is used, if any.
+	Proxy *ProxyConfig
+
+	// Priority is the priority of the remote universe within the
+	// federation. Servers with a higher priority are synced first, and
+	// leaves synced from them are never replaced by leaves synced from
+	// servers with a lower or equal priority.
+	Priority int
 }
 
 // NewServerAddrFromStr creates a new server address from a string that is the
@@ -435,11 +753,29 @@ func (s *ServerAddr) Addr() (net.Addr, error) {
 	return addr, err
 }
 
+// WithDefaultProxy returns a copy of the given server address that connects
+// through the given default proxy, unless a server specific proxy is already
+// set.
+func WithDefaultProxy(addr ServerAddr, defaultProxy *ProxyConfig) ServerAddr {
+	if addr.Proxy == nil {
+		addr.Proxy = defaultProxy
+	}
+
+	return addr
+}
+
 // HostStr returns the host string of the remote universe server.
 func (s *ServerAddr) HostStr() string {
 	return s.addrStr
 }
 
+// HostPort returns the host:port of the remote universe server, without any
+// name resolution taking place. This is used to connect through a proxy, which
+// then resolves the host name itself.
+func (s *ServerAddr) HostPort() (string, error) {
+	return universeHostPort(s.addrStr)
+}
+
 // SyncType is an enum that describes the type of sync that should be performed
 // between a local and remote universe.
 type SyncType uint8
@@ -450,6 +786,16 @@ const (
 
 	// SyncFull is a sync that will sync all the assets in the universe.
 	SyncFull
+
+	// SyncDelta is a sync that will sync all the assets in the universe,
+	// but only fetches the leaves inserted since the local root of each
+	// universe, instead of diffing the full set of leaf keys.
+	SyncDelta
+
+	// SyncRootsOnly is a sync that only imports the roots of all the
+	// assets in the universe, without any of their leaves. The leaves are
+	// fetched from the remote universe once they're queried.
+	SyncRootsOnly
 )
 
 // String returns a human-readable string representation of the sync type.
@@ -459,11 +805,39 @@ func (s SyncType) String() string {
 		return "issuance"
 	case SyncFull:
 		return "full"
+	case SyncDelta:
+		return "delta"
+	case SyncRootsOnly:
+		return "roots_only"
 	default:
 		return fmt.Sprintf("unknown(%v)", int(s))
 	}
 }
 
+// SyncProgress describes the progress of an ongoing Universe sync.
+type SyncProgress struct {
+	// NumUniverses is the total number of Universes that are synced.
+	NumUniverses int
+
+	// NumUniversesDone is the number of Universes whose sync is finished,
+	// either successfully or with an error.
+	NumUniversesDone int
+
+	// NumLeavesInserted is the total number of new leaves that were
+	// inserted into the local Universe so far.
+	NumLeavesInserted int
+
+	// CurrentID is the ID of the Universe that caused this progress
+	// update. This isn't set for the initial update that is sent once the
+	// set of Universes to sync is known.
+	CurrentID *Identifier
+}
+
+// SyncProgressFunc is called with a progress update whenever a batch of new
+// leaves was inserted or the sync of a Universe finished. Calls are never made
+// concurrently.
+type SyncProgressFunc func(SyncProgress)
+
 // AssetSyncDiff is the result of a success Universe sync. The diff contains the
 // Universe root, and the set of assets that were added to the Universe.
 type AssetSyncDiff struct {
@@ -477,8 +851,101 @@ type AssetSyncDiff struct {
 	// Universe.
 	NewLeafProofs []*Leaf
 
-	// TODO(roasbeef): ability to return if things failed?
-	//  * can used a sealed interface to return the error
+	// NumIssuanceLeaves is the number of new issuance leaves that were
+	// pulled from the remote Universe as part of this diff.
+	NumIssuanceLeaves uint64
+
+	// NumTransferLeaves is the number of new transfer leaves that were
+	// pulled from the remote Universe as part of this diff.
+	NumTransferLeaves uint64
+
+	// SyncErr is set if the sync of this particular Universe root failed.
+	// A failure for a single root doesn't abort the sync of the remaining
+	// roots, so callers should check this field for each diff.
+	SyncErr error
+
+	// DeltaFallback is true if a delta sync was requested, but the remote
+	// Universe didn't know the local root, so the full set of leaf keys
+	// was diffed instead.
+	DeltaFallback bool
+
+	// SkippedTooDeep is true if the sync of this Universe root was skipped
+	// because the proof chain of one of its assets exceeded the maximum
+	// proof depth. The SyncErr is set as well in that case.
+	SkippedTooDeep bool
+
+	// NumShallowLeaves is the number of new leaves of the remote Universe
+	// that weren't synced because their anchor transaction didn't have the
+	// minimum number of confirmations yet. These leaves are synced by a
+	// later sync, once their anchor is buried deep enough.
+	NumShallowLeaves uint64
+}
+
+// Failed returns true if the sync of the target Universe root failed.
+func (a *AssetSyncDiff) Failed() bool {
+	return a.SyncErr != nil
+}
+
+// LeafSource describes where a new leaf inserted into a local Universe tree
+// originated from.
+type LeafSource uint8
+
+const (
+	// LeafSourceFederation indicates that the leaf was pushed to us by, or
+	// synced from, a remote Universe server.
+	LeafSourceFederation LeafSource = iota
+
+	// LeafSourceLocal indicates that the leaf was created locally, for
+	// example as a result of minting a new asset.
+	LeafSourceLocal
+)
+
+// String returns a human-readable string representation of the leaf source.
+func (s LeafSource) String() string {
+	switch s {
+	case LeafSourceFederation:
+		return "federation"
+	case LeafSourceLocal:
+		return "local"
+	default:
+		return fmt.Sprintf("unknown(%v)", int(s))
+	}
+}
+
+// LeafEvent is an event that is emitted each time a new leaf is inserted into,
+// or removed from, one of the local Universe trees.
+type LeafEvent struct {
+	// timestamp is the time the event was created.
+	timestamp time.Time
+
+	// Index is the monotonically increasing index of the event. This can
+	// be used by subscribers to resume a subscription without missing any
+	// events. The index is reset each time the daemon restarts.
+	Index uint64
+
+	// UniverseRoot is the root of the Universe tree after the leaf was
+	// inserted.
+	UniverseRoot BaseRoot
+
+	// Key is the leaf key the new leaf was inserted at.
+	Key LeafKey
+
+	// Leaf is the new leaf itself.
+	Leaf *Leaf
+
+	// Source describes where the new leaf originated from.
+	Source LeafSource
+
+	// Removed is true if the leaf was removed from the Universe tree
+	// instead of inserted, because its anchor transaction was
+	// re-organized out of the chain. UniverseRoot is then the root after
+	// the removal, and Leaf the leaf that was removed.
+	Removed bool
+}
+
+// Timestamp returns the timestamp of the event.
+func (e *LeafEvent) Timestamp() time.Time {
+	return e.timestamp
 }
 
 // Syncer is used to synchronize the state of two Universe instances: a local
@@ -492,6 +959,32 @@ type Syncer interface {
 	SyncUniverse(ctx context.Context, host ServerAddr,
 		syncType SyncType, syncConfigs SyncConfigs,
 		idsToSync ...Identifier) ([]AssetSyncDiff, error)
+
+	// SyncUniverseWithProgress is identical to SyncUniverse, but reports
+	// the progress of the sync to the given function as it proceeds.
+	SyncUniverseWithProgress(ctx context.Context, host ServerAddr,
+		syncType SyncType, syncConfigs SyncConfigs,
+		progress SyncProgressFunc,
+		idsToSync ...Identifier) ([]AssetSyncDiff, error)
+
+	// FetchSyncDiff computes the diff between the local and the remote
+	// universe, governed by the sync type and the set of universe IDs,
+	// without inserting any of the new leaves into the local universe.
+	FetchSyncDiff(ctx context.Context, host ServerAddr,
+		syncType SyncType, syncConfigs SyncConfigs,
+		idsToSync ...Identifier) ([]AssetSyncDiff, error)
+
+	// DiffUniverse compares the local universe with the universe of the
+	// remote server and returns the set of universes whose roots differ.
+	DiffUniverse(ctx context.Context,
+		host ServerAddr) ([]UniverseRootDiff, error)
+
+	// MultiverseRoot returns the hash that summarizes the multiverse
+	// trees of the remote universe that are covered by a sync of the
+	// given type. If the hash didn't change since a previous sync, then a
+	// new sync won't find any new leaves.
+	MultiverseRoot(ctx context.Context, host ServerAddr,
+		syncType SyncType) (mssmt.NodeHash, error)
 }
 
 // DiffEngine is a Universe diff engine that can be used to compare the state
@@ -500,12 +993,25 @@ type DiffEngine interface {
 	// RootNode returns the root node for a given base universe.
 	RootNode(ctx context.Context, id Identifier) (BaseRoot, error)
 
-	// RootNodes returns the set of root nodes for all known universes.
-	RootNodes(ctx context.Context) ([]BaseRoot, error)
+	// RootNodes returns the set of root nodes for all known universes that
+	// match the given query.
+	RootNodes(ctx context.Context, q RootNodesQuery) ([]BaseRoot, error)
+
+	// MultiverseRoot returns the root of the multiverse tree for the given
+	// proof type.
+	MultiverseRoot(ctx context.Context,
+		proofType ProofType) (*MultiverseRoot, error)
 
 	// UniverseLeafKeys returns all the keys inserted in the universe.
 	UniverseLeafKeys(ctx context.Context, id Identifier) ([]LeafKey, error)
 
+	// UniverseLeafKeysSince returns the keys of all leaves inserted into
+	// the universe after it had the given root hash. ErrUnknownUniverseRoot
+	// is returned if the root hash isn't a known past root, for example
+	// because it's on a diverged branch.
+	UniverseLeafKeysSince(ctx context.Context, id Identifier,
+		rootHash mssmt.NodeHash) ([]LeafKey, error)
+
 	// FetchIssuanceProof attempts to fetch an issuance proof for the
 	// target base leaf based on the universe identifier (assetID/groupKey).
 	//
@@ -584,11 +1090,63 @@ type FederationLog interface {
 	// RemoveServers removes a set of servers from the federation.
 	RemoveServers(ctx context.Context, addrs ...ServerAddr) error
 
+	// UpdateServerPriority sets the priority of the given federation
+	// server, which is identified by its host or ID. If the server isn't
+	// a member of the federation, ErrUnknownUniverseServer is returned.
+	UpdateServerPriority(ctx context.Context, addr ServerAddr,
+		priority int) error
+
 	// LogNewSyncs logs a new sync event for each server. This can be used
 	// to keep track of the last time we synced with a remote server.
 	LogNewSyncs(ctx context.Context, addrs ...ServerAddr) error
 }
 
+// PendingPush is a leaf of the local universe that couldn't be pushed out to a
+// federation server, and is queued to be retried.
+type PendingPush struct {
+	// ID is the unique identifier of the pending push.
+	ID int64
+
+	// ServerAddr is the address of the server the leaf should be pushed
+	// to.
+	ServerAddr ServerAddr
+
+	// UniverseID is the identifier of the universe the leaf belongs to.
+	UniverseID Identifier
+
+	// Key is the key of the leaf to push.
+	Key LeafKey
+
+	// NumAttempts is the number of failed attempts to push the leaf.
+	NumAttempts uint32
+
+	// NextAttempt is the time at which the push should be retried.
+	NextAttempt time.Time
+
+	// LastErr is the error of the last failed push attempt.
+	LastErr string
+}
+
+// FederationPushLog is used to durably track the leaves that couldn't be
+// pushed out to a federation server, so the push can be retried later.
+type FederationPushLog interface {
+	// QueuePendingPush adds a new failed push to the set of pending
+	// pushes.
+	QueuePendingPush(ctx context.Context, push PendingPush) error
+
+	// UpdatePendingPush updates the number of attempts, the next attempt
+	// time and the last error of an existing pending push.
+	UpdatePendingPush(ctx context.Context, push PendingPush) error
+
+	// RemovePendingPush removes a pending push, once it succeeded.
+	RemovePendingPush(ctx context.Context, id int64) error
+
+	// PendingPushes returns the set of all pending pushes. Pending pushes
+	// of servers that were removed from the federation are removed along
+	// with the server.
+	PendingPushes(ctx context.Context) ([]PendingPush, error)
+}
+
 // ProofType is an enum that describes the type of proof which can be stored in
 // a given universe.
 type ProofType uint8
@@ -696,6 +1254,7 @@ type FederationSyncConfigDB interface {
 // and tracked servers.
 type FederationDB interface {
 	FederationLog
+	FederationPushLog
 	FederationSyncConfigDB
 }
 
@@ -839,6 +1398,14 @@ type AggregateStats struct {
 	// NumTotalProofs is the total number of proofs that have been inserted
 	// into the Universe.
 	NumTotalProofs uint64
+
+	// NumTotalLeaves is the total number of leaves across all universe
+	// trees.
+	NumTotalLeaves uint64
+
+	// NumTotalPushes is the total number of leaves that have been pushed
+	// out to members of the universe federation.
+	NumTotalPushes uint64
 }
 
 // GroupedStatsQuery packages a set of query parameters to retrieve event based
@@ -892,6 +1459,11 @@ type Telemetry interface {
 	// universe.
 	LogNewProofEvents(ctx context.Context, uniIDs ...Identifier) error
 
+	// LogPushEvent logs that a leaf of the target universe was pushed to
+	// the given federation server.
+	LogPushEvent(ctx context.Context, uniID Identifier, key LeafKey,
+		serverHost string) error
+
 	// QuerySyncStats attempts to query the stats for the target universe.
 	// For a given asset ID, tag, or type, the set of universe stats is
 	// returned which lists information such as the total number of syncs
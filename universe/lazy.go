@@ -0,0 +1,220 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+// LazyRoot is a universe root that was imported by a roots only sync, without
+// any of the leaves of the universe. The leaves are fetched from the server the
+// root was synced from once they're queried.
+type LazyRoot struct {
+	BaseRoot
+
+	// SourceHost is the host of the universe server the root was synced
+	// from.
+	SourceHost string
+
+	// SyncedAt is the time the root was last synced.
+	SyncedAt time.Time
+}
+
+// LazyRootStore is used to persist the universe roots imported by a roots only
+// sync.
+type LazyRootStore interface {
+	// UpsertLazyRoot inserts a new lazy root, or replaces the lazy root
+	// that is already known for the same universe.
+	UpsertLazyRoot(ctx context.Context, root LazyRoot) error
+
+	// FetchLazyRoot returns the lazy root of the given universe. If the
+	// universe doesn't have a lazy root, then ErrNoUniverseRoot is
+	// returned.
+	FetchLazyRoot(ctx context.Context, id Identifier) (*LazyRoot, error)
+
+	// LazyRoots returns all known lazy roots.
+	LazyRoots(ctx context.Context) ([]LazyRoot, error)
+
+	// DeleteLazyRoot removes the lazy root of the given universe, once the
+	// universe is fully backed by local leaves.
+	DeleteLazyRoot(ctx context.Context, id Identifier) error
+}
+
+// fetchLazyRoot returns the lazy root of the given universe, or nil if the
+// universe doesn't have one.
+func (a *MintingArchive) fetchLazyRoot(ctx context.Context,
+	id Identifier) (*LazyRoot, error) {
+
+	if a.cfg.LazyRoots == nil {
+		return nil, nil
+	}
+
+	lazyRoot, err := a.cfg.LazyRoots.FetchLazyRoot(ctx, id)
+	switch {
+	case errors.Is(err, ErrNoUniverseRoot):
+		return nil, nil
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch lazy root: %w", err)
+	}
+
+	return lazyRoot, nil
+}
+
+// withLazyRoot returns the lazy root of the given universe in place of the
+// given local root, if the universe has one. The lazy root always reflects a
+// more recent state than the local leaves, as the lazy root is removed once the
+// universe is fully backed.
+func (a *MintingArchive) withLazyRoot(ctx context.Context, id Identifier,
+	localRoot BaseRoot, localErr error) (BaseRoot, error) {
+
+	if localErr != nil && !errors.Is(localErr, ErrNoUniverseRoot) {
+		return BaseRoot{}, localErr
+	}
+
+	lazyRoot, err := a.fetchLazyRoot(ctx, id)
+	switch {
+	case err != nil:
+		return BaseRoot{}, err
+
+	case lazyRoot == nil:
+		return localRoot, localErr
+	}
+
+	root := lazyRoot.BaseRoot
+	root.Lazy = true
+	if root.AssetName == "" {
+		root.AssetName = localRoot.AssetName
+	}
+
+	return root, nil
+}
+
+// mergeLazyRoots replaces the roots of all universes with a lazy root by the
+// lazy root, and adds the lazy roots of all universes without any local
+// leaves.
+func (a *MintingArchive) mergeLazyRoots(ctx context.Context, q RootNodesQuery,
+	roots []BaseRoot) ([]BaseRoot, error) {
+
+	if a.cfg.LazyRoots == nil {
+		return roots, nil
+	}
+
+	lazyRoots, err := a.cfg.LazyRoots.LazyRoots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch lazy roots: %w", err)
+	}
+
+	lazyByID := make(map[string]BaseRoot, len(lazyRoots))
+	for _, lazyRoot := range lazyRoots {
+		if !q.Matches(lazyRoot.ID) {
+			continue
+		}
+
+		root := lazyRoot.BaseRoot
+		root.Lazy = true
+		lazyByID[lazyRoot.ID.String()] = root
+	}
+
+	merged := make([]BaseRoot, 0, len(roots)+len(lazyByID))
+	for _, root := range roots {
+		lazyRoot, ok := lazyByID[root.ID.String()]
+		if !ok {
+			merged = append(merged, root)
+			continue
+		}
+
+		if lazyRoot.AssetName == "" {
+			lazyRoot.AssetName = root.AssetName
+		}
+		merged = append(merged, lazyRoot)
+		delete(lazyByID, root.ID.String())
+	}
+	for _, lazyRoot := range lazyRoots {
+		if root, ok := lazyByID[lazyRoot.ID.String()]; ok {
+			merged = append(merged, root)
+		}
+	}
+
+	return merged, nil
+}
+
+// fetchLazyProof fetches the proof of a leaf of a universe with a lazy root
+// from the server the root was synced from. The proof is verified against the
+// lazy root and then inserted into the local universe, so later queries for
+// the same leaf are served locally.
+func (a *MintingArchive) fetchLazyProof(ctx context.Context,
+	lazyRoot *LazyRoot, key LeafKey) ([]*Proof, error) {
+
+	id := lazyRoot.ID
+
+	log.Debugf("Fetching lazy Universe proof from %v: id=%v, "+
+		"base_key=%v", lazyRoot.SourceHost, id.StringForLog(),
+		spew.Sdump(key))
+
+	if a.cfg.NewRemoteDiffEngine == nil {
+		return nil, ErrNoUniverseProofFound
+	}
+
+	diffEngine, err := a.cfg.NewRemoteDiffEngine(
+		NewServerAddrFromStr(lazyRoot.SourceHost),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create remote diff "+
+			"engine: %w", err)
+	}
+
+	proofs, err := diffEngine.FetchIssuanceProof(ctx, id, key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch proof from %v: %w",
+			lazyRoot.SourceHost, err)
+	}
+
+	// We only serve proofs that are part of the lazy root, so the remote
+	// server can't make us serve leaves that aren't part of the root we
+	// synced.
+	for _, leafProof := range proofs {
+		validRoot, err := leafProof.VerifyRoot(lazyRoot.BaseRoot)
+		if err != nil {
+			return nil, fmt.Errorf("unable to verify root: %w",
+				err)
+		}
+		if !validRoot {
+			return nil, fmt.Errorf("proof for key=%v is not part "+
+				"of lazy root", spew.Sdump(key))
+		}
+	}
+
+	// Failing to cache the proofs locally doesn't prevent us from serving
+	// them, so we only log the error.
+	for _, leafProof := range proofs {
+		_, err := a.RegisterIssuance(
+			ctx, id, leafProof.LeafKey, leafProof.Leaf,
+		)
+		if err != nil {
+			log.Warnf("Unable to cache lazy Universe proof "+
+				"(id=%v, key=%v): %v", id.StringForLog(),
+				spew.Sdump(leafProof.LeafKey), err)
+
+			return proofs, nil
+		}
+	}
+
+	// If the local leaves now add up to the lazy root, then the universe
+	// is fully backed and the lazy root is no longer needed.
+	localRoot, err := a.localRootNode(ctx, id)
+	if err == nil && localRoot.NodeHash() == lazyRoot.NodeHash() {
+		log.Infof("UniverseRoot(%v) is now fully backed", id.String())
+
+		err := a.cfg.LazyRoots.DeleteLazyRoot(ctx, id)
+		if err != nil {
+			log.Warnf("Unable to remove lazy root of %v: %v",
+				id.String(), err)
+		}
+	}
+
+	return proofs, nil
+}
@@ -0,0 +1,308 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+// SpentLeafPruneCriteria is the set of criteria that determines which spent
+// transfer leaves are pruned.
+type SpentLeafPruneCriteria struct {
+	// MinConfs is the minimum number of confirmations the transfer that
+	// spends a leaf must have for the leaf to be pruned.
+	MinConfs uint32
+
+	// CurrentHeight is the current height of the main chain, which is
+	// used to determine the number of confirmations of the spending
+	// transfers.
+	CurrentHeight uint32
+
+	// DryRun, if set, only determines the leaves that would be pruned
+	// without actually pruning them.
+	DryRun bool
+}
+
+// PrunedLeaf describes a transfer leaf that was pruned, or that would be
+// pruned in case of a dry run.
+type PrunedLeaf struct {
+	// ID identifies the transfer universe of the leaf.
+	ID Identifier
+
+	// Key is the key of the leaf within the universe.
+	Key LeafKey
+
+	// Amount is the amount of the asset held by the leaf's output. The
+	// amount remains committed to by the universe root.
+	Amount uint64
+
+	// SpentHeight is the block height at which the transfer that spends
+	// the leaf's output was confirmed.
+	SpentHeight uint32
+}
+
+// PruneSpentLeaves prunes the proofs of the transfer leaves of all assets that
+// are spent by another transfer leaf with at least the minimum number of
+// confirmations, and returns the leaves that were pruned. A pruned leaf keeps
+// its hash and sum in the universe tree, so the universe and multiverse roots
+// don't change and can still be verified. The outputs that aren't spent are
+// never pruned, so the current holdings of an asset can still be verified.
+//
+// NOTE: The proof of a pruned leaf is gone for good. It can no longer be
+// served to syncers or clients that request the full transfer history of an
+// asset, and a sync of a universe with pruned leaves fails for those leaves.
+func (a *MintingArchive) PruneSpentLeaves(ctx context.Context,
+	criteria SpentLeafPruneCriteria) ([]PrunedLeaf, error) {
+
+	if criteria.MinConfs == 0 {
+		return nil, fmt.Errorf("minimum number of confirmations must " +
+			"be set")
+	}
+
+	// We hold the prune mutex for the whole operation, so no new leaves
+	// can be inserted by a concurrent sync while we determine which of
+	// the leaves are spent.
+	a.pruneMtx.Lock()
+	defer a.pruneMtx.Unlock()
+
+	roots, err := a.cfg.Multiverse.RootNodes(ctx, RootNodesQuery{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch universe roots: %w",
+			err)
+	}
+
+	var pruned []PrunedLeaf
+	for _, root := range roots {
+		if root.ID.ProofType != ProofTypeTransfer {
+			continue
+		}
+
+		prunedLeaves, err := a.pruneSpentLeaves(ctx, root.ID, criteria)
+		if err != nil {
+			return nil, fmt.Errorf("unable to prune leaves of "+
+				"universe %v: %w", root.ID.StringForLog(), err)
+		}
+
+		pruned = append(pruned, prunedLeaves...)
+	}
+
+	if !criteria.DryRun && len(pruned) != 0 {
+		a.invalidateProofCache()
+	}
+
+	return pruned, nil
+}
+
+// pruneSpentLeaves prunes the spent leaves of the transfer universe with the
+// given ID that match the given criteria.
+//
+// NOTE: The prune mutex must be held when calling this method.
+func (a *MintingArchive) pruneSpentLeaves(ctx context.Context, id Identifier,
+	criteria SpentLeafPruneCriteria) ([]PrunedLeaf, error) {
+
+	baseUni := a.fetchUniverse(id)
+
+	// Pruned leaves aren't returned, which is fine, as the outputs they
+	// spend were already pruned before them.
+	leaves, err := baseUni.MintingLeaves(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch leaves: %w", err)
+	}
+
+	// We'll first find the most recent spend of each output. Usually an
+	// output is only spent once, but the universe might know about a
+	// conflicting spend that was re-organized out of the chain.
+	spenders := make(map[asset.PrevID]*proof.Proof)
+	for idx := range leaves {
+		spendProof := leaves[idx].Proof
+		for _, prevID := range spentPrevIDs(&leaves[idx]) {
+			spender, ok := spenders[prevID]
+			if ok && spender.BlockHeight >= spendProof.BlockHeight {
+				continue
+			}
+
+			spenders[prevID] = spendProof
+		}
+	}
+
+	var (
+		candidates []PrunedLeaf
+		keys       []LeafKey
+	)
+	for idx := range leaves {
+		leaf := &leaves[idx]
+
+		spender, ok := spenders[leafPrevID(leaf)]
+		if !ok {
+			continue
+		}
+
+		confs := ProofConfs(spender, criteria.CurrentHeight)
+		if confs < criteria.MinConfs {
+			continue
+		}
+
+		key := LeafKey{
+			OutPoint:  leaf.Proof.OutPoint(),
+			ScriptKey: &leaf.Proof.Asset.ScriptKey,
+		}
+		candidates = append(candidates, PrunedLeaf{
+			ID:          id,
+			Key:         key,
+			Amount:      leaf.Amt,
+			SpentHeight: spender.BlockHeight,
+		})
+		keys = append(keys, key)
+	}
+
+	if criteria.DryRun || len(keys) == 0 {
+		return candidates, nil
+	}
+
+	prunedKeys, err := baseUni.PruneLeaves(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	prunedSet := fn.NewSet[UniverseKey]()
+	for _, key := range prunedKeys {
+		prunedSet.Add(key.UniverseKey())
+	}
+
+	pruned := fn.Filter(candidates, func(leaf PrunedLeaf) bool {
+		return prunedSet.Contains(leaf.Key.UniverseKey())
+	})
+
+	log.Infof("Pruned %d spent leaves of universe %v", len(pruned),
+		id.StringForLog())
+
+	return pruned, nil
+}
+
+// PrunedLeafKeys returns the keys of all the leaves of the universe with the
+// given ID that were pruned.
+func (a *MintingArchive) PrunedLeafKeys(ctx context.Context,
+	id Identifier) ([]LeafKey, error) {
+
+	log.Debugf("Retrieving pruned leaf keys for Universe: id=%v",
+		id.StringForLog())
+
+	return withBaseUni(
+		a, id, func(baseUni BaseBackend) ([]LeafKey, error) {
+			return baseUni.PrunedLeafKeys(ctx)
+		},
+	)
+}
+
+// SpentLeafPrunerConfig is the main config for the SpentLeafPruner.
+type SpentLeafPrunerConfig struct {
+	// Archive is the Universe archive the spent leaves are pruned from.
+	Archive *MintingArchive
+
+	// ChainHeight returns the current height of the main chain.
+	ChainHeight ChainHeightFunc
+
+	// RetentionConfs is the number of confirmations after which a spent
+	// transfer leaf is pruned.
+	RetentionConfs uint32
+
+	// PruneInterval is the interval at which the spent leaves are pruned.
+	PruneInterval time.Duration
+}
+
+// SpentLeafPruner periodically prunes the transfer leaves that are spent by a
+// transfer with at least the configured number of confirmations, which
+// enforces the retention policy of the local Universe.
+type SpentLeafPruner struct {
+	cfg SpentLeafPrunerConfig
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*fn.ContextGuard
+
+	startOnce sync.Once
+
+	stopOnce sync.Once
+}
+
+// NewSpentLeafPruner creates a new spent leaf pruner based on the passed
+// config.
+func NewSpentLeafPruner(cfg SpentLeafPrunerConfig) *SpentLeafPruner {
+	return &SpentLeafPruner{
+		cfg: cfg,
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start starts the spent leaf pruner.
+func (p *SpentLeafPruner) Start() error {
+	p.startOnce.Do(func() {
+		log.Infof("Starting spent leaf pruner, retention_confs=%d, "+
+			"interval=%v", p.cfg.RetentionConfs,
+			p.cfg.PruneInterval)
+
+		p.Wg.Add(1)
+		go p.pruneLoop()
+	})
+
+	return nil
+}
+
+// Stop stops the spent leaf pruner.
+func (p *SpentLeafPruner) Stop() error {
+	p.stopOnce.Do(func() {
+		log.Infof("Stopping spent leaf pruner")
+
+		close(p.Quit)
+		p.Wg.Wait()
+	})
+
+	return nil
+}
+
+// pruneLoop prunes the spent leaves once at startup, and then at every prune
+// interval.
+func (p *SpentLeafPruner) pruneLoop() {
+	defer p.Wg.Done()
+
+	ticker := time.NewTicker(p.cfg.PruneInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.prune(); err != nil {
+			log.Errorf("Unable to prune spent leaves: %v", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-p.Quit:
+			return
+		}
+	}
+}
+
+// prune prunes all the spent leaves that are buried deep enough.
+func (p *SpentLeafPruner) prune() error {
+	ctx, cancel := p.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	currentHeight, err := p.cfg.ChainHeight(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch current height: %w", err)
+	}
+
+	_, err = p.cfg.Archive.PruneSpentLeaves(ctx, SpentLeafPruneCriteria{
+		MinConfs:      p.cfg.RetentionConfs,
+		CurrentHeight: currentHeight,
+	})
+
+	return err
+}
@@ -0,0 +1,125 @@
+package universe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/stretchr/testify/require"
+)
+
+// mockRootsMultiverse is a mock multiverse that serves a static set of roots.
+type mockRootsMultiverse struct {
+	MultiverseArchive
+
+	roots []BaseRoot
+}
+
+func (m *mockRootsMultiverse) RootNodes(context.Context,
+	RootNodesQuery) ([]BaseRoot, error) {
+
+	return m.roots, nil
+}
+
+func (m *mockSupplyUniverse) PruneLeaves(_ context.Context,
+	keys []LeafKey) ([]LeafKey, error) {
+
+	m.prunedKeys = append(m.prunedKeys, keys...)
+
+	return keys, nil
+}
+
+// TestPruneSpentLeaves tests that only the transfer leaves that are spent by a
+// transfer with enough confirmations are pruned, and that a dry run doesn't
+// prune any leaves.
+func TestPruneSpentLeaves(t *testing.T) {
+	t.Parallel()
+
+	// The first leaf is spent by the second one, which is in turn spent by
+	// the third one. The fourth leaf is spent by a recent transfer, while
+	// the fifth one is unspent.
+	atHeight := func(leaf Leaf, height uint32) Leaf {
+		leaf.Proof.BlockHeight = height
+		return leaf
+	}
+	first := atHeight(utxoLeaf(t, 100), 100)
+	second := atHeight(utxoLeaf(t, 100, leafPrevID(&first)), 105)
+	third := atHeight(utxoLeaf(t, 100, leafPrevID(&second)), 110)
+	fourth := atHeight(utxoLeaf(t, 50), 100)
+	fifth := atHeight(utxoLeaf(t, 50, leafPrevID(&fourth)), 118)
+
+	transferUni := &mockSupplyUniverse{
+		leaves: []Leaf{first, second, third, fourth, fifth},
+	}
+	transferID := Identifier{
+		AssetID:   asset.RandID(t),
+		ProofType: ProofTypeTransfer,
+	}
+	issuanceID := transferID
+	issuanceID.ProofType = ProofTypeIssuance
+
+	archive := NewMintingArchive(MintingArchiveConfig{
+		NewBaseTree: func(id Identifier) BaseBackend {
+			require.Equal(t, ProofTypeTransfer, id.ProofType)
+			return transferUni
+		},
+		Multiverse: &mockRootsMultiverse{
+			roots: []BaseRoot{{ID: issuanceID}, {ID: transferID}},
+		},
+	})
+
+	ctx := context.Background()
+
+	// A minimum number of confirmations is required.
+	_, err := archive.PruneSpentLeaves(ctx, SpentLeafPruneCriteria{
+		CurrentHeight: 120,
+	})
+	require.ErrorContains(t, err, "minimum number of confirmations")
+
+	leafKey := func(leaf *Leaf) LeafKey {
+		return LeafKey{
+			OutPoint:  leaf.Proof.OutPoint(),
+			ScriptKey: &leaf.Proof.Asset.ScriptKey,
+		}
+	}
+	expected := []PrunedLeaf{{
+		ID:          transferID,
+		Key:         leafKey(&first),
+		Amount:      100,
+		SpentHeight: 105,
+	}, {
+		ID:          transferID,
+		Key:         leafKey(&second),
+		Amount:      100,
+		SpentHeight: 110,
+	}}
+
+	// At a height of 120, the spend of the second leaf has exactly 11
+	// confirmations, while the spend of the fourth leaf only has 3. A dry
+	// run must return the leaves without pruning them.
+	criteria := SpentLeafPruneCriteria{
+		MinConfs:      11,
+		CurrentHeight: 120,
+		DryRun:        true,
+	}
+	pruned, err := archive.PruneSpentLeaves(ctx, criteria)
+	require.NoError(t, err)
+	require.Equal(t, expected, pruned)
+	require.Empty(t, transferUni.prunedKeys)
+
+	criteria.DryRun = false
+	pruned, err = archive.PruneSpentLeaves(ctx, criteria)
+	require.NoError(t, err)
+	require.Equal(t, expected, pruned)
+	require.Equal(t, []LeafKey{
+		leafKey(&first), leafKey(&second),
+	}, transferUni.prunedKeys)
+
+	// With a stricter retention policy, only the first leaf is spent deep
+	// enough.
+	criteria.MinConfs = 12
+	criteria.DryRun = true
+	pruned, err = archive.PruneSpentLeaves(ctx, criteria)
+	require.NoError(t, err)
+	require.Equal(t, expected[:1], pruned)
+}
@@ -0,0 +1,237 @@
+package universe
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+var (
+	// ErrAssetNotAllowed is returned when a leaf that is inserted by a
+	// remote party is rejected by the asset policy of the universe.
+	ErrAssetNotAllowed = errors.New("asset not allowed by universe policy")
+
+	// ErrPushNotAllowed is returned when a proof is pushed by a remote
+	// host that isn't in the list of hosts pushes are accepted from.
+	ErrPushNotAllowed = errors.New("proof push not allowed from host")
+)
+
+// AssetPolicyRule identifies the assets a rule of an asset policy applies to.
+// Exactly one of the asset ID or the group key is set. A rule with a group key
+// applies to all assets of the group.
+type AssetPolicyRule struct {
+	// AssetID is the ID of the asset the rule applies to.
+	AssetID *asset.ID
+
+	// GroupKey is the key of the asset group the rule applies to.
+	GroupKey *btcec.PublicKey
+}
+
+// NewAssetPolicyRule parses an asset policy rule from the given hex string,
+// which is either a 32-byte asset ID or a 33-byte compressed group key.
+func NewAssetPolicyRule(ruleStr string) (AssetPolicyRule, error) {
+	ruleBytes, err := hex.DecodeString(ruleStr)
+	if err != nil {
+		return AssetPolicyRule{}, fmt.Errorf("invalid asset policy "+
+			"rule %v: %w", ruleStr, err)
+	}
+
+	switch len(ruleBytes) {
+	case sha256.Size:
+		var assetID asset.ID
+		copy(assetID[:], ruleBytes)
+
+		return AssetPolicyRule{
+			AssetID: &assetID,
+		}, nil
+
+	case btcec.PubKeyBytesLenCompressed:
+		groupKey, err := btcec.ParsePubKey(ruleBytes)
+		if err != nil {
+			return AssetPolicyRule{}, fmt.Errorf("invalid group "+
+				"key %v: %w", ruleStr, err)
+		}
+
+		return AssetPolicyRule{
+			GroupKey: groupKey,
+		}, nil
+
+	default:
+		return AssetPolicyRule{}, fmt.Errorf("invalid asset policy "+
+			"rule %v: expected 32-byte asset ID or 33-byte group "+
+			"key", ruleStr)
+	}
+}
+
+// Validate returns an error if the rule doesn't identify exactly one asset or
+// asset group.
+func (r AssetPolicyRule) Validate() error {
+	if (r.AssetID == nil) == (r.GroupKey == nil) {
+		return fmt.Errorf("asset policy rule must specify either an " +
+			"asset ID or a group key")
+	}
+
+	return nil
+}
+
+// String returns the hex encoded asset ID or group key of the rule.
+func (r AssetPolicyRule) String() string {
+	if r.GroupKey != nil {
+		return hex.EncodeToString(r.GroupKey.SerializeCompressed())
+	}
+
+	if r.AssetID != nil {
+		return r.AssetID.String()
+	}
+
+	return ""
+}
+
+// matches returns true if the rule applies to the given asset.
+func (r AssetPolicyRule) matches(a *asset.Asset) bool {
+	if r.GroupKey != nil {
+		return a.GroupKey != nil &&
+			a.GroupKey.GroupPubKey.IsEqual(r.GroupKey)
+	}
+
+	return r.AssetID != nil && *r.AssetID == a.ID()
+}
+
+// AssetPolicy determines the set of assets a universe accepts leaves for from
+// remote parties, either through a federation push or a proof insertion over
+// RPC. Leaves created locally are not subject to the policy.
+type AssetPolicy struct {
+	// AllowList is the set of rules of which one must match an asset for
+	// its leaves to be accepted. If empty, leaves for all assets that
+	// aren't denied are accepted.
+	AllowList []AssetPolicyRule
+
+	// DenyList is the set of rules that, if any of them matches an asset,
+	// cause its leaves to be rejected. The deny list takes precedence over
+	// the allow list.
+	DenyList []AssetPolicyRule
+}
+
+// Validate returns an error if any of the rules of the policy is invalid.
+func (p AssetPolicy) Validate() error {
+	for _, rules := range [][]AssetPolicyRule{p.AllowList, p.DenyList} {
+		for _, rule := range rules {
+			if err := rule.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// CheckAsset returns an error wrapping ErrAssetNotAllowed if leaves of the
+// given asset aren't accepted by the policy.
+func (p AssetPolicy) CheckAsset(a *asset.Asset) error {
+	matches := func(r AssetPolicyRule) bool {
+		return r.matches(a)
+	}
+
+	assetDesc := fmt.Sprintf("asset_id=%v", a.ID())
+	if a.GroupKey != nil {
+		assetDesc += fmt.Sprintf(", group_key=%x",
+			a.GroupKey.GroupPubKey.SerializeCompressed())
+	}
+
+	if fn.Any(p.DenyList, matches) {
+		return fmt.Errorf("%w: %v is denied", ErrAssetNotAllowed,
+			assetDesc)
+	}
+
+	if len(p.AllowList) > 0 && !fn.Any(p.AllowList, matches) {
+		return fmt.Errorf("%w: %v is not in the allow list",
+			ErrAssetNotAllowed, assetDesc)
+	}
+
+	return nil
+}
+
+// HostLookupFunc resolves a host name into the set of its IP addresses.
+type HostLookupFunc func(ctx context.Context, host string) ([]string, error)
+
+// NormalizePushHost returns the host of the given entry of a push allow list
+// in its canonical form. The entry is either an IP address or a host name,
+// optionally followed by a port, which is ignored.
+func NormalizePushHost(entry string) (string, error) {
+	host := strings.TrimSpace(entry)
+	if splitHost, _, err := net.SplitHostPort(host); err == nil {
+		host = splitHost
+	}
+
+	host = strings.ToLower(strings.Trim(host, "[]"))
+	if host == "" {
+		return "", fmt.Errorf("invalid push host %q: host must not be "+
+			"empty", entry)
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.String(), nil
+	}
+
+	return host, nil
+}
+
+// checkPushHost returns an error wrapping ErrPushNotAllowed if the given
+// remote host isn't in the allow list of push hosts. Host names in the allow
+// list are resolved with the given lookup function, so they match the IP
+// addresses pushes originate from. An empty allow list accepts pushes from all
+// hosts.
+func checkPushHost(ctx context.Context, allowList []string, host string,
+	lookup HostLookupFunc) error {
+
+	if len(allowList) == 0 {
+		return nil
+	}
+
+	host, err := NormalizePushHost(host)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrPushNotAllowed, err)
+	}
+
+	for _, allowed := range allowList {
+		if allowed == host {
+			return nil
+		}
+	}
+
+	// The caller is identified by its IP address, so we'll also check if
+	// any of the allowed host names resolve to it.
+	if net.ParseIP(host) == nil || lookup == nil {
+		return fmt.Errorf("%w %v", ErrPushNotAllowed, host)
+	}
+
+	for _, allowed := range allowList {
+		if net.ParseIP(allowed) != nil {
+			continue
+		}
+
+		addrs, err := lookup(ctx, allowed)
+		if err != nil {
+			log.Debugf("Unable to resolve push host %v: %v",
+				allowed, err)
+			continue
+		}
+
+		for _, addr := range addrs {
+			ip := net.ParseIP(addr)
+			if ip != nil && ip.String() == host {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("%w %v", ErrPushNotAllowed, host)
+}
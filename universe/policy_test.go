@@ -0,0 +1,305 @@
+package universe
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAssetPolicyCheckAsset tests that the asset policy accepts and rejects
+// assets based on their asset ID and group key.
+func TestAssetPolicyCheckAsset(t *testing.T) {
+	t.Parallel()
+
+	groupedAsset := asset.RandAsset(t, asset.Normal)
+	groupKey := &groupedAsset.GroupKey.GroupPubKey
+
+	plainAsset := asset.RandAsset(t, asset.Normal)
+	plainAsset.GroupKey = nil
+
+	groupedID := groupedAsset.ID()
+	plainID := plainAsset.ID()
+
+	idRule := func(id asset.ID) AssetPolicyRule {
+		return AssetPolicyRule{
+			AssetID: &id,
+		}
+	}
+	groupRule := AssetPolicyRule{
+		GroupKey: groupKey,
+	}
+
+	testCases := []struct {
+		name       string
+		policy     AssetPolicy
+		allowGroup bool
+		allowPlain bool
+	}{{
+		name:       "empty policy",
+		allowGroup: true,
+		allowPlain: true,
+	}, {
+		name: "allow asset ID",
+		policy: AssetPolicy{
+			AllowList: []AssetPolicyRule{idRule(plainID)},
+		},
+		allowPlain: true,
+	}, {
+		name: "allow group key",
+		policy: AssetPolicy{
+			AllowList: []AssetPolicyRule{groupRule},
+		},
+		allowGroup: true,
+	}, {
+		name: "deny group key",
+		policy: AssetPolicy{
+			DenyList: []AssetPolicyRule{groupRule},
+		},
+		allowPlain: true,
+	}, {
+		name: "deny takes precedence",
+		policy: AssetPolicy{
+			AllowList: []AssetPolicyRule{
+				groupRule, idRule(plainID),
+			},
+			DenyList: []AssetPolicyRule{idRule(groupedID)},
+		},
+		allowPlain: true,
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			checkAsset := func(a *asset.Asset, allowed bool) {
+				err := testCase.policy.CheckAsset(a)
+				if allowed {
+					require.NoError(t, err)
+					return
+				}
+
+				require.ErrorIs(t, err, ErrAssetNotAllowed)
+				require.ErrorContains(t, err, a.ID().String())
+			}
+
+			checkAsset(groupedAsset, testCase.allowGroup)
+			checkAsset(plainAsset, testCase.allowPlain)
+		})
+	}
+}
+
+// TestNewAssetPolicyRule tests that asset policy rules are parsed from hex
+// encoded asset IDs and group keys.
+func TestNewAssetPolicyRule(t *testing.T) {
+	t.Parallel()
+
+	assetID := asset.RandID(t)
+	rule, err := NewAssetPolicyRule(assetID.String())
+	require.NoError(t, err)
+	require.Equal(t, assetID, *rule.AssetID)
+	require.Nil(t, rule.GroupKey)
+	require.Equal(t, assetID.String(), rule.String())
+
+	groupKey := test.RandPubKey(t)
+	groupKeyStr := hex.EncodeToString(groupKey.SerializeCompressed())
+	rule, err = NewAssetPolicyRule(groupKeyStr)
+	require.NoError(t, err)
+	require.True(t, groupKey.IsEqual(rule.GroupKey))
+	require.Nil(t, rule.AssetID)
+	require.Equal(t, groupKeyStr, rule.String())
+
+	_, err = NewAssetPolicyRule("zz")
+	require.Error(t, err)
+
+	_, err = NewAssetPolicyRule("abcd")
+	require.ErrorContains(t, err, "expected 32-byte asset ID")
+}
+
+// TestMintingArchiveAssetPolicy tests that the minting archive rejects leaves
+// inserted by remote parties that aren't allowed by the asset policy, and that
+// the policy can be updated at runtime.
+func TestMintingArchiveAssetPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	newAsset := asset.RandAsset(t, asset.Normal)
+	assetID := newAsset.ID()
+
+	archive := NewMintingArchive(MintingArchiveConfig{
+		AssetPolicy: AssetPolicy{
+			DenyList: []AssetPolicyRule{{
+				AssetID: &assetID,
+			}},
+		},
+	})
+
+	leaf := &Leaf{
+		Proof: &proof.Proof{
+			Asset: *newAsset,
+		},
+		Amt: newAsset.Amount,
+	}
+
+	_, err := archive.RegisterIssuance(ctx, Identifier{
+		AssetID: assetID,
+	}, randLeafKey(t), leaf)
+	require.ErrorIs(t, err, ErrAssetNotAllowed)
+	require.ErrorContains(t, err, "is denied")
+
+	// An invalid policy is rejected and doesn't replace the current one.
+	err = archive.SetAssetPolicy(AssetPolicy{
+		AllowList: []AssetPolicyRule{{}},
+	})
+	require.Error(t, err)
+	require.Len(t, archive.AssetPolicy().DenyList, 1)
+
+	// After the policy is updated to only allow the group of another
+	// asset, the leaf is still rejected, but for a different reason.
+	otherGroupKey := test.RandPubKey(t)
+	err = archive.SetAssetPolicy(AssetPolicy{
+		AllowList: []AssetPolicyRule{{
+			GroupKey: otherGroupKey,
+		}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, archive.AssetPolicy().DenyList)
+
+	_, err = archive.RegisterIssuance(ctx, Identifier{
+		AssetID: assetID,
+	}, randLeafKey(t), leaf)
+	require.ErrorIs(t, err, ErrAssetNotAllowed)
+	require.ErrorContains(t, err, "not in the allow list")
+
+	// Rejected leaves of a batch are reported per leaf, without failing
+	// the whole batch.
+	itemErrs, err := archive.RegisterProofBatch(ctx, []*IssuanceItem{{
+		ID: Identifier{
+			AssetID: assetID,
+		},
+		Key:  randLeafKey(t),
+		Leaf: leaf,
+	}})
+	require.NoError(t, err)
+	require.Len(t, itemErrs, 1)
+	require.ErrorIs(t, itemErrs[0], ErrAssetNotAllowed)
+}
+
+// TestCheckPushHost tests that pushes are only accepted from the hosts of the
+// push allow list, either listed by their IP address or their host name.
+func TestCheckPushHost(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	lookup := func(_ context.Context, host string) ([]string, error) {
+		if host == "universe.example.com" {
+			return []string{"10.0.0.7", "2001:db8::7"}, nil
+		}
+
+		return nil, fmt.Errorf("no such host")
+	}
+
+	allowList, err := fn.MapErr([]string{
+		"10.0.0.5:10029", "[2001:DB8::5]", "Universe.example.com",
+		"unknown.example.com",
+	}, NormalizePushHost)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"10.0.0.5", "2001:db8::5", "universe.example.com",
+		"unknown.example.com",
+	}, allowList)
+
+	testCases := []struct {
+		name    string
+		host    string
+		allowed bool
+	}{{
+		name:    "listed ip",
+		host:    "10.0.0.5",
+		allowed: true,
+	}, {
+		name:    "listed ipv6",
+		host:    "2001:db8:0::5",
+		allowed: true,
+	}, {
+		name:    "resolved host name",
+		host:    "10.0.0.7",
+		allowed: true,
+	}, {
+		name:    "resolved ipv6 host name",
+		host:    "2001:db8::7",
+		allowed: true,
+	}, {
+		name:    "unlisted ip",
+		host:    "10.0.0.6",
+		allowed: false,
+	}, {
+		name:    "empty host",
+		host:    "",
+		allowed: false,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkPushHost(ctx, allowList, tc.host, lookup)
+			if tc.allowed {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, ErrPushNotAllowed)
+		})
+	}
+
+	// Without a lookup function, host names are never resolved.
+	err = checkPushHost(ctx, allowList, "10.0.0.7", nil)
+	require.ErrorIs(t, err, ErrPushNotAllowed)
+
+	// An empty allow list accepts pushes from all hosts.
+	require.NoError(t, checkPushHost(ctx, nil, "10.0.0.6", nil))
+}
+
+// TestMintingArchiveAcceptPushFrom tests that the push allow list of the
+// minting archive can be updated at runtime.
+func TestMintingArchiveAcceptPushFrom(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	archive := NewMintingArchive(MintingArchiveConfig{
+		AcceptPushFrom: []string{"10.0.0.5"},
+	})
+
+	require.NoError(t, archive.CheckPushHost(ctx, "10.0.0.5"))
+	err := archive.CheckPushHost(ctx, "10.0.0.6")
+	require.ErrorIs(t, err, ErrPushNotAllowed)
+
+	// An invalid list is rejected and doesn't replace the current one.
+	err = archive.SetAcceptPushFrom([]string{"10.0.0.6", " "})
+	require.Error(t, err)
+	require.Equal(t, []string{"10.0.0.5"}, archive.AcceptPushFrom())
+
+	err = archive.SetAcceptPushFrom([]string{"10.0.0.6:10029"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"10.0.0.6"}, archive.AcceptPushFrom())
+
+	require.NoError(t, archive.CheckPushHost(ctx, "10.0.0.6"))
+	err = archive.CheckPushHost(ctx, "10.0.0.5")
+	require.ErrorIs(t, err, ErrPushNotAllowed)
+
+	// Clearing the list accepts pushes from all hosts again.
+	require.NoError(t, archive.SetAcceptPushFrom(nil))
+	require.NoError(t, archive.CheckPushHost(ctx, "10.0.0.5"))
+}
@@ -0,0 +1,212 @@
+package universe
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/neutrino/cache/lru"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+)
+
+// ProofCacheStats is a snapshot of the counters of the universe proof cache.
+type ProofCacheStats struct {
+	// NumHits is the number of proof queries that were served from the
+	// cache.
+	NumHits uint64
+
+	// NumMisses is the number of proof queries that had to be served from
+	// the multiverse, because they weren't cached or the cached proofs
+	// were stale.
+	NumMisses uint64
+}
+
+// uniCacheID identifies a universe within the proof cache. We can't use the
+// Identifier directly, as it holds the group key as a pointer.
+type uniCacheID struct {
+	// id is the asset ID or the hash of the group key of the universe.
+	id [32]byte
+
+	// proofType is the proof type of the universe.
+	proofType ProofType
+}
+
+// newUniCacheID creates the cache identifier of the given universe.
+func newUniCacheID(id Identifier) uniCacheID {
+	return uniCacheID{
+		id:        id.Bytes(),
+		proofType: id.ProofType,
+	}
+}
+
+// proofCacheKey is the key the proofs of a leaf query are cached under.
+type proofCacheKey struct {
+	// uniID is the universe the leaf is located within.
+	uniID uniCacheID
+
+	// outPoint is the outpoint of the queried leaf key.
+	outPoint wire.OutPoint
+
+	// scriptKey is the script key of the queried leaf key. This is empty
+	// if the query was for all the script keys at the outpoint.
+	scriptKey asset.SerializedKey
+
+	// rootHash is the universe root the proofs were generated against.
+	rootHash mssmt.NodeHash
+}
+
+// newProofCacheKey creates the cache key for a query of the given leaf key
+// against the given universe root.
+func newProofCacheKey(uniID uniCacheID, key LeafKey,
+	rootHash mssmt.NodeHash) proofCacheKey {
+
+	cacheKey := proofCacheKey{
+		uniID:    uniID,
+		outPoint: key.OutPoint,
+		rootHash: rootHash,
+	}
+	if key.ScriptKey != nil && key.ScriptKey.PubKey != nil {
+		cacheKey.scriptKey = asset.ToSerialized(key.ScriptKey.PubKey)
+	}
+
+	return cacheKey
+}
+
+// cachedProofs is the set of proofs returned for a single leaf query, along
+// with the time they expire from the cache.
+type cachedProofs struct {
+	proofs []*Proof
+
+	expiry time.Time
+}
+
+// Size returns the size of the cached proofs. Since we scale the cache by the
+// number of queries and not the total memory size, we can simply return 1
+// here to count each set of proofs as 1 item.
+func (c *cachedProofs) Size() (uint64, error) {
+	return 1, nil
+}
+
+// proofCache is an LRU cache of recently served universe proofs. Each set of
+// proofs is cached under the universe root it was generated against. As the
+// proofs also commit to the multiverse root, which changes whenever any
+// universe is updated, the cache is invalidated on every write to the
+// multiverse.
+type proofCache struct {
+	// proofs is the set of cached proofs.
+	proofs *lru.Cache[proofCacheKey, *cachedProofs]
+
+	// ttl is the maximum amount of time a set of proofs is served from
+	// the cache.
+	ttl time.Duration
+
+	// now returns the current time.
+	now func() time.Time
+
+	// roots is the known root hash of each universe that proofs were
+	// cached for since the last invalidation.
+	roots map[uniCacheID]mssmt.NodeHash
+
+	// generation is increased on every invalidation. Proofs that were
+	// fetched during an earlier generation are not added to the cache, as
+	// they might already be stale.
+	generation uint64
+
+	// mtx guards the known roots and the generation.
+	mtx sync.Mutex
+
+	// numHits is the number of queries served from the cache.
+	numHits atomic.Uint64
+
+	// numMisses is the number of queries not served from the cache.
+	numMisses atomic.Uint64
+}
+
+// newProofCache creates a new proof cache that holds the proofs of up to size
+// queries, each for at most the given TTL. A TTL of zero means proofs are
+// served until they're evicted or invalidated.
+func newProofCache(size uint64, ttl time.Duration) *proofCache {
+	return &proofCache{
+		proofs: lru.NewCache[proofCacheKey, *cachedProofs](size),
+		ttl:    ttl,
+		now:    time.Now,
+		roots:  make(map[uniCacheID]mssmt.NodeHash),
+	}
+}
+
+// fetch returns the cached proofs of the given leaf query, if they were
+// generated against the current root of the universe and haven't expired
+// yet. The returned generation must be passed to add once the proofs were
+// fetched from the multiverse after a miss.
+func (c *proofCache) fetch(id Identifier, key LeafKey) ([]*Proof, uint64,
+	bool) {
+
+	uniID := newUniCacheID(id)
+
+	c.mtx.Lock()
+	rootHash, ok := c.roots[uniID]
+	generation := c.generation
+	c.mtx.Unlock()
+
+	if !ok {
+		c.numMisses.Add(1)
+		return nil, generation, false
+	}
+
+	cached, err := c.proofs.Get(newProofCacheKey(uniID, key, rootHash))
+	if err != nil || (c.ttl != 0 && c.now().After(cached.expiry)) {
+		c.numMisses.Add(1)
+		return nil, generation, false
+	}
+
+	c.numHits.Add(1)
+
+	return cached.proofs, generation, true
+}
+
+// add caches the proofs of the given leaf query, unless the cache was
+// invalidated since the given generation.
+func (c *proofCache) add(id Identifier, key LeafKey, proofs []*Proof,
+	generation uint64) {
+
+	if len(proofs) == 0 || proofs[0].UniverseRoot == nil {
+		return
+	}
+
+	uniID := newUniCacheID(id)
+	rootHash := proofs[0].UniverseRoot.NodeHash()
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+	c.roots[uniID] = rootHash
+
+	cacheKey := newProofCacheKey(uniID, key, rootHash)
+	_, _ = c.proofs.Put(cacheKey, &cachedProofs{
+		proofs: proofs,
+		expiry: c.now().Add(c.ttl),
+	})
+}
+
+// invalidate marks all cached proofs as stale. This must be called after the
+// multiverse was updated.
+func (c *proofCache) invalidate() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.generation++
+	c.roots = make(map[uniCacheID]mssmt.NodeHash)
+}
+
+// stats returns a snapshot of the hit and miss counters of the cache.
+func (c *proofCache) stats() ProofCacheStats {
+	return ProofCacheStats{
+		NumHits:   c.numHits.Load(),
+		NumMisses: c.numMisses.Load(),
+	}
+}
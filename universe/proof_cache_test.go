@@ -0,0 +1,98 @@
+package universe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// randCachedProof returns a proof for the given leaf key that was generated
+// against a random universe root.
+func randCachedProof(t *testing.T, key LeafKey) *Proof {
+	return &Proof{
+		LeafKey: key,
+		UniverseRoot: mssmt.NewComputedBranch(
+			mssmt.NodeHash(asset.RandID(t)), 1,
+		),
+	}
+}
+
+// TestProofCache tests that proofs are served from the proof cache until they
+// expire or the cache is invalidated, and that the hit and miss counters are
+// updated accordingly.
+func TestProofCache(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_000_000, 0)
+	cache := newProofCache(10, time.Minute)
+	cache.now = func() time.Time {
+		return now
+	}
+
+	id := randBaseRoot(t).ID
+	key := randLeafKey(t)
+	proofs := []*Proof{randCachedProof(t, key)}
+
+	// Nothing is cached yet, so the first query is a miss.
+	_, generation, ok := cache.fetch(id, key)
+	require.False(t, ok)
+
+	cache.add(id, key, proofs, generation)
+
+	// The second query for the same key is served from the cache, while a
+	// query for another key of the same universe or the same key of
+	// another universe is a miss.
+	cachedProofs, _, ok := cache.fetch(id, key)
+	require.True(t, ok)
+	require.Equal(t, proofs, cachedProofs)
+
+	_, _, ok = cache.fetch(id, randLeafKey(t))
+	require.False(t, ok)
+
+	transferID := id
+	transferID.ProofType = ProofTypeTransfer
+	_, _, ok = cache.fetch(transferID, key)
+	require.False(t, ok)
+
+	require.Equal(t, ProofCacheStats{
+		NumHits:   1,
+		NumMisses: 3,
+	}, cache.stats())
+
+	// Once the TTL has passed, the proofs aren't served anymore.
+	now = now.Add(time.Minute + time.Second)
+	_, generation, ok = cache.fetch(id, key)
+	require.False(t, ok)
+
+	cache.add(id, key, proofs, generation)
+	_, _, ok = cache.fetch(id, key)
+	require.True(t, ok)
+
+	// After an invalidation, the proofs aren't served anymore either.
+	cache.invalidate()
+	_, generation, ok = cache.fetch(id, key)
+	require.False(t, ok)
+
+	// Proofs that were fetched before another invalidation must not be
+	// added to the cache, as they might be stale already.
+	cache.invalidate()
+	cache.add(id, key, proofs, generation)
+	_, generation, ok = cache.fetch(id, key)
+	require.False(t, ok)
+
+	// Proofs generated against a new universe root are served once
+	// they're added.
+	newProofs := []*Proof{randCachedProof(t, key)}
+	cache.add(id, key, newProofs, generation)
+	cachedProofs, _, ok = cache.fetch(id, key)
+	require.True(t, ok)
+	require.Equal(t, newProofs, cachedProofs)
+
+	require.Equal(t, ProofCacheStats{
+		NumHits:   3,
+		NumMisses: 6,
+	}, cache.stats())
+}
@@ -0,0 +1,296 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+// LeafChainNotifier is used to be notified of confirmations and re-orgs of the
+// anchor transactions of Universe leaves.
+type LeafChainNotifier interface {
+	// CurrentHeight returns the current height of the main chain.
+	CurrentHeight(ctx context.Context) (uint32, error)
+
+	// RegisterConfirmationsNtfn registers an intent to be notified once
+	// txid reaches numConfs confirmations. If the transaction is
+	// re-organized out of the chain before that, a signal is sent on the
+	// given re-org channel.
+	RegisterConfirmationsNtfn(ctx context.Context, txid *chainhash.Hash,
+		pkScript []byte, numConfs, heightHint uint32,
+		includeBlock bool,
+		reOrgChan chan struct{}) (*chainntnfs.ConfirmationEvent,
+		chan error, error)
+}
+
+// LeafReOrgArchive is the Universe archive the LeafReOrgWatcher watches new
+// leaves of and removes re-organized leaves from.
+type LeafReOrgArchive interface {
+	fn.EventPublisher[*LeafEvent, uint64]
+
+	// RemoveReOrgedLeaf removes the leaf stored at the given key from the
+	// specified base universe if its proof is still anchored in the block
+	// with the given hash.
+	RemoveReOrgedLeaf(ctx context.Context, id Identifier, key LeafKey,
+		blockHash chainhash.Hash) (bool, error)
+}
+
+// LeafReOrgWatcherConfig is the main config for the LeafReOrgWatcher.
+type LeafReOrgWatcherConfig struct {
+	// ChainNotifier is used to watch the anchor transactions of new
+	// leaves.
+	ChainNotifier LeafChainNotifier
+
+	// Archive is the Universe archive that new leaves are inserted into.
+	Archive LeafReOrgArchive
+
+	// SafeDepth is the number of confirmations after which the anchor
+	// transaction of a leaf is considered to be safely buried in the
+	// chain.
+	SafeDepth uint32
+}
+
+// watchedLeaf identifies a leaf of a Universe tree that is anchored in a
+// specific block.
+type watchedLeaf struct {
+	uniID string
+
+	leafKey UniverseKey
+
+	blockHash chainhash.Hash
+}
+
+// LeafReOrgWatcher watches the anchor transactions of leaves that were pushed
+// to us by, or synced from, a remote Universe server until they are safely
+// buried in the chain. If an anchor transaction is re-organized out of the
+// chain before that, the now invalid leaf is removed from the local Universe
+// tree, so we no longer serve it to others. An updated proof for the leaf can
+// then be synced again once the anchor transaction confirms in the new chain.
+//
+// Leaves created locally aren't watched, as their proofs are updated by the
+// re-org watcher of the minting and transfer sub-systems instead.
+//
+// NOTE: Only leaves inserted while the watcher is running are watched.
+type LeafReOrgWatcher struct {
+	cfg LeafReOrgWatcherConfig
+
+	// eventSubscriber receives the leaf events of the archive.
+	eventSubscriber *fn.EventReceiver[*LeafEvent]
+
+	// watchedLeaves is the set of leaves currently being watched.
+	watchedLeaves map[watchedLeaf]struct{}
+
+	// watchMtx guards the set of watched leaves.
+	watchMtx sync.Mutex
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*fn.ContextGuard
+
+	startOnce sync.Once
+
+	stopOnce sync.Once
+}
+
+// NewLeafReOrgWatcher creates a new leaf re-org watcher based on the passed
+// config.
+func NewLeafReOrgWatcher(cfg LeafReOrgWatcherConfig) *LeafReOrgWatcher {
+	return &LeafReOrgWatcher{
+		cfg: cfg,
+		eventSubscriber: fn.NewEventReceiver[*LeafEvent](
+			fn.DefaultQueueSize,
+		),
+		watchedLeaves: make(map[watchedLeaf]struct{}),
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start starts the leaf re-org watcher.
+func (w *LeafReOrgWatcher) Start() error {
+	var startErr error
+	w.startOnce.Do(func() {
+		log.Infof("Starting leaf re-org watcher")
+
+		err := w.cfg.Archive.RegisterSubscriber(
+			w.eventSubscriber, false, 0,
+		)
+		if err != nil {
+			startErr = fmt.Errorf("unable to subscribe to leaf "+
+				"events: %w", err)
+			return
+		}
+
+		w.Wg.Add(1)
+		go w.processEvents()
+	})
+
+	return startErr
+}
+
+// Stop stops the leaf re-org watcher.
+func (w *LeafReOrgWatcher) Stop() error {
+	var stopErr error
+	w.stopOnce.Do(func() {
+		log.Infof("Stopping leaf re-org watcher")
+
+		stopErr = w.cfg.Archive.RemoveSubscriber(w.eventSubscriber)
+
+		close(w.Quit)
+		w.Wg.Wait()
+	})
+
+	return stopErr
+}
+
+// processEvents starts watching the anchor transaction of each new leaf
+// inserted into the archive.
+func (w *LeafReOrgWatcher) processEvents() {
+	defer w.Wg.Done()
+
+	for {
+		select {
+		case event := <-w.eventSubscriber.NewItemCreated.ChanOut():
+			if err := w.maybeWatch(event); err != nil {
+				log.Warnf("Unable to watch leaf of universe "+
+					"%v for re-orgs: %v",
+					event.UniverseRoot.ID.StringForLog(),
+					err)
+			}
+
+		case <-w.Quit:
+			return
+		}
+	}
+}
+
+// maybeWatch starts watching the anchor transaction of the leaf of the given
+// event, if it was inserted by a remote party and isn't safely buried yet.
+func (w *LeafReOrgWatcher) maybeWatch(event *LeafEvent) error {
+	if event.Removed || event.Source != LeafSourceFederation ||
+		event.Leaf == nil || event.Leaf.Proof == nil {
+
+		return nil
+	}
+
+	ctx, cancel := w.WithCtxQuit()
+	defer cancel()
+
+	p := event.Leaf.Proof
+	currentHeight, err := w.cfg.ChainNotifier.CurrentHeight(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch current height: %w", err)
+	}
+
+	if ProofConfs(p, currentHeight) >= w.cfg.SafeDepth {
+		return nil
+	}
+
+	id := event.UniverseRoot.ID
+	leaf := watchedLeaf{
+		uniID:     id.String(),
+		leafKey:   event.Key.UniverseKey(),
+		blockHash: p.BlockHeader.BlockHash(),
+	}
+
+	w.watchMtx.Lock()
+	defer w.watchMtx.Unlock()
+
+	if _, ok := w.watchedLeaves[leaf]; ok {
+		return nil
+	}
+
+	return w.watchLeaf(id, event.Key, leaf, p)
+}
+
+// watchLeaf registers for a confirmation notification of the anchor
+// transaction of the given leaf proof, and removes the leaf from the archive
+// if the transaction is re-organized out of the chain before it's safely
+// buried.
+//
+// NOTE: The watch mutex must be held when calling this method.
+func (w *LeafReOrgWatcher) watchLeaf(id Identifier, key LeafKey,
+	leaf watchedLeaf, p *proof.Proof) error {
+
+	outputIndex := p.InclusionProof.OutputIndex
+	if int(outputIndex) >= len(p.AnchorTx.TxOut) {
+		return fmt.Errorf("invalid anchor output index %d",
+			outputIndex)
+	}
+	pkScript := p.AnchorTx.TxOut[outputIndex].PkScript
+
+	txHash := p.AnchorTx.TxHash()
+	reOrgChan := make(chan struct{}, 1)
+	notifier := w.cfg.ChainNotifier
+	ctx, cancel := w.WithCtxQuitNoTimeout()
+	confEvent, errChan, err := notifier.RegisterConfirmationsNtfn(
+		ctx, &txHash, pkScript, w.cfg.SafeDepth, p.BlockHeight, false,
+		reOrgChan,
+	)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("unable to register for conf ntfn: %w", err)
+	}
+
+	log.Debugf("Watching anchor TX %v of leaf of universe %v until it "+
+		"reaches %d confirmations", txHash, id.StringForLog(),
+		w.cfg.SafeDepth)
+
+	w.watchedLeaves[leaf] = struct{}{}
+
+	w.Wg.Add(1)
+	go func() {
+		defer w.Wg.Done()
+		defer cancel()
+		defer confEvent.Cancel()
+		defer func() {
+			w.watchMtx.Lock()
+			delete(w.watchedLeaves, leaf)
+			w.watchMtx.Unlock()
+		}()
+
+		select {
+		// The anchor transaction is buried deep enough, so we can stop
+		// watching it.
+		case <-confEvent.Confirmed:
+			log.Debugf("Anchor TX %v of leaf of universe %v is "+
+				"safely buried", txHash, id.StringForLog())
+
+		// The anchor transaction was re-organized out of the chain, so
+		// the leaf is no longer valid and needs to be removed.
+		case <-reOrgChan:
+			log.Infof("Anchor TX %v of leaf of universe %v was "+
+				"re-organized out of the chain, removing leaf",
+				txHash, id.StringForLog())
+
+			ctxb, cancelRemove := w.CtxBlocking()
+			defer cancelRemove()
+
+			_, err := w.cfg.Archive.RemoveReOrgedLeaf(
+				ctxb, id, key, leaf.blockHash,
+			)
+			if err != nil {
+				log.Errorf("Unable to remove re-organized "+
+					"leaf of universe %v: %v",
+					id.StringForLog(), err)
+			}
+
+		case err := <-errChan:
+			if !fn.IsCanceled(err) {
+				log.Warnf("Error while watching anchor TX %v: "+
+					"%v", txHash, err)
+			}
+
+		case <-w.Quit:
+		}
+	}()
+
+	return nil
+}
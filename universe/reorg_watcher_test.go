@@ -0,0 +1,212 @@
+package universe
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/stretchr/testify/require"
+)
+
+// mockConfRegistration is a confirmation notification registered with the
+// mockLeafChainNotifier.
+type mockConfRegistration struct {
+	txHash chainhash.Hash
+
+	numConfs uint32
+
+	heightHint uint32
+
+	confEvent *chainntnfs.ConfirmationEvent
+
+	reOrgChan chan struct{}
+}
+
+// mockLeafChainNotifier is a mock implementation of the LeafChainNotifier
+// interface that hands out each confirmation registration to the test.
+type mockLeafChainNotifier struct {
+	height uint32
+
+	registrations chan *mockConfRegistration
+}
+
+func (m *mockLeafChainNotifier) CurrentHeight(context.Context) (uint32,
+	error) {
+
+	return m.height, nil
+}
+
+func (m *mockLeafChainNotifier) RegisterConfirmationsNtfn(_ context.Context,
+	txid *chainhash.Hash, _ []byte, numConfs, heightHint uint32, _ bool,
+	reOrgChan chan struct{}) (*chainntnfs.ConfirmationEvent, chan error,
+	error) {
+
+	confEvent := &chainntnfs.ConfirmationEvent{
+		Confirmed: make(chan *chainntnfs.TxConfirmation, 1),
+		Cancel:    func() {},
+	}
+	m.registrations <- &mockConfRegistration{
+		txHash:     *txid,
+		numConfs:   numConfs,
+		heightHint: heightHint,
+		confEvent:  confEvent,
+		reOrgChan:  reOrgChan,
+	}
+
+	return confEvent, make(chan error), nil
+}
+
+// removedLeaf is a leaf removed from the mockReOrgArchive.
+type removedLeaf struct {
+	id Identifier
+
+	key LeafKey
+
+	blockHash chainhash.Hash
+}
+
+// mockReOrgArchive is a mock implementation of the LeafReOrgArchive interface
+// that records all removed leaves.
+type mockReOrgArchive struct {
+	events *fn.EventDistributor[*LeafEvent]
+
+	removed chan removedLeaf
+}
+
+func (m *mockReOrgArchive) RegisterSubscriber(
+	receiver *fn.EventReceiver[*LeafEvent], _ bool, _ uint64) error {
+
+	m.events.RegisterSubscriber(receiver)
+	return nil
+}
+
+func (m *mockReOrgArchive) RemoveSubscriber(
+	subscriber *fn.EventReceiver[*LeafEvent]) error {
+
+	return m.events.RemoveSubscriber(subscriber)
+}
+
+func (m *mockReOrgArchive) RemoveReOrgedLeaf(_ context.Context, id Identifier,
+	key LeafKey, blockHash chainhash.Hash) (bool, error) {
+
+	m.removed <- removedLeaf{
+		id:        id,
+		key:       key,
+		blockHash: blockHash,
+	}
+
+	return true, nil
+}
+
+// TestLeafReOrgWatcher tests that the anchor transactions of shallow leaves
+// inserted by remote parties are watched, and that a leaf is removed if its
+// anchor transaction is re-organized out of the chain.
+func TestLeafReOrgWatcher(t *testing.T) {
+	t.Parallel()
+
+	const (
+		safeDepth     = 6
+		currentHeight = 200
+	)
+
+	notifier := &mockLeafChainNotifier{
+		height:        currentHeight,
+		registrations: make(chan *mockConfRegistration, 1),
+	}
+	archive := &mockReOrgArchive{
+		events:  fn.NewEventDistributor[*LeafEvent](),
+		removed: make(chan removedLeaf, 1),
+	}
+
+	watcher := NewLeafReOrgWatcher(LeafReOrgWatcherConfig{
+		ChainNotifier: notifier,
+		Archive:       archive,
+		SafeDepth:     safeDepth,
+	})
+	require.NoError(t, watcher.Start())
+	t.Cleanup(func() {
+		require.NoError(t, watcher.Stop())
+	})
+
+	root := randBaseRoot(t)
+	newEvent := func(blockHeight uint32, source LeafSource) *LeafEvent {
+		leaf := &Leaf{
+			Proof: &proof.Proof{
+				BlockHeader: wire.BlockHeader{
+					Nonce: blockHeight,
+				},
+				BlockHeight: blockHeight,
+				AnchorTx: wire.MsgTx{
+					LockTime: blockHeight,
+					TxOut: []*wire.TxOut{{
+						PkScript: []byte{0x51},
+					}},
+				},
+			},
+		}
+
+		return newLeafEvent(root, randLeafKey(t), leaf, source)
+	}
+
+	receiveRegistration := func() *mockConfRegistration {
+		select {
+		case reg := <-notifier.registrations:
+			return reg
+		case <-time.After(time.Second):
+			t.Fatalf("no confirmation registration received")
+			return nil
+		}
+	}
+
+	// Local leaves and leaves that are already buried deep enough aren't
+	// watched. As events are processed in order, the first registration
+	// we receive must be for the shallow leaf inserted after them.
+	localEvent := newEvent(currentHeight, LeafSourceLocal)
+	buriedEvent := newEvent(currentHeight-safeDepth, LeafSourceFederation)
+	shallowEvent := newEvent(currentHeight-1, LeafSourceFederation)
+	archive.events.NotifySubscribers(localEvent, buriedEvent, shallowEvent)
+
+	shallowProof := shallowEvent.Leaf.Proof
+	reg := receiveRegistration()
+	require.Equal(t, shallowProof.AnchorTx.TxHash(), reg.txHash)
+	require.EqualValues(t, safeDepth, reg.numConfs)
+	require.Equal(t, shallowProof.BlockHeight, reg.heightHint)
+
+	// Once the anchor transaction is re-organized out of the chain, the
+	// leaf is removed from the archive.
+	reg.reOrgChan <- struct{}{}
+
+	select {
+	case removed := <-archive.removed:
+		require.Equal(t, root.ID, removed.id)
+		require.Equal(t, shallowEvent.Key, removed.key)
+		require.Equal(
+			t, shallowProof.BlockHeader.BlockHash(),
+			removed.blockHash,
+		)
+
+	case <-time.After(time.Second):
+		t.Fatalf("re-organized leaf wasn't removed")
+	}
+
+	// A leaf with an anchor transaction that is safely buried is no longer
+	// watched and is never removed.
+	confirmedEvent := newEvent(currentHeight, LeafSourceFederation)
+	archive.events.NotifySubscribers(confirmedEvent)
+
+	reg = receiveRegistration()
+	reg.confEvent.Confirmed <- &chainntnfs.TxConfirmation{}
+
+	require.Eventually(t, func() bool {
+		watcher.watchMtx.Lock()
+		defer watcher.watchMtx.Unlock()
+
+		return len(watcher.watchedLeaves) == 0
+	}, time.Second, 10*time.Millisecond)
+	require.Empty(t, archive.removed)
+}
@@ -0,0 +1,260 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+)
+
+// RootDiffStatus describes which side of a universe comparison is ahead of the
+// other one.
+type RootDiffStatus uint8
+
+const (
+	// RootDiffLocalAhead indicates that the local universe contains all
+	// leaves of the remote universe, plus additional ones. This is also
+	// the case if the remote server doesn't know the universe at all.
+	RootDiffLocalAhead RootDiffStatus = iota
+
+	// RootDiffRemoteAhead indicates that the remote universe contains all
+	// leaves of the local universe, plus additional ones. This is also the
+	// case if the universe isn't known locally at all.
+	RootDiffRemoteAhead
+
+	// RootDiffDiverged indicates that both the local and the remote
+	// universe contain leaves the other side doesn't know about.
+	RootDiffDiverged
+)
+
+// String returns a human-readable string for the root diff status.
+func (s RootDiffStatus) String() string {
+	switch s {
+	case RootDiffLocalAhead:
+		return "local_ahead"
+
+	case RootDiffRemoteAhead:
+		return "remote_ahead"
+
+	case RootDiffDiverged:
+		return "diverged"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", s)
+	}
+}
+
+// UniverseRootDiff describes a universe whose root differs between the local
+// and a remote universe server.
+type UniverseRootDiff struct {
+	// ID is the identifier of the universe.
+	ID Identifier
+
+	// LocalRoot is the root of the local universe. This is nil if the
+	// universe isn't known locally.
+	LocalRoot mssmt.Node
+
+	// RemoteRoot is the root of the remote universe. This is nil if the
+	// universe isn't known to the remote server.
+	RemoteRoot mssmt.Node
+
+	// Status describes which side is ahead of the other one.
+	Status RootDiffStatus
+
+	// NumNewLeaves is the number of leaves the side that's ahead has on
+	// top of the leaves of the other side. This is zero if the universes
+	// diverged.
+	NumNewLeaves uint64
+}
+
+// DiffUniverse compares the local universe with the universe of the given
+// remote server. The multiverse roots are compared first, and only if they
+// differ are the per-universe roots compared. Leaf keys are only fetched for
+// universes whose roots differ, to determine which side is ahead.
+func (s *SimpleSyncer) DiffUniverse(ctx context.Context,
+	host ServerAddr) ([]UniverseRootDiff, error) {
+
+	log.Infof("Diffing universe with host=%v", host.HostStr())
+
+	remoteEngine, err := s.cfg.NewRemoteDiffEngine(host)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create remote diff "+
+			"engine: %w", err)
+	}
+
+	return diffUniverseRoots(ctx, s.cfg.LocalDiffEngine, remoteEngine)
+}
+
+// diffUniverseRoots returns the set of universes whose roots differ between
+// the local and the remote diff engine.
+func diffUniverseRoots(ctx context.Context, localEngine,
+	remoteEngine DiffEngine) ([]UniverseRootDiff, error) {
+
+	// If the multiverse roots of a proof type match, then all universes
+	// of that proof type match as well, so we can skip them.
+	var diffProofTypes []ProofType
+	for _, proofType := range []ProofType{
+		ProofTypeIssuance, ProofTypeTransfer,
+	} {
+
+		localRoot, err := localEngine.MultiverseRoot(ctx, proofType)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch local "+
+				"multiverse root: %w", err)
+		}
+		remoteRoot, err := remoteEngine.MultiverseRoot(ctx, proofType)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch remote "+
+				"multiverse root: %w", err)
+		}
+
+		if !mssmt.IsEqualNode(localRoot.Node, remoteRoot.Node) {
+			diffProofTypes = append(diffProofTypes, proofType)
+		}
+	}
+
+	if len(diffProofTypes) == 0 {
+		log.Debugf("Multiverse roots match, universes are in sync")
+		return nil, nil
+	}
+
+	isDiffProofType := func(r BaseRoot) bool {
+		return fn.Any(diffProofTypes, func(p ProofType) bool {
+			return r.ID.ProofType == p
+		})
+	}
+
+	localRoots, err := localEngine.RootNodes(ctx, RootNodesQuery{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch local roots: %w", err)
+	}
+	remoteRoots, err := remoteEngine.RootNodes(ctx, RootNodesQuery{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch remote roots: %w", err)
+	}
+
+	localRoots = fn.Filter(localRoots, isDiffProofType)
+	remoteRoots = fn.Filter(remoteRoots, isDiffProofType)
+
+	localRootsByID := make(map[string]BaseRoot, len(localRoots))
+	for _, root := range localRoots {
+		localRootsByID[root.ID.String()] = root
+	}
+
+	var rootDiffs []UniverseRootDiff
+	for _, remoteRoot := range remoteRoots {
+		localRoot, ok := localRootsByID[remoteRoot.ID.String()]
+		delete(localRootsByID, remoteRoot.ID.String())
+
+		// We don't know about this universe at all, so the remote
+		// server is ahead by all of its leaves.
+		if !ok {
+			remoteKeys, err := remoteEngine.UniverseLeafKeys(
+				ctx, remoteRoot.ID,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to fetch "+
+					"remote leaf keys: %w", err)
+			}
+
+			rootDiffs = append(rootDiffs, UniverseRootDiff{
+				ID:           remoteRoot.ID,
+				RemoteRoot:   remoteRoot.Node,
+				Status:       RootDiffRemoteAhead,
+				NumNewLeaves: uint64(len(remoteKeys)),
+			})
+
+			continue
+		}
+
+		if mssmt.IsEqualNode(localRoot.Node, remoteRoot.Node) {
+			continue
+		}
+
+		rootDiff, err := diffUniverseRoot(
+			ctx, localEngine, remoteEngine, localRoot, remoteRoot,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		rootDiffs = append(rootDiffs, *rootDiff)
+	}
+
+	// Any universe that's left is unknown to the remote server, so we're
+	// ahead by all of our leaves.
+	for _, localRoot := range localRoots {
+		if _, ok := localRootsByID[localRoot.ID.String()]; !ok {
+			continue
+		}
+
+		localKeys, err := localEngine.UniverseLeafKeys(
+			ctx, localRoot.ID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch local leaf "+
+				"keys: %w", err)
+		}
+
+		rootDiffs = append(rootDiffs, UniverseRootDiff{
+			ID:           localRoot.ID,
+			LocalRoot:    localRoot.Node,
+			Status:       RootDiffLocalAhead,
+			NumNewLeaves: uint64(len(localKeys)),
+		})
+	}
+
+	log.Infof("Found %d universes with differing roots", len(rootDiffs))
+
+	return rootDiffs, nil
+}
+
+// diffUniverseRoot determines which side is ahead for a universe that's known
+// to both sides, but has differing roots. If one side knows the current root
+// of the other side as one of its past roots, then it's ahead by the leaves
+// inserted since then. Otherwise, the universes diverged.
+func diffUniverseRoot(ctx context.Context, localEngine,
+	remoteEngine DiffEngine, localRoot,
+	remoteRoot BaseRoot) (*UniverseRootDiff, error) {
+
+	rootDiff := &UniverseRootDiff{
+		ID:         localRoot.ID,
+		LocalRoot:  localRoot.Node,
+		RemoteRoot: remoteRoot.Node,
+	}
+
+	remoteKeys, err := remoteEngine.UniverseLeafKeysSince(
+		ctx, remoteRoot.ID, localRoot.Node.NodeHash(),
+	)
+	switch {
+	case err == nil:
+		rootDiff.Status = RootDiffRemoteAhead
+		rootDiff.NumNewLeaves = uint64(len(remoteKeys))
+
+		return rootDiff, nil
+
+	case !errors.Is(err, ErrUnknownUniverseRoot):
+		return nil, fmt.Errorf("unable to fetch remote leaf keys "+
+			"since local root: %w", err)
+	}
+
+	localKeys, err := localEngine.UniverseLeafKeysSince(
+		ctx, localRoot.ID, remoteRoot.Node.NodeHash(),
+	)
+	switch {
+	case err == nil:
+		rootDiff.Status = RootDiffLocalAhead
+		rootDiff.NumNewLeaves = uint64(len(localKeys))
+
+	case errors.Is(err, ErrUnknownUniverseRoot):
+		rootDiff.Status = RootDiffDiverged
+
+	default:
+		return nil, fmt.Errorf("unable to fetch local leaf keys "+
+			"since remote root: %w", err)
+	}
+
+	return rootDiff, nil
+}
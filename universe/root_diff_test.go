@@ -0,0 +1,112 @@
+package universe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// randMultiverseRoot returns a random multiverse root node.
+func randMultiverseRoot(t *testing.T) mssmt.Node {
+	return mssmt.NewComputedBranch(mssmt.NodeHash(asset.RandID(t)), 10)
+}
+
+// TestDiffUniverseRoots tests that the universes whose roots differ between
+// the local and the remote diff engine are found, and that the side that is
+// ahead is determined correctly.
+func TestDiffUniverseRoots(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var (
+		syncedRoot      = randBaseRoot(t)
+		remoteAheadRoot = randBaseRoot(t)
+		localAheadRoot  = randBaseRoot(t)
+		divergedRoot    = randBaseRoot(t)
+		remoteOnlyRoot  = randBaseRoot(t)
+		localOnlyRoot   = randBaseRoot(t)
+		transferRoot    = randBaseRootWithID(t, Identifier{
+			AssetID:   asset.RandID(t),
+			ProofType: ProofTypeTransfer,
+		})
+	)
+
+	newRemoteRoot := func(root BaseRoot) BaseRoot {
+		return randBaseRootWithID(t, root.ID)
+	}
+
+	localEngine := newMockDiffEngine(
+		syncedRoot, remoteAheadRoot, localAheadRoot, divergedRoot,
+		localOnlyRoot, transferRoot,
+	)
+	remoteEngine := newMockDiffEngine(
+		syncedRoot, newRemoteRoot(remoteAheadRoot),
+		newRemoteRoot(localAheadRoot), newRemoteRoot(divergedRoot),
+		remoteOnlyRoot, newRemoteRoot(transferRoot),
+	)
+
+	// If the multiverse roots match, then nothing is reported, even though
+	// the individual roots of the mock engines differ.
+	rootDiffs, err := diffUniverseRoots(ctx, localEngine, remoteEngine)
+	require.NoError(t, err)
+	require.Empty(t, rootDiffs)
+
+	// Only the issuance multiverse roots differ, so the transfer universe
+	// must not be reported.
+	localEngine.multiverseRoots[ProofTypeIssuance] = randMultiverseRoot(t)
+	remoteEngine.multiverseRoots[ProofTypeIssuance] = randMultiverseRoot(t)
+
+	remoteEngine.keysSince[remoteAheadRoot.ID] = []LeafKey{
+		randLeafKey(t), randLeafKey(t),
+	}
+	localEngine.keysSince[localAheadRoot.ID] = []LeafKey{randLeafKey(t)}
+	remoteEngine.leafKeys[remoteOnlyRoot.ID] = []LeafKey{
+		randLeafKey(t), randLeafKey(t), randLeafKey(t),
+	}
+	localEngine.leafKeys[localOnlyRoot.ID] = []LeafKey{randLeafKey(t)}
+
+	rootDiffs, err = diffUniverseRoots(ctx, localEngine, remoteEngine)
+	require.NoError(t, err)
+	require.Len(t, rootDiffs, 5)
+
+	diffsByID := make(map[string]UniverseRootDiff, len(rootDiffs))
+	for _, rootDiff := range rootDiffs {
+		diffsByID[rootDiff.ID.String()] = rootDiff
+	}
+
+	assertDiff := func(root BaseRoot, status RootDiffStatus,
+		numNewLeaves uint64) UniverseRootDiff {
+
+		rootDiff, ok := diffsByID[root.ID.String()]
+		require.True(t, ok, "missing diff for %v", root.ID.String())
+		require.Equal(t, status, rootDiff.Status)
+		require.EqualValues(t, numNewLeaves, rootDiff.NumNewLeaves)
+
+		return rootDiff
+	}
+
+	assertDiff(remoteAheadRoot, RootDiffRemoteAhead, 2)
+	assertDiff(localAheadRoot, RootDiffLocalAhead, 1)
+	assertDiff(divergedRoot, RootDiffDiverged, 0)
+
+	// Universes that are only known to one side don't have a root on the
+	// other side.
+	remoteOnlyDiff := assertDiff(remoteOnlyRoot, RootDiffRemoteAhead, 3)
+	require.Nil(t, remoteOnlyDiff.LocalRoot)
+	require.True(t, mssmt.IsEqualNode(
+		remoteOnlyRoot.Node, remoteOnlyDiff.RemoteRoot,
+	))
+
+	localOnlyDiff := assertDiff(localOnlyRoot, RootDiffLocalAhead, 1)
+	require.Nil(t, localOnlyDiff.RemoteRoot)
+	require.True(t, mssmt.IsEqualNode(
+		localOnlyRoot.Node, localOnlyDiff.LocalRoot,
+	))
+
+	require.NotContains(t, diffsByID, syncedRoot.ID.String())
+	require.NotContains(t, diffsByID, transferRoot.ID.String())
+}
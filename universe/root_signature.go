@@ -0,0 +1,101 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+)
+
+var (
+	// multiverseRootSigTag is the tag that prefixes the message that is
+	// signed to attest to a multiverse root. The tag ensures a signature
+	// over a multiverse root can't be replayed for any other message.
+	multiverseRootSigTag = []byte("taproot-assets/multiverse-root")
+
+	// universeRootSigTag is the tag that prefixes the message that is
+	// signed to attest to the root of a single universe.
+	universeRootSigTag = []byte("taproot-assets/universe-root")
+
+	// universeSnapshotSigTag is the tag that prefixes the message that is
+	// signed to attest to the state of a single universe at a given block
+	// height.
+	universeSnapshotSigTag = []byte("taproot-assets/universe-snapshot")
+)
+
+// RootSigner signs universe roots with the identity key of the universe
+// server, so clients can verify the authenticity of the roots they're served.
+type RootSigner interface {
+	// SignRootMsg signs the given root message with the identity key of
+	// the server. The returned signature is a DER encoded ECDSA signature
+	// over the SHA256 digest of the message.
+	SignRootMsg(ctx context.Context, msg []byte) ([]byte, error)
+
+	// PubKey returns the identity key that roots are signed with.
+	PubKey() *btcec.PublicKey
+}
+
+// writeRootNode writes the hash and sum of the given root node.
+func writeRootNode(buf *bytes.Buffer, root mssmt.Node) {
+	rootHash := root.NodeHash()
+	buf.Write(rootHash[:])
+
+	var sum [8]byte
+	binary.BigEndian.PutUint64(sum[:], root.NodeSum())
+	buf.Write(sum[:])
+}
+
+// MultiverseRootMsg returns the message that is signed to attest to the given
+// multiverse root of the given proof type:
+//
+//	tag || proof_type || root_hash || root_sum
+func MultiverseRootMsg(proofType ProofType, root mssmt.Node) []byte {
+	var buf bytes.Buffer
+	buf.Write(multiverseRootSigTag)
+	buf.WriteByte(byte(proofType))
+	writeRootNode(&buf, root)
+
+	return buf.Bytes()
+}
+
+// UniverseRootMsg returns the message that is signed to attest to the given
+// root of the universe with the given ID:
+//
+//	tag || proof_type || universe_id || root_hash || root_sum
+func UniverseRootMsg(id Identifier, root mssmt.Node) []byte {
+	var buf bytes.Buffer
+	buf.Write(universeRootSigTag)
+	buf.WriteByte(byte(id.ProofType))
+
+	idBytes := id.Bytes()
+	buf.Write(idBytes[:])
+
+	writeRootNode(&buf, root)
+
+	return buf.Bytes()
+}
+
+// VerifyRootSig verifies that the given signature over the given root message
+// was created by the given public key.
+func VerifyRootSig(msg, sig []byte, pubKey *btcec.PublicKey) error {
+	if len(sig) == 0 {
+		return fmt.Errorf("root isn't signed")
+	}
+
+	parsedSig, err := ecdsa.ParseDERSignature(sig)
+	if err != nil {
+		return fmt.Errorf("unable to parse root signature: %w", err)
+	}
+
+	digest := sha256.Sum256(msg)
+	if !parsedSig.Verify(digest[:], pubKey) {
+		return fmt.Errorf("invalid root signature")
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package universe
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// signRootMsg signs the given root message the same way lnd signs a message
+// with its node identity key.
+func signRootMsg(privKey *btcec.PrivateKey, msg []byte) []byte {
+	digest := sha256.Sum256(msg)
+	return ecdsa.Sign(privKey, digest[:]).Serialize()
+}
+
+// TestRootSignatures tests that signed multiverse and universe roots can be
+// verified, and that a signature doesn't verify for any other root, proof type
+// or key.
+func TestRootSignatures(t *testing.T) {
+	t.Parallel()
+
+	privKey := test.RandPrivKey(t)
+	otherKey := test.RandPrivKey(t)
+
+	randRoot := func() mssmt.Node {
+		rootHash := mssmt.NodeHash(test.RandHash())
+		return mssmt.NewComputedNode(rootHash, 100)
+	}
+	root := randRoot()
+	otherRoot := randRoot()
+
+	// A signed multiverse root verifies against the signing key only.
+	msg := MultiverseRootMsg(ProofTypeIssuance, root)
+	sig := signRootMsg(privKey, msg)
+	require.NoError(t, VerifyRootSig(msg, sig, privKey.PubKey()))
+	require.Error(t, VerifyRootSig(msg, sig, otherKey.PubKey()))
+
+	// The signature doesn't verify for a different root or proof type.
+	require.Error(t, VerifyRootSig(
+		MultiverseRootMsg(ProofTypeIssuance, otherRoot), sig,
+		privKey.PubKey(),
+	))
+	require.Error(t, VerifyRootSig(
+		MultiverseRootMsg(ProofTypeTransfer, root), sig,
+		privKey.PubKey(),
+	))
+
+	// A missing or malformed signature is rejected.
+	require.ErrorContains(
+		t, VerifyRootSig(msg, nil, privKey.PubKey()), "isn't signed",
+	)
+	require.Error(t, VerifyRootSig(msg, sig[1:], privKey.PubKey()))
+
+	// A signed universe root verifies as well, but the signature can't be
+	// used for the root of any other universe, or for a multiverse root
+	// with the same root node.
+	uniID := randBaseRoot(t).ID
+	otherID := randBaseRoot(t).ID
+
+	uniMsg := UniverseRootMsg(uniID, root)
+	uniSig := signRootMsg(privKey, uniMsg)
+	require.NoError(t, VerifyRootSig(uniMsg, uniSig, privKey.PubKey()))
+	require.Error(t, VerifyRootSig(
+		UniverseRootMsg(otherID, root), uniSig, privKey.PubKey(),
+	))
+	require.Error(t, VerifyRootSig(
+		MultiverseRootMsg(uniID.ProofType, root), uniSig,
+		privKey.PubKey(),
+	))
+}
@@ -0,0 +1,138 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/mssmt"
+)
+
+// ErrDuplicateLeafKey is returned when a universe root is computed from a set
+// of leaves that contains more than one leaf with the same key.
+var ErrDuplicateLeafKey = errors.New("duplicate universe leaf key")
+
+// ComputeRoot computes the root of a universe tree that holds exactly the given
+// leaves, without accessing any persisted universe state. ErrDuplicateLeafKey
+// is returned if more than one of the leaves has the same key.
+func ComputeRoot(ctx context.Context, leaves []ArchiveLeaf) (mssmt.Node,
+	error) {
+
+	tree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+	keys := make(map[[32]byte]struct{}, len(leaves))
+	for idx := range leaves {
+		leaf := &leaves[idx]
+
+		key := leaf.Key.UniverseKey()
+		if _, ok := keys[key]; ok {
+			return nil, fmt.Errorf("%w: outpoint=%v, key=%x",
+				ErrDuplicateLeafKey, leaf.Key.OutPoint, key[:])
+		}
+		keys[key] = struct{}{}
+
+		leafNode, err := leaf.newLeaf().SmtLeafNode()
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = tree.Insert(ctx, key, leafNode)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tree.Root(ctx)
+}
+
+// RootVerification is the result of verifying the persisted root of a universe
+// tree against the root recomputed from the leaves stored for the universe.
+type RootVerification struct {
+	// ID is the identifier of the universe.
+	ID Identifier
+
+	// PersistedRoot is the root of the universe tree as it was persisted
+	// before the verification. This is nil if no root was persisted.
+	PersistedRoot mssmt.Node
+
+	// CommittedRoot is the universe root the multiverse tree commits to.
+	// This is nil if the universe has no leaf in the multiverse tree.
+	CommittedRoot mssmt.Node
+
+	// ComputedRoot is the root recomputed from the stored leaves. This is
+	// nil if no leaves are stored for the universe.
+	ComputedRoot mssmt.Node
+
+	// NumLeaves is the number of leaves stored for the universe.
+	NumLeaves uint64
+
+	// Mismatch is true if either the persisted root or the root committed
+	// to in the multiverse tree doesn't match the computed root.
+	Mismatch bool
+
+	// Repaired is true if the persisted roots were rewritten to match the
+	// computed root.
+	Repaired bool
+}
+
+// VerifyUniverse recomputes the roots of the given universes from their stored
+// leaves and compares them to the persisted roots. If no IDs are given, then
+// all known universes are verified. Universes that are entirely unknown are
+// skipped. If repair is true, then any mismatching roots are rewritten to match
+// the recomputed roots.
+func (a *MintingArchive) VerifyUniverse(ctx context.Context, ids []Identifier,
+	repair bool) ([]RootVerification, error) {
+
+	if len(ids) == 0 {
+		roots, err := a.cfg.Multiverse.RootNodes(ctx, RootNodesQuery{})
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch universe "+
+				"roots: %w", err)
+		}
+
+		for _, root := range roots {
+			ids = append(ids, root.ID)
+		}
+	}
+
+	log.Infof("Verifying %d universe roots (repair=%v)", len(ids), repair)
+
+	verifications := make([]RootVerification, 0, len(ids))
+	for _, id := range ids {
+		verification, err := a.cfg.Multiverse.VerifyUniverseRoot(
+			ctx, id, repair,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to verify universe %v: "+
+				"%w", id.StringForLog(), err)
+		}
+
+		unknown := verification.NumLeaves == 0 &&
+			verification.PersistedRoot == nil &&
+			verification.CommittedRoot == nil
+		if unknown {
+			continue
+		}
+
+		if verification.Mismatch {
+			log.Warnf("Universe %v root mismatch: persisted=%v, "+
+				"computed=%v, repaired=%v", id.StringForLog(),
+				nodeHashStr(verification.PersistedRoot),
+				nodeHashStr(verification.ComputedRoot),
+				verification.Repaired)
+		}
+
+		verifications = append(verifications, *verification)
+	}
+
+	return verifications, nil
+}
+
+// nodeHashStr returns the hex encoded hash of the given node, or "none" if the
+// node isn't set.
+func nodeHashStr(node mssmt.Node) string {
+	if node == nil {
+		return "none"
+	}
+
+	return node.NodeHash().String()
+}
@@ -0,0 +1,126 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+)
+
+// Snapshot is the state of a single universe as it was at a given block
+// height. The root commits to exactly those leaves of the universe that were
+// confirmed at or before that height, so it can be recomputed by anyone with
+// access to the leaves.
+type Snapshot struct {
+	// ID is the identifier of the universe.
+	ID Identifier
+
+	// Root is the root of the universe tree at the snapshot height. The
+	// sum of the root is the total amount committed to by the leaves.
+	Root mssmt.Node
+
+	// NumLeaves is the number of leaves committed to by the root.
+	NumLeaves uint64
+
+	// BlockHeight is the height of the block the snapshot was taken at.
+	BlockHeight uint32
+
+	// BlockHash is the hash of the block at the snapshot height, which
+	// anchors the snapshot to a specific chain.
+	BlockHash chainhash.Hash
+}
+
+// UniverseSnapshotMsg returns the message that is signed to attest to the
+// given universe snapshot:
+//
+//	tag || proof_type || universe_id || block_height || block_hash ||
+//	num_leaves || root_hash || root_sum
+func UniverseSnapshotMsg(snapshot *Snapshot) []byte {
+	var buf bytes.Buffer
+	buf.Write(universeSnapshotSigTag)
+	buf.WriteByte(byte(snapshot.ID.ProofType))
+
+	idBytes := snapshot.ID.Bytes()
+	buf.Write(idBytes[:])
+
+	var height [4]byte
+	binary.BigEndian.PutUint32(height[:], snapshot.BlockHeight)
+	buf.Write(height[:])
+	buf.Write(snapshot.BlockHash[:])
+
+	var numLeaves [8]byte
+	binary.BigEndian.PutUint64(numLeaves[:], snapshot.NumLeaves)
+	buf.Write(numLeaves[:])
+
+	writeRootNode(&buf, snapshot.Root)
+
+	return buf.Bytes()
+}
+
+// VerifySnapshotSig verifies that the given signature over the given universe
+// snapshot was created by the given public key.
+func VerifySnapshotSig(snapshot *Snapshot, sig []byte,
+	pubKey *btcec.PublicKey) error {
+
+	return VerifyRootSig(UniverseSnapshotMsg(snapshot), sig, pubKey)
+}
+
+// UniverseSnapshot creates a snapshot of the universe with the given ID as it
+// was at the block with the given height and hash. The root of the snapshot is
+// recomputed from the leaves of the universe that were confirmed at or before
+// that height. ErrNoUniverseRoot is returned if the universe isn't known.
+func (a *MintingArchive) UniverseSnapshot(ctx context.Context, id Identifier,
+	blockHeight uint32, blockHash chainhash.Hash) (*Snapshot, error) {
+
+	log.Debugf("Creating snapshot of Universe %v at height %d",
+		id.StringForLog(), blockHeight)
+
+	leafKeys, err := a.UniverseLeafKeys(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch leaf keys: %w", err)
+	}
+	if len(leafKeys) == 0 {
+		return nil, ErrNoUniverseRoot
+	}
+
+	leaves := make([]ArchiveLeaf, 0, len(leafKeys))
+	for _, key := range leafKeys {
+		// We query the multiverse directly, as we don't want the
+		// snapshot to show up as a set of sync events.
+		proofs, err := a.cfg.Multiverse.FetchProofLeaf(ctx, id, key)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch leaf: %w", err)
+		}
+		if len(proofs) != 1 {
+			return nil, fmt.Errorf("expected a single proof for "+
+				"leaf, got %d", len(proofs))
+		}
+
+		leafProof := proofs[0].Leaf.Proof
+		if leafProof.BlockHeight > blockHeight {
+			continue
+		}
+
+		leaves = append(leaves, ArchiveLeaf{
+			Key:   key,
+			Proof: leafProof,
+		})
+	}
+
+	root, err := ComputeRoot(ctx, leaves)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute root: %w", err)
+	}
+
+	return &Snapshot{
+		ID:          id,
+		Root:        root,
+		NumLeaves:   uint64(len(leaves)),
+		BlockHeight: blockHeight,
+		BlockHash:   blockHash,
+	}, nil
+}
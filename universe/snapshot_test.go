@@ -0,0 +1,171 @@
+package universe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSnapshotUniverse is a mock base universe that serves a static set of
+// leaf keys.
+type mockSnapshotUniverse struct {
+	BaseBackend
+
+	keys []LeafKey
+}
+
+func (m *mockSnapshotUniverse) MintingKeys(context.Context) ([]LeafKey,
+	error) {
+
+	return m.keys, nil
+}
+
+// mockSnapshotMultiverse is a mock multiverse that serves the leaves of a
+// single archived universe.
+type mockSnapshotMultiverse struct {
+	MultiverseArchive
+
+	uni ArchiveUniverse
+}
+
+func (m *mockSnapshotMultiverse) FetchProofLeaf(_ context.Context,
+	_ Identifier, key LeafKey) ([]*Proof, error) {
+
+	for _, leaf := range m.uni.Leaves {
+		if leaf.Key.UniverseKey() == key.UniverseKey() {
+			return []*Proof{{
+				Leaf:    leaf.newLeaf(),
+				LeafKey: key,
+			}}, nil
+		}
+	}
+
+	return nil, ErrNoUniverseProofFound
+}
+
+// TestUniverseSnapshot tests that a universe snapshot only commits to the
+// leaves confirmed at or before its height, and that an unknown universe can't
+// be snapshotted.
+func TestUniverseSnapshot(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// The leaves of the universe are confirmed at consecutive heights,
+	// starting at height 100.
+	const numLeaves = 4
+	uni := randArchiveUniverse(t, numLeaves, false)
+	keys := make([]LeafKey, 0, numLeaves)
+	for idx := range uni.Leaves {
+		uni.Leaves[idx].Proof.BlockHeight = uint32(100 + idx)
+		keys = append(keys, uni.Leaves[idx].Key)
+	}
+
+	archive := NewMintingArchive(MintingArchiveConfig{
+		NewBaseTree: func(id Identifier) BaseBackend {
+			if id == uni.ID {
+				return &mockSnapshotUniverse{keys: keys}
+			}
+
+			return &mockSnapshotUniverse{}
+		},
+		Multiverse: &mockSnapshotMultiverse{uni: uni},
+	})
+
+	// A snapshot at the height of the last leaf commits to all leaves.
+	// The block height is part of the leaves, so we need to recompute the
+	// root of the universe.
+	fullRoot, err := ComputeRoot(ctx, uni.Leaves)
+	require.NoError(t, err)
+
+	blockHash := chainhash.Hash(test.RandHash())
+	snapshot, err := archive.UniverseSnapshot(ctx, uni.ID, 103, blockHash)
+	require.NoError(t, err)
+	require.Equal(t, uni.ID, snapshot.ID)
+	require.True(t, mssmt.IsEqualNode(fullRoot, snapshot.Root))
+	require.EqualValues(t, numLeaves, snapshot.NumLeaves)
+	require.EqualValues(t, 103, snapshot.BlockHeight)
+	require.Equal(t, blockHash, snapshot.BlockHash)
+
+	// An earlier snapshot only commits to the leaves confirmed until then.
+	snapshot, err = archive.UniverseSnapshot(ctx, uni.ID, 101, blockHash)
+	require.NoError(t, err)
+	expectedRoot, err := ComputeRoot(ctx, uni.Leaves[:2])
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(expectedRoot, snapshot.Root))
+	require.EqualValues(t, 2, snapshot.NumLeaves)
+
+	// A snapshot before the first leaf is empty.
+	snapshot, err = archive.UniverseSnapshot(ctx, uni.ID, 99, blockHash)
+	require.NoError(t, err)
+	require.Zero(t, snapshot.NumLeaves)
+	require.Zero(t, snapshot.Root.NodeSum())
+	require.Equal(t, mssmt.EmptyTreeRootHash, snapshot.Root.NodeHash())
+
+	// An unknown universe can't be snapshotted.
+	unknownID := randArchiveUniverse(t, 0, false).ID
+	_, err = archive.UniverseSnapshot(ctx, unknownID, 103, blockHash)
+	require.ErrorIs(t, err, ErrNoUniverseRoot)
+}
+
+// TestSnapshotSignature tests that a signed universe snapshot can be verified,
+// and that the signature doesn't verify for a snapshot with any other value or
+// as a signature over the universe root alone.
+func TestSnapshotSignature(t *testing.T) {
+	t.Parallel()
+
+	privKey := test.RandPrivKey(t)
+	otherKey := test.RandPrivKey(t)
+
+	snapshot := Snapshot{
+		ID: randBaseRoot(t).ID,
+		Root: mssmt.NewComputedNode(
+			mssmt.NodeHash(test.RandHash()), 100,
+		),
+		NumLeaves:   3,
+		BlockHeight: 800_000,
+		BlockHash:   chainhash.Hash(test.RandHash()),
+	}
+
+	sig := signRootMsg(privKey, UniverseSnapshotMsg(&snapshot))
+	require.NoError(t, VerifySnapshotSig(&snapshot, sig, privKey.PubKey()))
+	require.Error(t, VerifySnapshotSig(&snapshot, sig, otherKey.PubKey()))
+
+	// Changing any of the committed values invalidates the signature.
+	modifications := []func(s *Snapshot){
+		func(s *Snapshot) {
+			s.ID = randBaseRoot(t).ID
+		},
+		func(s *Snapshot) {
+			s.Root = mssmt.NewComputedNode(s.Root.NodeHash(), 101)
+		},
+		func(s *Snapshot) {
+			s.NumLeaves++
+		},
+		func(s *Snapshot) {
+			s.BlockHeight++
+		},
+		func(s *Snapshot) {
+			s.BlockHash = chainhash.Hash(test.RandHash())
+		},
+	}
+	for _, modify := range modifications {
+		modified := snapshot
+		modify(&modified)
+
+		require.Error(
+			t, VerifySnapshotSig(&modified, sig, privKey.PubKey()),
+		)
+	}
+
+	// The signature can't be replayed as a signature over the root of the
+	// universe alone.
+	require.Error(t, VerifyRootSig(
+		UniverseRootMsg(snapshot.ID, snapshot.Root), sig,
+		privKey.PubKey(),
+	))
+}
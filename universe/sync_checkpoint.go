@@ -0,0 +1,91 @@
+package universe
+
+import (
+	"context"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+// SyncCheckpointStore is used to persist the progress of a sync with a remote
+// universe server, so a sync that is interrupted (for example by a restart or
+// a network failure) can be resumed without syncing the universes that were
+// already synced again.
+type SyncCheckpointStore interface {
+	// MarkUniverseSynced records that the given universe was completely
+	// synced by the ongoing sync of the given type with the given server.
+	MarkUniverseSynced(ctx context.Context, server ServerAddr,
+		syncType SyncType, id Identifier) error
+
+	// SyncCheckpoint returns the namespaces of the universes that were
+	// completely synced by the last unfinished sync of the given type
+	// with the given server.
+	SyncCheckpoint(ctx context.Context, server ServerAddr,
+		syncType SyncType) (fn.Set[string], error)
+
+	// ClearSyncCheckpoint removes the checkpoint of the sync of the given
+	// type with the given server, once the sync finished.
+	ClearSyncCheckpoint(ctx context.Context, server ServerAddr,
+		syncType SyncType) error
+}
+
+// loadSyncCheckpoint returns the set of universes that can be skipped by a
+// sync of the given type with the given server, as they were already synced by
+// a previous, interrupted sync. If the sync shouldn't be resumed, then the
+// checkpoint of any previous sync is removed, so it isn't picked up by a later
+// sync, and an empty set is returned.
+func (s *SimpleSyncer) loadSyncCheckpoint(ctx context.Context,
+	server ServerAddr, syncType SyncType,
+	resume bool) (fn.Set[string], error) {
+
+	if s.cfg.Checkpoints == nil {
+		return fn.NewSet[string](), nil
+	}
+
+	if !resume {
+		err := s.cfg.Checkpoints.ClearSyncCheckpoint(
+			ctx, server, syncType,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return fn.NewSet[string](), nil
+	}
+
+	return s.cfg.Checkpoints.SyncCheckpoint(ctx, server, syncType)
+}
+
+// markUniverseSynced adds the given universe to the checkpoint of the ongoing
+// sync with the given server. A failure to store the checkpoint doesn't fail
+// the sync, as it only means the universe is synced again if the sync is
+// resumed.
+func (s *SimpleSyncer) markUniverseSynced(ctx context.Context,
+	server ServerAddr, syncType SyncType, id Identifier) {
+
+	if s.cfg.Checkpoints == nil {
+		return
+	}
+
+	err := s.cfg.Checkpoints.MarkUniverseSynced(ctx, server, syncType, id)
+	if err != nil {
+		log.Warnf("Unable to checkpoint sync of UniverseRoot(%v) "+
+			"with server=%v: %v", id.String(), server.HostStr(),
+			err)
+	}
+}
+
+// clearSyncCheckpoint removes the checkpoint of a finished sync with the given
+// server.
+func (s *SimpleSyncer) clearSyncCheckpoint(ctx context.Context,
+	server ServerAddr, syncType SyncType) {
+
+	if s.cfg.Checkpoints == nil {
+		return
+	}
+
+	err := s.cfg.Checkpoints.ClearSyncCheckpoint(ctx, server, syncType)
+	if err != nil {
+		log.Warnf("Unable to clear sync checkpoint of server=%v: %v",
+			server.HostStr(), err)
+	}
+}
@@ -2,14 +2,17 @@ package universe
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"sort"
+	"sync"
 	"sync/atomic"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -17,6 +20,10 @@ var (
 	// ErrUnsupportedSync is returned when a syncer is asked to async in a
 	// way that it does not support.
 	ErrUnsupportedSync = fmt.Errorf("unsupported sync type")
+
+	// ErrMaxProofDepthExceeded is returned when the proof chain of an
+	// asset that is being synced exceeds the maximum proof depth.
+	ErrMaxProofDepthExceeded = errors.New("max proof depth exceeded")
 )
 
 // SimpleSyncCfg contains all the configuration needed to create a new
@@ -35,8 +42,40 @@ type SimpleSyncCfg struct {
 	// the diff operation.
 	LocalRegistrar BatchRegistrar
 
-	// SyncBatchSize is the number of items to sync in a single batch.
+	// SyncBatchSize is the number of leaves that are fetched from the
+	// remote Universe and inserted into the local Universe in a single
+	// batch. Larger batches speed up the sync at the cost of holding more
+	// proofs in memory at once. If zero, then all new leaves of a Universe
+	// are fetched in a single batch.
 	SyncBatchSize int
+
+	// MaxProofDepth is the maximum depth of the proof chain of an asset
+	// formed by the new transfer leaves of a single Universe sync. The
+	// sync of a Universe with a deeper proof chain is skipped, to prevent
+	// a remote Universe from exhausting our resources. If zero, then the
+	// depth isn't limited.
+	MaxProofDepth int
+
+	// MinConfs is the minimum number of confirmations the anchor
+	// transaction of a new leaf must have to be synced. Leaves with a
+	// shallower anchor are skipped until a later sync. A value of one or
+	// less syncs all leaves. This can be overridden per sync through the
+	// sync configs.
+	MinConfs uint32
+
+	// ChainHeight returns the current height of the main chain. This is
+	// only required if leaves are synced with a minimum number of
+	// confirmations larger than one.
+	ChainHeight ChainHeightFunc
+
+	// LazyRoots is used to store the roots imported by a roots only sync.
+	// If nil, roots only syncs aren't supported.
+	LazyRoots LazyRootStore
+
+	// Checkpoints is used to persist the universes that were synced by an
+	// ongoing sync, so the sync can be resumed if it's interrupted. If
+	// nil, syncs can't be resumed.
+	Checkpoints SyncCheckpointStore
 }
 
 // SimpleSyncer is a simple implementation of the Syncer interface. It's based
@@ -59,10 +98,21 @@ func NewSimpleSyncer(cfg SimpleSyncCfg) *SimpleSyncer {
 
 // executeSync attempts to sync the local Universe with the remote diff engine.
 // A simple approach where a set difference is used to find the set of assets
-// that need to be synced is used.
+// that need to be synced is used. If dryRun is true, then the diff is computed
+// but none of the new leaves are inserted into the local Universe. If progress
+// is set, then it's called with updates as the sync proceeds. All fetched
+// leaves are tagged with the priority of the given source server.
 func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
-	syncType SyncType, syncConfigs SyncConfigs,
-	idsToSync []Identifier) ([]AssetSyncDiff, error) {
+	source ServerAddr, syncType SyncType, syncConfigs SyncConfigs,
+	idsToSync []Identifier, dryRun bool,
+	progress SyncProgressFunc) ([]AssetSyncDiff, error) {
+
+	if syncType == SyncRootsOnly && s.cfg.LazyRoots == nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedSync, syncType)
+	}
+	if syncConfigs.ResumeSync && s.cfg.Checkpoints == nil {
+		return nil, fmt.Errorf("%w: resumed sync", ErrUnsupportedSync)
+	}
 
 	// Prevent the syncer from running twice.
 	if !s.isSyncing.CompareAndSwap(false, true) {
@@ -74,8 +124,16 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 		s.isSyncing.Store(false)
 	}()
 
+	rootsQuery := RootNodesQuery{
+		IDPrefix: syncConfigs.IDPrefix,
+	}
+	if err := rootsQuery.Validate(); err != nil {
+		return nil, err
+	}
+
 	var (
 		targetRoots []BaseRoot
+		failedDiffs []AssetSyncDiff
 		err         error
 	)
 	switch {
@@ -87,14 +145,27 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 			spew.Sdump(idsToSync))
 
 		// We'll use an error group to fetch each Universe root we need
-		// as a series of parallel requests backed by a worker pool.
+		// as a series of parallel requests backed by a worker pool. If
+		// the remote Universe doesn't know about one of the requested
+		// roots, then we'll report that as a failed diff rather than
+		// silently skipping it.
 		rootsToSync := make(chan BaseRoot, len(idsToSync))
+		failedRoots := make(chan AssetSyncDiff, len(idsToSync))
 		err = fn.ParSlice(
 			ctx, idsToSync,
 			func(ctx context.Context, id Identifier) error {
 				root, err := diffEngine.RootNode(ctx, id)
 				if err != nil {
-					return err
+					failedRoots <- AssetSyncDiff{
+						NewUniverseRoot: BaseRoot{
+							ID: id,
+						},
+						SyncErr: fmt.Errorf("unable "+
+							"to fetch remote "+
+							"root: %w", err),
+					}
+
+					return nil
 				}
 
 				rootsToSync <- root
@@ -107,11 +178,15 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 		}
 
 		targetRoots = fn.Collect(rootsToSync)
+		failedDiffs = fn.Collect(failedRoots)
 
 	// Otherwise, we'll just fetch all the roots from the remote universe.
+	// If the sync is restricted to an ID prefix, the remote server only
+	// returns the roots that match the prefix.
 	default:
-		log.Infof("Fetching all roots for remote Universe server...")
-		targetRoots, err = diffEngine.RootNodes(ctx)
+		log.Infof("Fetching all roots for remote Universe server "+
+			"(id_prefix=%q)...", syncConfigs.IDPrefix)
+		targetRoots, err = diffEngine.RootNodes(ctx, rootsQuery)
 		if err != nil {
 			return nil, err
 		}
@@ -127,6 +202,13 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 				return false
 			}
 
+			// Older remote servers don't know about the ID prefix
+			// and return all roots, so we'll also check the prefix
+			// locally.
+			if !rootsQuery.Matches(r.ID) {
+				return false
+			}
+
 			return syncConfigs.IsSyncInsertEnabled(r.ID)
 		},
 	)
@@ -136,26 +218,122 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 	log.Tracef("Obtained %v roots from remote Universe server: %v",
 		len(targetRoots), spew.Sdump(targetRoots))
 
+	// Unless this is a dry run, we'll checkpoint the universes that are
+	// synced, so the sync can be resumed if it's interrupted. If we're
+	// resuming a previous sync, then we can skip the universes that were
+	// already synced by it.
+	checkpoint := fn.NewSet[string]()
+	if !dryRun {
+		checkpoint, err = s.loadSyncCheckpoint(
+			ctx, source, syncType, syncConfigs.ResumeSync,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load sync "+
+				"checkpoint: %w", err)
+		}
+	}
+	if len(checkpoint) != 0 {
+		numRoots := len(targetRoots)
+		targetRoots = fn.Filter(targetRoots, func(r BaseRoot) bool {
+			return !checkpoint.Contains(r.ID.String())
+		})
+
+		log.Infof("Resuming sync with server=%v, skipping %v roots "+
+			"that were already synced", source.HostStr(),
+			numRoots-len(targetRoots))
+	}
+
+	// The minimum number of confirmations of the new leaves can be
+	// overridden per sync. We only need to query the chain height once, as
+	// the sync is short-lived compared to the block interval.
+	minConfs := s.cfg.MinConfs
+	if syncConfigs.MinConfs != 0 {
+		minConfs = syncConfigs.MinConfs
+	}
+	currentHeight, err := currentHeightForConfs(
+		ctx, minConfs, s.cfg.ChainHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+	confs := confsFilter{
+		minConfs:      minConfs,
+		currentHeight: currentHeight,
+	}
+
+	tracker := newSyncProgressTracker(len(targetRoots), progress)
+
 	// Now that we know the set of Universes we need to sync, we'll execute
-	// the diff operation for each of them.
+	// the diff operation for each of them. A failure to sync a single root
+	// shouldn't abort the sync of all the other roots, so we'll report any
+	// failure as part of the diff for that root instead.
 	syncDiffs := make(chan AssetSyncDiff, len(targetRoots))
 	err = fn.ParSlice(
 		ctx, targetRoots, func(ctx context.Context, r BaseRoot) error {
-			return s.syncRoot(ctx, r, diffEngine, syncDiffs)
+			err := s.syncRoot(
+				ctx, r, syncType, dryRun, confs, diffEngine,
+				source, syncDiffs, tracker,
+			)
+			if err != nil {
+				log.Warnf("Unable to sync UniverseRoot(%v): %v",
+					r.ID.String(), err)
+
+				syncDiffs <- AssetSyncDiff{
+					NewUniverseRoot: r,
+					SyncErr:         err,
+					SkippedTooDeep: errors.Is(
+						err, ErrMaxProofDepthExceeded,
+					),
+				}
+			} else if !dryRun {
+				s.markUniverseSynced(
+					ctx, source, syncType, r.ID,
+				)
+			}
+
+			tracker.universeDone(r.ID)
+
+			return nil
 		},
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	// Finally, we'll collect all the diffs and return them to the caller.
-	return fn.Collect(syncDiffs), nil
+	// If the sync ran to completion, then there's nothing left to resume,
+	// so we can remove its checkpoint. A failure to sync a single root
+	// doesn't count as an interruption, as resuming the sync would only
+	// try it again like a new sync does.
+	if !dryRun && ctx.Err() == nil {
+		s.clearSyncCheckpoint(ctx, source, syncType)
+	}
+
+	// Finally, we'll collect all the diffs and return them to the caller,
+	// along with the failed diffs for any roots we couldn't fetch.
+	return append(fn.Collect(syncDiffs), failedDiffs...), nil
+}
+
+// confsFilter is used to skip the new leaves of a sync whose anchor transaction
+// doesn't have the minimum number of confirmations yet.
+type confsFilter struct {
+	minConfs      uint32
+	currentHeight uint32
+}
+
+// isShallow returns true if the anchor transaction of the given proof has
+// fewer than the minimum number of confirmations.
+func (c confsFilter) isShallow(p *proof.Proof) bool {
+	return CheckProofConfs(p, c.minConfs, c.currentHeight) != nil
 }
 
 // syncRoot attempts to sync the local Universe with the remote diff engine for
-// a specific base root.
+// a specific base root. If dryRun is true, then the new leaves are fetched and
+// verified against the remote root, but not inserted into the local Universe.
+// New leaves that are rejected by the confirmation filter are skipped.
 func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot BaseRoot,
-	diffEngine DiffEngine, result chan<- AssetSyncDiff) error {
+	syncType SyncType, dryRun bool, confs confsFilter,
+	diffEngine DiffEngine, source ServerAddr, result chan<- AssetSyncDiff,
+	tracker *syncProgressTracker) error {
 
 	// First, we'll compare the remote root against the local root.
 	uniID := remoteRoot.ID
@@ -167,8 +345,12 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot BaseRoot,
 		// TODO(roasbeef): abstraction leak, error should be in
 		// universe package
 
-	// If the local root matches the remote root, then we're done here.
-	case err == nil && mssmt.IsEqualNode(localRoot, remoteRoot):
+	// If the local root matches the remote root, then we're done here. A
+	// lazy local root isn't backed by local leaves, so only a roots only
+	// sync is done if it matches.
+	case err == nil && mssmt.IsEqualNode(localRoot, remoteRoot) &&
+		(!localRoot.Lazy || syncType == SyncRootsOnly):
+
 		log.Infof("Root for %v matches, no sync needed",
 			uniID.String())
 
@@ -178,36 +360,78 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot BaseRoot,
 		return fmt.Errorf("unable to fetch local root: %v", err)
 	}
 
+	// For a roots only sync, we only record the remote root as a lazy
+	// root. Its leaves are fetched from the remote Universe once they're
+	// queried.
+	if syncType == SyncRootsOnly {
+		return s.syncLazyRoot(
+			ctx, localRoot, remoteRoot, source, dryRun, result,
+		)
+	}
+
 	log.Infof("UniverseRoot(%v) diverges, performing leaf diff...",
 		uniID.String())
 
-	// Otherwise, we'll need to perform a diff operation to find the set of
-	// keys we need to fetch.
-	remoteUniKeys, err := diffEngine.UniverseLeafKeys(ctx, uniID)
-	if err != nil {
-		return err
+	// For a delta sync, we'll ask the remote Universe for the keys that
+	// were inserted since our local root, so we don't need to fetch the
+	// full set of keys. If the remote Universe doesn't know our local
+	// root, then we'll fall back to a full diff below. The leaves of a lazy
+	// local root aren't stored locally, so it always needs a full diff.
+	var (
+		keysToFetch   []LeafKey
+		useDelta      = syncType == SyncDelta && localRoot.Node != nil
+		deltaFallback bool
+	)
+	if localRoot.Lazy {
+		useDelta = false
 	}
-	localUniKeys, err := s.cfg.LocalDiffEngine.UniverseLeafKeys(ctx, uniID)
-	if err != nil {
-		return err
+	if useDelta {
+		keysToFetch, err = diffEngine.UniverseLeafKeysSince(
+			ctx, uniID, localRoot.Node.NodeHash(),
+		)
+		switch {
+		case errors.Is(err, ErrUnknownUniverseRoot):
+			log.Infof("UniverseRoot(%v): local root unknown to "+
+				"remote, falling back to full diff",
+				uniID.String())
+
+			useDelta = false
+			deltaFallback = true
+
+		case err != nil:
+			return err
+		}
 	}
 
-	// With the set of keys fetched, we can now find the set of keys that
-	// need to be synced.
-	keysToFetch := fn.SetDiff(remoteUniKeys, localUniKeys)
+	// Otherwise, we'll need to perform a diff operation to find the set of
+	// keys we need to fetch.
+	if !useDelta {
+		keysToFetch, err = s.leafKeyDiff(ctx, uniID, diffEngine)
+		if err != nil {
+			return err
+		}
+	}
 
 	log.Infof("UniverseRoot(%v): diff_size=%v", uniID.String(),
 		len(keysToFetch))
 	log.Tracef("UniverseRoot(%v): diff_size=%v, diff=%v", uniID.String(),
 		len(keysToFetch), spew.Sdump(keysToFetch))
 
+	// We fetch and insert the new leaves in batches, so we only ever hold
+	// a bounded number of proofs in memory, even for very large diffs.
+	batchSize := s.cfg.SyncBatchSize
+	if batchSize <= 0 {
+		batchSize = len(keysToFetch)
+	}
+
 	// Before we start fetching leaves, we already start our batch stream
 	// for the new leaves. This allows us to stream the new leaves to the
-	// local registrar as they're fetched.
+	// local registrar as they're fetched. If the batch streamer fails, the
+	// fetch context is canceled so we stop fetching leaves.
 	var (
-		fetchedLeaves = make(chan *IssuanceItem, len(keysToFetch))
-		newLeafProofs []*Leaf
-		batchSyncEG   errgroup.Group
+		fetchedLeaves         = make(chan *IssuanceItem, batchSize)
+		newLeafProofs         []*Leaf
+		batchSyncEG, fetchCtx = errgroup.WithContext(ctx)
 	)
 
 	// We use an error group to simply the error handling of a goroutine.
@@ -215,76 +439,164 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot BaseRoot,
 	// insert into the DB. We'll fee the output of the goroutines below
 	// into the input fetchedLeaves channel.
 	batchSyncEG.Go(func() error {
+		// If we bail out early, we still need to drain the channel so
+		// the fetching side never blocks on a full channel.
+		defer func() {
+			for range fetchedLeaves {
+			}
+		}()
+
+		// For a dry run, we only collect the new leaves, without
+		// inserting them.
+		if dryRun {
+			for item := range fetchedLeaves {
+				newLeafProofs = append(newLeafProofs, item.Leaf)
+			}
+
+			return nil
+		}
+
+		var err error
 		newLeafProofs, err = s.batchStreamNewItems(
-			ctx, uniID, fetchedLeaves, len(keysToFetch),
+			ctx, uniID, fetchedLeaves, batchSize,
+			len(keysToFetch), tracker,
 		)
 		return err
 	})
 
-	// If this is a transfer tree, then we'll use these channels to sort
-	// the contents before sending to the batch writer.
+	// stopBatchSync stops the batch streamer and returns its error, if
+	// any, or the given error otherwise.
+	stopBatchSync := func(err error) error {
+		close(fetchedLeaves)
+		if syncErr := batchSyncEG.Wait(); syncErr != nil {
+			return syncErr
+		}
+
+		return err
+	}
+
+	// Transfer leaves need to be sorted before they are sent to the batch
+	// writer, so they can be validated in dependency order.
 	isIssuanceTree := remoteRoot.ID.ProofType == ProofTypeIssuance
-	transferLeafProofs := make(chan *IssuanceItem, len(keysToFetch))
+	var transferLeaves []*IssuanceItem
 
-	// Now that we know where the divergence is, we can fetch the issuance
-	// proofs from the remote party.
-	err = fn.ParSlice(
-		ctx, keysToFetch, func(ctx context.Context, key LeafKey) error {
-			newProof, err := diffEngine.FetchIssuanceProof(
-				ctx, uniID, key,
-			)
-			if err != nil {
-				return err
-			}
+	// The proof chain of a transfer is always anchored at or above the
+	// height of its inputs, so skipping the shallow leaves never leaves
+	// us with a leaf whose input was skipped.
+	var numShallow atomic.Uint64
 
-			leafProof := newProof[0]
+	// fetchLeaf fetches the proof of a single leaf from the remote party,
+	// verifies it against the remote root and sends it on the given
+	// channel, unless it isn't buried deep enough yet.
+	fetchLeaf := func(ctx context.Context, key LeafKey,
+		fetched chan<- *IssuanceItem) error {
 
-			// Now that we have this leaf proof, we want to ensure
-			// that it's actually part of the remote root we were
-			// given.
-			validRoot, err := leafProof.VerifyRoot(remoteRoot)
-			if err != nil {
-				return fmt.Errorf("unable to verify root: %w",
-					err)
-			}
-			if !validRoot {
-				return fmt.Errorf("proof for key=%v is "+
-					"invalid", spew.Sdump(key))
-			}
+		newProof, err := diffEngine.FetchIssuanceProof(ctx, uniID, key)
+		if err != nil {
+			return err
+		}
 
-			// If this is an issuance proof, then we can send
-			// things directly to the batch insertion goroutine.
-			// Otherwise, we'll another step to the pipeline below
-			// for sorting.
-			if isIssuanceTree {
-				fetchedLeaves <- &IssuanceItem{
-					ID:   uniID,
-					Key:  key,
-					Leaf: leafProof.Leaf,
-				}
-			} else {
-				transferLeafProofs <- &IssuanceItem{
-					ID:   uniID,
-					Key:  key,
-					Leaf: leafProof.Leaf,
-				}
-			}
+		leafProof := newProof[0]
+
+		// Now that we have this leaf proof, we want to ensure that it's
+		// actually part of the remote root we were given.
+		validRoot, err := leafProof.VerifyRoot(remoteRoot)
+		if err != nil {
+			return fmt.Errorf("unable to verify root: %w", err)
+		}
+		if !validRoot {
+			return fmt.Errorf("proof for key=%v is invalid",
+				spew.Sdump(key))
+		}
 
+		// Leaves that aren't buried deep enough yet are skipped,
+		// they'll be synced by a later sync.
+		if confs.isShallow(leafProof.Leaf.Proof) {
+			numShallow.Add(1)
 			return nil
-		})
-	if err != nil {
-		return err
+		}
+
+		// We tag the leaf with the priority of the server we fetched it
+		// from, so it can't be replaced by a diverging leaf of a server
+		// with a lower priority.
+		leafProof.Leaf.SourcePriority = fn.Ptr(source.Priority)
+
+		fetched <- &IssuanceItem{
+			ID:   uniID,
+			Key:  key,
+			Leaf: leafProof.Leaf,
+		}
+
+		return nil
 	}
 
-	// If this is a tranfer tree, then we'll collect all the items as we
-	// need to sort them to ensure we can validate them in dep order.
+	// Now that we know where the divergence is, we can fetch the issuance
+	// proofs from the remote party, one batch at a time.
+	for start := 0; start < len(keysToFetch); start += batchSize {
+		batchKeys := keysToFetch[start:min(
+			start+batchSize, len(keysToFetch),
+		)]
+
+		fetched := make(chan *IssuanceItem, len(batchKeys))
+		err = fn.ParSlice(
+			fetchCtx, batchKeys,
+			func(ctx context.Context, key LeafKey) error {
+				return fetchLeaf(ctx, key, fetched)
+			},
+		)
+		if err != nil {
+			return stopBatchSync(err)
+		}
+
+		// Issuance leaves can be sent directly to the batch insertion
+		// goroutine, which blocks us until there's room for more.
+		// Otherwise, we'll add another step to the pipeline below for
+		// sorting.
+		batchLeaves := fn.Collect(fetched)
+		if isIssuanceTree {
+			fn.SendAll(fetchedLeaves, batchLeaves...)
+			continue
+		}
+
+		transferLeaves = append(transferLeaves, batchLeaves...)
+	}
+
+	// If this is a tranfer tree, then we'll sort all the items we fetched
+	// to ensure we can validate them in dep order. As a transfer can
+	// depend on any other transfer of the tree, we need all of them
+	// before we can start inserting.
 	if !isIssuanceTree {
-		transferLeaves := fn.Collect(transferLeafProofs)
 		sort.Slice(transferLeaves, func(i, j int) bool {
 			return transferLeaves[i].Leaf.Proof.BlockHeight <
 				transferLeaves[j].Leaf.Proof.BlockHeight
 		})
 
+		// A chain of transfers can never be deeper than the number of
+		// transfer leaves, so we only need to compute the depth if
+		// there are more leaves than the maximum depth.
+		maxDepth := s.cfg.MaxProofDepth
+		if maxDepth > 0 && len(transferLeaves) > maxDepth {
+			depth, deepest := proofChainDepth(transferLeaves)
+			if depth > maxDepth {
+				deepestAsset := deepest.Leaf.Proof.Asset
+				log.Warnf("UniverseRoot(%v): skipping sync, "+
+					"proof chain of asset_id=%v "+
+					"(leaf_key=%x) has depth %d, which "+
+					"exceeds max proof depth of %d",
+					uniID.String(), deepestAsset.ID(),
+					deepest.Key.UniverseKey(), depth,
+					maxDepth)
+
+				close(fetchedLeaves)
+				_ = batchSyncEG.Wait()
+
+				return fmt.Errorf("%w: proof chain of asset "+
+					"%v has depth %d, max is %d",
+					ErrMaxProofDepthExceeded,
+					deepestAsset.ID(), depth, maxDepth)
+			}
+		}
+
 		fn.SendAll(fetchedLeaves, transferLeaves...)
 	}
 
@@ -295,18 +607,50 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot BaseRoot,
 		return err
 	}
 
-	log.Infof("Universe sync for UniverseRoot(%v) complete, %d "+
-		"new leaves inserted", uniID.String(), len(keysToFetch))
+	if dryRun {
+		log.Infof("Universe sync dry run for UniverseRoot(%v) "+
+			"complete, %d new leaves found", uniID.String(),
+			len(newLeafProofs))
+	} else {
+		log.Infof("Universe sync for UniverseRoot(%v) complete, %d "+
+			"new leaves inserted", uniID.String(),
+			len(newLeafProofs))
+	}
+	if numShallow.Load() > 0 {
+		log.Infof("UniverseRoot(%v): skipped %d new leaves with fewer "+
+			"than %d confirmations", uniID.String(),
+			numShallow.Load(), confs.minConfs)
+	}
+
+	// Once all the leaves of the remote root are inserted, a lazy local
+	// root is superseded by the local leaves.
+	if localRoot.Lazy && s.cfg.LazyRoots != nil && !dryRun &&
+		numShallow.Load() == 0 {
+
+		err := s.cfg.LazyRoots.DeleteLazyRoot(ctx, uniID)
+		if err != nil {
+			return fmt.Errorf("unable to remove lazy root: %w", err)
+		}
+	}
 
 	// TODO(roabseef): sanity check local and remote roots match now?
 
 	// To wrap up, we'll collect the set of leaves then convert them into a
-	// final sync diff.
-	result <- AssetSyncDiff{
-		OldUniverseRoot: localRoot,
-		NewUniverseRoot: remoteRoot,
-		NewLeafProofs:   newLeafProofs,
+	// final sync diff. We also note how many of the new leaves are issuance
+	// vs transfer leaves, so callers can tell them apart.
+	diff := AssetSyncDiff{
+		OldUniverseRoot:  localRoot,
+		NewUniverseRoot:  remoteRoot,
+		NewLeafProofs:    newLeafProofs,
+		DeltaFallback:    deltaFallback,
+		NumShallowLeaves: numShallow.Load(),
+	}
+	if isIssuanceTree {
+		diff.NumIssuanceLeaves = uint64(len(newLeafProofs))
+	} else {
+		diff.NumTransferLeaves = uint64(len(newLeafProofs))
 	}
+	result <- diff
 
 	log.Infof("Sync for UniverseRoot(%v) complete!", uniID.String())
 	log.Tracef("Sync for UniverseRoot(%v) complete! New "+
@@ -315,18 +659,194 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot BaseRoot,
 	return nil
 }
 
+// syncLazyRoot records the given remote root as the lazy root of its Universe,
+// unless dryRun is true, and reports it as the new root of the sync diff.
+func (s *SimpleSyncer) syncLazyRoot(ctx context.Context, localRoot,
+	remoteRoot BaseRoot, source ServerAddr, dryRun bool,
+	result chan<- AssetSyncDiff) error {
+
+	uniID := remoteRoot.ID
+	if !dryRun {
+		err := s.cfg.LazyRoots.UpsertLazyRoot(ctx, LazyRoot{
+			BaseRoot:   remoteRoot,
+			SourceHost: source.HostStr(),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to store lazy root: %w", err)
+		}
+	}
+
+	lazyRoot := remoteRoot
+	lazyRoot.Lazy = true
+
+	result <- AssetSyncDiff{
+		OldUniverseRoot: localRoot,
+		NewUniverseRoot: lazyRoot,
+	}
+
+	log.Infof("Imported lazy UniverseRoot(%v) from %v", uniID.String(),
+		source.HostStr())
+
+	return nil
+}
+
+// syncProgressTracker aggregates the progress of the concurrent syncs of the
+// individual Universes of a sync and reports it to the progress function. All
+// methods are no-ops if no progress function is set.
+type syncProgressTracker struct {
+	report SyncProgressFunc
+
+	// mtx guards the progress, and also serializes the calls to the
+	// progress function.
+	mtx      sync.Mutex
+	progress SyncProgress
+}
+
+// newSyncProgressTracker creates a new progress tracker for a sync of the
+// given number of Universes, and reports the initial progress.
+func newSyncProgressTracker(numUniverses int,
+	report SyncProgressFunc) *syncProgressTracker {
+
+	t := &syncProgressTracker{
+		report: report,
+		progress: SyncProgress{
+			NumUniverses: numUniverses,
+		},
+	}
+
+	if report != nil {
+		report(t.progress)
+	}
+
+	return t
+}
+
+// leavesInserted records that the given number of new leaves were inserted
+// into the Universe with the given ID.
+func (t *syncProgressTracker) leavesInserted(id Identifier, numLeaves int) {
+	if t.report == nil {
+		return
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.progress.NumLeavesInserted += numLeaves
+	t.progress.CurrentID = &id
+	t.report(t.progress)
+}
+
+// universeDone records that the sync of the Universe with the given ID is
+// finished.
+func (t *syncProgressTracker) universeDone(id Identifier) {
+	if t.report == nil {
+		return
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.progress.NumUniversesDone++
+	t.progress.CurrentID = &id
+	t.report(t.progress)
+}
+
+// proofChainDepth returns the depth of the longest proof chain formed by the
+// given transfer leaves, along with the leaf at the end of that chain. A leaf
+// that spends an asset that isn't part of the given leaves starts a new chain.
+func proofChainDepth(leaves []*IssuanceItem) (int, *IssuanceItem) {
+	leavesByKey := make(map[UniverseKey]*IssuanceItem, len(leaves))
+	for _, leaf := range leaves {
+		leavesByKey[leaf.Key.UniverseKey()] = leaf
+	}
+
+	var (
+		depths   = make(map[UniverseKey]int, len(leaves))
+		maxDepth int
+		deepest  *IssuanceItem
+	)
+	for _, leaf := range leaves {
+		// We'll walk back the chain of the leaf until we reach a leaf
+		// with a known depth, or the start of the chain. As the leaves
+		// come from a remote party, we also bound the walk in case the
+		// leaves form a cycle.
+		var (
+			path      []UniverseKey
+			baseDepth int
+			key       = leaf.Key.UniverseKey()
+		)
+		for len(path) <= len(leaves) {
+			if depth, ok := depths[key]; ok {
+				baseDepth = depth
+				break
+			}
+
+			item, ok := leavesByKey[key]
+			if !ok {
+				break
+			}
+			path = append(path, key)
+
+			if item.Leaf.Proof.Asset.IsGenesisAsset() {
+				break
+			}
+
+			_, prevLeafKey, err := prevAssetLeafKey(
+				*item.Leaf.Proof,
+			)
+			if err != nil {
+				break
+			}
+			key = prevLeafKey.UniverseKey()
+		}
+
+		// Now we can assign the depth of each leaf on the path,
+		// starting from the oldest one.
+		for i := len(path) - 1; i >= 0; i-- {
+			baseDepth++
+			depths[path[i]] = baseDepth
+		}
+
+		if depth := depths[leaf.Key.UniverseKey()]; depth > maxDepth {
+			maxDepth = depth
+			deepest = leaf
+		}
+	}
+
+	return maxDepth, deepest
+}
+
+// leafKeyDiff returns the set of leaf keys the remote Universe has for the
+// given Universe, that the local Universe doesn't have.
+func (s *SimpleSyncer) leafKeyDiff(ctx context.Context, uniID Identifier,
+	diffEngine DiffEngine) ([]LeafKey, error) {
+
+	remoteUniKeys, err := diffEngine.UniverseLeafKeys(ctx, uniID)
+	if err != nil {
+		return nil, err
+	}
+	localUniKeys, err := s.cfg.LocalDiffEngine.UniverseLeafKeys(ctx, uniID)
+	if err != nil {
+		return nil, err
+	}
+
+	// With the set of keys fetched, we can now find the set of keys that
+	// need to be synced.
+	return fn.SetDiff(remoteUniKeys, localUniKeys), nil
+}
+
 // batchStreamNewItems streams the set of new items to the local registrar in
 // batches and returns the new leaf proofs.
 func (s *SimpleSyncer) batchStreamNewItems(ctx context.Context,
-	uniID Identifier, fetchedLeaves chan *IssuanceItem,
-	numTotal int) ([]*Leaf, error) {
+	uniID Identifier, fetchedLeaves chan *IssuanceItem, batchSize,
+	numTotal int, tracker *syncProgressTracker) ([]*Leaf, error) {
 
 	var (
 		numItems      int
 		newLeafProofs []*Leaf
 	)
 	err := fn.CollectBatch(
-		ctx, fetchedLeaves, s.cfg.SyncBatchSize,
+		ctx, fetchedLeaves, batchSize,
 		func(ctx context.Context, batch []*IssuanceItem) error {
 			numItems += len(batch)
 			log.Infof("UniverseRoot(%v): Inserting %d new leaves "+
@@ -344,6 +864,7 @@ func (s *SimpleSyncer) batchStreamNewItems(ctx context.Context,
 			log.Infof("UniverseRoot(%v): Inserted %d new leaves "+
 				"(%d of %d)", uniID.String(), len(batch),
 				numItems, numTotal)
+			tracker.leavesInserted(uniID, len(batch))
 
 			newLeaves := fn.Map(
 				batch, func(i *IssuanceItem) *Leaf {
@@ -369,6 +890,18 @@ func (s *SimpleSyncer) SyncUniverse(ctx context.Context, host ServerAddr,
 	syncType SyncType, syncConfigs SyncConfigs,
 	idsToSync ...Identifier) ([]AssetSyncDiff, error) {
 
+	return s.SyncUniverseWithProgress(
+		ctx, host, syncType, syncConfigs, nil, idsToSync...,
+	)
+}
+
+// SyncUniverseWithProgress is identical to SyncUniverse, but reports the
+// progress of the sync to the given function as it proceeds.
+func (s *SimpleSyncer) SyncUniverseWithProgress(ctx context.Context,
+	host ServerAddr, syncType SyncType, syncConfigs SyncConfigs,
+	progress SyncProgressFunc, idsToSync ...Identifier) ([]AssetSyncDiff,
+	error) {
+
 	log.Infof("Attempting to sync universe: host=%v, sync_type=%v, ids=%v",
 		host.HostStr(), syncType, spew.Sdump(idsToSync))
 
@@ -382,5 +915,83 @@ func (s *SimpleSyncer) SyncUniverse(ctx context.Context, host ServerAddr,
 
 	// With the engine created, we can now sync the local Universe with the
 	// remote instance.
-	return s.executeSync(ctx, diffEngine, syncType, syncConfigs, idsToSync)
+	return s.executeSync(
+		ctx, diffEngine, host, syncType, syncConfigs, idsToSync,
+		false, progress,
+	)
+}
+
+// FetchSyncDiff computes the diff between the local and the remote universe,
+// governed by the sync type and the set of universe IDs, without inserting any
+// of the new leaves into the local universe.
+func (s *SimpleSyncer) FetchSyncDiff(ctx context.Context, host ServerAddr,
+	syncType SyncType, syncConfigs SyncConfigs,
+	idsToSync ...Identifier) ([]AssetSyncDiff, error) {
+
+	log.Infof("Fetching universe sync diff: host=%v, sync_type=%v, "+
+		"ids=%v", host.HostStr(), syncType, spew.Sdump(idsToSync))
+
+	diffEngine, err := s.cfg.NewRemoteDiffEngine(host)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create remote diff "+
+			"engine: %w", err)
+	}
+
+	return s.executeSync(
+		ctx, diffEngine, host, syncType, syncConfigs, idsToSync,
+		true, nil,
+	)
+}
+
+// MultiverseRoot returns the hash that summarizes the multiverse trees of the
+// remote universe that are covered by a sync of the given type. If the hash
+// didn't change since a previous sync, then a new sync won't find any new
+// leaves.
+func (s *SimpleSyncer) MultiverseRoot(ctx context.Context, host ServerAddr,
+	syncType SyncType) (mssmt.NodeHash, error) {
+
+	diffEngine, err := s.cfg.NewRemoteDiffEngine(host)
+	if err != nil {
+		return mssmt.NodeHash{}, fmt.Errorf("unable to create remote "+
+			"diff engine: %w", err)
+	}
+
+	return syncMultiverseRoot(ctx, diffEngine, syncType)
+}
+
+// syncMultiverseRoot returns the hash that summarizes the multiverse trees of
+// the given diff engine that are covered by a sync of the given type. For an
+// issuance sync, this is the root hash of the issuance multiverse tree. For all
+// other syncs, it's the SHA256 hash of the root hashes of the issuance and the
+// transfer multiverse trees, so a change to either tree changes the hash.
+func syncMultiverseRoot(ctx context.Context, diffEngine DiffEngine,
+	syncType SyncType) (mssmt.NodeHash, error) {
+
+	issuanceRoot, err := diffEngine.MultiverseRoot(ctx, ProofTypeIssuance)
+	if err != nil {
+		return mssmt.NodeHash{}, fmt.Errorf("unable to fetch issuance "+
+			"multiverse root: %w", err)
+	}
+
+	if syncType == SyncIssuance {
+		return issuanceRoot.NodeHash(), nil
+	}
+
+	transferRoot, err := diffEngine.MultiverseRoot(ctx, ProofTypeTransfer)
+	if err != nil {
+		return mssmt.NodeHash{}, fmt.Errorf("unable to fetch transfer "+
+			"multiverse root: %w", err)
+	}
+
+	issuanceHash := issuanceRoot.NodeHash()
+	transferHash := transferRoot.NodeHash()
+
+	h := sha256.New()
+	h.Write(issuanceHash[:])
+	h.Write(transferHash[:])
+
+	var rootHash mssmt.NodeHash
+	copy(rootHash[:], h.Sum(nil))
+
+	return rootHash, nil
 }
@@ -0,0 +1,1144 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// mockDiffEngine is a mock implementation of the DiffEngine interface that
+// serves a static set of roots.
+type mockDiffEngine struct {
+	roots map[Identifier]BaseRoot
+
+	// leafKeys is the set of leaf keys known for each universe.
+	leafKeys map[Identifier][]LeafKey
+
+	// leafKeyErrs is a set of universe IDs for which the call to
+	// UniverseLeafKeys will fail.
+	leafKeyErrs map[Identifier]error
+
+	// keysSince is the set of leaf keys inserted since a given root, for
+	// each universe. A universe without an entry is treated as not
+	// knowing the given root.
+	keysSince map[Identifier][]LeafKey
+
+	// proofs is the set of proofs served for each leaf, keyed by the
+	// universe key of the leaf. If a leaf doesn't have a proof, then an
+	// empty proof is served.
+	proofs map[[32]byte]*Proof
+
+	// multiverseRoots is the multiverse root served for each proof type.
+	// If a proof type doesn't have a root, then the empty tree root is
+	// served.
+	multiverseRoots map[ProofType]mssmt.Node
+
+	// rootsQuery is the query of the last call to RootNodes. The query is
+	// only recorded and not applied, to mimic a server that doesn't know
+	// about root queries.
+	rootsQuery *RootNodesQuery
+}
+
+// newMockDiffEngine creates a new mock diff engine serving the given roots.
+func newMockDiffEngine(roots ...BaseRoot) *mockDiffEngine {
+	m := &mockDiffEngine{
+		roots:       make(map[Identifier]BaseRoot),
+		leafKeys:    make(map[Identifier][]LeafKey),
+		leafKeyErrs: make(map[Identifier]error),
+		keysSince:   make(map[Identifier][]LeafKey),
+		proofs:      make(map[[32]byte]*Proof),
+
+		multiverseRoots: make(map[ProofType]mssmt.Node),
+	}
+	for _, r := range roots {
+		m.roots[r.ID] = r
+	}
+
+	return m
+}
+
+func (m *mockDiffEngine) RootNode(_ context.Context,
+	id Identifier) (BaseRoot, error) {
+
+	root, ok := m.roots[id]
+	if !ok {
+		return BaseRoot{}, ErrNoUniverseRoot
+	}
+
+	return root, nil
+}
+
+func (m *mockDiffEngine) RootNodes(_ context.Context,
+	q RootNodesQuery) ([]BaseRoot, error) {
+
+	m.rootsQuery = &q
+
+	roots := make([]BaseRoot, 0, len(m.roots))
+	for _, r := range m.roots {
+		roots = append(roots, r)
+	}
+
+	return roots, nil
+}
+
+func (m *mockDiffEngine) MultiverseRoot(_ context.Context,
+	proofType ProofType) (*MultiverseRoot, error) {
+
+	root, ok := m.multiverseRoots[proofType]
+	if !ok {
+		root = mssmt.NewComputedBranch(mssmt.EmptyTreeRootHash, 0)
+	}
+
+	return &MultiverseRoot{
+		ProofType: proofType,
+		Node:      root,
+	}, nil
+}
+
+func (m *mockDiffEngine) UniverseLeafKeys(_ context.Context,
+	id Identifier) ([]LeafKey, error) {
+
+	if err, ok := m.leafKeyErrs[id]; ok {
+		return nil, err
+	}
+
+	return m.leafKeys[id], nil
+}
+
+func (m *mockDiffEngine) UniverseLeafKeysSince(_ context.Context,
+	id Identifier, _ mssmt.NodeHash) ([]LeafKey, error) {
+
+	keys, ok := m.keysSince[id]
+	if !ok {
+		return nil, ErrUnknownUniverseRoot
+	}
+
+	return keys, nil
+}
+
+func (m *mockDiffEngine) FetchIssuanceProof(_ context.Context, id Identifier,
+	key LeafKey) ([]*Proof, error) {
+
+	for _, leafKey := range m.leafKeys[id] {
+		if leafKey.UniverseKey() != key.UniverseKey() {
+			continue
+		}
+
+		if uniProof, ok := m.proofs[key.UniverseKey()]; ok {
+			return []*Proof{uniProof}, nil
+		}
+
+		return []*Proof{{
+			LeafKey: key,
+			Leaf:    &Leaf{},
+		}}, nil
+	}
+
+	return nil, ErrNoUniverseProofFound
+}
+
+// mockBatchRegistrar is a mock implementation of the BatchRegistrar interface
+// that accepts any batch of new leaves.
+type mockBatchRegistrar struct {
+	Registrar
+
+	registeredItems []*IssuanceItem
+
+	mtx sync.Mutex
+}
+
+func (m *mockBatchRegistrar) RegisterNewIssuanceBatch(_ context.Context,
+	items []*IssuanceItem) error {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.registeredItems = append(m.registeredItems, items...)
+
+	return nil
+}
+
+// randBaseRoot returns a random issuance root for a random asset ID.
+func randBaseRoot(t *testing.T) BaseRoot {
+	return BaseRoot{
+		ID: Identifier{
+			AssetID:   asset.RandID(t),
+			ProofType: ProofTypeIssuance,
+		},
+		Node: mssmt.NewComputedBranch(
+			mssmt.NodeHash(asset.RandID(t)), 1,
+		),
+	}
+}
+
+// randBaseRootWithID returns a random root for the given universe ID.
+func randBaseRootWithID(t *testing.T, id Identifier) BaseRoot {
+	root := randBaseRoot(t)
+	root.ID = id
+
+	return root
+}
+
+// TestSimpleSyncerPartialFailure tests that a failure to sync a single root
+// doesn't abort the sync of the remaining roots, and that the failure is
+// reported as part of the returned diff.
+func TestSimpleSyncerPartialFailure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	syncedRoot := randBaseRoot(t)
+	failedRoot := randBaseRoot(t)
+
+	// The local Universe already knows about the first root, so no sync is
+	// needed for that one.
+	localEngine := newMockDiffEngine(syncedRoot)
+
+	// The remote Universe fails to serve the leaf keys of the second root.
+	remoteEngine := newMockDiffEngine(syncedRoot, failedRoot)
+	remoteEngine.leafKeyErrs[failedRoot.ID] = fmt.Errorf("remote failure")
+
+	syncer := NewSimpleSyncer(SimpleSyncCfg{
+		LocalDiffEngine: localEngine,
+		SyncBatchSize:   10,
+	})
+
+	syncConfigs := SyncConfigs{
+		GlobalSyncConfigs: []*FedGlobalSyncConfig{{
+			ProofType:       ProofTypeIssuance,
+			AllowSyncInsert: true,
+		}},
+	}
+	diffs, err := syncer.executeSync(
+		ctx, remoteEngine, ServerAddr{}, SyncIssuance, syncConfigs,
+		nil, false, nil,
+	)
+	require.NoError(t, err)
+
+	// We expect a single failed diff for the second root.
+	require.Len(t, diffs, 1)
+	require.True(t, diffs[0].Failed())
+	require.Equal(t, failedRoot.ID, diffs[0].NewUniverseRoot.ID)
+	require.ErrorContains(t, diffs[0].SyncErr, "remote failure")
+}
+
+// TestSimpleSyncerUnknownTarget tests that syncing a specific set of roots
+// returns a failed diff for any root the remote Universe doesn't know about.
+func TestSimpleSyncerUnknownTarget(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	knownRoot := randBaseRoot(t)
+	unknownRoot := randBaseRoot(t)
+
+	localEngine := newMockDiffEngine(knownRoot)
+	remoteEngine := newMockDiffEngine(knownRoot)
+
+	syncer := NewSimpleSyncer(SimpleSyncCfg{
+		LocalDiffEngine: localEngine,
+		SyncBatchSize:   10,
+	})
+
+	syncConfigs := SyncConfigs{
+		GlobalSyncConfigs: []*FedGlobalSyncConfig{{
+			ProofType:       ProofTypeIssuance,
+			AllowSyncInsert: true,
+		}},
+	}
+	diffs, err := syncer.executeSync(
+		ctx, remoteEngine, ServerAddr{}, SyncIssuance, syncConfigs,
+		[]Identifier{knownRoot.ID, unknownRoot.ID}, false, nil,
+	)
+	require.NoError(t, err)
+
+	// The known root is already in sync, so we only expect a single
+	// failed diff for the unknown root.
+	require.Len(t, diffs, 1)
+	require.True(t, diffs[0].Failed())
+	require.Equal(t, unknownRoot.ID, diffs[0].NewUniverseRoot.ID)
+	require.ErrorIs(t, diffs[0].SyncErr, ErrNoUniverseRoot)
+}
+
+// TestSimpleSyncerDelta tests that a delta sync only fetches the keys inserted
+// since the local root, and falls back to a full diff if the remote Universe
+// doesn't know the local root.
+func TestSimpleSyncerDelta(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// The local Universe knows about both roots, but at an older state
+	// than the remote Universe.
+	deltaRoot := randBaseRoot(t)
+	fallbackRoot := randBaseRoot(t)
+	localEngine := newMockDiffEngine(
+		randBaseRootWithID(t, deltaRoot.ID),
+		randBaseRootWithID(t, fallbackRoot.ID),
+	)
+
+	// The remote Universe knows the local root of the first universe, but
+	// not the one of the second universe. Fetching the full set of keys
+	// of the first universe fails, so a sync of that universe only
+	// succeeds if the delta is used.
+	remoteEngine := newMockDiffEngine(deltaRoot, fallbackRoot)
+	remoteEngine.keysSince[deltaRoot.ID] = nil
+	remoteEngine.leafKeyErrs[deltaRoot.ID] = fmt.Errorf("full diff")
+
+	syncer := NewSimpleSyncer(SimpleSyncCfg{
+		LocalDiffEngine: localEngine,
+		LocalRegistrar:  &mockBatchRegistrar{},
+		SyncBatchSize:   10,
+	})
+
+	syncConfigs := SyncConfigs{
+		GlobalSyncConfigs: []*FedGlobalSyncConfig{{
+			ProofType:       ProofTypeIssuance,
+			AllowSyncInsert: true,
+		}},
+	}
+	diffs, err := syncer.executeSync(
+		ctx, remoteEngine, ServerAddr{}, SyncDelta, syncConfigs,
+		nil, false, nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, diffs, 2)
+
+	diffsByID := make(map[Identifier]AssetSyncDiff, len(diffs))
+	for _, diff := range diffs {
+		diffsByID[diff.NewUniverseRoot.ID] = diff
+	}
+
+	deltaDiff := diffsByID[deltaRoot.ID]
+	require.False(t, deltaDiff.Failed())
+	require.False(t, deltaDiff.DeltaFallback)
+
+	fallbackDiff := diffsByID[fallbackRoot.ID]
+	require.False(t, fallbackDiff.Failed())
+	require.True(t, fallbackDiff.DeltaFallback)
+
+	// A full sync of the same roots always performs a full diff, so the
+	// sync of the first universe fails.
+	diffs, err = syncer.executeSync(
+		ctx, remoteEngine, ServerAddr{}, SyncFull, syncConfigs,
+		[]Identifier{deltaRoot.ID}, false, nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	require.True(t, diffs[0].Failed())
+	require.ErrorContains(t, diffs[0].SyncErr, "full diff")
+}
+
+// newMockDiffEngineWithProofs creates a new mock diff engine that serves the
+// given archived universe, including valid inclusion proofs for its leaves.
+func newMockDiffEngineWithProofs(t *testing.T,
+	uni ArchiveUniverse) *mockDiffEngine {
+
+	ctx := context.Background()
+
+	tree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+	for idx := range uni.Leaves {
+		leaf := &uni.Leaves[idx]
+
+		leafNode, err := leaf.newLeaf().SmtLeafNode()
+		require.NoError(t, err)
+
+		_, err = tree.Insert(ctx, leaf.Key.UniverseKey(), leafNode)
+		require.NoError(t, err)
+	}
+
+	// Like a remote Universe, we'll only serve the computed root instead
+	// of the full tree root. We use the root of the tree we just built, so
+	// tests can modify the leaves of the universe before creating the
+	// engine.
+	treeRoot, err := tree.Root(ctx)
+	require.NoError(t, err)
+	root := mssmt.NewComputedBranch(
+		treeRoot.NodeHash(), treeRoot.NodeSum(),
+	)
+
+	m := newMockDiffEngine(BaseRoot{
+		ID:   uni.ID,
+		Node: root,
+	})
+	for idx := range uni.Leaves {
+		leaf := &uni.Leaves[idx]
+
+		inclusionProof, err := tree.MerkleProof(
+			ctx, leaf.Key.UniverseKey(),
+		)
+		require.NoError(t, err)
+
+		m.leafKeys[uni.ID] = append(m.leafKeys[uni.ID], leaf.Key)
+		m.proofs[leaf.Key.UniverseKey()] = &Proof{
+			Leaf:                   leaf.newLeaf(),
+			LeafKey:                leaf.Key,
+			UniverseRoot:           root,
+			UniverseInclusionProof: inclusionProof,
+		}
+	}
+
+	return m
+}
+
+// TestSimpleSyncerDryRun tests that a dry run returns the same diff as a
+// normal sync, without inserting any of the new leaves into the local
+// Universe.
+func TestSimpleSyncerDryRun(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	const numLeaves = 3
+	uni := randArchiveUniverse(t, numLeaves, false)
+
+	localEngine := newMockDiffEngine()
+	remoteEngine := newMockDiffEngineWithProofs(t, uni)
+	registrar := &mockBatchRegistrar{}
+
+	syncer := NewSimpleSyncer(SimpleSyncCfg{
+		LocalDiffEngine: localEngine,
+		LocalRegistrar:  registrar,
+		SyncBatchSize:   10,
+	})
+
+	syncConfigs := SyncConfigs{
+		GlobalSyncConfigs: []*FedGlobalSyncConfig{{
+			ProofType:       ProofTypeIssuance,
+			AllowSyncInsert: true,
+		}},
+	}
+
+	// A dry run should find all the new leaves, without inserting them.
+	dryRunDiffs, err := syncer.executeSync(
+		ctx, remoteEngine, ServerAddr{}, SyncIssuance, syncConfigs,
+		nil, true, nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, dryRunDiffs, 1)
+	require.False(t, dryRunDiffs[0].Failed())
+	require.Len(t, dryRunDiffs[0].NewLeafProofs, numLeaves)
+	require.EqualValues(t, numLeaves, dryRunDiffs[0].NumIssuanceLeaves)
+	require.Empty(t, registrar.registeredItems)
+
+	// A normal sync should result in the same diff, but also insert the
+	// new leaves.
+	diffs, err := syncer.executeSync(
+		ctx, remoteEngine, ServerAddr{}, SyncIssuance, syncConfigs,
+		nil, false, nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	require.Equal(
+		t, dryRunDiffs[0].NewUniverseRoot, diffs[0].NewUniverseRoot,
+	)
+	require.ElementsMatch(
+		t, dryRunDiffs[0].NewLeafProofs, diffs[0].NewLeafProofs,
+	)
+	require.Len(t, registrar.registeredItems, numLeaves)
+}
+
+// TestSimpleSyncerMinConfs tests that leaves with an anchor that doesn't have
+// the minimum number of confirmations are skipped during a sync, and that the
+// minimum can be overridden for a single sync.
+func TestSimpleSyncerMinConfs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	const (
+		numLeaves     = 3
+		minConfs      = 3
+		currentHeight = 105
+	)
+
+	// The anchors of the leaves have 6, 3 and 1 confirmations, so only the
+	// last one is too shallow.
+	uni := randArchiveUniverse(t, numLeaves, false)
+	for idx, height := range []uint32{100, 103, 105} {
+		uni.Leaves[idx].Proof.BlockHeight = height
+	}
+
+	remoteEngine := newMockDiffEngineWithProofs(t, uni)
+	registrar := &mockBatchRegistrar{}
+
+	syncer := NewSimpleSyncer(SimpleSyncCfg{
+		LocalDiffEngine: newMockDiffEngine(),
+		LocalRegistrar:  registrar,
+		SyncBatchSize:   10,
+		MinConfs:        minConfs,
+		ChainHeight: func(context.Context) (uint32, error) {
+			return currentHeight, nil
+		},
+	})
+
+	syncConfigs := SyncConfigs{
+		GlobalSyncConfigs: []*FedGlobalSyncConfig{{
+			ProofType:       ProofTypeIssuance,
+			AllowSyncInsert: true,
+		}},
+	}
+	diffs, err := syncer.executeSync(
+		ctx, remoteEngine, ServerAddr{}, SyncIssuance, syncConfigs,
+		nil, false, nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	require.False(t, diffs[0].Failed())
+	require.Len(t, diffs[0].NewLeafProofs, numLeaves-1)
+	require.EqualValues(t, 1, diffs[0].NumShallowLeaves)
+	require.Len(t, registrar.registeredItems, numLeaves-1)
+
+	for _, leaf := range diffs[0].NewLeafProofs {
+		require.NotEqualValues(
+			t, currentHeight, leaf.Proof.BlockHeight,
+		)
+	}
+
+	// If we lower the minimum for a single sync, the shallow leaf is
+	// synced as well.
+	syncConfigs.MinConfs = 1
+	diffs, err = syncer.executeSync(
+		ctx, remoteEngine, ServerAddr{}, SyncIssuance, syncConfigs,
+		nil, true, nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	require.Len(t, diffs[0].NewLeafProofs, numLeaves)
+	require.Zero(t, diffs[0].NumShallowLeaves)
+}
+
+// TestSimpleSyncerIDPrefix tests that a sync restricted to an ID prefix passes
+// the prefix on to the remote Universe and only syncs the matching roots, even
+// if the remote returns all of its roots.
+func TestSimpleSyncerIDPrefix(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	matchingRoot := randBaseRoot(t)
+	matchingRoot.ID.AssetID[0] = 0xab
+	otherRoot := randBaseRoot(t)
+	otherRoot.ID.AssetID[0] = 0xcd
+
+	// We make the remote fail to serve the leaf keys of both roots, so
+	// each root the syncer attempts to sync shows up as a failed diff.
+	remoteEngine := newMockDiffEngine(matchingRoot, otherRoot)
+	for _, root := range []BaseRoot{matchingRoot, otherRoot} {
+		remoteEngine.leafKeyErrs[root.ID] = fmt.Errorf("remote failure")
+	}
+
+	syncer := NewSimpleSyncer(SimpleSyncCfg{
+		LocalDiffEngine: newMockDiffEngine(),
+		SyncBatchSize:   10,
+	})
+
+	syncConfigs := SyncConfigs{
+		GlobalSyncConfigs: []*FedGlobalSyncConfig{{
+			ProofType:       ProofTypeIssuance,
+			AllowSyncInsert: true,
+		}},
+		IDPrefix: "ab",
+	}
+	diffs, err := syncer.executeSync(
+		ctx, remoteEngine, ServerAddr{}, SyncIssuance, syncConfigs,
+		nil, false, nil,
+	)
+	require.NoError(t, err)
+
+	require.NotNil(t, remoteEngine.rootsQuery)
+	require.Equal(t, "ab", remoteEngine.rootsQuery.IDPrefix)
+
+	require.Len(t, diffs, 1)
+	require.Equal(t, matchingRoot.ID, diffs[0].NewUniverseRoot.ID)
+
+	// An invalid prefix is rejected before anything is synced.
+	syncConfigs.IDPrefix = "AB"
+	_, err = syncer.executeSync(
+		ctx, remoteEngine, ServerAddr{}, SyncIssuance, syncConfigs,
+		nil, false, nil,
+	)
+	require.ErrorContains(t, err, "lower case hex")
+}
+
+// TestSimpleSyncerProgress tests that the progress of a sync is reported after
+// each inserted batch of leaves and once the sync of a Universe is finished.
+func TestSimpleSyncerProgress(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	const numLeaves = 5
+	uni := randArchiveUniverse(t, numLeaves, false)
+
+	localEngine := newMockDiffEngine()
+	remoteEngine := newMockDiffEngineWithProofs(t, uni)
+	registrar := &mockBatchRegistrar{}
+
+	syncer := NewSimpleSyncer(SimpleSyncCfg{
+		LocalDiffEngine: localEngine,
+		LocalRegistrar:  registrar,
+		SyncBatchSize:   2,
+	})
+
+	syncConfigs := SyncConfigs{
+		GlobalSyncConfigs: []*FedGlobalSyncConfig{{
+			ProofType:       ProofTypeIssuance,
+			AllowSyncInsert: true,
+		}},
+	}
+
+	var updates []SyncProgress
+	_, err := syncer.executeSync(
+		ctx, remoteEngine, ServerAddr{}, SyncIssuance, syncConfigs,
+		nil, false, func(progress SyncProgress) {
+			updates = append(updates, progress)
+		},
+	)
+	require.NoError(t, err)
+
+	// We expect an initial update, one for each of the three batches and a
+	// final one once the Universe is synced.
+	require.Len(t, updates, 5)
+
+	require.Equal(t, SyncProgress{NumUniverses: 1}, updates[0])
+	for idx, numInserted := range []int{2, 4, 5} {
+		update := updates[idx+1]
+		require.Equal(t, numInserted, update.NumLeavesInserted)
+		require.Zero(t, update.NumUniversesDone)
+		require.Equal(t, uni.ID, *update.CurrentID)
+	}
+
+	final := updates[4]
+	require.Equal(t, 1, final.NumUniverses)
+	require.Equal(t, 1, final.NumUniversesDone)
+	require.Equal(t, numLeaves, final.NumLeavesInserted)
+	require.Equal(t, uni.ID, *final.CurrentID)
+}
+
+// countingDiffEngine is a mock diff engine that keeps track of the maximum
+// number of proofs that were fetched concurrently.
+type countingDiffEngine struct {
+	*mockDiffEngine
+
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func (c *countingDiffEngine) FetchIssuanceProof(ctx context.Context,
+	id Identifier, key LeafKey) ([]*Proof, error) {
+
+	numInFlight := c.inFlight.Add(1)
+	defer c.inFlight.Add(-1)
+
+	for {
+		maxInFlight := c.maxInFlight.Load()
+		if numInFlight <= maxInFlight ||
+			c.maxInFlight.CompareAndSwap(maxInFlight, numInFlight) {
+
+			break
+		}
+	}
+
+	// We hold on to the fetch for a bit, so concurrent fetches actually
+	// overlap.
+	time.Sleep(10 * time.Millisecond)
+
+	return c.mockDiffEngine.FetchIssuanceProof(ctx, id, key)
+}
+
+// TestSimpleSyncerBatchSize tests that the new leaves of a Universe are
+// fetched and inserted in batches of the configured size.
+func TestSimpleSyncerBatchSize(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	const (
+		numLeaves = 7
+		batchSize = 3
+	)
+	uni := randArchiveUniverse(t, numLeaves, false)
+
+	remoteEngine := &countingDiffEngine{
+		mockDiffEngine: newMockDiffEngineWithProofs(t, uni),
+	}
+	registrar := &mockBatchRegistrar{}
+
+	syncer := NewSimpleSyncer(SimpleSyncCfg{
+		LocalDiffEngine: newMockDiffEngine(),
+		LocalRegistrar:  registrar,
+		SyncBatchSize:   batchSize,
+	})
+
+	syncConfigs := SyncConfigs{
+		GlobalSyncConfigs: []*FedGlobalSyncConfig{{
+			ProofType:       ProofTypeIssuance,
+			AllowSyncInsert: true,
+		}},
+	}
+
+	var updates []SyncProgress
+	diffs, err := syncer.executeSync(
+		ctx, remoteEngine, ServerAddr{}, SyncIssuance, syncConfigs,
+		nil, false, func(progress SyncProgress) {
+			updates = append(updates, progress)
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	require.Len(t, diffs[0].NewLeafProofs, numLeaves)
+	require.Len(t, registrar.registeredItems, numLeaves)
+
+	// We should never have fetched more than a single batch at once.
+	require.LessOrEqual(
+		t, remoteEngine.maxInFlight.Load(), int32(batchSize),
+	)
+
+	// The leaves should've been inserted in three batches, with an
+	// initial and a final progress update around them.
+	require.Len(t, updates, 5)
+	for idx, numInserted := range []int{3, 6, 7} {
+		require.Equal(
+			t, numInserted, updates[idx+1].NumLeavesInserted,
+		)
+	}
+}
+
+// TestSyncMultiverseRoot tests that the multiverse root of a sync only covers
+// the multiverse trees of the sync type.
+func TestSyncMultiverseRoot(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	remoteEngine := newMockDiffEngine()
+	remoteEngine.multiverseRoots[ProofTypeIssuance] = randMultiverseRoot(t)
+	remoteEngine.multiverseRoots[ProofTypeTransfer] = randMultiverseRoot(t)
+
+	issuanceRoot, err := syncMultiverseRoot(ctx, remoteEngine, SyncIssuance)
+	require.NoError(t, err)
+	require.Equal(
+		t, remoteEngine.multiverseRoots[ProofTypeIssuance].NodeHash(),
+		issuanceRoot,
+	)
+
+	fullRoot, err := syncMultiverseRoot(ctx, remoteEngine, SyncFull)
+	require.NoError(t, err)
+	require.NotEqual(t, issuanceRoot, fullRoot)
+
+	// A change to the transfer multiverse is only picked up by a full
+	// sync.
+	remoteEngine.multiverseRoots[ProofTypeTransfer] = randMultiverseRoot(t)
+
+	newIssuanceRoot, err := syncMultiverseRoot(
+		ctx, remoteEngine, SyncIssuance,
+	)
+	require.NoError(t, err)
+	require.Equal(t, issuanceRoot, newIssuanceRoot)
+
+	newFullRoot, err := syncMultiverseRoot(ctx, remoteEngine, SyncFull)
+	require.NoError(t, err)
+	require.NotEqual(t, fullRoot, newFullRoot)
+
+	// The root is stable as long as neither multiverse changes.
+	deltaRoot, err := syncMultiverseRoot(ctx, remoteEngine, SyncDelta)
+	require.NoError(t, err)
+	require.Equal(t, newFullRoot, deltaRoot)
+}
+
+// TestProofChainDepth tests that the depth of the longest proof chain formed
+// by a set of transfer leaves is computed correctly, regardless of the order
+// of the leaves.
+func TestProofChainDepth(t *testing.T) {
+	t.Parallel()
+
+	// We'll create a chain of four leaves, starting with a leaf that
+	// spends an asset that isn't part of the leaves, and an unrelated leaf
+	// that starts a chain of its own.
+	first := batchItemSpending(t, nil)
+	first.Leaf.Proof.Asset.PrevWitnesses[0].PrevID.OutPoint.Index = 1
+	second := batchItemSpending(t, &first.Key)
+	third := batchItemSpending(t, &second.Key)
+	fourth := batchItemSpending(t, &third.Key)
+	unrelated := batchItemSpending(t, &LeafKey{
+		OutPoint:  first.Key.OutPoint,
+		ScriptKey: third.Key.ScriptKey,
+	})
+
+	depth, deepest := proofChainDepth([]*IssuanceItem{
+		third, unrelated, fourth, first, second,
+	})
+	require.Equal(t, 4, depth)
+	require.Equal(t, fourth, deepest)
+
+	depth, deepest = proofChainDepth([]*IssuanceItem{unrelated})
+	require.Equal(t, 1, depth)
+	require.Equal(t, unrelated, deepest)
+
+	// Leaves that spend each other form a cycle, which must not result in
+	// an endless loop.
+	loopA := batchItemSpending(t, &fourth.Key)
+	loopB := batchItemSpending(t, &loopA.Key)
+	loopA.Leaf.Proof.Asset.PrevWitnesses[0].PrevID.OutPoint =
+		loopB.Key.OutPoint
+	loopA.Leaf.Proof.Asset.PrevWitnesses[0].PrevID.ScriptKey =
+		asset.ToSerialized(loopB.Key.ScriptKey.PubKey)
+
+	depth, _ = proofChainDepth([]*IssuanceItem{loopA, loopB})
+	require.LessOrEqual(t, depth, 3)
+}
+
+// mockLazyRootStore is a mock implementation of the LazyRootStore interface
+// that keeps the lazy roots in memory.
+type mockLazyRootStore struct {
+	roots map[string]LazyRoot
+
+	mtx sync.Mutex
+}
+
+func newMockLazyRootStore() *mockLazyRootStore {
+	return &mockLazyRootStore{
+		roots: make(map[string]LazyRoot),
+	}
+}
+
+func (m *mockLazyRootStore) UpsertLazyRoot(_ context.Context,
+	root LazyRoot) error {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	root.Lazy = true
+	m.roots[root.ID.String()] = root
+
+	return nil
+}
+
+func (m *mockLazyRootStore) FetchLazyRoot(_ context.Context,
+	id Identifier) (*LazyRoot, error) {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	root, ok := m.roots[id.String()]
+	if !ok {
+		return nil, ErrNoUniverseRoot
+	}
+
+	return &root, nil
+}
+
+func (m *mockLazyRootStore) LazyRoots(_ context.Context) ([]LazyRoot, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	roots := make([]LazyRoot, 0, len(m.roots))
+	for _, root := range m.roots {
+		roots = append(roots, root)
+	}
+
+	return roots, nil
+}
+
+func (m *mockLazyRootStore) DeleteLazyRoot(_ context.Context,
+	id Identifier) error {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	delete(m.roots, id.String())
+
+	return nil
+}
+
+// TestSimpleSyncerRootsOnly tests that a roots only sync only imports the
+// roots of the remote Universe as lazy roots, and that a later full sync
+// replaces a lazy root with the actual leaves.
+func TestSimpleSyncerRootsOnly(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	const numLeaves = 3
+	uni := randArchiveUniverse(t, numLeaves, false)
+	syncedRoot := randBaseRoot(t)
+
+	localEngine := newMockDiffEngine(syncedRoot)
+	remoteEngine := newMockDiffEngineWithProofs(t, uni)
+	remoteEngine.roots[syncedRoot.ID] = syncedRoot
+	remoteRoot := remoteEngine.roots[uni.ID]
+
+	registrar := &mockBatchRegistrar{}
+	lazyRoots := newMockLazyRootStore()
+
+	syncConfigs := SyncConfigs{
+		GlobalSyncConfigs: []*FedGlobalSyncConfig{{
+			ProofType:       ProofTypeIssuance,
+			AllowSyncInsert: true,
+		}},
+	}
+	source := NewServerAddrFromStr("universe.example.com:10029")
+
+	// Without a lazy root store, roots only syncs aren't supported.
+	syncer := NewSimpleSyncer(SimpleSyncCfg{
+		LocalDiffEngine: localEngine,
+		LocalRegistrar:  registrar,
+		SyncBatchSize:   10,
+	})
+	_, err := syncer.executeSync(
+		ctx, remoteEngine, source, SyncRootsOnly, syncConfigs, nil,
+		false, nil,
+	)
+	require.ErrorIs(t, err, ErrUnsupportedSync)
+
+	syncer = NewSimpleSyncer(SimpleSyncCfg{
+		LocalDiffEngine: localEngine,
+		LocalRegistrar:  registrar,
+		SyncBatchSize:   10,
+		LazyRoots:       lazyRoots,
+	})
+
+	// A dry run reports the new lazy root without storing it.
+	diffs, err := syncer.executeSync(
+		ctx, remoteEngine, source, SyncRootsOnly, syncConfigs, nil,
+		true, nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	require.True(t, diffs[0].NewUniverseRoot.Lazy)
+	require.Empty(t, lazyRoots.roots)
+
+	// The root we already know is skipped, while the new root is only
+	// stored as a lazy root, without fetching any of its leaves.
+	diffs, err = syncer.executeSync(
+		ctx, remoteEngine, source, SyncRootsOnly, syncConfigs, nil,
+		false, nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	require.False(t, diffs[0].Failed())
+	require.True(t, diffs[0].NewUniverseRoot.Lazy)
+	require.Empty(t, diffs[0].NewLeafProofs)
+	require.Empty(t, registrar.registeredItems)
+
+	lazyRoot, err := lazyRoots.FetchLazyRoot(ctx, uni.ID)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(remoteRoot, lazyRoot))
+	require.Equal(t, source.HostStr(), lazyRoot.SourceHost)
+
+	// The local Universe now reports the lazy root. A full sync must still
+	// fetch all the leaves, even though the roots match, and then remove
+	// the lazy root.
+	localEngine.roots[uni.ID] = lazyRoot.BaseRoot
+
+	diffs, err = syncer.executeSync(
+		ctx, remoteEngine, source, SyncFull, syncConfigs, nil, false,
+		nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	require.False(t, diffs[0].NewUniverseRoot.Lazy)
+	require.Len(t, diffs[0].NewLeafProofs, numLeaves)
+	require.Len(t, registrar.registeredItems, numLeaves)
+
+	_, err = lazyRoots.FetchLazyRoot(ctx, uni.ID)
+	require.ErrorIs(t, err, ErrNoUniverseRoot)
+}
+
+// mockSyncCheckpointStore is a mock implementation of the SyncCheckpointStore
+// interface that keeps the checkpoints in memory.
+type mockSyncCheckpointStore struct {
+	checkpoints map[string]fn.Set[string]
+
+	// marked is the list of all universes that were ever marked as synced,
+	// which isn't affected by clearing a checkpoint.
+	marked []Identifier
+
+	mtx sync.Mutex
+}
+
+func newMockSyncCheckpointStore() *mockSyncCheckpointStore {
+	return &mockSyncCheckpointStore{
+		checkpoints: make(map[string]fn.Set[string]),
+	}
+}
+
+func checkpointKey(server ServerAddr, syncType SyncType) string {
+	return server.HostStr() + "/" + syncType.String()
+}
+
+func (m *mockSyncCheckpointStore) MarkUniverseSynced(_ context.Context,
+	server ServerAddr, syncType SyncType, id Identifier) error {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	key := checkpointKey(server, syncType)
+	if _, ok := m.checkpoints[key]; !ok {
+		m.checkpoints[key] = fn.NewSet[string]()
+	}
+	m.checkpoints[key].Add(id.String())
+	m.marked = append(m.marked, id)
+
+	return nil
+}
+
+func (m *mockSyncCheckpointStore) SyncCheckpoint(_ context.Context,
+	server ServerAddr, syncType SyncType) (fn.Set[string], error) {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	checkpoint := fn.NewSet[string]()
+	for namespace := range m.checkpoints[checkpointKey(server, syncType)] {
+		checkpoint.Add(namespace)
+	}
+
+	return checkpoint, nil
+}
+
+func (m *mockSyncCheckpointStore) ClearSyncCheckpoint(_ context.Context,
+	server ServerAddr, syncType SyncType) error {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	delete(m.checkpoints, checkpointKey(server, syncType))
+
+	return nil
+}
+
+// TestSimpleSyncerResume tests that a resumed sync skips the universes in the
+// checkpoint of a previous, interrupted sync, and that the checkpoint is
+// removed once a sync finishes.
+func TestSimpleSyncerResume(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	const numLeaves = 3
+	uni := randArchiveUniverse(t, numLeaves, false)
+	checkpointedRoot := randBaseRoot(t)
+
+	// The remote Universe fails to serve the leaf keys of the root that is
+	// in the checkpoint, so we can tell whether it was synced again.
+	remoteEngine := newMockDiffEngineWithProofs(t, uni)
+	remoteEngine.roots[checkpointedRoot.ID] = checkpointedRoot
+	remoteEngine.leafKeyErrs[checkpointedRoot.ID] = fmt.Errorf(
+		"remote failure",
+	)
+
+	syncConfigs := SyncConfigs{
+		GlobalSyncConfigs: []*FedGlobalSyncConfig{{
+			ProofType:       ProofTypeIssuance,
+			AllowSyncInsert: true,
+		}},
+		ResumeSync: true,
+	}
+	source := NewServerAddrFromStr("universe.example.com:10029")
+
+	// Without a checkpoint store, syncs can't be resumed.
+	syncer := NewSimpleSyncer(SimpleSyncCfg{
+		LocalDiffEngine: newMockDiffEngine(),
+		LocalRegistrar:  &mockBatchRegistrar{},
+		SyncBatchSize:   10,
+	})
+	_, err := syncer.executeSync(
+		ctx, remoteEngine, source, SyncIssuance, syncConfigs, nil,
+		false, nil,
+	)
+	require.ErrorIs(t, err, ErrUnsupportedSync)
+
+	newSyncer := func(checkpoints SyncCheckpointStore) (*SimpleSyncer,
+		*mockBatchRegistrar) {
+
+		registrar := &mockBatchRegistrar{}
+		return NewSimpleSyncer(SimpleSyncCfg{
+			LocalDiffEngine: newMockDiffEngine(),
+			LocalRegistrar:  registrar,
+			SyncBatchSize:   10,
+			Checkpoints:     checkpoints,
+		}), registrar
+	}
+
+	// We simulate an interrupted sync that already synced one of the
+	// roots. Resuming the sync only syncs the remaining root.
+	checkpoints := newMockSyncCheckpointStore()
+	err = checkpoints.MarkUniverseSynced(
+		ctx, source, SyncIssuance, checkpointedRoot.ID,
+	)
+	require.NoError(t, err)
+
+	syncer, registrar := newSyncer(checkpoints)
+	diffs, err := syncer.executeSync(
+		ctx, remoteEngine, source, SyncIssuance, syncConfigs, nil,
+		false, nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	require.False(t, diffs[0].Failed())
+	require.Equal(t, uni.ID, diffs[0].NewUniverseRoot.ID)
+	require.Len(t, registrar.registeredItems, numLeaves)
+
+	// The synced root was added to the checkpoint, which was then removed
+	// as the sync finished.
+	require.Contains(t, checkpoints.marked, uni.ID)
+	require.Empty(t, checkpoints.checkpoints)
+
+	// If the sync isn't resumed, then the checkpoint of the previous sync
+	// is ignored, and all roots are synced again.
+	err = checkpoints.MarkUniverseSynced(
+		ctx, source, SyncIssuance, checkpointedRoot.ID,
+	)
+	require.NoError(t, err)
+
+	syncConfigs.ResumeSync = false
+	numMarked := len(checkpoints.marked)
+	syncer, registrar = newSyncer(checkpoints)
+	diffs, err = syncer.executeSync(
+		ctx, remoteEngine, source, SyncIssuance, syncConfigs, nil,
+		false, nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, diffs, 2)
+	require.Len(t, registrar.registeredItems, numLeaves)
+	require.Empty(t, checkpoints.checkpoints)
+
+	// The failed root is still reported, but not added to the checkpoint.
+	for _, diff := range diffs {
+		if diff.NewUniverseRoot.ID == checkpointedRoot.ID {
+			require.True(t, diff.Failed())
+		}
+	}
+	require.Equal(t, []Identifier{uni.ID}, checkpoints.marked[numMarked:])
+
+	// A sync that is interrupted keeps its checkpoint, so it can be
+	// resumed later.
+	err = checkpoints.MarkUniverseSynced(
+		ctx, source, SyncIssuance, checkpointedRoot.ID,
+	)
+	require.NoError(t, err)
+
+	ctxc, cancel := context.WithCancel(ctx)
+	cancel()
+
+	syncConfigs.ResumeSync = true
+	syncer, _ = newSyncer(checkpoints)
+	_, _ = syncer.executeSync(
+		ctxc, remoteEngine, source, SyncIssuance, syncConfigs, nil,
+		false, nil,
+	)
+
+	checkpoint, err := checkpoints.SyncCheckpoint(ctx, source, SyncIssuance)
+	require.NoError(t, err)
+	require.True(t, checkpoint.Contains(checkpointedRoot.ID.String()))
+}
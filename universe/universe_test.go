@@ -1,9 +1,13 @@
 package universe
 
 import (
+	"encoding/hex"
+	"strings"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/stretchr/testify/require"
 )
@@ -105,3 +109,144 @@ func TestValidateProofUniverseType(t *testing.T) {
 		})
 	}
 }
+
+// TestServerAddrProxy tests that the proxy of a server address is validated,
+// that the global proxy is only used if no server specific proxy is set, and
+// that the host of a server is never resolved when connecting through a proxy.
+func TestServerAddrProxy(t *testing.T) {
+	t.Parallel()
+
+	validProxy := &ProxyConfig{
+		Addr:     "127.0.0.1:9050",
+		User:     "user",
+		Password: "password",
+	}
+	require.NoError(t, validProxy.Validate())
+
+	noPortProxy := &ProxyConfig{
+		Addr: "127.0.0.1",
+	}
+	require.Error(t, noPortProxy.Validate())
+
+	noUserProxy := &ProxyConfig{
+		Addr:     "127.0.0.1:9050",
+		Password: "password",
+	}
+	require.Error(t, noUserProxy.Validate())
+
+	// A server without a proxy should use the global proxy, while a server
+	// specific proxy takes precedence.
+	plainAddr := NewServerAddrFromStr("universe.onion")
+	require.Equal(
+		t, validProxy, WithDefaultProxy(plainAddr, validProxy).Proxy,
+	)
+	require.Nil(t, plainAddr.Proxy)
+
+	serverProxy := &ProxyConfig{
+		Addr: "127.0.0.1:9150",
+	}
+	proxyAddr := NewServerAddrFromStr("universe.onion:10030")
+	proxyAddr.Proxy = serverProxy
+	require.Equal(
+		t, serverProxy, WithDefaultProxy(proxyAddr, validProxy).Proxy,
+	)
+
+	// The host port should use the default port if none is set, without
+	// resolving the host name.
+	hostPort, err := plainAddr.HostPort()
+	require.NoError(t, err)
+	require.Equal(t, "universe.onion:10029", hostPort)
+
+	hostPort, err = proxyAddr.HostPort()
+	require.NoError(t, err)
+	require.Equal(t, "universe.onion:10030", hostPort)
+}
+
+// TestRootNodesQueryIDRange tests that the byte range of an ID prefix contains
+// exactly the IDs that start with the prefix.
+func TestRootNodesQueryIDRange(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		prefix string
+		start  []byte
+		end    []byte
+	}{{
+		prefix: "",
+	}, {
+		prefix: "ab",
+		start:  []byte{0xab},
+		end:    []byte{0xac},
+	}, {
+		prefix: "a",
+		start:  []byte{0xa0},
+		end:    []byte{0xb0},
+	}, {
+		prefix: "9",
+		start:  []byte{0x90},
+		end:    []byte{0xa0},
+	}, {
+		prefix: "abf",
+		start:  []byte{0xab, 0xf0},
+		end:    []byte{0xac},
+	}, {
+		prefix: "0fff",
+		start:  []byte{0x0f, 0xff},
+		end:    []byte{0x10},
+	}, {
+		prefix: "ff",
+		start:  []byte{0xff},
+	}}
+
+	for _, tc := range testCases {
+		q := RootNodesQuery{IDPrefix: tc.prefix}
+		require.NoError(t, q.Validate())
+
+		start, end := q.IDRange()
+		require.Equal(t, tc.start, start, "prefix %q", tc.prefix)
+		require.Equal(t, tc.end, end, "prefix %q", tc.prefix)
+	}
+
+	for _, prefix := range []string{"AB", "xy", strings.Repeat("a", 65)} {
+		q := RootNodesQuery{IDPrefix: prefix}
+		require.Error(t, q.Validate(), "prefix %q", prefix)
+	}
+}
+
+// TestRootNodesQueryMatches tests that both asset IDs and group keys are
+// matched against the ID prefix of a query.
+func TestRootNodesQueryMatches(t *testing.T) {
+	t.Parallel()
+
+	assetID := asset.RandID(t)
+	groupKey := test.RandPubKey(t)
+
+	assetIDHex := hex.EncodeToString(assetID[:])
+	groupKeyHex := hex.EncodeToString(schnorr.SerializePubKey(groupKey))
+
+	assetUni := Identifier{
+		AssetID: assetID,
+	}
+	groupUni := Identifier{
+		AssetID:  assetID,
+		GroupKey: groupKey,
+	}
+
+	require.True(t, RootNodesQuery{}.Matches(assetUni))
+	require.True(t, RootNodesQuery{}.Matches(groupUni))
+
+	assetQuery := RootNodesQuery{IDPrefix: assetIDHex[:5]}
+	require.True(t, assetQuery.Matches(assetUni))
+
+	groupQuery := RootNodesQuery{IDPrefix: groupKeyHex[:5]}
+	require.True(t, groupQuery.Matches(groupUni))
+
+	fullQuery := RootNodesQuery{IDPrefix: assetIDHex}
+	require.True(t, fullQuery.Matches(assetUni))
+
+	// A grouped universe is identified by its group key, so the asset ID
+	// doesn't match it, unless the prefixes happen to collide.
+	if !strings.HasPrefix(groupKeyHex, assetIDHex[:5]) {
+		require.False(t, assetQuery.Matches(groupUni))
+	}
+}
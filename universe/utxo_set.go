@@ -0,0 +1,155 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+// AssetUtxo is an asset output that isn't spent by any of the leaves known to
+// the local universe.
+type AssetUtxo struct {
+	// AssetID is the ID of the asset held by the output.
+	AssetID asset.ID
+
+	// OutPoint is the on-chain output the asset is anchored in.
+	OutPoint wire.OutPoint
+
+	// ScriptKey is the script key that owns the asset.
+	ScriptKey asset.SerializedKey
+
+	// Amount is the number of asset units held by the output.
+	Amount uint64
+}
+
+// AssetUtxoSet is the set of unspent outputs of an asset, as derived from the
+// issuance and transfer universes of the asset.
+type AssetUtxoSet struct {
+	// Utxos is the set of unspent outputs of the asset, sorted by outpoint
+	// and script key.
+	Utxos []AssetUtxo
+
+	// TotalAmount is the sum of the amounts of all unspent outputs.
+	TotalAmount uint64
+}
+
+// leafPrevID returns the prev ID that a spend of the asset of the given leaf
+// refers to.
+func leafPrevID(leaf *Leaf) asset.PrevID {
+	leafAsset := &leaf.Proof.Asset
+
+	return asset.PrevID{
+		OutPoint:  leaf.Proof.OutPoint(),
+		ID:        leafAsset.ID(),
+		ScriptKey: asset.ToSerialized(leafAsset.ScriptKey.PubKey),
+	}
+}
+
+// spentPrevIDs returns the prev IDs of all inputs spent by the asset of the
+// given leaf. For a split output, the inputs are spent by the root asset of the
+// split.
+func spentPrevIDs(leaf *Leaf) []asset.PrevID {
+	witnesses := leaf.Proof.Asset.PrevWitnesses
+	if leaf.Proof.Asset.HasSplitCommitmentWitness() {
+		witnesses = witnesses[0].SplitCommitment.RootAsset.PrevWitnesses
+	}
+
+	var prevIDs []asset.PrevID
+	for _, witness := range witnesses {
+		prevID := witness.PrevID
+		if prevID == nil || *prevID == asset.ZeroPrevID {
+			continue
+		}
+
+		prevIDs = append(prevIDs, *prevID)
+	}
+
+	return prevIDs
+}
+
+// QueryAssetUtxos returns the current set of unspent outputs of the asset with
+// the given universe ID, along with the total amount they hold. The set is
+// derived by walking all the issuance and transfer leaves of the asset, and
+// removing the outputs that are spent by any of the transfer leaves. The proof
+// type of the ID is ignored. Burned outputs and outputs without any units are
+// never part of the set.
+//
+// NOTE: The set is only complete if the local universe knows about the full
+// transfer history of the asset.
+func (a *MintingArchive) QueryAssetUtxos(ctx context.Context,
+	id Identifier) (*AssetUtxoSet, error) {
+
+	log.Debugf("Querying asset UTXO set for Universe: id=%v",
+		id.StringForLog())
+
+	var leaves []Leaf
+	for _, proofType := range []ProofType{
+		ProofTypeIssuance, ProofTypeTransfer,
+	} {
+		uniID := id
+		uniID.ProofType = proofType
+
+		uniLeaves, err := a.MintingLeaves(ctx, uniID)
+		switch {
+		case errors.Is(err, ErrNoUniverseRoot):
+			continue
+
+		case err != nil:
+			return nil, fmt.Errorf("unable to fetch %v leaves: %w",
+				proofType, err)
+		}
+
+		leaves = append(leaves, uniLeaves...)
+	}
+
+	spent := fn.NewSet[asset.PrevID]()
+	for idx := range leaves {
+		for _, prevID := range spentPrevIDs(&leaves[idx]) {
+			spent.Add(prevID)
+		}
+	}
+
+	// The same output can be known to both universes, so we'll make sure
+	// to only count each output once.
+	utxoSet := &AssetUtxoSet{}
+	seen := fn.NewSet[asset.PrevID]()
+	for idx := range leaves {
+		leaf := &leaves[idx]
+		leafAsset := leaf.Proof.Asset
+
+		prevID := leafPrevID(leaf)
+		if spent.Contains(prevID) || seen.Contains(prevID) {
+			continue
+		}
+		seen.Add(prevID)
+
+		if leafAsset.Amount == 0 || leafAsset.IsBurn() {
+			continue
+		}
+
+		utxoSet.Utxos = append(utxoSet.Utxos, AssetUtxo{
+			AssetID:   prevID.ID,
+			OutPoint:  prevID.OutPoint,
+			ScriptKey: prevID.ScriptKey,
+			Amount:    leafAsset.Amount,
+		})
+		utxoSet.TotalAmount += leafAsset.Amount
+	}
+
+	sort.Slice(utxoSet.Utxos, func(i, j int) bool {
+		left, right := utxoSet.Utxos[i], utxoSet.Utxos[j]
+		if left.OutPoint != right.OutPoint {
+			return left.OutPoint.String() < right.OutPoint.String()
+		}
+
+		return bytes.Compare(left.ScriptKey[:], right.ScriptKey[:]) < 0
+	})
+
+	return utxoSet, nil
+}
@@ -0,0 +1,129 @@
+package universe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// utxoLeaf returns a leaf with an asset of the given amount that spends the
+// given inputs, anchored in a random output. If no inputs are given, the asset
+// is a genesis asset.
+func utxoLeaf(t *testing.T, amt uint64, inputs ...asset.PrevID) Leaf {
+	newAsset := asset.RandAsset(t, asset.Normal)
+	newAsset.Amount = amt
+	newAsset.PrevWitnesses = []asset.Witness{{
+		PrevID: &asset.ZeroPrevID,
+	}}
+	if len(inputs) != 0 {
+		newAsset.PrevWitnesses = nil
+	}
+	for idx := range inputs {
+		newAsset.PrevWitnesses = append(
+			newAsset.PrevWitnesses, asset.Witness{
+				PrevID: &inputs[idx],
+			},
+		)
+	}
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(t),
+	})
+
+	return Leaf{
+		Proof: &proof.Proof{
+			AnchorTx: *anchorTx,
+			Asset:    *newAsset,
+		},
+		Amt: amt,
+	}
+}
+
+// TestQueryAssetUtxos tests that the UTXO set of an asset only contains the
+// outputs that aren't spent by any of the leaves of the asset, and that burned
+// outputs are excluded.
+func TestQueryAssetUtxos(t *testing.T) {
+	t.Parallel()
+
+	// The first issuance is spent by a transfer that splits it into two
+	// outputs. One of them is burned, the other one is unspent.
+	spentIssuance := utxoLeaf(t, 100)
+	splitRoot := utxoLeaf(t, 60, leafPrevID(&spentIssuance))
+
+	splitOutput := utxoLeaf(t, 40)
+	splitOutput.Proof.Asset.PrevWitnesses = []asset.Witness{{
+		PrevID: &asset.ZeroPrevID,
+		SplitCommitment: &asset.SplitCommitment{
+			RootAsset: *splitRoot.Proof.Asset.Copy(),
+		},
+	}}
+	require.True(t, splitOutput.Proof.Asset.HasSplitCommitmentWitness())
+
+	splitRootID := leafPrevID(&splitRoot)
+	burn := utxoLeaf(t, 60, splitRootID)
+	burn.Proof.Asset.ScriptKey = asset.NewScriptKey(
+		asset.DeriveBurnKey(splitRootID),
+	)
+	require.True(t, burn.Proof.Asset.IsBurn())
+
+	// The second issuance is unspent, while the third issuance is merged
+	// with the split output into a single output.
+	unspentIssuance := utxoLeaf(t, 10)
+	mergedIssuance := utxoLeaf(t, 5)
+	merge := utxoLeaf(
+		t, 45, leafPrevID(&mergedIssuance), leafPrevID(&splitOutput),
+	)
+
+	universes := map[ProofType]*mockSupplyUniverse{
+		ProofTypeIssuance: {
+			leaves: []Leaf{
+				spentIssuance, unspentIssuance, mergedIssuance,
+			},
+		},
+		ProofTypeTransfer: {
+			leaves: []Leaf{splitRoot, splitOutput, burn, merge},
+		},
+	}
+	archive := NewMintingArchive(MintingArchiveConfig{
+		NewBaseTree: func(id Identifier) BaseBackend {
+			return universes[id.ProofType]
+		},
+	})
+
+	ctx := context.Background()
+	utxoSet, err := archive.QueryAssetUtxos(ctx, Identifier{
+		AssetID: asset.RandID(t),
+	})
+	require.NoError(t, err)
+
+	expected := []AssetUtxo{{
+		AssetID:   unspentIssuance.Proof.Asset.ID(),
+		OutPoint:  unspentIssuance.Proof.OutPoint(),
+		ScriptKey: leafPrevID(&unspentIssuance).ScriptKey,
+		Amount:    10,
+	}, {
+		AssetID:   merge.Proof.Asset.ID(),
+		OutPoint:  merge.Proof.OutPoint(),
+		ScriptKey: leafPrevID(&merge).ScriptKey,
+		Amount:    45,
+	}}
+	require.ElementsMatch(t, expected, utxoSet.Utxos)
+	require.EqualValues(t, 55, utxoSet.TotalAmount)
+
+	// An unknown asset doesn't have any outputs.
+	universes[ProofTypeIssuance].leaves = nil
+	universes[ProofTypeTransfer].leaves = nil
+
+	utxoSet, err = archive.QueryAssetUtxos(ctx, Identifier{
+		AssetID: asset.RandID(t),
+	})
+	require.NoError(t, err)
+	require.Empty(t, utxoSet.Utxos)
+	require.Zero(t, utxoSet.TotalAmount)
+}
@@ -0,0 +1,355 @@
+package taprootassets
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+
+	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
+	"golang.org/x/exp/maps"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// rootsExportPath is the REST path that exports all known universe
+	// roots.
+	rootsExportPath = "/v1/taproot-assets/universe/roots/export"
+
+	// rootsExportMethod is the full name of the RPC that is used to fetch
+	// the roots for an export. The caller's macaroon is checked against
+	// the permissions of this method.
+	rootsExportMethod = "/universerpc.Universe/AssetRoots"
+
+	// rootsExportPageSize is the number of roots that are fetched at once
+	// while exporting.
+	rootsExportPageSize = 500
+
+	// rootsExportFormatCSV is the export format that writes one root per
+	// CSV row, preceded by a header row.
+	rootsExportFormatCSV = "csv"
+
+	// rootsExportFormatJSON is the export format that writes all roots as
+	// a single JSON array.
+	rootsExportFormatJSON = "json"
+)
+
+// rootsExportColumns are the names of the columns of a CSV roots export, which
+// are also used as the field names of a JSON export.
+var rootsExportColumns = []string{
+	"namespace", "asset_id", "group_key", "asset_name", "root_hash",
+	"root_sum", "num_leaves", "last_updated",
+}
+
+// exportedRoot is a single universe root as written to an export.
+type exportedRoot struct {
+	Namespace   string `json:"namespace"`
+	AssetID     string `json:"asset_id"`
+	GroupKey    string `json:"group_key"`
+	AssetName   string `json:"asset_name"`
+	RootHash    string `json:"root_hash"`
+	RootSum     int64  `json:"root_sum"`
+	NumLeaves   uint64 `json:"num_leaves"`
+	LastUpdated int64  `json:"last_updated"`
+}
+
+// newExportedRoot creates the export form of the given universe root.
+func newExportedRoot(namespace string,
+	root *unirpc.UniverseRoot) exportedRoot {
+
+	exported := exportedRoot{
+		Namespace:   namespace,
+		AssetID:     hex.EncodeToString(root.Id.GetAssetId()),
+		GroupKey:    hex.EncodeToString(root.Id.GetGroupKey()),
+		AssetName:   root.AssetName,
+		NumLeaves:   root.NumLeaves,
+		LastUpdated: root.LastUpdated,
+	}
+	if root.MssmtRoot != nil {
+		exported.RootHash = hex.EncodeToString(root.MssmtRoot.RootHash)
+		exported.RootSum = root.MssmtRoot.RootSum
+	}
+
+	return exported
+}
+
+// csvRow returns the root as a CSV row, in the order of rootsExportColumns.
+func (r exportedRoot) csvRow() []string {
+	return []string{
+		r.Namespace, r.AssetID, r.GroupKey, r.AssetName, r.RootHash,
+		strconv.FormatInt(r.RootSum, 10),
+		strconv.FormatUint(r.NumLeaves, 10),
+		strconv.FormatInt(r.LastUpdated, 10),
+	}
+}
+
+// rootsExportWriter writes universe roots to an export in a specific format.
+type rootsExportWriter interface {
+	// contentType returns the HTTP content type of the export.
+	contentType() string
+
+	// begin writes anything that precedes the first root.
+	begin() error
+
+	// writeRoot writes a single root.
+	writeRoot(root exportedRoot) error
+
+	// flush writes out all buffered data.
+	flush() error
+
+	// end writes anything that follows the last root and flushes all
+	// buffered data.
+	end() error
+}
+
+// csvRootsWriter writes roots as CSV rows.
+type csvRootsWriter struct {
+	w *csv.Writer
+}
+
+// contentType returns the HTTP content type of the export.
+func (c *csvRootsWriter) contentType() string {
+	return "text/csv"
+}
+
+// begin writes the CSV header row.
+func (c *csvRootsWriter) begin() error {
+	return c.w.Write(rootsExportColumns)
+}
+
+// writeRoot writes a single root as a CSV row.
+func (c *csvRootsWriter) writeRoot(root exportedRoot) error {
+	return c.w.Write(root.csvRow())
+}
+
+// flush writes out all buffered rows.
+func (c *csvRootsWriter) flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// end flushes all buffered rows, as there's no trailer in a CSV export.
+func (c *csvRootsWriter) end() error {
+	return c.flush()
+}
+
+// jsonRootsWriter writes roots as the elements of a JSON array. The array is
+// written incrementally, so the full set of roots never needs to be held in
+// memory.
+type jsonRootsWriter struct {
+	w io.Writer
+
+	numWritten int
+}
+
+// contentType returns the HTTP content type of the export.
+func (j *jsonRootsWriter) contentType() string {
+	return "application/json"
+}
+
+// begin opens the JSON array.
+func (j *jsonRootsWriter) begin() error {
+	_, err := io.WriteString(j.w, "[")
+	return err
+}
+
+// writeRoot writes a single root as an element of the JSON array.
+func (j *jsonRootsWriter) writeRoot(root exportedRoot) error {
+	rootBytes, err := json.Marshal(root)
+	if err != nil {
+		return err
+	}
+
+	if j.numWritten > 0 {
+		if _, err := io.WriteString(j.w, ",\n"); err != nil {
+			return err
+		}
+	}
+	j.numWritten++
+
+	_, err = j.w.Write(rootBytes)
+	return err
+}
+
+// flush is a no-op, as the JSON writer doesn't buffer any data.
+func (j *jsonRootsWriter) flush() error {
+	return nil
+}
+
+// end closes the JSON array.
+func (j *jsonRootsWriter) end() error {
+	_, err := io.WriteString(j.w, "]\n")
+	return err
+}
+
+// newRootsExportWriter returns the export writer for the given format. An
+// empty format selects the CSV format.
+func newRootsExportWriter(format string, w io.Writer) (rootsExportWriter,
+	error) {
+
+	switch format {
+	case "", rootsExportFormatCSV:
+		return &csvRootsWriter{w: csv.NewWriter(w)}, nil
+
+	case rootsExportFormatJSON:
+		return &jsonRootsWriter{w: w}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown export format %q, must be "+
+			"either %q or %q", format, rootsExportFormatCSV,
+			rootsExportFormatJSON)
+	}
+}
+
+// exportRoots pages through all universe roots matching the given request and
+// writes them to the given export writer. The begin callback is invoked once
+// the first page was fetched successfully, before anything is written, so the
+// caller can still report errors of the first call properly.
+func exportRoots(ctx context.Context, client unirpc.UniverseClient,
+	req *unirpc.AssetRootRequest, exportWriter rootsExportWriter,
+	begin func(), flush func()) error {
+
+	req.Offset = 0
+	req.Limit = rootsExportPageSize
+
+	started := false
+	for {
+		resp, err := client.AssetRoots(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		if !started {
+			begin()
+			if err := exportWriter.begin(); err != nil {
+				return err
+			}
+			started = true
+		}
+
+		// The roots of a page are returned as a map, so we sort them
+		// by their namespace to keep the order of the export stable.
+		namespaces := maps.Keys(resp.UniverseRoots)
+		sort.Strings(namespaces)
+		for _, namespace := range namespaces {
+			root := newExportedRoot(
+				namespace, resp.UniverseRoots[namespace],
+			)
+			if err := exportWriter.writeRoot(root); err != nil {
+				return err
+			}
+		}
+
+		if err := exportWriter.flush(); err != nil {
+			return err
+		}
+		flush()
+
+		// A zero next offset means this was the last page.
+		if resp.NextOffset == 0 {
+			break
+		}
+		req.Offset = resp.NextOffset
+	}
+
+	if err := exportWriter.end(); err != nil {
+		return err
+	}
+	flush()
+
+	return nil
+}
+
+// registerRootsExportHandler registers the REST handler that streams all
+// known universe roots as CSV or JSON to the caller. The roots are fetched
+// page by page through the AssetRoots RPC of the given gRPC endpoint, so the
+// usual macaroon checks apply.
+func registerRootsExportHandler(ctx context.Context, mux *proxy.ServeMux,
+	endpoint string, dialOpts []grpc.DialOption) error {
+
+	conn, err := grpc.DialContext(ctx, endpoint, dialOpts...)
+	if err != nil {
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		if err := conn.Close(); err != nil {
+			rpcsLog.Errorf("Unable to close roots export "+
+				"connection to %s: %v", endpoint, err)
+		}
+	}()
+
+	client := unirpc.NewUniverseClient(conn)
+
+	return mux.HandlePath(
+		http.MethodGet, rootsExportPath,
+		func(w http.ResponseWriter, req *http.Request,
+			_ map[string]string) {
+
+			serveRootsExport(mux, client, w, req)
+		},
+	)
+}
+
+// serveRootsExport handles a single roots export request. The export format
+// is selected with the "format" query parameter, an optional ID prefix can be
+// given with the "id_prefix" query parameter.
+func serveRootsExport(mux *proxy.ServeMux, client unirpc.UniverseClient,
+	w http.ResponseWriter, req *http.Request) {
+
+	_, outboundMarshaler := proxy.MarshalerForRequest(mux, req)
+
+	ctx, err := proxy.AnnotateContext(
+		req.Context(), mux, req, rootsExportMethod,
+	)
+	if err != nil {
+		proxy.HTTPError(
+			req.Context(), mux, outboundMarshaler, w, req, err,
+		)
+		return
+	}
+
+	query := req.URL.Query()
+	exportWriter, err := newRootsExportWriter(query.Get("format"), w)
+	if err != nil {
+		err = status.Error(codes.InvalidArgument, err.Error())
+		proxy.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	}
+
+	// Once the first page of roots was fetched, we'll commit to a
+	// successful response. Any error after that can only be reported by
+	// aborting the export.
+	started := false
+	begin := func() {
+		w.Header().Set("Content-Type", exportWriter.contentType())
+		w.WriteHeader(http.StatusOK)
+		started = true
+	}
+	flush := func() {
+		if flusher, ok := w.(http.Flusher); ok {
+			flusher.Flush()
+		}
+	}
+
+	rootReq := &unirpc.AssetRootRequest{
+		IdPrefix: query.Get("id_prefix"),
+	}
+	err = exportRoots(ctx, client, rootReq, exportWriter, begin, flush)
+	switch {
+	case err != nil && !started:
+		proxy.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+
+	case err != nil:
+		rpcsLog.Errorf("Unable to complete universe roots export: %v",
+			err)
+	}
+}
@@ -0,0 +1,65 @@
+package taprootassets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// LndRootSigner is an implementation of the universe.RootSigner interface
+// that signs universe roots with the node identity key of the backing lnd
+// node.
+type LndRootSigner struct {
+	lnd *lndclient.LndServices
+
+	pubKey *btcec.PublicKey
+}
+
+// NewLndRootSigner creates a new instance of the LndRootSigner based on the
+// passed ln client.
+func NewLndRootSigner(lnd *lndclient.LndServices) (*LndRootSigner, error) {
+	pubKey, err := btcec.ParsePubKey(lnd.NodePubkey[:])
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse node identity key: %w",
+			err)
+	}
+
+	return &LndRootSigner{
+		lnd:    lnd,
+		pubKey: pubKey,
+	}, nil
+}
+
+// SignRootMsg signs the given root message with the node identity key. The
+// returned signature is a DER encoded ECDSA signature over the SHA256 digest
+// of the message.
+//
+// NOTE: This is part of the universe.RootSigner interface.
+func (l *LndRootSigner) SignRootMsg(ctx context.Context,
+	msg []byte) ([]byte, error) {
+
+	sig, err := l.lnd.Signer.SignMessage(ctx, msg, keychain.KeyLocator{
+		Family: keychain.KeyFamilyNodeKey,
+		Index:  0,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign root: %w", err)
+	}
+
+	return sig, nil
+}
+
+// PubKey returns the node identity key that roots are signed with.
+//
+// NOTE: This is part of the universe.RootSigner interface.
+func (l *LndRootSigner) PubKey() *btcec.PublicKey {
+	return l.pubKey
+}
+
+// A compile time assertion to ensure LndRootSigner meets the
+// universe.RootSigner interface.
+var _ universe.RootSigner = (*LndRootSigner)(nil)
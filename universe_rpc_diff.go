@@ -49,10 +49,15 @@ func unmarshalUniverseRoot(
 		return universe.BaseRoot{}, err
 	}
 
-	return universe.BaseRoot{
+	baseRoot := universe.BaseRoot{
 		ID:   id,
 		Node: unmarshalMerkleSumNode(root.MssmtRoot),
-	}, nil
+	}
+	if root.BurnRoot != nil {
+		baseRoot.BurnRoot = unmarshalMerkleSumNode(root.BurnRoot)
+	}
+
+	return baseRoot, nil
 }
 
 func unmarshalUniverseRoots(
@@ -60,27 +65,26 @@ func unmarshalUniverseRoots(
 
 	baseRoots := make([]universe.BaseRoot, 0, len(roots))
 	for _, root := range roots {
-		id, err := UnmarshalUniID(root.Id)
+		baseRoot, err := unmarshalUniverseRoot(root)
 		if err != nil {
 			return nil, err
 		}
 
-		baseRoots = append(baseRoots, universe.BaseRoot{
-			ID:   id,
-			Node: unmarshalMerkleSumNode(root.MssmtRoot),
-		})
+		baseRoots = append(baseRoots, baseRoot)
 	}
 
 	return baseRoots, nil
 }
 
 // RootNodes returns the complete set of known root nodes for the set
-// of assets tracked in the base Universe.
-func (r *RpcUniverseDiff) RootNodes(
-	ctx context.Context) ([]universe.BaseRoot, error) {
+// of assets tracked in the base Universe that match the given query.
+func (r *RpcUniverseDiff) RootNodes(ctx context.Context,
+	q universe.RootNodesQuery) ([]universe.BaseRoot, error) {
 
 	universeRoots, err := r.conn.AssetRoots(
-		ctx, &unirpc.AssetRootRequest{},
+		ctx, &unirpc.AssetRootRequest{
+			IdPrefix: q.IDPrefix,
+		},
 	)
 	if err != nil {
 		return nil, err
@@ -108,11 +112,41 @@ func (r *RpcUniverseDiff) RootNode(ctx context.Context,
 		return universe.BaseRoot{}, err
 	}
 
+	root := universeRoot.TransferRoot
 	if id.ProofType == universe.ProofTypeIssuance {
-		return unmarshalUniverseRoot(universeRoot.IssuanceRoot)
+		root = universeRoot.IssuanceRoot
+	}
+
+	// The remote server returns a blank root if it doesn't know about the
+	// target universe.
+	if root == nil || root.MssmtRoot == nil {
+		return universe.BaseRoot{}, universe.ErrNoUniverseRoot
+	}
+
+	return unmarshalUniverseRoot(root)
+}
+
+// MultiverseRoot returns the root of the multiverse tree for the given proof
+// type.
+func (r *RpcUniverseDiff) MultiverseRoot(ctx context.Context,
+	proofType universe.ProofType) (*universe.MultiverseRoot, error) {
+
+	rpcProofType, err := MarshalUniProofType(proofType)
+	if err != nil {
+		return nil, err
 	}
 
-	return unmarshalUniverseRoot(universeRoot.TransferRoot)
+	resp, err := r.conn.MultiverseRoot(ctx, &unirpc.MultiverseRootRequest{
+		ProofType: rpcProofType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &universe.MultiverseRoot{
+		ProofType: proofType,
+		Node:      unmarshalMerkleSumNode(resp.MultiverseRoot),
+	}, nil
 }
 
 // UniverseLeafKeys returns all the keys inserted in the universe.
@@ -141,6 +175,43 @@ func (r *RpcUniverseDiff) UniverseLeafKeys(ctx context.Context,
 	return keys, nil
 }
 
+// UniverseLeafKeysSince returns the keys of all leaves inserted into the
+// universe after it had the given root hash.
+func (r *RpcUniverseDiff) UniverseLeafKeysSince(ctx context.Context,
+	id universe.Identifier,
+	rootHash mssmt.NodeHash) ([]universe.LeafKey, error) {
+
+	uniID, err := MarshalUniID(id)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.conn.AssetLeafKeysSince(
+		ctx, &unirpc.AssetLeafKeysSinceRequest{
+			Id:       uniID,
+			RootHash: rootHash[:],
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.UnknownRoot {
+		return nil, universe.ErrUnknownUniverseRoot
+	}
+
+	keys := make([]universe.LeafKey, len(resp.AssetKeys))
+	for i, key := range resp.AssetKeys {
+		leafKey, err := unmarshalLeafKey(key)
+		if err != nil {
+			return nil, err
+		}
+
+		keys[i] = leafKey
+	}
+
+	return keys, nil
+}
+
 // FetchIssuanceProof attempts to fetch an issuance proof for the
 // target base leaf based on the universe identifier
 // (assetID/groupKey).
@@ -4,17 +4,34 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"net"
+	"strings"
 	"time"
 
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/mssmt"
 	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	"github.com/lightninglabs/taproot-assets/universe"
+	"golang.org/x/net/proxy"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 )
 
+var (
+	// ErrTLSCertMismatch is returned when the TLS certificate presented by
+	// a remote universe server doesn't match the pinned certificate.
+	ErrTLSCertMismatch = errors.New("universe server TLS cert doesn't " +
+		"match pinned cert")
+
+	// ErrInvalidInclusionProof is returned when a universe inclusion proof
+	// doesn't commit to the claimed Universe root.
+	ErrInvalidInclusionProof = errors.New("invalid universe inclusion " +
+		"proof")
+)
+
 // RpcUniverseRegistrar is an implementation of the universe.Registrar interface
 // that uses an RPC connection to target Universe.
 type RpcUniverseRegistrar struct {
@@ -78,6 +95,63 @@ func unmarshalIssuanceProof(uniKey *unirpc.UniverseKey,
 	}, nil
 }
 
+// VerifyUniverseInclusionProof verifies that the asset leaf contained in the
+// given universe inclusion proof is committed to within the Universe root of
+// the proof. Only the data contained in the proof itself is used, so the
+// Universe root value reported by the server doesn't need to be trusted.
+func VerifyUniverseInclusionProof(
+	uniProof *unirpc.UniverseInclusionProof) error {
+
+	if uniProof.Req == nil || uniProof.UniverseRoot == nil {
+		return fmt.Errorf("incomplete universe inclusion proof")
+	}
+
+	// We'll first make sure the leaf key of the proof actually matches the
+	// asset key we requested the proof for.
+	leafKey, err := unmarshalLeafKey(uniProof.Req.LeafKey)
+	if err != nil {
+		return err
+	}
+
+	expectedKey := leafKey.UniverseKey()
+	if !bytes.Equal(expectedKey[:], uniProof.LeafKey) {
+		return fmt.Errorf("%w: leaf key mismatch, expected %x, got %x",
+			ErrInvalidInclusionProof, expectedKey[:],
+			uniProof.LeafKey)
+	}
+
+	var compressedProof mssmt.CompressedProof
+	err = compressedProof.Decode(
+		bytes.NewReader(uniProof.UniverseInclusionProof),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to decode inclusion proof: %w", err)
+	}
+
+	inclusionProof, err := compressedProof.Decompress()
+	if err != nil {
+		return fmt.Errorf("unable to decompress inclusion proof: %w",
+			err)
+	}
+
+	// With the proof decoded, we'll now reconstruct the root from the
+	// leaf and check it against the claimed root.
+	leafNode := mssmt.NewLeafNode(uniProof.LeafValue, uniProof.LeafSum)
+	claimedRoot := mssmt.NewComputedBranch(
+		fn.ToArray[mssmt.NodeHash](uniProof.UniverseRoot.RootHash),
+		uint64(uniProof.UniverseRoot.RootSum),
+	)
+	if !mssmt.VerifyMerkleProof(
+		expectedKey, leafNode, inclusionProof, claimedRoot,
+	) {
+
+		return fmt.Errorf("%w: universe root mismatch",
+			ErrInvalidInclusionProof)
+	}
+
+	return nil
+}
+
 // RegisterIssuance is an implementation of the universe.Registrar interface
 // that uses a remote Universe server as the Registry instance.
 func (r *RpcUniverseRegistrar) RegisterIssuance(ctx context.Context,
@@ -151,28 +225,155 @@ func CheckFederationServer(localRuntimeID int64, connectTimeout time.Duration,
 	return nil
 }
 
+// universeTLSConfig returns the TLS config that should be used to connect to
+// the given universe server. If the server has a pinned TLS certificate, then
+// that certificate is used as the only trusted CA. Otherwise, we fall back to
+// not verifying the server's certificate at all.
+func universeTLSConfig(serverAddr universe.ServerAddr) (*tls.Config, error) {
+	if len(serverAddr.TLSCert) == 0 {
+		return &tls.Config{
+			InsecureSkipVerify: true,
+		}, nil
+	}
+
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(serverAddr.TLSCert) {
+		return nil, fmt.Errorf("unable to parse pinned TLS cert for "+
+			"universe server %v", serverAddr.HostStr())
+	}
+
+	// We skip the default verification, as that would also check the host
+	// name against the certificate, which isn't what we want for a pinned
+	// certificate. Instead, we only make sure the presented certificate
+	// chain is rooted in the pinned certificate.
+	verifyPinned := func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return fmt.Errorf("universe server %v presented no TLS "+
+				"certificate", serverAddr.HostStr())
+		}
+
+		certs := make([]*x509.Certificate, 0, len(rawCerts))
+		for _, rawCert := range rawCerts {
+			cert, err := x509.ParseCertificate(rawCert)
+			if err != nil {
+				return fmt.Errorf("unable to parse TLS cert of "+
+					"universe server %v: %w",
+					serverAddr.HostStr(), err)
+			}
+			certs = append(certs, cert)
+		}
+
+		intermediates := x509.NewCertPool()
+		for _, cert := range certs[1:] {
+			intermediates.AddCert(cert)
+		}
+
+		_, err := certs[0].Verify(x509.VerifyOptions{
+			Roots:         certPool,
+			Intermediates: intermediates,
+		})
+		if err != nil {
+			return fmt.Errorf("%w: universe server %v: %v",
+				ErrTLSCertMismatch, serverAddr.HostStr(), err)
+		}
+
+		return nil
+	}
+
+	return &tls.Config{
+		InsecureSkipVerify:    true,
+		VerifyPeerCertificate: verifyPinned,
+	}, nil
+}
+
+// isOnionHost returns true if the given universe address is an onion service.
+func isOnionHost(uniAddr string) bool {
+	host, _, err := net.SplitHostPort(uniAddr)
+	if err != nil {
+		host = uniAddr
+	}
+
+	return strings.HasSuffix(host, ".onion")
+}
+
+// universeProxyDialer returns a gRPC dialer that connects to the target address
+// through the given SOCKS5 proxy. The target host name is sent to the proxy
+// as is, so name resolution takes place on the proxy.
+func universeProxyDialer(cfg *universe.ProxyConfig) (func(context.Context,
+	string) (net.Conn, error), error) {
+
+	var auth *proxy.Auth
+	if cfg.User != "" {
+		auth = &proxy.Auth{
+			User:     cfg.User,
+			Password: cfg.Password,
+		}
+	}
+
+	dialer, err := proxy.SOCKS5("tcp", cfg.Addr, auth, &net.Dialer{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create proxy dialer: %w", err)
+	}
+
+	contextDialer, ok := dialer.(proxy.ContextDialer)
+	if !ok {
+		return nil, fmt.Errorf("proxy dialer doesn't support contexts")
+	}
+
+	return func(ctx context.Context, addr string) (net.Conn, error) {
+		return contextDialer.DialContext(ctx, "tcp", addr)
+	}, nil
+}
+
 // ConnectUniverse connects to a remote Universe server using the provided
-// server address.
+// server address. If the server address has a proxy set, then the connection
+// is established through that proxy.
 func ConnectUniverse(
 	serverAddr universe.ServerAddr) (unirpc.UniverseClient, error) {
 
 	// TODO(roasbeef): all info is authenticated, but also want to allow
 	// brontide connect as well, can avoid TLS certs
-	creds := credentials.NewTLS(&tls.Config{
-		InsecureSkipVerify: true,
-	})
+	tlsConfig, err := universeTLSConfig(serverAddr)
+	if err != nil {
+		return nil, err
+	}
+	creds := credentials.NewTLS(tlsConfig)
 
 	// Create a dial options array.
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(creds),
 	}
 
-	uniAddr, err := serverAddr.Addr()
-	if err != nil {
-		return nil, err
+	var target string
+	switch {
+	// If a proxy is set, we don't resolve the host name locally, as that
+	// would leak the host we're connecting to. The proxy resolves it
+	// instead, which is also required to reach onion services.
+	case serverAddr.Proxy != nil:
+		target, err = serverAddr.HostPort()
+		if err != nil {
+			return nil, err
+		}
+
+		proxyDialer, err := universeProxyDialer(serverAddr.Proxy)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithContextDialer(proxyDialer))
+
+	case isOnionHost(serverAddr.HostStr()):
+		return nil, fmt.Errorf("a proxy is required to connect to "+
+			"onion universe server %v", serverAddr.HostStr())
+
+	default:
+		uniAddr, err := serverAddr.Addr()
+		if err != nil {
+			return nil, err
+		}
+		target = uniAddr.String()
 	}
 
-	rawConn, err := grpc.Dial(uniAddr.String(), opts...)
+	rawConn, err := grpc.Dial(target, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to connect to RPC "+
 			"server: %v", err)
@@ -12,6 +12,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/btcsuite/btcwallet/wtxmgr"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/tapfreighter"
 	"github.com/lightninglabs/taproot-assets/tapgarden"
@@ -40,6 +41,17 @@ const (
 	defaultChangeType = walletrpc.ChangeAddressType_CHANGE_ADDRESS_TYPE_P2TR
 )
 
+// lndInternalLockID is the binary representation of the SHA256 hash of the
+// string "lnd-internal-lock-id" and is used by lnd to lease the UTXOs it
+// selects when funding a PSBT. The ID corresponds to the hex value of
+// ede19a92ed321a4705f8a1cccc1d4f6182545d4bb4fae08bd5937831b7e38f98.
+var lndInternalLockID = wtxmgr.LockID{
+	0xed, 0xe1, 0x9a, 0x92, 0xed, 0x32, 0x1a, 0x47,
+	0x05, 0xf8, 0xa1, 0xcc, 0xcc, 0x1d, 0x4f, 0x61,
+	0x82, 0x54, 0x5d, 0x4b, 0xb4, 0xfa, 0xe0, 0x8b,
+	0xd5, 0x93, 0x78, 0x31, 0xb7, 0xe3, 0x8f, 0x98,
+}
+
 // FundPsbt attaches enough inputs to the target PSBT packet for it to be
 // valid.
 func (l *LndRpcWalletAnchor) FundPsbt(ctx context.Context, packet *psbt.Packet,
@@ -130,8 +142,19 @@ func (l *LndRpcWalletAnchor) ImportTaprootOutput(ctx context.Context,
 	return addr, nil
 }
 
-// UnlockInput unlocks the set of target inputs after a batch is abandoned.
-func (l *LndRpcWalletAnchor) UnlockInput(ctx context.Context) error {
+// ReleaseUTXOs releases the given wallet UTXOs that were leased when funding a
+// PSBT, making them available for coin selection again.
+func (l *LndRpcWalletAnchor) ReleaseUTXOs(ctx context.Context,
+	utxos ...wire.OutPoint) error {
+
+	for _, utxo := range utxos {
+		err := l.lnd.WalletKit.ReleaseOutput(ctx, lndInternalLockID, utxo)
+		if err != nil {
+			return fmt.Errorf("unable to release UTXO %v: %w", utxo,
+				err)
+		}
+	}
+
 	return nil
 }
 
@@ -145,6 +168,17 @@ func (l *LndRpcWalletAnchor) ListUnspentImportScripts(
 	)
 }
 
+// ListUnspent lists all UTXOs of the default wallet account with at least the
+// given number of confirmations that aren't leased.
+func (l *LndRpcWalletAnchor) ListUnspent(ctx context.Context,
+	minConfs uint32) ([]*lnwallet.Utxo, error) {
+
+	return l.lnd.WalletKit.ListUnspent(
+		ctx, int32(minConfs), math.MaxInt32,
+		lndclient.WithUnspentAccount(lnwallet.DefaultAccountName),
+	)
+}
+
 // SubscribeTransactions creates a uni-directional stream from the server to the
 // client in which any newly discovered transactions relevant to the wallet are
 // sent over.